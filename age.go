@@ -0,0 +1,130 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// ageWarningInterval is the minimum interval between two warnings of a list
+// serving an old embedded list.
+const ageWarningInterval = 24 * time.Hour
+
+// embeddedDate is the build date of the statically compiled list, zero if
+// unknown. It is the date declared by the header of the list, or the date its
+// release was committed on.
+var embeddedDate time.Time
+
+// EmbeddedRelease returns the release of the statically compiled list, the
+// baseline shipped with the binary, whatever list is currently loaded. It
+// reflects the list given to SetEmbedded, if any.
+func EmbeddedRelease() string {
+	if embeddedRules == nil {
+		return ""
+	}
+
+	return embeddedRules.Release
+}
+
+// EmbeddedBuildDate returns the build date of the statically compiled list,
+// whatever list is currently loaded: the date declared by the header of the
+// list, or else the date its release was committed on. It is zero if unknown.
+func EmbeddedBuildDate() time.Time {
+	return embeddedDate
+}
+
+// setInitialDate records the commit date of the release of list.go, if known,
+// as the build date of the statically compiled list, unless its header
+// declares one.
+func setInitialDate() {
+	if embeddedDate.IsZero() {
+		embeddedDate, _ = time.Parse(time.RFC3339, initialDate)
+	}
+}
+
+// EmbeddedAgeWarning describes the old embedded list still served by a list,
+// see SetEmbeddedAgeWarning.
+type EmbeddedAgeWarning struct {
+	// Release of the embedded list.
+	Release string
+	// Date is the build date of the embedded list.
+	Date time.Time
+	// Age of the embedded list.
+	Age time.Duration
+}
+
+// ageWarning warns about lookups served by an old embedded list.
+type ageWarning struct {
+	maxAge time.Duration
+	warn   func(EmbeddedAgeWarning)
+	// next is the time, in Unix nanoseconds, before which no warning is given
+	next atomic.Int64
+}
+
+// SetEmbeddedAgeWarning calls warn when the package level functions look up a
+// domain with the statically compiled list while it is older than maxAge,
+// nudging operators who forgot to set up updates. warn is called on the first
+// of these lookups and then at most once a day, synchronously. A nil warn logs
+// the warning with the log package, a maxAge of zero or less disables the
+// warning. Nothing is reported if the build date of the list is unknown, see
+// EmbeddedBuildDate.
+func SetEmbeddedAgeWarning(maxAge time.Duration, warn func(EmbeddedAgeWarning)) {
+	defaultList().SetEmbeddedAgeWarning(maxAge, warn)
+}
+
+// SetEmbeddedAgeWarning calls warn when l looks up a domain with an old
+// embedded list, see the package level SetEmbeddedAgeWarning.
+func (l *List) SetEmbeddedAgeWarning(maxAge time.Duration, warn func(EmbeddedAgeWarning)) {
+	if maxAge <= 0 {
+		l.ageWarning.Store(nil)
+		return
+	}
+
+	if warn == nil {
+		warn = logEmbeddedAge
+	}
+
+	l.ageWarning.Store(&ageWarning{maxAge: maxAge, warn: warn})
+}
+
+// logEmbeddedAge is the default warning of SetEmbeddedAgeWarning.
+func logEmbeddedAge(w EmbeddedAgeWarning) {
+	log.Printf("publicsuffix: serving the embedded list %s built %s, %s ago, the list should be updated", w.Release, w.Date.Format("2006-01-02"), w.Age.Round(time.Hour))
+}
+
+// check warns if ri is an embedded list older than the maximum age. It is a
+// no-op on a nil ageWarning.
+func (w *ageWarning) check(ri *rulesInfo) {
+	if w == nil || ri.provenance == nil || ri.provenance.Source != SourceEmbedded || embeddedDate.IsZero() {
+		return
+	}
+
+	var now = time.Now()
+	var age = now.Sub(embeddedDate)
+	if age < w.maxAge {
+		return
+	}
+
+	var next = w.next.Load()
+	if now.UnixNano() < next || !w.next.CompareAndSwap(next, now.Add(ageWarningInterval).UnixNano()) {
+		return
+	}
+
+	w.warn(EmbeddedAgeWarning{Release: ri.Release, Date: embeddedDate, Age: age})
+}
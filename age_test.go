@@ -0,0 +1,107 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func Test_SetEmbeddedAgeWarning(t *testing.T) {
+	var warnings []EmbeddedAgeWarning
+	var warn = func(w EmbeddedAgeWarning) {
+		warnings = append(warnings, w)
+	}
+
+	var saved = embeddedDate
+	t.Cleanup(func() { embeddedDate = saved })
+
+	// nothing is reported while the build date is unknown
+	embeddedDate = time.Time{}
+	var list = NewList()
+	list.SetEmbeddedAgeWarning(time.Nanosecond, warn)
+	list.PublicSuffix("example.com")
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %+v", warnings)
+	}
+
+	embeddedDate = time.Now().Add(-48 * time.Hour)
+	list = NewList()
+
+	// the embedded list isn't old enough
+	list.SetEmbeddedAgeWarning(time.Since(EmbeddedBuildDate())+time.Hour, warn)
+	list.PublicSuffix("example.com")
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %+v", warnings)
+	}
+
+	// warnings are given once a day
+	list.SetEmbeddedAgeWarning(time.Nanosecond, warn)
+	list.PublicSuffix("example.com")
+	list.Lookup("example.com")
+	if len(warnings) != 1 || warnings[0].Release != initialRelease || !warnings[0].Date.Equal(EmbeddedBuildDate()) || warnings[0].Age <= 0 {
+		t.Fatalf("unexpected warnings: %+v", warnings)
+	}
+
+	// an updated list isn't embedded
+	list.SetEmbeddedAgeWarning(time.Nanosecond, warn)
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\n"), Release: "age_test"}
+	if err := list.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	list.PublicSuffix("example.jp")
+	if len(warnings) != 1 {
+		t.Fatalf("unexpected warnings: %+v", warnings)
+	}
+
+	// disabled
+	list = NewList()
+	list.SetEmbeddedAgeWarning(0, warn)
+	list.PublicSuffix("example.com")
+	if len(warnings) != 1 {
+		t.Fatalf("unexpected warnings: %+v", warnings)
+	}
+}
+
+func Test_EmbeddedBuildInfo(t *testing.T) {
+	preserveRules(t)
+
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\n"), Release: "build_info_test"}
+	if err := UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// independent of the loaded list
+	if got := EmbeddedRelease(); got != initialRelease {
+		t.Fatalf("got: %s, want: %s", got, initialRelease)
+	}
+
+	// the commit date of the release is unknown when list.go was generated
+	// offline
+	var generated time.Time
+	if initialDate != "" {
+		var err error
+		if generated, err = time.Parse(time.RFC3339, initialDate); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if got := EmbeddedBuildDate(); !got.Equal(generated) {
+		t.Fatalf("got: %v, want: %v", got, generated)
+	}
+}
@@ -0,0 +1,212 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+)
+
+// listFile is the name of the list in the repository of the Public Suffix
+// List, looked up in archives by ReadArchive.
+const listFile = "public_suffix_list.dat"
+
+// ReadArchive loads a public suffix list from a zip, tar or gzip compressed tar
+// archive read from r, such as a data bundle shipped by a distribution
+// pipeline, and uses it for future lookups.
+//
+// The first file of the archive named public_suffix_list.dat, parsed as the
+// list identified by release, or public_suffix_list.bin, read as a snapshot
+// written by Write, is loaded whatever its directory. An error matching
+// fs.ErrNotExist is returned if there is none, and one matching ErrInvalidData
+// if the archive is damaged. See Read for the supported options.
+func ReadArchive(r io.Reader, release string, opts ...Option) error {
+	return defaultList().ReadArchive(r, release, opts...)
+}
+
+// ReadArchive loads a public suffix list from the archive read from r into l,
+// see the package level ReadArchive.
+func (l *List) ReadArchive(r io.Reader, release string, opts ...Option) error {
+	return l.loaded(l.readArchive(r, release, opts))
+}
+
+// ReadArchiveFile loads a public suffix list from the archive at path and uses
+// it for future lookups, see ReadArchive.
+func ReadArchiveFile(path, release string, opts ...Option) error {
+	return defaultList().ReadArchiveFile(path, release, opts...)
+}
+
+// ReadArchiveFile loads a public suffix list from the archive at path into l,
+// see the package level ReadArchive.
+func (l *List) ReadArchiveFile(path, release string, opts ...Option) error {
+	var file, err = os.Open(path)
+	if err != nil {
+		return l.loaded(err)
+	}
+	defer file.Close()
+
+	return l.ReadArchive(file, release, opts...)
+}
+
+// readArchive loads the list found in the archive read from r in l, see
+// ReadArchive.
+func (l *List) readArchive(r io.Reader, release string, opts []Option) error {
+	var name, content, err = findInArchive(r)
+	if err != nil {
+		return err
+	}
+
+	if name == cacheFile {
+		return l.read(bytes.NewReader(content), opts)
+	}
+
+	var o = l.options(opts)
+
+	var rulesInfo *rulesInfo
+	rulesInfo, err = newList(bytes.NewReader(content), release, append(l.opts[:len(l.opts):len(l.opts)], opts...)...)
+	if err != nil {
+		return err
+	}
+
+	if err := o.checkSize(rulesInfo); err != nil {
+		return err
+	}
+
+	var sum = sha256.Sum256(content)
+	rulesInfo.provenance = &Provenance{
+		Source:  SourceParsed,
+		Release: release,
+		Time:    time.Now(),
+		SHA256:  hex.EncodeToString(sum[:]),
+	}
+
+	l.store(*rulesInfo)
+
+	return nil
+}
+
+// findInArchive returns the base name and content of the first list or
+// snapshot of the archive read from r, whose format is detected from its first
+// bytes.
+func findInArchive(r io.Reader) (string, []byte, error) {
+	var br = bufio.NewReader(r)
+	var magic, _ = br.Peek(4)
+
+	switch {
+	case bytes.HasPrefix(magic, []byte("PK")):
+		// zip needs random access to its central directory at the end
+		var archive, err = io.ReadAll(br)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return findInZip(archive)
+
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		var gz, err = gzip.NewReader(br)
+		if err != nil {
+			return "", nil, dataError(fmt.Errorf("gzip error: %w", err))
+		}
+		defer gz.Close()
+
+		return findInTar(gz)
+
+	default:
+		return findInTar(br)
+	}
+}
+
+// findInZip returns the first list or snapshot of a zip archive.
+func findInZip(archive []byte) (string, []byte, error) {
+	var zr, err = zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return "", nil, dataError(fmt.Errorf("zip error: %w", err))
+	}
+
+	for _, file := range zr.File {
+		var name = path.Base(file.Name)
+		if file.FileInfo().IsDir() || (name != listFile && name != cacheFile) {
+			continue
+		}
+
+		var rc io.ReadCloser
+		rc, err = file.Open()
+		if err != nil {
+			return "", nil, dataError(fmt.Errorf("zip error: %w", err))
+		}
+		defer rc.Close()
+
+		var content []byte
+		content, err = io.ReadAll(rc)
+		if err != nil {
+			return "", nil, dataError(fmt.Errorf("zip error: %w", err))
+		}
+
+		return name, content, nil
+	}
+
+	return "", nil, notInArchive()
+}
+
+// findInTar returns the first list or snapshot of a tar archive.
+func findInTar(r io.Reader) (string, []byte, error) {
+	var tr = tar.NewReader(r)
+
+	for {
+		var header, err = tr.Next()
+		if errors.Is(err, io.EOF) {
+			return "", nil, notInArchive()
+		}
+		if err != nil {
+			return "", nil, dataError(fmt.Errorf("tar error: %w", err))
+		}
+
+		var name = path.Base(header.Name)
+		if header.Typeflag != tar.TypeReg || (name != listFile && name != cacheFile) {
+			continue
+		}
+
+		var content []byte
+		content, err = io.ReadAll(tr)
+		if err != nil {
+			return "", nil, dataError(fmt.Errorf("tar error: %w", err))
+		}
+
+		return name, content, nil
+	}
+}
+
+// notInArchive returns the error of an archive holding neither a list nor a
+// snapshot.
+func notInArchive() error {
+	return fmt.Errorf("no %s or %s in archive: %w", listFile, cacheFile, fs.ErrNotExist)
+}
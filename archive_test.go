@@ -0,0 +1,112 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_ReadArchive(t *testing.T) {
+	var list = NewList()
+
+	t.Run("Zip", func(t *testing.T) {
+		var archive bytes.Buffer
+		var zw = zip.NewWriter(&archive)
+		var w, _ = zw.Create("README")
+		w.Write([]byte("bundle"))
+		w, _ = zw.Create("psl/public_suffix_list.dat")
+		w.Write([]byte("jp\nkobe.jp\n"))
+		zw.Close()
+
+		if err := list.ReadArchive(&archive, "zip_test"); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if release := list.Release(); release != "zip_test" {
+			t.Fatalf("got: %s, want: %s", release, "zip_test")
+		}
+
+		if suffix, _ := list.PublicSuffix("example.kobe.jp"); suffix != "kobe.jp" {
+			t.Fatalf("got: %s, want: %s", suffix, "kobe.jp")
+		}
+	})
+
+	t.Run("Tar gzip snapshot", func(t *testing.T) {
+		var source, err = ParseList(bytes.NewBufferString("ac\ncom.ac\n"), "snapshot_test")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var snapshot bytes.Buffer
+		if err := source.Write(&snapshot); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var path = filepath.Join(t.TempDir(), "bundle.tar.gz")
+		var archive bytes.Buffer
+		var gz = gzip.NewWriter(&archive)
+		var tw = tar.NewWriter(gz)
+		tw.WriteHeader(&tar.Header{Name: "data/", Typeflag: tar.TypeDir, Mode: 0755})
+		tw.WriteHeader(&tar.Header{Name: "data/public_suffix_list.bin", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(snapshot.Len())})
+		tw.Write(snapshot.Bytes())
+		tw.Close()
+		gz.Close()
+
+		if err := os.WriteFile(path, archive.Bytes(), 0644); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		// the release of a snapshot is the one it was written with
+		if err := list.ReadArchiveFile(path, "ignored"); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if release := list.Release(); release != "snapshot_test" {
+			t.Fatalf("got: %s, want: %s", release, "snapshot_test")
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		var archive bytes.Buffer
+		var zw = zip.NewWriter(&archive)
+		zw.Create("README")
+		zw.Close()
+
+		if err := list.ReadArchive(&archive, "missing_test"); !errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("got: %v, want: %v", err, fs.ErrNotExist)
+		}
+
+		if err := list.ReadArchive(bytes.NewBufferString("not an archive, just some text that is long enough"), "invalid_test"); !errors.Is(err, ErrInvalidData) {
+			t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+		}
+
+		if release := list.Release(); release != "snapshot_test" {
+			t.Fatalf("got: %s, want: %s", release, "snapshot_test")
+		}
+	})
+}
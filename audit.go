@@ -0,0 +1,140 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "time"
+
+// AuditEntry records a change of the rules of a list, see SetAuditLog.
+type AuditEntry struct {
+	// Time of the change.
+	Time time.Time `json:"time"`
+	// Source of the new rules, one of SourceEmbedded, SourceSnapshot,
+	// SourceRetriever or SourceParsed, identifying what triggered the change:
+	// an update, Read or loading a parsed list.
+	Source string `json:"source"`
+	// Retriever and URL identify the origin of the new rules when known, see
+	// Provenance.
+	Retriever string `json:"retriever,omitempty"`
+	URL       string `json:"url,omitempty"`
+	// OldRelease and NewRelease are the releases of the replaced and new
+	// rules.
+	OldRelease string `json:"old_release"`
+	NewRelease string `json:"new_release"`
+	// Added and Removed are the number of rules added and removed by the
+	// change.
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+}
+
+// auditLog holds the audit trail of a list.
+type auditLog struct {
+	size    int
+	entries []AuditEntry
+	persist func(AuditEntry)
+}
+
+// SetAuditLog records an AuditEntry for every change of the rules of the
+// default list, such as by Update or Read, and retains the last n entries in
+// memory for AuditLog. If persist isn't nil it is called with each entry, for
+// example to append it to a file with JSONAuditWriter. It is called
+// synchronously by the function changing the list, once the change is made.
+//
+// The audit log is disabled by default as comparing the rules of each change
+// takes time; n <= 0 and a nil persist disable it and discard the retained
+// entries.
+func SetAuditLog(n int, persist func(AuditEntry)) {
+	defaultList().SetAuditLog(n, persist)
+}
+
+// SetAuditLog records the changes of the rules of l, see the package level
+// SetAuditLog.
+func (l *List) SetAuditLog(n int, persist func(AuditEntry)) {
+	if n < 0 {
+		n = 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if n == 0 && persist == nil {
+		l.audit = nil
+		return
+	}
+
+	var entries []AuditEntry
+	if l.audit != nil {
+		entries = l.audit.entries
+		if len(entries) > n {
+			entries = append([]AuditEntry(nil), entries[len(entries)-n:]...)
+		}
+	}
+
+	l.audit = &auditLog{size: n, entries: entries, persist: persist}
+}
+
+// AuditLog returns the entries retained by the audit log of the default list,
+// oldest first, see SetAuditLog.
+func AuditLog() []AuditEntry {
+	return defaultList().AuditLog()
+}
+
+// AuditLog returns the entries retained by the audit log of l, see the
+// package level AuditLog.
+func (l *List) AuditLog() []AuditEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.audit == nil {
+		return nil
+	}
+
+	return append([]AuditEntry(nil), l.audit.entries...)
+}
+
+// record adds the entry of the change from previous to ri to the audit log, if
+// enabled, and returns it with the function persisting it, nil if none. It
+// must be called with l.mu held.
+func (l *List) record(previous, ri *rulesInfo) (AuditEntry, func(AuditEntry)) {
+	if l.audit == nil {
+		return AuditEntry{}, nil
+	}
+
+	var entry = AuditEntry{Time: time.Now(), NewRelease: ri.Release}
+	if ri.provenance != nil {
+		entry.Source = ri.provenance.Source
+		entry.Retriever = ri.provenance.Retriever
+		entry.URL = ri.provenance.URL
+	}
+
+	var old map[string][]rule
+	if previous != nil {
+		entry.OldRelease = previous.Release
+		old = previous.Map
+	}
+
+	entry.Added = len(diffRules(ri.Map, old))
+	entry.Removed = len(diffRules(old, ri.Map))
+
+	if l.audit.size > 0 {
+		l.audit.entries = append(l.audit.entries, entry)
+		if len(l.audit.entries) > l.audit.size {
+			l.audit.entries = append([]AuditEntry(nil), l.audit.entries[len(l.audit.entries)-l.audit.size:]...)
+		}
+	}
+
+	return entry, l.audit.persist
+}
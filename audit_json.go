@@ -0,0 +1,44 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONAuditWriter returns a function for SetAuditLog persisting each entry to
+// w as a line of JSON, such as:
+//
+//	{"time":"2024-06-26T08:54:27Z","source":"retriever","retriever":"publicsuffix.gitHubListRetriever","url":"https://raw.githubusercontent.com/publicsuffix/list/0b5a2c8/public_suffix_list.dat","old_release":"22a461e","new_release":"0b5a2c8","added":12,"removed":3}
+//
+// Writes are serialised, errors are ignored.
+func JSONAuditWriter(w io.Writer) func(AuditEntry) {
+	var mu sync.Mutex
+	var encoder = json.NewEncoder(w)
+
+	return func(entry AuditEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		encoder.Encode(entry)
+	}
+}
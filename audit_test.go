@@ -0,0 +1,87 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func Test_AuditLog(t *testing.T) {
+	var list, err = ParseList(bytes.NewBufferString("jp\nkobe.jp\n"), "audit_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if entries := list.AuditLog(); entries != nil {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	var persisted bytes.Buffer
+	list.SetAuditLog(1, JSONAuditWriter(&persisted))
+
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\nkobe.jp\nosaka.jp\n"), Release: "audit_2"}
+	if err := list.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var snapshot bytes.Buffer
+	var source, _ = ParseList(bytes.NewBufferString("jp\n"), "audit_3")
+	source.Write(&snapshot)
+	if err := list.Read(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// only the last entry is retained
+	var entries = list.AuditLog()
+	if len(entries) != 1 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	var entry = entries[0]
+	if entry.Source != SourceSnapshot || entry.OldRelease != "audit_2" || entry.NewRelease != "audit_3" || entry.Added != 0 || entry.Removed != 2 || entry.Time.IsZero() {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+
+	// every entry is persisted
+	var decoder = json.NewDecoder(&persisted)
+	var sources []string
+	for decoder.More() {
+		var entry AuditEntry
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		sources = append(sources, entry.Source)
+		if entry.Source == SourceRetriever && (entry.OldRelease != "audit_1" || entry.Added != 1 || entry.Removed != 0 || entry.Retriever == "") {
+			t.Fatalf("unexpected entry: %+v", entry)
+		}
+	}
+
+	if len(sources) != 2 || sources[0] != SourceRetriever || sources[1] != SourceSnapshot {
+		t.Fatalf("got: %v, want: %v", sources, []string{SourceRetriever, SourceSnapshot})
+	}
+
+	list.SetAuditLog(0, nil)
+	if entries := list.AuditLog(); entries != nil {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
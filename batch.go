@@ -0,0 +1,109 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// batchKinds are the kinds of errors counted separately by BatchError, other
+// errors are counted under themselves.
+var batchKinds = []error{ErrDomainTooLong, ErrLabelTooLong, ErrLimitExceeded, ErrNoRegisteredDomain, ErrListTooSmall}
+
+// BatchResult is the outcome of EffectiveTLDPlusOne for a domain of a batch.
+type BatchResult struct {
+	// Domain is the domain given to the batch.
+	Domain string
+	// EffectiveTLDPlusOne is the eTLD+1 of Domain, empty on error.
+	EffectiveTLDPlusOne string
+	// Err is the error returned for Domain, if any.
+	Err error
+}
+
+// BatchError summarises the errors of a batch. It matches with errors.Is the
+// kinds of errors it counts.
+type BatchError struct {
+	// Total is the number of domains of the batch.
+	Total int
+	// Failed is the number of domains which failed.
+	Failed int
+	// Counts is the number of failures by kind of error, such as
+	// ErrNoRegisteredDomain or ErrLabelTooLong.
+	Counts map[error]int
+}
+
+func (e *BatchError) Error() string {
+	var counts = make([]string, 0, len(e.Counts))
+	for kind, n := range e.Counts {
+		counts = append(counts, fmt.Sprintf("%d %s", n, kind.Error()))
+	}
+	sort.Strings(counts)
+
+	return fmt.Sprintf("publicsuffix: %d of %d domains failed: %s", e.Failed, e.Total, strings.Join(counts, ", "))
+}
+
+// Is reports whether target is a kind of error counted by e.
+func (e *BatchError) Is(target error) bool {
+	return e.Counts[target] > 0
+}
+
+// add counts err.
+func (e *BatchError) add(err error) {
+	e.Failed++
+
+	for _, kind := range batchKinds {
+		if errors.Is(err, kind) {
+			e.Counts[kind]++
+			return
+		}
+	}
+
+	e.Counts[err]++
+}
+
+// EffectiveTLDPlusOneBatch returns the eTLD+1 of each of domains, in the same
+// order, as EffectiveTLDPlusOne would. The errors of the individual domains are
+// reported in their result, and summarised by a *BatchError returned if any
+// domain failed.
+func EffectiveTLDPlusOneBatch(domains []string) ([]BatchResult, error) {
+	return defaultList().EffectiveTLDPlusOneBatch(domains)
+}
+
+// EffectiveTLDPlusOneBatch returns the eTLD+1 of each of domains using l, see
+// the package level EffectiveTLDPlusOneBatch.
+func (l *List) EffectiveTLDPlusOneBatch(domains []string) ([]BatchResult, error) {
+	var results = make([]BatchResult, len(domains))
+	var summary = &BatchError{Total: len(domains), Counts: map[error]int{}}
+
+	for i, domain := range domains {
+		var etldPlusOne, err = l.EffectiveTLDPlusOne(domain)
+		results[i] = BatchResult{Domain: domain, EffectiveTLDPlusOne: etldPlusOne, Err: err}
+
+		if err != nil {
+			summary.add(err)
+		}
+	}
+
+	if summary.Failed == 0 {
+		return results, nil
+	}
+
+	return results, summary
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_EffectiveTLDPlusOneBatch(t *testing.T) {
+	installRulesTestList(t)
+
+	var domains = []string{"www.example.jp", "jp", "kobe.jp", strings.Repeat("a", 64) + ".jp", "www.city.kobe.jp"}
+
+	var results, err = EffectiveTLDPlusOneBatch(domains)
+	if len(results) != len(domains) {
+		t.Fatalf("got: %d, want: %d", len(results), len(domains))
+	}
+
+	for i, want := range []string{"example.jp", "", "", "", "city.kobe.jp"} {
+		if results[i].Domain != domains[i] || results[i].EffectiveTLDPlusOne != want {
+			t.Fatalf("got: %+v, want: %s", results[i], want)
+		}
+	}
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("got: %v, want: *BatchError", err)
+	}
+
+	if batchErr.Total != 5 || batchErr.Failed != 3 || batchErr.Counts[ErrNoRegisteredDomain] != 2 || batchErr.Counts[ErrLabelTooLong] != 1 {
+		t.Fatalf("unexpected summary: %+v", batchErr)
+	}
+
+	if !errors.Is(err, ErrLabelTooLong) || errors.Is(err, ErrDomainTooLong) {
+		t.Fatalf("unexpected match of %v", err)
+	}
+
+	if want := "publicsuffix: 3 of 5 domains failed: 1 label exceeds 63 octets, 2 no registered domain"; err.Error() != want {
+		t.Fatalf("got: %s, want: %s", err.Error(), want)
+	}
+
+	if _, err := EffectiveTLDPlusOneBatch(domains[:1]); err != nil {
+		t.Fatalf("got: %v, want: %v", err, nil)
+	}
+}
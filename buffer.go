@@ -0,0 +1,155 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// LookupBuffer is scratch memory owned by the caller of the lookups accepting
+// one, such as PublicSuffixWithBuffer, instead of memory taken from the pool
+// shared by the other lookups. Reusing a LookupBuffer makes the memory used by
+// lookups deterministic and attributable to the caller, for latency critical
+// services and allocation profiling. It grows to the number of labels of the
+// longest domain looked up.
+//
+// The zero value is ready to use. A LookupBuffer must not be used by
+// concurrent lookups, a goroutine or worker typically owns one.
+type LookupBuffer struct {
+	subdomains []subdomain
+}
+
+// bufferedEngine is implemented by engines needing scratch memory for their
+// lookups, allowing the caller to provide it.
+type bufferedEngine interface {
+	// lookupBuffer looks up domain using buf, which is nil if the engine
+	// should get its own scratch memory.
+	lookupBuffer(domain string, buf *LookupBuffer) match
+}
+
+var (
+	// lookupBufferPool pools the scratch memory of the lookups without
+	// LookupBuffer to avoid reallocation cost
+	lookupBufferPool = sync.Pool{
+		New: func() interface{} {
+			// 5 should cover the average domain
+			return &LookupBuffer{subdomains: make([]subdomain, 0, 5)}
+		},
+	}
+
+	// lookupPoolDisabled is set by SetLookupPool to allocate the scratch
+	// memory of each lookup instead
+	lookupPoolDisabled atomic.Bool
+)
+
+// SetLookupPool enables or disables the sync.Pool providing the scratch memory
+// of the lookups which aren't given a LookupBuffer. It is enabled by default.
+// Disabled, each lookup allocates its scratch memory, which the garbage
+// collector reclaims, making allocations visible to profiles at the expense of
+// throughput.
+func SetLookupPool(enabled bool) {
+	lookupPoolDisabled.Store(!enabled)
+}
+
+// getLookupBuffer returns scratch memory for a lookup, to be released with
+// putLookupBuffer.
+func getLookupBuffer() *LookupBuffer {
+	if lookupPoolDisabled.Load() {
+		return &LookupBuffer{}
+	}
+
+	return lookupBufferPool.Get().(*LookupBuffer)
+}
+
+// putLookupBuffer releases buf, returned by getLookupBuffer.
+func putLookupBuffer(buf *LookupBuffer) {
+	if lookupPoolDisabled.Load() {
+		return
+	}
+
+	lookupBufferPool.Put(buf)
+}
+
+// lookupBuffer looks up domain in the warm matches of ri, and then with its
+// engine using buf, see lookup.
+func (ri *rulesInfo) lookupBuffer(domain string, buf *LookupBuffer) match {
+	if ri.limits.check(domain) != nil {
+		return match{}
+	}
+
+	if m, found := ri.warm[domain]; found {
+		return m
+	}
+
+	if e, ok := ri.engine.(bufferedEngine); ok {
+		return onionMatch(domain, e.lookupBuffer(domain, buf))
+	}
+
+	return onionMatch(domain, ri.engine.lookup(domain))
+}
+
+// PublicSuffixWithBuffer returns the public suffix of domain like PublicSuffix,
+// using buf as scratch memory.
+func PublicSuffixWithBuffer(domain string, buf *LookupBuffer) (string, bool) {
+	return defaultList().PublicSuffixWithBuffer(domain, buf)
+}
+
+// PublicSuffixWithBuffer returns the public suffix of domain using l and buf,
+// see the package level PublicSuffixWithBuffer.
+func (l *List) PublicSuffixWithBuffer(domain string, buf *LookupBuffer) (string, bool) {
+	var ri = l.load()
+	if ri.tooSmall {
+		return "", false
+	}
+
+	var m = ri.lookupBuffer(domain, buf)
+	l.canary.Load().check(domain, m.suffix)
+	l.stats.Load().record(m)
+	l.ageWarning.Load().check(ri)
+
+	return m.suffix, m.icann
+}
+
+// EffectiveTLDPlusOneWithBuffer returns the effective top level domain plus
+// one more label of domain like EffectiveTLDPlusOne, using buf as scratch
+// memory.
+func EffectiveTLDPlusOneWithBuffer(domain string, buf *LookupBuffer) (string, error) {
+	return defaultList().EffectiveTLDPlusOneWithBuffer(domain, buf)
+}
+
+// EffectiveTLDPlusOneWithBuffer returns the effective top level domain plus
+// one more label of domain using l and buf, see the package level
+// EffectiveTLDPlusOneWithBuffer.
+func (l *List) EffectiveTLDPlusOneWithBuffer(domain string, buf *LookupBuffer) (string, error) {
+	if err := checkDomain(domain); err != nil {
+		return "", err
+	}
+
+	var ri = l.load()
+	if ri.tooSmall {
+		return "", ErrListTooSmall
+	}
+
+	if err := ri.limits.check(domain); err != nil {
+		return "", err
+	}
+
+	var suffix, _ = l.PublicSuffixWithBuffer(domain, buf)
+
+	return registeredDomain(domain, suffix)
+}
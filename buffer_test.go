@@ -0,0 +1,60 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"testing"
+)
+
+func Test_LookupBuffer(t *testing.T) {
+	var list = NewList()
+	var buf LookupBuffer
+
+	for _, domain := range []string{"www.example.co.uk", "www.example.com", "city.kobe.jp", "a.b.c.d.e.f.g.nosuchtld", "co.uk"} {
+		var suffix, icann = list.PublicSuffixWithBuffer(domain, &buf)
+		var wantSuffix, wantICANN = list.PublicSuffix(domain)
+		if suffix != wantSuffix || icann != wantICANN {
+			t.Fatalf("%s: got: %s %v, want: %s %v", domain, suffix, icann, wantSuffix, wantICANN)
+		}
+
+		var etldPlusOne, err = list.EffectiveTLDPlusOneWithBuffer(domain, &buf)
+		var want, wantErr = list.EffectiveTLDPlusOne(domain)
+		if etldPlusOne != want || (err == nil) != (wantErr == nil) {
+			t.Fatalf("%s: got: %s %v, want: %s %v", domain, etldPlusOne, err, want, wantErr)
+		}
+	}
+
+	// the scratch memory of the buffer is reused
+	var withBuffer = testing.AllocsPerRun(100, func() {
+		list.PublicSuffixWithBuffer("www.example.co.uk", &buf)
+	})
+
+	SetLookupPool(false)
+	defer SetLookupPool(true)
+
+	var withoutPool = testing.AllocsPerRun(100, func() {
+		list.PublicSuffix("www.example.co.uk")
+	})
+
+	if withBuffer >= withoutPool {
+		t.Fatalf("got: %v allocations, want less than: %v", withBuffer, withoutPool)
+	}
+
+	if suffix, _ := list.PublicSuffix("www.example.co.uk"); suffix != "co.uk" {
+		t.Fatalf("got: %s, want: %s", suffix, "co.uk")
+	}
+}
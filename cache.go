@@ -0,0 +1,93 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// cacheFile is the name of the file written by SaveToCache.
+const cacheFile = "public_suffix_list.bin"
+
+// DefaultCachePath returns the path of the file used by SaveToCache and
+// LoadFromCache, in the cache directory of the user returned by
+// os.UserCacheDir:
+//
+//	Linux, BSD: $XDG_CACHE_HOME/publicsuffix/public_suffix_list.bin (~/.cache by default)
+//	macOS:      ~/Library/Caches/com.globalsign.publicsuffix/public_suffix_list.bin
+//	Windows:    %LocalAppData%\publicsuffix\cache\public_suffix_list.bin
+func DefaultCachePath() (string, error) {
+	var dir, err = os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	switch runtime.GOOS {
+	case "darwin", "ios":
+		dir = filepath.Join(dir, "com.globalsign.publicsuffix")
+	case "windows":
+		dir = filepath.Join(dir, "publicsuffix", "cache")
+	default:
+		dir = filepath.Join(dir, "publicsuffix")
+	}
+
+	return filepath.Join(dir, cacheFile), nil
+}
+
+// SaveToCache writes the currently loaded public suffix list to
+// DefaultCachePath with WriteFile, creating its directory if needed.
+func SaveToCache() error {
+	return defaultList().SaveToCache()
+}
+
+// SaveToCache writes l to DefaultCachePath, see the package level
+// SaveToCache.
+func (l *List) SaveToCache() error {
+	var path, err = DefaultCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return l.WriteFile(path)
+}
+
+// LoadFromCache loads the public suffix list saved by SaveToCache with
+// ReadFile and uses it for future lookups. An error matching fs.ErrNotExist
+// is returned if nothing was saved. See Read for the supported options.
+func LoadFromCache(opts ...Option) error {
+	return defaultList().LoadFromCache(opts...)
+}
+
+// LoadFromCache loads the list saved by SaveToCache into l, see the package
+// level LoadFromCache.
+func (l *List) LoadFromCache(opts ...Option) error {
+	var path, err = DefaultCachePath()
+	if err != nil {
+		return err
+	}
+
+	return l.ReadFile(path, opts...)
+}
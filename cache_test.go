@@ -0,0 +1,66 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_Cache(t *testing.T) {
+	var dir = t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("LocalAppData", dir)
+
+	var path, err = DefaultCachePath()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !strings.HasPrefix(path, dir) || filepath.Base(path) != cacheFile {
+		t.Fatalf("unexpected cache path: %s", path)
+	}
+
+	var list = NewList()
+	if err := list.LoadFromCache(); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("got: %v, want: %v", err, fs.ErrNotExist)
+	}
+
+	var source = NewList()
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("ac\ncom.ac\n"), Release: "cache_test"}
+	if err := source.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := source.SaveToCache(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := list.LoadFromCache(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if list.Release() != "cache_test" {
+		t.Fatalf("got: %s, want: %s", list.Release(), "cache_test")
+	}
+}
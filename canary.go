@@ -0,0 +1,87 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"math/rand"
+	"net/http/cookiejar"
+)
+
+// Divergence is a lookup whose public suffix differs from the one of the
+// reference implementation, see SetCanary.
+type Divergence struct {
+	// Domain is the domain looked up.
+	Domain string
+	// Got is the public suffix returned by this package.
+	Got string
+	// Want is the public suffix returned by the reference.
+	Want string
+	// Release of the list used by this package.
+	Release string
+}
+
+// canary mirrors a fraction of the lookups to a reference implementation.
+type canary struct {
+	reference cookiejar.PublicSuffixList
+	rate      float64
+	report    func(Divergence)
+	release   func() string
+}
+
+// SetCanary mirrors a fraction rate, between 0 and 1, of the lookups of the
+// public suffix of a domain by the package level functions to reference and
+// calls report for the ones whose results differ. It allows validating updated
+// lists and this package against a frozen reference in production, typically
+// golang.org/x/net/publicsuffix.List, which isn't imported by this package to
+// keep it out of binaries not using it.
+//
+// report is called synchronously by the lookup. A nil reference disables the
+// canary. Domains rejected by this package, such as ones exceeding the RFC
+// 1035 lengths, aren't compared.
+func SetCanary(reference cookiejar.PublicSuffixList, rate float64, report func(Divergence)) {
+	defaultList().SetCanary(reference, rate, report)
+}
+
+// SetCanary mirrors a fraction of the lookups of l to reference, see the
+// package level SetCanary.
+func (l *List) SetCanary(reference cookiejar.PublicSuffixList, rate float64, report func(Divergence)) {
+	if reference == nil || report == nil || rate <= 0 {
+		l.canary.Store(nil)
+		return
+	}
+
+	l.canary.Store(&canary{reference: reference, rate: rate, report: report, release: l.Release})
+}
+
+// check compares suffix, the public suffix of domain, with the one of the
+// reference for a sample of the calls. It is a no-op on a nil canary.
+func (c *canary) check(domain, suffix string) {
+	if c == nil || suffix == "" {
+		return
+	}
+
+	if c.rate < 1 && rand.Float64() >= c.rate {
+		return
+	}
+
+	if want := c.reference.PublicSuffix(domain); want != suffix {
+		c.report(Divergence{Domain: domain, Got: suffix, Want: want, Release: c.release()})
+	}
+}
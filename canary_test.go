@@ -0,0 +1,72 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"testing"
+
+	psl "golang.org/x/net/publicsuffix"
+)
+
+// fixedList is a cookiejar.PublicSuffixList returning the same suffix.
+type fixedList string
+
+func (f fixedList) PublicSuffix(domain string) string { return string(f) }
+func (f fixedList) String() string                    { return "fixed" }
+
+func Test_Canary(t *testing.T) {
+	var list = NewList()
+
+	var divergences []Divergence
+	var report = func(d Divergence) { divergences = append(divergences, d) }
+
+	// the embedded list agrees with x/net for common domains
+	list.SetCanary(psl.List, 1, report)
+	for _, domain := range []string{"www.example.com", "foo.bar.co.uk", "foo.kawasaki.jp"} {
+		list.PublicSuffix(domain)
+	}
+	if len(divergences) != 0 {
+		t.Fatalf("unexpected divergences: %+v", divergences)
+	}
+
+	list.SetCanary(fixedList("invalid"), 1, report)
+	list.Lookup("www.example.com")
+	list.EffectiveTLDPlusOne("www.example.com")
+
+	var expected = Divergence{Domain: "www.example.com", Got: "com", Want: "invalid", Release: list.Release()}
+	if len(divergences) != 2 || divergences[0] != expected || divergences[1] != expected {
+		t.Fatalf("got: %+v, want: 2 x %+v", divergences, expected)
+	}
+
+	// sampled out
+	divergences = nil
+	list.SetCanary(fixedList("invalid"), 1e-12, report)
+	list.PublicSuffix("www.example.com")
+	if len(divergences) != 0 {
+		t.Fatalf("unexpected divergences: %+v", divergences)
+	}
+
+	// disabled
+	list.SetCanary(nil, 1, report)
+	list.PublicSuffix("www.example.com")
+	if len(divergences) != 0 {
+		t.Fatalf("unexpected divergences: %+v", divergences)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TLDCategory is the type of a TLD in the IANA root zone database.
+type TLDCategory int
+
+const (
+	// CategoryUnknown is the category of the TLDs without any rule in the
+	// list, which are likely not delegated.
+	CategoryUnknown TLDCategory = iota
+	// CategoryGeneric is a generic TLD, such as "com" or "xn--80asehdb".
+	CategoryGeneric
+	// CategoryGenericRestricted is a generic TLD with eligibility rules,
+	// such as "biz".
+	CategoryGenericRestricted
+	// CategorySponsored is a TLD sponsored by a community, such as "edu" or
+	// "museum".
+	CategorySponsored
+	// CategoryCountryCode is a country code TLD, such as "uk" or "xn--p1ai".
+	CategoryCountryCode
+	// CategoryInfrastructure is the TLD reserved for the infrastructure of
+	// the internet, "arpa".
+	CategoryInfrastructure
+)
+
+// String returns the name of the category in the IANA root zone database, such
+// as "generic" or "country-code", or "unknown".
+func (c TLDCategory) String() string {
+	switch c {
+	case CategoryUnknown:
+		return "unknown"
+	case CategoryGeneric:
+		return "generic"
+	case CategoryGenericRestricted:
+		return "generic-restricted"
+	case CategorySponsored:
+		return "sponsored"
+	case CategoryCountryCode:
+		return "country-code"
+	case CategoryInfrastructure:
+		return "infrastructure"
+	default:
+		return fmt.Sprintf("TLDCategory(%d)", int(c))
+	}
+}
+
+// tldCategories holds the category of the TLDs which are neither generic nor
+// country codes, from the IANA root zone database.
+var tldCategories = map[string]TLDCategory{
+	"arpa": CategoryInfrastructure,
+
+	"biz":  CategoryGenericRestricted,
+	"name": CategoryGenericRestricted,
+	"pro":  CategoryGenericRestricted,
+
+	"aero":   CategorySponsored,
+	"asia":   CategorySponsored,
+	"cat":    CategorySponsored,
+	"coop":   CategorySponsored,
+	"edu":    CategorySponsored,
+	"gov":    CategorySponsored,
+	"int":    CategorySponsored,
+	"jobs":   CategorySponsored,
+	"mil":    CategorySponsored,
+	"museum": CategorySponsored,
+	"post":   CategorySponsored,
+	"tel":    CategorySponsored,
+	"travel": CategorySponsored,
+	"xxx":    CategorySponsored,
+}
+
+// Category returns the category of the TLD of suffix, e.g. CategorySponsored
+// for "ac.edu" or CategoryCountryCode for "co.uk", so that policies can treat
+// them differently. Internationalized TLDs are accepted in Unicode or Punycode
+// form. CategoryUnknown is returned for the TLDs without any rule in the
+// currently loaded list.
+func Category(suffix string) TLDCategory {
+	return defaultList().Category(suffix)
+}
+
+// Category returns the category of the TLD of suffix using l, see the package
+// level Category.
+func (l *List) Category(suffix string) TLDCategory {
+	var tld = tldOf(suffix)
+
+	var listed bool
+	for _, rule := range l.load().Map[tld] {
+		var name = strings.TrimPrefix(strings.TrimPrefix(rule.DottedName, "!"), "*.")
+		listed = listed || name[strings.LastIndex(name, ".")+1:] == tld
+	}
+
+	switch category, found := tldCategories[tld]; {
+	case !listed:
+		return CategoryUnknown
+	case found:
+		return category
+	case isCCTLD(tld):
+		return CategoryCountryCode
+	default:
+		return CategoryGeneric
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "testing"
+
+func Test_Category(t *testing.T) {
+	var tests = []struct {
+		suffix string
+		want   TLDCategory
+	}{
+		{"com", CategoryGeneric},
+		{"xn--80asehdb", CategoryGeneric},
+		{"blogspot.com", CategoryGeneric},
+		{"biz", CategoryGenericRestricted},
+		{"ac.EDU", CategorySponsored},
+		{"co.uk", CategoryCountryCode},
+		{"eu", CategoryCountryCode},
+		{"рф", CategoryCountryCode},
+		{"ck", CategoryCountryCode},
+		{"in-addr.arpa", CategoryInfrastructure},
+		{"example", CategoryUnknown},
+		{"", CategoryUnknown},
+	}
+
+	var list = NewList()
+	for _, tt := range tests {
+		if unsupportedIDN(tt.suffix) {
+			continue
+		}
+
+		if got := list.Category(tt.suffix); got != tt.want {
+			t.Errorf("%q: got: %s, want: %s", tt.suffix, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Genlist compiles a raw public_suffix_list.dat file into one of the formats
+// understood by the publicsuffix package, without accessing the network.
+//
+// Usage:
+//
+//	genlist [-release name] [-format snapshot|json|dat] [-o output] public_suffix_list.dat
+//
+// The list is validated using the same parser as publicsuffix.Update and
+// statistics about its rules are printed to stderr. The snapshot format can be
+// loaded with publicsuffix.Read, the json format is the one produced by
+// publicsuffix.ExportJSON.
+//
+// The dat format is the list itself with its rules converted to ASCII, to be
+// served by internal mirrors: parsing it at runtime doesn't involve IDNA.
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+var (
+	release = flag.String("release", "", "release recorded in the output, defaults to the SHA-256 of the input")
+	format  = flag.String("format", "snapshot", "output format: snapshot, json or dat")
+	output  = flag.String("o", "", "output file, defaults to stdout")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: genlist [flags] public_suffix_list.dat\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0)); err != nil {
+		fmt.Fprintf(os.Stderr, "genlist: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(path string) error {
+	var content, err = ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var name = *release
+	if name == "" {
+		var sum = sha256.Sum256(content)
+		name = hex.EncodeToString(sum[:])
+	}
+
+	var list *publicsuffix.List
+	list, err = publicsuffix.ParseList(bytes.NewReader(content), name)
+	if err != nil {
+		return err
+	}
+
+	var compiled bytes.Buffer
+	switch *format {
+	case "snapshot":
+		if err := list.Write(&compiled); err != nil {
+			return err
+		}
+	case "json":
+		if err := list.ExportJSON(&compiled); err != nil {
+			return err
+		}
+	case "dat":
+		if err := list.WriteDAT(&compiled); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	printStats(os.Stderr, list)
+
+	if *output == "" {
+		_, err = os.Stdout.Write(compiled.Bytes())
+		return err
+	}
+
+	return ioutil.WriteFile(*output, compiled.Bytes(), 0644)
+}
+
+// printStats writes the number of rules of list per section and kind to w.
+func printStats(w io.Writer, list *publicsuffix.List) {
+	var rules int
+	var sections = map[publicsuffix.Section]int{}
+	var kinds = map[publicsuffix.RuleKind]int{}
+	var count = func(rule publicsuffix.Rule) bool {
+		rules++
+		sections[rule.Section]++
+		kinds[rule.Kind]++
+		return true
+	}
+
+	list.ICANNRules(count)
+	list.PrivateRules(count)
+
+	fmt.Fprintf(w, "release:   %s\n", list.Release())
+	fmt.Fprintf(w, "rules:     %d\n", rules)
+	fmt.Fprintf(w, "icann:     %d\n", sections[publicsuffix.ICANNSection])
+	fmt.Fprintf(w, "private:   %d\n", sections[publicsuffix.PrivateSection])
+	fmt.Fprintf(w, "normal:    %d\n", kinds[publicsuffix.NormalRule])
+	fmt.Fprintf(w, "wildcard:  %d\n", kinds[publicsuffix.WildcardRule])
+	fmt.Fprintf(w, "exception: %d\n", kinds[publicsuffix.ExceptionRule])
+}
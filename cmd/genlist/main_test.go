@@ -0,0 +1,108 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+// fixture is a small list with rules of every section and kind.
+const fixture = "testdata/public_suffix_list.dat"
+
+func Test_PrintStats(t *testing.T) {
+	var file, err = os.Open(fixture)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer file.Close()
+
+	list, err := publicsuffix.ParseList(file, "genlist_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var stats bytes.Buffer
+	printStats(&stats, list)
+
+	var expected = "release:   genlist_test\n" +
+		"rules:     8\n" +
+		"icann:     6\n" +
+		"private:   2\n" +
+		"normal:    5\n" +
+		"wildcard:  2\n" +
+		"exception: 1\n"
+	if stats.String() != expected {
+		t.Fatalf("got: %q, want: %q", stats.String(), expected)
+	}
+}
+
+func Test_Run(t *testing.T) {
+	t.Cleanup(func() { *release, *format, *output = "", "snapshot", "" })
+
+	*release = "genlist_test"
+	*output = filepath.Join(t.TempDir(), "list")
+
+	for _, f := range []string{"snapshot", "json", "dat"} {
+		*format = f
+		if err := run(fixture); err != nil {
+			t.Fatalf("%s: unexpected error: %s", f, err.Error())
+		}
+
+		var compiled, err = os.ReadFile(*output)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", f, err.Error())
+		}
+
+		var list = publicsuffix.NewList()
+		switch f {
+		case "snapshot":
+			err = list.Read(bytes.NewReader(compiled))
+		case "json":
+			if !strings.Contains(string(compiled), `"release":"genlist_test"`) {
+				t.Fatalf("%s: got: %s, want: the release", f, compiled)
+			}
+			continue
+		case "dat":
+			// the rules are converted to ASCII
+			if strings.Contains(string(compiled), "\n台湾\n") || !strings.Contains(string(compiled), "\nxn--kprw13d\n") {
+				t.Fatalf("%s: got: %s, want: ASCII rules", f, compiled)
+			}
+			list, err = publicsuffix.ParseList(bytes.NewReader(compiled), "genlist_test")
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", f, err.Error())
+		}
+
+		if suffix, _ := list.PublicSuffix("www.example.compute.example.jp"); suffix != "example.compute.example.jp" {
+			t.Fatalf("%s: got: %s, want: %s", f, suffix, "example.compute.example.jp")
+		}
+	}
+
+	*format = "xml"
+	if err := run(fixture); err == nil {
+		t.Fatalf("got: %v, want: an error for an unknown format", err)
+	}
+}
@@ -0,0 +1,210 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Libpsl exports the lookups of the publicsuffix package with a C ABI, so that
+// services not written in Go can share one continuously updated list rather
+// than embedding their own copy. It is built as a shared library along with
+// its header, libpsl.h:
+//
+//	go build -buildmode=c-shared -o libpsl.so ./cmd/libpsl
+//
+// The library serves the list saved in the cache of the user by the last call
+// to psl_update of any process, see publicsuffix.DefaultCachePath, or the list
+// compiled in it if there is none. The cache is checked for a list saved by
+// another process at most every 10 seconds, on lookup:
+//
+//	char *public_suffix(char *domain, int *icann);
+//	char *etld_plus_one(char *domain);
+//	int psl_update(void);
+//	char *psl_release(void);
+//	char *psl_last_error(void);
+//	void psl_free(char *s);
+//
+// The strings returned are allocated with malloc and must be released with
+// psl_free. The functions are safe to call concurrently.
+package main
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+// reloadInterval is the minimum time between two checks of the cache for a
+// list saved by another process.
+const reloadInterval = 10 * time.Second
+
+var (
+	// nextCheck is when the cache is next checked for changes, in Unix
+	// nanoseconds
+	nextCheck atomic.Int64
+
+	// cache is the state of the list loaded from the cache
+	cache struct {
+		sync.Mutex
+		// modTime and size identify the cache file the list was loaded from or
+		// saved to
+		modTime time.Time
+		size    int64
+		// err is the error of the last update, save or load, see psl_last_error
+		err error
+	}
+)
+
+func init() {
+	reload(true)
+}
+
+// reload loads the list saved in the cache if the file changed since it was
+// last loaded or saved, unless it was checked less than reloadInterval ago and
+// force is false. Without a cache the compiled list is served, psl_last_error
+// reports the errors loading an existing one.
+func reload(force bool) {
+	var now = time.Now().UnixNano()
+	if !force && now < nextCheck.Load() {
+		return
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+
+	nextCheck.Store(now + int64(reloadInterval))
+
+	var path, err = publicsuffix.DefaultCachePath()
+	if err != nil {
+		return
+	}
+
+	var info os.FileInfo
+	if info, err = os.Stat(path); err != nil {
+		return
+	}
+	if info.ModTime().Equal(cache.modTime) && info.Size() == cache.size {
+		return
+	}
+
+	// a failed load is retried on the next check
+	if cache.err = publicsuffix.LoadFromCache(); cache.err == nil {
+		cache.modTime, cache.size = info.ModTime(), info.Size()
+	}
+}
+
+// public_suffix returns the public suffix of domain, and sets *icann to 1 if
+// it is managed by ICANN, 0 otherwise, unless icann is NULL.
+//
+//export public_suffix
+func public_suffix(domain *C.char, icann *C.int) *C.char {
+	reload(false)
+
+	var suffix, isICANN = publicsuffix.PublicSuffix(C.GoString(domain))
+
+	if icann != nil {
+		*icann = 0
+		if isICANN {
+			*icann = 1
+		}
+	}
+
+	return C.CString(suffix)
+}
+
+// etld_plus_one returns the effective top level domain plus one more label of
+// domain, or NULL if it has none.
+//
+//export etld_plus_one
+func etld_plus_one(domain *C.char) *C.char {
+	reload(false)
+
+	var etldPlusOne, err = publicsuffix.EffectiveTLDPlusOne(C.GoString(domain))
+	if err != nil {
+		return nil
+	}
+
+	return C.CString(etldPlusOne)
+}
+
+// psl_update updates the list from the official repository and saves it in the
+// cache shared by the processes using the library. It returns 0 on success, -1
+// if the update failed and -2 if the list couldn't be saved, see
+// psl_last_error.
+//
+//export psl_update
+func psl_update() C.int {
+	cache.Lock()
+	defer cache.Unlock()
+
+	if cache.err = publicsuffix.Update(); cache.err != nil {
+		return -1
+	}
+
+	if cache.err = publicsuffix.SaveToCache(); cache.err != nil {
+		return -2
+	}
+
+	// the list just saved doesn't need to be reloaded
+	if path, err := publicsuffix.DefaultCachePath(); err == nil {
+		if info, err := os.Stat(path); err == nil {
+			cache.modTime, cache.size = info.ModTime(), info.Size()
+		}
+	}
+
+	return 0
+}
+
+// psl_release returns the release of the list.
+//
+//export psl_release
+func psl_release() *C.char {
+	reload(false)
+
+	return C.CString(publicsuffix.Release())
+}
+
+// psl_last_error returns the error of the last attempt to update, save or load
+// the list, NULL if it succeeded.
+//
+//export psl_last_error
+func psl_last_error() *C.char {
+	cache.Lock()
+	var err = cache.err
+	cache.Unlock()
+
+	if err == nil {
+		return nil
+	}
+
+	return C.CString(err.Error())
+}
+
+// psl_free releases a string returned by the library.
+//
+//export psl_free
+func psl_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+// main is required by -buildmode=c-shared but never called.
+func main() {}
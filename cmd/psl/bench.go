@@ -0,0 +1,275 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func init() {
+	commands["bench"] = &command{
+		usage: "[-list file] [-backends names] [-shards n] [-remote url] [-remote-ttl duration] [corpus]",
+		short: "compare the lookup backends on a corpus of domains",
+		run:   runBench,
+	}
+}
+
+// benchConfig holds the flags of psl bench configuring the backends.
+type benchConfig struct {
+	shards    int
+	remote    string
+	remoteTTL time.Duration
+}
+
+// backend is a lookup backend compared by psl bench.
+type backend struct {
+	name string
+	// options returns the options of the list of the backend, nil for none
+	options func(config benchConfig) []publicsuffix.Option
+	// prepare configures l for the backend and returns the lookup to measure
+	prepare func(l *publicsuffix.List, corpus []string) func(domain string)
+}
+
+// lookup returns the lookup of the public suffix of a domain in l.
+func lookup(l *publicsuffix.List, corpus []string) func(string) {
+	return func(domain string) { l.PublicSuffix(domain) }
+}
+
+// backends are the lookup backends compared by psl bench, in order.
+var backends = []backend{
+	{"map", nil, lookup},
+	{"buffered", nil, func(l *publicsuffix.List, corpus []string) func(string) {
+		var buf publicsuffix.LookupBuffer
+		return func(domain string) { l.PublicSuffixWithBuffer(domain, &buf) }
+	}},
+	{"cached", nil, func(l *publicsuffix.List, corpus []string) func(string) {
+		l.Warm(corpus)
+		return lookup(l, corpus)
+	}},
+	{"compact", func(benchConfig) []publicsuffix.Option {
+		return []publicsuffix.Option{publicsuffix.CompactRules()}
+	}, lookup},
+	{"sharded", func(config benchConfig) []publicsuffix.Option {
+		return []publicsuffix.Option{publicsuffix.ShardedRules(config.shards)}
+	}, lookup},
+	{"remote", func(config benchConfig) []publicsuffix.Option {
+		var client = &http.Client{Timeout: 10 * time.Second}
+		return []publicsuffix.Option{publicsuffix.RemoteLookups(config.remote, client, config.remoteTTL)}
+	}, lookup},
+}
+
+// runBench measures the latency and the allocations of the lookups of the
+// domains of the corpus file given in args, or of the standard input, with
+// each backend:
+//
+//	map       the default engine, with pooled buffers
+//	buffered  the default engine, with a buffer reused by the caller
+//	cached    the corpus resolved ahead of time, see publicsuffix.Warm
+//	compact   the default engine, with the rules stored by CompactRules
+//	sharded   the engine of ShardedRules, with -shards shards
+//	remote    the engine of RemoteLookups, querying the LookupHandler at
+//	          -remote, or one serving the list in process if empty
+//
+// The remote backend falls back to the rules of the list when the service
+// fails, and only queries it once per domain and -remote-ttl.
+//
+// The corpus holds a domain per line, blank lines and lines starting with #
+// are ignored.
+func runBench(flags *flag.FlagSet, args []string) error {
+	var listPath = flags.String("list", "", "file written by publicsuffix.WriteFile to look up in, the embedded list if empty")
+	var names = flags.String("backends", "map,buffered,cached,compact,sharded", "comma separated backends to compare")
+	var config benchConfig
+	flags.IntVar(&config.shards, "shards", 16, "number of shards of the sharded backend")
+	flags.StringVar(&config.remote, "remote", "", "URL of the LookupHandler queried by the remote backend, one is served in process if empty")
+	flags.DurationVar(&config.remoteTTL, "remote-ttl", 0, "duration the remote backend caches the answers of the service for")
+	flags.Parse(args)
+
+	if flags.NArg() > 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var selected []backend
+	for _, name := range strings.Split(*names, ",") {
+		var b, err = findBackend(strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+		selected = append(selected, b)
+
+		if b.name == "remote" && config.remote == "" {
+			var stop func()
+			if config.remote, stop, err = serveLookups(*listPath); err != nil {
+				return err
+			}
+			defer stop()
+		}
+	}
+
+	var in = stdin
+	if flags.NArg() == 1 {
+		var file, err = os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	var corpus, err = readCorpus(in)
+	if err != nil {
+		return err
+	}
+	if len(corpus) == 0 {
+		return errors.New("the corpus holds no domain")
+	}
+
+	var w = tabwriter.NewWriter(stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "backend\tns/op\tB/op\tallocs/op\t\n")
+
+	for _, b := range selected {
+		// every backend gets its own list, so that warming doesn't benefit
+		// the others
+		var opts []publicsuffix.Option
+		if b.options != nil {
+			opts = b.options(config)
+		}
+
+		var l, err = newBenchList(*listPath, opts...)
+		if err != nil {
+			return err
+		}
+
+		var result = measure(b.prepare(l, corpus), corpus)
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t\n", b.name, result.ns, result.bytes, result.allocs)
+	}
+
+	return w.Flush()
+}
+
+// benchTime is the minimum duration the lookups of a backend are measured for.
+const benchTime = time.Second
+
+// benchResult is the average cost of a lookup.
+type benchResult struct {
+	ns, bytes, allocs uint64
+}
+
+// measure looks up the domains of the corpus in turn with lookup, doubling
+// their number until they take at least benchTime, and returns the average
+// cost of a lookup of the last run.
+func measure(lookup func(domain string), corpus []string) benchResult {
+	for n := 1; ; n *= 2 {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		var start = time.Now()
+		for i := 0; i < n; i++ {
+			lookup(corpus[i%len(corpus)])
+		}
+		var elapsed = time.Since(start)
+
+		runtime.ReadMemStats(&after)
+
+		if elapsed >= benchTime || n >= 1<<30 {
+			return benchResult{
+				ns:     uint64(elapsed.Nanoseconds()) / uint64(n),
+				bytes:  (after.TotalAlloc - before.TotalAlloc) / uint64(n),
+				allocs: (after.Mallocs - before.Mallocs) / uint64(n),
+			}
+		}
+	}
+}
+
+// newBenchList returns a list created with opts, loaded from the file at
+// listPath unless empty.
+func newBenchList(listPath string, opts ...publicsuffix.Option) (*publicsuffix.List, error) {
+	var l = publicsuffix.NewList(opts...)
+	if listPath != "" {
+		if err := l.ReadFile(listPath); err != nil {
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// serveLookups serves the lookups of the list loaded from listPath, see
+// newBenchList, with a LookupHandler on a loopback port. It returns the URL of
+// the handler and a function stopping it.
+func serveLookups(listPath string) (string, func(), error) {
+	var l, err = newBenchList(listPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var listener net.Listener
+	if listener, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		return "", nil, err
+	}
+
+	var server = &http.Server{Handler: publicsuffix.LookupHandler(l)}
+	go server.Serve(listener)
+
+	return "http://" + listener.Addr().String(), func() { server.Close() }, nil
+}
+
+// findBackend returns the backend named name.
+func findBackend(name string) (backend, error) {
+	var known []string
+	for _, b := range backends {
+		if b.name == name {
+			return b, nil
+		}
+		known = append(known, b.name)
+	}
+
+	return backend{}, fmt.Errorf("unknown backend %q, want one of %s", name, strings.Join(known, ", "))
+}
+
+// readCorpus returns the domains read from r, one per line.
+func readCorpus(r io.Reader) ([]string, error) {
+	var corpus []string
+
+	var scanner = bufio.NewScanner(r)
+	for scanner.Scan() {
+		var line = strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		corpus = append(corpus, line)
+	}
+
+	return corpus, scanner.Err()
+}
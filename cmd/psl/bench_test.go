@@ -0,0 +1,59 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_Bench(t *testing.T) {
+	if testing.Short() {
+		t.Skip("benchmarks the lookups")
+	}
+
+	var out, _, err = runCommand(t, strings.NewReader("# corpus\nwww.example.co.uk\n\nexample.com\n"), "bench", "-backends", "map")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var lines = strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "ns/op") || !strings.HasPrefix(strings.TrimSpace(lines[1]), "map") {
+		t.Fatalf("got: %q, want: a row for the map backend", out)
+	}
+
+	// the engines of the package are compared too, the remote one with a
+	// service started for the benchmark
+	out, _, err = runCommand(t, strings.NewReader("www.example.co.uk\n"), "bench", "-backends", "compact,sharded,remote", "-remote-ttl", "1m")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if lines = strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 4 || !strings.HasPrefix(strings.TrimSpace(lines[3]), "remote") {
+		t.Fatalf("got: %q, want: rows for the compact, sharded and remote backends", out)
+	}
+
+	if _, _, err = runCommand(t, strings.NewReader("example.com\n"), "bench", "-backends", "trie"); err == nil {
+		t.Fatalf("got: %v, want: an error for an unknown backend", err)
+	}
+
+	if _, _, err = runCommand(t, strings.NewReader("# empty\n"), "bench"); err == nil {
+		t.Fatalf("got: %v, want: an error for an empty corpus", err)
+	}
+}
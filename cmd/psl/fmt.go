@@ -0,0 +1,86 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func init() {
+	commands["fmt"] = &command{
+		usage: "[-w] [file ...]",
+		short: "canonicalize files in the format of the list",
+		run:   runFmt,
+	}
+}
+
+// runFmt formats the files given in args, or the standard input, with
+// publicsuffix.FormatList.
+func runFmt(flags *flag.FlagSet, args []string) error {
+	var write = flags.Bool("w", false, "write the result to the files instead of the standard output")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		if *write {
+			return errors.New("cannot use -w with the standard input")
+		}
+
+		return publicsuffix.FormatList(stdout, stdin)
+	}
+
+	for _, path := range flags.Args() {
+		var content, err = ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var formatted bytes.Buffer
+		if err := publicsuffix.FormatList(&formatted, bytes.NewReader(content)); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		if !*write {
+			if _, err := stdout.Write(formatted.Bytes()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if bytes.Equal(content, formatted.Bytes()) {
+			continue
+		}
+
+		var info os.FileInfo
+		info, err = os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(path, formatted.Bytes(), info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func Test_Fmt(t *testing.T) {
+	const input = "// overlay\nb.example\na.example\n\n\nc.example\n"
+	const expected = "// overlay\na.example\nb.example\n\nc.example\n"
+
+	var out, _, err = runCommand(t, strings.NewReader(input), "fmt")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if out != expected {
+		t.Fatalf("got: %q, want: %q", out, expected)
+	}
+
+	var path = writeFile(t, "overlay.dat", input)
+	if out, _, err = runCommand(t, nil, "fmt", "-w", path); err != nil || out != "" {
+		t.Fatalf("got: %q %v, want: no output", out, err)
+	}
+
+	var content, _ = os.ReadFile(path)
+	if string(content) != expected {
+		t.Fatalf("got: %q, want: %q", content, expected)
+	}
+
+	if _, _, err = runCommand(t, nil, "fmt", "-w"); err == nil {
+		t.Fatalf("got: %v, want: an error for -w with the standard input", err)
+	}
+
+	if _, _, err = runCommand(t, nil, "fmt", writeFile(t, "invalid.dat", "B.example\n")); err == nil {
+		t.Fatalf("got: %v, want: an error for an invalid rule", err)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Psl is a tool for the files in the format of the public suffix list, such
+// as internal overlays, and for the lists loaded by the publicsuffix package.
+//
+// Usage:
+//
+//	psl <command> [arguments]
+//
+// The commands are:
+//
+//	bench  compare the lookup backends on a corpus of domains
+//	fmt    canonicalize files in the format of the list
+//	merge  merge files in the format of the list
+//	serve  keep a list up to date and serve it over HTTP
+//
+// Run "psl <command> -h" for the arguments of a command.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// The standard streams of the commands, replaced by the tests.
+var (
+	stdin  io.Reader = os.Stdin
+	stdout io.Writer = os.Stdout
+	stderr io.Writer = os.Stderr
+)
+
+// command is a subcommand of psl.
+type command struct {
+	// usage is the synopsis of the arguments of the command
+	usage string
+	// short describes the command in the list of commands
+	short string
+	// run defines the flags of the command in flags, parses args with them
+	// and runs the command
+	run func(flags *flag.FlagSet, args []string) error
+}
+
+// commands are the subcommands of psl by name.
+var commands = map[string]*command{}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: psl <command> [arguments]\n\ncommands:\n")
+
+	var names = make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", name, commands[name].short)
+	}
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var name = os.Args[1]
+	var cmd, found = commands[name]
+	if !found {
+		usage()
+	}
+
+	var flags = flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: psl %s %s\n", name, cmd.usage)
+		flags.PrintDefaults()
+	}
+
+	if err := cmd.run(flags, os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "psl %s: %s\n", name, err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// runCommand runs the command name with args, its input read from in, and
+// returns its standard output and standard error.
+func runCommand(t *testing.T, in io.Reader, name string, args ...string) (string, string, error) {
+	t.Helper()
+
+	var out, errOut bytes.Buffer
+	stdin, stdout, stderr = in, &out, &errOut
+	t.Cleanup(func() {
+		stdin, stdout, stderr = os.Stdin, os.Stdout, os.Stderr
+	})
+
+	var err = commands[name].run(flag.NewFlagSet(name, flag.ContinueOnError), args)
+
+	return out.String(), errOut.String(), err
+}
+
+// writeFile writes content to the file name of a temporary directory and
+// returns its path.
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	var path = filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	return path
+}
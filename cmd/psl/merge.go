@@ -0,0 +1,86 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func init() {
+	commands["merge"] = &command{
+		usage: "[-o output] file ...",
+		short: "merge files in the format of the list",
+		run:   runMerge,
+	}
+}
+
+// runMerge merges the files given in args with publicsuffix.MergeLists. The
+// conflicts are reported to the standard error, and make it fail once the
+// merged list is written.
+func runMerge(flags *flag.FlagSet, args []string) error {
+	var output = flags.String("o", "", "output file, defaults to the standard output")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var inputs []io.Reader
+	for _, path := range flags.Args() {
+		var content, err = ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		inputs = append(inputs, bytes.NewReader(content))
+	}
+
+	var merged bytes.Buffer
+	var conflicts, err = publicsuffix.MergeLists(&merged, inputs...)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = stdout.Write(merged.Bytes())
+	} else {
+		err = ioutil.WriteFile(*output, merged.Bytes(), 0644)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, conflict := range conflicts {
+		fmt.Fprintf(stderr, "%s: line %d: rule %q conflicts with %q of %s line %d\n",
+			flags.Arg(conflict.Input), conflict.Line, conflict.Rule,
+			conflict.Kept, flags.Arg(conflict.KeptInput), conflict.KeptLine)
+	}
+
+	if len(conflicts) > 0 {
+		return errors.New("conflicting rules left out")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_Merge(t *testing.T) {
+	var list = writeFile(t, "list.dat", "// ===BEGIN ICANN DOMAINS===\nexample\n// ===END ICANN DOMAINS===\n")
+	var overlay = writeFile(t, "overlay.dat", "// internal\nb.example\na.example\n")
+
+	var out, errOut, err = runCommand(t, nil, "merge", list, overlay)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, rule := range []string{"\nexample\n", "\na.example\n", "\nb.example\n"} {
+		if !strings.Contains(out, rule) {
+			t.Fatalf("got: %q, want: %q", out, rule)
+		}
+	}
+	if errOut != "" {
+		t.Fatalf("got: %q, want: no conflict", errOut)
+	}
+
+	// the conflicting rules are left out and reported
+	var conflicting = writeFile(t, "conflicting.dat", "// ===BEGIN ICANN DOMAINS===\na.example\n// ===END ICANN DOMAINS===\n")
+	var output = filepath.Join(t.TempDir(), "merged.dat")
+
+	out, errOut, err = runCommand(t, nil, "merge", "-o", output, overlay, conflicting)
+	if err == nil || !strings.Contains(errOut, `rule "a.example" conflicts`) {
+		t.Fatalf("got: %v %q, want: a conflict", err, errOut)
+	}
+
+	var merged, _ = os.ReadFile(output)
+	if out != "" || !strings.Contains(string(merged), "\na.example\n") {
+		t.Fatalf("got: %q %q, want: the merged list in %s", out, merged, output)
+	}
+}
@@ -0,0 +1,153 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func init() {
+	commands["serve"] = &command{
+		usage: "[-addr address] [-webhook-secret secret] [-psl-* flags]",
+		short: "keep a list up to date and serve it over HTTP",
+		run:   runServe,
+	}
+}
+
+// serveMetrics is the JSON encoding of publicsuffix.ManagerMetrics served by
+// psl serve.
+type serveMetrics struct {
+	Updates     int64     `json:"updates"`
+	Failures    int64     `json:"failures"`
+	LastSuccess time.Time `json:"last_success"`
+	LastFailure time.Time `json:"last_failure"`
+	LastError   string    `json:"last_error,omitempty"`
+	Release     string    `json:"release"`
+	Rules       int       `json:"rules"`
+}
+
+// runServe runs a publicsuffix.Manager configured by the PUBLICSUFFIX_*
+// environment variables and the -psl-* flags, and serves until interrupted:
+//
+//	/list      the list, see publicsuffix.ListHandler
+//	/lookup    lookups, see publicsuffix.LookupHandler
+//	/healthz   the health of the list, see publicsuffix.Manager.HealthHandler
+//	/metrics   the metrics of the manager as JSON
+//	/webhook   updates on push, see publicsuffix.Manager.WebhookHandler, if a secret is set
+//
+// The list is persisted to the cache file, if any, on shutdown.
+func runServe(flags *flag.FlagSet, args []string) error {
+	var config, err = publicsuffix.ConfigFromEnv()
+	if err != nil {
+		return err
+	}
+
+	var addr = flags.String("addr", ":8080", "address to listen on")
+	var webhookSecret = flags.String("webhook-secret", "", "secret of the GitHub webhook triggering updates, disabled if empty")
+	config.RegisterFlags(flags)
+	flags.Parse(args)
+
+	var opts []publicsuffix.ManagerOption
+	opts, err = config.ManagerOptions()
+	if err != nil {
+		return err
+	}
+
+	var ctx, stop = signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var manager *publicsuffix.Manager
+	manager, err = publicsuffix.NewManager(context.Background(), opts...)
+	if err != nil {
+		return err
+	}
+
+	var server = &http.Server{Addr: *addr, Handler: serveMux(manager, *webhookSecret), ReadHeaderTimeout: 10 * time.Second}
+
+	var served = make(chan error, 1)
+	go func() {
+		served <- server.ListenAndServe()
+	}()
+
+	log.Printf("psl serve: listening on %s", *addr)
+
+	select {
+	case err = <-served:
+	case <-ctx.Done():
+		log.Printf("psl serve: shutting down")
+	}
+
+	var shutdownCtx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if shutdownErr := server.Shutdown(shutdownCtx); err == nil {
+		err = shutdownErr
+	}
+
+	if closeErr := manager.Close(shutdownCtx); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// serveMux returns the handler of psl serve, see runServe.
+func serveMux(manager *publicsuffix.Manager, webhookSecret string) *http.ServeMux {
+	var list = manager.List()
+
+	var mux = http.NewServeMux()
+	mux.Handle("/list", publicsuffix.ListHandler(list))
+	mux.Handle("/lookup", publicsuffix.LookupHandler(list))
+	mux.Handle("/healthz", manager.HealthHandler())
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		var metrics = manager.Metrics()
+
+		var encoded = serveMetrics{
+			Updates:     metrics.Updates,
+			Failures:    metrics.Failures,
+			LastSuccess: metrics.LastSuccess,
+			LastFailure: metrics.LastFailure,
+			Release:     metrics.Release,
+			Rules:       metrics.Rules,
+		}
+		if metrics.LastError != nil {
+			encoded.LastError = metrics.LastError.Error()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(encoded)
+	})
+
+	if webhookSecret != "" {
+		mux.Handle("/webhook", manager.WebhookHandler(webhookSecret))
+	}
+
+	return mux
+}
@@ -0,0 +1,84 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func Test_ServeMux(t *testing.T) {
+	var upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+	}))
+	defer upstream.Close()
+
+	var manager, err = publicsuffix.NewManager(context.Background(),
+		publicsuffix.WithRetrievers(publicsuffix.NewGitHubListRetriever(upstream.Client(), publicsuffix.WithCommitURL(upstream.URL))),
+		publicsuffix.WithRefreshInterval(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer manager.Close(context.Background())
+
+	var deadline = time.Now().Add(5 * time.Second)
+	for manager.Metrics().Failures == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("the first update didn't run")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var get = func(mux http.Handler, method, path string) *httptest.ResponseRecorder {
+		var recorder = httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(method, path, strings.NewReader("{}")))
+		return recorder
+	}
+
+	var mux = serveMux(manager, "")
+	if recorder := get(mux, http.MethodGet, "/lookup?domain=www.example.co.uk"); recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"suffix":"co.uk"`) {
+		t.Fatalf("got: %d %s, want: the lookup of www.example.co.uk", recorder.Code, recorder.Body.String())
+	}
+
+	var recorder = get(mux, http.MethodGet, "/metrics")
+	var metrics serveMetrics
+	if err := json.NewDecoder(recorder.Body).Decode(&metrics); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if metrics.Failures != 1 || metrics.LastError == "" || metrics.Rules == 0 {
+		t.Fatalf("unexpected metrics: %+v", metrics)
+	}
+
+	// the webhook is only served with a secret
+	if recorder := get(mux, http.MethodPost, "/webhook"); recorder.Code != http.StatusNotFound {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusNotFound)
+	}
+	if recorder := get(serveMux(manager, "serve_test"), http.MethodPost, "/webhook"); recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusUnauthorized)
+	}
+}
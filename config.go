@@ -0,0 +1,238 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config is the declarative configuration of a Manager, which can be set from
+// environment variables with ConfigFromEnv and from command line flags with
+// RegisterFlags. The zero value of a field keeps the default behaviour.
+type Config struct {
+	// UpdateInterval is the interval between updates, see
+	// WithRefreshInterval. Environment variable PUBLICSUFFIX_UPDATE_INTERVAL,
+	// flag -psl-update-interval.
+	UpdateInterval time.Duration
+	// MaxAge is the age after which the list is unhealthy, see WithMaxAge.
+	// Environment variable PUBLICSUFFIX_MAX_AGE, flag -psl-max-age.
+	MaxAge time.Duration
+	// CachePath is the file the list is persisted to, see WithCachePath.
+	// Environment variable PUBLICSUFFIX_CACHE_PATH, flag -psl-cache-path.
+	CachePath string
+	// SourceURLs are the URLs the list is downloaded from, tried in order.
+	// Each must contain a single %s placeholder replaced by the release, see
+	// WithListURL. Environment variable PUBLICSUFFIX_SOURCE_URLS and flag
+	// -psl-source-urls hold them separated by commas.
+	SourceURLs []string
+	// CommitURL is the URL the latest release is retrieved from, see
+	// WithCommitURL. Environment variable PUBLICSUFFIX_COMMIT_URL, flag
+	// -psl-commit-url.
+	CommitURL string
+	// UserAgent is sent with the requests of the retrievers, see
+	// WithUserAgent. Environment variable PUBLICSUFFIX_USER_AGENT, flag
+	// -psl-user-agent.
+	UserAgent string
+	// ICANNOnly discards the rules of the private section, see ICANNOnly.
+	// Environment variable PUBLICSUFFIX_ICANN_ONLY, flag -psl-icann-only.
+	ICANNOnly bool
+	// MinRules refuses lists with fewer rules, see MinRules. Environment
+	// variable PUBLICSUFFIX_MIN_RULES, flag -psl-min-rules.
+	MinRules int
+	// FailClosed makes lookups fail rather than use a list refused by
+	// MinRules, see FailClosed. Environment variable PUBLICSUFFIX_FAIL_CLOSED,
+	// flag -psl-fail-closed.
+	FailClosed bool
+}
+
+// ConfigFromEnv returns the Config set by the PUBLICSUFFIX_* environment
+// variables documented by Config. Durations use the syntax of
+// time.ParseDuration and booleans the one of strconv.ParseBool.
+func ConfigFromEnv() (Config, error) {
+	var c Config
+	var errs []string
+
+	var lookup = func(name string, parse func(string) error) {
+		if value, found := os.LookupEnv("PUBLICSUFFIX_" + name); found {
+			if err := parse(value); err != nil {
+				errs = append(errs, fmt.Sprintf("PUBLICSUFFIX_%s: %s", name, err.Error()))
+			}
+		}
+	}
+
+	lookup("UPDATE_INTERVAL", func(value string) (err error) {
+		c.UpdateInterval, err = time.ParseDuration(value)
+		return err
+	})
+	lookup("MAX_AGE", func(value string) (err error) {
+		c.MaxAge, err = time.ParseDuration(value)
+		return err
+	})
+	lookup("CACHE_PATH", func(value string) error {
+		c.CachePath = value
+		return nil
+	})
+	lookup("SOURCE_URLS", listValue{&c.SourceURLs}.Set)
+	lookup("COMMIT_URL", func(value string) error {
+		c.CommitURL = value
+		return nil
+	})
+	lookup("USER_AGENT", func(value string) error {
+		c.UserAgent = value
+		return nil
+	})
+	lookup("ICANN_ONLY", func(value string) (err error) {
+		c.ICANNOnly, err = strconv.ParseBool(value)
+		return err
+	})
+	lookup("MIN_RULES", func(value string) (err error) {
+		c.MinRules, err = strconv.Atoi(value)
+		return err
+	})
+	lookup("FAIL_CLOSED", func(value string) (err error) {
+		c.FailClosed, err = strconv.ParseBool(value)
+		return err
+	})
+
+	if len(errs) > 0 {
+		return Config{}, fmt.Errorf("publicsuffix: invalid configuration: %s", strings.Join(errs, ", "))
+	}
+
+	return c, nil
+}
+
+// RegisterFlags defines the -psl-* flags documented by Config in flags,
+// setting the fields of c when parsed. Their defaults are the current values
+// of c, so flags can override a configuration read by ConfigFromEnv.
+func (c *Config) RegisterFlags(flags *flag.FlagSet) {
+	flags.DurationVar(&c.UpdateInterval, "psl-update-interval", c.UpdateInterval, "interval between the updates of the public suffix list")
+	flags.DurationVar(&c.MaxAge, "psl-max-age", c.MaxAge, "age after which the public suffix list is unhealthy")
+	flags.StringVar(&c.CachePath, "psl-cache-path", c.CachePath, "file the public suffix list is persisted to")
+	flags.Var(listValue{&c.SourceURLs}, "psl-source-urls", "comma separated URLs the public suffix list is downloaded from, with a %s placeholder for the release")
+	flags.StringVar(&c.CommitURL, "psl-commit-url", c.CommitURL, "URL the latest release of the public suffix list is retrieved from")
+	flags.StringVar(&c.UserAgent, "psl-user-agent", c.UserAgent, "User-Agent of the requests retrieving the public suffix list")
+	flags.BoolVar(&c.ICANNOnly, "psl-icann-only", c.ICANNOnly, "discard the private rules of the public suffix list")
+	flags.IntVar(&c.MinRules, "psl-min-rules", c.MinRules, "minimum number of rules of the public suffix list")
+	flags.BoolVar(&c.FailClosed, "psl-fail-closed", c.FailClosed, "fail lookups when the public suffix list has too few rules")
+}
+
+// Retrievers returns the chain of retrievers configured by c, one per source
+// URL, or the GitHub retriever if c has no source URL.
+func (c Config) Retrievers() ([]ListRetriever, error) {
+	var opts []RetrieverOption
+	if c.CommitURL != "" {
+		opts = append(opts, WithCommitURL(c.CommitURL))
+	}
+	if c.UserAgent != "" {
+		opts = append(opts, WithUserAgent(c.UserAgent))
+	}
+
+	if len(c.SourceURLs) == 0 {
+		return []ListRetriever{NewGitHubListRetriever(http.DefaultClient, opts...)}, nil
+	}
+
+	var listRetrievers []ListRetriever
+	for _, sourceURL := range c.SourceURLs {
+		if strings.Count(sourceURL, "%s") != 1 {
+			return nil, fmt.Errorf("publicsuffix: source URL %q must contain a single %%s placeholder", sourceURL)
+		}
+
+		// the URL of a release must be valid, whatever else it escapes
+		if u, err := url.Parse(strings.Replace(sourceURL, "%s", "release", 1)); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("publicsuffix: invalid source URL %q", sourceURL)
+		}
+
+		var sourceOpts = append(opts[:len(opts):len(opts)], WithListURL(sourceURL))
+		listRetrievers = append(listRetrievers, NewGitHubListRetriever(http.DefaultClient, sourceOpts...))
+	}
+
+	return listRetrievers, nil
+}
+
+// ManagerOptions returns the options configuring a Manager as set by c, to be
+// given to NewManager along with the options which can't be configured, such
+// as WithList.
+func (c Config) ManagerOptions() ([]ManagerOption, error) {
+	if c.UpdateInterval < 0 || c.MaxAge < 0 || c.MinRules < 0 {
+		return nil, errors.New("publicsuffix: negative durations and rule counts aren't allowed")
+	}
+
+	var listRetrievers, err = c.Retrievers()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts = []ManagerOption{WithRetrievers(listRetrievers...)}
+	if c.UpdateInterval > 0 {
+		opts = append(opts, WithRefreshInterval(c.UpdateInterval))
+	}
+	if c.MaxAge > 0 {
+		opts = append(opts, WithMaxAge(c.MaxAge))
+	}
+	if c.CachePath != "" {
+		opts = append(opts, WithCachePath(c.CachePath))
+	}
+
+	var listOpts []Option
+	if c.ICANNOnly {
+		listOpts = append(listOpts, ICANNOnly())
+	}
+	if c.MinRules > 0 {
+		listOpts = append(listOpts, MinRules(c.MinRules))
+	}
+	if c.FailClosed {
+		listOpts = append(listOpts, FailClosed())
+	}
+	if len(listOpts) > 0 {
+		opts = append(opts, WithListOptions(listOpts...))
+	}
+
+	return opts, nil
+}
+
+// listValue is a flag.Value setting a list of comma separated items.
+type listValue struct{ list *[]string }
+
+func (v listValue) String() string {
+	if v.list == nil {
+		return ""
+	}
+
+	return strings.Join(*v.list, ",")
+}
+
+func (v listValue) Set(s string) error {
+	*v.list = nil
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*v.list = append(*v.list, item)
+		}
+	}
+
+	return nil
+}
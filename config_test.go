@@ -0,0 +1,113 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_ConfigFromEnv(t *testing.T) {
+	t.Setenv("PUBLICSUFFIX_UPDATE_INTERVAL", "6h")
+	t.Setenv("PUBLICSUFFIX_CACHE_PATH", "/var/cache/psl.bin")
+	t.Setenv("PUBLICSUFFIX_SOURCE_URLS", "https://a.example/%s/list.dat, https://b.example/%s/list.dat")
+	t.Setenv("PUBLICSUFFIX_MIN_RULES", "5000")
+	t.Setenv("PUBLICSUFFIX_FAIL_CLOSED", "true")
+
+	var config, err = ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var want = Config{
+		UpdateInterval: 6 * time.Hour,
+		CachePath:      "/var/cache/psl.bin",
+		SourceURLs:     []string{"https://a.example/%s/list.dat", "https://b.example/%s/list.dat"},
+		MinRules:       5000,
+		FailClosed:     true,
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Fatalf("got: %+v, want: %+v", config, want)
+	}
+
+	// flags override the environment
+	var flags = flag.NewFlagSet("config_test", flag.ContinueOnError)
+	config.RegisterFlags(flags)
+	if err := flags.Parse([]string{"-psl-min-rules", "10", "-psl-source-urls", "https://c.example/%s"}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if config.MinRules != 10 || !reflect.DeepEqual(config.SourceURLs, []string{"https://c.example/%s"}) || config.UpdateInterval != 6*time.Hour {
+		t.Fatalf("unexpected config: %+v", config)
+	}
+
+	t.Setenv("PUBLICSUFFIX_MAX_AGE", "soon")
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Fatalf("got: %v, want: an error", err)
+	}
+}
+
+func Test_ConfigManagerOptions(t *testing.T) {
+	var config = Config{
+		UpdateInterval: time.Hour,
+		CachePath:      "/var/cache/psl.bin",
+		SourceURLs:     []string{"https://a.example/%s/list.dat", "https://b.example/%s/list.dat"},
+		MinRules:       5000,
+	}
+
+	var opts, err = config.ManagerOptions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var m Manager
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	if m.interval != time.Hour || m.cachePath != "/var/cache/psl.bin" || len(m.retrievers) != 2 || len(m.opts) != 1 {
+		t.Fatalf("unexpected manager: %v %s %d %d", m.interval, m.cachePath, len(m.retrievers), len(m.opts))
+	}
+
+	if url := m.retrievers[1].(urlRetriever).URL("abc"); url != "https://b.example/abc/list.dat" {
+		t.Fatalf("got: %s, want: %s", url, "https://b.example/abc/list.dat")
+	}
+
+	// escapes are kept, the placeholder is the only substitution
+	config.SourceURLs = []string{"https://a.example/psl%2Flist/%s/list.dat"}
+	if opts, err = config.ManagerOptions(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	if url := m.retrievers[0].(urlRetriever).URL("abc"); url != "https://a.example/psl%2Flist/abc/list.dat" {
+		t.Fatalf("got: %s, want: %s", url, "https://a.example/psl%2Flist/abc/list.dat")
+	}
+
+	for _, sourceURL := range []string{"https://a.example/list.dat", "https://a.example/%s/%s", "a.example/%s", "https://a.example/%zz/%s"} {
+		config.SourceURLs = []string{sourceURL}
+		if _, err := config.ManagerOptions(); err == nil {
+			t.Fatalf("%s: got: %v, want: an error", sourceURL, err)
+		}
+	}
+}
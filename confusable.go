@@ -0,0 +1,115 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables maps characters to the characters they are visually confused
+// with, following the skeletons of Unicode Technical Standard #39. It holds
+// the subset of its confusables.txt relevant to hostnames: lookalikes of the
+// Latin letters and digits from the scripts most used in homograph attacks.
+var confusables = map[rune]string{
+	// Latin and digits
+	'0': "o", '1': "l", 'm': "rn", 'ı': "i", 'ɑ': "a", 'ɡ': "g", 'ɩ': "i",
+	// Cyrillic
+	'а': "a", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y", 'х': "x",
+	'ѕ': "s", 'і': "i", 'ј': "j", 'ԁ': "d", 'һ': "h", 'ӏ': "l", 'ԛ': "q",
+	'ԝ': "w", 'ү': "y",
+	// Greek
+	'α': "a", 'ι': "i", 'κ': "k", 'ν': "v", 'ο': "o", 'ρ': "p", 'υ': "u",
+	// Armenian
+	'ո': "n", 'ս': "u", 'օ': "o",
+}
+
+// Skeleton returns the confusable skeleton of domain: two domains which look
+// alike have the same skeleton, e.g. "exаmple.com" with a Cyrillic а and
+// "example.com". Punycode encoded labels are decoded, the domain is
+// normalised to NFKC, lower cased, and lookalike characters are replaced as
+// in Unicode Technical Standard #39, from a subset of its data covering the
+// Latin, Cyrillic, Greek and Armenian lookalikes of Latin letters and digits.
+//
+// Skeletons are only meant to be compared with each other, they aren't valid
+// domains as such.
+func Skeleton(domain string) (string, error) {
+	var unicode, err = idna.ToUnicode(domain)
+	if err != nil {
+		return "", &DomainError{Domain: domain, Err: err}
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(norm.NFKC.String(unicode)) {
+		if replacement, found := confusables[r]; found {
+			b.WriteString(replacement)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// Confusable reports whether the hostnames a and b look alike up to their
+// registered domains, see EffectiveTLDPlusOne: whether the skeletons of their
+// registered domains, see Skeleton, are the same. "login.exаmple.com" with a
+// Cyrillic а is confusable with "www.example.com", and so is any domain with
+// itself. Hostnames are normalised with Normalize first.
+func Confusable(a, b string) (bool, error) {
+	return defaultList().Confusable(a, b)
+}
+
+// Confusable reports whether a and b look alike up to their registered
+// domains in l, see the package level Confusable.
+func (l *List) Confusable(a, b string) (bool, error) {
+	var skeletonA, err = l.siteSkeleton(a)
+	if err != nil {
+		return false, err
+	}
+
+	var skeletonB string
+	skeletonB, err = l.siteSkeleton(b)
+	if err != nil {
+		return false, err
+	}
+
+	return skeletonA == skeletonB, nil
+}
+
+// siteSkeleton returns the skeleton of the registered domain of domain. The
+// registered domain is found before replacing lookalikes, which could turn a
+// public suffix into a name which isn't one.
+func (l *List) siteSkeleton(domain string) (string, error) {
+	var normalized, err = Normalize(domain)
+	if err != nil {
+		return "", err
+	}
+
+	var site string
+	site, err = l.EffectiveTLDPlusOne(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	return Skeleton(site)
+}
@@ -0,0 +1,82 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "testing"
+
+func Test_Skeleton(t *testing.T) {
+	var tests = []struct {
+		domain   string
+		expected string
+	}{
+		{"example.com", "exarnple.corn"},
+		{"exаmple.com", "exarnple.corn"},
+		{"xn--exmple-4nf.com", "exarnple.corn"},
+		{"ＥＸＡＭＰＬＥ.com", "exarnple.corn"},
+		{"g00gle.com", "google.corn"},
+		{"аррӏе.com", "apple.corn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			var got, err = Skeleton(tt.domain)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if got != tt.expected {
+				t.Fatalf("got: %q, want: %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_Confusable(t *testing.T) {
+	var tests = []struct {
+		a, b     string
+		expected bool
+	}{
+		{"login.exаmple.com", "www.example.com", true},
+		{"xn--exmple-4nf.com", "example.com", true},
+		{"example.com", "example.com", true},
+		{"paypa1.com", "paypal.com", true},
+		{"rnicrosoft.com", "microsoft.com", true},
+		{"example.com", "example.net", false},
+		{"exаmple.com", "example.org", false},
+		// the suffix is found before replacing lookalikes
+		{"a.com.au", "b.com.au", false},
+		{"exаmple.com.au", "example.com.au", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			var got, err = Confusable(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if got != tt.expected {
+				t.Fatalf("got: %v, want: %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := Confusable("com", "example.com"); err == nil {
+		t.Fatalf("got: nil, want: error")
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "context"
+
+// listContextKey is the context key of the List stored by NewContext.
+type listContextKey struct{}
+
+// NewContext returns a copy of ctx carrying l, so request scoped code can
+// select the list used for lookups with FromContext without passing it to
+// every call site.
+func NewContext(ctx context.Context, l *List) context.Context {
+	return context.WithValue(ctx, listContextKey{}, l)
+}
+
+// FromContext returns the List carried by ctx, or the default list used by the
+// package level functions if ctx doesn't carry one.
+func FromContext(ctx context.Context) *List {
+	if l, ok := ctx.Value(listContextKey{}).(*List); ok && l != nil {
+		return l
+	}
+
+	return defaultList()
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func Test_Context(t *testing.T) {
+	if l := FromContext(context.Background()); l != defaultList() {
+		t.Fatalf("got: %p, want the default list %p", l, defaultList())
+	}
+
+	var tenantList = NewList()
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\nblogspot.jp\n"), Release: "context_test"}
+	if err := tenantList.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var ctx = NewContext(context.Background(), tenantList)
+	if l := FromContext(ctx); l != tenantList {
+		t.Fatalf("got: %p, want: %p", l, tenantList)
+	}
+
+	if suffix, _ := FromContext(ctx).PublicSuffix("foo.blogspot.jp"); suffix != "blogspot.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "blogspot.jp")
+	}
+
+	// The default list must not be affected by updates of another list.
+	if Release() == tenantList.Release() {
+		t.Fatalf("the default list should not have been updated")
+	}
+	if suffix, _ := PublicSuffix("foo.kobe.jp"); suffix != "foo.kobe.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "foo.kobe.jp")
+	}
+}
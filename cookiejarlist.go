@@ -1,3 +1,6 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
 /*
 Copyright 2018 GMO GlobalSign Ltd
 
@@ -28,6 +31,11 @@ type list struct{}
 var CookieJarList cookiejar.PublicSuffixList = list{}
 
 func (list) PublicSuffix(domain string) string {
+	// without a list, cookies are only accepted for the host setting them
+	if load().tooSmall {
+		return domain
+	}
+
 	var ps, _ = PublicSuffix(domain)
 	return ps
 }
@@ -36,3 +44,26 @@ func (list) String() string {
 	var rules = load()
 	return fmt.Sprintf("publicsuffix.org's public_suffix_list.dat, git revision: %s", rules.Release)
 }
+
+type icannList struct{}
+
+// ICANNCookieJarList implements the cookiejar.PublicSuffixList interface like
+// CookieJarList, but ignores the rules of the private section. Cookies may then
+// be set for domains such as blogspot.com, unlike in browsers, which is meant
+// for applications wanting cookies scoped by the registries only.
+var ICANNCookieJarList cookiejar.PublicSuffixList = icannList{}
+
+func (icannList) PublicSuffix(domain string) string {
+	var rules = load()
+	if rules.tooSmall {
+		return domain
+	}
+
+	var m = onionMatch(domain, rules.icannEngine().lookup(domain))
+	return m.suffix
+}
+
+func (icannList) String() string {
+	var rules = load()
+	return fmt.Sprintf("publicsuffix.org's public_suffix_list.dat (ICANN section), git revision: %s", rules.Release)
+}
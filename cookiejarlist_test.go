@@ -1,3 +1,6 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
 /*
 Copyright 2018 GMO GlobalSign Ltd
 
@@ -18,6 +21,8 @@ package publicsuffix
 
 import (
 	"fmt"
+	"net/http/cookiejar"
+	"strings"
 	"testing"
 )
 
@@ -36,3 +41,59 @@ func TestCookieJarList_String(t *testing.T) {
 		t.Fatalf("got: %s, want %s", release, expected)
 	}
 }
+
+func TestICANNCookieJarList_PublicSuffix(t *testing.T) {
+	installRulesTestList(t)
+
+	var tests = []struct {
+		domain string
+		want   string
+	}{
+		{"www.example.jp", "jp"},
+		{"foo.blogspot.jp", "jp"},
+		{"a.b.compute.example.jp", "jp"},
+		{"www.city.kobe.jp", "kobe.jp"},
+	}
+
+	for _, tt := range tests {
+		if got := ICANNCookieJarList.PublicSuffix(tt.domain); got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.domain, got, tt.want)
+		}
+	}
+
+	// the private rules still apply to CookieJarList
+	if got := CookieJarList.PublicSuffix("foo.blogspot.jp"); got != "blogspot.jp" {
+		t.Fatalf("got: %s, want: %s", got, "blogspot.jp")
+	}
+}
+
+func Test_CookieJarListSetDefault(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("// ===BEGIN ICANN DOMAINS===\njp\nkobe.jp\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nblogspot.jp\n// ===END PRIVATE DOMAINS===\n"), "cookiejarlist_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var previous = SetDefault(list)
+	defer SetDefault(previous)
+
+	// the adapters follow the default list
+	if got := CookieJarList.PublicSuffix("www.example.kobe.jp"); got != "kobe.jp" {
+		t.Fatalf("got: %s, want: %s", got, "kobe.jp")
+	}
+	if got := ICANNCookieJarList.PublicSuffix("www.blogspot.jp"); got != "jp" {
+		t.Fatalf("got: %s, want: %s", got, "jp")
+	}
+
+	// a list failing closed only allows host cookies
+	var empty = NewList(FailClosed())
+	if err := empty.UpdateWithListRetriever(mockListRetriever{RawList: strings.NewReader(""), Release: "empty"}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	SetDefault(empty)
+
+	for _, adapter := range []cookiejar.PublicSuffixList{CookieJarList, ICANNCookieJarList} {
+		if got := adapter.PublicSuffix("www.example.com"); got != "www.example.com" {
+			t.Fatalf("got: %s, want: %s", got, "www.example.com")
+		}
+	}
+}
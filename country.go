@@ -0,0 +1,157 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+)
+
+// ccTLDExceptions maps the ccTLDs which differ from the ISO 3166-1 alpha-2
+// code of their country, or which aren't a country, to the code or to ""
+// respectively.
+var ccTLDExceptions = map[string]string{
+	"uk": "GB",
+	// Ascension Island is part of Saint Helena, Ascension and Tristan da Cunha
+	"ac": "SH",
+	// the European Union, in Latin, Cyrillic and Greek
+	"eu":        "",
+	"xn--e1a4c": "",
+	"xn--qxa6a": "",
+	// the former Soviet Union
+	"su": "",
+}
+
+// idnCCTLDs maps the internationalized ccTLDs, in Punycode, to the ISO 3166-1
+// alpha-2 code of their country.
+var idnCCTLDs = map[string]string{
+	"xn--mgbaam7a8h":         "AE",
+	"xn--y9a3aq":             "AM",
+	"xn--54b7fta0cc":         "BD",
+	"xn--90ae":               "BG",
+	"xn--mgbcpq6gpa1a":       "BH",
+	"xn--90ais":              "BY",
+	"xn--fiqs8s":             "CN",
+	"xn--fiqz9s":             "CN",
+	"xn--lgbbat1ad8j":        "DZ",
+	"xn--wgbh1c":             "EG",
+	"xn--node":               "GE",
+	"xn--qxam":               "GR",
+	"xn--j6w193g":            "HK",
+	"xn--2scrj9c":            "IN",
+	"xn--3hcrj9c":            "IN",
+	"xn--45br5cyl":           "IN",
+	"xn--45brj9c":            "IN",
+	"xn--fpcrj9c3d":          "IN",
+	"xn--gecrj9c":            "IN",
+	"xn--h2breg3eve":         "IN",
+	"xn--h2brj9c":            "IN",
+	"xn--h2brj9c8c":          "IN",
+	"xn--mgbbh1a":            "IN",
+	"xn--mgbbh1a71e":         "IN",
+	"xn--mgbgu82a":           "IN",
+	"xn--rvc1e0am3e":         "IN",
+	"xn--s9brj9c":            "IN",
+	"xn--xkc2dl3a5ee0h":      "IN",
+	"xn--mgbtx2b":            "IQ",
+	"xn--mgba3a4f16a":        "IR",
+	"xn--mgba3a4fra":         "IR",
+	"xn--mgbayh7gpa":         "JO",
+	"xn--3e0b707e":           "KR",
+	"xn--80ao21a":            "KZ",
+	"xn--q7ce6a":             "LA",
+	"xn--fzc2c9e2c":          "LK",
+	"xn--xkc2al3hye2a":       "LK",
+	"xn--mgbc0a9azcg":        "MA",
+	"xn--d1alf":              "MK",
+	"xn--l1acc":              "MN",
+	"xn--mix891f":            "MO",
+	"xn--mgbah1a3hjkrd":      "MR",
+	"xn--mgbx4cd0ab":         "MY",
+	"xn--mgb9awbf":           "OM",
+	"xn--mgbai9a5eva00b":     "PK",
+	"xn--mgbai9azgqp6j":      "PK",
+	"xn--ygbi2ammx":          "PS",
+	"xn--wgbl6a":             "QA",
+	"xn--90a3ac":             "RS",
+	"xn--p1ai":               "RU",
+	"xn--mgberp4a5d4a87g":    "SA",
+	"xn--mgberp4a5d4ar":      "SA",
+	"xn--mgbqly7c0a67fbc":    "SA",
+	"xn--mgbqly7cvafr":       "SA",
+	"xn--mgbpl2fh":           "SD",
+	"xn--clchc0ea0b2g2a9gcd": "SG",
+	"xn--yfro4i67o":          "SG",
+	"xn--mgbtf8fl":           "SY",
+	"xn--ogbpf8fl":           "SY",
+	"xn--o3cw4h":             "TH",
+	"xn--pgbs0dh":            "TN",
+	"xn--kprw13d":            "TW",
+	"xn--kpry57d":            "TW",
+	"xn--j1amh":              "UA",
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of the country of suffix, a
+// public suffix under a country code TLD, e.g. "GB" for "co.uk" or "RU" for
+// "xn--p1ai" (".рф"). Internationalized TLDs are accepted in Unicode or
+// Punycode form.
+//
+// ok is false for the suffixes under generic TLDs, such as "com", and under
+// the ccTLDs which don't belong to a country, such as "eu". It doesn't depend
+// on the rules of the list, suffix isn't checked to be a public suffix.
+func Country(suffix string) (iso string, ok bool) {
+	var tld = tldOf(suffix)
+
+	if iso, ok = ccTLDExceptions[tld]; ok {
+		return iso, iso != ""
+	}
+
+	if iso, ok = idnCCTLDs[tld]; ok {
+		return iso, true
+	}
+
+	if !isASCIICCTLD(tld) {
+		return "", false
+	}
+
+	return strings.ToUpper(tld), true
+}
+
+// tldOf returns the last label of suffix, lower case and in Punycode.
+func tldOf(suffix string) string {
+	var tld = strings.ToLower(strings.TrimSuffix(suffix, "."))
+	tld = tld[strings.LastIndex(tld, ".")+1:]
+
+	if ascii, err := toASCII(tld); err == nil {
+		tld = ascii
+	}
+
+	return tld
+}
+
+// isASCIICCTLD reports whether tld has the form of an ASCII ccTLD, two
+// letters.
+func isASCIICCTLD(tld string) bool {
+	return len(tld) == 2 && tld[0] >= 'a' && tld[0] <= 'z' && tld[1] >= 'a' && tld[1] <= 'z'
+}
+
+// isCCTLD reports whether tld is a country code TLD, including the ones which
+// don't belong to a country.
+func isCCTLD(tld string) bool {
+	var _, exception = ccTLDExceptions[tld]
+
+	return exception || idnCCTLDs[tld] != "" || isASCIICCTLD(tld)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "testing"
+
+func Test_Country(t *testing.T) {
+	var tests = []struct {
+		suffix string
+		iso    string
+		ok     bool
+	}{
+		{"fr", "FR", true},
+		{"co.uk", "GB", true},
+		{"kobe.JP", "JP", true},
+		{"xn--p1ai", "RU", true},
+		{"рф", "RU", true},
+		{"com.xn--fiqs8s", "CN", true},
+		{"ac", "SH", true},
+		{"eu", "", false},
+		{"xn--qxa6a", "", false},
+		{"com", "", false},
+		{"xn--80asehdb", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if unsupportedIDN(tt.suffix) {
+			continue
+		}
+
+		if iso, ok := Country(tt.suffix); iso != tt.iso || ok != tt.ok {
+			t.Errorf("%q: got: %s %v, want: %s %v", tt.suffix, iso, ok, tt.iso, tt.ok)
+		}
+	}
+
+	// the internationalized ccTLDs are all in the list
+	for tld := range idnCCTLDs {
+		if len(embeddedRules.Map[tld]) == 0 {
+			t.Errorf("%s: not in the list", tld)
+		}
+	}
+}
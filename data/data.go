@@ -0,0 +1,50 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package data provides a recent public suffix list compiled into the binary.
+//
+// This module is released whenever the upstream list changes, while the code
+// of github.com/globalsign/publicsuffix, which embeds its own list, stays
+// stable. Importing it for its side effects replaces the list embedded in
+// publicsuffix, so a fresher compiled list is obtained with go get -u:
+//
+//	import _ "github.com/globalsign/publicsuffix/data"
+//
+// The list is regenerated by running go generate in this directory.
+package data
+
+import (
+	_ "embed"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+//go:generate go run ../gen.go -asset -o public_suffix_list.bin
+
+// snapshot is the list serialised by publicsuffix.Write.
+//
+//go:embed public_suffix_list.bin
+var snapshot []byte
+
+// Release is the release of the list provided by this package.
+var Release string
+
+func init() {
+	if err := publicsuffix.SetEmbedded(snapshot); err != nil {
+		panic("error while loading the Public Suffix List of the data package: " + err.Error())
+	}
+
+	Release = publicsuffix.NewList().Release()
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package data
+
+import (
+	"testing"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func Test_Init(t *testing.T) {
+	if Release == "" {
+		t.Fatalf("the release of the list is empty")
+	}
+
+	var provenance = publicsuffix.CurrentProvenance()
+	if provenance.Source != publicsuffix.SourceEmbedded || provenance.Release != Release {
+		t.Fatalf("unexpected provenance: %+v", provenance)
+	}
+
+	if suffix, _ := publicsuffix.PublicSuffix("www.example.co.uk"); suffix != "co.uk" {
+		t.Fatalf("got: %s, want: %s", suffix, "co.uk")
+	}
+}
@@ -0,0 +1,17 @@
+module github.com/globalsign/publicsuffix/data
+
+go 1.19
+
+// No release of publicsuffix provides SetEmbedded yet, the module is taken
+// from this repository by the replace directive below. The requirement must be
+// set to the first tagged release providing it before this module is tagged.
+require github.com/globalsign/publicsuffix v0.0.0-00010101000000-000000000000
+
+require (
+	golang.org/x/net v0.0.0-20211105192438-b53810dc28af // indirect
+	golang.org/x/text v0.3.6 // indirect
+)
+
+// The replace directive only applies when developing in this repository, it
+// is ignored by the modules requiring this one.
+replace github.com/globalsign/publicsuffix => ../
@@ -0,0 +1,48 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+// defaultList returns the list used by the package level functions.
+func defaultList() *List {
+	return defaults.Load()
+}
+
+// DefaultList returns the list currently used by the package level
+// functions, CookieJarList and ICANNCookieJarList. Its methods behave like the
+// package level functions, so wrappers such as metrics decorators or caching
+// layers can be composed around it, and a list derived from it can be
+// installed with SetDefault.
+func DefaultList() *List {
+	return defaultList()
+}
+
+// SetDefault atomically installs l as the list used by the package level
+// functions, CookieJarList and ICANNCookieJarList, and returns the list it
+// replaces. Applications building and validating a list elsewhere, e.g. from
+// a staging source or with overlays, can then use it process wide. A nil l
+// installs a new list holding the embedded rules.
+//
+// The settings of the previous list, such as those of SetStats, SetHistory or
+// SetCanary, aren't carried over. Lookups in progress may complete with the
+// previous list, and a Manager keeps updating the list it was created with.
+func SetDefault(l *List) *List {
+	if l == nil {
+		l = &List{}
+	}
+
+	return defaults.Swap(l)
+}
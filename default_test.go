@@ -0,0 +1,80 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_SetDefault(t *testing.T) {
+	var list, err = ParseList(bytes.NewBufferString("// ===BEGIN ICANN DOMAINS===\njp\nkobe.jp\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nblogspot.jp\n// ===END PRIVATE DOMAINS===\n"), "default_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var previous = SetDefault(list)
+	t.Cleanup(func() { SetDefault(previous) })
+
+	if previous == list || previous == nil {
+		t.Fatalf("got: %p, want the previous default list", previous)
+	}
+	if got := DefaultList(); got != list {
+		t.Fatalf("got: %p, want: %p", got, list)
+	}
+
+	if got := Release(); got != "default_test" {
+		t.Fatalf("got: %s, want: %s", got, "default_test")
+	}
+
+	// nil installs the embedded rules
+	if got := SetDefault(nil); got != list {
+		t.Fatalf("got: %p, want: %p", got, list)
+	}
+	if got := Release(); got != embeddedRules.Release {
+		t.Fatalf("got: %s, want: %s", got, embeddedRules.Release)
+	}
+}
+
+func Test_DefaultList(t *testing.T) {
+	preserveRules(t)
+
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\nkobe.jp\n"), Release: "default_list_test"}
+	if err := DefaultList().UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// updates of the handle are seen by the package level functions
+	if got := Release(); got != "default_list_test" {
+		t.Fatalf("got: %s, want: %s", got, "default_list_test")
+	}
+	if got, _ := DefaultList().PublicSuffix("www.example.kobe.jp"); got != "kobe.jp" {
+		t.Fatalf("got: %s, want: %s", got, "kobe.jp")
+	}
+}
+
+func Test_ZeroList(t *testing.T) {
+	var l List
+
+	if suffix, _ := l.PublicSuffix("example.co.uk"); suffix != "co.uk" {
+		t.Fatalf("got: %s, want: %s", suffix, "co.uk")
+	}
+
+	if provenance := l.Provenance(); provenance.Source != SourceEmbedded {
+		t.Fatalf("got: %s, want: %s", provenance.Source, SourceEmbedded)
+	}
+}
@@ -1,3 +1,6 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
 /*
 Copyright 2018 GMO GlobalSign Ltd
 
@@ -0,0 +1,172 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// WriteDOT writes the rules of the currently loaded public suffix list at or
+// below zone, e.g. "jp" or "kobe.jp", to w as a Graphviz graph in the DOT
+// language, for maintainers inspecting tricky zones such as "jp" or "ck" when
+// investigating lookup bugs. The graph is rendered by Graphviz, e.g. with
+// "dot -Tsvg".
+//
+// Each name is a node linked to the nodes of the names directly below it.
+// Normal rules are boxes, wildcard rules diamonds and exception rules red
+// octagons, also linked by a dashed edge from the wildcard rules they are an
+// exception to. Rules of the private section are blue, and the names which
+// aren't rules, such as zone itself when it isn't one, are dotted ellipses.
+// Rules whose Unicode form differs from their name show it as a second line.
+//
+// The output is meant for debugging, its layout may change between releases.
+func WriteDOT(w io.Writer, zone string) error {
+	return defaultList().WriteDOT(w, zone)
+}
+
+// WriteDOT writes the rules of l at or below zone to w as a Graphviz graph,
+// see the package level WriteDOT.
+func (l *List) WriteDOT(w io.Writer, zone string) error {
+	zone = strings.ToLower(strings.Trim(zone, "."))
+
+	var rules = l.load().selectRules([]Filter{func(r Rule) bool {
+		var name = strings.TrimPrefix(r.Name, "!")
+		return name == zone || strings.HasSuffix(name, "."+zone)
+	}})
+
+	// nodes are the names of the graph by node id, the rule name of rules and
+	// the name without "!" otherwise
+	var nodes = map[string]*Rule{zone: nil}
+	var edges = map[[2]string]bool{}
+	var wildcards []Rule
+
+	for i := range rules {
+		var r = &rules[i]
+		nodes[r.Name] = r
+		if r.Kind == WildcardRule {
+			wildcards = append(wildcards, *r)
+		}
+
+		// link the names from zone down to the rule
+		var child = r.Name
+		for name := strings.TrimPrefix(r.Name, "!"); name != zone; {
+			var parent = name[strings.IndexByte(name, '.')+1:]
+			if _, found := nodes[parent]; !found {
+				nodes[parent] = nil
+			}
+
+			edges[[2]string{parent, child}] = false
+			child, name = parent, parent
+		}
+	}
+
+	for _, r := range rules {
+		if r.Kind != ExceptionRule {
+			continue
+		}
+
+		var name = r.Name[1:]
+		for _, wildcard := range wildcards {
+			if strings.Count(wildcard.Name, ".") == strings.Count(name, ".") && matchPattern(name, wildcard.Name) {
+				edges[[2]string{wildcard.Name, r.Name}] = true
+			}
+		}
+	}
+
+	var ids = make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		switch {
+		case ruleLess(ids[i], ids[j]):
+			return true
+		case ruleLess(ids[j], ids[i]):
+			return false
+		}
+
+		return ids[i] < ids[j]
+	})
+
+	var links = make([][2]string, 0, len(edges))
+	for edge := range edges {
+		links = append(links, edge)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i][0] != links[j][0] {
+			return links[i][0] < links[j][0]
+		}
+
+		return links[i][1] < links[j][1]
+	})
+
+	var buffer = bufio.NewWriter(w)
+	fmt.Fprintf(buffer, "digraph %q {\n", zone)
+	buffer.WriteString("\trankdir=LR;\n")
+
+	for _, id := range ids {
+		fmt.Fprintf(buffer, "\t%q [%s];\n", id, dotAttributes(id, nodes[id]))
+	}
+
+	for _, link := range links {
+		if edges[link] {
+			fmt.Fprintf(buffer, "\t%q -> %q [style=dashed, color=red];\n", link[0], link[1])
+		} else {
+			fmt.Fprintf(buffer, "\t%q -> %q;\n", link[0], link[1])
+		}
+	}
+
+	buffer.WriteString("}\n")
+
+	return buffer.Flush()
+}
+
+// dotAttributes returns the DOT attributes of the node id, the name of r or a
+// name which isn't a rule if r is nil.
+func dotAttributes(id string, r *Rule) string {
+	if r == nil {
+		return "shape=ellipse, style=dotted"
+	}
+
+	var label = r.Name
+	if r.Unicode != r.Name {
+		label += "\n" + r.Unicode
+	}
+
+	var attributes = fmt.Sprintf("label=%q", label)
+	switch r.Kind {
+	case NormalRule:
+		attributes += ", shape=box"
+	case WildcardRule:
+		attributes += ", shape=diamond"
+	case ExceptionRule:
+		attributes += ", shape=octagon, color=red"
+	}
+
+	if r.Section == PrivateSection {
+		attributes += ", fontcolor=blue"
+		if r.Kind != ExceptionRule {
+			attributes += ", color=blue"
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_WriteDOT(t *testing.T) {
+	if unsupportedIDN("рф") {
+		t.Skip("internationalised domain names aren't supported")
+	}
+
+	var list, err = ParseList(strings.NewReader(`// ===BEGIN ICANN DOMAINS===
+jp
+kobe.jp
+*.kobe.jp
+!city.kobe.jp
+網路.tw
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+*.compute.example.jp
+// ===END PRIVATE DOMAINS===
+`), "dot_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var output bytes.Buffer
+	if err := list.WriteDOT(&output, "JP."); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = `digraph "jp" {
+	rankdir=LR;
+	"jp" [label="jp", shape=box];
+	"example.jp" [shape=ellipse, style=dotted];
+	"compute.example.jp" [shape=ellipse, style=dotted];
+	"*.compute.example.jp" [label="*.compute.example.jp", shape=diamond, fontcolor=blue, color=blue];
+	"kobe.jp" [label="kobe.jp", shape=box];
+	"*.kobe.jp" [label="*.kobe.jp", shape=diamond];
+	"!city.kobe.jp" [label="!city.kobe.jp", shape=octagon, color=red];
+	"*.kobe.jp" -> "!city.kobe.jp" [style=dashed, color=red];
+	"compute.example.jp" -> "*.compute.example.jp";
+	"example.jp" -> "compute.example.jp";
+	"jp" -> "example.jp";
+	"jp" -> "kobe.jp";
+	"kobe.jp" -> "!city.kobe.jp";
+	"kobe.jp" -> "*.kobe.jp";
+}
+`
+	if output.String() != expected {
+		t.Fatalf("got: %s, want: %s", output.String(), expected)
+	}
+
+	output.Reset()
+	if err := list.WriteDOT(&output, "tw"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !strings.Contains(output.String(), `"xn--zf0ao64a.tw" [label="xn--zf0ao64a.tw\n網路.tw", shape=box];`) {
+		t.Fatalf("got: %s, want the Unicode form in the label", output.String())
+	}
+}
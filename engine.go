@@ -0,0 +1,376 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"sync"
+)
+
+// match is the outcome of looking up a domain in the list.
+type match struct {
+	// suffix is the public suffix of the domain
+	suffix string
+	// icann is set if the matching rule belongs to the ICANN section
+	icann bool
+	// found is set if a rule matched, otherwise suffix is the last label as
+	// per the implicit "*" rule
+	found bool
+	// kind is the type of the matching rule, only meaningful if found is set
+	kind ruleType
+}
+
+// engine looks up domains in a set of rules. It allows alternative backends,
+// such as a trie or a remote service, to replace the map based lookup without
+// changing the functions built on top of it.
+//
+// Implementations must be safe for concurrent use and must not modify the
+// rules they were created from.
+type engine interface {
+	lookup(domain string) match
+}
+
+// Engine looks up domains in the rules of a list. Alternative backends, such
+// as a trie or a remote service, can replace the map based lookup of a list
+// with WithEngine, without changing the functions built on top of it.
+//
+// Lookup returns the public suffix of domain, whether it is managed by ICANN
+// and the kind of the matching rule, MatchDefault if the implicit "*" rule
+// applied. The other fields of the Result are ignored. Implementations must be
+// safe for concurrent use.
+type Engine interface {
+	Lookup(domain string) Result
+}
+
+// WithEngine makes a list perform its lookups with the Engine returned by
+// newEngine for the rules, sorted by name, of every list it loads. The map
+// engine is used by default.
+func WithEngine(newEngine func(rules []Rule) Engine) Option {
+	return withEngine(func(ri rulesInfo) engine {
+		return publicEngine{newEngine(ri.selectRules(nil))}
+	})
+}
+
+// withEngine sets the function creating the lookup engine of a list from its
+// rules. The map engine is used by default.
+func withEngine(newEngine func(rulesInfo) engine) Option {
+	return func(o *options) {
+		o.newEngine = newEngine
+	}
+}
+
+// publicEngine adapts an Engine given to WithEngine.
+type publicEngine struct {
+	Engine
+}
+
+// lookup implements engine.
+func (e publicEngine) lookup(domain string) match {
+	var result = e.Lookup(domain)
+	var m = match{suffix: result.PublicSuffix, icann: result.ICANN}
+	if result.Kind != MatchDefault {
+		m.found, m.kind = true, ruleType(result.Kind-MatchNormal)
+	}
+
+	return m
+}
+
+// lazyEngine creates an engine when first used.
+type lazyEngine struct {
+	once      sync.Once
+	newEngine func(rulesInfo) engine
+	engine    engine
+}
+
+// icannEngine returns an engine performing the lookups of ri as if it only
+// contained the rules of the ICANN section.
+func (ri *rulesInfo) icannEngine() engine {
+	if ri.ICANNOnly || ri.icann == nil {
+		return ri.engine
+	}
+
+	ri.icann.once.Do(func() {
+		ri.icann.engine = ri.icann.newEngine(ri.withoutPrivate())
+	})
+
+	return ri.icann.engine
+}
+
+// mapEngine looks up the rules keyed by their undotted name, from the longest
+// candidate to the shortest.
+type mapEngine struct {
+	rules map[string][]rule
+
+	// tlds holds the match of the TLDs with a normal rule and no wildcard,
+	// such as "com", keyed by the TLD
+	tlds map[string]match
+
+	// deeper holds the last two labels of every rule with more than one label,
+	// such as "blogspot.com"
+	deeper map[string]bool
+
+	// ruled holds the last label of every rule, domains under any other TLD
+	// can only match the implicit "*" rule
+	ruled map[string]bool
+
+	// patterns are the rules with wildcards the map can't match, see
+	// rule.patterned
+	patterns []rule
+}
+
+// newMapEngine returns a mapEngine for the rules of ri.
+func newMapEngine(ri rulesInfo) engine {
+	var e = mapEngine{
+		rules:  ri.Map,
+		tlds:   make(map[string]match),
+		deeper: make(map[string]bool),
+		ruled:  make(map[string]bool),
+	}
+
+	var wildcards = make(map[string]bool)
+	for _, rules := range ri.Map {
+		for _, rule := range rules {
+			if rule.patterned() {
+				e.patterns = append(e.patterns, rule)
+				continue
+			}
+
+			var dot = strings.LastIndex(rule.DottedName, ".")
+			e.ruled[rule.DottedName[dot+1:]] = true
+
+			if dot == -1 {
+				if rule.RuleType == normal {
+					e.tlds[rule.DottedName] = match{suffix: rule.DottedName, icann: rule.ICANN, found: true, kind: normal}
+				}
+				continue
+			}
+
+			var tld = rule.DottedName[dot+1:]
+			if rule.RuleType == wildcard && rule.DottedName == "*."+tld {
+				wildcards[tld] = true
+			}
+
+			var start = strings.LastIndex(rule.DottedName[:dot], ".")
+			e.deeper[rule.DottedName[start+1:]] = true
+		}
+	}
+
+	for tld := range wildcards {
+		delete(e.tlds, tld)
+	}
+
+	return e
+}
+
+// lookupTLD is the fast path of lookup for domains under the TLDs which
+// dominate real traffic, such as "www.example.com", and under the TLDs no
+// rule exists for, which dominate junk traffic. It answers with the TLD when
+// no other rule can match, otherwise ok is false.
+func (e mapEngine) lookupTLD(domain string) (m match, ok bool) {
+	var dot = strings.LastIndex(domain, ".")
+	var tld = domain[dot+1:]
+
+	m, ok = e.tlds[tld]
+	if !ok {
+		if e.ruled[tld] {
+			return match{}, false
+		}
+
+		return match{suffix: tld}, true
+	}
+
+	if dot == -1 {
+		return m, true
+	}
+
+	var start = strings.LastIndex(domain[:dot], ".")
+	if e.deeper[domain[start+1:]] {
+		return match{}, false
+	}
+
+	return m, true
+}
+
+// lookup implements engine.
+func (e mapEngine) lookup(domain string) match {
+	return e.lookupBuffer(domain, nil)
+}
+
+// lookupBuffer implements bufferedEngine.
+func (e mapEngine) lookupBuffer(domain string, buf *LookupBuffer) match {
+	// If the domain ends on a dot the subdomains can't be obtained - no PSL applicable
+	if strings.LastIndex(domain, ".") == len(domain)-1 {
+		return match{}
+	}
+
+	// Domains exceeding the RFC 1035 lengths can't be valid, don't waste work
+	if checkDomain(domain) != nil {
+		return match{}
+	}
+
+	// the fast path doesn't know about patterns, which may match any label
+	if len(e.patterns) > 0 {
+		return e.searchPatterns(domain, e.search(domain, buf))
+	}
+
+	if m, ok := e.lookupTLD(domain); ok {
+		return m
+	}
+
+	return e.search(domain, buf)
+}
+
+// searchPatterns returns the match of domain given m, its match by the other
+// rules, and the patterns. As for any rule, an exception rule prevails, then
+// the rule with the most labels.
+func (e mapEngine) searchPatterns(domain string, m match) match {
+	// labels of the rule which produced m, none for the implicit "*" rule
+	var labels int
+	if m.found {
+		labels = strings.Count(m.suffix, ".") + 1
+		if m.kind == exception {
+			labels++
+		}
+	}
+
+	for _, rule := range e.patterns {
+		var name = strings.TrimPrefix(rule.DottedName, "!")
+		if !matchPattern(domain, name) {
+			continue
+		}
+
+		var n = strings.Count(name, ".") + 1
+		if (m.found && m.kind == exception) == (rule.RuleType == exception) {
+			if n <= labels {
+				continue
+			}
+		} else if rule.RuleType != exception {
+			continue
+		}
+
+		labels, m = n, match{icann: rule.ICANN, found: true, kind: rule.RuleType}
+
+		// an exception rule makes its parent the public suffix
+		if rule.RuleType == exception {
+			n--
+		}
+
+		var dot = len(domain)
+		for i := 0; i < n; i++ {
+			dot = strings.LastIndex(domain[:dot], ".")
+		}
+		m.suffix = domain[dot+1:]
+	}
+
+	return m
+}
+
+// matchPattern reports whether domain matches pattern, whose "*" labels match
+// any label of domain.
+func matchPattern(domain, pattern string) bool {
+	for {
+		var pdot = strings.LastIndex(pattern, ".")
+		var ddot = strings.LastIndex(domain, ".")
+
+		var label = domain[ddot+1:]
+		if label == "" || (pattern[pdot+1:] != "*" && pattern[pdot+1:] != label) {
+			return false
+		}
+
+		switch {
+		case pdot == -1:
+			return true
+		case ddot == -1:
+			return false
+		}
+
+		pattern, domain = pattern[:pdot], domain[:ddot]
+	}
+}
+
+// search looks up domain by decomposing it in buf, or in pooled scratch memory
+// if nil, and walking all its candidates.
+func (e mapEngine) search(domain string, buf *LookupBuffer) match {
+	if buf == nil {
+		buf = getLookupBuffer()
+		defer putLookupBuffer(buf)
+	}
+
+	buf.subdomains = decomposeDomain(domain, buf.subdomains[:0])
+	var subdomains = buf.subdomains
+
+	// the longest matching rule (the one with the most levels) will be used
+	for _, sub := range subdomains {
+		var rules, found = e.rules[sub.name]
+		if !found {
+			continue
+		}
+
+		// Look for all the rules matching the concatenated name
+		for _, rule := range rules {
+			switch rule.RuleType {
+			case wildcard:
+				// first check if the rule is contained within the domain without the *.
+				if !strings.HasSuffix(sub.dottedName, rule.DottedName[2:]) {
+					continue
+				}
+
+				if len(domain) < len(rule.DottedName) {
+					// Handle corner case where the domain doesn't have a left side and a wildcard rule matches,
+					// i.e ".ck" with rule "*.ck" must return .ck as per golang implementation
+					if domain[0] == '.' && strings.Compare(domain, rule.DottedName[1:]) == 0 {
+						return match{suffix: domain, icann: rule.ICANN, found: true, kind: rule.RuleType}
+					}
+
+					continue
+				}
+
+				var nbLevels = strings.Count(rule.DottedName, ".") + 1
+				var dot = len(domain) - 1
+
+				for i := 0; i < nbLevels && dot != -1; i++ {
+					dot = strings.LastIndex(domain[:dot], ".")
+				}
+
+				return match{suffix: domain[dot+1:], icann: rule.ICANN, found: true, kind: rule.RuleType}
+
+			case exception:
+				// first check if the rule is contained within the domain without !
+				if !strings.HasSuffix(sub.dottedName, rule.DottedName[1:]) {
+					continue
+				}
+
+				var dot = strings.Index(rule.DottedName, ".")
+
+				return match{suffix: rule.DottedName[dot+1:], icann: rule.ICANN, found: true, kind: rule.RuleType}
+
+			default:
+				// first check if the rule is contained within the domain
+				if !strings.HasSuffix(sub.dottedName, rule.DottedName) {
+					continue
+				}
+
+				return match{suffix: rule.DottedName, icann: rule.ICANN, found: true, kind: rule.RuleType}
+			}
+		}
+	}
+
+	// If no rules match, the prevailing rule is "*".
+	var dot = strings.LastIndex(domain, ".")
+
+	return match{suffix: domain[dot+1:]}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+// fakeEngine answers every lookup with the same suffix.
+type fakeEngine struct {
+	suffix string
+}
+
+func (e fakeEngine) lookup(domain string) match {
+	return match{suffix: e.suffix, icann: true, found: true}
+}
+
+func Test_Engine(t *testing.T) {
+	var list = NewList(withEngine(func(rulesInfo) engine {
+		return fakeEngine{suffix: "example"}
+	}))
+
+	if suffix, icann := list.PublicSuffix("www.example.com"); suffix != "example" || !icann {
+		t.Fatalf("got: %s %v, want: %s %v", suffix, icann, "example", true)
+	}
+
+	// the engine is kept when a new list is loaded
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "engine_test"}
+	if err := list.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if suffix, _ := list.PublicSuffix("www.example.com"); suffix != "example" {
+		t.Fatalf("got: %s, want: %s", suffix, "example")
+	}
+}
+
+// rulesEngine answers every lookup with the number of its rules as suffix.
+type rulesEngine struct {
+	rules []Rule
+}
+
+func (e rulesEngine) Lookup(domain string) Result {
+	return Result{PublicSuffix: strconv.Itoa(len(e.rules)), Kind: MatchWildcard}
+}
+
+func Test_WithEngine(t *testing.T) {
+	var list, err = ParseList(bytes.NewBufferString(rulesTestList), "engine_test", WithEngine(func(rules []Rule) Engine {
+		return rulesEngine{rules: rules}
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var want = strconv.Itoa(list.load().size())
+	var result, _ = list.Lookup("www.example.com")
+	if result.PublicSuffix != want || result.Kind != MatchWildcard || result.ICANN {
+		t.Fatalf("got: %+v, want: %s %s", result, want, MatchWildcard)
+	}
+}
+
+func Test_MapEngine(t *testing.T) {
+	var rulesInfo, err = newList(bytes.NewBufferString(rulesTestList), "engine_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var tests = []struct {
+		domain string
+		want   match
+	}{
+		{"kobe.jp", match{suffix: "kobe.jp", icann: true, found: true, kind: normal}},
+		{"www.foo.compute.example.jp", match{suffix: "foo.compute.example.jp", found: true, kind: wildcard}},
+		{"city.kobe.jp", match{suffix: "kobe.jp", icann: true, found: true, kind: exception}},
+		{"example.invalid", match{suffix: "invalid"}},
+		{"example.com.", match{}},
+	}
+
+	var e = newMapEngine(*rulesInfo)
+	for _, tt := range tests {
+		if got := e.lookup(tt.domain); got != tt.want {
+			t.Errorf("%s: got: %+v, want: %+v", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func Test_MapEnginePatterns(t *testing.T) {
+	var list = "jp\n*.*.cloud.jp\nedge.*.cdn.jp\n!www.*.cdn.jp\n*.static.cdn.jp\n*.*\n"
+	var rulesInfo, err = newList(bytes.NewBufferString(list), "engine_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var tests = []struct {
+		domain string
+		want   match
+	}{
+		{"a.b.c.cloud.jp", match{suffix: "b.c.cloud.jp", found: true, kind: wildcard}},
+		{"b.cloud.jp", match{suffix: "cloud.jp", found: true, kind: wildcard}},
+		{"www.edge.eu.cdn.jp", match{suffix: "edge.eu.cdn.jp", found: true, kind: normal}},
+		{"www.eu.cdn.jp", match{suffix: "eu.cdn.jp", found: true, kind: exception}},
+		{"www.static.cdn.jp", match{suffix: "static.cdn.jp", found: true, kind: exception}},
+		{"foo.static.cdn.jp", match{suffix: "foo.static.cdn.jp", found: true, kind: wildcard}},
+		{"example.jp", match{suffix: "example.jp", found: true, kind: wildcard}},
+		{"jp", match{suffix: "jp", found: true, kind: normal}},
+		{"www.example.com", match{suffix: "example.com", found: true, kind: wildcard}},
+	}
+
+	var e = newMapEngine(*rulesInfo)
+	for _, tt := range tests {
+		if got := e.lookup(tt.domain); got != tt.want {
+			t.Errorf("%s: got: %+v, want: %+v", tt.domain, got, tt.want)
+		}
+	}
+
+	for _, line := range []string{"*", "a*.example", "a.!b.example"} {
+		if _, _, err := parseRule(line, true); err == nil {
+			t.Fatalf("%s: got: %v, want: an error", line, err)
+		}
+	}
+}
+
+func Test_MapEngineTLD(t *testing.T) {
+	var rulesInfo, err = newList(bytes.NewBufferString(rulesTestList), "engine_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var e = newMapEngine(*rulesInfo).(mapEngine)
+
+	var tests = []struct {
+		domain string
+		ok     bool
+	}{
+		{"jp", true},
+		{"www.example.jp", false},
+		{"www.foo.jp", true},
+		{"www.kobe.jp", false},
+		{"www.blogspot.jp", false},
+		{"compute.example.jp", false},
+		{"example.invalid", true},
+		{"a.b.c.example", true},
+		{"example", true},
+	}
+
+	for _, tt := range tests {
+		var m, ok = e.lookupTLD(tt.domain)
+		if ok != tt.ok {
+			t.Fatalf("%s: got: %v, want: %v", tt.domain, ok, tt.ok)
+		}
+
+		// the fast path must agree with the full lookup
+		if ok && m != e.search(tt.domain, nil) {
+			t.Fatalf("%s: got: %+v, want: %+v", tt.domain, m, e.search(tt.domain, nil))
+		}
+	}
+}
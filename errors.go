@@ -0,0 +1,199 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNetwork is matched by errors.Is for errors caused by a failure to
+	// communicate with a remote data source. These are usually retryable.
+	ErrNetwork = errors.New("publicsuffix: network error")
+
+	// ErrInvalidData is matched by errors.Is for errors caused by invalid list
+	// or snapshot data. Retrying with the same data won't succeed.
+	ErrInvalidData = errors.New("publicsuffix: invalid data")
+)
+
+var (
+	// ErrTemporary is matched by errors.Is for errors which may not occur
+	// again if the operation is retried later, such as network errors, server
+	// errors and exhausted rate limits.
+	ErrTemporary = errors.New("publicsuffix: temporary error")
+
+	// ErrPermanent is matched by errors.Is for errors which will occur again
+	// if the operation is retried, such as invalid data or a missing release.
+	ErrPermanent = errors.New("publicsuffix: permanent error")
+)
+
+var (
+	// ErrDomainTooLong is matched by errors.Is for domains longer than the 253
+	// octets allowed by RFC 1035.
+	ErrDomainTooLong = errors.New("domain exceeds 253 octets")
+
+	// ErrLabelTooLong is matched by errors.Is for domains containing a label
+	// longer than the 63 octets allowed by RFC 1035.
+	ErrLabelTooLong = errors.New("label exceeds 63 octets")
+
+	// ErrNotPublicSuffix is matched by errors.Is for domains which aren't a
+	// public suffix when one is expected.
+	ErrNotPublicSuffix = errors.New("not a public suffix")
+
+	// ErrNoRegisteredDomain is matched by errors.Is for domains which don't
+	// have an eTLD+1, such as public suffixes themselves.
+	ErrNoRegisteredDomain = errors.New("no registered domain")
+
+	// ErrLimitExceeded is matched by errors.Is for domains exceeding the
+	// limits set by WithLimits.
+	ErrLimitExceeded = errors.New("domain exceeds the lookup limits")
+)
+
+// ErrListTooSmall is matched by errors.Is when a list is refused because of
+// MinRules, and returned by the lookups of a list configured with FailClosed
+// while its rules are missing.
+var ErrListTooSmall = errors.New("publicsuffix: list has too few rules")
+
+// DomainError is returned when a domain is rejected before being looked up.
+type DomainError struct {
+	// Domain is the rejected domain.
+	Domain string
+	// Err is the reason the domain was rejected, such as ErrDomainTooLong.
+	Err error
+}
+
+func (e *DomainError) Error() string {
+	return fmt.Sprintf("publicsuffix: invalid domain %q: %s", e.Domain, e.Err.Error())
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimitError is returned by the GitHub retriever when the rate limit of
+// the GitHub API is exhausted. It matches ErrNetwork.
+type RateLimitError struct {
+	// URL of the rejected request.
+	URL string
+	// Reset is the time the rate limit resets, zero if unknown.
+	Reset time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Reset.IsZero() {
+		return fmt.Sprintf("error GET %s: rate limit exceeded", e.URL)
+	}
+
+	return fmt.Sprintf("error GET %s: rate limit exceeded until %s", e.URL, e.Reset.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrNetwork or ErrTemporary.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrNetwork || target == ErrTemporary
+}
+
+// StatusError is returned by the HTTP retrievers when a request fails with an
+// unexpected status. It matches ErrNetwork, and ErrTemporary for server errors
+// and responses asking to retry later, otherwise ErrPermanent.
+type StatusError struct {
+	// Method of the failed request.
+	Method string
+	// URL of the failed request.
+	URL string
+	// StatusCode of the response.
+	StatusCode int
+	// RetryAfter is the delay requested by the Retry-After header of the
+	// response, zero if absent.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("error %s %s: status %d, retry after %s", e.Method, e.URL, e.StatusCode, e.RetryAfter)
+	}
+
+	return fmt.Sprintf("error %s %s: status %d", e.Method, e.URL, e.StatusCode)
+}
+
+// Is reports whether target is ErrNetwork, or the ErrTemporary or
+// ErrPermanent category of the status.
+func (e *StatusError) Is(target error) bool {
+	// 408 Request Timeout and 429 Too Many Requests, net/http isn't imported
+	// for them as it isn't available to lite builds
+	var temporary = e.StatusCode >= 500 ||
+		e.StatusCode == 408 ||
+		e.StatusCode == 429 ||
+		e.RetryAfter > 0
+
+	switch target {
+	case ErrNetwork:
+		return true
+	case ErrTemporary:
+		return temporary
+	case ErrPermanent:
+		return !temporary
+	default:
+		return false
+	}
+}
+
+// categoryError attaches a category, such as ErrNetwork, to an error without
+// changing its message.
+type categoryError struct {
+	category error
+	err      error
+}
+
+func (e *categoryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *categoryError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether target is the category of e, or ErrTemporary for
+// network errors and ErrPermanent for invalid data errors.
+func (e *categoryError) Is(target error) bool {
+	switch target {
+	case e.category:
+		return true
+	case ErrTemporary:
+		return e.category == ErrNetwork
+	case ErrPermanent:
+		return e.category == ErrInvalidData
+	default:
+		return false
+	}
+}
+
+// networkError marks err as a network error.
+func networkError(err error) error {
+	return &categoryError{category: ErrNetwork, err: err}
+}
+
+// dataError marks err as an invalid data error.
+func dataError(err error) error {
+	return &categoryError{category: ErrInvalidData, err: err}
+}
+
+// noRegisteredDomain marks err as an error matching ErrNoRegisteredDomain.
+func noRegisteredDomain(err error) error {
+	return &categoryError{category: ErrNoRegisteredDomain, err: err}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func Test_ErrorRetryability(t *testing.T) {
+	var tests = []struct {
+		name      string
+		err       error
+		temporary bool
+	}{
+		{"Network error", networkError(errors.New("connection refused")), true},
+		{"Server error", &StatusError{Method: http.MethodGet, URL: "/", StatusCode: http.StatusServiceUnavailable}, true},
+		{"Too many requests", &StatusError{Method: http.MethodGet, URL: "/", StatusCode: http.StatusTooManyRequests}, true},
+		{"Rate limit", &RateLimitError{URL: "/"}, true},
+		{"Missing release", &StatusError{Method: http.MethodGet, URL: "/", StatusCode: http.StatusNotFound}, false},
+		{"Invalid data", dataError(errors.New("bad data")), false},
+		{"Invalid list", UpdateWithListRetriever(mockListRetriever{Release: "errors_test", RawList: bytes.NewBufferString("COM")}), false},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, ErrTemporary); got != tt.temporary {
+				t.Fatalf("temporary got: %v, want: %v", got, tt.temporary)
+			}
+
+			if got := errors.Is(tt.err, ErrPermanent); got == tt.temporary {
+				t.Fatalf("permanent got: %v, want: %v", got, !tt.temporary)
+			}
+		})
+	}
+}
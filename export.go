@@ -0,0 +1,158 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// exportVersion is the version of the ExportJSON schema. It is only
+// incremented for incompatible changes, new fields may be added to the schema
+// without changing it.
+const exportVersion = 1
+
+// exportList is the top level object written by ExportJSON.
+type exportList struct {
+	Version int          `json:"version"`
+	Release string       `json:"release"`
+	Rules   []exportRule `json:"rules"`
+}
+
+// exportRule is a single rule written by ExportJSON.
+type exportRule struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind"`
+	Section string `json:"section"`
+}
+
+// ExportJSON writes the currently loaded public suffix list to w as JSON.
+//
+// Unlike Write, which produces an internal format only meant to be loaded by
+// Read, ExportJSON uses a stable schema intended for external consumers and
+// long-term archival:
+//
+//	{
+//		"version": 1,
+//		"release": "<release of the list>",
+//		"rules": [
+//			{"name": "kobe.jp", "kind": "normal", "section": "icann"},
+//			{"name": "*.kobe.jp", "kind": "wildcard", "section": "icann"},
+//			{"name": "!city.kobe.jp", "kind": "exception", "section": "icann"},
+//			{"name": "blogspot.com", "kind": "normal", "section": "private"}
+//		]
+//	}
+//
+// name is the rule in canonical (Punycode) form exactly as it appears in the
+// list, kind is one of "normal", "wildcard" or "exception" and section is
+// either "icann" or "private". Rules are sorted by section, ICANN first, and
+// then by name so the output of a given release is always identical.
+//
+// Fields are never removed or renamed without incrementing version, but new
+// fields may be added.
+func ExportJSON(w io.Writer) error {
+	return defaultList().ExportJSON(w)
+}
+
+// ExportJSON writes l to w as JSON, see the package level ExportJSON.
+func (l *List) ExportJSON(w io.Writer) error {
+	var rulesInfo = l.load()
+
+	var export = exportList{
+		Version: exportVersion,
+		Release: rulesInfo.Release,
+		Rules:   make([]exportRule, 0, len(rulesInfo.Map)),
+	}
+
+	for _, rules := range rulesInfo.Map {
+		for _, rule := range rules {
+			var r = rule.public()
+			export.Rules = append(export.Rules, exportRule{
+				Name:    r.Name,
+				Kind:    r.Kind.String(),
+				Section: r.Section.String(),
+			})
+		}
+	}
+
+	sort.Slice(export.Rules, func(i, j int) bool {
+		var a, b = export.Rules[i], export.Rules[j]
+		if a.Section != b.Section {
+			return a.Section == ICANNSection.String()
+		}
+
+		return a.Name < b.Name
+	})
+
+	return json.NewEncoder(w).Encode(export)
+}
+
+// Export writes the rules of the currently loaded public suffix list kept by
+// filter to w in the format of the public_suffix_list.dat file, as WriteDAT
+// does for the whole list, so that downstream systems can consume a list
+// trimmed to their traffic. For example the ICANN rules of a few TLDs are
+// exported by:
+//
+//	Export(w, AllOf(InSection(ICANNSection), InTLD("uk", "jp")))
+//
+// A nil filter keeps every rule. The output parses back with ParseList.
+func Export(w io.Writer, filter Filter) error {
+	return defaultList().Export(w, filter)
+}
+
+// Export writes the rules of l kept by filter to w, see the package level
+// Export.
+func (l *List) Export(w io.Writer, filter Filter) error {
+	return writeDAT(w, l.load().filter(filter))
+}
+
+// ExportSnapshot writes the rules of the currently loaded public suffix list
+// kept by filter to w in the snapshot format of Write, so that trimmed lists
+// can be loaded by Read, ReadFile or SetEmbedded. A nil filter keeps every
+// rule, see Export.
+func ExportSnapshot(w io.Writer, filter Filter) error {
+	return defaultList().ExportSnapshot(w, filter)
+}
+
+// ExportSnapshot writes the rules of l kept by filter to w in the snapshot
+// format, see the package level ExportSnapshot.
+func (l *List) ExportSnapshot(w io.Writer, filter Filter) error {
+	return writeSnapshot(w, l.load().filter(filter), SnapshotLegacy)
+}
+
+// filter returns a copy of ri holding only its rules kept by filter, or ri
+// itself if filter is nil.
+func (ri *rulesInfo) filter(filter Filter) *rulesInfo {
+	if filter == nil {
+		return ri
+	}
+
+	var filtered = rulesInfo{Release: ri.Release, ICANNOnly: ri.ICANNOnly, Header: ri.Header, Map: make(map[string][]rule)}
+	for key, rules := range ri.Map {
+		for _, r := range rules {
+			if filter(r.public()) {
+				filtered.Map[key] = append(filtered.Map[key], r)
+			}
+		}
+	}
+
+	return &filtered
+}
@@ -0,0 +1,122 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_ExportJSON(t *testing.T) {
+	preserveRules(t)
+
+	var input bytes.Buffer
+	input.WriteString(`// ===BEGIN ICANN DOMAINS===
+jp
+*.kobe.jp
+!city.kobe.jp
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+blogspot.jp
+// ===END PRIVATE DOMAINS===
+`)
+
+	var mockRetriever = mockListRetriever{RawList: &input, Release: "export_test"}
+	if err := UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var output bytes.Buffer
+	if err := ExportJSON(&output); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = `{"version":1,"release":"export_test","rules":[` +
+		`{"name":"!city.kobe.jp","kind":"exception","section":"icann"},` +
+		`{"name":"*.kobe.jp","kind":"wildcard","section":"icann"},` +
+		`{"name":"jp","kind":"normal","section":"icann"},` +
+		`{"name":"blogspot.jp","kind":"normal","section":"private"}]}` + "\n"
+	if output.String() != expected {
+		t.Fatalf("got: %s, want: %s", output.String(), expected)
+	}
+}
+
+func Test_Export(t *testing.T) {
+	var list, err = ParseList(bytes.NewBufferString(`// VERSION: 2024-06-26_08-54-27_UTC
+
+// ===BEGIN ICANN DOMAINS===
+jp
+*.kobe.jp
+!city.kobe.jp
+uk
+co.uk
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+blogspot.jp
+// ===END PRIVATE DOMAINS===
+`), "export_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var output bytes.Buffer
+	if err := list.Export(&output, AllOf(InSection(ICANNSection), InTLD("jp"))); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = "// VERSION: 2024-06-26_08-54-27_UTC\n\n" +
+		"// ===BEGIN ICANN DOMAINS===\n\njp\n*.kobe.jp\n!city.kobe.jp\n\n// ===END ICANN DOMAINS===\n\n" +
+		"// ===BEGIN PRIVATE DOMAINS===\n\n// ===END PRIVATE DOMAINS===\n"
+	if output.String() != expected {
+		t.Fatalf("got: %q, want: %q", output.String(), expected)
+	}
+
+	output.Reset()
+	if err := list.ExportSnapshot(&output, InTLD("uk")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var trimmed = NewList()
+	if err := trimmed.Read(&output); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := strings.Join(trimmed.Suffixes(), " "); got != "co.uk uk" {
+		t.Fatalf("got: %s, want: %s", got, "co.uk uk")
+	}
+	if got := trimmed.Release(); got != "export_test" {
+		t.Fatalf("got: %s, want: %s", got, "export_test")
+	}
+
+	// a nil filter keeps every rule
+	output.Reset()
+	if err := list.Export(&output, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var whole bytes.Buffer
+	if err := list.WriteDAT(&whole); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if output.String() != whole.String() {
+		t.Fatalf("got: %q, want: %q", output.String(), whole.String())
+	}
+}
@@ -0,0 +1,49 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "net/http"
+
+// fetchDoer adapts the requests of the GitHub retriever to the Fetch API,
+// used by net/http under js/wasm. Browsers refuse to let scripts set some
+// headers, such as User-Agent and Accept-Encoding, and transparently
+// decompress the responses.
+type fetchDoer struct {
+	client *http.Client
+}
+
+// Do implements Doer.
+func (d fetchDoer) Do(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	// set by the browser
+	req.Header.Del("User-Agent")
+	req.Header.Del("Accept-Encoding")
+
+	var res, err = d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the body is already decompressed
+	res.Header.Del("Content-Encoding")
+
+	return res, nil
+}
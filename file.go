@@ -0,0 +1,160 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ReadFS loads a public suffix list serialised by Write from the file at path
+// in fsys, such as an embed.FS, and uses it for future lookups. See Read for
+// the supported options.
+func ReadFS(fsys fs.FS, path string, opts ...Option) error {
+	return defaultList().ReadFS(fsys, path, opts...)
+}
+
+// ReadFS loads a public suffix list serialised by Write from the file at path
+// in fsys into l.
+func (l *List) ReadFS(fsys fs.FS, path string, opts ...Option) error {
+	var file, err = fsys.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return l.Read(file, opts...)
+}
+
+// WriteFile atomically writes the currently loaded public suffix list, as
+// serialised by Write, to the file at path.
+//
+// The list is written to a temporary file which is synced to disk and renamed
+// over path, so readers never observe a partially written file. An advisory
+// lock on path+".lock" serialises writers and readers using ReadFile, allowing
+// several processes on a host to share one cache file.
+func WriteFile(path string) error {
+	return defaultList().WriteFile(path)
+}
+
+// WriteFile atomically writes l to the file at path, see the package level
+// WriteFile.
+func (l *List) WriteFile(path string) error {
+	var unlock, err = lockFile(path, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var tmp *os.File
+	tmp, err = os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	// Removing the temporary file fails harmlessly once it has been renamed.
+	defer os.Remove(tmp.Name())
+
+	if err := l.Write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	return syncDir(filepath.Dir(path))
+}
+
+// ReadFile loads a public suffix list written by WriteFile and uses it for
+// future lookups. See Read for the supported options.
+func ReadFile(path string, opts ...Option) error {
+	return defaultList().ReadFile(path, opts...)
+}
+
+// ReadFile loads a public suffix list written by WriteFile into l, see the
+// package level ReadFile.
+func (l *List) ReadFile(path string, opts ...Option) error {
+	var unlock, err = lockFile(path, false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var file *os.File
+	file, err = os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return l.Read(file, opts...)
+}
+
+// lockFile acquires an advisory lock on path+".lock", exclusive or shared,
+// and returns a function releasing it.
+//
+// Shared locks only need to read the lock file, so that lists can be read from
+// read-only directories. If the lock file doesn't exist and can't be created
+// there, no writer can be using it and the shared lock is skipped.
+func lockFile(path string, exclusive bool) (func(), error) {
+	var file *os.File
+	var err error
+	if exclusive {
+		file, err = os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0644)
+	} else {
+		file, err = os.Open(path + ".lock")
+		if errors.Is(err, fs.ErrNotExist) {
+			file, err = os.OpenFile(path+".lock", os.O_CREATE|os.O_RDONLY, 0644)
+			if err != nil {
+				return func() {}, nil
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lock(file, exclusive); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return func() {
+		unlock(file)
+		file.Close()
+	}, nil
+}
@@ -0,0 +1,39 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "os"
+
+// lock is a no-op on platforms without file locks, such as plan9 and js,
+// WriteFile remains atomic thanks to the rename but concurrent writers aren't
+// serialised.
+func lock(file *os.File, exclusive bool) error {
+	return nil
+}
+
+// unlock is a no-op, see lock.
+func unlock(file *os.File) error {
+	return nil
+}
+
+// syncDir is a no-op on platforms where directories can't be synced.
+func syncDir(path string) error {
+	return nil
+}
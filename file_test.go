@@ -0,0 +1,141 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+func Test_ReadFS(t *testing.T) {
+	var source = NewList()
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("ac\ncom.ac\n"), Release: "readfs_test"}
+	if err := source.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var snapshot bytes.Buffer
+	if err := source.Write(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var fsys = fstest.MapFS{"data/list.bin": &fstest.MapFile{Data: snapshot.Bytes()}}
+
+	var list = NewList()
+	if err := list.ReadFS(fsys, "data/list.bin"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if list.Release() != "readfs_test" {
+		t.Fatalf("got: %s, want: %s", list.Release(), "readfs_test")
+	}
+
+	if err := list.ReadFS(fsys, "missing.bin"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("got: %v, want: %v", err, fs.ErrNotExist)
+	}
+}
+
+func Test_WriteFile_ReadFile(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "list.bin")
+
+	var source = NewList()
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("ac\ncom.ac\n"), Release: "file_test"}
+	if err := source.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Concurrent writers and readers must never observe a partial file.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := source.WriteFile(path); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := source.WriteFile(path); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := NewList().ReadFile(path); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	var list = NewList()
+	if err := list.ReadFile(path); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if list.Release() != "file_test" {
+		t.Fatalf("got: %s, want: %s", list.Release(), "file_test")
+	}
+
+	// Only the list and its lock file remain.
+	var entries, _ = os.ReadDir(filepath.Dir(path))
+	if len(entries) != 2 {
+		t.Fatalf("got %d files, want: %d", len(entries), 2)
+	}
+}
+
+func Test_ReadFileReadOnly(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("permissions don't apply to root")
+	}
+
+	var dir = t.TempDir()
+	var path = filepath.Join(dir, "list.bin")
+	if err := NewList().WriteFile(path); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// a list installed in a read-only directory, without its lock file
+	if err := os.Remove(path + ".lock"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := os.Chmod(dir, 0555); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	t.Cleanup(func() { os.Chmod(dir, 0755) })
+
+	if err := NewList().ReadFile(path); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := NewList().WriteFile(path); err == nil {
+		t.Fatalf("got: %v, want: an error", err)
+	}
+}
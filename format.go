@@ -0,0 +1,142 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// FormatList writes to w the canonical form of r, a file in the format of the
+// public suffix list such as an internal overlay, so that it can be kept
+// deterministic and diff friendly:
+//   - surrounding spaces are trimmed and runs of blank lines are collapsed,
+//   - rules are converted to their canonical form, lower case and Punycode
+//     encoded,
+//   - each group of consecutive rules is sorted label by label from the TLD,
+//     the order LintList expects.
+//
+// Comments are kept as is. An error matching ErrInvalidData is returned for
+// lines which aren't valid rules, nothing is written then.
+func FormatList(w io.Writer, r io.Reader) error {
+	var lines []string
+	var group []string
+	var flush = func() {
+		sort.SliceStable(group, func(i, j int) bool {
+			return ruleLess(group[i], group[j])
+		})
+		lines = append(lines, group...)
+		group = group[:0]
+	}
+
+	var scanner = bufio.NewScanner(r)
+	var number int
+	for scanner.Scan() {
+		number++
+		var line = strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "//") {
+			flush()
+
+			var blank = len(lines) == 0 || lines[len(lines)-1] == ""
+			if line != "" || !blank {
+				lines = append(lines, line)
+			}
+			continue
+		}
+
+		var _, rule, err = parseRule(line, false)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", number, err)
+		}
+
+		group = append(group, rule.DottedName)
+	}
+	flush()
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	// the file ends with a single line feed
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var buffer = bufio.NewWriter(w)
+	for _, line := range lines {
+		buffer.WriteString(line)
+		buffer.WriteByte('\n')
+	}
+
+	return buffer.Flush()
+}
+
+// WriteDAT writes the currently loaded public suffix list to w in the format
+// of the public_suffix_list.dat file: its header, then the rules of each
+// section in canonical form between the section markers, preceded by their
+// comment. Rules are written in their order in the list they were parsed from,
+// or in canonical order when unknown, such as for the embedded list. The
+// output parses back to the same rules with ParseList.
+func WriteDAT(w io.Writer) error {
+	return defaultList().WriteDAT(w)
+}
+
+// WriteDAT writes l to w in the format of the public_suffix_list.dat file, see
+// the package level WriteDAT.
+func (l *List) WriteDAT(w io.Writer) error {
+	return writeDAT(w, l.load())
+}
+
+// writeDAT writes ri to w in the format of the public_suffix_list.dat file.
+func writeDAT(w io.Writer, ri *rulesInfo) error {
+	var rules []mergedRule
+	for _, list := range ri.Map {
+		for _, r := range list {
+			rules = append(rules, mergedRule{rule: r})
+		}
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Line != rules[j].Line {
+			return rules[i].Line < rules[j].Line
+		}
+
+		return ruleLess(rules[i].DottedName, rules[j].DottedName)
+	})
+
+	var buffer = bufio.NewWriter(w)
+
+	if header := ri.Header; header != nil {
+		if header.Version != "" {
+			fmt.Fprintf(buffer, "// VERSION: %s\n", header.Version)
+		}
+		if header.Commit != "" {
+			fmt.Fprintf(buffer, "// COMMIT: %s\n", header.Commit)
+		}
+		buffer.WriteString("\n")
+	}
+
+	writeSection(buffer, "ICANN", rules, true)
+	buffer.WriteString("\n")
+	writeSection(buffer, "PRIVATE", rules, false)
+
+	return buffer.Flush()
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_FormatList(t *testing.T) {
+	if unsupportedIDN("рф") {
+		t.Skip("internationalised domain names aren't supported")
+	}
+
+	var list = "\n\n// ===BEGIN ICANN DOMAINS===\n  // jp  \nkobe.jp\n*.kobe.jp \njp\n\n\n\n!city.kobe.jp\nрф\n// ===END ICANN DOMAINS===\n\n"
+	var want = "// ===BEGIN ICANN DOMAINS===\n// jp\njp\nkobe.jp\n*.kobe.jp\n\n!city.kobe.jp\nxn--p1ai\n// ===END ICANN DOMAINS===\n"
+
+	var formatted bytes.Buffer
+	if err := FormatList(&formatted, strings.NewReader(list)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if formatted.String() != want {
+		t.Fatalf("got: %q, want: %q", formatted.String(), want)
+	}
+
+	// the formatted list is stable and passes the order checks
+	var again bytes.Buffer
+	if err := FormatList(&again, strings.NewReader(want)); err != nil || again.String() != want {
+		t.Fatalf("got: %q %v, want: %q", again.String(), err, want)
+	}
+
+	for _, issue := range LintList(strings.NewReader(want), CheckOrder()) {
+		if issue.Kind == IssueOrder || issue.Kind == IssueNotCanonical {
+			t.Fatalf("unexpected issue: %s", issue)
+		}
+	}
+
+	var err = FormatList(&formatted, strings.NewReader("jp\nBad Rule\n"))
+	if !errors.Is(err, ErrInvalidData) || !strings.HasPrefix(err.Error(), "line 2: ") {
+		t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+	}
+}
+
+func Test_WriteDAT(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("// VERSION: 2024-06-26_08-54-27_UTC\n\n// ===BEGIN ICANN DOMAINS===\n// jp\njp\n*.kobe.jp\n!city.kobe.jp\n// ===END ICANN DOMAINS===\n\n// ===BEGIN PRIVATE DOMAINS===\n// Google\nblogspot.jp\n// ===END PRIVATE DOMAINS===\n"), "dat_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var dat bytes.Buffer
+	if err := list.WriteDAT(&dat); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var want = "// VERSION: 2024-06-26_08-54-27_UTC\n\n// ===BEGIN ICANN DOMAINS===\n\n// jp\njp\n*.kobe.jp\n!city.kobe.jp\n\n// ===END ICANN DOMAINS===\n\n// ===BEGIN PRIVATE DOMAINS===\n\n// Google\nblogspot.jp\n\n// ===END PRIVATE DOMAINS===\n"
+	if dat.String() != want {
+		t.Fatalf("got: %q, want: %q", dat.String(), want)
+	}
+}
@@ -27,6 +27,11 @@ import (
 func Fuzz(in []byte) int {
 	var domain = string(in)
 
+	// golang.org/x/net/publicsuffix doesn't enforce the RFC 1035 lengths
+	if checkDomain(domain) != nil {
+		return -1
+	}
+
 	var got, _ = PublicSuffix(domain)
 	var want, _ = psl.PublicSuffix(domain)
 	if want != got {
@@ -0,0 +1,86 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// ruleSet returns the rules of ri in a comparable form.
+func ruleSet(ri *rulesInfo) []rule {
+	var rules []rule
+	for _, list := range ri.Map {
+		for _, r := range list {
+			rules = append(rules, rule{DottedName: r.DottedName, ICANN: r.ICANN, RuleType: r.RuleType})
+		}
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].DottedName != rules[j].DottedName {
+			return rules[i].DottedName < rules[j].DottedName
+		}
+
+		return rules[i].ICANN && !rules[j].ICANN
+	})
+
+	return rules
+}
+
+func FuzzParseList(f *testing.F) {
+	f.Add([]byte("jp\n*.kobe.jp\n!city.kobe.jp\n"))
+	f.Add([]byte("// VERSION: 2024-06-26_08-54-27_UTC\n\n// ===BEGIN ICANN DOMAINS===\n// jp\njp\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nblogspot.jp\n"))
+	f.Add([]byte("網路.tw\n*.ck\n!www.ck\n"))
+
+	f.Fuzz(func(t *testing.T, list []byte) {
+		var ri, err = newList(bytes.NewReader(list), "fuzz")
+		if err != nil {
+			return
+		}
+
+		var l = &List{}
+		l.store(*ri)
+
+		var dat bytes.Buffer
+		if err := l.WriteDAT(&dat); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var parsed *rulesInfo
+		parsed, err = newList(&dat, "fuzz")
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", dat.String(), err.Error())
+		}
+
+		if got, want := ruleSet(parsed), ruleSet(ri); !reflect.DeepEqual(got, want) {
+			t.Fatalf("got: %v, want: %v", got, want)
+		}
+
+		var got, want Header
+		if parsed.Header != nil {
+			got = *parsed.Header
+		}
+		if ri.Header != nil {
+			want = *ri.Header
+		}
+		if got.Version != want.Version || got.Commit != want.Commit {
+			t.Fatalf("got: %+v, want: %+v", got, want)
+		}
+	})
+}
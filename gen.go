@@ -18,19 +18,77 @@ limitations under the License.
 
 // This program generates list.go. It can be invoked by running
 // go generate
+//
+// It can also be used to generate a file pinned to an audited release of the
+// list for use in another package, so builds are reproducible:
+//
+//	go run gen.go -release <commit> -package mypackage -o ../mypackage/psl_list.go
+//
+// The generated file loads the list into publicsuffix when mypackage is
+// initialised. Alternatively -asset writes the raw snapshot, suitable for
+// go:embed and publicsuffix.Read, instead of Go source. This is how the list
+// of the github.com/globalsign/publicsuffix/data module is refreshed:
+//
+//	cd data && go generate
+//
+// For the publicsuffix package the list is also written as a table of rules
+// to list_static.go, see -static-o, used instead of list.go by the lite builds
+// which can't decode snapshots.
+//
+// -snapshot generates the files from a snapshot written by publicsuffix.Write
+// rather than from the GitHub repository, e.g. to keep list.go and
+// list_static.go in sync without network access.
 
 package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/globalsign/publicsuffix"
 )
 
+var (
+	release      = flag.String("release", "", "release (commit) of the list to use, defaults to the latest")
+	pkg          = flag.String("package", "publicsuffix", "package name of the generated file")
+	output       = flag.String("o", "list.go", "output file")
+	asset        = flag.Bool("asset", false, "write the raw snapshot instead of Go source")
+	staticOutput = flag.String("static-o", "list_static.go", "output file of the table of rules for lite builds, written along with list.go")
+	snapshot     = flag.String("snapshot", "", "snapshot to read the list from instead of retrieving it")
+)
+
+// buildTags are the build constraints of the files generated for the
+// publicsuffix package, depending on whether they hold a table of rules.
+var buildTags = map[bool]string{
+	false: "//go:build !tinygo && !publicsuffix_lite\n// +build !tinygo,!publicsuffix_lite\n\n",
+	true:  "//go:build tinygo || publicsuffix_lite\n// +build tinygo publicsuffix_lite\n\n",
+}
+
+// pinnedListRetriever retrieves a fixed release of the list.
+type pinnedListRetriever struct {
+	publicsuffix.ListRetriever
+	release string
+}
+
+// GetLatestReleaseTag returns the pinned release.
+func (p pinnedListRetriever) GetLatestReleaseTag() (string, error) {
+	return p.release, nil
+}
+
 func main() {
-	publicsuffix.Update()
+	flag.Parse()
+
+	if err := load(); err != nil {
+		fmt.Printf("error while retrieving the list: %s\n", err.Error())
+		os.Exit(1)
+	}
 
 	var rules bytes.Buffer
 	if err := publicsuffix.Write(&rules); err != nil {
@@ -46,21 +104,167 @@ func main() {
 	fmt.Printf("Updated Public Suffix List using release: %s\n", publicsuffix.Release())
 }
 
+// load loads the list to generate the files from.
+func load() error {
+	if *snapshot != "" {
+		var file, err = os.Open(*snapshot)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		return publicsuffix.Read(file)
+	}
+
+	var listRetriever = publicsuffix.NewGitHubListRetriever(http.DefaultClient)
+	if *release != "" {
+		listRetriever = pinnedListRetriever{ListRetriever: listRetriever, release: *release}
+	}
+
+	return publicsuffix.UpdateWithListRetriever(listRetriever)
+}
+
 func printFile(rules bytes.Buffer) error {
-	var file, err = os.Create("list.go")
+	var file, err = os.Create(*output)
 	if err != nil {
 		return err
 	}
+	defer file.Close()
 
-	fmt.Fprintf(file, "// Code generated by publicsuffix/gen.go; DO NOT EDIT\n\n")
-	fmt.Fprintf(file, "package publicsuffix\n\nvar initialRelease = `%s`\n\n", publicsuffix.Release())
-	fmt.Fprintf(file, "var listBytes = []byte{")
+	switch {
+	case *asset:
+		_, err = file.Write(rules.Bytes())
+		return err
+
+	case *pkg == "publicsuffix":
+		fmt.Fprintf(file, "// Code generated by publicsuffix/gen.go; DO NOT EDIT\n\n")
+		fmt.Fprintf(file, "%s", buildTags[false])
+		fmt.Fprintf(file, "package publicsuffix\n\nvar initialRelease = `%s`\n\n", publicsuffix.Release())
+		fmt.Fprintf(file, "var initialDate = `%s`\n\n", buildDate())
+		fmt.Fprintf(file, "var listBytes = []byte{")
+		printBytes(file, rules.Bytes())
+		fmt.Fprintf(file, "}\n")
 
-	for _, b := range rules.Bytes() {
-		fmt.Fprintf(file, "%#X,", b)
+		return printStaticFile()
+
+	default:
+		fmt.Fprintf(file, "// Code generated by publicsuffix/gen.go; DO NOT EDIT\n\n")
+		fmt.Fprintf(file, "package %s\n\n", *pkg)
+		fmt.Fprintf(file, "import (\n\t\"bytes\"\n\n\t\"github.com/globalsign/publicsuffix\"\n)\n\n")
+		fmt.Fprintf(file, "// publicSuffixRelease is the pinned release of the public suffix list.\n")
+		fmt.Fprintf(file, "const publicSuffixRelease = `%s`\n\n", publicsuffix.Release())
+		fmt.Fprintf(file, "var publicSuffixListBytes = []byte{")
+		printBytes(file, rules.Bytes())
+		fmt.Fprintf(file, "}\n\n")
+		fmt.Fprintf(file, "func init() {\n")
+		fmt.Fprintf(file, "\tif err := publicsuffix.Read(bytes.NewReader(publicSuffixListBytes)); err != nil {\n")
+		fmt.Fprintf(file, "\t\tpanic(\"error while loading pinned Public Suffix List: \" + err.Error())\n")
+		fmt.Fprintf(file, "\t}\n\n")
+		fmt.Fprintf(file, "\tpublicSuffixListBytes = nil\n")
+		fmt.Fprintf(file, "}\n")
+	}
+
+	return nil
+}
+
+// printStaticFile writes the table of rules of the list for lite builds.
+func printStaticFile() error {
+	var file, err = os.Create(*staticOutput)
+	if err != nil {
+		return err
 	}
+	defer file.Close()
 
+	fmt.Fprintf(file, "// Code generated by publicsuffix/gen.go; DO NOT EDIT\n\n")
+	fmt.Fprintf(file, "%s", buildTags[true])
+	fmt.Fprintf(file, "package publicsuffix\n\nvar initialRelease = `%s`\n\n", publicsuffix.Release())
+	fmt.Fprintf(file, "var initialDate = `%s`\n\n", buildDate())
+	fmt.Fprintf(file, "var staticRules = []rule{\n")
+	printRules(file)
 	fmt.Fprintf(file, "}\n")
 
 	return nil
 }
+
+// commitURL is the GitHub API endpoint describing a commit of the list.
+const commitURL = "https://api.github.com/repos/publicsuffix/list/commits/%s"
+
+// buildDate returns the date of the list declared by its header, or else the
+// date its release was committed on, formatted as RFC 3339. It is empty if
+// neither is known, e.g. when generating from a snapshot without network
+// access.
+func buildDate() string {
+	var date = publicsuffix.CurrentHeader().Date
+	if date.IsZero() {
+		date = releaseDate(publicsuffix.Release())
+	}
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.UTC().Format(time.RFC3339)
+}
+
+// releaseDate returns the date release was committed on in the GitHub
+// repository of the list, zero if it can't be retrieved.
+func releaseDate(release string) time.Time {
+	var resp, err = http.Get(fmt.Sprintf(commitURL, release))
+	if err != nil {
+		return time.Time{}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}
+	}
+
+	var commit struct {
+		Commit struct {
+			Committer struct {
+				Date time.Time `json:"date"`
+			} `json:"committer"`
+		} `json:"commit"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&commit); err != nil {
+		return time.Time{}
+	}
+
+	return commit.Commit.Committer.Date
+}
+
+// printRules prints the rules of the list as the elements of a []rule, in
+// their order in the list when known.
+func printRules(w io.Writer) {
+	var rules []publicsuffix.Rule
+	var collect = func(r publicsuffix.Rule) bool {
+		rules = append(rules, r)
+		return true
+	}
+
+	publicsuffix.ICANNRules(collect)
+	publicsuffix.PrivateRules(collect)
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Line < rules[j].Line
+	})
+
+	for _, r := range rules {
+		fmt.Fprintf(w, "\t{DottedName: %q", r.Name)
+		if r.Kind != publicsuffix.NormalRule {
+			fmt.Fprintf(w, ", RuleType: %s", r.Kind)
+		}
+		if r.Section == publicsuffix.ICANNSection {
+			fmt.Fprintf(w, ", ICANN: true")
+		}
+		if r.Unicode != r.Name {
+			fmt.Fprintf(w, ", Text: %q", r.Unicode)
+		}
+		fmt.Fprintf(w, "},\n")
+	}
+}
+
+func printBytes(w io.Writer, b []byte) {
+	for _, c := range b {
+		fmt.Fprintf(w, "%#X,", c)
+	}
+}
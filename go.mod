@@ -5,6 +5,5 @@ go 1.19
 require (
 	github.com/weppos/publicsuffix-go v0.15.0
 	golang.org/x/net v0.0.0-20211105192438-b53810dc28af
+	golang.org/x/text v0.3.6
 )
-
-require golang.org/x/text v0.3.6 // indirect
@@ -0,0 +1,78 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"time"
+)
+
+// headerDateLayout is the layout of the date in the VERSION header line.
+const headerDateLayout = "2006-01-02_15-04-05_MST"
+
+// Header is the metadata declared by the comments at the top of the
+// public_suffix_list.dat file, such as:
+//
+//	// VERSION: 2024-06-26_08-54-27_UTC
+//	// COMMIT: 0b5a2c8e7a1c6c8d6e3e6f2b1c3d4e5f6a7b8c9d
+//
+// It identifies the data even when the release of the list, e.g. a tag
+// returned by a ListRetriever, is opaque. Fields are empty when the list
+// doesn't declare them.
+type Header struct {
+	// Version is the value of the VERSION line.
+	Version string
+	// Commit is the value of the COMMIT line.
+	Commit string
+	// Date is parsed from Version, zero if it isn't a date.
+	Date time.Time
+}
+
+// CurrentHeader returns the header of the currently loaded list.
+func CurrentHeader() Header {
+	return defaultList().Header()
+}
+
+// Header returns the header of the list currently loaded in l.
+func (l *List) Header() Header {
+	var header = l.load().Header
+	if header == nil {
+		return Header{}
+	}
+
+	return *header
+}
+
+// parseLine records the metadata of a comment line of the header.
+func (h *Header) parseLine(line string) {
+	var key, value, found = strings.Cut(strings.TrimSpace(strings.TrimPrefix(line, "//")), ":")
+	if !found {
+		return
+	}
+
+	value = strings.TrimSpace(value)
+
+	switch strings.TrimSpace(key) {
+	case "VERSION":
+		h.Version = value
+		if date, err := time.Parse(headerDateLayout, value); err == nil {
+			h.Date = date
+		}
+	case "COMMIT":
+		h.Commit = value
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Header(t *testing.T) {
+	var list, err = ParseList(strings.NewReader(`// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0.
+
+// VERSION: 2024-06-26_08-54-27_UTC
+// COMMIT: 0b5a2c8e7a1c6c8d6e3e6f2b1c3d4e5f6a7b8c9d
+
+// ===BEGIN ICANN DOMAINS===
+jp
+// VERSION: not a header line
+// ===END ICANN DOMAINS===
+`), "header_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = Header{
+		Version: "2024-06-26_08-54-27_UTC",
+		Commit:  "0b5a2c8e7a1c6c8d6e3e6f2b1c3d4e5f6a7b8c9d",
+		Date:    time.Date(2024, 6, 26, 8, 54, 27, 0, time.UTC),
+	}
+
+	if got := list.Header(); got.Version != expected.Version || got.Commit != expected.Commit || !got.Date.Equal(expected.Date) {
+		t.Fatalf("got: %+v, want: %+v", got, expected)
+	}
+
+	// lists without header
+	if got := NewList().Header(); got != (Header{}) {
+		t.Fatalf("got: %+v, want: %+v", got, Header{})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrReleaseNotRetained is matched by errors.Is when the rules of a release
+// aren't retained by the history of a list, see SetHistory.
+var ErrReleaseNotRetained = errors.New("publicsuffix: release not retained")
+
+// historyEntry holds the rules of a release previously loaded in a list.
+type historyEntry struct {
+	release string
+	rules   map[string][]rule
+}
+
+// SetHistory retains the rules of the last n releases replaced in the default
+// list, so that ChangedRulesSince can compare them with the current list. The
+// history is disabled by default as it keeps the rules of every retained
+// release in memory; n <= 0 disables it and discards the retained releases.
+func SetHistory(n int) {
+	defaultList().SetHistory(n)
+}
+
+// SetHistory retains the rules of the last n releases replaced in l, see the
+// package level SetHistory.
+func (l *List) SetHistory(n int) {
+	if n < 0 {
+		n = 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.historySize = n
+	if len(l.history) > n {
+		l.history = append([]historyEntry(nil), l.history[len(l.history)-n:]...)
+	}
+}
+
+// retain adds previous, the rules replaced in l, to its history. It must be
+// called with l.mu held.
+func (l *List) retain(previous *rulesInfo) {
+	if l.historySize == 0 || previous == nil {
+		return
+	}
+
+	l.history = append(l.history, historyEntry{release: previous.Release, rules: previous.Map})
+	if len(l.history) > l.historySize {
+		l.history = append([]historyEntry(nil), l.history[len(l.history)-l.historySize:]...)
+	}
+}
+
+// ChangedRulesSince returns the rules added to and removed from the default
+// list since release, a release it was loaded with before and which is
+// retained by its history, see SetHistory. An error matching
+// ErrReleaseNotRetained is returned otherwise. Rules are sorted by name.
+func ChangedRulesSince(release string) (added, removed []Rule, err error) {
+	return defaultList().ChangedRulesSince(release)
+}
+
+// ChangedRulesSince returns the rules added to and removed from l since
+// release, see the package level ChangedRulesSince.
+func (l *List) ChangedRulesSince(release string) (added, removed []Rule, err error) {
+	// a List which was never loaded gets its rules first
+	l.load()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var current = l.rules.Load()
+	if current.Release == release {
+		return nil, nil, nil
+	}
+
+	// the most recent load of release is the one to compare with
+	for i := len(l.history) - 1; i >= 0; i-- {
+		if l.history[i].release == release {
+			return diffRules(current.Map, l.history[i].rules), diffRules(l.history[i].rules, current.Map), nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: %q", ErrReleaseNotRetained, release)
+}
+
+// diffRules returns the rules of a missing from b.
+func diffRules(a, b map[string][]rule) []Rule {
+	var diff []Rule
+	for key, rules := range a {
+		for _, r := range rules {
+			if !hasRule(b[key], r) {
+				diff = append(diff, r.public())
+			}
+		}
+	}
+
+	sort.Slice(diff, func(i, j int) bool {
+		if diff[i].Name != diff[j].Name {
+			return diff[i].Name < diff[j].Name
+		}
+		return diff[i].Section < diff[j].Section
+	})
+
+	return diff
+}
+
+// hasRule reports whether rules holds r, in the same section.
+func hasRule(rules []rule, r rule) bool {
+	for _, candidate := range rules {
+		if candidate.DottedName == r.DottedName && candidate.ICANN == r.ICANN {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_ChangedRulesSince(t *testing.T) {
+	var l = NewList()
+	l.SetHistory(2)
+
+	var update = func(release, list string) {
+		var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(list), Release: release}
+		if err := l.UpdateWithListRetriever(mockRetriever); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	var names = func(rules []Rule) []string {
+		var names []string
+		for _, r := range rules {
+			names = append(names, r.Name)
+		}
+		return names
+	}
+
+	var embedded = l.Release()
+	update("1", "// ===BEGIN ICANN DOMAINS===\njp\nkobe.jp\n// ===END ICANN DOMAINS===\n")
+	update("2", "// ===BEGIN ICANN DOMAINS===\njp\n*.kobe.jp\n// ===END ICANN DOMAINS===\nkobe.jp\n")
+
+	var added, removed, err = l.ChangedRulesSince("1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// kobe.jp moved to the private section
+	if got, want := names(added), []string{"*.kobe.jp", "kobe.jp"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if got, want := names(removed), []string{"kobe.jp"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if removed[0].Section != ICANNSection || added[1].Section != PrivateSection {
+		t.Fatalf("unexpected sections: %v %v", added, removed)
+	}
+
+	if _, removed, err := l.ChangedRulesSince(embedded); err != nil || len(removed) < 1000 {
+		t.Fatalf("got: %d %v, want: the rules of the embedded list", len(removed), err)
+	}
+
+	if added, removed, err := l.ChangedRulesSince("2"); err != nil || added != nil || removed != nil {
+		t.Fatalf("got: %v %v %v, want: no change", added, removed, err)
+	}
+
+	// the embedded release is dropped from the history
+	update("3", "jp\n")
+	if _, _, err := l.ChangedRulesSince(embedded); !errors.Is(err, ErrReleaseNotRetained) {
+		t.Fatalf("got: %v, want: %v", err, ErrReleaseNotRetained)
+	}
+
+	l.SetHistory(0)
+	if _, _, err := l.ChangedRulesSince("2"); !errors.Is(err, ErrReleaseNotRetained) {
+		t.Fatalf("got: %v, want: %v", err, ErrReleaseNotRetained)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "golang.org/x/net/idna"
+
+// toASCII converts s, a rule or a domain, to Punycode.
+func toASCII(s string) (string, error) {
+	return idna.ToASCII(s)
+}
+
+// lookupToASCII converts label to Punycode with the mapping of the IDNA lookup
+// profile, which lower cases it among others.
+func lookupToASCII(label string) (string, error) {
+	return idna.Lookup.ToASCII(label)
+}
+
+// toUnicode decodes the Punycode labels of s.
+func toUnicode(s string) (string, error) {
+	return idna.ToUnicode(s)
+}
@@ -0,0 +1,70 @@
+//go:build tinygo || publicsuffix_lite
+// +build tinygo publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Lite builds leave out golang.org/x/net/idna and its Unicode tables: they
+// only handle ASCII domains and rules, such as the Punycode encoded ones of the
+// lists pre-processed by cmd/genlist.
+
+// errIDNAUnsupported is returned for internationalised names by lite builds.
+var errIDNAUnsupported = errors.New("internationalised domain names aren't supported by lite builds, use Punycode")
+
+// toASCII returns s if it is ASCII, see the IDNA version.
+func toASCII(s string) (string, error) {
+	if !isASCII(s) {
+		return "", errIDNAUnsupported
+	}
+
+	return s, nil
+}
+
+// lookupToASCII lower cases label if it is ASCII, see the IDNA version. Like
+// the IDNA lookup profile it only accepts letters, digits and hyphens, and
+// rejects hyphens at the start, the end or the third and fourth positions of
+// labels which aren't Punycode encoded.
+func lookupToASCII(label string) (string, error) {
+	if !isASCII(label) {
+		return "", errIDNAUnsupported
+	}
+
+	label = strings.ToLower(label)
+	for i := 0; i < len(label); i++ {
+		if c := label[i]; (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return "", fmt.Errorf("invalid character %q in label %q", c, label)
+		}
+	}
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") ||
+		len(label) >= 4 && label[2:4] == "--" && !strings.HasPrefix(label, "xn--") {
+		return "", fmt.Errorf("invalid hyphen in label %q", label)
+	}
+
+	return label, nil
+}
+
+// toUnicode returns s unchanged, Punycode labels aren't decoded.
+func toUnicode(s string) (string, error) {
+	return s, nil
+}
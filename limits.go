@@ -0,0 +1,100 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Limits bounds the domains looked up by a List, see WithLimits. A zero
+// field sets no limit beyond the lengths allowed by RFC 1035.
+type Limits struct {
+	// MaxLength is the maximum length of a domain, in octets.
+	MaxLength int
+	// MaxLabels is the maximum number of labels of a domain.
+	MaxLabels int
+	// MaxWork is the maximum work of a lookup, estimated as the number of
+	// labels of the domain times the number of rules each of its names may
+	// be compared with: one, plus the number of rules with a "*" label which
+	// isn't the first one, such as "a.*.example", which are matched against
+	// the whole domain.
+	MaxWork int
+}
+
+// WithLimits makes the lookups of a list reject the domains exceeding limits,
+// so that untrusted input, such as the hostnames of packet captures, can't
+// cause excessive work per call. Lookup, EffectiveTLDPlusOne and the other
+// functions returning an error then return a *DomainError matching
+// ErrLimitExceeded, while PublicSuffix and the functions which can't fail
+// return an empty suffix, as for domains exceeding the lengths allowed by
+// RFC 1035. It is set on a List created by NewList.
+func WithLimits(limits Limits) Option {
+	return func(o *options) {
+		o.limits = limits
+	}
+}
+
+// ruleLimits are the Limits of the lookups in the rules of a list.
+type ruleLimits struct {
+	Limits
+	// patterns is the number of rules matched against the whole domain
+	patterns int
+}
+
+// forRules returns the limits of the lookups in ri, nil if there are none.
+func (limits Limits) forRules(ri *rulesInfo) *ruleLimits {
+	if limits == (Limits{}) {
+		return nil
+	}
+
+	var rl = &ruleLimits{Limits: limits}
+	if limits.MaxWork > 0 {
+		for _, rules := range ri.Map {
+			for _, r := range rules {
+				if r.patterned() {
+					rl.patterns++
+				}
+			}
+		}
+	}
+
+	return rl
+}
+
+// check returns a *DomainError matching ErrLimitExceeded if domain exceeds
+// the limits. It is a no-op on nil ruleLimits.
+func (rl *ruleLimits) check(domain string) error {
+	if rl == nil {
+		return nil
+	}
+
+	if rl.MaxLength > 0 && len(domain) > rl.MaxLength {
+		return &DomainError{Domain: domain, Err: fmt.Errorf("%w: %d octets, want at most %d", ErrLimitExceeded, len(domain), rl.MaxLength)}
+	}
+
+	var labels = strings.Count(domain, ".") + 1
+	if rl.MaxLabels > 0 && labels > rl.MaxLabels {
+		return &DomainError{Domain: domain, Err: fmt.Errorf("%w: %d labels, want at most %d", ErrLimitExceeded, labels, rl.MaxLabels)}
+	}
+
+	if work := labels * (1 + rl.patterns); rl.MaxWork > 0 && work > rl.MaxWork {
+		return &DomainError{Domain: domain, Err: fmt.Errorf("%w: work of %d, want at most %d", ErrLimitExceeded, work, rl.MaxWork)}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_WithLimits(t *testing.T) {
+	var tests = []struct {
+		name    string
+		limits  Limits
+		domain  string
+		allowed bool
+	}{
+		{"No limit", Limits{}, "a.b.c.d.e.f.example.co.uk", true},
+		{"Length", Limits{MaxLength: 16}, "www.example.co.uk", false},
+		{"Length allowed", Limits{MaxLength: 17}, "www.example.co.uk", true},
+		{"Labels", Limits{MaxLabels: 3}, "www.example.co.uk", false},
+		{"Labels allowed", Limits{MaxLabels: 4}, "www.example.co.uk", true},
+		{"Work", Limits{MaxWork: 3}, "www.example.co.uk", false},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			var l = NewList(WithLimits(tt.limits))
+
+			var _, err = l.EffectiveTLDPlusOne(tt.domain)
+			if tt.allowed && err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var domainError *DomainError
+			if !tt.allowed && (!errors.Is(err, ErrLimitExceeded) || !errors.As(err, &domainError)) {
+				t.Fatalf("got: %v, want: a *DomainError matching %v", err, ErrLimitExceeded)
+			}
+
+			if _, err := l.Lookup(tt.domain); (err == nil) != tt.allowed {
+				t.Fatalf("got: %v, want allowed: %v", err, tt.allowed)
+			}
+
+			var suffix, _ = l.PublicSuffix(tt.domain)
+			if (suffix == "co.uk") != tt.allowed {
+				t.Fatalf("got: %q, want allowed: %v", suffix, tt.allowed)
+			}
+		})
+	}
+}
+
+func Test_WithLimitsPatterns(t *testing.T) {
+	var l, err = ParseList(strings.NewReader("example\na.*.example\nb.*.example\n"), "limits_test", WithLimits(Limits{MaxWork: 9}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// each label is compared with a rule and the two patterns
+	if _, err := l.EffectiveTLDPlusOne("x.y.example"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err := l.EffectiveTLDPlusOne("w.x.y.example"); !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("got: %v, want: %v", err, ErrLimitExceeded)
+	}
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// IssueKind is the kind of problem reported by LintList and CheckRules.
+type IssueKind int
+
+const (
+	// IssueInvalid is a line which isn't a valid rule.
+	IssueInvalid IssueKind = iota
+	// IssueDuplicate is a rule listed more than once.
+	IssueDuplicate
+	// IssueSection is a rule outside of the section markers or in the wrong
+	// section, such as a TLD in the private section, or a misplaced marker.
+	IssueSection
+	// IssueNotCanonical is a rule which isn't in canonical form: lower case,
+	// with its internationalised labels either Punycode encoded or written in
+	// Unicode like in the upstream list, e.g. "рф" but not "РФ".
+	IssueNotCanonical
+	// IssueUncoveredException is an exception rule without a wildcard rule
+	// it is an exception to.
+	IssueUncoveredException
+	// IssueOrder is a rule out of order within its group of consecutive
+	// rules, which are sorted label by label from the TLD so that a rule comes
+	// right before the rules below it, see FormatList. It is only reported with
+	// the CheckOrder option.
+	IssueOrder
+	// IssueShadowed is a normal rule matched by a wildcard rule, e.g.
+	// "foo.kobe.jp" with "*.kobe.jp", which has no effect on lookups. It is
+	// only reported by CheckRules.
+	IssueShadowed
+)
+
+// String returns "invalid", "duplicate", "section", "not canonical",
+// "uncovered exception", "order" or "shadowed".
+func (k IssueKind) String() string {
+	switch k {
+	case IssueInvalid:
+		return "invalid"
+	case IssueDuplicate:
+		return "duplicate"
+	case IssueSection:
+		return "section"
+	case IssueNotCanonical:
+		return "not canonical"
+	case IssueUncoveredException:
+		return "uncovered exception"
+	case IssueOrder:
+		return "order"
+	case IssueShadowed:
+		return "shadowed"
+	default:
+		return fmt.Sprintf("IssueKind(%d)", int(k))
+	}
+}
+
+// Issue is a problem found by LintList or CheckRules.
+type Issue struct {
+	// Line is the number of the offending line, starting at 1.
+	Line int
+	// Kind is the kind of problem.
+	Kind IssueKind
+	// Rule is the offending line, or rule, as written in the list.
+	Rule string
+	// Message describes the problem.
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("line %d: %s: %s", i.Line, i.Kind, i.Message)
+}
+
+// Section markers of the public suffix list.
+const (
+	icannBeginMarker   = "// ===BEGIN ICANN DOMAINS==="
+	icannEndMarker     = "// ===END ICANN DOMAINS==="
+	privateBeginMarker = "// ===BEGIN PRIVATE DOMAINS==="
+	privateEndMarker   = "// ===END PRIVATE DOMAINS==="
+)
+
+// LintList checks r, a file in the format of the public suffix list such as an
+// internal overlay, and returns the problems found ordered by line: invalid,
+// duplicate or non canonical rules, rules outside of their section and
+// exception rules no wildcard rule covers. An error reading r is reported as
+// an IssueInvalid.
+//
+// With the CheckOrder option, the rules out of order within their group of
+// consecutive lines are reported too. The upstream list doesn't sort its
+// groups, so only lists written by FormatList pass this check.
+func LintList(r io.Reader, opts ...Option) []Issue {
+	var o = newOptions(opts)
+
+	var issues []Issue
+	var report = func(line int, kind IssueKind, rule string, format string, args ...interface{}) {
+		issues = append(issues, Issue{Line: line, Kind: kind, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var section Section
+	var inSection bool
+	var seen = map[string]int{}
+	var covering []rule
+	var exceptions []rawRule
+
+	// previous is the previous rule of the current group of consecutive rules
+	var previous string
+
+	var scanner = bufio.NewScanner(r)
+	var number int
+	for scanner.Scan() {
+		number++
+		var line = strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "//") {
+			previous = ""
+
+			switch line {
+			case icannBeginMarker, privateBeginMarker:
+				if inSection {
+					report(number, IssueSection, line, "section started before the end of the %s section", section)
+				}
+				section, inSection = ICANNSection, true
+				if line == privateBeginMarker {
+					section = PrivateSection
+				}
+			case icannEndMarker, privateEndMarker:
+				var ending = ICANNSection
+				if line == privateEndMarker {
+					ending = PrivateSection
+				}
+				if !inSection || section != ending {
+					report(number, IssueSection, line, "end of the %s section which wasn't started", ending)
+				}
+				inSection = false
+			}
+			continue
+		}
+
+		var _, parsed, err = parseRule(line, section == ICANNSection)
+		if err != nil {
+			report(number, IssueInvalid, line, "%s", err.Error())
+			continue
+		}
+
+		if canonical := canonicalRule(line, parsed.DottedName); canonical != line {
+			report(number, IssueNotCanonical, line, "rule %q should be written %q", line, canonical)
+		}
+
+		if first, found := seen[parsed.DottedName]; found {
+			report(number, IssueDuplicate, line, "rule %q already listed on line %d", line, first)
+		} else {
+			seen[parsed.DottedName] = number
+		}
+
+		switch {
+		case !inSection:
+			report(number, IssueSection, line, "rule %q outside of the sections", line)
+		case section == PrivateSection && !strings.Contains(parsed.DottedName, "."):
+			report(number, IssueSection, line, "TLD %q in the private section", line)
+		}
+
+		if o.checkOrder && previous != "" && ruleLess(parsed.DottedName, previous) {
+			report(number, IssueOrder, line, "rule %q should come before %q", line, previous)
+		}
+		previous = parsed.DottedName
+
+		if parsed.RuleType == exception {
+			exceptions = append(exceptions, rawRule{line: parsed.DottedName, number: number})
+		} else if strings.Contains(parsed.DottedName, "*") {
+			covering = append(covering, parsed)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		report(number+1, IssueInvalid, "", "error while reading the list: %s", err.Error())
+	}
+
+	for _, raw := range exceptions {
+		if !coveredException(raw.line[1:], covering) {
+			report(raw.number, IssueUncoveredException, raw.line, "no wildcard rule covers exception rule %q", raw.line)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Line < issues[j].Line
+	})
+
+	return issues
+}
+
+// canonicalRule returns the canonical form of line, a rule parsed as name. It
+// is name, unless line writes internationalised labels in Unicode like the
+// upstream list: these are then kept in Unicode, mapped to lower case as by
+// Normalize, e.g. "рф" for "РФ".
+func canonicalRule(line, name string) string {
+	var prefix string
+	if strings.HasPrefix(line, "!") {
+		prefix, line = "!", line[1:]
+	}
+
+	var labels = strings.Split(line, ".")
+	for i, label := range labels {
+		if label == "*" || isASCII(label) {
+			continue
+		}
+
+		var ascii, err = lookupToASCII(label)
+		if err != nil {
+			return name
+		}
+		if labels[i], err = toUnicode(ascii); err != nil {
+			return name
+		}
+	}
+
+	if canonical := prefix + strings.Join(labels, "."); canonical != prefix+line {
+		return canonical
+	}
+	if !isASCII(line) {
+		return prefix + line
+	}
+
+	return name
+}
+
+// isASCII reports whether s only holds ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkRules reports the duplicate rules of ri, and its normal rules shadowed
+// by a wildcard rule, to warn in the order of their lines, see CheckRules.
+func checkRules(ri *rulesInfo, warn func(Issue)) {
+	var rules, covering []rule
+	for _, list := range ri.Map {
+		for _, r := range list {
+			rules = append(rules, r)
+			if r.RuleType != exception && strings.Contains(r.DottedName, "*") {
+				covering = append(covering, r)
+			}
+		}
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Line != rules[j].Line {
+			return rules[i].Line < rules[j].Line
+		}
+
+		return rules[i].DottedName < rules[j].DottedName
+	})
+
+	var seen = map[string]int{}
+	for _, r := range rules {
+		var name = r.public().Unicode
+
+		if first, found := seen[r.DottedName]; found {
+			warn(Issue{Line: r.Line, Kind: IssueDuplicate, Rule: name, Message: fmt.Sprintf("rule %q already listed on line %d", name, first)})
+			continue
+		}
+		seen[r.DottedName] = r.Line
+
+		if r.RuleType != normal || strings.Contains(r.DottedName, "*") {
+			continue
+		}
+
+		for _, w := range covering {
+			if coveredException(r.DottedName, []rule{w}) {
+				warn(Issue{Line: r.Line, Kind: IssueShadowed, Rule: name, Message: fmt.Sprintf("rule %q is shadowed by the wildcard rule %q", name, w.DottedName)})
+				break
+			}
+		}
+	}
+}
+
+// logIssue is the default warning of CheckRules.
+func logIssue(issue Issue) {
+	log.Printf("publicsuffix: %s", issue)
+}
+
+// coveredException reports whether name, the name of an exception rule without
+// its "!", is matched by one of the wildcard rules.
+func coveredException(name string, wildcards []rule) bool {
+	var labels = strings.Count(name, ".")
+	for _, rule := range wildcards {
+		if strings.Count(rule.DottedName, ".") == labels && matchPattern(name, rule.DottedName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleLess reports whether the rule named a comes before the rule named b in
+// the canonical order of the rules of a group: by label from the TLD, so that
+// a rule comes right before the rules below it, ignoring the mark of the
+// exception rules.
+func ruleLess(a, b string) bool {
+	a, b = strings.TrimPrefix(a, "!"), strings.TrimPrefix(b, "!")
+
+	for {
+		var adot, bdot = strings.LastIndex(a, "."), strings.LastIndex(b, ".")
+		if a[adot+1:] != b[bdot+1:] {
+			return a[adot+1:] < b[bdot+1:]
+		}
+
+		switch {
+		case adot == -1:
+			return bdot != -1
+		case bdot == -1:
+			return false
+		}
+
+		a, b = a[:adot], b[:bdot]
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_LintList(t *testing.T) {
+	if unsupportedIDN("рф") {
+		t.Skip("internationalised domain names aren't supported")
+	}
+
+	var list = `outside.example
+// ===BEGIN ICANN DOMAINS===
+jp
+ac.jp
+kobe.jp
+*.kobe.jp
+!city.kobe.jp
+!www.osaka.jp
+co.jp
+b.jp
+ac.jp
+РФ
+Bad Rule
+// ===END ICANN DOMAINS===
+
+// ===BEGIN PRIVATE DOMAINS===
+example
+blogspot.jp
+// ===END ICANN DOMAINS===
+`
+
+	var want = []Issue{
+		{Line: 1, Kind: IssueSection, Rule: "outside.example"},
+		{Line: 8, Kind: IssueUncoveredException, Rule: "!www.osaka.jp"},
+		{Line: 9, Kind: IssueOrder, Rule: "co.jp"},
+		{Line: 10, Kind: IssueOrder, Rule: "b.jp"},
+		{Line: 11, Kind: IssueDuplicate, Rule: "ac.jp"},
+		{Line: 11, Kind: IssueOrder, Rule: "ac.jp"},
+		{Line: 12, Kind: IssueNotCanonical, Rule: "РФ"},
+		{Line: 13, Kind: IssueInvalid, Rule: "Bad Rule"},
+		{Line: 17, Kind: IssueSection, Rule: "example"},
+		{Line: 19, Kind: IssueSection, Rule: "// ===END ICANN DOMAINS==="},
+	}
+
+	var issues = LintList(strings.NewReader(list), CheckOrder())
+	for i := range issues {
+		if issues[i].Message == "" {
+			t.Fatalf("missing message: %+v", issues[i])
+		}
+		issues[i].Message = ""
+	}
+
+	if !reflect.DeepEqual(issues, want) {
+		t.Fatalf("got: %+v, want: %+v", issues, want)
+	}
+
+	if issues := LintList(strings.NewReader(rulesTestList)); len(issues) != 0 {
+		t.Fatalf("got: %v, want: no issue", issues)
+	}
+
+	// the order isn't checked by default, and Unicode rules are canonical like
+	// in the upstream list
+	var upstream = "// ===BEGIN ICANN DOMAINS===\nrf\nрф\nac.рф\nb.jp\na.jp\n// ===END ICANN DOMAINS===\n"
+	if issues := LintList(strings.NewReader(upstream)); len(issues) != 0 {
+		t.Fatalf("got: %v, want: no issue", issues)
+	}
+}
+
+func Test_RuleLess(t *testing.T) {
+	var ordered = []string{"jp", "ac.jp", "kobe.jp", "*.kobe.jp", "a.b.kobe.jp", "!city.kobe.jp", "www.kobe.jp", "uk"}
+
+	for i := range ordered {
+		for j := range ordered {
+			if got := ruleLess(ordered[i], ordered[j]); got != (i < j) {
+				t.Fatalf("%s < %s got: %v, want: %v", ordered[i], ordered[j], got, i < j)
+			}
+		}
+	}
+}
@@ -1,7 +1,12 @@
 // Code generated by publicsuffix/gen.go; DO NOT EDIT
 
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
 package publicsuffix
 
 var initialRelease = `22a461ea3f7b5563f6cef218f9ec9cd19c616d33`
 
+var initialDate = ``
+
 var listBytes = []byte{0x78, 0x9C, 0xA4, 0xBD, 0x41, 0xB3, 0xE4, 0xAC, 0x92, 0x36, 0xF6, 0x5B, 0x7C, 0x97, 0x6F, 0x4C, 0x11, 0xB7, 0xFB, 0xBD, 0xF3, 0xCE, 0x7C, 0xB3, 0x73, 0xD8, 0x1B, 0x2F, 0xFC, 0x2D, 0x1C, 0xDE, 0x39, 0xBC, 0x40, 0x12, 0x25, 0x71, 0x24, 0x40, 0xD, 0xA8, 0x4E, 0x57, 0x4D, 0xF8, 0xBF, 0x3B, 0x40, 0x52, 0x95, 0x4E, 0x77, 0xA6, 0xF2, 0xA9, 0x7B, 0x37, 0x7D, 0x3A, 0xF4, 0x64, 0x3E, 0x85, 0x50, 0x92, 0x40, 0x2, 0xC9, 0x7F, 0xFF, 0xED, 0xFF, 0xD4, 0xF3, 0xDF, 0xFE, 0xEB, 0xBF, 0xFF, 0xF6, 0xF7, 0xBF, 0x7F, 0xFB, 0xFC, 0xFC, 0x6C, 0x83, 0xFB, 0xDB, 0x7F, 0xFD, 0x3F, 0xFF, 0xFD, 0xB7, 0xFF, 0x3D, 0xE4, 0x6C, 0xBA, 0xFF, 0xA9, 0x9D, 0xF9, 0xDB, 0x7F, 0x6D, 0x90, 0x2A, 0xD8, 0xBF, 0xFD, 0xED, 0xFF, 0x5A, 0x26, 0xF3, 0x7F, 0xDF, 0x67, 0xF3, 0xB7, 0xFF, 0xFA, 0xFB, 0xBF, 0xFD, 0xED, 0xFF, 0xF8, 0xDF, 0xFE, 0xD7, 0xFF, 0xF9, 0x3F, 0xFF, 0xF6, 0x5F, 0x57, 0x3D, 0x25, 0xF3, 0xFF, 0xFD, 0xBF, 0xFF, 0xF6, 0xB7, 0xBF, 0x37, 0x3D, 0xA1, 0xAE, 0x9A, 0x9E, 0x51, 0xCC, 0x71, 0x59, 0xF5, 0x8C, 0x73, 0xE4, 0x4F, 0xFF, 0xA1, 0xA, 0xF4, 0xDB, 0x4F, 0x7F, 0x23, 0x7E, 0xDA, 0xDC, 0x5A, 0xE2, 0xB7, 0x8D, 0xBA, 0xB5, 0x52, 0xA9, 0xBF, 0x7D, 0x1F, 0x66, 0x9D, 0x7F, 0x57, 0x2E, 0xCF, 0x95, 0xCE, 0x88, 0x7A, 0x3B, 0x30, 0xEA, 0xED, 0x80, 0xA8, 0x77, 0x86, 0x51, 0xEF, 0x8C, 0xA8, 0xFE, 0xE7, 0x9F, 0xFF, 0x31, 0xDB, 0x36, 0x2F, 0xD1, 0x24, 0x82, 0xE4, 0xCF, 0x3F, 0xFF, 0x43, 0x3D, 0x61, 0x89, 0xEA, 0xAF, 0x4B, 0x63, 0xA9, 0x7A, 0xF8, 0xEB, 0xD2, 0x28, 0x2B, 0xD7, 0x3, 0xF5, 0xF1, 0xBF, 0xC9, 0x1F, 0xFF, 0xDB, 0xA8, 0xE7, 0x99, 0xFC, 0xFA, 0x15, 0x41, 0xEC, 0xEE, 0xFB, 0xDF, 0xFF, 0xFE, 0xF7, 0x61, 0xF9, 0x9D, 0xA0, 0x3C, 0x57, 0xC3, 0x22, 0x14, 0xE0, 0xFB, 0xDF, 0xFF, 0xFC, 0x4F, 0x1B, 0x28, 0xED, 0x3F, 0xFF, 0x53, 0xD9, 0x20, 0xFE, 0x38, 0xF5, 0xDE, 0xDF, 0xE5, 0xF7, 0xFE, 0x6E, 0x7F, 0x52, 0x56, 0xF7, 0xDD, 0xFE, 0x4, 0x8C, 0xEE, 0xBB, 0xFD, 0x49, 0xD9, 0x5C, 0x51, 0x96, 0x4D, 0xEE, 0xBB, 0xFD, 0x49, 0x59, 0x5C, 0x51, 0x96, 0xD, 0xEE, 0xCF, 0xEF, 0xB4, 0x95, 0x94, 0xE7, 0x80, 0x95, 0xFC, 0x49, 0xD5, 0xD6, 0x9F, 0x72, 0x6D, 0xFD, 0xB9, 0x64, 0x3B, 0xD9, 0x6C, 0x4D, 0x22, 0x4D, 0xE5, 0x5, 0x23, 0xF6, 0xF2, 0xF, 0xAA, 0x10, 0xFF, 0x90, 0xB, 0xF1, 0x8F, 0xC9, 0x3A, 0x4D, 0x7D, 0xB4, 0xA, 0x0, 0x9F, 0xAD, 0xCA, 0x51, 0x1F, 0x6E, 0x25, 0x90, 0x3F, 0x5D, 0x95, 0xA3, 0x3E, 0xDE, 0x4A, 0x20, 0x7F, 0xBE, 0x7F, 0x2C, 0x64, 0xFD, 0xFD, 0x63, 0x41, 0xEA, 0xED, 0xDF, 0xA9, 0x7A, 0xFB, 0x77, 0xB9, 0xDE, 0xFE, 0xFA, 0xF6, 0x2D, 0x13, 0xD, 0xEC, 0xAF, 0x6F, 0xDF, 0x54, 0x16, 0xDB, 0xD7, 0x5F, 0xFF, 0xA0, 0xD, 0xAE, 0x3C, 0x7, 0xC, 0xEE, 0x2F, 0xAA, 0xCC, 0x7F, 0xC9, 0x65, 0xFE, 0xF, 0x4A, 0xEF, 0x3F, 0x64, 0xBD, 0xFF, 0xA4, 0xF4, 0xFE, 0x53, 0xD6, 0xFB, 0x1F, 0x94, 0xDE, 0xFF, 0x0, 0xF4, 0xFA, 0x45, 0xB7, 0x4B, 0x13, 0x9, 0xE5, 0x8A, 0xA8, 0x26, 0xA, 0xC, 0x5A, 0xEB, 0xDF, 0x95, 0xCB, 0x43, 0x51, 0x6D, 0x8E, 0xC4, 0x57, 0xD5, 0x5A, 0xAB, 0x2, 0x48, 0xDA, 0x9E, 0xD4, 0x55, 0x5E, 0xD6, 0x8C, 0x4D, 0x88, 0xD9, 0xD0, 0xFA, 0x2B, 0x6, 0xB1, 0xCC, 0xA4, 0xFE, 0x2C, 0x29, 0x36, 0x3A, 0x66, 0xA2, 0x5, 0x6F, 0xCF, 0x45, 0xE5, 0x34, 0xD8, 0x68, 0x87, 0x30, 0x8E, 0xDA, 0x76, 0xE1, 0x83, 0x2A, 0xC3, 0x26, 0xA3, 0x76, 0x21, 0xF5, 0x21, 0x97, 0xA9, 0xA1, 0x78, 0x1A, 0x59, 0x2D, 0x64, 0xA2, 0x65, 0x6D, 0xCF, 0x45, 0xE5, 0x9B, 0x25, 0x1C, 0xD1, 0xF6, 0x5C, 0x52, 0x26, 0x6, 0x6B, 0xE5, 0xA1, 0xA8, 0x46, 0x99, 0x6A, 0xA3, 0x5A, 0xD1, 0x58, 0x9B, 0x96, 0x6A, 0x22, 0xBA, 0x69, 0x81, 0xF6, 0xD1, 0x18, 0x1F, 0x42, 0xD2, 0xA3, 0xA6, 0x3F, 0x97, 0xF1, 0x41, 0x55, 0x18, 0xF9, 0x50, 0x44, 0x23, 0xD7, 0x72, 0x23, 0xD7, 0x8D, 0x1D, 0x43, 0x3B, 0xD8, 0x86, 0x29, 0x82, 0x1D, 0x83, 0xAA, 0x30, 0x52, 0x4, 0x1B, 0xF5, 0xB9, 0xFD, 0xD9, 0xA8, 0xDF, 0x32, 0xBE, 0x71, 0xD0, 0xF, 0xAB, 0x13, 0x31, 0x0, 0xDB, 0x31, 0x95, 0xB8, 0x41, 0xD8, 0xD3, 0x4F, 0xEB, 0x66, 0x22, 0xAD, 0x69, 0x92, 0x6D, 0x29, 0xF4, 0xBA, 0xA3, 0x9C, 0xC1, 0x6, 0x88, 0xEA, 0x33, 0x69, 0x54, 0x41, 0xCD, 0xB2, 0x55, 0x45, 0xAA, 0x67, 0xD2, 0x4D, 0xE4, 0x3B, 0xA6, 0x83, 0xEE, 0xF2, 0x78, 0x4, 0x46, 0xBF, 0x40, 0x8, 0xC7, 0xD2, 0xD, 0xBA, 0xB1, 0x14, 0xC5, 0x86, 0x88, 0x4, 0x77, 0xED, 0x74, 0xBF, 0xB4, 0x83, 0xA5, 0x6D, 0x61, 0x51, 0x4F, 0x1, 0xC0, 0x14, 0xA8, 0x56, 0x2D, 0x36, 0xEA, 0x56, 0x77, 0xC6, 0x59, 0xDD, 0x50, 0x9F, 0x70, 0xC3, 0x54, 0x23, 0x7E, 0xC6, 0x2A, 0x79, 0xE7, 0x28, 0xEE, 0x98, 0xBA, 0x5B, 0x92, 0x59, 0x88, 0xE1, 0xD1, 0x6, 0xAB, 0xD, 0x17, 0xB9, 0x28, 0x4B, 0x6E, 0x95, 0x16, 0x4D, 0xB9, 0x65, 0xBA, 0xD4, 0x16, 0xEA, 0x52, 0x5B, 0x6A, 0x68, 0x5A, 0x7E, 0x57, 0xB4, 0xA3, 0xB6, 0xA1, 0x4B, 0xDC, 0xC8, 0x25, 0x6E, 0x8D, 0x2F, 0xB3, 0x4B, 0x4A, 0x7D, 0x87, 0x64, 0x8A, 0x94, 0x5A, 0xED, 0x42, 0xA4, 0x9C, 0xE3, 0x8E, 0xAA, 0x2, 0x4B, 0x3E, 0xA4, 0x6D, 0xA9, 0xB6, 0xD0, 0xAA, 0x56, 0x6C, 0x8, 0x6D, 0x6B, 0x3B, 0xE3, 0xF3, 0xC5, 0xFA, 0x9B, 0x49, 0xD9, 0xF6, 0x3A, 0xDB, 0xE0, 0xB5, 0xA1, 0xBF, 0x6, 0x25, 0xAB, 0xAA, 0x30, 0xF8, 0x2B, 0x73, 0x34, 0x37, 0xE3, 0x81, 0x9F, 0x78, 0x9, 0x82, 0xFC, 0x9E, 0x7E, 0x7F, 0x2F, 0x6B, 0x86, 0xC5, 0x67, 0xED, 0x49, 0xB, 0x7A, 0x62, 0x28, 0x9, 0x11, 0x8A, 0x38, 0x82, 0x22, 0xD, 0xD5, 0x55, 0xB7, 0xAA, 0x15, 0x7B, 0xEA, 0xB6, 0xCD, 0x4C, 0x3, 0x6A, 0x33, 0xD4, 0x82, 0x5A, 0xD2, 0x83, 0xA8, 0x56, 0xF6, 0x1F, 0xD7, 0xF, 0x52, 0xF3, 0xFA, 0x21, 0x6A, 0xF6, 0xF4, 0x37, 0xEB, 0xE5, 0x6F, 0xD6, 0x87, 0x1B, 0x39, 0xAA, 0x69, 0x55, 0x1F, 0x6E, 0xC0, 0xC0, 0xA6, 0x1D, 0xAC, 0xD7, 0xBD, 0xF6, 0xF4, 0x38, 0xA0, 0xB8, 0xFC, 0x15, 0x46, 0x3C, 0xBF, 0xED, 0xC8, 0x82, 0xD8, 0x4E, 0xD6, 0x9C, 0x68, 0xCD, 0x49, 0xD6, 0x24, 0x5D, 0xB5, 0xB2, 0xB2, 0x87, 0xB6, 0x74, 0x9D, 0x5B, 0xB9, 0xCE, 0x2D, 0x5D, 0xE1, 0x56, 0xAE, 0x6C, 0xBA, 0x92, 0x91, 0xBA, 0x1D, 0x69, 0xEF, 0x3C, 0xCA, 0xAE, 0x75, 0xA4, 0x4B, 0x3B, 0xCA, 0xA5, 0x9D, 0x4C, 0xCF, 0xD8, 0xD6, 0x64, 0x7A, 0xDE, 0xB6, 0xE, 0xBE, 0x78, 0x1A, 0x69, 0xF5, 0x51, 0xFE, 0x6D, 0xD2, 0x7F, 0xA8, 0x49, 0xF6, 0x1C, 0x8E, 0x1A, 0xCA, 0xB5, 0xCA, 0x89, 0x23, 0xB9, 0xD6, 0xD1, 0x75, 0xEC, 0xE4, 0x3A, 0x76, 0xD4, 0xC0, 0xB7, 0x55, 0x4E, 0x8A, 0x3B, 0xEA, 0xD6, 0x7D, 0xD2, 0x9A, 0x9F, 0xB2, 0xE6, 0x83, 0xD6, 0x7C, 0x88, 0x9A, 0x9E, 0xEE, 0x21, 0xBD, 0xDC, 0x43, 0x7A, 0xFA, 0x37, 0xBD, 0xFC, 0x9B, 0xA4, 0x47, 0x96, 0x9D, 0x31, 0x39, 0x2E, 0x6F, 0x81, 0x61, 0x79, 0x3B, 0xD3, 0x96, 0x3B, 0xCB, 0x66, 0x1F, 0x69, 0xD3, 0x8B, 0xB2, 0xE9, 0x45, 0xDA, 0xC, 0xA2, 0x3C, 0xF3, 0x69, 0x23, 0x6D, 0x7, 0x51, 0xB6, 0x83, 0x44, 0x5B, 0x6D, 0x92, 0xAD, 0x36, 0xD1, 0x5F, 0x33, 0xC9, 0x5F, 0x33, 0x6B, 0xF2, 0x4D, 0xB3, 0xD2, 0xB2, 0xC5, 0x67, 0xD3, 0x2D, 0x9C, 0xBA, 0xE9, 0x16, 0x88, 0x82, 0xA, 0xBE, 0xB4, 0x4A, 0x8E, 0xBD, 0xB4, 0x99, 0xEE, 0xA2, 0xB3, 0xDC, 0x45, 0xE7, 0x40, 0x1A, 0x54, 0x79, 0x2C, 0xAA, 0xD2, 0xF5, 0x9C, 0xE5, 0x7A, 0x5E, 0xC8, 0xE1, 0xB0, 0x5A, 0xC4, 0x60, 0x41, 0xBB, 0xD0, 0x9E, 0x77, 0x91, 0x3D, 0xEF, 0x8D, 0xEE, 0x17, 0x6F, 0x72, 0xBF, 0xF8, 0xA0, 0x1B, 0xEB, 0x43, 0x6E, 0xAC, 0xF, 0xBA, 0x17, 0x7F, 0xC8, 0xBD, 0xF8, 0x83, 0x6E, 0x37, 0xF, 0xB1, 0xDD, 0x50, 0x63, 0x15, 0x71, 0xA0, 0xD2, 0x91, 0xD3, 0xDB, 0x4E, 0x9E, 0xE0, 0x76, 0x65, 0x28, 0x95, 0xC3, 0x78, 0xA7, 0xC7, 0x59, 0x15, 0x56, 0x15, 0x7, 0x6, 0x3, 0x9D, 0x75, 0xA1, 0xD, 0xE4, 0x17, 0x2E, 0x90, 0x6A, 0x3, 0xFF, 0x9D, 0x5F, 0x2E, 0xA7, 0xA3, 0x4B, 0x82, 0xFC, 0xBE, 0x23, 0x87, 0x5, 0x9D, 0x3, 0xC6, 0x9B, 0x5D, 0x68, 0x8C, 0x36, 0xAE, 0x9D, 0xC2, 0xD2, 0x91, 0xCB, 0x10, 0x5F, 0x24, 0x90, 0x15, 0x89, 0x2F, 0xA, 0xDE, 0x50, 0xB3, 0x97, 0x2F, 0x94, 0x45, 0x44, 0xA4, 0xA4, 0xBA, 0x80, 0x4E, 0xEC, 0x0, 0xBA, 0x65, 0x22, 0x7F, 0xBE, 0x3C, 0x6, 0x54, 0x7, 0x4E, 0x59, 0xD, 0xB2, 0x3E, 0x3D, 0xD, 0xE8, 0x90, 0x39, 0x40, 0x77, 0xB3, 0x29, 0xC4, 0x4F, 0xE2, 0x9D, 0xFF, 0x50, 0x1B, 0xA8, 0x3E, 0xBF, 0xBE, 0x3C, 0xB1, 0xFC, 0xAE, 0xBB, 0x1B, 0x39, 0x24, 0xE9, 0x6E, 0xC0, 0x98, 0xA4, 0xBB, 0xF7, 0xE6, 0xAE, 0xC9, 0xFE, 0x73, 0x85, 0x90, 0x4E, 0x74, 0x95, 0x24, 0x83, 0x90, 0x1B, 0x9, 0x10, 0x83, 0xA4, 0x7A, 0x53, 0xB1, 0x2B, 0x35, 0x94, 0x9F, 0x36, 0xA2, 0x97, 0x36, 0x1F, 0xD1, 0xD2, 0x8B, 0x18, 0x15, 0x1, 0x96, 0x30, 0xC, 0x1D, 0x31, 0x31, 0x50, 0xA8, 0x84, 0x8E, 0x3B, 0x0, 0x31, 0x6, 0x13, 0x43, 0xA3, 0xB3, 0x6D, 0x79, 0x86, 0xA, 0x43, 0xF1, 0xA, 0x13, 0x43, 0x3B, 0x2D, 0xD, 0x4F, 0x55, 0x50, 0x94, 0xA9, 0x8B, 0xC1, 0x19, 0x9E, 0xAA, 0xC2, 0x28, 0x97, 0xBB, 0xD1, 0x24, 0xCA, 0xDD, 0x0, 0xED, 0x39, 0xC4, 0x7C, 0xA5, 0x5A, 0xE4, 0x86, 0xA9, 0xAB, 0xD8, 0x2C, 0x4D, 0xA4, 0x97, 0x66, 0x4A, 0x19, 0xE4, 0xB5, 0x19, 0x93, 0x3D, 0xD5, 0x1D, 0xD7, 0xC7, 0x82, 0xEA, 0x95, 0xD0, 0xBB, 0x8A, 0x4A, 0xDA, 0xD9, 0xE9, 0xDE, 0x6, 0x37, 0x6B, 0x4F, 0x45, 0x50, 0xBE, 0xE2, 0x12, 0xD9, 0xD5, 0x7A, 0x9B, 0xEF, 0x53, 0x61, 0x88, 0xF7, 0x10, 0x7B, 0xB2, 0xB3, 0xFB, 0x2A, 0x55, 0x4C, 0x1E, 0xE9, 0xF7, 0xAE, 0x1F, 0x21, 0x76, 0x64, 0xA3, 0xAB, 0x8, 0xD0, 0xE8, 0xAE, 0x54, 0xB4, 0xE2, 0x2A, 0xC6, 0x2A, 0xAE, 0xD1, 0x92, 0x6B, 0x57, 0xEB, 0x73, 0x44, 0x99, 0xEE, 0x30, 0x2B, 0x4, 0xF5, 0x94, 0x94, 0xAB, 0x10, 0x9D, 0x54, 0xAF, 0xC7, 0x41, 0x53, 0x63, 0xC2, 0xD, 0x10, 0xD5, 0xBD, 0xB5, 0xBD, 0xCE, 0xF4, 0xB2, 0x55, 0xAF, 0xD5, 0x6, 0x3, 0xE3, 0x8E, 0x5E, 0xFB, 0x70, 0x4E, 0xE6, 0xC3, 0x3B, 0x74, 0x9D, 0xF1, 0x26, 0x91, 0x96, 0xB0, 0x42, 0x80, 0x29, 0xF4, 0xC6, 0xE9, 0x9C, 0x96, 0x93, 0x10, 0xDA, 0x26, 0x81, 0x87, 0xD1, 0x7A, 0xE3, 0xC9, 0x10, 0xE4, 0xFA, 0x5C, 0x56, 0xCE, 0x89, 0x71, 0x80, 0x15, 0x83, 0xBC, 0x5F, 0x4F, 0x2E, 0x49, 0xF5, 0xC0, 0x6A, 0x54, 0x1F, 0x75, 0xA4, 0xB6, 0x61, 0x55, 0x40, 0xDE, 0x87, 0xA5, 0xFB, 0x48, 0xE, 0x63, 0xFA, 0x8, 0xC, 0x63, 0xFA, 0x68, 0xDB, 0x65, 0xCA, 0x4B, 0x34, 0xEC, 0x1A, 0xCE, 0x4B, 0x4, 0x5C, 0xC7, 0x29, 0xA, 0xE4, 0xB4, 0xA6, 0x0, 0xC0, 0xCC, 0xA6, 0x8F, 0xB6, 0x54, 0x3B, 0xBD, 0xC6, 0xB7, 0x83, 0x50, 0xBD, 0x6, 0x72, 0x71, 0xAC, 0x8F, 0x1, 0x58, 0x18, 0xEB, 0x63, 0x98, 0x29, 0x9F, 0x55, 0xB4, 0x67, 0xD1, 0x6F, 0xF5, 0x8B, 0xB7, 0x61, 0xB4, 0x5E, 0x7F, 0x32, 0xCD, 0x6E, 0xF1, 0x56, 0x6D, 0x2, 0x80, 0x7D, 0xF, 0xE4, 0x2, 0xC5, 0x0, 0x2C, 0x50, 0xC, 0x64, 0x5B, 0x1D, 0x80, 0x66, 0x4A, 0x5, 0xBC, 0xC4, 0x68, 0x97, 0x6D, 0x4C, 0x4E, 0xCB, 0xE9, 0x3A, 0xF9, 0x2A, 0xF2, 0xCE, 0x4A, 0xB9, 0xE5, 0x96, 0x59, 0x2D, 0xB6, 0xBC, 0x6A, 0x3B, 0xF2, 0x4B, 0xDA, 0xE, 0xF8, 0x90, 0x96, 0xF2, 0xFF, 0x56, 0xEC, 0x0, 0xEC, 0xA8, 0x3F, 0xF5, 0xA8, 0x8B, 0x3, 0x63, 0x2C, 0xA0, 0x4A, 0xA8, 0x5D, 0x4, 0x79, 0xB, 0xAF, 0xBD, 0x19, 0xAC, 0x33, 0x34, 0x9D, 0xD7, 0x5E, 0x55, 0x18, 0xA1, 0xA, 0x76, 0xB8, 0x87, 0x9E, 0xF9, 0x3E, 0xC1, 0xAA, 0x8A, 0x22, 0x44, 0xB3, 0xA1, 0xC6, 0xFE, 0x76, 0x56, 0x46, 0x1C, 0xFF, 0xDB, 0x78, 0x49, 0x4B, 0xBC, 0x19, 0x3B, 0x4D, 0xDA, 0xB7, 0xDC, 0x8, 0xF4, 0x17, 0x29, 0xC8, 0x15, 0xDB, 0x78, 0xC9, 0xB1, 0x8C, 0x74, 0xDA, 0x4B, 0x1B, 0x7C, 0x8E, 0x61, 0xE2, 0xC9, 0x7F, 0x11, 0x4, 0xF9, 0x9B, 0x85, 0x9A, 0xEC, 0xAE, 0xCF, 0x45, 0xE5, 0x36, 0xEA, 0x6B, 0x66, 0x4B, 0x54, 0x51, 0xB0, 0x18, 0xD7, 0x10, 0x5B, 0xB2, 0xFE, 0x37, 0x44, 0x24, 0xE8, 0x17, 0x1D, 0x3B, 0xD6, 0xF3, 0x6F, 0x38, 0xE8, 0xF6, 0x6D, 0x9C, 0xAC, 0xE7, 0x3F, 0x63, 0x1, 0xC1, 0xD7, 0x3A, 0x29, 0x10, 0x5C, 0x96, 0x32, 0x5F, 0x60, 0xCB, 0x52, 0x27, 0x13, 0x58, 0x59, 0xB2, 0x21, 0x5D, 0x47, 0x7D, 0x2E, 0x2B, 0xAF, 0xC6, 0xC5, 0x96, 0x63, 0xC3, 0xB1, 0xA2, 0x24, 0x6D, 0x4F, 0x3C, 0x61, 0xD2, 0x56, 0xC1, 0xFE, 0x30, 0xD, 0x21, 0xD, 0xB6, 0x67, 0x1C, 0x53, 0x1A, 0x82, 0xAA, 0x30, 0x42, 0xF5, 0x58, 0x1A, 0xED, 0x7B, 0x73, 0x5D, 0xC6, 0x25, 0xD, 0xD6, 0x31, 0x94, 0x9B, 0x94, 0x7A, 0x8A, 0x61, 0xD4, 0xCE, 0x26, 0x9D, 0x83, 0xC8, 0xBD, 0x8A, 0xBD, 0x4F, 0x9E, 0x83, 0x48, 0xAC, 0x9E, 0x32, 0x18, 0x69, 0x71, 0xFA, 0x75, 0xEC, 0x2A, 0x16, 0xFA, 0x29, 0xF9, 0x56, 0xB9, 0x3F, 0xC8, 0x2D, 0xAB, 0xFA, 0x3, 0xD9, 0xAF, 0x3A, 0xCA, 0x7B, 0xD8, 0xC6, 0xB7, 0x77, 0xB1, 0x8D, 0xBA, 0xB7, 0xB5, 0xF0, 0x9E, 0xEE, 0x97, 0xA, 0xAE, 0x36, 0x1, 0x88, 0xCE, 0x7E, 0xEA, 0x30, 0xEA, 0xBB, 0x66, 0x6A, 0xAE, 0xA, 0xA8, 0x4D, 0x2, 0x22, 0x4C, 0xC3, 0x49, 0x67, 0x57, 0x61, 0xB8, 0xBB, 0x1B, 0x3B, 0x6A, 0x18, 0x56, 0x9E, 0x4A, 0x8A, 0xEB, 0x3E, 0x44, 0xBA, 0x8, 0x56, 0x55, 0x10, 0xF9, 0x7D, 0x1B, 0x17, 0x1F, 0xF8, 0x90, 0xF8, 0x8A, 0xC3, 0x31, 0xF1, 0x71, 0xFD, 0x72, 0x67, 0x7C, 0xAB, 0x5D, 0xE2, 0x84, 0x59, 0xD7, 0x7F, 0x18, 0xB6, 0xAC, 0xD5, 0xFA, 0x2F, 0x46, 0xC5, 0xB3, 0x0, 0xFA, 0xA3, 0x29, 0x1F, 0xFE, 0xD4, 0xD8, 0xAB, 0xC8, 0x5B, 0xC6, 0xEE, 0xC3, 0xB4, 0x84, 0x31, 0xD3, 0x9B, 0xB0, 0x9F, 0x28, 0x30, 0xB8, 0x1E, 0xC3, 0x89, 0x4D, 0x6, 0xDC, 0x20, 0xA3, 0x19, 0xB4, 0xF3, 0x74, 0x71, 0x56, 0xC, 0x29, 0x4C, 0xBE, 0x2F, 0x8D, 0xF5, 0x69, 0x24, 0xC3, 0xC0, 0x4F, 0x14, 0x89, 0x4, 0x8F, 0x8B, 0x37, 0xA3, 0xEE, 0x3, 0xEF, 0xFA, 0x8A, 0x84, 0x7A, 0x8A, 0x20, 0xEF, 0x48, 0x8E, 0xB5, 0x46, 0x25, 0xF, 0xB5, 0xA8, 0x9E, 0x5B, 0xEC, 0xB5, 0x27, 0xDD, 0x68, 0xA7, 0xD9, 0x21, 0xC8, 0xA, 0x83, 0xC3, 0x90, 0x49, 0xF, 0x46, 0x77, 0x1F, 0xB, 0xF9, 0x7D, 0x76, 0x10, 0xF8, 0x40, 0x93, 0xF6, 0xDD, 0x95, 0x9A, 0x8F, 0x15, 0x40, 0x5D, 0xC5, 0x59, 0xD9, 0xA4, 0xD3, 0x78, 0xF6, 0x4A, 0x69, 0x84, 0xDF, 0xA8, 0x35, 0xC9, 0x9B, 0xFC, 0x19, 0x22, 0x11, 0x5C, 0xFC, 0x43, 0x55, 0x5C, 0xED, 0x2, 0xD2, 0xE2, 0xC7, 0x64, 0x52, 0xD2, 0xBE, 0x8B, 0x56, 0x93, 0x53, 0xFD, 0x17, 0xC, 0x4C, 0xF6, 0x27, 0x93, 0x16, 0x4F, 0x87, 0x28, 0x57, 0x8, 0xA9, 0x66, 0xB3, 0xD0, 0x4B, 0x3, 0x93, 0x32, 0xB, 0xB4, 0x3A, 0x30, 0x5D, 0x75, 0xC, 0xCE, 0x90, 0xA5, 0xD8, 0x21, 0xA9, 0x10, 0xBD, 0x66, 0x42, 0xAD, 0x15, 0x41, 0x5E, 0x83, 0xDB, 0xDF, 0x36, 0x81, 0xFB, 0xDB, 0x26, 0xDB, 0xE8, 0x86, 0xA, 0xE0, 0x6C, 0x80, 0xA8, 0x3E, 0x6B, 0x2A, 0x18, 0xB7, 0x3E, 0x17, 0x95, 0x49, 0x53, 0x40, 0x2C, 0x80, 0xDB, 0x7A, 0x35, 0xA1, 0x5B, 0xAF, 0xA6, 0xE9, 0x5A, 0xA6, 0xD1, 0xD4, 0x82, 0xDC, 0x13, 0x12, 0xB, 0x91, 0xB2, 0xCE, 0xD4, 0x9C, 0x6C, 0x47, 0x44, 0x2, 0xB2, 0xEA, 0x26, 0xB9, 0xE2, 0x68, 0x9B, 0x41, 0xEC, 0x65, 0xFE, 0xA6, 0xCD, 0x75, 0xA, 0x9F, 0xD4, 0x9, 0xBB, 0x82, 0x2A, 0x6D, 0x54, 0xC1, 0x81, 0x83, 0x76, 0x7A, 0x9A, 0x7, 0x7D, 0x71, 0xF7, 0x1F, 0x5E, 0xCF, 0x27, 0xEB, 0xD5, 0xBF, 0x4A, 0x41, 0x91, 0xF8, 0xA2, 0xD4, 0x84, 0xC5, 0xE7, 0xFB, 0xE5, 0xBA, 0x4C, 0x13, 0xCF, 0xAC, 0xE, 0x52, 0x18, 0x73, 0xD2, 0xE4, 0x3C, 0x7A, 0x7D, 0x2E, 0x55, 0x5F, 0xCA, 0x7A, 0xD0, 0x4B, 0x4F, 0xD7, 0xFF, 0x6, 0x22, 0x9F, 0x21, 0x65, 0xFA, 0x8D, 0xEA, 0x73, 0x49, 0x99, 0x19, 0x93, 0x4C, 0xD0, 0x68, 0x64, 0xCA, 0x26, 0xDE, 0x6C, 0xCA, 0x9A, 0x71, 0x7F, 0x3B, 0x8C, 0xF9, 0xC0, 0x1C, 0x2E, 0xBA, 0xB3, 0xBD, 0xA1, 0x5B, 0xF2, 0x8E, 0x22, 0x2D, 0x3A, 0x87, 0x53, 0x22, 0x9C, 0x87, 0xC, 0x47, 0x4F, 0x19, 0x8, 0x46, 0x4F, 0xE4, 0xE8, 0x63, 0x42, 0x46, 0x1F, 0xB7, 0x8E, 0x6B, 0x94, 0x5, 0x41, 0x3E, 0xCB, 0xA7, 0xBE, 0xA7, 0x4E, 0x67, 0x4D, 0xEF, 0xD0, 0x78, 0xC2, 0xD0, 0xF6, 0xC, 0xCA, 0xB4, 0x44, 0xB3, 0x72, 0x9A, 0xF, 0x39, 0x38, 0xD, 0x7, 0x1C, 0x9C, 0xEE, 0x75, 0xD2, 0xE3, 0xC9, 0x3C, 0x6C, 0x97, 0x80, 0x47, 0xBE, 0xAE, 0x8C, 0xB, 0xF5, 0xB8, 0x38, 0xED, 0x42, 0xE6, 0x38, 0x8B, 0x88, 0xDA, 0x65, 0x20, 0x52, 0x67, 0xCF, 0x47, 0xAF, 0x45, 0xE2, 0xAD, 0xD1, 0xAB, 0xD3, 0x67, 0xB3, 0x63, 0xA7, 0xDF, 0x98, 0x1B, 0x3B, 0xFD, 0x8, 0xD4, 0xFC, 0x73, 0x7D, 0x2E, 0x29, 0x37, 0x86, 0x8F, 0xAB, 0x15, 0x10, 0x1C, 0x0, 0xD2, 0xDB, 0x98, 0x90, 0x5D, 0x4C, 0xAE, 0x59, 0xCE, 0x2, 0xBD, 0x3B, 0xC, 0x5, 0xC3, 0x76, 0xE1, 0x93, 0x37, 0xDA, 0xD8, 0xC0, 0xB7, 0x32, 0xD1, 0xB6, 0xDA, 0xF3, 0x63, 0xE4, 0x5D, 0xE0, 0x4D, 0x3E, 0x9F, 0xED, 0x8F, 0xC5, 0x24, 0x91, 0x76, 0x93, 0x7B, 0x93, 0x3D, 0x66, 0x91, 0x38, 0xE6, 0xF7, 0x38, 0xCD, 0xCF, 0x39, 0x9A, 0x44, 0xB9, 0xBC, 0x5F, 0x4, 0x30, 0xBA, 0x75, 0x3, 0x3, 0xCF, 0xB6, 0xE1, 0x18, 0x99, 0xF4, 0xB2, 0xF0, 0x9B, 0xFE, 0x24, 0x39, 0x7E, 0x8A, 0x8A, 0x57, 0xDA, 0x89, 0x5E, 0x1, 0x3F, 0xCA, 0xD, 0xC9, 0x1D, 0x38, 0x24, 0x77, 0xF4, 0x36, 0x8, 0x7, 0xEC, 0x82, 0x70, 0xD6, 0x36, 0x3A, 0xEA, 0x91, 0xF1, 0xE2, 0x56, 0x6D, 0x30, 0xE2, 0x81, 0xB8, 0x21, 0xB6, 0x43, 0x87, 0xD8, 0x6E, 0xB2, 0x64, 0x97, 0xE8, 0x26, 0xB, 0x74, 0x88, 0x2E, 0x64, 0x5A, 0x3B, 0x64, 0x44, 0x3B, 0xB5, 0xC1, 0xCD, 0x4B, 0x36, 0xF4, 0xC8, 0xF1, 0x9, 0x43, 0x43, 0x46, 0x97, 0xB2, 0x89, 0x1D, 0x6D, 0x10, 0x3B, 0x24, 0x96, 0x67, 0x95, 0xE3, 0xED, 0x7A, 0x13, 0x40, 0xD, 0x3B, 0x7D, 0x7A, 0x2A, 0xE4, 0xE1, 0xD2, 0xA7, 0xF2, 0x5C, 0xDC, 0xE3, 0xF0, 0x46, 0x4B, 0x32, 0xCE, 0x78, 0x6E, 0xD5, 0x64, 0x87, 0x21, 0xFF, 0xEC, 0xF5, 0x74, 0xCF, 0xB6, 0xA5, 0xFC, 0xC8, 0x13, 0x12, 0x29, 0x9C, 0x7D, 0x2C, 0x36, 0xD, 0x75, 0xA1, 0x94, 0xB4, 0xDD, 0x55, 0x44, 0xED, 0x32, 0x80, 0x1, 0x7B, 0xED, 0x2D, 0x69, 0xC0, 0x5, 0x0, 0x9A, 0xA1, 0xD7, 0xFE, 0x64, 0xDB, 0x8A, 0xD7, 0x1E, 0xDF, 0xB2, 0x52, 0x9C, 0xFE, 0xE9, 0xFA, 0x43, 0x61, 0x7B, 0x67, 0xF5, 0xC1, 0xB7, 0xC1, 0xD3, 0x81, 0x94, 0x8A, 0x0, 0xE3, 0x64, 0xDF, 0xE9, 0xB4, 0x84, 0x29, 0x90, 0x6D, 0x6C, 0x7, 0x81, 0x86, 0xE6, 0x3B, 0xD3, 0xD0, 0x31, 0xAF, 0x8A, 0x40, 0xC, 0x8E, 0x6C, 0xA3, 0xBE, 0x53, 0xE, 0x68, 0x9C, 0x9E, 0x5F, 0xD3, 0xF4, 0xE8, 0x72, 0xA6, 0xEF, 0x82, 0xD7, 0x91, 0xF9, 0x34, 0x5D, 0x50, 0x5, 0x44, 0xBE, 0x4A, 0x17, 0xEE, 0x4C, 0x55, 0x84, 0x3B, 0x54, 0x13, 0xD1, 0xEA, 0x4B, 0xA3, 0xE3, 0x64, 0x72, 0xD6, 0x97, 0x1C, 0xB5, 0xB7, 0xF4, 0x47, 0x26, 0x4, 0xA1, 0x6F, 0x5E, 0xD4, 0xAA, 0xF4, 0x53, 0xF9, 0x84, 0xFF, 0xAB, 0x20, 0xCA, 0xBF, 0xCB, 0xB, 0xA5, 0xFF, 0x22, 0x86, 0x72, 0x57, 0x61, 0xB9, 0xE4, 0x5F, 0xC4, 0x30, 0xEE, 0x40, 0x9E, 0xCD, 0xDC, 0x0, 0x49, 0x9D, 0x2E, 0x9, 0xF2, 0xC3, 0x1F, 0x81, 0x9F, 0x73, 0xF9, 0x8F, 0x0, 0x4F, 0xBA, 0xBC, 0xBF, 0xD4, 0xB4, 0x2E, 0xCE, 0x92, 0x53, 0xD8, 0x1D, 0x56, 0xCE, 0x2, 0x93, 0x59, 0xEF, 0xF5, 0xA8, 0xFB, 0xC5, 0x73, 0xBE, 0xAA, 0xC0, 0xAA, 0xE2, 0x50, 0xC9, 0xCC, 0x35, 0x6A, 0x3F, 0xF2, 0x8D, 0x74, 0x13, 0x40, 0x9B, 0xEA, 0xAC, 0xDB, 0xC1, 0xF6, 0xF6, 0xBA, 0xD0, 0x85, 0xAB, 0xB0, 0x2A, 0x38, 0x52, 0xB8, 0x1F, 0xB, 0xB9, 0x73, 0x73, 0x7D, 0x2E, 0x29, 0xE7, 0x21, 0x6, 0xFE, 0xB5, 0xA, 0x8A, 0xBE, 0x53, 0x91, 0x9D, 0xC3, 0x14, 0x7A, 0x3E, 0xBB, 0xC2, 0x41, 0x6, 0x66, 0x3D, 0x9F, 0x8F, 0xBC, 0x37, 0xF, 0x21, 0x23, 0xA5, 0x72, 0x8C, 0x94, 0xF2, 0x86, 0xA2, 0x1F, 0xC, 0xFD, 0x69, 0xFC, 0xA0, 0xC2, 0x70, 0xF0, 0x20, 0xF4, 0x5A, 0x58, 0xF9, 0xDC, 0x25, 0xE0, 0xA5, 0x4F, 0x7A, 0xB7, 0x22, 0xB2, 0x4D, 0xB1, 0xEE, 0x2, 0x64, 0xC7, 0x14, 0x61, 0x7C, 0xE3, 0x34, 0x79, 0xA0, 0x86, 0x7F, 0x41, 0x5C, 0xF2, 0xA, 0x2E, 0x44, 0xBB, 0xFE, 0x4B, 0x97, 0xA1, 0x20, 0x6A, 0xFB, 0x3, 0x94, 0x42, 0x20, 0x2, 0x18, 0x52, 0xD6, 0x97, 0x9B, 0x9E, 0x26, 0x73, 0xA7, 0xAB, 0xF5, 0x85, 0x23, 0x15, 0x9C, 0x98, 0x8E, 0xA1, 0x0, 0xA0, 0xBE, 0x50, 0x98, 0x77, 0xCA, 0x42, 0x87, 0x33, 0xB, 0x0, 0xE8, 0xCF, 0x17, 0x1F, 0x62, 0x1E, 0x8C, 0x4E, 0xF9, 0xF2, 0xCD, 0x4C, 0x3A, 0x65, 0xDB, 0x36, 0x46, 0xFB, 0x94, 0xF5, 0x34, 0xD2, 0xF3, 0x9A, 0x2F, 0x2A, 0xEA, 0x57, 0x1D, 0x68, 0xB2, 0x73, 0xA4, 0xF8, 0xFE, 0xFE, 0xAF, 0x7E, 0xFF, 0xD7, 0x7F, 0xF5, 0xCF, 0xF7, 0x7F, 0xF5, 0xCF, 0x7F, 0xF6, 0x57, 0x53, 0x58, 0xF2, 0x80, 0xD7, 0xEE, 0x26, 0xFE, 0x2F, 0xFD, 0xDA, 0x7B, 0xDF, 0xF3, 0xA0, 0xF2, 0xAF, 0xFF, 0x2A, 0xFC, 0x3D, 0xF, 0x2A, 0xFF, 0xE4, 0xAF, 0xEA, 0x68, 0x5A, 0xDB, 0x69, 0x72, 0x2, 0xB6, 0x83, 0xC0, 0x24, 0x6C, 0xD6, 0x31, 0x97, 0x99, 0x28, 0x35, 0xAA, 0x79, 0x61, 0x12, 0x9, 0x17, 0x91, 0x99, 0xC1, 0x88, 0x4C, 0x21, 0x20, 0xB7, 0x15, 0xAF, 0x4, 0xF2, 0xCE, 0xE2, 0xD9, 0xF6, 0xC6, 0x50, 0x9, 0x54, 0x57, 0x4, 0x48, 0xA1, 0xAA, 0x67, 0x9B, 0x72, 0x37, 0xD9, 0x86, 0xF9, 0x64, 0x56, 0xAD, 0x30, 0xF6, 0x75, 0x48, 0xB7, 0x34, 0x23, 0x3E, 0x89, 0x8B, 0xA, 0xCD, 0x68, 0x54, 0x68, 0xA6, 0x3A, 0x8B, 0x59, 0xEC, 0x26, 0xE6, 0xB9, 0xD1, 0xFE, 0xA1, 0x6D, 0x5D, 0x42, 0xA4, 0x6B, 0x72, 0x56, 0x7, 0x11, 0xA8, 0x4A, 0x67, 0xFA, 0x55, 0x66, 0xC4, 0x24, 0xE6, 0x76, 0xB0, 0xF, 0xCB, 0x7C, 0x8D, 0x15, 0xC4, 0xBE, 0xC5, 0x6C, 0x7C, 0x6F, 0x3D, 0xBF, 0x28, 0xBB, 0xB, 0xC0, 0xCB, 0xB2, 0xF3, 0xDC, 0xD3, 0x75, 0xAC, 0x7A, 0xAE, 0x9A, 0x8F, 0xCA, 0x64, 0x8E, 0xB9, 0xFA, 0x58, 0xAC, 0x92, 0xC9, 0x7A, 0xBE, 0x4A, 0x2A, 0x8, 0x56, 0xC9, 0xE4, 0x66, 0x3A, 0xDB, 0x75, 0x79, 0x8B, 0x2, 0x82, 0x3C, 0x21, 0x5D, 0x4D, 0x17, 0xA2, 0x9E, 0x63, 0xF8, 0x30, 0x6D, 0xA6, 0x97, 0x3C, 0xE7, 0x59, 0x85, 0xA4, 0xBE, 0x8, 0x42, 0x8B, 0x9F, 0x85, 0x3E, 0xE5, 0x1E, 0xFE, 0x85, 0x94, 0xFB, 0x7F, 0xEA, 0x57, 0xA2, 0xF1, 0x9D, 0x89, 0x6C, 0x75, 0xAC, 0x30, 0x58, 0x21, 0xE9, 0xDA, 0xA4, 0xE6, 0x27, 0xC7, 0x95, 0x54, 0x85, 0x51, 0xAE, 0x49, 0xDB, 0xC8, 0x34, 0xC5, 0xA4, 0xA, 0x88, 0x35, 0xC2, 0x34, 0xEB, 0xD6, 0xC, 0x65, 0x50, 0xC4, 0xEC, 0x20, 0xF8, 0x22, 0x82, 0x16, 0xAE, 0x68, 0x2C, 0xA9, 0xD4, 0x9B, 0xCF, 0xC6, 0xE7, 0x53, 0xE6, 0x83, 0x1C, 0x4C, 0x1F, 0x4E, 0x28, 0x3, 0x4A, 0xB3, 0x74, 0x81, 0x5E, 0xEF, 0xAD, 0x10, 0xB4, 0xD6, 0x3B, 0xC7, 0xD0, 0x5D, 0x75, 0xCA, 0xD3, 0x9D, 0x66, 0x52, 0x45, 0x40, 0xAD, 0x12, 0x10, 0xE1, 0xF, 0x82, 0xE4, 0x87, 0xD4, 0xF6, 0x7F, 0x90, 0x3D, 0xCA, 0xF, 0xA0, 0x47, 0xF9, 0xB1, 0xE8, 0x68, 0x26, 0xD2, 0xE9, 0x3C, 0x21, 0x80, 0xC2, 0x2E, 0x7C, 0x6C, 0x7D, 0xC3, 0xC1, 0x99, 0xEE, 0x8F, 0xC5, 0x4E, 0xF4, 0x1C, 0xA2, 0x22, 0xC0, 0x2B, 0x51, 0x9D, 0x8A, 0xD8, 0xA1, 0x44, 0x4D, 0x25, 0xA8, 0x2D, 0x4F, 0x45, 0x45, 0x9B, 0x6, 0xFB, 0x58, 0x2, 0x93, 0x7C, 0x35, 0xEA, 0xBA, 0x29, 0xBB, 0xA, 0x0, 0xF3, 0xB1, 0xA8, 0x47, 0xFD, 0xA9, 0x93, 0xB6, 0x99, 0xDB, 0x96, 0xBD, 0x4A, 0xA8, 0x4D, 0x4, 0xA6, 0xE4, 0x27, 0xE0, 0x1B, 0x21, 0x3A, 0xFD, 0x8E, 0xDA, 0x91, 0xE9, 0x92, 0xD6, 0xE7, 0xA2, 0x72, 0x38, 0xDD, 0x48, 0x10, 0x75, 0x78, 0x67, 0x17, 0x41, 0x6C, 0x42, 0x34, 0xF9, 0xC4, 0xF6, 0x76, 0x1, 0xD0, 0xF8, 0x62, 0x3B, 0x68, 0x13, 0xA6, 0xD0, 0xDB, 0x56, 0x4F, 0x3C, 0xE9, 0x51, 0xEA, 0x5D, 0x66, 0x3E, 0xC6, 0xF4, 0x12, 0xC1, 0x39, 0xA9, 0x9D, 0xAA, 0xF5, 0x31, 0xA0, 0x9A, 0x4D, 0x7B, 0x7A, 0x1A, 0xF5, 0x20, 0x3, 0x17, 0x88, 0xF4, 0xC9, 0x58, 0xEF, 0x18, 0xB9, 0x3D, 0x3C, 0x11, 0xDB, 0xC2, 0x13, 0x8D, 0xD3, 0x71, 0xA4, 0x19, 0x2A, 0x4, 0x71, 0x78, 0xB6, 0x14, 0x15, 0x82, 0x38, 0xB8, 0xA4, 0xB9, 0x15, 0x41, 0x1C, 0x98, 0xD5, 0xA3, 0x49, 0x9A, 0x39, 0x34, 0x54, 0x51, 0x55, 0x60, 0xA4, 0x81, 0xD8, 0xAE, 0x1E, 0x7A, 0xFC, 0xD4, 0x27, 0x7, 0x3D, 0x76, 0x21, 0xB5, 0x4B, 0x81, 0xC4, 0x22, 0xE9, 0x7B, 0x84, 0x64, 0x95, 0x41, 0xD5, 0x95, 0xF5, 0x49, 0x6D, 0x65, 0xD, 0x57, 0xD6, 0x38, 0x68, 0xDF, 0x9B, 0x89, 0xD9, 0x9D, 0xFF, 0x82, 0x91, 0xED, 0xF9, 0xD1, 0x19, 0xCF, 0xA4, 0x9C, 0x5E, 0x21, 0x8C, 0x84, 0xDA, 0xD1, 0x11, 0xE5, 0x64, 0xC1, 0xD1, 0xD3, 0x9B, 0x14, 0xA3, 0x47, 0x36, 0x29, 0x46, 0x32, 0xAF, 0x5D, 0x94, 0xD3, 0xDA, 0xC5, 0x1F, 0xE4, 0x94, 0x2E, 0xFE, 0x0, 0xA6, 0x74, 0x91, 0xFC, 0xFE, 0xF2, 0xD7, 0xCF, 0xDA, 0x77, 0x9D, 0x49, 0xB6, 0xE7, 0x77, 0xAD, 0x1C, 0x64, 0x50, 0x4F, 0x96, 0xE9, 0x17, 0xC9, 0xD0, 0x8B, 0xB4, 0xC6, 0xE7, 0x93, 0x3D, 0x60, 0xBB, 0x0, 0x5C, 0x96, 0xCE, 0xB4, 0xFC, 0x52, 0xC7, 0xA, 0xE3, 0x5C, 0xA4, 0x59, 0x64, 0x25, 0xA7, 0x21, 0x8F, 0xB9, 0xA3, 0x56, 0x21, 0x8A, 0xAE, 0xB8, 0x10, 0x11, 0xC9, 0x8D, 0xDA, 0x51, 0xDE, 0xA4, 0x1D, 0xB3, 0x21, 0x33, 0xB, 0xC4, 0x6C, 0x80, 0xCC, 0x2, 0x31, 0x9B, 0x6E, 0x69, 0x6B, 0xF6, 0xE3, 0x93, 0xFA, 0x7B, 0xCA, 0xC0, 0x95, 0xD8, 0xEB, 0x69, 0x32, 0xF1, 0xA4, 0x2B, 0xDF, 0x25, 0x60, 0x46, 0x32, 0xB9, 0x56, 0x44, 0x52, 0x6B, 0x9D, 0x6C, 0x4D, 0x83, 0xB7, 0xA4, 0xC5, 0x4C, 0xC6, 0xD7, 0x62, 0xE6, 0x83, 0x6B, 0x7, 0x27, 0x95, 0x93, 0xF6, 0x5D, 0x3D, 0x4C, 0xCD, 0x2F, 0x77, 0x1D, 0x85, 0xE0, 0x42, 0x25, 0x7A, 0xA0, 0x99, 0x93, 0x2, 0x46, 0x9A, 0xE7, 0x85, 0xC1, 0xCB, 0x40, 0xAD, 0x50, 0xC6, 0xAC, 0x92, 0xB8, 0x42, 0x59, 0x74, 0xA9, 0x5C, 0x45, 0xE5, 0xD7, 0xBD, 0x98, 0xB0, 0x28, 0xE6, 0x44, 0x6E, 0xE, 0x2A, 0xDA, 0xF2, 0xAE, 0xA0, 0x98, 0xD3, 0x8D, 0x6E, 0x71, 0x49, 0xDD, 0xE4, 0x46, 0xB7, 0x78, 0x3A, 0x50, 0xA8, 0xE2, 0xE2, 0x15, 0x1F, 0x2E, 0x3C, 0xD8, 0x4, 0xB9, 0x10, 0x1E, 0x81, 0xE5, 0xEF, 0x78, 0xB, 0xEC, 0xA9, 0xA7, 0x2, 0x92, 0x47, 0x9E, 0xA8, 0x22, 0x50, 0x5, 0x10, 0x7F, 0x3D, 0xE9, 0xFE, 0xE4, 0xB8, 0x60, 0x41, 0xE1, 0x95, 0xCF, 0xA4, 0x7, 0xCB, 0x5F, 0x88, 0x51, 0x50, 0xF8, 0x42, 0x8C, 0x22, 0x7C, 0xB6, 0xB3, 0xB0, 0x92, 0xE1, 0x7B, 0xB, 0x8B, 0x78, 0x99, 0xF2, 0x9D, 0x1E, 0xD9, 0xDC, 0x85, 0xDE, 0x39, 0xB4, 0x59, 0x74, 0x9C, 0xA5, 0xF7, 0x5F, 0xD7, 0x32, 0x3A, 0x8B, 0xEC, 0xBE, 0x2E, 0xA2, 0x27, 0xEB, 0xB4, 0x95, 0x9, 0x5E, 0xA8, 0x2D, 0xD2, 0x39, 0xB0, 0xC3, 0xD4, 0x4A, 0xB6, 0xE2, 0x20, 0x59, 0xBD, 0x6D, 0x82, 0xCB, 0xF1, 0x54, 0xE9, 0x76, 0x9, 0x88, 0x70, 0x3C, 0x9D, 0xEC, 0xD7, 0x4B, 0x5B, 0xF0, 0xA9, 0x7E, 0xAA, 0x53, 0xF9, 0xF3, 0x3, 0xF1, 0xAB, 0xCC, 0x5B, 0x47, 0xE1, 0x93, 0x1E, 0x97, 0x76, 0xB0, 0x67, 0x87, 0xC5, 0x37, 0x91, 0x37, 0x8E, 0x8B, 0x27, 0xED, 0xAC, 0xD7, 0xCE, 0xE, 0x36, 0x9E, 0x9C, 0x2, 0xD8, 0xA5, 0xD4, 0x53, 0xC, 0xA1, 0x6E, 0xC3, 0x64, 0x2F, 0xB3, 0x6D, 0x8D, 0xA7, 0x67, 0x62, 0x47, 0x1, 0x60, 0x86, 0x51, 0xC5, 0x25, 0x3A, 0x9C, 0xAD, 0xA3, 0x46, 0xD7, 0xE5, 0xA9, 0xA4, 0x48, 0x79, 0x74, 0x20, 0x93, 0x72, 0x32, 0x91, 0x99, 0xD4, 0x56, 0x4, 0x98, 0x10, 0xA4, 0xA1, 0xD7, 0x8D, 0xEE, 0xC8, 0xD9, 0xCC, 0x86, 0x21, 0xD3, 0x99, 0x34, 0x0, 0x49, 0x8B, 0x76, 0xA1, 0xB7, 0x1C, 0x4F, 0xF1, 0x55, 0xBD, 0xCE, 0xA1, 0x6E, 0x4E, 0xE2, 0x68, 0x8B, 0x88, 0xDA, 0x64, 0x30, 0xD2, 0x7B, 0x6D, 0x4B, 0x5C, 0x68, 0xAF, 0xA, 0xA8, 0x4D, 0x2, 0x25, 0x3C, 0xE9, 0x5D, 0x2A, 0x1D, 0xDC, 0xBD, 0xC, 0x21, 0x6, 0xA1, 0x22, 0x43, 0xC, 0x6F, 0xD5, 0xA2, 0x25, 0xD, 0xD3, 0xCA, 0x86, 0x39, 0x9A, 0x48, 0xDB, 0xD7, 0x68, 0x22, 0x62, 0x5E, 0xA3, 0x75, 0x8C, 0xBE, 0x75, 0x90, 0x3E, 0xB3, 0xF3, 0xB3, 0x0, 0x90, 0xFE, 0x2D, 0x4C, 0x4C, 0xB, 0xA9, 0x10, 0xC2, 0xE1, 0x74, 0x3E, 0x1B, 0x8B, 0x6F, 0x38, 0x38, 0xEE, 0x4C, 0x9E, 0xCC, 0x41, 0x9E, 0x3C, 0x90, 0x7F, 0x3C, 0x71, 0x69, 0x3, 0x13, 0x96, 0x34, 0x30, 0xF9, 0x89, 0xCA, 0x2D, 0x9A, 0xBC, 0x9A, 0xC4, 0xD4, 0xA2, 0xE9, 0x3C, 0xBC, 0x9B, 0xDE, 0x8A, 0xEE, 0xA6, 0xA4, 0x1B, 0xC1, 0x57, 0x14, 0x89, 0xB7, 0x4C, 0x3C, 0xE9, 0x94, 0xAC, 0x3F, 0x9F, 0x11, 0x1E, 0x85, 0xD0, 0xEF, 0x95, 0x6C, 0xB2, 0x27, 0x84, 0x36, 0x59, 0x98, 0x69, 0xA4, 0x29, 0xD4, 0x8, 0xE8, 0x4E, 0xA7, 0x6B, 0x51, 0x29, 0x4D, 0xEF, 0x2C, 0x45, 0xA5, 0xE4, 0xC9, 0x1B, 0x32, 0x52, 0x31, 0x5, 0x31, 0x53, 0x7B, 0x4A, 0xA1, 0xA1, 0xD2, 0xD9, 0xA7, 0x14, 0x54, 0x23, 0x26, 0xB4, 0x4F, 0x29, 0x90, 0x37, 0x25, 0x15, 0x6D, 0xF9, 0xAE, 0xA4, 0xAA, 0xAD, 0x33, 0x75, 0xE1, 0xF0, 0x1, 0xC3, 0x48, 0xF8, 0x2B, 0x90, 0x5E, 0x2, 0xD0, 0x49, 0x8D, 0x97, 0xF8, 0x89, 0xA1, 0x3C, 0x19, 0x51, 0x6B, 0x9, 0x64, 0x50, 0xA6, 0x54, 0x93, 0x1C, 0x95, 0x49, 0x29, 0x70, 0x79, 0x18, 0xA, 0x1, 0x98, 0x89, 0x21, 0xA5, 0x40, 0xA6, 0x12, 0x2E, 0x14, 0x72, 0x1A, 0xE1, 0x94, 0x2, 0xB9, 0x5F, 0xA2, 0x68, 0xB3, 0x1B, 0x26, 0x8E, 0xDA, 0x64, 0xE4, 0xA4, 0x68, 0xCB, 0xA1, 0x93, 0x94, 0x2, 0xD3, 0xD8, 0x2, 0xD4, 0xDA, 0x82, 0xA3, 0xF2, 0xED, 0x17, 0x6D, 0x27, 0xA6, 0xDC, 0x4F, 0x29, 0x78, 0x4E, 0xDB, 0x23, 0xDA, 0xE4, 0x8D, 0x66, 0x45, 0x5B, 0xBE, 0xCF, 0x2C, 0x65, 0xFA, 0x68, 0x40, 0xCA, 0xC8, 0x59, 0x80, 0x94, 0x63, 0xF0, 0xE1, 0xE4, 0x2A, 0xBA, 0x5D, 0x0, 0xB5, 0xE1, 0x65, 0x34, 0xFC, 0x3E, 0xFC, 0x82, 0xC2, 0x1B, 0xF1, 0x13, 0x19, 0x76, 0x48, 0x40, 0xD8, 0x81, 0xAA, 0xE, 0xB1, 0x26, 0xF2, 0xA5, 0xD1, 0xBE, 0xBB, 0xB4, 0xDA, 0xCD, 0xB4, 0xC7, 0x3D, 0x8, 0x40, 0x1E, 0x37, 0x6B, 0x77, 0xBE, 0x9A, 0x5C, 0x4, 0xDE, 0x59, 0x4E, 0xCE, 0x5C, 0xFB, 0xCE, 0x68, 0xEB, 0xCE, 0x43, 0x4B, 0x1D, 0xE2, 0xCC, 0x83, 0x6A, 0xB9, 0x63, 0x9C, 0x47, 0xE5, 0xC9, 0x64, 0x6A, 0x50, 0xB9, 0x1, 0x52, 0xE9, 0x49, 0x2B, 0xCD, 0x80, 0x8D, 0xE6, 0x49, 0xFB, 0xCC, 0x9F, 0x31, 0x5E, 0x61, 0xD0, 0x3E, 0xF3, 0xF4, 0xB1, 0x6D, 0xC2, 0xBC, 0xCD, 0xE9, 0x32, 0x84, 0x94, 0x99, 0x8F, 0x3D, 0xA9, 0x5D, 0x50, 0xED, 0x92, 0xD8, 0x57, 0x77, 0xD4, 0xE9, 0x97, 0xAC, 0x1C, 0x77, 0xF6, 0xE5, 0xA8, 0x4A, 0x6, 0x6E, 0xB3, 0x3, 0x76, 0x45, 0xE6, 0x40, 0xAE, 0x71, 0xE4, 0x0, 0xAC, 0x71, 0xE4, 0xB4, 0xF4, 0xF6, 0x34, 0xE9, 0x6A, 0x95, 0x78, 0x27, 0xE9, 0x6A, 0x4E, 0x8B, 0x3B, 0xF, 0x49, 0x55, 0x89, 0x77, 0x46, 0x7B, 0x39, 0x87, 0xE8, 0xD, 0xB5, 0x84, 0xB6, 0x23, 0x12, 0xC1, 0x8D, 0x6E, 0x3B, 0x37, 0xB9, 0xDD, 0x50, 0xC3, 0x77, 0x71, 0xE8, 0xBE, 0xB4, 0xA5, 0xEF, 0xA7, 0x34, 0x57, 0x40, 0x52, 0xEF, 0xA8, 0x21, 0x53, 0x79, 0x2A, 0x2B, 0xD2, 0x97, 0xDB, 0x6E, 0x80, 0xAC, 0x4E, 0x8D, 0x8F, 0xEA, 0x63, 0x51, 0xD5, 0x9B, 0x4E, 0x4F, 0x74, 0x5E, 0xB5, 0x1D, 0x4, 0x26, 0x2D, 0xB, 0xE7, 0xE8, 0x16, 0xD4, 0xD1, 0x2D, 0xFD, 0x92, 0x72, 0xF8, 0x24, 0xDB, 0xD3, 0x86, 0x1, 0x8D, 0x6A, 0x19, 0x23, 0xBD, 0xF2, 0x5A, 0x0, 0xE4, 0x3D, 0x22, 0x7D, 0xE3, 0xC6, 0x12, 0x91, 0xFB, 0x36, 0x96, 0x38, 0x69, 0x26, 0x35, 0xD7, 0xA, 0x41, 0x1C, 0x69, 0xC, 0xFD, 0x65, 0x8, 0x27, 0x54, 0x7, 0x9, 0x84, 0x31, 0x35, 0x3A, 0x8D, 0x26, 0x37, 0x7A, 0x22, 0x2B, 0x37, 0xA9, 0x3, 0x2E, 0x7E, 0xA6, 0x34, 0x87, 0x44, 0xB9, 0xDF, 0xD, 0x10, 0xCB, 0x92, 0xD, 0x3B, 0xD9, 0xDF, 0x41, 0xE8, 0x9D, 0xB2, 0xE5, 0xC7, 0xF1, 0x15, 0x5, 0xBB, 0x97, 0x25, 0xE5, 0xA8, 0x27, 0xCB, 0x77, 0x56, 0xBB, 0xC0, 0x1B, 0x7C, 0x83, 0x61, 0x2, 0x2A, 0x3B, 0x88, 0xBC, 0x61, 0x1E, 0x7A, 0xA3, 0xE3, 0x25, 0x65, 0xDD, 0x5B, 0xDF, 0xD3, 0x5B, 0x7B, 0x7E, 0x11, 0x82, 0x36, 0xFA, 0x6C, 0x3A, 0x7A, 0x9E, 0xD3, 0x29, 0x69, 0xDD, 0xC7, 0xA, 0x12, 0x92, 0xB7, 0x9F, 0xAD, 0xCF, 0xE5, 0xD7, 0xC, 0x91, 0x99, 0xE0, 0x55, 0xC, 0x9A, 0xDB, 0x2D, 0x99, 0xD6, 0x47, 0x14, 0x5D, 0xC8, 0xF6, 0xC6, 0x6F, 0xC3, 0x7A, 0x4A, 0xA0, 0xDF, 0x3F, 0xD3, 0xD9, 0xF4, 0x97, 0x8C, 0x64, 0xD3, 0x5F, 0x72, 0xA0, 0x86, 0xD0, 0xF5, 0xB1, 0xA0, 0x7A, 0x33, 0xD3, 0x64, 0x99, 0x8, 0xF9, 0x86, 0x1, 0x23, 0xB7, 0x9B, 0x89, 0x4C, 0x30, 0xAF, 0x22, 0x80, 0xE1, 0xDE, 0xAC, 0xF6, 0x64, 0xA, 0x8F, 0xD, 0x90, 0xD5, 0x4F, 0xE7, 0xE8, 0xB7, 0xB7, 0x26, 0xE8, 0x37, 0xBA, 0x3A, 0x90, 0x8A, 0x8, 0xAD, 0xA6, 0xEF, 0xE8, 0xA9, 0x8, 0x30, 0xB5, 0xAE, 0x72, 0xF4, 0x6D, 0xC3, 0x2B, 0x5, 0x70, 0xDF, 0xF0, 0x2D, 0x2C, 0x26, 0x31, 0xA5, 0x58, 0x4C, 0x42, 0x4A, 0x91, 0x49, 0x6D, 0x95, 0x45, 0x4D, 0xEA, 0xEE, 0x3C, 0xF1, 0xE2, 0xBC, 0x4F, 0xFD, 0x71, 0x72, 0x78, 0xB5, 0xA0, 0x70, 0x88, 0xFD, 0xB3, 0x33, 0x37, 0xCA, 0x90, 0xFE, 0x50, 0x15, 0x52, 0xBF, 0xD8, 0x12, 0x95, 0x77, 0x93, 0xBA, 0xB5, 0x4C, 0x7F, 0x8A, 0x4D, 0xE9, 0x33, 0xF5, 0x53, 0x68, 0xF4, 0xA4, 0xDB, 0xD6, 0x4C, 0x26, 0xEA, 0x1C, 0x98, 0xAD, 0x95, 0x84, 0x20, 0xE4, 0x32, 0x3F, 0x93, 0x9B, 0x67, 0x26, 0xAF, 0xDF, 0x8A, 0x41, 0x34, 0xD4, 0xCC, 0x50, 0x4C, 0xEE, 0xF3, 0x93, 0x6A, 0x9B, 0x3F, 0xC5, 0x76, 0xF9, 0xD3, 0xF2, 0x1B, 0x3A, 0x7E, 0x5A, 0x74, 0x43, 0xC7, 0x5D, 0x37, 0x66, 0xBC, 0x73, 0xF1, 0xE9, 0x82, 0xAA, 0xA, 0x3, 0xE6, 0x71, 0xAF, 0x33, 0x9B, 0x91, 0x9F, 0x2, 0xAD, 0x2, 0x6A, 0x44, 0x67, 0x40, 0x77, 0xED, 0xEC, 0x5D, 0x3F, 0xB8, 0x55, 0xFE, 0xBB, 0x56, 0x3B, 0xE, 0x91, 0x25, 0x73, 0x3A, 0x41, 0x2B, 0x2, 0xEF, 0xCC, 0xCF, 0xA8, 0x30, 0xA3, 0x18, 0x61, 0x7C, 0x98, 0xD8, 0x68, 0xFB, 0xA1, 0x3D, 0xB9, 0x74, 0xF8, 0x44, 0x91, 0xC5, 0xC3, 0x87, 0x75, 0x4B, 0x1E, 0xF8, 0xBD, 0x21, 0x2B, 0xE, 0x6F, 0xF, 0x79, 0x2C, 0xCE, 0xFA, 0x70, 0xB2, 0xCD, 0x60, 0x15, 0xC0, 0x37, 0x1A, 0x3C, 0x16, 0x32, 0x22, 0x57, 0x1F, 0x3, 0xAA, 0x17, 0x17, 0x1A, 0x3B, 0x19, 0x3A, 0xB4, 0x70, 0x10, 0x80, 0x22, 0xA, 0x45, 0xBE, 0xD, 0x3E, 0x6B, 0xEB, 0xD, 0x79, 0x46, 0xE8, 0xF, 0xF5, 0x55, 0xE6, 0xD7, 0xA3, 0x42, 0x94, 0x2F, 0x2B, 0xA, 0x29, 0xD7, 0x8B, 0xF5, 0xE6, 0x39, 0xF1, 0x5, 0x7D, 0xC9, 0xC0, 0x65, 0xFD, 0x34, 0x4D, 0xB2, 0xD9, 0x9C, 0x90, 0xEE, 0x12, 0x20, 0x25, 0xE5, 0x76, 0x1F, 0x72, 0x18, 0xB0, 0xB9, 0x74, 0x3A, 0x6B, 0xAA, 0xC2, 0x56, 0x4, 0x38, 0x51, 0x45, 0x65, 0xF3, 0x15, 0x13, 0xF9, 0x36, 0xBA, 0xB1, 0xFA, 0xD2, 0x87, 0xA8, 0xA9, 0xA1, 0xDB, 0xB, 0x95, 0x7, 0x70, 0x8D, 0x6E, 0x88, 0x78, 0x47, 0x7D, 0x2A, 0x29, 0xB6, 0xE3, 0x3C, 0x69, 0x6F, 0xF4, 0x3C, 0x93, 0x15, 0x70, 0xC0, 0xA1, 0x6A, 0x68, 0xC7, 0xBB, 0x8E, 0x5D, 0x12, 0xCE, 0x8D, 0xFE, 0xA1, 0x9E, 0x92, 0x67, 0xE7, 0x47, 0x9, 0x7B, 0x6C, 0x74, 0xA7, 0xBB, 0xEE, 0x83, 0x9C, 0x6D, 0xEF, 0x98, 0x3C, 0x5A, 0x2C, 0x92, 0x1F, 0xE1, 0xC1, 0xF5, 0x2C, 0x1B, 0x8C, 0x75, 0x2E, 0x8D, 0xEE, 0x87, 0x4E, 0xB3, 0x9, 0x91, 0x36, 0x18, 0xE6, 0xA2, 0x4F, 0x4D, 0x37, 0x1A, 0x3B, 0x35, 0xDD, 0xE8, 0xA1, 0xD5, 0xB7, 0x25, 0x64, 0x7A, 0x27, 0xF8, 0x1, 0x46, 0x2A, 0x69, 0x68, 0x25, 0xB2, 0xB7, 0xD8, 0xD8, 0xD1, 0x75, 0xC1, 0xD0, 0xA, 0xB2, 0x9D, 0x26, 0x77, 0x1D, 0xAC, 0x8, 0x52, 0xE, 0xDB, 0x11, 0xBD, 0xD2, 0xFA, 0x58, 0x54, 0x25, 0x1C, 0x55, 0x3, 0x9C, 0x4E, 0x6B, 0xF4, 0x47, 0xC7, 0x96, 0xBB, 0x42, 0x48, 0xC1, 0x27, 0x9D, 0x86, 0x70, 0xA3, 0xFA, 0xD4, 0x1D, 0x3, 0x7A, 0xD4, 0x22, 0x4A, 0x26, 0xF9, 0xAB, 0x0, 0x54, 0xC, 0xFA, 0x34, 0x7A, 0xA3, 0xC1, 0xD3, 0xE8, 0x85, 0x81, 0xB7, 0x84, 0x9, 0x9C, 0xF1, 0x16, 0x49, 0xAF, 0x2F, 0x9D, 0xB9, 0xD9, 0xD6, 0xD0, 0x21, 0x85, 0xAF, 0x22, 0xC8, 0xD0, 0xB6, 0x68, 0xA4, 0x1C, 0x99, 0x10, 0xD8, 0xB, 0x85, 0xAA, 0x69, 0xD2, 0xBE, 0x37, 0x64, 0x88, 0xF3, 0x9, 0x62, 0x3C, 0x21, 0x78, 0xEB, 0x7B, 0x3A, 0x5A, 0xF1, 0xC2, 0x91, 0x88, 0x45, 0xA3, 0xA7, 0xA4, 0xFD, 0x25, 0x2D, 0x5D, 0xB6, 0x31, 0x90, 0xA9, 0xE0, 0x7F, 0x11, 0x41, 0x6C, 0x7B, 0x53, 0x30, 0x0, 0xA9, 0x79, 0x8F, 0x95, 0xE7, 0xC2, 0x18, 0xD8, 0x6B, 0x55, 0x9F, 0x20, 0xF4, 0x5, 0xB2, 0x75, 0x81, 0x3F, 0x32, 0xF7, 0x14, 0x40, 0xD, 0xD7, 0x35, 0x13, 0x19, 0xEE, 0x5D, 0x11, 0xA4, 0x44, 0x9E, 0x4E, 0xB2, 0xBA, 0x3, 0xB2, 0xBA, 0xD7, 0xD1, 0xCC, 0x4B, 0x33, 0x59, 0x62, 0x31, 0xFA, 0x17, 0x5C, 0x24, 0x23, 0x96, 0xB1, 0xEA, 0x53, 0x59, 0x51, 0xDB, 0xD3, 0x4D, 0xAB, 0xAB, 0xC8, 0x3B, 0x7B, 0x56, 0x8B, 0x46, 0x38, 0xD9, 0x33, 0x5D, 0x71, 0x7C, 0xCF, 0x74, 0xA3, 0x3D, 0x31, 0xE7, 0xA8, 0x4F, 0x45, 0xC5, 0x7D, 0x4C, 0x43, 0xF, 0x7E, 0xA, 0xAC, 0x56, 0x5C, 0x1C, 0xF0, 0x90, 0xBE, 0x56, 0x1E, 0x8, 0xC4, 0xBF, 0x93, 0xE3, 0xEA, 0x2, 0x20, 0xC3, 0xE9, 0x46, 0xC7, 0x6F, 0x1C, 0xC1, 0x37, 0x90, 0xE0, 0x3B, 0x47, 0xF0, 0x1D, 0x24, 0x68, 0xCD, 0x14, 0xA8, 0x1B, 0xA3, 0x5F, 0x90, 0x58, 0xB, 0xAB, 0x1C, 0xDF, 0x76, 0x37, 0x1, 0xB4, 0xED, 0xC6, 0x76, 0xD2, 0xF7, 0x56, 0x47, 0xD2, 0xE8, 0x5F, 0x20, 0x44, 0x43, 0x4C, 0x57, 0x9E, 0x88, 0x48, 0xD0, 0x91, 0x9, 0x40, 0x2B, 0x80, 0x78, 0x90, 0x68, 0xAE, 0x21, 0xD0, 0x1F, 0x67, 0x45, 0x44, 0x82, 0x5E, 0x5B, 0x4F, 0xBF, 0xC1, 0x8A, 0x88, 0x4, 0xE4, 0x6E, 0x94, 0xF2, 0x1C, 0xF1, 0xED, 0xC7, 0x2C, 0x9C, 0x17, 0xFE, 0x8E, 0x1B, 0x52, 0x10, 0xE7, 0xAF, 0x5A, 0x32, 0xFB, 0x41, 0xC, 0xE2, 0x26, 0xA3, 0xB3, 0x8D, 0x8E, 0x40, 0x68, 0xB6, 0xD1, 0x31, 0x1A, 0xBD, 0x50, 0x7B, 0xEC, 0x36, 0x48, 0xDE, 0x66, 0x57, 0x5, 0xA7, 0x4B, 0xB8, 0x5E, 0x46, 0x1F, 0x3E, 0x27, 0xD3, 0xF5, 0xC6, 0xFA, 0x2B, 0x5D, 0xA4, 0x5F, 0x5, 0x55, 0x95, 0x94, 0x5B, 0x6E, 0x34, 0x13, 0xFE, 0xB, 0xFF, 0xDC, 0x4F, 0xA4, 0x7B, 0x43, 0x2C, 0xFD, 0x56, 0x40, 0x35, 0xE2, 0xCA, 0x6F, 0x95, 0xA3, 0xE2, 0xBC, 0x2B, 0x1, 0xBB, 0x62, 0xF0, 0xB, 0x41, 0x3D, 0xD2, 0xC9, 0x8C, 0x3E, 0x9F, 0x38, 0x36, 0xF4, 0x2C, 0xE2, 0xD3, 0x42, 0x24, 0xA4, 0xD8, 0x4A, 0x54, 0x30, 0x88, 0x24, 0x50, 0x17, 0xD3, 0x6F, 0x24, 0x1, 0xB8, 0x8D, 0xBE, 0x8A, 0x76, 0x44, 0x58, 0x6B, 0xE5, 0xE8, 0xB8, 0xC8, 0xD6, 0x57, 0x2, 0x43, 0xCE, 0x53, 0xA, 0x81, 0x1, 0x26, 0x29, 0x31, 0xDD, 0x2D, 0xB1, 0x25, 0x62, 0x25, 0xB0, 0xDC, 0x9E, 0x88, 0x5F, 0x9, 0x18, 0x93, 0xAB, 0x14, 0xA0, 0x8D, 0xD1, 0x51, 0x91, 0x4A, 0x81, 0x11, 0x38, 0xB6, 0x22, 0x1D, 0x56, 0x91, 0xCE, 0x78, 0xB6, 0x2A, 0x2B, 0x6, 0x91, 0x84, 0x86, 0xD8, 0x25, 0xB2, 0x91, 0x14, 0xC, 0x21, 0xE1, 0xBA, 0xF3, 0x4, 0xED, 0x2C, 0xAE, 0x82, 0xC1, 0x4F, 0xD6, 0xB3, 0x35, 0xB2, 0xA1, 0x38, 0x11, 0xDF, 0xF2, 0x56, 0x1C, 0x6E, 0x79, 0x3B, 0x1D, 0xDB, 0x74, 0x9E, 0x7C, 0x68, 0x3, 0x22, 0x37, 0xA6, 0x6C, 0xAF, 0x29, 0x6F, 0x4C, 0xA9, 0x82, 0x5C, 0x3F, 0x91, 0xEE, 0x27, 0x3D, 0xC5, 0x2F, 0x14, 0xBC, 0x43, 0x99, 0x41, 0x7F, 0xC2, 0x17, 0x2, 0x2C, 0x43, 0x1A, 0x2, 0x39, 0x16, 0x2F, 0x14, 0x15, 0x83, 0x48, 0x2C, 0x75, 0x98, 0x7C, 0x23, 0xB1, 0xEC, 0x91, 0xF2, 0x5F, 0x48, 0x96, 0x79, 0xE, 0x54, 0xCA, 0x81, 0x8D, 0x67, 0x83, 0x11, 0x2A, 0xDE, 0xC7, 0x42, 0xF6, 0xB1, 0x98, 0x48, 0x26, 0xCA, 0xDF, 0x20, 0x24, 0xD2, 0x17, 0x17, 0x72, 0xB7, 0x47, 0x5, 0x90, 0x21, 0x60, 0x32, 0xF4, 0xD6, 0x9C, 0x27, 0x82, 0x11, 0xF0, 0x43, 0xEB, 0x15, 0x47, 0x47, 0xD6, 0xC9, 0x9C, 0x52, 0xE1, 0x3C, 0xC3, 0x68, 0xA3, 0xD5, 0x91, 0xF4, 0x99, 0x1B, 0xA8, 0x22, 0xE0, 0x35, 0x37, 0x59, 0x3A, 0xDE, 0xB6, 0x13, 0x21, 0x1, 0xB7, 0x64, 0xDB, 0x64, 0xE2, 0x8D, 0x5E, 0x93, 0x39, 0xC0, 0x50, 0x8F, 0x92, 0xEC, 0x64, 0x5B, 0x4D, 0x27, 0xDC, 0x7D, 0xA1, 0xC8, 0x30, 0x34, 0x31, 0x14, 0x90, 0x2E, 0xBB, 0xB1, 0x4B, 0xDC, 0xD4, 0xF5, 0x22, 0xC9, 0x3, 0xBB, 0xB6, 0x5B, 0x41, 0xF4, 0x8B, 0xE7, 0x70, 0x72, 0x4, 0xB0, 0xC0, 0xF8, 0xF1, 0xBF, 0x46, 0xE7, 0xB3, 0xA0, 0x51, 0xC6, 0x83, 0x46, 0x8B, 0x89, 0x27, 0xF1, 0xEE, 0x82, 0xA2, 0xAF, 0xB7, 0xC, 0x9A, 0x5A, 0xD9, 0xDA, 0x1, 0x49, 0xFD, 0x6E, 0x22, 0x39, 0x90, 0xAA, 0xCF, 0x5, 0x65, 0xAA, 0xEF, 0x90, 0x72, 0xA3, 0x35, 0xD, 0x15, 0x53, 0x6A, 0xC4, 0x40, 0x12, 0x39, 0x98, 0xE7, 0x7, 0xF2, 0x2F, 0x3D, 0xCA, 0x8B, 0x2, 0xFE, 0xB3, 0x49, 0xD4, 0x16, 0x95, 0xA6, 0x49, 0xF2, 0x1E, 0x95, 0xA6, 0xA1, 0x5A, 0x4E, 0x23, 0x36, 0x9B, 0xE6, 0x46, 0xCD, 0x37, 0xCA, 0x53, 0x41, 0xB1, 0x25, 0x27, 0x2A, 0x2D, 0x3F, 0x4B, 0x79, 0x69, 0x52, 0xB5, 0xDA, 0x8A, 0x95, 0xDA, 0xDA, 0xCE, 0xA7, 0x1C, 0x75, 0x6B, 0xE8, 0x31, 0x48, 0x6B, 0xD5, 0x2E, 0x0, 0xD, 0x45, 0x5A, 0xCA, 0x4, 0x5B, 0xD1, 0xFE, 0xDA, 0x79, 0xD2, 0xF9, 0x1A, 0xA2, 0x4B, 0x44, 0x92, 0xD6, 0xA6, 0x55, 0x3B, 0xAC, 0x92, 0x98, 0xA1, 0xB5, 0xA1, 0x23, 0x71, 0x5C, 0xFC, 0xED, 0x59, 0x84, 0x8E, 0x3A, 0x7F, 0xDD, 0x74, 0xF2, 0x1, 0xEC, 0x86, 0xD2, 0x93, 0x95, 0x74, 0xD4, 0xD3, 0x4D, 0xF, 0x23, 0x79, 0x8D, 0xD3, 0x1, 0x6, 0x7C, 0x90, 0x61, 0xA3, 0x44, 0x6, 0xC, 0x13, 0x19, 0x4D, 0x25, 0x81, 0x5E, 0x1F, 0x8B, 0xAA, 0x4B, 0xA6, 0xD6, 0xA4, 0xD7, 0xE7, 0xB2, 0xF2, 0xCF, 0xB3, 0x5C, 0x2E, 0x4F, 0x1, 0xD0, 0x87, 0x1A, 0x6A, 0xCF, 0x52, 0x63, 0x14, 0xBB, 0x6D, 0xE9, 0x65, 0x36, 0xA6, 0x7B, 0x58, 0x4F, 0xAE, 0xCF, 0x57, 0x4, 0x58, 0x9B, 0x37, 0x66, 0xEA, 0x8C, 0xEF, 0xCD, 0xB4, 0x58, 0x7E, 0x95, 0xF8, 0x28, 0x84, 0xBE, 0x94, 0x99, 0xE9, 0x72, 0x99, 0x19, 0x48, 0xE0, 0xD3, 0x18, 0x43, 0xB9, 0xBF, 0xF2, 0x54, 0xFC, 0x5D, 0x7A, 0xD3, 0x7D, 0x63, 0xC0, 0x4D, 0xF7, 0x8D, 0xE9, 0x49, 0xCB, 0x50, 0x3D, 0x67, 0x18, 0x7, 0x55, 0xAB, 0x23, 0xBD, 0xA6, 0x56, 0x11, 0xC4, 0xA6, 0x27, 0xBD, 0xCC, 0xC4, 0x26, 0xC3, 0xA, 0xA8, 0x59, 0xDA, 0x6A, 0xD8, 0x98, 0xC9, 0x90, 0x77, 0x34, 0x56, 0x0, 0xE8, 0x77, 0xCC, 0x34, 0x99, 0xDB, 0xC2, 0xAF, 0x26, 0x6D, 0x38, 0x6A, 0x4, 0xD3, 0xB4, 0xD0, 0xBB, 0x6F, 0x37, 0x8, 0x18, 0xCD, 0x99, 0x89, 0xEC, 0x9, 0xCD, 0x4, 0xF4, 0x84, 0xC6, 0x9B, 0x9B, 0xF1, 0x99, 0x29, 0xC1, 0x6, 0x22, 0x65, 0xF0, 0x79, 0xA2, 0xCE, 0xEC, 0xEC, 0x80, 0xA4, 0x3E, 0xCF, 0x4B, 0xB0, 0x74, 0x7A, 0x96, 0xA, 0xAA, 0x82, 0x2, 0xE3, 0x3F, 0x13, 0x7B, 0xED, 0x98, 0xB7, 0xA9, 0x10, 0xF2, 0x2E, 0xB1, 0x6F, 0xF4, 0xC2, 0x7F, 0xE0, 0xA, 0xA3, 0xDF, 0x37, 0x72, 0x6B, 0xC8, 0x15, 0x41, 0xEC, 0x3D, 0x92, 0x37, 0x27, 0x97, 0xE7, 0x90, 0xF6, 0x68, 0x26, 0xC3, 0x9E, 0xFA, 0xDC, 0x71, 0xF8, 0x6D, 0x26, 0x73, 0xD3, 0x5C, 0x79, 0x2A, 0x6, 0x95, 0x69, 0x22, 0x63, 0x83, 0xEB, 0x73, 0x44, 0xF9, 0xE4, 0x75, 0x26, 0xF4, 0x8, 0x47, 0x73, 0x36, 0xC0, 0xC7, 0x87, 0xF7, 0x26, 0x8D, 0xB6, 0xBB, 0xD3, 0x8E, 0xBC, 0x42, 0x48, 0xF, 0x43, 0x1D, 0x8D, 0xA9, 0x4F, 0x65, 0xC5, 0x66, 0x21, 0x5B, 0xDD, 0xA, 0x48, 0xEA, 0xE4, 0xCF, 0xCA, 0xBF, 0x9A, 0xC5, 0x6B, 0xC6, 0x8B, 0xCC, 0xBB, 0xB7, 0x8C, 0x17, 0x1D, 0xEB, 0x75, 0x13, 0xE8, 0x54, 0xEB, 0x2F, 0x18, 0x25, 0x23, 0x9D, 0x62, 0x56, 0xF2, 0xDA, 0xAB, 0xC9, 0x65, 0x82, 0x3E, 0xA5, 0x56, 0x33, 0x5B, 0x3B, 0xEB, 0xEB, 0x3D, 0x45, 0xA0, 0xE0, 0xA9, 0xC9, 0xD9, 0xC4, 0x4B, 0x1E, 0xB4, 0xCF, 0x44, 0x9E, 0x8E, 0x3, 0xAC, 0x32, 0x97, 0xAF, 0xE3, 0x45, 0x46, 0x24, 0xA8, 0x6B, 0xA4, 0xDC, 0x74, 0xE4, 0xFC, 0x4C, 0x9C, 0x48, 0xF4, 0xDC, 0x60, 0xA1, 0x47, 0x7, 0xB, 0x3D, 0xE9, 0x8C, 0x7B, 0xC0, 0xF, 0x53, 0x73, 0x6, 0x6E, 0x9E, 0xF0, 0x52, 0xD2, 0x31, 0x53, 0x91, 0xF2, 0xF5, 0xB9, 0xA4, 0xFC, 0x20, 0x47, 0x6, 0xC3, 0x3, 0x18, 0x17, 0x50, 0x3F, 0x2A, 0xFE, 0xA0, 0xD5, 0x53, 0xF8, 0xB4, 0xE6, 0x41, 0xEF, 0x20, 0x7D, 0xA2, 0x80, 0xF, 0xB1, 0x7A, 0xBA, 0xA7, 0x1C, 0x46, 0x8E, 0xA8, 0x82, 0x8, 0x4F, 0xA3, 0xED, 0xD9, 0x79, 0xDE, 0x2A, 0xF0, 0xC6, 0x71, 0xDE, 0xC6, 0xD2, 0x33, 0x7C, 0x8B, 0xCC, 0xF1, 0xE9, 0xF3, 0xAD, 0xD, 0x70, 0xBA, 0xB5, 0xCA, 0xB0, 0xEE, 0xBD, 0x80, 0xA0, 0x7F, 0xB7, 0xD, 0x95, 0x92, 0xB1, 0x14, 0x5F, 0xCC, 0xC8, 0xD8, 0x50, 0xF7, 0x23, 0x36, 0xE2, 0xDD, 0x88, 0x8D, 0x35, 0xC2, 0x17, 0x30, 0xEF, 0x7D, 0x0, 0x33, 0xE9, 0x4F, 0xC6, 0xC0, 0x2A, 0x84, 0x58, 0x85, 0x99, 0xE8, 0xFC, 0xFE, 0x2B, 0x2, 0x34, 0x66, 0x6B, 0xD2, 0xA3, 0x7D, 0x68, 0xA6, 0xAB, 0x7C, 0xA2, 0x50, 0x59, 0x6E, 0xCC, 0xE, 0xC8, 0x8A, 0x0, 0x3, 0x10, 0x7B, 0x5D, 0xC6, 0xD3, 0x43, 0xEB, 0xAB, 0xC4, 0x5B, 0x95, 0x2C, 0x25, 0xF6, 0x5A, 0x25, 0xDE, 0xA2, 0xA4, 0xEB, 0x1B, 0xA9, 0xEB, 0x91, 0x34, 0xD9, 0x51, 0x36, 0xD8, 0xA9, 0xD1, 0x6C, 0xCE, 0xE2, 0x15, 0x45, 0x9B, 0xCD, 0xC9, 0xC2, 0x82, 0x85, 0x17, 0x15, 0x2C, 0x97, 0xD8, 0x7D, 0x45, 0x90, 0x4F, 0xED, 0xA9, 0xCE, 0xAB, 0x3C, 0x95, 0x15, 0xE9, 0xDF, 0xED, 0xE5, 0xDF, 0x24, 0x15, 0x1, 0x35, 0xDA, 0x51, 0x2, 0x99, 0x1D, 0x1A, 0x3B, 0x93, 0x11, 0x36, 0x3B, 0x23, 0x91, 0x35, 0x5B, 0x4F, 0xA0, 0x9, 0x3E, 0x67, 0x95, 0x79, 0xCB, 0x7E, 0x63, 0x77, 0x92, 0x5A, 0x6D, 0x83, 0x51, 0x43, 0x88, 0xA3, 0x61, 0x12, 0xA4, 0xED, 0x18, 0x62, 0xC, 0x91, 0x5C, 0x55, 0xB2, 0x11, 0x59, 0x4F, 0xB2, 0x31, 0xF, 0xF3, 0xA4, 0xDB, 0x93, 0x5E, 0x65, 0x97, 0x40, 0x5F, 0x2A, 0x37, 0xD1, 0x76, 0x3D, 0x33, 0xC4, 0xDC, 0x51, 0x68, 0x74, 0x69, 0x73, 0xB3, 0xB4, 0xA3, 0xC9, 0xB4, 0xE9, 0x6D, 0x20, 0xB2, 0x3E, 0x65, 0x89, 0x3, 0x63, 0xE5, 0xA1, 0xF4, 0x2A, 0xF, 0x2A, 0x17, 0x4F, 0x63, 0x1F, 0x8A, 0xCD, 0xC7, 0x73, 0xFC, 0x4D, 0xEA, 0x98, 0x5A, 0x55, 0x6, 0x7E, 0x98, 0x5C, 0xE4, 0xB0, 0xF, 0x25, 0x2F, 0x74, 0xD8, 0x47, 0x4B, 0xCD, 0x9D, 0xEC, 0x43, 0xB5, 0xE2, 0xD4, 0xC9, 0x3E, 0x3A, 0x6A, 0x1, 0xD9, 0x3E, 0x54, 0x27, 0x2F, 0x1F, 0xDB, 0x87, 0xF1, 0x27, 0x69, 0x59, 0x2B, 0x8E, 0xE7, 0x64, 0x2D, 0xE2, 0x4C, 0xE5, 0xCB, 0x73, 0x39, 0xFB, 0xB8, 0x52, 0x5B, 0xD1, 0xEC, 0x43, 0x5D, 0xC5, 0x6D, 0x68, 0xF6, 0xD1, 0x93, 0x7D, 0xF9, 0x43, 0xF5, 0x72, 0xF8, 0xD2, 0x3E, 0xE8, 0xD1, 0xD1, 0x43, 0x1, 0x3, 0xA4, 0xC7, 0x48, 0x8E, 0xB6, 0x1F, 0x6A, 0x94, 0x47, 0xDC, 0x8F, 0x89, 0xA, 0x89, 0xDB, 0x87, 0x9A, 0xC4, 0x98, 0xB8, 0x7D, 0x38, 0x6A, 0xEE, 0x66, 0x1F, 0xCA, 0x49, 0x39, 0x16, 0x8B, 0x2E, 0x15, 0xB4, 0x2C, 0xBA, 0x62, 0xC8, 0xD2, 0x3E, 0xA8, 0x1B, 0x25, 0xAA, 0x2E, 0x60, 0xA8, 0x9E, 0xA9, 0x2B, 0xF, 0xD4, 0x95, 0x27, 0x7B, 0xA4, 0x87, 0xF2, 0x72, 0x8F, 0xF4, 0x98, 0x99, 0x6, 0x32, 0x8B, 0xBB, 0xAF, 0xED, 0x83, 0x1E, 0x24, 0x3E, 0x90, 0xD1, 0xE1, 0x63, 0x66, 0xCA, 0x3C, 0x3, 0x65, 0x4E, 0x8C, 0x6D, 0x24, 0xC0, 0x36, 0x32, 0xD3, 0x90, 0x32, 0xD0, 0x90, 0xC8, 0x0, 0x6E, 0xD1, 0x5, 0xCA, 0x9C, 0x99, 0xC6, 0x9F, 0x81, 0xC6, 0xBF, 0x50, 0xEB, 0x92, 0xF6, 0xA1, 0x16, 0x79, 0xFB, 0xA4, 0x7D, 0xDC, 0xA8, 0x20, 0x9E, 0x7D, 0xA8, 0x9B, 0x18, 0xC4, 0xB3, 0x8F, 0x7, 0xD9, 0x81, 0x3E, 0xD4, 0x43, 0xEC, 0x35, 0xA9, 0x5A, 0x16, 0x6B, 0xF8, 0x43, 0x47, 0x26, 0xDB, 0xEC, 0x6, 0x1, 0xA3, 0x87, 0xF, 0x72, 0x11, 0xF4, 0x43, 0xC9, 0xCB, 0xA0, 0x1F, 0x26, 0x8E, 0x91, 0x49, 0xEE, 0xF2, 0x4, 0x91, 0x12, 0x2C, 0x3D, 0x1D, 0x4B, 0x2E, 0x0, 0xA0, 0x3F, 0xE9, 0x96, 0x6A, 0x93, 0xF5, 0x31, 0xA0, 0xDA, 0xE8, 0xA5, 0x6B, 0x3B, 0x4F, 0xF, 0x57, 0xE, 0x2, 0xD0, 0x88, 0xA5, 0xC8, 0x5F, 0xA3, 0xED, 0x34, 0xE5, 0xD9, 0xE, 0xA0, 0x54, 0x2E, 0x72, 0x92, 0x84, 0x1C, 0x50, 0x9A, 0x42, 0x3B, 0x36, 0x4B, 0xCA, 0xE4, 0x32, 0xDA, 0x1, 0x14, 0x69, 0xA8, 0x9, 0x46, 0x79, 0x2A, 0x2B, 0x52, 0x77, 0xA4, 0x94, 0xE7, 0xF2, 0x1D, 0x29, 0x55, 0x9B, 0x2E, 0xF8, 0xC9, 0x11, 0xBE, 0xA3, 0x76, 0xE7, 0x99, 0x43, 0x77, 0x2B, 0x6, 0xC5, 0x58, 0x57, 0x51, 0xC6, 0x1E, 0x56, 0x1A, 0xC8, 0x14, 0xAA, 0x28, 0x1D, 0x66, 0xDC, 0x68, 0x90, 0x40, 0xE3, 0x14, 0x7A, 0x13, 0xC9, 0x41, 0x45, 0x45, 0xF8, 0x71, 0xC5, 0x17, 0x8A, 0x9E, 0x79, 0x19, 0xD5, 0x43, 0x2F, 0x32, 0x32, 0x2F, 0xA1, 0x46, 0xE8, 0x5, 0x92, 0xCD, 0x86, 0xAD, 0x88, 0x2, 0xA2, 0x35, 0x51, 0x64, 0x7F, 0xDE, 0xA9, 0x61, 0xF5, 0x4E, 0x54, 0x50, 0x84, 0x68, 0xE, 0x59, 0x53, 0xE1, 0x84, 0xD, 0x53, 0x5A, 0xDE, 0xBA, 0xB9, 0xD3, 0x50, 0x9D, 0xFA, 0x93, 0x46, 0x1E, 0x32, 0xEE, 0x34, 0x8C, 0xD5, 0xAE, 0x34, 0x90, 0xD5, 0x16, 0x51, 0xF2, 0xC8, 0xFF, 0x4E, 0xC3, 0x9E, 0xFD, 0xFF, 0x9D, 0xE6, 0xAC, 0x6E, 0xD8, 0xFD, 0x22, 0xBF, 0xD3, 0x70, 0xDF, 0xBC, 0xD2, 0x60, 0x9F, 0xBC, 0xD0, 0x50, 0xBD, 0xE3, 0x93, 0x86, 0xEB, 0x26, 0x7F, 0xA3, 0x21, 0xB7, 0x2B, 0xED, 0x34, 0xC0, 0xD1, 0x8A, 0x9D, 0x86, 0x5A, 0xA7, 0x78, 0xD2, 0x70, 0xB, 0x16, 0xBF, 0xD3, 0x50, 0xA1, 0x8D, 0x27, 0x8D, 0x1C, 0xDF, 0xD8, 0x69, 0xCE, 0xCC, 0xAF, 0x85, 0xCD, 0xAF, 0xD, 0xE4, 0x54, 0xF7, 0x49, 0x14, 0x90, 0x29, 0xEF, 0x93, 0x8B, 0xF3, 0x57, 0x3B, 0x17, 0xE6, 0xB4, 0x36, 0xAE, 0xD3, 0x17, 0xC, 0xCA, 0xBE, 0xF1, 0x8E, 0x64, 0x4, 0xF1, 0xC0, 0xC5, 0xC6, 0x12, 0x9, 0xAE, 0xB3, 0xF6, 0xA, 0x76, 0x33, 0x1B, 0x11, 0xB9, 0xA4, 0x77, 0xA0, 0xE2, 0xD7, 0xF6, 0x48, 0x36, 0x2A, 0x18, 0xF4, 0x85, 0x4D, 0x8E, 0xA, 0xBD, 0xD8, 0xB8, 0xEE, 0xF8, 0xC9, 0x6, 0x9C, 0xAC, 0x3F, 0xB0, 0x91, 0xC3, 0xA2, 0x23, 0x9B, 0xBC, 0xD7, 0xE6, 0xC5, 0x46, 0xDD, 0xC8, 0xF5, 0x85, 0x8D, 0xBD, 0x99, 0x8B, 0x64, 0x93, 0xCA, 0xC6, 0x86, 0x4F, 0x28, 0x36, 0x73, 0x6E, 0x69, 0x4E, 0x99, 0x77, 0x4C, 0xCD, 0x9C, 0xF9, 0xD1, 0xCA, 0x6, 0xFB, 0xD2, 0xC2, 0x46, 0x4D, 0x7, 0xBF, 0xB0, 0x71, 0xF3, 0x42, 0x8A, 0xCD, 0x9D, 0xFB, 0xD, 0xA7, 0xDC, 0x1B, 0x8E, 0xC3, 0x91, 0x81, 0xED, 0x23, 0x1B, 0x1B, 0xE3, 0xA6, 0xD8, 0xC8, 0x9, 0xE8, 0x91, 0x8D, 0x9D, 0x89, 0x52, 0x6C, 0xE4, 0x9E, 0x84, 0x23, 0x1B, 0xBB, 0x37, 0x81, 0x60, 0xF3, 0xDC, 0x28, 0x66, 0x73, 0x45, 0x1E, 0x1E, 0xC7, 0x30, 0xC7, 0x83, 0xE, 0x5C, 0xC0, 0xD9, 0x8F, 0x27, 0xD7, 0xE3, 0xB4, 0x93, 0xC, 0xEA, 0x81, 0xF7, 0x93, 0x54, 0x54, 0xE9, 0xC9, 0x24, 0x6F, 0x7, 0xD8, 0x69, 0x4E, 0x2B, 0xA, 0xAE, 0x25, 0xF2, 0xE4, 0xE0, 0x4E, 0x3, 0x1C, 0x1E, 0xDC, 0x69, 0xCE, 0xAA, 0x1A, 0x8, 0x92, 0x6E, 0xA2, 0x57, 0x2A, 0x7A, 0xB5, 0xD3, 0x5C, 0xE5, 0x73, 0x6F, 0x3B, 0xCD, 0x99, 0x7D, 0xB3, 0xE9, 0x10, 0x7F, 0xA3, 0xE9, 0xCF, 0x68, 0x7A, 0x98, 0x66, 0x38, 0xAB, 0x9B, 0x1, 0xAE, 0x9B, 0xE1, 0xAC, 0x34, 0x3, 0x5E, 0x9A, 0xB3, 0x7E, 0x70, 0x80, 0xFB, 0x40, 0x7B, 0x66, 0x37, 0x16, 0xB6, 0x1B, 0x72, 0x6B, 0xD8, 0x93, 0x46, 0x3E, 0x39, 0xBA, 0xD3, 0x9C, 0xB9, 0x6E, 0xB, 0xBB, 0x6D, 0x3A, 0xBC, 0xB0, 0xD3, 0xC0, 0xFE, 0x9A, 0xC, 0xF3, 0xEF, 0x34, 0x6C, 0x84, 0xFF, 0x37, 0x9A, 0xF1, 0xEC, 0x83, 0x8F, 0xF0, 0x7, 0x9F, 0xCE, 0xDA, 0xD4, 0x4, 0xB7, 0xA9, 0xE9, 0xAC, 0x6E, 0x26, 0xB8, 0x6E, 0xA6, 0x33, 0xF3, 0x9B, 0x60, 0xF3, 0xA3, 0xD2, 0xF1, 0x3F, 0x69, 0xE4, 0xA4, 0xFC, 0x4F, 0x9A, 0xB3, 0xA6, 0xE9, 0xE0, 0xA6, 0xE9, 0xCE, 0xBE, 0x94, 0x83, 0xBF, 0x94, 0xA3, 0xF6, 0xBD, 0x3F, 0x69, 0xE4, 0xDD, 0xEF, 0x3B, 0xCD, 0x59, 0x7F, 0xCC, 0xAE, 0x21, 0xFC, 0x46, 0xE3, 0xCF, 0x26, 0x18, 0x1E, 0x9E, 0x5D, 0x90, 0x21, 0xCC, 0x27, 0xD, 0x3C, 0x12, 0x9D, 0xCF, 0xFC, 0xCD, 0xC, 0xFB, 0x9B, 0xF9, 0xCC, 0x8A, 0x67, 0xD8, 0x8A, 0x7F, 0x9C, 0x8D, 0x6, 0x7E, 0xC0, 0x43, 0x1, 0x2A, 0x1B, 0xE5, 0x93, 0x86, 0xCD, 0x49, 0xF9, 0x3B, 0xCD, 0x59, 0x15, 0x3, 0xE7, 0x7D, 0x76, 0x9A, 0x33, 0x27, 0x1A, 0x61, 0x27, 0x4A, 0xAE, 0xBA, 0x3F, 0x69, 0xE0, 0x16, 0x4E, 0x9E, 0xE4, 0xD9, 0x69, 0xD8, 0xF3, 0x3C, 0xBF, 0xD3, 0x9C, 0xD, 0x9C, 0x13, 0x3C, 0x68, 0x4E, 0x67, 0x4E, 0x34, 0xC1, 0x4E, 0x34, 0x9D, 0xF9, 0x9B, 0x4, 0xFB, 0x1B, 0xEA, 0xC2, 0xE3, 0x17, 0xD, 0xDC, 0x6B, 0xE6, 0x33, 0x27, 0x9A, 0x61, 0x27, 0x9A, 0xA9, 0xA5, 0xCE, 0x27, 0xD, 0xB7, 0xDE, 0xF9, 0x1B, 0xCD, 0x72, 0xF6, 0xA5, 0x16, 0xF8, 0x4B, 0x91, 0xEB, 0x54, 0x3B, 0xD, 0xBB, 0x58, 0xF5, 0x95, 0xE6, 0x9E, 0x99, 0x5C, 0x5, 0x1B, 0x88, 0x6, 0x32, 0x6E, 0x4C, 0x53, 0x50, 0x37, 0xA4, 0x19, 0x4, 0xD7, 0x18, 0x26, 0x3A, 0xBC, 0x41, 0x62, 0xE0, 0xFF, 0x67, 0xEB, 0xD8, 0xC0, 0x7F, 0xC1, 0xB0, 0x17, 0x59, 0xC8, 0xA6, 0xB8, 0x88, 0x1B, 0xCC, 0xA6, 0xC5, 0x34, 0x36, 0x1B, 0x72, 0x9B, 0xCA, 0x86, 0x21, 0xBB, 0x54, 0xA8, 0xE2, 0x8B, 0xB, 0x87, 0xAE, 0x23, 0x43, 0x34, 0xAE, 0x3, 0x16, 0x4C, 0x5C, 0xD0, 0x39, 0xEB, 0x76, 0x70, 0xC6, 0x67, 0x66, 0xA5, 0xE2, 0x8B, 0x8, 0x14, 0xA6, 0x77, 0x94, 0x77, 0x75, 0xE2, 0x4A, 0x33, 0xB9, 0x8F, 0x40, 0xDE, 0x44, 0x40, 0x35, 0x2, 0x71, 0xE5, 0x92, 0xCE, 0xE0, 0x87, 0x64, 0xEF, 0xF3, 0xF3, 0xAC, 0xA3, 0x6D, 0xA8, 0xB, 0xBD, 0xF, 0x98, 0x44, 0x12, 0x27, 0xAA, 0x5B, 0xF5, 0x51, 0x4D, 0x72, 0x8F, 0x4A, 0xD9, 0x98, 0xB8, 0xAE, 0x16, 0xE8, 0x13, 0x93, 0x1, 0x38, 0x31, 0x19, 0xF4, 0xCD, 0xA6, 0xAC, 0x49, 0x23, 0xDB, 0x30, 0xC0, 0xD2, 0x42, 0x17, 0xC8, 0xF1, 0x51, 0xE8, 0x2, 0xB0, 0xC2, 0x1B, 0xCC, 0x10, 0xAD, 0xEF, 0xC9, 0x35, 0xCD, 0x17, 0x26, 0x91, 0x5C, 0xA9, 0x4A, 0x2F, 0x4F, 0x25, 0x45, 0xD2, 0x5A, 0x90, 0x23, 0x57, 0x61, 0xA4, 0x17, 0xB6, 0xC3, 0x88, 0xAC, 0x6B, 0x87, 0xA9, 0x9B, 0xEE, 0x7D, 0xB0, 0xBE, 0xF7, 0xE1, 0x73, 0x30, 0x91, 0x59, 0x42, 0xFB, 0x4D, 0xC, 0x6A, 0xA5, 0x61, 0x32, 0x69, 0xD2, 0x9F, 0xD6, 0xB4, 0xE4, 0xA6, 0x94, 0x17, 0xC, 0x6C, 0x4E, 0x9, 0x93, 0xBD, 0x59, 0x4D, 0x5B, 0x66, 0x85, 0x80, 0x95, 0xDF, 0x30, 0x85, 0xDE, 0xD3, 0x7B, 0xB1, 0x57, 0x8, 0xA9, 0xEE, 0x89, 0x9E, 0xF4, 0x87, 0xE9, 0x64, 0xC2, 0x7F, 0xD0, 0x7E, 0x68, 0x1F, 0x2E, 0x7A, 0xCA, 0x41, 0x77, 0xB6, 0x37, 0x4C, 0x59, 0xBE, 0xA, 0x41, 0xA5, 0x2A, 0x2A, 0x27, 0x6C, 0x8, 0x7, 0xD5, 0x35, 0xB0, 0x3D, 0xDA, 0x41, 0x6D, 0x62, 0x9A, 0x9D, 0x9B, 0xA0, 0x76, 0x47, 0x66, 0xD1, 0xC, 0x72, 0x16, 0xCD, 0xE0, 0xF9, 0x43, 0x68, 0xC1, 0xA3, 0x87, 0xD0, 0x82, 0xF, 0xB1, 0xE3, 0x6E, 0x7E, 0x6A, 0xCA, 0xB, 0xAC, 0x30, 0xF2, 0x26, 0x24, 0x3, 0xA0, 0x46, 0x8D, 0x63, 0xCB, 0x53, 0x59, 0x91, 0xDE, 0xA3, 0xBD, 0x1, 0xB2, 0xFA, 0x9A, 0xD9, 0x49, 0xCF, 0x54, 0x88, 0xE5, 0x9, 0xAB, 0x82, 0x8B, 0x4D, 0x3D, 0xB8, 0x49, 0xD3, 0xDB, 0x19, 0x2A, 0x4, 0xED, 0x66, 0x8, 0x89, 0x5C, 0xAF, 0xAC, 0x8F, 0xA5, 0x97, 0x49, 0xD9, 0x92, 0xD5, 0x58, 0x9F, 0xCB, 0xCA, 0xD4, 0x25, 0x74, 0xDB, 0x73, 0x44, 0x99, 0x37, 0xC4, 0x82, 0xA2, 0xA6, 0x48, 0xD6, 0x9E, 0xDC, 0x6E, 0xB3, 0xF6, 0xB6, 0xD5, 0x53, 0xAF, 0x63, 0x67, 0x4E, 0x4A, 0xF2, 0x45, 0xC, 0x2E, 0xD2, 0xAA, 0x24, 0xB2, 0xBE, 0xC5, 0x7, 0x95, 0xF4, 0xED, 0x72, 0xF2, 0x27, 0x75, 0x2B, 0xA, 0x33, 0x99, 0xC9, 0x38, 0x1D, 0x47, 0xC6, 0x1F, 0xEC, 0x30, 0xE2, 0xF, 0x16, 0xDF, 0x1A, 0xC7, 0xEC, 0x51, 0xDF, 0x40, 0xAC, 0x5D, 0x8, 0x27, 0x46, 0xDF, 0x3C, 0x2C, 0x1A, 0x96, 0x6C, 0x7F, 0x90, 0xD3, 0x90, 0x1D, 0x11, 0x5F, 0x2C, 0x5B, 0x26, 0xF3, 0x63, 0x85, 0xB0, 0x52, 0x50, 0xF1, 0xB9, 0x20, 0x66, 0x70, 0xE, 0x3F, 0xAF, 0x4B, 0xA2, 0x67, 0x41, 0x2B, 0x84, 0x4C, 0x82, 0xC2, 0xC3, 0x8, 0xA9, 0xC0, 0xBF, 0x48, 0x20, 0x9D, 0xE7, 0x2A, 0x7F, 0x96, 0x8, 0xFC, 0xAB, 0x8, 0xC8, 0xC9, 0x32, 0x1, 0xFA, 0xF5, 0x40, 0x45, 0x47, 0x7F, 0xA7, 0x15, 0x83, 0x3E, 0xD4, 0x2A, 0x4A, 0x2E, 0x6D, 0x6D, 0x2C, 0xC0, 0xCA, 0xD6, 0x2A, 0x49, 0x37, 0x85, 0x8D, 0x5, 0x69, 0x9, 0xD4, 0xE0, 0x5C, 0x1C, 0x94, 0x47, 0xDD, 0x99, 0x44, 0xAE, 0xE3, 0xEF, 0x88, 0x48, 0xE0, 0xE9, 0x5C, 0x38, 0x35, 0x21, 0xBE, 0x9C, 0xE, 0xA7, 0x88, 0xDD, 0x3F, 0xAD, 0x37, 0x37, 0x3D, 0x9D, 0x65, 0x14, 0xF8, 0x45, 0xE, 0xF4, 0x58, 0xB1, 0xA6, 0x3E, 0xAB, 0x14, 0x4, 0x63, 0xC5, 0x90, 0x7C, 0x9B, 0x55, 0xF4, 0xA4, 0x68, 0xC9, 0xA2, 0x4E, 0x9E, 0x71, 0xD, 0x98, 0x5B, 0x88, 0xC6, 0xE9, 0x32, 0xD3, 0x22, 0xDD, 0xEF, 0xE, 0x2, 0xCE, 0x37, 0x9A, 0xD4, 0x32, 0xA9, 0x8B, 0x57, 0x8, 0x68, 0x40, 0xF5, 0x10, 0x51, 0xE9, 0xC0, 0xC9, 0xAA, 0x7D, 0x81, 0x22, 0x8D, 0xEF, 0x6C, 0xA2, 0xCF, 0x24, 0x6E, 0x18, 0x54, 0x98, 0x94, 0xC3, 0xC9, 0x7, 0xAA, 0x30, 0xFA, 0x85, 0xE8, 0xB2, 0x40, 0xA5, 0xC8, 0x36, 0xD, 0x6D, 0x98, 0x16, 0xD7, 0xF0, 0x57, 0x78, 0xFE, 0x22, 0x86, 0x97, 0xCA, 0xA6, 0x41, 0xE0, 0x44, 0xB9, 0x82, 0xEE, 0x5A, 0x9D, 0xF8, 0x33, 0x74, 0xBB, 0xC0, 0x1B, 0x7C, 0x9F, 0xE4, 0x86, 0xEB, 0x1D, 0x11, 0x9, 0x46, 0x73, 0xB1, 0xF4, 0x4D, 0xDA, 0x3B, 0x88, 0xB9, 0xC0, 0x30, 0x92, 0x31, 0x8A, 0xF5, 0x39, 0x50, 0xA, 0xE6, 0xF2, 0xCF, 0x15, 0x83, 0xAE, 0xD2, 0x88, 0xC1, 0x7B, 0xE6, 0x48, 0xC0, 0xA, 0x21, 0xED, 0xB3, 0xA, 0xA6, 0x85, 0x99, 0x78, 0xBD, 0x60, 0x88, 0x2B, 0xF, 0x4C, 0x9D, 0x54, 0x40, 0x54, 0xFF, 0x4C, 0x26, 0x26, 0x7D, 0x35, 0xF9, 0x5E, 0x86, 0x76, 0xE4, 0xE0, 0xE2, 0x57, 0x21, 0x64, 0x98, 0x11, 0x17, 0xB7, 0xF8, 0x8E, 0x3B, 0x87, 0xFB, 0x44, 0x91, 0x37, 0x5C, 0xFC, 0x49, 0x8E, 0xD0, 0x8A, 0xA2, 0x86, 0xBC, 0xA4, 0xB3, 0x74, 0xA3, 0x2B, 0xFC, 0x16, 0x17, 0x15, 0xF4, 0xDB, 0x11, 0x8C, 0x40, 0x28, 0xD, 0x9A, 0xEA, 0x2C, 0x2E, 0x3F, 0xCD, 0x34, 0x99, 0x33, 0xBA, 0x55, 0x0, 0xE5, 0xBB, 0x6B, 0x9F, 0x46, 0x32, 0x9, 0xEA, 0xA, 0x21, 0x29, 0x50, 0xE3, 0xDD, 0xD3, 0x37, 0x9E, 0x14, 0x0, 0xF8, 0xF2, 0x54, 0xED, 0x8A, 0xF5, 0x9A, 0xE8, 0xC4, 0x11, 0x9, 0x49, 0x1C, 0x41, 0xE, 0x74, 0x80, 0x41, 0xE, 0x9D, 0x53, 0x15, 0x49, 0xA9, 0x9A, 0x52, 0x67, 0x92, 0xED, 0xA9, 0x19, 0x78, 0x4A, 0x6A, 0xC3, 0xA4, 0x7A, 0x4E, 0x69, 0xBA, 0xEA, 0x94, 0x27, 0x26, 0x53, 0xB7, 0x4A, 0x69, 0x52, 0xAB, 0x0, 0xE4, 0x65, 0x29, 0xE, 0xF1, 0x45, 0xE8, 0x2D, 0x2F, 0x27, 0x9B, 0x5D, 0x9E, 0x9A, 0x4B, 0xA7, 0x67, 0x3A, 0x95, 0xD1, 0x8E, 0x88, 0x4, 0xE6, 0xE3, 0x83, 0xCE, 0x78, 0xB8, 0x42, 0x80, 0xA9, 0x2D, 0xBD, 0xCE, 0x64, 0x2, 0x98, 0xD, 0x90, 0xD4, 0xED, 0x44, 0x45, 0xF2, 0xEA, 0x63, 0x40, 0xD5, 0x90, 0x2B, 0xE6, 0x3B, 0x2, 0x10, 0x58, 0xDF, 0xB3, 0x2D, 0x7F, 0xC3, 0xC1, 0x86, 0xBF, 0xD8, 0x29, 0x7F, 0xDA, 0x3C, 0x74, 0x3A, 0x8E, 0xF4, 0x58, 0xF6, 0x28, 0x1, 0xD, 0x6B, 0x97, 0x71, 0xD0, 0x91, 0x4E, 0xA8, 0xBC, 0x42, 0x88, 0x2F, 0x59, 0xE4, 0x2B, 0xAC, 0x96, 0x77, 0xAF, 0xB0, 0x5A, 0x80, 0x2B, 0xAC, 0x96, 0xB7, 0xAF, 0xB0, 0x5A, 0xD8, 0x2B, 0xAC, 0x16, 0xF0, 0xA, 0xAB, 0xC5, 0xF7, 0x21, 0xF8, 0xC0, 0x66, 0xB6, 0xDB, 0x70, 0x34, 0xB9, 0x5D, 0x11, 0xCF, 0x7A, 0xD4, 0x9D, 0x3E, 0x65, 0x5C, 0x45, 0x70, 0xD2, 0x7A, 0x75, 0xEE, 0x78, 0xA7, 0x13, 0x3F, 0x54, 0x58, 0x55, 0x1C, 0x22, 0x23, 0x1B, 0x2F, 0xD2, 0x6E, 0x63, 0x3F, 0x84, 0x2B, 0x6F, 0xFB, 0x15, 0x46, 0x4D, 0x3F, 0x69, 0x4F, 0x6E, 0x95, 0x2B, 0x0, 0xBF, 0x4F, 0xEE, 0xA0, 0x3F, 0x9C, 0x4C, 0x70, 0x2B, 0xA, 0x97, 0xC4, 0x7A, 0x43, 0x1E, 0x2A, 0xDE, 0x11, 0x91, 0xE0, 0xA4, 0x1C, 0x68, 0x21, 0xA8, 0x11, 0xBF, 0x9C, 0x1D, 0x6E, 0xB9, 0xA7, 0x21, 0x2C, 0x89, 0xB9, 0xDF, 0xF5, 0x5, 0x43, 0x5D, 0xD1, 0xF2, 0x30, 0xFE, 0xBA, 0x8C, 0x4B, 0x18, 0x19, 0xBB, 0x7D, 0x18, 0xAF, 0x36, 0x1, 0xC4, 0xCE, 0x1E, 0xD4, 0x5E, 0xE7, 0xF2, 0x54, 0x50, 0xA4, 0x76, 0x5A, 0x8B, 0x67, 0xF7, 0xA9, 0x5, 0x77, 0x71, 0xBD, 0x9D, 0xAA, 0x74, 0xB1, 0xCE, 0xEF, 0x5D, 0x1F, 0xD2, 0xA3, 0xA5, 0xCF, 0xBF, 0xEF, 0x20, 0xB0, 0xD0, 0x78, 0x37, 0x9E, 0xB9, 0x70, 0xE1, 0x6E, 0x3C, 0x76, 0xDF, 0xC2, 0xBD, 0x67, 0x17, 0x94, 0x56, 0x8, 0x68, 0xD4, 0xF7, 0x91, 0xB9, 0x29, 0xAF, 0x0, 0x88, 0x7E, 0xE, 0x8E, 0xAE, 0x8A, 0x1C, 0x1C, 0x50, 0xD, 0x94, 0x91, 0x88, 0x26, 0xF2, 0xA0, 0x96, 0x6F, 0x1E, 0xE2, 0xE2, 0xCD, 0x83, 0xEC, 0x29, 0x1E, 0x40, 0x2F, 0xF1, 0x78, 0x74, 0x7A, 0x9E, 0x93, 0xD1, 0x31, 0x13, 0x3F, 0xFD, 0x87, 0x6A, 0x1E, 0xF, 0x55, 0x25, 0xD4, 0x2A, 0x72, 0xA4, 0x23, 0x6E, 0x9C, 0x6B, 0xFF, 0xFA, 0x8B, 0xA, 0xA3, 0xB5, 0x7F, 0xFD, 0x5, 0x44, 0xD0, 0xA8, 0x93, 0x7F, 0x62, 0x96, 0xF2, 0x56, 0x5F, 0x5A, 0xE3, 0x73, 0xD4, 0xD3, 0xE5, 0x9B, 0x99, 0x74, 0xCA, 0xB6, 0x6D, 0x8C, 0xF6, 0x29, 0xEB, 0x89, 0x1E, 0x7A, 0x1C, 0x15, 0xD4, 0xAF, 0x1A, 0xC8, 0x50, 0xA4, 0xD5, 0x9A, 0xE, 0x0, 0xB4, 0x5A, 0x23, 0xB3, 0xFF, 0x56, 0x6B, 0x6A, 0x13, 0x74, 0xD1, 0x96, 0xF7, 0x3F, 0xB7, 0x9A, 0x48, 0x39, 0x53, 0x1E, 0x4A, 0xBF, 0xD9, 0x4C, 0xE6, 0xE2, 0x42, 0x67, 0x1C, 0xB9, 0x3F, 0xE1, 0x80, 0x23, 0x1B, 0x13, 0x5A, 0xDD, 0xE9, 0x1F, 0xB, 0x3F, 0x51, 0xDC, 0x71, 0xAC, 0x93, 0x68, 0x35, 0x93, 0xE8, 0xB1, 0xD5, 0x60, 0xA2, 0xC7, 0x56, 0x5F, 0x29, 0xAB, 0x2B, 0x4F, 0xA5, 0x9F, 0xBE, 0x7E, 0xD0, 0x5B, 0xD2, 0x2A, 0x82, 0x99, 0x43, 0x3F, 0x59, 0xE6, 0x46, 0xBA, 0x1D, 0x93, 0x1B, 0x62, 0xAB, 0x87, 0xD6, 0xA4, 0x25, 0xD0, 0x5B, 0x1, 0x5E, 0xA8, 0xEC, 0xB8, 0x5A, 0x32, 0x66, 0xDB, 0x2, 0xE1, 0xDA, 0x96, 0x3A, 0x24, 0xDD, 0xB2, 0x87, 0xA3, 0xF, 0x6A, 0xBA, 0x61, 0x6E, 0xB9, 0xDB, 0x31, 0xE8, 0xC7, 0xED, 0x35, 0x44, 0xCF, 0xC7, 0x44, 0x5F, 0x12, 0xA8, 0x59, 0x91, 0x83, 0xF1, 0x56, 0x3, 0xE3, 0xEF, 0x96, 0xBC, 0x9F, 0xA4, 0x95, 0x6F, 0x26, 0x69, 0xF5, 0x94, 0xB5, 0xB7, 0x29, 0x99, 0x4C, 0xDF, 0xB3, 0xF2, 0x45, 0x0, 0x2A, 0xC8, 0xCD, 0xFA, 0x71, 0x32, 0xD4, 0xD1, 0x94, 0x23, 0x28, 0xD1, 0x90, 0x15, 0x2A, 0x57, 0xA1, 0x5B, 0xC3, 0xF4, 0xFC, 0x37, 0xD9, 0x4, 0xD0, 0x4F, 0xE2, 0xBA, 0x5B, 0x64, 0xDA, 0x7A, 0x81, 0xB0, 0xC6, 0xEE, 0x4C, 0x24, 0xBB, 0x8A, 0xFA, 0x5C, 0x2C, 0x1, 0xFD, 0x55, 0x1C, 0xF2, 0x2D, 0x1C, 0x31, 0x7C, 0xAC, 0x4F, 0x65, 0x45, 0xED, 0xB9, 0x16, 0xB2, 0x62, 0xD8, 0xCF, 0xDB, 0x4E, 0xFB, 0x70, 0x71, 0xA6, 0xB3, 0xE4, 0x5E, 0xA5, 0x5F, 0x44, 0xDE, 0xE0, 0x94, 0x29, 0xDF, 0x61, 0xF4, 0xBA, 0x8F, 0xDA, 0x77, 0x86, 0xA, 0xA2, 0x7D, 0x11, 0x90, 0xC3, 0x69, 0x9B, 0x78, 0x3A, 0xA1, 0x4A, 0x18, 0x4B, 0x68, 0x74, 0x4A, 0xEC, 0x3B, 0xAE, 0x28, 0xF2, 0x7E, 0xD4, 0xA5, 0xB2, 0xAD, 0x56, 0xE2, 0x85, 0xB2, 0x45, 0xB3, 0x3B, 0xF1, 0x6E, 0x5, 0x45, 0x9B, 0x91, 0x6F, 0x4D, 0x8C, 0xA6, 0xC, 0xDB, 0xA8, 0xD, 0x3F, 0xBF, 0xE0, 0x22, 0xD9, 0x49, 0x99, 0xE0, 0x2, 0x51, 0xFB, 0x7F, 0xD6, 0xC7, 0x92, 0xEA, 0x6C, 0x9A, 0x68, 0x4E, 0xB6, 0x0, 0xBD, 0x24, 0xD0, 0xC2, 0xCC, 0x26, 0x87, 0x4F, 0xB2, 0x3C, 0x1B, 0x22, 0x12, 0x58, 0x32, 0x61, 0xC8, 0xE, 0x40, 0xEA, 0xE4, 0xA2, 0xE7, 0x1, 0x93, 0x48, 0x48, 0x7B, 0x97, 0xED, 0x3C, 0xEA, 0x56, 0x4F, 0xEE, 0x9E, 0x7, 0xDB, 0x5E, 0x1A, 0xA3, 0x53, 0xE6, 0x86, 0x37, 0x55, 0x50, 0x7D, 0x91, 0xC4, 0x86, 0x3B, 0x51, 0xDF, 0x34, 0x59, 0xB9, 0x2B, 0x20, 0x16, 0xB0, 0x9, 0xDE, 0xEA, 0x8B, 0xED, 0x27, 0x93, 0x2C, 0x7D, 0x91, 0xD8, 0x6F, 0x42, 0x48, 0xB3, 0x5C, 0x55, 0x10, 0xDA, 0xB7, 0x58, 0x3B, 0x22, 0x80, 0xB2, 0x3E, 0x16, 0x55, 0xC9, 0xEF, 0xCF, 0x9E, 0xA3, 0x3A, 0x2A, 0xB6, 0x93, 0xB6, 0x8E, 0xB9, 0x2, 0xBC, 0xD5, 0x6A, 0x13, 0x20, 0x2E, 0x1, 0xA7, 0x3F, 0x18, 0x79, 0x5D, 0xCB, 0xF6, 0x5C, 0x2E, 0xC, 0x19, 0x57, 0xDE, 0x1, 0x51, 0xBD, 0xF, 0xDC, 0x64, 0x29, 0xF6, 0x1, 0x9B, 0x2E, 0xC5, 0xA8, 0xA3, 0xBE, 0x38, 0x9D, 0x12, 0xD3, 0x83, 0x1E, 0x4, 0xA0, 0x6F, 0x5A, 0xC4, 0x25, 0xBA, 0x37, 0xD8, 0x3A, 0x6A, 0x6B, 0x4C, 0x5, 0x80, 0xDC, 0x16, 0x45, 0xCE, 0x9A, 0xC8, 0x3B, 0xBF, 0xA, 0xA3, 0x9E, 0x8F, 0xF9, 0x54, 0xC0, 0x77, 0xCA, 0x21, 0xF8, 0x93, 0x94, 0xB9, 0x2F, 0x9, 0xB4, 0x28, 0x89, 0xEC, 0x26, 0x93, 0xDC, 0x4B, 0x26, 0xDD, 0x6A, 0x47, 0xC6, 0xFD, 0x36, 0xC, 0x9, 0xFA, 0x15, 0xD1, 0xCE, 0x4C, 0xDA, 0x5, 0x6F, 0xCE, 0x3A, 0xDD, 0xA3, 0x14, 0xFC, 0x66, 0x64, 0xC3, 0x16, 0x17, 0xF8, 0x8A, 0x48, 0xE4, 0x26, 0x5, 0x15, 0x42, 0xCC, 0x8D, 0xCA, 0xB5, 0x5C, 0x9F, 0x8A, 0x8A, 0xE4, 0xAD, 0x60, 0xDB, 0x73, 0x44, 0x99, 0xDA, 0xB5, 0xBF, 0x22, 0xF2, 0xBE, 0xFD, 0x56, 0xA7, 0xCC, 0xE7, 0xC4, 0x5F, 0x51, 0xB8, 0xFA, 0x73, 0x3C, 0x8B, 0x3C, 0x54, 0x18, 0xE5, 0x22, 0xBF, 0x85, 0xFC, 0x11, 0x32, 0x3F, 0x9A, 0xCF, 0xE8, 0x60, 0x3E, 0x6B, 0xFF, 0xD0, 0x91, 0x19, 0x8F, 0x6E, 0x20, 0xC6, 0x43, 0xEC, 0x3, 0x6D, 0x75, 0x6, 0x2E, 0x28, 0x6B, 0x75, 0x36, 0x91, 0xDC, 0x1, 0xFF, 0x44, 0xC4, 0x5F, 0xAF, 0x62, 0x9C, 0x8B, 0x5F, 0x51, 0xCC, 0xCB, 0xE7, 0x21, 0x4C, 0x96, 0xB8, 0xFE, 0xF1, 0x89, 0x60, 0x4, 0xA6, 0x5B, 0xA8, 0xDC, 0x4A, 0x3B, 0xAC, 0x4C, 0xB7, 0xF0, 0xB9, 0x95, 0x5E, 0x64, 0xD4, 0xAD, 0x99, 0xAD, 0x56, 0xE2, 0x9D, 0x99, 0xAD, 0xFE, 0x69, 0xB9, 0xC9, 0x4A, 0x41, 0x80, 0xA9, 0xA, 0x95, 0x1C, 0xAE, 0x65, 0x93, 0xC2, 0xBD, 0xD4, 0xA8, 0xCF, 0x28, 0xDF, 0x81, 0xD9, 0x36, 0x3D, 0x75, 0x44, 0xBA, 0x6D, 0x7A, 0xE0, 0x74, 0x74, 0xDB, 0x90, 0xE6, 0xDB, 0x0, 0x76, 0x4B, 0x9D, 0x9, 0x6C, 0xC5, 0x43, 0x81, 0x6D, 0x43, 0x8E, 0xAA, 0x1A, 0x79, 0x54, 0x45, 0xED, 0x3, 0x69, 0xC5, 0x8D, 0x20, 0x2D, 0x65, 0x90, 0xA2, 0x29, 0xB6, 0x7A, 0x24, 0xED, 0xA7, 0x55, 0x7A, 0x4, 0x4C, 0xA8, 0xD5, 0x13, 0xA7, 0x3E, 0x41, 0xEA, 0x91, 0x53, 0x8F, 0x90, 0x7A, 0xE2, 0xD4, 0x13, 0xA4, 0xFE, 0xE0, 0xD4, 0x1F, 0x88, 0x3A, 0xD3, 0xF4, 0x5A, 0x5, 0xB5, 0xBE, 0xB6, 0xD, 0x9C, 0x7A, 0x80, 0xD4, 0x33, 0xA7, 0x9E, 0x11, 0xF5, 0xAE, 0x65, 0xD4, 0xBB, 0x16, 0x52, 0x37, 0x9C, 0xBA, 0x81, 0xD4, 0xFD, 0x7F, 0xFC, 0x7, 0x1D, 0x5B, 0x53, 0x15, 0x83, 0x82, 0x6B, 0xED, 0x95, 0x33, 0xBE, 0x2B, 0x64, 0x7C, 0x3D, 0xF7, 0xFD, 0x7A, 0xE8, 0xFB, 0xF5, 0xB, 0xA7, 0xBE, 0x20, 0xEA, 0x83, 0x65, 0xD4, 0x7, 0xB, 0xA9, 0x53, 0x4E, 0xA9, 0x55, 0x83, 0xB8, 0x7B, 0xAA, 0x6D, 0x2D, 0xF7, 0xDE, 0x16, 0x7A, 0x6F, 0xDB, 0x71, 0xEA, 0x1D, 0xA4, 0x4E, 0xE5, 0x98, 0x6A, 0x5B, 0xCB, 0xA7, 0x97, 0x3A, 0xE8, 0x72, 0x1F, 0xDC, 0x42, 0x1F, 0xDC, 0x7A, 0x4E, 0xDD, 0x23, 0xEA, 0x23, 0xE7, 0x6D, 0x46, 0xC8, 0xDB, 0x8C, 0x77, 0x4E, 0xFD, 0x8E, 0xA8, 0x4F, 0xDC, 0x57, 0x9B, 0xA0, 0xAF, 0xE6, 0x38, 0x75, 0x87, 0xA9, 0x73, 0x1F, 0xDD, 0x41, 0x1F, 0xDD, 0x71, 0xEE, 0xC2, 0x41, 0xEE, 0xC2, 0x71, 0x6D, 0xC5, 0x41, 0x6D, 0xC5, 0x71, 0xDF, 0xDD, 0x41, 0xDF, 0xDD, 0x71, 0x8E, 0xDA, 0x41, 0x8E, 0xDA, 0x71, 0x66, 0xE3, 0x20, 0xB3, 0x71, 0x9C, 0x9F, 0x77, 0x90, 0x9F, 0x27, 0x43, 0xC0, 0x2D, 0x10, 0x2, 0x6E, 0x3D, 0xD7, 0x43, 0x78, 0xA8, 0x87, 0xF0, 0x9C, 0xC5, 0x78, 0xC8, 0x62, 0x3C, 0x67, 0x31, 0x1E, 0xB2, 0x18, 0x3F, 0x70, 0xEA, 0x3, 0xA4, 0xFE, 0xC1, 0xA9, 0x7F, 0x40, 0xEA, 0x8E, 0x53, 0x77, 0x90, 0xFA, 0x8D, 0x53, 0xBF, 0x41, 0xEA, 0x9C, 0xA3, 0xF1, 0x90, 0xA3, 0x9, 0x5C, 0xD5, 0x5, 0xA8, 0xEA, 0x2, 0x37, 0xA0, 0xC, 0xD0, 0x80, 0x32, 0x70, 0x23, 0xC2, 0x0, 0x8D, 0x8, 0x67, 0xCE, 0xCD, 0xCD, 0x90, 0x9B, 0x9B, 0xB9, 0x5F, 0x9F, 0xA1, 0x5F, 0x8F, 0x9C, 0x9F, 0x8A, 0x90, 0x9F, 0x4A, 0x5C, 0x8B, 0x4B, 0x50, 0x8B, 0x4B, 0x5C, 0x8B, 0x4B, 0x50, 0x8B, 0xCB, 0x9C, 0x97, 0xCC, 0x90, 0x97, 0xCC, 0x3F, 0x39, 0xF5, 0x9F, 0x88, 0x3A, 0x75, 0xC5, 0x46, 0xDB, 0x2, 0x37, 0x6C, 0xB4, 0xED, 0xC2, 0x79, 0xC8, 0x5, 0xF2, 0x90, 0x37, 0xCE, 0x64, 0x6E, 0x90, 0xC9, 0xDC, 0xB8, 0x6F, 0x7E, 0x83, 0xBE, 0xF9, 0x8D, 0x2B, 0xFC, 0xD, 0x2A, 0xFC, 0x27, 0x57, 0xF8, 0x4F, 0xA8, 0xF0, 0x9F, 0x5C, 0xE1, 0x3F, 0xA1, 0xC2, 0x7F, 0x72, 0x8E, 0xEA, 0x13, 0x72, 0x54, 0x9F, 0x9C, 0xA3, 0xFA, 0x44, 0x1C, 0x15, 0xB5, 0xE0, 0x20, 0xED, 0x3E, 0x2F, 0xF3, 0x16, 0x7A, 0xDE, 0xD1, 0xA1, 0xFB, 0x77, 0x3A, 0x7F, 0x31, 0x5D, 0xCF, 0x6C, 0xF7, 0x7C, 0xA2, 0x50, 0xE0, 0xB7, 0xCC, 0x74, 0x2E, 0x29, 0x4D, 0x1C, 0xD5, 0x8A, 0x82, 0x54, 0x73, 0xC, 0x9D, 0xCE, 0x93, 0x4E, 0xC9, 0x6A, 0x7F, 0xE9, 0xCC, 0x8D, 0x63, 0x55, 0x45, 0x52, 0x7D, 0x11, 0x85, 0x7E, 0xC2, 0xB4, 0x83, 0x8D, 0x74, 0x7A, 0xAF, 0xD, 0x83, 0x16, 0xE5, 0x4C, 0x1F, 0x6E, 0x64, 0xD0, 0xC9, 0xA8, 0x3E, 0xDC, 0x80, 0xA0, 0x13, 0x99, 0x48, 0xA5, 0x5, 0x32, 0xA7, 0xB4, 0x66, 0x32, 0xE4, 0x75, 0x20, 0xAD, 0x51, 0x93, 0x39, 0xB9, 0x10, 0xE4, 0x50, 0xF8, 0x29, 0xDB, 0x96, 0xD, 0xE8, 0x56, 0x14, 0x8C, 0xE7, 0x1A, 0x4F, 0x5E, 0xA9, 0xB2, 0x3D, 0x47, 0x94, 0xF9, 0x62, 0x14, 0x14, 0x2F, 0x46, 0xD4, 0xD3, 0x92, 0xF4, 0x63, 0x89, 0x26, 0x65, 0x9D, 0x6D, 0xAB, 0xE7, 0x99, 0x31, 0xEE, 0x5D, 0x58, 0xFD, 0x22, 0x8D, 0x99, 0xF, 0x15, 0x76, 0x35, 0x62, 0xB0, 0xD5, 0x44, 0x6A, 0xA2, 0x5B, 0x9E, 0x8A, 0x8A, 0xD9, 0x5E, 0x6D, 0xAB, 0xB3, 0xD, 0x9E, 0x9, 0xFA, 0x1E, 0x45, 0xA0, 0xC8, 0xAF, 0x89, 0xD9, 0xF5, 0xCC, 0xA6, 0xA0, 0x15, 0x83, 0x5C, 0x88, 0x49, 0xC6, 0xEB, 0xCB, 0x35, 0xC4, 0x89, 0xDE, 0x8A, 0x77, 0xC0, 0x11, 0xAB, 0x2E, 0xD2, 0x2, 0x19, 0xCA, 0x45, 0xDC, 0x44, 0xC1, 0x5E, 0x40, 0xF1, 0x52, 0xA2, 0xBA, 0x6E, 0x31, 0xD, 0x56, 0x7B, 0xA5, 0x1C, 0xF8, 0x55, 0xF4, 0xE0, 0x54, 0xDD, 0x8B, 0x91, 0x63, 0x6A, 0x25, 0x4A, 0x5C, 0x87, 0x1A, 0xB4, 0x6B, 0x74, 0x1F, 0xE9, 0x80, 0xC5, 0xE, 0x2, 0x61, 0x8B, 0x41, 0xBB, 0xD9, 0x6, 0x9F, 0x6, 0x3B, 0x33, 0x86, 0x78, 0x90, 0x80, 0xEC, 0x70, 0xD0, 0xDE, 0x50, 0x9B, 0x3E, 0xD6, 0xE7, 0xB2, 0x32, 0xAB, 0xD, 0xAB, 0xA7, 0xEE, 0x16, 0x69, 0x27, 0xF1, 0xC2, 0x21, 0xB7, 0x30, 0xE8, 0x68, 0x33, 0x71, 0x8, 0x60, 0x7, 0xC4, 0xD2, 0xC4, 0xCC, 0x9D, 0x72, 0xDE, 0x40, 0xB0, 0x46, 0xC9, 0xA5, 0xD2, 0x1, 0x58, 0x2B, 0x1D, 0xC8, 0xA5, 0xB9, 0x41, 0x5E, 0x9B, 0x1B, 0x74, 0xCE, 0xDA, 0x87, 0xD0, 0xF3, 0x8B, 0xBE, 0x2F, 0x11, 0xD0, 0x9F, 0xF, 0x46, 0x53, 0x1B, 0xFF, 0xEA, 0x63, 0x51, 0x75, 0xCA, 0xC6, 0xF, 0xDA, 0xF1, 0xA5, 0xD9, 0x25, 0xE0, 0xC2, 0xC4, 0x51, 0xA7, 0x74, 0x27, 0xC7, 0xF6, 0x3B, 0xC8, 0xF, 0xF1, 0x7F, 0xE1, 0x39, 0xA3, 0x1, 0x59, 0xBC, 0xED, 0xC3, 0x8D, 0xA3, 0xA9, 0x20, 0xCA, 0x33, 0xD8, 0x13, 0x9E, 0xC1, 0xC2, 0x3C, 0xB7, 0x9C, 0x2C, 0x4F, 0x54, 0x50, 0x90, 0x29, 0xDC, 0x32, 0x5F, 0x43, 0x2B, 0xA, 0x31, 0x25, 0x3D, 0x1B, 0x3D, 0x9A, 0x46, 0xB3, 0x27, 0xBB, 0xBE, 0x8, 0xC1, 0x96, 0xC0, 0xC, 0xBB, 0x7, 0x74, 0xD8, 0x3D, 0xD8, 0x86, 0x3C, 0x12, 0x55, 0x1, 0xF9, 0x28, 0x54, 0x3B, 0xD8, 0x56, 0xF7, 0xEC, 0xA5, 0xDD, 0x1B, 0x8C, 0xBE, 0x8D, 0x2D, 0xBF, 0xBA, 0x24, 0x6D, 0x33, 0x73, 0x37, 0xED, 0x2E, 0xA2, 0x36, 0x19, 0xA8, 0x80, 0x26, 0xD3, 0x3D, 0x78, 0x45, 0x80, 0xCE, 0x7B, 0xB0, 0xBD, 0x4E, 0x7A, 0xB4, 0xA3, 0xF6, 0xBA, 0xD7, 0x9F, 0x5C, 0xC1, 0x56, 0x21, 0xB5, 0x4B, 0x41, 0x45, 0x1B, 0xF4, 0x5D, 0xEB, 0xB1, 0x28, 0xEA, 0x50, 0xFE, 0x61, 0xA8, 0xF, 0x62, 0xAA, 0xCA, 0x41, 0xE4, 0x1F, 0xF6, 0xB3, 0x96, 0xA5, 0x14, 0x8B, 0x21, 0x2E, 0x22, 0x6A, 0x97, 0x81, 0x48, 0xC7, 0xA5, 0xF, 0x27, 0x27, 0xE9, 0x36, 0x9, 0xFC, 0x2C, 0x5D, 0x55, 0x18, 0x44, 0xCA, 0xE1, 0x7D, 0xCA, 0x71, 0x29, 0x2F, 0xE6, 0xC9, 0x73, 0xA5, 0x4F, 0x11, 0xB5, 0xCA, 0x80, 0xA4, 0x1F, 0x62, 0x39, 0x3F, 0xDE, 0x2D, 0xA7, 0xD3, 0x42, 0x29, 0x9D, 0x7E, 0xAF, 0x8C, 0xC9, 0x58, 0xDB, 0xE8, 0xC8, 0x7F, 0xF3, 0x2A, 0xA2, 0x36, 0x19, 0x94, 0x74, 0xB0, 0x5E, 0x7C, 0xF7, 0x2A, 0xF4, 0xE6, 0xEB, 0x9F, 0x9F, 0xCB, 0xDC, 0x45, 0xDE, 0x22, 0x9D, 0xBA, 0xC8, 0x27, 0xC6, 0xDB, 0x71, 0xD8, 0x23, 0x55, 0xE9, 0x74, 0x9E, 0x6D, 0xEF, 0x17, 0xB1, 0x37, 0xB9, 0x45, 0x56, 0x98, 0xCF, 0x8D, 0xC6, 0x67, 0xEA, 0x7C, 0xFC, 0x8E, 0x1, 0x7, 0xE4, 0xDB, 0xF2, 0x11, 0x4F, 0x4D, 0xD2, 0x87, 0x77, 0xC, 0xD2, 0x67, 0x4D, 0x9D, 0x74, 0xDB, 0x0, 0x51, 0x7D, 0x9E, 0x97, 0xC6, 0xE4, 0xB4, 0xEC, 0x17, 0xFF, 0x33, 0x65, 0xDA, 0xC5, 0xD4, 0x2E, 0x7, 0x95, 0x2D, 0x86, 0x39, 0xEA, 0xF6, 0x2C, 0xF2, 0x70, 0x90, 0x81, 0xBF, 0x42, 0x5C, 0x62, 0x6F, 0x8D, 0x4F, 0x97, 0xCE, 0xF8, 0x6C, 0x53, 0x36, 0xE9, 0x62, 0xFC, 0xE5, 0x1A, 0xB5, 0x6F, 0xD, 0x3D, 0xE5, 0x39, 0x55, 0x1, 0xAE, 0x7, 0x22, 0x19, 0xE0, 0x9F, 0x42, 0x66, 0x5A, 0x36, 0xDE, 0x17, 0x5D, 0xBA, 0x5E, 0xA6, 0xFE, 0xE3, 0x7D, 0x51, 0x15, 0x87, 0xEA, 0x9D, 0xEC, 0x8C, 0xA1, 0x8E, 0x38, 0xEB, 0xB3, 0x52, 0x64, 0xFD, 0x4E, 0x21, 0x42, 0x32, 0x82, 0x55, 0x15, 0x91, 0xF7, 0x2C, 0xEA, 0x1E, 0x3A, 0xDD, 0x2F, 0x9E, 0x1D, 0xBC, 0x14, 0x5C, 0x55, 0x1, 0x98, 0x8E, 0xCD, 0x8D, 0xB0, 0xD3, 0x81, 0xC9, 0x11, 0xDA, 0xC1, 0x3E, 0x96, 0x1C, 0x72, 0xE, 0x91, 0xAB, 0xBF, 0xC7, 0xA2, 0x36, 0x1, 0x84, 0x2E, 0xB4, 0x61, 0xD2, 0x99, 0xDF, 0xD1, 0xB9, 0xB, 0xA0, 0xD, 0x27, 0x5C, 0x97, 0x93, 0x77, 0xD, 0xD7, 0x5, 0x7F, 0xD3, 0xE0, 0xB5, 0x3F, 0x19, 0xD9, 0x16, 0x58, 0xC1, 0x3, 0xDC, 0x90, 0x8C, 0x3D, 0x23, 0x2B, 0xBD, 0xE9, 0x1B, 0x64, 0xC3, 0x39, 0xD9, 0xF0, 0xE, 0xD9, 0x3D, 0x94, 0xF1, 0x81, 0xB, 0x99, 0xAB, 0xB4, 0x7B, 0x50, 0xBB, 0x4, 0x42, 0x18, 0x6D, 0xCA, 0x56, 0xFB, 0xD4, 0x2C, 0x91, 0xCD, 0x3, 0xF3, 0x55, 0xA, 0xFD, 0xB8, 0x45, 0xC7, 0x51, 0xD9, 0xF8, 0x5F, 0x90, 0x48, 0x11, 0x1C, 0x19, 0x4A, 0xA8, 0xCF, 0x25, 0xE5, 0x4C, 0xED, 0xE2, 0x1B, 0x54, 0xE6, 0x36, 0xF2, 0x1D, 0x7C, 0x6B, 0x8E, 0xC6, 0x77, 0x43, 0x48, 0xD9, 0xFA, 0x9E, 0x3B, 0x90, 0x31, 0xA8, 0xA3, 0x14, 0x78, 0x2A, 0x63, 0xC8, 0x71, 0xFC, 0xF6, 0x9D, 0xD9, 0x40, 0x32, 0xE4, 0xA8, 0xC6, 0x6F, 0xDF, 0xB1, 0x6D, 0x24, 0xC3, 0xE2, 0xFB, 0x66, 0x19, 0xA9, 0xA4, 0x23, 0x3B, 0x26, 0xE7, 0x1D, 0xA9, 0x92, 0x5E, 0x3B, 0x96, 0xC5, 0x6B, 0x7, 0xB1, 0x84, 0x13, 0x3, 0x5F, 0x2, 0x6E, 0xDE, 0xCB, 0xF9, 0x88, 0x73, 0x79, 0x6B, 0xAC, 0xB9, 0x84, 0x93, 0x9, 0xD6, 0x12, 0xF0, 0x69, 0xD5, 0xC2, 0x67, 0xAB, 0xA9, 0x44, 0xB0, 0x93, 0x5A, 0xC2, 0x5D, 0x97, 0xD1, 0x7F, 0xE2, 0xFA, 0xB3, 0x25, 0xA8, 0xA7, 0x4, 0x44, 0x48, 0x9F, 0x4, 0x5C, 0x9F, 0xB, 0xCA, 0xD4, 0x18, 0x4D, 0x1C, 0x9E, 0x59, 0xE3, 0x5B, 0xFA, 0x3E, 0x81, 0xD, 0x92, 0xEF, 0x13, 0x68, 0xAD, 0x49, 0x8F, 0xBB, 0xA7, 0xB2, 0x6C, 0x6C, 0x90, 0x9C, 0x67, 0xA3, 0xA5, 0xA7, 0xF7, 0xC8, 0xD4, 0xDE, 0x92, 0xF7, 0xE6, 0xB6, 0xF6, 0xE4, 0xBA, 0xDC, 0x97, 0xAE, 0x6F, 0xAD, 0xF7, 0x3A, 0x5B, 0xD6, 0x53, 0x3E, 0x25, 0x40, 0x2F, 0x69, 0xBD, 0x71, 0x7C, 0xCC, 0xB2, 0xA2, 0x28, 0xD3, 0x1C, 0xAD, 0xF6, 0xE4, 0x47, 0xDD, 0x10, 0x89, 0x20, 0xB6, 0x13, 0xE5, 0x6D, 0xD7, 0xE7, 0xB2, 0x32, 0x9F, 0x38, 0x68, 0x45, 0xD1, 0xF7, 0x20, 0x73, 0xE, 0xAF, 0x8F, 0x1, 0xD5, 0x6B, 0x34, 0x9A, 0x49, 0x1, 0xF2, 0x84, 0xA1, 0xC5, 0x55, 0x9B, 0x72, 0xC, 0x9E, 0xBA, 0x80, 0x6E, 0x83, 0x80, 0xFB, 0xE7, 0x5A, 0x9B, 0x75, 0x47, 0x2E, 0x10, 0x6C, 0x80, 0xF4, 0x42, 0x54, 0x5E, 0xB9, 0xFA, 0x54, 0x56, 0xA4, 0x7A, 0xBF, 0xFA, 0x58, 0x54, 0xA5, 0x96, 0x10, 0x80, 0xF5, 0x3, 0x9B, 0xEF, 0x86, 0xBC, 0xCB, 0xE6, 0x89, 0xC8, 0x4, 0xE4, 0x69, 0x23, 0x9B, 0xEF, 0xC0, 0x59, 0x23, 0x9B, 0xEF, 0xA3, 0xFE, 0x64, 0x23, 0x5F, 0xFF, 0x4B, 0xA5, 0xD9, 0x25, 0x7E, 0xF5, 0xAD, 0xDF, 0x69, 0x3E, 0x9B, 0xF5, 0x78, 0x5F, 0xD2, 0xB0, 0x9C, 0x30, 0x3E, 0x65, 0x40, 0xCE, 0xD0, 0x98, 0x13, 0xB6, 0xD0, 0x18, 0x8C, 0xC7, 0xEB, 0x3E, 0xDC, 0xC9, 0xBE, 0x6D, 0x65, 0x5A, 0x71, 0x8C, 0x2B, 0xE9, 0x79, 0xE, 0x91, 0xEC, 0xDF, 0x56, 0xB2, 0x4D, 0x0, 0x64, 0x33, 0xBE, 0xD3, 0x27, 0xDF, 0x60, 0xC5, 0x31, 0xAE, 0x7B, 0x18, 0xC3, 0xC0, 0x4, 0x86, 0x57, 0xB6, 0x5D, 0x2, 0xE1, 0xBB, 0xD9, 0x49, 0xDF, 0xEC, 0xD9, 0x32, 0xF6, 0x51, 0x4, 0x5A, 0xEC, 0x2A, 0xA, 0x36, 0x55, 0x79, 0xDE, 0xFB, 0xBD, 0x64, 0x50, 0x1F, 0x58, 0x34, 0x1E, 0x0, 0xEB, 0xE3, 0x5D, 0xD6, 0x4F, 0xCD, 0x6F, 0x76, 0xD8, 0x70, 0x90, 0x8D, 0xB8, 0x36, 0xE4, 0xF, 0xD5, 0x7E, 0xBD, 0x34, 0xE4, 0x1B, 0xA1, 0x46, 0x2E, 0xAC, 0x8C, 0xC0, 0x82, 0xA, 0xE5, 0x46, 0x45, 0xF, 0x4A, 0xDD, 0xE6, 0xD5, 0x2, 0x77, 0x79, 0xD5, 0xA3, 0xD6, 0x94, 0x2F, 0x5B, 0x9F, 0x8B, 0xBF, 0xEA, 0xA3, 0xA5, 0x6, 0x78, 0x93, 0xF6, 0xAA, 0x20, 0xE2, 0xAF, 0x1B, 0xED, 0xE9, 0x23, 0x7A, 0x3B, 0x22, 0x95, 0xC0, 0xC4, 0x91, 0xBC, 0xA0, 0xA6, 0x22, 0xC8, 0xDD, 0x34, 0x55, 0x30, 0x65, 0xDD, 0xD3, 0x17, 0xDD, 0xBC, 0x60, 0x90, 0xEC, 0x66, 0xA2, 0x9E, 0xE7, 0x44, 0x65, 0x89, 0x7E, 0xA1, 0x40, 0x7A, 0xE8, 0x76, 0xB2, 0x94, 0xED, 0xAD, 0x8F, 0xA5, 0x5A, 0xB1, 0xED, 0x68, 0x72, 0x9D, 0xAA, 0xD1, 0x43, 0x84, 0x83, 0x0, 0x34, 0x48, 0x28, 0xF2, 0xD1, 0x26, 0xEB, 0x7B, 0x7A, 0x5, 0xFF, 0x85, 0x43, 0x2B, 0xF8, 0x93, 0xF5, 0x64, 0xBF, 0xBD, 0x3E, 0x17, 0x5F, 0xCE, 0xD3, 0xF7, 0x93, 0x3C, 0x11, 0x99, 0xE0, 0x2C, 0x21, 0xC7, 0xA, 0x83, 0xDE, 0x81, 0x4E, 0xC2, 0x84, 0xE4, 0x60, 0x9A, 0x42, 0x3B, 0xF2, 0x65, 0x8, 0xED, 0x88, 0x96, 0x20, 0xE4, 0x81, 0x69, 0x42, 0x1B, 0x22, 0x12, 0x90, 0xF3, 0xFE, 0x93, 0x79, 0xFE, 0x17, 0xD5, 0xCB, 0x35, 0x7E, 0x5B, 0xBC, 0x4D, 0xB3, 0x6E, 0xC9, 0x7B, 0x57, 0x9E, 0x42, 0x6A, 0x97, 0x82, 0x8C, 0x3F, 0x2C, 0xDD, 0x5F, 0x7F, 0x7D, 0x92, 0x9E, 0xA9, 0x42, 0xEA, 0x53, 0xBC, 0x24, 0x77, 0x93, 0x7C, 0xD0, 0xB9, 0x4A, 0x36, 0x9A, 0x7, 0x9F, 0xAD, 0xE4, 0x2B, 0x91, 0x6E, 0x5B, 0x93, 0xD2, 0x10, 0xA8, 0x34, 0xCC, 0x7, 0x1, 0x55, 0x25, 0x70, 0x42, 0xA6, 0x3D, 0xBD, 0xF8, 0xA0, 0xF6, 0x54, 0xC4, 0xE7, 0xF9, 0x84, 0x6B, 0x9E, 0xDF, 0x21, 0x4A, 0x9D, 0xED, 0x99, 0x24, 0x31, 0xBB, 0x84, 0xDA, 0x45, 0x10, 0xCA, 0x36, 0xF8, 0x1C, 0xC3, 0xA4, 0xE7, 0x99, 0x71, 0x47, 0x5F, 0x64, 0x30, 0x8F, 0xF4, 0x52, 0x99, 0x98, 0x5B, 0x6C, 0x7E, 0x91, 0x81, 0x69, 0x4D, 0xD4, 0x74, 0x8A, 0xCF, 0x3F, 0xD4, 0x8E, 0xFF, 0x9E, 0xE8, 0x93, 0x4A, 0x17, 0x59, 0x84, 0xAF, 0xA6, 0xB, 0x51, 0xCF, 0x31, 0x7C, 0x98, 0x36, 0xD3, 0x1B, 0x1A, 0xAA, 0x17, 0xFE, 0x22, 0x7, 0x6D, 0x6F, 0xA8, 0xEC, 0x31, 0x78, 0xFA, 0xD2, 0x88, 0x17, 0xC, 0x7F, 0xF8, 0xEB, 0xE2, 0xDB, 0x32, 0xCC, 0x3A, 0xB1, 0xC9, 0xA7, 0x8, 0x4C, 0xDA, 0x87, 0xC0, 0xBE, 0x73, 0xC5, 0x10, 0x12, 0xEB, 0xB3, 0x89, 0xA5, 0x61, 0xA5, 0x30, 0x2D, 0xF5, 0xF7, 0x1B, 0xAE, 0x49, 0xAB, 0xDF, 0x65, 0x55, 0x83, 0xB5, 0xF0, 0x8F, 0x2D, 0x5F, 0x66, 0x98, 0x8D, 0xCF, 0x96, 0xCE, 0xA4, 0x56, 0x7F, 0x62, 0x17, 0x54, 0x45, 0x52, 0x65, 0x7B, 0x92, 0x5D, 0xED, 0x97, 0x5F, 0xB0, 0xD7, 0x2B, 0x9D, 0x81, 0xFE, 0x5, 0xC3, 0x35, 0xEB, 0x4C, 0xD6, 0x75, 0x7F, 0xED, 0xE2, 0x5A, 0x22, 0x1D, 0xEB, 0x66, 0xAC, 0xEA, 0x20, 0xA6, 0xDA, 0x7, 0x64, 0xB3, 0x3E, 0xE9, 0x64, 0xA9, 0x1, 0xE5, 0xA, 0xAA, 0x8A, 0x22, 0x45, 0xF4, 0xA9, 0xB1, 0x44, 0xD1, 0x76, 0x9E, 0x2, 0x62, 0x34, 0xE4, 0x49, 0xF5, 0x8D, 0x85, 0x3D, 0xB1, 0xFE, 0x1B, 0xC9, 0xB4, 0x50, 0xE9, 0x45, 0x77, 0x9A, 0x82, 0x62, 0x44, 0x86, 0x9A, 0xBD, 0x6F, 0x34, 0x46, 0x5E, 0x86, 0x5E, 0x25, 0xC9, 0x94, 0x87, 0x1B, 0x9, 0x9B, 0xF1, 0xF0, 0x77, 0x92, 0x2B, 0xD7, 0xE5, 0x56, 0x9A, 0x2B, 0xD6, 0xD3, 0x7A, 0xFA, 0xE6, 0xE5, 0x9D, 0x7, 0xF5, 0x46, 0x3E, 0xCD, 0xE4, 0x6C, 0x73, 0xA3, 0x99, 0xE5, 0xEB, 0xF1, 0x77, 0x1A, 0x22, 0x2F, 0xF4, 0x93, 0x45, 0xBC, 0xE4, 0x7C, 0x93, 0x24, 0xD7, 0x1E, 0x36, 0x12, 0x76, 0xDD, 0xE1, 0x2B, 0x49, 0xF1, 0xF2, 0xFC, 0xED, 0x3, 0x4F, 0x11, 0x25, 0xDC, 0x43, 0xF0, 0x95, 0xF4, 0xDE, 0x4E, 0x4B, 0xCA, 0x86, 0xD9, 0xB, 0x7B, 0x94, 0xC0, 0x5C, 0x1, 0x6D, 0xD4, 0xAC, 0x31, 0xBF, 0x6, 0x6F, 0x4B, 0xC3, 0x44, 0x7, 0xA6, 0xA5, 0x81, 0x82, 0x2, 0xD3, 0xD2, 0x38, 0x43, 0x8E, 0x1D, 0x57, 0x40, 0x56, 0xA7, 0xAE, 0xB3, 0xAF, 0x3F, 0xCF, 0x5E, 0x65, 0xFF, 0xD4, 0xA6, 0x3A, 0x7C, 0x71, 0xB0, 0x4C, 0xB5, 0x39, 0xF1, 0x58, 0x80, 0xBF, 0xF8, 0x10, 0xF3, 0x70, 0xF9, 0x66, 0x1A, 0xED, 0xF4, 0x23, 0x78, 0xFD, 0x99, 0xDA, 0xE0, 0x68, 0xB2, 0x5D, 0x56, 0x99, 0x46, 0x3D, 0xA5, 0xCB, 0xD0, 0x43, 0xB1, 0x3F, 0xF4, 0xFA, 0x96, 0x9B, 0xF6, 0xA7, 0x49, 0xF9, 0x9D, 0x5F, 0x5B, 0xE5, 0xFF, 0xB9, 0x5F, 0xA4, 0x47, 0x4E, 0x1E, 0x1A, 0x2C, 0x79, 0x6E, 0x8B, 0xA6, 0x47, 0xB7, 0x68, 0x7A, 0xFA, 0x70, 0x8D, 0x3F, 0x39, 0x59, 0xF3, 0xFA, 0x2C, 0x64, 0x7, 0xD, 0x5C, 0x1E, 0xD1, 0xFA, 0xF9, 0xDE, 0x77, 0x64, 0x7D, 0xCE, 0x77, 0x55, 0x10, 0xB1, 0xD8, 0xE9, 0x23, 0xDC, 0x8D, 0xCF, 0x64, 0xE5, 0xFD, 0xA1, 0x5A, 0x9F, 0xD4, 0x2A, 0xF0, 0x5B, 0x35, 0x52, 0x5D, 0xAE, 0xCF, 0x74, 0x25, 0x64, 0xA4, 0x12, 0xC8, 0x30, 0x93, 0x7, 0xC2, 0x4C, 0xFE, 0x46, 0xF5, 0x62, 0x5E, 0xDD, 0xE4, 0xE, 0x8C, 0xF2, 0x19, 0xA2, 0xB3, 0x8, 0x9A, 0x5C, 0xC2, 0xAB, 0x8F, 0x45, 0x55, 0x6A, 0xB4, 0x17, 0x94, 0x16, 0xA7, 0xFA, 0x41, 0x53, 0xE6, 0x19, 0x94, 0x16, 0x67, 0xC5, 0x81, 0xBF, 0xD5, 0xB6, 0x60, 0xE0, 0xE4, 0x3C, 0x90, 0x99, 0x89, 0x83, 0x92, 0x73, 0x13, 0x7, 0x4D, 0xD6, 0xB2, 0xD2, 0x40, 0x45, 0xA7, 0xAC, 0xA7, 0xCE, 0x5C, 0x8D, 0x6F, 0xF9, 0x2D, 0x2C, 0x5F, 0xA4, 0xE0, 0xB7, 0xA1, 0x5A, 0x5C, 0x50, 0xF2, 0xB9, 0x85, 0xD0, 0x50, 0x9D, 0x54, 0x50, 0x8D, 0xD8, 0x4B, 0x85, 0x86, 0x5A, 0x1A, 0xA, 0xAA, 0x11, 0xD7, 0x86, 0x2, 0x99, 0x84, 0x29, 0x28, 0x36, 0xD, 0xD3, 0xC1, 0xC0, 0xF9, 0xEB, 0x45, 0xA, 0xC1, 0xF9, 0xD, 0x23, 0x47, 0x1A, 0xAA, 0x7B, 0xB, 0x4A, 0xBC, 0xE2, 0xA2, 0xD, 0xE4, 0x6D, 0x1, 0x41, 0xB1, 0x17, 0x6, 0x1C, 0x7E, 0x94, 0x5C, 0xF0, 0xE, 0x4A, 0x5E, 0xF3, 0xE, 0x64, 0xE8, 0x39, 0x28, 0x39, 0xFA, 0x1C, 0xC8, 0xDE, 0x38, 0x28, 0xB9, 0x43, 0xE, 0x74, 0xFF, 0x13, 0xA0, 0xFE, 0x27, 0xB4, 0x94, 0xDF, 0xC, 0xAA, 0x15, 0xDD, 0x66, 0xA0, 0x66, 0x4C, 0x45, 0x53, 0x9E, 0x90, 0x84, 0xCE, 0xC4, 0x85, 0xB0, 0xAC, 0x3F, 0x54, 0x81, 0x54, 0xC1, 0x24, 0x87, 0x1F, 0x3A, 0x43, 0x5A, 0x56, 0x79, 0x2C, 0x15, 0xBC, 0x33, 0x69, 0xE, 0x74, 0xC7, 0xB3, 0x83, 0x58, 0xDD, 0x75, 0x54, 0x70, 0x39, 0xA8, 0x8E, 0xB, 0x2E, 0x1F, 0x55, 0xF9, 0x83, 0x1E, 0xA1, 0x43, 0xCF, 0x77, 0x4, 0xD3, 0x2D, 0xEB, 0x39, 0x4A, 0xB2, 0x1C, 0x2F, 0x54, 0x2C, 0x8E, 0xB9, 0x19, 0x4F, 0xAE, 0xC7, 0x6, 0xB5, 0x41, 0x22, 0xC5, 0xF5, 0x6A, 0xC8, 0x2E, 0xA6, 0x3E, 0x97, 0x5E, 0xE4, 0x6A, 0xBD, 0xF6, 0xAD, 0x25, 0xC3, 0x65, 0x41, 0xBD, 0x50, 0xB1, 0x14, 0x3D, 0xDD, 0x59, 0xF5, 0x72, 0x67, 0xD5, 0xD3, 0xBF, 0xDD, 0xCB, 0x2D, 0xB7, 0x67, 0x12, 0xE5, 0x84, 0x1E, 0xCB, 0x94, 0x13, 0x7A, 0x6A, 0x19, 0x3D, 0xA8, 0x5E, 0x5C, 0x47, 0xA7, 0x53, 0x6E, 0x22, 0xE9, 0x36, 0x83, 0xA5, 0x26, 0x1D, 0x41, 0x59, 0x71, 0xCA, 0x11, 0x2C, 0x5D, 0x51, 0x56, 0xAE, 0x28, 0x4B, 0xFB, 0x29, 0x2B, 0x1B, 0x3A, 0x39, 0xD3, 0xF, 0xFC, 0x24, 0xFF, 0xA0, 0x49, 0xFB, 0x37, 0x2B, 0xFB, 0x37, 0x72, 0x6C, 0x8C, 0x64, 0xDC, 0xC, 0x1F, 0xF4, 0x58, 0xEB, 0x43, 0x6E, 0x7, 0xE4, 0x56, 0x2F, 0x64, 0xBF, 0x58, 0x18, 0xE9, 0xDF, 0x1C, 0xE5, 0xDF, 0x24, 0x77, 0xF4, 0x5, 0x60, 0x2F, 0x5F, 0x18, 0x23, 0x6D, 0x44, 0xE5, 0xB9, 0xD8, 0x52, 0x27, 0x2A, 0x1E, 0x15, 0xD4, 0x24, 0x2E, 0x5E, 0x85, 0xA9, 0x3B, 0x5B, 0x9A, 0x5E, 0x61, 0xD4, 0x83, 0x4E, 0x93, 0x69, 0x4F, 0xD7, 0xCE, 0x9F, 0x12, 0x6F, 0x30, 0xF6, 0xE4, 0xB7, 0x58, 0x1, 0x48, 0xFD, 0xAA, 0x3D, 0x3D, 0x2B, 0x7C, 0xC2, 0xD0, 0xD4, 0x30, 0x4C, 0xE4, 0xA2, 0x56, 0x98, 0x14, 0xBB, 0xA4, 0x75, 0x54, 0xE, 0x3D, 0xB9, 0xEA, 0xB3, 0x1, 0xE2, 0x8B, 0x4, 0x6F, 0xF5, 0xF4, 0x69, 0xA7, 0xC9, 0x6A, 0x77, 0xBE, 0x67, 0x98, 0x90, 0x85, 0x6B, 0x3B, 0x44, 0xDD, 0x85, 0x79, 0xD2, 0xD9, 0xE8, 0xE5, 0xEC, 0x7, 0xE, 0x62, 0x30, 0x37, 0xDD, 0x21, 0x8A, 0x97, 0xEE, 0x4A, 0x77, 0x77, 0xBF, 0x77, 0x69, 0xF7, 0x2A, 0x7D, 0xB2, 0x63, 0x6E, 0xC3, 0x51, 0x36, 0x92, 0x2, 0x50, 0xA3, 0x47, 0xD3, 0x4E, 0x9C, 0x24, 0x7, 0xA7, 0xC9, 0x96, 0xEE, 0x94, 0x96, 0x9B, 0xBA, 0xD3, 0x54, 0x6E, 0x84, 0xA2, 0x2B, 0xE6, 0x47, 0x8, 0x8E, 0x9E, 0xB8, 0x3A, 0x64, 0xE6, 0xEA, 0xC8, 0x63, 0x49, 0x45, 0x57, 0x9E, 0x2, 0x38, 0x7A, 0x14, 0xE3, 0x94, 0x7C, 0x31, 0x44, 0x70, 0xF4, 0x64, 0xD7, 0x21, 0xB3, 0x5D, 0x47, 0xDE, 0x8, 0x51, 0x74, 0x65, 0x4F, 0xEE, 0xC8, 0x94, 0xC8, 0x45, 0x57, 0x1E, 0x49, 0x38, 0x4D, 0x4E, 0xD1, 0x9C, 0xD2, 0xF2, 0x1C, 0xCD, 0x69, 0x72, 0x12, 0xE1, 0x94, 0x96, 0x6E, 0xC2, 0x6B, 0x83, 0x23, 0xF3, 0x20, 0x7, 0xA7, 0xE4, 0x54, 0xC8, 0xC1, 0xD1, 0x93, 0x69, 0x87, 0xCC, 0xA6, 0x5D, 0x43, 0x6, 0x61, 0x9C, 0x6A, 0xE4, 0x28, 0x8C, 0xA3, 0xA7, 0xE2, 0xE, 0x99, 0x8B, 0xBB, 0x86, 0xB1, 0x8D, 0x6, 0xB0, 0xD, 0x7A, 0x22, 0xEF, 0xF8, 0x99, 0xFC, 0x51, 0x97, 0xC, 0xA3, 0x38, 0x60, 0xFB, 0x76, 0xA0, 0x37, 0x50, 0x7, 0x64, 0x3, 0x75, 0x70, 0x64, 0x46, 0xE6, 0xA2, 0x2B, 0x3B, 0x60, 0x47, 0x5D, 0x2F, 0x5D, 0x75, 0xE5, 0xD1, 0x9B, 0xA3, 0x6E, 0xC7, 0xAC, 0xBA, 0xF2, 0x78, 0xDC, 0x51, 0xB7, 0x3A, 0x56, 0x5D, 0xC0, 0x9E, 0x5B, 0x4D, 0x6E, 0x8C, 0xD8, 0x0, 0x59, 0x9D, 0x31, 0x2D, 0x20, 0x6A, 0xE1, 0xE8, 0xE0, 0x83, 0x43, 0xA2, 0xF, 0x74, 0x58, 0xFD, 0x2C, 0x74, 0x7E, 0xD4, 0x65, 0x4C, 0xB, 0x88, 0x85, 0xBA, 0x96, 0x71, 0x59, 0x2D, 0xE0, 0xB2, 0x5A, 0xE2, 0xAA, 0xD5, 0xAA, 0x2B, 0x5D, 0xB7, 0x5A, 0x74, 0x19, 0x77, 0xD7, 0x2, 0xEE, 0xAE, 0x65, 0x4C, 0xAB, 0x5, 0x4C, 0xAB, 0x23, 0x7, 0x63, 0x4E, 0x75, 0xF2, 0xD2, 0x7C, 0x70, 0x1D, 0xF3, 0x81, 0x3B, 0xE0, 0x3, 0x77, 0xCC, 0x47, 0xEA, 0x80, 0x8F, 0xD4, 0x31, 0xED, 0xA1, 0x3, 0xDA, 0x3, 0x3D, 0xA3, 0x91, 0x8F, 0x73, 0x5, 0x67, 0x98, 0x11, 0x87, 0x1, 0x46, 0x1C, 0x74, 0x14, 0xC3, 0x29, 0x20, 0x8A, 0xE1, 0xC, 0x33, 0xE2, 0x30, 0xC0, 0x88, 0x83, 0x8E, 0x67, 0x39, 0x5, 0x4, 0xB4, 0x1C, 0xB9, 0xC, 0x5A, 0x74, 0x1, 0xB7, 0x71, 0xFD, 0xA0, 0x75, 0xAF, 0x1F, 0x80, 0x2E, 0x63, 0x55, 0x57, 0xC0, 0xAA, 0xC8, 0x13, 0xC8, 0x45, 0x17, 0xE8, 0x19, 0xE8, 0x20, 0x8F, 0x3, 0x6A, 0x99, 0x9E, 0x9A, 0x39, 0x5, 0xCC, 0xCC, 0x5C, 0xCF, 0xF4, 0xFB, 0x3D, 0xD0, 0xEF, 0xF7, 0x8C, 0x73, 0xEE, 0x1, 0xE7, 0x4C, 0x47, 0xA6, 0x1C, 0x12, 0x9A, 0x72, 0x3D, 0xE3, 0x9C, 0x7B, 0xC0, 0x39, 0xF7, 0x64, 0x30, 0xC2, 0xA9, 0x5E, 0x8E, 0x46, 0xB8, 0x9E, 0xF9, 0xBA, 0x3D, 0xF2, 0x75, 0x19, 0x6B, 0xEE, 0x1, 0x6B, 0xEE, 0x99, 0x4E, 0xA1, 0x7, 0x3A, 0x5, 0x3A, 0xC, 0xE7, 0x90, 0x38, 0x9C, 0x1B, 0xC8, 0x50, 0xB0, 0x53, 0x83, 0xB8, 0xD1, 0x38, 0x38, 0x32, 0xA9, 0x7C, 0xD1, 0x5, 0xBE, 0xD1, 0xC0, 0xD4, 0xF3, 0x0, 0xD4, 0xF3, 0xC0, 0xD4, 0xF3, 0x0, 0xD4, 0x33, 0x1D, 0xCA, 0x73, 0x48, 0x2C, 0xCF, 0x91, 0x5B, 0x5C, 0x8B, 0x2E, 0xD0, 0x9F, 0xD8, 0x1F, 0x8C, 0xEE, 0xF, 0x40, 0x97, 0xF1, 0xB0, 0x16, 0xF0, 0xB0, 0x1F, 0x4C, 0x99, 0x3F, 0x80, 0x32, 0x8F, 0x4C, 0xAF, 0x30, 0x2, 0xFE, 0x6A, 0x64, 0xFC, 0xC6, 0x8, 0xF8, 0x8D, 0x91, 0xF9, 0x46, 0x23, 0xF0, 0x8D, 0x46, 0xA6, 0xED, 0x8F, 0x40, 0xDB, 0x1F, 0x99, 0x1, 0xD2, 0x8, 0xC, 0x90, 0x46, 0xA6, 0xD, 0x8E, 0x40, 0x1B, 0x1C, 0x99, 0xB1, 0xC6, 0x8, 0x8C, 0x35, 0xC8, 0xC3, 0x1F, 0xC1, 0xF1, 0xC7, 0x3F, 0x8E, 0xBA, 0xCC, 0x5C, 0x72, 0x2, 0xE6, 0x92, 0x74, 0x14, 0xD4, 0x21, 0x61, 0x50, 0x37, 0x31, 0x3E, 0x67, 0x2, 0x7C, 0xCE, 0xC4, 0xF8, 0x8D, 0x9, 0xF0, 0x1B, 0x13, 0x33, 0x78, 0x9E, 0x80, 0xC1, 0xF3, 0xC4, 0x7C, 0xDF, 0x9, 0xF8, 0xBE, 0xAE, 0xD1, 0x9E, 0x7E, 0xE5, 0x15, 0x91, 0x9, 0x98, 0x86, 0x88, 0xC, 0x1C, 0x1C, 0x53, 0xD9, 0xE, 0xF9, 0x5D, 0xA6, 0x3, 0x77, 0x40, 0x7, 0xEE, 0x18, 0xC7, 0xE3, 0x0, 0xC7, 0x43, 0x9E, 0x4A, 0x2A, 0xBA, 0x80, 0xC3, 0x73, 0x4C, 0xE7, 0xE0, 0x80, 0xCE, 0xC1, 0x31, 0x9D, 0xB0, 0x3, 0x3A, 0x61, 0xB7, 0x78, 0xC3, 0x4, 0xC1, 0xA, 0x4, 0x5, 0xC2, 0xDC, 0xE2, 0xB7, 0xFC, 0xB2, 0x7C, 0xD0, 0xF4, 0x20, 0x4, 0x47, 0x4E, 0x5F, 0x2A, 0x27, 0xD1, 0xD8, 0xA3, 0xD4, 0x5B, 0xCC, 0xE4, 0x61, 0xDA, 0x27, 0x4, 0x52, 0x5C, 0xE6, 0x18, 0x98, 0x89, 0xE2, 0x4B, 0x0, 0x9B, 0x32, 0xBE, 0xE4, 0xE9, 0x6D, 0x96, 0x5F, 0x18, 0x91, 0x6D, 0x96, 0xBB, 0x82, 0x50, 0x77, 0x19, 0x5E, 0xFE, 0x76, 0x8E, 0xF1, 0x44, 0xE, 0xF0, 0x44, 0x8E, 0xE9, 0xA5, 0x1C, 0xD0, 0x4B, 0x39, 0xEA, 0x46, 0xBD, 0xA2, 0xCB, 0xDD, 0xA8, 0x77, 0xD4, 0x65, 0x3C, 0xA0, 0x3, 0xBE, 0x31, 0x79, 0xAD, 0x48, 0x70, 0xC0, 0xBD, 0x22, 0xC1, 0x79, 0x26, 0x92, 0xEE, 0x81, 0x48, 0x3A, 0xBD, 0x8C, 0xE4, 0xF8, 0x65, 0xA4, 0xA3, 0x2E, 0x33, 0x82, 0x91, 0xB3, 0xC, 0x4, 0xE7, 0x99, 0x5E, 0xCA, 0x3, 0xBD, 0x14, 0xB3, 0x7E, 0xEB, 0xA0, 0x15, 0x5C, 0xC7, 0x2C, 0x93, 0x28, 0x64, 0xA5, 0x64, 0x66, 0xBE, 0xD2, 0xC, 0x7C, 0xA5, 0x59, 0x7B, 0xDA, 0x3E, 0x2A, 0x0, 0xA8, 0x93, 0xD7, 0xDF, 0x6D, 0x80, 0xAC, 0xCE, 0xCC, 0x8E, 0x67, 0x44, 0x97, 0xB1, 0xAF, 0x19, 0xB0, 0xAF, 0x99, 0x99, 0x59, 0xCF, 0xC0, 0xCC, 0x7A, 0x66, 0x3A, 0xE6, 0x19, 0xE8, 0x98, 0xC9, 0xC4, 0x22, 0x45, 0x17, 0xE8, 0x98, 0x67, 0xC6, 0x36, 0x67, 0xC0, 0x36, 0x67, 0xA6, 0x63, 0x9E, 0x81, 0x8E, 0x79, 0x66, 0x3A, 0xE6, 0x19, 0xB0, 0xEA, 0x79, 0xC9, 0xE6, 0xF2, 0xED, 0xB8, 0x27, 0xFA, 0x77, 0xAE, 0x3F, 0xD4, 0x53, 0xF0, 0xEB, 0x5E, 0xE8, 0x2F, 0xF4, 0xE4, 0x9E, 0xAC, 0xAA, 0x6, 0xB2, 0xFF, 0xCB, 0xDC, 0x54, 0xD8, 0x99, 0x61, 0xFF, 0x35, 0x14, 0xCD, 0xFF, 0x40, 0x4D, 0xAF, 0x4F, 0x1F, 0x5B, 0xDB, 0x88, 0x37, 0x9, 0x8C, 0x8E, 0x36, 0x91, 0x15, 0x81, 0x3E, 0x56, 0x1C, 0x6C, 0xCA, 0x21, 0x9E, 0x75, 0x99, 0x47, 0x31, 0xB8, 0xE3, 0xAC, 0x4A, 0x12, 0x29, 0xCE, 0xC6, 0x74, 0x69, 0x33, 0xE0, 0xB1, 0x7E, 0x30, 0xCE, 0xF2, 0x7, 0xE0, 0x2C, 0x19, 0x5F, 0xA7, 0x10, 0x57, 0x47, 0x9E, 0xDA, 0x28, 0xBA, 0xC0, 0xB8, 0x9A, 0xBC, 0xF, 0xB5, 0xE8, 0x2, 0xFB, 0xBE, 0x1D, 0x79, 0xED, 0x75, 0x70, 0x4A, 0xBE, 0xF8, 0x3A, 0xB8, 0xC4, 0xCC, 0x34, 0x13, 0x30, 0xD3, 0x4C, 0xCC, 0x4C, 0x33, 0x1, 0x33, 0xCD, 0x44, 0xEE, 0xF3, 0x71, 0x2A, 0xC9, 0xBB, 0xC5, 0x1C, 0x99, 0xE1, 0xBD, 0xE8, 0x22, 0x63, 0xD0, 0xC4, 0x44, 0xF2, 0x13, 0x12, 0xC9, 0x4F, 0xCC, 0xA8, 0x25, 0x1, 0xA3, 0x16, 0xF2, 0x16, 0xED, 0xA2, 0xB, 0xF4, 0x48, 0x89, 0xE9, 0x55, 0x12, 0xD0, 0xAB, 0x24, 0x26, 0x16, 0x98, 0x80, 0x58, 0x60, 0x62, 0x4C, 0x3A, 0x1, 0x26, 0x4D, 0xEF, 0xD3, 0x76, 0x8A, 0xDD, 0xA2, 0x7D, 0xD4, 0x65, 0x7A, 0x24, 0x64, 0xD1, 0x32, 0x31, 0x23, 0xF8, 0x4, 0x8C, 0xE0, 0x13, 0xE3, 0x76, 0x12, 0xE0, 0x76, 0x32, 0xB3, 0xEA, 0x91, 0x81, 0x55, 0x8F, 0xCC, 0x8C, 0xD, 0x33, 0xE0, 0x2A, 0x33, 0xF3, 0x7D, 0x33, 0xF0, 0x7D, 0x33, 0xF3, 0x7D, 0x33, 0xF0, 0x7D, 0x33, 0x33, 0x5A, 0xC9, 0x40, 0x47, 0x94, 0x99, 0xEF, 0x9B, 0x81, 0xEF, 0x4B, 0x9E, 0x4E, 0x2B, 0xBA, 0xC0, 0x2C, 0x8B, 0xDE, 0xDB, 0x8A, 0x4, 0x11, 0xC8, 0x13, 0x3D, 0xC1, 0x1, 0x47, 0x7A, 0x82, 0x5B, 0x18, 0xAF, 0xB1, 0x0, 0x5E, 0x63, 0x61, 0x2C, 0x72, 0x1, 0x2C, 0x72, 0x61, 0xA2, 0x97, 0xB, 0x10, 0xBD, 0xBC, 0x31, 0xBE, 0xFD, 0x6, 0x78, 0xC9, 0x1B, 0xE3, 0x9F, 0x6F, 0x40, 0x27, 0x7A, 0x63, 0xE6, 0x76, 0x37, 0x60, 0x6E, 0x77, 0x63, 0x5A, 0xC2, 0xD, 0x68, 0x9, 0xE4, 0xB9, 0xAB, 0xA2, 0xB, 0xD8, 0x6, 0x7D, 0x9E, 0x4, 0x99, 0xF5, 0x93, 0xA9, 0x34, 0x82, 0xE3, 0xD3, 0x68, 0x1C, 0x74, 0xEF, 0x4C, 0x2D, 0xDF, 0x81, 0x5A, 0xA6, 0xCF, 0x59, 0x38, 0xC0, 0x2E, 0x1E, 0x8C, 0xA7, 0x7A, 0xC8, 0x9E, 0x8A, 0xE, 0x35, 0x20, 0x91, 0x6, 0xDF, 0x5, 0xB2, 0xA2, 0xEA, 0x73, 0x51, 0xD9, 0xE4, 0xCF, 0x10, 0xE9, 0x73, 0x15, 0x3B, 0x26, 0xE, 0x1B, 0xFC, 0x95, 0xDE, 0xCE, 0xE6, 0xAF, 0xC8, 0x7E, 0x36, 0x7F, 0x35, 0xD1, 0xF8, 0xD6, 0x30, 0x27, 0x7B, 0x9F, 0x38, 0x74, 0xBE, 0x37, 0xF8, 0x2B, 0x1D, 0x2E, 0xF7, 0x57, 0x24, 0x5E, 0xEE, 0xAF, 0xF4, 0xF0, 0xC9, 0x5F, 0xA1, 0xF1, 0x13, 0x1D, 0x7B, 0x41, 0x42, 0x2F, 0x64, 0x32, 0xC3, 0x80, 0xE4, 0x31, 0xC, 0xDE, 0x2F, 0xE4, 0xF7, 0xF3, 0x5E, 0x2D, 0xC8, 0xC7, 0xA3, 0xF, 0xED, 0x79, 0xF9, 0xE0, 0x7B, 0xF0, 0x29, 0xC7, 0xA5, 0xE5, 0xCE, 0xC3, 0x1C, 0x50, 0xF1, 0xED, 0xD3, 0x32, 0xE9, 0x8E, 0xCE, 0x53, 0xB3, 0x83, 0xC8, 0xF0, 0xA6, 0x88, 0x66, 0xED, 0x33, 0x6B, 0x4A, 0x1B, 0xE, 0x9A, 0x52, 0x91, 0xA6, 0xF3, 0x14, 0xBD, 0x30, 0x94, 0xE4, 0xB4, 0x48, 0xD6, 0xF7, 0x60, 0x91, 0xB2, 0x6E, 0xE9, 0x4A, 0xAA, 0x0, 0xA0, 0xDE, 0x1B, 0x66, 0x8F, 0xE0, 0x8A, 0x21, 0x1B, 0x5, 0x7D, 0x36, 0x6E, 0xE, 0x51, 0xC7, 0xBB, 0x8E, 0x99, 0x9F, 0xD4, 0x7E, 0x11, 0x43, 0xE7, 0xB6, 0x2F, 0x25, 0x84, 0x18, 0x67, 0x8D, 0xBA, 0xCD, 0x81, 0xCC, 0x88, 0x70, 0x0, 0x1, 0x9A, 0x30, 0xB1, 0x1F, 0xB2, 0x80, 0xE0, 0x57, 0xBC, 0x19, 0x7F, 0x56, 0x71, 0x5, 0x86, 0x5F, 0x8D, 0xEE, 0xAD, 0xBC, 0xDC, 0x5B, 0x85, 0x91, 0xB1, 0xED, 0x15, 0x80, 0xD4, 0xF9, 0x6B, 0xD6, 0xBE, 0xE2, 0x22, 0x19, 0x4D, 0x1, 0x28, 0xD2, 0x47, 0x9C, 0x80, 0xB0, 0x71, 0x20, 0x57, 0xDC, 0x83, 0xBC, 0xDE, 0x1E, 0x66, 0x7A, 0xE3, 0x77, 0x98, 0x91, 0x9D, 0xDF, 0x61, 0xA6, 0x9B, 0x5F, 0x98, 0x91, 0xA6, 0x17, 0x66, 0x13, 0x75, 0xB6, 0x37, 0x3A, 0xCD, 0xF3, 0xB, 0x46, 0xF6, 0xA, 0x87, 0x99, 0xDE, 0x90, 0x12, 0x66, 0x64, 0x47, 0x4A, 0x98, 0xE9, 0xED, 0xE, 0x61, 0x46, 0xF6, 0x3B, 0x84, 0x99, 0x5E, 0x4A, 0xA, 0x33, 0xB2, 0x96, 0x14, 0x66, 0x7A, 0x54, 0x59, 0xB4, 0xE5, 0x71, 0x65, 0x98, 0xE9, 0xF8, 0x59, 0x98, 0x91, 0x0, 0x5A, 0x98, 0x23, 0xF7, 0xDB, 0x11, 0xF9, 0x6D, 0x7A, 0x72, 0x17, 0x66, 0x64, 0x76, 0x37, 0x1B, 0x3F, 0xE8, 0xFE, 0xE4, 0xE6, 0x9B, 0xA7, 0x4, 0xEA, 0x3D, 0xE8, 0x80, 0x3C, 0x1F, 0x8F, 0x3F, 0x74, 0xFE, 0xF3, 0xA4, 0x5B, 0x7A, 0xC7, 0xE8, 0x8A, 0xC8, 0x4, 0xF4, 0x19, 0xC3, 0x59, 0x1E, 0x2E, 0xCC, 0x31, 0xD0, 0x43, 0x9E, 0x39, 0x6, 0x68, 0xCC, 0x43, 0x26, 0xEB, 0x9, 0x7C, 0x9E, 0x9E, 0xD7, 0x4F, 0xD3, 0xBE, 0x9F, 0x8F, 0x62, 0x1E, 0x7E, 0x34, 0x96, 0x3E, 0xEB, 0x7C, 0x91, 0xFC, 0x29, 0x82, 0x7E, 0x3F, 0xBA, 0x47, 0x53, 0x40, 0x5F, 0x16, 0x93, 0xA5, 0xF, 0xEC, 0xAF, 0x80, 0xA8, 0x7E, 0x33, 0xF9, 0xE4, 0xB6, 0x91, 0xD, 0x87, 0x5F, 0x83, 0xFE, 0x1E, 0x40, 0x83, 0x4A, 0xC6, 0x3F, 0x34, 0xBD, 0xF2, 0x58, 0x21, 0x64, 0xF1, 0x91, 0x1E, 0x76, 0x22, 0xE3, 0xCD, 0x94, 0x17, 0x77, 0x52, 0x9, 0x15, 0x46, 0xEB, 0x20, 0xD1, 0x1D, 0x79, 0x92, 0x3B, 0xF2, 0x6C, 0xDA, 0xC1, 0x87, 0x89, 0x3B, 0xD6, 0x7C, 0x80, 0x45, 0x1B, 0xCD, 0x64, 0x18, 0x56, 0x65, 0x39, 0xA, 0x4B, 0x7, 0xF8, 0x90, 0xF8, 0x1E, 0x1D, 0xDE, 0x43, 0xA2, 0x7B, 0xB4, 0x2F, 0x45, 0x3C, 0x69, 0xA6, 0x2B, 0x3B, 0xCB, 0x95, 0x4D, 0x87, 0xBA, 0x90, 0x1B, 0xFC, 0xC3, 0xD2, 0xE, 0x73, 0xC8, 0x3A, 0x87, 0x6B, 0xB4, 0x86, 0x49, 0x5F, 0xF6, 0x8B, 0x10, 0x76, 0xEC, 0x94, 0xE, 0xA1, 0x21, 0x11, 0x34, 0xDA, 0x8D, 0xF2, 0x3E, 0xF4, 0xA8, 0xE9, 0x48, 0xEF, 0xBF, 0x8C, 0xFC, 0x89, 0x81, 0x63, 0x91, 0x7D, 0x6B, 0xD9, 0xA1, 0x74, 0x5, 0xB1, 0xA1, 0xF4, 0xE2, 0x73, 0x24, 0xCD, 0x7E, 0x5, 0x20, 0xF5, 0x75, 0x89, 0x91, 0x6F, 0xC6, 0x7, 0x21, 0xB4, 0x31, 0x2F, 0xFE, 0x6C, 0xDB, 0x4D, 0x41, 0x61, 0xA6, 0x99, 0x9E, 0x5D, 0xD7, 0xE7, 0x88, 0x32, 0xD9, 0x43, 0xAC, 0x80, 0xA8, 0x1E, 0x13, 0x7D, 0x4A, 0x62, 0x5, 0x44, 0x75, 0xBA, 0x73, 0x2, 0x12, 0x34, 0xD0, 0xB1, 0x59, 0x24, 0x34, 0x4B, 0x47, 0x57, 0x91, 0xE0, 0x2A, 0x1D, 0x5B, 0x45, 0x42, 0xAB, 0xF, 0xDA, 0x25, 0x3C, 0xE4, 0x5E, 0xF4, 0x41, 0xCF, 0x5, 0x1E, 0xC8, 0x54, 0x80, 0x8E, 0x35, 0x22, 0xA1, 0xC6, 0x7, 0xDD, 0xDB, 0x3E, 0xC4, 0xDE, 0x96, 0xDC, 0x68, 0x23, 0xEF, 0xB2, 0x99, 0x35, 0x9D, 0x63, 0x71, 0xD6, 0x27, 0xF9, 0x15, 0x9F, 0xDA, 0x3F, 0xC8, 0xF3, 0x6E, 0x3F, 0x80, 0xE3, 0x6E, 0x54, 0xDD, 0x8A, 0xF5, 0x1A, 0xF5, 0x35, 0x5B, 0xDF, 0xFF, 0xBC, 0x53, 0x26, 0xB8, 0x81, 0xAA, 0xA0, 0x92, 0x8B, 0x2B, 0xB2, 0xFC, 0xB6, 0xC5, 0x8A, 0x82, 0x3E, 0x20, 0x6A, 0xDF, 0xC4, 0x10, 0xF8, 0xD4, 0xDB, 0xBB, 0x0, 0xCA, 0xD7, 0x91, 0xC7, 0x0, 0x63, 0xC7, 0x1F, 0x3, 0x3C, 0xBC, 0x96, 0x39, 0x1F, 0xC3, 0x6E, 0x38, 0x5A, 0x14, 0xD3, 0x91, 0x83, 0xB7, 0xF5, 0x39, 0xA2, 0xDC, 0x6A, 0x32, 0x29, 0xC6, 0xB, 0x43, 0x48, 0x16, 0x4F, 0xC7, 0x30, 0xF, 0xA0, 0x48, 0xE3, 0x82, 0xA7, 0x7, 0xA2, 0x2B, 0x4, 0xC, 0x44, 0xA3, 0xF9, 0x64, 0x7A, 0xC4, 0x68, 0x3E, 0xA1, 0xEE, 0x30, 0x5A, 0xD2, 0xA1, 0x44, 0xB, 0x38, 0x94, 0x58, 0xF3, 0xEB, 0x93, 0xDA, 0x15, 0x10, 0xD5, 0x9D, 0xD1, 0xE4, 0xE0, 0xA8, 0x22, 0xC0, 0x52, 0x60, 0xB4, 0x64, 0x2C, 0x2B, 0x5A, 0x20, 0x98, 0x15, 0xE9, 0x9A, 0x47, 0x2A, 0x3D, 0xE4, 0xE0, 0xD, 0xAD, 0x5E, 0x21, 0x88, 0xE3, 0x93, 0xB4, 0x9E, 0xF2, 0x58, 0x52, 0xA5, 0xFA, 0x48, 0x79, 0xFA, 0x16, 0xE9, 0x9A, 0x46, 0x6A, 0x79, 0xB1, 0xE4, 0x12, 0xC7, 0xFA, 0x1C, 0x51, 0x26, 0x4B, 0xBC, 0x2, 0x92, 0xFA, 0x7D, 0xCE, 0xC1, 0x7, 0x67, 0x5B, 0x72, 0x87, 0xF3, 0x1F, 0xEA, 0x20, 0xF1, 0xDB, 0xE, 0x67, 0x6A, 0xD7, 0x17, 0xB9, 0xC7, 0x46, 0xDE, 0x60, 0x43, 0x56, 0x0, 0xBF, 0xC0, 0xF3, 0xD2, 0x23, 0xCD, 0x24, 0x1, 0x16, 0x92, 0x46, 0x33, 0xD9, 0x4F, 0xD3, 0x70, 0x37, 0x17, 0x24, 0xB5, 0x9, 0x80, 0x97, 0x15, 0xA6, 0x9F, 0x64, 0x7A, 0xE9, 0xF4, 0x13, 0x49, 0x2D, 0x9D, 0xC9, 0xD7, 0xC8, 0xC0, 0x6B, 0x64, 0x72, 0x48, 0x97, 0x81, 0x21, 0x1D, 0xB5, 0x3E, 0x28, 0x2E, 0xD, 0x2E, 0x56, 0x37, 0x9A, 0xF4, 0x68, 0x15, 0x1, 0x9C, 0xDA, 0x62, 0x93, 0xF5, 0x66, 0x22, 0x4F, 0x1B, 0xBD, 0x30, 0x89, 0x64, 0xCA, 0x4B, 0xD4, 0x53, 0x6B, 0xFC, 0xD9, 0x26, 0xBE, 0x2F, 0x52, 0x60, 0xF7, 0xB7, 0xE9, 0x48, 0x9C, 0xEF, 0xB0, 0xF1, 0xF3, 0x98, 0x15, 0x46, 0xB9, 0xBC, 0xA1, 0x37, 0x74, 0x17, 0x0, 0xB0, 0x94, 0x65, 0x6E, 0xF5, 0x68, 0xC8, 0x13, 0x80, 0x2B, 0xC4, 0x9F, 0x2, 0x7C, 0x19, 0xEA, 0x12, 0x6D, 0xB6, 0x9C, 0x5, 0xAC, 0x18, 0x62, 0x3, 0xF1, 0xD6, 0x19, 0x2A, 0xDA, 0xBC, 0xC4, 0x9B, 0x2A, 0x88, 0x58, 0x8C, 0x94, 0x3B, 0x73, 0xAB, 0x17, 0x93, 0x74, 0x4B, 0xCA, 0xE4, 0x71, 0xCA, 0x25, 0xE5, 0xC2, 0xA5, 0x9E, 0x52, 0xC8, 0x8D, 0x21, 0x85, 0xD8, 0xA6, 0x68, 0x5A, 0x80, 0xBB, 0xCA, 0xBD, 0x4D, 0x1F, 0x9C, 0x89, 0x97, 0xD0, 0x5A, 0x6E, 0x6F, 0xF0, 0x41, 0x4, 0xDA, 0x16, 0xBC, 0xC9, 0xBB, 0x7B, 0x1E, 0x6C, 0x7B, 0x69, 0x8C, 0x4E, 0x99, 0xDE, 0x77, 0xBC, 0x4B, 0xAA, 0x2F, 0xA2, 0x50, 0x9A, 0xC7, 0x4D, 0x33, 0xCD, 0xC6, 0x74, 0xB3, 0x8E, 0xD9, 0x9B, 0x48, 0x9E, 0xB0, 0xD9, 0x7F, 0xE1, 0x28, 0x88, 0x9C, 0xB4, 0xA9, 0x7A, 0xC2, 0x4D, 0x4, 0xAB, 0xD0, 0xD9, 0x45, 0x4, 0xC, 0xF3, 0x1C, 0x43, 0x27, 0x7F, 0xCD, 0x22, 0xF5, 0xF6, 0xB7, 0x8C, 0x26, 0xC7, 0x90, 0xFC, 0xD2, 0x30, 0x61, 0x9B, 0xC2, 0xF4, 0x94, 0x51, 0x67, 0x51, 0x9C, 0x2F, 0xB4, 0xD9, 0xD4, 0x5B, 0x72, 0xE5, 0x42, 0x6F, 0x82, 0xEF, 0x95, 0x9B, 0x6A, 0x77, 0xE2, 0x2, 0xCF, 0x8D, 0x1C, 0xDD, 0xDC, 0x80, 0xD1, 0xD, 0x35, 0xB3, 0x15, 0xA7, 0xB5, 0xD4, 0xE1, 0x22, 0xF1, 0x60, 0xD1, 0x4F, 0xB2, 0x8C, 0x3F, 0x91, 0x40, 0x20, 0x15, 0xAF, 0x12, 0x43, 0x55, 0x77, 0x4D, 0xA6, 0xDA, 0xBC, 0x6B, 0x3E, 0xD7, 0xE6, 0xE1, 0x17, 0x9B, 0x93, 0x8E, 0xAB, 0x80, 0x60, 0xAF, 0x70, 0xE7, 0xB2, 0xA7, 0xDF, 0xD1, 0xEC, 0xE9, 0x77, 0x7A, 0x33, 0x77, 0x7D, 0x2C, 0xFD, 0xB8, 0x8B, 0x7C, 0xC6, 0xB9, 0x2, 0xA2, 0x2F, 0x11, 0xFC, 0x64, 0xC9, 0x83, 0xAD, 0xF7, 0xE0, 0x55, 0x85, 0xC4, 0xB7, 0x8, 0x9E, 0xBE, 0x6E, 0xA7, 0x52, 0xFC, 0x76, 0xD1, 0xE, 0x4D, 0x41, 0xD6, 0x43, 0x10, 0xAB, 0x81, 0xF2, 0x56, 0xE2, 0xFC, 0xE8, 0x61, 0x26, 0xDD, 0x3D, 0xC8, 0xD5, 0xBE, 0x15, 0x2, 0x8E, 0xE0, 0x3C, 0x4C, 0xCA, 0xC, 0x43, 0x71, 0x69, 0x80, 0x3E, 0x63, 0x3C, 0xF, 0xD4, 0x78, 0x1E, 0xE4, 0x88, 0xE4, 0x21, 0xF, 0x47, 0x3A, 0xDD, 0x2C, 0xC4, 0x20, 0x62, 0x7D, 0x2C, 0xA9, 0x12, 0xE3, 0xF5, 0xF2, 0x50, 0x52, 0x33, 0xFD, 0x42, 0x65, 0xFA, 0xAC, 0x80, 0x9C, 0xEC, 0xB3, 0xD3, 0xE6, 0xC3, 0x4, 0xCF, 0x30, 0x14, 0x8, 0xE2, 0x70, 0xC1, 0xCF, 0xDA, 0x9B, 0x89, 0xF2, 0x1D, 0x2B, 0xAC, 0x2A, 0xE, 0x38, 0x91, 0x4E, 0xF7, 0x26, 0x65, 0xED, 0xA9, 0xF6, 0xBB, 0x63, 0xC0, 0x89, 0x8C, 0x5D, 0x34, 0x9D, 0xD1, 0x24, 0x80, 0xC6, 0xF6, 0xC1, 0x36, 0x3A, 0x32, 0x77, 0xCA, 0x56, 0x5C, 0x6D, 0x2, 0x72, 0x3E, 0xD7, 0x4E, 0xDB, 0x64, 0xBC, 0x1E, 0x6D, 0x26, 0xEF, 0x33, 0x5D, 0x61, 0x55, 0x71, 0x88, 0x2C, 0xF3, 0xF7, 0x9B, 0x57, 0x14, 0xBD, 0xE0, 0xBC, 0xD3, 0xF6, 0x53, 0xF, 0x36, 0x86, 0x34, 0x58, 0xFA, 0xAA, 0xD5, 0x2A, 0xA1, 0x9E, 0x22, 0x8, 0xE5, 0xC4, 0xDE, 0x91, 0x5D, 0x30, 0xCC, 0x8B, 0x76, 0x7A, 0x9A, 0x34, 0x1B, 0xDE, 0x5C, 0x51, 0x94, 0xC9, 0xF9, 0x64, 0xE2, 0xCD, 0x44, 0x72, 0x20, 0xF9, 0x82, 0x91, 0xC1, 0x63, 0xA7, 0x3D, 0xB5, 0x29, 0x61, 0x7D, 0x2C, 0x95, 0x63, 0x9E, 0xAC, 0xA1, 0x16, 0xB5, 0x56, 0x4, 0x58, 0xD6, 0xAA, 0xB7, 0xCC, 0x19, 0x1D, 0xA9, 0x55, 0xD4, 0x3F, 0xD4, 0x7A, 0x7, 0xDD, 0xA, 0xB, 0xE3, 0xEC, 0x4E, 0x67, 0x62, 0x74, 0x51, 0x9F, 0x4A, 0x2F, 0x91, 0x75, 0xA3, 0x13, 0x3B, 0x9, 0xDC, 0x71, 0xF4, 0xD3, 0x50, 0x7D, 0x5C, 0xF7, 0xEB, 0x79, 0x3C, 0x5A, 0xF1, 0xBA, 0x8C, 0xCB, 0x89, 0xD5, 0x66, 0xA3, 0x9E, 0x12, 0x88, 0xD1, 0x66, 0x33, 0x84, 0x71, 0xD4, 0xB6, 0x23, 0x73, 0x36, 0x57, 0xBE, 0x5D, 0x0, 0xA2, 0x23, 0xF7, 0xBE, 0x6D, 0xCF, 0x45, 0xE5, 0x44, 0x65, 0xD3, 0xDF, 0x9E, 0x8B, 0xCA, 0x39, 0x4C, 0xA1, 0xD5, 0x13, 0x63, 0xED, 0x3B, 0x8C, 0x59, 0xFB, 0x2E, 0x4D, 0xC6, 0xD3, 0xE, 0x64, 0x40, 0xBA, 0x80, 0x2A, 0x1D, 0xCD, 0xA4, 0xEF, 0x7C, 0xC9, 0x2A, 0xC, 0x97, 0xEC, 0xD3, 0x34, 0x3C, 0x55, 0x8D, 0x75, 0x21, 0x44, 0xB7, 0x9B, 0xF1, 0x1F, 0x3A, 0xF6, 0x9A, 0xDA, 0xA8, 0x7C, 0x80, 0xF9, 0x1D, 0xCB, 0xAF, 0xBA, 0xBF, 0xDD, 0x4C, 0xB2, 0xB6, 0xE3, 0xA9, 0x2A, 0x8A, 0x30, 0x11, 0x63, 0xF8, 0xF2, 0x50, 0x52, 0x7B, 0x68, 0x7B, 0x5D, 0x8A, 0xDD, 0x7, 0xAE, 0x73, 0xA8, 0x12, 0x6A, 0x13, 0x1, 0x2C, 0xB9, 0xA1, 0xCC, 0x58, 0x35, 0xA2, 0x11, 0xB7, 0x54, 0x36, 0x9B, 0xFA, 0x54, 0x52, 0xA4, 0x62, 0x80, 0x5D, 0x2B, 0xC7, 0x0, 0xBB, 0xEE, 0xD2, 0xF9, 0x44, 0x4D, 0xE5, 0x57, 0x4, 0x98, 0xBB, 0x77, 0x9D, 0x4F, 0xFF, 0x4E, 0xDB, 0x54, 0x41, 0x20, 0x83, 0xEA, 0x7C, 0xBA, 0x46, 0x63, 0x58, 0x96, 0x2, 0xA2, 0x44, 0xBD, 0x31, 0x23, 0x4B, 0x54, 0x40, 0x94, 0xA8, 0xEE, 0x95, 0xE5, 0x88, 0xA, 0x88, 0x12, 0x4D, 0xF6, 0xC6, 0xBF, 0x5A, 0x1, 0x51, 0x22, 0xB2, 0x23, 0x2C, 0x5F, 0x9, 0xE8, 0x6, 0x3B, 0xE6, 0x3E, 0xCE, 0xAA, 0x8F, 0xB8, 0xA2, 0xCE, 0x27, 0xC6, 0x50, 0x7C, 0x42, 0xED, 0x84, 0xDE, 0x60, 0xB3, 0x32, 0x0, 0x93, 0x8D, 0xAE, 0x63, 0x67, 0xCC, 0x5D, 0x7, 0xCE, 0x97, 0xBB, 0x8E, 0x6A, 0x2A, 0x9D, 0xD8, 0x50, 0xA8, 0x37, 0x17, 0xBB, 0x5C, 0x43, 0xA5, 0xC1, 0xAE, 0x4F, 0x65, 0x45, 0xEA, 0xE0, 0xFC, 0xF6, 0x5C, 0x56, 0xA6, 0xDE, 0xB1, 0x3E, 0x16, 0x55, 0xB3, 0x5F, 0x48, 0x4F, 0x5C, 0x11, 0xC0, 0xB, 0x9B, 0xC6, 0x6A, 0x4F, 0x5B, 0x5A, 0x85, 0x20, 0x5B, 0x63, 0x9A, 0xB, 0xD6, 0x50, 0xC, 0xBD, 0x75, 0xBD, 0x6A, 0xB3, 0xBB, 0xD7, 0xBF, 0xA8, 0xD7, 0xFD, 0xDA, 0x65, 0x50, 0xC8, 0xF, 0xA6, 0xBF, 0x48, 0x81, 0x96, 0x67, 0xBA, 0xBB, 0xA7, 0x22, 0x79, 0x15, 0x0, 0x2, 0x77, 0x9D, 0x61, 0x66, 0xEB, 0x9D, 0x1, 0x67, 0xEB, 0x9D, 0xB9, 0x52, 0x81, 0xFB, 0xCE, 0x5C, 0xE5, 0x98, 0x7D, 0x67, 0xAE, 0xD6, 0x5B, 0xA7, 0xE9, 0x37, 0x58, 0x31, 0xE8, 0x25, 0x56, 0x51, 0xC6, 0x44, 0x36, 0x1E, 0xC8, 0x48, 0xFA, 0x48, 0xE5, 0xA9, 0xDD, 0x9E, 0x4B, 0x2F, 0x43, 0x25, 0xD8, 0xEC, 0xC, 0x9F, 0x5F, 0xF3, 0xF0, 0xBB, 0x93, 0xFE, 0xD4, 0xFC, 0x9A, 0xCE, 0x8E, 0xA3, 0x46, 0x51, 0x3A, 0x0, 0x6A, 0xBB, 0xDC, 0x13, 0x11, 0x9, 0x48, 0x63, 0x9F, 0x64, 0x1F, 0x33, 0x4D, 0x97, 0xD0, 0x4F, 0x56, 0xA7, 0x1C, 0xC9, 0x5D, 0x12, 0x5F, 0x25, 0x80, 0x88, 0x8E, 0x99, 0x26, 0x91, 0xF0, 0x3D, 0x3E, 0x67, 0xFC, 0x10, 0x62, 0x62, 0xF, 0xE6, 0x1C, 0x44, 0xE0, 0xFA, 0xE, 0x36, 0x93, 0x53, 0xA8, 0x1D, 0x11, 0x9, 0x48, 0xE7, 0xCA, 0x5F, 0x89, 0x71, 0xB4, 0x1C, 0x72, 0xA, 0x59, 0x1F, 0x4B, 0xBF, 0xEA, 0xA8, 0x90, 0x97, 0x51, 0x4E, 0x5C, 0x97, 0xEE, 0x8C, 0xB, 0x6D, 0xD4, 0xAD, 0x25, 0xCF, 0xA7, 0xBC, 0x50, 0xF9, 0x78, 0xCA, 0x26, 0x4B, 0x7E, 0xD8, 0xD, 0x91, 0x9, 0xCA, 0x6C, 0x77, 0x5D, 0x8F, 0xBD, 0x12, 0x9B, 0xFB, 0x8A, 0x84, 0x7A, 0x89, 0xA8, 0x2B, 0xB7, 0xD3, 0xEF, 0xCB, 0xEB, 0x15, 0x85, 0xCE, 0x64, 0xD3, 0xD2, 0x17, 0x9A, 0x3D, 0x49, 0x57, 0x19, 0xAC, 0x13, 0x71, 0x61, 0xBF, 0x9E, 0x9B, 0xE7, 0x7C, 0x5E, 0xE0, 0xD, 0x32, 0x7A, 0xEA, 0x44, 0x69, 0x5, 0x80, 0x43, 0xA5, 0x9D, 0xF1, 0x4E, 0x47, 0x76, 0xC3, 0xD9, 0x6, 0xA3, 0xAD, 0xC0, 0x87, 0x4B, 0xCA, 0xBA, 0xB7, 0xBE, 0x27, 0xD7, 0x61, 0x8F, 0x2, 0xC8, 0x7A, 0x6C, 0x91, 0x67, 0x89, 0x40, 0x82, 0x4C, 0xF, 0x96, 0xEA, 0x73, 0xF1, 0x75, 0xB2, 0xA5, 0x4E, 0xC, 0xEC, 0x80, 0xA4, 0x3E, 0xD3, 0x43, 0x9E, 0x19, 0x19, 0xEF, 0xCC, 0x21, 0x66, 0x43, 0x37, 0xAD, 0xA, 0x21, 0xED, 0x6A, 0xE, 0x27, 0x1E, 0x6E, 0xE, 0xB0, 0x6F, 0x4B, 0x9A, 0xBA, 0xD2, 0xAC, 0x3C, 0x97, 0x2F, 0x35, 0xEB, 0x4C, 0xA2, 0xC7, 0x6, 0x9, 0x19, 0x1B, 0x24, 0xB2, 0x31, 0x27, 0x69, 0xA3, 0x6E, 0x11, 0xA1, 0xD2, 0x73, 0x6F, 0xCF, 0x11, 0x65, 0x7A, 0x7B, 0xDE, 0x8A, 0x21, 0x1B, 0xF4, 0x56, 0x49, 0xBE, 0xFA, 0x2B, 0xF, 0x58, 0xFF, 0xE4, 0xD, 0xBC, 0x9D, 0x1, 0x6E, 0xE0, 0xED, 0x4C, 0x8E, 0xC6, 0x77, 0x43, 0xA8, 0xEB, 0xB9, 0xCC, 0x96, 0xA4, 0xCE, 0xA8, 0xA3, 0x14, 0xB6, 0x2F, 0xA9, 0x30, 0x7, 0x7B, 0x62, 0x5F, 0x15, 0x46, 0xDF, 0x90, 0xC, 0x34, 0x18, 0x20, 0xD0, 0x40, 0x3B, 0x7, 0x69, 0xD9, 0xB9, 0x33, 0xB7, 0x8B, 0xBB, 0xFF, 0xF0, 0x7A, 0xAE, 0xEF, 0xCA, 0xB8, 0xE2, 0x2F, 0x32, 0x98, 0x33, 0xBE, 0xE9, 0x2E, 0x34, 0x46, 0x1B, 0xC7, 0xF3, 0xFE, 0x51, 0x37, 0x8F, 0x7C, 0x91, 0x43, 0xF6, 0x63, 0x74, 0xE6, 0x46, 0x5B, 0xC1, 0xD, 0xB1, 0x82, 0x5B, 0xDB, 0x79, 0xDD, 0xB6, 0x26, 0x25, 0xFA, 0x4A, 0xD3, 0x5A, 0xA8, 0x97, 0xC, 0x58, 0x20, 0x33, 0xD5, 0x53, 0xA8, 0x33, 0x11, 0xDD, 0xAA, 0x84, 0x2B, 0xAE, 0x8A, 0x0, 0x46, 0xE6, 0xEA, 0x6, 0xC, 0xB2, 0xE1, 0x3E, 0xF1, 0xDF, 0xEE, 0x30, 0xA5, 0x3F, 0x84, 0x6D, 0x4D, 0x8A, 0x26, 0x59, 0xBF, 0x75, 0x37, 0xF4, 0xFC, 0xA2, 0x8A, 0xA9, 0xA3, 0x1C, 0x34, 0xD7, 0xB8, 0xA5, 0xAC, 0xB3, 0x6D, 0x27, 0xED, 0xC9, 0xE6, 0x74, 0x53, 0x2B, 0xAE, 0xAA, 0x80, 0xCC, 0x46, 0xE5, 0x5C, 0x29, 0x2C, 0xF2, 0x65, 0xD7, 0xDD, 0xB5, 0xF, 0xB4, 0x5D, 0x5C, 0x55, 0x1F, 0x10, 0xD3, 0xB8, 0x4E, 0x86, 0xBC, 0xE5, 0xBC, 0xBB, 0xAA, 0xC9, 0x9C, 0x5C, 0x74, 0xFE, 0x2A, 0x42, 0xDF, 0x6A, 0xC6, 0x59, 0xF6, 0xAD, 0x86, 0x5C, 0x65, 0x7F, 0xA3, 0xB2, 0xEC, 0x74, 0xAA, 0xBF, 0xF1, 0x69, 0x76, 0x5E, 0xBF, 0x3F, 0x34, 0xF7, 0x6C, 0xCA, 0x20, 0x85, 0xDE, 0x2C, 0xD3, 0xD, 0x6A, 0x17, 0xC0, 0x76, 0xCA, 0x74, 0x3, 0x35, 0x60, 0x18, 0xC4, 0xD1, 0x82, 0xD5, 0xDD, 0xD6, 0x9E, 0x9, 0xF5, 0xA, 0x82, 0x9E, 0xD5, 0x6A, 0x17, 0x3C, 0x19, 0x4F, 0xDA, 0x11, 0xA9, 0x24, 0x66, 0xEA, 0x3A, 0xED, 0x97, 0x10, 0xA3, 0x25, 0x87, 0x20, 0x47, 0x1, 0x60, 0x30, 0x62, 0xC9, 0x49, 0xB5, 0x15, 0x77, 0x8A, 0x77, 0xB6, 0xB7, 0xF4, 0xF0, 0x6B, 0x3, 0x20, 0xF5, 0xD0, 0x1A, 0xED, 0xD3, 0xAC, 0x5B, 0xC3, 0xE5, 0xBD, 0xFC, 0x5D, 0x10, 0x72, 0x60, 0x76, 0x30, 0x31, 0x85, 0x69, 0xA9, 0xF9, 0xA4, 0x69, 0xDE, 0xC1, 0x44, 0xF5, 0x12, 0x11, 0x9, 0x7D, 0x48, 0x7A, 0xE1, 0x83, 0x89, 0x1B, 0xE, 0x76, 0x8A, 0x36, 0x1A, 0x2A, 0x1, 0xC8, 0xF6, 0x1C, 0x51, 0xE, 0x64, 0x0, 0xE0, 0x9, 0x21, 0x14, 0x3F, 0x16, 0xDB, 0x8E, 0x6D, 0xF0, 0xDE, 0xB4, 0x99, 0x4A, 0x92, 0xB6, 0x4A, 0xA9, 0xA3, 0x18, 0x9F, 0x34, 0xED, 0x50, 0x53, 0xA9, 0x1E, 0x8D, 0xA3, 0xF8, 0x36, 0x44, 0x2A, 0x5C, 0x5A, 0x8F, 0xA6, 0xF5, 0x31, 0x2C, 0xD4, 0xE2, 0xCA, 0x8E, 0xAB, 0x55, 0x0, 0x2A, 0x4F, 0x4C, 0x26, 0x93, 0x9, 0xD2, 0x5F, 0x6C, 0x15, 0x47, 0xC8, 0x6E, 0x64, 0x9C, 0x75, 0x47, 0x80, 0x97, 0xBB, 0x19, 0x3E, 0x2F, 0xCA, 0x53, 0x0, 0xB5, 0x24, 0x2A, 0xE5, 0x62, 0x7D, 0x2A, 0x2A, 0x8E, 0xB5, 0x27, 0xB, 0xDE, 0x50, 0x5D, 0xD4, 0xB, 0x56, 0x46, 0xEE, 0xAA, 0x5E, 0xD2, 0xE4, 0x82, 0xC3, 0x81, 0xC, 0x58, 0x77, 0x78, 0x49, 0xD3, 0xA1, 0xCB, 0x3, 0x1B, 0x12, 0xC0, 0xB4, 0x69, 0x5C, 0x52, 0x2A, 0x8D, 0xBC, 0x31, 0xD1, 0xD8, 0x76, 0x20, 0x3, 0xF3, 0xBF, 0x49, 0x21, 0xEB, 0x13, 0x36, 0xB7, 0xC3, 0x3D, 0x2C, 0xD1, 0xCE, 0xF4, 0x30, 0xF3, 0x85, 0x43, 0x43, 0x4C, 0x7B, 0xCB, 0x3A, 0xDD, 0x96, 0xD0, 0x79, 0x7A, 0x69, 0xF3, 0x80, 0x23, 0x9E, 0xFD, 0x96, 0x57, 0xF1, 0xCC, 0xD3, 0x3D, 0x5, 0x10, 0x3E, 0xD2, 0xE1, 0x88, 0xAE, 0x86, 0x38, 0x50, 0xDE, 0x49, 0xA7, 0xC9, 0xA9, 0x1B, 0xE2, 0xD9, 0xEB, 0xE1, 0x5F, 0x4A, 0x5C, 0xAC, 0x7B, 0x44, 0x63, 0xDD, 0xD3, 0xD2, 0x87, 0xC9, 0x8C, 0x9A, 0xDA, 0x1F, 0xF7, 0x4, 0xE5, 0x3D, 0x72, 0xD4, 0xAD, 0x71, 0xE2, 0x8D, 0x71, 0x9D, 0x37, 0x73, 0xC, 0x73, 0x99, 0x5B, 0xDD, 0xD2, 0x48, 0x6D, 0x4B, 0xFD, 0x2A, 0x21, 0x6F, 0xA3, 0xED, 0xBC, 0x15, 0x19, 0xED, 0xBB, 0x8C, 0xE4, 0x6C, 0xCE, 0x3, 0xF7, 0xB5, 0x77, 0x9E, 0xF2, 0xE5, 0x5E, 0x5C, 0x19, 0xF7, 0x49, 0x4F, 0x56, 0xD3, 0xC3, 0x83, 0x1D, 0x84, 0x5A, 0xD7, 0x26, 0x4B, 0xAF, 0x22, 0xEC, 0x44, 0xC8, 0x2A, 0xC2, 0x26, 0x4B, 0xDB, 0xDA, 0x4E, 0x84, 0x98, 0x9B, 0x4F, 0x5D, 0xF8, 0xA0, 0x27, 0x6D, 0x1B, 0x6, 0xBE, 0x58, 0x11, 0xE5, 0xDE, 0xAB, 0xD2, 0x60, 0xAF, 0x55, 0x44, 0xB9, 0xB7, 0xAA, 0x34, 0xD8, 0x4B, 0x5D, 0x43, 0x24, 0x7B, 0x81, 0x8A, 0x20, 0x1D, 0x80, 0x4F, 0x43, 0x70, 0x86, 0x74, 0xD3, 0x2B, 0x84, 0xF8, 0x66, 0x9F, 0x6C, 0x1A, 0xAD, 0x1F, 0x99, 0x7D, 0x30, 0x4F, 0x18, 0xAC, 0xE2, 0x35, 0xD, 0x16, 0x49, 0xB4, 0x2E, 0xEC, 0x73, 0x1D, 0xEE, 0x91, 0x64, 0x99, 0x3B, 0x9D, 0x8D, 0xF5, 0x57, 0xCA, 0x1D, 0xEF, 0xB0, 0xAA, 0x38, 0x4A, 0x46, 0x1E, 0xAA, 0x78, 0xA1, 0x58, 0x4D, 0x2D, 0x33, 0x67, 0x3D, 0xCB, 0x8C, 0xD9, 0xE, 0xED, 0x5D, 0x0, 0x8F, 0x42, 0xD5, 0x84, 0xD8, 0x15, 0x85, 0x96, 0xF2, 0x42, 0xE5, 0xA9, 0xA8, 0x98, 0x3, 0x35, 0x78, 0x5B, 0x9F, 0x4B, 0xCA, 0x26, 0x5D, 0x6C, 0xA6, 0x6B, 0x6A, 0xC5, 0xA0, 0xBA, 0xA, 0x26, 0xF9, 0x6C, 0x7E, 0xDA, 0xC4, 0x2C, 0x3D, 0xBC, 0x70, 0xC8, 0x34, 0x5F, 0xE2, 0x74, 0xD3, 0x3D, 0xD0, 0x21, 0xCD, 0x37, 0x90, 0x1C, 0xE2, 0x3E, 0xA4, 0x30, 0x4D, 0xFC, 0xCA, 0x77, 0x1, 0xC1, 0xE1, 0x6C, 0x70, 0xDA, 0x52, 0x13, 0xB6, 0x1D, 0x90, 0xD4, 0xBD, 0xC9, 0x4C, 0x57, 0xB7, 0x42, 0x88, 0x45, 0x7A, 0x66, 0xBC, 0x54, 0x0, 0x60, 0x9C, 0x14, 0x7C, 0x48, 0x99, 0xBF, 0xC6, 0x7E, 0xC7, 0xD1, 0xA, 0x39, 0xB7, 0x95, 0xB7, 0x2C, 0x65, 0x13, 0x66, 0x2C, 0x78, 0xA7, 0x82, 0x6C, 0xF8, 0xDC, 0xE4, 0xDE, 0x32, 0xB8, 0xE0, 0x3A, 0xCF, 0x74, 0xEF, 0x2B, 0x86, 0xBD, 0x5C, 0x15, 0x65, 0xCA, 0xB3, 0xD2, 0x40, 0xA5, 0x99, 0x35, 0x37, 0xD6, 0xA8, 0x10, 0x56, 0x96, 0x34, 0xD8, 0xBB, 0x76, 0xDA, 0xEB, 0x34, 0xD0, 0xDB, 0xDA, 0x8B, 0x84, 0x7A, 0x8A, 0x0, 0xFB, 0x3, 0x3, 0xF9, 0xC9, 0xC4, 0xA9, 0x74, 0xB8, 0x45, 0x43, 0xDB, 0xF2, 0x2D, 0x1A, 0xC4, 0x96, 0x3F, 0xFD, 0x14, 0xC8, 0xA3, 0x13, 0x3B, 0x22, 0x10, 0xCC, 0x64, 0x5B, 0x9C, 0x81, 0x66, 0x18, 0xB5, 0x73, 0xC6, 0x93, 0x85, 0x5F, 0x21, 0xA0, 0xF8, 0x51, 0xFB, 0xDE, 0x74, 0x7A, 0x62, 0x58, 0x56, 0x10, 0xE2, 0xB9, 0xB3, 0x9B, 0x1E, 0x37, 0xC, 0xE9, 0x68, 0xA3, 0xBE, 0x77, 0xAC, 0xAD, 0x6F, 0x20, 0x64, 0x60, 0x45, 0x96, 0x2F, 0xE, 0x5A, 0x1A, 0xA3, 0xDD, 0x10, 0x52, 0x36, 0x91, 0x2B, 0xD1, 0x4B, 0x0, 0x2B, 0x95, 0xA5, 0xD2, 0xF6, 0xAC, 0x8F, 0xA5, 0xA, 0xA6, 0xF2, 0x66, 0x77, 0x51, 0xCE, 0x9B, 0xDD, 0xC5, 0xD0, 0xE8, 0x91, 0xFE, 0xBE, 0x5, 0x41, 0x3E, 0x6E, 0xD4, 0x54, 0x84, 0x3A, 0x46, 0xA5, 0xE5, 0x0, 0x75, 0xA4, 0x6E, 0x8, 0xE8, 0xA2, 0x7C, 0x41, 0x40, 0x17, 0x97, 0x8E, 0x5C, 0xB7, 0x88, 0x4B, 0x87, 0xAC, 0x53, 0xC4, 0xA5, 0x23, 0xA7, 0x62, 0x45, 0x9D, 0x9D, 0x8B, 0xBD, 0xD4, 0x53, 0xD, 0x57, 0x37, 0x96, 0x38, 0x86, 0xB6, 0x61, 0xAA, 0x80, 0x18, 0xD, 0x39, 0xDA, 0xDF, 0x58, 0x80, 0xE1, 0xFE, 0x46, 0x12, 0x1A, 0x6A, 0x41, 0x78, 0xA7, 0x29, 0xA8, 0x48, 0x44, 0x95, 0x43, 0x4E, 0x8, 0xD1, 0x25, 0x77, 0xF7, 0x9C, 0xD7, 0x5F, 0x31, 0xC8, 0xFE, 0x57, 0x51, 0xBA, 0x77, 0xDD, 0x68, 0x90, 0xBE, 0x75, 0x15, 0xA5, 0x7B, 0xB2, 0x8D, 0x6, 0xE9, 0xC9, 0x52, 0x76, 0xF4, 0x64, 0x3D, 0x65, 0xE5, 0x90, 0xF9, 0x7A, 0xA6, 0x96, 0x5F, 0xB3, 0xB8, 0xFC, 0xBA, 0x34, 0x9A, 0xFA, 0x8E, 0xF5, 0xB1, 0xA4, 0xDA, 0x52, 0x71, 0x9F, 0xF2, 0x54, 0x56, 0x64, 0x3B, 0xFF, 0x15, 0x83, 0xAA, 0x6C, 0xF1, 0x13, 0x95, 0x4E, 0x78, 0x7B, 0x2E, 0x95, 0x61, 0xE, 0x64, 0xD0, 0x7B, 0x7D, 0x2E, 0x29, 0xC7, 0x46, 0x53, 0x4B, 0xA3, 0xEB, 0x73, 0x51, 0x79, 0xD0, 0x8E, 0x1D, 0x6E, 0x56, 0x14, 0x1C, 0x6C, 0xDE, 0x34, 0x55, 0x83, 0xE5, 0xA9, 0xA4, 0x48, 0x79, 0xC1, 0x9B, 0xE8, 0x3, 0x6F, 0xB1, 0xD5, 0x8E, 0x99, 0xE, 0x57, 0xC, 0x9B, 0xB, 0xDF, 0x22, 0xFB, 0xF5, 0x2B, 0x4, 0x7D, 0xFC, 0x4F, 0xD3, 0xD0, 0xE7, 0x89, 0xFF, 0x50, 0x5, 0xFB, 0xFD, 0x44, 0x31, 0xB5, 0x4A, 0x74, 0x27, 0x77, 0xA8, 0xDD, 0x91, 0x4D, 0x69, 0x77, 0x7F, 0x69, 0x4C, 0x9C, 0xAC, 0x27, 0xBB, 0xF5, 0x27, 0x8A, 0xF4, 0xEC, 0x77, 0x7F, 0xB1, 0xF3, 0xF7, 0x7F, 0x70, 0x44, 0x5, 0x3, 0x69, 0xC2, 0x25, 0xE9, 0x85, 0x39, 0xD1, 0xF7, 0x84, 0x21, 0xE7, 0x78, 0xF7, 0x97, 0xDB, 0xCC, 0xBE, 0xDB, 0x6D, 0x6, 0x5F, 0xEC, 0xDF, 0xFF, 0x24, 0x3B, 0xCD, 0x2, 0x20, 0xBD, 0xE6, 0xDD, 0x9F, 0x4, 0x13, 0x37, 0x10, 0x7C, 0x9D, 0x93, 0x60, 0xE2, 0x4E, 0x84, 0x78, 0xFB, 0x4D, 0x96, 0xB6, 0xDE, 0x9D, 0x8, 0xB1, 0xDF, 0xBB, 0xD7, 0xCE, 0xB6, 0x65, 0x28, 0xCA, 0x34, 0xA8, 0x97, 0x0, 0xD6, 0xAA, 0xAA, 0x7C, 0x6A, 0x7, 0x93, 0xD8, 0xB1, 0xEF, 0x57, 0x11, 0xEC, 0xB, 0xEA, 0x3C, 0x4, 0x67, 0xD8, 0x8A, 0xAB, 0x28, 0x58, 0x73, 0x6D, 0x48, 0x96, 0x2F, 0x9A, 0xDA, 0x60, 0xAC, 0x58, 0xFC, 0x61, 0x95, 0xBB, 0x57, 0xF0, 0x81, 0x95, 0xBB, 0xEF, 0x7C, 0xBA, 0xE8, 0x7C, 0x29, 0x6F, 0xC1, 0x59, 0xD9, 0x41, 0x4, 0xB4, 0xB5, 0x4D, 0xE3, 0x33, 0x44, 0xE6, 0x9C, 0xD2, 0x17, 0x91, 0x37, 0x48, 0x9B, 0x29, 0x30, 0x7, 0x96, 0x5E, 0xF8, 0x1B, 0x74, 0xFC, 0x89, 0xAC, 0x17, 0xFE, 0x6, 0x9D, 0x54, 0x89, 0x6F, 0xD6, 0x20, 0xB7, 0x4A, 0xB7, 0xA3, 0x6F, 0x50, 0x39, 0x6D, 0x99, 0x93, 0x9F, 0x2F, 0xFC, 0xD, 0xBA, 0x70, 0xBD, 0xDA, 0xF6, 0xF4, 0x55, 0x57, 0x89, 0x37, 0x28, 0x67, 0xDB, 0xB2, 0x6E, 0x6E, 0xC7, 0xDF, 0xA0, 0x8B, 0xC6, 0x85, 0x7C, 0x5A, 0xC2, 0x55, 0xE2, 0xD, 0xCA, 0xB3, 0xD3, 0xE2, 0x47, 0x89, 0x37, 0x28, 0xD9, 0xA3, 0xAA, 0x4F, 0xF8, 0x1D, 0x32, 0x3B, 0xD2, 0x9, 0x97, 0xE, 0xF8, 0x3B, 0x74, 0x42, 0xE3, 0x7D, 0xAF, 0xE5, 0x7E, 0x63, 0x5C, 0x55, 0x81, 0x60, 0x47, 0x45, 0x4F, 0xFF, 0x2A, 0x4, 0xCD, 0xFE, 0xAA, 0x64, 0xA7, 0xE7, 0xD9, 0x87, 0xCE, 0x30, 0xFD, 0x72, 0x75, 0xC0, 0x9B, 0x8, 0xD6, 0x43, 0x17, 0xCE, 0x13, 0x67, 0x5C, 0x9, 0xDF, 0xF2, 0xC7, 0x6C, 0x7F, 0xF8, 0x46, 0x57, 0xC8, 0xE, 0x30, 0x57, 0x16, 0xAC, 0x83, 0xEE, 0x7C, 0x22, 0x27, 0x55, 0x2B, 0x7, 0x3B, 0xB5, 0xFA, 0x85, 0x82, 0xBA, 0x4F, 0x6D, 0xA3, 0x60, 0xAF, 0x54, 0x3B, 0x52, 0x14, 0xAF, 0x59, 0x9A, 0xC2, 0xDA, 0xBA, 0xB8, 0x2E, 0xEF, 0xAB, 0x14, 0x56, 0xD7, 0x5C, 0x68, 0xAB, 0x20, 0xA0, 0x69, 0x97, 0x9F, 0xE3, 0x2A, 0xBA, 0x60, 0x60, 0x4D, 0x2F, 0xDC, 0xF8, 0x62, 0x1, 0x87, 0x16, 0xB7, 0xBF, 0x38, 0x86, 0xDB, 0x5F, 0x28, 0x5, 0x3B, 0xDC, 0x45, 0x47, 0xBB, 0x31, 0xDC, 0xC9, 0xC8, 0x56, 0x1, 0x80, 0xC0, 0x16, 0xD5, 0xB8, 0xA5, 0x14, 0x43, 0xE6, 0xDB, 0x77, 0x2A, 0x88, 0x67, 0xBE, 0x7D, 0x97, 0x93, 0x6F, 0x9B, 0x6F, 0x7F, 0xFD, 0x43, 0x47, 0x2A, 0xBB, 0x74, 0x41, 0x54, 0x85, 0x4, 0x86, 0x7F, 0x50, 0x79, 0x91, 0xCC, 0x3F, 0x80, 0xAC, 0x6D, 0x4C, 0xBE, 0x8E, 0xDF, 0xF3, 0x74, 0x10, 0xBF, 0xAA, 0x53, 0xBE, 0x8C, 0xFA, 0xA1, 0xC7, 0x81, 0x4B, 0x69, 0xF3, 0x8B, 0x8, 0x90, 0xD9, 0xA6, 0x68, 0xE8, 0x6B, 0xB4, 0x2D, 0xBB, 0x10, 0xF4, 0x92, 0xC0, 0x66, 0xE7, 0x55, 0x3E, 0x59, 0xAD, 0x1F, 0x4B, 0x34, 0xEB, 0x26, 0x64, 0x3D, 0xCF, 0xF4, 0x14, 0x64, 0x97, 0x55, 0xBF, 0x8, 0x23, 0xA6, 0x5B, 0x74, 0xDB, 0xA0, 0xF9, 0x13, 0x74, 0x4F, 0x1, 0xBC, 0xDC, 0x4B, 0xFA, 0x8E, 0x16, 0x7B, 0x49, 0xDF, 0xFF, 0xB9, 0x52, 0x93, 0x7C, 0x52, 0xE4, 0xC5, 0xD4, 0x54, 0x21, 0x97, 0x10, 0x7B, 0xED, 0x99, 0x7C, 0xB6, 0x5F, 0x45, 0xC0, 0xB2, 0x4, 0x4F, 0x47, 0xDF, 0x2A, 0x4, 0xC5, 0xDF, 0x4C, 0x63, 0x72, 0x5A, 0xCE, 0xB2, 0xA5, 0xAC, 0x12, 0x6F, 0xE4, 0x4B, 0x31, 0x54, 0x96, 0x9, 0x23, 0x67, 0x99, 0x30, 0x8D, 0xF5, 0x7A, 0xD4, 0x5E, 0xF7, 0xFA, 0x93, 0xCC, 0x77, 0x51, 0x5, 0xD4, 0x2E, 0x81, 0x14, 0xC4, 0xFA, 0xE0, 0xEC, 0xBD, 0x34, 0x28, 0x72, 0x81, 0xAC, 0xA, 0xA8, 0x5D, 0x2, 0x22, 0x7C, 0x50, 0x77, 0xE6, 0x96, 0xE7, 0xF2, 0xA5, 0xB9, 0xD4, 0x6D, 0xD9, 0xE2, 0x4D, 0xD9, 0xA6, 0x1D, 0xEC, 0xC3, 0xF8, 0xEB, 0x32, 0x2E, 0xF4, 0x2B, 0xAC, 0x78, 0xCD, 0x1, 0x82, 0xBC, 0x41, 0xEB, 0xA9, 0xAD, 0xFA, 0xA6, 0xF5, 0xF2, 0x46, 0x7F, 0x43, 0x25, 0x82, 0x37, 0x6C, 0x16, 0xF8, 0x83, 0x1A, 0xFD, 0x93, 0x1, 0xFA, 0xC9, 0x29, 0xF4, 0xF4, 0x1, 0xD1, 0x1D, 0x93, 0x8F, 0xB1, 0x95, 0x9, 0x85, 0x33, 0xB1, 0x35, 0x97, 0x34, 0x84, 0x99, 0xDA, 0x75, 0xF7, 0x55, 0x2, 0xB8, 0xD1, 0xCA, 0xB4, 0x35, 0xF1, 0x34, 0x57, 0xB0, 0x8A, 0x1, 0x5, 0xEB, 0x34, 0xA5, 0xDF, 0x29, 0x2D, 0x6B, 0xB6, 0x44, 0x8, 0xD0, 0x74, 0xAA, 0x95, 0xC2, 0xDF, 0xA6, 0xA3, 0xAE, 0x3A, 0x28, 0x9A, 0xE2, 0xC7, 0xE8, 0xCC, 0x48, 0xF5, 0xBA, 0xF5, 0xB1, 0xA4, 0xDA, 0x9B, 0x32, 0x3A, 0x27, 0x1D, 0xDF, 0x8A, 0x41, 0x1E, 0xAF, 0xEB, 0xCB, 0x97, 0x9A, 0x97, 0x6C, 0xC8, 0x23, 0x43, 0x7, 0xFC, 0xB7, 0x23, 0x43, 0xC, 0x5D, 0xCA, 0xBA, 0x25, 0x2F, 0xA7, 0x79, 0x82, 0xC0, 0xDA, 0x92, 0xE9, 0x6C, 0xE, 0xF1, 0x27, 0x35, 0x37, 0xD9, 0x20, 0x60, 0x4E, 0x62, 0x3A, 0xB2, 0x7D, 0x77, 0x40, 0xA3, 0xEE, 0x42, 0x71, 0x88, 0x39, 0x8C, 0x77, 0xDA, 0x83, 0xAF, 0xB8, 0xAA, 0x2, 0x8, 0x1D, 0x75, 0xE3, 0x99, 0xE9, 0xE4, 0x1B, 0xCF, 0x4C, 0x47, 0xD, 0x6A, 0x3A, 0x29, 0x4B, 0xA5, 0xE9, 0x16, 0x6A, 0x6D, 0xD4, 0x74, 0xB, 0xBF, 0x36, 0x7A, 0xD4, 0xBD, 0x32, 0xBA, 0x57, 0x40, 0x97, 0x72, 0x6, 0x45, 0x57, 0xDA, 0x7C, 0x5B, 0x74, 0xC9, 0x76, 0xB4, 0xC8, 0xF7, 0x3A, 0x16, 0x5D, 0xBA, 0xA2, 0xE4, 0x1B, 0x90, 0x8B, 0x2E, 0x35, 0x78, 0x2D, 0xBA, 0xE2, 0xA8, 0xBB, 0x5B, 0x1A, 0xB2, 0x1, 0x2F, 0xAA, 0x91, 0x5B, 0xF0, 0xD2, 0x34, 0x8C, 0x6E, 0x3, 0xE8, 0x52, 0x83, 0xE6, 0xA2, 0x2B, 0x8E, 0x9A, 0xBB, 0x85, 0x5A, 0xB0, 0xAD, 0xBA, 0xB2, 0xAB, 0x5B, 0x1A, 0x6A, 0x60, 0x59, 0x74, 0xC5, 0xE1, 0x64, 0xB7, 0x34, 0xC4, 0x52, 0x59, 0xD5, 0x95, 0x96, 0xCA, 0x8A, 0x2E, 0xE9, 0xA, 0x16, 0xA4, 0x4B, 0x58, 0xC8, 0xDE, 0xB2, 0xE8, 0x2, 0x76, 0xD5, 0x50, 0x83, 0xC1, 0xA2, 0x2B, 0x8E, 0x3, 0xBB, 0xA5, 0x21, 0x9D, 0xF3, 0xA2, 0x1A, 0x71, 0x74, 0xDB, 0x2D, 0xD, 0x63, 0x93, 0xD, 0x60, 0x93, 0xAD, 0xA6, 0x2F, 0x85, 0x7E, 0x41, 0x20, 0x5, 0x9F, 0x1B, 0xFF, 0x20, 0x2, 0xCE, 0x26, 0x76, 0x5, 0x91, 0xF1, 0x1D, 0xBE, 0x10, 0xE9, 0x63, 0x8E, 0x3B, 0x8A, 0x1C, 0x75, 0x2C, 0xB2, 0x4C, 0x83, 0x0, 0xFA, 0xFE, 0x85, 0xBA, 0x1F, 0xA9, 0xEA, 0x22, 0xB5, 0xCC, 0x18, 0xB5, 0x3C, 0x4, 0xEC, 0x16, 0xEA, 0x4E, 0x87, 0xAA, 0xB, 0x38, 0x3C, 0x2A, 0x2F, 0x78, 0xD5, 0x95, 0x76, 0x9, 0x14, 0x5D, 0xB2, 0x2B, 0x5B, 0x94, 0x98, 0xE8, 0xDB, 0x74, 0xB, 0x75, 0xC0, 0xA2, 0xE8, 0x8A, 0x87, 0x2C, 0x8A, 0x2E, 0x53, 0x57, 0xE2, 0xAE, 0xE3, 0xA2, 0xCB, 0x34, 0x26, 0x39, 0xAC, 0xD2, 0x2D, 0xE4, 0x24, 0xA3, 0x5B, 0x94, 0x3C, 0xD1, 0xE8, 0x16, 0x2A, 0x69, 0x51, 0xD5, 0x15, 0x43, 0x32, 0xDD, 0x62, 0xA8, 0x39, 0x5F, 0xD1, 0x15, 0x67, 0x7D, 0xDD, 0x42, 0x5D, 0x12, 0x53, 0x75, 0x1, 0xA7, 0x45, 0x8F, 0x28, 0x17, 0x25, 0x9E, 0x2C, 0x2D, 0xBA, 0xF4, 0x41, 0x9E, 0xAA, 0xE, 0x9D, 0xE4, 0x31, 0xDD, 0x72, 0x65, 0x6C, 0xE4, 0xA, 0xD8, 0x48, 0x4F, 0xEC, 0x78, 0x2C, 0xBA, 0xBD, 0xB4, 0xDF, 0xB1, 0xE8, 0x32, 0xDF, 0xAA, 0x7, 0xBE, 0x55, 0xCF, 0x74, 0xC6, 0x3D, 0xD0, 0x19, 0xF7, 0x8C, 0xEF, 0xE9, 0x1, 0xDF, 0xD3, 0x5B, 0x7A, 0x27, 0xEF, 0xA, 0x81, 0x15, 0xDE, 0x33, 0x23, 0xB6, 0x1E, 0x18, 0xB1, 0x51, 0x9, 0x3D, 0xAA, 0x2E, 0xE0, 0xFC, 0x7A, 0x72, 0x60, 0xBD, 0xA8, 0x5E, 0x1E, 0x4E, 0x2F, 0x3D, 0xD3, 0xA3, 0xF7, 0x40, 0x8F, 0x4E, 0xA5, 0x3, 0xAB, 0xBA, 0x80, 0x81, 0xF7, 0x8C, 0xD3, 0xED, 0x1, 0xA7, 0x4B, 0xDD, 0xF, 0x5B, 0x75, 0xA5, 0xA3, 0x78, 0xA6, 0x5B, 0x6, 0x62, 0x7F, 0x4B, 0xD1, 0x1D, 0xA4, 0x2D, 0x56, 0x45, 0x97, 0xF9, 0x46, 0x3, 0xF0, 0x8D, 0xA8, 0x2B, 0xCA, 0xAB, 0x2E, 0x50, 0x57, 0x96, 0xF9, 0x5D, 0xB, 0xFC, 0xAE, 0xFD, 0xC1, 0xE8, 0xFE, 0x0, 0x74, 0x19, 0xE7, 0xC7, 0xDE, 0x78, 0x73, 0xD4, 0x65, 0xDE, 0x57, 0x4C, 0x60, 0x66, 0xBA, 0xE5, 0x83, 0xE9, 0xA0, 0x3E, 0x80, 0xE, 0x6A, 0x64, 0xBC, 0xE6, 0x8, 0x38, 0xFB, 0x91, 0x71, 0x20, 0x23, 0xE0, 0x40, 0xA8, 0x4B, 0xE4, 0xAB, 0x2E, 0xE0, 0x6C, 0x47, 0xE6, 0xFB, 0x8E, 0xC0, 0xF7, 0x1D, 0x99, 0xB6, 0x3F, 0x2, 0x6D, 0x7F, 0xA4, 0x6E, 0x1C, 0xAC, 0xCA, 0xEC, 0x75, 0x83, 0x47, 0x77, 0x37, 0x32, 0xE3, 0x97, 0x11, 0x18, 0xBF, 0x8C, 0x4C, 0x13, 0x1E, 0x81, 0x26, 0x3C, 0x32, 0x63, 0x90, 0x11, 0x18, 0x83, 0x50, 0x17, 0x68, 0x15, 0x5D, 0xF1, 0xF2, 0xAC, 0xA2, 0xCB, 0x4C, 0x32, 0x27, 0x60, 0x92, 0x39, 0x31, 0x63, 0x9F, 0x9, 0x18, 0xFB, 0x50, 0x9, 0x8E, 0xAB, 0x2E, 0xE0, 0xB2, 0x26, 0xC6, 0xBD, 0x4F, 0x80, 0x7B, 0xA7, 0x72, 0xF6, 0x55, 0x5D, 0xA0, 0xF9, 0x4F, 0xCC, 0xB8, 0x78, 0x2, 0xC6, 0xC5, 0x13, 0x63, 0x1B, 0x13, 0x60, 0x1B, 0x74, 0xB8, 0x6C, 0xE1, 0x3, 0x65, 0x47, 0x5D, 0xC6, 0x75, 0x38, 0xC0, 0x75, 0x38, 0xE6, 0x1B, 0x39, 0xE0, 0x1B, 0x39, 0x66, 0xD8, 0xE0, 0x80, 0x61, 0x83, 0x63, 0x5C, 0x87, 0x3, 0x5C, 0x87, 0x63, 0xDC, 0xAC, 0x3, 0xDC, 0xAC, 0x63, 0x6C, 0xC3, 0x1, 0xB6, 0xE1, 0x98, 0xAE, 0xC1, 0x1, 0x5D, 0x83, 0x63, 0xEC, 0xCA, 0x1, 0x76, 0xE5, 0x18, 0x7F, 0xE5, 0x0, 0x7F, 0xE5, 0x88, 0xBB, 0x95, 0xAA, 0xAE, 0x74, 0xBF, 0x52, 0xD1, 0x65, 0xEC, 0xD9, 0x21, 0xF6, 0xCC, 0xF8, 0x3A, 0x7, 0xF8, 0x3A, 0xEA, 0x62, 0x80, 0xA2, 0x2B, 0x5E, 0xC, 0x50, 0x74, 0x99, 0xAE, 0xD0, 0x3, 0x5D, 0xA1, 0x67, 0x7C, 0x8E, 0x7, 0x7C, 0xE, 0xB5, 0x7B, 0xA2, 0xE8, 0xB2, 0x5B, 0x27, 0xE, 0xBA, 0xE4, 0xBA, 0x7B, 0xB7, 0x28, 0x79, 0xD1, 0xBD, 0x5B, 0x66, 0xC6, 0x6F, 0xCC, 0x80, 0xDF, 0x98, 0x99, 0x20, 0xEF, 0xC, 0x4, 0x79, 0x67, 0x66, 0xBE, 0x33, 0x3, 0xF3, 0x9D, 0x99, 0xF1, 0x39, 0x33, 0xE0, 0x73, 0xC8, 0x95, 0xA6, 0xA2, 0xB, 0xF8, 0x9C, 0x99, 0xF1, 0x39, 0x33, 0xE0, 0x73, 0x66, 0xC6, 0x36, 0x66, 0xC0, 0x36, 0x66, 0xC6, 0xE7, 0xCC, 0x80, 0xCF, 0x99, 0x19, 0x9F, 0x33, 0x3, 0x3E, 0x67, 0x66, 0xDA, 0xEF, 0xC, 0xB4, 0xDF, 0x1F, 0x8C, 0x4D, 0xFE, 0x0, 0x6C, 0x92, 0xBA, 0x52, 0xB7, 0xE8, 0x8A, 0xB7, 0xEA, 0x16, 0x5D, 0x66, 0x7A, 0x25, 0xDF, 0x6B, 0x64, 0xBA, 0x25, 0x31, 0x85, 0x4E, 0x40, 0xA1, 0x13, 0x33, 0x48, 0x4A, 0xC0, 0x20, 0x89, 0xBA, 0x5, 0xB7, 0xEA, 0x2, 0x83, 0xA4, 0xD4, 0x52, 0x7, 0x44, 0x57, 0x6D, 0xF6, 0x94, 0xE8, 0x97, 0x97, 0x66, 0xC6, 0xC2, 0x9, 0x88, 0x78, 0x24, 0xC6, 0xD3, 0x26, 0xC0, 0xD3, 0x26, 0xA6, 0x25, 0x26, 0xA0, 0x25, 0x26, 0xA6, 0x25, 0x26, 0xA0, 0x25, 0x26, 0xA6, 0xF7, 0x4F, 0x40, 0xEF, 0x9F, 0x18, 0xCB, 0x4C, 0x80, 0x65, 0x52, 0x29, 0x67, 0xAB, 0x2E, 0xD0, 0x12, 0x13, 0xD3, 0xFB, 0x27, 0xA0, 0xF7, 0x4F, 0x4C, 0x2B, 0x4E, 0x40, 0x2B, 0xCE, 0x44, 0xA, 0x9F, 0xA2, 0x9B, 0xA5, 0x34, 0x3E, 0x45, 0x97, 0xE9, 0xD1, 0x32, 0xD0, 0xA3, 0x51, 0x39, 0xC9, 0xAA, 0x2E, 0xF0, 0x8D, 0xA8, 0x63, 0x99, 0x55, 0x17, 0xF0, 0xB4, 0x99, 0xF9, 0x46, 0x19, 0xF8, 0x46, 0xE4, 0x26, 0x94, 0xA2, 0xB, 0x8C, 0xB2, 0xA8, 0xA3, 0xD2, 0x45, 0x57, 0x3C, 0x2B, 0x5D, 0x74, 0x99, 0xEF, 0xBB, 0x0, 0xDF, 0x97, 0x4A, 0xD0, 0x58, 0x74, 0xD9, 0xFC, 0x8C, 0x47, 0x5D, 0x66, 0xE4, 0x20, 0x6F, 0x16, 0xEC, 0x96, 0x1B, 0xD3, 0x7E, 0x6F, 0x40, 0xFB, 0xA5, 0xD2, 0x6A, 0x56, 0x5D, 0x20, 0x80, 0x46, 0x6D, 0xA0, 0x2D, 0xBA, 0xEC, 0xEE, 0xD9, 0x83, 0xEE, 0x9D, 0x79, 0xDF, 0x3B, 0xF0, 0xBE, 0xF, 0xE6, 0xFB, 0x3E, 0x80, 0xEF, 0xFB, 0x60, 0xDA, 0xD1, 0x43, 0x6C, 0x47, 0x54, 0x81, 0xC5, 0xC2, 0x72, 0xD7, 0x46, 0x18, 0xF4, 0xDA, 0x8, 0x63, 0xE2, 0x9A, 0xD, 0xDC, 0xD4, 0x6B, 0x3A, 0xC9, 0x42, 0xEC, 0x12, 0x6A, 0x13, 0x1, 0x28, 0xCF, 0xC8, 0x50, 0x1A, 0xEA, 0xB5, 0xC4, 0x9E, 0xAA, 0x37, 0x31, 0x2D, 0xBE, 0xA3, 0xB6, 0xE3, 0xEE, 0x98, 0xBC, 0x23, 0xD7, 0xF4, 0xF7, 0x39, 0x5B, 0xCD, 0x2F, 0x5D, 0x6E, 0x38, 0xB8, 0x72, 0x39, 0x58, 0x67, 0xC8, 0x5D, 0x25, 0x5, 0x0, 0x76, 0x93, 0xD8, 0xEE, 0xFA, 0x11, 0x22, 0xFD, 0x4E, 0x1B, 0x6, 0xBC, 0x93, 0xE5, 0x8, 0x20, 0xDD, 0xD4, 0x98, 0xD8, 0x33, 0x4, 0x15, 0xC3, 0x58, 0xC6, 0xC0, 0x92, 0x8C, 0x1, 0xE4, 0xB8, 0x85, 0x89, 0x4C, 0x14, 0xB1, 0x63, 0x8, 0xCB, 0x99, 0x99, 0x58, 0xDC, 0x4E, 0xEC, 0x60, 0xEC, 0x87, 0xE5, 0xB7, 0x16, 0xAE, 0x38, 0xBC, 0xB5, 0xD0, 0x26, 0xE3, 0x1B, 0x3D, 0xF0, 0x76, 0xB7, 0xB, 0x80, 0x86, 0x37, 0x4E, 0xA1, 0x37, 0x89, 0xBA, 0x0, 0x79, 0x83, 0x94, 0x78, 0x11, 0xF2, 0x76, 0xD, 0x42, 0x63, 0xB4, 0x4F, 0x59, 0x4F, 0xF4, 0xC9, 0x98, 0x5F, 0x85, 0x90, 0x33, 0x4, 0x66, 0x6A, 0xB4, 0xD3, 0x8F, 0xE0, 0xF5, 0x27, 0x97, 0x52, 0xD6, 0x4C, 0x8D, 0x7A, 0xCA, 0x20, 0xD9, 0x64, 0x7F, 0xE1, 0xA4, 0x56, 0xEA, 0x9, 0xD6, 0x5F, 0x57, 0xEE, 0x69, 0xE2, 0x49, 0xF7, 0xE4, 0x6C, 0xB4, 0x22, 0xC0, 0x84, 0x74, 0x6A, 0x96, 0xD8, 0xB3, 0x1F, 0xB6, 0xA2, 0xE0, 0x57, 0x9D, 0x8C, 0x33, 0x3E, 0x87, 0xC8, 0xA4, 0x39, 0x7E, 0xE2, 0x58, 0xA6, 0xE3, 0x17, 0x1D, 0x79, 0xB5, 0xD2, 0x81, 0xD, 0xB8, 0x61, 0xC9, 0x4C, 0x23, 0x5D, 0x49, 0x23, 0x52, 0x43, 0x37, 0xE3, 0xBB, 0x68, 0x26, 0x7E, 0xF, 0xCA, 0x53, 0x2, 0xAD, 0xA9, 0x9B, 0x89, 0x8B, 0x23, 0x1B, 0xF9, 0xA, 0x1, 0x4D, 0xDC, 0x69, 0x4B, 0xBD, 0x51, 0x7D, 0x2C, 0xA9, 0x36, 0xDA, 0xFE, 0xD4, 0x9D, 0x26, 0xE7, 0x12, 0x3B, 0x8, 0xCC, 0x28, 0x9C, 0xBC, 0xC3, 0xDC, 0xBD, 0xBD, 0xC5, 0xDC, 0x35, 0xE4, 0xC2, 0x88, 0x6B, 0x80, 0x85, 0x11, 0xD7, 0xC4, 0x60, 0xBB, 0x93, 0xE4, 0xB8, 0x2F, 0x9, 0xF0, 0x4B, 0x39, 0x13, 0xA9, 0x9C, 0x12, 0xDB, 0x73, 0x59, 0xB9, 0x37, 0xBE, 0xBD, 0x33, 0x5B, 0x83, 0x76, 0x18, 0xDA, 0x1B, 0xE4, 0xEC, 0x64, 0xF5, 0x25, 0x6, 0xA7, 0x7B, 0x4F, 0x5E, 0x74, 0xF4, 0x55, 0x2, 0x58, 0x28, 0xAC, 0xF2, 0x22, 0xE1, 0x1B, 0x7C, 0x33, 0xB9, 0xBD, 0xCD, 0xCD, 0xC0, 0xF6, 0x36, 0x37, 0x47, 0x93, 0xE8, 0x2D, 0xD7, 0x2B, 0x4, 0x6C, 0xAF, 0x73, 0x91, 0x7E, 0x8B, 0x8, 0x94, 0xDD, 0x5F, 0x62, 0x8, 0xF9, 0x4A, 0x95, 0x7F, 0x85, 0xD4, 0x55, 0xCC, 0x75, 0x6F, 0xBC, 0xEE, 0xED, 0x75, 0x21, 0x9B, 0x81, 0xD7, 0xAA, 0x60, 0x80, 0xF9, 0xFB, 0xF6, 0xDE, 0x4E, 0x61, 0x36, 0x9D, 0x6D, 0x59, 0x23, 0x3E, 0xC8, 0x80, 0x66, 0xEC, 0xBB, 0x70, 0xB5, 0x3E, 0x9B, 0xE8, 0xD, 0x9D, 0xBD, 0xED, 0x8B, 0x4, 0xB4, 0x61, 0xFB, 0xA8, 0x40, 0xF, 0xF5, 0xBF, 0x50, 0x22, 0x43, 0xFE, 0xA2, 0x90, 0x7, 0x23, 0xB3, 0x1E, 0x84, 0x30, 0x62, 0xD3, 0xE8, 0x91, 0x4C, 0xF2, 0xB4, 0x41, 0x80, 0xBF, 0xF5, 0x26, 0x92, 0x1B, 0x27, 0xD6, 0xE7, 0x92, 0x32, 0x79, 0x7, 0x9E, 0xF1, 0xC0, 0x1D, 0x78, 0xC6, 0x93, 0x57, 0x30, 0x18, 0x7F, 0x72, 0xFF, 0xC2, 0x41, 0xD7, 0x44, 0x26, 0x7D, 0xD9, 0x8E, 0x21, 0xAF, 0xDE, 0x5B, 0x6F, 0x18, 0x27, 0x56, 0x31, 0xC8, 0x83, 0x55, 0x49, 0x2A, 0xF3, 0xF9, 0x13, 0xC1, 0x8, 0xCE, 0xA, 0x62, 0xB0, 0x8D, 0x96, 0x9B, 0x2C, 0x79, 0xF7, 0xF3, 0x11, 0x14, 0x69, 0x26, 0xED, 0x3B, 0xBE, 0x99, 0x56, 0x18, 0x6D, 0xA1, 0xFD, 0xCC, 0xBC, 0x96, 0x9A, 0x81, 0x17, 0x22, 0x5D, 0x9F, 0x47, 0x3C, 0x9F, 0xFD, 0xD4, 0xA7, 0x3D, 0xB8, 0xAF, 0xF7, 0xCA, 0xE3, 0xFD, 0xB7, 0x67, 0x7A, 0x13, 0xF, 0x75, 0x22, 0x3E, 0xB5, 0x7A, 0x32, 0x1D, 0x19, 0x11, 0xDE, 0x30, 0x3E, 0x2C, 0x7C, 0x68, 0xF0, 0x29, 0x53, 0xB1, 0x21, 0x9F, 0x54, 0x16, 0x63, 0x43, 0xC5, 0xB1, 0xCC, 0xD1, 0x26, 0x53, 0x87, 0xA9, 0x9E, 0x8A, 0x13, 0x7D, 0x15, 0x51, 0x5E, 0x5E, 0x15, 0x78, 0x6A, 0x50, 0xA1, 0xA3, 0x3, 0x8, 0x94, 0x2D, 0x6B, 0xEB, 0xCB, 0xD0, 0x97, 0x6B, 0x7, 0x7, 0x11, 0xAC, 0x31, 0xE4, 0xE0, 0xC2, 0x14, 0x7A, 0x7E, 0xD0, 0xF4, 0x94, 0x40, 0x6D, 0x39, 0xCF, 0x93, 0xCE, 0xD7, 0x10, 0x1D, 0x75, 0xB7, 0x40, 0x29, 0xD7, 0x8E, 0x2B, 0xF6, 0x9A, 0x81, 0x43, 0xD5, 0xDD, 0x6C, 0xC, 0xEB, 0x1B, 0x4F, 0x6D, 0xA8, 0x87, 0xB8, 0xCF, 0xF7, 0x6F, 0x73, 0xA, 0x68, 0xE9, 0x9F, 0xEA, 0xC0, 0x2F, 0x80, 0x9C, 0xB3, 0x9D, 0xCC, 0x9C, 0xF8, 0x1A, 0xDE, 0x70, 0x94, 0x2D, 0x91, 0x9B, 0xEA, 0xEB, 0x63, 0x41, 0xF5, 0xC7, 0x62, 0xEB, 0x45, 0x49, 0x84, 0xFA, 0x13, 0x2, 0x29, 0x18, 0xFB, 0xDB, 0x61, 0xC8, 0xF6, 0x88, 0xDE, 0xE0, 0xF, 0xF5, 0x4B, 0x4F, 0xF0, 0x8D, 0x50, 0xB3, 0x6D, 0xA2, 0xEB, 0x60, 0x47, 0xA4, 0xDF, 0xB5, 0x2E, 0x9C, 0x7A, 0xBD, 0x22, 0xF0, 0x8E, 0xD7, 0x8B, 0xE4, 0x8A, 0x7F, 0x94, 0xD7, 0xFB, 0x63, 0xC8, 0xB6, 0xD5, 0x3, 0xE5, 0x65, 0x56, 0x48, 0xD, 0x62, 0x44, 0xBA, 0x8, 0x8E, 0x3C, 0xC7, 0x88, 0x70, 0x50, 0x7E, 0x49, 0x74, 0x47, 0xE9, 0xF2, 0x4D, 0xFF, 0xD4, 0x65, 0x18, 0xC6, 0xCD, 0xF9, 0xD3, 0xE5, 0x9B, 0xDA, 0x44, 0xC0, 0x69, 0x7F, 0xD2, 0xFE, 0xF4, 0xCB, 0x24, 0xED, 0xDF, 0xF9, 0x30, 0x49, 0xA7, 0xC1, 0xA, 0x84, 0x69, 0xB0, 0xEF, 0x51, 0x52, 0xBB, 0x59, 0x92, 0xD2, 0xDC, 0x66, 0x96, 0xC3, 0xCB, 0x51, 0xA1, 0x67, 0x39, 0xC1, 0xA4, 0x49, 0x5C, 0x38, 0x3D, 0xA1, 0xE1, 0xF4, 0xC4, 0xDC, 0x5, 0x66, 0x12, 0x76, 0x17, 0x98, 0x49, 0x23, 0xAD, 0x3D, 0xCA, 0x9A, 0xCC, 0x2D, 0x62, 0x26, 0x81, 0xB7, 0x88, 0x99, 0x44, 0x4F, 0x2D, 0x13, 0x32, 0xB5, 0x4C, 0xD4, 0xE, 0xDE, 0x24, 0x6E, 0xDF, 0x4D, 0xC9, 0xFC, 0x64, 0xDD, 0x75, 0x1, 0x41, 0x5F, 0x9D, 0xF2, 0x45, 0x5F, 0x26, 0x7D, 0x71, 0xDA, 0xA6, 0xE0, 0xE9, 0x28, 0xE5, 0x57, 0x19, 0x28, 0x48, 0xF9, 0x52, 0x49, 0x56, 0xA6, 0x2D, 0x32, 0x28, 0xED, 0x64, 0x2E, 0xB3, 0xCE, 0xF1, 0x84, 0xF4, 0x29, 0x81, 0x52, 0xBA, 0xE0, 0x6B, 0x22, 0xB0, 0xC5, 0x30, 0xA9, 0x0, 0x7F, 0x15, 0x2, 0x89, 0x35, 0x75, 0xA7, 0xF2, 0xF6, 0x5C, 0xFC, 0x2C, 0x3A, 0xB3, 0xD7, 0x69, 0xAF, 0x28, 0xFC, 0x81, 0xC9, 0x4D, 0x39, 0x29, 0x3, 0x9B, 0x72, 0xA8, 0x6E, 0x58, 0xEC, 0x7F, 0xB3, 0xFE, 0xB0, 0x4E, 0xF, 0x36, 0x86, 0x34, 0x58, 0x47, 0xA7, 0x53, 0x58, 0x65, 0xD4, 0x53, 0x8, 0xF0, 0x6A, 0xB9, 0x25, 0xDB, 0x58, 0x6E, 0x81, 0x36, 0x96, 0x7, 0x7F, 0x3E, 0x82, 0xDC, 0x5, 0xC0, 0x3A, 0xCD, 0x96, 0x2E, 0x8B, 0x45, 0xCA, 0x62, 0x93, 0x9E, 0xC8, 0x5C, 0x1E, 0x3B, 0x22, 0x11, 0x78, 0x2E, 0xE3, 0xF7, 0x6, 0x1, 0x33, 0xE6, 0xEC, 0xC9, 0xAC, 0xE9, 0xE5, 0x39, 0xA0, 0x4D, 0x75, 0xE4, 0x62, 0x7, 0xBE, 0x5C, 0xBE, 0x99, 0x9B, 0xF1, 0x3E, 0x74, 0x74, 0x96, 0xB5, 0x22, 0xA0, 0x76, 0x9, 0xA8, 0x81, 0x2D, 0x97, 0xEF, 0x12, 0xE3, 0xF7, 0x77, 0x19, 0xFF, 0x94, 0x18, 0xFF, 0x7C, 0x97, 0xF1, 0x1F, 0x12, 0xE3, 0x3F, 0xDE, 0x65, 0x6C, 0x8D, 0xCF, 0x51, 0x4F, 0x97, 0x6F, 0xD8, 0x1A, 0xD3, 0x41, 0x41, 0xFD, 0x53, 0xB, 0x4E, 0xCB, 0xE5, 0xB3, 0x38, 0x40, 0xF8, 0xE7, 0x56, 0xE9, 0x7F, 0xE9, 0xB7, 0xBE, 0xBF, 0xF5, 0x5B, 0xDF, 0xFF, 0xA5, 0xDF, 0xFA, 0xF3, 0xAD, 0xDF, 0xFA, 0xF3, 0x9F, 0xFC, 0x2D, 0x72, 0x4C, 0xB6, 0x20, 0x63, 0xB2, 0x85, 0x29, 0xF, 0xF6, 0xBB, 0x96, 0x9C, 0x4B, 0x2D, 0xCA, 0xCA, 0x13, 0xA9, 0xC5, 0x99, 0x6C, 0x42, 0xD4, 0x33, 0x9D, 0x56, 0xD2, 0x2C, 0xEA, 0x29, 0x80, 0x95, 0xC5, 0x93, 0x5B, 0x13, 0x16, 0xF, 0x1C, 0xD5, 0x64, 0x86, 0x97, 0xD8, 0xD8, 0x72, 0x39, 0x9D, 0xEE, 0x2F, 0x6F, 0xCD, 0xF6, 0x97, 0xF9, 0x9E, 0x87, 0xE0, 0xB5, 0xBF, 0x7F, 0xE, 0x26, 0x72, 0x8D, 0x5A, 0x7D, 0x95, 0xC2, 0xAA, 0x27, 0x86, 0xCE, 0x46, 0x72, 0x67, 0xE7, 0xA, 0x21, 0xBB, 0x3B, 0x97, 0x18, 0x6E, 0x36, 0xD1, 0x87, 0xDA, 0x5F, 0x98, 0x54, 0xD9, 0xD4, 0x64, 0x4B, 0xCE, 0xE7, 0x74, 0x33, 0x5E, 0xA7, 0x44, 0x5E, 0xDA, 0xBA, 0x63, 0x40, 0xE7, 0x72, 0x33, 0xDE, 0x24, 0x8E, 0xC3, 0x24, 0x8C, 0x21, 0x53, 0x6F, 0xB0, 0x3E, 0x97, 0x94, 0x3F, 0xCC, 0x25, 0xF4, 0x97, 0x21, 0x44, 0xCF, 0x96, 0xE3, 0x8B, 0x8, 0x50, 0xA0, 0x9F, 0xED, 0xA0, 0x3D, 0x79, 0x6C, 0x76, 0x47, 0x30, 0x2, 0x26, 0xA2, 0xB1, 0xA1, 0x50, 0x40, 0xE3, 0xA7, 0xC9, 0x86, 0xBD, 0xE3, 0x75, 0x45, 0xC1, 0x31, 0xD0, 0xCF, 0xEB, 0x92, 0x97, 0x68, 0x5A, 0x97, 0xA8, 0xC1, 0xCC, 0x1F, 0xCA, 0xFC, 0x54, 0x4F, 0x9, 0xF5, 0xCB, 0xA8, 0x86, 0xDA, 0x29, 0xF0, 0x73, 0xB0, 0x8D, 0x3D, 0x8D, 0x9B, 0x3D, 0x25, 0xD0, 0x12, 0x7A, 0x93, 0xC9, 0x5C, 0x77, 0x5, 0x40, 0x32, 0xDC, 0xFD, 0xC, 0x31, 0xD7, 0xE4, 0x9A, 0xEC, 0x1B, 0xEE, 0x2, 0xD0, 0xB, 0xCE, 0x26, 0x52, 0xBE, 0x78, 0x7D, 0x2E, 0xBD, 0x4B, 0x11, 0x4A, 0x97, 0x36, 0xB8, 0x39, 0xEB, 0x66, 0x32, 0x89, 0x5C, 0xB, 0xFC, 0x4D, 0x8A, 0x5F, 0x16, 0x3C, 0x30, 0x87, 0x64, 0xA8, 0xD0, 0xC8, 0x6, 0x88, 0xEA, 0xD1, 0x90, 0xFB, 0x85, 0x37, 0x0, 0x52, 0xE7, 0xC, 0xBB, 0x82, 0x98, 0x5D, 0xE7, 0xA8, 0xEB, 0xED, 0xC9, 0x14, 0xCD, 0x13, 0x13, 0x48, 0x1E, 0x73, 0xC, 0x3F, 0xEF, 0xE3, 0x32, 0x99, 0xE5, 0x66, 0x7C, 0x43, 0x71, 0xAD, 0x22, 0x6A, 0x97, 0x51, 0x8D, 0xB8, 0x1D, 0xEF, 0xAA, 0xA9, 0x96, 0x5F, 0x9F, 0x9E, 0x17, 0xE7, 0x4A, 0x6E, 0xA0, 0xB8, 0xCA, 0xFB, 0x27, 0xAE, 0xDA, 0xC6, 0x4F, 0x4B, 0x5E, 0xC2, 0xFD, 0x82, 0x44, 0xA, 0x2A, 0xD9, 0xE4, 0xFA, 0x58, 0x52, 0x75, 0x96, 0x3A, 0xE2, 0xB7, 0x3D, 0x47, 0x94, 0x3B, 0x7A, 0x63, 0xD3, 0xE, 0x22, 0xBD, 0xE9, 0x2E, 0x4B, 0x2E, 0x26, 0x3F, 0x89, 0x80, 0x75, 0xE4, 0x5D, 0x96, 0x1C, 0x7F, 0x3C, 0x89, 0x80, 0x51, 0xC8, 0x2A, 0xCB, 0x39, 0xB7, 0x15, 0xC5, 0x1C, 0xDB, 0x55, 0x3B, 0xEA, 0x6C, 0xD0, 0x55, 0x3B, 0xF9, 0x6C, 0xD0, 0x95, 0xBA, 0x85, 0xE3, 0x2A, 0x5E, 0xC1, 0x71, 0xD5, 0xD4, 0x9D, 0x75, 0xF5, 0xA9, 0xA8, 0x98, 0x75, 0xBA, 0x4F, 0x46, 0xF7, 0x8B, 0x69, 0x89, 0x7D, 0xD8, 0x5F, 0x4, 0x54, 0x2B, 0x5E, 0x48, 0x74, 0xD5, 0x91, 0x9A, 0x7B, 0x5F, 0x75, 0x94, 0xE7, 0xDE, 0x57, 0x1D, 0xC9, 0xAA, 0x8F, 0x72, 0x8D, 0x47, 0xF7, 0x5C, 0x38, 0xE0, 0x3F, 0xE1, 0x41, 0x8, 0xFD, 0x92, 0xD1, 0x19, 0xEA, 0x1C, 0xD0, 0xE, 0x40, 0xEA, 0xA7, 0x5, 0x8A, 0xE0, 0xC5, 0x84, 0x45, 0xF8, 0x8C, 0x8, 0x67, 0x49, 0xD9, 0x68, 0x76, 0xD1, 0xF9, 0x29, 0x0, 0xF3, 0x71, 0xDB, 0x4D, 0x37, 0x48, 0x1E, 0x7E, 0x5D, 0x75, 0x1A, 0xC8, 0x1, 0xF1, 0xE, 0x88, 0xEA, 0xA4, 0xB, 0x11, 0xB7, 0x9F, 0x15, 0x91, 0x66, 0xA, 0x3D, 0xE3, 0x82, 0x56, 0x10, 0x73, 0x41, 0x29, 0x4F, 0xF7, 0x4B, 0x36, 0x31, 0xEA, 0x68, 0x17, 0xC7, 0x38, 0xC7, 0xAF, 0x42, 0x98, 0x93, 0x2C, 0x3A, 0x53, 0xC3, 0x96, 0xB0, 0x80, 0x68, 0x9, 0x6B, 0xC6, 0x42, 0xCE, 0xDF, 0xEE, 0x30, 0x4A, 0x76, 0x9B, 0x4F, 0xD3, 0xE7, 0x7F, 0x15, 0x41, 0x5F, 0xF5, 0x36, 0xA7, 0x21, 0x70, 0x1F, 0xF3, 0x36, 0x27, 0x55, 0x51, 0x8C, 0x28, 0x59, 0x2A, 0xB6, 0xBB, 0x13, 0x55, 0x54, 0x24, 0x5A, 0xD2, 0x48, 0x46, 0xE1, 0x56, 0x4, 0x30, 0x6C, 0x2A, 0xDB, 0xED, 0x55, 0xCE, 0x76, 0x7B, 0x6D, 0x7E, 0x5E, 0x2, 0x39, 0x80, 0x5C, 0x11, 0x60, 0x2F, 0xD9, 0xB5, 0xF9, 0xC9, 0x32, 0x60, 0x4, 0x2D, 0xB5, 0x9, 0xE3, 0xDA, 0xCA, 0x5B, 0x30, 0xAE, 0xA6, 0x33, 0x71, 0xCD, 0xA8, 0x46, 0x8E, 0x1A, 0x5F, 0x38, 0x32, 0x70, 0x2C, 0xD2, 0x44, 0x64, 0x66, 0x7D, 0x2C, 0xAA, 0x7E, 0xD0, 0xDF, 0xAF, 0x0, 0xC0, 0xE7, 0x33, 0x5D, 0x88, 0xDA, 0xFA, 0x6B, 0xD4, 0x75, 0xE5, 0x91, 0x1E, 0x61, 0xFC, 0x22, 0x4, 0x8D, 0x34, 0xAA, 0xCE, 0x6C, 0xC2, 0x3C, 0x99, 0x13, 0xD2, 0x55, 0x0, 0x24, 0xA4, 0x42, 0x2, 0x57, 0x73, 0x72, 0x13, 0xDF, 0xEB, 0x2D, 0x4D, 0xD7, 0x68, 0x6A, 0x8F, 0xEA, 0x13, 0x91, 0x8, 0x6C, 0xD4, 0x64, 0x97, 0x5F, 0x0, 0xA0, 0xD3, 0x37, 0xA4, 0xA5, 0x19, 0xC4, 0xD2, 0x7C, 0xB6, 0xBD, 0x89, 0xEE, 0x9E, 0x7, 0xDB, 0x5E, 0x1A, 0x53, 0xDC, 0x18, 0xED, 0x8F, 0x36, 0x49, 0xF5, 0x45, 0x14, 0xF2, 0x4B, 0x26, 0xBA, 0x40, 0x17, 0x30, 0xBA, 0x80, 0x94, 0xB1, 0xF8, 0x7B, 0x72, 0x47, 0xD3, 0x6, 0xA1, 0x1C, 0xC4, 0xFE, 0x80, 0x1D, 0x90, 0xD5, 0x99, 0x96, 0xB8, 0x2, 0x92, 0x7A, 0xCA, 0xE6, 0x62, 0xE9, 0xC, 0xBD, 0x3B, 0x8, 0xF5, 0x1B, 0x26, 0xD3, 0xAD, 0x31, 0x23, 0x6D, 0x31, 0xB3, 0xE3, 0x8C, 0x15, 0x2, 0x38, 0x7A, 0xF2, 0x2B, 0xF4, 0xC0, 0x7, 0x18, 0x2E, 0x6E, 0x31, 0x3E, 0x65, 0x13, 0xA9, 0x44, 0xBE, 0x7, 0x18, 0x48, 0xE6, 0x7B, 0x1D, 0xA8, 0x45, 0xFC, 0xEB, 0x20, 0xAF, 0xE2, 0x5F, 0x87, 0x34, 0xD2, 0xBA, 0x69, 0x84, 0xB4, 0xC9, 0xEA, 0x1B, 0x80, 0x8, 0xD9, 0x75, 0xB8, 0xD1, 0x3F, 0x7C, 0x3, 0x7E, 0x97, 0x32, 0x5C, 0xD1, 0x66, 0x2D, 0x15, 0xD2, 0xA9, 0x4F, 0x25, 0xC5, 0xEA, 0x86, 0xF7, 0x88, 0x31, 0xFD, 0xE3, 0xEA, 0x8B, 0xC, 0x70, 0x37, 0xDE, 0xD5, 0x52, 0x99, 0xB1, 0xB, 0x91, 0xE8, 0xDD, 0x6C, 0x67, 0x26, 0x9B, 0xA9, 0xF9, 0xF6, 0x8E, 0x88, 0x4, 0xD4, 0x57, 0xB3, 0x62, 0xB6, 0xC8, 0xAB, 0x35, 0xD4, 0xD1, 0xCB, 0xAB, 0x35, 0x72, 0xD6, 0xC6, 0xAB, 0x35, 0x13, 0x3F, 0x45, 0x28, 0x20, 0x38, 0x3D, 0xB0, 0xCC, 0xC2, 0xC0, 0xD5, 0x82, 0xFB, 0x4E, 0xAE, 0xB6, 0x5F, 0x4C, 0x34, 0xFC, 0x1C, 0x6A, 0xC3, 0xD1, 0xF2, 0x90, 0x2E, 0xC0, 0x2, 0x2E, 0xC0, 0x4E, 0x3A, 0x9B, 0x89, 0xBF, 0x55, 0xFD, 0x29, 0x80, 0x96, 0x64, 0x32, 0xBD, 0xD1, 0xF1, 0xA2, 0xC9, 0x9C, 0x49, 0x7, 0x18, 0x48, 0x32, 0xFE, 0x94, 0xEE, 0xCC, 0x29, 0x59, 0x67, 0xDE, 0x21, 0xEB, 0x9B, 0x53, 0xB2, 0xBE, 0x79, 0x87, 0xCC, 0x9E, 0x97, 0xCC, 0xBE, 0x55, 0xB2, 0x8F, 0xF9, 0x94, 0xEC, 0x63, 0x7E, 0x87, 0x2C, 0xF5, 0xA7, 0x64, 0xA9, 0x7F, 0x83, 0xEC, 0x8C, 0x9, 0xA3, 0xA1, 0x2D, 0xB, 0xB0, 0x26, 0x47, 0x6D, 0xDF, 0x2B, 0xCF, 0xE5, 0xBD, 0x7B, 0x45, 0xEA, 0xC4, 0xAC, 0xD1, 0xD0, 0x82, 0xF5, 0x54, 0xCA, 0xF4, 0xF5, 0xB1, 0xAC, 0xEA, 0xA9, 0xD0, 0xF0, 0xE, 0x20, 0xEA, 0x96, 0xFB, 0xF5, 0x15, 0x12, 0x29, 0xA8, 0xD4, 0xC9, 0x57, 0xEB, 0xF9, 0xD4, 0xC9, 0x87, 0xCF, 0xE6, 0x8D, 0x8E, 0x7C, 0xDC, 0x69, 0x85, 0xE1, 0x5A, 0x2C, 0xC2, 0x27, 0xFE, 0x6E, 0xC5, 0x71, 0x36, 0x2A, 0xA6, 0x67, 0xBD, 0x9C, 0xF5, 0xF7, 0x6A, 0xFD, 0xD9, 0xE9, 0x84, 0xD, 0x86, 0xCB, 0xE1, 0xE9, 0xAB, 0xA4, 0x56, 0x4, 0x18, 0x81, 0x58, 0x4F, 0xED, 0xC8, 0x2F, 0x6F, 0x22, 0xEE, 0xC8, 0xBF, 0xDA, 0x48, 0xDA, 0x56, 0x94, 0xD, 0x2B, 0x9A, 0x46, 0x27, 0xC3, 0xAD, 0xB0, 0x1F, 0x70, 0x68, 0x2E, 0x61, 0xA3, 0xF1, 0x26, 0xB7, 0x44, 0xE0, 0xFE, 0xF, 0xB5, 0x81, 0xAA, 0x1D, 0xA4, 0x85, 0xAA, 0x2A, 0xF9, 0xA0, 0x67, 0x4D, 0x2B, 0x84, 0x74, 0x67, 0xD1, 0xA4, 0x1C, 0xA8, 0x4C, 0x6, 0x2F, 0x48, 0xA6, 0xF8, 0xD4, 0xD3, 0x74, 0xE9, 0x75, 0x36, 0x9F, 0xFA, 0xCE, 0xD6, 0xD0, 0x51, 0x8, 0xAD, 0xA6, 0xA3, 0xE, 0x75, 0x87, 0xD9, 0x6F, 0xBC, 0xF2, 0x6D, 0x66, 0xBF, 0xAA, 0xD0, 0xF3, 0x99, 0x5F, 0x79, 0x91, 0x79, 0x4D, 0xD1, 0x31, 0xD, 0x79, 0xED, 0xC8, 0x86, 0x21, 0x57, 0x8E, 0x5C, 0x6D, 0x74, 0x54, 0x1A, 0xF5, 0xF2, 0x5C, 0xCE, 0xA3, 0x5E, 0xA4, 0x3A, 0x3D, 0xD1, 0x55, 0xB5, 0x22, 0x0, 0x1, 0x15, 0x5, 0x28, 0x3F, 0xDF, 0x71, 0x41, 0x80, 0x2F, 0xA5, 0xA7, 0x72, 0xEC, 0x56, 0x75, 0x31, 0xC9, 0x6E, 0x91, 0xA2, 0xB2, 0xEC, 0x56, 0x6D, 0x31, 0xCD, 0x6E, 0x91, 0xF2, 0x44, 0x12, 0xB8, 0xAA, 0xED, 0xA5, 0x2C, 0x70, 0x55, 0x9B, 0x1C, 0xA2, 0x16, 0x6D, 0x60, 0x80, 0x1A, 0x1D, 0x39, 0xB9, 0x2E, 0xEA, 0xF2, 0xE4, 0xDA, 0x46, 0x47, 0x65, 0xB0, 0xA9, 0xDA, 0x62, 0xA, 0x9B, 0xAB, 0xA5, 0x36, 0xCC, 0xD4, 0xA7, 0xB2, 0x22, 0x79, 0x78, 0x6D, 0x7, 0x24, 0x75, 0xF2, 0x4B, 0xCB, 0x5F, 0x39, 0x7F, 0xE8, 0x48, 0xF7, 0x2, 0x5, 0x41, 0x7A, 0x81, 0xEC, 0xC9, 0xD5, 0xEC, 0x1D, 0x10, 0xD4, 0x89, 0x3C, 0x52, 0x57, 0x29, 0x87, 0xD4, 0xF5, 0x43, 0x4F, 0x86, 0x2E, 0x75, 0x45, 0x80, 0x52, 0x7F, 0x50, 0x9, 0x1B, 0xAE, 0x1F, 0xF2, 0xCD, 0xA, 0xD7, 0xF, 0x43, 0xA7, 0x4, 0xA9, 0x0, 0xF0, 0xCB, 0xE4, 0x6D, 0xF2, 0x57, 0xEE, 0x1A, 0xF9, 0xA7, 0xDA, 0xA4, 0xC7, 0x94, 0x35, 0x1D, 0x6E, 0xD9, 0x30, 0xE0, 0xC7, 0xCB, 0xF8, 0xE0, 0x6C, 0xF9, 0x6B, 0xC3, 0xC1, 0x21, 0xC4, 0xA4, 0x99, 0xE2, 0x40, 0x25, 0x99, 0x2D, 0xB1, 0x85, 0xA2, 0x3C, 0x57, 0x56, 0x3C, 0x52, 0x72, 0x9D, 0x74, 0xD6, 0xBE, 0x67, 0x8C, 0x60, 0x7, 0x91, 0x62, 0x98, 0x71, 0x34, 0x6C, 0xCA, 0x9B, 0x3, 0xC, 0x71, 0xB1, 0x99, 0x6B, 0x76, 0xC, 0x61, 0xB1, 0x2D, 0x75, 0x2C, 0x63, 0x7B, 0x2E, 0x2A, 0xF7, 0x3, 0x73, 0x8A, 0x6B, 0xC5, 0xA0, 0x0, 0x7F, 0x95, 0xA4, 0x9A, 0xF4, 0x6, 0x88, 0xEA, 0x74, 0xF9, 0xE5, 0xD2, 0x7, 0xF2, 0x50, 0x49, 0x79, 0xE, 0x4, 0xAD, 0xA7, 0x10, 0x19, 0x7B, 0xC, 0x11, 0xB2, 0xC8, 0x10, 0x8D, 0x6F, 0xE9, 0x41, 0xDC, 0x86, 0x1, 0xA3, 0xB8, 0x29, 0x44, 0xDB, 0xF1, 0x21, 0x89, 0x15, 0x46, 0xDB, 0x57, 0x88, 0x76, 0xA6, 0x3, 0xF9, 0x2B, 0x84, 0xD5, 0x8A, 0x25, 0x17, 0xF0, 0x36, 0x40, 0x56, 0xF, 0x5C, 0xA5, 0x6, 0xA8, 0x52, 0x3F, 0xE9, 0xD5, 0xFA, 0xD, 0x90, 0xD4, 0xD7, 0x53, 0x68, 0x6E, 0xF1, 0x96, 0xBA, 0x5, 0xF6, 0x3A, 0x6D, 0x47, 0xD0, 0x54, 0x91, 0x0, 0x6E, 0x84, 0xBD, 0x4E, 0xE4, 0xAA, 0xCC, 0x4, 0x2C, 0xCA, 0x90, 0x1B, 0x74, 0xE4, 0xDD, 0x39, 0xD3, 0xBD, 0x33, 0x44, 0x8E, 0xC6, 0xEB, 0x54, 0x6, 0xC8, 0xE2, 0xED, 0xCE, 0xD7, 0xE9, 0xEE, 0xFD, 0x10, 0x52, 0xB6, 0x9E, 0x59, 0x14, 0x3F, 0x8, 0x40, 0x23, 0x63, 0xCA, 0x28, 0x45, 0x43, 0x74, 0xA4, 0xD, 0x3A, 0xC0, 0xFC, 0x1C, 0xD9, 0x9C, 0x1C, 0xD0, 0x90, 0xC8, 0x5C, 0x3A, 0x57, 0x20, 0x8D, 0xCE, 0xD5, 0x77, 0x64, 0x71, 0x7D, 0x7, 0x94, 0xD7, 0x7F, 0x8E, 0xCC, 0x3A, 0xB5, 0xFF, 0x1C, 0xB1, 0x45, 0x6A, 0xAA, 0xD8, 0x62, 0x99, 0x43, 0xDF, 0x5B, 0x7A, 0x49, 0xAA, 0x22, 0x40, 0x7D, 0x85, 0xC9, 0x6, 0x6F, 0xF2, 0x67, 0x88, 0xDC, 0x1B, 0x1C, 0x24, 0xC0, 0x37, 0x99, 0x46, 0xD3, 0xD8, 0x86, 0x1E, 0xEE, 0xEC, 0x20, 0xF0, 0x41, 0xC2, 0x34, 0x31, 0xC7, 0x6F, 0x36, 0x8, 0xE1, 0x20, 0xB5, 0x1, 0x35, 0x6A, 0x90, 0x51, 0x9E, 0xCA, 0x8A, 0x5B, 0x5D, 0xD1, 0xFA, 0x3B, 0x28, 0xD2, 0xE4, 0x46, 0x4F, 0x54, 0x18, 0x6C, 0x47, 0x24, 0x82, 0x78, 0x69, 0x4C, 0xCE, 0x26, 0x92, 0x17, 0xF3, 0xBF, 0x60, 0xE4, 0x72, 0xFE, 0x22, 0xED, 0x42, 0x34, 0x2C, 0x55, 0x1, 0x51, 0xA2, 0xB0, 0x44, 0xFA, 0x12, 0xFD, 0xD, 0x84, 0x6E, 0xD1, 0x2F, 0xB2, 0x29, 0x38, 0xBE, 0x44, 0x5, 0x44, 0x4B, 0x94, 0x7, 0x9E, 0x27, 0xF, 0x28, 0x4D, 0xCB, 0x9E, 0x21, 0xAC, 0x20, 0xD8, 0x75, 0x7, 0xEA, 0x22, 0x91, 0xFA, 0x54, 0x56, 0xA4, 0x77, 0x48, 0x14, 0x0, 0x69, 0x27, 0x91, 0xDC, 0x9C, 0x51, 0x1F, 0x8B, 0xAA, 0xBD, 0x69, 0xA6, 0xD0, 0x8E, 0xCC, 0x8A, 0xFD, 0xB, 0x87, 0x62, 0x46, 0x21, 0xF6, 0x21, 0xF, 0x26, 0x7A, 0x4D, 0xC6, 0xE0, 0x2B, 0xAC, 0x86, 0x32, 0x4A, 0xD7, 0x40, 0x1C, 0x7E, 0x65, 0xB3, 0xE9, 0x9C, 0xCD, 0x26, 0x94, 0x6D, 0xB2, 0x97, 0xD6, 0x24, 0x43, 0x67, 0x36, 0x39, 0xE2, 0x88, 0xEB, 0x8D, 0x93, 0x15, 0xC8, 0xDE, 0xE0, 0x72, 0x86, 0x9A, 0x9A, 0x86, 0xA8, 0xA, 0x20, 0xBF, 0x98, 0x63, 0xBE, 0x9D, 0x72, 0xD0, 0x37, 0xA3, 0x3, 0x90, 0x21, 0x2A, 0x3E, 0xFC, 0x78, 0x54, 0x4F, 0x74, 0xC0, 0x6B, 0x3, 0x4E, 0xDE, 0xFD, 0xDF, 0x88, 0x9F, 0x3C, 0xD1, 0xF9, 0xFA, 0x9B, 0xCC, 0x36, 0x84, 0x15, 0x82, 0x1A, 0x4D, 0xD6, 0x13, 0x39, 0x68, 0xA8, 0x8, 0x30, 0x6E, 0x8, 0x31, 0x1B, 0x72, 0x3E, 0x5B, 0x0, 0x64, 0x42, 0x1B, 0x62, 0x76, 0x36, 0xA5, 0xB0, 0x4C, 0xFC, 0xE4, 0xE1, 0x20, 0x3, 0xBB, 0xA1, 0xD2, 0x4B, 0xE5, 0xE1, 0x8C, 0xB2, 0xA, 0xC0, 0x7C, 0xC, 0xB, 0xA6, 0x4A, 0x2E, 0x88, 0x15, 0x0, 0x58, 0x11, 0x2B, 0x62, 0xF, 0xB6, 0xC7, 0x59, 0xDC, 0x3, 0xEC, 0x70, 0x12, 0x73, 0x46, 0x69, 0x45, 0x10, 0x63, 0xC9, 0xB4, 0xA5, 0x64, 0xC4, 0x4C, 0x16, 0xDF, 0x31, 0x17, 0xA8, 0x1E, 0x30, 0x94, 0x84, 0xFF, 0xAA, 0xBB, 0x4, 0xFA, 0x59, 0xC9, 0x2E, 0x43, 0xEE, 0x30, 0x1E, 0x74, 0x4D, 0x3C, 0x80, 0x9A, 0xA0, 0x14, 0x65, 0xA5, 0xCB, 0xB7, 0x59, 0xEB, 0xE4, 0x74, 0x4A, 0xF6, 0x66, 0xFA, 0x68, 0x3B, 0x7A, 0x52, 0x14, 0x2F, 0xDF, 0x54, 0x11, 0x54, 0x7, 0x49, 0x68, 0x76, 0x14, 0xF5, 0xB7, 0x4B, 0xB7, 0xA6, 0x80, 0xFA, 0xB0, 0xD7, 0x2B, 0xB3, 0x22, 0xB1, 0x4A, 0xA9, 0x97, 0x18, 0xC8, 0xED, 0x4C, 0xA4, 0x97, 0x24, 0x2A, 0x4, 0xAD, 0x48, 0x54, 0xC9, 0x56, 0xFB, 0x9B, 0x66, 0x3A, 0xE9, 0x83, 0x0, 0xD4, 0x4B, 0x47, 0xED, 0xE9, 0xA8, 0x49, 0x1, 0x80, 0xD6, 0x10, 0xB5, 0x6F, 0xB5, 0x4D, 0xFC, 0xA0, 0x69, 0x17, 0x0, 0x4D, 0x31, 0x6A, 0x3F, 0x5E, 0x97, 0x93, 0xA5, 0xDB, 0x5D, 0x0, 0xE7, 0x7B, 0xD8, 0x34, 0x6A, 0xCF, 0x9F, 0xE1, 0x3B, 0xCA, 0xA0, 0xAC, 0xA6, 0x8B, 0x96, 0xF, 0xC8, 0xBE, 0x60, 0xA4, 0x6, 0xC9, 0x3D, 0x41, 0x51, 0xDE, 0x11, 0x14, 0x8D, 0x69, 0xC2, 0xCF, 0x4B, 0xE0, 0x2C, 0x61, 0x87, 0x31, 0x3B, 0xD8, 0xA5, 0xE9, 0x96, 0xF9, 0xE4, 0x2, 0xF6, 0x29, 0xAF, 0xC2, 0xE7, 0xC5, 0x7A, 0xAB, 0x54, 0xA7, 0x85, 0x82, 0xCB, 0xD4, 0x75, 0x9E, 0x39, 0x92, 0xB4, 0x81, 0xD0, 0xE6, 0xA7, 0x4D, 0x96, 0xC, 0x23, 0xED, 0x3C, 0x6C, 0x30, 0xE9, 0x2B, 0x8D, 0x49, 0x63, 0xE, 0x33, 0x5F, 0xA4, 0x15, 0x47, 0x4B, 0x35, 0x30, 0x55, 0xA4, 0x6, 0xA8, 0x76, 0x9C, 0x4E, 0xC1, 0xF3, 0x59, 0x76, 0xF, 0x22, 0x70, 0x13, 0x31, 0xEE, 0x6E, 0x99, 0x55, 0xFA, 0xD, 0x44, 0x6D, 0x20, 0xD9, 0x6C, 0x98, 0x73, 0x8, 0x1B, 0x8A, 0x1D, 0x44, 0x88, 0xC6, 0xE4, 0x29, 0xAD, 0xC7, 0x34, 0x18, 0xD7, 0x5E, 0x25, 0xD4, 0x2A, 0x82, 0xB9, 0x75, 0x63, 0xCF, 0xD2, 0x6D, 0xEF, 0x38, 0x5C, 0x6B, 0xE4, 0x61, 0xF0, 0xF2, 0x1C, 0x72, 0x25, 0xC9, 0x78, 0xCB, 0xD8, 0xE6, 0x6, 0x89, 0x14, 0xCC, 0xF6, 0xC0, 0x88, 0x6E, 0xF, 0x8C, 0xB6, 0x9, 0xA7, 0x15, 0xB2, 0xE2, 0x68, 0x85, 0x90, 0x53, 0x2A, 0x20, 0x1, 0xF0, 0x35, 0xDA, 0x65, 0xB2, 0x97, 0xDB, 0xA5, 0x2F, 0x7F, 0xE9, 0x99, 0xD9, 0x57, 0x11, 0x9C, 0xD3, 0x0, 0xA4, 0xE6, 0x7D, 0x56, 0x80, 0xF4, 0x6D, 0x4E, 0x6F, 0x1E, 0x56, 0x23, 0xC5, 0x3D, 0xA, 0xBE, 0xCB, 0x8F, 0xD2, 0xBF, 0xCB, 0x2E, 0xF3, 0xBE, 0xC7, 0x28, 0x1B, 0xC3, 0xBB, 0xB6, 0x0, 0x98, 0xC2, 0xDB, 0x96, 0x20, 0x1B, 0xC2, 0xBB, 0x76, 0x80, 0x9A, 0xC1, 0x3F, 0x67, 0x5, 0xA0, 0x11, 0xFC, 0x53, 0x36, 0x20, 0x9A, 0xC0, 0x1B, 0x16, 0x40, 0x45, 0x61, 0xA3, 0x18, 0x80, 0x8D, 0xA1, 0xA7, 0x4F, 0xF2, 0x6E, 0x80, 0xAC, 0xCE, 0x7B, 0xC2, 0x0, 0x7B, 0xC1, 0xD0, 0x7B, 0xBA, 0x5F, 0x8, 0xBD, 0x47, 0x3A, 0x86, 0x30, 0x71, 0x41, 0x92, 0x15, 0x82, 0x38, 0xDC, 0x45, 0xD3, 0x99, 0x7E, 0x36, 0xC, 0xEB, 0xCE, 0x8B, 0xE8, 0x74, 0x42, 0x33, 0xE1, 0x34, 0xCC, 0x51, 0xC7, 0x15, 0xC3, 0x69, 0x1E, 0xCC, 0x58, 0xA0, 0x62, 0xD8, 0x20, 0x20, 0xB8, 0x4B, 0xAB, 0xF9, 0xD2, 0xB4, 0x1A, 0x2E, 0x4D, 0x1B, 0xF8, 0xD2, 0xB4, 0x1, 0x2F, 0x4D, 0x3E, 0x29, 0x4D, 0x86, 0x4B, 0xD3, 0xB5, 0x3C, 0x4D, 0xD7, 0xE2, 0x34, 0x74, 0xD6, 0x9F, 0xD, 0x83, 0x69, 0xAE, 0x27, 0x76, 0x73, 0xC5, 0xED, 0xA6, 0x3F, 0xF9, 0x52, 0x3D, 0xFE, 0xA5, 0x6, 0xCB, 0xD3, 0xC, 0x16, 0xA6, 0xB1, 0x27, 0xA5, 0xB1, 0x78, 0x69, 0x6C, 0x77, 0x42, 0xD3, 0xE1, 0x34, 0x27, 0x55, 0x6C, 0xF1, 0x2A, 0xB6, 0x74, 0xAE, 0xC9, 0xD, 0x83, 0x69, 0xB8, 0xA5, 0x88, 0x15, 0xC3, 0x69, 0x98, 0xAD, 0xB0, 0x2B, 0x6, 0xD3, 0x4C, 0x9A, 0x6F, 0x9A, 0x93, 0x86, 0x9B, 0xA6, 0x3B, 0xF9, 0xE0, 0xE, 0xFF, 0xE0, 0xEE, 0xE4, 0x83, 0x3B, 0xFC, 0x83, 0x3B, 0xE6, 0xC0, 0xEB, 0x8A, 0x61, 0x23, 0xFE, 0x22, 0x7A, 0xD2, 0x18, 0x1C, 0xDE, 0x18, 0xDC, 0x89, 0xDD, 0x38, 0xDC, 0x6E, 0x5C, 0x38, 0xA1, 0x9, 0x38, 0xCD, 0x89, 0xF9, 0x39, 0xDC, 0xFC, 0xDC, 0x89, 0x2F, 0x76, 0xB8, 0x2F, 0xF6, 0x27, 0xBE, 0xD8, 0xE3, 0xBE, 0xD8, 0x9F, 0xD8, 0x8D, 0xC7, 0xED, 0xC6, 0x9F, 0xB8, 0x74, 0x8F, 0xBB, 0x74, 0x3F, 0x9C, 0xD0, 0xC, 0x38, 0xCD, 0xC7, 0x9, 0xCD, 0x7, 0x4E, 0xC3, 0x64, 0x8A, 0x58, 0x31, 0x9C, 0xE6, 0x76, 0x42, 0x73, 0xC3, 0x69, 0xB8, 0x20, 0x45, 0xC5, 0x60, 0x7F, 0x13, 0x4E, 0xAA, 0x38, 0xE0, 0x55, 0x1C, 0x4E, 0xC6, 0x7E, 0x1, 0x1F, 0xFB, 0x85, 0x93, 0x41, 0x5B, 0xC0, 0x7, 0x6D, 0xF3, 0x89, 0x13, 0x9D, 0x71, 0x27, 0x3A, 0x9F, 0x94, 0x66, 0xC6, 0x4B, 0x13, 0x4F, 0xBC, 0x5F, 0xC4, 0xBD, 0x5F, 0x3A, 0x69, 0xE1, 0x9, 0x6F, 0xE1, 0xE9, 0xA4, 0x85, 0x27, 0xBC, 0x85, 0xE7, 0x13, 0x5F, 0x9C, 0x71, 0x5F, 0x9C, 0x7F, 0x9E, 0xD0, 0xFC, 0x84, 0x69, 0x96, 0x13, 0x27, 0xBA, 0xE0, 0x4E, 0xF4, 0x76, 0x62, 0x37, 0x37, 0xDC, 0x6E, 0x6E, 0x27, 0xA5, 0xB9, 0xE1, 0xA5, 0xF9, 0x3C, 0x29, 0xCD, 0x27, 0x5E, 0x9A, 0xCF, 0x13, 0xF3, 0xFB, 0xC4, 0xCD, 0xEF, 0xF3, 0xC4, 0x6D, 0x7D, 0xE2, 0x6E, 0xEB, 0xF3, 0x64, 0xB4, 0xF5, 0x9, 0x8F, 0xB6, 0xE8, 0x78, 0x76, 0x70, 0x7C, 0x3C, 0xFB, 0x38, 0x53, 0x75, 0x3A, 0x8E, 0x26, 0xD3, 0x7, 0x2A, 0xA, 0xC9, 0xB, 0x7, 0x8A, 0xC2, 0xEC, 0x2, 0x2B, 0x3C, 0x27, 0x7B, 0xC0, 0x8E, 0x14, 0x3E, 0x77, 0x21, 0xD0, 0x2F, 0xB4, 0x41, 0xE2, 0x2B, 0xF9, 0x6C, 0xA9, 0xBB, 0x35, 0x9E, 0x88, 0x48, 0x90, 0xAC, 0xF, 0x9E, 0xDE, 0xDE, 0xBC, 0x83, 0x48, 0x14, 0x25, 0xA4, 0xCC, 0x2C, 0x18, 0x16, 0x4, 0x8A, 0x23, 0xDC, 0x39, 0x82, 0x3B, 0xA4, 0xBF, 0xA4, 0x91, 0x3C, 0x81, 0xF6, 0x87, 0x5A, 0xB1, 0x5F, 0x4F, 0x9E, 0x51, 0x7, 0xF6, 0xC8, 0xDC, 0x9, 0x40, 0xE6, 0x84, 0x44, 0x2F, 0xFE, 0x27, 0x64, 0xF1, 0x3F, 0xCF, 0xBA, 0x6D, 0x4D, 0x4A, 0x64, 0x4E, 0xB7, 0x1D, 0x44, 0xF2, 0xB9, 0x51, 0x17, 0x99, 0x5F, 0xC5, 0x5B, 0xCC, 0xAF, 0x4B, 0x3B, 0x2C, 0xA7, 0x39, 0xE6, 0xAB, 0xC4, 0x3B, 0x19, 0xE6, 0xAB, 0x42, 0xE, 0xE3, 0x9D, 0xBC, 0x86, 0x63, 0xA5, 0xAB, 0x30, 0x4C, 0x75, 0xD7, 0x67, 0x45, 0x5B, 0x71, 0x84, 0xAC, 0xD3, 0xD6, 0x7E, 0xEA, 0x4C, 0xDE, 0x85, 0x5C, 0x51, 0x55, 0x61, 0x84, 0xCA, 0x5C, 0x97, 0xD1, 0x96, 0xDF, 0xF5, 0x3A, 0xD, 0xE4, 0xDD, 0xBB, 0x9B, 0x8C, 0x7A, 0xA, 0x41, 0xB4, 0x13, 0x73, 0x4E, 0x63, 0x31, 0x13, 0x74, 0x4A, 0x63, 0xA9, 0xFB, 0x3E, 0x73, 0xA7, 0x93, 0x37, 0xF9, 0x41, 0x1E, 0xC9, 0xFC, 0x2A, 0x82, 0x9C, 0xC8, 0x5C, 0x3E, 0xAC, 0xE9, 0xCA, 0x2B, 0x3C, 0x96, 0x30, 0x32, 0x5F, 0xA2, 0x8A, 0xA8, 0x5D, 0x6, 0x79, 0xD7, 0xF, 0x6B, 0x3B, 0x13, 0x75, 0x48, 0x9A, 0xE7, 0xAC, 0x12, 0xAA, 0x8A, 0x60, 0x94, 0xA3, 0xFE, 0xD4, 0xFD, 0xD2, 0xE, 0x76, 0xC, 0xC2, 0xD7, 0xF9, 0x22, 0xFB, 0xDE, 0x57, 0x5A, 0x55, 0xA5, 0xFA, 0x28, 0x32, 0x6F, 0x56, 0x48, 0x51, 0x81, 0x8A, 0xFD, 0x6E, 0x79, 0x9D, 0xF5, 0xBA, 0xD7, 0x9E, 0x69, 0x91, 0x5, 0x57, 0xAB, 0x0, 0x4C, 0x17, 0x92, 0xB6, 0x99, 0x6D, 0x97, 0xAB, 0x88, 0xDA, 0x64, 0x50, 0x52, 0x91, 0xF2, 0x3D, 0x42, 0x1F, 0x9C, 0xBD, 0x8B, 0x9F, 0x69, 0x95, 0x7A, 0xF3, 0x43, 0x85, 0x51, 0xF7, 0x8B, 0xE7, 0x8B, 0x5A, 0x88, 0xAA, 0x0, 0x46, 0x97, 0x74, 0xE, 0x7A, 0xB4, 0x99, 0xE5, 0x2B, 0x2, 0xAA, 0x4A, 0xA0, 0x84, 0x9F, 0xFA, 0xC4, 0xD5, 0xAD, 0x2, 0xB8, 0xB7, 0x5B, 0xE5, 0x47, 0x5D, 0x6C, 0xE4, 0xF3, 0xA4, 0x90, 0x9F, 0x5A, 0xED, 0x42, 0x20, 0x6D, 0xE9, 0x57, 0x6C, 0xA3, 0xA3, 0x1E, 0x59, 0x7B, 0xAF, 0x32, 0x6A, 0x13, 0x82, 0x69, 0x4F, 0xBF, 0xF9, 0x9B, 0x5F, 0x9B, 0xCC, 0x7E, 0xBD, 0x3, 0xB2, 0xFA, 0xBD, 0xF4, 0x9C, 0x9D, 0xDC, 0xB0, 0x37, 0xB9, 0xF7, 0xDA, 0xF6, 0x58, 0x6B, 0xFB, 0xEC, 0xE2, 0xAB, 0x5D, 0xE6, 0x8D, 0xAB, 0xAF, 0x8A, 0xCA, 0x5D, 0x9F, 0x36, 0xC7, 0x22, 0xF0, 0x4E, 0x73, 0x1C, 0x8B, 0xA3, 0x2D, 0x7F, 0xD8, 0xCF, 0x52, 0x25, 0xD4, 0x26, 0x2, 0x53, 0x96, 0xCA, 0x1A, 0xEF, 0x21, 0x73, 0xAF, 0xBE, 0xCB, 0xA8, 0x2A, 0x84, 0xD1, 0x76, 0xA1, 0xF8, 0xA2, 0x9E, 0x2D, 0x67, 0xC1, 0x55, 0x11, 0xC0, 0xE8, 0xF8, 0xFB, 0xF9, 0xD7, 0x7B, 0xF9, 0xC1, 0xDB, 0xF9, 0x5, 0x16, 0x48, 0xDF, 0x15, 0x93, 0x3D, 0x1B, 0x50, 0x6D, 0x22, 0x6F, 0xC, 0xAA, 0x4E, 0xBF, 0x28, 0xFC, 0x25, 0x63, 0x10, 0x1A, 0x6D, 0x15, 0x79, 0xAB, 0xDD, 0x8E, 0x4B, 0xD2, 0xA3, 0x1D, 0xEE, 0xA1, 0x67, 0x6D, 0xA3, 0x8, 0xA8, 0x2A, 0x1, 0x12, 0x96, 0x11, 0xF0, 0xF3, 0x3F, 0x2C, 0x6B, 0x1D, 0x27, 0xBF, 0xFE, 0x87, 0x53, 0xB, 0xCD, 0x78, 0xE3, 0x7B, 0xAB, 0x1D, 0x23, 0x45, 0x85, 0x78, 0x8A, 0x3D, 0x8, 0x13, 0x85, 0x55, 0xE8, 0xBD, 0xB9, 0x2, 0xB5, 0xE9, 0x79, 0x11, 0x77, 0x3B, 0x2F, 0x5E, 0x37, 0xC5, 0x43, 0xB6, 0x83, 0x6D, 0x98, 0xC2, 0x6C, 0x12, 0xAA, 0x8A, 0x40, 0x25, 0xD1, 0xBD, 0xCE, 0xD5, 0x4B, 0x97, 0xBF, 0x1C, 0x69, 0xC1, 0xD4, 0x2E, 0x84, 0xD1, 0xE, 0xA5, 0x1C, 0x67, 0x8D, 0x6E, 0x13, 0x79, 0xA3, 0xD1, 0x79, 0xEA, 0x54, 0x41, 0x79, 0x2A, 0x2B, 0xEA, 0xD6, 0x6, 0x76, 0xF3, 0xC1, 0x86, 0x83, 0x1B, 0x10, 0x96, 0x60, 0xD3, 0xB8, 0x90, 0xD3, 0xF6, 0x15, 0x2, 0x26, 0xEE, 0x4B, 0x48, 0x69, 0xA4, 0x4F, 0x13, 0xAF, 0x10, 0xC2, 0xF1, 0xFF, 0x73, 0xF6, 0x2F, 0x3A, 0x8F, 0xA3, 0xCA, 0xF7, 0x30, 0x7C, 0x3F, 0xA3, 0xEF, 0xB1, 0x34, 0xB3, 0x5F, 0xFD, 0xEF, 0x7, 0xDB, 0xC4, 0x26, 0x36, 0xE0, 0xE1, 0xE0, 0x74, 0x72, 0xF5, 0x9F, 0x0, 0x3B, 0x49, 0xF7, 0x54, 0xC1, 0xF2, 0x4F, 0xDA, 0x7B, 0xD4, 0xCA, 0x5A, 0x55, 0xF, 0xE6, 0x50, 0x40, 0x51, 0x14, 0x4E, 0x98, 0xEA, 0x33, 0x9F, 0x85, 0x71, 0x69, 0xE0, 0x38, 0xA3, 0x42, 0x24, 0xF3, 0x11, 0xBD, 0x21, 0x50, 0x5, 0x5F, 0xD9, 0x7, 0x1, 0xAD, 0x6D, 0x17, 0x7B, 0xE5, 0x1A, 0x36, 0xA2, 0x70, 0xAE, 0x7D, 0x69, 0x1C, 0xAD, 0xB1, 0xD, 0x53, 0x51, 0x48, 0xD7, 0x8C, 0x9A, 0x8B, 0x69, 0xAB, 0x92, 0x56, 0xD7, 0x13, 0x33, 0x6F, 0x15, 0x46, 0x57, 0x28, 0x88, 0x4A, 0x4F, 0x3B, 0x89, 0xA2, 0x47, 0x7C, 0x44, 0xD1, 0x5B, 0x91, 0x2C, 0x6, 0x5D, 0x16, 0x6F, 0xBB, 0x8C, 0x42, 0xC5, 0x48, 0xEB, 0x71, 0xD6, 0xC1, 0xE1, 0xBD, 0xC0, 0x1D, 0x1C, 0x41, 0xF4, 0xAD, 0x19, 0x25, 0x51, 0xAE, 0xD5, 0x7C, 0xE8, 0x2D, 0x15, 0xD7, 0x54, 0x7E, 0x6F, 0xA, 0xFB, 0x6C, 0xE6, 0x3C, 0xBB, 0x24, 0x4F, 0xEB, 0x83, 0x93, 0x1, 0x95, 0x26, 0xF8, 0x58, 0x31, 0xD4, 0x9, 0xC6, 0xAD, 0x74, 0xF5, 0xAD, 0x9C, 0x2B, 0xF, 0xE5, 0x14, 0xEE, 0x71, 0x4B, 0x9E, 0xCC, 0x62, 0xFA, 0x4D, 0xF8, 0x53, 0x21, 0xE9, 0x26, 0xC9, 0xA3, 0x5D, 0xA8, 0xB5, 0xAA, 0xF0, 0x20, 0x20, 0xA, 0x77, 0x3A, 0x13, 0xEE, 0x8E, 0xA4, 0xC2, 0x7D, 0x52, 0x69, 0xF2, 0x9E, 0xCD, 0x7C, 0xAE, 0xCF, 0x75, 0x91, 0x9E, 0xBD, 0xDE, 0xFD, 0x46, 0x81, 0xD1, 0xF6, 0x74, 0xD2, 0x73, 0x17, 0xBC, 0xF, 0xAC, 0xAD, 0x65, 0xFA, 0xFB, 0x1F, 0xCA, 0xB5, 0x3A, 0xFD, 0xFD, 0x4F, 0xDB, 0xB5, 0x3A, 0x9, 0x42, 0x50, 0x34, 0x85, 0xD4, 0x1E, 0x6D, 0xA0, 0xAF, 0xBF, 0xBC, 0x41, 0xA0, 0xE0, 0x54, 0x96, 0xC3, 0xA9, 0x99, 0xDF, 0x70, 0x12, 0xEB, 0x2A, 0x1D, 0x91, 0x54, 0xE2, 0x4, 0x20, 0x71, 0x6E, 0xC6, 0x39, 0x60, 0x6C, 0xBE, 0x49, 0x64, 0xB2, 0xE, 0xD2, 0xCF, 0x6D, 0xD1, 0x48, 0x8C, 0xF5, 0xE3, 0xF7, 0xA6, 0x30, 0x6D, 0xE5, 0x33, 0x80, 0xD4, 0xBC, 0x16, 0x93, 0x75, 0x8A, 0x35, 0xF5, 0x27, 0x1, 0x35, 0xF7, 0x53, 0x16, 0x23, 0xB4, 0xB4, 0x56, 0x0, 0x89, 0xF2, 0x93, 0xEC, 0x7, 0x19, 0x4B, 0xF3, 0x46, 0x91, 0x68, 0x9A, 0x4C, 0x3E, 0x1E, 0x72, 0x20, 0xE, 0xA, 0xBE, 0x60, 0xE0, 0xA8, 0x20, 0xB1, 0x89, 0xD8, 0xD5, 0xF2, 0x73, 0xFB, 0x93, 0x3C, 0x75, 0x61, 0x33, 0x3, 0xED, 0xB, 0x9B, 0x89, 0x16, 0x1E, 0xB4, 0x78, 0x17, 0x1E, 0x6D, 0xE9, 0xB4, 0xB1, 0xA0, 0x37, 0xE6, 0x9, 0xEC, 0x32, 0xA, 0x35, 0xE9, 0xAE, 0x16, 0xBA, 0x93, 0x25, 0x4, 0xE9, 0x65, 0x66, 0x12, 0xBB, 0x52, 0xB, 0xD3, 0x55, 0x4F, 0x14, 0xD3, 0xF4, 0xB0, 0x86, 0x4A, 0xEF, 0x74, 0x40, 0xED, 0xA7, 0xB7, 0x27, 0x41, 0x56, 0x48, 0xBB, 0x1A, 0xDC, 0x48, 0xDD, 0x2B, 0x3F, 0x7E, 0x47, 0x84, 0x79, 0x53, 0x93, 0x50, 0xD4, 0xD2, 0x1C, 0xF9, 0x23, 0x89, 0x94, 0x38, 0x7F, 0x75, 0x9F, 0xCC, 0x91, 0x7B, 0x6B, 0x4A, 0x3F, 0xA8, 0x7C, 0x99, 0x8A, 0x26, 0xB0, 0x50, 0x71, 0xA5, 0x93, 0xE0, 0x15, 0x4, 0x69, 0x58, 0xEA, 0xCE, 0xCD, 0x24, 0xDA, 0x69, 0x85, 0x92, 0x24, 0x33, 0x75, 0x1E, 0x10, 0xF2, 0xD7, 0xC9, 0x59, 0xA4, 0x39, 0x83, 0xF4, 0x84, 0x54, 0xDF, 0x14, 0x22, 0xCF, 0xF6, 0xA7, 0x1E, 0x39, 0xD6, 0x9F, 0xA8, 0xD7, 0x57, 0xA6, 0xF6, 0xEB, 0x2B, 0x13, 0x99, 0xD6, 0x63, 0xE2, 0xF3, 0x79, 0x7C, 0x4, 0xC9, 0x0, 0xAA, 0x9, 0x7A, 0xA1, 0x67, 0x1A, 0x6, 0x6A, 0x59, 0x31, 0x74, 0x43, 0x73, 0x65, 0x41, 0x6C, 0xD9, 0xA7, 0xD6, 0x86, 0x7D, 0x1A, 0x85, 0xF1, 0xCB, 0x46, 0xAD, 0x25, 0x32, 0xD2, 0x6D, 0xDC, 0x8A, 0xE2, 0x53, 0xE2, 0x51, 0x30, 0xF2, 0x90, 0x30, 0x95, 0x12, 0x71, 0x1A, 0xDB, 0x29, 0x11, 0xA7, 0x91, 0x14, 0x6C, 0x8B, 0x3D, 0x8D, 0x62, 0xA, 0x9C, 0x10, 0xA4, 0xCC, 0xD4, 0x6C, 0xDD, 0x9C, 0xAB, 0x25, 0xD5, 0xAC, 0xB2, 0xD9, 0xA8, 0x52, 0x2E, 0x93, 0x58, 0xC5, 0x2F, 0x3A, 0xBA, 0xE5, 0x3, 0x43, 0x23, 0x41, 0xCA, 0xC5, 0x50, 0x7D, 0x5A, 0xCA, 0xA5, 0x33, 0xCD, 0x6E, 0x2D, 0xE5, 0xBA, 0x2B, 0x33, 0x2C, 0xAC, 0xF9, 0x3B, 0x9, 0xA0, 0x1, 0x94, 0xB3, 0x72, 0xC6, 0x90, 0x27, 0x48, 0x5, 0xEB, 0x4C, 0xE5, 0xF0, 0xE8, 0xEB, 0xAB, 0x94, 0x97, 0x6A, 0xB1, 0xDC, 0x6A, 0x2C, 0xC3, 0x5D, 0xC6, 0x81, 0x89, 0x9B, 0xC, 0x55, 0x99, 0x80, 0x18, 0x95, 0x49, 0x6A, 0xBB, 0xDA, 0x49, 0xD, 0x62, 0xE5, 0x2B, 0xE8, 0x4D, 0x41, 0xAB, 0xC8, 0x50, 0xD9, 0x70, 0x27, 0x69, 0xDA, 0xC9, 0x70, 0x27, 0x69, 0x16, 0xC1, 0x9E, 0x32, 0x14, 0x14, 0x3C, 0x63, 0x98, 0xA4, 0xD1, 0xE4, 0xFD, 0xCE, 0x54, 0x10, 0xAD, 0x80, 0xF9, 0x46, 0x1A, 0x2A, 0x26, 0x2A, 0x89, 0xB7, 0x63, 0xA1, 0x92, 0x30, 0xD9, 0x69, 0xD, 0xD2, 0x67, 0x8D, 0x25, 0xAF, 0x71, 0x65, 0x0, 0x69, 0x54, 0x63, 0x77, 0x56, 0xC1, 0x8E, 0x69, 0xA0, 0xA5, 0x11, 0x41, 0xB1, 0x6D, 0x74, 0x2C, 0xF8, 0x9, 0x62, 0xA3, 0xDE, 0xD0, 0x21, 0x69, 0x27, 0xD0, 0x2E, 0xC7, 0x9A, 0xFF, 0x9C, 0xE2, 0xCB, 0x72, 0x12, 0xD0, 0xF2, 0x50, 0x6B, 0x51, 0x69, 0xBA, 0x66, 0x34, 0xD1, 0x24, 0x2D, 0xB9, 0x5D, 0x97, 0x16, 0xD8, 0xAE, 0xCB, 0x34, 0xF8, 0xF8, 0xC5, 0x5B, 0x81, 0xD1, 0x81, 0x69, 0xB5, 0xC, 0x4E, 0xFE, 0x94, 0x97, 0x7C, 0xA9, 0x44, 0x1, 0x7F, 0x50, 0xDA, 0xF, 0xFC, 0x4E, 0xD2, 0x3A, 0x7A, 0x6A, 0xC9, 0xBF, 0x3, 0xC2, 0xFC, 0xA3, 0x24, 0x7, 0xC, 0x7F, 0x5C, 0x22, 0x53, 0x67, 0xD2, 0xA7, 0x9E, 0xF6, 0x9B, 0xCC, 0x93, 0xC, 0xEC, 0x45, 0xFC, 0x3, 0xC3, 0x3A, 0x4B, 0xF0, 0x3F, 0x2A, 0xD0, 0xB, 0xAA, 0x82, 0x41, 0xAB, 0x2A, 0x22, 0x2B, 0xF8, 0xD4, 0x4A, 0x8, 0x3E, 0x51, 0x63, 0xA6, 0x39, 0x5C, 0x26, 0x41, 0xA4, 0xB5, 0x99, 0xA6, 0x4E, 0x70, 0x89, 0x6D, 0x3E, 0x85, 0x9C, 0xA8, 0x9C, 0x1C, 0xF9, 0xD7, 0xD6, 0xDF, 0xBC, 0x91, 0xA3, 0x62, 0xBA, 0x1, 0xA3, 0x82, 0x48, 0x1C, 0x3E, 0xB5, 0xD2, 0x86, 0x4F, 0xD9, 0x69, 0x49, 0xED, 0x1E, 0xB2, 0xBF, 0xA1, 0xFD, 0xF6, 0xD5, 0x44, 0x38, 0xF, 0xA7, 0x96, 0xEF, 0x70, 0x52, 0x72, 0xE, 0x62, 0x8F, 0x56, 0x70, 0xEE, 0xB3, 0x6F, 0x2, 0xB0, 0x83, 0x51, 0xD2, 0xFB, 0xCA, 0xE, 0xB3, 0xC0, 0xE0, 0x98, 0x51, 0x37, 0xAA, 0x87, 0xA6, 0x5F, 0xDB, 0x82, 0xD4, 0xCC, 0x9A, 0x7F, 0x6E, 0x8A, 0xC6, 0xF4, 0x7F, 0x72, 0x7E, 0x57, 0xB7, 0xD8, 0xE5, 0xFF, 0xB4, 0x67, 0xF7, 0x9A, 0xA, 0x40, 0x5A, 0xBD, 0xE8, 0x3B, 0x39, 0x19, 0x81, 0xC6, 0xB8, 0x5A, 0x47, 0xE9, 0x17, 0x66, 0x3F, 0x7A, 0x82, 0x48, 0x7B, 0x1A, 0x61, 0xF8, 0xAF, 0x31, 0xC2, 0xC0, 0x35, 0x62, 0xEC, 0x43, 0x18, 0xBB, 0x28, 0xC3, 0x44, 0x23, 0x1D, 0x8C, 0xEE, 0xA0, 0x60, 0x2A, 0x5F, 0xA2, 0xA1, 0xF1, 0x25, 0xAE, 0x28, 0x64, 0x1E, 0x25, 0xCD, 0x0, 0x52, 0x59, 0xE1, 0x67, 0x13, 0x93, 0xF4, 0x4E, 0x5, 0x39, 0x52, 0xA6, 0xFE, 0x24, 0x74, 0x4E, 0x85, 0x2E, 0x51, 0x9A, 0xCD, 0x18, 0x7E, 0x9C, 0xDC, 0xAC, 0xA7, 0x82, 0x42, 0xDF, 0x20, 0x10, 0xE, 0x3A, 0xA9, 0xBC, 0xB6, 0x21, 0x4D, 0x44, 0x42, 0x3A, 0xDF, 0x7C, 0x89, 0x67, 0x52, 0x61, 0x8E, 0x3D, 0x69, 0xA1, 0x32, 0x2, 0x99, 0xA8, 0x44, 0xDC, 0x9C, 0xDC, 0x95, 0x7C, 0x90, 0xAE, 0xA3, 0xDF, 0x18, 0x48, 0x5E, 0xE5, 0x22, 0x10, 0xBD, 0x74, 0x83, 0x35, 0x41, 0x1A, 0xFA, 0x36, 0xC7, 0x7F, 0x58, 0xD8, 0x18, 0xA, 0xAB, 0xE0, 0x3E, 0x78, 0x15, 0xE0, 0x7, 0xE7, 0xF6, 0xA6, 0xEB, 0x3D, 0x41, 0x50, 0xC5, 0xEF, 0xA4, 0xEB, 0x37, 0xFF, 0xDC, 0xEA, 0x91, 0x3B, 0xBD, 0x4C, 0x2D, 0xBF, 0x37, 0x84, 0xEF, 0x52, 0x33, 0x29, 0xF6, 0xE, 0x8, 0x18, 0x12, 0x77, 0xE9, 0x46, 0x17, 0xC9, 0x14, 0xD0, 0x27, 0x86, 0x69, 0xE1, 0x52, 0x74, 0x9D, 0x18, 0xA4, 0x85, 0xF5, 0xD0, 0xDD, 0xD1, 0xB3, 0xAD, 0xBB, 0xE5, 0x9C, 0xD0, 0x19, 0x1, 0x34, 0x50, 0x6E, 0x92, 0xE6, 0x11, 0xD3, 0x2A, 0x48, 0xB, 0x90, 0x7F, 0x6E, 0x8A, 0xB2, 0xAF, 0x44, 0x24, 0xC, 0x9C, 0x8B, 0x57, 0x41, 0x3D, 0xD, 0x52, 0x7E, 0x6, 0x44, 0x6B, 0x25, 0x80, 0x8B, 0x40, 0xD6, 0x40, 0xFB, 0xFB, 0xA5, 0xE4, 0x66, 0xD3, 0xC, 0x41, 0xA6, 0x60, 0x55, 0xA3, 0x32, 0x13, 0x1D, 0xE7, 0x7F, 0x80, 0x48, 0xA8, 0xFF, 0xB4, 0xAA, 0x30, 0xCC, 0xE4, 0xD1, 0x54, 0x46, 0x80, 0x77, 0xEC, 0xA6, 0x55, 0x3D, 0xD4, 0x20, 0x49, 0x6F, 0x5B, 0x81, 0x10, 0x77, 0xDB, 0x6A, 0x7B, 0xF2, 0xB0, 0xB3, 0xFC, 0xDE, 0xFA, 0x88, 0x44, 0xDA, 0x9C, 0x1D, 0x2B, 0xF9, 0xB7, 0xA, 0xA9, 0x4B, 0xAC, 0xB, 0x39, 0xB8, 0x8A, 0x94, 0xF7, 0x6B, 0x5B, 0xB3, 0xF7, 0xEB, 0x45, 0xC5, 0x64, 0xD3, 0xA5, 0x9F, 0x9B, 0x9F, 0x3B, 0xD9, 0x7, 0x5D, 0xDF, 0x9, 0xE1, 0xAB, 0xFB, 0x4B, 0xC3, 0xB6, 0x49, 0x32, 0xED, 0xCB, 0x1, 0x21, 0x66, 0x23, 0x4E, 0xD6, 0x4D, 0x91, 0xB8, 0xBE, 0x96, 0xA0, 0xCE, 0xBA, 0xA9, 0x8B, 0xCD, 0xB, 0x6C, 0x13, 0x35, 0xA, 0x9A, 0x3, 0x4F, 0xB, 0x45, 0x7D, 0x7D, 0xFE, 0xB9, 0x25, 0xDA, 0x53, 0x7B, 0xA1, 0xF4, 0x6B, 0x4B, 0x50, 0x19, 0xDA, 0xA3, 0x9C, 0x0, 0xA0, 0xCA, 0x35, 0x55, 0xD9, 0xBA, 0x59, 0xCB, 0x9A, 0xDA, 0x68, 0xEA, 0x56, 0xFE, 0xD4, 0x89, 0x72, 0x28, 0x36, 0x9D, 0x89, 0x46, 0xC9, 0x97, 0xB1, 0xE4, 0x47, 0x16, 0x8, 0xF8, 0x4C, 0xDB, 0xB, 0x6A, 0x97, 0x6A, 0xFB, 0x4E, 0x34, 0x77, 0xA9, 0xB6, 0x27, 0x87, 0x84, 0xED, 0xBB, 0xF6, 0x98, 0xB0, 0xFD, 0x40, 0x95, 0xDB, 0xF6, 0xDD, 0x0, 0x94, 0x99, 0x7A, 0x75, 0x37, 0xC9, 0x36, 0xDF, 0xDD, 0x9D, 0x6C, 0x4F, 0xBD, 0xB9, 0x98, 0x64, 0x9B, 0x6F, 0x2E, 0x26, 0x59, 0x6A, 0xA, 0x4B, 0xB2, 0xCD, 0x39, 0xCC, 0xF6, 0x13, 0x65, 0x8A, 0x6C, 0xDF, 0x4D, 0xCD, 0xDD, 0xA8, 0xED, 0x67, 0xAA, 0x7F, 0xD8, 0xBE, 0x9B, 0x9B, 0x7D, 0xC4, 0xF6, 0x64, 0x8F, 0xB4, 0x7D, 0xD7, 0xEE, 0x94, 0xB6, 0x37, 0xD4, 0xBA, 0xD3, 0xF6, 0x9D, 0x69, 0xBA, 0x5, 0x6C, 0x6F, 0x1F, 0x62, 0x11, 0x5C, 0xF8, 0x6B, 0xC2, 0xBB, 0x93, 0x0, 0x6C, 0xAC, 0x6C, 0xBF, 0x51, 0x47, 0x35, 0xB6, 0xEF, 0xB6, 0xE6, 0x69, 0x8D, 0xED, 0x37, 0x6A, 0xBE, 0x4C, 0xB2, 0xCD, 0xB9, 0xDF, 0xF6, 0x1B, 0x65, 0x30, 0x93, 0x6C, 0xEB, 0xC1, 0x87, 0xC9, 0xF6, 0x9E, 0xDA, 0xA0, 0xD8, 0xBE, 0xF3, 0xDC, 0xAE, 0xE4, 0x4B, 0x96, 0x7A, 0x78, 0x2E, 0xC9, 0x36, 0xDF, 0x9D, 0x9B, 0x2C, 0xF5, 0x58, 0xEA, 0x64, 0xF9, 0xB7, 0x52, 0xBF, 0x24, 0x49, 0x5B, 0xD0, 0x7E, 0x48, 0x7B, 0xB2, 0xA3, 0x18, 0x47, 0xEA, 0xEC, 0xF9, 0x0, 0x9A, 0xE2, 0x96, 0xF5, 0x11, 0xD8, 0xD1, 0xA2, 0x2E, 0x2, 0x3B, 0x3E, 0xD, 0x97, 0xDA, 0x75, 0xB2, 0x5D, 0x41, 0xA1, 0xC8, 0x1B, 0x3B, 0xD9, 0x9D, 0x74, 0xDF, 0xD9, 0x6E, 0xB2, 0x3B, 0xE0, 0xC1, 0xB3, 0x4A, 0x18, 0x45, 0x3E, 0xD8, 0x74, 0x40, 0x90, 0xE, 0xEA, 0xF4, 0xD8, 0xF2, 0x39, 0xE4, 0xBF, 0x24, 0x37, 0x72, 0xB9, 0x6F, 0xD5, 0x86, 0xEC, 0xF5, 0x2D, 0x79, 0xB8, 0x62, 0x81, 0x83, 0x15, 0x7B, 0xB7, 0x5A, 0xB2, 0x97, 0xDA, 0xA, 0x8C, 0x5E, 0x69, 0xA3, 0x6F, 0x70, 0x20, 0xB7, 0x36, 0x26, 0xBB, 0x8, 0x2F, 0xB5, 0x7A, 0x8A, 0x17, 0x13, 0xCF, 0x5A, 0x18, 0xDD, 0x49, 0x81, 0x54, 0x92, 0x15, 0xDA, 0x2D, 0xED, 0xF1, 0xB8, 0xD0, 0x36, 0x64, 0x41, 0x6C, 0x8, 0x19, 0x21, 0x64, 0x81, 0xE0, 0x20, 0xBB, 0x92, 0x9D, 0x67, 0x6D, 0xF7, 0x9D, 0x75, 0xDC, 0xAC, 0x22, 0xF, 0xC8, 0xDE, 0x50, 0x53, 0x85, 0x24, 0x9F, 0x6F, 0x9B, 0x6C, 0xB7, 0xCA, 0xCA, 0x3, 0x6E, 0x5F, 0x3D, 0x70, 0xA5, 0xE, 0x7, 0xD2, 0xAF, 0xCD, 0x3F, 0x7D, 0xBB, 0x9, 0x43, 0x9E, 0x8B, 0x16, 0x8, 0x48, 0xC9, 0x3C, 0x59, 0x7A, 0x8F, 0x6F, 0x91, 0xFD, 0xBD, 0x35, 0x76, 0x96, 0xC2, 0x6A, 0xEB, 0x98, 0x8E, 0x97, 0xF0, 0xAE, 0x10, 0x90, 0x6E, 0x67, 0xE8, 0xCE, 0x63, 0x90, 0xCE, 0x43, 0x7E, 0x44, 0xFB, 0xB, 0xEC, 0xF8, 0x94, 0xF4, 0xAA, 0xF0, 0x40, 0x9A, 0xA, 0x48, 0xFB, 0x6B, 0x9B, 0xFE, 0x22, 0x6B, 0xE9, 0x3D, 0x7A, 0xF9, 0x1D, 0x11, 0xE6, 0xF, 0x44, 0xDF, 0x30, 0xB4, 0x5D, 0xCF, 0xEC, 0xC1, 0x32, 0xC9, 0xA, 0x3F, 0x30, 0xA6, 0x8C, 0xEC, 0x7, 0xED, 0xA6, 0xDF, 0xE8, 0xA6, 0xDF, 0x90, 0xA6, 0xDF, 0xA8, 0x78, 0x4B, 0xDB, 0x6D, 0xCD, 0x68, 0x4B, 0xEB, 0x26, 0xF6, 0xF2, 0x4A, 0x6, 0x41, 0x67, 0x8B, 0x75, 0xEA, 0x45, 0x67, 0x6B, 0x3D, 0x20, 0x64, 0x36, 0xC9, 0xCF, 0xE5, 0x90, 0x1B, 0x9C, 0x2, 0x21, 0x1B, 0x1C, 0x2F, 0x8D, 0x70, 0xCC, 0x74, 0xE4, 0x65, 0x97, 0x40, 0x64, 0x1C, 0x7A, 0x69, 0x8C, 0x52, 0xDC, 0x25, 0xB1, 0x8C, 0x77, 0x7, 0x1, 0x52, 0x37, 0xAB, 0xCA, 0x35, 0xC5, 0x3, 0x47, 0x6F, 0x29, 0x4E, 0xD6, 0xD3, 0x5D, 0xC5, 0x23, 0x5D, 0x85, 0x6C, 0xA2, 0x76, 0xDB, 0x84, 0xD1, 0x78, 0xEA, 0xB5, 0xF4, 0x82, 0xFC, 0xF9, 0x54, 0x3A, 0x39, 0x2E, 0xB2, 0xA, 0x7A, 0x80, 0x15, 0x1D, 0xC8, 0xE0, 0xA, 0xFC, 0x8A, 0x2F, 0xC0, 0xCB, 0xBD, 0x20, 0x75, 0x5F, 0x4D, 0x1A, 0x70, 0x50, 0x2E, 0xDC, 0x45, 0x9D, 0x6C, 0x20, 0x6B, 0xA7, 0xB, 0x4D, 0xDF, 0x85, 0xD, 0xC4, 0x5B, 0xC8, 0x49, 0xB2, 0xF5, 0x1C, 0xF2, 0x64, 0x83, 0xAD, 0x5D, 0xE3, 0x49, 0xF8, 0x85, 0x5B, 0x3C, 0x93, 0xD, 0x9B, 0x30, 0x61, 0x96, 0x96, 0xCE, 0xFF, 0xF5, 0x85, 0x83, 0x6D, 0xE5, 0xCB, 0x75, 0x26, 0x26, 0xC0, 0x2C, 0xE1, 0xDD, 0x41, 0x80, 0x4A, 0x47, 0x85, 0x20, 0xD9, 0x2E, 0x34, 0x23, 0x90, 0x6C, 0xA4, 0x77, 0x8, 0xB1, 0x3D, 0x45, 0xC5, 0x4D, 0xAD, 0x92, 0x8C, 0x2D, 0x29, 0x10, 0xF0, 0x4, 0xC5, 0x64, 0xE3, 0xDE, 0x93, 0x2D, 0x1C, 0xF7, 0xAE, 0x6F, 0xB7, 0x71, 0xDC, 0xE9, 0x1D, 0x5E, 0xDC, 0x91, 0x3D, 0x5E, 0xDC, 0x99, 0xD2, 0xEF, 0x40, 0x38, 0x8C, 0x8D, 0x3B, 0xF5, 0x90, 0x7C, 0x96, 0x6E, 0x3E, 0x24, 0x9F, 0x58, 0xB, 0xD9, 0x8D, 0xE2, 0xDE, 0x2D, 0xED, 0x19, 0x25, 0xEE, 0x9A, 0x9C, 0xA, 0xE2, 0xDE, 0xE9, 0xF6, 0x3C, 0x10, 0x77, 0x4F, 0x7A, 0x51, 0xE2, 0xDE, 0xF9, 0xB6, 0x1B, 0x85, 0xDC, 0xC9, 0xB7, 0xB7, 0xF1, 0xBB, 0x20, 0xBD, 0x4D, 0x7B, 0x27, 0xDA, 0xDE, 0xA6, 0x5D, 0x90, 0x6B, 0xA1, 0xBD, 0x13, 0xED, 0xB5, 0xD0, 0x2E, 0xC8, 0xF5, 0xF3, 0xDE, 0x89, 0xF6, 0xA, 0x7A, 0x27, 0x1D, 0xFB, 0x49, 0xB6, 0x5D, 0xC9, 0x3B, 0xBD, 0x6E, 0xDC, 0x11, 0x6F, 0xE2, 0x2E, 0xC8, 0x55, 0xFB, 0xDE, 0x89, 0xB6, 0x77, 0x6D, 0x17, 0xD4, 0x69, 0x79, 0x92, 0x6D, 0x5E, 0x4E, 0xB1, 0xBB, 0x20, 0xCD, 0xC8, 0xDE, 0x89, 0xB6, 0x1D, 0xD9, 0x7B, 0xD2, 0x2D, 0xB5, 0x77, 0x7D, 0xDB, 0x2D, 0xB5, 0xF7, 0x54, 0xD8, 0x7D, 0x92, 0x6D, 0x86, 0xDE, 0xDB, 0xBD, 0x67, 0xDA, 0xB7, 0x7, 0xDA, 0x97, 0x74, 0xA9, 0x27, 0xD9, 0xF6, 0xC4, 0xB4, 0xF7, 0xE4, 0xF8, 0xDD, 0xBB, 0xBE, 0x3D, 0x7C, 0xF7, 0x9E, 0x1C, 0x7F, 0x7B, 0xD7, 0x3, 0xC3, 0x8F, 0xDE, 0x4E, 0x42, 0x9E, 0x98, 0xBD, 0x67, 0xFA, 0x55, 0xF, 0xF4, 0xAB, 0x9E, 0xB4, 0x76, 0x7B, 0xD7, 0xB7, 0x8D, 0xDD, 0xDE, 0x93, 0xE, 0xB1, 0xBD, 0xEB, 0xDB, 0xFE, 0xB0, 0xBD, 0x67, 0xFA, 0x64, 0xF, 0xF4, 0xC9, 0x81, 0xDC, 0xF9, 0xEF, 0xDD, 0xD0, 0xDE, 0xFB, 0xEF, 0xB4, 0x57, 0x7E, 0x47, 0xBC, 0xF2, 0xFB, 0xC0, 0xF4, 0x8D, 0x1, 0xE8, 0x1B, 0xE4, 0x8D, 0x83, 0x24, 0xB, 0xF4, 0x8D, 0x81, 0xDC, 0xE7, 0xEE, 0xDD, 0xD0, 0xDE, 0xEA, 0xEE, 0x3, 0x63, 0x37, 0x6, 0xC0, 0x6E, 0xC, 0xA4, 0x67, 0x7D, 0xEF, 0x86, 0xB6, 0x67, 0x7D, 0x1F, 0x98, 0xBE, 0x31, 0x0, 0x7D, 0x63, 0x64, 0xEA, 0x79, 0x4, 0xEA, 0x99, 0x3E, 0x39, 0xD9, 0x91, 0x93, 0x93, 0x7D, 0x64, 0xFA, 0xE4, 0x8, 0xF4, 0x49, 0xFA, 0xD4, 0x65, 0x47, 0x4E, 0x5D, 0x76, 0x32, 0x2, 0x33, 0xC9, 0x2, 0xF3, 0xA0, 0x24, 0x17, 0x7A, 0x7B, 0x27, 0xDB, 0x2B, 0xBD, 0x5D, 0x3A, 0xA3, 0xA5, 0x9, 0xCC, 0xA1, 0xFD, 0x1B, 0x87, 0xCE, 0xED, 0xED, 0x4E, 0x1E, 0x45, 0xA7, 0x92, 0x0, 0x96, 0xE4, 0x46, 0xAE, 0x16, 0xF7, 0xEE, 0xD6, 0x5E, 0x2C, 0xEE, 0xE4, 0x15, 0x24, 0xBB, 0x77, 0xED, 0x6B, 0x48, 0x76, 0x27, 0x43, 0x9F, 0x93, 0x2C, 0x50, 0xF3, 0x64, 0x10, 0x6B, 0x92, 0x5, 0x66, 0x19, 0x32, 0x14, 0x35, 0xC9, 0xB6, 0x97, 0xB7, 0x3B, 0x79, 0x96, 0x9A, 0x64, 0x1, 0x4B, 0x32, 0x31, 0xB3, 0xCC, 0x4, 0xCC, 0x32, 0x13, 0x63, 0x49, 0x26, 0xC0, 0x92, 0x4C, 0x8C, 0x35, 0x98, 0x0, 0x6B, 0x30, 0x93, 0x3B, 0xFF, 0xBD, 0x9B, 0xDB, 0x3B, 0xFF, 0x5D, 0x31, 0xED, 0xAB, 0x80, 0xF6, 0x25, 0x8F, 0xF4, 0x93, 0x2C, 0x30, 0x53, 0x90, 0x17, 0x68, 0x92, 0x2C, 0xD0, 0x46, 0xEA, 0x5F, 0x46, 0xF6, 0x5F, 0x40, 0x96, 0x69, 0x5F, 0x5, 0xB4, 0xAF, 0x62, 0x56, 0x11, 0xA, 0x58, 0x45, 0xD0, 0x6E, 0xB0, 0x1D, 0x71, 0x81, 0xED, 0x77, 0xC6, 0x62, 0xDF, 0x1, 0x9B, 0xB3, 0x30, 0xD6, 0x6F, 0x1, 0xAC, 0xDF, 0xC2, 0x8C, 0xC1, 0x5, 0x18, 0x83, 0xF4, 0x2E, 0xF, 0xDA, 0xE4, 0xED, 0xB, 0xD3, 0x37, 0x16, 0xA0, 0x6F, 0x2C, 0xA4, 0x57, 0x61, 0xEF, 0x96, 0xB6, 0x2F, 0x61, 0x5F, 0x48, 0x8F, 0xE9, 0xDE, 0x2D, 0x6D, 0x97, 0xE9, 0xBE, 0x30, 0xE3, 0x77, 0x1, 0xC6, 0xEF, 0xC2, 0xCC, 0xAA, 0xB, 0x30, 0xAB, 0xAE, 0xCC, 0xEE, 0x63, 0x5, 0x76, 0x1F, 0x2B, 0xB3, 0xFB, 0x58, 0x81, 0xDD, 0xC7, 0xCA, 0xCC, 0xE6, 0x2B, 0x30, 0x9B, 0xAF, 0x8C, 0xBD, 0x5A, 0x1, 0x7B, 0xB5, 0x32, 0xE3, 0x77, 0x5, 0xC6, 0xEF, 0xCA, 0x8C, 0xDF, 0x15, 0x18, 0xBF, 0x2B, 0x33, 0x7E, 0x57, 0x60, 0xFC, 0xAE, 0xB4, 0xEB, 0xA0, 0x5B, 0x1, 0xEF, 0xC1, 0xCA, 0xF4, 0xAB, 0x15, 0xE8, 0x57, 0x9A, 0xE9, 0x1B, 0x1A, 0xE8, 0x1B, 0x9A, 0x99, 0x17, 0xDA, 0xD9, 0x2F, 0xEC, 0xAE, 0x19, 0x9B, 0xA3, 0x1, 0x9B, 0xA3, 0x99, 0xBE, 0xA1, 0x81, 0xBE, 0x41, 0xFB, 0x86, 0x20, 0xD7, 0xD0, 0xAE, 0x19, 0x9B, 0xA3, 0x1, 0x9B, 0x43, 0x6, 0x8A, 0x25, 0x59, 0xC0, 0x3E, 0x6B, 0xA6, 0x3F, 0x6B, 0xA0, 0x3F, 0x6B, 0xA6, 0x3F, 0x6B, 0xA0, 0x3F, 0x6B, 0x66, 0xAD, 0xA2, 0x81, 0xB5, 0x8A, 0x66, 0xFA, 0xB3, 0x6, 0xFA, 0xB3, 0x66, 0x6C, 0xAC, 0x6, 0x6C, 0xAC, 0x66, 0xC6, 0x82, 0x46, 0xC6, 0x2, 0x63, 0x63, 0x35, 0x60, 0x63, 0xCD, 0x40, 0xDE, 0x8C, 0xB4, 0x7B, 0x67, 0x6, 0xE0, 0x72, 0xA4, 0xDD, 0xC9, 0xF8, 0xF9, 0x24, 0xE, 0xC, 0x8, 0xC3, 0xFD, 0x69, 0xE0, 0xEF, 0xD2, 0x1E, 0xFB, 0xBD, 0x63, 0x1D, 0xF5, 0x5F, 0xB2, 0x1B, 0xB3, 0x78, 0xDF, 0x80, 0xC5, 0x3B, 0x7D, 0x14, 0xB5, 0x23, 0x47, 0x51, 0x3B, 0x7D, 0xD6, 0xB7, 0x23, 0xE7, 0x7C, 0xFB, 0xC6, 0xC, 0xE2, 0xD, 0x18, 0xC4, 0x1B, 0x53, 0xCF, 0x1B, 0x50, 0xCF, 0x1B, 0x33, 0x10, 0x37, 0x60, 0x20, 0x6E, 0xCC, 0xC4, 0xB2, 0x1, 0x13, 0xCB, 0xC6, 0xC, 0x88, 0xD, 0x18, 0x10, 0xFF, 0x32, 0x93, 0xC3, 0xBF, 0xC0, 0xE4, 0xE0, 0x98, 0xEF, 0x75, 0xC0, 0xF7, 0x3A, 0xC6, 0xF0, 0xB8, 0xF6, 0x4D, 0x26, 0xBB, 0x3B, 0xC6, 0x7A, 0x38, 0xC0, 0x7A, 0x78, 0xE6, 0x83, 0x3D, 0xF0, 0xC1, 0x9E, 0x59, 0x29, 0x79, 0x60, 0xA5, 0xE4, 0x99, 0x95, 0x92, 0x7, 0x56, 0x4A, 0x7E, 0xA0, 0xCF, 0x66, 0x93, 0x34, 0x7B, 0x40, 0xFB, 0x5D, 0x61, 0x9E, 0xD9, 0xFA, 0x7B, 0x60, 0xEB, 0xEF, 0x19, 0xAB, 0xE5, 0x1, 0xAB, 0xE5, 0x19, 0xEB, 0xE1, 0x1, 0xEB, 0xE1, 0x19, 0xB, 0xE0, 0x1, 0xB, 0xE0, 0x99, 0xA9, 0xD8, 0x3, 0x53, 0x31, 0x79, 0x3B, 0x25, 0xC9, 0x2, 0xBD, 0xDA, 0x33, 0x8E, 0x40, 0xF, 0x38, 0x2, 0x3D, 0x33, 0x8A, 0x3D, 0x30, 0x8A, 0xE9, 0x63, 0xE2, 0x1D, 0x39, 0x27, 0xDE, 0x3, 0x53, 0xCF, 0x1, 0xA8, 0xE7, 0xC0, 0xCC, 0x2C, 0x1, 0x98, 0x59, 0x2, 0x63, 0xA5, 0x3, 0x60, 0xA5, 0x3, 0x99, 0x73, 0xC2, 0xEE, 0x1, 0x48, 0x3A, 0x61, 0xF7, 0xC0, 0xF4, 0x8E, 0x0, 0xF4, 0xE, 0x6E, 0x9, 0x80, 0xCC, 0xFF, 0x81, 0x19, 0xC4, 0x1, 0xB0, 0xF1, 0x64, 0xDA, 0xB4, 0x24, 0xB, 0x98, 0xBC, 0xC8, 0x98, 0xBC, 0x8, 0x98, 0x3C, 0xF2, 0x9A, 0x48, 0x92, 0x5, 0xE6, 0xF0, 0x9D, 0x31, 0x79, 0x3B, 0x60, 0xF2, 0xE8, 0xA8, 0xE7, 0x1D, 0x89, 0x7A, 0xDE, 0x77, 0xA6, 0x67, 0xED, 0x40, 0xCF, 0x7A, 0x30, 0xA3, 0xFF, 0x1, 0x8C, 0xFE, 0x27, 0x53, 0xE6, 0x27, 0x50, 0xE6, 0x17, 0xD3, 0x46, 0x2F, 0xA0, 0x8D, 0x5E, 0xCC, 0x28, 0x7C, 0x1, 0xA3, 0xF0, 0xC5, 0xF4, 0xAB, 0x57, 0xB3, 0x5F, 0x51, 0x1E, 0x96, 0xA6, 0x77, 0x85, 0x72, 0xD9, 0x35, 0xDD, 0x75, 0xD4, 0x90, 0x6B, 0xE, 0x37, 0x27, 0x94, 0x99, 0xA8, 0xD7, 0xB1, 0xDE, 0x48, 0x53, 0xC1, 0x5D, 0x3E, 0xE8, 0x4B, 0x35, 0x5, 0x2, 0xD6, 0xA2, 0x4E, 0x98, 0xD1, 0x89, 0x4D, 0x8D, 0xFC, 0xB5, 0xC5, 0xF, 0x5, 0x8C, 0xA7, 0x73, 0xC2, 0xD0, 0x57, 0xCB, 0x13, 0x0, 0xC4, 0xA5, 0x3A, 0x41, 0xDF, 0x1F, 0x73, 0x2, 0xB9, 0x3C, 0xE6, 0x84, 0xD9, 0x15, 0xAB, 0x60, 0x57, 0x98, 0x8E, 0x6D, 0x56, 0x3, 0x35, 0xD0, 0x4E, 0x4, 0x50, 0x60, 0x7F, 0x91, 0xB1, 0xBD, 0x5, 0x42, 0x62, 0x7B, 0x9D, 0x8, 0xC2, 0x4C, 0xCC, 0x65, 0xBA, 0x13, 0x84, 0xBE, 0x26, 0x90, 0x1E, 0xE1, 0xF2, 0x7B, 0x53, 0xF8, 0x55, 0xE9, 0x19, 0x2F, 0xB4, 0x4B, 0xD0, 0x41, 0x59, 0xD0, 0x5B, 0x95, 0x93, 0x93, 0x64, 0x12, 0xC8, 0xFC, 0x73, 0xEB, 0xEF, 0xCA, 0x20, 0xC8, 0x18, 0xA2, 0x4, 0x0, 0x41, 0x44, 0x4E, 0x46, 0x3A, 0x60, 0xCF, 0x75, 0x32, 0x42, 0xF1, 0x7A, 0x4E, 0x69, 0xF6, 0x3A, 0xF7, 0x81, 0x21, 0x4D, 0xA8, 0x36, 0xCA, 0x74, 0xE7, 0x9F, 0x9B, 0xA2, 0xD4, 0xA4, 0xEE, 0x0, 0x87, 0xBE, 0x53, 0xF, 0x6E, 0x9F, 0xEB, 0xD4, 0xA3, 0xC3, 0x36, 0xBB, 0x8E, 0xC, 0x9F, 0x73, 0x40, 0xCC, 0x9C, 0xB3, 0x3, 0x9D, 0x6A, 0xF8, 0x0, 0x9A, 0xE2, 0x8B, 0xFF, 0x9, 0xB3, 0x54, 0xE6, 0x46, 0xD6, 0xFD, 0x1, 0x77, 0x19, 0x6F, 0xB6, 0x62, 0x61, 0x2B, 0x5F, 0xD7, 0xA6, 0x3C, 0xAA, 0xCE, 0x8C, 0xC2, 0x91, 0x33, 0x6A, 0x81, 0x80, 0x59, 0xD5, 0x59, 0x33, 0xD1, 0xBD, 0xCA, 0x9A, 0x9, 0xE9, 0x52, 0xD6, 0x7B, 0x19, 0xE8, 0xFB, 0x33, 0x7, 0x86, 0xF4, 0x11, 0x1B, 0xCD, 0x38, 0xB, 0x33, 0xAE, 0xFC, 0x9D, 0xF1, 0xDF, 0x38, 0xD0, 0x11, 0xB4, 0xB3, 0x64, 0x7A, 0xE6, 0xFC, 0x33, 0x20, 0xCA, 0x97, 0x63, 0x3, 0xFF, 0xFC, 0xCB, 0x3C, 0xC9, 0xFD, 0x7F, 0x46, 0x10, 0x17, 0x40, 0x26, 0x92, 0x29, 0xB0, 0x8A, 0xA, 0x20, 0x3, 0x96, 0xDB, 0xC8, 0xF3, 0x7, 0xB7, 0x1, 0xE7, 0xF, 0x2E, 0x92, 0x51, 0x48, 0x2E, 0x2, 0x51, 0x48, 0x2E, 0x32, 0xB3, 0x77, 0x44, 0x26, 0x6F, 0x6A, 0xA2, 0x69, 0x4E, 0x32, 0x5E, 0xD0, 0x9, 0x99, 0x7C, 0x27, 0x90, 0x44, 0x4C, 0x5E, 0xCC, 0x9C, 0xF8, 0x8C, 0x88, 0xF7, 0xE4, 0x1B, 0x21, 0x93, 0xEF, 0x7A, 0xE0, 0x85, 0x90, 0xC9, 0x93, 0x1, 0x41, 0x1E, 0x88, 0x7, 0xA2, 0xDE, 0xF3, 0x9C, 0xDA, 0xEF, 0x79, 0x4E, 0xFE, 0x46, 0x27, 0x19, 0xF1, 0xDD, 0xD, 0xC9, 0x30, 0xE2, 0x67, 0xFA, 0xD2, 0x90, 0xEF, 0x66, 0xE4, 0xDA, 0x90, 0x9F, 0xB9, 0xBF, 0x3E, 0x43, 0x7F, 0xFD, 0x2E, 0xCC, 0x8F, 0x16, 0x4F, 0x66, 0x5D, 0xE3, 0xBB, 0x37, 0xE, 0x29, 0x23, 0xA3, 0xCF, 0xFC, 0x9D, 0x8F, 0x3E, 0xFB, 0xC, 0x30, 0xAF, 0xC9, 0xB9, 0xCD, 0x6B, 0x60, 0x5A, 0xF3, 0x9A, 0xCE, 0xBD, 0xEC, 0x3B, 0x8D, 0xA4, 0x5E, 0xF6, 0x86, 0x6B, 0x2, 0x3, 0x35, 0x81, 0x9, 0x9C, 0x78, 0x40, 0xC4, 0xED, 0x8D, 0x11, 0xB7, 0x37, 0x48, 0x9C, 0x2B, 0x3C, 0x74, 0xED, 0xCC, 0x5B, 0xBF, 0x92, 0xF, 0xEA, 0x24, 0x5, 0x7E, 0x5, 0x1E, 0xD4, 0x99, 0xBC, 0xE3, 0x4A, 0xE0, 0xA0, 0x12, 0x78, 0xEE, 0xFB, 0x3D, 0xF4, 0xFD, 0x9E, 0xAB, 0x7D, 0xF, 0xD5, 0xBE, 0xDF, 0xC5, 0xDA, 0xB, 0x47, 0xAF, 0x9, 0x7D, 0x77, 0xC2, 0x88, 0xAA, 0xC0, 0xD, 0xC5, 0x0, 0xD, 0xC5, 0xC0, 0x75, 0xE2, 0x0, 0x75, 0xE2, 0x9D, 0xB3, 0xDA, 0x3B, 0x64, 0xB5, 0x77, 0xAE, 0x15, 0x76, 0xA4, 0x15, 0xA8, 0x75, 0x4B, 0x73, 0xB9, 0x42, 0x4D, 0xC8, 0xCD, 0x83, 0xC4, 0x28, 0x34, 0x2D, 0x28, 0x34, 0x10, 0x32, 0x15, 0x85, 0x1B, 0x95, 0xA0, 0xA6, 0x88, 0x13, 0x69, 0x29, 0xE8, 0x23, 0xB5, 0xA, 0x8E, 0x7D, 0x17, 0x9B, 0x8B, 0xE0, 0x38, 0x90, 0xF7, 0x2F, 0xF2, 0xCF, 0x2D, 0x51, 0xE9, 0x8C, 0x97, 0x7C, 0xBE, 0xD3, 0x3, 0x7, 0x37, 0x80, 0x91, 0x8C, 0xCF, 0x8B, 0xED, 0xE8, 0xBC, 0xA8, 0xC8, 0xEB, 0xE1, 0xF9, 0xE7, 0xA6, 0x68, 0x10, 0xE4, 0x9, 0xD0, 0x1, 0xB4, 0xC4, 0xEF, 0xFC, 0x4D, 0xFF, 0x78, 0x87, 0x6F, 0xFA, 0xC7, 0x95, 0x99, 0xE9, 0x12, 0x0, 0xF4, 0x73, 0xEE, 0x19, 0x57, 0xF0, 0xF9, 0xD6, 0x29, 0xDA, 0x7D, 0x14, 0x93, 0x14, 0x6A, 0x34, 0xF4, 0x32, 0xE7, 0x9B, 0x80, 0x94, 0x87, 0x5C, 0x18, 0x47, 0x76, 0x4D, 0xFC, 0x11, 0xF4, 0xB3, 0x5A, 0x84, 0x56, 0xB5, 0x2C, 0x86, 0x27, 0xE7, 0x42, 0x22, 0xC3, 0x48, 0x7A, 0x59, 0x22, 0x90, 0x55, 0x7A, 0x17, 0x54, 0x75, 0xEC, 0x9D, 0x68, 0xD6, 0xC1, 0x4E, 0x3D, 0x9F, 0x94, 0x24, 0x9B, 0x6, 0x68, 0xEF, 0xDD, 0xB6, 0xFA, 0xFE, 0x17, 0x99, 0x6A, 0xAF, 0x3B, 0x51, 0x24, 0xDD, 0x1E, 0xED, 0xBC, 0xAE, 0xF8, 0xAE, 0x3F, 0xA2, 0x94, 0x47, 0xB5, 0xE9, 0x4D, 0x7D, 0x8, 0x33, 0xDD, 0x23, 0x79, 0xC9, 0xBF, 0x40, 0xC0, 0x4D, 0xFF, 0x87, 0x1A, 0xC7, 0x55, 0xE, 0x96, 0xF4, 0xD9, 0x17, 0xB0, 0x1B, 0x2C, 0x92, 0xDC, 0xE9, 0x17, 0xB9, 0xE8, 0xFE, 0x5, 0x2C, 0xBA, 0x29, 0x5B, 0xDA, 0xB4, 0xA3, 0x4F, 0x69, 0xCD, 0xD4, 0xC7, 0x85, 0xFC, 0xFC, 0x13, 0x4, 0x2A, 0x20, 0x53, 0x27, 0xC5, 0xAB, 0x99, 0x14, 0xAA, 0xC5, 0x8, 0xCD, 0xAB, 0x31, 0x42, 0x23, 0x7A, 0xEC, 0x12, 0x83, 0x5D, 0xA2, 0x16, 0x9A, 0x79, 0xA0, 0xF6, 0xA0, 0x74, 0x27, 0x7, 0x18, 0x8F, 0x2F, 0xB2, 0x65, 0x5E, 0xED, 0x96, 0x99, 0x45, 0xAF, 0x16, 0x65, 0x2C, 0xFB, 0xE0, 0xFA, 0x49, 0x40, 0xDF, 0x5B, 0x9F, 0x45, 0xAF, 0x25, 0xB9, 0xC2, 0x29, 0x48, 0xDB, 0xD8, 0xCD, 0xA2, 0xB7, 0xAE, 0xFA, 0x72, 0x63, 0x61, 0x5C, 0x78, 0xCF, 0x70, 0x16, 0xC3, 0xAC, 0xEE, 0x96, 0x7D, 0x9E, 0xEF, 0xC0, 0xD1, 0x7, 0xFA, 0x32, 0xBD, 0x9E, 0xC7, 0xE1, 0x4D, 0x81, 0x53, 0x39, 0x64, 0x9, 0x7B, 0x57, 0xF5, 0x42, 0xDA, 0xBB, 0xBA, 0x54, 0x4A, 0x67, 0x27, 0x11, 0x4, 0x9B, 0x71, 0xE5, 0x8B, 0x83, 0xA6, 0x5D, 0x99, 0x5, 0xD5, 0xD7, 0x66, 0x81, 0xF4, 0xB5, 0x51, 0x18, 0x5B, 0x7B, 0x30, 0xBC, 0x30, 0x2E, 0x3C, 0x17, 0x3E, 0x8B, 0xD1, 0x4B, 0x72, 0x5B, 0x52, 0x10, 0xA4, 0xB7, 0xC9, 0x5E, 0xB8, 0x58, 0x99, 0x1F, 0xF, 0x6, 0x3E, 0x3B, 0xCE, 0x62, 0x12, 0xC1, 0xE, 0xB3, 0xA2, 0x5F, 0xB8, 0x4C, 0x70, 0x77, 0xE0, 0x90, 0x32, 0xD9, 0x5B, 0xCE, 0x9B, 0xFD, 0x41, 0x91, 0x2F, 0x9D, 0xF2, 0xA3, 0xD7, 0x53, 0x64, 0x5E, 0xDD, 0x48, 0x84, 0xEE, 0xCD, 0x40, 0x8A, 0x96, 0xDF, 0x5F, 0xAF, 0x5D, 0x8B, 0x3F, 0x28, 0x17, 0xAE, 0xC5, 0xCF, 0x82, 0xA, 0xC2, 0xCF, 0xBF, 0xB6, 0x4, 0x17, 0x11, 0x44, 0xE5, 0xE9, 0xE9, 0x42, 0xC0, 0xDF, 0x12, 0x9F, 0xC5, 0x62, 0x47, 0x11, 0x64, 0xDD, 0x12, 0x15, 0xCE, 0x25, 0x5B, 0xB4, 0x58, 0x23, 0xEB, 0xA3, 0x20, 0x31, 0x2E, 0x8D, 0x82, 0x25, 0xF6, 0x99, 0x6D, 0xB8, 0x52, 0xC6, 0x5E, 0x74, 0x85, 0x80, 0xA9, 0x53, 0x2A, 0x2F, 0xA, 0xD9, 0x2, 0x46, 0xD5, 0x9D, 0xC, 0x4C, 0xA1, 0x17, 0xA6, 0xA5, 0xD2, 0xB, 0x73, 0x49, 0xE9, 0x3A, 0xD2, 0x8B, 0xFC, 0x82, 0x20, 0x3, 0x62, 0xBD, 0xCD, 0xD6, 0x13, 0xAB, 0xCA, 0x84, 0x74, 0x19, 0x6A, 0x2C, 0x89, 0x66, 0xB1, 0xAE, 0xF6, 0x76, 0x13, 0x9A, 0xCD, 0x89, 0xF2, 0x61, 0x60, 0x9B, 0xB6, 0x99, 0x7B, 0xE9, 0x70, 0xC6, 0x5E, 0x3A, 0x9C, 0x85, 0xFE, 0x71, 0x62, 0x54, 0xF6, 0xC7, 0x6E, 0x64, 0x7A, 0xD3, 0x6F, 0x2, 0x92, 0xD9, 0x74, 0x16, 0x5A, 0x8C, 0xA2, 0x92, 0x96, 0xA1, 0x10, 0xF0, 0xBC, 0xC, 0x89, 0xAF, 0x45, 0x49, 0xF5, 0x50, 0x31, 0x1C, 0x7, 0xE9, 0x92, 0xE9, 0xD0, 0xF4, 0xE3, 0x6C, 0x19, 0x80, 0xAA, 0x4E, 0x38, 0xFB, 0x64, 0x35, 0xD8, 0x27, 0xA6, 0x23, 0x8, 0x2D, 0xB8, 0x37, 0x7C, 0x4E, 0x1C, 0x7C, 0xC5, 0x27, 0xD3, 0xAD, 0xE9, 0x65, 0xF0, 0xB1, 0x6E, 0x87, 0x3E, 0xBC, 0x4B, 0xB6, 0x48, 0xF7, 0x91, 0x3A, 0x6A, 0x3D, 0x1, 0x48, 0x9C, 0xEF, 0xFA, 0x19, 0x46, 0xFB, 0xBD, 0xD6, 0xC2, 0xDD, 0xA4, 0xF0, 0x81, 0xE9, 0xFE, 0x1F, 0x1C, 0x6A, 0x6, 0x2D, 0xDD, 0x4D, 0xD2, 0x4E, 0xC3, 0xF, 0xA, 0x69, 0x8A, 0x8E, 0x7F, 0xD8, 0xB9, 0xC0, 0xF8, 0x92, 0xCC, 0x8, 0x2D, 0x16, 0xA5, 0x1E, 0x22, 0x30, 0x63, 0xA9, 0x10, 0xBA, 0xCC, 0x0, 0x15, 0xAA, 0x34, 0x4B, 0xB0, 0x6F, 0x29, 0x7F, 0x28, 0xE8, 0x7B, 0xCA, 0x49, 0xE2, 0x51, 0x7F, 0x7F, 0xBA, 0x50, 0xAE, 0xBC, 0x3F, 0x3D, 0xB, 0x33, 0xA, 0xF6, 0xF9, 0xD5, 0x8C, 0xA2, 0x6F, 0xAF, 0x26, 0xB2, 0xB7, 0xEC, 0x1B, 0x20, 0x7, 0x8C, 0x76, 0x3B, 0x33, 0x55, 0x93, 0x6F, 0x7F, 0x11, 0x90, 0x63, 0xCC, 0x44, 0xB7, 0x91, 0x34, 0xBB, 0x5, 0x68, 0x8A, 0x1B, 0x61, 0x2A, 0x9B, 0xB1, 0x4, 0xE3, 0x5B, 0x31, 0x93, 0x54, 0xA9, 0xC0, 0x24, 0x59, 0xCD, 0x78, 0x77, 0x10, 0x20, 0x75, 0x64, 0xFD, 0x20, 0x63, 0xC8, 0x3C, 0x63, 0xBD, 0x20, 0xCF, 0x78, 0xA5, 0x20, 0x9B, 0x56, 0x8C, 0xA1, 0x4F, 0x8, 0x52, 0xA0, 0x6D, 0xB3, 0xB1, 0xB2, 0x2B, 0x4A, 0x30, 0xBE, 0x21, 0x72, 0x82, 0x3C, 0x11, 0xC8, 0x0, 0x52, 0x18, 0x57, 0x5D, 0xBB, 0xB9, 0x2B, 0x2B, 0x37, 0x27, 0x15, 0xB3, 0x51, 0x48, 0x8, 0x54, 0x18, 0xA5, 0x5, 0x9B, 0x78, 0xAC, 0xC0, 0x68, 0xDE, 0xB1, 0x59, 0xB0, 0xAF, 0x2A, 0x1C, 0x10, 0x54, 0xA0, 0x5D, 0xFA, 0x30, 0xC8, 0x55, 0xF6, 0x4E, 0x4, 0x55, 0x1B, 0xF7, 0x7F, 0x32, 0x51, 0x13, 0xE0, 0x85, 0x16, 0x96, 0xED, 0xB, 0x9, 0xED, 0x2C, 0xD8, 0x15, 0xBC, 0xD0, 0xAA, 0x96, 0x61, 0xAB, 0x30, 0x2E, 0xE4, 0xD8, 0x9A, 0x85, 0x9F, 0x7B, 0x61, 0x26, 0x2A, 0x66, 0xFE, 0xC4, 0xF8, 0xC0, 0xF9, 0xAF, 0x55, 0xDC, 0xE1, 0xE4, 0xAD, 0xB9, 0x2C, 0x4E, 0x3F, 0x30, 0xEE, 0xB2, 0xC8, 0xC6, 0x9F, 0x73, 0xD8, 0x1F, 0x30, 0xE8, 0xB3, 0x2F, 0x6C, 0x1B, 0xAA, 0x79, 0xA1, 0xDF, 0xA4, 0xB, 0xC9, 0xA1, 0x67, 0xE1, 0xE3, 0x28, 0xAA, 0xE6, 0x27, 0x11, 0xAE, 0xD8, 0x1F, 0x1F, 0x9D, 0xF8, 0x11, 0xDB, 0x46, 0x39, 0x94, 0x3F, 0x28, 0xE0, 0x50, 0x2E, 0x64, 0xB1, 0x31, 0xA5, 0x72, 0xA2, 0x4B, 0x58, 0x5B, 0x9, 0xF3, 0x66, 0x48, 0x41, 0x90, 0x41, 0x16, 0xEC, 0x24, 0x9E, 0xF5, 0x4A, 0x2A, 0x94, 0x2B, 0xD5, 0x14, 0xEC, 0x33, 0x2F, 0x85, 0xAB, 0x4A, 0x9F, 0x65, 0x35, 0x8C, 0x2B, 0xF5, 0x71, 0xC9, 0xB, 0x85, 0x59, 0x39, 0x5B, 0x59, 0xA0, 0xBC, 0x79, 0xDD, 0x9B, 0x8, 0xA9, 0xF, 0xB7, 0xBB, 0x5C, 0x57, 0xE6, 0x1D, 0x97, 0x6F, 0x1C, 0xA9, 0xD6, 0x38, 0x49, 0x1F, 0xD, 0x63, 0x1, 0xF, 0x10, 0xD2, 0x43, 0x1C, 0xB7, 0xE4, 0x5F, 0x5B, 0x82, 0xF, 0xA1, 0x14, 0x6F, 0x30, 0x13, 0x8A, 0x1A, 0xC9, 0xA7, 0x48, 0xFB, 0xF2, 0xD4, 0x5A, 0x26, 0xD, 0x42, 0xBA, 0xD2, 0xB, 0xA9, 0x7B, 0xB3, 0x90, 0x1A, 0x7F, 0x16, 0x33, 0x61, 0x6B, 0xA3, 0xFE, 0xE0, 0x74, 0x16, 0x1F, 0xF4, 0xAF, 0x58, 0x59, 0x6D, 0xBE, 0x22, 0xBC, 0xD8, 0xEC, 0x87, 0x75, 0xD4, 0x42, 0xAD, 0xD4, 0x9, 0xDC, 0xDC, 0x77, 0x7, 0xA, 0x84, 0xA7, 0xCD, 0x3D, 0xE9, 0x38, 0xED, 0x1, 0xC7, 0x29, 0xF5, 0x1A, 0xF6, 0xDC, 0x7E, 0xD, 0x7B, 0xA6, 0xDE, 0x5F, 0x98, 0x9B, 0x8F, 0x2F, 0xCC, 0xE3, 0x8D, 0x38, 0xE5, 0xCA, 0xBF, 0xB6, 0x5, 0x7B, 0x61, 0x88, 0x73, 0xA6, 0x37, 0xD2, 0x50, 0x20, 0xC5, 0x4A, 0x65, 0xC5, 0x3C, 0x7E, 0x47, 0x84, 0x7F, 0x6, 0xE1, 0xA4, 0x93, 0x37, 0xEB, 0x34, 0x19, 0xFE, 0xFC, 0x1F, 0x16, 0x12, 0xD, 0x5D, 0x84, 0x92, 0xC, 0xA7, 0x30, 0x63, 0x48, 0xF9, 0xD8, 0xC1, 0x98, 0x51, 0x70, 0x30, 0x66, 0x2E, 0x75, 0xD9, 0xE9, 0xD0, 0xD2, 0xBC, 0xEE, 0x54, 0x78, 0xD4, 0x8D, 0x98, 0x43, 0x43, 0xF3, 0x52, 0xCC, 0x2C, 0xC9, 0xDE, 0x2C, 0x81, 0xDE, 0x2C, 0xA7, 0xE8, 0x14, 0x97, 0x74, 0xB7, 0xA0, 0x60, 0xDA, 0xDD, 0x59, 0x2A, 0x2D, 0x42, 0x34, 0x63, 0x9C, 0x1D, 0xFF, 0x28, 0xE4, 0xEF, 0x2C, 0xB4, 0x8A, 0x17, 0x65, 0x84, 0xE1, 0x4D, 0x48, 0xC1, 0x61, 0x2B, 0x22, 0xD7, 0xCA, 0x33, 0x59, 0x5, 0x45, 0xB, 0xB6, 0x92, 0xC5, 0x59, 0x81, 0x22, 0x78, 0x65, 0xA8, 0xAC, 0x2D, 0x6F, 0x4, 0x53, 0x50, 0xF9, 0x8A, 0x8C, 0xA3, 0xDF, 0xA1, 0xFB, 0xE7, 0x34, 0xFA, 0x9B, 0x75, 0x7D, 0x34, 0x23, 0xAF, 0xF4, 0x37, 0x1A, 0xAC, 0x9B, 0xBE, 0xC, 0x93, 0x1, 0x60, 0xBE, 0x65, 0xDF, 0xA5, 0x9B, 0xC1, 0x67, 0xE9, 0x66, 0xA9, 0xBD, 0xE4, 0xD6, 0x10, 0x7, 0x86, 0x68, 0xD9, 0x6E, 0xD6, 0xE5, 0xF7, 0x7B, 0x29, 0x35, 0x5, 0x44, 0x2E, 0x44, 0xCC, 0xD2, 0x31, 0xDB, 0xB0, 0x4, 0x20, 0x5, 0xA1, 0x2D, 0x1F, 0x60, 0xF3, 0x9C, 0xFC, 0xB9, 0x59, 0xF7, 0xA3, 0xAD, 0x63, 0x2E, 0x5, 0xFC, 0x46, 0x81, 0x22, 0xF9, 0x67, 0xE9, 0x54, 0x10, 0x7C, 0x1A, 0xF2, 0x13, 0x47, 0x3B, 0x8B, 0xD3, 0xD4, 0xBB, 0x3D, 0xC7, 0xEF, 0x4D, 0x61, 0xBB, 0x44, 0xB1, 0xD1, 0x6F, 0x26, 0xBF, 0x51, 0x6C, 0x8E, 0x49, 0x64, 0xEF, 0xD7, 0x8A, 0x2A, 0xEF, 0x57, 0x54, 0xD5, 0x33, 0x55, 0xE7, 0x8F, 0x9D, 0x7E, 0x9C, 0xD5, 0xDC, 0xAB, 0x84, 0x99, 0xD7, 0xFD, 0x41, 0x84, 0x7A, 0x83, 0x7D, 0x1A, 0xEB, 0xC6, 0x55, 0x30, 0x4B, 0x5B, 0x59, 0x7C, 0xE5, 0x85, 0x1, 0x28, 0x9C, 0x2, 0x91, 0x34, 0x24, 0xFF, 0xDA, 0x10, 0x54, 0x83, 0xD0, 0xF4, 0x29, 0x47, 0x42, 0xA0, 0xE3, 0x8D, 0x64, 0xC0, 0x3D, 0x1B, 0x74, 0x76, 0xC0, 0xE8, 0x1E, 0x56, 0x91, 0x93, 0xA2, 0x2, 0x26, 0x45, 0x35, 0xF2, 0xFB, 0x68, 0x35, 0xC2, 0x9B, 0x68, 0x35, 0x8A, 0x45, 0x54, 0x4F, 0xA, 0x32, 0xE3, 0xCA, 0x19, 0x41, 0x16, 0x58, 0x2C, 0x37, 0x11, 0x16, 0x7D, 0x19, 0x47, 0x95, 0xD5, 0x76, 0x84, 0x45, 0x1D, 0xBE, 0x1F, 0xCC, 0xFC, 0xAA, 0xCB, 0xA0, 0x68, 0xBC, 0xE0, 0x2F, 0x50, 0xA3, 0x75, 0x82, 0x1E, 0x85, 0x19, 0x82, 0x86, 0xA0, 0x9A, 0xD2, 0x96, 0x45, 0x4C, 0x69, 0x1F, 0xAA, 0x5, 0x1B, 0x50, 0xF8, 0x7, 0xAF, 0x3, 0x3, 0xC, 0xF, 0xB1, 0x21, 0xFF, 0xAF, 0xAF, 0xFA, 0x56, 0x7F, 0x67, 0x5E, 0xA9, 0xD9, 0x2C, 0x58, 0x3B, 0x50, 0x2F, 0x8C, 0xB, 0x27, 0xEA, 0x1F, 0x95, 0xFC, 0xC6, 0xFD, 0x4B, 0x29, 0xBA, 0x69, 0x2F, 0x22, 0xEF, 0x6D, 0x7E, 0xDD, 0x31, 0xF0, 0x7, 0xF9, 0x92, 0x77, 0xA0, 0xC8, 0xAA, 0x57, 0xD4, 0xB6, 0x76, 0x20, 0xFA, 0x45, 0xBB, 0x70, 0x2C, 0xFA, 0x96, 0xAA, 0x9E, 0x8B, 0xBE, 0x59, 0x57, 0xE, 0x46, 0x8B, 0xD0, 0x92, 0xC3, 0x9, 0x16, 0x3E, 0xEE, 0xE0, 0x9B, 0xD6, 0x2D, 0x68, 0xF0, 0xC1, 0x5B, 0x2A, 0xBA, 0x86, 0xE5, 0xF9, 0x22, 0x5E, 0x32, 0x40, 0x87, 0xDC, 0x3, 0x53, 0xFF, 0xF8, 0xBF, 0x28, 0x8F, 0x2E, 0x6A, 0xC9, 0x9F, 0xF2, 0x7D, 0xB3, 0xE0, 0xC3, 0xBE, 0x22, 0x74, 0x1E, 0x75, 0x6B, 0xA1, 0xD5, 0x53, 0x30, 0x21, 0x41, 0x7F, 0x52, 0xBB, 0xC2, 0xBD, 0xF6, 0x47, 0x5A, 0xCE, 0xB6, 0x3F, 0xA8, 0x97, 0x4D, 0x81, 0x8E, 0x2E, 0x9B, 0xD0, 0x56, 0x35, 0x9D, 0xBC, 0x8B, 0x15, 0x65, 0x84, 0x8B, 0x9E, 0x7F, 0x1C, 0xEC, 0x37, 0x16, 0x7C, 0x36, 0x73, 0x8, 0xC9, 0x1C, 0xD6, 0xC4, 0x3C, 0xCE, 0xF2, 0x26, 0x75, 0x27, 0xB, 0x56, 0x5C, 0xB, 0x1B, 0x3B, 0xC, 0x19, 0x1E, 0x36, 0x76, 0xA8, 0xD4, 0xCA, 0xA7, 0x7F, 0x56, 0x74, 0x5A, 0xAD, 0xBA, 0xCC, 0xC1, 0x95, 0x7A, 0xB1, 0x8, 0xFE, 0x58, 0xF1, 0x8B, 0x4, 0x1F, 0x2E, 0x16, 0x19, 0x3F, 0x2B, 0x97, 0x27, 0x60, 0x7E, 0xF1, 0xF2, 0x3B, 0xF, 0x5E, 0xC8, 0x14, 0xB1, 0xA8, 0xD5, 0xD3, 0x9E, 0x65, 0xAB, 0xE9, 0x3F, 0x89, 0x17, 0xCB, 0x1F, 0x7C, 0x34, 0xB6, 0xB2, 0xB8, 0xF9, 0x90, 0xF0, 0x25, 0x4E, 0x96, 0x89, 0xAE, 0x72, 0xDA, 0xFD, 0xA6, 0xC0, 0xFE, 0x83, 0x22, 0x91, 0xFA, 0xE7, 0xF2, 0x64, 0xC2, 0x86, 0xBF, 0x38, 0x5D, 0x26, 0x5D, 0x52, 0x1B, 0x2A, 0xD1, 0xB1, 0xDF, 0xAC, 0x8B, 0x83, 0xFA, 0x69, 0x47, 0x9B, 0xE6, 0x91, 0x56, 0xFB, 0x9D, 0xBC, 0x8B, 0xCD, 0x97, 0x9B, 0xDC, 0x58, 0xD6, 0x99, 0xF4, 0x1B, 0xB, 0x75, 0x2A, 0xA9, 0xBB, 0x62, 0x4F, 0x3, 0xD5, 0x5D, 0xA1, 0x67, 0x81, 0x6A, 0x11, 0xAE, 0x11, 0x70, 0x99, 0x29, 0x97, 0x42, 0x2E, 0xF3, 0xFC, 0x56, 0x5D, 0x7B, 0xE4, 0x41, 0x76, 0x61, 0xD5, 0xB1, 0xA8, 0x6C, 0x70, 0x2A, 0xA, 0x55, 0x31, 0x37, 0xB0, 0x42, 0x6B, 0x64, 0xC5, 0x82, 0xE5, 0xD8, 0x46, 0xD8, 0x7A, 0x69, 0x79, 0xE7, 0x9F, 0xC1, 0x2A, 0x30, 0x7C, 0x1A, 0xAD, 0xB4, 0x2C, 0x27, 0xD, 0x6C, 0xE3, 0x1E, 0x4, 0xB8, 0x85, 0xB5, 0x2A, 0xE7, 0x57, 0x8C, 0x36, 0xD5, 0x1D, 0xC7, 0x5B, 0x80, 0x2A, 0x63, 0xC7, 0xDA, 0xFA, 0x23, 0xC1, 0xF8, 0xD0, 0x33, 0x76, 0x16, 0xB5, 0xA0, 0xA5, 0x83, 0x70, 0x45, 0x61, 0x55, 0xD9, 0x35, 0x45, 0x21, 0x70, 0x27, 0xD0, 0x45, 0x55, 0xC6, 0x11, 0x65, 0x76, 0x49, 0xAB, 0xC9, 0xDA, 0x5A, 0xDF, 0x2E, 0xAA, 0x7B, 0x53, 0x10, 0x95, 0xDB, 0x4C, 0xBD, 0xC6, 0x77, 0xFC, 0xDE, 0x12, 0x76, 0x62, 0xAC, 0x3E, 0xAF, 0x55, 0x18, 0x57, 0xE, 0xFF, 0x95, 0x13, 0x69, 0x1B, 0x51, 0x9, 0x18, 0x3B, 0x19, 0x70, 0xC4, 0x58, 0x9E, 0x87, 0x43, 0xCD, 0x20, 0x17, 0x2, 0x6E, 0x89, 0x9D, 0x30, 0xA2, 0x16, 0x57, 0x50, 0x8, 0x78, 0x54, 0x81, 0x72, 0xC2, 0x89, 0xEA, 0xCA, 0x2A, 0x11, 0xAE, 0x2C, 0xAC, 0xDC, 0x11, 0x19, 0x5E, 0xE9, 0x29, 0xEE, 0x8C, 0xD, 0x87, 0xBB, 0x8A, 0x2B, 0x47, 0xCC, 0xD5, 0x48, 0xEE, 0x93, 0x74, 0x25, 0x98, 0x5B, 0xA5, 0xD5, 0x73, 0x25, 0x20, 0x28, 0xE3, 0x78, 0x48, 0x50, 0xBE, 0xDE, 0xF1, 0x68, 0xB8, 0x48, 0xA, 0xE7, 0x92, 0x93, 0xC4, 0xD9, 0xE5, 0x88, 0x12, 0x64, 0xB7, 0xAA, 0x85, 0x72, 0xC5, 0x3F, 0xE0, 0xAC, 0xB1, 0xAD, 0x86, 0xB2, 0xC6, 0x5E, 0x6C, 0x28, 0x6B, 0x6C, 0x6D, 0x0, 0x25, 0x85, 0xF8, 0xE8, 0xB1, 0xF5, 0xAB, 0x49, 0x89, 0x70, 0x69, 0xFF, 0xE9, 0xD2, 0x38, 0xAB, 0xF, 0x9F, 0xCC, 0xB8, 0x32, 0x7E, 0x6A, 0x26, 0xF1, 0x82, 0xB7, 0xC3, 0xB, 0x9D, 0xD6, 0xBE, 0x94, 0x96, 0x13, 0x6A, 0xAB, 0x48, 0xFD, 0xA9, 0xDA, 0x4B, 0xA, 0xE5, 0x4A, 0x2F, 0xF1, 0xC1, 0x2A, 0xC7, 0xBB, 0xFF, 0xF, 0x1C, 0x74, 0xFF, 0x27, 0xB6, 0x53, 0x83, 0x58, 0xAB, 0xFA, 0x32, 0xE3, 0xAA, 0x46, 0x6F, 0x7, 0x25, 0x3, 0x7B, 0x9B, 0xFB, 0x3F, 0xC4, 0x6B, 0xFA, 0x67, 0x1B, 0xBD, 0xE4, 0xCF, 0x14, 0x7F, 0x63, 0x5D, 0xD2, 0xEC, 0x87, 0xB9, 0xA9, 0x37, 0x73, 0xAE, 0x6A, 0x6D, 0xEB, 0xBC, 0xA2, 0xB1, 0x51, 0xAF, 0x97, 0x6A, 0xF3, 0x69, 0x6F, 0x7E, 0x50, 0xD2, 0xC, 0xF5, 0x5E, 0xF5, 0xC5, 0x43, 0xB5, 0x7, 0x31, 0xCC, 0xD4, 0x99, 0xEB, 0x1, 0x40, 0xE2, 0xF9, 0xE, 0x15, 0xB7, 0xA4, 0xC8, 0x8C, 0xEE, 0xA0, 0x20, 0xA3, 0x27, 0xB, 0x18, 0xB1, 0x88, 0xB6, 0xDA, 0xC4, 0xBA, 0xAC, 0xDA, 0x6A, 0xF5, 0x4, 0x74, 0x67, 0xDA, 0x75, 0xE5, 0x1, 0x51, 0x1D, 0x2E, 0x2A, 0xE6, 0x77, 0x4, 0x1, 0xDF, 0xC, 0x4, 0x47, 0x7, 0x48, 0x27, 0x0, 0x38, 0x3, 0x53, 0x64, 0x8C, 0x97, 0x6A, 0x5F, 0xA9, 0x9F, 0x15, 0x75, 0x78, 0xA6, 0xDA, 0x67, 0x67, 0x2F, 0x69, 0xD8, 0x6B, 0x2A, 0x9, 0x44, 0xEF, 0xA8, 0xDC, 0x85, 0xB, 0xDC, 0x9, 0xE3, 0x81, 0x1, 0xDF, 0x7F, 0x97, 0xAB, 0x96, 0xEC, 0x89, 0xE2, 0x1B, 0x5, 0x34, 0x51, 0xF1, 0x47, 0xCD, 0xC8, 0xA3, 0x85, 0x3C, 0xB9, 0x5B, 0x80, 0x93, 0xBB, 0x85, 0x3E, 0x2A, 0x5A, 0xA0, 0x63, 0xA2, 0x85, 0x3E, 0xDB, 0x5F, 0xA0, 0x53, 0xFD, 0x85, 0x3A, 0xF8, 0x5C, 0x9A, 0x77, 0xC, 0x56, 0xF2, 0x53, 0x57, 0xE0, 0x53, 0xE9, 0x36, 0x46, 0x5A, 0x77, 0xFD, 0xF5, 0xBF, 0x4D, 0x50, 0xE9, 0x48, 0x12, 0xD2, 0x65, 0xA8, 0xF5, 0xB5, 0xEB, 0xAF, 0x55, 0x51, 0xF9, 0x7F, 0xE7, 0xF5, 0x57, 0x97, 0x91, 0xB6, 0x2, 0xB6, 0x8, 0x58, 0x9, 0xA8, 0x66, 0x6E, 0xCE, 0x0, 0xF4, 0xD5, 0x0, 0xE4, 0x5E, 0x0, 0xD5, 0x4A, 0xCD, 0x26, 0x32, 0x64, 0xE3, 0x1A, 0xA0, 0x71, 0x6D, 0xDF, 0x3F, 0x7F, 0xBC, 0xA, 0xF4, 0x9B, 0xF6, 0x1F, 0x18, 0xEA, 0xD7, 0x6F, 0x36, 0xDD, 0xBF, 0x3F, 0xCA, 0x90, 0x7E, 0x6E, 0x7B, 0x3A, 0xF9, 0x55, 0x6, 0x80, 0x8A, 0xB4, 0xC3, 0x22, 0x89, 0xC, 0x14, 0xC7, 0xEF, 0x2D, 0x61, 0x32, 0x61, 0xD2, 0x8C, 0x24, 0xED, 0x9A, 0xED, 0x2D, 0xD6, 0x1D, 0x80, 0xF6, 0x16, 0x2F, 0xB9, 0xFF, 0x6A, 0x3B, 0xF, 0x7C, 0xCF, 0x61, 0x97, 0x85, 0x8D, 0x4A, 0x3E, 0x30, 0xE4, 0xE3, 0x96, 0xE8, 0x9E, 0xF5, 0x5B, 0x89, 0x85, 0x72, 0x65, 0x3B, 0x94, 0x33, 0x5F, 0xB4, 0x74, 0x86, 0x4B, 0x3B, 0xAC, 0x2C, 0x50, 0x8F, 0x5B, 0x2E, 0x3A, 0xAF, 0x44, 0x2D, 0xDB, 0x75, 0x54, 0x86, 0xCA, 0xBB, 0xF8, 0x46, 0x9A, 0xA, 0xE8, 0x8, 0x35, 0xBB, 0x22, 0x1, 0x6A, 0x76, 0x55, 0xA3, 0x20, 0xBB, 0x74, 0x1, 0x9A, 0xE2, 0x5A, 0xFA, 0xB4, 0x5E, 0x61, 0xFA, 0xC0, 0x1B, 0x86, 0x8A, 0xC2, 0xE8, 0x80, 0x64, 0x83, 0x60, 0x32, 0x35, 0x9D, 0x18, 0xA2, 0x45, 0xCB, 0x9F, 0x87, 0xEC, 0xBD, 0x74, 0xBB, 0x74, 0x54, 0xE2, 0xAA, 0xDF, 0x19, 0x1D, 0x9B, 0xC4, 0xEA, 0xCB, 0xE2, 0x68, 0xD9, 0x47, 0xB5, 0x32, 0x6F, 0x7B, 0xBE, 0x61, 0xE8, 0x46, 0xA0, 0xD5, 0x72, 0x94, 0x1B, 0xF5, 0x54, 0xC7, 0x7, 0x2, 0x54, 0x18, 0xCF, 0x98, 0xD2, 0x8C, 0x61, 0x76, 0x54, 0xCB, 0xF1, 0x69, 0x6A, 0x9A, 0xBA, 0x82, 0xA3, 0xDA, 0x6E, 0x81, 0xB9, 0x63, 0x5E, 0x30, 0x28, 0xFE, 0xAA, 0x50, 0xD9, 0x12, 0x25, 0x35, 0x60, 0x69, 0x26, 0x6B, 0x47, 0x72, 0x44, 0x9E, 0x50, 0xBB, 0x96, 0x15, 0xFF, 0x3D, 0xA, 0xFE, 0x9C, 0x55, 0x99, 0xC5, 0x1A, 0xA6, 0x1F, 0x26, 0xB0, 0x4B, 0x28, 0xA6, 0x28, 0xFE, 0x62, 0x66, 0xE3, 0x3, 0xC5, 0x26, 0xE3, 0x83, 0xCC, 0x7E, 0x5B, 0x51, 0x85, 0x7F, 0x5E, 0xFC, 0xC5, 0xB6, 0x57, 0x51, 0x5, 0xB6, 0x98, 0xBD, 0xDD, 0xD4, 0x20, 0x99, 0xF7, 0x24, 0x3E, 0x84, 0xAE, 0xFA, 0xB4, 0xC4, 0x6F, 0x1A, 0x99, 0xF6, 0x47, 0xDA, 0xDE, 0xCB, 0x21, 0x3A, 0x15, 0x9E, 0x5A, 0xC, 0x6C, 0x9D, 0x7F, 0x71, 0xD0, 0x9A, 0x3F, 0x45, 0xB6, 0xB6, 0xD6, 0xED, 0x82, 0x52, 0x43, 0xE5, 0xB1, 0xFD, 0x40, 0xC0, 0xE7, 0x2E, 0xAB, 0xDC, 0xA8, 0x5C, 0xAC, 0x6F, 0x90, 0xCF, 0xC8, 0xFA, 0x5B, 0x59, 0xA2, 0x51, 0x7C, 0x27, 0x4D, 0x20, 0xFA, 0x51, 0x89, 0xCB, 0x76, 0xD1, 0xAC, 0x8, 0xEC, 0xA1, 0x89, 0xCB, 0x76, 0xD0, 0xAC, 0x8, 0xEA, 0x9F, 0x46, 0x28, 0x99, 0x8F, 0x18, 0xC9, 0x25, 0x83, 0x11, 0xAA, 0xCB, 0x30, 0xB2, 0x5A, 0x0, 0x32, 0x38, 0xFC, 0x1F, 0xB2, 0x37, 0x58, 0x33, 0x12, 0xD9, 0xCC, 0xCB, 0xCF, 0x4D, 0x51, 0x79, 0xB3, 0x9E, 0x8E, 0x71, 0x3F, 0x30, 0x64, 0xE6, 0x35, 0x93, 0xAD, 0xBB, 0x9B, 0x13, 0xE3, 0x52, 0x88, 0x9D, 0x35, 0x77, 0xCB, 0xC7, 0x0, 0x25, 0x14, 0x8E, 0xFD, 0x49, 0xE4, 0x5A, 0x3C, 0x54, 0x56, 0x86, 0x7, 0x41, 0x59, 0x73, 0x7F, 0xD6, 0x8B, 0xF6, 0xBC, 0x50, 0xB6, 0x2D, 0x58, 0x4D, 0x8E, 0xE0, 0x2D, 0x58, 0xFE, 0x1D, 0xCD, 0xAF, 0xDE, 0xB9, 0x5, 0xCB, 0xF4, 0xF1, 0xA4, 0x1, 0xEA, 0xE0, 0xCE, 0x28, 0xC3, 0x39, 0x6E, 0x4E, 0x10, 0xE9, 0x5, 0x2E, 0x27, 0xF5, 0x52, 0xF5, 0xEE, 0x7D, 0x90, 0x2E, 0xF5, 0x6F, 0x67, 0x57, 0x3B, 0xF1, 0x7E, 0xDE, 0x3, 0x7, 0x5D, 0xB1, 0xD6, 0x59, 0x63, 0xFB, 0x7A, 0x3A, 0xA7, 0x83, 0x73, 0xAD, 0x90, 0xB4, 0x25, 0x76, 0x80, 0x15, 0x76, 0x81, 0x5B, 0xFC, 0x26, 0x4, 0xA9, 0x7A, 0xBF, 0xA9, 0x20, 0x48, 0x23, 0x7E, 0x20, 0x6D, 0x5, 0xF4, 0xDC, 0xEB, 0x37, 0x68, 0xC6, 0x25, 0xB3, 0x27, 0xF1, 0x89, 0x93, 0xBE, 0xFE, 0x6E, 0x90, 0xE3, 0xA6, 0x98, 0x79, 0xA3, 0x80, 0xD8, 0xBC, 0xE1, 0x83, 0xA2, 0x5E, 0xD0, 0x3C, 0x81, 0x76, 0x39, 0x94, 0x99, 0x7E, 0x86, 0x35, 0xFA, 0x20, 0x9D, 0xA1, 0x6B, 0xF2, 0x9B, 0xD2, 0x19, 0x60, 0x52, 0xCC, 0x12, 0xFA, 0x79, 0x57, 0xC6, 0x52, 0xD7, 0x46, 0xFF, 0xEA, 0xE, 0x4A, 0x57, 0x38, 0x7F, 0x5E, 0x1E, 0xFD, 0x9B, 0xD5, 0x69, 0xF7, 0x99, 0x9C, 0x21, 0x3F, 0x1A, 0xED, 0x3E, 0xFF, 0x67, 0x9A, 0x64, 0xF4, 0x3D, 0xF, 0x19, 0xF2, 0x16, 0xFB, 0x17, 0x8E, 0xDC, 0x63, 0xA7, 0xB7, 0x37, 0xED, 0x6E, 0x10, 0xE4, 0x4A, 0xAF, 0xD9, 0xA, 0x0, 0x88, 0xCF, 0xD4, 0xF9, 0x64, 0x2, 0xBA, 0xB9, 0x79, 0x3C, 0x99, 0x68, 0xD4, 0x7B, 0xF, 0x45, 0xBE, 0xF9, 0xE2, 0x43, 0xA6, 0x71, 0xA5, 0x87, 0xA, 0x1F, 0xA8, 0x3B, 0x9C, 0xF9, 0x8F, 0x87, 0xE6, 0x15, 0xCE, 0x44, 0x7B, 0xA8, 0x30, 0xDF, 0x56, 0x35, 0xCD, 0x81, 0x19, 0x49, 0xBF, 0x71, 0xB0, 0x1, 0x15, 0xB4, 0xA0, 0xDE, 0xEE, 0x3F, 0x81, 0x56, 0xA9, 0x22, 0x6D, 0xE, 0x23, 0x60, 0xE, 0xA3, 0xE7, 0x8F, 0xDF, 0x12, 0x88, 0x1A, 0x7A, 0x22, 0xD5, 0x6E, 0xFA, 0xB1, 0x25, 0xF6, 0x14, 0x66, 0x62, 0x52, 0x97, 0x1E, 0x18, 0x62, 0x91, 0x9F, 0xAB, 0x30, 0xA3, 0xA4, 0xF3, 0x4E, 0x9D, 0x20, 0xA0, 0x87, 0x4A, 0x82, 0xD8, 0x4C, 0x81, 0xE8, 0x4, 0x7B, 0x83, 0xDA, 0x89, 0xAE, 0x7A, 0x8B, 0xFA, 0xD3, 0x1, 0xB8, 0xE7, 0x97, 0x66, 0xF4, 0xF9, 0xA5, 0xD9, 0xF7, 0xD4, 0xFD, 0xF1, 0xF4, 0x6B, 0xA3, 0xFC, 0x64, 0xD7, 0x69, 0xBF, 0xCD, 0x31, 0x7B, 0x2A, 0x29, 0xEF, 0xEC, 0xDB, 0xD9, 0x78, 0x67, 0xEF, 0x22, 0xE5, 0x2F, 0xF7, 0x5D, 0xFA, 0xBD, 0xF9, 0x9D, 0x2F, 0x7A, 0x9B, 0xEF, 0xBB, 0x17, 0xB2, 0xC3, 0xA7, 0x4C, 0x66, 0xD3, 0x62, 0x86, 0xB0, 0xF5, 0x69, 0x5A, 0xA1, 0x1A, 0xA8, 0x60, 0x50, 0x13, 0x51, 0x46, 0xB3, 0x69, 0x2E, 0x23, 0x6D, 0x64, 0x22, 0x64, 0x57, 0x22, 0xD7, 0xAD, 0x22, 0xDA, 0xAD, 0xE2, 0x34, 0x93, 0x73, 0x45, 0xF9, 0xBD, 0x55, 0x76, 0xE5, 0xBD, 0x92, 0xEE, 0xE7, 0x1E, 0x7D, 0x50, 0x83, 0xA4, 0xDE, 0x27, 0xFB, 0x93, 0xD3, 0x7E, 0xAA, 0x6C, 0x8E, 0x5A, 0x18, 0x15, 0x54, 0x25, 0x5C, 0xE2, 0xCD, 0x0, 0x8D, 0x57, 0xA4, 0x77, 0xC0, 0x11, 0xDA, 0xFB, 0x46, 0xC7, 0x2D, 0xE7, 0x33, 0x2, 0x98, 0x9D, 0xE8, 0x22, 0x7D, 0x60, 0x95, 0x0, 0x40, 0x7E, 0x17, 0x2B, 0x63, 0x42, 0x33, 0x2, 0x68, 0x78, 0xA, 0xEA, 0x41, 0xD4, 0xF2, 0x73, 0x4B, 0x74, 0x5D, 0x25, 0x9B, 0x70, 0xEA, 0x4, 0x91, 0x22, 0x70, 0xF1, 0xC6, 0x60, 0xA0, 0xF1, 0x33, 0x4E, 0xF9, 0xB2, 0xCF, 0x8B, 0xB, 0x53, 0x48, 0x84, 0xEE, 0x64, 0x40, 0xA, 0xCD, 0x28, 0xA8, 0x28, 0x92, 0x3, 0x68, 0x88, 0xBF, 0x6, 0x72, 0x89, 0xF7, 0x1A, 0x80, 0x95, 0x9D, 0xFA, 0xE7, 0x7F, 0xFF, 0x1F, 0xB5, 0x51, 0x4D, 0xBF, 0x3, 0xFB, 0x54, 0x25, 0xB4, 0x58, 0x57, 0xA1, 0xE9, 0xFB, 0x94, 0x6F, 0x14, 0xB1, 0x20, 0x8A, 0xCC, 0x82, 0xA3, 0x44, 0x3B, 0x42, 0xE2, 0x88, 0x5, 0xA9, 0x4, 0x8E, 0x9C, 0xD1, 0x22, 0x70, 0xD4, 0x8, 0xA0, 0xB, 0xD5, 0xC1, 0x46, 0xE2, 0x9E, 0x8A, 0xC0, 0x40, 0xDC, 0x4C, 0xAF, 0x64, 0xD1, 0x29, 0x99, 0x98, 0xE1, 0xC, 0x3A, 0xAA, 0x67, 0xC7, 0xD2, 0x1, 0xB5, 0x47, 0x92, 0xA2, 0x12, 0xD7, 0xA8, 0x76, 0xE2, 0x1A, 0xD5, 0xE7, 0x84, 0x95, 0xDC, 0x9D, 0xA2, 0x3, 0x6, 0xAF, 0x12, 0xA9, 0x9E, 0xEA, 0x79, 0x7D, 0xCB, 0x12, 0xAB, 0x81, 0x5A, 0xC5, 0xE4, 0x5F, 0x5B, 0x82, 0xD4, 0x70, 0x19, 0x5A, 0x8B, 0x18, 0x35, 0x30, 0xEF, 0x3E, 0xAA, 0x1, 0x7B, 0xF5, 0x31, 0x5F, 0xF0, 0x15, 0xC1, 0x56, 0xA2, 0x67, 0xB, 0xA5, 0x7B, 0x73, 0x80, 0xCA, 0x1B, 0x66, 0x35, 0xB, 0xC7, 0xA7, 0x17, 0x3D, 0x9, 0x68, 0x72, 0xD1, 0xC4, 0x5F, 0x84, 0xAA, 0xE4, 0x47, 0x3F, 0x18, 0x78, 0x8A, 0xF4, 0x22, 0x50, 0x49, 0x81, 0x7A, 0x12, 0xAE, 0x95, 0xF1, 0xC1, 0xA7, 0x41, 0x7C, 0x2B, 0x4, 0x67, 0x84, 0x93, 0xAF, 0x95, 0x17, 0x8D, 0xB3, 0xE8, 0xDF, 0xA9, 0x57, 0xCE, 0xA4, 0xD5, 0xF1, 0xE2, 0x0, 0x1B, 0xEA, 0x7C, 0x12, 0xD0, 0x60, 0xE7, 0xC2, 0x4F, 0xD3, 0x14, 0x7B, 0x93, 0xED, 0x43, 0x41, 0x6F, 0xB2, 0x7D, 0x24, 0xAA, 0xCD, 0x75, 0x28, 0xBD, 0xD0, 0x60, 0xC6, 0x7A, 0x59, 0xC9, 0xAC, 0xFB, 0xA1, 0xE0, 0x9F, 0x4F, 0x2C, 0x92, 0xD3, 0x8F, 0xD, 0xB1, 0x91, 0x90, 0x1A, 0x9B, 0x42, 0x82, 0xFA, 0x63, 0x63, 0x27, 0xDA, 0x7F, 0x4E, 0xB2, 0x37, 0x2, 0xD5, 0x28, 0xD1, 0x9B, 0x80, 0x6A, 0xBC, 0x51, 0x9B, 0x7E, 0x35, 0xDE, 0xBA, 0xE6, 0x9E, 0x5F, 0x8D, 0x39, 0x11, 0x8D, 0xB3, 0xC3, 0x42, 0xAD, 0x33, 0xD4, 0xD8, 0xBD, 0x71, 0x64, 0xC1, 0x31, 0x52, 0xF, 0x0, 0xA8, 0xB1, 0x6B, 0xBE, 0x0, 0xA0, 0xC6, 0x95, 0x88, 0x84, 0x54, 0x63, 0xB7, 0xB6, 0x62, 0x21, 0xD5, 0xB8, 0x12, 0xC1, 0x14, 0x49, 0xB2, 0x15, 0x4A, 0xA1, 0x46, 0xD3, 0xAF, 0x76, 0x9A, 0x24, 0xFD, 0xD8, 0xF2, 0x7, 0x86, 0x56, 0x38, 0xA3, 0x93, 0xDB, 0x3A, 0xD0, 0x75, 0x98, 0xA0, 0x6E, 0x0, 0xAA, 0xCF, 0xC9, 0x40, 0x7A, 0x1F, 0xA, 0x2, 0x4C, 0xDA, 0x23, 0xB9, 0xCE, 0x1A, 0x81, 0x75, 0xD6, 0xB8, 0x53, 0xC1, 0x97, 0x6A, 0xDC, 0xBB, 0x66, 0x0, 0xA6, 0x1A, 0xF7, 0x40, 0xF8, 0x6D, 0x92, 0x6C, 0x68, 0xB9, 0x6E, 0x14, 0x35, 0xE1, 0x36, 0xE7, 0x5B, 0x29, 0x49, 0x31, 0x9, 0x8, 0xD2, 0x3B, 0x59, 0x25, 0xC1, 0x9D, 0xAC, 0xBA, 0x51, 0x5D, 0xE5, 0xD6, 0x5C, 0x91, 0xDC, 0x22, 0x71, 0xDC, 0xA7, 0x6E, 0x5D, 0x6C, 0x1D, 0xF7, 0xA9, 0x69, 0x95, 0x5E, 0x9, 0xFF, 0x33, 0x8, 0xD7, 0x5B, 0xA3, 0x4, 0xF5, 0x0, 0xF1, 0x7F, 0x48, 0xED, 0x97, 0x88, 0x4F, 0x11, 0x44, 0xED, 0x5, 0xAD, 0xB3, 0x7C, 0xD6, 0xEE, 0x7F, 0x65, 0x1C, 0xBF, 0xFE, 0xA5, 0xD4, 0x58, 0xB9, 0x4E, 0x95, 0x50, 0xF8, 0x32, 0x95, 0x52, 0x63, 0x35, 0x93, 0x40, 0xC2, 0x2F, 0x24, 0x11, 0x50, 0xEA, 0xAE, 0x74, 0xB5, 0x6C, 0x9, 0xBF, 0x50, 0xBA, 0x20, 0x82, 0xAC, 0x5E, 0x9F, 0x2A, 0x94, 0x2B, 0xD7, 0xA7, 0x94, 0x2A, 0x4B, 0x10, 0xBE, 0x8C, 0x79, 0x3B, 0x81, 0x97, 0xF1, 0x15, 0x97, 0xDA, 0x7D, 0xA0, 0x42, 0xC0, 0x6F, 0x3, 0x25, 0xBE, 0xA9, 0x96, 0x2F, 0xE1, 0x78, 0xF9, 0x16, 0x32, 0x88, 0xBF, 0xFC, 0xDC, 0x14, 0x75, 0x71, 0x12, 0xDC, 0x5D, 0xF4, 0x3, 0x6, 0x2F, 0xA1, 0xAB, 0x45, 0x4, 0xC1, 0x46, 0x1F, 0x64, 0x14, 0x8D, 0x3E, 0xC8, 0xCB, 0x49, 0xF6, 0xDC, 0xBA, 0xAC, 0x63, 0xC1, 0x63, 0x6B, 0xB5, 0xC8, 0x31, 0x37, 0x1E, 0xBD, 0x18, 0x4C, 0x68, 0x6E, 0x39, 0x64, 0x1D, 0x98, 0xC8, 0xEC, 0x6, 0x2F, 0x6B, 0x2, 0xB7, 0x77, 0x89, 0x5B, 0x3B, 0xD4, 0x2D, 0xCA, 0xF0, 0x13, 0xDD, 0xCC, 0xAF, 0x74, 0xA8, 0xAC, 0xE, 0xEF, 0x4F, 0x72, 0xE4, 0xEF, 0xDA, 0x16, 0x5D, 0xE8, 0xFE, 0x7E, 0x51, 0x37, 0xC2, 0x7, 0xA4, 0x16, 0xD5, 0xDD, 0x38, 0x17, 0xD0, 0x67, 0xCA, 0x59, 0xAA, 0x69, 0xC9, 0x93, 0x12, 0xFC, 0x5A, 0xB2, 0x5A, 0xAC, 0xAE, 0xF4, 0x73, 0xAB, 0xF1, 0x5E, 0x1E, 0xFD, 0x71, 0x81, 0x96, 0xAB, 0xEC, 0x4C, 0xC0, 0xAB, 0x9B, 0x5A, 0xC3, 0x36, 0xD7, 0xAF, 0xAB, 0x78, 0x8, 0x72, 0xE7, 0x9D, 0x0, 0x60, 0xE3, 0xBD, 0x72, 0xB, 0x82, 0x15, 0x5D, 0x10, 0xAC, 0x6B, 0xF4, 0xA1, 0x9E, 0xDE, 0xFE, 0x9B, 0x83, 0xF9, 0x92, 0xD5, 0x6A, 0x77, 0x39, 0xD8, 0x75, 0x95, 0x13, 0x93, 0x7B, 0xF1, 0x9B, 0x1, 0xA5, 0x5E, 0x54, 0x2B, 0xB9, 0x36, 0x5C, 0x81, 0xB5, 0x21, 0xF5, 0x49, 0xCD, 0x2F, 0xD0, 0xA2, 0x17, 0x8E, 0x8F, 0xC2, 0x3A, 0x70, 0xD8, 0x12, 0x6A, 0x31, 0xC9, 0xFC, 0x3A, 0x48, 0x25, 0xE3, 0xE8, 0x6F, 0x24, 0xB0, 0xA2, 0x75, 0xEA, 0xC4, 0xD5, 0xC8, 0x92, 0x83, 0x72, 0xC5, 0xC, 0xE5, 0x7, 0x90, 0x48, 0x4D, 0xBA, 0xF9, 0xE4, 0xA9, 0xD2, 0xC2, 0x29, 0xEE, 0x72, 0x57, 0x6, 0xC1, 0xCB, 0x5D, 0x4A, 0xF7, 0x3D, 0xB5, 0x1D, 0xD3, 0x7D, 0xD7, 0x37, 0xF7, 0x63, 0x7A, 0xEC, 0x29, 0xD1, 0xB1, 0x6F, 0xA, 0x92, 0xB, 0x49, 0xD, 0x2C, 0x1D, 0xB5, 0x7A, 0x45, 0x3E, 0x27, 0x47, 0x86, 0xE1, 0xA4, 0x1C, 0x4A, 0x6B, 0x6A, 0xCC, 0xA4, 0x5F, 0xDB, 0x82, 0xBD, 0x5A, 0x95, 0x24, 0x8E, 0x16, 0xBF, 0xB0, 0x96, 0x92, 0x4D, 0x3A, 0x66, 0x49, 0x5D, 0x20, 0xA4, 0x3A, 0x36, 0xE9, 0x82, 0x53, 0xBF, 0x86, 0xD1, 0xD0, 0xFB, 0xD3, 0x2F, 0x2, 0xB4, 0x43, 0x7D, 0xF3, 0xAB, 0xDA, 0x20, 0x55, 0x54, 0xE5, 0x34, 0x2B, 0xC5, 0xFC, 0x88, 0x71, 0x74, 0xC2, 0x6D, 0xD4, 0x4E, 0xA8, 0x80, 0x5D, 0x46, 0x9B, 0x7A, 0x7A, 0xE9, 0x56, 0x65, 0xA8, 0xEB, 0x2, 0x6F, 0x10, 0xB8, 0x29, 0x90, 0xB8, 0xAE, 0xE7, 0xB4, 0xB8, 0x1E, 0x54, 0x11, 0x43, 0xA0, 0xAF, 0x2E, 0xBC, 0x41, 0x4C, 0xD1, 0xE8, 0x57, 0x46, 0xCB, 0xE8, 0x57, 0x58, 0x5, 0x79, 0x4, 0x79, 0xE8, 0x0, 0x8E, 0x21, 0xB, 0x93, 0x9E, 0x11, 0x8B, 0x12, 0x64, 0x4A, 0x34, 0x3F, 0x61, 0x96, 0x3F, 0xBD, 0x30, 0x23, 0x57, 0x9C, 0x13, 0x7, 0xCB, 0xB4, 0x6F, 0x5C, 0x6B, 0xEF, 0x1B, 0xD8, 0xD4, 0xFB, 0x66, 0xB8, 0xC2, 0x24, 0x1D, 0x70, 0x39, 0xB8, 0xBA, 0x49, 0x4A, 0xA0, 0xBA, 0x11, 0xBD, 0xD4, 0x8A, 0x9E, 0x12, 0x13, 0xD6, 0x69, 0x85, 0x4C, 0x87, 0x25, 0x25, 0x9, 0xEF, 0xE5, 0x3E, 0x52, 0x96, 0xA0, 0x4E, 0x6E, 0x23, 0x26, 0xFE, 0xF5, 0xDA, 0x8C, 0xA2, 0x79, 0x82, 0x94, 0xA9, 0xDD, 0xF2, 0x56, 0xE6, 0xC2, 0xF5, 0x6E, 0x65, 0x44, 0x2D, 0x4B, 0x53, 0x86, 0xF1, 0x19, 0x21, 0xB1, 0x6B, 0x99, 0x54, 0x8A, 0x3A, 0x3C, 0x8D, 0x8A, 0xAA, 0x6E, 0x5D, 0xAF, 0xEC, 0x5B, 0x4D, 0x2D, 0xF5, 0x63, 0xD2, 0x4, 0x7, 0x3A, 0xAB, 0x7C, 0xDE, 0x50, 0x3F, 0x2E, 0x3D, 0x28, 0x97, 0x9A, 0x21, 0xF8, 0xB8, 0xD4, 0xD2, 0xC9, 0x9E, 0x94, 0xB, 0x7B, 0x7F, 0x53, 0x92, 0xA1, 0xB9, 0x7A, 0xCE, 0x98, 0xF, 0xED, 0x92, 0xE3, 0xC3, 0x88, 0x57, 0xDA, 0x38, 0xB3, 0x47, 0x1F, 0x5, 0x87, 0xCF, 0x3D, 0xC, 0x75, 0x9A, 0x68, 0x9A, 0x87, 0x89, 0x66, 0x98, 0xA5, 0x35, 0x54, 0x7C, 0xD3, 0x1, 0xB5, 0x83, 0x9C, 0x12, 0x91, 0x72, 0xCB, 0x9A, 0x81, 0x77, 0xCB, 0x7E, 0xD9, 0x9A, 0x91, 0x5C, 0x0, 0x9A, 0x11, 0x58, 0x0, 0x9A, 0x51, 0x32, 0xF, 0x6A, 0x1E, 0x10, 0xE0, 0x8D, 0x36, 0xE3, 0x44, 0x5A, 0xDC, 0xB1, 0x9B, 0x9A, 0xCB, 0x1F, 0x33, 0x2A, 0x61, 0x4, 0xBB, 0xD2, 0x2F, 0x30, 0xB8, 0xC6, 0x2F, 0xE4, 0xCD, 0xAE, 0x8A, 0xD, 0xF6, 0xF9, 0xE6, 0x5C, 0xD1, 0xAA, 0x85, 0x5B, 0x64, 0xA8, 0x6B, 0x2D, 0x9C, 0x4B, 0x5A, 0xAB, 0xFA, 0x70, 0x4D, 0x72, 0xB3, 0x8E, 0x5C, 0xAB, 0x8D, 0x4A, 0x76, 0x19, 0x6B, 0x77, 0x21, 0x35, 0x49, 0x23, 0xA8, 0xD7, 0x68, 0x4E, 0xAC, 0x6B, 0xBE, 0x4A, 0x93, 0x98, 0x74, 0x31, 0x3A, 0x60, 0xD9, 0x38, 0x2E, 0xD4, 0xE1, 0x82, 0x19, 0xBB, 0xA5, 0x79, 0xB8, 0x60, 0x46, 0xFB, 0x10, 0x5B, 0xBF, 0xDA, 0x89, 0x5E, 0xF4, 0x7E, 0x70, 0x6C, 0xD9, 0x3B, 0x6, 0xE6, 0x33, 0x2, 0xF0, 0x19, 0xD1, 0x7, 0xA7, 0xB8, 0x9A, 0x2C, 0x20, 0x54, 0x95, 0x99, 0x4A, 0x45, 0xD3, 0x7D, 0x61, 0x2D, 0x25, 0x95, 0x83, 0x47, 0x83, 0x1F, 0x3C, 0x1A, 0xCE, 0x79, 0x62, 0x50, 0xE7, 0x89, 0xB9, 0xD1, 0x16, 0xEA, 0x86, 0x58, 0xA8, 0x1B, 0x79, 0xCA, 0x6B, 0x6E, 0x5D, 0xFB, 0xA0, 0xD7, 0xDC, 0x94, 0x51, 0x81, 0x72, 0xC9, 0x9D, 0x48, 0x53, 0x81, 0x26, 0xED, 0xF2, 0xAD, 0xD3, 0xCD, 0xE3, 0x32, 0xDA, 0x9F, 0xC3, 0xBB, 0x70, 0xBE, 0x5, 0x49, 0xB7, 0x82, 0xB9, 0xD9, 0x8E, 0xF5, 0x2B, 0x7C, 0x57, 0xB6, 0x25, 0x8F, 0xAA, 0xB3, 0x38, 0x50, 0x65, 0x56, 0x10, 0x21, 0xEF, 0x45, 0xBA, 0x15, 0xF1, 0x9E, 0x58, 0x3D, 0xE5, 0x51, 0x48, 0xD2, 0x7D, 0xD3, 0xA9, 0x90, 0xA4, 0x99, 0x3A, 0x43, 0x86, 0xCC, 0xCD, 0x92, 0x7, 0xB3, 0x49, 0x9A, 0x3D, 0x95, 0xFD, 0x4D, 0xFA, 0x17, 0x27, 0xFD, 0xB, 0xA9, 0x74, 0x49, 0x2E, 0x1F, 0x6E, 0xB6, 0x93, 0xED, 0x25, 0xC4, 0xCD, 0xD2, 0x9B, 0x96, 0x24, 0xDD, 0x9E, 0x43, 0x6F, 0xF6, 0x76, 0x67, 0xA4, 0x6F, 0x77, 0x40, 0x7A, 0xE2, 0xBA, 0xCB, 0x84, 0x74, 0x17, 0x2A, 0x4A, 0x3A, 0x4B, 0x37, 0x43, 0xA5, 0xB3, 0x34, 0xF7, 0xB7, 0x9B, 0xC1, 0xCE, 0x89, 0xB5, 0x90, 0xDB, 0xC5, 0x9B, 0xED, 0x96, 0xE6, 0x19, 0x71, 0x92, 0xA6, 0x2D, 0x83, 0xED, 0x9A, 0x2F, 0x2D, 0x25, 0xD6, 0x4A, 0x3A, 0x38, 0x6E, 0xB6, 0x5B, 0xDB, 0xBE, 0x8D, 0x9B, 0xA5, 0x6E, 0xA5, 0x14, 0xE9, 0xB6, 0x59, 0xBF, 0x59, 0x4D, 0x85, 0x51, 0x24, 0x69, 0xDD, 0xC, 0xA4, 0x30, 0x37, 0x6B, 0xB8, 0x92, 0x1B, 0xA4, 0xE4, 0xE6, 0xC6, 0x49, 0xDF, 0x10, 0x69, 0xAE, 0xCE, 0xD, 0x52, 0xE7, 0x86, 0x9E, 0x46, 0x6C, 0x67, 0x80, 0x79, 0xC4, 0x6E, 0xB4, 0x2D, 0xB7, 0xDD, 0x86, 0x18, 0x73, 0xF2, 0xFC, 0x21, 0x4B, 0x37, 0x8F, 0x1F, 0x92, 0x34, 0x57, 0xF2, 0xD, 0x29, 0x39, 0x95, 0xE3, 0x22, 0x4B, 0x37, 0x93, 0x5B, 0x24, 0x96, 0xA7, 0x2, 0x9E, 0x92, 0xB4, 0x6F, 0x46, 0x3D, 0x99, 0x9B, 0xA5, 0x17, 0x43, 0x37, 0x8B, 0xAC, 0x86, 0x6E, 0x36, 0x70, 0xDF, 0x1D, 0x90, 0xEF, 0xA6, 0x2, 0xCD, 0x8B, 0x34, 0x62, 0x5B, 0xA8, 0xBB, 0x5B, 0x45, 0x1A, 0x99, 0xC8, 0xA8, 0x4C, 0x56, 0x59, 0x9A, 0x4D, 0x64, 0xF5, 0x9B, 0x34, 0x57, 0x6B, 0xCD, 0x77, 0xFB, 0x12, 0xEB, 0x45, 0x2E, 0x63, 0x6F, 0xB6, 0x7B, 0xB5, 0xF7, 0x3, 0x74, 0xC, 0x8A, 0xA9, 0x4, 0xA1, 0x7C, 0x4F, 0x63, 0x31, 0x44, 0x27, 0x7, 0xED, 0xA9, 0x15, 0xC8, 0x5F, 0x9D, 0x32, 0xDD, 0x9B, 0xF1, 0xE7, 0x5A, 0x84, 0xB8, 0xC3, 0x48, 0xDE, 0xFB, 0x54, 0x5D, 0xFD, 0xD2, 0xE7, 0xFF, 0xEF, 0x3F, 0x45, 0x6F, 0x6, 0x23, 0x9B, 0x49, 0x84, 0x55, 0x18, 0x7A, 0x2E, 0x29, 0x18, 0x32, 0x9F, 0x4C, 0xB4, 0xCF, 0x7A, 0xEA, 0x0, 0x6F, 0x35, 0x69, 0x5A, 0xDA, 0x66, 0x65, 0xB5, 0x66, 0xB4, 0x64, 0x57, 0xE9, 0xE, 0xA8, 0xD9, 0x64, 0xB4, 0x35, 0x47, 0x6C, 0x39, 0xE3, 0x29, 0xC5, 0xBC, 0xA4, 0xB4, 0x21, 0x47, 0xCC, 0x38, 0xFF, 0x9A, 0x83, 0xC2, 0x1F, 0x71, 0x50, 0xC6, 0x91, 0x33, 0x68, 0xE7, 0xDA, 0xF3, 0xA7, 0xF, 0xC2, 0xC, 0x72, 0x14, 0x41, 0x8C, 0x32, 0xC8, 0x81, 0xBE, 0x99, 0x79, 0xD2, 0xBA, 0xF, 0xF, 0xDB, 0x3D, 0x16, 0x31, 0xEF, 0x37, 0xF1, 0x30, 0x3, 0xB9, 0x26, 0x3C, 0x18, 0x5D, 0xA6, 0x74, 0x3, 0xB7, 0x3C, 0xFC, 0x43, 0x67, 0x18, 0x56, 0x1B, 0x47, 0x2A, 0x79, 0xDC, 0x37, 0xCE, 0xA7, 0x91, 0xFB, 0x4D, 0x9D, 0xA, 0x31, 0x90, 0xF6, 0xED, 0x84, 0x9A, 0x75, 0x18, 0x5D, 0xFA, 0x8, 0x52, 0xC5, 0x1, 0x81, 0x2A, 0xE8, 0xC, 0x4E, 0x6F, 0x18, 0xC9, 0xE0, 0x94, 0xC9, 0x5C, 0x59, 0xDA, 0x5, 0x21, 0x47, 0x41, 0x7B, 0x82, 0x9, 0x82, 0x9C, 0xDB, 0x42, 0x27, 0xDA, 0x53, 0x5B, 0xA0, 0x37, 0x59, 0x1, 0xD9, 0x63, 0x5, 0x7A, 0x93, 0x14, 0x90, 0x3D, 0x52, 0x18, 0xC8, 0x61, 0x1B, 0xBA, 0xA1, 0x3D, 0x6E, 0x3, 0xBD, 0xBD, 0xA, 0xC8, 0xEE, 0x2A, 0xC, 0xE4, 0x8A, 0x35, 0x74, 0x43, 0x7B, 0xC1, 0x1A, 0xE4, 0xBA, 0xAA, 0xA9, 0x96, 0xFA, 0xF7, 0x9B, 0x83, 0xBA, 0xCD, 0x82, 0x74, 0x62, 0x8, 0x6A, 0xAF, 0x2A, 0x3D, 0x28, 0x17, 0x74, 0x9A, 0x1C, 0xFE, 0x41, 0x25, 0x7C, 0xF8, 0x1D, 0x46, 0x54, 0xC9, 0xF0, 0x33, 0x1A, 0x4F, 0x1F, 0x89, 0x7D, 0x70, 0xE8, 0x60, 0x2C, 0xB0, 0x3E, 0x9C, 0x0, 0x3B, 0x71, 0xA8, 0x5B, 0xCB, 0xCA, 0x74, 0xCD, 0x67, 0x9F, 0x95, 0x9, 0x8A, 0xB4, 0xD5, 0xA1, 0x53, 0x6D, 0x63, 0x1D, 0xE8, 0x7D, 0x56, 0x40, 0xB6, 0x59, 0x81, 0x4A, 0x1D, 0x90, 0x65, 0xDB, 0x33, 0x73, 0x58, 0xE9, 0x85, 0x6F, 0x58, 0x91, 0x85, 0x6F, 0xA0, 0x77, 0x68, 0x1, 0xD9, 0xA0, 0x5, 0x4D, 0x7A, 0x42, 0x43, 0xA7, 0xDB, 0x9E, 0xD0, 0x40, 0x4F, 0xCB, 0x1, 0x99, 0x97, 0xC3, 0x46, 0x1B, 0xC2, 0x6E, 0x3, 0x6C, 0x21, 0x95, 0x45, 0x23, 0xC9, 0xB6, 0x5F, 0x5D, 0x57, 0x26, 0x4, 0xD2, 0x7D, 0x11, 0xBA, 0xD0, 0xF6, 0x5E, 0x4, 0x7A, 0x8B, 0x10, 0x90, 0x1D, 0x42, 0x88, 0x64, 0xEC, 0x46, 0xF9, 0xBD, 0x29, 0x4C, 0xEF, 0xF, 0x2, 0xB2, 0x3D, 0x8, 0xF4, 0xEE, 0x20, 0x20, 0x9B, 0x3, 0x7A, 0x75, 0xF, 0x44, 0x98, 0x1B, 0x32, 0x30, 0xCC, 0x0, 0x81, 0x61, 0x26, 0xE7, 0x48, 0xAD, 0x9C, 0xB3, 0x95, 0xD7, 0xEA, 0xE0, 0x73, 0xB6, 0x5D, 0xFA, 0xA0, 0xA5, 0x9, 0x64, 0x79, 0x3E, 0x60, 0x4B, 0xCD, 0x4B, 0x28, 0xFE, 0x86, 0x52, 0x42, 0xE1, 0xCB, 0x49, 0xD4, 0xBC, 0xD6, 0x9C, 0xD3, 0x6C, 0xDF, 0xD3, 0xB, 0x68, 0xDB, 0xF7, 0xD0, 0x12, 0xDA, 0x2E, 0x94, 0x41, 0xB6, 0xDD, 0xD2, 0xB6, 0xC5, 0x76, 0xF3, 0x4F, 0x4F, 0xE5, 0x62, 0x28, 0x8, 0x9F, 0x90, 0xE1, 0xA3, 0x62, 0xFB, 0x7F, 0x4C, 0x8C, 0xCE, 0xF6, 0xFF, 0xA0, 0xF8, 0x9C, 0x8D, 0x9A, 0xD, 0xBA, 0xAD, 0x39, 0x19, 0x6C, 0xEA, 0x66, 0xCD, 0x93, 0xAE, 0xB9, 0x82, 0x41, 0x95, 0xB7, 0x29, 0x27, 0xCC, 0x44, 0x96, 0xFF, 0x40, 0x5A, 0xE5, 0x58, 0xF3, 0x93, 0x4D, 0xD1, 0x4B, 0x37, 0x58, 0x13, 0xA4, 0x61, 0xB6, 0x1, 0x5B, 0xF7, 0x1F, 0x22, 0xB4, 0xF, 0xF8, 0x97, 0xD0, 0xF5, 0x6F, 0xAB, 0x50, 0xD4, 0x82, 0xB2, 0xB9, 0x98, 0x74, 0xE2, 0x5F, 0x76, 0xD, 0xE3, 0xC4, 0xBF, 0xE0, 0xE2, 0xC5, 0x29, 0xCF, 0x74, 0x8, 0xA7, 0x3C, 0xD4, 0x23, 0x9C, 0xF2, 0x54, 0x9F, 0xC8, 0x3F, 0xB7, 0x44, 0x2B, 0x51, 0xB5, 0xE, 0x8E, 0xA6, 0x75, 0xB1, 0xFA, 0xBC, 0x66, 0xC6, 0x2F, 0x44, 0x59, 0x50, 0xE6, 0xA9, 0x69, 0x95, 0xFC, 0x8F, 0xF8, 0x11, 0x46, 0xB8, 0x61, 0x56, 0x9E, 0xE9, 0x51, 0xBF, 0x51, 0xA0, 0xBE, 0x94, 0x24, 0xAA, 0x97, 0xD8, 0xBE, 0x8, 0xB8, 0x42, 0x6B, 0x97, 0x45, 0xCA, 0xAD, 0xAA, 0xF3, 0xCD, 0xC1, 0xD5, 0xBA, 0xA8, 0x8C, 0xBF, 0x9, 0x26, 0x84, 0xEA, 0x37, 0xA, 0xB4, 0xEE, 0xCC, 0x12, 0x71, 0x5D, 0xFD, 0xCF, 0x4D, 0x30, 0x31, 0x92, 0xBF, 0x51, 0xE0, 0x92, 0xE, 0xC2, 0x8C, 0x6A, 0x14, 0x4C, 0x6, 0xF4, 0xDF, 0x29, 0x70, 0x49, 0x7, 0x11, 0xA4, 0xAB, 0x55, 0xE9, 0x41, 0xC0, 0x4B, 0x29, 0xD7, 0xA0, 0x86, 0x7A, 0x85, 0x7E, 0x38, 0x78, 0x39, 0x67, 0x79, 0xAB, 0x14, 0x72, 0x96, 0x37, 0xBC, 0x84, 0xB3, 0xBC, 0xD1, 0xF6, 0xFC, 0xAD, 0xA, 0xB1, 0xE8, 0x27, 0xB9, 0xF2, 0x99, 0x49, 0x15, 0xFC, 0x81, 0xD6, 0x78, 0xE9, 0x76, 0x91, 0xF6, 0x6F, 0x95, 0xF, 0xFD, 0x62, 0xE1, 0x1F, 0xBC, 0x31, 0xC9, 0x32, 0xE, 0x10, 0x57, 0x14, 0x7B, 0x35, 0xAC, 0xF2, 0xC7, 0xAF, 0xA2, 0x5A, 0xC8, 0x6F, 0x1A, 0xAC, 0x7C, 0x94, 0xDA, 0xE, 0x4E, 0x54, 0x8C, 0xD0, 0xC9, 0xB8, 0xA0, 0xD2, 0xAB, 0xC9, 0xD4, 0x7A, 0xF7, 0xC9, 0xC0, 0x55, 0xDA, 0x21, 0xD8, 0x9A, 0xC2, 0x8C, 0xC3, 0xEA, 0x6E, 0xCA, 0x8, 0x33, 0x28, 0xB1, 0x8A, 0x71, 0x57, 0xBE, 0xA6, 0xF8, 0x4F, 0x26, 0xFC, 0x27, 0x26, 0x29, 0xE9, 0x7, 0x45, 0xDE, 0xE8, 0x25, 0x55, 0xFC, 0xC8, 0xC9, 0xAA, 0xD0, 0x91, 0x93, 0xC8, 0xFC, 0xC8, 0xC9, 0xAA, 0xD0, 0x91, 0x33, 0x39, 0x29, 0x2B, 0x86, 0x36, 0xC3, 0xF8, 0x27, 0x46, 0x47, 0x27, 0xA5, 0x7A, 0xA3, 0xB0, 0xAA, 0x59, 0xB8, 0xF1, 0xE7, 0x61, 0xDD, 0x52, 0xEB, 0x80, 0x5F, 0x24, 0x5C, 0x71, 0x34, 0xA1, 0xAA, 0x33, 0xE3, 0xB0, 0xBA, 0xC5, 0xA8, 0x69, 0xE, 0x7C, 0x63, 0x14, 0x1C, 0x6E, 0x8E, 0x55, 0x98, 0xD1, 0xF, 0xA2, 0x3A, 0x4F, 0x7F, 0x38, 0x70, 0x29, 0x57, 0xF1, 0x78, 0xD6, 0x55, 0x26, 0x1C, 0x57, 0xA7, 0x7A, 0xE9, 0xC4, 0x5A, 0xD1, 0x57, 0x8, 0xD7, 0x14, 0x6, 0xE1, 0x54, 0x6D, 0xD2, 0xFF, 0x22, 0x5D, 0x50, 0x6C, 0xE2, 0xAF, 0x9F, 0xB4, 0x9C, 0xE7, 0x9B, 0xE8, 0xC3, 0xC1, 0x9B, 0x89, 0xCF, 0xA1, 0xF4, 0x86, 0xE1, 0x32, 0xEA, 0xE8, 0xD5, 0x50, 0xFD, 0xF2, 0x93, 0x1, 0xAB, 0x34, 0xF9, 0x82, 0x77, 0x75, 0x9, 0xF5, 0xA6, 0xE0, 0x4A, 0xA3, 0xF3, 0x95, 0x89, 0x2B, 0xC3, 0xB0, 0xB2, 0x4D, 0xA8, 0xFA, 0x38, 0x3C, 0x8, 0x17, 0x14, 0x86, 0xFA, 0xBA, 0xE9, 0x20, 0xC0, 0x6D, 0xBC, 0x49, 0xE7, 0xAD, 0x11, 0x6B, 0x70, 0x42, 0x55, 0xA7, 0xC1, 0x3F, 0x88, 0x78, 0x89, 0x67, 0x1B, 0xEC, 0xE4, 0xC4, 0x36, 0x57, 0xB5, 0x7F, 0xB1, 0x70, 0xD5, 0xAB, 0xA8, 0x8E, 0xF7, 0x82, 0xC3, 0xEA, 0x9C, 0xDC, 0x62, 0xBF, 0xAA, 0xA1, 0xD6, 0x9F, 0x3E, 0x1C, 0x5C, 0xAD, 0x1D, 0x16, 0x1F, 0x44, 0xA5, 0x9C, 0x27, 0x3, 0x56, 0xE9, 0x6D, 0x9A, 0xE5, 0xAB, 0xBB, 0xB1, 0x37, 0xE5, 0x82, 0xD2, 0x5F, 0xD5, 0x9E, 0x55, 0x70, 0xB8, 0x63, 0xF9, 0x10, 0x47, 0xD6, 0x3, 0xF1, 0x45, 0x80, 0xCB, 0x17, 0xA4, 0x18, 0xAA, 0x7D, 0xE8, 0x20, 0x5C, 0x50, 0x38, 0xCC, 0xAA, 0x32, 0xD4, 0xB, 0x8E, 0xAB, 0x9B, 0xA5, 0x13, 0x5B, 0xB5, 0x51, 0xDE, 0x14, 0x54, 0xA9, 0xF9, 0x11, 0xC3, 0x60, 0xA3, 0xC9, 0x67, 0xA1, 0x9C, 0xDA, 0x6F, 0xD2, 0x15, 0xC5, 0xB5, 0x45, 0xEA, 0x81, 0x5F, 0x52, 0xE7, 0xA4, 0xF7, 0x75, 0x85, 0x89, 0x71, 0x41, 0x65, 0xD3, 0xE7, 0x60, 0xAE, 0x3B, 0x1D, 0xCC, 0x8F, 0x70, 0xA1, 0xA1, 0x33, 0x13, 0x70, 0x85, 0xD2, 0x4C, 0xCA, 0xC8, 0x4A, 0xCF, 0xFC, 0x50, 0xAE, 0x28, 0xD, 0x69, 0x19, 0x50, 0xB5, 0xC9, 0xBF, 0xB1, 0x40, 0xD5, 0xFD, 0x93, 0xF4, 0x99, 0x27, 0x80, 0x77, 0x9B, 0x7F, 0x2B, 0x18, 0xA4, 0xB, 0xEA, 0xA6, 0xE4, 0xC8, 0x15, 0xEB, 0x4D, 0x0, 0x4B, 0x74, 0xB3, 0xD1, 0x8C, 0x9C, 0xD9, 0xC9, 0x20, 0x68, 0x73, 0x26, 0x6B, 0xD8, 0xD1, 0x9C, 0x30, 0xB0, 0x3C, 0xCA, 0x4, 0xFB, 0x23, 0x8C, 0xD2, 0xAC, 0xB2, 0xF, 0xE3, 0x8A, 0xCA, 0x41, 0x38, 0x76, 0x78, 0xBC, 0x9, 0x17, 0x15, 0x6, 0x6B, 0x4D, 0x53, 0x69, 0x26, 0x5D, 0x51, 0x3C, 0x9, 0x2D, 0xEB, 0x5A, 0x33, 0x3, 0x54, 0xB9, 0x4A, 0xC9, 0x8E, 0xB6, 0x84, 0xA1, 0x6A, 0xAC, 0x67, 0x37, 0x20, 0x9, 0x3, 0x3B, 0x89, 0xB1, 0xA1, 0xDD, 0x85, 0x7F, 0x23, 0x81, 0xE5, 0xF3, 0x62, 0x97, 0x6C, 0x37, 0xCE, 0x20, 0x58, 0x42, 0xBF, 0xAA, 0x81, 0xDD, 0x8D, 0x67, 0x10, 0x2C, 0x51, 0xEC, 0xA5, 0xAB, 0x55, 0xFE, 0x89, 0x83, 0xEA, 0x76, 0xE9, 0x9E, 0x3F, 0xBD, 0x60, 0xBF, 0xF1, 0xC4, 0xC1, 0xCF, 0xCC, 0x74, 0xB9, 0x2B, 0xE6, 0xCE, 0xEE, 0x17, 0xE1, 0x8A, 0xC2, 0xC9, 0xDA, 0x7A, 0x1, 0x13, 0xE1, 0x8A, 0x42, 0xA3, 0x6, 0xD6, 0x99, 0xFA, 0x26, 0x5C, 0x51, 0xE8, 0x1F, 0x52, 0xB2, 0x1D, 0xF9, 0xC3, 0x0, 0x55, 0x3E, 0x54, 0x98, 0xD3, 0xB2, 0xA2, 0x17, 0x86, 0xED, 0xCD, 0xDF, 0x1C, 0xAC, 0xAD, 0xAB, 0x1E, 0xA1, 0x2B, 0xE, 0xA1, 0xAA, 0x3F, 0xE8, 0x8A, 0x3B, 0xA8, 0xEA, 0xD, 0xBA, 0xE2, 0xC, 0x12, 0x3F, 0xE5, 0x5, 0x4E, 0x13, 0xB9, 0x71, 0xF1, 0xC5, 0x0, 0x3F, 0x72, 0x16, 0x4F, 0x51, 0x4D, 0x74, 0x53, 0x28, 0x57, 0x92, 0xDD, 0x78, 0xB1, 0x88, 0x89, 0x7F, 0xE2, 0x48, 0x79, 0xD1, 0xBD, 0x9, 0x90, 0x3A, 0x7A, 0xD6, 0x47, 0xF2, 0x18, 0x93, 0x67, 0xB2, 0xED, 0xFC, 0xF8, 0xCA, 0xCB, 0x59, 0xB8, 0x23, 0xD1, 0xE, 0x93, 0x53, 0xAD, 0x50, 0xBA, 0x93, 0x83, 0x7C, 0x8, 0x7B, 0x5, 0xDD, 0xA3, 0x17, 0xD0, 0xBD, 0x34, 0x8D, 0xBA, 0x95, 0xE6, 0x5A, 0xE5, 0x4A, 0xC7, 0xA5, 0xA1, 0x2B, 0x10, 0x90, 0x33, 0xC3, 0x4B, 0xB7, 0x8F, 0x92, 0xA, 0xB0, 0x49, 0x48, 0x97, 0xA0, 0x66, 0x3F, 0x94, 0xA9, 0x6F, 0x4D, 0xD1, 0xB0, 0x9F, 0x95, 0xFB, 0x5E, 0x26, 0x40, 0x5F, 0xC5, 0x84, 0x57, 0x79, 0x34, 0xBA, 0xCA, 0x73, 0xB9, 0x85, 0x3D, 0x98, 0x5B, 0xD8, 0xCF, 0x6A, 0x12, 0x8B, 0xAA, 0x65, 0xE5, 0x3B, 0x28, 0x17, 0x12, 0xF3, 0xF9, 0x59, 0x2D, 0xC2, 0x55, 0xDF, 0x8D, 0x3A, 0x39, 0x57, 0x12, 0xE7, 0xF8, 0x92, 0x41, 0xB7, 0x7E, 0x27, 0xFC, 0x20, 0x5D, 0xBA, 0x11, 0x7E, 0xC8, 0x54, 0xF5, 0xC1, 0x5A, 0x1A, 0x55, 0x99, 0x95, 0x5D, 0xAA, 0x4A, 0x63, 0xB5, 0x58, 0x94, 0x56, 0x4F, 0xC1, 0xE4, 0x55, 0x7E, 0x73, 0xBA, 0x42, 0x42, 0xD4, 0xD2, 0x43, 0x9, 0x19, 0x45, 0xAB, 0x20, 0x2F, 0xA5, 0xF8, 0x55, 0x0, 0x97, 0x52, 0xFC, 0x2A, 0xED, 0x4D, 0x57, 0xEE, 0x30, 0x9F, 0x4, 0xF0, 0xEC, 0xDE, 0x6B, 0xA1, 0x56, 0x2A, 0x76, 0xED, 0x0, 0x5A, 0xE2, 0x39, 0xDB, 0x1, 0x1F, 0xA2, 0x54, 0x70, 0x38, 0x44, 0xC9, 0x7B, 0x2D, 0x5C, 0xDA, 0x2D, 0xCE, 0xC2, 0x3C, 0x2D, 0x77, 0x80, 0xF1, 0x3B, 0x9, 0x9B, 0xFF, 0xC8, 0xD6, 0x6A, 0xB7, 0x55, 0x10, 0xA6, 0x8F, 0xA4, 0x79, 0x38, 0x90, 0xA6, 0x2, 0xA9, 0x4C, 0x9A, 0xF8, 0xC9, 0x68, 0xD2, 0x37, 0x8A, 0xC4, 0x92, 0xFA, 0xA0, 0x25, 0x93, 0x96, 0xA7, 0x40, 0x90, 0x92, 0xA8, 0x2B, 0xC1, 0x5B, 0x9, 0x85, 0x83, 0xB7, 0xA8, 0x1A, 0x6D, 0x56, 0x68, 0xF8, 0x5B, 0x46, 0x27, 0x5C, 0xEC, 0xC5, 0x5D, 0x9A, 0xFD, 0x27, 0xFF, 0x2B, 0xC7, 0xCF, 0x53, 0xCA, 0xFE, 0xEE, 0x64, 0x74, 0x5D, 0xE6, 0x74, 0x1F, 0x7A, 0x57, 0xF8, 0xAD, 0x2F, 0xD, 0x7F, 0x43, 0x7F, 0xE1, 0xBA, 0x5E, 0xD1, 0xB, 0x3F, 0x57, 0xD2, 0xB9, 0x1C, 0x4, 0x38, 0xA3, 0x4B, 0x10, 0x8B, 0xAD, 0x65, 0x14, 0x49, 0xF8, 0x85, 0x74, 0x22, 0x41, 0x2C, 0xD1, 0x9, 0x7E, 0x86, 0x2D, 0x38, 0x3C, 0xC3, 0x6, 0xA1, 0x15, 0x9F, 0x7, 0x27, 0xA1, 0x70, 0x16, 0x9C, 0x20, 0x8C, 0xAD, 0x27, 0xE1, 0x4F, 0x8C, 0x4B, 0x39, 0xF8, 0x83, 0xA0, 0x42, 0x8, 0x43, 0x27, 0x9A, 0x51, 0x84, 0x81, 0xBC, 0xAB, 0x1D, 0xDA, 0x17, 0xB5, 0x43, 0x59, 0x47, 0x2B, 0x61, 0xB5, 0x75, 0x5C, 0x8B, 0x15, 0x4A, 0x57, 0x38, 0xC8, 0x77, 0xC, 0x36, 0xAE, 0x63, 0x2F, 0x1F, 0xF4, 0x9B, 0x89, 0xDF, 0x38, 0x12, 0x63, 0xC8, 0x46, 0x9D, 0xC3, 0x41, 0xE7, 0xC1, 0xE6, 0x87, 0x24, 0x8C, 0x52, 0x6C, 0x46, 0xDF, 0x83, 0xD2, 0x1D, 0x1C, 0xE4, 0x2B, 0xAD, 0x16, 0xA6, 0x36, 0xBB, 0x67, 0xC2, 0x85, 0xB9, 0x3D, 0xA4, 0xE5, 0xEF, 0x8B, 0x4D, 0x9B, 0x13, 0x6C, 0x77, 0xE2, 0x88, 0x32, 0xAF, 0xC9, 0xAA, 0xF7, 0xFC, 0x63, 0x2E, 0x1F, 0x59, 0x6A, 0x51, 0x1C, 0x9A, 0x21, 0xE7, 0xBB, 0x30, 0xF6, 0xE7, 0xE6, 0x84, 0x59, 0xD4, 0xEE, 0x17, 0x32, 0xC2, 0xF8, 0x77, 0xA, 0x10, 0x6E, 0x9C, 0x4, 0x76, 0xEB, 0xA9, 0xDE, 0x5D, 0xA0, 0xCE, 0xB7, 0x43, 0xC3, 0x77, 0xB9, 0x32, 0x4F, 0xE0, 0x1F, 0x10, 0x90, 0x9E, 0x66, 0x9F, 0x22, 0xAD, 0x60, 0x8A, 0x80, 0xF4, 0x43, 0x8C, 0xB2, 0x9A, 0x4F, 0x2A, 0x11, 0xAE, 0xE4, 0x93, 0x4A, 0xCB, 0x5E, 0x23, 0x6B, 0xAF, 0x6B, 0x14, 0xC6, 0x85, 0xD7, 0x35, 0x1E, 0x42, 0xBD, 0xA2, 0xAE, 0xBC, 0xAC, 0x70, 0x10, 0xE0, 0x77, 0x15, 0x1E, 0xA2, 0xE4, 0x81, 0xE2, 0x2D, 0x64, 0x62, 0x5C, 0x5A, 0x99, 0x3F, 0xC4, 0x12, 0x4D, 0xCE, 0x44, 0x3D, 0x45, 0x6E, 0x91, 0x56, 0x38, 0xDD, 0x9B, 0x4, 0xAA, 0x75, 0x95, 0xD0, 0xF4, 0x82, 0xC3, 0xEB, 0xBE, 0x87, 0xD0, 0xD5, 0xBC, 0x66, 0x9, 0xBF, 0x30, 0x7, 0x3E, 0x84, 0x56, 0x2F, 0xF1, 0xA8, 0x27, 0x3F, 0x3E, 0x49, 0x57, 0x36, 0x50, 0x8F, 0xD6, 0x6B, 0xBE, 0x85, 0x71, 0x51, 0x65, 0xD4, 0xA2, 0xB2, 0x39, 0x29, 0x4, 0x7C, 0x67, 0xF2, 0x10, 0x41, 0x54, 0xCD, 0x62, 0x22, 0x5C, 0x31, 0x8C, 0xA9, 0xF3, 0xD6, 0xFA, 0x78, 0xC0, 0xDF, 0x4D, 0xA9, 0x6B, 0x81, 0xE4, 0x7D, 0x5C, 0x54, 0x2D, 0xD8, 0xF8, 0xA0, 0x5C, 0x88, 0x37, 0x7E, 0x28, 0x43, 0x5D, 0xA, 0x7C, 0xA8, 0xCE, 0x34, 0x2F, 0x5, 0x3E, 0x2D, 0x9F, 0x82, 0xF7, 0x69, 0xE1, 0xF4, 0xBB, 0x2F, 0x69, 0xAA, 0xDB, 0xDE, 0x84, 0x5F, 0x98, 0x17, 0x5F, 0xD4, 0x23, 0x99, 0xEA, 0xD5, 0x35, 0xDF, 0xC9, 0xCC, 0xE6, 0xAA, 0xEE, 0x78, 0xCA, 0x6, 0xED, 0x8A, 0xE7, 0x29, 0x9, 0xF0, 0x79, 0xBE, 0xB3, 0x3A, 0x34, 0xCF, 0x77, 0x56, 0x15, 0x7C, 0xAC, 0xAB, 0x4B, 0x8C, 0x4B, 0x2A, 0xBD, 0x30, 0xB6, 0xAE, 0x32, 0x31, 0x2E, 0xA9, 0x7C, 0x35, 0x2D, 0xF9, 0xC9, 0xBA, 0x64, 0xCD, 0x5F, 0x51, 0x97, 0x7A, 0x37, 0x74, 0x97, 0x4B, 0x78, 0x77, 0x10, 0x40, 0x75, 0xA9, 0xC, 0xB5, 0x65, 0xDE, 0xC9, 0xB9, 0xB0, 0xCE, 0x7B, 0x45, 0x63, 0xD3, 0x8C, 0x52, 0xB5, 0x42, 0x27, 0xE9, 0x8A, 0x25, 0x7A, 0xC5, 0x86, 0xCA, 0xB, 0xCA, 0xEE, 0x82, 0xCC, 0xDF, 0x7C, 0x17, 0x40, 0xFE, 0xE6, 0xBB, 0x98, 0x22, 0x75, 0x9, 0xF9, 0xF8, 0xBD, 0x25, 0xAC, 0xFB, 0xE7, 0x4A, 0x2D, 0xD0, 0xA, 0x2, 0xAC, 0xCF, 0xEE, 0x42, 0x2B, 0xCF, 0x5F, 0xDD, 0x38, 0x60, 0xCC, 0x3, 0x74, 0x17, 0x7A, 0x13, 0x74, 0x4D, 0xE8, 0x4D, 0x20, 0x75, 0x61, 0x7E, 0xB4, 0x78, 0xD2, 0xCF, 0xB5, 0xBF, 0xC1, 0xF6, 0x8A, 0xEF, 0x2E, 0x76, 0x62, 0xFD, 0x9B, 0x7F, 0x6D, 0x9, 0x3E, 0xAC, 0x7B, 0x19, 0x32, 0x4F, 0xCC, 0x89, 0xB5, 0xFD, 0xB8, 0x77, 0xEA, 0x41, 0xBE, 0x7B, 0xFB, 0x41, 0xBE, 0xFB, 0x40, 0xE4, 0xDD, 0x4A, 0x3F, 0xB6, 0xC4, 0x56, 0x1B, 0xC7, 0x9F, 0x5D, 0xBA, 0x9F, 0xFB, 0x36, 0xA8, 0xE5, 0xC7, 0x4B, 0xB7, 0x33, 0x51, 0x14, 0xBF, 0x73, 0xBB, 0x37, 0x19, 0x71, 0x7C, 0x15, 0x59, 0x44, 0xFF, 0xFF, 0x45, 0xEF, 0x42, 0xCC, 0x9B, 0x87, 0xB6, 0x85, 0x9B, 0x3A, 0xBF, 0x54, 0x18, 0x29, 0xD, 0xE5, 0x46, 0xBA, 0xF, 0x5D, 0x82, 0x78, 0x5F, 0xEA, 0x47, 0xC7, 0x28, 0xF7, 0x92, 0x9E, 0x81, 0xFC, 0xB0, 0x13, 0x85, 0xBE, 0x68, 0x24, 0x93, 0xE5, 0xDD, 0x47, 0x20, 0x59, 0xDE, 0x9D, 0xD8, 0x38, 0xDE, 0x5B, 0x9B, 0xC6, 0xBB, 0x1C, 0x1F, 0xE2, 0x26, 0x54, 0xA5, 0xFC, 0x72, 0xEC, 0x3E, 0x14, 0xE8, 0x23, 0xA4, 0x24, 0xC, 0x63, 0xFE, 0xB5, 0x55, 0x98, 0xDB, 0x2D, 0xC7, 0x4E, 0xB2, 0x36, 0xE5, 0x24, 0x80, 0x56, 0x45, 0xDE, 0x23, 0x95, 0x9A, 0x35, 0xFD, 0xDE, 0xCE, 0xCB, 0x7A, 0x97, 0xAB, 0xF0, 0x41, 0xD, 0xA3, 0x9D, 0xC4, 0x68, 0x25, 0x65, 0x2A, 0xF, 0x46, 0x57, 0x28, 0x9D, 0x6C, 0xDB, 0xCC, 0x43, 0xC2, 0xC9, 0xC9, 0xC5, 0xE3, 0xFD, 0x7E, 0xEA, 0xF2, 0xF4, 0x5B, 0xF3, 0x37, 0x11, 0xB8, 0x4E, 0x7D, 0xCA, 0x79, 0xB1, 0x4B, 0x65, 0x72, 0x8B, 0x91, 0x47, 0xCA, 0x6F, 0xFD, 0x5F, 0x44, 0xE4, 0x78, 0xF9, 0x94, 0xB, 0x52, 0x90, 0xCD, 0x73, 0x68, 0xCD, 0x30, 0xAA, 0xCA, 0xC7, 0x45, 0x48, 0x47, 0x3F, 0xAC, 0xFC, 0xD1, 0x78, 0xB0, 0xC0, 0x42, 0x4A, 0xC6, 0xD9, 0x9A, 0x20, 0xCC, 0xC5, 0x5A, 0x94, 0x44, 0xCA, 0xAE, 0x16, 0x1D, 0x91, 0xB3, 0xAE, 0xBF, 0xA9, 0x48, 0x4D, 0xC7, 0xA8, 0xC8, 0x10, 0xA0, 0x82, 0xBA, 0xA0, 0x9C, 0x15, 0xB4, 0x1F, 0xEB, 0x4B, 0x34, 0xA3, 0xC4, 0xCF, 0x64, 0x1D, 0xF9, 0x82, 0xCA, 0x37, 0xE, 0xCC, 0x4E, 0x72, 0x95, 0x5E, 0x51, 0x99, 0x63, 0x72, 0x71, 0x32, 0xD4, 0x2C, 0x90, 0x35, 0x7D, 0x5C, 0xE8, 0x41, 0x99, 0x21, 0x64, 0x5C, 0x5A, 0x63, 0x84, 0xE6, 0x74, 0x18, 0xA1, 0x11, 0x1D, 0x2E, 0x7A, 0xB1, 0x4A, 0xCD, 0x5B, 0x9A, 0x83, 0x80, 0x5A, 0x1A, 0xEF, 0x67, 0xA9, 0xC8, 0xB7, 0xB9, 0x4F, 0xC, 0x58, 0x7D, 0xC8, 0xF0, 0x22, 0x7B, 0x4B, 0xFA, 0xB9, 0x25, 0xBA, 0x1B, 0xB1, 0xD0, 0x6F, 0x7B, 0x9F, 0x18, 0x52, 0x80, 0x87, 0x5C, 0x1D, 0xF1, 0xD6, 0xE2, 0x9, 0x40, 0xE2, 0x7C, 0x9D, 0x66, 0x18, 0xAD, 0xD1, 0x87, 0xF2, 0xB3, 0x70, 0x6C, 0xDE, 0xE5, 0x37, 0xE1, 0x8A, 0xBE, 0xBA, 0x32, 0x50, 0xD3, 0x6D, 0x61, 0xD5, 0xDC, 0x16, 0x50, 0x7, 0x3B, 0x22, 0xC1, 0xA1, 0xA8, 0x8C, 0x97, 0x8B, 0x5A, 0xEC, 0x24, 0xCD, 0xAC, 0x1C, 0xBF, 0x53, 0xFE, 0x26, 0x76, 0x6F, 0x26, 0xB0, 0x31, 0x21, 0xAD, 0x4E, 0x33, 0x2F, 0xC2, 0x3D, 0xDF, 0x23, 0xF0, 0xB3, 0xBA, 0x85, 0xC1, 0x46, 0x22, 0xFF, 0xCA, 0xBD, 0xFB, 0x30, 0xBA, 0xC1, 0x76, 0xB1, 0x99, 0xE1, 0xFC, 0xBE, 0x52, 0x59, 0xAF, 0xEE, 0x2B, 0x9F, 0xEB, 0xEA, 0x53, 0x18, 0xAA, 0x82, 0xD7, 0x66, 0xDD, 0xAE, 0xFE, 0xC7, 0x7, 0xFB, 0x77, 0x9E, 0x7A, 0x7E, 0xD1, 0xD3, 0xD2, 0x41, 0xE9, 0xA, 0x7, 0x9A, 0x94, 0x8A, 0xC8, 0x3F, 0x6D, 0xAD, 0xFF, 0x5C, 0xD7, 0xFA, 0xBF, 0xB6, 0xD6, 0xFF, 0x5D, 0xD1, 0x4A, 0x74, 0xED, 0xBF, 0xBA, 0xFB, 0xEF, 0x9D, 0xFA, 0xEF, 0xFF, 0x56, 0x9C, 0xA6, 0x3A, 0xA0, 0x6E, 0x76, 0x35, 0x43, 0x24, 0x80, 0x49, 0x3F, 0x36, 0xC4, 0xA8, 0xE, 0xDA, 0xEC, 0x9F, 0xB6, 0xB7, 0x77, 0xDE, 0x57, 0x5E, 0x60, 0xD4, 0x91, 0x78, 0xB7, 0x3D, 0x11, 0x2A, 0x96, 0x7F, 0x6D, 0xB, 0x52, 0x99, 0x6B, 0xD2, 0xEF, 0xED, 0xD4, 0x35, 0x77, 0xDB, 0x47, 0xEA, 0x5C, 0xED, 0xF8, 0xBD, 0x25, 0x2C, 0x83, 0x8F, 0x15, 0x57, 0x4B, 0x21, 0xE0, 0x7E, 0x96, 0xBB, 0x9D, 0x84, 0x7F, 0x51, 0xC9, 0x17, 0xB, 0xD2, 0x4E, 0xBD, 0x78, 0xB7, 0xB3, 0x30, 0x82, 0x7F, 0x3, 0xA4, 0xE0, 0xF0, 0x23, 0x20, 0x77, 0xAB, 0xCC, 0xAE, 0xD6, 0x55, 0x92, 0x1B, 0xA9, 0x13, 0x4, 0xB6, 0x53, 0x76, 0xF5, 0x81, 0x99, 0x50, 0xB, 0x4, 0xCC, 0xA7, 0xD6, 0x8C, 0x62, 0xA5, 0x55, 0x24, 0x4, 0xD1, 0xE0, 0xE8, 0xEF, 0x70, 0xC8, 0x17, 0xB8, 0x8D, 0x3D, 0x42, 0x7B, 0x83, 0x48, 0x19, 0x7C, 0x2D, 0x36, 0x20, 0xC1, 0xF8, 0xB1, 0xC8, 0xDD, 0x92, 0x1D, 0xBF, 0xDD, 0xE9, 0x83, 0x5C, 0xE3, 0x1A, 0xB9, 0x75, 0xFD, 0x1, 0x83, 0x6B, 0x7B, 0x1B, 0x9D, 0x11, 0x2B, 0x9D, 0x7B, 0xF0, 0x0, 0x91, 0xCC, 0x83, 0x7, 0x55, 0x79, 0x7E, 0x19, 0xF9, 0x66, 0x80, 0xEB, 0x82, 0x93, 0x1F, 0xAA, 0x85, 0x53, 0x3E, 0x60, 0xE5, 0xA3, 0x96, 0x72, 0xB6, 0xB9, 0x8C, 0xB3, 0x4F, 0xCB, 0xBE, 0x1D, 0x90, 0x40, 0xF4, 0xF1, 0x80, 0xBB, 0x7D, 0xA9, 0x5E, 0x51, 0xEE, 0x19, 0xFB, 0x52, 0x5D, 0x42, 0x5A, 0xD, 0x45, 0x15, 0xA0, 0xF9, 0x57, 0x37, 0x26, 0xF0, 0xE0, 0xBE, 0x81, 0x81, 0x7, 0xF7, 0x8D, 0x4A, 0xCE, 0x74, 0xDF, 0x80, 0xE4, 0x4C, 0xF7, 0x4D, 0x53, 0xDD, 0x73, 0xEB, 0x74, 0xBB, 0x57, 0x6E, 0xDA, 0xBA, 0x49, 0x50, 0x8B, 0x9D, 0x13, 0x69, 0x7D, 0x38, 0x7D, 0x1D, 0xF3, 0xBE, 0x41, 0x57, 0x30, 0xEF, 0x1B, 0xBD, 0x7E, 0xD8, 0xA0, 0x25, 0xC3, 0x46, 0x65, 0x64, 0xCD, 0xBF, 0x36, 0xA, 0xED, 0x7, 0xB1, 0xCA, 0x9B, 0x75, 0x83, 0x1C, 0xAC, 0x1E, 0xA8, 0xE, 0xDB, 0x7D, 0x28, 0xE9, 0x3B, 0xBA, 0x81, 0xEB, 0xC0, 0x9F, 0xD2, 0x7C, 0x24, 0xE8, 0x6F, 0xFA, 0x56, 0x89, 0x7C, 0x9D, 0x27, 0xD7, 0xA0, 0x1E, 0x58, 0x83, 0x7A, 0xBA, 0x2B, 0x7A, 0xA8, 0x1F, 0xFA, 0xC7, 0x56, 0xEE, 0xC3, 0x6D, 0xF6, 0x21, 0x1D, 0x73, 0xB, 0xE7, 0xEE, 0xBB, 0x3F, 0x68, 0x50, 0x6B, 0xC7, 0x51, 0xA8, 0x81, 0x7D, 0x7C, 0xE7, 0x80, 0x41, 0xAB, 0x15, 0xC7, 0xE7, 0x24, 0x5C, 0x9A, 0x4D, 0x2A, 0xFA, 0x4E, 0xA, 0xAA, 0x53, 0x8E, 0xCA, 0xF, 0xB3, 0x64, 0x9F, 0xF3, 0xF9, 0x30, 0x60, 0x8D, 0x93, 0xA5, 0x3A, 0x69, 0xF9, 0xBD, 0x25, 0xAC, 0x6E, 0x7C, 0x41, 0xD4, 0xD, 0x2D, 0x2, 0xB9, 0x44, 0x8E, 0x1D, 0xBB, 0x48, 0xFE, 0x6A, 0x2F, 0xA3, 0x36, 0x49, 0x2D, 0x5, 0xE, 0xA0, 0xF5, 0x97, 0xDD, 0x46, 0x4E, 0x28, 0xD1, 0x75, 0x5B, 0x7B, 0x22, 0x89, 0x9E, 0x5C, 0x85, 0x44, 0xF, 0xAC, 0x42, 0x7E, 0x91, 0x63, 0xE7, 0x57, 0x7B, 0xEC, 0x2C, 0x7F, 0xFF, 0x23, 0x16, 0xEA, 0x36, 0xC6, 0xF2, 0xF7, 0x3F, 0x9D, 0x58, 0xDA, 0x17, 0x32, 0x92, 0x2, 0xF2, 0x45, 0xDC, 0xAC, 0x0, 0x78, 0x15, 0x37, 0x29, 0x70, 0xAC, 0x2, 0x87, 0x29, 0xF0, 0xAC, 0x2, 0x8F, 0x29, 0x78, 0xB1, 0xA, 0x5E, 0x90, 0x82, 0x81, 0xBC, 0xD2, 0x92, 0x14, 0xC, 0xC0, 0xAD, 0x96, 0xA4, 0xC0, 0xB2, 0xA, 0x2C, 0xA6, 0x20, 0xB0, 0xA, 0x2, 0xA4, 0x60, 0x1C, 0x38, 0x5, 0xE3, 0x80, 0x29, 0x90, 0xAC, 0x2, 0x9, 0x29, 0xA0, 0x1E, 0x78, 0x49, 0xE2, 0xCD, 0xF7, 0x5D, 0x96, 0xBF, 0xFF, 0xB9, 0xB1, 0x9D, 0xF0, 0x86, 0x75, 0xC2, 0x89, 0x6D, 0xC1, 0x9, 0x6B, 0xC1, 0x29, 0xB2, 0xA, 0x22, 0xA4, 0x40, 0xB1, 0x25, 0x50, 0x58, 0x9, 0xD4, 0xC8, 0x2A, 0x18, 0x31, 0x5, 0x84, 0x33, 0x27, 0x8B, 0xB7, 0xFC, 0x39, 0x59, 0x96, 0xFD, 0xE3, 0x58, 0x3, 0xD0, 0xF9, 0x53, 0xB3, 0x2, 0x20, 0x87, 0xEA, 0xF2, 0xF7, 0x3F, 0xB, 0x6B, 0x5, 0x16, 0xCC, 0xA, 0x2C, 0xE4, 0x6D, 0xF4, 0xAC, 0xA0, 0x72, 0x1D, 0xFD, 0x4B, 0xC1, 0xCA, 0xB6, 0xE0, 0x8A, 0xB5, 0xA0, 0x66, 0x15, 0x68, 0x50, 0x1, 0xDB, 0x5, 0x34, 0xD6, 0x5, 0x34, 0x3B, 0x88, 0x35, 0x36, 0x88, 0xB5, 0x62, 0x15, 0x28, 0x4C, 0x1, 0xDB, 0xF, 0x34, 0xD6, 0xF, 0x34, 0x6B, 0x4A, 0x35, 0x66, 0x4A, 0x35, 0xDB, 0x91, 0x34, 0xD6, 0x91, 0x34, 0x6B, 0x8B, 0x35, 0x66, 0x8B, 0xD, 0x6B, 0x8B, 0xD, 0x66, 0x8B, 0xD, 0xDB, 0x8C, 0x6, 0x6B, 0x46, 0x33, 0xB3, 0xA, 0x66, 0x4C, 0xC1, 0x9D, 0x55, 0x70, 0xC7, 0x14, 0x68, 0x56, 0x81, 0xC6, 0x14, 0xEC, 0xAC, 0x82, 0x1D, 0x53, 0xC0, 0xDA, 0x3, 0x83, 0xD9, 0x3, 0xCB, 0x56, 0xA2, 0xC5, 0x2A, 0xD1, 0xB2, 0x6B, 0x33, 0x8B, 0xAD, 0xCD, 0x2C, 0xBB, 0xB4, 0xB2, 0xD8, 0xD2, 0x6A, 0x63, 0x2D, 0xD2, 0x86, 0x59, 0xA4, 0x8D, 0x2D, 0xC1, 0x86, 0x95, 0xC0, 0xB3, 0x63, 0xC1, 0x63, 0x63, 0x21, 0xB0, 0x6, 0x25, 0x60, 0x6, 0x85, 0x7A, 0x62, 0x29, 0x8B, 0xB7, 0xD6, 0xE5, 0x49, 0xF6, 0x17, 0xFB, 0xC7, 0x7F, 0x41, 0x7F, 0x3C, 0xB2, 0xB6, 0x24, 0x62, 0xB6, 0x64, 0x67, 0x5B, 0x70, 0xC7, 0x5A, 0x70, 0x27, 0x6E, 0xD, 0x66, 0xF1, 0xD6, 0xA5, 0xC1, 0x2C, 0xCB, 0xFE, 0x71, 0x6C, 0x36, 0xD8, 0xD9, 0xCF, 0xDF, 0xB1, 0xCF, 0x7F, 0xB0, 0x9F, 0xFF, 0xC0, 0x3E, 0xFF, 0xC1, 0x7E, 0xC2, 0x3, 0xFB, 0x84, 0x7, 0x6B, 0x46, 0x1E, 0x88, 0x19, 0x11, 0xC2, 0x4F, 0x84, 0x7B, 0x23, 0xFD, 0xDE, 0x4D, 0x4D, 0xFF, 0xC6, 0x22, 0xC6, 0x7A, 0xC8, 0x71, 0x21, 0xE0, 0x31, 0xC7, 0x8B, 0x18, 0xED, 0x24, 0x1E, 0x39, 0x90, 0xFD, 0xC5, 0xB8, 0xAB, 0x4F, 0x4E, 0x77, 0x92, 0x30, 0xB5, 0x5A, 0xB0, 0xC1, 0xB9, 0x5, 0x46, 0x23, 0x73, 0x17, 0x71, 0xBB, 0x5B, 0x47, 0xFA, 0xE4, 0xF, 0xA8, 0xED, 0x91, 0x5F, 0xC4, 0x24, 0x6A, 0xD7, 0x9D, 0x13, 0xDE, 0xE1, 0x77, 0x9E, 0x13, 0x5D, 0xAB, 0xC4, 0xAF, 0x46, 0xB, 0x7F, 0x68, 0x57, 0xC2, 0x85, 0x8B, 0x14, 0xFB, 0xEA, 0x53, 0x81, 0xD1, 0x87, 0x9F, 0xA, 0xDB, 0x58, 0xCB, 0xDF, 0xC9, 0x39, 0x29, 0x9D, 0x45, 0x6F, 0xE5, 0x2C, 0x6C, 0xDA, 0x85, 0x5, 0x4C, 0xB6, 0x50, 0xD, 0x56, 0xBF, 0x10, 0xA6, 0xFE, 0xA6, 0x82, 0xA, 0x2F, 0xA9, 0x9E, 0x6D, 0xE5, 0x75, 0xF7, 0x4, 0xE3, 0x2F, 0xBB, 0x27, 0xF6, 0x12, 0xEB, 0x3D, 0x30, 0x31, 0x2E, 0xF5, 0xC1, 0x24, 0x90, 0x6F, 0xFF, 0x30, 0x27, 0x93, 0x87, 0xCA, 0x93, 0x82, 0xA8, 0x54, 0x46, 0x98, 0xEA, 0x65, 0xCF, 0x42, 0xB9, 0x72, 0xDB, 0xB3, 0x48, 0xD4, 0x2E, 0x85, 0x1D, 0x3A, 0xF1, 0x5B, 0x61, 0x8B, 0x50, 0x5E, 0xAA, 0x5A, 0xFA, 0x8F, 0xC2, 0xB8, 0x90, 0xFD, 0x63, 0x11, 0x2A, 0x88, 0x6A, 0x60, 0x48, 0x66, 0x5C, 0x89, 0x8, 0x59, 0x44, 0xBE, 0xC0, 0x65, 0x84, 0xE7, 0x6, 0x72, 0xB9, 0xBC, 0x95, 0x9, 0x90, 0xBA, 0x57, 0x5C, 0x6A, 0x46, 0x35, 0xE3, 0xB8, 0x55, 0x5D, 0x84, 0x56, 0x93, 0x98, 0x85, 0x13, 0x93, 0xBA, 0x45, 0x5A, 0xE5, 0x87, 0xD3, 0x25, 0x12, 0xA4, 0x56, 0xA6, 0x1A, 0xAF, 0x45, 0xFC, 0x9F, 0x9C, 0xB, 0x61, 0xFF, 0x8B, 0x58, 0x94, 0xB1, 0x8B, 0xAA, 0xDC, 0xA2, 0x38, 0x29, 0xF8, 0x45, 0x8A, 0x45, 0x2C, 0x79, 0x62, 0x63, 0x2F, 0x4A, 0x9F, 0x4, 0xF4, 0xAE, 0xF4, 0x22, 0x96, 0x38, 0x56, 0x2E, 0x85, 0x15, 0x1C, 0xBE, 0x13, 0xB6, 0x88, 0x55, 0xF9, 0x17, 0x15, 0xF4, 0x54, 0x90, 0x76, 0xD4, 0xD3, 0x22, 0x56, 0xFD, 0x5C, 0x94, 0xA0, 0x22, 0x66, 0x4F, 0xC, 0x8, 0x92, 0x3D, 0xA9, 0xD4, 0xED, 0x87, 0xB7, 0x9A, 0xF6, 0xFD, 0x87, 0x45, 0xAC, 0x71, 0xE2, 0x94, 0xC4, 0x9, 0x53, 0x91, 0x6C, 0xD9, 0x53, 0xB0, 0xC9, 0x10, 0x4E, 0x2, 0x9A, 0xF, 0x21, 0xF1, 0x53, 0xAF, 0x62, 0x23, 0x5C, 0x4E, 0x2, 0x1A, 0xE3, 0x92, 0xF8, 0x4B, 0xAC, 0x27, 0x27, 0x3A, 0x39, 0x97, 0xEC, 0x93, 0x96, 0x76, 0x11, 0xEC, 0x29, 0xF3, 0x81, 0xA3, 0x7, 0xCD, 0x89, 0x9E, 0x6C, 0xF, 0x93, 0xF1, 0xE8, 0x84, 0xB1, 0xB4, 0x47, 0xC9, 0x48, 0xE4, 0x2F, 0xF2, 0x62, 0x89, 0x5A, 0x68, 0xBE, 0x88, 0x27, 0xAD, 0x3B, 0x79, 0x98, 0xF2, 0x5B, 0x74, 0xC2, 0xD8, 0xDA, 0x5, 0xCE, 0xF, 0xEB, 0xC2, 0x25, 0xCE, 0x6C, 0xDC, 0xAC, 0xE3, 0x13, 0x25, 0x9C, 0x4, 0x7C, 0xFC, 0x6B, 0xA5, 0x86, 0x59, 0xF1, 0xA1, 0x39, 0x27, 0x3, 0xE, 0xCE, 0xC9, 0x2, 0xE5, 0x2E, 0x1B, 0x7B, 0x79, 0xF2, 0xCD, 0xC1, 0x6F, 0x4F, 0x26, 0x91, 0xBB, 0xD2, 0x82, 0xBD, 0x6, 0x79, 0x12, 0xD0, 0xBB, 0x90, 0x89, 0xBF, 0x34, 0xEE, 0xEE, 0x9E, 0x9C, 0x8B, 0x4D, 0xB4, 0xD4, 0x4D, 0xF4, 0xA9, 0x13, 0x6F, 0xA2, 0xC4, 0x6F, 0x54, 0x67, 0x56, 0x79, 0xA9, 0x36, 0x97, 0x9C, 0x3F, 0x22, 0xCD, 0xE6, 0x8E, 0xD7, 0x7A, 0x70, 0xBA, 0x44, 0x2, 0xD5, 0x5A, 0x61, 0x94, 0x48, 0x82, 0xAC, 0xD2, 0xC4, 0xE8, 0x32, 0x5, 0x55, 0xC9, 0xEE, 0x2C, 0xAE, 0xEC, 0x2B, 0xB4, 0xD2, 0xCA, 0x48, 0x2F, 0x26, 0xB6, 0x64, 0x9, 0xEF, 0x12, 0x1, 0x55, 0xC7, 0xCF, 0x9B, 0x1A, 0xCF, 0xF1, 0xB4, 0xE4, 0xCD, 0x4C, 0x6A, 0xC0, 0x4A, 0xD6, 0x80, 0xF, 0x9, 0x4F, 0x1C, 0x50, 0x64, 0x52, 0xFB, 0xD5, 0x17, 0xDD, 0x27, 0xEB, 0xD2, 0xC2, 0x5B, 0x2B, 0xBB, 0x88, 0x6A, 0x3B, 0x27, 0xEB, 0x7E, 0xA1, 0x95, 0xBD, 0x8, 0xB6, 0xD1, 0xC9, 0x13, 0xE5, 0x5A, 0x27, 0xF7, 0xB3, 0x9A, 0xAD, 0x6B, 0x5A, 0xE2, 0x83, 0x76, 0x71, 0x9C, 0xFB, 0x58, 0x89, 0x99, 0x2B, 0x4, 0x3C, 0x6A, 0xAE, 0xF0, 0xCB, 0xEC, 0xDA, 0x2C, 0xEE, 0xC1, 0xBB, 0x58, 0xDE, 0x60, 0xCD, 0x28, 0xEA, 0xFB, 0x9A, 0x83, 0x74, 0x69, 0x6B, 0xA3, 0x55, 0xF0, 0x51, 0xDA, 0x4A, 0x4F, 0x48, 0x78, 0x67, 0xD1, 0x9E, 0x60, 0x65, 0x23, 0xF9, 0xC1, 0xC9, 0xB9, 0xF6, 0xF9, 0x79, 0x61, 0x5C, 0x5B, 0x86, 0x95, 0x95, 0x33, 0xBE, 0xC, 0xE3, 0x23, 0xE8, 0x12, 0x78, 0x61, 0x61, 0x63, 0x2B, 0xC1, 0xBA, 0x59, 0x15, 0x1C, 0xAA, 0x5B, 0x5F, 0xC3, 0xE1, 0x4B, 0x37, 0x23, 0x4C, 0x65, 0xDB, 0x96, 0xF6, 0xBE, 0xF0, 0xA6, 0xCD, 0x88, 0x97, 0x78, 0x34, 0x5C, 0x59, 0x85, 0x73, 0xC9, 0x95, 0x60, 0x64, 0x49, 0x1F, 0x5A, 0x59, 0x6, 0x9F, 0x14, 0x7C, 0x21, 0x6C, 0xF2, 0x1A, 0xB2, 0xE1, 0x21, 0x2B, 0xA4, 0x6B, 0xFE, 0xB1, 0x22, 0x53, 0xB7, 0xC2, 0x87, 0xDE, 0x2B, 0x36, 0xD8, 0x28, 0xC9, 0xA6, 0x46, 0xC9, 0x28, 0x9A, 0x18, 0x25, 0x91, 0xF9, 0x3D, 0xB5, 0x51, 0xF0, 0x5E, 0xDA, 0xE8, 0xB4, 0xA9, 0x65, 0x57, 0x14, 0x19, 0x86, 0x17, 0x13, 0xC6, 0xF0, 0xF9, 0xC5, 0x32, 0x8A, 0x66, 0x17, 0xCB, 0x64, 0x5D, 0xCD, 0x13, 0x70, 0x50, 0x2E, 0xED, 0xF0, 0x8D, 0x35, 0x77, 0x55, 0x73, 0x2D, 0x66, 0x42, 0x87, 0xBB, 0x18, 0xD3, 0x34, 0xDC, 0xF0, 0xB, 0x26, 0xCA, 0x35, 0xA7, 0xA0, 0xA9, 0x64, 0x69, 0xCB, 0xE8, 0x85, 0x5A, 0x8C, 0xBA, 0xBE, 0x46, 0xC9, 0x19, 0x64, 0x2E, 0xAC, 0x4F, 0xCC, 0x4B, 0x2C, 0x8A, 0x5F, 0x91, 0x65, 0x18, 0x5E, 0x90, 0x39, 0x31, 0x58, 0x32, 0xDF, 0xC1, 0x1, 0x41, 0xBB, 0x75, 0x27, 0x26, 0x61, 0x46, 0x66, 0xCF, 0x7F, 0x80, 0xA0, 0x22, 0x7F, 0xB7, 0x73, 0x6A, 0x30, 0x5A, 0x53, 0x41, 0x91, 0x83, 0x80, 0xCC, 0x5D, 0x2A, 0x7A, 0x16, 0x50, 0x4B, 0xF6, 0xBD, 0x56, 0xDB, 0xEF, 0x24, 0x5D, 0x69, 0x43, 0x27, 0x2, 0xFB, 0xC6, 0x7A, 0x41, 0xB1, 0xC8, 0xC9, 0xCC, 0xF5, 0x91, 0xEF, 0xD, 0x19, 0xC6, 0x7B, 0x83, 0x5C, 0x39, 0xFF, 0x4F, 0x86, 0xB0, 0x46, 0x54, 0x8B, 0x8, 0xD1, 0x55, 0x3E, 0xAF, 0x10, 0xE0, 0x2F, 0x54, 0xD5, 0x5C, 0x77, 0x85, 0x70, 0x65, 0xBA, 0x77, 0xEA, 0xC9, 0x67, 0xC7, 0x2A, 0x30, 0x3E, 0x7, 0xB8, 0xD5, 0xDB, 0x27, 0xD3, 0xCF, 0x12, 0x4, 0x75, 0x33, 0xCD, 0xAA, 0xD0, 0xA0, 0x86, 0x4D, 0xC, 0x8C, 0xF, 0x31, 0x43, 0x88, 0x13, 0xD1, 0x85, 0xF8, 0x7A, 0x32, 0x3A, 0x12, 0x4, 0xE9, 0x88, 0x39, 0x6D, 0x57, 0x5A, 0x34, 0x19, 0x66, 0x95, 0x77, 0x50, 0xBA, 0xC2, 0x81, 0xAA, 0x38, 0x6A, 0x51, 0x5B, 0xB3, 0x64, 0x1C, 0x5F, 0xB0, 0xF8, 0x86, 0x37, 0xDC, 0x5F, 0xF3, 0x84, 0x7B, 0x51, 0x73, 0x2D, 0x79, 0x71, 0xC1, 0xAF, 0xE4, 0xEB, 0x99, 0xD6, 0xA, 0xE1, 0xCA, 0xEE, 0x28, 0xF1, 0x83, 0x8F, 0xB5, 0x6F, 0xCD, 0x84, 0xB, 0x1F, 0x6B, 0x17, 0x51, 0x3D, 0x65, 0xCC, 0x8C, 0x2B, 0x87, 0x8C, 0x3E, 0xED, 0x1D, 0xF8, 0xD5, 0x4F, 0x86, 0xE1, 0xD5, 0x4F, 0xDA, 0x93, 0xA, 0x57, 0x57, 0x97, 0x5B, 0xF7, 0x82, 0xC2, 0x56, 0x9B, 0xE4, 0x35, 0xC5, 0x95, 0x46, 0x49, 0x2, 0xBC, 0xC5, 0x2E, 0xFA, 0x50, 0x8B, 0xED, 0x67, 0x55, 0xDD, 0xA0, 0x65, 0x1C, 0xDF, 0x9F, 0x65, 0xBA, 0xAB, 0x1D, 0x3F, 0x1D, 0xC, 0x7C, 0x2F, 0x93, 0x5, 0x1A, 0x89, 0xAC, 0xBE, 0x58, 0x57, 0xC, 0xB9, 0xAF, 0x24, 0xCE, 0x79, 0xA3, 0xC8, 0xAD, 0x8F, 0x45, 0xF8, 0x38, 0x89, 0xEA, 0x39, 0x70, 0x22, 0x5C, 0x39, 0x9, 0x4E, 0xFC, 0x9A, 0x5D, 0x48, 0xEA, 0x70, 0xC3, 0x10, 0x27, 0xC1, 0x27, 0xF2, 0x3E, 0x70, 0x7C, 0xCE, 0xF2, 0x71, 0x11, 0xBD, 0xAC, 0xFA, 0x8D, 0xA, 0xE5, 0x8A, 0xDF, 0xC8, 0x47, 0xAD, 0x26, 0x11, 0x5D, 0x63, 0xB4, 0x9C, 0xAC, 0x4B, 0x23, 0x26, 0x3E, 0x5B, 0x8D, 0xF3, 0xBC, 0xD6, 0x38, 0xAF, 0xD8, 0x33, 0x53, 0x5D, 0x86, 0x90, 0xA9, 0x2E, 0xE4, 0x80, 0x8A, 0x8A, 0x33, 0xAF, 0x10, 0x70, 0x6F, 0x5E, 0xA8, 0x65, 0xBA, 0x2B, 0x30, 0x3E, 0xEA, 0x72, 0x9A, 0xC9, 0xEA, 0x56, 0xF0, 0x60, 0xE0, 0x1B, 0x99, 0x60, 0x1D, 0x9F, 0x9E, 0xBC, 0xC0, 0xB8, 0xA1, 0x9, 0xF6, 0xA1, 0x6, 0x49, 0x37, 0x41, 0xC1, 0xA0, 0x36, 0xF0, 0x31, 0xCD, 0xE4, 0xBC, 0xB9, 0x3F, 0x8, 0xB0, 0xB9, 0x3F, 0xF8, 0x75, 0x7F, 0xDF, 0xA9, 0xF4, 0x8A, 0xBF, 0x2F, 0x2D, 0xD2, 0xE7, 0xB4, 0xEE, 0xE5, 0x52, 0x93, 0x7F, 0x28, 0x68, 0x72, 0xF2, 0x2C, 0x11, 0x5D, 0xCD, 0xFA, 0x17, 0xC2, 0x85, 0x56, 0x29, 0x3B, 0x99, 0x34, 0x92, 0x98, 0xF1, 0x7B, 0x30, 0xF2, 0x60, 0x83, 0xC6, 0x6E, 0xBC, 0x49, 0xE2, 0x9E, 0xD3, 0xF1, 0x7B, 0x53, 0x38, 0xD8, 0x45, 0x2A, 0x63, 0xE9, 0xC5, 0xF1, 0x89, 0x22, 0xB, 0xE4, 0x87, 0xE8, 0x6B, 0x83, 0x21, 0xC1, 0xF8, 0x48, 0xC8, 0x33, 0x6E, 0x59, 0xBF, 0x56, 0x6, 0xEC, 0x17, 0xB, 0x1F, 0xB7, 0xF, 0x31, 0x59, 0xEE, 0x85, 0x9A, 0x3, 0x45, 0x8F, 0x6B, 0x33, 0xB9, 0x6A, 0xE4, 0x8B, 0xBA, 0xB, 0x36, 0xFE, 0x51, 0x72, 0x10, 0xB7, 0x94, 0xE6, 0x3C, 0xC5, 0x97, 0xD4, 0xA6, 0x35, 0x59, 0xB0, 0x21, 0x30, 0x59, 0xDB, 0x4F, 0x4A, 0x77, 0x70, 0x30, 0xA5, 0xB5, 0x3D, 0xC3, 0xE3, 0xCA, 0x8E, 0xE1, 0x21, 0x2A, 0x26, 0x26, 0x69, 0x42, 0xCD, 0xCB, 0x43, 0xDC, 0xF3, 0xDA, 0xAF, 0x5A, 0x7D, 0xF7, 0xB2, 0xFE, 0xBB, 0x50, 0x7B, 0xF9, 0x34, 0xAA, 0xB2, 0xE5, 0x2A, 0x8C, 0xB, 0x3B, 0xAE, 0x53, 0x25, 0xFF, 0xCD, 0x87, 0x42, 0xF4, 0xB3, 0xD3, 0x1C, 0x58, 0x77, 0x67, 0x17, 0xCE, 0x25, 0x77, 0xF6, 0x23, 0x6D, 0x5E, 0x5A, 0x9E, 0xE7, 0x42, 0xBA, 0xE6, 0x79, 0x7E, 0xE4, 0x8F, 0x2F, 0x67, 0x95, 0x7C, 0xBC, 0xEC, 0xC9, 0xBA, 0x14, 0x31, 0x9B, 0xF6, 0xC6, 0xBD, 0x6C, 0xF8, 0x2B, 0xB, 0xE9, 0x9A, 0xC7, 0xF2, 0x21, 0x8C, 0x9C, 0xAD, 0xA9, 0xBB, 0x6B, 0xF, 0xD2, 0x25, 0x87, 0xED, 0x43, 0xE4, 0x64, 0xB1, 0x95, 0xB5, 0xEC, 0xC1, 0xC0, 0x97, 0xB3, 0x87, 0x40, 0xAD, 0x7F, 0x15, 0x8D, 0x78, 0x7, 0xCB, 0xFC, 0xFA, 0x59, 0xC1, 0xA9, 0xF4, 0xCA, 0x61, 0x41, 0xDA, 0xEA, 0x54, 0x57, 0x9E, 0x79, 0x2F, 0x74, 0x61, 0xE5, 0xF9, 0x60, 0xDF, 0x78, 0xFB, 0xAB, 0x3B, 0xD1, 0x3F, 0xF5, 0xFC, 0x37, 0xA5, 0xD0, 0xC9, 0xAC, 0x1D, 0xA6, 0x1F, 0xBA, 0xF0, 0x3, 0xF5, 0x47, 0x5A, 0x62, 0x56, 0x9F, 0xA1, 0x3B, 0x39, 0x17, 0xDE, 0xA1, 0x4B, 0x22, 0x51, 0xF2, 0xE, 0x88, 0x84, 0xC2, 0xDE, 0x87, 0x87, 0xA8, 0x27, 0x19, 0x2E, 0x8C, 0x4B, 0xBD, 0xFB, 0x29, 0x7A, 0x59, 0x3F, 0x36, 0x4D, 0x8C, 0x4B, 0x87, 0xA6, 0x2F, 0xA5, 0x95, 0x74, 0xAF, 0x9F, 0xD1, 0xAE, 0x86, 0xD9, 0x64, 0xFC, 0x46, 0x41, 0x16, 0xBA, 0xAF, 0xFA, 0x29, 0xFF, 0xEB, 0xD2, 0x9, 0xFF, 0x2B, 0x1A, 0x5B, 0xD9, 0xB7, 0x24, 0x18, 0xDE, 0xB5, 0x50, 0x99, 0x79, 0x97, 0x76, 0x66, 0xDE, 0x65, 0x1C, 0xA9, 0x1B, 0x2A, 0xE9, 0xD7, 0x86, 0x20, 0x91, 0x66, 0x71, 0x69, 0x65, 0x73, 0x5D, 0xA4, 0xF2, 0xD2, 0xD4, 0xC6, 0x71, 0x26, 0x5C, 0x18, 0xC7, 0x72, 0x55, 0xF, 0xD9, 0x33, 0x59, 0x77, 0xE, 0x14, 0x4B, 0xBA, 0xB3, 0x48, 0xDD, 0xA7, 0xF5, 0x52, 0xB5, 0x13, 0x1E, 0x9C, 0x2B, 0xDD, 0x50, 0x6E, 0x74, 0xD6, 0xE5, 0xC, 0x0, 0x5D, 0x4E, 0x6E, 0xE4, 0x15, 0x2A, 0xB9, 0x1, 0x57, 0xA8, 0xA4, 0x73, 0xCF, 0xD9, 0x6, 0xB9, 0x52, 0x77, 0x68, 0xBE, 0x40, 0x40, 0xCD, 0x6A, 0x27, 0x95, 0x33, 0xB7, 0x32, 0x9A, 0x3E, 0x38, 0xA0, 0x6C, 0x73, 0x76, 0x93, 0x2E, 0x28, 0xC9, 0x69, 0xFB, 0x22, 0xB4, 0xD4, 0x5, 0xF7, 0x7A, 0x1A, 0xBA, 0x82, 0x33, 0x84, 0x54, 0xF1, 0x53, 0xE7, 0x2D, 0x82, 0xA4, 0xDE, 0xD, 0xFB, 0xA0, 0xC0, 0xD3, 0x61, 0xCB, 0x6D, 0x26, 0x34, 0xDC, 0xE6, 0x56, 0x9, 0xA8, 0xC1, 0xDB, 0x1C, 0xBA, 0x13, 0x95, 0x62, 0x74, 0x99, 0xDA, 0xD9, 0x45, 0x17, 0xA2, 0x90, 0x7F, 0x75, 0xCB, 0xDC, 0x9A, 0xEC, 0x66, 0xB1, 0x8, 0xEF, 0x99, 0x83, 0xA9, 0x13, 0x44, 0x8E, 0xA6, 0x66, 0xE1, 0x16, 0xB5, 0x53, 0x4F, 0xF9, 0x1C, 0x50, 0xFB, 0x9, 0x9F, 0x4C, 0xB4, 0xBC, 0xE, 0xB, 0xE9, 0xC8, 0xC9, 0x9A, 0x69, 0x1D, 0x25, 0x8F, 0x33, 0xA0, 0x43, 0xCB, 0xD5, 0xA8, 0xE0, 0x17, 0xF5, 0xA4, 0x15, 0x7D, 0x70, 0x54, 0xDB, 0x33, 0xF8, 0xE5, 0xA9, 0x78, 0x6D, 0x5, 0x47, 0xB4, 0x6D, 0xAB, 0x78, 0x1A, 0x6A, 0x6C, 0x64, 0xA4, 0x33, 0xDC, 0xD0, 0xF8, 0x6A, 0x2A, 0xBA, 0x18, 0xC0, 0x1F, 0xA7, 0xE6, 0x95, 0xE6, 0xAC, 0xA2, 0xA8, 0xBF, 0xA6, 0xDA, 0x7F, 0xAB, 0x57, 0xC3, 0x1C, 0xAB, 0xD7, 0xAE, 0xE, 0xCA, 0x85, 0x13, 0x11, 0x35, 0x2C, 0xFE, 0x47, 0x78, 0x4F, 0xA6, 0x68, 0x7A, 0xA3, 0x48, 0x7E, 0xA6, 0x37, 0x99, 0x4C, 0xB6, 0xF4, 0x51, 0x5, 0xE4, 0x5C, 0x5A, 0xD4, 0x48, 0x19, 0xCD, 0xF4, 0x6B, 0xEB, 0x73, 0x46, 0x36, 0x55, 0x51, 0xC2, 0xC0, 0x53, 0x60, 0x35, 0xD2, 0x17, 0xFC, 0x93, 0x86, 0xF6, 0x7D, 0x4C, 0x25, 0xE9, 0x11, 0xAB, 0x24, 0x32, 0x5C, 0xD3, 0xDC, 0x1C, 0xF9, 0x18, 0xEA, 0xC, 0xC3, 0x11, 0xD4, 0x6A, 0xB6, 0x9C, 0x7B, 0x47, 0xCD, 0x16, 0xF4, 0xED, 0xA8, 0xBB, 0xAD, 0xEE, 0x4D, 0xD5, 0xDD, 0x5E, 0xD9, 0x95, 0xAA, 0xC5, 0xB6, 0xA2, 0x7, 0xB, 0xE5, 0xCA, 0x2, 0x44, 0x2D, 0x69, 0xC9, 0x52, 0xBD, 0x2A, 0x50, 0x28, 0x57, 0xAE, 0x9, 0xA8, 0x25, 0x36, 0xEF, 0x1D, 0x1D, 0x9C, 0x2B, 0xDB, 0x0, 0xB5, 0x8A, 0xA0, 0x9C, 0xA5, 0xF3, 0xC4, 0xBD, 0x51, 0xE8, 0x24, 0x49, 0x91, 0x1A, 0xDA, 0xFD, 0x5B, 0x2B, 0x63, 0xAB, 0x6E, 0xE7, 0xCC, 0xB8, 0xE2, 0x73, 0x56, 0x39, 0x86, 0x94, 0xF7, 0xE, 0x17, 0x1C, 0x76, 0xE, 0x2B, 0x6D, 0x7B, 0x19, 0x7C, 0xAC, 0x77, 0x93, 0x83, 0x74, 0xA9, 0xA3, 0x98, 0x91, 0x4A, 0xE0, 0x75, 0xFC, 0xDE, 0x16, 0x5E, 0xA9, 0xD5, 0x53, 0xF9, 0xBD, 0x29, 0x3C, 0xCD, 0xD6, 0x7, 0xC6, 0xD0, 0x16, 0x10, 0xB3, 0xB3, 0x66, 0xB1, 0x75, 0x9F, 0x4E, 0x62, 0x5C, 0x72, 0xE8, 0x1C, 0x81, 0xEB, 0xF5, 0x2E, 0x71, 0x4, 0xB7, 0x5F, 0xE9, 0x14, 0xB5, 0x37, 0x1E, 0x17, 0x75, 0xE1, 0x81, 0xC7, 0x45, 0x55, 0xDE, 0x98, 0x4B, 0x20, 0x7C, 0x1E, 0xA9, 0xDC, 0x22, 0x8D, 0xF4, 0xA4, 0x93, 0xFF, 0xC0, 0x0, 0x17, 0xBF, 0x72, 0x76, 0xB7, 0x93, 0x13, 0x23, 0x6D, 0xE9, 0x4F, 0x14, 0xB1, 0xF7, 0xEE, 0x19, 0xF9, 0xB3, 0x82, 0x84, 0xC2, 0x47, 0x5, 0xCA, 0xB, 0x27, 0x5E, 0xB5, 0x41, 0x58, 0x8, 0xF8, 0x28, 0xCC, 0x7, 0xE5, 0x63, 0xE5, 0x84, 0xFE, 0x64, 0xC0, 0x67, 0xE, 0xCA, 0xDB, 0xB7, 0x77, 0xB4, 0xE2, 0x45, 0xFE, 0xA6, 0xE1, 0xAE, 0x64, 0xE5, 0x6D, 0x5D, 0xE7, 0x25, 0x55, 0xC5, 0xE7, 0xC5, 0xCC, 0x9E, 0x5, 0x46, 0x67, 0xD0, 0x20, 0x84, 0x5A, 0x6A, 0x5E, 0xF3, 0x83, 0x71, 0xA1, 0x7C, 0xA1, 0x5C, 0xED, 0xE0, 0x5D, 0x2B, 0x27, 0x8, 0x7B, 0x57, 0x54, 0x10, 0xA3, 0x50, 0xC1, 0x56, 0xC7, 0xEC, 0xC1, 0xB9, 0x32, 0x72, 0x43, 0x76, 0x80, 0xB2, 0x5E, 0xB9, 0x3, 0x47, 0xFD, 0x72, 0x7, 0x9D, 0xD, 0x69, 0x39, 0xD5, 0x81, 0x31, 0x2D, 0x99, 0xFE, 0x10, 0xFC, 0x5, 0xA6, 0x83, 0x0, 0x5F, 0x62, 0x3A, 0xF8, 0xF5, 0x15, 0xD3, 0xA1, 0xF3, 0xCA, 0xAA, 0x29, 0x88, 0xB9, 0xF1, 0xD9, 0xF3, 0xB5, 0xCF, 0x7E, 0x5F, 0x3B, 0xAF, 0xCF, 0xB1, 0x5F, 0xC4, 0x4B, 0xF3, 0x6C, 0xC8, 0xA7, 0x36, 0xFC, 0xA1, 0xD8, 0x41, 0x80, 0xCF, 0xC5, 0x32, 0xBF, 0x75, 0x0, 0x73, 0x90, 0x2E, 0x1D, 0xC0, 0x1C, 0x32, 0xAD, 0x6, 0xCB, 0x7A, 0xAF, 0x35, 0x18, 0x7F, 0xFB, 0x24, 0xD, 0x48, 0xF4, 0xF6, 0x49, 0x56, 0x14, 0xFD, 0x4C, 0x8E, 0x9E, 0xBF, 0xBA, 0xF, 0xE, 0x78, 0xF2, 0xCB, 0x23, 0xDE, 0x8D, 0xF6, 0xD6, 0xD7, 0x56, 0xDE, 0x21, 0x2F, 0xA7, 0x6B, 0x1E, 0xE3, 0x83, 0x72, 0xC1, 0x6B, 0xAC, 0x82, 0x30, 0x62, 0x11, 0x53, 0xF4, 0xD9, 0x49, 0x5A, 0xB5, 0x47, 0x1F, 0xE2, 0x35, 0xA3, 0x54, 0x99, 0xD4, 0xC2, 0x85, 0xF9, 0x2C, 0x47, 0xB6, 0xD8, 0xFE, 0x38, 0xBA, 0xA9, 0x76, 0xCD, 0x93, 0x78, 0xB5, 0x7B, 0xF2, 0x61, 0x1C, 0xA9, 0xA4, 0x68, 0x0, 0x87, 0xA, 0x22, 0xBA, 0x66, 0x37, 0x8F, 0xEE, 0x6A, 0x2F, 0x4F, 0x6B, 0xC1, 0xFA, 0xC2, 0xF1, 0xB8, 0xA1, 0x7A, 0x65, 0xE1, 0x18, 0x86, 0x99, 0x8C, 0xE4, 0x38, 0x80, 0x96, 0xF8, 0x83, 0xBD, 0x85, 0xAE, 0x1E, 0xE8, 0xD, 0x74, 0xF5, 0x20, 0x9D, 0x3B, 0x8F, 0xB6, 0x7B, 0x87, 0x7E, 0x46, 0x76, 0x81, 0xDE, 0x91, 0x5D, 0xF2, 0xB9, 0x22, 0x6F, 0xE6, 0x73, 0x5, 0xA2, 0x46, 0xFE, 0x69, 0xCB, 0xE9, 0x79, 0xE5, 0xCA, 0xD9, 0xC1, 0xB9, 0x92, 0x36, 0x40, 0x3D, 0xAD, 0x17, 0xA1, 0x7E, 0x63, 0xF3, 0xE0, 0x5C, 0x32, 0x24, 0x4F, 0xEB, 0x65, 0xA5, 0xAB, 0x27, 0x18, 0xEF, 0xEC, 0x4F, 0xEB, 0x63, 0x65, 0xC3, 0x90, 0x60, 0x7C, 0xCB, 0xF0, 0x8A, 0x15, 0x53, 0xFE, 0x8A, 0xB0, 0x29, 0x5F, 0x45, 0x4F, 0xBE, 0x84, 0x9E, 0x1, 0x60, 0xD7, 0xB1, 0xCA, 0x6D, 0xA3, 0xE5, 0xE5, 0xB6, 0x21, 0xF2, 0x76, 0x7C, 0x2D, 0xF4, 0x41, 0x4D, 0x81, 0x80, 0x73, 0x4, 0xCA, 0xE3, 0xD0, 0x74, 0x38, 0xE8, 0xCD, 0x6F, 0x93, 0xDD, 0xC9, 0xBF, 0x9C, 0xB0, 0x6E, 0xB2, 0x3B, 0xF2, 0xB7, 0xC9, 0xDD, 0x96, 0x6, 0x76, 0x59, 0x94, 0x2D, 0x69, 0x9A, 0x11, 0xA3, 0xA6, 0x39, 0x6C, 0x56, 0x99, 0xE0, 0x9F, 0x3E, 0x48, 0x4D, 0xB9, 0x4, 0x3F, 0x9C, 0xEE, 0x24, 0xB5, 0xF6, 0xEF, 0xC6, 0x3E, 0xBC, 0xA, 0x62, 0x5D, 0x95, 0xB9, 0x51, 0x8D, 0xF9, 0xC6, 0xBB, 0x4C, 0x68, 0xAA, 0xFB, 0x75, 0xBC, 0xD6, 0x49, 0x7B, 0x16, 0xDE, 0x30, 0xE4, 0x5B, 0xB0, 0xBD, 0x78, 0xE6, 0xA0, 0xF0, 0xDA, 0x34, 0x71, 0x92, 0xAE, 0x4C, 0x14, 0xB6, 0x27, 0xED, 0xF1, 0x5F, 0x5D, 0x42, 0x80, 0x95, 0x8B, 0xED, 0x95, 0x74, 0xAF, 0x27, 0x13, 0xC5, 0xF9, 0x46, 0x81, 0x4E, 0xC4, 0xAE, 0xF4, 0xC1, 0x5, 0x7E, 0xA2, 0x35, 0x94, 0xC0, 0xAA, 0x46, 0xA1, 0x5C, 0x65, 0x7A, 0x2F, 0x38, 0x6C, 0xF4, 0xAC, 0xEC, 0xA5, 0x99, 0xC5, 0xCE, 0x3E, 0xDD, 0xF9, 0x61, 0x80, 0x8E, 0x70, 0x2B, 0x57, 0x6A, 0xE4, 0xE4, 0x9F, 0x1, 0xD1, 0x4A, 0x39, 0x56, 0xB8, 0x8, 0xB7, 0x58, 0x4F, 0x79, 0x65, 0x6F, 0xF1, 0x52, 0xCE, 0x2B, 0x5B, 0x8F, 0xBD, 0xB7, 0x97, 0x22, 0xEF, 0xED, 0x54, 0x8D, 0x40, 0x4F, 0xCA, 0xF0, 0xD0, 0x73, 0x3B, 0x9, 0x23, 0x55, 0xA5, 0x3B, 0x64, 0x1C, 0xEF, 0xE, 0x93, 0xAC, 0x45, 0x39, 0xDA, 0x49, 0x5E, 0x88, 0x70, 0xB4, 0xF9, 0xD6, 0x4, 0xB3, 0x2A, 0x49, 0x75, 0x95, 0x51, 0x48, 0x91, 0x35, 0x96, 0xCF, 0x45, 0x50, 0x60, 0x34, 0x15, 0x81, 0x5D, 0x62, 0x1F, 0xCD, 0xBD, 0x56, 0x67, 0x7, 0x3, 0xAF, 0xB5, 0xD5, 0xF6, 0xEE, 0x25, 0x27, 0xDA, 0xCE, 0x1C, 0x20, 0x62, 0x66, 0xB4, 0xA8, 0xAC, 0x66, 0x12, 0x8A, 0x17, 0x49, 0x8B, 0xD4, 0xF2, 0x15, 0xF7, 0xD1, 0xC1, 0xC0, 0xDD, 0x47, 0x56, 0x8B, 0xA5, 0x72, 0xC7, 0x23, 0xC3, 0xF0, 0xFA, 0xC8, 0xE6, 0x6B, 0x5D, 0xB4, 0x9A, 0xC0, 0x9E, 0x94, 0xFF, 0x21, 0x5E, 0xD, 0xD8, 0x2C, 0x94, 0x2B, 0xF1, 0x9A, 0x45, 0x22, 0x6F, 0xB2, 0xEA, 0xC9, 0x7, 0xBF, 0x88, 0x97, 0x52, 0x10, 0x5A, 0x7D, 0xB3, 0xAE, 0xF7, 0xD4, 0x71, 0x40, 0x81, 0x3A, 0xDF, 0x3C, 0x12, 0xB0, 0x5A, 0x47, 0x23, 0xD6, 0xC4, 0x8E, 0x66, 0x64, 0x74, 0x7D, 0x53, 0x50, 0x9D, 0x92, 0x5C, 0x25, 0x16, 0x8, 0x58, 0x27, 0x5A, 0x6D, 0xD, 0x7B, 0x70, 0x98, 0x41, 0x70, 0xF, 0x65, 0xB5, 0x75, 0x35, 0x97, 0x6C, 0xC6, 0xAF, 0xF4, 0xDA, 0x3D, 0xFE, 0x62, 0xAA, 0x69, 0x8F, 0xBF, 0x90, 0xDA, 0x31, 0xC2, 0x54, 0xBA, 0xBD, 0x11, 0x6, 0xEF, 0xF5, 0x46, 0x98, 0x8A, 0x49, 0x4C, 0xAA, 0x60, 0x9B, 0x68, 0x26, 0xDF, 0x4B, 0x37, 0xD1, 0xAD, 0x76, 0x80, 0x48, 0xBB, 0x99, 0xC9, 0xEF, 0xCA, 0x4C, 0xF4, 0x8B, 0x79, 0x5F, 0x30, 0xA4, 0x4B, 0xD1, 0x11, 0x47, 0x9, 0x40, 0xEC, 0x9F, 0xF1, 0x8B, 0x7D, 0xD8, 0x95, 0x7C, 0x61, 0xF5, 0x83, 0x62, 0x9A, 0xE2, 0x2A, 0x2, 0xB7, 0x7F, 0x38, 0x60, 0x70, 0xB, 0x61, 0xCD, 0x73, 0x97, 0xAB, 0xA5, 0xDE, 0x4A, 0x3C, 0xB1, 0xF6, 0x6B, 0x89, 0x8B, 0xB5, 0xAE, 0x91, 0xA6, 0x37, 0x31, 0x2E, 0xF9, 0x79, 0xEC, 0x26, 0xDD, 0xAE, 0xE8, 0x24, 0x2, 0x7, 0x86, 0xB4, 0x9A, 0x53, 0xED, 0x4, 0x29, 0x7, 0xE9, 0x5A, 0xF1, 0xDC, 0xD3, 0xB2, 0x21, 0xD4, 0x9, 0x44, 0xC3, 0xA7, 0xAD, 0x17, 0xF5, 0x34, 0x1F, 0x89, 0x70, 0xE5, 0x38, 0x3D, 0x7B, 0xEA, 0xF8, 0x63, 0x90, 0xE2, 0xC8, 0x43, 0xCF, 0x40, 0xAC, 0x97, 0xAA, 0x32, 0xA8, 0xBD, 0x54, 0xF8, 0xA0, 0xF6, 0x33, 0x79, 0xD2, 0x5C, 0x7E, 0x6F, 0xB, 0xAB, 0x29, 0xED, 0xB2, 0x2A, 0x6E, 0xD5, 0x93, 0x73, 0xC1, 0xAF, 0x9A, 0xFD, 0xF8, 0xEA, 0x55, 0x3F, 0x56, 0x3F, 0x49, 0x57, 0x9C, 0x37, 0xD6, 0xCF, 0xAD, 0x65, 0xBA, 0x9F, 0x2F, 0xAE, 0xD3, 0x7D, 0x9C, 0x64, 0x4B, 0x67, 0x9C, 0xE4, 0x35, 0xA5, 0xA1, 0x72, 0x8C, 0x6C, 0x3, 0x7E, 0x8C, 0x6C, 0x83, 0x9D, 0x95, 0x13, 0x95, 0xDC, 0x32, 0x7, 0x3, 0x4F, 0x2E, 0x63, 0x83, 0xAD, 0x74, 0xBD, 0x60, 0xF1, 0x9E, 0x17, 0x6C, 0x65, 0xE5, 0x19, 0x2C, 0xBE, 0xF0, 0xC, 0x36, 0xD6, 0xAF, 0x4A, 0x15, 0xC6, 0x95, 0x7D, 0x44, 0xEA, 0x7B, 0x7C, 0xA, 0x91, 0x2, 0xA3, 0xE, 0x4E, 0x1B, 0x8D, 0xF5, 0xB1, 0x3A, 0x46, 0x32, 0xE3, 0xCA, 0x8, 0xC9, 0xDD, 0xB8, 0x1E, 0x5E, 0x51, 0x38, 0x97, 0x2, 0x2C, 0x6C, 0x7C, 0xBD, 0x17, 0xA3, 0x5C, 0xC3, 0x9C, 0x14, 0xBC, 0x79, 0x9E, 0x75, 0xC7, 0xBB, 0x7D, 0x5E, 0x72, 0xBA, 0xDB, 0x57, 0xEE, 0xA9, 0x75, 0x95, 0x85, 0x73, 0x51, 0xED, 0x52, 0xB9, 0xCA, 0x9B, 0x61, 0x38, 0x22, 0xC1, 0xBE, 0x9A, 0xE5, 0xBB, 0x56, 0x36, 0xFB, 0xA0, 0xC3, 0xB0, 0x12, 0x2, 0x85, 0x60, 0x51, 0xA5, 0x68, 0xFE, 0xDD, 0x4D, 0x53, 0x21, 0x92, 0xE9, 0xD7, 0x96, 0x20, 0xE5, 0x8E, 0xD9, 0x9A, 0xCE, 0x98, 0xAD, 0xE2, 0x7F, 0xDD, 0x70, 0xFF, 0x2B, 0x35, 0x9D, 0x35, 0xA7, 0x32, 0x27, 0x84, 0x99, 0xE6, 0x85, 0xDE, 0x92, 0x9C, 0x20, 0xB0, 0xB4, 0x71, 0x62, 0x92, 0x8E, 0xBE, 0x9C, 0x5B, 0x20, 0x48, 0xC7, 0x62, 0x1F, 0x64, 0x2D, 0x64, 0x84, 0xAF, 0x82, 0x4F, 0x7B, 0x3B, 0xE1, 0x8D, 0x5A, 0x18, 0x1D, 0x9, 0x42, 0x95, 0xD8, 0x51, 0x38, 0x72, 0xD7, 0x7C, 0x82, 0x48, 0x90, 0x39, 0x93, 0x8E, 0x2, 0xCB, 0x43, 0xE1, 0xA8, 0xEB, 0x24, 0x8E, 0xBB, 0x44, 0xF2, 0xA9, 0x44, 0x49, 0xCA, 0x49, 0x40, 0x70, 0x5D, 0x95, 0x30, 0xB4, 0x9F, 0xFA, 0x0, 0x21, 0x2F, 0xB5, 0x63, 0x1E, 0x17, 0x5E, 0x1C, 0xF8, 0xB8, 0xF0, 0xE2, 0x94, 0xF, 0x4A, 0x18, 0xCF, 0x3C, 0xCC, 0xFD, 0x8D, 0x23, 0xDD, 0xEA, 0x60, 0xC7, 0x86, 0xB6, 0x8, 0x6A, 0x23, 0xEB, 0xA7, 0x53, 0xAD, 0xF7, 0xB9, 0x17, 0x67, 0xC7, 0xB4, 0xB8, 0x14, 0x4C, 0x29, 0x4E, 0x14, 0x29, 0x83, 0x5D, 0x7C, 0x10, 0xA3, 0x5C, 0x77, 0x3A, 0x3D, 0xDA, 0x17, 0x8E, 0x68, 0x23, 0xCF, 0x6D, 0x1C, 0x70, 0x6E, 0xE3, 0x9E, 0xF4, 0x99, 0x8F, 0x7B, 0x22, 0xA7, 0x3E, 0xA4, 0xAB, 0x0, 0xF0, 0x12, 0x78, 0xF2, 0x6F, 0x7A, 0xE4, 0x2F, 0x92, 0x71, 0xE3, 0x48, 0xD4, 0x78, 0x1C, 0x66, 0x65, 0x6C, 0x28, 0x89, 0x63, 0xD9, 0x3B, 0x91, 0x6F, 0xD6, 0x95, 0x5B, 0x91, 0x71, 0x2C, 0x89, 0x97, 0xF2, 0x5D, 0xD4, 0xC8, 0xAD, 0x7E, 0x4F, 0x56, 0xF7, 0xA6, 0x41, 0xAA, 0x6D, 0xF3, 0xFC, 0xFF, 0xE0, 0x5C, 0x99, 0x97, 0xA3, 0xAA, 0xBF, 0x9B, 0x11, 0xD5, 0xA5, 0x57, 0x33, 0xE2, 0xBD, 0x12, 0x88, 0x14, 0xEF, 0x78, 0x10, 0x52, 0xBC, 0xC7, 0x25, 0xD6, 0x12, 0x93, 0x1C, 0x4, 0x78, 0x3D, 0x13, 0xEF, 0x91, 0x75, 0x85, 0xC7, 0x7B, 0x44, 0xFD, 0xE0, 0x71, 0xA9, 0xA6, 0x49, 0x88, 0xCB, 0x95, 0xC, 0x9, 0x71, 0x95, 0x71, 0x97, 0x86, 0xBB, 0x6F, 0x78, 0xC0, 0xE0, 0x85, 0xC3, 0x58, 0xDE, 0x4E, 0xA8, 0x96, 0xEE, 0x78, 0x5E, 0xE1, 0x42, 0x9, 0xB5, 0x58, 0xEC, 0x24, 0xD, 0x7F, 0x4D, 0xE2, 0x64, 0xC0, 0x37, 0x25, 0x6A, 0x37, 0x7, 0xF0, 0x1B, 0x3, 0x7, 0x13, 0xD2, 0x76, 0x4D, 0xAD, 0xB1, 0x8D, 0x11, 0xA1, 0xF3, 0x43, 0x5, 0x57, 0x6, 0x45, 0x92, 0xE0, 0x9C, 0xBC, 0x45, 0x1D, 0xE6, 0xE4, 0xCD, 0x29, 0x47, 0x9D, 0xE2, 0xE3, 0xA9, 0xE, 0x2, 0x1C, 0x53, 0x15, 0xB5, 0xBC, 0x2B, 0x5D, 0x7D, 0x93, 0xEC, 0xA0, 0x5C, 0x88, 0xF8, 0x8A, 0x5A, 0x1A, 0x61, 0x6A, 0x97, 0xA7, 0xA, 0xE3, 0xC2, 0xDD, 0xA9, 0xA8, 0xB3, 0x1F, 0x8D, 0x8F, 0xD, 0x39, 0x8, 0x70, 0x7C, 0x48, 0x34, 0xA3, 0x34, 0xD6, 0x5, 0xAF, 0xCC, 0xCD, 0xA, 0x62, 0x15, 0xF0, 0x57, 0x57, 0x38, 0xDD, 0x49, 0xEA, 0x44, 0x60, 0x4E, 0xCE, 0xBF, 0x86, 0xA0, 0x51, 0x53, 0x4E, 0xF6, 0x5E, 0xA9, 0xCE, 0x42, 0xB9, 0x52, 0x9D, 0x46, 0xE9, 0x86, 0xB, 0x34, 0x53, 0x2E, 0x39, 0x19, 0xA3, 0x51, 0x69, 0x4A, 0xE3, 0x2D, 0x62, 0xC1, 0x61, 0xAB, 0x68, 0x54, 0x10, 0xF9, 0xED, 0xC, 0x6E, 0xCF, 0x7D, 0x32, 0xE0, 0x2D, 0x77, 0x12, 0xB0, 0x8D, 0x5C, 0x1D, 0x7, 0xE7, 0x4A, 0x74, 0x44, 0x34, 0x46, 0x6E, 0x5B, 0xDD, 0x2D, 0x77, 0x70, 0xAE, 0x78, 0xE5, 0xA2, 0xB1, 0xB3, 0xAC, 0xCC, 0x7C, 0x9, 0xC6, 0xE7, 0x3E, 0xE3, 0x3, 0x7B, 0xA0, 0x9F, 0x40, 0xF0, 0x40, 0x3F, 0x51, 0xBD, 0xD0, 0x7A, 0x8D, 0x66, 0xAA, 0xEA, 0x3B, 0x49, 0x17, 0xF4, 0x46, 0x33, 0x8E, 0xD2, 0xAB, 0x89, 0x8F, 0x3C, 0xF8, 0x8D, 0x85, 0x6A, 0xB6, 0xCB, 0xE4, 0x6C, 0x24, 0xAB, 0xF0, 0x84, 0x5A, 0x2A, 0x9C, 0xF0, 0xB3, 0x5A, 0x54, 0xD5, 0xFE, 0x1C, 0x9C, 0x2B, 0x16, 0xC8, 0x89, 0x20, 0x6B, 0xD1, 0xC, 0x99, 0x70, 0x21, 0x9E, 0x21, 0x3A, 0xD9, 0x98, 0x67, 0x9C, 0xBC, 0x36, 0xCB, 0xB8, 0x29, 0x6D, 0x7C, 0x48, 0x4D, 0x93, 0x30, 0xC8, 0x1E, 0x37, 0x96, 0xE3, 0x8A, 0xFA, 0xE0, 0x38, 0x8E, 0x34, 0xAE, 0xC, 0xE, 0x67, 0x7B, 0x59, 0x79, 0x56, 0x27, 0xE3, 0xF8, 0xA3, 0x3A, 0xD1, 0xD9, 0x49, 0xD5, 0x9B, 0xC2, 0x4E, 0xEA, 0x52, 0x53, 0x58, 0xE5, 0x67, 0x25, 0xAC, 0xE6, 0x9C, 0xAF, 0x7, 0xA3, 0x2B, 0x14, 0x54, 0xA5, 0xAD, 0xE5, 0x97, 0x2E, 0x8C, 0xB, 0xC9, 0xA5, 0xA3, 0x2B, 0x7, 0xE8, 0xAD, 0x2B, 0x9D, 0x5F, 0xBC, 0x8B, 0x8D, 0x14, 0x6A, 0xF9, 0xEA, 0xF, 0x1C, 0x3D, 0x7C, 0x8A, 0x2E, 0xEA, 0xC6, 0x78, 0x89, 0xFA, 0xD2, 0x78, 0xF1, 0x39, 0xE5, 0x2D, 0x7B, 0x7F, 0xAB, 0xE0, 0xF0, 0xD, 0xAE, 0x42, 0xE7, 0x4F, 0x1, 0xE, 0x75, 0xE8, 0x41, 0x40, 0x99, 0x78, 0xEB, 0x93, 0x55, 0x19, 0xC8, 0x57, 0xE6, 0xAA, 0x24, 0x61, 0x43, 0xFD, 0xFE, 0xE8, 0x49, 0xBA, 0xB4, 0xE9, 0xF3, 0xC9, 0xB0, 0xD4, 0x7B, 0x51, 0xA6, 0x5C, 0xEA, 0x40, 0x3E, 0x8, 0x23, 0x14, 0xF9, 0x5A, 0xDD, 0x81, 0x21, 0xAF, 0xD5, 0x1D, 0x54, 0xDA, 0x90, 0x1D, 0x6A, 0x10, 0x53, 0xE6, 0x2B, 0xDB, 0x3D, 0x8F, 0x6F, 0xF7, 0xC2, 0x30, 0xB, 0x53, 0xAF, 0xA9, 0x4C, 0xB9, 0x54, 0x53, 0x81, 0xC9, 0xE1, 0x92, 0x0, 0xC0, 0x39, 0x1C, 0x1F, 0xC2, 0xB0, 0x61, 0xF7, 0x19, 0x44, 0xF7, 0x13, 0xAF, 0xB4, 0x7B, 0xAB, 0xED, 0xD8, 0xB, 0x1, 0x5E, 0xB9, 0xEC, 0x95, 0xD7, 0x7E, 0x77, 0xF8, 0xB9, 0xDF, 0x5D, 0xAC, 0xAC, 0x83, 0xEF, 0xC0, 0x20, 0x2D, 0x9A, 0xD1, 0xA0, 0x21, 0x69, 0x23, 0xCC, 0x24, 0xD, 0xA3, 0xA2, 0x80, 0x88, 0x1E, 0x65, 0xA4, 0x1F, 0xC5, 0x4A, 0xEB, 0x39, 0x40, 0x4C, 0x8F, 0xE1, 0x1D, 0x8E, 0x6F, 0x14, 0xD2, 0x14, 0xA4, 0x97, 0x8A, 0x53, 0x54, 0x40, 0x4C, 0xF, 0x97, 0x22, 0xBF, 0x40, 0x88, 0xE, 0xD2, 0xFD, 0x87, 0x24, 0x6F, 0x78, 0x10, 0x72, 0x8F, 0xA6, 0x10, 0x7B, 0x34, 0xF3, 0x40, 0xF, 0x66, 0x1E, 0x95, 0xF3, 0x9D, 0x7, 0x7E, 0xBE, 0xF3, 0x24, 0xC4, 0x9F, 0x4D, 0x21, 0x26, 0xC9, 0xCD, 0x13, 0xCA, 0x70, 0xF3, 0xB4, 0x46, 0x18, 0xDE, 0x9F, 0x96, 0x61, 0xD8, 0x9B, 0xF6, 0xB4, 0x41, 0xE8, 0xBE, 0xB2, 0x21, 0x3F, 0x8, 0xF0, 0x86, 0x3C, 0xF1, 0x73, 0xA, 0xC5, 0xAA, 0xC6, 0x92, 0x3F, 0xF1, 0x8A, 0xCA, 0xA9, 0xF2, 0x32, 0xD5, 0x41, 0xB8, 0xA2, 0x90, 0xEA, 0xED, 0xF9, 0x67, 0x40, 0x94, 0x2B, 0x3, 0xF6, 0xA7, 0x1F, 0x95, 0xC8, 0x9B, 0x84, 0xC2, 0x81, 0x37, 0x89, 0x5C, 0x9D, 0xD0, 0xB2, 0xB6, 0xB, 0xD3, 0xD9, 0x33, 0xA7, 0x4D, 0x66, 0x3, 0xD, 0xA, 0x8C, 0x6, 0x1A, 0xD0, 0xF, 0xF4, 0x23, 0x8F, 0xF3, 0x53, 0x17, 0xC1, 0x5A, 0x77, 0xC0, 0xD6, 0x1F, 0xFB, 0x33, 0xFD, 0xA8, 0x1F, 0x43, 0xA5, 0xEA, 0x7A, 0x83, 0x40, 0xA6, 0xAE, 0x95, 0x18, 0x97, 0x6B, 0x6B, 0x48, 0xAE, 0xE2, 0xC7, 0x6F, 0xF2, 0xA5, 0x4, 0x75, 0xC, 0xF5, 0x6, 0xDB, 0xA7, 0x51, 0xAB, 0x10, 0x8B, 0xF4, 0x7B, 0x94, 0x5A, 0x91, 0x67, 0xBF, 0xDF, 0x78, 0xDB, 0x28, 0xAF, 0xA2, 0xA7, 0xEE, 0xDF, 0xAC, 0xA2, 0xEF, 0xDA, 0x57, 0x6E, 0x56, 0xD1, 0x5B, 0xF6, 0x89, 0x98, 0xC, 0x62, 0x9E, 0x80, 0x44, 0xE5, 0xDF, 0x9A, 0x29, 0x28, 0xAA, 0x69, 0x10, 0xEA, 0x17, 0xD9, 0x3C, 0x5, 0x68, 0x89, 0xCF, 0xDB, 0xA6, 0xE8, 0x5A, 0x4D, 0x8, 0x52, 0x9F, 0x77, 0xBB, 0xAE, 0x82, 0xFF, 0x96, 0xC, 0xA3, 0x1F, 0xB3, 0x8, 0x4F, 0x45, 0x54, 0x66, 0xA0, 0x1D, 0x4E, 0xB9, 0xA, 0xDD, 0x5B, 0x37, 0xCD, 0xCA, 0x10, 0x37, 0x2E, 0xBF, 0xC1, 0xA6, 0x1A, 0x2A, 0xE0, 0xAE, 0xFC, 0xDC, 0x12, 0x35, 0xBD, 0x22, 0x83, 0xB9, 0xB, 0xD2, 0x3E, 0x33, 0x5C, 0x85, 0x19, 0x44, 0xDA, 0x9D, 0xB0, 0x2F, 0x2D, 0x7D, 0x18, 0x68, 0xAD, 0x26, 0x7E, 0xA0, 0x3F, 0xE9, 0x84, 0xDA, 0x2A, 0xA8, 0x64, 0x63, 0xC7, 0xEF, 0x4D, 0x61, 0xE2, 0xF8, 0x27, 0xFF, 0xDA, 0x16, 0xFC, 0xF9, 0xFF, 0x7E, 0xBC, 0x58, 0x25, 0x65, 0x36, 0xBF, 0x60, 0xDE, 0x7E, 0x7E, 0xD, 0x5D, 0x33, 0x4A, 0x36, 0xA7, 0x57, 0x41, 0xE1, 0xFA, 0x1C, 0x95, 0x99, 0xF4, 0xF3, 0xAE, 0x8C, 0xA5, 0xF6, 0x83, 0x7F, 0x75, 0x7, 0xA5, 0x2B, 0x9C, 0x3F, 0xF7, 0x85, 0x84, 0x6F, 0x3D, 0x9, 0x38, 0xBB, 0x33, 0x6D, 0x74, 0x40, 0xCD, 0x62, 0x4D, 0x72, 0x17, 0x64, 0xEC, 0xF7, 0x89, 0x21, 0xC3, 0xD9, 0xD4, 0x2A, 0x9, 0xAF, 0xA2, 0x5F, 0xD2, 0xD3, 0x6D, 0x96, 0x81, 0x96, 0xF8, 0x46, 0xA6, 0xA0, 0x4D, 0xBF, 0xB7, 0x57, 0x9F, 0xAB, 0xF8, 0x37, 0xAA, 0x95, 0x99, 0x75, 0x32, 0x84, 0xCC, 0x39, 0x8E, 0xD9, 0xD5, 0x14, 0x4, 0xA9, 0x48, 0xE7, 0xBD, 0x65, 0xFD, 0xC7, 0x7, 0x8C, 0x56, 0x27, 0x17, 0xD1, 0x5D, 0x10, 0xA4, 0x34, 0x5E, 0xAC, 0x54, 0xCA, 0xA9, 0x13, 0x68, 0x8A, 0x57, 0x66, 0x72, 0x7C, 0x22, 0x27, 0xC5, 0x1, 0x31, 0x65, 0x98, 0x3F, 0x9D, 0x10, 0xE8, 0xF, 0x33, 0x73, 0x5C, 0xFE, 0xBD, 0x29, 0xEC, 0x6C, 0x4F, 0x56, 0x5D, 0x1, 0x5A, 0xE2, 0xB4, 0xED, 0x6A, 0x2F, 0xF9, 0x56, 0xB1, 0xA7, 0x85, 0x27, 0x5D, 0xF0, 0xC, 0x21, 0xED, 0xBE, 0xB3, 0xFB, 0xFC, 0x13, 0x43, 0xB4, 0x10, 0xFB, 0xD1, 0xF4, 0x63, 0x53, 0x6C, 0x73, 0xE4, 0x1F, 0x7E, 0x74, 0x9, 0x68, 0x4A, 0x3F, 0x69, 0x7B, 0x98, 0x7F, 0x6F, 0xA, 0xBF, 0xC8, 0xF9, 0xEA, 0xD1, 0xBD, 0xDA, 0xF3, 0xD5, 0x4B, 0x59, 0xBA, 0xBB, 0xBD, 0x94, 0x45, 0x7A, 0xDB, 0x8B, 0x91, 0x6, 0x64, 0x7B, 0x42, 0xB0, 0x6F, 0xA, 0x11, 0x21, 0x6C, 0x6B, 0x3B, 0x9D, 0xF5, 0x3A, 0x10, 0x62, 0x43, 0x53, 0x88, 0xFC, 0xB8, 0x1, 0xF8, 0xB6, 0x61, 0x1D, 0xE5, 0x4E, 0x4E, 0x97, 0xC3, 0xDA, 0x25, 0xA8, 0x35, 0x49, 0xE, 0xAB, 0xF, 0x62, 0x92, 0xBC, 0x96, 0xC, 0x63, 0xAA, 0x62, 0x2F, 0x8F, 0xFB, 0xEC, 0xE4, 0xE6, 0xE8, 0xB, 0x47, 0xF6, 0x47, 0x54, 0x9A, 0xD1, 0xB5, 0x99, 0x65, 0x74, 0x95, 0x62, 0xDC, 0xC4, 0x24, 0xFD, 0x40, 0x8D, 0x92, 0x13, 0xEC, 0x86, 0xE6, 0xDD, 0xFD, 0x55, 0xA, 0x33, 0x89, 0x5D, 0x29, 0xFA, 0x11, 0xD2, 0x2F, 0x18, 0x18, 0xEC, 0x52, 0x90, 0xEB, 0xD7, 0xFC, 0x73, 0x5B, 0x54, 0x99, 0x49, 0x48, 0x72, 0xD4, 0x17, 0xB0, 0xCB, 0x68, 0x4B, 0x4F, 0x2F, 0x7D, 0x4F, 0x3A, 0xDF, 0xE, 0x8, 0xF9, 0x8C, 0xDE, 0x3A, 0x32, 0xBC, 0xB6, 0x20, 0xC0, 0x3A, 0x42, 0xF6, 0x41, 0x69, 0x23, 0xC3, 0x8B, 0xEC, 0x21, 0x6F, 0x14, 0xE9, 0x1F, 0x72, 0x18, 0x24, 0x39, 0x6E, 0x12, 0x0, 0xC, 0x1D, 0x39, 0xC, 0xB4, 0x49, 0x4A, 0x0, 0x24, 0xBF, 0x4A, 0x47, 0xD, 0xF7, 0x3, 0x68, 0x8A, 0xBF, 0xC, 0x79, 0xFF, 0xAE, 0x20, 0x40, 0xA4, 0xF2, 0x2A, 0x6F, 0x4E, 0x2C, 0x94, 0x82, 0xFC, 0x7B, 0xEB, 0xEF, 0x4F, 0x82, 0xFC, 0xE3, 0xE9, 0xE7, 0xA6, 0x68, 0x4F, 0x4D, 0x22, 0x72, 0xEA, 0xFA, 0xE6, 0x24, 0x22, 0x27, 0xA3, 0x6, 0xE6, 0xBB, 0x33, 0x84, 0x74, 0xA2, 0x89, 0xEC, 0xC5, 0x53, 0xBB, 0xFF, 0xA6, 0xC1, 0xCC, 0xDC, 0xC0, 0x7C, 0x83, 0xC8, 0x38, 0x50, 0x8E, 0x3D, 0xB5, 0x78, 0x83, 0x98, 0x1E, 0x6E, 0x11, 0x5A, 0x20, 0x48, 0x7, 0xD9, 0x87, 0x91, 0xE, 0xAC, 0x42, 0x34, 0x93, 0x97, 0xB4, 0x2B, 0xEB, 0x8D, 0x42, 0x63, 0x91, 0x33, 0x92, 0x98, 0x75, 0x5C, 0x3C, 0x5B, 0xD, 0x19, 0x42, 0x74, 0xAC, 0x74, 0x9F, 0x5C, 0x91, 0x3E, 0xB9, 0xC6, 0x5F, 0x5E, 0x5, 0xB2, 0x16, 0xD6, 0xF8, 0xAB, 0xCB, 0x58, 0xB3, 0xA, 0x4C, 0x9C, 0xA8, 0x23, 0xC8, 0xC, 0x0, 0xE7, 0x8F, 0xAB, 0x34, 0x6C, 0x25, 0x18, 0xB0, 0xE, 0xD8, 0xD, 0x96, 0x4, 0x37, 0x58, 0xD2, 0xDF, 0x99, 0x86, 0xF4, 0x77, 0xA8, 0x25, 0xF7, 0xCA, 0xF8, 0xDA, 0xE1, 0xE1, 0xF5, 0x50, 0x5E, 0xAB, 0x75, 0x95, 0xBC, 0x3B, 0xEF, 0x43, 0x1, 0xB7, 0x7B, 0xF2, 0x17, 0xB9, 0x67, 0xC8, 0x3F, 0xB7, 0x44, 0x5F, 0xE2, 0xEE, 0x99, 0x69, 0x2F, 0x43, 0x80, 0xC9, 0x9A, 0x7A, 0x6A, 0x94, 0xA6, 0x5F, 0x5B, 0x82, 0x94, 0x87, 0x7C, 0x9D, 0xDA, 0x7E, 0xF1, 0x75, 0xA2, 0x4E, 0x7E, 0xD6, 0xA9, 0x7D, 0xEE, 0xB3, 0x52, 0x9E, 0xBE, 0xA6, 0x83, 0x4F, 0xF5, 0x62, 0x21, 0xAB, 0x58, 0xF5, 0x9D, 0x58, 0x80, 0xAD, 0x99, 0xEA, 0xC5, 0xCA, 0x2A, 0x58, 0x31, 0x5, 0x8E, 0x55, 0xE0, 0x30, 0x5, 0xE4, 0x1D, 0x81, 0xAC, 0x0, 0xB8, 0x27, 0x90, 0x14, 0xBC, 0x58, 0x5, 0x2F, 0x48, 0xC1, 0x40, 0xEF, 0x6D, 0x55, 0xDF, 0xD, 0xC8, 0xFE, 0x56, 0xF5, 0x83, 0x65, 0x15, 0x58, 0x4C, 0x41, 0x60, 0x15, 0x4, 0x48, 0xC1, 0x38, 0x70, 0xA, 0xC6, 0x1, 0x53, 0x40, 0xFB, 0x26, 0x93, 0x2, 0xC8, 0x2D, 0xA9, 0x7A, 0x49, 0xD9, 0x72, 0xD5, 0x77, 0xB2, 0xB9, 0xDC, 0x56, 0xFD, 0x8D, 0xED, 0x85, 0x37, 0xAC, 0x17, 0x4E, 0x6C, 0x13, 0x4E, 0x58, 0x13, 0x4E, 0x91, 0x55, 0x10, 0x21, 0x5, 0xB3, 0xE2, 0x14, 0xCC, 0xA, 0x52, 0xA0, 0xD8, 0x4F, 0x50, 0xD8, 0x27, 0xA8, 0x91, 0x55, 0x30, 0x62, 0xA, 0xD8, 0x56, 0x50, 0x58, 0x2B, 0x28, 0xC3, 0x2A, 0x30, 0x90, 0x2, 0xFA, 0xBE, 0x50, 0x52, 0x80, 0xDC, 0x19, 0x4A, 0xA, 0xC8, 0x93, 0xC9, 0xAC, 0x0, 0x38, 0x9D, 0x5C, 0x55, 0xCF, 0xF8, 0xB9, 0x54, 0xDF, 0x41, 0xBE, 0x2E, 0xD5, 0x6B, 0x56, 0x81, 0x6, 0x15, 0xB0, 0xCD, 0xA8, 0xB1, 0x66, 0xD4, 0xEC, 0x50, 0xD6, 0x95, 0xA1, 0xFC, 0xAD, 0x80, 0xED, 0xCA, 0x1A, 0xEB, 0xCA, 0x9A, 0xED, 0x7, 0x1A, 0xEB, 0x7, 0x9A, 0x35, 0xA8, 0x1A, 0x33, 0xA8, 0x9A, 0xED, 0x48, 0x1A, 0xEB, 0x48, 0x9A, 0xB5, 0xC8, 0x1A, 0xB3, 0xC8, 0x86, 0xB5, 0xC8, 0x6, 0xB3, 0xC8, 0x86, 0xED, 0x7, 0x6, 0xEB, 0x7, 0x86, 0xED, 0x7, 0x6, 0xEB, 0x7, 0x66, 0x66, 0x15, 0xCC, 0x98, 0x82, 0x3B, 0xAB, 0xE0, 0x8E, 0x29, 0xD0, 0xAC, 0x2, 0x8D, 0x29, 0xD8, 0x59, 0x5, 0x3B, 0xA6, 0x80, 0x35, 0x28, 0x6, 0x33, 0x28, 0x96, 0xAD, 0x44, 0x8B, 0x55, 0xA2, 0x65, 0x97, 0x78, 0x16, 0x5B, 0xE2, 0x59, 0x76, 0x85, 0x66, 0xB1, 0x15, 0xDA, 0xC6, 0x9A, 0xB4, 0xD, 0x33, 0x69, 0x1B, 0x5B, 0x82, 0xD, 0x2B, 0x81, 0x63, 0x2D, 0x92, 0xC3, 0x2C, 0x92, 0x67, 0x47, 0xA3, 0xC7, 0x46, 0xA3, 0x67, 0x47, 0xA3, 0xC7, 0x46, 0x63, 0x60, 0x6D, 0x62, 0xC0, 0x6C, 0x62, 0xA0, 0x77, 0x53, 0x49, 0xC1, 0x2F, 0x48, 0x41, 0x64, 0x4D, 0x5A, 0xC4, 0x4C, 0xDA, 0xCE, 0xF6, 0x83, 0x1D, 0xEB, 0x7, 0x3B, 0xDB, 0x8C, 0x3B, 0xD6, 0x8C, 0x3B, 0xFB, 0x9, 0x3B, 0xF6, 0x9, 0xF, 0xF6, 0x13, 0x1E, 0xD8, 0x27, 0x3C, 0xD8, 0x4F, 0x78, 0x60, 0x9F, 0xF0, 0x60, 0xD, 0xCA, 0x3, 0x32, 0x28, 0x23, 0xB5, 0x27, 0x4E, 0xBF, 0xB6, 0x4, 0xA5, 0xA3, 0xD3, 0xE0, 0x15, 0x4, 0x70, 0x11, 0x28, 0xC6, 0xC9, 0xA0, 0x20, 0x7, 0x83, 0xBA, 0x91, 0xFB, 0x83, 0x5B, 0x7B, 0x77, 0x70, 0x93, 0xCA, 0xF8, 0xE8, 0x84, 0x19, 0x18, 0xD, 0x1F, 0xB8, 0xAD, 0xCA, 0x87, 0x27, 0x79, 0x7E, 0xFC, 0x86, 0x5A, 0x2A, 0xA6, 0x39, 0x28, 0x43, 0x1D, 0x54, 0x9D, 0x48, 0x53, 0x1, 0xE9, 0x3C, 0x54, 0x13, 0xE0, 0x3D, 0x54, 0x53, 0x74, 0xCC, 0xE9, 0x75, 0x81, 0x10, 0x1D, 0xB4, 0x38, 0x22, 0x49, 0x3D, 0xFF, 0x9A, 0x7F, 0x6D, 0xB, 0xFA, 0x9F, 0x4D, 0x49, 0x32, 0x37, 0xC7, 0x1B, 0x45, 0x52, 0x74, 0x64, 0xF2, 0x20, 0xCC, 0xF8, 0xE4, 0x75, 0x65, 0x18, 0x53, 0xB6, 0xAE, 0x72, 0x16, 0x5A, 0x73, 0xFD, 0xFA, 0xD, 0x23, 0xDD, 0x7B, 0x5D, 0x25, 0x97, 0x3D, 0xE3, 0xD, 0x62, 0x7A, 0x88, 0xA8, 0xE3, 0xF2, 0x73, 0x4B, 0x54, 0x8B, 0x9F, 0x41, 0x85, 0x27, 0x19, 0x9E, 0x70, 0x82, 0x7F, 0x5E, 0x90, 0x25, 0xEB, 0xE5, 0xE0, 0xE, 0xC4, 0x93, 0xA1, 0x1F, 0x45, 0x3, 0xF7, 0xC0, 0xE9, 0x7F, 0x15, 0x91, 0xCE, 0xEE, 0xB7, 0x22, 0xC0, 0xD7, 0x7D, 0x72, 0x9D, 0x1D, 0x16, 0xD2, 0x7A, 0x9E, 0xBA, 0xA, 0x1, 0x50, 0x67, 0xEC, 0x83, 0x3E, 0x11, 0x39, 0x30, 0xC0, 0xBF, 0xA8, 0xB4, 0x78, 0x59, 0xC3, 0x7C, 0x5A, 0x97, 0xA1, 0x76, 0x41, 0x54, 0xA0, 0xB2, 0xC7, 0x9C, 0x40, 0xB3, 0x4, 0x64, 0x6F, 0xD3, 0xED, 0x5E, 0x66, 0x6, 0x4B, 0x65, 0xB2, 0x3E, 0x1, 0x48, 0x9C, 0x75, 0x14, 0x17, 0x18, 0x74, 0x12, 0x2B, 0x33, 0xA, 0x3A, 0x38, 0x24, 0x23, 0xC8, 0x90, 0xA1, 0xF, 0x53, 0xF2, 0xCF, 0x80, 0xA8, 0x67, 0x5E, 0x3F, 0x7B, 0x83, 0x50, 0x11, 0xA8, 0x33, 0xC1, 0xF4, 0x6B, 0x5B, 0xF0, 0x29, 0xDC, 0xF8, 0x93, 0x93, 0x47, 0xD0, 0xE3, 0xED, 0x9B, 0x1, 0xD, 0xBA, 0x22, 0xC0, 0xA4, 0xAB, 0x38, 0x61, 0x2C, 0x5D, 0xC5, 0xAA, 0x8C, 0x1D, 0xA5, 0xED, 0xEF, 0x72, 0x8, 0x9E, 0x34, 0xB9, 0x7F, 0x75, 0xBF, 0x71, 0xFE, 0x63, 0x77, 0xA9, 0xA0, 0x5, 0x65, 0x5E, 0x95, 0xBE, 0xF3, 0x42, 0x3B, 0xCE, 0xE6, 0x49, 0x4B, 0x99, 0x7E, 0x6E, 0x89, 0xEE, 0x64, 0x87, 0xD9, 0xDB, 0xFD, 0x65, 0x67, 0xE6, 0xFE, 0x1D, 0x99, 0xF9, 0x13, 0x69, 0x56, 0x3E, 0x58, 0xF7, 0xE4, 0xBF, 0xFF, 0x8B, 0x84, 0x56, 0x44, 0x12, 0xA9, 0x2B, 0x84, 0x35, 0x59, 0x67, 0xE8, 0x3, 0xFA, 0x2, 0x21, 0xAB, 0x84, 0x5F, 0x8A, 0x34, 0xAB, 0xE9, 0xE7, 0x86, 0x28, 0x35, 0x86, 0x9A, 0x23, 0x68, 0xF9, 0x1F, 0x15, 0xBB, 0xBA, 0x2E, 0xFF, 0x3, 0xAE, 0x31, 0xAE, 0x64, 0xE8, 0x50, 0x3B, 0x76, 0x68, 0xA5, 0xCE, 0x87, 0xD6, 0xE6, 0xE9, 0x90, 0x19, 0x28, 0x93, 0x6B, 0xBA, 0xA1, 0x69, 0x70, 0xAD, 0xE8, 0x45, 0x20, 0x8D, 0x54, 0x46, 0x0, 0xB, 0x65, 0x5, 0xF5, 0xB7, 0xD3, 0xAF, 0x6D, 0x41, 0x6A, 0xC6, 0xCD, 0x3F, 0xB7, 0x44, 0x7, 0xB1, 0xB6, 0xFA, 0xFB, 0x17, 0x7, 0xEC, 0xA4, 0x59, 0xC2, 0xFA, 0x30, 0x8A, 0x6D, 0x55, 0x52, 0x53, 0x23, 0xF9, 0xA4, 0x74, 0x85, 0xD3, 0xE9, 0xF6, 0x4C, 0x9C, 0x44, 0xD2, 0x94, 0xFD, 0xEB, 0x49, 0xDC, 0x50, 0x79, 0xA3, 0x5D, 0x82, 0xDB, 0xAA, 0x16, 0x32, 0xE0, 0xAF, 0xFC, 0xDE, 0xFC, 0x3A, 0x72, 0x7B, 0x98, 0x7F, 0x6E, 0x89, 0x8E, 0xF4, 0xDA, 0xDE, 0x8E, 0xC8, 0xEA, 0xDE, 0x8E, 0x8A, 0x8D, 0xB0, 0x3C, 0x30, 0xA4, 0x9B, 0xDD, 0xC8, 0x12, 0xDC, 0xDA, 0x7F, 0x9E, 0x8E, 0x6F, 0xB1, 0x48, 0x7C, 0x8B, 0x9D, 0x94, 0x59, 0x95, 0x91, 0x62, 0xA3, 0xC6, 0xE5, 0x89, 0x76, 0x9, 0x6E, 0xB6, 0xDD, 0x41, 0x26, 0x63, 0xF3, 0x3E, 0xAA, 0xFE, 0xC, 0xCD, 0xAB, 0xA9, 0x52, 0x64, 0x8D, 0x9E, 0x9A, 0x54, 0x3B, 0x28, 0xEE, 0xE4, 0x7A, 0xE9, 0x76, 0x35, 0x48, 0xB2, 0x7B, 0x9C, 0xEA, 0xDE, 0x1C, 0x58, 0x29, 0x1D, 0x83, 0xF1, 0x51, 0x88, 0xC4, 0x61, 0x24, 0x76, 0xB0, 0xF4, 0x50, 0xCC, 0x10, 0x34, 0x2, 0x27, 0xE5, 0x83, 0x1A, 0x3C, 0x13, 0x76, 0x77, 0xC2, 0x50, 0xE0, 0x9D, 0x9D, 0xAC, 0xDA, 0xE8, 0x2D, 0x62, 0x86, 0xA0, 0xED, 0x61, 0x66, 0x92, 0x4B, 0xCB, 0xA2, 0x3, 0xD8, 0xB7, 0xD8, 0x59, 0x47, 0x4F, 0xD7, 0x4B, 0x42, 0xA0, 0x6A, 0xA1, 0xC7, 0x34, 0x32, 0xA2, 0xA9, 0x79, 0xD8, 0x36, 0x67, 0x61, 0xBB, 0xAA, 0xCD, 0x6E, 0x74, 0xAF, 0xCD, 0x10, 0xD4, 0x67, 0x75, 0x2F, 0xDC, 0xC8, 0xF8, 0x2A, 0x4E, 0x10, 0xF9, 0x86, 0x4C, 0x7D, 0x56, 0xD4, 0x3C, 0x21, 0x2D, 0x8C, 0x2, 0x48, 0x96, 0x36, 0x89, 0xC0, 0xF5, 0xF4, 0xD5, 0xEA, 0x17, 0xBD, 0xC9, 0x4C, 0x0, 0xB0, 0xC3, 0xB4, 0xE6, 0xE7, 0xEF, 0x4D, 0x8, 0xAF, 0x85, 0xF7, 0x6A, 0x97, 0x93, 0x53, 0x23, 0x99, 0x2C, 0x34, 0x13, 0xBB, 0xC4, 0xEC, 0xBE, 0xA8, 0x48, 0xEA, 0xD0, 0x24, 0xF9, 0xF, 0xFA, 0x27, 0xFE, 0xF9, 0xBF, 0xFE, 0x89, 0xD1, 0x92, 0x8B, 0x8F, 0xFC, 0x7B, 0xB3, 0xA, 0x46, 0x5B, 0x52, 0xEF, 0x89, 0x6D, 0xF3, 0xA3, 0x9A, 0x54, 0x20, 0x63, 0x28, 0x33, 0xAF, 0x7B, 0x13, 0xBB, 0x93, 0x9, 0x15, 0x8E, 0x5F, 0x9D, 0x64, 0xAD, 0xE0, 0xBA, 0xC4, 0x8C, 0x4E, 0x19, 0x41, 0x4F, 0x63, 0x5, 0x43, 0xE6, 0x32, 0xF3, 0x10, 0x37, 0xA1, 0xCA, 0x7E, 0x90, 0x36, 0x60, 0xA6, 0xFB, 0x50, 0x30, 0x43, 0xB6, 0x6D, 0x74, 0x84, 0x59, 0x2, 0x90, 0x6E, 0xEC, 0xA4, 0xF1, 0x8B, 0xA5, 0xAF, 0x64, 0x9D, 0x20, 0xA2, 0xC7, 0xB, 0x33, 0xC9, 0xB5, 0x72, 0x7D, 0xED, 0xCD, 0x40, 0xEB, 0xDC, 0xCB, 0xA7, 0x8D, 0x8E, 0xB5, 0xF5, 0x27, 0x8C, 0x55, 0x53, 0xE0, 0x96, 0x3F, 0x1, 0x5B, 0xFB, 0x4, 0x7A, 0x2A, 0xD, 0xEC, 0xC, 0xFA, 0x2D, 0x4A, 0xFF, 0xE1, 0xE6, 0x9D, 0xF5, 0xD5, 0xC6, 0xBD, 0x72, 0xC7, 0x32, 0xA3, 0x68, 0x65, 0x92, 0xBB, 0x62, 0xDB, 0xDE, 0x15, 0xDB, 0x87, 0x1A, 0x5E, 0xB4, 0x9D, 0x4B, 0x8, 0x62, 0xE8, 0x9E, 0x62, 0x55, 0x7C, 0x3A, 0xB5, 0x13, 0x7, 0x3F, 0x84, 0xBF, 0x8B, 0x0, 0x5F, 0x44, 0x20, 0xBF, 0xA6, 0xFD, 0x1D, 0xDB, 0x7A, 0x53, 0x26, 0x5F, 0x26, 0xA5, 0xE5, 0x3F, 0x68, 0x4B, 0x51, 0xF4, 0xD2, 0xD, 0xD6, 0x4, 0x69, 0x2, 0xDD, 0xB7, 0xBF, 0x19, 0x50, 0xF7, 0xA6, 0xEC, 0x52, 0xD3, 0x1C, 0x51, 0x8B, 0xCD, 0xE6, 0x46, 0x84, 0xBC, 0x77, 0x1, 0x5C, 0x19, 0xA6, 0xA6, 0x9D, 0xE6, 0xFC, 0x1C, 0x28, 0x27, 0x57, 0x68, 0xBA, 0x4E, 0xC3, 0x48, 0x45, 0x6A, 0xA6, 0x5F, 0x9B, 0x82, 0x83, 0x55, 0x54, 0x83, 0x84, 0x64, 0x8C, 0x3B, 0xD5, 0xEC, 0x9D, 0x61, 0x1C, 0x28, 0xBF, 0x55, 0x12, 0x6F, 0x3A, 0xAE, 0xC2, 0x38, 0x51, 0x81, 0xA2, 0x61, 0xEC, 0xA6, 0x66, 0xB0, 0x68, 0x18, 0x67, 0xCA, 0xC1, 0x12, 0xC6, 0x6E, 0xE6, 0x9C, 0x2C, 0x9F, 0xCE, 0x13, 0x46, 0xD2, 0x3B, 0x13, 0xC6, 0xAE, 0xED, 0xA1, 0x9, 0x23, 0xE9, 0x33, 0xB, 0x63, 0xC7, 0xFA, 0xCC, 0xBE, 0xFF, 0x30, 0x19, 0x52, 0x1B, 0x46, 0x3E, 0xA6, 0xF6, 0x37, 0x61, 0xA6, 0xD4, 0xB1, 0x5D, 0x6A, 0x26, 0xA7, 0xFA, 0x1A, 0xC0, 0x9C, 0xEA, 0x6B, 0x20, 0x17, 0x9D, 0x1, 0x58, 0x73, 0x6, 0xFA, 0x9B, 0x81, 0x30, 0x62, 0xCA, 0x15, 0xD6, 0x4C, 0x37, 0x10, 0x7B, 0xE1, 0x2, 0xFD, 0xE, 0xC0, 0x89, 0x21, 0x57, 0x63, 0x62, 0x4F, 0xBF, 0x90, 0x95, 0x1, 0x60, 0x12, 0x88, 0xFD, 0xCA, 0x2A, 0x58, 0x6B, 0x1A, 0xBE, 0x8A, 0x30, 0x8, 0xC3, 0x6C, 0x3A, 0xA, 0x4, 0x54, 0x7E, 0x1C, 0x6, 0x4E, 0xC3, 0x80, 0xC9, 0x4B, 0x67, 0xF8, 0x19, 0xB9, 0xC0, 0xE0, 0x4C, 0x16, 0xB9, 0x4E, 0x18, 0xD1, 0x4E, 0x18, 0x27, 0x61, 0xFC, 0x42, 0xF6, 0xA7, 0x2, 0x21, 0x9D, 0x6A, 0xB2, 0x6E, 0x22, 0x47, 0x52, 0x9C, 0x52, 0x21, 0xF8, 0xD1, 0xF4, 0x5D, 0xE, 0xCF, 0x2B, 0xF1, 0xB0, 0x16, 0xBA, 0x61, 0x90, 0x56, 0x61, 0x1E, 0xBA, 0xC8, 0x0, 0xD2, 0x39, 0xCD, 0xD8, 0xCB, 0x81, 0x2C, 0xFE, 0x81, 0xB4, 0x15, 0x90, 0x8B, 0x4B, 0x28, 0x4D, 0xDA, 0x1A, 0x8D, 0x61, 0x4E, 0xA9, 0x33, 0x82, 0x68, 0x70, 0x74, 0x1A, 0xB0, 0xC, 0x20, 0xF2, 0x3E, 0x70, 0x25, 0x48, 0x8, 0xA2, 0x21, 0x70, 0x1D, 0x31, 0x60, 0xDD, 0xF0, 0x17, 0xB5, 0xAE, 0x48, 0xBF, 0xB6, 0x5, 0x75, 0x6F, 0xA3, 0xE3, 0xF, 0x49, 0xDE, 0xC, 0x74, 0x5C, 0xFE, 0x8A, 0x8E, 0x9A, 0xC4, 0xCB, 0xEF, 0x2D, 0xE1, 0x97, 0x74, 0xFC, 0x96, 0x33, 0xA3, 0x60, 0x31, 0x28, 0x1F, 0x25, 0xE7, 0x98, 0xFC, 0x8, 0x71, 0x36, 0x65, 0x47, 0x6D, 0xCA, 0x4E, 0x67, 0x38, 0x4B, 0xBF, 0x3, 0xCD, 0xC8, 0xC8, 0x2, 0x92, 0x54, 0x8D, 0x37, 0x6B, 0xFB, 0x69, 0x26, 0xEE, 0x86, 0x56, 0x81, 0x80, 0x9E, 0xFB, 0x64, 0x3D, 0xE3, 0x4F, 0xD0, 0x2F, 0xFE, 0x34, 0xBF, 0xF4, 0x33, 0xCC, 0x6A, 0xF8, 0xE9, 0xA5, 0xF0, 0xCC, 0x59, 0x6A, 0x62, 0x75, 0xBF, 0xD1, 0x90, 0x85, 0xBD, 0x26, 0xAA, 0x53, 0xB7, 0xEA, 0x52, 0x8B, 0x41, 0x6C, 0xA4, 0xAF, 0xA2, 0x20, 0x6D, 0x4F, 0x85, 0x16, 0x83, 0x54, 0x96, 0xD1, 0x20, 0x95, 0xC5, 0x34, 0x38, 0x11, 0xC8, 0xC9, 0xF6, 0xC4, 0xDA, 0x96, 0x5D, 0x8B, 0x61, 0x56, 0x23, 0xFF, 0x8E, 0xD8, 0x81, 0xA3, 0x19, 0xCD, 0xB5, 0x18, 0x9E, 0xC4, 0xA6, 0xA7, 0xFC, 0xDC, 0x12, 0x1D, 0xB9, 0x51, 0xAD, 0xC5, 0x88, 0xD, 0x69, 0x2D, 0x46, 0xA7, 0x88, 0x1D, 0xCD, 0xF1, 0x3B, 0x22, 0x5C, 0x29, 0x83, 0x53, 0x70, 0x31, 0x64, 0x9F, 0xDF, 0xB7, 0xE6, 0x12, 0xF9, 0x9E, 0x4, 0x34, 0x93, 0xAF, 0x16, 0x93, 0x78, 0x29, 0x23, 0x69, 0xE7, 0xFE, 0x89, 0x22, 0xBE, 0x7D, 0x2D, 0x26, 0x69, 0x82, 0xF5, 0x2A, 0x48, 0x26, 0xC4, 0xE1, 0xAF, 0xEE, 0x8B, 0x43, 0xC4, 0x39, 0x10, 0x21, 0x9, 0x5A, 0x4C, 0x46, 0x86, 0x4D, 0x4C, 0xC4, 0xC, 0x53, 0xB0, 0x2E, 0x83, 0xCD, 0xA1, 0x38, 0xD9, 0x9D, 0x1E, 0x12, 0x39, 0xE3, 0x24, 0x30, 0x24, 0xF2, 0xEB, 0xE8, 0x6C, 0xC2, 0xE3, 0x3, 0x47, 0x13, 0x1E, 0x6B, 0xA1, 0x6E, 0xA4, 0x92, 0x5B, 0x5B, 0x90, 0x74, 0x81, 0xA4, 0xDF, 0xDB, 0x8B, 0x25, 0x2D, 0x94, 0x9, 0xD2, 0x8, 0x33, 0xB0, 0xED, 0xFD, 0x26, 0x60, 0x4D, 0xAE, 0x3C, 0xE5, 0x41, 0x3E, 0x7E, 0x6F, 0xA, 0xBF, 0xA2, 0x8B, 0x6C, 0x6E, 0xC7, 0x3, 0x47, 0xD3, 0x4B, 0x6A, 0xB1, 0x48, 0x2A, 0xF3, 0xFD, 0xF1, 0x7B, 0x53, 0x58, 0x19, 0x3B, 0x97, 0x16, 0x66, 0x5F, 0x7C, 0xFD, 0xB0, 0x2E, 0x3C, 0xFB, 0xAA, 0xC5, 0x12, 0x5D, 0x4E, 0x59, 0x5D, 0x7D, 0xAB, 0xF1, 0x43, 0xBB, 0xF2, 0x5C, 0xA3, 0x16, 0xAB, 0x8, 0x7B, 0xB4, 0x9B, 0x26, 0xF3, 0xF, 0x7D, 0xC1, 0xED, 0xA9, 0x50, 0x8B, 0x95, 0xCB, 0x68, 0x71, 0x40, 0x48, 0x7, 0x63, 0xF2, 0x21, 0x68, 0xD1, 0x55, 0x53, 0x22, 0x7C, 0x8D, 0xD4, 0x75, 0xB5, 0x6E, 0x60, 0xF3, 0xFD, 0x9D, 0x38, 0x6A, 0x2E, 0x57, 0xBB, 0xD9, 0xD5, 0x2F, 0xE4, 0x69, 0xCF, 0x7, 0x85, 0xBE, 0xCC, 0xCB, 0x75, 0x67, 0x6A, 0x39, 0x41, 0x50, 0xD, 0x33, 0xB7, 0xDA, 0xB, 0x82, 0x68, 0xD0, 0xD1, 0xD9, 0x49, 0x3C, 0x72, 0xAA, 0xF2, 0x49, 0xD0, 0x9, 0x4D, 0x3F, 0xAC, 0xEE, 0xA4, 0x21, 0x5D, 0x89, 0x1C, 0xC8, 0xED, 0x51, 0x6C, 0xC4, 0x24, 0xB5, 0x34, 0xE4, 0x5A, 0xE1, 0x8D, 0xB5, 0x95, 0x44, 0x4F, 0xF7, 0x9B, 0x84, 0x20, 0xF6, 0xD9, 0xC, 0xB3, 0x4C, 0xFB, 0x1D, 0xBE, 0xE3, 0x9C, 0xC, 0xB4, 0xEB, 0x18, 0x66, 0x79, 0x5A, 0x10, 0xA4, 0xB1, 0xC, 0x95, 0x9B, 0xA3, 0xFC, 0xDC, 0x16, 0x7D, 0xFA, 0x79, 0x15, 0xB, 0x95, 0x45, 0xE1, 0x83, 0x2, 0xA9, 0x14, 0xB4, 0x30, 0xEA, 0x21, 0x2A, 0xCF, 0x79, 0x14, 0x2, 0xFE, 0x96, 0x87, 0x16, 0xC6, 0x58, 0xFE, 0x39, 0xE1, 0xC, 0xC3, 0x6F, 0x9, 0x6B, 0x61, 0xBC, 0xE2, 0xCF, 0xF9, 0xE, 0x18, 0x6E, 0xB1, 0x44, 0x66, 0xF, 0xB1, 0x4E, 0x1C, 0xD6, 0x16, 0xEC, 0xCE, 0xAC, 0x81, 0x33, 0x84, 0x2C, 0x81, 0xCD, 0xAF, 0x4A, 0x69, 0x7E, 0xA1, 0x25, 0xB1, 0x4E, 0x19, 0x22, 0xFA, 0x29, 0x3, 0x9D, 0x69, 0x65, 0xE9, 0xD5, 0x82, 0x6C, 0xA6, 0x76, 0xD3, 0x6C, 0x37, 0xE1, 0xC3, 0xFA, 0x5C, 0x7B, 0xF2, 0xE0, 0x59, 0x8B, 0xAD, 0x3B, 0x9, 0xC8, 0x71, 0xF3, 0x5B, 0x5F, 0x43, 0x1B, 0xA6, 0xCB, 0xF5, 0x15, 0xB7, 0xC1, 0x1, 0xA3, 0xD5, 0xEB, 0x86, 0x99, 0x4C, 0x1C, 0x53, 0x10, 0xA4, 0x99, 0x9D, 0x32, 0x92, 0x8A, 0x76, 0x2C, 0x8, 0x10, 0xF0, 0x98, 0x89, 0x13, 0xB3, 0xEF, 0xCB, 0x10, 0x62, 0x3, 0xC9, 0x7, 0x39, 0xB5, 0x0, 0x5E, 0xE4, 0xCC, 0xB2, 0x4A, 0xB3, 0xDE, 0xD1, 0x13, 0x87, 0xAB, 0x34, 0xB1, 0x6D, 0x43, 0x9B, 0x85, 0xB5, 0x2D, 0xB4, 0x87, 0xAB, 0x20, 0x88, 0x25, 0x76, 0xB, 0xDD, 0xE9, 0xF2, 0xEF, 0x88, 0x30, 0x19, 0x5E, 0xFC, 0x81, 0x10, 0x15, 0xE4, 0xEE, 0xB5, 0x0, 0x4D, 0x71, 0xC3, 0xE6, 0xB, 0x7D, 0x83, 0x50, 0x35, 0x38, 0x65, 0x3, 0x5D, 0x11, 0x5, 0x69, 0x2A, 0xF0, 0xB3, 0x58, 0xA9, 0xC3, 0xC7, 0xF, 0xD4, 0x54, 0x11, 0x27, 0xA1, 0x65, 0x6D, 0x2, 0x29, 0x8C, 0xB, 0x73, 0x88, 0x8B, 0xDA, 0xBA, 0xFC, 0xD6, 0x19, 0xFD, 0x5A, 0xD0, 0xC9, 0xE8, 0xA, 0x5, 0x52, 0xF9, 0x5C, 0x85, 0xA9, 0x6C, 0xD9, 0xB, 0xE, 0x77, 0xE0, 0xE7, 0x3A, 0x47, 0xC7, 0x9F, 0xA3, 0x7F, 0x18, 0xA8, 0x46, 0x2F, 0x16, 0xC5, 0xBE, 0x2A, 0x59, 0x60, 0xF4, 0x49, 0x49, 0x2D, 0xBC, 0x74, 0x22, 0x10, 0xE7, 0x97, 0x6F, 0xA4, 0xA9, 0x20, 0x27, 0xF9, 0xA2, 0x5D, 0x71, 0x1F, 0x14, 0xE9, 0xA1, 0x7E, 0x56, 0x8B, 0xAC, 0xE5, 0xD7, 0x3F, 0x28, 0x17, 0x32, 0xEC, 0x17, 0x9, 0x55, 0x7B, 0xEB, 0xF2, 0xA0, 0x5C, 0x78, 0xEA, 0xB2, 0x48, 0xD4, 0x9E, 0xA8, 0x3A, 0x18, 0xF8, 0x13, 0x55, 0x5A, 0x30, 0xF, 0x82, 0x64, 0x0, 0xAA, 0x3C, 0x2F, 0x7E, 0x6, 0xE1, 0x9C, 0x70, 0xCC, 0xBA, 0xE5, 0x8B, 0x80, 0xCC, 0x9, 0xDE, 0x8B, 0x96, 0xBA, 0x2B, 0xDA, 0xE2, 0x28, 0xF2, 0xBE, 0xD6, 0x70, 0x9D, 0x36, 0x8E, 0x79, 0x67, 0x9D, 0x18, 0x48, 0x75, 0x5, 0x7A, 0xBA, 0xC, 0xC8, 0x54, 0x19, 0x24, 0xF7, 0x51, 0x9, 0x41, 0x3E, 0x27, 0xF8, 0xD8, 0xB, 0x27, 0xD8, 0xD7, 0x44, 0xDF, 0xC, 0xF4, 0x39, 0xD1, 0x22, 0x10, 0xFD, 0x5C, 0x7B, 0x22, 0xF7, 0x43, 0xC2, 0x9F, 0xA1, 0xCD, 0x32, 0xA3, 0x58, 0xD2, 0x76, 0x8C, 0x35, 0xBA, 0x99, 0xD2, 0x9D, 0x1C, 0x54, 0xA9, 0x65, 0x5F, 0x44, 0x39, 0x70, 0xF4, 0x49, 0x94, 0x4C, 0x97, 0xD5, 0xEE, 0x91, 0x8, 0x97, 0xBA, 0x87, 0x8F, 0x8B, 0x78, 0xE4, 0xF, 0x32, 0xCC, 0xA8, 0x3F, 0x28, 0x5D, 0xE1, 0x80, 0x4A, 0xB5, 0x68, 0x18, 0xA8, 0xC2, 0xB9, 0x64, 0xA1, 0x92, 0x88, 0xD, 0xB6, 0xE1, 0x22, 0x3A, 0x58, 0xD7, 0x3C, 0x44, 0x87, 0x50, 0xA3, 0x1A, 0xB2, 0xDE, 0x4B, 0xD5, 0x60, 0x6C, 0x65, 0xFE, 0xC9, 0x38, 0x3E, 0x1, 0x5, 0x1F, 0xD3, 0x18, 0x61, 0x1E, 0xE5, 0x7A, 0xE3, 0xD8, 0xBB, 0x5C, 0xFA, 0x7C, 0x9A, 0x4E, 0x6, 0x5B, 0x79, 0xF6, 0xF5, 0x43, 0xEB, 0xDE, 0x3C, 0x5C, 0x79, 0x79, 0xAD, 0x8E, 0xB3, 0xFC, 0x27, 0xE7, 0xC2, 0x82, 0x23, 0xF8, 0x18, 0x9D, 0xA8, 0xBD, 0xA9, 0x7E, 0x72, 0x2E, 0xBC, 0xA8, 0xFE, 0x7E, 0x4D, 0xBD, 0xDE, 0x54, 0x79, 0xE3, 0x7F, 0xA5, 0xB1, 0x5E, 0x62, 0x51, 0x75, 0x4F, 0xE9, 0x41, 0xBA, 0xE4, 0x28, 0xD, 0x41, 0xFC, 0xEC, 0xC2, 0xDD, 0xEF, 0xF4, 0x95, 0xAA, 0xDF, 0x8, 0xC8, 0x74, 0x18, 0x82, 0x24, 0xBD, 0x7E, 0xF9, 0xF7, 0x96, 0x30, 0x75, 0xDB, 0x7, 0x49, 0x14, 0xA5, 0xC5, 0xF3, 0xA6, 0x9C, 0xF, 0xCA, 0xDC, 0xC8, 0x6F, 0x28, 0x68, 0x97, 0xE1, 0xE6, 0xA6, 0xB0, 0x90, 0xC9, 0x53, 0xE1, 0xB7, 0x26, 0xE0, 0x60, 0x58, 0x8B, 0x97, 0xDC, 0x56, 0x41, 0xDF, 0xCD, 0x3F, 0x41, 0xEC, 0x48, 0xF3, 0x65, 0x1F, 0x3E, 0xF1, 0x49, 0x3D, 0x19, 0x43, 0x7C, 0xA9, 0xAF, 0xE8, 0xC8, 0x7, 0x18, 0xA, 0x2, 0x68, 0xE8, 0xA9, 0xB3, 0xD5, 0xBE, 0x79, 0xB8, 0xDA, 0xF, 0xA4, 0x5C, 0x37, 0xB4, 0x25, 0xA9, 0x26, 0x68, 0xA7, 0x40, 0xD7, 0x3D, 0xB9, 0xC8, 0xE8, 0x81, 0x5, 0x6, 0x71, 0x3, 0x52, 0xB7, 0x2E, 0x40, 0xEA, 0x41, 0xFC, 0xA2, 0xC4, 0x3A, 0xF1, 0xAB, 0xD9, 0xB4, 0xC3, 0xA8, 0x9C, 0xA6, 0x8E, 0xD9, 0x12, 0x0, 0x5C, 0x88, 0xC9, 0x3C, 0xD2, 0x9, 0x92, 0x15, 0x0, 0x3E, 0x90, 0x81, 0x9, 0x80, 0xD0, 0x3, 0x18, 0x0, 0xA1, 0xC9, 0xDC, 0xF1, 0x1A, 0xC8, 0x1D, 0xAF, 0x87, 0x45, 0x19, 0x2F, 0x89, 0x40, 0x86, 0x37, 0xD2, 0x52, 0xB0, 0x91, 0x57, 0x1E, 0xD3, 0xEF, 0x50, 0xE5, 0x6D, 0x8E, 0xF6, 0x20, 0x25, 0x0, 0xA9, 0x3C, 0xEA, 0x6C, 0xBA, 0x79, 0x2E, 0x3D, 0xE, 0xD4, 0xAE, 0x6F, 0xEC, 0x86, 0xE6, 0x96, 0x8F, 0xCC, 0xBA, 0x84, 0xE4, 0xC1, 0x23, 0x2B, 0xA9, 0x15, 0x29, 0xA4, 0xA9, 0x5C, 0xC, 0xBA, 0x99, 0x87, 0x41, 0xCB, 0x91, 0xDC, 0x22, 0xC8, 0x11, 0xD8, 0x22, 0xC8, 0x51, 0x52, 0x43, 0x50, 0x8E, 0x9D, 0x6C, 0xE, 0xC3, 0x24, 0xAB, 0xCC, 0x8D, 0xFE, 0xDB, 0x9, 0xEA, 0x6E, 0xD0, 0xDF, 0x57, 0x66, 0xA1, 0x2C, 0xF6, 0xA1, 0x63, 0x69, 0xBA, 0x9, 0xE4, 0x48, 0x25, 0xFF, 0xCC, 0xDF, 0x0, 0x54, 0xF9, 0x4C, 0xD, 0x26, 0x39, 0x76, 0x73, 0x73, 0x34, 0xC9, 0x91, 0x7A, 0xCD, 0xA9, 0xFC, 0xC, 0x88, 0x32, 0x87, 0xD3, 0x9, 0x82, 0x8E, 0xA5, 0x13, 0x91, 0x7A, 0xE6, 0xAB, 0x68, 0x68, 0x3E, 0xF3, 0x95, 0x69, 0xAC, 0xAB, 0x26, 0xEB, 0xC0, 0xBC, 0x34, 0x89, 0x4A, 0x4E, 0x73, 0x59, 0x47, 0x7B, 0x96, 0x4B, 0xB4, 0x20, 0x87, 0xB9, 0xA7, 0xEC, 0xD2, 0x9, 0x76, 0x3D, 0x67, 0x9B, 0x3E, 0xC6, 0xE1, 0xE4, 0x92, 0xB7, 0x67, 0x3F, 0x9A, 0x80, 0xDB, 0xB3, 0x89, 0x3C, 0x88, 0xB5, 0x56, 0x3B, 0x83, 0x58, 0xF1, 0xFA, 0x19, 0x94, 0x11, 0x3D, 0xD3, 0xD8, 0x43, 0xBE, 0x99, 0x85, 0xB4, 0xB6, 0xFD, 0x19, 0x84, 0xDE, 0xD4, 0x28, 0xE8, 0x24, 0x9, 0x7F, 0x50, 0x80, 0xE9, 0x20, 0x9, 0xB4, 0x55, 0x5E, 0xD4, 0xF8, 0x52, 0xA6, 0xDC, 0xA8, 0x57, 0x7E, 0x98, 0xF9, 0xCB, 0x56, 0x4, 0x15, 0xAE, 0x4F, 0x2A, 0x14, 0x2F, 0xD, 0xD9, 0x66, 0x38, 0x9E, 0x96, 0x23, 0xB9, 0x38, 0x94, 0x63, 0xC7, 0x2E, 0xB, 0xBF, 0x64, 0x37, 0x7A, 0xB8, 0x77, 0x1B, 0x30, 0xDE, 0x99, 0x11, 0x2, 0x84, 0x95, 0x27, 0x61, 0xC6, 0x52, 0x0, 0xF, 0x0, 0x69, 0x39, 0x7A, 0xA6, 0xD8, 0x1E, 0x28, 0xB6, 0xA7, 0xA7, 0xA4, 0xCE, 0xB7, 0x67, 0x25, 0xE9, 0x6A, 0xAD, 0x9F, 0x50, 0xB4, 0xC1, 0xC9, 0x53, 0xD, 0xD9, 0x3E, 0xD3, 0x90, 0xDC, 0x52, 0x4B, 0xA2, 0x4B, 0x2D, 0x39, 0x45, 0x67, 0xF9, 0xE0, 0xBE, 0xC, 0xC3, 0xB1, 0x7D, 0x52, 0x99, 0x1F, 0xE5, 0xA5, 0xDB, 0xA9, 0x5B, 0xDD, 0x1F, 0x14, 0xB8, 0xD9, 0x9D, 0xC9, 0xBB, 0x9A, 0x2C, 0xF9, 0xEC, 0xCE, 0x7, 0x5, 0x55, 0xDD, 0xAC, 0x8B, 0x9A, 0x3E, 0xB0, 0x3C, 0x51, 0xE8, 0xBC, 0x92, 0x3E, 0x5F, 0x44, 0xCE, 0x16, 0xA5, 0x7A, 0x8, 0x3E, 0xDA, 0x2F, 0xA1, 0x70, 0xA8, 0x5F, 0x22, 0x73, 0x2E, 0x96, 0xAC, 0x8, 0x73, 0xAF, 0x48, 0x2A, 0xDD, 0x9C, 0x96, 0x5D, 0x33, 0xDF, 0x9C, 0x96, 0x2B, 0x93, 0x88, 0xAD, 0x20, 0xC0, 0x9E, 0x5E, 0xAE, 0xBD, 0x8D, 0x8E, 0xCA, 0xED, 0xF5, 0x81, 0x9A, 0x2A, 0x72, 0xE4, 0xE1, 0x2A, 0x73, 0xB0, 0xAF, 0x20, 0x97, 0x9, 0x6B, 0xF7, 0xE6, 0xA4, 0x6D, 0x71, 0x27, 0xDA, 0xEB, 0x6F, 0xB9, 0x72, 0x7, 0x74, 0x19, 0x81, 0xBE, 0x6D, 0x8E, 0x64, 0xC2, 0xAB, 0x82, 0x40, 0x1A, 0x98, 0x3, 0x84, 0x4, 0x20, 0xF2, 0xBA, 0x97, 0xCE, 0x97, 0xBC, 0x51, 0xB4, 0xAF, 0xA0, 0x30, 0x8E, 0xD4, 0x52, 0x90, 0xC7, 0x80, 0xDE, 0x15, 0x49, 0x60, 0xC9, 0xAF, 0xAD, 0x23, 0xAF, 0x6B, 0xBE, 0x11, 0x4C, 0x1, 0x6F, 0x68, 0xB, 0x8E, 0x9A, 0x5A, 0xED, 0x65, 0x60, 0x2C, 0x41, 0x82, 0x30, 0x33, 0x40, 0xC5, 0x58, 0xC9, 0x66, 0x8C, 0x95, 0x34, 0x64, 0x2F, 0x35, 0xED, 0x85, 0xAC, 0x13, 0xDC, 0xD1, 0x79, 0x81, 0x90, 0x4E, 0xE1, 0x86, 0x45, 0xD3, 0x93, 0xDD, 0x81, 0x34, 0x15, 0x78, 0xA9, 0xC, 0xF3, 0xD, 0x5, 0xEB, 0xD8, 0x4F, 0xF9, 0xAA, 0x3B, 0x2F, 0xF2, 0x63, 0x6A, 0x7C, 0x7B, 0x1E, 0x4, 0xB4, 0x41, 0x3D, 0x59, 0x2D, 0x9D, 0x6F, 0xD7, 0x8, 0xF5, 0xF2, 0x68, 0x92, 0x6C, 0xEF, 0x7D, 0xBD, 0x67, 0x9E, 0x9A, 0x3C, 0x20, 0x64, 0x42, 0x8, 0xE4, 0xB6, 0xB4, 0xB, 0xDC, 0xAE, 0xF4, 0xAB, 0xA, 0x83, 0xB4, 0x4E, 0x6C, 0xF4, 0xA5, 0xFC, 0x37, 0x8A, 0xD, 0xEB, 0x40, 0x5, 0x24, 0xC9, 0x2E, 0x34, 0xA3, 0x91, 0x24, 0x75, 0xD7, 0x4C, 0xCB, 0xF6, 0xCD, 0x4F, 0x3A, 0x7D, 0x3E, 0x92, 0x3A, 0x5F, 0xCB, 0x9D, 0xEC, 0x7C, 0xDD, 0xE, 0x74, 0xBB, 0x5F, 0x4C, 0x6D, 0xFD, 0x82, 0xEA, 0x89, 0x5A, 0x63, 0x35, 0x9D, 0x86, 0x13, 0x17, 0xAD, 0x3E, 0x81, 0xD1, 0xEA, 0x13, 0x17, 0x86, 0x3B, 0xA1, 0x61, 0xB8, 0x44, 0xC6, 0x43, 0xCD, 0xA5, 0x38, 0x7C, 0xFF, 0x59, 0x25, 0x34, 0xE5, 0x4F, 0xCA, 0x3F, 0x37, 0x45, 0x7D, 0xED, 0xA4, 0x2E, 0xC1, 0xF8, 0xF1, 0x94, 0x12, 0x3E, 0xD0, 0x1B, 0xEF, 0x8C, 0x0, 0x3B, 0x6F, 0xD5, 0xC7, 0xDA, 0xD1, 0xBE, 0xEA, 0xE3, 0x85, 0x73, 0x7D, 0x95, 0x88, 0x82, 0xF, 0x72, 0x3C, 0x70, 0xD0, 0x6E, 0xA9, 0xC1, 0xD9, 0x9C, 0xD3, 0x98, 0xF1, 0x92, 0xBC, 0x71, 0xC8, 0x55, 0x92, 0xD8, 0x9E, 0x4C, 0xE, 0xF6, 0x81, 0x5A, 0x2A, 0x46, 0x11, 0x56, 0x61, 0x82, 0x1A, 0xF8, 0x2F, 0x7C, 0x53, 0xD0, 0x8F, 0x1C, 0xAD, 0x53, 0xFC, 0xD9, 0x72, 0x86, 0xE1, 0xA3, 0xE5, 0xCC, 0xE6, 0xD7, 0xD0, 0x45, 0x19, 0xBA, 0x88, 0x56, 0xA3, 0x67, 0x6E, 0x8C, 0x1E, 0x10, 0x30, 0xA5, 0xAA, 0x31, 0x38, 0xF9, 0x33, 0x89, 0xC8, 0xAE, 0x19, 0x7F, 0x63, 0x20, 0x1A, 0xE9, 0x55, 0x3D, 0xB4, 0x9E, 0x57, 0x72, 0x95, 0x3, 0x3D, 0x5A, 0x12, 0x82, 0x8C, 0x16, 0xB9, 0x1A, 0xCB, 0x69, 0x30, 0x16, 0xD1, 0x70, 0x8B, 0x46, 0x56, 0x23, 0x74, 0x32, 0xE3, 0x4A, 0x80, 0x8E, 0x9A, 0x85, 0x16, 0x22, 0x75, 0x22, 0x5A, 0x5F, 0x82, 0xBB, 0x8C, 0xA3, 0xCA, 0x2A, 0xFD, 0x31, 0x2B, 0x83, 0xFB, 0x63, 0x62, 0xDF, 0xE2, 0x12, 0x6B, 0x25, 0xCB, 0x38, 0xAA, 0x8C, 0x6D, 0xFF, 0xAC, 0xA, 0xEC, 0x6, 0x89, 0xFB, 0x10, 0x95, 0x40, 0xF1, 0xA2, 0xED, 0xA4, 0x60, 0x2A, 0x9D, 0x98, 0x95, 0xAB, 0xC4, 0x34, 0x64, 0x4A, 0xF7, 0xE6, 0xA0, 0x4A, 0x17, 0x5B, 0x69, 0x57, 0x27, 0xBA, 0x8C, 0x83, 0xCA, 0x62, 0xAA, 0xEA, 0x7A, 0x9, 0x63, 0xF7, 0xE6, 0x40, 0x4A, 0x6D, 0xBE, 0xD, 0xC6, 0x1C, 0xE3, 0xAB, 0xD9, 0x76, 0x7, 0x8E, 0x28, 0x23, 0x57, 0x8B, 0x40, 0xE2, 0x48, 0xAD, 0x16, 0xE1, 0x45, 0x35, 0x52, 0x25, 0x33, 0xAE, 0xC4, 0xA9, 0xA8, 0xA5, 0x75, 0xF5, 0x24, 0x33, 0xAE, 0x5C, 0x3B, 0x51, 0x8B, 0x9A, 0x9F, 0x76, 0xE2, 0xA, 0xA8, 0xBA, 0x8C, 0x22, 0x8A, 0x48, 0xFB, 0xD3, 0xB6, 0x3C, 0xAB, 0xA0, 0xD6, 0xD3, 0x6A, 0xED, 0x44, 0xF3, 0x98, 0x47, 0xAD, 0x82, 0xDA, 0xDC, 0x26, 0xD9, 0xE6, 0xF6, 0x56, 0xAD, 0xE4, 0xDE, 0x36, 0xC9, 0x22, 0x65, 0x36, 0x74, 0xB7, 0x58, 0x85, 0x41, 0x7A, 0xC6, 0xCA, 0x79, 0xB5, 0x33, 0x82, 0x69, 0xA0, 0x96, 0x97, 0xA9, 0xF4, 0xCD, 0xC5, 0xA9, 0x5A, 0x5, 0xB5, 0x77, 0x48, 0xB2, 0xCD, 0xCD, 0x83, 0x5A, 0xC9, 0x93, 0x7F, 0xB5, 0x76, 0xED, 0xC3, 0x7F, 0xB5, 0x92, 0x7, 0xD, 0x49, 0xB6, 0x3D, 0xBF, 0x92, 0x2F, 0xAA, 0x66, 0x59, 0xE0, 0x7B, 0xC9, 0xF3, 0x9B, 0x24, 0xDB, 0xF4, 0xCA, 0xAB, 0x75, 0x60, 0x7A, 0xC9, 0x0, 0xF4, 0x12, 0x2A, 0xAD, 0x71, 0x96, 0x6D, 0x7A, 0x1C, 0xD4, 0x4A, 0xA5, 0xE9, 0xCA, 0xB2, 0x40, 0x5D, 0x8D, 0x8C, 0xEC, 0x8, 0xC8, 0x92, 0x87, 0xAE, 0x6A, 0x5, 0xE, 0x5D, 0xD5, 0x2A, 0xA9, 0xDD, 0x56, 0x92, 0x6D, 0xEE, 0xB8, 0xD4, 0x7A, 0xBB, 0xD3, 0xB2, 0xB7, 0x7B, 0x5B, 0x96, 0xBC, 0x9D, 0xAC, 0xD6, 0x8E, 0xBD, 0x98, 0xFC, 0x2D, 0x4B, 0xED, 0xB4, 0x92, 0x6C, 0x7B, 0xB7, 0xB5, 0x4E, 0xF4, 0x10, 0xEE, 0x26, 0x60, 0xFC, 0xCE, 0x4C, 0xDF, 0x98, 0x81, 0xBE, 0x41, 0x5E, 0x84, 0x57, 0x6B, 0xD7, 0xBE, 0x8, 0xAF, 0x56, 0xC5, 0xFC, 0x5D, 0x85, 0xFC, 0xDD, 0x7F, 0x19, 0xD9, 0x7F, 0x1, 0xD9, 0x20, 0xF8, 0x6C, 0xD7, 0x27, 0x8E, 0xEE, 0x4E, 0xD6, 0x3B, 0xD3, 0xC3, 0xEF, 0x40, 0xF, 0x5F, 0x98, 0x5E, 0xBA, 0x0, 0xBD, 0x94, 0xC, 0x7, 0x48, 0xB2, 0x40, 0x99, 0x17, 0xC6, 0x82, 0x2D, 0x80, 0x5, 0x5B, 0x18, 0x8B, 0xBD, 0x0, 0x16, 0x9B, 0xF7, 0xC0, 0xAA, 0x15, 0xF5, 0xBE, 0xAA, 0x95, 0xCA, 0xA9, 0x92, 0x4A, 0xD0, 0xCC, 0xAB, 0xA2, 0xD5, 0x4A, 0x7A, 0x61, 0xD4, 0xDA, 0xB5, 0x3D, 0x31, 0x6A, 0xD5, 0xCC, 0xDF, 0xD5, 0xC8, 0xDF, 0x65, 0xEC, 0xBE, 0x6, 0xEC, 0xBE, 0x66, 0x6A, 0x5C, 0x3, 0x35, 0x4E, 0xDE, 0x55, 0x52, 0x2B, 0x9F, 0xD6, 0xED, 0x5B, 0x96, 0xF4, 0xE0, 0xAC, 0x9D, 0x69, 0xBB, 0x70, 0x98, 0xAD, 0x2C, 0xB4, 0x7F, 0x5D, 0xC9, 0xB, 0x8E, 0x49, 0x16, 0xF8, 0xDE, 0x8D, 0xB1, 0xBF, 0x1B, 0x60, 0x7F, 0x37, 0xC6, 0xFE, 0x6E, 0x80, 0xFD, 0xA5, 0xB7, 0xBC, 0x48, 0x22, 0x6, 0xB5, 0x6E, 0x4C, 0xDF, 0xD8, 0x80, 0xBE, 0xF1, 0x2F, 0xB3, 0x6, 0xFA, 0x17, 0x58, 0x3, 0x91, 0x41, 0x66, 0x6A, 0x45, 0x42, 0xCC, 0xD4, 0xEA, 0x1E, 0x8C, 0xF0, 0xA3, 0xFD, 0x87, 0x3D, 0x53, 0xD1, 0x1E, 0xA8, 0x68, 0xCF, 0x4C, 0x74, 0x1E, 0x98, 0xE8, 0xA8, 0xC7, 0x48, 0xB2, 0x2C, 0x50, 0xD1, 0x81, 0x59, 0x14, 0x4, 0x60, 0x51, 0x10, 0x18, 0x53, 0x1D, 0x0, 0x63, 0x47, 0x25, 0x9D, 0xCD, 0xB2, 0xC0, 0x40, 0xA, 0x8C, 0x99, 0xF, 0x80, 0x99, 0xF, 0x4C, 0xFB, 0x6, 0xA0, 0x7D, 0xC9, 0x3, 0x81, 0x24, 0xB, 0xC, 0xE0, 0xC8, 0xB4, 0x51, 0x4, 0xDA, 0x68, 0x67, 0x16, 0x8C, 0x3B, 0xB0, 0x60, 0xA4, 0x12, 0xEB, 0x66, 0x59, 0xC0, 0x70, 0x3C, 0x19, 0xD9, 0x27, 0x20, 0xFB, 0x62, 0x6, 0xF0, 0xB, 0x18, 0xC0, 0x2F, 0xA6, 0x5F, 0xBD, 0x80, 0x7E, 0xF5, 0x62, 0xDA, 0xF7, 0xD5, 0x6E, 0x5F, 0x2D, 0x42, 0xBE, 0xF2, 0xF0, 0x62, 0xDD, 0x1A, 0x89, 0xD1, 0x9D, 0x14, 0x64, 0xC3, 0xAE, 0x45, 0xFD, 0x82, 0x86, 0xD2, 0xE2, 0xD2, 0xD5, 0xC, 0x65, 0xF2, 0x1D, 0x92, 0x8A, 0xA7, 0xB7, 0x10, 0x70, 0x5F, 0xAF, 0x11, 0xE9, 0xA3, 0xEA, 0x7E, 0xC9, 0xC2, 0xB9, 0xE4, 0x99, 0x34, 0x42, 0xAB, 0x1F, 0xB1, 0x6E, 0xFE, 0x29, 0xB4, 0x30, 0xC2, 0x73, 0xAE, 0xAC, 0x37, 0xAF, 0x7B, 0x13, 0x51, 0xF5, 0x42, 0x2D, 0xAA, 0x76, 0x9E, 0x72, 0x72, 0x2E, 0x1C, 0xAA, 0x64, 0x91, 0x9C, 0xE0, 0xC8, 0xD5, 0xEF, 0x92, 0xFD, 0xC6, 0xBC, 0x72, 0xA5, 0xAC, 0x8, 0x3E, 0xC4, 0xBD, 0xE6, 0x12, 0x7A, 0x73, 0x70, 0xCF, 0x50, 0x12, 0xE9, 0xAD, 0xAF, 0x5C, 0x56, 0x7B, 0x53, 0x70, 0x27, 0x6E, 0x92, 0x18, 0x85, 0xA, 0xD6, 0xA6, 0x9A, 0xAE, 0x55, 0x45, 0x66, 0x75, 0x7, 0xD, 0x55, 0x2D, 0x87, 0x59, 0xBD, 0xA4, 0xA9, 0xB8, 0x89, 0xBF, 0x58, 0xB8, 0xB7, 0x38, 0x9, 0x25, 0x32, 0x77, 0x71, 0x27, 0x13, 0xBA, 0x83, 0x81, 0x2B, 0x74, 0xC2, 0xD8, 0xBA, 0xAB, 0xF1, 0xC3, 0xBB, 0xE4, 0x70, 0x4C, 0x62, 0xCA, 0x4B, 0xD6, 0xC1, 0x7D, 0xE0, 0xA8, 0x8F, 0x3B, 0xD3, 0x5F, 0xB1, 0x7A, 0x77, 0xE9, 0x24, 0x5D, 0xB9, 0xBB, 0x94, 0x65, 0xF8, 0x54, 0x56, 0xA5, 0x56, 0xD1, 0x4C, 0x56, 0x99, 0x9D, 0x93, 0x4D, 0x35, 0x6, 0x70, 0xE2, 0x5C, 0x1C, 0xC0, 0x5A, 0x19, 0x5B, 0xBD, 0x13, 0xF9, 0xC5, 0xBA, 0xA8, 0xDA, 0x4E, 0xD1, 0xD4, 0x6F, 0x59, 0x7F, 0x68, 0x97, 0xCD, 0xA5, 0x17, 0xC6, 0x25, 0xE5, 0xFC, 0xA5, 0xBB, 0x6F, 0x1A, 0x7E, 0xEF, 0x2E, 0x4B, 0x5, 0x61, 0x64, 0xFD, 0xEA, 0xE5, 0x9B, 0x76, 0xE9, 0xEE, 0x65, 0x91, 0xAA, 0xCF, 0x71, 0xB9, 0x67, 0x5C, 0x9C, 0xE5, 0xB4, 0x8A, 0xD6, 0x44, 0x2D, 0x8C, 0x52, 0xBC, 0x23, 0xFE, 0x43, 0xEB, 0xE, 0x1E, 0xAA, 0x3C, 0x4D, 0x36, 0x7E, 0x56, 0xCE, 0x36, 0x3A, 0xF4, 0x9B, 0x77, 0xA5, 0x67, 0x1B, 0x5B, 0xBB, 0xDD, 0x9C, 0x9, 0x17, 0x6E, 0x36, 0x2B, 0x23, 0x82, 0xE5, 0xAF, 0x60, 0x67, 0x18, 0xBE, 0x7F, 0x9D, 0xD9, 0x7C, 0x48, 0x6B, 0x51, 0x86, 0x86, 0xB4, 0x2A, 0x33, 0x58, 0x1D, 0x48, 0x67, 0x57, 0x42, 0xBA, 0xD0, 0xF6, 0x77, 0x31, 0x31, 0x53, 0x60, 0xBC, 0x94, 0x32, 0xD2, 0xF1, 0x51, 0xC6, 0x19, 0x45, 0x9D, 0x2F, 0xB4, 0x4F, 0x0, 0xF0, 0x8, 0x98, 0xCA, 0x3, 0x88, 0x5, 0xC5, 0x8B, 0xE0, 0xA5, 0xDB, 0xA5, 0xA3, 0x83, 0x71, 0xDE, 0x30, 0x14, 0x93, 0x93, 0xD8, 0x2A, 0x48, 0x4D, 0x45, 0x13, 0x1D, 0x58, 0xA7, 0x9B, 0x2F, 0x75, 0x69, 0x65, 0x8C, 0xF4, 0x36, 0xF0, 0x37, 0x45, 0x4E, 0x2, 0xFC, 0x8D, 0xB6, 0x8F, 0xAD, 0x15, 0xA1, 0xED, 0xE3, 0xC5, 0xC5, 0xA0, 0x9D, 0xD4, 0x2D, 0x72, 0xDA, 0xBA, 0x4, 0x62, 0x6A, 0xE6, 0xDA, 0x30, 0xB3, 0xF3, 0x85, 0x51, 0x66, 0x17, 0xA1, 0xAB, 0xA5, 0x4A, 0xF8, 0x85, 0x92, 0x35, 0xE6, 0xB3, 0x8B, 0x33, 0x19, 0xE9, 0x6A, 0x68, 0xA7, 0xB0, 0x53, 0x26, 0x48, 0xC7, 0xBC, 0xDA, 0x76, 0x80, 0xD0, 0x9B, 0x6D, 0x5A, 0xB9, 0x1C, 0x7B, 0x3C, 0x5B, 0xDA, 0xE9, 0x51, 0xD0, 0x2E, 0xC3, 0xA0, 0x2A, 0xDA, 0xE5, 0x73, 0x28, 0x42, 0xFC, 0x3E, 0x85, 0x4A, 0x46, 0xDF, 0x9D, 0x6A, 0xD8, 0x18, 0xBC, 0x2F, 0x35, 0x5E, 0x2C, 0xAA, 0x96, 0x88, 0x2E, 0x13, 0x2E, 0x24, 0xA2, 0x2B, 0xA, 0xF9, 0x5E, 0x59, 0xD4, 0xA1, 0xDD, 0xD2, 0xB, 0x9F, 0xCC, 0x7F, 0xB0, 0x8E, 0x19, 0x7B, 0x89, 0xD0, 0x1D, 0xC, 0x4C, 0x61, 0xB0, 0x62, 0x51, 0xDC, 0xFC, 0x9C, 0xE0, 0x2E, 0xE3, 0xA8, 0xB2, 0xDA, 0xE2, 0x27, 0x6B, 0xC3, 0x57, 0x3D, 0x89, 0x5E, 0x9D, 0x87, 0xB3, 0xBE, 0xB, 0xF3, 0x70, 0x56, 0x58, 0xC9, 0xDE, 0x71, 0x28, 0x84, 0xB3, 0x77, 0x64, 0x7E, 0x3D, 0x1A, 0x25, 0x6B, 0xBC, 0x12, 0x8D, 0xE2, 0xC5, 0x43, 0x8, 0xAB, 0x2B, 0xD, 0xFC, 0x10, 0x5D, 0x21, 0x40, 0xEA, 0x6, 0x6B, 0x6E, 0xD1, 0xCB, 0x91, 0xBE, 0x49, 0xF3, 0xC1, 0xA1, 0xFB, 0x34, 0x2A, 0xD7, 0x4D, 0x23, 0x14, 0xA5, 0xAC, 0x11, 0x2F, 0xC5, 0xA2, 0x64, 0x62, 0x7D, 0xBF, 0x53, 0x74, 0x5D, 0xD9, 0xED, 0x78, 0xAF, 0x56, 0x3E, 0x10, 0xBB, 0xC0, 0xE8, 0xAC, 0xE7, 0xBD, 0x8D, 0x6B, 0x65, 0x12, 0x2D, 0x38, 0xAC, 0x2D, 0x4E, 0x8A, 0xDD, 0x2C, 0x26, 0x10, 0xDD, 0x29, 0x92, 0xC6, 0xB7, 0x3D, 0xB, 0x4, 0xB1, 0x54, 0x52, 0x9E, 0x67, 0x18, 0x5F, 0x42, 0x6, 0xB1, 0x48, 0x7E, 0x9E, 0x4C, 0x28, 0x3C, 0x4B, 0xA6, 0xBD, 0x4B, 0xC5, 0x18, 0xE5, 0xAD, 0xD, 0x6C, 0x8C, 0x2, 0xE9, 0xCA, 0xEF, 0x42, 0xDB, 0x93, 0x1F, 0xEA, 0x91, 0x51, 0xE1, 0x52, 0x64, 0x54, 0xB0, 0x79, 0xB9, 0x34, 0x45, 0x36, 0x16, 0x2C, 0xD8, 0xB2, 0x5A, 0xCA, 0x14, 0x4C, 0xE5, 0xB3, 0x96, 0xDB, 0x34, 0xE3, 0x78, 0x62, 0x3A, 0x15, 0x7C, 0xEC, 0xD3, 0xF0, 0x22, 0x55, 0x9D, 0x18, 0xA0, 0x44, 0x1A, 0xE1, 0xB8, 0x12, 0xF9, 0x28, 0xBB, 0x4, 0x83, 0xE5, 0x59, 0x64, 0x75, 0xD7, 0x98, 0x19, 0x57, 0x36, 0x8C, 0xB1, 0xE5, 0xC, 0x8C, 0x17, 0x7D, 0x80, 0xF4, 0x32, 0x3, 0x8, 0xF2, 0x57, 0x4F, 0x31, 0x56, 0x57, 0x82, 0x9, 0xBF, 0xB0, 0x12, 0xE4, 0x27, 0x5B, 0x74, 0x96, 0x7D, 0x8A, 0xA5, 0xD2, 0x72, 0x9, 0x85, 0x5B, 0xEE, 0x29, 0x16, 0x5B, 0xF5, 0xDB, 0x25, 0xC2, 0x15, 0xBF, 0x5D, 0xE2, 0xAB, 0x87, 0x8, 0x8C, 0xA9, 0xCC, 0xEA, 0x32, 0x8E, 0x2A, 0x33, 0xF6, 0x6E, 0xEB, 0x7, 0x4, 0x27, 0xEB, 0xD2, 0x21, 0xC1, 0xF3, 0x88, 0x95, 0xAD, 0x7C, 0xF8, 0x31, 0x25, 0xC2, 0x1F, 0x5E, 0x89, 0x97, 0x7D, 0x5E, 0x88, 0x97, 0x7D, 0x16, 0xE7, 0x47, 0x75, 0x19, 0x75, 0x70, 0xAE, 0xAC, 0xA4, 0x9E, 0x39, 0x8, 0xB7, 0xF1, 0xC9, 0x89, 0x72, 0xED, 0xA3, 0x6B, 0xCD, 0x82, 0xB7, 0x45, 0x62, 0x42, 0xDA, 0x2E, 0xAA, 0xAD, 0x3C, 0x3, 0x90, 0x61, 0xDC, 0xC3, 0xF4, 0x4C, 0xCB, 0xD0, 0x4A, 0xD8, 0x77, 0xC6, 0xF1, 0xB8, 0xEF, 0x4C, 0x6F, 0x84, 0x30, 0x17, 0x95, 0x97, 0x62, 0x98, 0xB3, 0x48, 0xBD, 0xE7, 0xD8, 0x6B, 0x39, 0xFE, 0x8A, 0x40, 0xC3, 0xD9, 0x58, 0x94, 0x5E, 0xF2, 0x36, 0x3E, 0x6D, 0xA8, 0x1B, 0x55, 0x1B, 0xAE, 0x18, 0xD5, 0x57, 0x9C, 0x6B, 0x2E, 0xB6, 0x4, 0xE3, 0xEB, 0xA3, 0x57, 0xD4, 0x62, 0xA9, 0x1F, 0x67, 0x14, 0xCA, 0x95, 0xA1, 0xF2, 0x8A, 0x46, 0x68, 0xC5, 0x2F, 0xBB, 0xA, 0xE, 0x2F, 0xBC, 0x5E, 0x31, 0x6D, 0x2B, 0x2A, 0x96, 0xB6, 0x10, 0x60, 0x5B, 0x7B, 0xB7, 0x66, 0x14, 0x2B, 0x93, 0x6A, 0xF5, 0x4, 0x81, 0x88, 0x1B, 0xEA, 0x12, 0x5E, 0xF3, 0x6, 0xDE, 0xC2, 0xA5, 0xD, 0x58, 0xD0, 0xB4, 0x1, 0xE4, 0x43, 0x63, 0x1A, 0x78, 0x65, 0x4C, 0x53, 0xB1, 0x36, 0xCD, 0x38, 0x9B, 0xB5, 0xA7, 0xA4, 0xFA, 0xB6, 0xD8, 0x4D, 0x18, 0xFA, 0x4B, 0x33, 0x4, 0x7D, 0x2A, 0x99, 0x26, 0xB9, 0x9D, 0x20, 0x99, 0xD8, 0x4, 0xFD, 0xD5, 0xE9, 0xDF, 0x77, 0x3E, 0x7F, 0x13, 0x62, 0x54, 0xBD, 0xB6, 0x9F, 0x9B, 0xD2, 0xE2, 0x26, 0x4C, 0xAF, 0xA8, 0xD8, 0x8D, 0xC, 0x75, 0x9, 0x6B, 0x7D, 0x29, 0xE5, 0xE5, 0x6E, 0xBA, 0xB7, 0xE9, 0x8, 0x76, 0x24, 0x7C, 0xDD, 0x90, 0x4B, 0x44, 0x3, 0x2C, 0x11, 0xA9, 0x31, 0xD3, 0x1C, 0x29, 0xF6, 0x47, 0xFD, 0x38, 0x61, 0xC8, 0x97, 0xBA, 0xDF, 0x20, 0x30, 0xE2, 0xEC, 0x8F, 0x57, 0x52, 0x4B, 0xE3, 0xA9, 0x27, 0xFC, 0x3F, 0x28, 0xF0, 0x8A, 0xBF, 0xB6, 0xC2, 0xC9, 0x45, 0xD2, 0x5, 0xCA, 0x10, 0x52, 0x9C, 0xB4, 0xD1, 0xE2, 0x4F, 0xC4, 0x33, 0xC, 0x9F, 0x86, 0xDB, 0x9E, 0xDA, 0xE3, 0xA4, 0x5F, 0xDB, 0x82, 0x13, 0xFD, 0xE7, 0x55, 0x37, 0x21, 0x7F, 0x96, 0x4C, 0x46, 0x91, 0xA4, 0xDB, 0xE9, 0x28, 0x6C, 0xAF, 0x56, 0x46, 0x7A, 0x45, 0x84, 0xD, 0x35, 0xE4, 0xD2, 0x9F, 0x36, 0xCD, 0x61, 0x97, 0xA4, 0x29, 0xEB, 0x92, 0xA5, 0x9B, 0x51, 0x9A, 0xB6, 0x57, 0x64, 0x16, 0xF7, 0x24, 0xDD, 0x4E, 0xE2, 0x9E, 0xA4, 0xA9, 0xF1, 0x9E, 0xA5, 0x9B, 0xC1, 0x5A, 0x76, 0x98, 0xD5, 0x2B, 0x56, 0xCF, 0xA6, 0x4F, 0xA, 0xBE, 0x2C, 0xB0, 0xC3, 0xB2, 0xF9, 0xA0, 0xD, 0x3D, 0x32, 0x86, 0xA5, 0xCB, 0x28, 0x34, 0x32, 0xC8, 0x3B, 0xF, 0x16, 0xB8, 0xF2, 0x60, 0xA9, 0x87, 0xA7, 0xF3, 0xAF, 0x6D, 0x41, 0x6E, 0x3E, 0xB6, 0xE8, 0x6C, 0x6C, 0x47, 0xB9, 0xAE, 0xCA, 0x4C, 0xCC, 0x9D, 0xDE, 0x13, 0x86, 0xAE, 0xF4, 0xDA, 0x51, 0x32, 0x39, 0x6, 0x32, 0x2, 0x18, 0x58, 0x3B, 0x56, 0x1E, 0xC2, 0x2C, 0x28, 0xE8, 0x8F, 0xB3, 0xE4, 0xBB, 0xD8, 0xDA, 0x2, 0xEF, 0x62, 0x6B, 0x3B, 0x46, 0xCD, 0xD4, 0x6A, 0xD4, 0x48, 0x9D, 0x92, 0x63, 0xBB, 0x3D, 0xB0, 0xC9, 0x2, 0xB7, 0x4B, 0x4B, 0xD7, 0x38, 0x52, 0xDB, 0x8B, 0xA8, 0xDD, 0x35, 0xB7, 0xD9, 0x63, 0x88, 0xDE, 0x35, 0xB7, 0xEB, 0xC8, 0xCC, 0xB, 0xEB, 0x8, 0xCD, 0xA, 0xAB, 0xF2, 0x74, 0xB2, 0xA2, 0x8C, 0x20, 0x9F, 0xB3, 0x32, 0xE2, 0x88, 0x2C, 0xD9, 0xDF, 0xDA, 0xBD, 0x4C, 0xF3, 0xFE, 0x63, 0xAB, 0x51, 0xDF, 0xB1, 0xD5, 0xBD, 0xC, 0x3E, 0x56, 0xC3, 0x98, 0xE, 0xCE, 0x95, 0x10, 0x26, 0x6B, 0x4, 0x19, 0xFA, 0x5C, 0x7E, 0x6F, 0xA, 0x93, 0x39, 0x49, 0xF3, 0xCF, 0x80, 0x28, 0x5F, 0x2B, 0x46, 0xA2, 0x97, 0x5D, 0xAC, 0xD1, 0x36, 0x86, 0xB9, 0xA2, 0x2A, 0xE3, 0xB0, 0x36, 0x1F, 0x24, 0x15, 0xA1, 0x7C, 0x0, 0x4D, 0xF1, 0xA0, 0x6, 0xB9, 0xAE, 0xFC, 0xD3, 0x32, 0x6F, 0x6, 0x5C, 0xA0, 0xE0, 0x64, 0x25, 0x4B, 0xD0, 0x81, 0xC3, 0xDA, 0x5E, 0xE2, 0xA7, 0x77, 0x4A, 0x98, 0x17, 0x63, 0x82, 0xBF, 0x8, 0xC8, 0x80, 0x48, 0x74, 0xF9, 0x93, 0xCC, 0x3F, 0xA0, 0xF7, 0xF, 0x22, 0xA8, 0xBF, 0xA5, 0xF6, 0x9A, 0x36, 0xD9, 0x52, 0x27, 0x2F, 0xEA, 0xCB, 0x9F, 0xD4, 0x54, 0xFA, 0xCD, 0x2, 0x35, 0xB3, 0xCA, 0x10, 0x79, 0x6B, 0xFC, 0x20, 0x56, 0x49, 0x2D, 0x58, 0xFE, 0xEA, 0xDE, 0xF0, 0x9F, 0x2B, 0x16, 0xEA, 0x85, 0xD3, 0x93, 0x4B, 0xE7, 0x96, 0x7A, 0x6B, 0x42, 0xD2, 0x4B, 0x59, 0x37, 0xDA, 0x5D, 0x9, 0xF2, 0xBC, 0xFF, 0xC0, 0x90, 0xF3, 0xFE, 0x83, 0x4A, 0x3E, 0x3D, 0x77, 0xAA, 0x1, 0x1E, 0x9E, 0xB3, 0x4E, 0x1A, 0xFA, 0x45, 0xAB, 0x8C, 0x0, 0x37, 0x67, 0x73, 0xDA, 0x8E, 0x61, 0xAE, 0x9C, 0xF1, 0x9F, 0xC, 0xF8, 0x98, 0xDF, 0x3A, 0x95, 0x13, 0x39, 0x54, 0x4F, 0x44, 0x4F, 0xD2, 0x95, 0x33, 0x51, 0xEB, 0x94, 0x5D, 0x2A, 0x7E, 0x9E, 0x82, 0xC3, 0x6E, 0x1E, 0xEB, 0xD4, 0x53, 0xD4, 0xCE, 0xCA, 0x32, 0xE1, 0xC2, 0x69, 0x59, 0xE6, 0xEB, 0xCA, 0xB, 0xB6, 0x7, 0x1, 0x7E, 0xC2, 0x36, 0xF1, 0xB3, 0xAF, 0x95, 0x3D, 0x55, 0x3C, 0x19, 0xF0, 0xC1, 0xA2, 0x75, 0x9A, 0x7C, 0x4E, 0xB6, 0xFC, 0xDE, 0x14, 0xB6, 0xF9, 0x39, 0x8F, 0xAA, 0x7B, 0xFA, 0x24, 0x5D, 0xF1, 0x4F, 0x5B, 0x67, 0x73, 0xCD, 0x55, 0xBC, 0xB5, 0x7, 0xE5, 0x82, 0xBB, 0xD6, 0xBA, 0x30, 0xD1, 0xAF, 0x1A, 0x9F, 0x48, 0x4B, 0x81, 0x1F, 0x2C, 0x75, 0x9B, 0xA3, 0xFC, 0x8E, 0x8, 0xF3, 0x53, 0x5E, 0x42, 0xD1, 0x9, 0xCF, 0xCB, 0xE8, 0x67, 0x55, 0x5F, 0x34, 0x15, 0xCE, 0xA5, 0x45, 0x93, 0xBF, 0x5B, 0x6E, 0x4F, 0x95, 0x21, 0x64, 0x29, 0xEB, 0x17, 0x69, 0x24, 0x9D, 0x51, 0xF1, 0xC0, 0x20, 0x2D, 0x9C, 0x6, 0x4C, 0x9A, 0x7B, 0xF1, 0x35, 0x23, 0x88, 0x86, 0x20, 0x27, 0x55, 0xDD, 0x1F, 0x24, 0xC2, 0x95, 0x1D, 0x2, 0x79, 0xB9, 0xCC, 0xB6, 0xAF, 0x96, 0xD9, 0x60, 0xFB, 0x58, 0xBB, 0xEC, 0x90, 0x9, 0x17, 0xEE, 0x39, 0xD8, 0x60, 0xDD, 0xF0, 0x1C, 0x2A, 0x91, 0x24, 0x6F, 0x6, 0xDA, 0x1B, 0x4F, 0x3E, 0xE5, 0x20, 0xFC, 0x2, 0xDB, 0x6A, 0x7C, 0x64, 0x4F, 0x0, 0x32, 0x8A, 0xFA, 0xFF, 0x6D, 0xC8, 0x96, 0x81, 0xCF, 0x57, 0x73, 0x10, 0xE0, 0x8C, 0x35, 0x96, 0x74, 0x7E, 0x5A, 0xC4, 0xF9, 0x49, 0xDD, 0x64, 0xB6, 0xCD, 0x6B, 0xCC, 0x76, 0x57, 0xA4, 0x99, 0x4A, 0x3F, 0xB7, 0x45, 0xB5, 0x92, 0x26, 0x58, 0x32, 0xF1, 0xC6, 0x1B, 0x5, 0xF2, 0x6F, 0xD8, 0x97, 0x4A, 0xB, 0x5B, 0x65, 0x99, 0xC7, 0x50, 0x3E, 0x38, 0xE4, 0x9A, 0xA7, 0x1A, 0xA2, 0x59, 0xF3, 0x1B, 0xB9, 0x96, 0xD9, 0x80, 0x75, 0xC, 0x95, 0xB6, 0xA0, 0x99, 0xB2, 0x80, 0xFA, 0x63, 0xCD, 0xBF, 0xE4, 0xC4, 0x64, 0x1F, 0x74, 0x2A, 0xAE, 0x2, 0x1, 0x77, 0x93, 0xE9, 0x7C, 0xA1, 0x48, 0xAA, 0x50, 0xAA, 0x67, 0x36, 0xBB, 0x25, 0x99, 0x5A, 0xB4, 0x9D, 0x55, 0xD4, 0x53, 0x9D, 0xB2, 0xF3, 0xCD, 0x3E, 0xE9, 0xB9, 0xDC, 0x8A, 0x1E, 0xCC, 0xAD, 0xE8, 0xC9, 0x7D, 0x83, 0x7, 0x36, 0xD, 0x9E, 0xCC, 0xC0, 0xE0, 0x81, 0x4, 0xC, 0x7E, 0x21, 0xD7, 0xF4, 0x7E, 0x41, 0x96, 0xF3, 0x9E, 0x7E, 0x44, 0xDA, 0x43, 0xAF, 0x47, 0x7B, 0x2E, 0x93, 0xA4, 0x47, 0x33, 0x49, 0x7A, 0xD2, 0x60, 0x79, 0xC0, 0x60, 0x51, 0xF5, 0xDC, 0xAC, 0xE4, 0xC0, 0x1D, 0x51, 0x6, 0xF4, 0x88, 0x32, 0x70, 0x3D, 0x24, 0x80, 0x3D, 0x24, 0x90, 0x3D, 0x24, 0x0, 0x3D, 0x24, 0x70, 0xB5, 0x1D, 0xD0, 0xDA, 0x26, 0x6F, 0x9C, 0xB4, 0xEF, 0x9A, 0x90, 0x97, 0xC6, 0xDB, 0x37, 0xC6, 0x3, 0xD9, 0xB6, 0x1, 0x68, 0x5B, 0xAA, 0x47, 0x36, 0xD3, 0x19, 0x47, 0x69, 0x86, 0x59, 0xF2, 0x9E, 0xE9, 0x3, 0x7, 0x17, 0xB, 0x51, 0x9A, 0xEA, 0x83, 0xF1, 0x7, 0x8E, 0x6A, 0x9B, 0x1A, 0xC1, 0x17, 0x71, 0xBA, 0x16, 0x79, 0x11, 0xD5, 0x52, 0xBD, 0xE0, 0x95, 0xF0, 0xB, 0x81, 0x7A, 0xF9, 0xA1, 0xC3, 0xEA, 0x32, 0xBD, 0x3C, 0x85, 0x78, 0x61, 0x91, 0x1E, 0x97, 0xCA, 0xD, 0xB1, 0xB8, 0xE0, 0xD7, 0xC2, 0xE2, 0x3A, 0xDB, 0xE8, 0xF9, 0x65, 0xE0, 0x81, 0xA3, 0x2D, 0x61, 0xC4, 0x22, 0x42, 0x35, 0x82, 0xEA, 0xA0, 0x5C, 0x8, 0xB, 0x89, 0x66, 0x50, 0x95, 0x2, 0x26, 0x14, 0x2E, 0x9E, 0x22, 0x53, 0xD5, 0x45, 0xA3, 0xBA, 0x76, 0xBA, 0xBA, 0x68, 0x3D, 0xF3, 0x3A, 0x5E, 0x46, 0x80, 0x49, 0x3A, 0x6E, 0x93, 0xDD, 0xC9, 0x15, 0x42, 0xDC, 0xB2, 0x81, 0x6B, 0xAF, 0x10, 0x72, 0xCC, 0xA7, 0x56, 0xD5, 0xDE, 0x59, 0x28, 0x57, 0x3A, 0xA8, 0x3B, 0x32, 0xC, 0x30, 0xFB, 0x9C, 0x8C, 0xE3, 0x57, 0x1C, 0xA2, 0xCB, 0xF7, 0x2, 0xAA, 0xC9, 0xA, 0xF, 0xCE, 0x95, 0x74, 0x85, 0xD1, 0x69, 0x61, 0x98, 0x19, 0xF5, 0xC0, 0x90, 0x69, 0x35, 0x3A, 0xEB, 0x84, 0xA9, 0xF, 0xC7, 0x4C, 0xB9, 0x34, 0x1E, 0xF3, 0x83, 0x1C, 0xEC, 0x4E, 0x23, 0xC3, 0xF0, 0x3E, 0x23, 0xFA, 0xFC, 0x58, 0xEF, 0x51, 0x45, 0x7C, 0xC8, 0xD6, 0xEF, 0x3C, 0x38, 0x76, 0xAB, 0x88, 0x99, 0x4A, 0x2C, 0xD8, 0xC9, 0xB8, 0xA0, 0x92, 0x9C, 0x3A, 0xA3, 0x7, 0xA6, 0xEC, 0xE8, 0xA5, 0xE4, 0x5F, 0xE7, 0xCE, 0x28, 0x3A, 0xBC, 0x79, 0x1D, 0xA0, 0xF0, 0x20, 0x4D, 0x75, 0x5A, 0xFA, 0x70, 0xAE, 0x14, 0x89, 0xCC, 0x63, 0x95, 0x11, 0x20, 0x95, 0x55, 0xD1, 0x40, 0xB9, 0x9B, 0xE, 0xD, 0x4D, 0x8F, 0x53, 0xE6, 0xD1, 0x76, 0x2B, 0x6B, 0x0, 0xEC, 0x56, 0xE2, 0x91, 0x7, 0x84, 0x45, 0x43, 0xFB, 0x98, 0x30, 0xF3, 0xC8, 0xC0, 0x89, 0xA2, 0xA1, 0x1D, 0x3A, 0x91, 0x79, 0xBB, 0x74, 0xD2, 0xA8, 0xA9, 0x76, 0xB5, 0xF5, 0xF, 0x1E, 0xDC, 0x4E, 0x8A, 0xCA, 0x65, 0x93, 0x7F, 0x6, 0x44, 0x5, 0x99, 0x92, 0x33, 0x23, 0x40, 0x56, 0xCE, 0xCC, 0x23, 0xF7, 0xE9, 0x45, 0x43, 0x7B, 0x8F, 0x9E, 0x78, 0x95, 0x1A, 0x81, 0x73, 0x7F, 0x47, 0xCF, 0x5C, 0x2, 0x88, 0xBE, 0x83, 0x2E, 0x2, 0xC4, 0xE0, 0x63, 0xE5, 0x9E, 0x57, 0x82, 0xF1, 0x6B, 0x5E, 0x31, 0xBF, 0x64, 0xFB, 0xA8, 0x44, 0x4A, 0x9D, 0xC, 0x38, 0x58, 0x2A, 0x86, 0x48, 0xE6, 0x7E, 0x2D, 0xBF, 0x23, 0xC2, 0x74, 0x4B, 0x27, 0x4, 0x68, 0x69, 0xCA, 0x10, 0x34, 0x2D, 0x0, 0x35, 0xF6, 0x9B, 0x83, 0xFE, 0xC1, 0xAD, 0x34, 0x1E, 0xE0, 0x42, 0x83, 0x7A, 0xCC, 0x94, 0x7B, 0xC9, 0xF4, 0x23, 0x44, 0xC6, 0x65, 0xFD, 0x2, 0xA2, 0xB2, 0xA8, 0xA3, 0xF6, 0xE6, 0x39, 0xFB, 0xF3, 0xE7, 0xA6, 0x9C, 0x7C, 0x88, 0x75, 0xA5, 0xF7, 0x9D, 0x1F, 0x1C, 0xDA, 0x7D, 0x3E, 0x7F, 0x26, 0x11, 0xE4, 0x43, 0x3C, 0xC9, 0xF7, 0xAB, 0xDE, 0x28, 0xF2, 0x7E, 0xD5, 0xF3, 0xC7, 0xD9, 0x18, 0x24, 0xFD, 0x12, 0xD6, 0x9, 0x62, 0x8A, 0xF8, 0x17, 0xB5, 0x9E, 0xF8, 0x7B, 0x5A, 0xCF, 0x9F, 0x87, 0x30, 0x8C, 0x92, 0x87, 0x30, 0x90, 0xA, 0x31, 0x4, 0xB5, 0xCB, 0x51, 0x39, 0x39, 0x4, 0xEB, 0x98, 0x17, 0x8D, 0xFF, 0x64, 0x41, 0x59, 0x1, 0x9E, 0xC2, 0x47, 0x1F, 0x2C, 0x93, 0x61, 0xE0, 0x44, 0x31, 0x55, 0xC3, 0x28, 0xA9, 0x5, 0xE2, 0x73, 0x18, 0x3B, 0xD9, 0x5E, 0x1C, 0x3E, 0xF3, 0x95, 0x6A, 0x32, 0x69, 0x70, 0x81, 0x90, 0x27, 0x1F, 0x9F, 0xA3, 0x8, 0xC1, 0x32, 0x1F, 0x93, 0x31, 0xEC, 0x53, 0x32, 0x95, 0x3E, 0x9B, 0x3E, 0xD4, 0x20, 0x27, 0xD3, 0xCF, 0x71, 0x34, 0xDE, 0xD9, 0x61, 0xA1, 0xEC, 0x7A, 0x6, 0xBB, 0x82, 0xB6, 0x15, 0x29, 0xEF, 0xA5, 0x61, 0x5E, 0x62, 0x3A, 0x51, 0xB0, 0x4C, 0xB6, 0x57, 0xDE, 0x73, 0x55, 0x94, 0x41, 0xB0, 0x8E, 0x9C, 0xED, 0xD9, 0xAA, 0x4E, 0x18, 0xA8, 0xC6, 0x6B, 0x72, 0x70, 0x8C, 0x1E, 0x79, 0x41, 0xF8, 0x29, 0x6F, 0x37, 0x39, 0x70, 0x15, 0x53, 0x40, 0xAC, 0x5E, 0x38, 0xF, 0xDA, 0x13, 0xF5, 0xA0, 0x3D, 0x6F, 0xC2, 0x7, 0x26, 0x81, 0x41, 0xC6, 0xB0, 0xF4, 0x5, 0x99, 0xEA, 0x37, 0x31, 0x90, 0xB5, 0x92, 0xF5, 0x14, 0xB4, 0xAD, 0xA8, 0x6E, 0xA1, 0xAF, 0x19, 0xE8, 0xFC, 0xEA, 0xDF, 0x60, 0x75, 0xDA, 0xB1, 0x7, 0x72, 0x90, 0x96, 0x77, 0x1, 0x3F, 0x94, 0xB6, 0x4A, 0xA7, 0xC2, 0x8B, 0x69, 0xB9, 0x8C, 0x61, 0xD, 0x77, 0xB, 0x1B, 0x1D, 0xCA, 0x9F, 0x10, 0x28, 0x92, 0x3F, 0x11, 0xB9, 0x4A, 0xA, 0x1B, 0x56, 0x3F, 0xB3, 0xD5, 0xF2, 0xA7, 0xA4, 0x63, 0xA1, 0x8D, 0xFD, 0x17, 0x1, 0xB2, 0xF9, 0x64, 0xBA, 0xE9, 0x27, 0x90, 0x6D, 0xFA, 0xA9, 0xB6, 0xD4, 0xD1, 0x98, 0x91, 0x59, 0x40, 0x6C, 0x68, 0xDE, 0x95, 0xB1, 0xA4, 0xB, 0x3E, 0x23, 0x88, 0x17, 0xFE, 0xB9, 0xD8, 0x55, 0xA8, 0x9D, 0xBC, 0xFE, 0x72, 0x60, 0xC0, 0x25, 0x98, 0xA7, 0x16, 0x6A, 0xCD, 0x59, 0x70, 0xC8, 0x54, 0x9D, 0x7, 0x9C, 0xDF, 0x2C, 0x64, 0x73, 0x76, 0x7E, 0x95, 0xA9, 0xBC, 0x50, 0x3C, 0x30, 0x3D, 0xEF, 0x40, 0xB1, 0xBE, 0x67, 0x17, 0x5B, 0xF3, 0x7, 0x25, 0xFC, 0x82, 0x33, 0xE8, 0xB9, 0xC9, 0x6D, 0x55, 0x86, 0xBA, 0xA4, 0x94, 0xA0, 0x2E, 0x63, 0xCD, 0x32, 0x6D, 0x32, 0xF8, 0x7, 0x39, 0xE3, 0x24, 0xA4, 0x7B, 0x0, 0xD3, 0xCD, 0x36, 0xDB, 0x60, 0xFD, 0x40, 0xED, 0xCB, 0xE, 0xAC, 0x1B, 0xDA, 0xF, 0xAF, 0x3D, 0x37, 0x45, 0xE6, 0xD3, 0x7F, 0x6E, 0x8A, 0x4F, 0xA8, 0xFF, 0x2D, 0xEE, 0x7F, 0x31, 0x6D, 0xB4, 0xF9, 0x5F, 0x58, 0xFB, 0xFC, 0x6B, 0xC4, 0x96, 0x17, 0xF, 0xCC, 0x68, 0x78, 0xE3, 0xD8, 0x80, 0x70, 0x62, 0x97, 0x66, 0xEC, 0x19, 0x65, 0x7, 0x8A, 0xA9, 0xF2, 0x72, 0x88, 0x4E, 0x85, 0xE7, 0x20, 0xB4, 0x74, 0x82, 0xD1, 0xF8, 0x3B, 0xE9, 0xFF, 0xA2, 0x98, 0xA9, 0xC0, 0x3F, 0x14, 0x43, 0x75, 0xF9, 0xBB, 0xC, 0x63, 0x2F, 0xFF, 0x50, 0xC, 0x99, 0x4E, 0x3F, 0xDB, 0xAD, 0x5F, 0xD3, 0x42, 0x88, 0xAB, 0x86, 0x37, 0x1, 0xAC, 0x82, 0xD9, 0x6E, 0xEA, 0xC6, 0x2D, 0x93, 0xF, 0x14, 0x54, 0xB5, 0x39, 0x29, 0xC6, 0x24, 0x22, 0xE8, 0x8A, 0x7C, 0xE3, 0x9D, 0x40, 0xEA, 0xF0, 0x4D, 0xEF, 0xC9, 0x49, 0xE2, 0x4B, 0x5D, 0xF, 0xCC, 0x11, 0x1F, 0xFA, 0x40, 0x5A, 0xD8, 0x2F, 0x75, 0x3, 0x67, 0x65, 0x69, 0x75, 0x54, 0xEC, 0xF6, 0x6F, 0xEA, 0xB8, 0x20, 0x6E, 0x5A, 0x1D, 0xD3, 0x12, 0x5F, 0xFA, 0xAE, 0x35, 0x6, 0xF7, 0x86, 0xED, 0x1F, 0x2A, 0x91, 0x27, 0x6C, 0x7F, 0xD3, 0x4A, 0xBE, 0xD2, 0xF8, 0xBB, 0x52, 0xFE, 0xC9, 0x46, 0x52, 0x27, 0xBD, 0x18, 0xF8, 0xD2, 0x88, 0xAC, 0x5, 0xBE, 0xD4, 0xB5, 0xA, 0x38, 0x5E, 0x2A, 0x1D, 0x19, 0x32, 0xF4, 0xAD, 0x8E, 0xD, 0x1B, 0x22, 0xD5, 0xDD, 0xA8, 0x5B, 0x24, 0xDF, 0xEA, 0x6E, 0xDC, 0x8D, 0x12, 0x5A, 0x1D, 0xE5, 0xF0, 0xF9, 0x4D, 0x5D, 0xFB, 0x68, 0xF8, 0x8B, 0x4E, 0x46, 0xF4, 0x7C, 0xAB, 0x63, 0x63, 0x7B, 0x68, 0x75, 0x2D, 0x83, 0xC0, 0x9E, 0x7C, 0x93, 0xEA, 0xFE, 0xFF, 0xAC, 0xBD, 0xED, 0xB2, 0xA3, 0xB8, 0xE, 0x2E, 0x7C, 0x3F, 0x53, 0x6F, 0xA8, 0x33, 0xB3, 0xF7, 0xA9, 0x7D, 0x3D, 0x6, 0x1C, 0xF0, 0x2, 0x2C, 0xDA, 0x36, 0xC9, 0x4A, 0xAE, 0xFE, 0x2D, 0x1B, 0x48, 0xD2, 0xDD, 0x92, 0xFD, 0xB8, 0xE7, 0xFC, 0x59, 0xD5, 0xCD, 0x23, 0x29, 0xE0, 0xF, 0x59, 0xB6, 0xF5, 0x21, 0x69, 0xEA, 0xB7, 0x3C, 0x4C, 0x4B, 0xBF, 0x5, 0x72, 0x67, 0x50, 0x3F, 0xC9, 0x2B, 0x97, 0x9A, 0xFF, 0x14, 0xC7, 0x2E, 0xE5, 0x9F, 0xE2, 0x80, 0x25, 0xFD, 0x4D, 0xCE, 0x9E, 0x90, 0x7D, 0x8A, 0x3, 0xA, 0xE1, 0x7F, 0x90, 0xB3, 0x8E, 0x31, 0x9F, 0xE2, 0x44, 0x1F, 0x99, 0xF, 0x71, 0xC1, 0x78, 0xDE, 0xDC, 0xD, 0xC6, 0x43, 0xD6, 0x6E, 0xD8, 0x66, 0xAD, 0x24, 0x6D, 0xB7, 0x83, 0x98, 0xA6, 0xBB, 0xD9, 0x4E, 0x90, 0x72, 0xB3, 0x1D, 0x26, 0xE2, 0x6E, 0x9C, 0x16, 0x16, 0xE9, 0x8, 0x41, 0x4B, 0x33, 0xB7, 0xAB, 0x2A, 0xC5, 0x4A, 0xDA, 0xFF, 0x6, 0xCE, 0xEA, 0xB3, 0xFF, 0xD, 0x80, 0xD1, 0xC7, 0x1D, 0x62, 0x16, 0x4F, 0x30, 0xAD, 0xFA, 0xEF, 0xC6, 0xF5, 0x5B, 0x7C, 0xE, 0x74, 0x9B, 0x55, 0x6A, 0xD1, 0xFE, 0x4B, 0xDF, 0x36, 0xBD, 0x18, 0x36, 0x8C, 0xEC, 0x67, 0x8A, 0xF2, 0xAD, 0x8D, 0x55, 0x4C, 0x0, 0x7E, 0x7C, 0x58, 0x64, 0x53, 0x4E, 0xCA, 0xE3, 0xB5, 0x83, 0x58, 0x96, 0x16, 0xAB, 0xBA, 0xD1, 0x4C, 0x2A, 0xF8, 0x6D, 0x73, 0xD9, 0xE2, 0x86, 0x3F, 0x11, 0x56, 0x64, 0x95, 0xB3, 0x6A, 0x50, 0x63, 0xCE, 0xAF, 0xFE, 0x24, 0x40, 0xFD, 0xEA, 0x23, 0xBD, 0xC9, 0xDD, 0x5A, 0x27, 0x82, 0x8A, 0x2B, 0xEB, 0x3D, 0x8, 0x77, 0x54, 0x4E, 0x89, 0x89, 0xF1, 0xDF, 0x24, 0x68, 0x6A, 0x7C, 0x39, 0xFA, 0x17, 0x8D, 0xF9, 0xDD, 0xE9, 0x8A, 0x72, 0x6A, 0xC4, 0xD1, 0xA4, 0xA6, 0x87, 0xEC, 0x92, 0xF2, 0x26, 0x41, 0x1D, 0x53, 0xE, 0x8E, 0xCC, 0xBE, 0xF6, 0xA0, 0xC0, 0x77, 0xB6, 0x91, 0x21, 0x13, 0x76, 0xBF, 0xC3, 0xE8, 0x4D, 0x52, 0xA2, 0xD6, 0x8F, 0x5C, 0x69, 0xD2, 0x37, 0x49, 0x8D, 0x50, 0x2F, 0xC4, 0x4F, 0x9C, 0x18, 0x2E, 0x5, 0x92, 0x56, 0x29, 0x76, 0xCB, 0x25, 0x19, 0xDF, 0x29, 0x2A, 0xF2, 0x8B, 0x47, 0x86, 0x40, 0xD9, 0x3C, 0x4E, 0x27, 0x9, 0x1E, 0xDF, 0xB1, 0x73, 0x64, 0xB3, 0xD3, 0xED, 0x24, 0x35, 0xE9, 0xE9, 0xF6, 0x1C, 0x6A, 0xF9, 0x29, 0x53, 0x91, 0xEB, 0xD6, 0xAA, 0x21, 0x97, 0x8E, 0x35, 0xC2, 0x78, 0x32, 0xD6, 0x48, 0xED, 0xF3, 0xD3, 0xD9, 0xF8, 0xAA, 0xE9, 0x9C, 0xAB, 0xAB, 0x10, 0x61, 0x3C, 0xD0, 0x20, 0x52, 0x3F, 0xD8, 0xE5, 0x34, 0x3D, 0x47, 0x98, 0xB9, 0x97, 0xF8, 0xAB, 0xD9, 0xB1, 0x5F, 0x5F, 0xE0, 0xF7, 0xE4, 0x2C, 0x56, 0x8D, 0x2A, 0xFB, 0x35, 0xA3, 0xAA, 0xF9, 0x9A, 0x51, 0x39, 0x23, 0xBA, 0xF9, 0xEC, 0x30, 0xEA, 0xE6, 0x63, 0x95, 0xD1, 0x39, 0x2F, 0xA4, 0x88, 0x57, 0xB8, 0x20, 0xF1, 0x79, 0x7, 0x90, 0x9C, 0x3, 0x56, 0x4D, 0xAA, 0xA7, 0x45, 0x39, 0x23, 0xDF, 0xDC, 0xBF, 0x69, 0xE0, 0xEB, 0xFB, 0xC8, 0x12, 0x27, 0x45, 0xC6, 0x19, 0xF0, 0x24, 0xC1, 0x9D, 0x1, 0x4F, 0x8E, 0x7C, 0xC3, 0x1D, 0x52, 0x6B, 0x1A, 0x6F, 0x2A, 0x4E, 0xF0, 0xA9, 0x76, 0x8A, 0xEF, 0xC, 0x59, 0xCF, 0xD4, 0x97, 0xD4, 0xA, 0xEF, 0xD4, 0xC4, 0xB3, 0xF9, 0xD4, 0x62, 0x99, 0x71, 0x7D, 0x12, 0xD5, 0x8C, 0xEE, 0x49, 0xD, 0xD9, 0x45, 0x3C, 0xE1, 0xF8, 0xA, 0x3E, 0xE5, 0xB, 0x50, 0x47, 0x82, 0x9A, 0xD4, 0x6D, 0x91, 0x3E, 0x13, 0x96, 0x99, 0xC4, 0xC1, 0x41, 0x99, 0x49, 0x58, 0x2E, 0x7B, 0x66, 0x22, 0xA8, 0xC8, 0x9E, 0x99, 0xEA, 0x1B, 0x95, 0x6, 0x7B, 0x4D, 0xBA, 0xC4, 0xBD, 0x60, 0x52, 0x37, 0x9A, 0x6C, 0x5E, 0xFA, 0x17, 0x55, 0x4D, 0x6A, 0xFA, 0xC4, 0xB4, 0x65, 0x4A, 0x79, 0x9F, 0x4, 0xB8, 0x2E, 0x4B, 0xA6, 0xDA, 0x94, 0xC6, 0xBB, 0xBC, 0xAA, 0xBD, 0x88, 0x9A, 0x23, 0xF2, 0xA, 0x13, 0x4C, 0x5F, 0x94, 0xB1, 0x9F, 0x77, 0x2, 0xDC, 0x7A, 0x9E, 0xA, 0xB6, 0xEF, 0x54, 0x67, 0xFB, 0x46, 0xF2, 0x40, 0xC6, 0x8F, 0xE9, 0xC3, 0x64, 0x91, 0x81, 0x9A, 0x93, 0x8, 0x17, 0x2B, 0x38, 0x68, 0x1E, 0x2F, 0x9, 0x7A, 0x67, 0x46, 0x6A, 0x1F, 0xB7, 0x57, 0x56, 0x65, 0x83, 0x45, 0x3F, 0xE9, 0x2A, 0x75, 0x67, 0xB1, 0x8A, 0xCB, 0x49, 0x54, 0x53, 0xC3, 0x25, 0xF1, 0x0, 0x99, 0x41, 0x3E, 0xE9, 0x6A, 0xDF, 0xDB, 0x6F, 0x71, 0x76, 0x4B, 0xF1, 0x86, 0x1F, 0x24, 0x60, 0xD0, 0xA1, 0x3D, 0x76, 0xAF, 0xF9, 0x5D, 0xE4, 0x54, 0xEB, 0xFB, 0x6C, 0xA3, 0xCE, 0x33, 0x36, 0xAF, 0xF0, 0x23, 0x45, 0x95, 0xB6, 0x9F, 0xD9, 0x68, 0xB5, 0x4, 0x94, 0x1D, 0xC4, 0xAC, 0x9A, 0xE3, 0xB6, 0x9D, 0x3F, 0xF2, 0x48, 0x10, 0x74, 0xEA, 0x91, 0x28, 0x39, 0x97, 0xEE, 0x53, 0x48, 0xD9, 0xA3, 0xDB, 0xAA, 0xA5, 0x8F, 0xFF, 0x36, 0x3D, 0x7F, 0x6C, 0x72, 0xA2, 0xC8, 0x91, 0x9, 0xE7, 0x5, 0x92, 0x9E, 0x96, 0x19, 0xB9, 0xCA, 0xEA, 0xF1, 0x79, 0xB9, 0xB4, 0x7A, 0xA4, 0xEA, 0x18, 0x6F, 0x86, 0xC4, 0xDD, 0x95, 0xBC, 0xE0, 0x22, 0x15, 0x57, 0x7D, 0x3B, 0x71, 0x17, 0xCB, 0x6F, 0x27, 0x6E, 0xD6, 0x70, 0x8C, 0xDC, 0x65, 0xD3, 0x71, 0xD1, 0x5C, 0xF5, 0xEE, 0xC4, 0x5D, 0x2C, 0xDF, 0x1D, 0xA9, 0xE2, 0xF8, 0xCF, 0x2E, 0xEA, 0x3B, 0x49, 0xCD, 0xC2, 0x1E, 0x39, 0xEE, 0xB9, 0x9C, 0x1, 0x27, 0x49, 0xCD, 0x9E, 0x72, 0xD1, 0x23, 0x73, 0xF, 0x90, 0xBE, 0x73, 0x2C, 0xB9, 0x7D, 0x46, 0x2A, 0xAE, 0x6A, 0x75, 0xE2, 0x2E, 0x96, 0xAD, 0x8E, 0x54, 0x5C, 0xCA, 0xD0, 0xC4, 0x5D, 0xCC, 0x1B, 0x9A, 0xB8, 0x19, 0xB7, 0xD3, 0x9D, 0xBB, 0xE4, 0x7B, 0x9A, 0xB8, 0xA5, 0x91, 0x59, 0xF4, 0xCF, 0x8C, 0x54, 0xFC, 0x59, 0xEA, 0xA2, 0xCB, 0x2E, 0xA1, 0x89, 0x5B, 0x1A, 0xD7, 0xC5, 0x44, 0x7D, 0x91, 0x6A, 0x95, 0x7A, 0x6C, 0x45, 0x7A, 0x8C, 0xAB, 0xF4, 0x9B, 0xB8, 0x8B, 0xA5, 0x7E, 0x23, 0x95, 0x2B, 0x1A, 0x43, 0x27, 0x4D, 0x85, 0x2D, 0xB4, 0x68, 0xAE, 0x2A, 0x6E, 0x7A, 0xA9, 0x62, 0x59, 0xDC, 0xC4, 0x2D, 0x35, 0x48, 0x31, 0xDC, 0x30, 0x71, 0x4B, 0x6A, 0xA2, 0xE8, 0xBC, 0x1F, 0xA9, 0x6E, 0x4C, 0x74, 0x64, 0xE2, 0xBE, 0x95, 0x22, 0x24, 0x53, 0xB1, 0xC5, 0x6C, 0x75, 0x8E, 0x44, 0x51, 0x53, 0x9B, 0x23, 0x95, 0xC, 0x54, 0x41, 0xE9, 0xD1, 0x2C, 0xC2, 0x41, 0xF7, 0x4E, 0xD0, 0x24, 0xA, 0x48, 0xA0, 0x27, 0x36, 0x5B, 0xC5, 0x8E, 0x40, 0x6B, 0x8F, 0xF7, 0x13, 0xD, 0x8A, 0x4D, 0xBD, 0xF1, 0x46, 0x11, 0x49, 0x56, 0x15, 0x4E, 0x16, 0xAC, 0xAA, 0x3B, 0x5A, 0xB0, 0xAA, 0x60, 0xE1, 0x5A, 0x55, 0x67, 0xDE, 0xDA, 0x76, 0xCB, 0x14, 0xFC, 0x49, 0x38, 0x5E, 0xEF, 0x27, 0x91, 0x17, 0x76, 0x49, 0xB6, 0xAE, 0x78, 0x97, 0x55, 0x76, 0xA0, 0xC2, 0x98, 0xB3, 0x3, 0xD5, 0x8D, 0x39, 0xFB, 0x95, 0x3F, 0x58, 0xB3, 0x5F, 0x55, 0x27, 0x6B, 0x76, 0xA2, 0x69, 0xCB, 0x6C, 0xE1, 0x12, 0x8E, 0xEF, 0xE0, 0xEC, 0x42, 0xD3, 0x96, 0xD9, 0x67, 0x25, 0x1C, 0xDF, 0x66, 0x59, 0xAB, 0x7D, 0x50, 0x82, 0x49, 0x76, 0x80, 0xD0, 0x58, 0x5E, 0xC9, 0x65, 0xEB, 0x9B, 0x1E, 0x24, 0x75, 0xE3, 0x39, 0x28, 0x9B, 0x39, 0xE7, 0x88, 0x30, 0x7E, 0xCC, 0x61, 0x3, 0xE5, 0xF4, 0xBC, 0x4D, 0x95, 0xB, 0x51, 0x15, 0x6F, 0x1F, 0x94, 0xDF, 0x3B, 0xD8, 0x7, 0x55, 0x6D, 0x1C, 0xF6, 0xDD, 0x96, 0x5C, 0x58, 0xE5, 0xA4, 0x80, 0x4B, 0xAB, 0x58, 0xB5, 0xCE, 0x9A, 0xCD, 0xC4, 0xB0, 0x23, 0xC8, 0x81, 0xE3, 0x4A, 0xB3, 0x11, 0x24, 0xD0, 0x6C, 0x10, 0x9, 0x7C, 0x65, 0xF, 0xAC, 0xA4, 0x47, 0xA4, 0xCA, 0x4A, 0xC0, 0xC5, 0xA4, 0x30, 0xC9, 0xCC, 0xD, 0xD0, 0x41, 0x81, 0x5F, 0x0, 0x39, 0x13, 0x72, 0x37, 0x4A, 0x11, 0xAE, 0x10, 0x46, 0xF, 0x7E, 0xFE, 0x39, 0x7A, 0x20, 0x73, 0xCF, 0xA5, 0xFC, 0xF8, 0x5, 0xCD, 0xBA, 0x13, 0xD5, 0x29, 0x57, 0xB7, 0xA5, 0x6A, 0x9F, 0x39, 0xED, 0x1A, 0x49, 0xEA, 0x8E, 0x45, 0xDD, 0xCD, 0x98, 0x89, 0x4D, 0x15, 0x7E, 0x62, 0xD0, 0x37, 0xB, 0x59, 0xA3, 0x76, 0x4, 0x91, 0xE0, 0xE3, 0x2B, 0xA7, 0x1C, 0xDE, 0x99, 0xDC, 0x51, 0x9F, 0x64, 0x78, 0x6, 0xA9, 0xC8, 0x55, 0x10, 0x5A, 0x23, 0x2C, 0xA8, 0x99, 0x8B, 0xDB, 0x4D, 0x40, 0x39, 0x72, 0xD7, 0xAA, 0x60, 0xC8, 0xAA, 0xF9, 0x6A, 0x9C, 0x56, 0x6E, 0x11, 0xB, 0x9E, 0xFE, 0x4A, 0x87, 0xC5, 0x22, 0x9E, 0x5C, 0xA3, 0x8E, 0x63, 0x7E, 0x10, 0x13, 0x0, 0xFC, 0x4A, 0x57, 0x27, 0xBD, 0x24, 0x15, 0x97, 0x76, 0xD3, 0xC9, 0xA2, 0xEF, 0x94, 0x98, 0x23, 0xE3, 0x67, 0x2A, 0x58, 0x32, 0x39, 0x23, 0x87, 0xE6, 0xEF, 0x38, 0x1C, 0x9A, 0x6F, 0x55, 0xE0, 0xB2, 0x94, 0x58, 0x15, 0xCA, 0x45, 0x71, 0xAD, 0xA, 0x9B, 0x63, 0xF7, 0x44, 0xE9, 0x39, 0xC2, 0x3C, 0x73, 0x41, 0xAE, 0x7, 0x54, 0x8E, 0x72, 0x3D, 0x8, 0x47, 0xE3, 0x3, 0x39, 0x31, 0xBF, 0xEE, 0xCF, 0x54, 0x70, 0x2B, 0xFF, 0x26, 0x19, 0x93, 0x7F, 0x6, 0x61, 0x57, 0xFC, 0x8A, 0xEF, 0x8C, 0xB6, 0x9D, 0xCE, 0x4D, 0x97, 0x4F, 0xB2, 0xA, 0xD9, 0xAD, 0xDB, 0x26, 0x3F, 0x3C, 0x16, 0xCB, 0xF9, 0xA1, 0xFD, 0x4C, 0x51, 0xCE, 0xD6, 0x94, 0xE8, 0x73, 0xB3, 0x6E, 0x73, 0xF8, 0x5C, 0xDB, 0xDC, 0xDE, 0x5E, 0xC6, 0x77, 0x63, 0xE1, 0xCB, 0x3F, 0x9, 0x71, 0xF9, 0x9B, 0xBB, 0xEB, 0xA0, 0xAD, 0xEF, 0x46, 0xB5, 0xAE, 0x72, 0x9A, 0x1A, 0x86, 0x14, 0xFD, 0x8D, 0x6D, 0x69, 0x37, 0x27, 0x86, 0x9D, 0x9F, 0x38, 0x2C, 0xCD, 0x87, 0x5E, 0xCD, 0xFC, 0x3A, 0xB3, 0x63, 0xC8, 0x4A, 0x73, 0xCB, 0x29, 0xB0, 0x1B, 0xAE, 0xBD, 0x6E, 0x66, 0xD8, 0xF5, 0x1D, 0x9B, 0x2, 0xFF, 0x8D, 0x23, 0x39, 0xF0, 0xAD, 0xBA, 0x91, 0xE1, 0xF, 0x74, 0x6F, 0x64, 0xA0, 0xE3, 0xDC, 0xDB, 0x46, 0x81, 0xAF, 0xF9, 0x71, 0x62, 0x50, 0xE3, 0xB0, 0xE7, 0x56, 0xB7, 0xF2, 0xA1, 0xD5, 0xA3, 0xB8, 0xE1, 0x78, 0x54, 0xEE, 0x37, 0x5A, 0x4E, 0x6D, 0xB6, 0x45, 0x9D, 0xD9, 0x72, 0xFE, 0xF4, 0xB6, 0x95, 0xBD, 0xE8, 0xDF, 0x9C, 0xDC, 0xB9, 0x59, 0xD3, 0x16, 0xF, 0xCD, 0x98, 0x58, 0x1B, 0x5B, 0x4A, 0x80, 0x60, 0x3B, 0xF6, 0x58, 0xA9, 0x3, 0xE, 0x95, 0x3A, 0x2E, 0xD7, 0x80, 0xED, 0xCA, 0x79, 0x6, 0x2C, 0x5B, 0x11, 0xD9, 0x66, 0x6A, 0x21, 0xBF, 0x38, 0x39, 0xD5, 0x58, 0xD4, 0x85, 0x5A, 0x85, 0xCB, 0xE6, 0x66, 0xD6, 0x1B, 0xF6, 0x4, 0x11, 0x87, 0x58, 0xAB, 0x5B, 0xA7, 0xFC, 0x24, 0xA6, 0xCC, 0x3F, 0x71, 0x70, 0xE2, 0x6A, 0xAE, 0xBF, 0x74, 0xB1, 0xC3, 0x74, 0xEF, 0xF4, 0x45, 0x9B, 0x49, 0xB3, 0xE9, 0x6, 0x3F, 0x60, 0x60, 0x9A, 0xF1, 0xA7, 0x67, 0xC8, 0x89, 0x99, 0xE6, 0xCA, 0xC7, 0x58, 0x5D, 0x2E, 0x1E, 0x63, 0xF5, 0x44, 0x8A, 0x6D, 0xBC, 0x89, 0x1A, 0x5, 0xF4, 0x1, 0x97, 0xB, 0x30, 0xFE, 0x70, 0x71, 0xBC, 0xEA, 0x65, 0x2B, 0xA8, 0x86, 0x44, 0x51, 0xA3, 0x1A, 0xF4, 0xCA, 0x4, 0x13, 0x5A, 0xDD, 0xAC, 0xA5, 0xC, 0xF, 0x56, 0xBB, 0x7E, 0xA5, 0x99, 0x6E, 0x4C, 0x90, 0xCC, 0x81, 0x35, 0x11, 0x2C, 0xB6, 0x86, 0x33, 0x99, 0x1C, 0x43, 0x3B, 0xC, 0xDF, 0x34, 0x6B, 0xAF, 0x26, 0xED, 0xFC, 0xB8, 0xF1, 0x47, 0xA1, 0xDA, 0x37, 0x27, 0x8E, 0xC, 0x2D, 0xDF, 0x6E, 0x7E, 0xD2, 0x6E, 0xE3, 0xF, 0x91, 0xB4, 0x6F, 0x4E, 0x1C, 0x12, 0x26, 0xAC, 0x28, 0x11, 0x80, 0xF8, 0xA9, 0xEF, 0xF9, 0xB4, 0xC8, 0x27, 0x86, 0x4A, 0x9, 0xCA, 0xE, 0x39, 0x49, 0x3B, 0xE, 0x49, 0xF3, 0xBA, 0xE5, 0x77, 0xF8, 0x3B, 0x4, 0xCA, 0x60, 0x93, 0x9A, 0xED, 0x8, 0x22, 0x81, 0x3B, 0xC7, 0x29, 0xDF, 0x1C, 0xEA, 0x70, 0xB9, 0x3A, 0xAD, 0x84, 0x70, 0xBB, 0x37, 0x8C, 0xA9, 0xD5, 0xA0, 0x58, 0x55, 0x18, 0x1A, 0x55, 0xD6, 0x86, 0x41, 0xB1, 0x8A, 0x28, 0x34, 0xAA, 0xAC, 0x89, 0x82, 0xBA, 0xA, 0xBC, 0x57, 0x80, 0x97, 0x5B, 0xA5, 0x23, 0x6F, 0x71, 0x9D, 0xD6, 0x41, 0x31, 0x41, 0x4E, 0x89, 0xB7, 0x54, 0x2D, 0x27, 0xF2, 0x72, 0xB7, 0xFE, 0x91, 0xB7, 0x78, 0xE7, 0xAF, 0x3, 0xAF, 0x78, 0x83, 0xAC, 0x77, 0x3F, 0x79, 0x59, 0xBD, 0x1B, 0xCA, 0x79, 0x73, 0x22, 0x2F, 0x67, 0x51, 0x46, 0xDE, 0x52, 0xE6, 0xCA, 0xC8, 0xCB, 0xDD, 0xCC, 0x47, 0xDE, 0xE2, 0xC5, 0xBC, 0xE, 0xAC, 0xE9, 0xA6, 0x43, 0x53, 0xB6, 0xDE, 0x22, 0x2F, 0x17, 0x45, 0x7D, 0x2, 0x65, 0x76, 0x2E, 0x7E, 0x23, 0xFE, 0x74, 0x31, 0x84, 0x43, 0x87, 0x96, 0x5D, 0x15, 0x42, 0xD3, 0x96, 0xCA, 0xDE, 0x44, 0x5E, 0xA1, 0x8B, 0x5B, 0xA0, 0x8B, 0x5B, 0xEE, 0x74, 0x21, 0xF2, 0x16, 0x4F, 0x17, 0x74, 0x60, 0xF, 0x7, 0x22, 0x2F, 0xA0, 0x82, 0xD8, 0x63, 0xAC, 0xC8, 0xB, 0xC, 0xAD, 0x96, 0x33, 0x25, 0x23, 0x6F, 0xD9, 0x96, 0xC, 0x2D, 0xAF, 0xFC, 0x9A, 0x16, 0xD0, 0x7F, 0xAD, 0x30, 0x2C, 0x5B, 0x60, 0x58, 0x76, 0xC2, 0xF4, 0xEF, 0x80, 0xE9, 0xDF, 0x9, 0xFD, 0xDB, 0x1, 0xFD, 0xCB, 0x95, 0x78, 0x4B, 0xBC, 0x40, 0xFF, 0xB2, 0x1, 0x59, 0x3A, 0x13, 0x90, 0xF5, 0xC9, 0x2B, 0x4C, 0xFF, 0xE, 0x98, 0xFE, 0x1D, 0x6B, 0x61, 0x85, 0xA6, 0x2B, 0x9B, 0x58, 0x81, 0x75, 0xCB, 0x89, 0xBC, 0xC5, 0x6D, 0xA4, 0xE, 0xBD, 0xD0, 0xCE, 0x3D, 0xD0, 0xCE, 0xBD, 0xD0, 0x56, 0x3D, 0xD0, 0x56, 0xBD, 0x30, 0xAE, 0x7A, 0x60, 0x5C, 0xF1, 0x3B, 0x8A, 0xD0, 0x0, 0x9B, 0x8A, 0xC0, 0x3A, 0x21, 0x45, 0x5E, 0x60, 0x39, 0x13, 0x8C, 0x8, 0xC0, 0x3, 0x49, 0x4B, 0x59, 0xA7, 0x13, 0x3B, 0x94, 0x34, 0xC7, 0xEA, 0xC0, 0x3A, 0x31, 0xE9, 0x0, 0xF8, 0x30, 0xE9, 0x70, 0x9D, 0xD, 0x93, 0x2C, 0xED, 0x4, 0xCA, 0xEC, 0xC2, 0x30, 0xB9, 0x2, 0xC3, 0x84, 0xAB, 0x62, 0x12, 0x79, 0x8B, 0x35, 0x4C, 0x22, 0xAF, 0xD0, 0x66, 0x3, 0xD0, 0x5D, 0x83, 0x60, 0x41, 0xC, 0x80, 0x5, 0x31, 0x8, 0x2A, 0x64, 0x0, 0x54, 0x8, 0x57, 0xAF, 0x34, 0xF1, 0x96, 0x77, 0x26, 0x61, 0x10, 0x96, 0x88, 0x1, 0x58, 0x22, 0x6, 0x61, 0x78, 0xE, 0xC0, 0xF0, 0x1C, 0x4, 0xD5, 0x35, 0x0, 0xAA, 0x6B, 0x10, 0xD4, 0xCF, 0x0, 0xA8, 0x9F, 0x91, 0xB7, 0x3D, 0x9A, 0x11, 0x30, 0x3D, 0x46, 0xA1, 0x8F, 0x46, 0xA0, 0x8F, 0x46, 0xA1, 0xAD, 0x46, 0xA0, 0xAD, 0xB8, 0x74, 0x43, 0x91, 0xB7, 0x98, 0x6F, 0x28, 0xF2, 0xA, 0x63, 0xB2, 0x98, 0xD8, 0x39, 0xF2, 0xA, 0x73, 0xD0, 0x0, 0x73, 0xD0, 0x8, 0x6D, 0x65, 0x80, 0xB6, 0x32, 0x4C, 0x79, 0x8A, 0xC4, 0x5B, 0x2A, 0x51, 0x11, 0x79, 0x85, 0xF1, 0x6C, 0x80, 0xF1, 0x6C, 0x4, 0x93, 0xC7, 0x0, 0x26, 0xCF, 0x97, 0xA0, 0x73, 0xBE, 0x0, 0x9D, 0xC3, 0x7A, 0x41, 0x46, 0x5E, 0x60, 0x59, 0x9B, 0x4, 0x7D, 0x35, 0x1, 0xFA, 0x6A, 0x12, 0xCC, 0xA5, 0x9, 0x30, 0x97, 0x26, 0xA1, 0x7F, 0x27, 0xA0, 0x7F, 0x27, 0xC1, 0xF4, 0x98, 0x0, 0xD3, 0x63, 0x12, 0xE6, 0xFE, 0x4, 0xCC, 0xFD, 0x49, 0x30, 0x1, 0x26, 0xC0, 0x4, 0x98, 0x85, 0x1D, 0xCF, 0xC, 0xEC, 0x78, 0xB8, 0x9A, 0xEF, 0x89, 0x17, 0xD8, 0xB2, 0xCC, 0x82, 0xE9, 0x31, 0x3, 0xA6, 0xC7, 0x6C, 0xAE, 0xF, 0xB5, 0xF2, 0x8B, 0x43, 0xC4, 0x9A, 0x8, 0x96, 0xAD, 0x80, 0x59, 0x50, 0x99, 0x33, 0xA0, 0x32, 0x67, 0x61, 0x3A, 0xCE, 0xC0, 0x74, 0xE4, 0xCA, 0xD5, 0x27, 0x5E, 0x60, 0x3A, 0xCE, 0x9C, 0x73, 0x6F, 0xE4, 0x2D, 0xFA, 0xF6, 0xEA, 0x30, 0xB, 0x43, 0x6C, 0x6, 0x86, 0x18, 0x57, 0xF4, 0x3E, 0xF2, 0x16, 0xEB, 0xDE, 0x47, 0x5E, 0x41, 0x85, 0x94, 0xFD, 0xEC, 0x75, 0x60, 0x5D, 0xA1, 0x23, 0x2F, 0xD0, 0x47, 0x8B, 0xB0, 0x44, 0x2C, 0xC0, 0x12, 0xC1, 0x15, 0xB0, 0x4F, 0xBC, 0x80, 0xEA, 0xE2, 0xAA, 0xF3, 0x24, 0x5E, 0xA0, 0x7F, 0xB9, 0x52, 0x2C, 0x89, 0x17, 0x58, 0x4E, 0xB9, 0x52, 0x1F, 0x89, 0x17, 0x30, 0x3D, 0x58, 0xA7, 0xF1, 0xC8, 0xB, 0x8C, 0x2B, 0x2E, 0x65, 0x71, 0xE2, 0x5, 0xD4, 0x1E, 0x97, 0x78, 0x38, 0xF1, 0x2, 0xA6, 0x34, 0xEB, 0xAA, 0x1E, 0x79, 0x91, 0xF1, 0x2C, 0xA8, 0xCC, 0x72, 0xAA, 0x11, 0x1D, 0xAC, 0x70, 0x8, 0x68, 0x81, 0x43, 0x40, 0xD6, 0xC5, 0x3D, 0xF2, 0x2, 0xCB, 0x9A, 0x15, 0x96, 0x35, 0xB, 0x2C, 0x6B, 0x56, 0xD0, 0x57, 0x16, 0xD0, 0x57, 0x56, 0x68, 0x2B, 0xB, 0xB4, 0x95, 0x70, 0xDA, 0x5B, 0xCE, 0x21, 0x6F, 0x75, 0x58, 0x5, 0x9D, 0xB3, 0x2, 0x3A, 0x67, 0x15, 0x74, 0xCE, 0xA, 0xE8, 0x9C, 0x55, 0x38, 0x4D, 0x5B, 0x81, 0xD3, 0xB4, 0x55, 0xD0, 0x57, 0x2B, 0xA0, 0xAF, 0xD8, 0xF0, 0xAC, 0xC8, 0xB, 0xE8, 0xAB, 0x55, 0x30, 0x5B, 0x56, 0xC0, 0x6C, 0x61, 0x3, 0x27, 0x22, 0x2F, 0x30, 0x36, 0x56, 0x41, 0xD7, 0xAD, 0x80, 0xAE, 0x5B, 0x5, 0x5D, 0xB7, 0x2, 0xBA, 0x6E, 0x15, 0xE6, 0xFE, 0xA, 0xCC, 0x7D, 0x36, 0xD4, 0x43, 0x7, 0x20, 0xD2, 0x43, 0x7, 0x36, 0x0, 0x4E, 0x7, 0x24, 0xF8, 0x4D, 0x7, 0x27, 0x28, 0x4A, 0x7, 0x28, 0x4A, 0x2F, 0xBC, 0xB4, 0x7, 0x5E, 0xDA, 0xB, 0x76, 0x9A, 0x7, 0xEC, 0x34, 0x2F, 0xD8, 0x69, 0x1E, 0xB0, 0xD3, 0xB8, 0x82, 0x73, 0x89, 0x17, 0xD8, 0xDF, 0x79, 0x41, 0x51, 0x7A, 0x40, 0x51, 0x72, 0x55, 0xE0, 0x13, 0x2F, 0x30, 0x81, 0xBD, 0x30, 0x9, 0x3D, 0x30, 0x9, 0xBD, 0x60, 0x34, 0x78, 0xC0, 0x68, 0xF0, 0xC2, 0x44, 0xF2, 0xC0, 0x44, 0xE2, 0xD2, 0x3F, 0x27, 0x5E, 0x60, 0x22, 0x79, 0x61, 0x22, 0x79, 0x60, 0x22, 0x5, 0xA1, 0x9D, 0x3, 0xD0, 0xCE, 0x6C, 0x68, 0x53, 0xE4, 0x5, 0x8E, 0xE0, 0x82, 0xB0, 0xA8, 0x4, 0x60, 0x51, 0x61, 0x1D, 0x22, 0x35, 0xE2, 0x10, 0xA9, 0x3, 0x57, 0xCF, 0x35, 0xF1, 0x2, 0xFD, 0xCB, 0x7A, 0xCD, 0x44, 0x5E, 0x40, 0xC9, 0xB2, 0xA1, 0x58, 0x91, 0x17, 0xE8, 0x5F, 0x2E, 0x7B, 0x71, 0xE2, 0x5, 0x74, 0xE, 0x97, 0x42, 0x39, 0xF2, 0x16, 0xB3, 0x27, 0x47, 0x5E, 0x61, 0x21, 0x14, 0x33, 0x59, 0x7E, 0xF2, 0xA, 0x63, 0x72, 0x3, 0xC6, 0xE4, 0x26, 0x18, 0x2B, 0x1B, 0x60, 0xAC, 0xDC, 0x4, 0x5D, 0x77, 0x3, 0x74, 0xDD, 0x4D, 0x30, 0x38, 0x6E, 0x80, 0xC1, 0x71, 0x13, 0xC, 0xBB, 0x1B, 0x60, 0xD8, 0xB1, 0x81, 0x76, 0x91, 0x17, 0x18, 0xCF, 0x37, 0x61, 0x31, 0xBB, 0x1, 0x1B, 0x86, 0x3B, 0x39, 0xBE, 0x87, 0x13, 0x50, 0x66, 0x17, 0xD4, 0x9D, 0x98, 0x29, 0xFA, 0x83, 0xF7, 0x21, 0x34, 0xF5, 0x3, 0x68, 0x6A, 0x76, 0x74, 0x34, 0x1, 0x18, 0x1C, 0x4F, 0x61, 0x32, 0x3C, 0x81, 0xC9, 0xF0, 0x14, 0x14, 0xD6, 0xB3, 0xAC, 0xB0, 0xB6, 0x8C, 0x3, 0x6D, 0x4, 0x51, 0xDF, 0xB1, 0x8D, 0x5D, 0x4F, 0x9B, 0xAD, 0xBC, 0x9C, 0xB2, 0xBE, 0x77, 0x1A, 0xF1, 0xBD, 0xDB, 0xBC, 0xE0, 0x4, 0xB0, 0x3, 0x25, 0x76, 0x56, 0x73, 0x95, 0xD5, 0xD6, 0x7D, 0x54, 0xCB, 0xEA, 0x47, 0x93, 0x71, 0x67, 0xFE, 0xA0, 0x41, 0xDB, 0xEF, 0xFE, 0xA5, 0x9D, 0xD7, 0xB2, 0x1F, 0xFA, 0x49, 0x0, 0xCB, 0x5B, 0xF4, 0xB7, 0xE9, 0x28, 0x23, 0x6F, 0x27, 0x80, 0xE5, 0xAD, 0xE4, 0xC4, 0xBA, 0x6A, 0x7, 0xC, 0xCB, 0x62, 0x7B, 0x1C, 0x98, 0x9A, 0x77, 0x3F, 0xB2, 0x2A, 0xE5, 0xEE, 0x9B, 0xB1, 0xAC, 0x53, 0xEE, 0x7E, 0x55, 0xAB, 0x5C, 0x8F, 0xED, 0x45, 0x0, 0x7F, 0xC6, 0x83, 0xDC, 0x94, 0x91, 0x16, 0x61, 0x54, 0xD6, 0x37, 0xBB, 0x4, 0x7F, 0x97, 0x17, 0xE0, 0xEF, 0xB0, 0x17, 0xC7, 0xE1, 0xD9, 0xF, 0xAC, 0x28, 0x84, 0x9F, 0x83, 0xDF, 0xC0, 0x1C, 0x7C, 0xA4, 0x38, 0x3F, 0xF2, 0x4A, 0x48, 0x53, 0x74, 0x10, 0x34, 0x89, 0x2, 0xF0, 0xF9, 0xE3, 0xCE, 0x3E, 0x8A, 0xE7, 0x1E, 0x57, 0xD6, 0xB5, 0xF9, 0xA, 0xB8, 0x36, 0x5F, 0x39, 0x73, 0xFC, 0x5A, 0xB4, 0xC5, 0xAF, 0xF3, 0x55, 0x59, 0xFE, 0x9E, 0x38, 0x41, 0xD0, 0x3D, 0xF1, 0xD5, 0x8B, 0x35, 0x2D, 0xE, 0xC, 0xF2, 0x56, 0xE3, 0x5E, 0xA2, 0xA8, 0x75, 0x7, 0xE9, 0x9A, 0x7B, 0x40, 0x6F, 0xB9, 0x7, 0xCE, 0x58, 0x1D, 0x8A, 0x96, 0xEA, 0x40, 0xEC, 0xB1, 0xF8, 0x40, 0xC0, 0xB1, 0xF8, 0x40, 0xFC, 0xC7, 0x92, 0x7C, 0xBC, 0xF5, 0xF9, 0xBE, 0xEC, 0xB9, 0xCB, 0x40, 0xC0, 0xB9, 0xCB, 0x40, 0xEC, 0xEA, 0x3C, 0x10, 0xB0, 0x3A, 0xF, 0x8E, 0x26, 0xC3, 0xB6, 0x95, 0xA3, 0xA9, 0x31, 0xE5, 0xF4, 0xC1, 0x63, 0xAA, 0xB0, 0xC2, 0xE7, 0x71, 0x3F, 0x40, 0x2C, 0x85, 0xBB, 0x65, 0xEF, 0x70, 0xCB, 0x17, 0xB8, 0x63, 0x1C, 0xD1, 0xBC, 0x63, 0x66, 0x82, 0x90, 0x24, 0xDE, 0x76, 0xF4, 0xFC, 0xFB, 0x7B, 0xC0, 0x62, 0x1F, 0x59, 0xF5, 0x34, 0x2, 0x26, 0x2, 0x67, 0xF8, 0x16, 0x8D, 0x5E, 0xD3, 0x8D, 0xC6, 0x2A, 0xBB, 0x98, 0x87, 0x7A, 0x4A, 0x89, 0x64, 0xE, 0x9A, 0xE6, 0x24, 0x2, 0x34, 0xDB, 0xC1, 0x92, 0x4B, 0x54, 0x70, 0x4A, 0xC5, 0x73, 0x15, 0x98, 0x8E, 0xBD, 0x30, 0x36, 0x1D, 0x70, 0x61, 0xCC, 0x56, 0x32, 0x49, 0x4F, 0x8B, 0x8C, 0xEC, 0xEE, 0xDA, 0x74, 0xC0, 0xEE, 0xDA, 0x74, 0xEC, 0x54, 0x32, 0x1D, 0x30, 0x95, 0x4C, 0xCF, 0x4E, 0x24, 0xD3, 0x23, 0xD3, 0xC8, 0xE8, 0xB5, 0x93, 0x2D, 0xB6, 0x84, 0x82, 0x8B, 0xB5, 0xD1, 0x6E, 0xEB, 0x46, 0x5A, 0xC8, 0x77, 0x86, 0x3D, 0x53, 0xFD, 0x24, 0x0, 0x4E, 0x57, 0x33, 0x49, 0x89, 0xE1, 0x64, 0xC4, 0x3B, 0x21, 0x20, 0xAA, 0x46, 0xE4, 0xA8, 0x96, 0x4C, 0x4E, 0x93, 0x1D, 0x87, 0x53, 0x9A, 0x18, 0x33, 0xA9, 0x75, 0xCD, 0xA7, 0x5E, 0x3B, 0x68, 0x6A, 0x22, 0x1E, 0x8C, 0x59, 0xB2, 0xB9, 0x6F, 0x23, 0x5E, 0x91, 0xFC, 0xD6, 0x98, 0x67, 0x36, 0xD7, 0x53, 0xC4, 0x2B, 0x12, 0x3D, 0x99, 0x49, 0x8D, 0xA4, 0x26, 0x23, 0xF5, 0x49, 0x84, 0x9B, 0x84, 0x43, 0xC2, 0xB8, 0x8D, 0xA9, 0x29, 0x47, 0xB8, 0x98, 0x29, 0x9F, 0xA9, 0xCF, 0x4C, 0x55, 0x19, 0xFA, 0xCC, 0x34, 0x51, 0x2E, 0x6C, 0x3C, 0xE2, 0x15, 0x71, 0xE3, 0x66, 0xA2, 0x59, 0x69, 0xB6, 0x9E, 0xD6, 0x89, 0x1, 0x27, 0x42, 0x66, 0x22, 0x56, 0x11, 0xC6, 0xC7, 0x25, 0x56, 0xFB, 0xC5, 0xFE, 0x76, 0x7C, 0x5C, 0x64, 0xA5, 0x51, 0x9B, 0xBB, 0xA, 0xC2, 0x14, 0x89, 0x70, 0x93, 0x70, 0xA4, 0x21, 0x2C, 0xC5, 0xE5, 0x24, 0x9B, 0x46, 0xF4, 0xA0, 0xA9, 0xC9, 0x24, 0x6A, 0x5C, 0x4A, 0xC9, 0x9D, 0xCF, 0xBB, 0x70, 0x10, 0x55, 0xE5, 0x5D, 0x30, 0x7E, 0x34, 0xCA, 0x3C, 0xB7, 0x7C, 0x62, 0x9B, 0x93, 0xAA, 0x2A, 0xB9, 0x4D, 0x62, 0x72, 0x26, 0x28, 0xAF, 0x84, 0x64, 0xFC, 0x2F, 0x8A, 0x26, 0x92, 0xA0, 0x22, 0xEF, 0x6A, 0xDA, 0x9C, 0xCA, 0xEA, 0x8B, 0x37, 0x59, 0x8D, 0xDA, 0x88, 0x5C, 0x4F, 0x65, 0xE4, 0xE2, 0x1, 0x27, 0x5, 0x5C, 0x3D, 0x20, 0x32, 0xE4, 0xF2, 0xBF, 0x46, 0xBC, 0x22, 0xFD, 0x6B, 0x7A, 0xB5, 0x42, 0xE, 0xA2, 0x9D, 0xA6, 0xBA, 0xA3, 0x46, 0xE5, 0x54, 0x36, 0x91, 0xFB, 0x49, 0x54, 0x93, 0xCB, 0xFD, 0xE4, 0xC9, 0xA5, 0x38, 0x7A, 0xC9, 0xC5, 0xD3, 0x1C, 0x45, 0x16, 0xF3, 0x8C, 0x9F, 0xF7, 0xCC, 0xB6, 0x6C, 0x1C, 0xB0, 0x27, 0x11, 0x28, 0x76, 0x52, 0x21, 0x9B, 0xA2, 0xE3, 0xA4, 0xA9, 0x51, 0x8D, 0xFE, 0x28, 0x65, 0xE1, 0xA, 0x69, 0x53, 0x3E, 0x8, 0xAB, 0xA7, 0xF0, 0x74, 0x24, 0x65, 0x91, 0x2D, 0x87, 0x33, 0x13, 0x57, 0x45, 0xA2, 0xA0, 0xC8, 0xB3, 0x68, 0xA7, 0xF2, 0x46, 0xF4, 0x41, 0x54, 0x65, 0x45, 0xA7, 0xC, 0x38, 0x91, 0x61, 0x7C, 0xD0, 0x20, 0x8F, 0xB7, 0x43, 0x4F, 0x26, 0x22, 0x58, 0x2C, 0x2D, 0x14, 0xA, 0x99, 0x67, 0x3F, 0x8, 0xAB, 0xB2, 0xCF, 0xEE, 0x7C, 0x89, 0x3A, 0xFD, 0x11, 0x42, 0x5D, 0x3F, 0xC8, 0x9A, 0x83, 0xE, 0x14, 0x4E, 0xCA, 0x48, 0xB9, 0xBB, 0x12, 0xDC, 0x24, 0x1C, 0x15, 0x36, 0xD1, 0xBA, 0xE6, 0xB3, 0xBE, 0xBD, 0xC9, 0xAA, 0x6C, 0x87, 0xC8, 0x25, 0x1E, 0x16, 0x25, 0x9D, 0x86, 0x9E, 0x14, 0x45, 0xE2, 0x10, 0x89, 0xC5, 0x9C, 0x65, 0x27, 0x5, 0x9C, 0xB5, 0x2C, 0x32, 0xDC, 0xD5, 0x64, 0xF2, 0x43, 0x2B, 0x52, 0x54, 0xC, 0x2C, 0xAF, 0x58, 0xC3, 0x24, 0x3D, 0x7, 0x98, 0xB9, 0xBB, 0xAA, 0xFD, 0x79, 0x99, 0x59, 0x4B, 0x59, 0x21, 0xE, 0xC, 0x88, 0xC7, 0x34, 0x3E, 0x8E, 0xC8, 0xDC, 0xD8, 0x8A, 0x78, 0xC5, 0xE0, 0xE2, 0xB7, 0x7F, 0x1E, 0xD9, 0xFE, 0x5, 0xED, 0xC8, 0xB0, 0xB1, 0x59, 0x3B, 0x4, 0xC4, 0x67, 0x99, 0xC8, 0x25, 0x35, 0xCA, 0x8E, 0x21, 0x8D, 0xF2, 0xA0, 0x9E, 0xA2, 0xF9, 0x95, 0x19, 0x7B, 0x27, 0x9, 0x3C, 0xF8, 0xBE, 0xFC, 0x97, 0x9E, 0x95, 0xF, 0xA6, 0xBB, 0xAD, 0xFE, 0x32, 0x92, 0xE7, 0x2B, 0x40, 0xDB, 0x2F, 0xDF, 0x9C, 0x84, 0xCD, 0x49, 0x9, 0x1D, 0xC1, 0x7C, 0xB1, 0xC7, 0x28, 0x5F, 0xC0, 0x31, 0xA, 0xB7, 0xC9, 0x2D, 0x6E, 0x6B, 0x67, 0xF6, 0x50, 0x76, 0x6, 0xE, 0x65, 0x67, 0x36, 0x1E, 0x6D, 0x96, 0xC3, 0xD1, 0xDE, 0xDF, 0x38, 0x4B, 0xE7, 0x9A, 0x33, 0x7A, 0xAE, 0xC9, 0x8F, 0xD, 0x64, 0x54, 0x2C, 0x6C, 0x38, 0xDB, 0x2, 0x44, 0xB3, 0x2D, 0x6C, 0xCF, 0x2C, 0x40, 0xCF, 0x70, 0xEF, 0x5A, 0x7C, 0x51, 0xBA, 0x18, 0xBE, 0xBC, 0x74, 0x42, 0x90, 0xF2, 0xD2, 0x89, 0x90, 0xED, 0xDF, 0x24, 0xA1, 0x5C, 0x98, 0x73, 0x17, 0xC0, 0x9F, 0x69, 0xEE, 0x22, 0xA0, 0x13, 0xCD, 0x48, 0x6A, 0xEC, 0x95, 0x6D, 0x85, 0x28, 0x25, 0x61, 0x88, 0x10, 0x7E, 0xAA, 0x25, 0x19, 0xC8, 0xD4, 0x8A, 0x84, 0xFC, 0xB8, 0x4B, 0x22, 0x90, 0x71, 0x47, 0xAD, 0xA6, 0x29, 0x6F, 0x2F, 0xED, 0x24, 0x35, 0xD6, 0x12, 0xB5, 0xE4, 0x4C, 0x39, 0xE5, 0xFD, 0x9B, 0xAC, 0x66, 0x2D, 0xE7, 0xB, 0xE5, 0x5B, 0xA8, 0x46, 0xBE, 0xA5, 0x3E, 0x93, 0x98, 0x90, 0x7A, 0x3C, 0x2D, 0x21, 0xF5, 0x4A, 0xDE, 0x80, 0x47, 0x41, 0xE8, 0xF6, 0x9B, 0x7A, 0xDD, 0xAA, 0x59, 0xD9, 0x4E, 0xBB, 0xD9, 0xC4, 0xFF, 0xB1, 0x9F, 0xF7, 0x57, 0xF3, 0x49, 0xD8, 0xEC, 0x94, 0xBF, 0x7D, 0xF1, 0xDF, 0xEC, 0x17, 0x6F, 0xB, 0x7B, 0xE2, 0x1A, 0x1, 0xA4, 0x8E, 0x60, 0xA4, 0x63, 0xF, 0x40, 0x93, 0x0, 0xE0, 0x8, 0x94, 0x24, 0xE5, 0x48, 0xA8, 0x72, 0xA4, 0x68, 0xFE, 0xE7, 0x76, 0xA5, 0x89, 0xA0, 0x62, 0x5B, 0x4A, 0x43, 0xA6, 0xFE, 0xBE, 0xA5, 0x1, 0xAF, 0xBD, 0x6F, 0x69, 0x30, 0xB9, 0xED, 0x17, 0xD, 0xA6, 0x62, 0xE7, 0x45, 0xA3, 0xFE, 0xCA, 0x15, 0x30, 0x4A, 0x38, 0x5E, 0xBC, 0x88, 0xE2, 0x22, 0xCD, 0x8A, 0x21, 0xD9, 0x8B, 0xEE, 0xE3, 0xD3, 0x22, 0xFB, 0xC2, 0x9D, 0xFE, 0x25, 0x44, 0x8E, 0x74, 0xF8, 0x10, 0x61, 0x56, 0x5E, 0x80, 0x59, 0x41, 0x76, 0x76, 0x8D, 0x8A, 0xEC, 0xE2, 0x2A, 0xF5, 0xC9, 0xCE, 0x7E, 0x3D, 0x90, 0x9A, 0x95, 0x26, 0xC3, 0x2E, 0x33, 0xF1, 0x71, 0x89, 0x75, 0x51, 0x9C, 0x83, 0x28, 0x2D, 0x8D, 0x2A, 0x3A, 0x88, 0xD2, 0xC2, 0xA6, 0xD3, 0x88, 0xBC, 0xC5, 0xBB, 0x55, 0x5A, 0x38, 0x1B, 0x35, 0x2A, 0x8E, 0xE5, 0x57, 0xB, 0x95, 0xA9, 0x30, 0x16, 0xB9, 0x35, 0x9B, 0xBC, 0x20, 0x21, 0x40, 0xFE, 0x2, 0x12, 0xD4, 0x4C, 0x46, 0xC9, 0x7C, 0xF0, 0xEA, 0x8E, 0xB, 0xD5, 0xA0, 0x45, 0x37, 0x5D, 0x31, 0x56, 0x83, 0x16, 0xCD, 0x3A, 0x5D, 0x47, 0xEE, 0xB2, 0xD7, 0x35, 0x2D, 0x5C, 0xE9, 0xE6, 0xF8, 0xDE, 0x62, 0xC5, 0xE6, 0xF, 0x5E, 0xAE, 0xB0, 0x72, 0xE4, 0x15, 0xEB, 0x29, 0x7F, 0xF0, 0x9A, 0x6C, 0x3A, 0x72, 0x5A, 0x4C, 0x4D, 0x36, 0x72, 0x5A, 0x26, 0x76, 0x59, 0x5C, 0x9A, 0xA9, 0x78, 0xB5, 0x44, 0xCB, 0x22, 0xC, 0xBB, 0x5, 0x18, 0x76, 0x6C, 0xC2, 0x35, 0x5A, 0x9A, 0x72, 0xD2, 0x35, 0x5A, 0xD8, 0xAB, 0xD2, 0xC8, 0x5B, 0xBC, 0x2E, 0xA5, 0x85, 0xD, 0xA4, 0xA0, 0x5, 0x8, 0xA4, 0xA0, 0x65, 0x65, 0x95, 0xD2, 0x2, 0x4, 0x52, 0xD0, 0xC2, 0x5E, 0xBE, 0x45, 0xDE, 0xE2, 0xDE, 0x84, 0x16, 0x27, 0xFC, 0xAE, 0x3, 0x7E, 0x97, 0x4D, 0x6B, 0x18, 0x79, 0x81, 0xE9, 0xC5, 0xFA, 0x16, 0xD3, 0x2, 0xF8, 0x16, 0xD3, 0xC2, 0xFA, 0x80, 0xD2, 0x2, 0xF8, 0x80, 0xD2, 0xC2, 0x6E, 0xBB, 0x69, 0x1, 0xB6, 0xDD, 0x64, 0x29, 0x6E, 0xEE, 0xF3, 0x53, 0x64, 0xA7, 0xA9, 0x9A, 0x26, 0xB4, 0xF2, 0x31, 0x96, 0x44, 0x2B, 0x14, 0x60, 0x49, 0xAE, 0xBF, 0xA8, 0xCD, 0x49, 0xBB, 0xF9, 0x37, 0xC, 0x6C, 0xDD, 0x22, 0xF1, 0xD5, 0x11, 0x9F, 0x46, 0xEB, 0x4, 0x41, 0x39, 0x94, 0x7B, 0x23, 0x82, 0xDF, 0x27, 0x23, 0x5, 0x96, 0xA1, 0x7D, 0xD0, 0x17, 0xD3, 0x77, 0x5E, 0xDD, 0xB4, 0xB1, 0xDD, 0x4C, 0x5B, 0x2F, 0x6C, 0x74, 0xDE, 0xB4, 0xCD, 0x7, 0x31, 0xB6, 0xF7, 0x71, 0xFD, 0xA4, 0x56, 0xB6, 0x96, 0xFA, 0x89, 0x61, 0x6F, 0xEB, 0xF4, 0x65, 0x56, 0x96, 0x4F, 0xCF, 0xF6, 0x86, 0x51, 0x59, 0xC6, 0xF3, 0x19, 0xDA, 0x4E, 0x10, 0x92, 0xA3, 0x2F, 0x34, 0x5C, 0xB6, 0x9B, 0xDC, 0x17, 0x6F, 0x2, 0x48, 0xDE, 0x95, 0x66, 0xD9, 0x63, 0x70, 0x87, 0x41, 0x27, 0x4, 0x72, 0x61, 0xBC, 0x4C, 0x71, 0x1F, 0x38, 0xFA, 0xA0, 0x2C, 0x9B, 0x22, 0xF5, 0x17, 0x1A, 0x24, 0x5B, 0x6A, 0x64, 0xB9, 0xCE, 0xCA, 0x4E, 0xEC, 0xDC, 0x8C, 0x36, 0xCC, 0x49, 0xF0, 0xDB, 0x1C, 0x65, 0xF7, 0x3C, 0x2E, 0x8C, 0x99, 0xEF, 0xD, 0x63, 0xC5, 0xD7, 0xDE, 0xE3, 0x8, 0x75, 0x76, 0xD9, 0xC2, 0xC6, 0xA6, 0x1C, 0xFB, 0x9D, 0xA6, 0x2C, 0x94, 0xBD, 0x33, 0xDE, 0x9F, 0x97, 0x98, 0xBD, 0xDE, 0x4B, 0x7C, 0xA, 0x89, 0xFF, 0xF, 0x1C, 0x4D, 0xFE, 0x4F, 0x5E, 0xCB, 0xC7, 0xE2, 0xE4, 0x35, 0x7C, 0x2A, 0x4E, 0x7E, 0x34, 0x2E, 0xE3, 0x72, 0xB0, 0xE3, 0xB0, 0xCF, 0x1, 0x5, 0x65, 0x9C, 0xF6, 0xBC, 0x71, 0xB5, 0x63, 0x88, 0x85, 0xB5, 0x53, 0xF2, 0x76, 0xD1, 0x21, 0x5, 0x30, 0x8E, 0xF8, 0xBC, 0x5C, 0x84, 0xE4, 0xE5, 0xA2, 0x60, 0x3A, 0xAD, 0xDA, 0x59, 0xB, 0xDE, 0xC7, 0x2F, 0xBC, 0xC9, 0x38, 0x22, 0x7F, 0x8C, 0xEB, 0x60, 0x3A, 0xA3, 0x3C, 0x6F, 0xA6, 0xEF, 0x18, 0x62, 0xA8, 0x7, 0x39, 0xB, 0xE4, 0x81, 0x21, 0x5A, 0x25, 0xA4, 0x73, 0x66, 0xF9, 0xEA, 0xF9, 0x20, 0x80, 0x6F, 0x9E, 0x4B, 0x5, 0x28, 0x2B, 0x8B, 0x4F, 0x46, 0x5E, 0xA9, 0x74, 0xC4, 0x81, 0x21, 0x5F, 0x79, 0x53, 0x8E, 0xAF, 0x49, 0x9C, 0x10, 0x64, 0x27, 0x79, 0xD3, 0x1D, 0x39, 0xED, 0x4D, 0x60, 0xCD, 0xA8, 0x1D, 0x6D, 0x12, 0x5C, 0xEC, 0xFE, 0x1B, 0x1B, 0xA7, 0x49, 0x37, 0x24, 0x4E, 0x93, 0x6E, 0xBC, 0xB2, 0xBE, 0x41, 0xFA, 0x99, 0xB, 0x5A, 0xA0, 0x62, 0xD0, 0x2, 0xDD, 0x2F, 0xBD, 0xF5, 0xC2, 0xDA, 0x9F, 0x30, 0x6C, 0xA9, 0x4F, 0xA4, 0xC2, 0x19, 0xD2, 0x2E, 0x6, 0x3A, 0x45, 0x4A, 0xA4, 0x81, 0xD8, 0xC1, 0xB5, 0x8B, 0x89, 0x60, 0x59, 0x8C, 0x72, 0x5B, 0xAF, 0xF8, 0x5D, 0xC0, 0x8E, 0x21, 0x5B, 0x81, 0xBB, 0x63, 0x63, 0xBA, 0xF6, 0xE7, 0x45, 0x66, 0x36, 0x16, 0x94, 0xEE, 0x72, 0x2C, 0xE8, 0xE7, 0xFB, 0x7, 0x76, 0xAB, 0x1D, 0x1F, 0x97, 0x7E, 0xF7, 0xA9, 0xEE, 0x8A, 0xAC, 0xD7, 0x36, 0x53, 0x7B, 0xF6, 0x4D, 0x84, 0x17, 0xA0, 0xE5, 0x17, 0xFD, 0x62, 0x59, 0x74, 0x4E, 0x2F, 0x17, 0x75, 0x32, 0x5B, 0xFE, 0xA1, 0x5C, 0xFB, 0x81, 0x8D, 0x72, 0x2E, 0x87, 0x38, 0xBB, 0xBB, 0x68, 0x88, 0xB8, 0x3B, 0x68, 0x86, 0x78, 0xF6, 0xCA, 0xC3, 0x3, 0x57, 0x5A, 0x6C, 0xD, 0x5, 0xA0, 0x6C, 0x82, 0xB7, 0xEC, 0xB1, 0x9B, 0xB7, 0xC0, 0xDD, 0x90, 0xDF, 0xD6, 0x5E, 0x5, 0x2D, 0xDC, 0x8F, 0x1C, 0x28, 0x76, 0x45, 0xE2, 0xEF, 0x6C, 0xC6, 0x54, 0x7F, 0x7, 0x32, 0xA6, 0xFA, 0xBB, 0xEE, 0x37, 0x89, 0x5D, 0xF7, 0x1B, 0x20, 0x82, 0xCF, 0xD7, 0x8A, 0xA4, 0x6B, 0xD, 0x6C, 0x8F, 0x5, 0xA0, 0xC7, 0x42, 0x3F, 0xCF, 0xBC, 0x8A, 0x8A, 0x8, 0xA4, 0xA0, 0x82, 0xF4, 0xE1, 0x1, 0xFD, 0x6E, 0x76, 0xD1, 0x44, 0xD2, 0x28, 0x7, 0xC7, 0xDA, 0x4A, 0xC1, 0x1, 0xB6, 0x52, 0x60, 0xCF, 0x37, 0x2, 0x70, 0xBC, 0xC1, 0x6, 0x12, 0x97, 0xA3, 0x88, 0xB9, 0x26, 0x2A, 0xB6, 0xCD, 0xC6, 0xF6, 0xEC, 0x6, 0xF4, 0xEC, 0xC6, 0x9A, 0x11, 0x1B, 0x60, 0x42, 0x6C, 0x8B, 0xA, 0x4A, 0x2E, 0x6A, 0x97, 0x60, 0xB8, 0xA6, 0x5D, 0xA2, 0xCE, 0x5E, 0xCB, 0xED, 0xF2, 0x2A, 0x6E, 0xE4, 0xB6, 0x45, 0x15, 0x7C, 0xDB, 0x12, 0x45, 0x8D, 0x67, 0xDB, 0x46, 0x8E, 0x3F, 0xBC, 0x8F, 0x0, 0xD0, 0x64, 0x37, 0x56, 0x7F, 0xDD, 0x0, 0xF5, 0xF5, 0xCD, 0x5E, 0xA7, 0x7F, 0x3, 0xD7, 0xE9, 0x8F, 0xCB, 0xDF, 0xAB, 0x52, 0x7E, 0x51, 0xDE, 0x9B, 0x9B, 0x1E, 0x9C, 0x11, 0x4E, 0x3D, 0x1E, 0x97, 0xBF, 0x9B, 0x48, 0xD8, 0x7C, 0x50, 0x42, 0x76, 0xD0, 0xE3, 0xF2, 0xF, 0xF8, 0x3, 0xFF, 0xFC, 0xE1, 0xF, 0x28, 0xC5, 0xA6, 0xC6, 0x7E, 0x28, 0x85, 0xD4, 0x24, 0x78, 0x28, 0xCB, 0xE6, 0x12, 0x78, 0xA4, 0xD8, 0x92, 0x32, 0x3B, 0x77, 0x8E, 0xFC, 0x28, 0x1E, 0x22, 0x3F, 0xBA, 0x85, 0xEB, 0xB1, 0x47, 0xD7, 0x2C, 0x52, 0x97, 0x7D, 0xFE, 0xA6, 0xB8, 0x3C, 0x47, 0x1, 0xD0, 0xF2, 0xFC, 0xB0, 0x72, 0xFC, 0xEC, 0xC3, 0xA2, 0xA1, 0xB3, 0xF, 0xCF, 0x9B, 0x94, 0xF, 0x8F, 0x98, 0x93, 0xF, 0x76, 0xBC, 0x3F, 0x80, 0xF1, 0xFE, 0xD8, 0x9E, 0xDA, 0x66, 0x4A, 0x3F, 0x26, 0x1C, 0xAF, 0xFD, 0xC8, 0xD9, 0xB4, 0x45, 0x7B, 0xF6, 0xD9, 0x2A, 0x3F, 0xE9, 0xD0, 0xAA, 0x99, 0x6B, 0x81, 0x67, 0xF3, 0x1, 0x97, 0x3A, 0xF4, 0x29, 0x5D, 0x36, 0x3F, 0xC1, 0xCB, 0x66, 0x52, 0x4B, 0xDC, 0x65, 0x3A, 0xE5, 0x77, 0xB7, 0x5A, 0xDE, 0x57, 0xE0, 0x27, 0x2A, 0xD4, 0x69, 0x80, 0xE2, 0x5E, 0x32, 0x53, 0x27, 0x3C, 0xE1, 0x78, 0x91, 0x70, 0x6A, 0xD5, 0x92, 0x6E, 0xC5, 0x79, 0x61, 0x11, 0x4D, 0x57, 0xE2, 0xA8, 0xA8, 0x68, 0xAD, 0x7B, 0xE9, 0xD5, 0x92, 0xB4, 0x93, 0x2, 0x12, 0x68, 0xD5, 0xB3, 0xE0, 0xE2, 0xFB, 0x22, 0xAA, 0x70, 0xF1, 0x25, 0xAE, 0x2A, 0x10, 0x95, 0xAB, 0x2, 0x51, 0xCB, 0xDC, 0x34, 0xC5, 0x87, 0x45, 0xB6, 0xD1, 0xE4, 0xCB, 0xA5, 0x1C, 0x24, 0x15, 0xB, 0x26, 0xB5, 0xC6, 0x65, 0x97, 0xE0, 0x44, 0x50, 0x25, 0xD0, 0x1A, 0xEB, 0x27, 0x6E, 0x83, 0x7F, 0x40, 0xC0, 0x26, 0x9F, 0x5A, 0xAF, 0xDD, 0x4D, 0x33, 0xF6, 0xDB, 0xB, 0x29, 0xBD, 0xC5, 0x26, 0x3A, 0x6C, 0x52, 0xBB, 0xA1, 0xCE, 0x9A, 0xD4, 0x6E, 0x5E, 0xCB, 0xFB, 0xCB, 0x4, 0xC3, 0x3B, 0x4B, 0xEA, 0xB4, 0xB2, 0x34, 0x38, 0xB5, 0x8E, 0x46, 0x54, 0xF5, 0x3F, 0x11, 0x61, 0xEA, 0xFA, 0x93, 0xE5, 0xC7, 0x26, 0x6, 0xF8, 0xFD, 0x42, 0x6, 0xCB, 0x9E, 0x29, 0x2C, 0x8F, 0x30, 0x9A, 0xEE, 0xD2, 0x6A, 0xE5, 0x3, 0x5F, 0x5E, 0x64, 0xA7, 0x6B, 0x7E, 0x22, 0x44, 0x9C, 0xA0, 0xA2, 0x56, 0x13, 0x3D, 0x48, 0x93, 0xD7, 0x28, 0xE8, 0x3B, 0x4A, 0x9D, 0xE9, 0x36, 0x1F, 0x68, 0xD1, 0xEE, 0x12, 0xFF, 0xCD, 0x3B, 0x2E, 0x51, 0x67, 0x9A, 0x4F, 0x32, 0xC4, 0x6B, 0x89, 0xBA, 0x15, 0x92, 0xBC, 0xFE, 0x81, 0x64, 0xF, 0x49, 0xF6, 0xF5, 0x92, 0xE3, 0xBE, 0x56, 0x3C, 0x1, 0x4F, 0x28, 0x7A, 0xFE, 0x4D, 0xBD, 0xBA, 0xC7, 0x45, 0x20, 0x13, 0x92, 0x75, 0x90, 0x54, 0x44, 0x64, 0x51, 0xDF, 0xB3, 0x57, 0x53, 0xF1, 0x79, 0x79, 0x6B, 0x47, 0xBD, 0xF6, 0x8A, 0xB, 0x96, 0x4B, 0x40, 0x39, 0x52, 0x2E, 0x92, 0xC9, 0x2, 0x30, 0x9, 0xC4, 0x6D, 0x2E, 0xA9, 0xA7, 0xF2, 0xE6, 0x92, 0x7A, 0xFE, 0x97, 0x81, 0x5F, 0xD5, 0xD9, 0x15, 0x4C, 0xD7, 0x2C, 0x5D, 0xD7, 0x96, 0xF1, 0x36, 0xA3, 0x6B, 0xD3, 0x96, 0x9C, 0xEA, 0xE9, 0xCA, 0xE4, 0xA7, 0x88, 0xF, 0x8B, 0x6C, 0x5C, 0x85, 0x1D, 0xBA, 0x5E, 0xCB, 0x15, 0x76, 0x22, 0xAF, 0x1F, 0xD, 0x77, 0x53, 0x45, 0xD7, 0xE6, 0xC4, 0x4A, 0x9A, 0xE6, 0x7A, 0x35, 0x1D, 0x73, 0x4, 0x75, 0x3C, 0x2F, 0xBE, 0x81, 0xE9, 0xF4, 0x85, 0xEC, 0x25, 0x8C, 0x9A, 0xDD, 0xE8, 0xFC, 0x44, 0x81, 0x6C, 0x70, 0x12, 0x83, 0x51, 0xB3, 0xEA, 0x54, 0xAF, 0xB9, 0x3C, 0xAB, 0x27, 0x41, 0x73, 0x52, 0x14, 0x5, 0x72, 0x89, 0xC4, 0xE9, 0x2A, 0xE7, 0x11, 0xFF, 0x60, 0x9D, 0xC9, 0xF6, 0x42, 0xFB, 0x1E, 0x50, 0x51, 0x4, 0x3B, 0x9F, 0xAF, 0xC0, 0x6C, 0xBE, 0x6E, 0x56, 0x5, 0x12, 0x3D, 0x58, 0xF, 0x1C, 0x75, 0x62, 0xA5, 0x41, 0xC9, 0x8A, 0x6F, 0x50, 0xB0, 0xDA, 0x1B, 0xD4, 0x64, 0x6, 0x73, 0xDD, 0x4, 0x39, 0x93, 0x69, 0x22, 0xA, 0x9, 0xB2, 0x94, 0x89, 0xA7, 0x4E, 0x38, 0x1E, 0x4F, 0x4D, 0x83, 0xE2, 0xDA, 0x79, 0x68, 0x54, 0xB1, 0x9D, 0xA3, 0xBD, 0x7C, 0x4F, 0x45, 0xB4, 0x85, 0xDA, 0x74, 0x2F, 0xA, 0xB4, 0x3C, 0x5D, 0x72, 0x75, 0xCD, 0xB5, 0x76, 0xA8, 0x69, 0xEF, 0xBB, 0xCA, 0xED, 0x47, 0xD2, 0xC2, 0x82, 0xEF, 0x47, 0xF6, 0x9B, 0x69, 0xD1, 0x70, 0x3B, 0x2E, 0xA6, 0x41, 0xC3, 0x6D, 0x48, 0xAD, 0x22, 0x17, 0x8D, 0x3E, 0x8, 0xE0, 0xAA, 0xD1, 0xFB, 0x6D, 0x65, 0x76, 0x4C, 0xDC, 0x2B, 0x62, 0xEC, 0x69, 0x48, 0x19, 0x0, 0xC4, 0x28, 0xD1, 0x84, 0xE3, 0x11, 0xA2, 0x34, 0x18, 0x29, 0xE6, 0x98, 0x6, 0x3, 0x6, 0x1B, 0xD3, 0xC0, 0x1D, 0x99, 0xD1, 0x50, 0x3E, 0x2F, 0xA3, 0x61, 0x36, 0xCA, 0x7, 0xFE, 0xAA, 0xF3, 0x5, 0x22, 0x72, 0xC8, 0xE5, 0xC2, 0x87, 0x13, 0x8E, 0xFB, 0x69, 0xD3, 0x40, 0x5E, 0x67, 0xFB, 0x8C, 0xBC, 0xAE, 0xE9, 0xB3, 0xAD, 0x1B, 0x8D, 0xBC, 0x47, 0x49, 0x30, 0xBC, 0x4D, 0x19, 0x36, 0x6B, 0x72, 0xF1, 0xC7, 0x89, 0xA0, 0x22, 0xF6, 0x38, 0xD1, 0x67, 0x8D, 0x8D, 0x24, 0xB0, 0xC2, 0xDE, 0x18, 0x95, 0xCB, 0xEC, 0xC8, 0x22, 0xA, 0x7F, 0xEC, 0xD8, 0xE7, 0xE2, 0x47, 0x23, 0x8C, 0xC7, 0x8D, 0xD2, 0x1E, 0x61, 0x2E, 0xBC, 0x95, 0x81, 0xCF, 0x2C, 0x46, 0x93, 0xD5, 0xF, 0x11, 0xC6, 0xB5, 0x43, 0xA4, 0xCE, 0xF8, 0xF1, 0xEF, 0xD2, 0x60, 0x47, 0x7E, 0x1A, 0xA7, 0x23, 0x78, 0x5A, 0xEC, 0xCC, 0x44, 0x51, 0xD5, 0x9B, 0xE1, 0x58, 0x49, 0x32, 0x2F, 0x19, 0xCE, 0xA5, 0x4, 0x7E, 0x4F, 0xEE, 0xDC, 0x90, 0x80, 0x1C, 0x47, 0x64, 0xB2, 0xBB, 0x13, 0x53, 0xB3, 0x31, 0x31, 0x4E, 0x79, 0x2D, 0x47, 0x3D, 0xEC, 0x38, 0x1C, 0xF5, 0x40, 0xC6, 0x2D, 0x3, 0xDD, 0xB8, 0xC3, 0xD4, 0x8, 0x35, 0x3, 0xDD, 0xCA, 0x7, 0xAA, 0xC9, 0x15, 0xA5, 0xCF, 0xF7, 0xE0, 0x4E, 0x52, 0xD3, 0x85, 0xC6, 0x53, 0xBE, 0xD5, 0x3C, 0x55, 0xB5, 0x9B, 0xF4, 0x62, 0xD8, 0xCB, 0x4, 0x95, 0x95, 0x80, 0x8A, 0x79, 0x6E, 0x8B, 0x11, 0x2F, 0xA7, 0x76, 0x18, 0xBD, 0x9C, 0xA2, 0x2F, 0x23, 0x79, 0xB6, 0xD1, 0x97, 0x1, 0x9D, 0xDA, 0xE8, 0xCB, 0x3C, 0x72, 0x29, 0x89, 0x12, 0x8E, 0x27, 0x24, 0xA2, 0x49, 0x45, 0xCB, 0x33, 0xB7, 0xA8, 0xED, 0x14, 0x15, 0xCB, 0xDA, 0xA4, 0xEE, 0xB9, 0x70, 0xA6, 0x84, 0x57, 0x8A, 0x93, 0xF, 0x74, 0xA6, 0x8A, 0x68, 0xE0, 0x4C, 0x8A, 0x12, 0x38, 0x2B, 0xC9, 0x41, 0x8, 0x88, 0xAA, 0x13, 0x99, 0xB1, 0x2C, 0x23, 0x8C, 0x5B, 0x96, 0xC9, 0x35, 0x35, 0x63, 0x6, 0xEC, 0x38, 0xBC, 0x34, 0x4E, 0xBA, 0x64, 0x59, 0xEE, 0x14, 0x15, 0x76, 0xCA, 0xA4, 0x43, 0xFE, 0xC8, 0x39, 0x12, 0xD4, 0x9C, 0xF, 0x17, 0xC6, 0x6F, 0xD5, 0xD8, 0x4D, 0x16, 0x2D, 0x2B, 0x25, 0x1, 0x10, 0xBB, 0xF0, 0x1A, 0xA0, 0xAD, 0xBC, 0x13, 0x2, 0xA2, 0xAA, 0x44, 0x16, 0xB3, 0x64, 0xBC, 0x89, 0x2A, 0x6C, 0x9D, 0x62, 0x46, 0x8B, 0xEA, 0x64, 0x16, 0x34, 0x79, 0xAB, 0xD9, 0xD2, 0xE4, 0x3B, 0x2, 0xEC, 0xFE, 0xA7, 0x2D, 0xAA, 0x65, 0xCA, 0x7E, 0xEB, 0x4E, 0x52, 0xF3, 0xA5, 0xDB, 0x7E, 0x1B, 0x25, 0x26, 0x31, 0x49, 0x14, 0x35, 0x69, 0x7E, 0x68, 0x62, 0xAD, 0x93, 0x9, 0xB0, 0x4E, 0xA6, 0x2D, 0x4E, 0x35, 0x79, 0xD7, 0xBD, 0xE3, 0xF0, 0x9E, 0x7B, 0x56, 0x77, 0xF6, 0x76, 0x36, 0x1, 0x80, 0x35, 0x31, 0x47, 0xFD, 0xC5, 0xB2, 0x3F, 0x8A, 0xE9, 0x38, 0x76, 0xA2, 0xC1, 0xD1, 0xC6, 0x7D, 0xC8, 0x7, 0x58, 0x12, 0xD3, 0x1A, 0x75, 0x9, 0x7A, 0x59, 0xD, 0xEB, 0x59, 0xF1, 0x89, 0x3, 0x1B, 0xBD, 0x48, 0x5D, 0x10, 0x86, 0xCB, 0xEA, 0xAD, 0xBA, 0x71, 0xA7, 0x71, 0x7, 0x50, 0x62, 0xD7, 0xDD, 0x44, 0x7C, 0xEF, 0x44, 0x4, 0xE9, 0x9E, 0x69, 0xF3, 0x4F, 0x5E, 0x42, 0x44, 0x10, 0x9, 0x33, 0x37, 0x1F, 0xE3, 0xD3, 0x12, 0x23, 0x3B, 0x91, 0x81, 0xB0, 0xE, 0x9A, 0xFD, 0x33, 0x3C, 0x2C, 0xFF, 0xD6, 0x9, 0x2, 0x5E, 0x9B, 0xBB, 0x55, 0x2A, 0x5E, 0x4F, 0x2D, 0x2A, 0xE5, 0xB, 0x15, 0x57, 0xE3, 0x84, 0xE3, 0xCB, 0x71, 0x22, 0x17, 0xF, 0x3F, 0x76, 0x61, 0xE0, 0xF9, 0xC7, 0xA2, 0x74, 0x5C, 0xBA, 0x73, 0xAE, 0x48, 0x27, 0x4D, 0x85, 0x33, 0x12, 0x2D, 0x6A, 0x54, 0xE2, 0xD, 0x5F, 0x4, 0xC1, 0x7B, 0xBD, 0x45, 0xF9, 0xDB, 0x46, 0xC1, 0xF2, 0xD7, 0x30, 0x2F, 0x14, 0xE8, 0xFB, 0x45, 0xF, 0xDC, 0x12, 0x9C, 0x1E, 0x17, 0x59, 0x65, 0xAD, 0xB8, 0x68, 0x58, 0x23, 0x2E, 0xC3, 0x4C, 0xDC, 0xD0, 0x5B, 0x86, 0x26, 0x2, 0xA5, 0x93, 0xEB, 0xC5, 0x44, 0x93, 0x48, 0x76, 0xAF, 0xD8, 0x71, 0xD8, 0xB1, 0x62, 0x31, 0x63, 0x14, 0xB6, 0x28, 0x2B, 0xC6, 0x23, 0x7C, 0xD0, 0xA0, 0x21, 0x9, 0xB4, 0x64, 0x87, 0x79, 0xCD, 0x18, 0x37, 0xB9, 0xD, 0x49, 0x94, 0x4, 0x6F, 0x47, 0x96, 0x64, 0x44, 0xE6, 0x4E, 0x6D, 0x77, 0x8A, 0x8A, 0x73, 0xDB, 0xC5, 0x3C, 0xB2, 0xF6, 0x6B, 0x4A, 0x69, 0x86, 0x5B, 0xAF, 0x8B, 0x35, 0x77, 0x21, 0xDE, 0x61, 0xC7, 0xA0, 0x68, 0x87, 0x94, 0xEB, 0xAC, 0x90, 0x3A, 0xF0, 0xA0, 0xA9, 0xB2, 0x29, 0x96, 0xCD, 0xE5, 0x3D, 0x5D, 0x22, 0x41, 0x8D, 0xA7, 0xCB, 0xB2, 0x65, 0x73, 0x53, 0x24, 0xBC, 0xC2, 0xBE, 0xB6, 0x17, 0xB5, 0x6, 0xD3, 0xCE, 0x7C, 0x1E, 0x90, 0x37, 0xC, 0xDD, 0xF4, 0xDB, 0xCB, 0xF4, 0x1F, 0xCF, 0x25, 0xEF, 0xF8, 0xAB, 0xD9, 0xB1, 0x5F, 0xF3, 0x77, 0x70, 0xF7, 0xDA, 0xF6, 0xE2, 0x94, 0xED, 0x46, 0xED, 0x52, 0x70, 0xA6, 0x20, 0xEB, 0x20, 0x69, 0x76, 0x1A, 0x40, 0xA4, 0x21, 0xF1, 0xBD, 0x5C, 0xB4, 0x1A, 0x90, 0xF7, 0xA, 0xA3, 0xBE, 0xDC, 0x75, 0xCB, 0xC5, 0x33, 0xBC, 0xD1, 0x46, 0x8C, 0x6B, 0xF8, 0x14, 0x75, 0xD7, 0x2D, 0x17, 0x6D, 0xB6, 0x23, 0x72, 0xAC, 0xD9, 0x87, 0x88, 0x74, 0x6A, 0x93, 0x39, 0x8F, 0x3C, 0x8E, 0x75, 0xE0, 0x13, 0x49, 0xAB, 0x6E, 0x3E, 0xA8, 0x6E, 0xE2, 0xAF, 0x3E, 0x4F, 0x14, 0xBA, 0xF6, 0xB4, 0x9C, 0xD3, 0x32, 0xD9, 0xB2, 0xD3, 0x32, 0xD9, 0xCE, 0xCC, 0xB3, 0x2, 0x7C, 0x51, 0x76, 0xC2, 0x3F, 0x70, 0x46, 0xB1, 0xBD, 0x19, 0x4C, 0x50, 0x73, 0x72, 0x95, 0x61, 0xC3, 0x3F, 0x7F, 0x26, 0x41, 0x82, 0xB4, 0xC9, 0x72, 0xBA, 0xC7, 0x16, 0x6F, 0x9C, 0xED, 0x55, 0xB5, 0xCE, 0x74, 0x4A, 0xF8, 0xC4, 0x3, 0xC5, 0x3E, 0x8B, 0x73, 0x53, 0x2B, 0x16, 0x48, 0x20, 0x3B, 0x64, 0x75, 0x89, 0x1D, 0xAA, 0x54, 0xC9, 0xC0, 0x3A, 0x4C, 0xD8, 0x1, 0x70, 0x98, 0xB0, 0xFC, 0xD5, 0xBF, 0x95, 0x6F, 0xFD, 0xDF, 0xAC, 0x5F, 0xDB, 0xB4, 0xC9, 0x4B, 0x7B, 0x82, 0xE1, 0x95, 0x9D, 0x4B, 0x2, 0x47, 0xC5, 0x2C, 0x70, 0x64, 0x67, 0xC3, 0x8F, 0x81, 0xF4, 0x1C, 0x61, 0x16, 0xD, 0xBE, 0x84, 0x82, 0x16, 0x5F, 0xA2, 0xE5, 0xEA, 0xC9, 0x1D, 0x52, 0xC4, 0x92, 0x72, 0x1F, 0x3, 0xC9, 0x66, 0x4F, 0x3A, 0xAC, 0xAD, 0x39, 0xE6, 0xB0, 0x24, 0xDF, 0xBC, 0x58, 0x82, 0x6F, 0x5E, 0x6C, 0x61, 0x59, 0xB6, 0x75, 0x4B, 0xB2, 0x25, 0x31, 0xF3, 0x65, 0x14, 0x5, 0xE6, 0xBC, 0x24, 0x4B, 0x5F, 0x94, 0x9D, 0x3C, 0xF4, 0x45, 0x35, 0xB3, 0x87, 0x16, 0xD3, 0x8D, 0xC9, 0xB7, 0x33, 0xF7, 0xA5, 0x89, 0xA8, 0x79, 0x51, 0x21, 0x82, 0x9D, 0xEE, 0x5, 0xD, 0xE5, 0x74, 0xDF, 0xC8, 0x7A, 0xEA, 0x63, 0x50, 0x38, 0x6D, 0x7B, 0xED, 0x4, 0x3D, 0xB5, 0x83, 0x98, 0x9A, 0xA, 0xCA, 0x19, 0xB1, 0x5C, 0xD3, 0x1, 0xA3, 0x83, 0x3D, 0x8C, 0xFA, 0x6E, 0xAE, 0xBC, 0x4F, 0xDD, 0xB, 0xC5, 0x5E, 0xEB, 0x9, 0xAD, 0x39, 0xCF, 0x3F, 0x59, 0x70, 0x68, 0xD8, 0x1E, 0xBC, 0xBC, 0x88, 0x60, 0x22, 0xA6, 0x2D, 0xEB, 0xE9, 0x90, 0x70, 0x7C, 0x77, 0x40, 0xDC, 0x76, 0x90, 0x8A, 0x9B, 0x40, 0x4A, 0xC1, 0xD0, 0xB9, 0xD7, 0x48, 0x4, 0xF8, 0x7B, 0xAC, 0x9A, 0x53, 0xF9, 0xF1, 0x69, 0x99, 0x51, 0x19, 0xB1, 0xC2, 0xD5, 0x1, 0x83, 0xA3, 0x68, 0xD5, 0xB6, 0x73, 0xEA, 0x1A, 0x46, 0xF2, 0xC1, 0xB0, 0xAB, 0xE8, 0x49, 0xD1, 0x9C, 0x24, 0xA5, 0xEE, 0x5A, 0xB5, 0xF5, 0xD4, 0x19, 0x35, 0xB, 0x9B, 0x92, 0x17, 0x8E, 0x6D, 0x4C, 0x56, 0xED, 0xD4, 0x66, 0x4D, 0x10, 0x2C, 0xF4, 0x17, 0xC, 0xD, 0xA5, 0x95, 0xBA, 0xA7, 0xE5, 0x4F, 0xAF, 0x76, 0x8, 0x38, 0xC5, 0x59, 0x69, 0xD6, 0x82, 0x84, 0x59, 0x23, 0xFC, 0xAB, 0x90, 0x69, 0x64, 0x47, 0x80, 0xF3, 0x88, 0x75, 0xD5, 0x83, 0x72, 0x6C, 0xE, 0x95, 0x13, 0x3, 0xA4, 0x38, 0xD5, 0xCD, 0x5C, 0xFF, 0xEC, 0xCF, 0x8B, 0xCC, 0xF2, 0x25, 0xA4, 0x83, 0xC3, 0xE3, 0xC8, 0x29, 0x3B, 0xF0, 0xEF, 0x10, 0x9F, 0x23, 0xCC, 0x69, 0x7, 0xC4, 0xD5, 0x63, 0xFD, 0x80, 0xE5, 0xBA, 0xAC, 0xEF, 0x91, 0xE1, 0x14, 0x67, 0xF7, 0xBB, 0x46, 0x15, 0xCF, 0x53, 0x1D, 0x1B, 0xEB, 0xE0, 0x9A, 0x72, 0xB4, 0x83, 0xE3, 0x52, 0xE0, 0x92, 0x93, 0x53, 0xE0, 0x7E, 0x70, 0x72, 0xA6, 0xA6, 0x6B, 0xBA, 0xA2, 0xA5, 0xE9, 0xF4, 0x40, 0x56, 0xD4, 0x1D, 0x9, 0x5, 0x55, 0x47, 0xA2, 0xD, 0x4E, 0x99, 0x39, 0x2F, 0x2E, 0x91, 0xA0, 0x32, 0x39, 0xD, 0x24, 0x46, 0x11, 0x7D, 0xB0, 0x29, 0x26, 0x9A, 0x8D, 0xDC, 0xD0, 0xA8, 0x52, 0x40, 0x5B, 0xE4, 0x65, 0x47, 0xE0, 0xD0, 0xA8, 0xF2, 0x8, 0x1C, 0x14, 0xE7, 0x2A, 0x1C, 0x79, 0x8B, 0xDE, 0xC2, 0x6E, 0xE0, 0x92, 0xF, 0x26, 0x5E, 0xE4, 0x7B, 0xD9, 0x91, 0x33, 0x0, 0x9E, 0xC6, 0x6E, 0xE0, 0x12, 0xEB, 0x24, 0xDE, 0xA2, 0xEA, 0x72, 0x3, 0x17, 0xB0, 0x98, 0x78, 0x81, 0xAE, 0x55, 0xD6, 0xF0, 0xBD, 0x94, 0x80, 0x32, 0x3B, 0x3B, 0xE4, 0x87, 0xA6, 0x58, 0x4, 0x34, 0xF2, 0x72, 0xB1, 0x3A, 0x91, 0xB7, 0x14, 0x81, 0x1C, 0x79, 0x99, 0x20, 0xBB, 0xC4, 0x5B, 0xA, 0xB4, 0x23, 0x37, 0xB4, 0xDC, 0x49, 0x80, 0x1B, 0x9A, 0xB6, 0x78, 0x14, 0xE0, 0x86, 0x96, 0x29, 0xB8, 0x9E, 0x78, 0x4B, 0x5, 0xD7, 0x23, 0x2F, 0xB7, 0x9, 0x8A, 0xBC, 0xA5, 0x2A, 0x78, 0x91, 0x57, 0x18, 0x5A, 0x80, 0x3E, 0x1B, 0x5A, 0x61, 0x78, 0xB4, 0xC0, 0xF0, 0x68, 0x59, 0x25, 0x3E, 0x34, 0x6D, 0xD1, 0x20, 0x72, 0x3, 0x97, 0xA3, 0x27, 0xF1, 0x96, 0x57, 0x41, 0x7E, 0xD7, 0xEE, 0x90, 0x5D, 0xBB, 0x1B, 0x5A, 0xEE, 0xC6, 0x34, 0xF2, 0x16, 0xAF, 0x4C, 0xDD, 0xD0, 0xB2, 0xB, 0xCE, 0xD0, 0xB4, 0xE5, 0x15, 0x67, 0x68, 0x99, 0xF4, 0x18, 0x89, 0xB7, 0x94, 0x21, 0x23, 0xF2, 0xA, 0xE3, 0xB9, 0x5, 0xC6, 0x33, 0xBF, 0x60, 0xD, 0xC8, 0x8A, 0x35, 0x70, 0x91, 0xDE, 0x89, 0x17, 0xE8, 0x5F, 0x2E, 0xD7, 0x69, 0xE2, 0x5, 0xFA, 0xB7, 0x13, 0xE6, 0x7E, 0x7, 0xCC, 0x7D, 0x2E, 0x4D, 0x6A, 0xE2, 0x2D, 0x25, 0x6F, 0x89, 0xBC, 0x42, 0x1F, 0x75, 0x40, 0x1F, 0x75, 0xDC, 0xFD, 0x6C, 0xE4, 0x2D, 0x5E, 0xCF, 0xBA, 0xA1, 0x17, 0xE6, 0x60, 0xF, 0xCC, 0xC1, 0x5E, 0x68, 0xE7, 0x1E, 0x68, 0xE7, 0x5E, 0x18, 0x57, 0x3D, 0x30, 0xAE, 0xB4, 0xB0, 0x7C, 0x6B, 0x60, 0x5D, 0xD0, 0xC2, 0xF2, 0xAD, 0x81, 0xE5, 0x5B, 0xB, 0x4B, 0xB0, 0x6, 0x96, 0x60, 0x2E, 0x8F, 0x6D, 0xE2, 0x5, 0xE6, 0x3E, 0x7B, 0xC8, 0x1C, 0x79, 0x81, 0xB9, 0x7F, 0x65, 0xCA, 0x32, 0x46, 0xDE, 0x6B, 0xA9, 0x2C, 0x63, 0xE4, 0x15, 0xC6, 0xC6, 0x15, 0x18, 0x1B, 0xBC, 0xA1, 0x3E, 0x34, 0x80, 0xA1, 0x3E, 0xC, 0x42, 0x3B, 0xF, 0x40, 0x3B, 0xF, 0xC2, 0x5A, 0x36, 0x0, 0x6B, 0xD9, 0x20, 0xE8, 0xAB, 0x1, 0xD0, 0x57, 0x83, 0x60, 0x26, 0xD, 0x80, 0x99, 0x34, 0x8, 0xBA, 0x6E, 0x0, 0x74, 0xDD, 0xC0, 0xEE, 0xA9, 0x86, 0x66, 0x28, 0xEF, 0xA6, 0x86, 0x41, 0x58, 0xCB, 0x6, 0x60, 0x2D, 0x1B, 0x84, 0x31, 0x39, 0x0, 0x63, 0x72, 0x10, 0x74, 0xEC, 0x0, 0xE8, 0xD8, 0x41, 0xD0, 0x75, 0x3, 0xA0, 0xEB, 0xB8, 0x62, 0xB7, 0x91, 0xB7, 0x58, 0xEF, 0x36, 0xF2, 0xA, 0x7D, 0x34, 0x2, 0x7D, 0x34, 0xA, 0x6D, 0x35, 0x2, 0x6D, 0xC5, 0x55, 0x37, 0x4F, 0xBC, 0x40, 0x5B, 0x19, 0x61, 0x4C, 0x1A, 0x60, 0x4C, 0x1A, 0x61, 0xEE, 0x1B, 0x60, 0xEE, 0x73, 0xF5, 0x67, 0x13, 0x2F, 0xD0, 0x56, 0xE6, 0x87, 0xC0, 0xFB, 0x3, 0xE0, 0x15, 0xC6, 0xB3, 0x1, 0xC6, 0xB3, 0x11, 0xF4, 0xB3, 0x1, 0xF4, 0x33, 0x1B, 0x67, 0xE8, 0x6, 0x39, 0xD0, 0xF0, 0x93, 0x57, 0x58, 0x43, 0xBF, 0x80, 0x35, 0x74, 0x12, 0xF4, 0xE4, 0x4, 0xE8, 0xC9, 0x49, 0xD0, 0x75, 0x13, 0xA0, 0xEB, 0xB8, 0xA4, 0x9D, 0x89, 0x17, 0x18, 0x1B, 0x93, 0x30, 0x36, 0x26, 0x60, 0x6C, 0x4C, 0x82, 0xAE, 0x9B, 0x0, 0x5D, 0x37, 0x9, 0xF6, 0xD5, 0x4, 0xD8, 0x57, 0x93, 0xA0, 0x73, 0x26, 0x40, 0xE7, 0x4C, 0x82, 0x9D, 0x33, 0x1, 0x76, 0xCE, 0x2C, 0xEC, 0x7, 0x67, 0x60, 0x3F, 0x38, 0xB, 0xFB, 0xC1, 0x19, 0xD8, 0xF, 0xCE, 0x82, 0x7D, 0x35, 0x3, 0xF6, 0x15, 0x57, 0xCA, 0x3D, 0xF1, 0x2, 0x3A, 0x76, 0x16, 0xE6, 0xEF, 0xC, 0xCC, 0xDF, 0x59, 0x98, 0xBF, 0x33, 0x30, 0x7F, 0x67, 0xC1, 0x6E, 0x9F, 0x1, 0xBB, 0x7D, 0x16, 0xC6, 0xC6, 0xC, 0x8C, 0x8D, 0x45, 0xE8, 0xDF, 0x5, 0xE8, 0xDF, 0x45, 0xD0, 0x39, 0x62, 0xE1, 0x8E, 0x4F, 0x5E, 0x41, 0x6F, 0x14, 0xB, 0xA, 0x44, 0x5E, 0xA1, 0x7F, 0x17, 0xA0, 0x7F, 0x17, 0x61, 0x3D, 0x5A, 0x80, 0xF5, 0x88, 0xCB, 0x23, 0x95, 0x78, 0x1, 0xBD, 0xB1, 0x8, 0x3A, 0x76, 0x1, 0x74, 0xEC, 0x22, 0x8C, 0xAB, 0x5, 0x18, 0x57, 0x8B, 0xB0, 0xEE, 0x2F, 0xC0, 0xBA, 0xBF, 0x8, 0xEB, 0xFE, 0x2, 0xAC, 0xFB, 0x8B, 0x30, 0x9E, 0x17, 0x60, 0x3C, 0x2F, 0x82, 0x9E, 0x5C, 0x0, 0x3D, 0xB9, 0x7C, 0xB, 0xBC, 0xDF, 0x0, 0xAF, 0x30, 0x8F, 0xC4, 0x38, 0xFF, 0x4F, 0x5E, 0x41, 0xC7, 0x2E, 0x80, 0x8E, 0xB5, 0xC2, 0x1C, 0xB4, 0xC0, 0x1C, 0x64, 0x6F, 0xC0, 0x22, 0x2F, 0x30, 0x8F, 0xB8, 0xE2, 0x1A, 0x89, 0x17, 0x58, 0x7F, 0xB9, 0xDC, 0xAA, 0x89, 0x17, 0xD0, 0x93, 0x5C, 0x12, 0xB0, 0xC4, 0xB, 0xB4, 0x15, 0x7B, 0xA5, 0xE6, 0x86, 0xA6, 0xEC, 0x96, 0xEC, 0x6, 0xAE, 0x20, 0x48, 0xE4, 0x2D, 0x16, 0x4, 0x89, 0xBC, 0x82, 0xAE, 0x2B, 0x16, 0x4, 0x89, 0xBC, 0xC2, 0x91, 0xFB, 0xA, 0x1C, 0xB9, 0xAF, 0xC2, 0x5E, 0x72, 0x5, 0xF6, 0x92, 0xAB, 0xA0, 0x27, 0x57, 0x40, 0x4F, 0xB2, 0xF7, 0x85, 0x91, 0x17, 0xD0, 0x93, 0xAB, 0xA0, 0x27, 0x57, 0x40, 0x4F, 0xAE, 0xC2, 0xB8, 0x5A, 0x81, 0x71, 0xB5, 0xA, 0x7A, 0x72, 0x5, 0xF4, 0x24, 0x57, 0x20, 0x28, 0xF1, 0x2, 0x7A, 0x72, 0x15, 0xF4, 0xC6, 0xA, 0xE8, 0x8D, 0x1F, 0xC2, 0x98, 0xFC, 0x1, 0x8C, 0x49, 0x2E, 0x21, 0x6A, 0xE4, 0x2D, 0x66, 0x44, 0x8D, 0xBC, 0x42, 0x5B, 0x39, 0xA0, 0xAD, 0xB8, 0x2C, 0xE3, 0x89, 0xB7, 0x9C, 0x43, 0xCC, 0xD, 0x5C, 0x9E, 0xE4, 0xC4, 0xC, 0x28, 0x77, 0x2F, 0x34, 0x96, 0x7, 0x1A, 0xCB, 0xB, 0xC6, 0xA8, 0x7, 0x8C, 0x51, 0x2F, 0x18, 0xA3, 0x1E, 0x30, 0x46, 0x3D, 0xE3, 0x11, 0x9B, 0x78, 0x4B, 0xC5, 0xBE, 0x22, 0xAF, 0xA0, 0x74, 0x8A, 0x9, 0x9A, 0x23, 0xAF, 0xB0, 0x30, 0x78, 0x60, 0x61, 0xE0, 0xB2, 0x87, 0x27, 0x5E, 0x40, 0xE9, 0x78, 0x41, 0x71, 0x78, 0x40, 0x71, 0x78, 0x41, 0x71, 0x78, 0x40, 0x71, 0x78, 0x61, 0x32, 0x78, 0x60, 0x32, 0x78, 0x61, 0x32, 0x78, 0x60, 0x32, 0x70, 0x5, 0xF4, 0x12, 0x2F, 0xA0, 0x38, 0xBC, 0x60, 0x24, 0x79, 0xC0, 0x48, 0xF2, 0x82, 0xD2, 0xF1, 0x80, 0xD2, 0xF1, 0xC2, 0x2, 0xEC, 0x81, 0x5, 0x38, 0x8, 0x87, 0xB9, 0x1, 0x38, 0xCC, 0xE5, 0xAA, 0x4C, 0x25, 0x5E, 0x60, 0xF1, 0xE6, 0x3D, 0x26, 0x6, 0xD9, 0x53, 0xE2, 0x93, 0x57, 0x18, 0x1B, 0x62, 0xD2, 0xD9, 0x4F, 0x5E, 0x61, 0x41, 0xA, 0xC0, 0x82, 0xC4, 0x25, 0x9E, 0x4E, 0xBC, 0xC0, 0xD8, 0x8, 0x82, 0x9E, 0xC, 0x80, 0x9E, 0x64, 0x93, 0x99, 0xB9, 0x1, 0xC8, 0x66, 0xE6, 0x86, 0x4D, 0xD0, 0x1B, 0x1B, 0xA0, 0x37, 0xB8, 0xBA, 0xB9, 0x89, 0x17, 0x30, 0x38, 0x36, 0x61, 0x3C, 0x6F, 0xC0, 0x78, 0xE6, 0x2A, 0x25, 0x24, 0x5E, 0x60, 0x3C, 0xDF, 0x4, 0xDD, 0x7E, 0x3, 0x74, 0x3B, 0x57, 0xF9, 0x2C, 0xF1, 0x2, 0xFA, 0xF9, 0x26, 0x18, 0xDF, 0x37, 0xC0, 0xF8, 0xBE, 0x9, 0x73, 0xE1, 0x6, 0xCC, 0x85, 0x9B, 0xB0, 0x78, 0xDF, 0x80, 0x4D, 0x1D, 0x57, 0xA3, 0x26, 0xF2, 0x8A, 0xA5, 0x69, 0x3E, 0x78, 0x1F, 0x42, 0x5B, 0x3D, 0x80, 0xB6, 0x7A, 0x8, 0xF3, 0xE8, 0x51, 0xE, 0x6D, 0x70, 0x3, 0x57, 0x62, 0x2E, 0x32, 0x17, 0x4B, 0xCC, 0x45, 0x5E, 0x41, 0x61, 0x3D, 0x1, 0x85, 0xF5, 0x14, 0x26, 0xF0, 0xB3, 0x3C, 0x81, 0xD, 0x6B, 0x33, 0x34, 0xA6, 0x6C, 0x32, 0x98, 0xC1, 0x58, 0xB6, 0x93, 0x76, 0xA0, 0xC8, 0xEE, 0x83, 0xE2, 0xAB, 0xA2, 0x9E, 0x18, 0x10, 0xAE, 0xEB, 0x78, 0x7E, 0x84, 0x93, 0xF7, 0xCE, 0x43, 0xDC, 0xF2, 0x26, 0x65, 0x7, 0xED, 0x58, 0x7, 0xC3, 0x3, 0x43, 0x1C, 0xC, 0x27, 0xC9, 0xD1, 0x31, 0x21, 0x90, 0x4, 0x76, 0x98, 0x37, 0x53, 0x79, 0x94, 0x4F, 0xBC, 0x83, 0xDC, 0x54, 0x5E, 0x67, 0xA4, 0xF2, 0x74, 0x3B, 0x82, 0xBC, 0x35, 0x7F, 0xD2, 0x83, 0x1C, 0xF4, 0xF0, 0x67, 0x17, 0xC8, 0xD1, 0xC5, 0xCA, 0xCE, 0x8F, 0x66, 0x2D, 0x4F, 0xF, 0x6F, 0x82, 0x16, 0x5C, 0xBE, 0x77, 0xC, 0xF2, 0xAC, 0x75, 0x7E, 0xA2, 0x81, 0x6F, 0xB6, 0x88, 0x20, 0xCD, 0x96, 0x66, 0x4, 0x2B, 0x20, 0x20, 0x51, 0xBA, 0x8E, 0x8D, 0xFC, 0x70, 0x72, 0xD4, 0xC7, 0x7, 0x27, 0xBB, 0xE2, 0x35, 0xA1, 0xBC, 0xE0, 0xF1, 0xEB, 0x3B, 0xB2, 0xBC, 0xB3, 0xC9, 0x16, 0x1C, 0x90, 0x6C, 0xC1, 0x7D, 0xB3, 0x65, 0xFD, 0xC9, 0x7D, 0x23, 0x45, 0xFD, 0x53, 0x89, 0x39, 0x86, 0x3B, 0x3D, 0x2E, 0xFC, 0xB2, 0x54, 0xBB, 0xE, 0x2C, 0x5B, 0x97, 0xC8, 0xFC, 0x9E, 0xAE, 0x26, 0x2B, 0xCA, 0x1F, 0x9, 0x6B, 0x70, 0xB1, 0x66, 0x52, 0x8B, 0xF9, 0x32, 0x8B, 0xCA, 0xC7, 0x8F, 0x7C, 0x52, 0x56, 0x5, 0x91, 0x44, 0xC6, 0x4C, 0xB0, 0x61, 0x8A, 0x5D, 0x85, 0x43, 0xD, 0xBD, 0xF2, 0x1D, 0x9F, 0x5C, 0x37, 0x21, 0x80, 0xE3, 0x19, 0xBB, 0x93, 0x5, 0xF6, 0xB1, 0x9E, 0xAF, 0x43, 0x47, 0x1E, 0xA9, 0x41, 0x47, 0x5E, 0x7, 0xCA, 0xC6, 0xF2, 0x46, 0x82, 0x9A, 0x58, 0x5E, 0x3F, 0xEA, 0x7E, 0x51, 0x6E, 0xE2, 0xDF, 0xA9, 0x39, 0x50, 0xE4, 0xCD, 0x62, 0x37, 0xCA, 0x81, 0xF6, 0x7B, 0x2F, 0xA3, 0xB1, 0xF6, 0x89, 0x3A, 0xA5, 0x19, 0xDB, 0xA4, 0x9C, 0x45, 0xBB, 0xC0, 0x17, 0xD, 0x26, 0xD4, 0xA6, 0xA2, 0xE, 0x56, 0x79, 0x59, 0xA8, 0xA5, 0xE6, 0x45, 0x3, 0x9, 0x25, 0xD7, 0x2B, 0x71, 0xBD, 0xF2, 0xCD, 0xB, 0x87, 0xDA, 0x70, 0x93, 0x13, 0xD4, 0xFA, 0x71, 0x83, 0xB3, 0xD3, 0xFA, 0x99, 0xF8, 0xD7, 0x99, 0x9, 0x79, 0xD, 0x7A, 0x38, 0x81, 0x3F, 0x22, 0x88, 0x4, 0xB1, 0x12, 0xE1, 0x1, 0x41, 0x32, 0x9C, 0xBE, 0x4, 0xA, 0xD2, 0x7C, 0x79, 0xC1, 0x98, 0x2C, 0xE2, 0xB, 0xD8, 0x1D, 0x10, 0x70, 0xA, 0x1C, 0x9, 0x67, 0x3D, 0xC9, 0x52, 0x22, 0x8, 0xCA, 0xB9, 0x1B, 0xDD, 0x89, 0x72, 0x22, 0x88, 0xCA, 0xB9, 0xCF, 0xD3, 0x2A, 0xB, 0x8A, 0x28, 0x20, 0x29, 0xA8, 0x41, 0xE, 0x88, 0x8B, 0x20, 0x18, 0x39, 0x90, 0x29, 0xD9, 0x44, 0x78, 0xBD, 0x26, 0xA, 0x6A, 0xD2, 0xF9, 0x35, 0x24, 0x52, 0x54, 0xAD, 0x1D, 0x41, 0x4D, 0x46, 0x8E, 0xCF, 0x8D, 0x28, 0x1C, 0x9E, 0x1B, 0x89, 0x33, 0x11, 0x68, 0x49, 0x16, 0x1C, 0x7F, 0x16, 0xA9, 0x73, 0x79, 0x28, 0x92, 0x34, 0x3C, 0xF, 0x45, 0x50, 0xA5, 0x84, 0x54, 0x41, 0x55, 0x26, 0xA4, 0xA, 0x6A, 0xE5, 0x1C, 0x7F, 0xFF, 0x6A, 0x22, 0xF2, 0xAB, 0xEF, 0x2F, 0x97, 0xB3, 0x20, 0x28, 0x97, 0x6F, 0x2F, 0x57, 0xD5, 0x5E, 0x6E, 0xCB, 0xA6, 0x16, 0x8B, 0x4, 0x35, 0x99, 0xC5, 0x42, 0x66, 0xC9, 0xA, 0xF8, 0x7A, 0x15, 0x46, 0xED, 0x2C, 0x77, 0x27, 0x16, 0x81, 0xC6, 0x16, 0x6F, 0xC5, 0x2, 0xBB, 0xC1, 0xC, 0xC0, 0x6, 0x33, 0x50, 0x9B, 0x4F, 0xB6, 0x16, 0x9, 0xAA, 0x5A, 0x84, 0xAE, 0xDB, 0x54, 0x12, 0x19, 0x49, 0xAA, 0x85, 0x8A, 0x61, 0xCA, 0xA1, 0x26, 0x48, 0x39, 0x90, 0xB1, 0x7A, 0x5D, 0xB, 0xC3, 0xE0, 0x20, 0xAA, 0x7B, 0xC7, 0x7, 0xC9, 0x39, 0x25, 0x23, 0xA, 0xE7, 0x94, 0xC, 0x3E, 0x9A, 0x24, 0xF2, 0x52, 0xBE, 0xE3, 0xF0, 0x6A, 0x1E, 0xFC, 0xC6, 0xEE, 0x17, 0xF6, 0xE7, 0x0, 0x73, 0xA6, 0xF8, 0x49, 0x82, 0x6B, 0x3E, 0x6C, 0x32, 0x79, 0x13, 0x6A, 0x17, 0x58, 0x63, 0x42, 0x5, 0xBF, 0xC9, 0x39, 0x8C, 0x82, 0xDF, 0xE0, 0xE4, 0x45, 0xEC, 0xE1, 0x74, 0xF9, 0x64, 0x7A, 0xCB, 0x65, 0xE3, 0xDA, 0x2A, 0x92, 0x71, 0x6D, 0xBD, 0x58, 0xA6, 0x3C, 0x62, 0x68, 0x36, 0xD7, 0x4D, 0x4C, 0xE6, 0xBB, 0xA1, 0xB9, 0x7C, 0xB7, 0x25, 0x3F, 0x3F, 0xB6, 0xA5, 0x6A, 0x6A, 0x6C, 0xC1, 0x3F, 0x7C, 0xD0, 0x8B, 0x4F, 0xA1, 0x9C, 0xFC, 0x39, 0xC5, 0xCF, 0x34, 0xD0, 0x79, 0xC5, 0x4D, 0xBB, 0x51, 0xCD, 0x33, 0x7F, 0xE2, 0x70, 0x82, 0x80, 0x75, 0x77, 0xE3, 0xE, 0x1D, 0x6E, 0xC5, 0x23, 0x87, 0x9B, 0xD3, 0x17, 0x6D, 0x26, 0xE1, 0x8C, 0xED, 0x85, 0x2, 0x2F, 0x70, 0x57, 0xD6, 0x64, 0xB2, 0x39, 0x47, 0x18, 0x4F, 0xE6, 0x7C, 0x57, 0xCE, 0x28, 0xAF, 0x72, 0x69, 0xDB, 0x5F, 0x24, 0x70, 0xCA, 0xD6, 0xBB, 0x35, 0x2B, 0x9F, 0x56, 0x27, 0x22, 0x50, 0x4A, 0x9D, 0xBB, 0x5D, 0xB9, 0x7E, 0xBF, 0xDB, 0x66, 0x2D, 0x77, 0xF5, 0xDD, 0xAE, 0x8E, 0x6E, 0x46, 0xCC, 0xE0, 0xF0, 0xC6, 0xA1, 0x91, 0x73, 0xA7, 0x8E, 0x7A, 0x2E, 0xF8, 0xE3, 0xAF, 0x86, 0xEE, 0xD4, 0xEC, 0x60, 0xC9, 0x32, 0xF9, 0x56, 0x42, 0x7A, 0x27, 0xFA, 0x56, 0x4C, 0x56, 0x27, 0xEE, 0x3D, 0xBE, 0xAF, 0xE4, 0x7A, 0xD1, 0x74, 0x4E, 0x28, 0x68, 0x3B, 0x7F, 0xB3, 0xEE, 0xB, 0xDF, 0xB2, 0xF7, 0xC2, 0xFB, 0x25, 0x1E, 0xAA, 0xD5, 0x72, 0x39, 0xC3, 0x4, 0xC3, 0xD5, 0xC, 0x13, 0x59, 0x2E, 0xE1, 0xFA, 0x63, 0x4F, 0x22, 0x8A, 0x66, 0x5B, 0x8F, 0xE4, 0x4F, 0x35, 0x99, 0xE9, 0x21, 0xD5, 0xC, 0x38, 0x29, 0x9A, 0x44, 0x2, 0x89, 0x64, 0x2F, 0x3F, 0x1F, 0xA, 0xA8, 0xB8, 0x49, 0xF, 0x61, 0xB6, 0x3F, 0xA0, 0x79, 0xFE, 0x18, 0x94, 0xEB, 0x85, 0xFD, 0xE8, 0x81, 0x21, 0x52, 0x58, 0x4F, 0xE0, 0x87, 0xEC, 0x8, 0xFC, 0xF1, 0xFA, 0xD4, 0x93, 0xB8, 0xC4, 0x44, 0x10, 0x5D, 0x63, 0x1E, 0x69, 0xFB, 0xEC, 0x67, 0xD3, 0x3B, 0xCD, 0x7F, 0xCE, 0x7, 0x1, 0xF0, 0x4D, 0x4F, 0x36, 0xBA, 0xF7, 0x9, 0x4, 0xF7, 0x3E, 0xA9, 0x50, 0x19, 0x30, 0x12, 0xD4, 0x2C, 0x56, 0xCF, 0x4D, 0x8F, 0x66, 0xE1, 0xD, 0xAF, 0xE7, 0xD6, 0x24, 0x10, 0x12, 0x93, 0x2D, 0x75, 0xF1, 0xDC, 0x2A, 0xA, 0x5D, 0x70, 0x6E, 0x7A, 0x45, 0x17, 0xBD, 0x55, 0x29, 0xDF, 0x6A, 0xDD, 0x3E, 0x82, 0xE6, 0xF2, 0xC6, 0xA5, 0x72, 0xB7, 0x7, 0xFE, 0x6B, 0xEE, 0x38, 0x66, 0xE8, 0x44, 0xF2, 0x5E, 0x5, 0xD5, 0x69, 0x1B, 0xB4, 0xBB, 0x32, 0x57, 0xB5, 0x49, 0xE0, 0x9B, 0xA4, 0xB9, 0x4A, 0xD7, 0xB6, 0x3F, 0xC9, 0x1C, 0xC9, 0x7, 0xDD, 0x5F, 0xDA, 0xC7, 0x65, 0x75, 0x5A, 0x56, 0xF0, 0x49, 0xF8, 0xEF, 0xB4, 0x88, 0xB2, 0xFF, 0xA5, 0x0, 0xB0, 0x2C, 0xFE, 0xB3, 0xF6, 0x2F, 0x24, 0xB7, 0x33, 0x57, 0xB9, 0x44, 0xE2, 0x1, 0x63, 0xEA, 0x7B, 0x55, 0xBD, 0x76, 0x2D, 0x39, 0x31, 0xAB, 0xC3, 0x8B, 0x0, 0x96, 0x47, 0x37, 0xB6, 0x34, 0xCD, 0x8E, 0x94, 0x37, 0x86, 0xAB, 0xEA, 0x37, 0x49, 0xC0, 0x6, 0xF1, 0x73, 0xB1, 0x86, 0xE9, 0x69, 0x99, 0xF1, 0xEA, 0xC8, 0x6, 0xB5, 0xAE, 0x42, 0x5F, 0xBD, 0x9, 0xB0, 0x7E, 0x1A, 0xB4, 0xEF, 0x35, 0xE3, 0x58, 0x94, 0x90, 0x26, 0x42, 0x88, 0x8, 0x13, 0x46, 0x7F, 0x19, 0xB5, 0x99, 0x5B, 0x47, 0xD6, 0xF6, 0xC2, 0xD7, 0xF9, 0xC6, 0x84, 0xE6, 0x93, 0xB0, 0xE9, 0x8B, 0xF9, 0x71, 0x12, 0xDF, 0xAA, 0x75, 0xBF, 0x50, 0x6B, 0x66, 0xF3, 0x14, 0xE7, 0xC0, 0xAF, 0x64, 0xF0, 0xE7, 0x7, 0x72, 0xAB, 0xA3, 0x2F, 0xDD, 0x5, 0xD6, 0x88, 0xDB, 0x5F, 0xFC, 0x4D, 0x84, 0xD8, 0x73, 0x89, 0xE7, 0x6E, 0x94, 0xEB, 0xEF, 0xBA, 0x95, 0xDF, 0xB7, 0x39, 0x49, 0xD0, 0x77, 0xFD, 0x9E, 0x45, 0x61, 0xDF, 0x33, 0x28, 0x84, 0x6E, 0xDC, 0xD5, 0xCC, 0xAA, 0xD2, 0xEE, 0xA7, 0x78, 0x35, 0x93, 0x4, 0x70, 0xFB, 0xA7, 0x43, 0x40, 0x71, 0xFB, 0xB4, 0xA, 0x13, 0x7, 0x99, 0x35, 0xB3, 0xEA, 0xC4, 0x44, 0x7A, 0x3B, 0x8A, 0x6A, 0x80, 0x59, 0xB3, 0x69, 0x11, 0x56, 0xD5, 0xCC, 0x3A, 0x93, 0x19, 0xE1, 0xA3, 0x1D, 0x67, 0x2D, 0x1E, 0xEB, 0x26, 0x10, 0x7F, 0x13, 0xB7, 0xB0, 0x2E, 0x14, 0x7, 0x4, 0xB5, 0xCB, 0xA2, 0x3C, 0xFF, 0x39, 0x11, 0x41, 0x3A, 0x75, 0x5E, 0xFC, 0xEA, 0x8C, 0x1D, 0x7C, 0xE6, 0x9B, 0x4E, 0x12, 0xF4, 0xCB, 0xAC, 0x5A, 0xC4, 0x3C, 0xD7, 0x3B, 0x8A, 0x4B, 0xF2, 0xC4, 0xE6, 0x56, 0x79, 0x43, 0x45, 0x11, 0x7A, 0xE6, 0x62, 0xB4, 0x13, 0x20, 0x47, 0x69, 0x7F, 0xF4, 0xB7, 0xD, 0xA3, 0x26, 0xEB, 0x8D, 0x64, 0x30, 0xBC, 0x71, 0xC8, 0x60, 0x70, 0xAA, 0x1B, 0xB7, 0x60, 0xEC, 0xA0, 0x34, 0xE7, 0x97, 0xFD, 0x41, 0xD0, 0x24, 0x8A, 0xD2, 0xF7, 0x39, 0x35, 0xCC, 0xA6, 0xCF, 0xCA, 0x3B, 0x8, 0x40, 0x79, 0x5C, 0x74, 0xE9, 0xFE, 0x18, 0x60, 0x15, 0xAA, 0xA6, 0x27, 0xC, 0x2C, 0x9C, 0x9E, 0x68, 0xE5, 0xE1, 0x13, 0x5, 0x81, 0xA3, 0xC7, 0xCD, 0x46, 0x2D, 0xDA, 0x6, 0x2E, 0xE7, 0xC4, 0x1B, 0x2D, 0xA7, 0x9E, 0x78, 0xD3, 0x72, 0x11, 0x29, 0x1F, 0x92, 0x8A, 0x81, 0x29, 0xAB, 0x72, 0x8B, 0xA0, 0x6, 0xDD, 0x2, 0x69, 0x42, 0x47, 0xDD, 0x38, 0xFD, 0xFD, 0xCF, 0xA2, 0x38, 0x2F, 0x8B, 0x1D, 0x6E, 0xA6, 0xBF, 0xFF, 0x69, 0x16, 0x55, 0x76, 0xB8, 0x58, 0x15, 0xB7, 0x3D, 0x4E, 0x4F, 0x8B, 0x8C, 0xC1, 0x70, 0x57, 0xF5, 0x9, 0x28, 0x5F, 0xD7, 0x47, 0x32, 0xAB, 0x85, 0x1F, 0xDF, 0x91, 0xB2, 0x0, 0x81, 0x1B, 0x61, 0xE5, 0xC7, 0x43, 0x28, 0x8F, 0x3, 0xAF, 0x7A, 0x6D, 0x33, 0xBA, 0x6D, 0xC7, 0xD1, 0xF1, 0xE9, 0xBD, 0x1A, 0x34, 0xE7, 0x86, 0xF7, 0x86, 0xCA, 0x22, 0xB4, 0x1D, 0xB4, 0xBB, 0x28, 0x9F, 0x32, 0xF, 0x6, 0x43, 0x56, 0xD2, 0x3, 0xC, 0x29, 0xA6, 0x11, 0x82, 0x33, 0x8A, 0x4B, 0x43, 0xB4, 0x23, 0xE5, 0x4C, 0x44, 0xAB, 0x30, 0x56, 0x91, 0x1, 0x7A, 0x33, 0xC2, 0x74, 0xB9, 0x19, 0x68, 0xBA, 0xB0, 0x3D, 0x5D, 0xEE, 0xE7, 0xC7, 0x7D, 0x34, 0x8E, 0x37, 0xB9, 0xFE, 0x6A, 0x4E, 0x18, 0x29, 0xE6, 0xBD, 0xB6, 0x5C, 0xC9, 0xD7, 0xB5, 0x2D, 0x97, 0x7C, 0x5D, 0x5B, 0x4E, 0x8F, 0x36, 0x6D, 0xC9, 0xFF, 0x6A, 0x6D, 0x25, 0x43, 0xAF, 0x5, 0xD, 0xBD, 0x56, 0xB2, 0x91, 0x5A, 0xD4, 0x46, 0xEA, 0xB8, 0xC, 0x41, 0xE9, 0x69, 0xE1, 0xA7, 0x3B, 0xB6, 0xB3, 0x3B, 0xA0, 0xA7, 0xD3, 0xC9, 0x62, 0xDC, 0xA, 0x73, 0xFC, 0xE9, 0xEC, 0x3C, 0x81, 0xC5, 0x37, 0x67, 0x2D, 0x5C, 0xC0, 0x71, 0x60, 0xED, 0xD9, 0x57, 0xEF, 0x81, 0x57, 0xEF, 0xAD, 0x17, 0xF6, 0x85, 0xBD, 0xF5, 0x4D, 0x66, 0x73, 0xF8, 0x7E, 0x6D, 0x8E, 0xB9, 0xA8, 0x84, 0x35, 0x97, 0xCD, 0x7D, 0xD5, 0xE5, 0x6C, 0xEE, 0xAB, 0x96, 0x86, 0x98, 0x6, 0x87, 0x98, 0x66, 0x1B, 0x4B, 0x3, 0x8D, 0xA5, 0x39, 0xF7, 0xD7, 0x55, 0x97, 0xDD, 0x5F, 0x57, 0x2D, 0xD, 0x6B, 0x8D, 0xE, 0x6B, 0x6D, 0x9F, 0xCA, 0x33, 0x27, 0x72, 0x9, 0x68, 0x7C, 0x31, 0xAA, 0x6D, 0xD5, 0x8E, 0x4B, 0x93, 0xB0, 0x6A, 0x57, 0x4E, 0x93, 0xB0, 0xF2, 0x57, 0xE1, 0x2B, 0x72, 0x11, 0xBE, 0x6A, 0xC7, 0x45, 0x79, 0x45, 0xDE, 0x62, 0x94, 0x57, 0xE4, 0x25, 0x2E, 0x29, 0x4C, 0x2, 0xCA, 0x69, 0x61, 0x12, 0x19, 0x17, 0xAF, 0xB5, 0xF3, 0x17, 0x23, 0xB6, 0x12, 0x19, 0x17, 0xD3, 0xB3, 0xF3, 0x17, 0xA3, 0x7A, 0x22, 0xD9, 0xAA, 0xBB, 0x20, 0xDD, 0x4A, 0xBC, 0x70, 0xEC, 0x6E, 0x62, 0xD5, 0x6E, 0x1B, 0x84, 0x35, 0x69, 0x87, 0x90, 0x31, 0xEC, 0x95, 0xA3, 0xCB, 0xE6, 0x5A, 0xC3, 0xFB, 0xD0, 0xFF, 0x44, 0x80, 0xCA, 0x2B, 0x89, 0xAB, 0x90, 0xD6, 0x29, 0xBE, 0x80, 0xF3, 0x1, 0x21, 0x32, 0x58, 0xEE, 0x22, 0x1B, 0x37, 0xC0, 0x8B, 0x83, 0xFB, 0x6A, 0x9E, 0x9A, 0x9B, 0xD6, 0xFB, 0xF3, 0x2, 0x33, 0x33, 0x2D, 0xFE, 0x6A, 0xD6, 0x41, 0x58, 0xD6, 0xDF, 0x6C, 0xEA, 0xAA, 0x2C, 0x7F, 0x68, 0x94, 0x20, 0xE8, 0xA8, 0x68, 0xB8, 0xD2, 0xC0, 0x9F, 0xEA, 0x44, 0x4, 0x3A, 0xD4, 0x61, 0xB, 0x75, 0xAF, 0x40, 0xA1, 0x6E, 0x2E, 0x5C, 0xBC, 0x18, 0x2A, 0xBE, 0x8E, 0x71, 0x97, 0xD2, 0x19, 0x6D, 0xB9, 0x12, 0x27, 0x6F, 0x54, 0x2E, 0x73, 0xF2, 0xBB, 0x24, 0xCF, 0x25, 0xE8, 0xF9, 0x80, 0xCB, 0x89, 0x7A, 0xE, 0x62, 0xCE, 0xD2, 0x3B, 0x11, 0x4C, 0x80, 0x68, 0xD6, 0x1F, 0x38, 0x68, 0xD6, 0x8F, 0x9C, 0x96, 0x19, 0x4B, 0x21, 0x39, 0xEB, 0x68, 0x66, 0xD5, 0xEB, 0x79, 0x1D, 0x8D, 0x52, 0x4E, 0xCB, 0x7B, 0x8C, 0x5F, 0xE8, 0xD0, 0x97, 0x7A, 0x73, 0x21, 0x92, 0x2B, 0xA4, 0x6, 0x3D, 0x67, 0x5A, 0xEE, 0x20, 0xC0, 0xE5, 0x19, 0x2E, 0x38, 0xFF, 0x4, 0x4A, 0xEC, 0xA4, 0xAD, 0xF9, 0x96, 0x5F, 0x26, 0xC1, 0xE8, 0xAB, 0xB0, 0x95, 0x15, 0xF6, 0xC7, 0x45, 0x56, 0xEE, 0x2A, 0x75, 0x7F, 0xC, 0xB0, 0xE, 0x4E, 0xAD, 0x23, 0x3B, 0x9A, 0xDF, 0x20, 0x2C, 0x26, 0xD3, 0x18, 0x27, 0x9, 0xDC, 0x20, 0x81, 0xD8, 0xAE, 0x49, 0xCF, 0x4B, 0xCC, 0xDF, 0xDA, 0xFA, 0x4E, 0xCD, 0xBA, 0x67, 0xB7, 0x82, 0xE3, 0x77, 0x73, 0xE2, 0xF2, 0xA6, 0xF0, 0xAD, 0xF5, 0xC6, 0x7, 0x5B, 0x8B, 0xEA, 0x78, 0x5E, 0x78, 0x15, 0xA3, 0xBA, 0x68, 0xAE, 0xB1, 0x8A, 0xF3, 0xC0, 0x0, 0xF5, 0x69, 0x3A, 0xEE, 0x3B, 0xE2, 0xD3, 0x22, 0x63, 0x60, 0x97, 0x8D, 0xFD, 0x79, 0x99, 0x79, 0x73, 0x9C, 0x83, 0xC6, 0xB, 0x29, 0x9, 0xE0, 0x74, 0x53, 0x31, 0x5C, 0x70, 0x35, 0xBA, 0x5F, 0xC8, 0xB2, 0xEE, 0x94, 0x27, 0x86, 0xB4, 0x99, 0x8E, 0x84, 0xBC, 0xE9, 0x7F, 0x60, 0x88, 0x14, 0x69, 0xEF, 0x61, 0xC0, 0xBD, 0x87, 0xE1, 0x5F, 0x0, 0xF9, 0xE9, 0x99, 0x75, 0x53, 0x8F, 0xCF, 0x81, 0xD, 0xA2, 0x91, 0xF6, 0x1F, 0x6, 0xDD, 0x7F, 0x98, 0x99, 0x82, 0x70, 0xBA, 0x13, 0x21, 0xE8, 0x34, 0x27, 0x12, 0xCA, 0xA7, 0xAB, 0x9, 0x5, 0x15, 0x82, 0x59, 0x8C, 0xB6, 0x41, 0xF1, 0xE7, 0xBD, 0x7, 0x8, 0x9D, 0xF7, 0x72, 0x29, 0x18, 0xD7, 0x62, 0xFE, 0xC5, 0xD5, 0xD8, 0x56, 0xBA, 0x91, 0x32, 0xB6, 0x5, 0xEF, 0xA4, 0xD8, 0xCA, 0x20, 0xAB, 0x5C, 0xC, 0xE4, 0x83, 0x91, 0x89, 0x61, 0x4F, 0x4F, 0x4B, 0x8C, 0x64, 0x35, 0x6B, 0xAF, 0x1E, 0x40, 0x89, 0xBD, 0xE0, 0xA4, 0x9B, 0x8, 0x2A, 0x1C, 0x3B, 0xD6, 0xE4, 0x29, 0xCF, 0xC9, 0xF1, 0x90, 0x1E, 0xF4, 0x81, 0x84, 0xED, 0xD0, 0xE, 0x41, 0x32, 0xD8, 0x9A, 0xB9, 0xF1, 0x39, 0xD2, 0x83, 0x21, 0xF8, 0x76, 0x73, 0xC3, 0x28, 0xF, 0xEA, 0x93, 0x2, 0x1D, 0xD8, 0x77, 0x71, 0x5C, 0xDD, 0xD1, 0x61, 0xF5, 0x4D, 0xB3, 0xB1, 0xC4, 0x5B, 0xF7, 0x7, 0x8, 0x19, 0xF8, 0xE6, 0xC9, 0x85, 0xA5, 0xEF, 0x8F, 0xB, 0xEF, 0xC0, 0x8D, 0xCF, 0xE2, 0xE8, 0xE4, 0xBE, 0xBA, 0xF8, 0xB5, 0xB3, 0xEA, 0x38, 0x5B, 0x29, 0x3D, 0x2E, 0xB2, 0x5A, 0x1D, 0x94, 0x33, 0xDB, 0x22, 0x76, 0xDF, 0x9B, 0x4, 0xEC, 0xBF, 0x59, 0xD9, 0x90, 0x8E, 0xB7, 0x73, 0x22, 0x77, 0x8A, 0xA, 0x89, 0xB2, 0xD2, 0x4C, 0x28, 0x2C, 0x29, 0x5C, 0xC9, 0x2D, 0xFF, 0x87, 0x2D, 0x50, 0xF8, 0x42, 0x91, 0xDA, 0x84, 0x27, 0xB1, 0x1F, 0xF9, 0x6A, 0x44, 0x7F, 0x35, 0x6F, 0x8A, 0xDF, 0xEB, 0x11, 0x71, 0x47, 0xD5, 0xB3, 0xA, 0x41, 0xBB, 0x8B, 0xE8, 0x61, 0xF2, 0xC6, 0xA1, 0xA1, 0xFB, 0x26, 0xE7, 0xDD, 0x4C, 0x3E, 0xC4, 0x21, 0xCE, 0x26, 0x3B, 0xF9, 0xCA, 0xDA, 0x8F, 0x7, 0x86, 0xD8, 0x8E, 0x33, 0x7B, 0x25, 0x30, 0x97, 0xEF, 0x4, 0x66, 0xF5, 0xF0, 0xFB, 0xA0, 0xE1, 0xF9, 0x4F, 0x10, 0x16, 0x73, 0x91, 0xDD, 0xBE, 0x7F, 0xA3, 0x2, 0x1B, 0xFC, 0x29, 0x6F, 0xEE, 0x22, 0x88, 0x8E, 0xD1, 0xAE, 0x23, 0x2E, 0xA5, 0xF2, 0x3A, 0x77, 0x4D, 0x47, 0xE5, 0xAC, 0xCA, 0xEB, 0xDC, 0x71, 0x99, 0x46, 0x23, 0x7B, 0x31, 0xD3, 0xE8, 0x3A, 0x77, 0x5C, 0x72, 0x98, 0xC8, 0x5B, 0x4C, 0xE, 0xB3, 0xCE, 0xDA, 0x4F, 0x96, 0xF, 0xF8, 0x3F, 0x30, 0xAC, 0x1D, 0xB5, 0x9F, 0x84, 0x3, 0xF9, 0x8, 0x61, 0x27, 0xF2, 0xB3, 0x74, 0x13, 0x3E, 0xA3, 0xD7, 0xE0, 0x33, 0xB1, 0x5B, 0xE1, 0x99, 0xCA, 0x79, 0xEA, 0xD6, 0x99, 0xCB, 0xEC, 0xB3, 0xCE, 0xE5, 0xC4, 0x3E, 0xEB, 0xBC, 0x2D, 0x2D, 0x6F, 0x14, 0x9D, 0x48, 0x51, 0x80, 0x33, 0x56, 0x75, 0x86, 0xAC, 0x9A, 0xD9, 0x5B, 0xC3, 0x4F, 0x2, 0xE0, 0xF2, 0x70, 0xE6, 0x67, 0x7C, 0xF9, 0xEE, 0x90, 0x1B, 0x5, 0xC5, 0xA1, 0xBB, 0x58, 0xD6, 0x96, 0x59, 0x2C, 0x60, 0xC7, 0x70, 0x8A, 0xA1, 0xA8, 0xF, 0x2C, 0xE7, 0xDC, 0x62, 0x8B, 0x6E, 0x2D, 0xFC, 0x6B, 0x22, 0x6F, 0x49, 0x8A, 0xDD, 0x84, 0x90, 0x2, 0xB6, 0x4E, 0xD4, 0x8F, 0x8A, 0x2F, 0x11, 0x77, 0x40, 0x80, 0x69, 0x44, 0xFD, 0xAC, 0xBC, 0x91, 0x84, 0x24, 0xC, 0x92, 0xF2, 0x24, 0xAB, 0xF9, 0x13, 0xD8, 0x1D, 0x83, 0x8E, 0x60, 0x77, 0x52, 0x7E, 0xA2, 0x1E, 0x62, 0x90, 0xA9, 0x4A, 0x92, 0xC1, 0x48, 0xA0, 0xBD, 0x48, 0x23, 0xCB, 0x3D, 0x96, 0x19, 0x8D, 0xD, 0xFF, 0x84, 0xD1, 0x8, 0x5A, 0xEF, 0x5, 0x43, 0x8A, 0x2F, 0x52, 0x7, 0x62, 0xE7, 0xDB, 0xE, 0x21, 0xB, 0x2C, 0x7F, 0x5, 0x81, 0x5C, 0x3C, 0x90, 0xB9, 0x39, 0xB2, 0x42, 0x67, 0x24, 0xC, 0xEB, 0x8C, 0x89, 0xDD, 0x5E, 0xA5, 0xC7, 0xA5, 0x37, 0x98, 0x1C, 0xDD, 0xFC, 0xC4, 0xDE, 0xF1, 0x1D, 0x18, 0x72, 0xCD, 0x47, 0x33, 0x57, 0x31, 0x66, 0xA5, 0xB9, 0x5C, 0x31, 0x66, 0xA5, 0x99, 0xBD, 0x6C, 0xA3, 0x19, 0xB8, 0x6A, 0xA3, 0xD9, 0x74, 0x9A, 0x5D, 0x3E, 0x13, 0x2, 0xAC, 0xA0, 0x34, 0x9B, 0x60, 0x3A, 0xDE, 0xDB, 0xE3, 0xC0, 0x0, 0x95, 0x9D, 0x28, 0xB9, 0xE5, 0xF3, 0x0, 0x8A, 0xEC, 0x13, 0xDD, 0x4D, 0x27, 0xA8, 0x88, 0x3, 0x44, 0x26, 0xD5, 0x1C, 0xD4, 0x4D, 0xB1, 0x2B, 0xE1, 0xE, 0x1, 0xCB, 0x21, 0xCD, 0x5C, 0x5E, 0xBF, 0xD8, 0x1D, 0xC5, 0xBC, 0x7E, 0x2B, 0x2D, 0xE4, 0xFC, 0x24, 0xA9, 0xBA, 0x3, 0x44, 0xBE, 0x63, 0x21, 0xF7, 0x94, 0xA5, 0x3C, 0x21, 0x19, 0xD6, 0xA8, 0x40, 0x77, 0xFE, 0xFC, 0xEA, 0x4, 0x65, 0x39, 0x1F, 0x63, 0xDB, 0xAE, 0xDA, 0xB3, 0xC7, 0x88, 0x9, 0x29, 0xE7, 0x1E, 0x5B, 0xC9, 0x3E, 0xBA, 0x79, 0x63, 0xB2, 0xAC, 0x46, 0xA4, 0x49, 0x50, 0xF1, 0x25, 0x5C, 0xAF, 0x2D, 0x59, 0xFE, 0x28, 0xF1, 0x4, 0x11, 0x8D, 0xE3, 0xB8, 0x35, 0x3B, 0x3E, 0x2D, 0x32, 0x7A, 0x31, 0x8F, 0xD8, 0xB, 0x2C, 0x7, 0xF8, 0x1C, 0xA4, 0x5B, 0x46, 0xCC, 0x86, 0x49, 0x19, 0xDC, 0x66, 0xD9, 0x8, 0xAA, 0x17, 0x8, 0xC9, 0x9, 0x4A, 0x2C, 0x79, 0xB9, 0xA3, 0xE0, 0x16, 0x82, 0x5C, 0x60, 0xAF, 0x8, 0xC9, 0x5, 0xE0, 0x72, 0x90, 0x5C, 0x98, 0x95, 0x15, 0x23, 0x0, 0x4F, 0x1C, 0x7F, 0x97, 0x79, 0x36, 0x83, 0xA, 0x59, 0x79, 0x89, 0x2, 0x95, 0xC8, 0x7A, 0x19, 0xC9, 0xEE, 0x45, 0x9F, 0x8C, 0x8B, 0xB2, 0x17, 0xDD, 0x8D, 0xC2, 0xD1, 0xD0, 0x7, 0x1, 0xB6, 0x62, 0xFB, 0xE0, 0x2F, 0xCA, 0xF6, 0x97, 0xA0, 0x67, 0xDD, 0xD1, 0xB2, 0x6C, 0xD6, 0x74, 0x69, 0xDF, 0x28, 0x1F, 0x57, 0x64, 0x78, 0xD0, 0xEF, 0xF, 0x6A, 0xD0, 0x4E, 0x58, 0xA8, 0x13, 0x86, 0x2D, 0xD4, 0x41, 0xBC, 0x88, 0xD9, 0x21, 0x64, 0x6, 0xDF, 0x8D, 0xA, 0xBC, 0x52, 0x8B, 0x8, 0xA2, 0x19, 0x9F, 0x56, 0x59, 0x5E, 0x42, 0x44, 0x10, 0x9D, 0xB8, 0x72, 0x85, 0x34, 0xD7, 0xB5, 0x5C, 0x47, 0x73, 0x5D, 0xF9, 0x1B, 0x81, 0x15, 0xA8, 0xD1, 0xB8, 0xAE, 0x5C, 0x7A, 0xED, 0x75, 0x5, 0xB2, 0x6B, 0xAF, 0x2B, 0xEB, 0xBB, 0xBB, 0x2, 0x8E, 0xBB, 0x2B, 0xBB, 0x9C, 0xAE, 0xF2, 0x4A, 0xFA, 0xFE, 0x51, 0xEE, 0x43, 0x8B, 0x1F, 0xE9, 0xD4, 0xA2, 0x9D, 0x61, 0x1D, 0xD5, 0x5E, 0x50, 0x51, 0x44, 0xE0, 0x6D, 0xD2, 0x8, 0x0, 0x43, 0xCC, 0xA9, 0x6F, 0x2E, 0xAE, 0x2F, 0x3D, 0x2E, 0xB1, 0xF6, 0xAC, 0x16, 0x74, 0x3D, 0xA0, 0x4, 0x5D, 0xCF, 0x7A, 0x46, 0xB8, 0x1E, 0xF0, 0x88, 0x70, 0x3D, 0x57, 0x36, 0x25, 0xF2, 0x16, 0xCB, 0xA6, 0xAC, 0x4E, 0xFF, 0xD8, 0xD4, 0x6C, 0xAE, 0x8F, 0x45, 0x7, 0xEA, 0xD9, 0xD3, 0xB2, 0xF, 0x92, 0x66, 0xA7, 0x29, 0xF6, 0xBD, 0xF6, 0xDA, 0xDD, 0xF2, 0x87, 0xB2, 0x1F, 0x34, 0xA0, 0x1E, 0x72, 0xD1, 0x12, 0x31, 0x72, 0x34, 0xCE, 0x81, 0xE3, 0xD2, 0xB8, 0x9D, 0x4F, 0x7A, 0xC, 0xB0, 0xA, 0x17, 0x73, 0x11, 0x82, 0x2E, 0xE6, 0x22, 0x21, 0xEB, 0xC4, 0x92, 0x24, 0x94, 0x9D, 0x58, 0x22, 0x99, 0xE6, 0xAA, 0x98, 0xEE, 0x48, 0xB9, 0x90, 0xE9, 0x4E, 0xC7, 0xF, 0xBA, 0x24, 0x1, 0x18, 0x77, 0x91, 0x8E, 0xAB, 0x9C, 0x73, 0x48, 0x28, 0x16, 0xCF, 0x49, 0x74, 0x5C, 0xA9, 0xA1, 0xBD, 0x15, 0x8A, 0xC5, 0x86, 0x76, 0xFE, 0xCC, 0x78, 0x40, 0x97, 0xB8, 0x48, 0xCA, 0x2A, 0xC9, 0x24, 0xA3, 0xAC, 0x27, 0x9D, 0x74, 0x4B, 0xED, 0xC0, 0x5B, 0x6A, 0x67, 0xB8, 0xEA, 0x9F, 0xAB, 0x33, 0xE5, 0xEA, 0x9F, 0xAB, 0x33, 0xB, 0xCF, 0x5B, 0x2C, 0xB8, 0x94, 0x68, 0x82, 0x9E, 0x25, 0x67, 0xC9, 0x3, 0x6, 0x7D, 0x25, 0x9D, 0xB1, 0x9D, 0x59, 0x35, 0x6B, 0x2D, 0x1D, 0x58, 0x39, 0xFD, 0xFD, 0xCA, 0xA6, 0x9, 0x5E, 0x81, 0x34, 0xC1, 0xAB, 0x33, 0x37, 0xAE, 0xF6, 0x7D, 0x7C, 0x2E, 0x57, 0xBF, 0xFF, 0x7C, 0xFD, 0xA8, 0x8D, 0x9E, 0x7A, 0xD4, 0x6A, 0xE, 0xA3, 0xB1, 0x7E, 0x73, 0xCA, 0x76, 0xC2, 0xA1, 0x93, 0x40, 0xC, 0x9D, 0x42, 0x39, 0x73, 0xE3, 0x4A, 0x27, 0xA6, 0xF7, 0x2C, 0xD6, 0x4E, 0x8C, 0x54, 0x42, 0x77, 0xDF, 0xCA, 0x15, 0xB6, 0x22, 0x15, 0xBB, 0x6D, 0x88, 0xDC, 0xE5, 0x1D, 0x83, 0x33, 0xFC, 0xB9, 0x57, 0xE4, 0x2E, 0x9B, 0x5E, 0x4E, 0xF2, 0x88, 0x70, 0xA8, 0x47, 0x4, 0xAF, 0x72, 0xCB, 0xAF, 0x4D, 0xAC, 0xC5, 0xE6, 0x8, 0x30, 0xD9, 0x1C, 0x9B, 0xE0, 0x34, 0xF2, 0x2, 0x93, 0x9A, 0xBA, 0x51, 0x3E, 0xDA, 0x78, 0xA1, 0x48, 0xD3, 0x11, 0xBF, 0x56, 0x10, 0xB2, 0x52, 0x10, 0x3B, 0xB5, 0xA9, 0xB8, 0x73, 0x77, 0xD4, 0x6F, 0x5D, 0x26, 0xC4, 0xE8, 0x85, 0x63, 0xB, 0xDE, 0x49, 0xCD, 0xAE, 0xBA, 0x6F, 0xB0, 0x28, 0x86, 0x2B, 0xB1, 0x1C, 0x5B, 0xA2, 0x58, 0x62, 0x79, 0x75, 0xC4, 0x79, 0x1C, 0xC7, 0xA7, 0x65, 0x46, 0xED, 0xE5, 0x2B, 0x8E, 0x37, 0xC, 0x9C, 0x96, 0x39, 0xE2, 0xEA, 0x1E, 0xC7, 0xF, 0x28, 0xD6, 0x3D, 0x8E, 0xBC, 0xBC, 0x5D, 0x4D, 0xD7, 0x72, 0x25, 0xA4, 0xD5, 0xD1, 0x10, 0x97, 0x33, 0xC3, 0x95, 0x27, 0xF8, 0x4, 0x8B, 0x62, 0xD8, 0x23, 0x4B, 0x87, 0x44, 0x7, 0xEC, 0x91, 0xF5, 0xF2, 0x8A, 0xBD, 0x7, 0xDE, 0x83, 0x6B, 0x36, 0x71, 0xC5, 0xF3, 0xF6, 0xC7, 0x65, 0x56, 0xEE, 0x8A, 0xDA, 0x51, 0xB9, 0xE, 0xDD, 0xEA, 0x88, 0xCB, 0x6D, 0x1E, 0x79, 0x8B, 0xC9, 0xCD, 0x57, 0x47, 0xEC, 0x31, 0x80, 0xA3, 0x72, 0x9D, 0xB2, 0xD5, 0xD1, 0xAA, 0x5D, 0x30, 0xAC, 0x3B, 0xE0, 0x1B, 0x83, 0x84, 0xF0, 0xAA, 0x64, 0x47, 0xCA, 0x2, 0x4, 0x75, 0x88, 0x8C, 0xBF, 0xA0, 0x3B, 0xE1, 0x46, 0xFD, 0x8D, 0x95, 0x85, 0x90, 0xD5, 0x81, 0x75, 0x8, 0x3D, 0x30, 0x24, 0x2E, 0xDB, 0xB1, 0x79, 0xEE, 0xE2, 0x77, 0x14, 0x53, 0xDD, 0xAD, 0x8E, 0xB8, 0x42, 0x1B, 0x91, 0xB7, 0x58, 0x68, 0x83, 0xDF, 0xC4, 0x8B, 0x5B, 0xF8, 0xF, 0xB6, 0x5E, 0xDB, 0x60, 0x14, 0xBB, 0x8E, 0xBC, 0xB0, 0xA2, 0x10, 0x76, 0xEC, 0x0, 0x71, 0x99, 0x6E, 0xF3, 0xCF, 0x89, 0xEE, 0xFC, 0x32, 0xB6, 0x63, 0xC8, 0x22, 0x76, 0xEB, 0x1E, 0xC2, 0x45, 0x7B, 0x84, 0xB0, 0x8B, 0x76, 0xF7, 0xD4, 0x77, 0x72, 0x7E, 0xE2, 0xDF, 0xE5, 0x0, 0x81, 0x97, 0xE1, 0x9A, 0xA2, 0xD8, 0xE, 0xBE, 0x63, 0x8D, 0x1, 0xDF, 0x1, 0xC6, 0x0, 0xBB, 0xBD, 0x0, 0xB6, 0x16, 0xDE, 0xF0, 0xBF, 0x69, 0x90, 0xDF, 0x5C, 0xA5, 0x9B, 0x4A, 0xBF, 0x82, 0x57, 0x95, 0xDE, 0x6B, 0x51, 0x86, 0xD7, 0xA8, 0x90, 0xB0, 0x58, 0x76, 0xC6, 0x46, 0x0, 0x99, 0xAE, 0xDC, 0x5C, 0x2D, 0xCE, 0xD3, 0x20, 0xF9, 0x64, 0x4, 0xD4, 0x27, 0x83, 0x77, 0x81, 0x46, 0x9C, 0x9F, 0xD9, 0xB, 0xF, 0xF1, 0xAA, 0xE3, 0x83, 0x6D, 0xCE, 0x24, 0x53, 0x4A, 0x28, 0xB8, 0x3A, 0x46, 0x5A, 0x3F, 0xAE, 0x8E, 0xBE, 0x1F, 0xFC, 0xD9, 0xF3, 0x7, 0x1, 0x74, 0xF6, 0xBC, 0xB5, 0x33, 0xDB, 0xD, 0x5B, 0x3B, 0x97, 0xAB, 0x21, 0xAE, 0x5B, 0x4B, 0xF2, 0x55, 0x43, 0x4, 0xE1, 0xCF, 0xE2, 0x8A, 0xFD, 0xAD, 0x5B, 0x5B, 0x2E, 0xF6, 0xB7, 0x6E, 0x6D, 0x98, 0x3D, 0x3F, 0x22, 0x12, 0x4, 0xD, 0x89, 0x4D, 0xB7, 0x33, 0xB1, 0x56, 0x60, 0x42, 0x0, 0x3, 0x70, 0xE3, 0xA3, 0xB8, 0x36, 0x24, 0x8C, 0x6B, 0x1B, 0x66, 0xC1, 0xFD, 0x36, 0x21, 0x88, 0x4, 0x9E, 0x1B, 0xE1, 0x9C, 0xD5, 0xFD, 0xC1, 0x2A, 0x82, 0x84, 0x0, 0x6A, 0x60, 0x13, 0xD5, 0xD1, 0x86, 0xAA, 0xA3, 0xDB, 0xC8, 0x6A, 0xC3, 0xDB, 0x8, 0x68, 0xC3, 0x1B, 0xFB, 0xE9, 0x37, 0xE0, 0xD3, 0x6F, 0x81, 0x5D, 0xB5, 0x6E, 0xA1, 0x29, 0x67, 0xF2, 0xBA, 0x5, 0x39, 0x7F, 0xC7, 0x2D, 0xE0, 0xC9, 0x3B, 0xB8, 0x10, 0xFB, 0x62, 0x80, 0xFD, 0x9D, 0xDB, 0x2B, 0xDD, 0x8B, 0x1B, 0x25, 0xCE, 0x40, 0x2C, 0x9A, 0x86, 0xF, 0x15, 0xCC, 0x10, 0x57, 0x5F, 0xD6, 0xC6, 0x79, 0xA1, 0xC8, 0x85, 0xC5, 0x63, 0xB1, 0x81, 0x75, 0x6C, 0x88, 0x80, 0xEC, 0xD7, 0xF0, 0x21, 0x20, 0x8C, 0x64, 0x95, 0x7D, 0xDC, 0x47, 0xED, 0x34, 0xAF, 0xFF, 0x7E, 0x22, 0x81, 0x34, 0xE0, 0x93, 0x1D, 0x3F, 0xCF, 0xF2, 0xF8, 0xF9, 0x71, 0x51, 0xC2, 0x52, 0xF4, 0xE3, 0xA2, 0xC0, 0xB5, 0x88, 0x2B, 0x8, 0x5B, 0x2C, 0x6, 0xFB, 0x43, 0xFD, 0xC3, 0x7E, 0xFC, 0xF, 0xF5, 0xF, 0xF2, 0xC5, 0x3F, 0xB8, 0x9C, 0x14, 0x3F, 0xCA, 0x39, 0x29, 0x7E, 0xB4, 0x9B, 0x17, 0xD2, 0xB7, 0xED, 0x10, 0xF4, 0xE3, 0x1D, 0x77, 0xD, 0xF4, 0xA3, 0x2B, 0xE7, 0x2B, 0xF8, 0xD1, 0xF1, 0x3F, 0xDD, 0x61, 0x3F, 0xFB, 0xCD, 0x59, 0x2A, 0x3F, 0xBA, 0x6F, 0xC0, 0x4E, 0xF9, 0x31, 0x76, 0xCC, 0xCE, 0xE0, 0xC7, 0xD8, 0x74, 0xA5, 0x8D, 0xC1, 0x8F, 0xB9, 0xE7, 0x92, 0x7F, 0xFE, 0x98, 0xFB, 0x72, 0xF6, 0xCF, 0x1F, 0x73, 0xAF, 0xFB, 0x4D, 0x62, 0xD7, 0xFD, 0x6, 0x89, 0x18, 0xE8, 0x26, 0x89, 0x88, 0xAA, 0xB9, 0x2C, 0x82, 0x2, 0xB1, 0x2D, 0x47, 0x81, 0x90, 0xA6, 0x5B, 0xB9, 0x7D, 0x61, 0x7A, 0x5A, 0xF8, 0x5D, 0x3F, 0x73, 0x4B, 0xC2, 0xF, 0x3F, 0x97, 0x97, 0x84, 0xFD, 0x5E, 0xCB, 0x90, 0xE4, 0x3, 0xFF, 0x81, 0x43, 0x63, 0x67, 0xD3, 0x2D, 0x77, 0x3A, 0x75, 0x3C, 0x2F, 0xBD, 0x4B, 0x24, 0x92, 0x6C, 0xA4, 0x1D, 0xC5, 0x8C, 0xA4, 0x1F, 0x1B, 0x7B, 0x6, 0xBF, 0x3F, 0x2E, 0xB1, 0x9A, 0x6E, 0xF2, 0xF, 0xA9, 0x8E, 0xDA, 0x1B, 0xC6, 0x9A, 0xC3, 0xAC, 0x7A, 0xD6, 0x8B, 0xB6, 0x81, 0x17, 0xF7, 0x57, 0xF3, 0x49, 0x82, 0xE4, 0xD7, 0x71, 0xAA, 0x63, 0xBD, 0x95, 0x8F, 0xE7, 0xF9, 0x8F, 0x73, 0xAA, 0x9B, 0x16, 0xF5, 0xE4, 0xD7, 0x83, 0x13, 0x44, 0x3E, 0xEC, 0xA4, 0x65, 0xAF, 0x6, 0x5E, 0x82, 0x80, 0xAB, 0x0, 0xA7, 0x7A, 0x36, 0x71, 0x70, 0x7C, 0x5E, 0x3E, 0x8E, 0x77, 0xAA, 0xE7, 0x26, 0xDC, 0xFE, 0x18, 0x60, 0x55, 0x6C, 0x33, 0xF4, 0x86, 0x1A, 0x5, 0x34, 0x41, 0x6F, 0xD8, 0x93, 0xF1, 0x5D, 0x40, 0x71, 0xE6, 0x25, 0xB2, 0xAB, 0xF8, 0x2, 0x57, 0xE4, 0x5, 0x68, 0xE1, 0x6C, 0xC9, 0x4, 0x94, 0x2D, 0xC9, 0x48, 0xC6, 0xD6, 0x56, 0x4A, 0x0, 0xD2, 0xF8, 0xD7, 0xEB, 0xAC, 0xB5, 0xD, 0xEE, 0x21, 0x54, 0xB8, 0xFD, 0xA0, 0x68, 0xB2, 0xD5, 0x6E, 0x3F, 0xBE, 0x69, 0xD8, 0xE3, 0x3F, 0x2E, 0xDD, 0x98, 0xD2, 0xFC, 0x6E, 0xFC, 0x22, 0xFA, 0x49, 0xD7, 0x1C, 0x84, 0xD8, 0xB8, 0x3D, 0xF8, 0x20, 0xE1, 0x95, 0x92, 0x37, 0x3E, 0xB0, 0x70, 0x47, 0xCA, 0x36, 0x92, 0x53, 0xE3, 0x34, 0x69, 0xA7, 0x6E, 0x5F, 0xAC, 0x7B, 0xDC, 0x7, 0x8C, 0xF4, 0xCD, 0x48, 0x73, 0x10, 0xC4, 0xD0, 0x1C, 0x10, 0x9, 0x9C, 0xCF, 0x63, 0x7A, 0x5A, 0x64, 0x9C, 0x1D, 0x29, 0xD1, 0x99, 0xED, 0xC4, 0x31, 0x4D, 0x1E, 0xA9, 0xEF, 0x4A, 0x8C, 0xEA, 0x3F, 0x60, 0x58, 0x96, 0x67, 0x8B, 0x44, 0x24, 0x0, 0x6A, 0x12, 0xB6, 0x7B, 0x91, 0xAE, 0x9D, 0x26, 0xED, 0x83, 0x62, 0x8B, 0xBB, 0xBD, 0x40, 0xE4, 0xD, 0x66, 0x63, 0x7, 0x3E, 0x73, 0xFC, 0x89, 0x21, 0x52, 0xAC, 0xD0, 0xC, 0x16, 0x6A, 0x5, 0xDB, 0xAB, 0x56, 0x3B, 0xB6, 0x42, 0xE8, 0xB, 0x84, 0xE4, 0x4C, 0xE4, 0x47, 0x93, 0x8B, 0xED, 0x3D, 0x69, 0x2A, 0xC2, 0x7B, 0x9D, 0xB2, 0x4F, 0x65, 0x33, 0xC5, 0xB0, 0x76, 0x2, 0xBC, 0x1A, 0x96, 0x53, 0xEB, 0xEA, 0x57, 0xA, 0xC2, 0xFA, 0xED, 0x9A, 0x3, 0xC7, 0x16, 0x6F, 0xC7, 0x5D, 0x3D, 0x39, 0xE5, 0xCA, 0x95, 0xB1, 0x9D, 0xF2, 0xDC, 0xB6, 0xD2, 0x29, 0xF, 0xEC, 0x27, 0x9D, 0xDA, 0x16, 0xA1, 0xCF, 0xB7, 0x5, 0xEA, 0xF4, 0x9B, 0xB6, 0x7D, 0x7B, 0x38, 0x4F, 0x72, 0xD7, 0x27, 0x7, 0x45, 0xF3, 0x26, 0x29, 0xBE, 0x52, 0x62, 0xE0, 0x6E, 0xF2, 0x4F, 0x59, 0xE2, 0x65, 0xFE, 0xAF, 0x42, 0xDC, 0xC6, 0x58, 0xCE, 0xA7, 0x94, 0x8, 0x22, 0x62, 0xAC, 0x30, 0xBD, 0x13, 0x84, 0xCC, 0xF1, 0xDB, 0xAA, 0x6, 0xDD, 0x91, 0x61, 0x97, 0xE4, 0x4, 0xA6, 0x68, 0xC2, 0xA2, 0x7B, 0xA5, 0x53, 0x77, 0x75, 0x59, 0x14, 0x1B, 0x23, 0x7E, 0x62, 0xC0, 0xF2, 0xCE, 0x6D, 0x5D, 0x5D, 0x79, 0xEB, 0xEA, 0xBA, 0xDE, 0xFE, 0xEF, 0x7F, 0xBC, 0x61, 0xD7, 0x24, 0xC, 0xB2, 0xEB, 0xD8, 0xC4, 0x86, 0xE, 0x48, 0x6C, 0xE8, 0xFA, 0x1B, 0x97, 0x4D, 0xC6, 0xF5, 0x37, 0xA0, 0x30, 0x87, 0xE3, 0x46, 0x53, 0x71, 0x66, 0x69, 0xC5, 0x2D, 0x75, 0xF1, 0x69, 0x99, 0xF1, 0xD2, 0x12, 0x4D, 0xFC, 0x99, 0xEA, 0x1B, 0x46, 0x8E, 0x37, 0x22, 0xF5, 0xF2, 0x68, 0x67, 0x1A, 0x44, 0x61, 0x3B, 0x8C, 0xA, 0xB, 0xA3, 0xEE, 0xA9, 0x63, 0x13, 0xC7, 0x7C, 0xC0, 0xC0, 0xC6, 0xD4, 0x69, 0x35, 0xC7, 0x5, 0x8A, 0xB, 0xBA, 0xFE, 0xC0, 0x8A, 0x6D, 0x75, 0x10, 0xB2, 0xC3, 0xFA, 0x85, 0x2, 0x3, 0x5B, 0xAB, 0x79, 0xE9, 0x18, 0x77, 0x96, 0x4, 0x34, 0x5D, 0xB1, 0x6E, 0x72, 0xA4, 0xB, 0xC4, 0x59, 0xEE, 0x7, 0x50, 0xFE, 0x7D, 0x56, 0x3, 0xEE, 0xCF, 0x4B, 0xCC, 0xED, 0x66, 0xB3, 0x4B, 0x5E, 0x24, 0xA8, 0x59, 0xEF, 0x34, 0x7B, 0x23, 0xE7, 0x34, 0x70, 0x23, 0xE7, 0x74, 0xC7, 0x15, 0x65, 0x8C, 0xBC, 0x5D, 0x71, 0x45, 0xD0, 0xDD, 0x18, 0x58, 0x77, 0xA4, 0x84, 0x34, 0x65, 0xA7, 0x24, 0xA7, 0x3B, 0x73, 0xD5, 0xC2, 0xCB, 0x9B, 0xAB, 0x86, 0xDE, 0xDF, 0xAC, 0x9C, 0x3B, 0xC0, 0x9, 0x14, 0xD9, 0xB9, 0xC, 0x93, 0xF1, 0xF3, 0x8B, 0x19, 0x26, 0x9D, 0xEE, 0x9C, 0xCE, 0x64, 0x1F, 0x7E, 0xE3, 0x88, 0x6B, 0x50, 0xA4, 0x16, 0x3A, 0x2, 0xE1, 0x65, 0x6D, 0xA, 0xDD, 0x1, 0x36, 0x85, 0x66, 0x15, 0x5F, 0x59, 0xEF, 0xF5, 0xC6, 0xE9, 0x2E, 0x2C, 0xC2, 0xFE, 0xFF, 0x5, 0x43, 0x2B, 0x85, 0xEE, 0x7D, 0x60, 0x73, 0x8F, 0xBD, 0x90, 0xE2, 0xDB, 0x78, 0xC6, 0x97, 0xC5, 0xE9, 0xBE, 0xF1, 0x25, 0x5F, 0x16, 0xA7, 0xFB, 0x6D, 0x69, 0x9D, 0x9E, 0xB9, 0x34, 0xA5, 0x9F, 0x60, 0x49, 0xCC, 0xD0, 0x73, 0xFB, 0x5F, 0x3D, 0x34, 0x7D, 0x79, 0xCF, 0xAB, 0x87, 0xC1, 0xD0, 0xA5, 0x53, 0xB3, 0x6A, 0x1D, 0x7F, 0xB7, 0xF5, 0xB, 0x9, 0xB0, 0x88, 0xEE, 0xC, 0x7A, 0x31, 0xC2, 0x6D, 0xD9, 0x4F, 0x4, 0xA8, 0xBC, 0xF2, 0x2B, 0xD6, 0xBE, 0x61, 0xE9, 0x5, 0xF1, 0xF7, 0x1B, 0x15, 0x73, 0xDF, 0xBC, 0x3F, 0x2E, 0xB1, 0xB2, 0x89, 0xDD, 0xF7, 0xC7, 0x0, 0x2B, 0x67, 0x79, 0xEE, 0xCF, 0x8B, 0xCC, 0xEC, 0x67, 0x17, 0x3E, 0xF6, 0xFF, 0xFB, 0xFD, 0xB7, 0xCA, 0x8D, 0x33, 0xCD, 0x6A, 0xE1, 0x1C, 0x6E, 0x77, 0xA4, 0xEC, 0x72, 0xEB, 0xD8, 0x24, 0xC4, 0xE, 0x48, 0x42, 0xEC, 0x34, 0x1B, 0xDE, 0xEB, 0x34, 0x10, 0xDE, 0xEB, 0xF4, 0x6C, 0x94, 0xE5, 0x32, 0xED, 0xBC, 0x90, 0xA2, 0x0, 0xDE, 0xD8, 0x98, 0x11, 0x2B, 0x63, 0xA1, 0xA0, 0xEF, 0x7C, 0xBA, 0x92, 0x13, 0x84, 0xE, 0x86, 0xF8, 0x11, 0x52, 0x1E, 0x1E, 0xB6, 0x57, 0xB3, 0xB0, 0xD5, 0x3F, 0x30, 0x60, 0xDF, 0xA6, 0xAD, 0x8D, 0x6, 0x5, 0x2F, 0x24, 0x42, 0xA0, 0xC, 0x2F, 0x1C, 0x15, 0x1E, 0x18, 0x24, 0x85, 0x1D, 0x7, 0xB6, 0x3C, 0xA, 0x6C, 0x50, 0x33, 0xBB, 0xC8, 0xEF, 0x40, 0x89, 0x7D, 0x55, 0x86, 0x1D, 0xBC, 0xE9, 0x39, 0xC2, 0xBC, 0xF0, 0x3D, 0x18, 0xA1, 0x66, 0x11, 0x3B, 0xF2, 0x63, 0x0, 0xAC, 0x2D, 0xF5, 0xF, 0xC9, 0x4E, 0x48, 0x20, 0x66, 0x24, 0xAC, 0xBC, 0xB1, 0xB4, 0x22, 0x96, 0xD2, 0x3A, 0xB1, 0xC6, 0xE6, 0xDA, 0x4C, 0x65, 0xB, 0x73, 0x9D, 0x79, 0x33, 0x71, 0x9D, 0x65, 0x3B, 0xF1, 0xF3, 0xF3, 0x67, 0x7E, 0x7F, 0x1E, 0xF9, 0x91, 0xCD, 0xB9, 0x5E, 0xC9, 0xB1, 0x63, 0x27, 0x3D, 0x2F, 0xBE, 0x7C, 0xF2, 0x19, 0x52, 0xFC, 0xB, 0x9C, 0x58, 0x49, 0x88, 0x10, 0xEC, 0xE4, 0x34, 0x14, 0xEA, 0xE4, 0xB4, 0xD7, 0xCA, 0x75, 0xA3, 0x28, 0x24, 0xA1, 0x35, 0x92, 0xC4, 0xF3, 0xD0, 0x53, 0x16, 0x76, 0x20, 0x9A, 0x2, 0xD0, 0x24, 0x15, 0x97, 0x30, 0x4C, 0xC3, 0x25, 0xD2, 0x5E, 0xDF, 0xC2, 0x68, 0xEC, 0xD0, 0x6F, 0x9E, 0x75, 0x4E, 0x3D, 0x25, 0xF6, 0xFA, 0xD6, 0xBC, 0x8, 0xA1, 0xED, 0xE7, 0x2E, 0xDE, 0xF8, 0x68, 0x54, 0x42, 0xBF, 0x90, 0x48, 0xFF, 0xE4, 0x47, 0x82, 0xF6, 0xC1, 0xD8, 0x1, 0xFA, 0x95, 0x83, 0xF6, 0xF, 0x7E, 0x46, 0x5F, 0xC8, 0xCE, 0xC6, 0xA, 0xD7, 0x70, 0x3F, 0x91, 0xE0, 0xED, 0x7F, 0x72, 0x8, 0x26, 0xF9, 0x4F, 0x42, 0x21, 0xB3, 0xDC, 0x73, 0x69, 0x2A, 0xE3, 0x88, 0x2F, 0x66, 0xAA, 0x4C, 0xBC, 0xBD, 0xBE, 0x99, 0x8E, 0xAD, 0x20, 0xF5, 0x1, 0x63, 0x4D, 0x66, 0x7C, 0x88, 0xAB, 0x7D, 0x66, 0xD0, 0x1F, 0x14, 0xF0, 0xB0, 0xE7, 0x9B, 0xA8, 0xAC, 0x4C, 0x7C, 0x50, 0x9B, 0x53, 0xFC, 0x4A, 0xF6, 0xC2, 0x4A, 0x42, 0x6E, 0x46, 0x33, 0xAE, 0x4C, 0xC7, 0x73, 0x84, 0x99, 0x5D, 0xC, 0x77, 0xA0, 0xCC, 0xEE, 0x3, 0x9B, 0xED, 0xE4, 0x80, 0xCA, 0xDE, 0x7B, 0x4E, 0x7F, 0x3B, 0xA, 0x4C, 0x36, 0xF5, 0x13, 0x28, 0xB0, 0xB3, 0xBE, 0x7F, 0xE, 0x70, 0xFD, 0x73, 0xA3, 0x9C, 0x3F, 0xEE, 0xC0, 0xA0, 0xD9, 0x62, 0x5A, 0x6D, 0x9C, 0xE2, 0xAF, 0x6E, 0xF, 0xC, 0x58, 0x50, 0x4D, 0xC7, 0x35, 0x41, 0x7C, 0x5A, 0x66, 0x54, 0xAE, 0x9F, 0x99, 0xB8, 0xD5, 0x37, 0x54, 0x14, 0xF1, 0xA5, 0x67, 0xE5, 0x83, 0xE9, 0x6E, 0xAB, 0xBF, 0x8C, 0xE4, 0xF9, 0x72, 0x8E, 0xCE, 0x74, 0xCD, 0x49, 0xD8, 0x9C, 0x94, 0xD0, 0xDC, 0x37, 0x1D, 0xF1, 0x5F, 0x47, 0xE5, 0xCF, 0xD3, 0x81, 0xCD, 0x37, 0x9C, 0x0, 0xA0, 0x93, 0xCD, 0x75, 0x5B, 0xCC, 0x43, 0xF1, 0x65, 0xA3, 0x23, 0xDA, 0xEC, 0x30, 0x70, 0x32, 0x66, 0xCC, 0xC4, 0x85, 0x94, 0xC6, 0xE7, 0xE5, 0x98, 0x52, 0x27, 0x7C, 0x5, 0xF2, 0x9, 0xD3, 0xD6, 0xEA, 0xE0, 0xB3, 0x59, 0x6B, 0x5F, 0x44, 0x35, 0x47, 0x7D, 0x66, 0xDA, 0x9E, 0xD1, 0xE8, 0x9D, 0x54, 0x50, 0xE6, 0xAE, 0x2, 0x5B, 0x9C, 0xF8, 0x27, 0xAA, 0x26, 0x91, 0x21, 0xA2, 0xB9, 0x7D, 0x92, 0x78, 0x55, 0xF1, 0x66, 0x5B, 0x8C, 0x15, 0x1A, 0x2A, 0x22, 0x48, 0x63, 0xC5, 0xAD, 0xC, 0xBB, 0xB5, 0x48, 0x8, 0xB0, 0xB1, 0x30, 0x76, 0x90, 0xB6, 0x38, 0x3B, 0x4, 0xCA, 0x70, 0x66, 0xE2, 0x5D, 0x51, 0x4E, 0x10, 0x93, 0xE3, 0xD5, 0xC4, 0xA7, 0xC9, 0x79, 0x81, 0x88, 0x1C, 0x96, 0x1F, 0x60, 0xE3, 0xB5, 0x1A, 0xA4, 0xD0, 0xA8, 0x75, 0xCA, 0x76, 0x92, 0x84, 0x1D, 0x84, 0xE4, 0xF4, 0xFA, 0x4B, 0x59, 0x6D, 0x9C, 0x98, 0xA7, 0xE0, 0x93, 0x6, 0x5C, 0xB2, 0xD, 0xAD, 0x4E, 0x7, 0xE9, 0xE5, 0x12, 0x86, 0xBC, 0x1B, 0x3B, 0x5F, 0xCA, 0x93, 0xC3, 0x8F, 0x26, 0xAA, 0x9E, 0xAF, 0xFC, 0x65, 0xF5, 0x9B, 0xAC, 0x6A, 0x52, 0x27, 0x2E, 0x40, 0x70, 0x9D, 0x50, 0x12, 0xC6, 0xA0, 0x27, 0x68, 0xFC, 0x79, 0xC1, 0x53, 0x22, 0x2, 0x8, 0x7F, 0x8, 0xE4, 0x47, 0x33, 0xF0, 0x37, 0xF0, 0x11, 0x6D, 0x12, 0x8C, 0x7C, 0xA, 0xE7, 0x37, 0xEE, 0x4C, 0xD9, 0x5F, 0xDC, 0x99, 0x9B, 0xD5, 0x5C, 0xF6, 0x96, 0x4, 0x94, 0x53, 0xA1, 0xB9, 0x2F, 0x21, 0xA5, 0x81, 0xFB, 0xC2, 0x52, 0x1A, 0xB8, 0x2F, 0x21, 0xDC, 0xDB, 0x7D, 0x81, 0xE1, 0xDE, 0x8E, 0xD7, 0x8C, 0x88, 0x56, 0x5C, 0x58, 0xAD, 0xBC, 0x0, 0x1A, 0xD9, 0x4A, 0x9F, 0x6D, 0xC1, 0xCF, 0x66, 0x53, 0x75, 0x3A, 0x20, 0x55, 0xA7, 0xB3, 0x52, 0x83, 0x59, 0xB4, 0xC1, 0xB8, 0xE6, 0x2A, 0xB6, 0x15, 0x29, 0xFE, 0x98, 0x8D, 0x14, 0x72, 0xC4, 0x46, 0xDD, 0xC8, 0xE5, 0x5F, 0x3C, 0x9E, 0x3, 0xCC, 0x3E, 0x68, 0x27, 0xAA, 0xCA, 0x93, 0x0, 0xD4, 0x93, 0xD4, 0x4D, 0xCA, 0x89, 0xE1, 0xC5, 0x7, 0x8C, 0xCB, 0xE2, 0xA6, 0x5E, 0x7A, 0x5C, 0x62, 0xED, 0x35, 0xDB, 0xA0, 0xF1, 0x71, 0x91, 0x55, 0x38, 0x6A, 0x24, 0xCC, 0x2B, 0x91, 0x84, 0x40, 0x3, 0x47, 0x60, 0x9C, 0x81, 0xA3, 0x81, 0xAD, 0xD4, 0x7A, 0x3C, 0x2F, 0xFD, 0xBC, 0x34, 0x7B, 0x8, 0x9C, 0x3D, 0x6C, 0x26, 0x6A, 0x97, 0xC9, 0x42, 0xFD, 0xF1, 0xE2, 0xEC, 0xC4, 0x3, 0x52, 0x3E, 0x39, 0x9A, 0x36, 0x4B, 0xA3, 0x56, 0xB4, 0x90, 0xE3, 0x2D, 0xEF, 0x83, 0xA2, 0xD9, 0x49, 0x0, 0xBD, 0x4D, 0xD2, 0x5C, 0x26, 0x78, 0x2E, 0xCF, 0xB3, 0xE2, 0xBD, 0xCC, 0x12, 0x82, 0x8C, 0x5, 0xBE, 0xF6, 0x70, 0x7C, 0x8E, 0x34, 0x8A, 0x5C, 0xD4, 0x3B, 0x49, 0x0, 0x67, 0xD1, 0xC2, 0x5F, 0xF4, 0xD0, 0x2, 0x24, 0x6C, 0x74, 0xB4, 0x8, 0xB, 0x71, 0x4, 0xA0, 0x16, 0xF0, 0x13, 0x9, 0x8D, 0x98, 0x20, 0x44, 0x6, 0xBB, 0xE3, 0xA6, 0x62, 0xC0, 0xBE, 0x23, 0x47, 0x9E, 0xFF, 0x69, 0x47, 0x1E, 0xF9, 0x61, 0xCF, 0xBB, 0xB0, 0xA6, 0x6D, 0x6C, 0x99, 0x3B, 0x90, 0xEB, 0x9C, 0xBA, 0xA, 0x15, 0x64, 0xDE, 0x38, 0x74, 0xF0, 0x4A, 0x5B, 0xD4, 0xD2, 0xCA, 0xAA, 0x21, 0xF9, 0xEA, 0x73, 0xF2, 0xB6, 0xA4, 0xA6, 0xDF, 0x24, 0xC5, 0xF1, 0x7D, 0x33, 0x83, 0x30, 0x65, 0x23, 0x82, 0x8C, 0x8E, 0x9B, 0x25, 0xD6, 0xC2, 0x89, 0x0, 0x60, 0xE1, 0xD0, 0x63, 0x12, 0x6E, 0x98, 0x12, 0x82, 0x34, 0xF2, 0xC3, 0xDD, 0xCC, 0x24, 0x88, 0x88, 0x10, 0x20, 0x43, 0x4A, 0x1D, 0xE5, 0xC0, 0xD4, 0x51, 0x4E, 0x4A, 0xAB, 0xE3, 0xD0, 0xB4, 0x3A, 0xAC, 0xBA, 0x2F, 0xAA, 0x7A, 0xDF, 0x72, 0x4D, 0xEF, 0x9B, 0xB6, 0x98, 0x1A, 0xD0, 0xF9, 0x2E, 0x79, 0xD2, 0xF1, 0xB, 0x95, 0xEF, 0xE, 0x3F, 0x3B, 0x64, 0xB1, 0x62, 0xEF, 0xAE, 0xCB, 0x41, 0xF5, 0xCE, 0x4B, 0x8D, 0xEE, 0xC1, 0x46, 0xF7, 0x52, 0xA3, 0x7B, 0xB8, 0xD1, 0xFD, 0xF2, 0xE0, 0x9C, 0xCC, 0xFF, 0x6A, 0x9C, 0xF7, 0xCD, 0xF2, 0xF8, 0x35, 0xB5, 0x2E, 0xE7, 0x50, 0xEB, 0x6F, 0xDC, 0x62, 0x15, 0x9F, 0x16, 0x5E, 0x9F, 0xBB, 0xD, 0x2F, 0x5E, 0x83, 0x6F, 0xFC, 0xE1, 0xE3, 0x6, 0x9D, 0x3B, 0x6E, 0x92, 0x61, 0xB2, 0xA1, 0x86, 0xC9, 0x36, 0xB4, 0x9C, 0xDF, 0x59, 0x7A, 0x5C, 0x7A, 0xF3, 0x91, 0xEB, 0xAA, 0xF8, 0xB4, 0xC4, 0xC8, 0x5D, 0x2, 0x88, 0x97, 0x76, 0x1F, 0x6C, 0x6C, 0xC1, 0x17, 0xB7, 0x59, 0xA4, 0xD4, 0x8B, 0xDB, 0xF8, 0x3, 0xCD, 0xD, 0x3A, 0xBD, 0xDC, 0xE8, 0xA6, 0xF8, 0x85, 0x23, 0x21, 0x80, 0x46, 0xDA, 0xBC, 0x97, 0xCB, 0x46, 0xEE, 0x28, 0xB8, 0xFA, 0xDF, 0x58, 0xF5, 0x7C, 0x3, 0x74, 0x33, 0x77, 0x45, 0x50, 0xBC, 0x1E, 0xB8, 0x73, 0xDA, 0xE0, 0x5E, 0x54, 0x6, 0x8F, 0xD6, 0x1A, 0x36, 0x9F, 0xC8, 0x8E, 0x0, 0xDE, 0x12, 0x8F, 0xFE, 0xAE, 0xAE, 0xCA, 0x64, 0x4E, 0xE8, 0x1F, 0x7D, 0xF3, 0x26, 0x81, 0x26, 0xCC, 0x63, 0x18, 0xF8, 0x3, 0xB9, 0x8, 0x0, 0xBD, 0xF8, 0xA0, 0x49, 0x2D, 0x26, 0x17, 0xA, 0xB0, 0x53, 0x54, 0xC4, 0x2, 0x3C, 0xB6, 0x41, 0x79, 0x35, 0x19, 0xD3, 0x2A, 0xA7, 0x26, 0xDE, 0x52, 0x3E, 0x69, 0x9A, 0x83, 0x8, 0x12, 0x3B, 0x3D, 0x38, 0x85, 0xB4, 0x3F, 0x2F, 0x32, 0xD3, 0x28, 0x9F, 0xB5, 0x44, 0x14, 0x3E, 0x6B, 0x79, 0x6A, 0xFF, 0xE4, 0x93, 0xDC, 0x1C, 0x10, 0x30, 0x12, 0x9E, 0x83, 0x54, 0x4D, 0x2C, 0x42, 0x58, 0x92, 0x3, 0xFF, 0x9F, 0x8B, 0x5A, 0x2F, 0x96, 0x5C, 0x18, 0xB5, 0xF2, 0xE1, 0xF2, 0xB7, 0x5A, 0xD4, 0x93, 0xAC, 0xBA, 0xF3, 0x51, 0x94, 0xBF, 0x91, 0x37, 0x2F, 0x7A, 0x64, 0xA0, 0xFD, 0xCA, 0xFE, 0x4F, 0xDD, 0xAF, 0xFD, 0xF3, 0x27, 0xBF, 0xE6, 0x69, 0xB, 0x23, 0xF6, 0x5D, 0x7, 0xE9, 0x1F, 0xFF, 0xA, 0xDE, 0x82, 0x1F, 0xE4, 0xFF, 0xEE, 0xD7, 0xA0, 0x16, 0xFC, 0x20, 0xAF, 0xFE, 0xB5, 0x4E, 0x5D, 0x3A, 0x6D, 0x83, 0x53, 0x33, 0xF0, 0x65, 0x9F, 0xC4, 0xD5, 0xBF, 0xA4, 0xB7, 0x8A, 0x5F, 0xFA, 0x24, 0xFE, 0x93, 0x5F, 0xBA, 0x6B, 0xA8, 0xAB, 0x5E, 0x94, 0x7F, 0xFC, 0x1B, 0xE5, 0xE, 0x7A, 0x51, 0xFE, 0xF1, 0x6F, 0xFC, 0x7, 0xFE, 0x8D, 0xFF, 0xD4, 0xFF, 0xC6, 0x77, 0xD0, 0xCE, 0x62, 0x7D, 0xF2, 0x22, 0xAD, 0xFE, 0x95, 0xAB, 0x59, 0xFD, 0x65, 0xF3, 0x97, 0x81, 0x6E, 0x68, 0xD7, 0xFC, 0xCE, 0x52, 0xFD, 0xAB, 0x5E, 0x5D, 0xC0, 0x29, 0xFB, 0xA2, 0xAC, 0xFE, 0x8D, 0xCD, 0x5F, 0xC0, 0x89, 0xFA, 0xA2, 0xFC, 0x93, 0xDF, 0xA8, 0x68, 0xB8, 0x7F, 0xD7, 0x66, 0x9B, 0xAF, 0xF8, 0x9D, 0x7F, 0xF9, 0x1B, 0x50, 0x9B, 0xFD, 0xE1, 0xDC, 0xB9, 0xEB, 0xD6, 0x9B, 0xA0, 0x6B, 0x57, 0x3F, 0x9E, 0xED, 0xDF, 0xFC, 0x7A, 0xCD, 0xCA, 0xC1, 0xB3, 0xFD, 0xBF, 0xF9, 0xF5, 0x72, 0x63, 0xF3, 0x6C, 0x7F, 0xFC, 0xEB, 0xB8, 0x16, 0xFE, 0x8D, 0xE3, 0x8F, 0x7F, 0x13, 0x9F, 0xF1, 0xBF, 0x71, 0xFC, 0xF1, 0x6F, 0x9E, 0xF3, 0x1A, 0xFF, 0xCD, 0x17, 0xC7, 0xBF, 0xF9, 0xCD, 0xCA, 0xB6, 0xFD, 0xF3, 0xD9, 0xFA, 0xAB, 0x4, 0x7C, 0x20, 0xFD, 0xEB, 0xD9, 0x5B, 0x69, 0x4C, 0x1E, 0x5C, 0xCD, 0xBF, 0x34, 0x2A, 0xDF, 0x3F, 0x8E, 0xDA, 0x96, 0x1F, 0x3F, 0xFC, 0xA7, 0x36, 0xE6, 0x21, 0xA2, 0xCA, 0x1C, 0x3B, 0x7F, 0xF6, 0x5F, 0x99, 0x65, 0x87, 0x90, 0x2A, 0xEB, 0xEC, 0xFC, 0xE1, 0x7F, 0x65, 0xA5, 0xBD, 0x7F, 0xB8, 0x6E, 0x58, 0x35, 0x7F, 0x6E, 0x50, 0xFD, 0xF2, 0x93, 0x65, 0xBB, 0xEA, 0xD7, 0x9F, 0xAC, 0xB7, 0xAF, 0xE, 0x9, 0xB8, 0x99, 0x70, 0xFE, 0xE4, 0x1F, 0x9B, 0xB, 0xA5, 0x5F, 0xA8, 0x96, 0x57, 0x35, 0x9, 0xFF, 0xE5, 0xE4, 0xC3, 0x27, 0xDD, 0xBF, 0x98, 0x6C, 0x35, 0x93, 0xEC, 0x5F, 0x4D, 0xAE, 0xCE, 0xEE, 0x6D, 0xF1, 0xF3, 0xCF, 0x70, 0x79, 0xBD, 0xE2, 0xF, 0xBD, 0x88, 0x7F, 0xFE, 0x19, 0x39, 0xE1, 0xD7, 0xC7, 0x2F, 0xF5, 0x9B, 0x9A, 0x7D, 0x50, 0xDD, 0x54, 0x67, 0xEF, 0x34, 0x2F, 0xBE, 0xE6, 0xDF, 0x59, 0x3C, 0xFC, 0xEF, 0x97, 0x47, 0x8B, 0xF0, 0xFB, 0xD5, 0xE3, 0xE6, 0xF3, 0xF7, 0xD1, 0x1, 0xF4, 0xD3, 0x6F, 0xFF, 0xE1, 0x50, 0xFA, 0xED, 0x77, 0xFF, 0xA4, 0xDD, 0xFF, 0x85, 0xAD, 0xC7, 0xFF, 0x7E, 0x65, 0xBB, 0xFF, 0xB, 0x6B, 0xEF, 0x25, 0xA7, 0x6A, 0x4E, 0xBD, 0x7F, 0xFD, 0xDF, 0xCC, 0xAE, 0x97, 0x94, 0x9A, 0xB5, 0xEB, 0xE3, 0xB7, 0xFF, 0xCD, 0xEA, 0xF5, 0xF9, 0xDB, 0x98, 0x25, 0xF6, 0xF3, 0xF, 0xFF, 0x99, 0x2D, 0xF6, 0xDB, 0xAF, 0xD6, 0x74, 0xF4, 0x1F, 0x2F, 0x9B, 0xBF, 0xFD, 0x6A, 0x79, 0x89, 0xF9, 0xFD, 0x57, 0xAB, 0x97, 0x9D, 0x97, 0x8, 0xD8, 0xA6, 0xFF, 0xF8, 0xD5, 0x3F, 0xB6, 0xEA, 0x5F, 0x22, 0x60, 0xAB, 0xFE, 0xE3, 0x57, 0xFF, 0xD8, 0xAE, 0xFF, 0xED, 0x57, 0x6B, 0xFA, 0xF5, 0x8F, 0xD, 0x85, 0xAA, 0x69, 0xF3, 0x6F, 0x26, 0xB, 0x3E, 0x5A, 0xFF, 0x78, 0x8C, 0xE2, 0x43, 0xF3, 0x8F, 0x7, 0x24, 0xDE, 0x37, 0x7F, 0xDA, 0x23, 0xFF, 0xF7, 0xC1, 0x79, 0x2B, 0xFF, 0xD5, 0xF8, 0xFF, 0xFB, 0xF8, 0x35, 0xBC, 0x87, 0xB9, 0xC1, 0xE5, 0x92, 0x59, 0x17, 0x13, 0x59, 0xBF, 0x26, 0xCA, 0x11, 0xDD, 0xD0, 0x6A, 0x65, 0x7D, 0x50, 0xF3, 0xC4, 0x7F, 0xDB, 0x6B, 0x5A, 0xFD, 0x4A, 0xE, 0x7D, 0x9F, 0x52, 0x6E, 0x56, 0x96, 0xB9, 0xA7, 0x7E, 0x21, 0xA5, 0x97, 0xE5, 0xB2, 0x8A, 0x7A, 0x55, 0x4E, 0x28, 0xEA, 0x55, 0xAB, 0xF4, 0x75, 0x9B, 0x36, 0xF6, 0xA2, 0x29, 0xA1, 0x4D, 0x82, 0xCB, 0x57, 0x3B, 0x5E, 0x9, 0x4D, 0x83, 0x35, 0x41, 0xC7, 0xDC, 0x1E, 0x47, 0xDE, 0xD2, 0xF5, 0xB1, 0x57, 0x3D, 0x59, 0x63, 0x6, 0x15, 0xD8, 0xDB, 0xA9, 0x8, 0x37, 0x7, 0x8E, 0x7C, 0x83, 0x90, 0xE4, 0xD5, 0x2B, 0x2C, 0xC7, 0xAB, 0x57, 0x57, 0xE6, 0x7E, 0x34, 0x3D, 0x2D, 0x33, 0x72, 0x29, 0x5F, 0x8E, 0xE7, 0x8, 0x33, 0xEF, 0x84, 0xB4, 0x63, 0x88, 0x3, 0x92, 0x57, 0x83, 0xD2, 0xF, 0xB5, 0x28, 0xB9, 0x2D, 0x7, 0xA5, 0x9B, 0x93, 0x2, 0x69, 0x4D, 0xFE, 0xC6, 0x30, 0x3E, 0xC7, 0xB8, 0x17, 0x33, 0x2A, 0xA7, 0x26, 0x65, 0xD5, 0xA0, 0xEE, 0xA2, 0xAC, 0x9D, 0xAA, 0x39, 0xC9, 0x30, 0xD1, 0xD9, 0x97, 0x83, 0xDF, 0x90, 0xCF, 0xE7, 0xEB, 0x15, 0x96, 0xCE, 0x37, 0x9, 0xE0, 0xEE, 0x31, 0xF, 0x1, 0xE5, 0x5B, 0x4C, 0x65, 0xE2, 0x17, 0xC7, 0x19, 0x4A, 0x93, 0xF0, 0x39, 0x89, 0xA2, 0x39, 0x48, 0x90, 0x8F, 0x32, 0x5F, 0xA4, 0x47, 0xB3, 0xB0, 0xA1, 0x3F, 0x9, 0x6D, 0x12, 0xC, 0x89, 0x9A, 0x94, 0xB1, 0x6A, 0xBF, 0xAC, 0x16, 0xC4, 0x4D, 0xCA, 0x34, 0x27, 0x9, 0x26, 0xD2, 0x90, 0x91, 0xC6, 0xA7, 0x99, 0x4C, 0x13, 0x51, 0x48, 0x90, 0xD, 0x33, 0x6D, 0x46, 0x2C, 0x6D, 0xF8, 0xA6, 0xC0, 0x1C, 0x31, 0xF8, 0xD4, 0x9C, 0x48, 0x5A, 0xCE, 0x8C, 0xFF, 0x0, 0xEC, 0x37, 0x70, 0x10, 0x2, 0xA2, 0xAA, 0x44, 0xD2, 0x62, 0x1E, 0xEA, 0x29, 0xF7, 0x5E, 0xA0, 0xE6, 0xA4, 0x40, 0x4, 0x4E, 0xAA, 0xA7, 0xEC, 0x2B, 0x46, 0x82, 0x9A, 0x37, 0x9C, 0x94, 0xEE, 0x46, 0xD3, 0x8A, 0xD2, 0x74, 0x93, 0x60, 0x50, 0x54, 0x1C, 0x87, 0x96, 0xD, 0x90, 0xD9, 0x65, 0xED, 0x38, 0x26, 0x6C, 0x34, 0x8E, 0xFC, 0x68, 0xE4, 0xF, 0x6D, 0x5E, 0x14, 0xA0, 0x40, 0x1A, 0xCC, 0x60, 0xAE, 0x9B, 0x24, 0x2F, 0xE2, 0x4D, 0x24, 0xC0, 0xC4, 0x99, 0xCC, 0xAA, 0x1F, 0xE7, 0x24, 0xBC, 0xEA, 0x4F, 0x2A, 0xE7, 0xAB, 0xB2, 0xB, 0x83, 0xFD, 0x54, 0x12, 0xF9, 0x62, 0xAC, 0xA, 0x14, 0x28, 0x4, 0xC1, 0x55, 0xFC, 0x83, 0xAA, 0x39, 0xC8, 0x40, 0xD1, 0x14, 0xFF, 0xCA, 0xEF, 0x99, 0x60, 0x4C, 0xD4, 0x64, 0xF2, 0xA3, 0x65, 0x32, 0x55, 0xC3, 0x25, 0xA8, 0xFC, 0xCA, 0x9B, 0x42, 0x2D, 0x6B, 0x96, 0xDE, 0x49, 0xDD, 0xD5, 0x44, 0xDD, 0x28, 0x36, 0xE0, 0x5D, 0x35, 0x9, 0x87, 0x84, 0xE9, 0xB8, 0x88, 0x14, 0xDE, 0x30, 0xD1, 0xD4, 0xBD, 0xE3, 0x36, 0x52, 0xB6, 0x15, 0xB7, 0x91, 0x6A, 0x5A, 0x71, 0xCB, 0xB, 0xAB, 0x12, 0xE5, 0xD8, 0x5D, 0x43, 0x7A, 0x8E, 0x30, 0xE7, 0xB4, 0xD2, 0xE6, 0x54, 0x85, 0x5A, 0xDA, 0x5C, 0xB2, 0x6A, 0xF2, 0x73, 0xEC, 0x20, 0xAA, 0x9A, 0x68, 0x9B, 0x8B, 0x8B, 0xB3, 0xCB, 0xBC, 0x65, 0x1C, 0xC2, 0xE, 0x7E, 0xCB, 0x10, 0x47, 0x13, 0x1F, 0x55, 0x7D, 0x7C, 0xF4, 0x41, 0x1, 0x9, 0x7C, 0xD0, 0xF4, 0xA0, 0x20, 0xF5, 0xE5, 0x83, 0x9A, 0x4, 0x23, 0xA2, 0x66, 0x25, 0x25, 0x34, 0x3E, 0xB1, 0xB2, 0xA7, 0x5F, 0xA4, 0x64, 0x1D, 0x3E, 0x13, 0x0, 0xF1, 0xB3, 0xFB, 0x82, 0xB9, 0xBC, 0x2F, 0x98, 0xF5, 0x22, 0x9B, 0x28, 0xB3, 0x5E, 0x50, 0xEB, 0x64, 0xD6, 0xCE, 0xB2, 0xB1, 0x0, 0x7, 0x84, 0xD8, 0x29, 0x33, 0x57, 0x3F, 0x62, 0x7F, 0x5C, 0x64, 0xD, 0x42, 0x14, 0xF6, 0x1, 0x41, 0x6D, 0xB8, 0xF5, 0x5C, 0x62, 0x89, 0x4, 0x94, 0xD3, 0x4A, 0x78, 0x35, 0xDF, 0x54, 0x4F, 0x8E, 0x73, 0x29, 0x3F, 0xB1, 0xB2, 0x57, 0xFA, 0x49, 0xD9, 0xAB, 0xD9, 0x64, 0xFA, 0xE5, 0x45, 0x3, 0x77, 0xCF, 0xB3, 0xDD, 0xDC, 0x90, 0x91, 0x98, 0x70, 0x54, 0xDA, 0xA2, 0xCF, 0x7D, 0x41, 0xC6, 0x6, 0xD9, 0x89, 0x9A, 0x17, 0x15, 0x32, 0x99, 0x16, 0x1B, 0x67, 0xC, 0x1B, 0xF9, 0xFB, 0x2, 0x91, 0xAE, 0x5C, 0x56, 0xC5, 0xF7, 0xC3, 0xB2, 0x2A, 0xA4, 0x13, 0x16, 0xDF, 0xCD, 0x5C, 0xFD, 0xB7, 0x17, 0x52, 0x16, 0xB0, 0x71, 0x45, 0x2E, 0x4E, 0xA0, 0xC8, 0xBE, 0xA5, 0x5, 0x36, 0xBB, 0x33, 0xDD, 0x69, 0xAA, 0xF6, 0xA5, 0x56, 0xD, 0x51, 0x47, 0x6, 0xCA, 0x76, 0xDC, 0x41, 0xD5, 0xBC, 0xC8, 0x20, 0xD1, 0xBD, 0x1A, 0x1F, 0x34, 0x8, 0x1A, 0xD5, 0xF6, 0xAA, 0x49, 0x30, 0x26, 0xAA, 0x53, 0x81, 0x4D, 0xFB, 0x7B, 0x62, 0x40, 0xD6, 0x9D, 0x48, 0xAA, 0xAF, 0x5F, 0xE4, 0xD8, 0x94, 0xF5, 0x6F, 0x14, 0x19, 0x4D, 0xB6, 0xD7, 0xB, 0x39, 0x7D, 0xA1, 0xE1, 0xE2, 0x68, 0xF1, 0xA2, 0xA6, 0xB1, 0xBD, 0x6E, 0x7E, 0x21, 0x4, 0xC5, 0xDF, 0xB4, 0xF, 0x57, 0x9A, 0xE5, 0x77, 0x6D, 0x4E, 0xA, 0x50, 0xE0, 0xB7, 0xBD, 0x5C, 0x96, 0x9F, 0xDE, 0xE4, 0xF2, 0xE3, 0x47, 0x2B, 0x8B, 0xE7, 0xE9, 0xB1, 0x1F, 0x33, 0x7A, 0x10, 0x33, 0x25, 0x9C, 0x38, 0xAA, 0x59, 0x6C, 0x6F, 0x35, 0x1B, 0x72, 0x77, 0x40, 0xD8, 0x1B, 0x59, 0xED, 0xFD, 0x17, 0x49, 0xAB, 0xF2, 0x1B, 0xC7, 0xA4, 0xF1, 0xE1, 0xBC, 0x3B, 0x82, 0x49, 0xB8, 0x19, 0x26, 0xB9, 0xEA, 0x9, 0x40, 0xEC, 0x1D, 0x39, 0x5A, 0xD8, 0xBC, 0x4D, 0xBF, 0x12, 0x14, 0xC5, 0x5D, 0x9D, 0xB2, 0x9D, 0xF1, 0x5D, 0xAE, 0xCF, 0x5E, 0x34, 0x70, 0xBF, 0xD, 0x24, 0x1B, 0x7B, 0x76, 0x20, 0xD8, 0xD4, 0xB3, 0x5F, 0xF9, 0xF3, 0x56, 0xFB, 0x55, 0x75, 0xE0, 0x6A, 0xAD, 0xB2, 0x39, 0xBD, 0x64, 0x95, 0xAD, 0x50, 0x4C, 0xF9, 0x10, 0xE3, 0x83, 0x0, 0x8E, 0x30, 0xF6, 0xCA, 0xD2, 0x95, 0x49, 0xA3, 0x74, 0x3C, 0x2F, 0x33, 0xB3, 0x11, 0x2F, 0x9, 0x40, 0xCE, 0xF6, 0x2C, 0x65, 0x4D, 0x6A, 0x4B, 0x35, 0x6, 0xB5, 0xD, 0xAA, 0x55, 0x2E, 0x6E, 0xF, 0x32, 0xA3, 0xEA, 0x45, 0x3, 0x8F, 0xAA, 0xA0, 0x3A, 0xB7, 0x3D, 0xB3, 0x22, 0x23, 0x41, 0x85, 0xBC, 0xAB, 0x98, 0x85, 0xED, 0x80, 0x2B, 0x64, 0x2D, 0xCA, 0x19, 0xC1, 0xCE, 0x38, 0x51, 0xC4, 0xD8, 0xB0, 0x81, 0x94, 0xED, 0x1D, 0x9B, 0x49, 0xFC, 0x8D, 0x42, 0x92, 0xB6, 0xC9, 0x4C, 0x19, 0x93, 0x21, 0xE2, 0xCD, 0x4, 0x9B, 0xB, 0xD4, 0x6A, 0x67, 0x95, 0xEB, 0xD9, 0xAC, 0x35, 0x1F, 0x30, 0xF2, 0x6A, 0x34, 0x90, 0xED, 0x84, 0xC6, 0xDA, 0x31, 0x48, 0x4A, 0xA0, 0x85, 0x4D, 0x7E, 0x77, 0x40, 0x4D, 0x31, 0x5, 0x9E, 0x57, 0x6C, 0xCB, 0x94, 0x1B, 0x63, 0x5D, 0xC9, 0xB1, 0x5A, 0xE4, 0xAF, 0xE6, 0x0, 0x7F, 0x6D, 0xD2, 0xBF, 0x19, 0x29, 0xAE, 0xD7, 0x3, 0x5F, 0xA7, 0xE3, 0xC4, 0x90, 0x8D, 0x92, 0xEB, 0x8D, 0xE5, 0xB3, 0x4E, 0x9F, 0x18, 0x24, 0x45, 0x10, 0x0, 0xF1, 0xB2, 0x9A, 0xC7, 0x95, 0xB5, 0x4E, 0x5C, 0xA5, 0x72, 0xB9, 0x82, 0x76, 0x8A, 0x8A, 0x54, 0x41, 0x5E, 0xB9, 0xD5, 0xB7, 0xC4, 0x97, 0xF3, 0x79, 0x81, 0xC8, 0x3A, 0xED, 0xB6, 0xEB, 0x56, 0xC8, 0x7A, 0xF6, 0x22, 0xAA, 0x7A, 0x41, 0x26, 0x38, 0x3B, 0x3E, 0x2C, 0xB2, 0xA9, 0x61, 0x73, 0x26, 0x7B, 0xFB, 0xB2, 0x93, 0xD4, 0x5C, 0xBF, 0xF8, 0x74, 0xCE, 0x96, 0x59, 0x12, 0x77, 0x2, 0x7C, 0x51, 0xF4, 0xBA, 0xA5, 0xFC, 0x25, 0x4C, 0xA4, 0xA8, 0xBA, 0x84, 0xF1, 0x93, 0xA, 0xDD, 0xA8, 0xEF, 0xCA, 0xCA, 0xBA, 0xFA, 0x4D, 0x83, 0x2A, 0x6C, 0x1F, 0x47, 0x3C, 0x3F, 0xE4, 0x13, 0x84, 0xC, 0xFB, 0x40, 0x7E, 0x24, 0x9A, 0x52, 0x13, 0xF1, 0x1F, 0x9B, 0x28, 0x9A, 0x83, 0x4, 0xF9, 0xD8, 0xE0, 0xB7, 0x45, 0x79, 0x6D, 0x7B, 0x15, 0x55, 0x77, 0xEE, 0x50, 0xFF, 0x83, 0xB2, 0x79, 0x91, 0x42, 0x3F, 0x11, 0x74, 0xF6, 0x5A, 0x24, 0x4, 0x5D, 0x73, 0x2B, 0x12, 0xBE, 0xE5, 0x6E, 0x9, 0xDF, 0x68, 0x77, 0x6C, 0x3D, 0x9B, 0x17, 0x24, 0x1, 0xC8, 0x6C, 0xDD, 0x46, 0xED, 0xF8, 0x7A, 0x64, 0x27, 0x86, 0x48, 0xB9, 0x45, 0x13, 0x70, 0x1C, 0x32, 0x56, 0xCB, 0x49, 0x81, 0x7E, 0x17, 0x57, 0xCB, 0x22, 0x3D, 0x2D, 0x33, 0xFA, 0x4B, 0x18, 0xF5, 0xE5, 0x3E, 0xAA, 0x59, 0x28, 0xDE, 0xF9, 0x2B, 0x11, 0xE6, 0xFB, 0x70, 0x23, 0x69, 0xC5, 0x89, 0x8, 0x32, 0xEC, 0xBF, 0xD9, 0x56, 0xFE, 0x2E, 0x37, 0x6F, 0x9C, 0x4, 0x99, 0x4B, 0x91, 0x34, 0x47, 0xE0, 0x5B, 0x91, 0x47, 0xE9, 0x6, 0xF2, 0x51, 0x79, 0x1, 0xF9, 0xA0, 0x8C, 0x1E, 0x7C, 0x10, 0xAC, 0x3, 0xB9, 0x83, 0xA2, 0xE2, 0x11, 0x11, 0x57, 0xDD, 0xCA, 0x97, 0xAB, 0x5B, 0xF9, 0x96, 0xDB, 0x31, 0xB4, 0xC5, 0xED, 0x42, 0xCB, 0x2D, 0x42, 0x6D, 0x71, 0x11, 0x6A, 0xB9, 0xF0, 0x7F, 0xDF, 0x96, 0xC3, 0xFF, 0x3D, 0x53, 0x44, 0xD7, 0x97, 0xA, 0xE8, 0x7A, 0xAE, 0x5E, 0xB4, 0x2F, 0x56, 0x8B, 0xF6, 0x1D, 0xD7, 0x3, 0x5D, 0xB1, 0xB, 0x3A, 0xD6, 0x25, 0xBC, 0x2B, 0x97, 0x7A, 0xF6, 0x9D, 0x90, 0x7C, 0xC4, 0x77, 0x58, 0xF2, 0x11, 0xDF, 0x8D, 0x8A, 0xD3, 0x13, 0x29, 0x89, 0x3A, 0xC2, 0x7B, 0xBD, 0xCE, 0x5C, 0xCE, 0xB6, 0xF, 0xAC, 0x28, 0x84, 0xCB, 0x5C, 0x12, 0x5F, 0xA0, 0x58, 0x20, 0x33, 0xF2, 0xF2, 0xBF, 0xDD, 0x14, 0xB, 0x12, 0xF8, 0x6E, 0xFC, 0xE2, 0x94, 0x49, 0x37, 0x36, 0x5F, 0x45, 0x75, 0xD2, 0x8D, 0xB3, 0xF6, 0xC6, 0x77, 0xA3, 0x96, 0xDD, 0x2A, 0x3E, 0x68, 0x40, 0x8D, 0xDD, 0x8D, 0x33, 0x77, 0x3A, 0xD3, 0x8D, 0xCD, 0x5C, 0x3C, 0x9C, 0xE9, 0xC6, 0x99, 0xF3, 0xAA, 0x8A, 0xBC, 0x45, 0xAF, 0xAA, 0x6E, 0x5C, 0x4C, 0xCF, 0xA9, 0xE5, 0x3, 0x28, 0xB2, 0xB3, 0x7, 0xCC, 0xDD, 0xD8, 0x94, 0xCF, 0x97, 0xBB, 0x91, 0xB4, 0x6D, 0xDD, 0x66, 0x65, 0x3, 0xEB, 0x4D, 0x2, 0x37, 0x23, 0x4D, 0x34, 0xE9, 0xC9, 0xB3, 0x99, 0x4F, 0xDE, 0x30, 0x92, 0x1, 0x65, 0xA7, 0x9E, 0x55, 0xAF, 0xB3, 0x2F, 0x78, 0x90, 0xE0, 0x2F, 0x38, 0x2B, 0xE7, 0x47, 0xB3, 0x72, 0xAA, 0xF0, 0x13, 0x2D, 0xB, 0x22, 0x6E, 0xFB, 0xB3, 0x3F, 0x47, 0x98, 0x73, 0x1F, 0x45, 0x33, 0xFE, 0x3D, 0x34, 0x5B, 0x56, 0x61, 0x26, 0x29, 0xB6, 0xAC, 0x36, 0x93, 0x4, 0xA6, 0x10, 0xDD, 0x29, 0x41, 0xAA, 0x44, 0xF7, 0xB3, 0x4, 0x6F, 0xFD, 0x5D, 0x72, 0x6C, 0xDC, 0x9, 0x1A, 0xEB, 0xEF, 0xA0, 0x87, 0x63, 0x62, 0x78, 0xCA, 0x5F, 0xF5, 0x4, 0xBE, 0xEA, 0x7, 0xCF, 0xDE, 0xFC, 0x0, 0x78, 0x59, 0x77, 0xDE, 0x6E, 0x6C, 0xCA, 0x2E, 0xBD, 0xDD, 0xE8, 0x5, 0xCD, 0xE6, 0xCB, 0xB7, 0x15, 0xDD, 0xE8, 0xF9, 0x51, 0xD9, 0x78, 0x60, 0x3C, 0x72, 0xB5, 0xAB, 0xFF, 0x6A, 0x22, 0xF7, 0x2F, 0x15, 0xAB, 0x99, 0x23, 0x89, 0x6E, 0xDC, 0xD8, 0xCB, 0xE3, 0xFD, 0x79, 0xF9, 0xA7, 0xE7, 0x54, 0x98, 0xC1, 0xF5, 0x92, 0x8C, 0x1D, 0x6E, 0xFA, 0x62, 0x29, 0x54, 0xDF, 0x8D, 0x77, 0xE5, 0xF8, 0xD1, 0x98, 0x80, 0xE2, 0xBB, 0xDC, 0xB5, 0x91, 0xCF, 0xA, 0x77, 0x18, 0x9E, 0x5B, 0x4F, 0x5E, 0x46, 0xF3, 0x2C, 0xF3, 0x1A, 0xCD, 0x2A, 0x66, 0xD3, 0xE8, 0xB2, 0x62, 0x36, 0x9A, 0xAD, 0x17, 0x75, 0x2, 0x10, 0xFB, 0xE5, 0x6A, 0xBA, 0x60, 0x28, 0xA3, 0x3A, 0x7F, 0x22, 0x43, 0x9B, 0x24, 0x31, 0x29, 0xDB, 0x8F, 0xC6, 0x7, 0x72, 0x62, 0x45, 0xEB, 0xDF, 0x8, 0x6B, 0xE5, 0x1B, 0xDB, 0x6F, 0x3E, 0x20, 0x3F, 0x70, 0x52, 0x56, 0xFD, 0x42, 0xA7, 0x6D, 0x26, 0x5D, 0xED, 0x4F, 0x44, 0x7F, 0x20, 0x37, 0x63, 0x98, 0x7C, 0x52, 0x55, 0x49, 0xC6, 0x1A, 0xFC, 0x4F, 0x5A, 0xBB, 0x20, 0xB2, 0x4A, 0x56, 0xE9, 0xCB, 0xEB, 0xBE, 0xD9, 0x5B, 0x15, 0x36, 0xA7, 0xE7, 0xB9, 0x2C, 0xF7, 0x4D, 0x59, 0xF1, 0xB, 0xC1, 0x78, 0x21, 0xAD, 0xE6, 0xB, 0x86, 0x9C, 0xDA, 0xBB, 0x2F, 0x1A, 0xAD, 0x67, 0xFD, 0x56, 0x5E, 0x50, 0x49, 0xC4, 0xC4, 0x4E, 0xF8, 0x66, 0x2A, 0x4F, 0x77, 0xAE, 0xA8, 0x5D, 0xE4, 0x2C, 0x5B, 0xE1, 0x42, 0xEE, 0x43, 0xDF, 0x81, 0xB9, 0xF, 0x7D, 0xC7, 0x55, 0x34, 0x8B, 0xEC, 0xE5, 0xE5, 0x8A, 0x58, 0xFB, 0x90, 0xCA, 0x16, 0x2F, 0x85, 0x59, 0x59, 0xB1, 0x2E, 0xFF, 0x89, 0xA3, 0xA3, 0xC0, 0xA9, 0x75, 0xD5, 0xEE, 0xE2, 0x4D, 0xE0, 0x4B, 0xE, 0xFD, 0x44, 0x81, 0x59, 0xAD, 0x91, 0xC1, 0xD8, 0xA1, 0x63, 0xF7, 0xBE, 0x7, 0xD8, 0x74, 0xD2, 0x2E, 0xF8, 0x53, 0xD0, 0xC3, 0xEB, 0x8E, 0x3F, 0x2, 0xDA, 0x31, 0xE8, 0xE4, 0xA7, 0xB, 0xEC, 0x6A, 0xDA, 0x84, 0xF2, 0x5A, 0xBA, 0xB1, 0x4B, 0x58, 0xB3, 0x95, 0x57, 0x30, 0x2E, 0xDB, 0x7D, 0xE4, 0x2C, 0x8E, 0xB, 0x6E, 0x3B, 0x5A, 0xDC, 0x8A, 0xF6, 0xEC, 0x16, 0xBE, 0x7, 0xB6, 0xF0, 0xBD, 0x95, 0xA2, 0x23, 0x7A, 0xB, 0x86, 0x47, 0xF4, 0x3E, 0xE5, 0x2, 0xE4, 0x85, 0xEC, 0x98, 0x2C, 0xE5, 0xDD, 0x4D, 0x3D, 0xDB, 0x64, 0x3D, 0xD0, 0x64, 0x9C, 0xE2, 0x28, 0x6A, 0xD, 0xAD, 0x56, 0x92, 0x33, 0xAC, 0x1F, 0x30, 0x38, 0x89, 0x52, 0xAD, 0x36, 0x56, 0x88, 0x2B, 0x16, 0x12, 0xF2, 0x5A, 0x71, 0xB3, 0x2E, 0x3E, 0x2D, 0x31, 0xB6, 0xCA, 0x7, 0x5A, 0x69, 0x66, 0xF, 0xA7, 0x5E, 0x28, 0x70, 0x48, 0xA5, 0x3B, 0xA5, 0xFC, 0xC8, 0x6D, 0xFF, 0x13, 0xD2, 0x8C, 0xC5, 0xE2, 0xB7, 0x5E, 0x77, 0xEC, 0x7E, 0x52, 0x77, 0x4D, 0x79, 0x27, 0xA9, 0xBB, 0x8D, 0x2B, 0xF8, 0x7E, 0x3C, 0x7, 0x98, 0xD, 0x1B, 0xD4, 0x75, 0x22, 0x98, 0x80, 0xA0, 0xBA, 0x60, 0x3A, 0xE1, 0xC0, 0xF9, 0x67, 0x1A, 0x48, 0xEB, 0x68, 0xCD, 0xB6, 0xA7, 0x2E, 0x1E, 0xA6, 0x68, 0x21, 0xBF, 0xAC, 0xD7, 0x60, 0x7E, 0x59, 0x2F, 0x2C, 0x6A, 0xB9, 0x15, 0xED, 0xFD, 0xEB, 0xD2, 0x91, 0x9E, 0x6, 0x8F, 0xF4, 0xB4, 0xE9, 0x15, 0x5F, 0xC7, 0x6A, 0x87, 0xA0, 0x25, 0x44, 0x9B, 0x31, 0x1F, 0xD2, 0x14, 0x9, 0x6A, 0x2E, 0xD3, 0xB4, 0x99, 0x94, 0xEC, 0xEA, 0x1C, 0x51, 0xD8, 0xD5, 0x59, 0x1B, 0x97, 0xF5, 0xCD, 0x49, 0xD5, 0x70, 0x70, 0xDF, 0x9C, 0x48, 0xBE, 0x95, 0xE4, 0x6D, 0x55, 0x2, 0x1F, 0x99, 0xD0, 0xB2, 0x88, 0xC2, 0xA1, 0x65, 0xFA, 0xCB, 0x3E, 0x58, 0xB5, 0x1E, 0x1, 0x60, 0x65, 0xD0, 0x53, 0xFF, 0x77, 0xAB, 0x75, 0xFB, 0x8, 0x3A, 0xAE, 0xFA, 0x9C, 0x99, 0x19, 0x49, 0x9A, 0x37, 0xD, 0xE2, 0xD2, 0xA8, 0x27, 0x33, 0xA8, 0x51, 0x39, 0x25, 0x6, 0xEA, 0xBC, 0x28, 0xD0, 0x50, 0x9D, 0xC8, 0x90, 0x11, 0x56, 0x21, 0x66, 0x52, 0x77, 0x95, 0xED, 0xCD, 0x9D, 0xA4, 0xA6, 0x3F, 0x67, 0xBE, 0x2A, 0x56, 0x2, 0x80, 0xCB, 0x3D, 0x3D, 0xEB, 0x8E, 0x9D, 0x8D, 0xE9, 0x79, 0x99, 0x59, 0x50, 0x26, 0xA8, 0x85, 0xAC, 0xE7, 0xAB, 0x59, 0x5B, 0xC3, 0x99, 0x60, 0x9, 0x6A, 0x22, 0x6, 0x9, 0x11, 0x54, 0x73, 0x12, 0x2, 0x69, 0xE4, 0x48, 0x69, 0xEC, 0x95, 0x6F, 0xCB, 0x28, 0x25, 0x81, 0x90, 0x18, 0x41, 0xC1, 0x25, 0x29, 0x90, 0x82, 0x8B, 0x94, 0x82, 0x9A, 0x4F, 0x42, 0x20, 0x3D, 0x3F, 0x7F, 0xB1, 0x89, 0x95, 0x13, 0x0, 0x8D, 0xC, 0xD1, 0x31, 0x78, 0x87, 0x20, 0x19, 0xF3, 0xF5, 0x11, 0xB7, 0xDC, 0xEC, 0x2, 0x1E, 0xC1, 0x66, 0x47, 0xCB, 0x1F, 0x33, 0xFB, 0xCB, 0x95, 0xDC, 0x65, 0xD6, 0x5E, 0x5A, 0x85, 0x3F, 0x49, 0xC0, 0x2E, 0x3F, 0x38, 0xF8, 0x8C, 0xEF, 0x1F, 0x38, 0x2E, 0xCE, 0x48, 0xAB, 0xDB, 0xE, 0x82, 0xDD, 0x3F, 0xFB, 0x7, 0x6D, 0x6E, 0xA4, 0x45, 0x4B, 0xA3, 0xE0, 0x4D, 0x1, 0xE, 0x6, 0xA1, 0x23, 0x91, 0x4E, 0x5C, 0x5A, 0x9A, 0x36, 0x35, 0x49, 0x21, 0xBF, 0x3B, 0xDE, 0x24, 0x2, 0x44, 0x63, 0x2D, 0xC6, 0x6A, 0xB9, 0xFA, 0xE3, 0x8E, 0xC3, 0xF5, 0x1F, 0xBD, 0xB6, 0xCA, 0x2B, 0xC5, 0x2A, 0xA1, 0x88, 0x34, 0xAA, 0x6C, 0x93, 0xD8, 0x5E, 0xB1, 0xAF, 0xF2, 0x57, 0x73, 0x38, 0x92, 0x94, 0x7D, 0xD6, 0xB4, 0x65, 0x6F, 0x1A, 0xD3, 0xE3, 0xE2, 0xCF, 0xF3, 0x3, 0x6, 0x1B, 0x2A, 0xD6, 0x2A, 0x2B, 0x3B, 0xF, 0x24, 0x18, 0x76, 0x1E, 0xD0, 0xD6, 0x6B, 0xED, 0x8C, 0x1D, 0x84, 0x37, 0x3A, 0x61, 0xF0, 0xCD, 0xBC, 0xA1, 0x94, 0x36, 0x30, 0x6E, 0xF1, 0x84, 0xC6, 0x3D, 0x48, 0x9A, 0x9D, 0x86, 0x6F, 0xE3, 0xF, 0x91, 0xB4, 0xCD, 0xEC, 0x69, 0x4E, 0x4, 0x80, 0x3, 0x1D, 0xED, 0xA, 0x21, 0xC1, 0xDA, 0xD5, 0x85, 0x4, 0x6B, 0xA7, 0xAC, 0xF1, 0xA3, 0x29, 0x4A, 0x4D, 0x54, 0x95, 0xA2, 0x6F, 0xBA, 0x6D, 0x25, 0x55, 0xB7, 0x83, 0x98, 0x56, 0xDA, 0x69, 0x85, 0x2E, 0x3D, 0x4, 0x41, 0x1D, 0xBA, 0xD3, 0xA, 0xA, 0xE9, 0x10, 0x4, 0xE9, 0xA2, 0x48, 0xAB, 0xB5, 0xCB, 0x7C, 0x9B, 0xD6, 0xE, 0xFF, 0xB8, 0x99, 0xA4, 0x1, 0x7B, 0xA0, 0xF0, 0xE7, 0x75, 0xB4, 0xA5, 0x93, 0xE7, 0xE0, 0xCC, 0xC4, 0xD7, 0xED, 0xFE, 0x9D, 0xC, 0x7E, 0x4F, 0xFD, 0xDD, 0x8D, 0xCA, 0xE, 0x19, 0xB9, 0x27, 0x5, 0x2C, 0xF2, 0x1A, 0x24, 0xB3, 0x67, 0x7, 0x6B, 0x4, 0xC9, 0x4D, 0x18, 0x5, 0xA1, 0x2D, 0x78, 0xD, 0x92, 0xDD, 0x72, 0x8, 0x42, 0x7, 0xC8, 0xA0, 0x96, 0x4C, 0x4B, 0x45, 0x14, 0xFE, 0xB8, 0x48, 0x2C, 0xBF, 0x54, 0x12, 0x85, 0xBE, 0xD5, 0xA8, 0xE6, 0xEB, 0x6C, 0xAE, 0x99, 0x37, 0x3B, 0x29, 0xE0, 0xB7, 0x1B, 0x43, 0xAE, 0x13, 0x23, 0x8A, 0x8B, 0xDA, 0x16, 0xDA, 0x32, 0xD3, 0x6A, 0xC7, 0x61, 0x71, 0xC6, 0x9, 0xA7, 0xAB, 0x7, 0x8, 0xB, 0x8A, 0xB, 0x7A, 0xAA, 0xC1, 0x25, 0x8F, 0xB1, 0x17, 0x9, 0x3C, 0xD2, 0x96, 0xF5, 0x3F, 0xF2, 0xDB, 0x2D, 0xEB, 0x7F, 0xE0, 0xB7, 0x5B, 0xFF, 0xC9, 0xB4, 0xFF, 0xFA, 0xF, 0xDE, 0xFC, 0xAB, 0x7C, 0x40, 0x74, 0xA0, 0xB0, 0xA8, 0x1F, 0x9B, 0xCA, 0x29, 0xA0, 0x4, 0xC3, 0xC2, 0x9C, 0x67, 0xEB, 0xFB, 0x1C, 0x58, 0x23, 0xD7, 0xF9, 0xF9, 0x59, 0x8C, 0x57, 0xAE, 0x53, 0x7E, 0x91, 0xDF, 0xEA, 0x20, 0x40, 0xDF, 0xCB, 0x74, 0x7A, 0xA0, 0x9B, 0x70, 0xD3, 0xB1, 0xE3, 0xE9, 0x50, 0x29, 0x73, 0xEB, 0xC1, 0xC8, 0xE3, 0x6E, 0xF9, 0x3F, 0xA5, 0x6D, 0xC0, 0x61, 0x65, 0xA2, 0x26, 0xCB, 0xEE, 0x59, 0x76, 0x49, 0x11, 0xC4, 0xC4, 0xB0, 0xC7, 0x9E, 0x7, 0x52, 0x36, 0x1, 0x22, 0x99, 0x70, 0x3, 0x77, 0xA0, 0xD0, 0x5, 0x1C, 0xFF, 0x16, 0xE5, 0x17, 0x8, 0x6A, 0x50, 0xF, 0x15, 0x68, 0x7A, 0x8, 0x27, 0x63, 0x3B, 0x41, 0x93, 0x28, 0x10, 0xA3, 0x26, 0x90, 0x32, 0x52, 0x3E, 0xC, 0x1D, 0xA8, 0x49, 0x28, 0x26, 0x68, 0xEB, 0x46, 0x93, 0x73, 0x32, 0x3F, 0x48, 0x2A, 0xBC, 0xCC, 0x75, 0x8, 0x73, 0xAA, 0xE9, 0x27, 0x1F, 0xFB, 0x9F, 0x14, 0xE8, 0xC9, 0x7F, 0xA4, 0xCF, 0x5C, 0x77, 0x1F, 0x38, 0x2E, 0xCD, 0x6F, 0x19, 0x5B, 0x3F, 0xC2, 0xB8, 0xAD, 0x7F, 0xCB, 0x5E, 0xF, 0xDC, 0x2A, 0xAE, 0x7, 0x6E, 0x9A, 0xD5, 0x2E, 0xF1, 0x71, 0x89, 0x95, 0x29, 0x7F, 0x15, 0x1F, 0x96, 0xD8, 0xBE, 0x39, 0xB6, 0xEF, 0x32, 0xDB, 0xC8, 0x79, 0x5F, 0xE9, 0xEF, 0x66, 0x2C, 0x7A, 0x5C, 0xE9, 0x6F, 0xFE, 0xA4, 0xF3, 0x1B, 0x39, 0xE7, 0xFC, 0x66, 0xEF, 0x1F, 0xBE, 0x8B, 0x77, 0xF, 0x57, 0x76, 0xCF, 0x7E, 0x5, 0xB6, 0xEC, 0x57, 0x6E, 0x97, 0x74, 0x2D, 0x6E, 0x90, 0x38, 0x1B, 0xA9, 0x78, 0x99, 0x39, 0x5C, 0xFE, 0x5E, 0x95, 0xF2, 0x8B, 0xF2, 0xDE, 0xDC, 0xF4, 0xE0, 0x4C, 0xCF, 0x2F, 0xF3, 0xC3, 0xE5, 0xEF, 0x26, 0x12, 0x36, 0x1F, 0x94, 0xD0, 0x6A, 0xCF, 0xDD, 0x9D, 0x15, 0xEF, 0xCD, 0x46, 0xD5, 0x4D, 0x56, 0x7, 0xCB, 0xF5, 0xF8, 0x81, 0x35, 0x56, 0xEA, 0xF6, 0x8F, 0xDF, 0x56, 0x13, 0x5, 0x65, 0xB3, 0xE1, 0x4E, 0x7, 0x4D, 0x4D, 0xB4, 0x53, 0x34, 0xF3, 0x9D, 0x99, 0xB9, 0xA9, 0xF7, 0x82, 0x8A, 0x22, 0xF2, 0xF5, 0xE4, 0x13, 0x41, 0xDD, 0x3B, 0x39, 0x41, 0xC, 0xC0, 0xCA, 0x4D, 0xE4, 0xF8, 0xB4, 0xC4, 0xC8, 0xDE, 0x4C, 0x8F, 0xC0, 0xCD, 0xF4, 0xA8, 0x67, 0x6E, 0x42, 0xA6, 0xC7, 0x0, 0xAB, 0xA8, 0x92, 0x23, 0x8, 0xEA, 0xE3, 0x51, 0xBB, 0xD6, 0x11, 0x4D, 0xB2, 0x87, 0xD0, 0x8B, 0x2, 0x95, 0x68, 0xD8, 0x11, 0x61, 0xCA, 0x83, 0xC1, 0xB4, 0x2A, 0xA8, 0xCC, 0xA1, 0xDA, 0x4E, 0x80, 0x9F, 0xAA, 0xED, 0xF4, 0xB9, 0xAB, 0x8A, 0x43, 0x22, 0x7E, 0x53, 0x31, 0x9A, 0x56, 0x77, 0x63, 0x3E, 0xAE, 0xF1, 0xA0, 0xA9, 0x1A, 0xB6, 0xA6, 0xD5, 0xA5, 0x80, 0xC4, 0x83, 0xA6, 0x52, 0x6C, 0x4A, 0x1D, 0x32, 0x6C, 0x56, 0x30, 0x30, 0x4E, 0x8A, 0x26, 0x91, 0x80, 0x22, 0x73, 0xD6, 0xD4, 0x8E, 0xC3, 0xC6, 0xD4, 0x18, 0x6D, 0xA5, 0x49, 0xF9, 0x71, 0x9B, 0xB6, 0x6C, 0xCF, 0xBF, 0xC9, 0x6A, 0xFA, 0xBF, 0x1B, 0x4D, 0x21, 0x92, 0xFF, 0x45, 0x83, 0x7, 0xF3, 0x8F, 0xE6, 0x1A, 0x3A, 0xF7, 0x58, 0x3, 0xF5, 0xFA, 0xC6, 0x8A, 0x3C, 0xF1, 0x26, 0x12, 0x14, 0xB5, 0xF3, 0x8B, 0x9C, 0xBD, 0x3D, 0xFC, 0x90, 0x6, 0x5C, 0x1C, 0x46, 0x6A, 0xDD, 0x9B, 0x20, 0x8A, 0x8A, 0x20, 0x26, 0x48, 0x48, 0x6B, 0x8A, 0xD5, 0x67, 0xF4, 0xA3, 0x31, 0xAD, 0xCA, 0x26, 0x7D, 0x4C, 0x14, 0x35, 0x59, 0x1F, 0x47, 0xF3, 0x45, 0x56, 0xDD, 0x55, 0xD0, 0xB2, 0xFD, 0xF8, 0xA6, 0x81, 0x8D, 0xC8, 0x38, 0xB0, 0x5A, 0x5D, 0x98, 0x7A, 0x91, 0xA4, 0x6E, 0xE6, 0x4D, 0xCA, 0xA4, 0xBF, 0x42, 0x84, 0x7E, 0x84, 0x9A, 0x93, 0x2, 0x14, 0xB8, 0xE4, 0x95, 0x63, 0x24, 0xA8, 0x99, 0x1C, 0x93, 0xA2, 0xC2, 0xE2, 0x9B, 0x48, 0x6A, 0x3F, 0x3B, 0xF8, 0x4D, 0xDE, 0x1F, 0x1D, 0x4, 0xF0, 0x1E, 0x69, 0x34, 0x53, 0xAE, 0x2C, 0x6A, 0xC2, 0x2B, 0x2, 0x1, 0x47, 0x33, 0xD1, 0xB4, 0x75, 0xE3, 0x96, 0x71, 0x1F, 0x78, 0xD3, 0xC0, 0x4E, 0x4, 0xA3, 0x99, 0xFC, 0xC8, 0xAF, 0x7A, 0x9, 0x28, 0xB2, 0x2F, 0xAA, 0x55, 0x4E, 0x65, 0xDD, 0x41, 0x4E, 0xA2, 0x1A, 0x97, 0x90, 0xC8, 0xD3, 0x2B, 0x3F, 0x9A, 0xA7, 0x18, 0x58, 0xBE, 0x93, 0x34, 0x27, 0xD, 0x28, 0x74, 0x51, 0x53, 0x69, 0xE8, 0xEC, 0x44, 0x75, 0x83, 0x27, 0xE, 0x70, 0xA9, 0x53, 0xD2, 0xD8, 0xC6, 0x3A, 0x63, 0x51, 0xB, 0x5, 0xCA, 0x29, 0x89, 0x9D, 0xA2, 0x42, 0x45, 0x2C, 0xCA, 0xCA, 0x2F, 0x66, 0xD1, 0xD7, 0xB2, 0x1A, 0x10, 0x55, 0x25, 0xD2, 0x3C, 0x4B, 0x46, 0x43, 0x24, 0xA9, 0xEC, 0x5, 0xF3, 0xDC, 0x4A, 0x63, 0x26, 0xA, 0xAD, 0x1B, 0x33, 0x54, 0x1E, 0x88, 0x54, 0x3D, 0x10, 0xA9, 0x57, 0x41, 0xE7, 0x32, 0x5A, 0x9E, 0x34, 0x15, 0x9, 0x2D, 0x23, 0xCB, 0x75, 0xF3, 0x99, 0xCC, 0x9B, 0x27, 0x5, 0x9C, 0x7C, 0x33, 0x32, 0xC, 0x94, 0xCF, 0xA2, 0xB7, 0xD3, 0x54, 0x25, 0xD1, 0x8B, 0x2C, 0xC9, 0x7C, 0x91, 0xF2, 0x31, 0x9D, 0x4, 0x68, 0x4E, 0xA6, 0x48, 0xFF, 0x65, 0x68, 0x32, 0x56, 0x5E, 0xB6, 0x22, 0x45, 0x73, 0x90, 0x80, 0x22, 0xE3, 0x12, 0x57, 0x1A, 0xA5, 0x89, 0xA8, 0x72, 0x9C, 0xD2, 0x64, 0xF6, 0x74, 0xB7, 0xD9, 0x6, 0x38, 0x89, 0x6A, 0x1A, 0xC1, 0x9A, 0x90, 0x35, 0x9A, 0x77, 0x8A, 0xA, 0xA3, 0x79, 0x21, 0x4B, 0x5E, 0x4F, 0x26, 0x65, 0xB5, 0xDD, 0xE4, 0xD5, 0xF1, 0xA4, 0x6B, 0x5E, 0x84, 0xA0, 0x78, 0xBF, 0xDD, 0x55, 0x26, 0x6B, 0xED, 0x49, 0x82, 0xA7, 0xAE, 0x8D, 0x1C, 0xC1, 0x6F, 0x93, 0xCE, 0xE5, 0x8E, 0x7A, 0x11, 0x55, 0x64, 0x90, 0x3A, 0x78, 0x96, 0x6C, 0x26, 0xDA, 0x17, 0x51, 0xD5, 0xC4, 0xDD, 0xF3, 0x23, 0xE6, 0x36, 0x27, 0x47, 0x6, 0x45, 0x7C, 0x7B, 0x62, 0x95, 0xA5, 0x45, 0x75, 0x63, 0x2E, 0x4B, 0xF3, 0x7, 0x55, 0x4D, 0xFB, 0xDA, 0x81, 0xB2, 0x1B, 0x13, 0x3B, 0x50, 0xCD, 0xA6, 0xC4, 0xE, 0x5B, 0x2E, 0x83, 0x4C, 0x22, 0xA8, 0xC8, 0x1F, 0x93, 0xE8, 0xE5, 0xAC, 0x9, 0xBB, 0x38, 0x34, 0x6F, 0x42, 0xA2, 0xBE, 0xAB, 0xCC, 0x49, 0xF7, 0x2E, 0xEF, 0x24, 0xC1, 0x44, 0x46, 0xD5, 0x96, 0xF7, 0x5A, 0x38, 0x88, 0xAA, 0x9C, 0x16, 0x46, 0x63, 0xBF, 0xE4, 0x14, 0x77, 0x9, 0xC5, 0x55, 0x89, 0xFD, 0xCA, 0x26, 0x91, 0xD9, 0xA5, 0xE1, 0xA7, 0xFB, 0x89, 0x3E, 0x9B, 0x3E, 0x7B, 0x97, 0x58, 0x91, 0x3C, 0x3B, 0x32, 0x6C, 0xD3, 0x96, 0x9D, 0x34, 0x91, 0xA0, 0x66, 0xCE, 0x4C, 0x6A, 0x31, 0x3, 0x85, 0x7C, 0xB6, 0xA0, 0xF, 0xBA, 0x3A, 0x9B, 0xD6, 0x92, 0xF5, 0x3A, 0x93, 0xEB, 0xEF, 0xA4, 0xA8, 0x19, 0x9D, 0x89, 0xA9, 0x7C, 0x2, 0xF3, 0xA2, 0xAB, 0x5B, 0xA9, 0x22, 0x9B, 0xCB, 0xDC, 0x1A, 0x9D, 0x14, 0x15, 0xDB, 0x22, 0x1B, 0x28, 0xB7, 0x40, 0xD9, 0x40, 0x15, 0xAB, 0x93, 0xD, 0x34, 0x15, 0x3F, 0x3D, 0xD2, 0x54, 0x7E, 0x76, 0xA0, 0xC5, 0x14, 0xCE, 0x0, 0x12, 0x4D, 0xDD, 0x29, 0x80, 0x7D, 0xC4, 0xB9, 0x1C, 0xF9, 0xA, 0xFA, 0xEE, 0x45, 0x57, 0xA5, 0xF6, 0x68, 0x28, 0x6D, 0xB4, 0x13, 0x45, 0xCD, 0x4E, 0x9B, 0xBE, 0x8C, 0xCB, 0x2F, 0x20, 0x89, 0xA2, 0x66, 0xF1, 0xA0, 0x47, 0x36, 0x4F, 0x7A, 0x22, 0xA8, 0x5A, 0x94, 0x9D, 0x1A, 0xD5, 0xA2, 0xA, 0x3A, 0x7A, 0x27, 0xAA, 0x53, 0xD3, 0x2E, 0xD5, 0x8, 0x28, 0x19, 0xBF, 0x3B, 0x55, 0xA5, 0xF9, 0xBB, 0x33, 0x89, 0x6E, 0xED, 0x2F, 0xA9, 0xA0, 0x6F, 0x7B, 0xA2, 0xA7, 0x9C, 0xDD, 0x1F, 0xF1, 0xA, 0xAB, 0xDF, 0x29, 0xBB, 0x4D, 0x25, 0xD3, 0x77, 0x27, 0xAA, 0x9B, 0x59, 0xAE, 0x7C, 0x74, 0xE3, 0xAA, 0x8F, 0x6E, 0x9C, 0xA2, 0x29, 0x9B, 0x76, 0xE9, 0x20, 0xA9, 0x3A, 0x6F, 0x71, 0x2A, 0x1C, 0x83, 0x25, 0xB3, 0x64, 0xED, 0x44, 0x75, 0xAB, 0x96, 0x33, 0xD1, 0x3A, 0x2E, 0x35, 0x42, 0x22, 0xAA, 0x6C, 0x86, 0xB4, 0x65, 0x92, 0x87, 0x0, 0x99, 0x9A, 0x11, 0x40, 0x26, 0x6D, 0x97, 0x33, 0xDA, 0x64, 0x27, 0xA9, 0x51, 0x27, 0x3B, 0x87, 0x58, 0x78, 0xEA, 0x25, 0x12, 0xAC, 0x3E, 0x95, 0x8C, 0x23, 0x15, 0x28, 0x6F, 0x8F, 0xEF, 0x34, 0x55, 0xE6, 0xB8, 0x1F, 0xCD, 0xB4, 0x15, 0x72, 0x90, 0x9F, 0x54, 0x55, 0x39, 0xC8, 0x47, 0xE3, 0x33, 0x9, 0xBD, 0x22, 0x5A, 0xB1, 0xF6, 0xFB, 0x2D, 0xDB, 0xDF, 0x7E, 0xAB, 0xE9, 0xEF, 0xA0, 0x9C, 0xCA, 0xAD, 0xF3, 0x11, 0xAF, 0x58, 0xE5, 0xEF, 0xCA, 0xDC, 0x55, 0x90, 0x8E, 0x8B, 0xEE, 0xAA, 0x49, 0x30, 0x24, 0xEA, 0xB9, 0x4D, 0x5B, 0x6C, 0xEB, 0x9C, 0xC0, 0x93, 0xA6, 0x4A, 0xAC, 0xBC, 0x0, 0xE3, 0x7, 0x39, 0x89, 0x12, 0x92, 0x56, 0x23, 0x96, 0xE2, 0x58, 0x2D, 0x6C, 0x10, 0x12, 0x4D, 0xDD, 0xFE, 0x80, 0x58, 0x4F, 0xA4, 0xF4, 0xB8, 0xC8, 0x6A, 0x55, 0xDE, 0x68, 0x89, 0x4, 0x35, 0xD6, 0x4A, 0xA4, 0xCF, 0x2B, 0xD7, 0x24, 0xB1, 0x46, 0xB3, 0x52, 0x7E, 0xC7, 0x42, 0x55, 0xFB, 0x15, 0xE2, 0x65, 0x20, 0x8C, 0xCA, 0x69, 0xAB, 0x58, 0x87, 0x95, 0x13, 0x44, 0x42, 0xAE, 0x47, 0x5A, 0x5B, 0xA7, 0x6D, 0x3F, 0x3F, 0x1C, 0xDF, 0x6B, 0x6B, 0x73, 0xE0, 0x8D, 0x93, 0xFA, 0xEF, 0x27, 0x69, 0x23, 0xE7, 0x16, 0x12, 0xC5, 0x8C, 0xC5, 0x48, 0xB3, 0xC8, 0xCD, 0xBA, 0x72, 0x44, 0xEE, 0xA2, 0xF7, 0xCE, 0x48, 0xAB, 0x9, 0x29, 0x16, 0x81, 0x75, 0x5E, 0x7C, 0xC1, 0x90, 0xEB, 0xE2, 0x48, 0x29, 0x45, 0x1, 0x2F, 0x27, 0x21, 0xE5, 0xB7, 0x91, 0xFA, 0x6, 0xF0, 0x26, 0x1A, 0x69, 0x65, 0xFD, 0x1, 0x23, 0xB7, 0xE8, 0x9, 0xF8, 0xD3, 0xDB, 0x7, 0xD6, 0xA1, 0x86, 0xD6, 0x26, 0x48, 0x5E, 0x35, 0x3F, 0xB1, 0xDF, 0x95, 0xD3, 0x52, 0x48, 0xD7, 0x1, 0x83, 0x41, 0x5D, 0x23, 0x6D, 0x5F, 0x5C, 0xA2, 0xC6, 0xFD, 0x79, 0xB1, 0x1D, 0x58, 0x9F, 0x13, 0x2A, 0xFB, 0x9C, 0xD0, 0x5D, 0x70, 0xA9, 0x1C, 0xE9, 0xE, 0xB9, 0x53, 0x46, 0x9, 0x5, 0xA3, 0x9C, 0x6A, 0xD, 0x72, 0xCA, 0xBA, 0x1A, 0x50, 0x8D, 0x9B, 0x1, 0xDD, 0x8F, 0x93, 0x5D, 0x2F, 0xAD, 0xA5, 0x74, 0x14, 0x9, 0x4B, 0x24, 0x98, 0xC8, 0x60, 0x16, 0xBE, 0xC3, 0x77, 0xA4, 0x24, 0x60, 0xB3, 0xCA, 0x16, 0xE, 0x70, 0x23, 0x49, 0xDD, 0xD9, 0xED, 0x9D, 0xBF, 0xAA, 0xBF, 0x23, 0x97, 0xF4, 0xDC, 0xB8, 0x2B, 0x8E, 0x39, 0xD3, 0x6A, 0x6B, 0x26, 0xD1, 0xDD, 0x67, 0x87, 0x41, 0x5F, 0x1F, 0xD3, 0x99, 0x59, 0x48, 0x4F, 0xBE, 0x43, 0x40, 0xCE, 0xD9, 0x48, 0xF8, 0x10, 0x45, 0x3C, 0x30, 0x9, 0x3C, 0x3B, 0xC2, 0xAB, 0xE7, 0x59, 0x4D, 0xAC, 0x8F, 0xE2, 0xE, 0x1, 0x8E, 0x8A, 0x46, 0xB, 0x69, 0x77, 0x23, 0x0, 0xBD, 0x83, 0x90, 0xE, 0xC1, 0xA0, 0xE9, 0x10, 0xCC, 0x20, 0x95, 0xAA, 0x49, 0x8, 0xF2, 0xD, 0xC6, 0x4, 0x29, 0x17, 0xF1, 0x8E, 0x41, 0xEB, 0xA, 0x9F, 0x76, 0x1B, 0xC9, 0xB8, 0x6D, 0xE6, 0x2F, 0xC5, 0x97, 0x71, 0x49, 0x8, 0xF2, 0x9, 0x6C, 0x76, 0x4F, 0x53, 0xCE, 0xED, 0x69, 0xE6, 0xCC, 0x74, 0x98, 0xE1, 0xB9, 0xB0, 0xAC, 0xB3, 0xBE, 0x6C, 0x6E, 0x16, 0x1A, 0xF1, 0x84, 0xB1, 0x76, 0x64, 0xD3, 0x3D, 0x9A, 0x72, 0xA6, 0x47, 0x63, 0x95, 0x5A, 0x85, 0x58, 0x90, 0x1D, 0x3, 0x7F, 0x7F, 0x98, 0x59, 0x33, 0xF7, 0x0, 0x4A, 0x6F, 0xE1, 0x54, 0xB7, 0x9, 0x85, 0x6C, 0xF, 0xC, 0x19, 0x12, 0x4E, 0x1C, 0xD5, 0xE, 0x1C, 0xD5, 0x81, 0xD3, 0xF7, 0xF1, 0x69, 0x99, 0x71, 0xD6, 0xEA, 0xCA, 0x3B, 0x1, 0x1F, 0x20, 0xE4, 0xF7, 0x1B, 0x67, 0x8E, 0xF, 0x2A, 0x98, 0x4E, 0xA8, 0xCC, 0x9E, 0xA6, 0xD6, 0x4E, 0xD1, 0x64, 0x4A, 0xB4, 0xBF, 0x25, 0x7E, 0x31, 0x42, 0xBE, 0x4A, 0xDF, 0xF3, 0xD5, 0xB1, 0x29, 0xE, 0xBE, 0x3A, 0x20, 0xDD, 0x9, 0x37, 0xAB, 0x8A, 0x73, 0x6A, 0x52, 0xD6, 0xF0, 0x85, 0xE, 0x13, 0x2, 0xF4, 0xDD, 0xA4, 0x6C, 0x6C, 0xF, 0x49, 0x46, 0x4A, 0x0, 0x86, 0x48, 0xD9, 0x78, 0xAD, 0x12, 0x1, 0x84, 0x9F, 0x4D, 0x54, 0x3D, 0x35, 0xE5, 0x54, 0xD5, 0x93, 0xEE, 0xFD, 0x42, 0x13, 0x39, 0xAF, 0x85, 0x86, 0xF8, 0x20, 0x40, 0xDE, 0x24, 0x91, 0x67, 0x24, 0x41, 0x32, 0x84, 0x95, 0x66, 0x42, 0x57, 0x9A, 0x89, 0xB3, 0x39, 0xA6, 0xA2, 0xD1, 0x31, 0x19, 0xA1, 0x46, 0x57, 0x4, 0x90, 0x17, 0x37, 0xDA, 0xDD, 0xF8, 0x32, 0x2, 0x3B, 0x4, 0xC9, 0x10, 0xB5, 0xFC, 0x84, 0x96, 0x57, 0x9C, 0xC, 0xE7, 0xEB, 0x1D, 0x9F, 0x96, 0x19, 0xF9, 0x97, 0x87, 0x5E, 0x7C, 0xD, 0x37, 0x69, 0x10, 0xED, 0x18, 0x22, 0xE5, 0x4B, 0xB0, 0x73, 0x22, 0x0, 0xF1, 0x6B, 0x77, 0x13, 0x2A, 0xA4, 0x1D, 0x18, 0x22, 0x65, 0xD6, 0xFC, 0xC6, 0x31, 0x2, 0xC0, 0xCE, 0x71, 0xA2, 0xEE, 0x49, 0x77, 0x5E, 0x42, 0x82, 0x20, 0x19, 0xBD, 0x90, 0x55, 0x24, 0x21, 0xC8, 0x57, 0x90, 0x94, 0x52, 0x6E, 0x22, 0x30, 0xA5, 0xDC, 0x44, 0xB3, 0xEC, 0x73, 0x1F, 0x41, 0x74, 0x44, 0x72, 0x71, 0x39, 0x53, 0x31, 0x2C, 0x67, 0x7A, 0xF4, 0xE6, 0x66, 0xEC, 0x20, 0xEC, 0x22, 0x4F, 0x18, 0xDA, 0x4A, 0x4E, 0x8F, 0x41, 0x2B, 0x27, 0x9A, 0x1D, 0x2F, 0x18, 0xB2, 0x3C, 0xA6, 0x84, 0x30, 0x42, 0xD6, 0xE2, 0xA2, 0xCD, 0xF5, 0x47, 0xB1, 0x1F, 0x66, 0xE5, 0xF9, 0xEA, 0x6A, 0x11, 0x0, 0xFA, 0x71, 0x56, 0x21, 0x6C, 0xB, 0x3B, 0x9A, 0x76, 0x8, 0x18, 0x4E, 0x73, 0xDF, 0xB3, 0xFC, 0x7D, 0xD3, 0x3, 0xBC, 0x2B, 0xB7, 0x42, 0xCD, 0x7D, 0xB3, 0x16, 0x97, 0xA8, 0x99, 0x4F, 0x7C, 0x34, 0x23, 0x59, 0xD4, 0x66, 0xFE, 0xF8, 0x68, 0x6, 0xCE, 0x8E, 0xE6, 0x6D, 0x95, 0xDA, 0x3C, 0x22, 0x48, 0xA3, 0x3F, 0xF9, 0x17, 0x7F, 0x2, 0x2F, 0xCE, 0xD, 0xD1, 0xE2, 0x2C, 0x5B, 0xD4, 0x3C, 0x5F, 0xEE, 0xBA, 0xE5, 0x57, 0xCF, 0x13, 0x85, 0x56, 0xD0, 0x45, 0x39, 0xCE, 0xA8, 0x4C, 0x8F, 0x4B, 0x6F, 0x61, 0xD8, 0x4, 0xDE, 0xE9, 0x71, 0x89, 0x95, 0x66, 0x7E, 0xF9, 0x8C, 0x0, 0x30, 0x44, 0x17, 0x36, 0xC4, 0x72, 0x1, 0x42, 0x2B, 0x97, 0xCD, 0x8F, 0x5D, 0x6F, 0x79, 0xDD, 0x70, 0x80, 0x90, 0x66, 0xE0, 0x56, 0xDE, 0xE2, 0xBA, 0x6B, 0x95, 0xF2, 0xBC, 0xAE, 0x4F, 0x8, 0xF0, 0xE5, 0x56, 0x79, 0xBE, 0xE5, 0x52, 0x66, 0x1E, 0x80, 0xBF, 0xBB, 0x72, 0xCC, 0xDD, 0xB5, 0xCC, 0xC8, 0x7E, 0x31, 0x10, 0x57, 0x66, 0xCD, 0x3C, 0xCB, 0x15, 0x71, 0x5F, 0x28, 0xF2, 0xF2, 0x24, 0x7E, 0x3D, 0x61, 0x9F, 0xCF, 0xF1, 0x96, 0x99, 0xCA, 0xE1, 0x50, 0x54, 0x1D, 0xD, 0x45, 0x5D, 0xC7, 0xE6, 0x37, 0xDA, 0x9F, 0x17, 0x99, 0x7B, 0x2E, 0xBF, 0x1B, 0x75, 0x4D, 0x5F, 0xCC, 0xB1, 0x4B, 0xDD, 0x68, 0x3C, 0x77, 0xF0, 0x9F, 0x8A, 0x2F, 0xFB, 0x72, 0x0, 0x27, 0x75, 0x46, 0x71, 0xEA, 0x72, 0x7F, 0x5E, 0xFC, 0x75, 0xA3, 0x83, 0x9C, 0x53, 0x7C, 0x87, 0x41, 0x63, 0x83, 0x3A, 0xF6, 0x8C, 0x85, 0x3A, 0xA0, 0x82, 0xA, 0x75, 0xDE, 0x75, 0xC2, 0x8E, 0x9A, 0xBA, 0x26, 0x82, 0xD0, 0x8E, 0x9A, 0x7A, 0x3D, 0xA8, 0xCD, 0x65, 0xFC, 0xB3, 0x4F, 0xA, 0xF8, 0xDE, 0x96, 0x74, 0xAF, 0x72, 0xD7, 0x72, 0x11, 0xAF, 0xB8, 0x95, 0xA3, 0x6B, 0x68, 0x95, 0x65, 0x1B, 0xEA, 0x40, 0xCA, 0x2, 0xEE, 0x8A, 0xBD, 0x9B, 0x38, 0x11, 0x4C, 0x80, 0x60, 0xDC, 0x1D, 0x28, 0x64, 0xDB, 0xD1, 0x60, 0xA5, 0x43, 0x98, 0x1D, 0x42, 0x54, 0xC0, 0x60, 0x79, 0x15, 0x1C, 0x1, 0x88, 0x5F, 0x30, 0xB6, 0x51, 0x5B, 0x9B, 0xD8, 0x6B, 0x37, 0x2A, 0xDF, 0xB9, 0x11, 0x7B, 0x82, 0x45, 0xC0, 0xD9, 0x15, 0x7D, 0xA9, 0xDC, 0x35, 0x2A, 0x7D, 0xA9, 0x8A, 0x6B, 0xD4, 0x82, 0x5F, 0x4E, 0x9D, 0x4F, 0xE, 0x4D, 0x72, 0x8F, 0x4E, 0x68, 0x8F, 0xA, 0xC6, 0x4, 0x66, 0x4B, 0xD0, 0xCC, 0xA6, 0xBA, 0x4B, 0x8F, 0x8B, 0xAC, 0x71, 0xCC, 0xC8, 0xCA, 0x2C, 0xC1, 0xA8, 0x32, 0x9B, 0x37, 0xE1, 0x78, 0x29, 0x21, 0xD0, 0x87, 0x6C, 0xC1, 0x90, 0xE5, 0xCE, 0x49, 0x5F, 0x50, 0x49, 0xC4, 0x52, 0xB8, 0x85, 0xA3, 0xA5, 0xEE, 0x12, 0x8E, 0x16, 0x2B, 0xF4, 0xCD, 0x62, 0xA1, 0xCE, 0xB1, 0xBD, 0xD3, 0x17, 0xF1, 0xE4, 0xED, 0xD, 0x83, 0xB2, 0x8C, 0x30, 0x87, 0x12, 0x84, 0x4C, 0x24, 0x76, 0x9B, 0x41, 0xE5, 0x5D, 0x6, 0xD9, 0xA1, 0x57, 0xB3, 0x70, 0xEC, 0x74, 0x82, 0xD0, 0x47, 0xC8, 0x1, 0x36, 0x64, 0xE1, 0xD8, 0x1A, 0xB2, 0xDC, 0x46, 0x3D, 0x3E, 0x2D, 0x31, 0x52, 0xBE, 0x5A, 0x28, 0x51, 0x55, 0x8D, 0x50, 0x5A, 0x29, 0xF0, 0xCA, 0x74, 0xA5, 0x80, 0x78, 0x54, 0x90, 0xBB, 0xA8, 0x4D, 0x3C, 0x99, 0x7F, 0xA1, 0x48, 0xCB, 0xBA, 0xCB, 0xD5, 0x91, 0xD0, 0x41, 0x3B, 0x86, 0x49, 0xA1, 0xCC, 0xDB, 0x10, 0xFC, 0x2E, 0x37, 0xE5, 0x94, 0x1D, 0xB4, 0x93, 0x24, 0x9D, 0x38, 0x24, 0xED, 0x4A, 0xB3, 0x30, 0x81, 0x12, 0x4, 0xC9, 0xA0, 0x4E, 0xB5, 0x7C, 0x21, 0xED, 0x3, 0x3, 0x76, 0xBB, 0xE4, 0x9C, 0x36, 0x82, 0x9, 0x7F, 0x60, 0xD0, 0xBB, 0x4, 0x59, 0x23, 0xEC, 0x18, 0x24, 0x45, 0x38, 0x28, 0x89, 0x0, 0xC2, 0x9F, 0xB, 0xCA, 0xA3, 0x8A, 0x78, 0x3C, 0xF2, 0x96, 0xEE, 0x46, 0x77, 0xFC, 0x3C, 0x38, 0x40, 0xC4, 0xAE, 0xF0, 0x82, 0x4, 0x84, 0x77, 0xB3, 0xBD, 0xB2, 0xFD, 0xCD, 0xF8, 0x5C, 0x1, 0xA7, 0x9F, 0xA8, 0xD0, 0x75, 0x6D, 0xB3, 0x7D, 0xA7, 0x7C, 0x60, 0x7D, 0x1D, 0x4E, 0xB0, 0x11, 0xFD, 0x1D, 0x3E, 0x66, 0xFA, 0x16, 0xC6, 0x4E, 0x39, 0x9A, 0x8D, 0x95, 0xCB, 0xE6, 0x7E, 0x12, 0xC1, 0x2F, 0x18, 0xC6, 0xBB, 0xF6, 0x72, 0x8A, 0xAA, 0x93, 0x0, 0x95, 0x77, 0xCF, 0xC6, 0x92, 0xD1, 0xBD, 0x26, 0x8A, 0x8C, 0x58, 0x35, 0x5D, 0xD4, 0xD2, 0xEC, 0x21, 0x5C, 0xF9, 0x4, 0x6E, 0x55, 0x6C, 0x11, 0xB0, 0xF4, 0x18, 0x60, 0xBD, 0x4, 0xBA, 0x38, 0x6D, 0x3, 0x7F, 0xB8, 0xF2, 0x49, 0x1, 0x9D, 0xB0, 0x44, 0x86, 0x49, 0x48, 0x1E, 0x71, 0x60, 0x88, 0x5B, 0x4A, 0x24, 0x15, 0xFB, 0x36, 0x82, 0x60, 0xBF, 0xAE, 0xAD, 0xE3, 0xEC, 0xF6, 0xB5, 0x6D, 0x5C, 0x79, 0xCF, 0xBC, 0xB6, 0xEC, 0x96, 0x7B, 0x6D, 0x91, 0xD, 0xF7, 0xDA, 0x5B, 0xCF, 0xD6, 0xB9, 0x8B, 0x0, 0x52, 0xE1, 0x2E, 0xD2, 0x69, 0xF6, 0xF7, 0xA3, 0x0, 0x8D, 0xBD, 0x1, 0x7F, 0xD8, 0x98, 0x24, 0x20, 0x7, 0x8D, 0xAB, 0xEE, 0x82, 0xDB, 0x96, 0xE5, 0xF1, 0x65, 0x2C, 0x71, 0x2D, 0xF9, 0x57, 0x73, 0xD2, 0x34, 0x3B, 0xD1, 0xAF, 0xED, 0xCA, 0xE5, 0x2F, 0x5E, 0xA5, 0xEA, 0x19, 0x2B, 0x58, 0x3D, 0x63, 0x1D, 0x8D, 0xFD, 0x5E, 0x1E, 0x61, 0x34, 0xDD, 0xA5, 0xD5, 0xCA, 0x7, 0xC1, 0xEB, 0x24, 0xD1, 0x35, 0x3F, 0x11, 0x62, 0x83, 0x98, 0xB5, 0x37, 0x57, 0xC0, 0xD4, 0x5C, 0xBF, 0xF4, 0x3C, 0xA9, 0x9B, 0xE1, 0xAF, 0xC9, 0x5E, 0x28, 0xB0, 0x52, 0xAD, 0x52, 0x4D, 0x81, 0x15, 0xAD, 0x29, 0xB0, 0x12, 0x7B, 0x4A, 0x9C, 0x1E, 0x97, 0x7E, 0x9C, 0x5C, 0x60, 0x37, 0xBC, 0xA9, 0xDC, 0x4F, 0x79, 0xD3, 0xBB, 0xB2, 0xC9, 0x2C, 0xD7, 0x72, 0xD9, 0xAE, 0xF5, 0xD1, 0xEB, 0x56, 0xBB, 0x81, 0x6F, 0xBE, 0x3, 0x44, 0x5A, 0x4F, 0x3E, 0xAD, 0x5A, 0xD1, 0x93, 0xAA, 0x1F, 0x9B, 0x72, 0xFA, 0x7F, 0x6C, 0xC9, 0xA2, 0x1D, 0x6A, 0xC4, 0xB2, 0x45, 0xEF, 0x4E, 0xD8, 0x29, 0x59, 0x45, 0x70, 0x8, 0x1, 0x54, 0xC1, 0x4E, 0xC9, 0x9F, 0x7B, 0x1D, 0x52, 0x90, 0x63, 0xAF, 0x44, 0x2A, 0xB6, 0x4B, 0x42, 0xC1, 0xA6, 0xE1, 0xC6, 0x65, 0x71, 0xD6, 0x3A, 0xE9, 0xC, 0xC6, 0x81, 0x67, 0x30, 0x6E, 0xC, 0x82, 0x3B, 0x8E, 0x1B, 0x43, 0x93, 0xF1, 0xC9, 0x79, 0x37, 0x81, 0x63, 0x67, 0xB6, 0x3, 0x66, 0xB6, 0x63, 0xDF, 0xBC, 0xFC, 0xCE, 0xBC, 0xA6, 0x73, 0x88, 0x9A, 0xE3, 0xE, 0x5, 0x8A, 0xA7, 0x1, 0x6C, 0x9D, 0xAF, 0xA6, 0x5C, 0xE9, 0xCB, 0xB3, 0x2D, 0xE3, 0x81, 0x96, 0xF1, 0x33, 0x39, 0x33, 0x18, 0xDB, 0xF5, 0xF6, 0x7F, 0xFF, 0xBB, 0xEC, 0xF5, 0xA8, 0xF8, 0x75, 0xC7, 0xCF, 0xCD, 0x4E, 0xDA, 0x7C, 0xD2, 0x42, 0x2B, 0x11, 0xF7, 0x72, 0xC5, 0x37, 0xB, 0xAA, 0x9B, 0x46, 0xED, 0xE8, 0x62, 0x75, 0xB8, 0x93, 0x9B, 0xF8, 0x55, 0xE2, 0x57, 0x2A, 0x68, 0x89, 0x8, 0xAA, 0xE7, 0xCC, 0xB5, 0xF4, 0xB8, 0xF8, 0x56, 0xBD, 0x6C, 0xB7, 0x46, 0x10, 0x9C, 0x84, 0x41, 0xD, 0xDA, 0x52, 0xAF, 0x95, 0xE3, 0xCD, 0xAD, 0x88, 0x37, 0x7, 0x1, 0x62, 0x73, 0x5, 0x35, 0x18, 0x3B, 0x90, 0x75, 0xBA, 0xE7, 0x93, 0xFE, 0xEE, 0x4, 0x4D, 0xA2, 0x80, 0x52, 0xFF, 0x6, 0x35, 0xB7, 0xCA, 0xCA, 0x79, 0x5F, 0xF, 0x1C, 0xFE, 0xDE, 0x99, 0xEE, 0xEA, 0x72, 0xA7, 0x99, 0x8F, 0xE9, 0xF8, 0xC0, 0x1, 0x65, 0x12, 0xE2, 0x46, 0x9C, 0x5D, 0x66, 0x12, 0x2, 0xAC, 0x31, 0x41, 0xAD, 0xBC, 0x83, 0xE3, 0x1, 0x14, 0xD9, 0x39, 0xC5, 0x10, 0xCA, 0x7, 0x98, 0x41, 0x39, 0xD5, 0x8D, 0x74, 0x37, 0x9D, 0x16, 0xDA, 0xE1, 0x85, 0x43, 0xED, 0xE0, 0x6, 0xE5, 0xF8, 0xBA, 0x84, 0x7, 0x86, 0x49, 0xB1, 0x71, 0x69, 0xCE, 0x74, 0xF5, 0x4E, 0x0, 0xF7, 0xB5, 0x23, 0x1F, 0xEE, 0xE2, 0xB1, 0xFD, 0x89, 0x83, 0x2B, 0x47, 0x50, 0x41, 0xB, 0xF7, 0x29, 0x2F, 0x8, 0x10, 0x71, 0x55, 0x8E, 0xFF, 0xB6, 0x3, 0x2, 0x44, 0x64, 0x1A, 0x28, 0xA0, 0xAB, 0x6F, 0x24, 0xA5, 0x6B, 0xAF, 0x67, 0x75, 0xCF, 0x2D, 0xE8, 0x3F, 0x93, 0xE1, 0xB2, 0x47, 0x3D, 0xCF, 0xD2, 0xCC, 0xD8, 0x41, 0x68, 0x72, 0x4, 0xD3, 0x5D, 0x54, 0xD7, 0x69, 0x2F, 0xD4, 0xDC, 0xF8, 0xA4, 0x80, 0xCC, 0x97, 0x9C, 0xB, 0x6C, 0x85, 0xF3, 0x6B, 0x22, 0xA5, 0xD6, 0x6B, 0x77, 0x53, 0xED, 0xAC, 0x37, 0xAF, 0x5D, 0x47, 0x36, 0x88, 0x5B, 0xE0, 0x5D, 0x34, 0xCB, 0x0, 0xAE, 0x14, 0xA9, 0x62, 0xA2, 0x58, 0xFD, 0x65, 0xC7, 0xC1, 0xD2, 0x2F, 0x91, 0x38, 0x73, 0xD6, 0xB3, 0xC3, 0x78, 0x57, 0x4B, 0xBD, 0xC, 0x75, 0xF0, 0x4D, 0x3E, 0xEA, 0x3C, 0x41, 0x4C, 0x8E, 0x76, 0xFC, 0x1, 0xEE, 0xE, 0x21, 0x32, 0xB8, 0x1A, 0xBA, 0x41, 0x2A, 0x9D, 0xFB, 0xC1, 0x36, 0x38, 0xDA, 0xB8, 0xF3, 0x9E, 0x13, 0x29, 0x9, 0xD0, 0x6A, 0x91, 0xFB, 0x42, 0xAB, 0x5, 0xED, 0x9, 0x6D, 0xB4, 0x5B, 0x94, 0x93, 0x63, 0x8, 0x5E, 0x14, 0xB8, 0xC4, 0x41, 0xB8, 0xB7, 0xD8, 0x21, 0xA4, 0x55, 0xB5, 0xB1, 0xD3, 0x97, 0xD4, 0xC3, 0x7, 0x88, 0xC8, 0x19, 0xD8, 0x24, 0xA7, 0x71, 0xE8, 0xF, 0xBF, 0xA5, 0x37, 0x65, 0x7, 0xFD, 0x90, 0x6C, 0x19, 0x59, 0xCA, 0x6E, 0xEA, 0x40, 0xA2, 0x46, 0xD5, 0xB1, 0x95, 0x64, 0xC3, 0xD8, 0xA8, 0xAE, 0x29, 0x97, 0x93, 0xD, 0x5F, 0x34, 0x66, 0xE6, 0x5F, 0x44, 0xD1, 0x2E, 0xFA, 0x22, 0xF1, 0xA, 0xE4, 0xC0, 0x90, 0xC6, 0xDD, 0x29, 0xFD, 0x18, 0x3F, 0x51, 0xE8, 0xF0, 0x9F, 0x48, 0x10, 0x99, 0xBC, 0x18, 0x84, 0x93, 0xBA, 0x69, 0xA4, 0x99, 0x6D, 0x9A, 0x13, 0x2, 0x45, 0xC8, 0x6D, 0x7C, 0x10, 0xA0, 0xCD, 0x4C, 0xD3, 0x24, 0xAC, 0x66, 0x11, 0x81, 0x3E, 0x6A, 0x26, 0xCF, 0x59, 0xD8, 0x71, 0xEC, 0x45, 0xEC, 0x57, 0xE3, 0x9A, 0x1D, 0x78, 0xE4, 0x2E, 0x7A, 0xBE, 0x89, 0x1D, 0xFE, 0x82, 0xA1, 0x17, 0x72, 0x6A, 0x60, 0xD, 0xF4, 0x1D, 0x80, 0xD8, 0x1F, 0xCA, 0xF6, 0xFA, 0x3B, 0xAD, 0x3A, 0xC2, 0xFA, 0x9C, 0xC8, 0x9A, 0xF, 0x3A, 0x6C, 0x95, 0xCE, 0x8C, 0x6A, 0x78, 0x50, 0x4B, 0x7E, 0x67, 0x1, 0x73, 0x39, 0x13, 0xA2, 0x72, 0x33, 0xC1, 0xB8, 0x3F, 0xB1, 0xB6, 0xCA, 0x8B, 0x91, 0xBD, 0x27, 0xE, 0x86, 0xF6, 0x26, 0xF2, 0x56, 0x90, 0xD3, 0xB4, 0x2D, 0xF2, 0x3A, 0x3D, 0x6B, 0xB6, 0x46, 0xFE, 0xBE, 0x5C, 0xC4, 0x25, 0xD2, 0x59, 0xCE, 0x7B, 0x30, 0x9, 0xB0, 0x45, 0xF, 0xC2, 0x48, 0xC6, 0x7A, 0x0, 0x25, 0xFE, 0xB2, 0xF3, 0x4F, 0x24, 0xE3, 0x37, 0xEF, 0x94, 0xE2, 0xA3, 0x11, 0xFE, 0x9B, 0xD4, 0x11, 0xCD, 0xD, 0xE9, 0x4F, 0xD9, 0x8D, 0xF1, 0x4, 0xB1, 0x21, 0xF5, 0x25, 0x99, 0xFF, 0xE4, 0xBE, 0x9A, 0x8C, 0xFD, 0xFF, 0xD1, 0x19, 0xAB, 0xE, 0xDA, 0xF9, 0x76, 0xCB, 0x6D, 0x94, 0xDE, 0x34, 0xA8, 0x86, 0x73, 0xCA, 0xF6, 0xFC, 0xF5, 0xEC, 0xE, 0x21, 0x9F, 0xE7, 0xA4, 0xBB, 0xD9, 0x84, 0x40, 0x12, 0xB4, 0x62, 0xBF, 0x27, 0x3D, 0x2F, 0x32, 0x3F, 0x84, 0xB5, 0xCB, 0x3D, 0xA0, 0x15, 0x6B, 0xEB, 0xB5, 0xD, 0x82, 0xAF, 0xDA, 0xE, 0x42, 0xAE, 0x6A, 0x61, 0xEB, 0xF9, 0xA3, 0x94, 0xF4, 0xBC, 0xCC, 0xCC, 0x5D, 0xFC, 0xA5, 0xC7, 0x45, 0xD6, 0xEB, 0xF5, 0xF2, 0xDF, 0x8B, 0x57, 0xB3, 0x70, 0x72, 0xF6, 0x41, 0x80, 0x1D, 0x97, 0xBD, 0xE9, 0x37, 0xF6, 0x88, 0xE2, 0x43, 0xDE, 0x56, 0x4E, 0x5A, 0x11, 0xC9, 0xE3, 0x84, 0x53, 0xBD, 0xB0, 0x47, 0x7A, 0xE1, 0xD8, 0x96, 0x68, 0xB, 0x61, 0x50, 0x2E, 0x73, 0xA, 0x76, 0x10, 0xA0, 0x33, 0xE0, 0xC1, 0x3A, 0xB5, 0xA7, 0xC7, 0x5, 0x56, 0xEE, 0x92, 0xA3, 0x78, 0xBD, 0xB1, 0x75, 0x13, 0xD7, 0xA8, 0xE9, 0x71, 0x89, 0x55, 0xF7, 0xC1, 0x38, 0x9A, 0xD9, 0xD3, 0xD6, 0x13, 0x4, 0xE, 0x5D, 0x37, 0x9D, 0xF3, 0x36, 0xDD, 0x74, 0x85, 0xAF, 0xE9, 0x36, 0x18, 0xAB, 0x16, 0x23, 0xA7, 0x6F, 0x3C, 0x8, 0xE0, 0xF4, 0x8D, 0xDB, 0x60, 0x2, 0xE5, 0xBC, 0xD, 0x13, 0x41, 0x85, 0xBF, 0xE1, 0x66, 0xAE, 0x5B, 0xEE, 0x7A, 0x3E, 0xE2, 0x15, 0xF7, 0xF3, 0x9B, 0xF1, 0x5E, 0x3E, 0x36, 0x49, 0x28, 0x38, 0xF0, 0x36, 0x13, 0x94, 0x9C, 0x24, 0x3C, 0xA2, 0x70, 0x82, 0xF0, 0x6D, 0x4A, 0x79, 0x65, 0xF3, 0x9E, 0x7C, 0x7, 0x51, 0x95, 0x37, 0xDF, 0x36, 0x6D, 0xB, 0xA5, 0x9C, 0x74, 0x82, 0xC8, 0x6D, 0xA1, 0x26, 0xE1, 0x88, 0x30, 0xC1, 0x6B, 0x73, 0x83, 0xBC, 0x36, 0xB7, 0x59, 0x32, 0xD, 0x13, 0x2, 0x49, 0x30, 0xEC, 0xBD, 0xE4, 0x36, 0x1B, 0xE0, 0x5A, 0x72, 0x5B, 0x4C, 0x9F, 0xC9, 0xED, 0x9B, 0x60, 0x7C, 0x94, 0x2F, 0x26, 0x64, 0x72, 0x6B, 0x25, 0x18, 0xCE, 0x82, 0xB5, 0x2D, 0x14, 0x32, 0xF9, 0xC8, 0x12, 0xC, 0x27, 0x24, 0x4B, 0xD4, 0xD3, 0xB6, 0x67, 0xA8, 0xCF, 0xC8, 0x3B, 0x49, 0x20, 0x91, 0xF, 0x36, 0x7A, 0x67, 0x5B, 0x1E, 0x40, 0xFC, 0xCE, 0xB6, 0xE7, 0x4C, 0xCE, 0x6, 0x63, 0x1C, 0x34, 0x35, 0xD1, 0x18, 0x92, 0xEF, 0x2D, 0xE6, 0x79, 0xBB, 0x59, 0xD1, 0x89, 0x79, 0x87, 0x10, 0x19, 0xAB, 0x8A, 0x9B, 0x1, 0x4E, 0x44, 0x13, 0x21, 0xF9, 0xDE, 0xED, 0xBD, 0x18, 0x1E, 0x32, 0x3A, 0xF6, 0x45, 0x76, 0xAC, 0xE9, 0xCA, 0xB7, 0x37, 0x7, 0x29, 0x1B, 0x5C, 0x7C, 0x8A, 0x11, 0x63, 0x8C, 0x7F, 0x13, 0xC3, 0x6F, 0x9, 0x4F, 0x39, 0xC8, 0x3E, 0x70, 0x5B, 0xD7, 0xD9, 0xB0, 0xD7, 0x23, 0x27, 0x52, 0x6C, 0xDB, 0x75, 0xE6, 0xEC, 0xA9, 0xFD, 0x79, 0x99, 0x99, 0xF7, 0x7E, 0x38, 0x80, 0x12, 0xBB, 0xE3, 0xB6, 0x4C, 0xF1, 0x69, 0x91, 0x71, 0xD0, 0x64, 0xFD, 0xA8, 0x32, 0xA5, 0xB5, 0x3E, 0x68, 0xD0, 0x65, 0xC6, 0xD, 0xDA, 0xB1, 0x6D, 0xB1, 0x3, 0x45, 0x76, 0xAB, 0xC4, 0xA1, 0xBE, 0x63, 0xC8, 0x58, 0x77, 0x4E, 0xCB, 0x5E, 0x15, 0x9, 0x45, 0x3F, 0xC7, 0xAB, 0xC9, 0x64, 0x56, 0xA4, 0x94, 0xC6, 0x19, 0x5E, 0x91, 0x3C, 0x59, 0xCA, 0xE6, 0xFD, 0x4B, 0x14, 0x35, 0x59, 0xFF, 0xB6, 0xBB, 0x9A, 0x27, 0xC3, 0xDE, 0x3B, 0xED, 0x10, 0x70, 0xE3, 0x94, 0xCF, 0xA5, 0x5F, 0x95, 0x46, 0x7F, 0x7B, 0xAA, 0x29, 0x2B, 0x2C, 0xE2, 0x35, 0xE2, 0xB6, 0x6C, 0x59, 0xA1, 0xED, 0xB9, 0xD5, 0x54, 0x15, 0xDA, 0x9E, 0xDB, 0x24, 0xD6, 0x5C, 0x49, 0x20, 0x58, 0x74, 0x25, 0xD2, 0x72, 0x99, 0x21, 0xF6, 0xE7, 0x5, 0x66, 0xAE, 0x88, 0x96, 0x54, 0x37, 0xEB, 0xCD, 0xA4, 0xE6, 0x56, 0x9, 0x47, 0x3, 0x7, 0x6, 0xCC, 0x8B, 0x5B, 0x77, 0x35, 0x2E, 0x2A, 0x4C, 0xCE, 0x53, 0xE8, 0xAF, 0xC6, 0xDF, 0xBA, 0xE6, 0x20, 0xF8, 0xD5, 0x5F, 0x88, 0x3B, 0x27, 0xBC, 0x69, 0xC3, 0x27, 0xE8, 0x88, 0x0, 0xF2, 0x36, 0x7A, 0x96, 0xBC, 0xCF, 0x76, 0x8, 0x91, 0xC1, 0x6E, 0x4E, 0x6E, 0xC0, 0xAE, 0xE4, 0x66, 0x9E, 0x4F, 0xED, 0x64, 0x4F, 0xDF, 0x3, 0x7, 0xF5, 0xC4, 0xCD, 0x5E, 0x9C, 0x5E, 0x89, 0x77, 0xA3, 0x3C, 0x41, 0xC4, 0x7F, 0xEA, 0xAE, 0xD8, 0xCE, 0x39, 0x9E, 0x17, 0xDE, 0xE2, 0xAE, 0x7B, 0x2D, 0x9F, 0xF8, 0x27, 0x14, 0xFC, 0x9E, 0xBB, 0xD6, 0x61, 0xD5, 0xEB, 0xAA, 0x1D, 0xBF, 0xC9, 0x7F, 0xE3, 0xD0, 0x1E, 0xFF, 0x6E, 0x7A, 0x6B, 0x3A, 0xDE, 0x17, 0xE2, 0xC0, 0x0, 0x45, 0x95, 0x28, 0xF9, 0x50, 0xFA, 0x1D, 0x42, 0x2, 0x3A, 0xEE, 0x46, 0xB7, 0xD4, 0x3F, 0x8D, 0x15, 0xE4, 0x1C, 0x28, 0xF4, 0x3A, 0x96, 0xB6, 0x2F, 0xDF, 0x19, 0xDE, 0xB7, 0xE1, 0xD, 0x43, 0xB2, 0x58, 0x17, 0xAA, 0xF4, 0xB8, 0xC0, 0xCA, 0x55, 0xD6, 0x2D, 0x16, 0xD6, 0xFD, 0x66, 0xA3, 0xAD, 0xBF, 0x81, 0x68, 0x6B, 0x6E, 0x6D, 0x2F, 0x2E, 0xEB, 0x8F, 0xDE, 0x6A, 0x96, 0x31, 0x3D, 0x47, 0x98, 0xC5, 0x51, 0x9D, 0x50, 0x70, 0x54, 0x3F, 0xA6, 0xE9, 0x31, 0xDF, 0x84, 0xBB, 0xA7, 0x13, 0x4, 0xB4, 0xCE, 0xC3, 0xA6, 0x4B, 0x6, 0x56, 0xF7, 0x1C, 0x98, 0xAC, 0x81, 0xDE, 0x43, 0xF1, 0x61, 0xE9, 0xD2, 0xF3, 0x2A, 0x63, 0x87, 0x10, 0x85, 0xF1, 0xB0, 0x34, 0xD3, 0xF0, 0xB8, 0xF4, 0xC6, 0x4F, 0xC7, 0xA5, 0xBA, 0x24, 0xF1, 0x57, 0xBA, 0x2A, 0xF1, 0xE2, 0x7B, 0x1E, 0x70, 0x85, 0xB0, 0x25, 0x23, 0x9, 0x89, 0xD4, 0x78, 0xF8, 0x1F, 0xDD, 0xB7, 0x70, 0xCB, 0xF5, 0xF0, 0xCD, 0x8F, 0xEE, 0x1B, 0xB9, 0xE6, 0x7A, 0xF8, 0xA0, 0x17, 0x6E, 0xD2, 0x1D, 0x40, 0x69, 0xC, 0x4, 0x2D, 0x78, 0x8B, 0x44, 0x4, 0xDA, 0x77, 0x70, 0x21, 0xF6, 0xC5, 0xF0, 0xFA, 0x67, 0xF7, 0xD4, 0x9D, 0xA0, 0xBD, 0xE, 0xC, 0xD0, 0x37, 0xCF, 0xEE, 0xF9, 0x8, 0x96, 0x24, 0x29, 0x11, 0x43, 0xA4, 0x8, 0xF5, 0xB9, 0x9F, 0x50, 0x81, 0xEE, 0xE7, 0x24, 0xB9, 0xC7, 0x25, 0xA4, 0xFC, 0xFB, 0xE1, 0x3F, 0xF3, 0x7F, 0xD6, 0xFF, 0xE3, 0x2, 0xDB, 0xD, 0x27, 0x88, 0xF4, 0x44, 0x50, 0x6C, 0xF2, 0xBE, 0xA0, 0x80, 0xBC, 0x7D, 0x41, 0x31, 0x77, 0x66, 0xF1, 0x61, 0x91, 0x2D, 0xC5, 0x43, 0x67, 0xD3, 0xBE, 0x9E, 0x44, 0x35, 0x99, 0x5F, 0x83, 0x6A, 0x4D, 0x20, 0xD7, 0x6B, 0xD7, 0x91, 0x61, 0x1A, 0xF7, 0x8D, 0x37, 0x1D, 0x35, 0xA6, 0xB8, 0x60, 0x6, 0xD5, 0x6E, 0x5E, 0x67, 0x13, 0xC1, 0xEE, 0x24, 0x35, 0x89, 0x60, 0x83, 0xEA, 0x46, 0xA3, 0x5C, 0x36, 0x3D, 0xF8, 0x8B, 0x6, 0x3F, 0x1F, 0x4E, 0x2C, 0x53, 0xAE, 0x28, 0xD6, 0x8B, 0x2, 0x3D, 0x3B, 0xB, 0xAA, 0x57, 0x34, 0xC9, 0x27, 0xA7, 0x7, 0x8E, 0x9E, 0x9D, 0x6, 0xD5, 0x93, 0xB0, 0x3, 0x89, 0x10, 0xB6, 0xFF, 0x88, 0x94, 0xE1, 0x3C, 0x60, 0x95, 0x44, 0x5, 0xBF, 0x35, 0xC7, 0x11, 0x2C, 0x20, 0x70, 0x50, 0x5F, 0x24, 0xD7, 0x6D, 0xD8, 0x71, 0xB8, 0x68, 0x43, 0x24, 0x5F, 0x4C, 0xA6, 0x12, 0xF5, 0x4E, 0x80, 0x17, 0xA2, 0xE, 0xAF, 0xF3, 0x66, 0x71, 0xAC, 0xBC, 0xCE, 0x9A, 0xC1, 0x81, 0x32, 0xE6, 0x4A, 0x17, 0xEC, 0x30, 0x5A, 0xB9, 0x20, 0xC4, 0x61, 0x6C, 0x2F, 0x42, 0x10, 0xD5, 0x1B, 0x5, 0x56, 0xC8, 0xA0, 0xCC, 0x97, 0xC9, 0x55, 0xA5, 0x49, 0x4, 0x15, 0x15, 0x69, 0x82, 0x32, 0xF9, 0xDA, 0xA5, 0x89, 0xA0, 0xE2, 0x24, 0x33, 0xD1, 0x8B, 0x23, 0x38, 0xCA, 0x2, 0x87, 0xB0, 0xB1, 0x2A, 0x3F, 0x44, 0x22, 0x41, 0xCD, 0x10, 0x31, 0xAB, 0x66, 0xF6, 0xE4, 0xC7, 0xF3, 0x22, 0xB3, 0x53, 0x81, 0x32, 0x8D, 0xEE, 0xA2, 0xC2, 0x80, 0x9B, 0xDC, 0x8F, 0x46, 0x3C, 0x20, 0xDF, 0x61, 0xF4, 0x80, 0x7C, 0xA7, 0xB6, 0xD9, 0xAB, 0x96, 0x83, 0xA6, 0xE6, 0xA6, 0x65, 0x67, 0xC9, 0x28, 0xB5, 0xF4, 0x8E, 0xB0, 0x4E, 0xE3, 0x17, 0x4F, 0x64, 0xED, 0x34, 0x81, 0x32, 0xBA, 0xDA, 0x4, 0xC2, 0xF5, 0xB4, 0xB9, 0x67, 0xEA, 0x58, 0x25, 0xB8, 0x42, 0x81, 0x7D, 0x99, 0xC5, 0x48, 0x95, 0x96, 0x76, 0x14, 0x2C, 0xB3, 0x14, 0x89, 0x5D, 0xAE, 0xA5, 0x53, 0x29, 0x2C, 0xB8, 0xA5, 0x27, 0x35, 0x64, 0x4A, 0x6B, 0xED, 0x38, 0x7C, 0xBE, 0x16, 0xC9, 0x47, 0x35, 0x98, 0xCC, 0x7D, 0xE5, 0x49, 0x82, 0x5F, 0x59, 0xEE, 0x1C, 0x4B, 0x4E, 0xB9, 0xEE, 0x4, 0xB8, 0x7A, 0xDD, 0xE9, 0xE3, 0xF0, 0xCE, 0xB, 0x4C, 0x14, 0xA8, 0x40, 0xB7, 0xE5, 0x6A, 0xB5, 0x9D, 0x34, 0x15, 0xB5, 0xDA, 0x76, 0x96, 0x38, 0xAD, 0x72, 0xEA, 0xFB, 0xA0, 0xC1, 0xD3, 0xE1, 0xEC, 0x2C, 0x21, 0x5B, 0x54, 0xEA, 0xA4, 0xA9, 0xA8, 0x7C, 0x92, 0x58, 0x64, 0x1D, 0x35, 0x29, 0x5C, 0x43, 0x4D, 0x25, 0x65, 0x32, 0x55, 0xAA, 0x93, 0x49, 0x2D, 0xAA, 0x60, 0xDF, 0x1C, 0x24, 0x15, 0x16, 0xCE, 0xA4, 0x16, 0x72, 0x26, 0x77, 0xD5, 0x78, 0xD2, 0x54, 0x5C, 0x36, 0x9E, 0x2C, 0xF9, 0x29, 0x99, 0x84, 0xD6, 0x4C, 0x4A, 0xAB, 0x5A, 0x5D, 0x1A, 0x9D, 0x91, 0xA6, 0x72, 0x74, 0x5A, 0xFD, 0x4C, 0xB6, 0xB1, 0x58, 0x48, 0xEF, 0x4D, 0x84, 0x17, 0xD3, 0x8B, 0x3C, 0x34, 0x65, 0x57, 0xCF, 0x44, 0x50, 0xB1, 0x7E, 0x4E, 0xCA, 0xA9, 0xE7, 0x56, 0x18, 0x9F, 0x3B, 0x49, 0xCD, 0x28, 0xF5, 0x6A, 0x90, 0xEF, 0xAD, 0x4F, 0x82, 0x3A, 0x81, 0x93, 0x11, 0x2B, 0x80, 0x9C, 0x4, 0x68, 0x11, 0x90, 0x44, 0x1F, 0x57, 0x6F, 0x3F, 0x1A, 0xBE, 0xD4, 0xD9, 0x8B, 0xA2, 0x49, 0x24, 0xA0, 0xC8, 0x6C, 0x5E, 0xC1, 0x9D, 0xA2, 0xCA, 0xFC, 0x9B, 0x54, 0xC8, 0x5B, 0xE2, 0x91, 0xA0, 0xC6, 0x12, 0x8F, 0x63, 0xC7, 0x89, 0x49, 0x87, 0x4E, 0x1C, 0x4C, 0x3C, 0x94, 0xC8, 0xFD, 0x36, 0xE5, 0xD5, 0x51, 0xA2, 0xA8, 0xD1, 0x47, 0x89, 0x21, 0xDB, 0x31, 0xBB, 0xC8, 0x8A, 0x8E, 0x49, 0xAA, 0x5A, 0xB4, 0x30, 0x8E, 0xD5, 0x1, 0xB5, 0x31, 0xE, 0x71, 0xB9, 0xB1, 0xB8, 0xCB, 0xC3, 0xC7, 0xE2, 0x7E, 0xE4, 0x90, 0x53, 0x6B, 0x47, 0x99, 0x71, 0x5C, 0xAD, 0x45, 0x4D, 0x55, 0x52, 0x6B, 0x49, 0x9B, 0x55, 0xA9, 0x35, 0x3D, 0x96, 0xEA, 0x9E, 0x9D, 0x44, 0x35, 0x85, 0xCF, 0x22, 0x4F, 0x50, 0x64, 0xC4, 0x5, 0x57, 0x7, 0xD5, 0x44, 0x18, 0x14, 0x45, 0x4B, 0xAE, 0xD2, 0xFC, 0x49, 0x82, 0x97, 0x9A, 0xF, 0x6A, 0x32, 0xA5, 0x4A, 0xF3, 0x27, 0x4D, 0xDD, 0xFC, 0x96, 0x77, 0x77, 0xF8, 0xE6, 0x6E, 0x32, 0x36, 0xAB, 0x6A, 0x8D, 0xAD, 0x51, 0xB4, 0xC6, 0xD2, 0xA6, 0x4B, 0x1F, 0x1A, 0x69, 0xEA, 0x3E, 0x74, 0xCA, 0x14, 0x24, 0x4F, 0x30, 0x5C, 0x8F, 0x3C, 0xE4, 0xA, 0xA9, 0x86, 0x8A, 0x2A, 0xAA, 0x41, 0x4D, 0x9B, 0x54, 0xE9, 0x32, 0x62, 0x60, 0x91, 0xCB, 0xA0, 0xB8, 0xC4, 0xA5, 0xE9, 0x69, 0x89, 0x71, 0x29, 0xD7, 0xAF, 0x3D, 0x89, 0xEA, 0x36, 0x9C, 0x4B, 0x6E, 0x64, 0x2D, 0x15, 0x63, 0x6B, 0x51, 0xCB, 0xE6, 0x72, 0xBA, 0x6E, 0x27, 0xC0, 0x75, 0xDD, 0xA2, 0x6C, 0xAE, 0x8, 0xE0, 0x4E, 0x50, 0x63, 0xB0, 0x2F, 0xB9, 0xF3, 0xC7, 0xA5, 0xE2, 0xE8, 0x71, 0x37, 0x83, 0x37, 0x97, 0xDF, 0xA1, 0xBD, 0xA8, 0x6A, 0x36, 0x69, 0x8B, 0x7A, 0xEC, 0x7D, 0x67, 0xC5, 0x2E, 0x79, 0x6C, 0xCD, 0x41, 0x1, 0x9, 0x6C, 0x73, 0xF6, 0xDA, 0xD2, 0x56, 0x98, 0x6A, 0xD1, 0xB4, 0x9D, 0x1E, 0xA2, 0x95, 0x9E, 0x2C, 0xDF, 0x84, 0xA3, 0xC2, 0xF2, 0x27, 0x6A, 0x49, 0x5E, 0xCD, 0x91, 0x9A, 0x55, 0xC3, 0xE6, 0x4A, 0x73, 0x64, 0x27, 0xAA, 0x9B, 0x23, 0x56, 0xC, 0x53, 0x3D, 0xB1, 0xF2, 0xE5, 0x64, 0xA2, 0x14, 0x24, 0x40, 0xDC, 0x62, 0x64, 0x67, 0xC4, 0xB0, 0x8B, 0xD6, 0xA0, 0x2C, 0xC5, 0xFD, 0xA8, 0xE8, 0x72, 0x7A, 0x12, 0xA0, 0x4E, 0xA7, 0x41, 0x51, 0xAB, 0xD8, 0x8F, 0x4A, 0xCF, 0x4B, 0xCC, 0x4E, 0x2D, 0x2A, 0xBB, 0xFA, 0x46, 0x82, 0x9A, 0xB5, 0xD7, 0x29, 0x1B, 0xD8, 0x4C, 0x9F, 0x7, 0x84, 0x1C, 0x7D, 0x39, 0x25, 0x6B, 0x7A, 0xA7, 0x60, 0x4D, 0xEF, 0x6, 0xF6, 0xF6, 0x6B, 0x7F, 0x5E, 0x66, 0x66, 0x7D, 0xC7, 0x12, 0x0, 0xDC, 0xBF, 0x29, 0x67, 0xA9, 0x75, 0x4F, 0x3D, 0x8, 0x42, 0xE, 0x14, 0x92, 0xB4, 0x65, 0xCE, 0xDB, 0xDC, 0x86, 0x1F, 0xB7, 0xB9, 0x6D, 0x31, 0x71, 0x67, 0x98, 0x49, 0x22, 0x7A, 0x12, 0xD5, 0x64, 0x12, 0xD, 0xCA, 0x2B, 0xE6, 0xAA, 0x33, 0x28, 0xDF, 0xA8, 0xD2, 0x4D, 0x67, 0x50, 0x5E, 0xF7, 0x9B, 0xC4, 0xAE, 0xFB, 0xD, 0x12, 0x31, 0xD0, 0x4D, 0x12, 0x31, 0xD0, 0xD, 0x12, 0x31, 0x4E, 0xDA, 0x6, 0x2E, 0x8E, 0xE4, 0xC4, 0x80, 0x6C, 0x71, 0x41, 0x85, 0x74, 0x38, 0xC2, 0x15, 0x98, 0xFD, 0xC0, 0x4A, 0xEF, 0xC2, 0xA6, 0x14, 0xD9, 0x1F, 0x17, 0x59, 0x75, 0xAB, 0x1E, 0x69, 0x9B, 0x2C, 0xDB, 0x0, 0x2F, 0x1A, 0xDC, 0xC, 0x8, 0xDA, 0x8F, 0xC6, 0x66, 0xF7, 0x3C, 0x7, 0x49, 0xC5, 0xA6, 0x27, 0xA4, 0x9B, 0xD3, 0x8C, 0x6D, 0xB8, 0x13, 0xE0, 0xF6, 0xE1, 0x4E, 0x9F, 0x3B, 0x6D, 0x39, 0x24, 0xE2, 0xC7, 0x2D, 0xC1, 0x6F, 0x59, 0x6B, 0x3D, 0xE1, 0xF8, 0xEA, 0x9D, 0xC8, 0xB3, 0xAD, 0x98, 0xE4, 0x55, 0xB4, 0x61, 0x20, 0x56, 0xF5, 0xA7, 0xE7, 0x25, 0xE6, 0x7B, 0xD4, 0xED, 0x14, 0x48, 0x3E, 0x5F, 0x38, 0x29, 0xE0, 0x13, 0x6, 0xC6, 0xE1, 0x2A, 0x3E, 0x2C, 0xB2, 0xB1, 0xB7, 0x53, 0xDF, 0xE5, 0xBB, 0xA9, 0x6F, 0xC3, 0xE5, 0xE5, 0x8A, 0xCF, 0xCB, 0x89, 0xB9, 0x42, 0xCB, 0x78, 0xEF, 0x85, 0xA6, 0x2D, 0xE5, 0x93, 0xE, 0xAD, 0x9, 0x9E, 0xF3, 0xD3, 0x49, 0x0, 0xE0, 0xA4, 0xC3, 0xE5, 0xB9, 0x28, 0xA6, 0xB9, 0x8, 0x6C, 0xDD, 0xBE, 0x0, 0x94, 0xED, 0xB, 0x1D, 0xD7, 0xBA, 0x5D, 0xB1, 0x71, 0x3B, 0x31, 0x78, 0x3F, 0x74, 0x60, 0xD8, 0x7E, 0xE8, 0xD6, 0xFF, 0xB2, 0x2D, 0xD5, 0xAD, 0xFF, 0x45, 0x1A, 0x8A, 0x49, 0xB5, 0x12, 0xA4, 0xD4, 0x30, 0xAF, 0xDF, 0xE4, 0x2, 0xAD, 0x83, 0x18, 0x63, 0xFD, 0x62, 0xD3, 0xAA, 0x1B, 0xB5, 0xBF, 0x3C, 0x68, 0x50, 0x6C, 0x74, 0xE4, 0x27, 0x1, 0x12, 0x1E, 0x19, 0xD8, 0x40, 0xDA, 0x50, 0xE, 0xA3, 0xD, 0x9A, 0xEF, 0x6A, 0x8D, 0xF4, 0xB5, 0xE6, 0x3C, 0x64, 0xD3, 0xD3, 0x32, 0xE3, 0xEE, 0x65, 0xC6, 0xB3, 0x1F, 0x18, 0x2A, 0x44, 0x1C, 0x39, 0x2F, 0xA, 0x70, 0x0, 0xE9, 0x6E, 0x31, 0x5C, 0xE8, 0x6B, 0x6C, 0x8D, 0xC5, 0xC8, 0x41, 0xAF, 0x1F, 0x2, 0xD2, 0xEF, 0x19, 0xD5, 0x72, 0x8A, 0xF2, 0x85, 0x36, 0x6D, 0x51, 0x5F, 0xEA, 0x8E, 0x8B, 0x60, 0x8F, 0x2F, 0x52, 0x8C, 0x5F, 0xF, 0x9A, 0x35, 0x88, 0x35, 0x60, 0xB, 0x6B, 0xCE, 0x7C, 0xD4, 0x45, 0xA3, 0x51, 0xCF, 0x5A, 0x2D, 0x9B, 0xE5, 0xE3, 0x90, 0x23, 0xDA, 0x24, 0x18, 0xF1, 0x50, 0x8E, 0xD4, 0xAD, 0x9, 0x6A, 0xE5, 0x96, 0x88, 0x1D, 0x6B, 0x22, 0x88, 0x89, 0xE1, 0x7C, 0x13, 0x4F, 0x29, 0xE5, 0xEC, 0x76, 0x7, 0xE5, 0xF7, 0x83, 0xF1, 0xF, 0xFC, 0xAB, 0x39, 0xE5, 0x44, 0xF8, 0x53, 0x10, 0xE3, 0xE2, 0x18, 0x49, 0x27, 0x5A, 0x96, 0xCD, 0x9A, 0x29, 0x9B, 0x8C, 0xE9, 0x37, 0x42, 0x74, 0xE0, 0xCE, 0x3A, 0x9F, 0xD1, 0xFB, 0x4D, 0x1, 0x4B, 0xC, 0xAC, 0x5A, 0x98, 0x9B, 0x50, 0x56, 0xB, 0x8B, 0xF2, 0x9A, 0xD3, 0x8B, 0x7, 0x50, 0x64, 0x5F, 0x2F, 0xBD, 0xE5, 0xD3, 0xF3, 0x9E, 0x20, 0xA6, 0x13, 0x97, 0xD5, 0xD0, 0x85, 0xE6, 0x96, 0xAF, 0x15, 0xFE, 0x89, 0x23, 0x73, 0x23, 0x52, 0x17, 0x84, 0x55, 0xC8, 0xDA, 0xDC, 0x3C, 0x12, 0x97, 0xE5, 0xE2, 0x0, 0x9B, 0x84, 0x16, 0x3F, 0xD1, 0xF6, 0x94, 0xBD, 0x90, 0x8E, 0x4, 0x35, 0xB7, 0xD1, 0xDA, 0x6A, 0x93, 0x3F, 0x50, 0x89, 0x14, 0x55, 0xA7, 0x29, 0xDA, 0x4E, 0xEA, 0xAE, 0x44, 0xBB, 0x6F, 0x87, 0x51, 0xA3, 0x4F, 0x5B, 0x6B, 0x58, 0xD, 0x9D, 0x9E, 0x17, 0x99, 0x33, 0xF7, 0x5B, 0x11, 0x84, 0xDF, 0x82, 0xF8, 0x45, 0x93, 0x90, 0x45, 0x33, 0x5A, 0xB9, 0xFC, 0x10, 0x8A, 0x8, 0x32, 0x7A, 0xDC, 0xA2, 0x9F, 0xEC, 0xEE, 0x31, 0x21, 0xC8, 0xDE, 0x51, 0x3B, 0xCB, 0xD6, 0x4C, 0x4F, 0x0, 0xF4, 0xA, 0x96, 0x56, 0x33, 0x73, 0xF1, 0xBD, 0x27, 0x56, 0x8E, 0xF1, 0x4D, 0xBB, 0xB8, 0x49, 0xD, 0xF9, 0xBB, 0x93, 0x93, 0xA8, 0xE6, 0x4E, 0x41, 0xFB, 0x70, 0x31, 0x5E, 0xBB, 0x1B, 0xEB, 0x8, 0xF8, 0x42, 0x11, 0x47, 0x40, 0xED, 0x3, 0x97, 0xC4, 0x3C, 0x3E, 0x7, 0xF2, 0xC1, 0x47, 0xB2, 0xC0, 0x94, 0xCE, 0x4E, 0xEC, 0xA1, 0x54, 0x3F, 0x3B, 0x68, 0xBE, 0x81, 0x91, 0xA6, 0xBD, 0xB1, 0x9C, 0xB7, 0x32, 0xE3, 0xB7, 0x12, 0x93, 0xAF, 0x26, 0x10, 0x5D, 0x40, 0xBE, 0x83, 0x91, 0x4B, 0xCD, 0x1E, 0x30, 0x28, 0x8B, 0x9, 0xAC, 0xD, 0xA5, 0xB0, 0xDA, 0xC0, 0x99, 0x22, 0xC5, 0xDD, 0xD6, 0x40, 0xEE, 0xC1, 0x9E, 0xA1, 0x45, 0x0, 0x38, 0x3E, 0xE3, 0xEC, 0xE1, 0xA2, 0x35, 0x3C, 0xAA, 0x70, 0xE7, 0x62, 0xB0, 0x23, 0xD0, 0xDC, 0xCB, 0x1, 0xD8, 0x61, 0xE4, 0xB6, 0x2E, 0x63, 0x71, 0xEF, 0x32, 0x6A, 0x15, 0xB8, 0xD2, 0x84, 0x27, 0x0, 0xB1, 0x8B, 0x3D, 0xBC, 0xC3, 0x60, 0xF, 0x47, 0x62, 0x2E, 0x77, 0xD6, 0x9, 0x14, 0xD9, 0x59, 0x7D, 0x3C, 0x6A, 0x40, 0x1F, 0x8F, 0xFA, 0x4E, 0x6E, 0x5A, 0x3B, 0xDE, 0xDE, 0x38, 0x51, 0xC8, 0xE0, 0x18, 0x8D, 0x1D, 0xFA, 0xCD, 0x87, 0x5E, 0x5, 0x61, 0x5B, 0xF7, 0x49, 0x81, 0x89, 0x74, 0xDB, 0xA8, 0x1D, 0x1F, 0x5B, 0x7F, 0x82, 0x50, 0x64, 0x85, 0x51, 0x9C, 0x42, 0x88, 0x4F, 0xB, 0xED, 0x63, 0xBA, 0x49, 0x7, 0x6E, 0xC9, 0x3D, 0x0, 0x88, 0x9D, 0x35, 0xC4, 0x77, 0x8, 0x31, 0xC4, 0x8D, 0xB6, 0x5C, 0xEA, 0xEC, 0xE3, 0x79, 0xE9, 0xD, 0xAE, 0x57, 0xC5, 0x55, 0xC1, 0x3A, 0x81, 0x12, 0xFB, 0xA2, 0x27, 0xAD, 0x57, 0x63, 0xC5, 0xFC, 0x5B, 0x1F, 0x24, 0xE0, 0x70, 0x37, 0x8B, 0xAC, 0x19, 0xCD, 0x82, 0xAA, 0x45, 0xB3, 0xB0, 0x49, 0xA, 0x93, 0x84, 0xF2, 0x5, 0x93, 0xB1, 0xC3, 0x8D, 0x66, 0x36, 0xC2, 0xFF, 0xC4, 0x20, 0x29, 0x6C, 0x10, 0x5F, 0x7C, 0x8E, 0x70, 0xAF, 0xEC, 0xC0, 0x5A, 0xCB, 0xA3, 0xCA, 0x71, 0x69, 0x22, 0xF6, 0xC7, 0x45, 0x56, 0xE2, 0x14, 0x7C, 0x7A, 0x5C, 0x60, 0xE5, 0x16, 0xF2, 0xE2, 0x1A, 0xFE, 0xC5, 0x45, 0x78, 0x86, 0xAF, 0x72, 0x84, 0x67, 0xF8, 0xD2, 0x73, 0x2F, 0x25, 0x2C, 0x79, 0x81, 0x40, 0x2B, 0x7F, 0x2D, 0xEA, 0x9B, 0x3B, 0x2B, 0xDD, 0x9F, 0x97, 0x98, 0x49, 0x18, 0x65, 0x11, 0x40, 0x7E, 0x9C, 0xFD, 0xE5, 0xE2, 0xCF, 0x72, 0xDB, 0xC7, 0xE2, 0xCE, 0x71, 0x12, 0x3E, 0x74, 0x42, 0x3E, 0x74, 0xF2, 0x2A, 0xB0, 0xC7, 0x36, 0x11, 0x0, 0x4E, 0x6C, 0xB8, 0x41, 0x55, 0x1C, 0x51, 0x33, 0xD9, 0xA3, 0x82, 0x0, 0xC7, 0x4D, 0xB6, 0x39, 0xD1, 0x92, 0x86, 0xE4, 0x34, 0x49, 0x51, 0x81, 0x2C, 0x6A, 0xE6, 0xDE, 0x3A, 0x3D, 0x2E, 0xB1, 0x76, 0x9C, 0x46, 0x5D, 0x9A, 0xAE, 0xA8, 0x4F, 0x17, 0xAE, 0xA2, 0x71, 0x58, 0xCA, 0x5, 0x8D, 0xC3, 0x72, 0xE5, 0x16, 0xF9, 0xA5, 0xB9, 0x16, 0xD7, 0xF8, 0x85, 0x8B, 0x8E, 0xC, 0x4B, 0x39, 0x36, 0x32, 0x2C, 0x13, 0xDB, 0xB2, 0xCD, 0x54, 0x6E, 0xDC, 0x85, 0x3B, 0x79, 0x5F, 0x9A, 0xA5, 0x78, 0xF8, 0xBE, 0x2C, 0x9C, 0xED, 0xBA, 0x34, 0x4B, 0xD1, 0x7C, 0x65, 0xEB, 0xDC, 0x5, 0xA0, 0xC8, 0x5D, 0x58, 0x56, 0xD6, 0x82, 0x5A, 0x56, 0xC0, 0x82, 0x5A, 0x58, 0x8B, 0x79, 0x1, 0xCC, 0xE5, 0x85, 0x4B, 0xBE, 0x18, 0x96, 0x72, 0x8E, 0xD0, 0xB0, 0x70, 0x19, 0x94, 0xC2, 0x52, 0x2E, 0x5A, 0x12, 0x96, 0x27, 0x67, 0x49, 0x2C, 0xCD, 0xB3, 0x68, 0x48, 0x70, 0x7A, 0xBC, 0xA8, 0xC4, 0x2D, 0xBB, 0xCD, 0xB6, 0xC0, 0x1E, 0xDB, 0xD2, 0xB7, 0x12, 0x52, 0xC0, 0x7, 0xDB, 0xD0, 0xB7, 0x62, 0xF2, 0xBF, 0x73, 0xAA, 0xC1, 0xB2, 0x47, 0xDA, 0x16, 0x38, 0xCE, 0xE6, 0x3A, 0xA7, 0xD8, 0x33, 0xD4, 0x4A, 0xA9, 0x5D, 0x22, 0x4, 0x3A, 0x97, 0x51, 0xAB, 0xF5, 0x68, 0x16, 0x49, 0x8C, 0x6E, 0x12, 0x8A, 0x9, 0x2A, 0x94, 0x74, 0xF, 0xD5, 0x25, 0xDD, 0x3, 0xB5, 0x26, 0x1D, 0x7E, 0xC9, 0xA1, 0x35, 0x27, 0x5, 0x1C, 0x5B, 0x93, 0x89, 0x5, 0x80, 0x3, 0x0, 0x76, 0x42, 0x40, 0x54, 0x9D, 0x48, 0xCA, 0x45, 0x7, 0x26, 0x82, 0x8A, 0xE8, 0x40, 0xEA, 0x73, 0x35, 0xA5, 0x23, 0x8C, 0xE7, 0x78, 0x8C, 0xD4, 0xDC, 0x2A, 0x94, 0x1E, 0x97, 0x58, 0xB5, 0xC9, 0xF4, 0x9E, 0x36, 0x78, 0xC7, 0xD, 0x2A, 0x1D, 0x85, 0x66, 0x2E, 0xDA, 0x4F, 0x12, 0xFC, 0xAA, 0x9D, 0x6, 0x65, 0xB5, 0xEC, 0xAC, 0x90, 0x60, 0xD8, 0x55, 0x81, 0x86, 0x9C, 0x9B, 0x2, 0xD, 0x15, 0x2E, 0xA, 0x34, 0x98, 0xB0, 0x67, 0xDD, 0xF3, 0x92, 0x17, 0xE4, 0x4E, 0xD2, 0x9C, 0x34, 0x90, 0xD0, 0xD9, 0xA8, 0x10, 0xC, 0x7B, 0xA2, 0x79, 0x82, 0xC8, 0xA1, 0x26, 0xD, 0x94, 0xE9, 0xD3, 0x81, 0x2A, 0xFA, 0x94, 0x2F, 0x36, 0x18, 0x8, 0x2B, 0x36, 0x18, 0x68, 0xE0, 0x94, 0x3E, 0x35, 0x43, 0x79, 0x9F, 0x4E, 0xC3, 0xE6, 0x72, 0xBE, 0x2F, 0x9, 0xAF, 0x18, 0x49, 0xE3, 0x92, 0x3F, 0x5E, 0x8D, 0x4, 0x55, 0xBA, 0x6F, 0xB4, 0xE4, 0xC7, 0x8C, 0x97, 0xF5, 0x41, 0x80, 0xF, 0xCF, 0x91, 0x72, 0x61, 0x2C, 0x34, 0x52, 0x45, 0x10, 0xB, 0x7F, 0xB0, 0x8E, 0x1C, 0xAA, 0xD3, 0xA4, 0x72, 0x1A, 0x61, 0x52, 0x15, 0x2A, 0x61, 0x52, 0x59, 0x47, 0xE1, 0x24, 0xC, 0x77, 0x11, 0xA6, 0x69, 0xF7, 0x5C, 0xCA, 0xAA, 0xE1, 0x83, 0xA6, 0x46, 0x13, 0xA7, 0x20, 0xA6, 0x29, 0x1B, 0xD, 0x71, 0xD2, 0x54, 0xB8, 0x64, 0xD2, 0x64, 0x6, 0x75, 0xCF, 0x2B, 0xF9, 0x9D, 0xA4, 0x46, 0xD1, 0x4F, 0xB2, 0x53, 0x22, 0x4D, 0xB0, 0x4F, 0xA2, 0x54, 0xD6, 0x1, 0xAC, 0xEA, 0x10, 0x68, 0x22, 0xAB, 0x16, 0x9D, 0x1B, 0x26, 0x89, 0xA0, 0x62, 0xA4, 0x90, 0xA3, 0x67, 0xB1, 0xB9, 0xE, 0xA2, 0xAA, 0x6, 0xCB, 0xDC, 0xE0, 0x11, 0x7E, 0x77, 0x77, 0x92, 0x82, 0x2, 0x2B, 0x45, 0x9F, 0x79, 0x57, 0xE4, 0x84, 0x26, 0x7, 0x51, 0x55, 0x4A, 0x13, 0x9A, 0x1E, 0x7C, 0x1F, 0x3F, 0x80, 0xE, 0x16, 0xA2, 0x5, 0xC0, 0x40, 0x1, 0x12, 0xA, 0xB0, 0x6, 0x2, 0xB, 0xB0, 0x6, 0x9A, 0x7, 0xDE, 0x6B, 0x3C, 0x2, 0xC8, 0x10, 0x5D, 0xD4, 0x44, 0x8B, 0xCA, 0xA7, 0x9A, 0x38, 0x89, 0xAA, 0xF4, 0xFE, 0xA2, 0x5C, 0x51, 0xAA, 0xAB, 0x14, 0xC9, 0x6D, 0x6D, 0xA8, 0x59, 0xCA, 0x7B, 0x1A, 0x5A, 0x74, 0x26, 0xC7, 0x0, 0x2D, 0x1A, 0x4F, 0x31, 0x40, 0x8B, 0x19, 0x36, 0x9F, 0x56, 0x98, 0x8C, 0x22, 0x3C, 0x89, 0x6A, 0x34, 0xE1, 0x62, 0x26, 0x39, 0xB2, 0x30, 0xA1, 0xB0, 0xE2, 0x5A, 0xB2, 0xF6, 0xE5, 0x52, 0x63, 0x5A, 0x2E, 0x86, 0xA6, 0x4C, 0xD4, 0xCE, 0x8E, 0xC3, 0xDE, 0xBA, 0xB4, 0x18, 0xBF, 0x47, 0x32, 0x4B, 0xE6, 0xC0, 0x4E, 0x80, 0x9B, 0x3, 0xB1, 0xE3, 0xB2, 0x7D, 0x6B, 0x1E, 0x15, 0x9, 0x24, 0x68, 0xA1, 0x56, 0x67, 0x17, 0xE3, 0x48, 0x50, 0xB3, 0x1A, 0x5B, 0x55, 0x58, 0x33, 0x23, 0x41, 0xCD, 0x30, 0xD9, 0x13, 0xE9, 0xCB, 0x6, 0xFA, 0x91, 0x47, 0x1F, 0x35, 0xD1, 0x6D, 0xAF, 0xE, 0x97, 0x6B, 0x39, 0xF4, 0xF6, 0x83, 0x8, 0x8E, 0xBE, 0x25, 0x9B, 0x6F, 0x47, 0x5B, 0xD7, 0x8A, 0x24, 0xC7, 0xA4, 0x90, 0x25, 0x38, 0x1E, 0x25, 0x25, 0xF2, 0xA5, 0x4C, 0x86, 0x82, 0x9D, 0x0, 0xCF, 0x4F, 0x40, 0xD6, 0x4B, 0xC5, 0xA4, 0x4F, 0xC, 0x51, 0xC3, 0x34, 0x5F, 0xC9, 0xD, 0x82, 0x47, 0xDC, 0x89, 0x42, 0xE, 0x71, 0x44, 0x33, 0x77, 0x7E, 0x93, 0x1E, 0x17, 0xDF, 0xC2, 0x66, 0x4E, 0x51, 0xC8, 0xE2, 0xA7, 0x28, 0xAC, 0x0, 0x80, 0x2D, 0xEF, 0x9F, 0x79, 0xE0, 0xE0, 0x75, 0x17, 0x39, 0x15, 0x5F, 0x57, 0x8E, 0xFE, 0x3E, 0x8, 0xE0, 0xE0, 0x6F, 0x72, 0xFC, 0xF1, 0x81, 0x3, 0x8E, 0xF, 0x9C, 0xE9, 0xF3, 0xD3, 0x21, 0x12, 0xD4, 0x4C, 0x8, 0x67, 0xAC, 0xB0, 0x79, 0x89, 0x8, 0xB2, 0x81, 0x89, 0x74, 0x72, 0x53, 0x27, 0x29, 0x68, 0x43, 0xAF, 0x8E, 0xBE, 0x74, 0x27, 0xE4, 0xF3, 0x7B, 0xC1, 0xD0, 0xBD, 0x33, 0x39, 0x3F, 0xF1, 0x89, 0x3E, 0xF, 0x8, 0x99, 0x4D, 0x5E, 0x89, 0xA9, 0xBF, 0x23, 0x88, 0x26, 0xFE, 0x8E, 0xB4, 0xD1, 0x3C, 0x35, 0xCF, 0x2D, 0x2B, 0xEF, 0xA0, 0xA9, 0x10, 0xDB, 0x29, 0xCB, 0xBB, 0x5, 0xEE, 0x10, 0xD2, 0x7D, 0x3E, 0x2E, 0x90, 0xAC, 0x84, 0x4, 0x0, 0xEC, 0xB9, 0x70, 0x57, 0x7A, 0x19, 0xE8, 0x98, 0x21, 0xCB, 0x96, 0xF6, 0xE, 0x4, 0xD4, 0xF6, 0xE, 0xE4, 0xE5, 0xD0, 0x69, 0xF2, 0x70, 0xE8, 0x34, 0x5, 0xC5, 0x5D, 0x2B, 0xA4, 0xC7, 0x45, 0xD6, 0x20, 0x85, 0x93, 0xEF, 0x10, 0xF4, 0xF3, 0x91, 0x10, 0x10, 0x55, 0x21, 0x72, 0xEB, 0x46, 0x79, 0x82, 0x6E, 0xDD, 0x88, 0xCE, 0xCF, 0xCD, 0x19, 0xCF, 0x5F, 0xBA, 0xEC, 0x10, 0x70, 0xF3, 0xB2, 0x13, 0xB2, 0x57, 0x1A, 0x87, 0x8C, 0xF2, 0xD5, 0x6, 0x6D, 0x6C, 0xEC, 0x59, 0x7A, 0x5C, 0x62, 0xBD, 0xAB, 0x5E, 0x65, 0xA2, 0xFE, 0x13, 0x8E, 0x87, 0xFD, 0xD3, 0x9D, 0xFD, 0x90, 0x7B, 0xF9, 0x13, 0xEE, 0xB2, 0xCB, 0x34, 0xDD, 0x51, 0x67, 0x69, 0xBA, 0x5B, 0xAB, 0xEF, 0xFE, 0x72, 0x54, 0x3B, 0xE7, 0x5D, 0x7F, 0x7E, 0x21, 0x82, 0xBC, 0x7F, 0x48, 0xB8, 0x19, 0xA6, 0x6, 0xBA, 0x14, 0xA6, 0x47, 0xE1, 0xEC, 0xEF, 0x51, 0x77, 0xF4, 0xF7, 0x50, 0x13, 0x95, 0x4, 0x4E, 0x54, 0x29, 0x52, 0x32, 0x7B, 0x51, 0x7B, 0xB7, 0x10, 0x86, 0x47, 0x75, 0x41, 0x78, 0xF4, 0x20, 0x35, 0xE5, 0x4E, 0x78, 0x12, 0x8E, 0x1F, 0xF0, 0x3C, 0x28, 0xA5, 0x19, 0xCB, 0xA, 0xDC, 0x13, 0x91, 0x55, 0x88, 0x9C, 0xD4, 0x3D, 0x77, 0xF7, 0xB4, 0x13, 0x54, 0x9, 0x94, 0x57, 0xC3, 0x7, 0x5C, 0xE8, 0x29, 0xD2, 0x5A, 0x95, 0x4B, 0xD1, 0x7A, 0x10, 0xE0, 0x5B, 0x8E, 0x7, 0xD9, 0x7C, 0x5F, 0xD8, 0xAA, 0xAE, 0xB0, 0x94, 0x7F, 0x37, 0xAA, 0x79, 0x33, 0xCA, 0xE5, 0xC5, 0xDA, 0x71, 0x38, 0x56, 0x93, 0x1E, 0x14, 0x37, 0xC9, 0x19, 0xD3, 0x76, 0x27, 0xC0, 0x4D, 0xDB, 0x7, 0x5, 0xD6, 0x98, 0x48, 0xCF, 0x11, 0xE6, 0x6C, 0xB3, 0x87, 0xAA, 0xD1, 0x15, 0x4A, 0x87, 0x7C, 0x49, 0x60, 0xD5, 0x11, 0xDF, 0x83, 0x2, 0x2D, 0x85, 0xE3, 0xA8, 0x9D, 0xA6, 0x4E, 0x1B, 0x51, 0xF0, 0x5B, 0xF6, 0x36, 0x22, 0x51, 0xD4, 0x5C, 0x48, 0x3C, 0x68, 0x73, 0x25, 0x2D, 0x1C, 0x49, 0xEA, 0xDE, 0x93, 0x5D, 0x77, 0x1F, 0xE5, 0x65, 0xF7, 0xA9, 0xEE, 0xF9, 0x4, 0x86, 0xFB, 0x41, 0x73, 0x45, 0xC0, 0x8, 0x3D, 0x7D, 0xAF, 0x59, 0xF7, 0x9A, 0x84, 0x0, 0x2E, 0x36, 0x2B, 0x6B, 0x6A, 0xAE, 0x80, 0xA5, 0xC9, 0x9D, 0xB4, 0x16, 0xEF, 0xE6, 0x9C, 0x62, 0x83, 0x2, 0xD2, 0x63, 0x80, 0xD5, 0xF1, 0x15, 0x43, 0x77, 0xC, 0x29, 0x18, 0x1A, 0x29, 0x8D, 0xE5, 0x8E, 0x5, 0xE, 0x0, 0x62, 0x97, 0xDF, 0x22, 0xDA, 0x16, 0xD8, 0x6B, 0xE8, 0x6D, 0x9, 0x83, 0x8E, 0x2F, 0x2E, 0xB4, 0xC8, 0x9B, 0x0, 0x89, 0x94, 0x70, 0xEA, 0x7A, 0x35, 0xDD, 0x3A, 0x1F, 0x95, 0x9F, 0x59, 0x89, 0x27, 0x5, 0xE8, 0xBA, 0xE2, 0x94, 0xB1, 0x99, 0x16, 0x8F, 0x20, 0xF8, 0xAD, 0xC6, 0x4A, 0x6D, 0xBE, 0x23, 0x45, 0x1, 0x13, 0x37, 0x5B, 0x9C, 0x6A, 0xA6, 0xE2, 0xC, 0x71, 0x52, 0x22, 0x18, 0x87, 0x65, 0x82, 0x71, 0xCA, 0xB6, 0xF, 0x49, 0x40, 0xFB, 0xC0, 0x24, 0x98, 0x8B, 0xB2, 0xBD, 0x33, 0xEA, 0xD2, 0x2A, 0x37, 0xEB, 0x20, 0xA4, 0xA, 0xE6, 0x8, 0x91, 0x79, 0x18, 0xD9, 0x4E, 0xFA, 0x83, 0x5F, 0x96, 0xFF, 0xB, 0x21, 0x28, 0x7F, 0x27, 0x2E, 0xBE, 0xFD, 0xCF, 0x64, 0xA0, 0xEC, 0x93, 0xBC, 0xF0, 0xE6, 0x3F, 0x93, 0x61, 0xB2, 0x49, 0xEC, 0x39, 0x2, 0x7B, 0xCE, 0xCF, 0x2A, 0xE8, 0x81, 0x88, 0x1F, 0xBD, 0x3B, 0xDC, 0x24, 0xBC, 0x3C, 0x9B, 0xAC, 0x5F, 0xC9, 0x5, 0xCD, 0xBA, 0xB9, 0xBE, 0x50, 0xC0, 0xD7, 0xF5, 0xA4, 0x15, 0xF7, 0x4E, 0x27, 0x1, 0xB8, 0x81, 0x8A, 0xE4, 0x9B, 0x9B, 0x5B, 0x46, 0xD, 0xFD, 0xD5, 0x9C, 0x68, 0xD3, 0xFE, 0xAC, 0x83, 0xB8, 0x40, 0xDB, 0x83, 0x54, 0x33, 0xEB, 0xD1, 0x87, 0x20, 0xBD, 0xA1, 0x82, 0x2C, 0xB3, 0xC9, 0xFE, 0x10, 0x64, 0x67, 0x40, 0xD0, 0xAA, 0x84, 0x58, 0xBB, 0x1D, 0x82, 0xC6, 0xD1, 0x8D, 0x8D, 0xCC, 0xDE, 0x9F, 0x23, 0xCC, 0xDD, 0xA8, 0xAC, 0x95, 0x65, 0x9C, 0x30, 0x22, 0x4A, 0xB3, 0x9B, 0xFE, 0x17, 0x4, 0x8A, 0x30, 0xB1, 0x1, 0x95, 0xED, 0xF8, 0x85, 0xE7, 0x57, 0x1A, 0x44, 0x28, 0x7B, 0x1E, 0x92, 0x10, 0xE0, 0x38, 0x24, 0xD1, 0x5, 0xBE, 0x93, 0xA2, 0x84, 0x50, 0xEE, 0xA3, 0x9E, 0xBD, 0xF8, 0x75, 0x3D, 0xE0, 0x2E, 0xE4, 0xB4, 0x1C, 0xE, 0xE2, 0x34, 0x1A, 0xE, 0xE2, 0xB4, 0xD, 0xC6, 0x5E, 0xFC, 0xD6, 0x5F, 0xC4, 0x72, 0xD1, 0xBF, 0x11, 0x21, 0xA3, 0xEF, 0xC5, 0x82, 0x88, 0xAD, 0x96, 0xAA, 0xA1, 0xD7, 0xD5, 0x7F, 0xF0, 0xBE, 0x99, 0xB2, 0xD9, 0xBF, 0x11, 0xC1, 0x72, 0xE9, 0xA2, 0x2E, 0xAA, 0x37, 0x3, 0x9F, 0x64, 0xE1, 0x17, 0x9A, 0x1A, 0xA9, 0x80, 0xD0, 0x6A, 0x99, 0x73, 0x20, 0xE4, 0x65, 0x5F, 0x64, 0x95, 0xB2, 0x31, 0xD1, 0xB5, 0x92, 0x7D, 0x71, 0x3C, 0xBC, 0x68, 0x6A, 0xA4, 0x2, 0x42, 0xAB, 0x65, 0x2, 0x53, 0x8D, 0xFE, 0x60, 0xAE, 0x11, 0x32, 0xD9, 0xA8, 0x7E, 0xB6, 0x11, 0x36, 0xDD, 0xE8, 0x4F, 0xE6, 0x1B, 0x41, 0x13, 0x8E, 0xFE, 0x64, 0xC6, 0x1, 0x13, 0xAE, 0x7A, 0xBE, 0x95, 0xA7, 0x5B, 0xED, 0x6C, 0xC3, 0x26, 0xDB, 0x9F, 0xCC, 0x35, 0x68, 0xAA, 0xFD, 0xC1, 0x4C, 0xCB, 0x89, 0xC3, 0xA5, 0x0, 0xD3, 0xB5, 0x7A, 0xB6, 0x96, 0x27, 0x6B, 0xED, 0x5C, 0x85, 0xA6, 0xEA, 0x1F, 0xCC, 0x54, 0x64, 0xA2, 0xD6, 0xCF, 0x53, 0x6C, 0x9A, 0xFE, 0xC9, 0x2C, 0x85, 0x26, 0x69, 0xFD, 0x1C, 0x45, 0x9A, 0xB7, 0xBE, 0x75, 0x81, 0xC6, 0xAD, 0x6E, 0x5B, 0xA8, 0x69, 0xFF, 0xA0, 0x65, 0x91, 0x86, 0xAD, 0x6D, 0x57, 0x79, 0x86, 0x62, 0xF3, 0x73, 0xE3, 0xDD, 0x4F, 0x5C, 0xA3, 0x37, 0xC8, 0xF5, 0xC4, 0xE9, 0x9B, 0xF1, 0xD2, 0x4B, 0x44, 0x8, 0x79, 0xB, 0xA3, 0xBD, 0x90, 0x91, 0x6A, 0x87, 0x20, 0x19, 0x2C, 0x3B, 0xC6, 0x49, 0xF6, 0x49, 0x56, 0xD8, 0x58, 0x46, 0xB4, 0x49, 0x70, 0x71, 0x1B, 0xC7, 0x6F, 0xE3, 0x91, 0x2D, 0x3C, 0x29, 0xDB, 0x1B, 0xDE, 0x3, 0xE2, 0xC0, 0x20, 0x29, 0x83, 0xF, 0x8A, 0xF, 0x5, 0x3E, 0x30, 0x48, 0x8A, 0x9, 0x7E, 0x62, 0x3, 0x2D, 0x76, 0x8, 0x9, 0xB3, 0x70, 0x34, 0xFF, 0xFF, 0xAC, 0x7D, 0x59, 0xB2, 0xBB, 0x38, 0xF3, 0xE5, 0x7B, 0xEF, 0xA2, 0xBF, 0xC7, 0x2F, 0x1A, 0xC2, 0xE3, 0x1D, 0xB6, 0xD1, 0x3B, 0x10, 0x20, 0x83, 0xC, 0x48, 0xB6, 0x6, 0x30, 0x8E, 0xF8, 0xEF, 0xBD, 0x3, 0x1, 0x36, 0xBE, 0x95, 0x29, 0x25, 0x15, 0xFD, 0x50, 0xF1, 0xBB, 0xE5, 0x73, 0x74, 0xD0, 0x3C, 0xA4, 0x52, 0x52, 0x83, 0xBF, 0x3, 0x3B, 0xC3, 0xD4, 0x35, 0x8B, 0x6A, 0xD, 0x62, 0x1C, 0x1B, 0x11, 0x52, 0x92, 0x5A, 0x3, 0xBE, 0x7E, 0x3D, 0x21, 0x24, 0x5, 0x59, 0x54, 0x5C, 0xC0, 0x67, 0x27, 0x17, 0x90, 0xA2, 0xE3, 0xC0, 0xEB, 0x9C, 0xFC, 0xCF, 0x84, 0xA0, 0xA1, 0x35, 0xA0, 0x87, 0xA9, 0x59, 0xEA, 0x4C, 0xC0, 0x2E, 0xE3, 0xC, 0xD9, 0x26, 0x3, 0xBC, 0x8F, 0x3E, 0xFE, 0x18, 0xB, 0x36, 0x24, 0x46, 0xAA, 0xFE, 0xD6, 0xA8, 0x1E, 0xDE, 0x7, 0x5F, 0x11, 0x48, 0x7B, 0xE0, 0x7A, 0xF0, 0xB6, 0xE2, 0x4B, 0xC3, 0x34, 0xC7, 0x14, 0xDF, 0xC, 0xA2, 0xA4, 0x11, 0xF0, 0xC5, 0x7, 0x1E, 0x21, 0x94, 0x35, 0x78, 0xFA, 0x94, 0x70, 0xF8, 0xD4, 0xF0, 0x46, 0x48, 0x55, 0x6A, 0x56, 0x80, 0x8D, 0xF0, 0xD, 0x53, 0x1A, 0x22, 0xEC, 0xA3, 0x43, 0x71, 0xD1, 0x31, 0x35, 0xB8, 0x77, 0x62, 0x6A, 0xC2, 0xED, 0x6E, 0xF0, 0x63, 0xBB, 0x96, 0xF4, 0xD2, 0xAE, 0x35, 0x63, 0xA4, 0xE1, 0xCE, 0xD8, 0xD8, 0xD4, 0x63, 0xB1, 0x9E, 0xD8, 0xB8, 0x8C, 0xB5, 0x3C, 0x74, 0x18, 0x66, 0x62, 0x6C, 0x38, 0xA, 0x33, 0x6, 0x98, 0x9E, 0xDA, 0xAB, 0x31, 0x37, 0xD2, 0x89, 0x92, 0x2E, 0x1C, 0x9A, 0x68, 0xF4, 0xE8, 0xE9, 0xCC, 0xD9, 0xB2, 0xF7, 0x67, 0x5C, 0x5E, 0x9, 0xA7, 0x59, 0xC8, 0xF9, 0x70, 0xE1, 0x6C, 0xF0, 0x3F, 0x34, 0xAE, 0x64, 0xDA, 0x5, 0x3C, 0x72, 0x26, 0x2, 0xDD, 0x25, 0x67, 0xE4, 0x87, 0x8E, 0xA2, 0x8E, 0xF8, 0x86, 0x83, 0xA8, 0xC6, 0x89, 0x3A, 0xF8, 0x48, 0xAD, 0x27, 0x6C, 0xD8, 0x98, 0x35, 0xAE, 0xF6, 0xD5, 0x23, 0x52, 0x3E, 0x13, 0x69, 0x5B, 0x1, 0xD5, 0x62, 0x50, 0x52, 0xE1, 0x2E, 0xF6, 0xB, 0x83, 0xEC, 0x64, 0x6F, 0x5C, 0xED, 0xB2, 0x48, 0x89, 0x8F, 0x8C, 0x4D, 0xE5, 0x5D, 0x3B, 0x51, 0xFB, 0x83, 0x86, 0x68, 0x8D, 0xAF, 0x9D, 0x48, 0x17, 0xA, 0x51, 0xB2, 0x15, 0xE8, 0x4B, 0x3D, 0x13, 0x4C, 0x7D, 0xAA, 0xC7, 0xB8, 0x96, 0x95, 0x2A, 0x70, 0xB3, 0xF8, 0x4C, 0xD8, 0x90, 0x87, 0xD8, 0xA9, 0x74, 0xE3, 0x88, 0x87, 0xD2, 0x8D, 0x93, 0x4C, 0x86, 0x3B, 0x1E, 0xC9, 0xE4, 0xA6, 0x7E, 0x47, 0x6, 0x5C, 0x6F, 0xFC, 0xED, 0xDC, 0x54, 0xDF, 0x9B, 0x91, 0x1C, 0x7C, 0xD2, 0xC9, 0xAB, 0x6D, 0x78, 0xCF, 0xC9, 0x38, 0xED, 0xCA, 0xC0, 0x5B, 0x8F, 0x13, 0x4E, 0x7E, 0xEA, 0x71, 0xA2, 0x4F, 0xEF, 0xAA, 0x5, 0xCE, 0xBA, 0xAD, 0x68, 0x1B, 0x4E, 0xBB, 0xF9, 0x50, 0x22, 0xD2, 0x7F, 0x8F, 0x94, 0x6D, 0xFD, 0xB7, 0x76, 0xF3, 0x5B, 0x33, 0x81, 0x2, 0xF7, 0x9C, 0x2D, 0xFE, 0x12, 0x63, 0x10, 0x1B, 0x72, 0x7D, 0x9C, 0x8, 0x5B, 0x3A, 0x5A, 0xED, 0xC2, 0x8F, 0x97, 0x8F, 0x8C, 0x4D, 0x2F, 0x97, 0x1B, 0x17, 0xB9, 0xDE, 0x60, 0x26, 0x90, 0x9D, 0x80, 0x66, 0x7E, 0xF0, 0xE0, 0xF8, 0xA2, 0xB9, 0xE1, 0xE4, 0xB8, 0x71, 0x3D, 0x93, 0x2A, 0xF4, 0x7A, 0xCE, 0xC4, 0xD8, 0xF0, 0x7C, 0x8E, 0xF1, 0x79, 0x19, 0x7A, 0x85, 0x68, 0x62, 0x6C, 0x78, 0x86, 0x8, 0x9A, 0x2D, 0x45, 0x67, 0x68, 0x56, 0x40, 0x33, 0x5D, 0x9B, 0x8A, 0xE8, 0xBC, 0xDD, 0x41, 0xBB, 0xA8, 0xFE, 0xD7, 0x58, 0x40, 0xE8, 0xE6, 0x76, 0x17, 0xBD, 0xB9, 0xDD, 0x35, 0xC, 0x9C, 0xCB, 0xBA, 0x86, 0x51, 0x26, 0xB1, 0xAE, 0xE1, 0xEC, 0x96, 0xDC, 0x98, 0xB6, 0x92, 0x6B, 0xE4, 0xD2, 0xDF, 0x4F, 0xE, 0x69, 0x8A, 0xEF, 0x24, 0x78, 0x41, 0x98, 0xFF, 0x39, 0x96, 0x20, 0x59, 0xC3, 0x76, 0x13, 0x27, 0x6B, 0x92, 0xD9, 0xC4, 0x69, 0xF0, 0x4D, 0xD, 0xA7, 0xD3, 0xF8, 0xA3, 0x1A, 0x4E, 0x83, 0xDB, 0x88, 0x4E, 0x13, 0xB6, 0x11, 0x9D, 0xE6, 0x35, 0xB8, 0xB, 0x3A, 0x2, 0x84, 0x4D, 0x50, 0xA7, 0x5, 0x7C, 0x5B, 0xCD, 0x8, 0x10, 0x56, 0x15, 0x4E, 0xF, 0xC6, 0xE, 0x35, 0x43, 0xE2, 0x30, 0x81, 0x94, 0x78, 0x98, 0x9C, 0xC9, 0x1, 0x8E, 0x89, 0x87, 0x28, 0x71, 0x31, 0xA0, 0x4F, 0x9A, 0xFF, 0x39, 0x16, 0xB4, 0x43, 0x2A, 0x74, 0x47, 0xAB, 0xD0, 0x8F, 0xB, 0x6B, 0x45, 0x33, 0x20, 0x75, 0x68, 0x46, 0x49, 0x15, 0x9, 0x5A, 0x81, 0x47, 0x17, 0xE0, 0x5D, 0x96, 0x41, 0xC1, 0xD2, 0x2C, 0x8B, 0x87, 0x84, 0x96, 0xC4, 0x1D, 0xC1, 0x1F, 0x4, 0xBE, 0x2D, 0x83, 0x72, 0x53, 0x46, 0xC7, 0xB, 0x6E, 0xAC, 0x66, 0xC8, 0x2D, 0x77, 0x6F, 0x98, 0xB0, 0x28, 0xEF, 0xC0, 0x3E, 0xB3, 0x23, 0xF4, 0x99, 0x1D, 0x58, 0xD9, 0x3A, 0x42, 0x3D, 0xEB, 0x6A, 0xA8, 0x94, 0xBB, 0xB4, 0x26, 0x94, 0xAE, 0x84, 0x3C, 0x74, 0xBB, 0x54, 0x46, 0x1D, 0x74, 0x3B, 0xA8, 0x6B, 0xEB, 0xA2, 0x1D, 0x5B, 0x67, 0x20, 0xA7, 0xBC, 0x2E, 0x35, 0xD1, 0x9B, 0x62, 0x3B, 0xD0, 0x5C, 0xD0, 0x11, 0xAC, 0x5, 0x9D, 0x85, 0x6E, 0x7B, 0xEB, 0x52, 0x1B, 0xBD, 0xED, 0xAD, 0x7, 0xC2, 0xF5, 0xD1, 0x40, 0xE0, 0x65, 0x8B, 0x3D, 0xE1, 0xB2, 0xC5, 0xBE, 0x65, 0xA2, 0xC9, 0xA1, 0x2B, 0xDB, 0x3C, 0x92, 0xE6, 0xD8, 0xB5, 0x6D, 0xEF, 0x2, 0xF5, 0x44, 0xD8, 0x3A, 0x32, 0x69, 0x50, 0x2C, 0x24, 0x9E, 0x9, 0xF7, 0x1D, 0x93, 0x8, 0xA5, 0xE3, 0x78, 0x80, 0x57, 0x7E, 0x3D, 0x8, 0x57, 0x7E, 0xD, 0x79, 0x5, 0x5F, 0x80, 0x3C, 0x2, 0x84, 0xBE, 0x7B, 0x28, 0x18, 0x6C, 0x59, 0x1B, 0x1, 0x42, 0x1B, 0x1E, 0xA4, 0xE1, 0x16, 0x16, 0x18, 0x11, 0x8A, 0x82, 0xB9, 0x5C, 0x95, 0x86, 0xBB, 0x93, 0x19, 0x23, 0xA9, 0x48, 0x6E, 0x10, 0xD, 0xC9, 0xD, 0x49, 0xA1, 0x63, 0xF0, 0xAE, 0x81, 0x47, 0x8, 0xA, 0x50, 0xCB, 0x89, 0x35, 0x1B, 0x77, 0x48, 0x1A, 0x95, 0xB3, 0xE6, 0x21, 0x33, 0x36, 0x80, 0xB3, 0xA9, 0x85, 0x91, 0x7A, 0xA, 0x65, 0x32, 0xE5, 0xE, 0x21, 0xB5, 0xD, 0x3A, 0x40, 0x7F, 0x17, 0xBD, 0xC9, 0xDC, 0x31, 0xC8, 0xDF, 0xCC, 0xB1, 0x14, 0xF5, 0x35, 0x7B, 0x7F, 0x2F, 0x63, 0x12, 0x38, 0x3D, 0x35, 0xFD, 0x1C, 0xF9, 0x6A, 0xC6, 0xB5, 0xB9, 0x31, 0xC8, 0x3D, 0x6D, 0x84, 0xD2, 0x9, 0x8B, 0x7E, 0x7F, 0x16, 0x81, 0xDC, 0xAB, 0xFF, 0x9B, 0xBE, 0xE0, 0xBF, 0xCE, 0xD5, 0x80, 0xD5, 0xD3, 0x65, 0x3C, 0x78, 0x86, 0xC2, 0x65, 0x7C, 0xCB, 0x1, 0xA, 0x7, 0xBD, 0x46, 0xE5, 0xE2, 0xAF, 0x51, 0xB9, 0xC, 0x2A, 0x8B, 0x2C, 0xD6, 0xAB, 0xB8, 0xD9, 0xBF, 0xD0, 0x14, 0x9D, 0x6, 0xFB, 0x47, 0x97, 0xAE, 0x18, 0x94, 0x6E, 0x72, 0x4C, 0x66, 0xC5, 0x82, 0x56, 0xD0, 0x85, 0x42, 0x37, 0x8A, 0x8D, 0x21, 0x6A, 0x85, 0x9E, 0x98, 0x9F, 0x60, 0xEA, 0x99, 0xF9, 0x91, 0x2D, 0x59, 0x11, 0xB4, 0x2A, 0x2F, 0x9C, 0xD, 0x66, 0x9, 0x1F, 0x64, 0xBA, 0x59, 0x24, 0x24, 0xAA, 0x5A, 0x41, 0xBE, 0xF2, 0xC0, 0x15, 0xE8, 0xE3, 0x1C, 0xAE, 0xA0, 0x3E, 0xCC, 0xE1, 0xA, 0xF0, 0x71, 0x34, 0x57, 0x10, 0xDE, 0x46, 0x73, 0x85, 0x90, 0xE0, 0xE, 0xB1, 0x7, 0xE2, 0x93, 0x30, 0x57, 0xC0, 0x81, 0x29, 0x21, 0xD5, 0x98, 0x55, 0x70, 0x71, 0x8F, 0x18, 0xCD, 0x32, 0xE8, 0x78, 0x11, 0xB8, 0xB2, 0x6D, 0x44, 0xC9, 0xF7, 0xB3, 0x38, 0x1E, 0xB0, 0x1C, 0x8F, 0x20, 0xD5, 0xE0, 0x39, 0x72, 0xC7, 0xEA, 0x1F, 0x34, 0xD, 0x2D, 0xA4, 0x2D, 0xD6, 0x21, 0x77, 0xC9, 0x2F, 0x4C, 0x82, 0x33, 0x94, 0x9, 0xA2, 0xCC, 0x50, 0x1C, 0x14, 0x3A, 0xDA, 0xFB, 0x94, 0xA5, 0xEA, 0xA0, 0xC9, 0x89, 0x2B, 0xFD, 0xBD, 0x7C, 0xD1, 0xD9, 0x89, 0x2B, 0x45, 0x8B, 0x4A, 0x88, 0x96, 0x28, 0x52, 0x69, 0x8E, 0x1E, 0x1A, 0xF6, 0x20, 0x6D, 0x83, 0xD5, 0x9, 0xC9, 0x32, 0x97, 0x33, 0x3, 0x8D, 0x8A, 0x22, 0x1D, 0xC1, 0xD4, 0xA3, 0xB1, 0xAC, 0xBC, 0xA, 0xC1, 0x3, 0x3B, 0x2D, 0x1E, 0xA7, 0xEF, 0xB4, 0xB8, 0xAB, 0x40, 0x9F, 0x7C, 0x76, 0x57, 0x41, 0x7D, 0xEF, 0xD9, 0x5D, 0xC5, 0xF4, 0xA2, 0x63, 0x48, 0x6C, 0x62, 0x90, 0x25, 0xA1, 0xB1, 0x3C, 0x3A, 0x90, 0xD7, 0xBB, 0x4C, 0x94, 0x1D, 0xF4, 0xFE, 0x80, 0xAB, 0x77, 0xE9, 0x88, 0x11, 0x1E, 0x20, 0x70, 0x35, 0x3C, 0xF9, 0xA9, 0x49, 0xD3, 0x9E, 0x31, 0x30, 0x34, 0x91, 0xA8, 0x73, 0x45, 0x78, 0x97, 0xD0, 0xD5, 0x88, 0x1F, 0x8F, 0xAB, 0x89, 0x7E, 0x3C, 0xAE, 0x16, 0x15, 0xB, 0xEC, 0x9D, 0x79, 0x9C, 0xBE, 0x75, 0xE6, 0x6A, 0x51, 0xBB, 0xD6, 0x3F, 0xD5, 0x89, 0xA8, 0xA5, 0xB, 0x4E, 0x11, 0x83, 0x16, 0xD0, 0xAE, 0x26, 0x2C, 0xA0, 0x5D, 0xD, 0xCF, 0x25, 0x6A, 0xD2, 0x4, 0xA2, 0xC6, 0x6F, 0x57, 0x76, 0x35, 0xFD, 0x76, 0x65, 0x57, 0xDF, 0xB4, 0x68, 0xB9, 0xE5, 0xD, 0x2E, 0xB6, 0x30, 0xA8, 0x8A, 0x9A, 0xE7, 0xD, 0x13, 0x2D, 0x2E, 0x38, 0x13, 0x88, 0x7A, 0x4D, 0xC3, 0xA5, 0x61, 0x35, 0xFC, 0x9E, 0xF9, 0x1B, 0x8D, 0x2F, 0x48, 0x26, 0x6E, 0xC7, 0x24, 0x78, 0x3B, 0xD2, 0x1B, 0xA5, 0x28, 0xA1, 0xCF, 0x7D, 0xBA, 0x86, 0xF8, 0xDC, 0xA7, 0x6B, 0xC, 0x93, 0x35, 0x34, 0xE7, 0x18, 0x81, 0xB4, 0x8E, 0xCE, 0x3A, 0x9A, 0x4E, 0xD4, 0x70, 0x42, 0x3A, 0x51, 0x13, 0xD2, 0xD0, 0xB2, 0xAB, 0x40, 0x77, 0xEF, 0x3C, 0x4A, 0xDD, 0xBD, 0x73, 0x6D, 0x6, 0xCE, 0x60, 0xDA, 0x8C, 0x30, 0x85, 0x69, 0x33, 0xE4, 0xCC, 0xDA, 0x84, 0x50, 0x14, 0xB0, 0x31, 0x91, 0x3A, 0x22, 0xB6, 0xA1, 0x9D, 0x79, 0xD7, 0x6E, 0xD8, 0x96, 0x77, 0xAD, 0x40, 0x7, 0xF9, 0x56, 0x50, 0x87, 0x79, 0xC9, 0x9E, 0xAE, 0xE, 0xDC, 0xA9, 0x36, 0x13, 0xC8, 0xB7, 0x42, 0x38, 0x59, 0x70, 0xD, 0x3A, 0x11, 0x7A, 0x24, 0xFD, 0xEB, 0x40, 0x8, 0xB5, 0xC2, 0x91, 0x68, 0x38, 0x43, 0xAB, 0xFD, 0x8C, 0x13, 0xEB, 0xBE, 0x14, 0x67, 0xB8, 0xF3, 0x93, 0xE2, 0x4C, 0xEA, 0xFE, 0xA4, 0xF0, 0x9D, 0x7, 0x98, 0xD1, 0x33, 0x86, 0x67, 0xF4, 0x87, 0xC, 0x38, 0x30, 0x4E, 0xBF, 0x47, 0x13, 0xA1, 0x24, 0x7C, 0xB6, 0xD7, 0x43, 0x94, 0x93, 0xBD, 0x4E, 0x8A, 0x8E, 0x6B, 0x23, 0x2C, 0x70, 0x91, 0xD7, 0xA, 0xA3, 0x8A, 0xE0, 0xA5, 0xB3, 0x30, 0xC8, 0xE5, 0xD3, 0x19, 0xC0, 0x12, 0x39, 0xFE, 0x9E, 0x9A, 0x98, 0x2D, 0xD2, 0xC9, 0x2B, 0xD3, 0xF0, 0x35, 0xA0, 0x33, 0x44, 0xE8, 0x9D, 0xA4, 0x64, 0x32, 0xB0, 0x33, 0xEA, 0x71, 0xFA, 0xBE, 0xA8, 0x83, 0x23, 0x13, 0x8F, 0x85, 0x33, 0x8E, 0x35, 0x37, 0xAE, 0x8D, 0x92, 0x48, 0x4D, 0x59, 0x31, 0x48, 0xB3, 0x29, 0xF9, 0xE4, 0x32, 0xB4, 0x91, 0xEC, 0x9, 0x1B, 0x76, 0x91, 0x1D, 0xF4, 0x1C, 0x90, 0x8B, 0x3E, 0x6, 0xE4, 0x94, 0x74, 0x2D, 0xB, 0x78, 0x83, 0x4C, 0x4, 0xBA, 0x37, 0x88, 0x53, 0x4F, 0x17, 0xE8, 0xB2, 0xD4, 0xD3, 0xD1, 0xFB, 0xAB, 0x1B, 0x63, 0xA6, 0x66, 0x4F, 0xCB, 0x1B, 0x7E, 0x53, 0xDA, 0xD6, 0x80, 0x25, 0xD1, 0x73, 0xD2, 0x15, 0x29, 0xAD, 0x31, 0xDB, 0xE2, 0x3B, 0xEF, 0x6F, 0x8D, 0x0, 0x67, 0xD2, 0xB7, 0x46, 0x50, 0x66, 0xD1, 0x37, 0xD5, 0x63, 0x9D, 0xFB, 0x4D, 0xF5, 0xC4, 0xCE, 0xFD, 0x76, 0x53, 0xA8, 0xC8, 0x4D, 0x51, 0x45, 0x20, 0x3B, 0x56, 0xF4, 0xD9, 0x29, 0xA7, 0x59, 0xCD, 0xFA, 0xA0, 0xCB, 0xD8, 0x4C, 0xD9, 0xE0, 0x30, 0xE6, 0x34, 0x33, 0x8A, 0x7, 0x6E, 0x14, 0x9D, 0x8, 0xF4, 0x1B, 0x45, 0x9D, 0x66, 0x2E, 0xEC, 0x77, 0x38, 0x31, 0xB6, 0xC5, 0x31, 0x78, 0x47, 0xB5, 0xC7, 0xE9, 0xE, 0x3B, 0x4E, 0xB3, 0x81, 0x79, 0x27, 0x45, 0xF8, 0xD2, 0xD8, 0x19, 0xA7, 0xDE, 0x19, 0xEB, 0x74, 0x26, 0xA4, 0x4A, 0x6E, 0xDC, 0x30, 0xD, 0x9E, 0x56, 0xF8, 0x20, 0x10, 0x26, 0x44, 0x9E, 0x1E, 0x93, 0xA3, 0xAB, 0x71, 0x33, 0x59, 0xE4, 0xE0, 0x8E, 0x78, 0x86, 0x89, 0x96, 0x26, 0x2D, 0x98, 0xBE, 0x41, 0x16, 0x3, 0x2D, 0x52, 0x8F, 0xC4, 0xC2, 0x37, 0xD0, 0xC6, 0x9A, 0xD3, 0x4D, 0x8A, 0x6E, 0xAE, 0xBD, 0xDB, 0xAF, 0x96, 0xD8, 0xC7, 0x25, 0xED, 0xE3, 0xAA, 0x97, 0xD8, 0xAA, 0x66, 0xC4, 0x88, 0xB, 0x1A, 0xED, 0x5A, 0x16, 0x6C, 0x2F, 0xCE, 0xFB, 0xE7, 0x90, 0x9B, 0xCB, 0x10, 0x69, 0x2C, 0xC3, 0xA6, 0xA6, 0x2, 0xF5, 0x2C, 0xD1, 0x8E, 0xC5, 0x24, 0x7B, 0xDE, 0x71, 0x29, 0x55, 0x1, 0xFB, 0xB8, 0x8F, 0x84, 0x74, 0x61, 0x90, 0x86, 0x49, 0x93, 0x1C, 0x62, 0x8A, 0x87, 0xAD, 0x8A, 0xC7, 0x98, 0xE2, 0x71, 0xAB, 0xE2, 0x29, 0xA6, 0x78, 0xDA, 0xAA, 0xC8, 0x99, 0xB1, 0xC9, 0x9E, 0xB5, 0xEC, 0xA9, 0x24, 0xEB, 0x61, 0x87, 0xA2, 0x17, 0x2D, 0x7D, 0xF1, 0x36, 0xA9, 0xF3, 0x86, 0x19, 0x2B, 0xF2, 0x8C, 0x33, 0x69, 0x2C, 0x6B, 0x10, 0xE3, 0xD0, 0xEB, 0x23, 0x7F, 0xE9, 0x5B, 0xBE, 0x75, 0xD8, 0xF4, 0xAD, 0xC3, 0xBF, 0xFD, 0x56, 0xA9, 0xBA, 0xA4, 0xE7, 0x5B, 0xD2, 0xF6, 0xE, 0xF1, 0x6F, 0xBF, 0xB9, 0xED, 0x7B, 0xFF, 0x1F, 0xBE, 0x45, 0xCE, 0xCB, 0x89, 0xFD, 0x2F, 0xBF, 0x85, 0x2F, 0xF1, 0xC, 0x75, 0x75, 0x67, 0x98, 0xB4, 0xE2, 0xEE, 0x38, 0xFA, 0x10, 0xF3, 0x9B, 0x41, 0x56, 0xC4, 0xFC, 0xB2, 0xC7, 0x58, 0xD1, 0x7C, 0xB2, 0x9D, 0x61, 0xDA, 0x86, 0xA2, 0xA4, 0x2D, 0x3D, 0x3A, 0xC0, 0x33, 0x8D, 0xCE, 0xA4, 0xC, 0x7B, 0xA5, 0x71, 0x95, 0xBF, 0x48, 0xA1, 0xD1, 0xA, 0x27, 0x57, 0x4E, 0x5A, 0x3D, 0x70, 0x63, 0x99, 0x45, 0xCF, 0x5B, 0xFD, 0xA1, 0x51, 0xD3, 0x94, 0xBB, 0xC6, 0x3A, 0x1D, 0x52, 0x9D, 0x8, 0x54, 0xBD, 0xB1, 0x53, 0xD4, 0xCC, 0x8A, 0x8E, 0x87, 0xF3, 0xFD, 0x93, 0x47, 0x55, 0x87, 0x8C, 0x19, 0xF1, 0x3, 0x4C, 0xCE, 0x70, 0xCD, 0xC, 0x17, 0x92, 0x5B, 0x64, 0x5D, 0x69, 0xB8, 0x4E, 0x67, 0x46, 0x1A, 0x5A, 0x59, 0xAE, 0xA, 0x85, 0xEB, 0x5C, 0x49, 0xCB, 0xA5, 0x85, 0x6F, 0x39, 0xFA, 0x6F, 0xBA, 0xA2, 0xFC, 0xF3, 0xA6, 0x23, 0x68, 0xB7, 0xFC, 0xCD, 0xC7, 0xE2, 0xB8, 0xC8, 0xD1, 0xE2, 0x77, 0x63, 0xDA, 0xE, 0x1C, 0x1E, 0xDA, 0xB9, 0x4E, 0x3D, 0x9C, 0x72, 0x74, 0x98, 0xFF, 0xD0, 0x32, 0x3A, 0xBF, 0xC0, 0xB6, 0x9B, 0x51, 0x6A, 0x44, 0x49, 0x6, 0x9C, 0x51, 0xC9, 0xE4, 0xAC, 0xE1, 0x9D, 0xD0, 0xD6, 0xB1, 0xC9, 0xFC, 0x9C, 0xAB, 0x16, 0xDC, 0x85, 0x1D, 0xC9, 0xA9, 0x67, 0xA7, 0x6B, 0xFA, 0xD8, 0x66, 0x8, 0x8F, 0x92, 0x8C, 0x15, 0xA, 0xDE, 0x81, 0x30, 0xD4, 0x1D, 0x8, 0x53, 0x32, 0x5D, 0x4, 0xB6, 0xCD, 0x66, 0x9C, 0x5A, 0x79, 0xB1, 0x5, 0xA0, 0x21, 0x2E, 0xFF, 0x4C, 0x25, 0x6A, 0x17, 0xF2, 0x1D, 0xF0, 0x84, 0xD, 0x9E, 0x3, 0xA6, 0x12, 0xC6, 0x2A, 0x8D, 0x1B, 0x90, 0x16, 0x2, 0x35, 0x85, 0x95, 0x63, 0x22, 0x30, 0x92, 0x78, 0x98, 0xAA, 0x5, 0xEE, 0xB0, 0x18, 0xCA, 0xE, 0x8B, 0x69, 0x44, 0x27, 0x64, 0x19, 0x4D, 0xDC, 0x7, 0x8D, 0x1A, 0x2D, 0xC8, 0xFD, 0xD1, 0x99, 0xB8, 0xFB, 0xA3, 0x33, 0x58, 0x65, 0x24, 0xD5, 0xC4, 0x5B, 0xC3, 0xEC, 0x45, 0xE9, 0xD6, 0x0, 0x6F, 0xF5, 0x3B, 0x93, 0x2E, 0x70, 0x6A, 0xB0, 0x77, 0xFB, 0x57, 0x5A, 0xDA, 0x37, 0xA3, 0xD6, 0x49, 0x91, 0x43, 0x26, 0x16, 0xA3, 0xA7, 0x15, 0x4D, 0x3A, 0x32, 0xD2, 0x3C, 0x6E, 0x5F, 0x31, 0x91, 0xAD, 0x1F, 0xB3, 0x71, 0xEB, 0xC7, 0x58, 0xD8, 0x1B, 0xDB, 0x3, 0x94, 0x76, 0xE2, 0x82, 0x96, 0x7D, 0xE3, 0xB6, 0x98, 0xF6, 0x8D, 0xAB, 0xD1, 0xD3, 0x61, 0x1E, 0xA4, 0xCE, 0x43, 0x2C, 0x43, 0xAF, 0x51, 0x1F, 0x31, 0x62, 0xFD, 0xB3, 0xCC, 0xBF, 0xDA, 0x18, 0xBC, 0x44, 0xF6, 0x45, 0xDA, 0xB2, 0x0, 0xB4, 0xEC, 0xC9, 0x40, 0xEF, 0xF3, 0x9, 0x89, 0xDF, 0x88, 0x3A, 0xF2, 0x5C, 0xC0, 0xC1, 0x67, 0x84, 0xE9, 0xDE, 0x3D, 0x56, 0x5, 0x77, 0x6B, 0xAD, 0xDA, 0xB2, 0x5B, 0x6B, 0x8D, 0xD0, 0xB0, 0x61, 0xDA, 0x23, 0x4, 0xBB, 0xF4, 0xEB, 0xA4, 0x5A, 0xC8, 0x65, 0xE1, 0x45, 0xDA, 0xE0, 0xB7, 0x60, 0xC1, 0xE1, 0xD9, 0xC6, 0x7D, 0x5E, 0x9D, 0xED, 0xB9, 0xB4, 0x1C, 0x34, 0x6C, 0x4E, 0x10, 0xC5, 0xB6, 0xD9, 0x89, 0x1C, 0xAD, 0x94, 0x9D, 0xC8, 0x89, 0x95, 0xB2, 0x67, 0x57, 0xD1, 0x32, 0xDC, 0x5, 0x6D, 0xC2, 0xC9, 0x3E, 0x68, 0xA8, 0xB9, 0x95, 0x6A, 0x6C, 0xED, 0x1D, 0x3, 0x4E, 0x5, 0xB9, 0xDE, 0xA5, 0xC, 0x3B, 0x18, 0xF4, 0xCE, 0x12, 0x68, 0x4F, 0x26, 0xBA, 0x17, 0x83, 0xB8, 0x99, 0xD2, 0x1C, 0x4C, 0xA1, 0x4E, 0x38, 0xEA, 0x31, 0xFB, 0xAC, 0x4A, 0xA5, 0x55, 0x1, 0x7A, 0xA7, 0xCE, 0x58, 0x1A, 0x77, 0x53, 0x7D, 0xA2, 0x93, 0x92, 0x27, 0x75, 0x56, 0xF2, 0x84, 0xA3, 0x10, 0xFF, 0x78, 0x97, 0x8, 0x79, 0x51, 0xE3, 0x7F, 0xFF, 0xC, 0x3F, 0x61, 0xA9, 0x7, 0x23, 0xD9, 0xD7, 0x1, 0x9F, 0xEF, 0xA2, 0xDF, 0x66, 0xEC, 0x66, 0x2C, 0xF8, 0xAC, 0xE2, 0xC, 0xC5, 0x3B, 0x85, 0x8E, 0xE5, 0xCC, 0xA, 0x25, 0x81, 0x6, 0xFC, 0x86, 0x62, 0x12, 0x5, 0x7C, 0xD, 0x88, 0x7, 0x28, 0x51, 0x28, 0x19, 0x78, 0x47, 0x8B, 0x7, 0x88, 0xE1, 0x91, 0xE0, 0xA4, 0xD0, 0x6, 0xBE, 0x29, 0x76, 0x42, 0x28, 0xA, 0x90, 0xA5, 0xBA, 0x23, 0x6C, 0xFF, 0x77, 0xAC, 0x36, 0x88, 0xD3, 0xFE, 0xC, 0x51, 0xBE, 0xDE, 0x24, 0x45, 0xC2, 0x94, 0x81, 0x6F, 0xE6, 0x5D, 0xC1, 0x94, 0xF8, 0x34, 0x49, 0x11, 0x96, 0xA2, 0x2B, 0x85, 0x23, 0xB5, 0x25, 0x4E, 0xC1, 0x28, 0x6D, 0x88, 0x11, 0xD7, 0x15, 0x2F, 0x5A, 0xA6, 0x41, 0xD7, 0x13, 0x8F, 0xA7, 0x33, 0x81, 0x94, 0xED, 0x5C, 0x2B, 0x63, 0x2F, 0xAA, 0x1, 0xCF, 0x3B, 0x4C, 0x72, 0x33, 0x81, 0x24, 0xD7, 0xF0, 0x60, 0x86, 0x2D, 0x30, 0x29, 0xA5, 0xD, 0x8F, 0xD4, 0x89, 0x37, 0x81, 0xAC, 0x17, 0x93, 0xDB, 0xA2, 0x16, 0xD6, 0xDA, 0xA2, 0x14, 0x4B, 0xE6, 0xB6, 0x54, 0x46, 0x12, 0xB9, 0x29, 0x8D, 0x53, 0x79, 0x81, 0xCE, 0xD5, 0x6B, 0x9C, 0xAC, 0x56, 0x44, 0xF5, 0x8A, 0x6D, 0x8A, 0x11, 0xB9, 0x4D, 0x5A, 0x45, 0x44, 0xAC, 0xD8, 0xA2, 0x86, 0xB4, 0xA7, 0x86, 0x34, 0x9C, 0x85, 0x2E, 0xC8, 0x9A, 0x50, 0xDA, 0x7C, 0xB0, 0x63, 0xD0, 0x22, 0xD9, 0xFF, 0x1A, 0xD, 0x8, 0x3A, 0xE9, 0xD1, 0xDC, 0xF3, 0x46, 0x96, 0x63, 0x1A, 0x58, 0x81, 0xBE, 0x90, 0xA8, 0x0, 0xF8, 0x71, 0xD2, 0xA7, 0x2D, 0x43, 0xD, 0x1F, 0x13, 0x4A, 0xCE, 0xBB, 0xA1, 0xE9, 0xE0, 0xA7, 0xE7, 0x16, 0x8C, 0x12, 0x1F, 0x70, 0xB, 0xB8, 0x63, 0x84, 0x9D, 0xDF, 0x8E, 0xDD, 0x94, 0x46, 0x96, 0xF2, 0x1E, 0xA3, 0xAD, 0xE2, 0xDF, 0x32, 0xD0, 0x12, 0xE5, 0x8D, 0x12, 0x56, 0x29, 0x1D, 0xD3, 0x5, 0x32, 0x4B, 0xD2, 0x5, 0x69, 0x96, 0xA4, 0xB9, 0x41, 0x5A, 0xD8, 0x88, 0x50, 0xF2, 0x44, 0x97, 0x25, 0x3, 0x4F, 0xF8, 0xCD, 0x10, 0x29, 0x16, 0xD8, 0x64, 0x49, 0xD3, 0xE6, 0x4A, 0xD0, 0x52, 0xB1, 0x63, 0xF1, 0xA5, 0x62, 0x7, 0x9D, 0x9B, 0xEA, 0xE2, 0xE7, 0xA6, 0x3A, 0xD0, 0xBF, 0xB3, 0x23, 0xB8, 0x77, 0x76, 0xC0, 0x89, 0xD4, 0xE, 0x3B, 0x8A, 0xFA, 0xE, 0x4, 0x7E, 0x2E, 0x27, 0x7C, 0xAE, 0x80, 0x7, 0x9E, 0x82, 0x32, 0xE0, 0x0, 0x5B, 0xA, 0x5D, 0x6C, 0x43, 0xA1, 0xE3, 0x17, 0x3, 0x37, 0xD1, 0x11, 0x20, 0x94, 0x25, 0x47, 0xE6, 0xDD, 0x9C, 0x34, 0xEF, 0xE6, 0x25, 0xD3, 0xA6, 0xE2, 0x2, 0x93, 0xF0, 0x20, 0x4D, 0x7, 0xAA, 0x52, 0xFE, 0xE7, 0x58, 0x50, 0x30, 0xC3, 0x29, 0x4D, 0x89, 0x4B, 0xE, 0x5F, 0x11, 0x3A, 0x21, 0x34, 0x85, 0x27, 0xEC, 0x3C, 0x3C, 0x43, 0x24, 0xD, 0x91, 0x23, 0x69, 0x18, 0x11, 0x9A, 0x2, 0x1C, 0x9C, 0x14, 0x56, 0x72, 0xD3, 0x20, 0x55, 0x60, 0xC2, 0x28, 0xC5, 0x27, 0xAD, 0xD3, 0xD0, 0x85, 0x21, 0x2F, 0x24, 0x26, 0xA0, 0x33, 0x26, 0xB1, 0x9C, 0x9C, 0x30, 0x4A, 0x62, 0x74, 0xCE, 0x1B, 0x76, 0x3, 0xC, 0x3F, 0x13, 0x94, 0x8E, 0x58, 0xAC, 0x8B, 0xF7, 0xCC, 0x82, 0x3, 0x77, 0x47, 0xCC, 0x22, 0x23, 0x46, 0x12, 0x69, 0xC0, 0x67, 0x2B, 0x16, 0x8C, 0x94, 0x1E, 0x6C, 0x61, 0xE9, 0x11, 0x4A, 0xC1, 0x68, 0x61, 0x44, 0x9, 0x78, 0xD2, 0xBE, 0x90, 0xA8, 0x80, 0x82, 0x5F, 0x66, 0x9D, 0x10, 0x52, 0x22, 0x34, 0x62, 0x19, 0xF0, 0x8, 0x29, 0x11, 0x86, 0x89, 0xA6, 0xC1, 0xB7, 0xF3, 0xDF, 0xC, 0xE2, 0xD4, 0x86, 0x6B, 0x23, 0xF2, 0x8A, 0x6B, 0x7, 0xBD, 0x69, 0xF4, 0x81, 0xC6, 0x84, 0x8C, 0x85, 0xDF, 0x16, 0x9A, 0x10, 0x4A, 0xE2, 0x8C, 0x45, 0x4E, 0xCA, 0xCF, 0x10, 0x4D, 0x43, 0xF3, 0xC4, 0x34, 0xA2, 0xD0, 0xF0, 0xBC, 0x7B, 0x4D, 0x20, 0xEB, 0x59, 0x65, 0x91, 0xD9, 0xDF, 0xA, 0xA7, 0xA9, 0x75, 0xAC, 0x44, 0x66, 0x1C, 0xB, 0x48, 0xD1, 0x1, 0x6B, 0x61, 0xBC, 0xFE, 0x59, 0x68, 0x4B, 0xB7, 0xE3, 0x36, 0x7E, 0xB0, 0xB6, 0xE3, 0x96, 0x6B, 0x21, 0x99, 0xD0, 0xFC, 0x2, 0x6B, 0x2C, 0x70, 0x7A, 0xD9, 0xA0, 0x55, 0x83, 0x55, 0xF8, 0xAD, 0x55, 0xC7, 0xEB, 0x6F, 0xC7, 0x1B, 0xEC, 0x3A, 0xE3, 0x17, 0x48, 0xC8, 0xD2, 0xB, 0x28, 0x70, 0x21, 0x84, 0x84, 0xDA, 0x4D, 0xB4, 0xB5, 0x94, 0x70, 0x3D, 0x2F, 0x29, 0x75, 0x1C, 0x30, 0xA3, 0x77, 0xB1, 0xBB, 0xB5, 0x3A, 0xC1, 0xAE, 0xE0, 0xA8, 0x34, 0xFD, 0x1E, 0xB, 0x9C, 0xA9, 0xA4, 0x63, 0xD, 0x97, 0x16, 0x19, 0x98, 0xD6, 0x4, 0x42, 0x47, 0x28, 0x32, 0x15, 0x93, 0xDB, 0xA2, 0x96, 0x33, 0x60, 0x8B, 0xAC, 0x13, 0x79, 0xCA, 0x62, 0xFB, 0x63, 0x9D, 0xC8, 0x79, 0xE1, 0xB0, 0xE0, 0xBC, 0x70, 0x34, 0x9, 0xF9, 0x44, 0xD2, 0xE1, 0x21, 0x52, 0x12, 0x4A, 0xD5, 0x61, 0xD1, 0x28, 0x55, 0x47, 0x89, 0x46, 0xC1, 0xA1, 0x1A, 0xE5, 0x7F, 0x26, 0x4, 0x85, 0x76, 0x19, 0x3D, 0x10, 0xDF, 0x64, 0xEC, 0x4, 0xD4, 0x4, 0x44, 0xB4, 0xD, 0xC0, 0x73, 0x2, 0xCA, 0x6C, 0x40, 0xD4, 0xE0, 0x13, 0x7C, 0xF3, 0xEF, 0x94, 0xC0, 0xE8, 0xF8, 0xE9, 0x51, 0xE2, 0xD8, 0x29, 0x6A, 0xF8, 0x39, 0x3E, 0xF, 0x50, 0x1A, 0x32, 0x6C, 0xB9, 0xA5, 0x1C, 0x19, 0xEC, 0x44, 0xD3, 0xB0, 0x12, 0xF5, 0x10, 0x9B, 0x61, 0x6A, 0x3A, 0x9A, 0x6, 0x5C, 0x73, 0x4C, 0xBF, 0xC7, 0x2, 0x43, 0x53, 0x2A, 0x11, 0x9D, 0x4D, 0x9, 0x59, 0x30, 0xF4, 0x76, 0x9D, 0x37, 0x4A, 0xC9, 0x9, 0x29, 0xC7, 0x1E, 0x0, 0xB2, 0x6E, 0x4D, 0x10, 0x61, 0xD7, 0x4B, 0x48, 0x39, 0x58, 0x23, 0x50, 0x15, 0xF, 0x52, 0x74, 0xA0, 0x9, 0xB7, 0x88, 0x6D, 0xAE, 0x76, 0xE2, 0x76, 0x9D, 0x3D, 0x44, 0x31, 0x1B, 0x8F, 0xB8, 0xA5, 0xB, 0x85, 0x68, 0xEA, 0x11, 0x37, 0x23, 0x24, 0x63, 0xB7, 0x1B, 0xB8, 0xB, 0xFA, 0x86, 0x29, 0xBB, 0xA1, 0x9D, 0xD0, 0x25, 0x5C, 0xCE, 0xFE, 0xF7, 0x58, 0xEA, 0x46, 0x12, 0xEE, 0x82, 0xB4, 0xE0, 0xD4, 0xCA, 0xEA, 0x9D, 0xCE, 0x12, 0x67, 0xB8, 0x86, 0x2E, 0xC3, 0x59, 0xE3, 0x84, 0xA7, 0x46, 0x67, 0x7A, 0x20, 0x6E, 0x23, 0xBC, 0x29, 0x6A, 0x86, 0xEB, 0x71, 0x49, 0x1, 0x56, 0xEB, 0x15, 0x81, 0x62, 0x63, 0x9B, 0xF8, 0x91, 0xA4, 0x6E, 0x49, 0x29, 0xE7, 0x4D, 0xA1, 0x5A, 0x2E, 0x24, 0xF4, 0x1A, 0xE1, 0x27, 0xE3, 0xEF, 0x8B, 0x84, 0xA8, 0x64, 0x38, 0xF3, 0x38, 0x39, 0xF3, 0xA0, 0xDB, 0x2B, 0xFC, 0xAF, 0xD1, 0x80, 0x42, 0x81, 0x75, 0xD3, 0xFF, 0x1E, 0xB, 0x6C, 0xB9, 0xCE, 0x60, 0xCB, 0xC7, 0x4, 0x51, 0x46, 0x23, 0xD0, 0x6, 0x28, 0x8, 0x36, 0x40, 0x1, 0xEE, 0x93, 0x8B, 0xF8, 0x4E, 0xB9, 0xE8, 0xC0, 0xEA, 0xD5, 0xC5, 0x3B, 0xCB, 0x7, 0xB8, 0x4, 0x10, 0xF, 0xC2, 0x12, 0xA0, 0x61, 0x4C, 0x16, 0x5C, 0x73, 0x28, 0xB3, 0xDF, 0x18, 0x55, 0x4, 0xAD, 0x35, 0x2F, 0x6, 0xB1, 0xE2, 0x34, 0xAC, 0x10, 0x35, 0xEB, 0x6A, 0xF6, 0xD4, 0xD0, 0x1C, 0xE6, 0xD, 0xA7, 0x3A, 0x7A, 0xE5, 0xE6, 0x8A, 0xD, 0x5D, 0xDC, 0xB9, 0x16, 0x8B, 0xDF, 0xDF, 0xE9, 0xD9, 0xAD, 0xD0, 0x68, 0xB4, 0x5A, 0xA1, 0xA9, 0x71, 0x6A, 0x85, 0x46, 0x23, 0x34, 0xCA, 0x50, 0x62, 0xA3, 0x4A, 0xB0, 0xEC, 0x1B, 0x55, 0x12, 0xA, 0xBF, 0xCD, 0x6, 0xCB, 0x5B, 0xA6, 0xEB, 0x5C, 0x41, 0xB7, 0xA7, 0x74, 0x6D, 0xBA, 0x10, 0xD2, 0x5C, 0xA5, 0xE8, 0x65, 0x2A, 0xEF, 0xE8, 0x40, 0xB5, 0x28, 0x5A, 0x7B, 0x24, 0x38, 0x40, 0x4B, 0xC2, 0xC8, 0xAC, 0x18, 0xB6, 0x57, 0xE0, 0x11, 0xC2, 0x3C, 0x43, 0x15, 0x35, 0xF4, 0x71, 0xFF, 0x73, 0x2C, 0x68, 0x53, 0xC0, 0x13, 0xC5, 0x11, 0xA0, 0x7C, 0xBA, 0xA9, 0xB9, 0xAC, 0x9D, 0x2C, 0xF0, 0x9, 0xDF, 0x9B, 0x42, 0x6C, 0x36, 0xAA, 0xA9, 0x4D, 0xCF, 0x4A, 0xB0, 0x35, 0xBF, 0xB1, 0xA8, 0x88, 0x2A, 0xB, 0xF0, 0x8E, 0xDB, 0x19, 0xA2, 0x54, 0x4C, 0xD5, 0x80, 0xBD, 0x99, 0xFF, 0x39, 0xFA, 0xFD, 0x1, 0xAE, 0x12, 0x23, 0x40, 0xA9, 0x15, 0x4A, 0x37, 0xEA, 0xC6, 0xD1, 0xAD, 0xB5, 0x5, 0x27, 0xCE, 0xB9, 0x94, 0x31, 0xBC, 0x63, 0xB2, 0x44, 0x8C, 0x45, 0x6F, 0x98, 0x52, 0xE6, 0x6, 0x36, 0x11, 0x28, 0x43, 0xB1, 0x11, 0x28, 0xE8, 0x69, 0x14, 0xFF, 0x6B, 0x34, 0x20, 0xBC, 0xB4, 0x9A, 0x7E, 0x8F, 0x6, 0x6, 0x63, 0x6C, 0xE3, 0xD1, 0x1D, 0x58, 0x9, 0x46, 0xD8, 0xFF, 0x1E, 0x9, 0x7C, 0x3B, 0xB5, 0x50, 0xD8, 0xDB, 0x89, 0x70, 0x25, 0x52, 0x77, 0x93, 0x85, 0x84, 0xDF, 0xA1, 0x99, 0x20, 0xCA, 0x9, 0x8A, 0xEE, 0x26, 0x6F, 0x8D, 0x33, 0x60, 0xE2, 0x27, 0x28, 0x45, 0xB3, 0x60, 0x25, 0x62, 0x92, 0x4A, 0x19, 0x8B, 0xC4, 0x65, 0x2, 0x69, 0xB1, 0x31, 0x6D, 0x5E, 0x20, 0xC3, 0xCE, 0xCD, 0xA4, 0x1E, 0xA4, 0x8C, 0x3B, 0x37, 0xD3, 0xE, 0x57, 0x21, 0x15, 0x24, 0xF4, 0xDF, 0xD4, 0x4B, 0x79, 0xFC, 0xAF, 0x16, 0x70, 0x8C, 0xA6, 0x3, 0xDF, 0x5D, 0xEB, 0x8, 0xEF, 0xAE, 0x75, 0xE0, 0xD3, 0x44, 0x1D, 0xE1, 0x69, 0xA2, 0xCE, 0xB4, 0x83, 0xAD, 0x44, 0x9E, 0x64, 0x9C, 0x19, 0xB, 0x1F, 0xF6, 0xEA, 0xC6, 0x34, 0xAC, 0x48, 0xA4, 0xC5, 0x90, 0x5, 0x63, 0x64, 0x9, 0x31, 0x2, 0x1D, 0x8B, 0x3B, 0x82, 0x63, 0x71, 0x7, 0x95, 0x65, 0xD4, 0x2E, 0x3, 0xF6, 0x8C, 0x78, 0xAF, 0xF8, 0x4E, 0xA1, 0xE3, 0x8D, 0x82, 0x62, 0x3A, 0xFD, 0x1E, 0xF9, 0x2A, 0x78, 0x3, 0x76, 0x47, 0xB8, 0x1, 0xBB, 0x7B, 0x34, 0xD0, 0x49, 0x8A, 0xEE, 0xD1, 0x10, 0x4E, 0x50, 0xF4, 0xC, 0x32, 0x9F, 0xF5, 0x2C, 0x6E, 0x39, 0xEB, 0x59, 0xE8, 0xCA, 0xC5, 0x11, 0x25, 0x5F, 0xB9, 0xD8, 0x33, 0xC4, 0x9A, 0xD8, 0x33, 0x9A, 0x31, 0xB1, 0x67, 0x97, 0x4B, 0xC3, 0x73, 0xDE, 0x34, 0x60, 0x75, 0x7D, 0xC3, 0x94, 0x8A, 0xDA, 0x33, 0xC4, 0xA8, 0xD8, 0x33, 0x9A, 0x4D, 0xB1, 0x67, 0x57, 0x51, 0xB, 0xAB, 0xEA, 0xE9, 0x3D, 0xD, 0x38, 0x7B, 0x46, 0x4A, 0xFA, 0xE2, 0x50, 0xF2, 0xE8, 0x2A, 0xDA, 0xE0, 0x5D, 0xA6, 0xB3, 0x67, 0x3A, 0xFD, 0x26, 0xD3, 0x9E, 0xD5, 0xCC, 0xE0, 0xF, 0xCC, 0x4C, 0x30, 0xF5, 0x7D, 0x99, 0x89, 0x6D, 0x95, 0xB5, 0xC8, 0xB3, 0x2A, 0xB3, 0xDC, 0xCC, 0xA0, 0x9, 0x62, 0x77, 0xB1, 0x2C, 0x18, 0x5D, 0x85, 0xA4, 0xB6, 0x49, 0x96, 0x7, 0x9E, 0x28, 0x19, 0x61, 0xFA, 0xFB, 0x24, 0x3D, 0xAB, 0x6B, 0x16, 0x39, 0x7, 0xB3, 0x70, 0x36, 0x1C, 0x83, 0xE9, 0x59, 0xED, 0x6, 0xD6, 0x8A, 0x81, 0xC1, 0x67, 0x3C, 0x26, 0x3C, 0x9D, 0x8, 0x14, 0xB9, 0x26, 0xD3, 0xCF, 0x21, 0xAF, 0x20, 0x17, 0xFB, 0x17, 0x18, 0x77, 0xB2, 0xEF, 0x59, 0x3, 0xED, 0xA8, 0x4C, 0x3F, 0x13, 0x82, 0x62, 0x53, 0x79, 0xF, 0xD2, 0x26, 0xF1, 0x3D, 0x6B, 0x1A, 0x25, 0x5, 0xBA, 0x2A, 0x58, 0x70, 0xB2, 0x5A, 0xCB, 0x34, 0xD0, 0x79, 0x2F, 0x40, 0x34, 0xB8, 0xE5, 0xC0, 0x2, 0x73, 0xFE, 0x3D, 0x16, 0x18, 0x9A, 0x75, 0xFA, 0x5F, 0xE3, 0x1, 0x4B, 0x5, 0x76, 0x72, 0x13, 0x10, 0xD, 0xAE, 0x9C, 0x3F, 0x17, 0x74, 0x71, 0x70, 0xDD, 0x9A, 0xF0, 0x74, 0x24, 0x50, 0xEA, 0x96, 0x66, 0x99, 0x8, 0x5C, 0x6F, 0x33, 0x11, 0xE8, 0xF7, 0xDB, 0xF4, 0x4C, 0xB7, 0x2, 0x3C, 0x78, 0x37, 0x21, 0x94, 0x6A, 0xAA, 0xF1, 0xEA, 0xA1, 0xA9, 0x35, 0x43, 0x9B, 0x27, 0xEB, 0xB1, 0x78, 0x78, 0x8C, 0x12, 0x13, 0x53, 0x9, 0x59, 0x5A, 0x25, 0xB, 0xF4, 0x80, 0xD3, 0x9A, 0x43, 0x8D, 0x9B, 0xA9, 0x2C, 0x97, 0xAC, 0x6F, 0x41, 0x3B, 0xDC, 0xB, 0x4E, 0x5B, 0x82, 0x45, 0xAE, 0x67, 0xC6, 0xB0, 0x36, 0x78, 0x89, 0xCB, 0x4C, 0xD9, 0xD4, 0x7F, 0x59, 0xA6, 0x99, 0x40, 0x6E, 0xED, 0x99, 0x51, 0xDA, 0xA5, 0x3D, 0x9E, 0x2C, 0x42, 0x5D, 0xE1, 0x88, 0x6F, 0xE8, 0xA, 0x6D, 0xE, 0xCC, 0xB8, 0xA6, 0x9F, 0x9, 0x41, 0x13, 0x26, 0x8B, 0x24, 0x6F, 0x54, 0x5E, 0xE3, 0xE5, 0xF9, 0x41, 0xA3, 0x16, 0xA9, 0xCD, 0x2B, 0x26, 0x8B, 0xB8, 0xF2, 0x42, 0xDA, 0xA0, 0xB, 0xF7, 0xD9, 0x13, 0x10, 0xB, 0xE, 0x57, 0x30, 0x4A, 0xB5, 0xEA, 0xE1, 0xA6, 0xD3, 0x53, 0x5A, 0xCD, 0xD3, 0xD5, 0xF8, 0xDD, 0xC7, 0x13, 0x4C, 0xBD, 0xF8, 0xB8, 0x87, 0xBC, 0x4E, 0xFB, 0xB8, 0xD7, 0x69, 0x9F, 0x35, 0xAA, 0x14, 0x80, 0x85, 0xC4, 0x3, 0xA9, 0x88, 0x9A, 0x46, 0xFA, 0xEC, 0xE, 0x2D, 0xD2, 0xFB, 0xEC, 0x4E, 0x58, 0xA4, 0xF7, 0x9C, 0xD9, 0xA, 0x1C, 0x5A, 0x66, 0x20, 0x12, 0xF9, 0x89, 0x35, 0xBF, 0xB, 0x80, 0xAA, 0x2C, 0x78, 0x4C, 0x2C, 0x3, 0xDD, 0xEA, 0x7A, 0x9E, 0x51, 0x7C, 0xEA, 0x7A, 0x9E, 0x41, 0x4F, 0x23, 0x8D, 0xA1, 0xA3, 0x6F, 0x23, 0xF5, 0x3C, 0xCB, 0x19, 0xD4, 0x12, 0xA6, 0xDF, 0xE3, 0x81, 0x91, 0xF, 0xE7, 0x84, 0xF, 0x17, 0x48, 0xD8, 0x82, 0x10, 0x16, 0x2E, 0xB9, 0x8C, 0x50, 0x6E, 0x59, 0x9, 0xD, 0xEC, 0x3C, 0x4B, 0xCB, 0xE8, 0xB8, 0xCE, 0xB3, 0x8A, 0x69, 0xE, 0x3A, 0x2F, 0xFE, 0x37, 0x9D, 0xD1, 0x7F, 0xF8, 0x2F, 0x2, 0x96, 0x8B, 0x91, 0xAA, 0x6E, 0x99, 0x0, 0xCE, 0x83, 0x4E, 0x50, 0x3A, 0x62, 0xF1, 0x52, 0xAF, 0xD4, 0xD, 0x3E, 0x55, 0x39, 0xAB, 0x50, 0x4E, 0x55, 0x4E, 0x54, 0xB0, 0x1D, 0x4D, 0x22, 0x94, 0xA6, 0x34, 0x12, 0x41, 0x1B, 0xD3, 0xAC, 0x41, 0xB0, 0x30, 0x4D, 0x4C, 0xF0, 0xEA, 0x84, 0x59, 0x84, 0x70, 0x7D, 0x82, 0x67, 0x1A, 0x2B, 0x64, 0x9, 0xBD, 0x32, 0xF8, 0x46, 0x53, 0xF4, 0xB9, 0xC1, 0xB5, 0x14, 0xD8, 0x37, 0xF1, 0xC, 0xEF, 0x99, 0x56, 0x15, 0x5, 0xDA, 0xD9, 0xF6, 0x61, 0x31, 0xF3, 0xF7, 0xFA, 0xC3, 0xD, 0xB0, 0x19, 0x32, 0x6, 0x6E, 0x62, 0xD7, 0xC9, 0xF7, 0x3C, 0x93, 0x17, 0x38, 0xAC, 0xBC, 0x10, 0xC2, 0x2, 0x5E, 0x60, 0x3E, 0x6C, 0xCC, 0x13, 0xAC, 0xE7, 0xD9, 0x8D, 0x31, 0xA3, 0xBA, 0xA, 0xAC, 0x5, 0xBE, 0x71, 0xF8, 0xB, 0x3D, 0x55, 0x57, 0xFD, 0xA3, 0x32, 0xC0, 0xD, 0xE4, 0x86, 0xE4, 0xC1, 0x8D, 0x90, 0x7, 0x9A, 0x17, 0x42, 0xF3, 0xDC, 0x62, 0xB5, 0x69, 0xC1, 0x89, 0x55, 0xA, 0x1A, 0xA0, 0x79, 0x8A, 0x3E, 0x2B, 0xF3, 0x11, 0x14, 0x7C, 0x4E, 0x7C, 0x1, 0xA2, 0x9, 0x19, 0x59, 0x3, 0x93, 0x5, 0x7F, 0xF8, 0xBD, 0x2, 0xAC, 0x89, 0x8D, 0xB4, 0x74, 0xC5, 0x23, 0xB6, 0x37, 0xFF, 0xBA, 0x8F, 0x56, 0x79, 0xD, 0x26, 0x70, 0x82, 0xD3, 0x9, 0x8F, 0x8B, 0xD9, 0x71, 0x8A, 0x6D, 0x20, 0xF, 0x85, 0x17, 0x48, 0xF0, 0x4E, 0x18, 0xB9, 0x57, 0xB8, 0xE0, 0xED, 0x35, 0x9E, 0x5F, 0xD0, 0x3B, 0x70, 0x3E, 0x2C, 0x61, 0x58, 0x80, 0xCE, 0x87, 0x8C, 0x61, 0xA3, 0x67, 0x44, 0x7A, 0x9E, 0x3D, 0x1, 0xA3, 0xE8, 0x18, 0xF6, 0x19, 0xDB, 0x2C, 0xEA, 0x79, 0x51, 0x80, 0x7B, 0x23, 0xB, 0x10, 0xD, 0xCE, 0x6F, 0x8D, 0x1A, 0xE0, 0x6C, 0x9F, 0x30, 0x52, 0xAE, 0x4F, 0x54, 0x78, 0x14, 0x98, 0x65, 0x28, 0xE3, 0xC0, 0x44, 0x85, 0xC, 0xAE, 0x2F, 0x19, 0x82, 0xD5, 0x95, 0xF3, 0xBA, 0x19, 0x9A, 0x31, 0xB4, 0x1E, 0x94, 0x2E, 0xA1, 0xAD, 0xE1, 0xF, 0xCE, 0xD8, 0x8C, 0x9, 0xBB, 0xC3, 0x3D, 0x2F, 0xB5, 0x82, 0xE7, 0xCC, 0x1E, 0x21, 0x4C, 0x9B, 0xB9, 0x80, 0x27, 0x5A, 0x82, 0x30, 0xCF, 0x12, 0x60, 0xDD, 0x14, 0xF1, 0x9A, 0xD9, 0x34, 0x19, 0x17, 0xB2, 0x7C, 0x2A, 0xC9, 0xE1, 0x7D, 0xF2, 0xF, 0xA, 0x6D, 0xA7, 0xFC, 0x23, 0x8, 0x7, 0x27, 0x44, 0x6B, 0x4D, 0x1E, 0xDD, 0xD8, 0xE9, 0xB9, 0xB1, 0xFB, 0xC4, 0x19, 0xDF, 0xFF, 0x5C, 0xC5, 0xE5, 0x32, 0x20, 0x9D, 0xD5, 0x44, 0x4B, 0xDF, 0x3C, 0x5A, 0x67, 0x65, 0x2C, 0xD7, 0xA8, 0x7B, 0xC7, 0xC, 0x13, 0x17, 0x6C, 0x23, 0xD9, 0x69, 0x75, 0xE3, 0xEC, 0xE9, 0xB4, 0xBF, 0x43, 0x4F, 0xE4, 0xEC, 0x76, 0x83, 0x37, 0xEC, 0xDE, 0xEC, 0xF4, 0xF, 0x9D, 0x1A, 0xEF, 0xB, 0x6B, 0x70, 0xC7, 0x94, 0x17, 0x81, 0x1E, 0x77, 0x67, 0xE, 0xD4, 0x88, 0x3B, 0x73, 0xF8, 0x77, 0xB1, 0xB6, 0xC0, 0xB1, 0xBE, 0x9E, 0xA7, 0x36, 0xFA, 0xC8, 0x64, 0xF, 0xCD, 0x3E, 0xA2, 0x33, 0x8F, 0x8A, 0x35, 0x1, 0xF7, 0xD9, 0x19, 0x26, 0xE6, 0x50, 0xA5, 0x4C, 0x5F, 0x41, 0x8D, 0x74, 0x6, 0x22, 0xC1, 0x5, 0x6F, 0x9C, 0x4, 0xFB, 0x8, 0x8F, 0x10, 0xFA, 0x8, 0x1, 0x79, 0x3F, 0xF8, 0x5F, 0xE3, 0x1, 0x2F, 0x4E, 0xD6, 0x17, 0xEE, 0xB8, 0x66, 0x50, 0x89, 0xA, 0x2E, 0xD3, 0x17, 0x23, 0x65, 0xF1, 0x72, 0x14, 0x17, 0xE4, 0xD2, 0x97, 0x5E, 0x5C, 0x68, 0x97, 0xBE, 0xF4, 0x42, 0x54, 0xA8, 0x86, 0xA8, 0xA8, 0x22, 0x35, 0x34, 0xB1, 0x1C, 0x7F, 0x8D, 0x7, 0x4, 0x3B, 0x5C, 0x51, 0xB, 0xC2, 0xD2, 0x76, 0xC, 0xD, 0x75, 0xBA, 0x3E, 0x74, 0xB4, 0xDF, 0x15, 0x4D, 0xD1, 0x88, 0xB, 0x6E, 0x6, 0x9F, 0x71, 0x62, 0xB5, 0x14, 0x4D, 0x23, 0x58, 0x5B, 0x89, 0x6, 0xCC, 0xC9, 0x37, 0x48, 0x92, 0x31, 0x99, 0xD3, 0x78, 0x73, 0x59, 0x71, 0xA8, 0x91, 0x3, 0x6B, 0x6C, 0xBC, 0xC2, 0xCA, 0xC, 0xAD, 0x1B, 0x32, 0xA3, 0xD6, 0xD, 0x59, 0xB4, 0xA2, 0x41, 0xFD, 0x3D, 0x17, 0x9C, 0x9C, 0x92, 0x42, 0xF5, 0xD0, 0x74, 0x76, 0x6, 0xA2, 0xC1, 0xA1, 0x19, 0x90, 0x40, 0xDF, 0x4F, 0x59, 0x5, 0x94, 0x1C, 0x7A, 0x8, 0x74, 0x1, 0x62, 0xC1, 0x15, 0x76, 0x3D, 0xD3, 0x8, 0x51, 0x73, 0xD2, 0x16, 0xA8, 0x88, 0x2D, 0xC8, 0x22, 0x55, 0xA9, 0x54, 0xD9, 0xC0, 0x37, 0x5E, 0xBF, 0x61, 0xD2, 0x5E, 0xB1, 0xB0, 0x55, 0xCB, 0x74, 0xCD, 0x61, 0xF7, 0x9F, 0x11, 0x4E, 0xDF, 0x38, 0x41, 0x6C, 0x50, 0xCE, 0xBA, 0xC, 0x8F, 0xDA, 0x8C, 0xD3, 0xE2, 0x6, 0xDA, 0x5D, 0x29, 0xE6, 0x7C, 0x81, 0x5D, 0x5B, 0xD6, 0xB, 0xE2, 0xBD, 0x65, 0xBD, 0x78, 0x8C, 0x4B, 0x36, 0x24, 0x1D, 0x1E, 0x23, 0xA5, 0xA1, 0x51, 0x79, 0xC3, 0x7A, 0xF8, 0x55, 0xFD, 0x17, 0x48, 0x88, 0xCE, 0x48, 0x45, 0x35, 0x8, 0xE1, 0xA7, 0xB, 0x21, 0xE1, 0xD5, 0xF6, 0x84, 0x91, 0x56, 0xDA, 0xE0, 0xF2, 0x3, 0x5D, 0x76, 0xBC, 0xBF, 0x7E, 0x69, 0x58, 0x6, 0x5F, 0xBA, 0x39, 0x63, 0xA4, 0xAF, 0x4B, 0xFE, 0xB0, 0xB0, 0x15, 0x76, 0x44, 0x48, 0x76, 0x58, 0x55, 0x3C, 0x85, 0x69, 0x10, 0xBB, 0xFC, 0x2, 0x12, 0xF2, 0x53, 0x35, 0xAA, 0x15, 0xF0, 0x2C, 0x64, 0x82, 0x48, 0x1A, 0x96, 0x6B, 0x53, 0x37, 0xAE, 0x7, 0x4D, 0xA5, 0x1F, 0x70, 0x5C, 0xAA, 0x61, 0x59, 0x52, 0xF0, 0x56, 0xC1, 0x55, 0x76, 0x45, 0x20, 0xD5, 0x5B, 0xA5, 0xA, 0x23, 0xA0, 0xD3, 0x7, 0x2F, 0x24, 0x16, 0x23, 0xD, 0x2D, 0x83, 0xC6, 0x5F, 0xE3, 0x1, 0xB9, 0x36, 0xA0, 0x5, 0x77, 0xC6, 0x28, 0xE7, 0xCF, 0x47, 0xAA, 0x90, 0x65, 0xA9, 0x95, 0xBB, 0xC1, 0x2F, 0x44, 0xAD, 0x19, 0x94, 0x87, 0xA2, 0x3C, 0xDF, 0x64, 0x4A, 0xB, 0x59, 0x22, 0x79, 0xFC, 0x26, 0xD0, 0xF2, 0x58, 0x83, 0x66, 0x1D, 0xFF, 0x73, 0x3C, 0x2E, 0x6, 0x4F, 0x96, 0xA1, 0xA6, 0xC7, 0x54, 0xEA, 0x86, 0xE, 0xEB, 0x33, 0x4E, 0x1C, 0xD6, 0x95, 0x6E, 0x20, 0x5B, 0xAC, 0xFF, 0x39, 0x1A, 0xD4, 0xF0, 0xC4, 0x56, 0x4C, 0x5A, 0xB8, 0xCC, 0x67, 0x34, 0xB5, 0x84, 0x52, 0x7, 0x9E, 0x26, 0x19, 0x7F, 0x8C, 0xC4, 0xE0, 0x56, 0xF0, 0x6E, 0xB9, 0xFE, 0x1A, 0x10, 0x78, 0xC1, 0xA4, 0x62, 0xBD, 0x71, 0x59, 0xA, 0xC9, 0x6F, 0xAA, 0xE7, 0x9A, 0x63, 0x8A, 0x1F, 0x1C, 0x9A, 0x6C, 0xA5, 0x8C, 0xE5, 0x45, 0xCB, 0x4, 0xE2, 0x24, 0xB6, 0x22, 0xD0, 0x4, 0x5B, 0x97, 0x17, 0xF0, 0x9B, 0x5A, 0x33, 0x46, 0x95, 0x29, 0x78, 0x37, 0x7E, 0x1B, 0xD6, 0x29, 0x78, 0x97, 0x7A, 0x34, 0x26, 0xA4, 0x85, 0xE5, 0xC6, 0x56, 0xC2, 0x64, 0x8D, 0x42, 0x5A, 0xD8, 0x7, 0x85, 0x14, 0x3B, 0xED, 0x87, 0x58, 0xB0, 0x9F, 0x9E, 0x20, 0x42, 0x3F, 0xAD, 0x55, 0x8E, 0x9, 0x10, 0x5E, 0xB8, 0x3, 0xE7, 0xBA, 0xD1, 0x6, 0x6E, 0x18, 0x36, 0x83, 0x31, 0x8C, 0x38, 0x83, 0x81, 0x2E, 0xE8, 0xEF, 0xE3, 0x17, 0xF4, 0xF7, 0xA6, 0xD6, 0xE8, 0xB7, 0x6B, 0x4D, 0xFD, 0x38, 0x74, 0xED, 0x58, 0x4F, 0xB8, 0x9B, 0xBB, 0x7, 0xD, 0x1B, 0xA8, 0x59, 0xE3, 0x1D, 0xC, 0x32, 0x6B, 0xD8, 0xA8, 0x5D, 0xC3, 0xA9, 0x27, 0x96, 0x58, 0xA7, 0x9E, 0xC4, 0xC4, 0x76, 0xE0, 0x44, 0xB5, 0x23, 0x4C, 0x54, 0xFB, 0x3E, 0x7, 0x86, 0xC9, 0xFF, 0xDD, 0xF7, 0x7D, 0x9A, 0x7F, 0x8E, 0x94, 0x7, 0x28, 0x30, 0x38, 0x0, 0xF4, 0x7D, 0x1A, 0xEF, 0xFC, 0x7, 0x30, 0xCA, 0x3, 0x21, 0xCA, 0xCF, 0x56, 0xA0, 0xB7, 0x2, 0x4F, 0x20, 0x2D, 0xD7, 0x1E, 0xA7, 0xD3, 0xF1, 0x6, 0x74, 0xD7, 0xE3, 0xEF, 0xE9, 0x2D, 0xFA, 0x94, 0xD4, 0x3, 0x72, 0x69, 0x78, 0xC4, 0x5D, 0x1A, 0x1E, 0x99, 0x2, 0x5E, 0xE, 0xF1, 0xBF, 0x46, 0x2, 0x72, 0x79, 0xD3, 0x65, 0xAB, 0xC1, 0x9E, 0xE9, 0xC1, 0x65, 0xEA, 0x51, 0x4A, 0xA7, 0xF4, 0xE0, 0x1A, 0x8C, 0x83, 0xFF, 0x39, 0x12, 0x89, 0x8B, 0x90, 0xE0, 0x53, 0x95, 0xB, 0x10, 0x9, 0x2E, 0x2A, 0xC7, 0x0, 0x4B, 0xC2, 0xFC, 0x7B, 0x2C, 0x30, 0x18, 0x32, 0x1A, 0xEC, 0x9A, 0x43, 0xE1, 0xAE, 0x69, 0x1E, 0xD, 0x19, 0x77, 0xE4, 0x7F, 0x6C, 0xF7, 0xE3, 0x7F, 0xC8, 0x24, 0xD9, 0x59, 0x7D, 0xFF, 0xBE, 0x7D, 0x4B, 0x9, 0xB9, 0xD6, 0x7C, 0x10, 0xE2, 0xBE, 0x35, 0x23, 0x7D, 0xBF, 0xCF, 0x4E, 0xF9, 0x11, 0x98, 0xFF, 0xAC, 0x41, 0x82, 0xCC, 0x21, 0xDF, 0x5F, 0xF8, 0x2E, 0xD3, 0xE3, 0xFF, 0xA8, 0x63, 0xDE, 0x9F, 0x80, 0x6D, 0x9A, 0xF, 0x62, 0xBA, 0x62, 0x92, 0xF4, 0x2F, 0xE2, 0x47, 0x3C, 0xB2, 0x9F, 0x86, 0xF0, 0x85, 0x85, 0xBA, 0xF9, 0x1B, 0x6A, 0x97, 0x1F, 0xB3, 0x13, 0xEF, 0x18, 0xE1, 0x23, 0x2F, 0xEE, 0xC6, 0xAF, 0xE4, 0xF5, 0x81, 0xEF, 0x33, 0x44, 0x76, 0x6, 0x29, 0x32, 0xB6, 0x7, 0xDD, 0xAB, 0x5E, 0x20, 0xB1, 0xFC, 0x9B, 0xBB, 0xD9, 0x1D, 0xD1, 0xDA, 0x34, 0xC3, 0x74, 0xAD, 0xEF, 0x7D, 0x11, 0xD2, 0xFA, 0xDE, 0x17, 0x44, 0xAD, 0xFB, 0xBD, 0x3F, 0x1C, 0x81, 0x91, 0x78, 0xD, 0xC6, 0x65, 0xE, 0xED, 0x89, 0xED, 0xCF, 0xA0, 0x1B, 0xE3, 0xA, 0xA6, 0x45, 0xE9, 0x60, 0x72, 0x7D, 0xFD, 0x5, 0xC6, 0xF8, 0x35, 0x18, 0x97, 0x39, 0xEE, 0xB4, 0xFE, 0x86, 0xFA, 0xC1, 0x37, 0x46, 0x10, 0x39, 0x74, 0xB7, 0xE3, 0xAE, 0xC2, 0xD2, 0x35, 0xC3, 0xB4, 0x74, 0x1D, 0x33, 0x63, 0x77, 0x3B, 0xA8, 0x8B, 0x5A, 0x81, 0x4, 0x99, 0xC2, 0x9C, 0x4E, 0x47, 0x68, 0x68, 0x5B, 0x81, 0x4, 0x19, 0xBE, 0xCB, 0xBE, 0x77, 0xDF, 0xC0, 0xC, 0xF0, 0x3, 0x25, 0x8, 0x55, 0x81, 0xE2, 0x5A, 0x40, 0x82, 0xCC, 0xED, 0xE1, 0x7E, 0x80, 0x99, 0xCE, 0xA, 0x8B, 0x8B, 0x9C, 0xF6, 0xBE, 0x47, 0xB9, 0xED, 0x59, 0xE, 0x4C, 0xF6, 0x66, 0x46, 0xFA, 0xA6, 0x10, 0x6, 0x82, 0xD3, 0x21, 0x3F, 0x14, 0xBF, 0x48, 0x3, 0x59, 0x40, 0x42, 0xCC, 0xCE, 0x99, 0x3E, 0xE7, 0x3, 0x30, 0x23, 0xFA, 0x40, 0x69, 0x42, 0x68, 0x76, 0x2F, 0x20, 0x45, 0xE6, 0xBE, 0xDF, 0xA3, 0x2A, 0x1E, 0x23, 0x88, 0x94, 0x99, 0x16, 0x48, 0x85, 0x9E, 0x31, 0x82, 0x88, 0xB0, 0xFB, 0xAF, 0x1F, 0xB4, 0x37, 0x9B, 0x61, 0x5A, 0x13, 0x3B, 0x9, 0xFB, 0xFD, 0xFB, 0x5D, 0x7, 0xB4, 0x46, 0x98, 0xA8, 0x75, 0xEB, 0x1E, 0x6, 0x55, 0x1A, 0x41, 0x9A, 0xCE, 0xF9, 0x94, 0x7D, 0x5F, 0x2C, 0xDB, 0xE5, 0x48, 0x6E, 0xAF, 0x70, 0x82, 0xD8, 0xF9, 0xDE, 0x9F, 0xE, 0x48, 0xE3, 0x5F, 0x40, 0x92, 0xCC, 0xE3, 0x8C, 0x4C, 0x46, 0x66, 0x8C, 0x2A, 0x2, 0x4E, 0xDD, 0x5E, 0x28, 0x61, 0xA, 0xF7, 0xE2, 0x56, 0x48, 0xEB, 0x9F, 0x95, 0x2A, 0x4A, 0x7, 0x30, 0x71, 0xC7, 0xBF, 0xAE, 0x5F, 0xFD, 0xFE, 0x17, 0xEB, 0x26, 0x67, 0xC9, 0x35, 0x8F, 0xA0, 0x7D, 0x35, 0xBB, 0xD3, 0x19, 0xAD, 0xA8, 0x33, 0x4C, 0xAC, 0x14, 0xDA, 0xDE, 0x4E, 0xBF, 0x39, 0xAA, 0x35, 0xC1, 0x64, 0xAD, 0xFB, 0xF1, 0x84, 0x56, 0xFA, 0x19, 0x26, 0x6A, 0x19, 0x77, 0x3C, 0x5D, 0x7F, 0x8F, 0x5F, 0x59, 0x69, 0xB0, 0xBC, 0xFB, 0xA0, 0x10, 0x24, 0xED, 0xB3, 0x3D, 0x63, 0x5A, 0x13, 0x16, 0x17, 0xF9, 0xCA, 0x6C, 0x7F, 0x6, 0xCF, 0xCD, 0xBC, 0x51, 0x5A, 0xA, 0xBF, 0x2E, 0xFA, 0xF9, 0x83, 0xB5, 0xA0, 0x5, 0x24, 0xC8, 0x28, 0xFD, 0x38, 0x68, 0x34, 0x42, 0x1E, 0x25, 0x46, 0xE8, 0x7E, 0xFF, 0xFD, 0xF9, 0xCA, 0x8E, 0xF, 0x64, 0x6C, 0x58, 0xE1, 0x71, 0xB1, 0x6F, 0xBB, 0x63, 0x87, 0xAF, 0x13, 0x5A, 0xB1, 0x16, 0x9C, 0x16, 0xB5, 0x9F, 0x1D, 0x63, 0x6C, 0x97, 0x77, 0x2C, 0x8F, 0x8C, 0xAA, 0x6F, 0xE2, 0xB6, 0xC1, 0xF5, 0x67, 0xC7, 0x8A, 0x47, 0x5, 0xD9, 0xB8, 0x3F, 0x50, 0x52, 0x4C, 0xD5, 0x61, 0x8F, 0x8C, 0xD2, 0xB, 0x48, 0x92, 0xB9, 0xF3, 0xBC, 0xD0, 0x1, 0xA5, 0x5, 0x27, 0x89, 0x19, 0x5E, 0x15, 0xC8, 0x12, 0xE4, 0x85, 0xD2, 0x84, 0x7A, 0xA4, 0xC6, 0xCE, 0x18, 0x49, 0xC4, 0x8D, 0xFF, 0xFE, 0xEE, 0xD8, 0x91, 0x21, 0x43, 0xD1, 0xC8, 0x49, 0x57, 0x24, 0x82, 0x6A, 0x63, 0xF5, 0xD7, 0x1, 0xED, 0x7B, 0x66, 0x98, 0x58, 0xDB, 0x6E, 0x9D, 0x3E, 0x81, 0x67, 0xED, 0xDE, 0x28, 0x51, 0x69, 0xD8, 0xB1, 0xDD, 0x17, 0xB6, 0xAA, 0x79, 0xA1, 0x71, 0xA1, 0xDF, 0x1D, 0xDB, 0xB3, 0x4B, 0xA4, 0xF2, 0x4F, 0xA4, 0x6D, 0x15, 0x3F, 0x54, 0xA, 0xE4, 0xCC, 0xFF, 0xDD, 0x31, 0x64, 0xFA, 0xEE, 0x11, 0x92, 0x80, 0x40, 0xDA, 0xDE, 0x4, 0x91, 0x24, 0xDA, 0x58, 0xEF, 0xE0, 0x39, 0x9B, 0xF3, 0xE7, 0x59, 0x11, 0x32, 0xEA, 0x59, 0x6D, 0xAB, 0xAF, 0xBF, 0x45, 0x56, 0x65, 0x4D, 0xF9, 0x55, 0x8, 0x6C, 0x53, 0xEB, 0x93, 0x45, 0xDB, 0xDA, 0x9A, 0xC3, 0xDC, 0xF, 0x48, 0x8D, 0x9B, 0x31, 0x82, 0x8, 0xB7, 0xE7, 0x3, 0xE0, 0xC8, 0xB9, 0xC2, 0x8, 0x22, 0xB5, 0xB6, 0xBB, 0x1D, 0x16, 0x95, 0x19, 0x8C, 0xCB, 0x30, 0x59, 0xC, 0x9, 0xF2, 0x12, 0xDF, 0x1A, 0x8F, 0xDF, 0x39, 0xE3, 0xD9, 0x5A, 0xDD, 0x94, 0xB6, 0x49, 0x36, 0xB0, 0x1C, 0xF0, 0x23, 0xFB, 0x43, 0x49, 0xF3, 0x98, 0x57, 0x99, 0xF, 0x60, 0xEA, 0x70, 0xC, 0x67, 0x9C, 0x18, 0x43, 0xA7, 0x4D, 0xAD, 0xCA, 0xA4, 0x6A, 0x98, 0x2C, 0x92, 0xAB, 0xCC, 0x50, 0xD9, 0xBF, 0x44, 0xA2, 0x7E, 0xC7, 0xF5, 0x90, 0xC, 0xE, 0x8F, 0xEE, 0x42, 0xA0, 0xE9, 0x65, 0xC9, 0xB9, 0x64, 0x52, 0xE9, 0x62, 0x8C, 0x6, 0xA6, 0xE9, 0x49, 0xE9, 0xC2, 0xDA, 0x20, 0x6C, 0x79, 0xC3, 0xB1, 0x17, 0xA4, 0xDE, 0xC2, 0xB, 0x8B, 0x28, 0x7C, 0xEA, 0xBF, 0x76, 0xE7, 0xB, 0x87, 0x1E, 0x7D, 0xF9, 0x83, 0x13, 0xC4, 0x58, 0x63, 0x98, 0x4C, 0x4C, 0x61, 0x85, 0x56, 0x4D, 0x22, 0xD, 0xF8, 0xFC, 0x5, 0xCC, 0x8C, 0xDF, 0x38, 0xE2, 0xC3, 0xE5, 0xF5, 0x3E, 0xFB, 0x65, 0xE7, 0x42, 0xF3, 0x13, 0xD2, 0x3, 0x7D, 0x52, 0x8, 0x92, 0x45, 0x51, 0x5C, 0x93, 0x56, 0xB3, 0xC, 0x2F, 0xB1, 0x37, 0x85, 0x98, 0xAB, 0x9C, 0x69, 0xD6, 0x74, 0x55, 0x2D, 0x92, 0xE1, 0x84, 0x56, 0xAE, 0x4F, 0x16, 0x55, 0x59, 0x37, 0xBC, 0x2B, 0x93, 0xEB, 0x23, 0x20, 0xFB, 0xA2, 0x10, 0x35, 0xAB, 0x9C, 0x75, 0x4E, 0x59, 0xC9, 0x12, 0x13, 0x88, 0xED, 0x7, 0x8B, 0xAC, 0xFC, 0xA, 0x54, 0x7F, 0x87, 0xA4, 0xD7, 0x34, 0xA2, 0xB6, 0x28, 0x74, 0x72, 0x96, 0x2C, 0x47, 0x55, 0x17, 0x2, 0x55, 0x8F, 0x77, 0x36, 0xD9, 0xDD, 0xF1, 0x58, 0x2E, 0x4, 0xA2, 0xDE, 0x95, 0xE9, 0x7A, 0x48, 0x2E, 0x3, 0x2E, 0xF8, 0x62, 0x50, 0x15, 0x8B, 0x82, 0xE9, 0xE4, 0x66, 0x3, 0x8A, 0xB, 0x83, 0xA8, 0xD8, 0xD8, 0x84, 0x37, 0xC, 0xED, 0x56, 0x17, 0x9C, 0xA8, 0xD6, 0x36, 0x2A, 0x29, 0xF1, 0xBE, 0x7F, 0xC1, 0x89, 0x6A, 0xAA, 0x48, 0xE, 0xF0, 0x95, 0xCD, 0x2B, 0x98, 0xAA, 0xF5, 0xE4, 0xEF, 0xDE, 0xE6, 0xA0, 0xF0, 0x7E, 0xE9, 0x2F, 0x91, 0xD8, 0x2D, 0x69, 0x29, 0x87, 0xA4, 0x77, 0x81, 0xCA, 0xF8, 0x62, 0x10, 0x63, 0x3C, 0xF2, 0x8D, 0x93, 0x45, 0xD2, 0xFE, 0x44, 0x54, 0x5F, 0x2C, 0xAA, 0xB2, 0x6B, 0x93, 0x4E, 0xE1, 0x19, 0x3B, 0xE3, 0x44, 0x35, 0x6B, 0xFC, 0xD5, 0xCF, 0xC9, 0xEF, 0x13, 0x57, 0x5C, 0x71, 0x88, 0xAA, 0x8E, 0x3C, 0x90, 0xFC, 0x83, 0x49, 0x2B, 0xB1, 0x7C, 0xCF, 0xA0, 0xB7, 0x0, 0xDE, 0x10, 0x51, 0x22, 0x36, 0x1F, 0xF6, 0xA4, 0x6D, 0xF3, 0xE1, 0x97, 0x6E, 0x60, 0xFA, 0xFE, 0x96, 0x25, 0x4F, 0xDF, 0xF3, 0x43, 0xA6, 0xBF, 0xB1, 0x24, 0x4F, 0x18, 0x21, 0x6E, 0x47, 0xB3, 0x3F, 0xB5, 0xD8, 0x7A, 0x70, 0x42, 0x69, 0xEB, 0xC1, 0x3C, 0xAF, 0xF, 0xD9, 0x11, 0x59, 0x88, 0x2F, 0x20, 0x49, 0xA6, 0xCF, 0x1F, 0xDC, 0x22, 0xD3, 0x97, 0x37, 0x4C, 0x90, 0xE2, 0x86, 0x4B, 0x96, 0x5C, 0x94, 0x6E, 0x92, 0x36, 0x47, 0x6B, 0xDC, 0x1F, 0x1A, 0xB1, 0xBA, 0xF9, 0x40, 0x3E, 0x8C, 0xF8, 0x1, 0x9F, 0x1F, 0xF8, 0x27, 0x8B, 0xA8, 0x5C, 0x9E, 0x32, 0xE8, 0x14, 0xC8, 0xA, 0x23, 0x88, 0x88, 0xFB, 0x4D, 0x62, 0xB6, 0x5E, 0xF, 0xD2, 0x4C, 0xBD, 0x79, 0x93, 0x57, 0xF9, 0x8E, 0xB3, 0x5D, 0x76, 0x28, 0xF, 0xEC, 0xB7, 0xCC, 0xB1, 0x62, 0xF9, 0x27, 0x8F, 0x20, 0xAE, 0xFC, 0x83, 0xFB, 0x8C, 0x9B, 0xA4, 0xFB, 0x62, 0x7, 0x15, 0x5A, 0x2D, 0xFE, 0xE5, 0xD2, 0xD7, 0x8C, 0xB9, 0xD2, 0x9A, 0xB, 0x65, 0x12, 0x9E, 0x8C, 0x33, 0xE9, 0x95, 0x50, 0x59, 0xE5, 0x87, 0x5F, 0xF4, 0x62, 0x74, 0x4A, 0xD8, 0xD, 0xB1, 0x78, 0xEA, 0xAF, 0xDF, 0x13, 0xD6, 0x3A, 0x66, 0x90, 0x24, 0x63, 0x76, 0x58, 0x65, 0x9B, 0x30, 0x92, 0x88, 0x3B, 0x60, 0x5, 0x39, 0x61, 0x24, 0x91, 0xFE, 0xF0, 0x93, 0x59, 0xC8, 0x75, 0xE8, 0xD, 0xA7, 0x36, 0xE6, 0xF4, 0x39, 0x92, 0x8B, 0x3D, 0xCB, 0xAF, 0x58, 0xD7, 0xB1, 0x80, 0x24, 0x99, 0x6, 0xE9, 0x5E, 0x27, 0x88, 0x24, 0x61, 0x63, 0x23, 0xC0, 0xC8, 0xD9, 0x36, 0x0, 0x14, 0xE7, 0x7B, 0xF7, 0xFD, 0xFC, 0xF9, 0xFE, 0x42, 0x4D, 0xC6, 0x6F, 0x6, 0xAD, 0xB3, 0x2D, 0x58, 0xD7, 0x71, 0x79, 0xD5, 0x25, 0xB, 0xAD, 0x47, 0x3E, 0x59, 0xB4, 0x91, 0xBA, 0xB8, 0x6A, 0xF3, 0x7D, 0x28, 0xBE, 0xDC, 0x80, 0xC6, 0xF5, 0xC5, 0x20, 0xC6, 0xF5, 0x6A, 0x7, 0x7C, 0xE7, 0x64, 0x42, 0x89, 0x4A, 0x52, 0xB2, 0xD0, 0x7C, 0x74, 0xC1, 0x89, 0x29, 0xD5, 0x19, 0xAB, 0xC7, 0x19, 0x1C, 0x2A, 0xB7, 0x10, 0x88, 0x7A, 0x83, 0xE, 0x5A, 0x4A, 0x16, 0x9C, 0xA6, 0xC6, 0xF7, 0xC, 0x5B, 0x10, 0x4F, 0x10, 0x41, 0x22, 0xAF, 0xBB, 0xC2, 0xE6, 0xBF, 0x48, 0x53, 0x7F, 0xC3, 0x4, 0xA9, 0x4B, 0x27, 0x7F, 0xD1, 0x8D, 0xDA, 0x9, 0xA5, 0x95, 0x21, 0xBF, 0x74, 0xC3, 0xCF, 0xF, 0xE2, 0x89, 0xB4, 0x80, 0x4, 0x99, 0xEA, 0xFE, 0x3C, 0x7F, 0xA1, 0xAE, 0x3E, 0x33, 0x4C, 0x8C, 0x52, 0x73, 0xBF, 0xEF, 0xBF, 0x50, 0xFF, 0x93, 0x19, 0x26, 0x6A, 0x75, 0x5C, 0x8A, 0x71, 0x4D, 0xB9, 0xDB, 0xC3, 0xEF, 0x59, 0xFE, 0x83, 0x44, 0xAB, 0xE, 0x97, 0xAF, 0xFB, 0xE3, 0x7C, 0x44, 0x37, 0xE7, 0x66, 0x98, 0x16, 0xC7, 0x4B, 0x6E, 0x4F, 0x87, 0x5F, 0x64, 0x72, 0xB0, 0x80, 0x4, 0x99, 0x2A, 0xE3, 0xC8, 0x3C, 0x65, 0x82, 0x8, 0x12, 0x62, 0x5C, 0x44, 0x5, 0xCC, 0x75, 0x2F, 0x2, 0x31, 0x97, 0xC4, 0xFD, 0x70, 0xF8, 0xC9, 0xCF, 0x15, 0x62, 0x70, 0x5F, 0xE1, 0x24, 0xB1, 0x2F, 0x6C, 0xC4, 0x9E, 0x31, 0x92, 0x88, 0xF9, 0xC1, 0x63, 0x33, 0x62, 0x24, 0x91, 0xE7, 0x2F, 0x2E, 0x32, 0x62, 0x4, 0x11, 0xBF, 0x62, 0x6B, 0xF0, 0xFE, 0xE9, 0x45, 0x20, 0xE6, 0xF5, 0xF5, 0xFE, 0x7D, 0xC0, 0x2C, 0xE0, 0xB, 0x48, 0x90, 0x69, 0x92, 0xA7, 0xC0, 0xE3, 0xE4, 0x51, 0x62, 0x84, 0x1A, 0xA5, 0x93, 0x6B, 0x20, 0x7D, 0x33, 0x4E, 0x55, 0xEB, 0x8F, 0xE7, 0x3D, 0xB2, 0xF5, 0xB3, 0x80, 0x4, 0x99, 0x71, 0xEE, 0xBF, 0xAC, 0x30, 0xF0, 0x35, 0xC8, 0x1F, 0x1A, 0x6D, 0xA5, 0x30, 0x6, 0x9A, 0xC3, 0xE4, 0xF8, 0x1A, 0xE4, 0x93, 0x45, 0x54, 0xBE, 0x79, 0x8F, 0x32, 0xCC, 0x95, 0xF6, 0xD, 0x13, 0xA4, 0x74, 0xC1, 0x43, 0x63, 0xF6, 0x82, 0x13, 0x4B, 0x45, 0x4B, 0x96, 0xF4, 0xB8, 0xAD, 0x63, 0xC1, 0xA9, 0x6A, 0x3, 0x4B, 0xAA, 0x50, 0xDC, 0x26, 0x9C, 0xA8, 0xF6, 0xCC, 0xF, 0xF9, 0x2F, 0x3F, 0x20, 0xA3, 0xF6, 0x1B, 0xA6, 0x48, 0xD, 0xE6, 0xA7, 0xF8, 0xFA, 0x75, 0x5D, 0x89, 0xAC, 0x4C, 0x3E, 0x18, 0x71, 0xC1, 0xF2, 0xF0, 0x78, 0x9C, 0x7E, 0x90, 0x98, 0x2D, 0x20, 0x41, 0x26, 0xAF, 0xF5, 0xF1, 0xB2, 0x43, 0x26, 0xD9, 0x2F, 0x94, 0x20, 0xC4, 0x3, 0xFE, 0x8C, 0xB, 0x48, 0x90, 0x29, 0x59, 0x27, 0x44, 0xCD, 0x92, 0x9F, 0x81, 0x9D, 0xBE, 0x2B, 0xAC, 0x1C, 0xFF, 0xD0, 0x68, 0xC5, 0x59, 0x8A, 0xA6, 0xE0, 0xA6, 0x6E, 0x92, 0x72, 0x87, 0x56, 0x90, 0x35, 0x87, 0xAA, 0x3A, 0x9B, 0xC5, 0x7F, 0x70, 0x33, 0xF2, 0x9A, 0x43, 0x54, 0xBD, 0x76, 0x22, 0x38, 0x95, 0x7D, 0x11, 0x88, 0x7A, 0xF5, 0x91, 0x59, 0xAC, 0xF3, 0x5B, 0x40, 0x82, 0x4C, 0x63, 0x12, 0xDE, 0xE0, 0xD6, 0xCE, 0x5, 0x27, 0x46, 0xAA, 0xBD, 0xEF, 0xCE, 0x3B, 0x81, 0x59, 0x35, 0x66, 0x98, 0x66, 0xD7, 0x28, 0xDB, 0x7B, 0x7F, 0x66, 0x1, 0xA9, 0xFE, 0xCC, 0xB6, 0x28, 0x3D, 0x62, 0xCE, 0x70, 0xB3, 0xE4, 0x63, 0x93, 0x33, 0x5C, 0x29, 0x8D, 0x15, 0x65, 0xC6, 0x8D, 0xE5, 0x4D, 0xC3, 0x65, 0xF2, 0xEC, 0x32, 0xE8, 0x2C, 0x30, 0x42, 0x25, 0xBC, 0x4A, 0xF6, 0xE, 0xD9, 0x8, 0x7E, 0xE1, 0x5A, 0x26, 0xBD, 0x8A, 0x7C, 0x62, 0x45, 0x24, 0x7E, 0xA0, 0x4A, 0xE, 0x17, 0xB4, 0x62, 0x7A, 0x90, 0x56, 0xFE, 0xD5, 0x9E, 0xF1, 0xB2, 0xA, 0x99, 0x6B, 0x26, 0x6, 0xDD, 0x28, 0x53, 0xED, 0x59, 0x25, 0x1F, 0x61, 0xC3, 0xAC, 0xE7, 0x6C, 0x33, 0xCC, 0x56, 0x7B, 0xD6, 0x88, 0x67, 0x5C, 0xB7, 0x11, 0xCF, 0x8D, 0xC2, 0x87, 0x4C, 0xF3, 0xF2, 0xC8, 0xA1, 0xB, 0xD8, 0xFE, 0xE0, 0x84, 0xC4, 0x1F, 0x2, 0x3E, 0xCE, 0xB, 0x48, 0x96, 0xC1, 0xC6, 0x96, 0x37, 0x4C, 0x90, 0x3A, 0xE6, 0xEE, 0x59, 0xEF, 0xB, 0xF1, 0x88, 0x1C, 0x58, 0x79, 0x11, 0xB7, 0x9D, 0x56, 0xA9, 0xB2, 0x96, 0xEB, 0xE4, 0x81, 0x6F, 0x8, 0xBE, 0x8, 0xC4, 0xA, 0x99, 0x73, 0xE3, 0x54, 0xA3, 0x92, 0xEF, 0x81, 0x1D, 0xCF, 0xE8, 0x9E, 0xDB, 0x1F, 0x1A, 0x51, 0x9B, 0x2F, 0xC6, 0x84, 0xB0, 0xEF, 0xC1, 0xC2, 0xDB, 0xE8, 0x7E, 0xB0, 0x4, 0x1B, 0xFF, 0x6E, 0x35, 0x4F, 0x54, 0x99, 0x68, 0xD5, 0x9A, 0x82, 0x35, 0xC9, 0xFD, 0x8E, 0xA7, 0x64, 0xF9, 0x18, 0x1C, 0x8C, 0xF8, 0xE9, 0x92, 0x67, 0xCA, 0x58, 0x56, 0x24, 0xE5, 0x11, 0x2F, 0x89, 0x35, 0x89, 0xA8, 0x5B, 0xD7, 0x42, 0x72, 0x99, 0x9C, 0x7B, 0x76, 0x41, 0x96, 0xA5, 0x2B, 0x4A, 0x7A, 0xC1, 0xD6, 0xA7, 0x9F, 0x2D, 0xAE, 0x6D, 0xF5, 0x85, 0x33, 0x63, 0xFD, 0x1E, 0x39, 0x3A, 0x90, 0xFD, 0xA1, 0x11, 0x23, 0x2C, 0xF9, 0x45, 0x19, 0x93, 0xDC, 0xF7, 0x78, 0x36, 0xBC, 0x29, 0x44, 0x4D, 0x95, 0x35, 0x21, 0x1B, 0xD4, 0x82, 0x53, 0xD5, 0x1A, 0x3B, 0xE, 0x26, 0x15, 0xEE, 0x9B, 0xB0, 0xA2, 0x10, 0x35, 0x6F, 0xAD, 0x8, 0x37, 0xC2, 0x85, 0x40, 0xD4, 0x7B, 0xD8, 0x9F, 0xFD, 0x9, 0xEB, 0x10, 0x67, 0x90, 0x20, 0x33, 0x30, 0x59, 0x72, 0x1D, 0x2C, 0x8C, 0x37, 0x85, 0x18, 0xB5, 0x61, 0x6C, 0x90, 0xDC, 0x26, 0x67, 0xDC, 0x44, 0xBB, 0xE6, 0xD0, 0x54, 0xC5, 0x3E, 0xFB, 0xCA, 0xF6, 0xEC, 0x8B, 0x1D, 0x90, 0x44, 0xAF, 0x9, 0x4, 0xB9, 0x56, 0x9F, 0xF7, 0x47, 0xE4, 0x7C, 0xC1, 0x2, 0x12, 0x64, 0x64, 0x31, 0x76, 0xE, 0x1, 0xE7, 0x88, 0x37, 0x83, 0x98, 0x4E, 0xB5, 0x63, 0xDF, 0x48, 0x43, 0x9E, 0x31, 0xAA, 0x8, 0x76, 0x7C, 0x62, 0x42, 0x69, 0xC7, 0x27, 0x26, 0x2E, 0x36, 0x63, 0x9C, 0x95, 0x48, 0x33, 0xC6, 0xEB, 0x9E, 0x15, 0xB7, 0xC8, 0xEC, 0xC0, 0x73, 0xB6, 0x4D, 0xE, 0xAE, 0x7B, 0xC6, 0x71, 0x35, 0x4E, 0x59, 0x95, 0x79, 0x5E, 0x3C, 0x66, 0x7C, 0xA3, 0x1F, 0xEE, 0x18, 0xA4, 0xF9, 0xC9, 0x8, 0xC2, 0xCD, 0x4F, 0xB6, 0x59, 0xBA, 0x45, 0x26, 0x9, 0x13, 0x44, 0x48, 0x74, 0x68, 0xDA, 0x4E, 0x9F, 0xAB, 0x5F, 0x9B, 0xFB, 0xE9, 0x67, 0x27, 0xF, 0xD0, 0xE5, 0x4A, 0x7F, 0x70, 0x92, 0xD8, 0xD7, 0xDE, 0xFD, 0xF6, 0xDF, 0x88, 0xF9, 0x71, 0x85, 0x53, 0xC4, 0x8C, 0xE5, 0x3A, 0xC9, 0xF0, 0xA6, 0xF9, 0x66, 0xD0, 0x9A, 0xE6, 0x55, 0xDF, 0xB8, 0xF7, 0xCB, 0xC, 0x74, 0x6C, 0x6B, 0xE, 0x51, 0xB5, 0xD3, 0xFB, 0x9F, 0x5F, 0x64, 0x6A, 0xBF, 0x80, 0x71, 0x99, 0xFA, 0x7B, 0x90, 0xBF, 0xF8, 0x29, 0xD9, 0x19, 0xA6, 0x59, 0xCA, 0x6B, 0xA6, 0xDB, 0xA0, 0x7D, 0xFA, 0x45, 0xA0, 0x25, 0xB1, 0xCE, 0xF4, 0xFD, 0x1B, 0x3D, 0xE9, 0x3C, 0xA1, 0xC4, 0x98, 0xE5, 0xFA, 0xF1, 0xFD, 0x5D, 0xEC, 0x1F, 0x27, 0xC4, 0x2, 0xBB, 0x26, 0x10, 0xE4, 0x26, 0xE3, 0xAF, 0x8, 0xA4, 0xF4, 0xC5, 0x20, 0x26, 0xB5, 0xC9, 0x5C, 0xC8, 0x52, 0xB7, 0xE0, 0x54, 0x35, 0xFB, 0xFD, 0xF3, 0x8D, 0x1E, 0xB, 0x9B, 0x61, 0x62, 0xD6, 0x35, 0xF6, 0x16, 0x94, 0xBA, 0x6D, 0x50, 0x7A, 0xFC, 0xA2, 0x7B, 0x32, 0x13, 0x4A, 0x56, 0x1A, 0xCE, 0x8F, 0x80, 0xD2, 0x70, 0x7E, 0x10, 0x95, 0x54, 0xE3, 0x54, 0x6D, 0xD9, 0xB8, 0xDA, 0x38, 0xE3, 0x26, 0xB1, 0x3F, 0x34, 0x62, 0x29, 0xDC, 0x74, 0xBF, 0xC7, 0x8C, 0xC2, 0xB, 0x48, 0x92, 0x19, 0xCE, 0xDF, 0xB8, 0x8C, 0x7, 0x29, 0x32, 0xCE, 0x1E, 0x91, 0x9, 0xC2, 0x8C, 0x11, 0x44, 0x34, 0x1B, 0xE7, 0x74, 0x25, 0xDE, 0x39, 0xBE, 0x19, 0xC4, 0x4C, 0xD2, 0x4C, 0x96, 0x55, 0xCD, 0x93, 0xC, 0xB7, 0x1B, 0xAE, 0x39, 0x54, 0xD5, 0xC2, 0x54, 0x5C, 0x33, 0xEF, 0x18, 0x88, 0xCB, 0xAE, 0x48, 0x54, 0x5D, 0x5E, 0xB1, 0x56, 0x26, 0x5, 0x3E, 0xC5, 0x5F, 0x51, 0xA8, 0x9A, 0x57, 0x55, 0xD5, 0x2C, 0xA9, 0x7A, 0x96, 0x9D, 0x7E, 0xAF, 0xB8, 0xEE, 0x7, 0x8D, 0xA8, 0x6D, 0x24, 0x37, 0x89, 0xB, 0xF4, 0x53, 0xB, 0x81, 0xA8, 0xD7, 0x4D, 0xFD, 0x9A, 0xC, 0xA4, 0xFF, 0x4D, 0xA1, 0x6A, 0xA, 0x6B, 0x82, 0x73, 0xE1, 0x37, 0x83, 0xAA, 0x28, 0xFD, 0xC3, 0xC5, 0x49, 0x1D, 0xA8, 0x53, 0x2B, 0xE, 0x4D, 0xB5, 0x49, 0xF6, 0xB8, 0x61, 0xCE, 0x83, 0x44, 0x9D, 0x3D, 0xC3, 0xCE, 0x5D, 0x4F, 0x10, 0x41, 0x82, 0x55, 0x9C, 0x15, 0x57, 0x37, 0x76, 0x47, 0x68, 0x8C, 0x56, 0x1C, 0x62, 0xC4, 0xC6, 0xC, 0x9, 0xFB, 0xD6, 0xAF, 0x28, 0x44, 0xCD, 0xBC, 0xD3, 0xC7, 0x3, 0x7A, 0x8E, 0x7A, 0x86, 0x69, 0x2B, 0x81, 0xA6, 0x10, 0xBE, 0xC4, 0x2, 0x8B, 0xCE, 0x15, 0x85, 0x18, 0x3F, 0xCE, 0x96, 0xDD, 0x8E, 0xF3, 0x1, 0xB5, 0xE4, 0x7C, 0xB2, 0xA8, 0xCA, 0xDE, 0x31, 0xB9, 0xC2, 0xDB, 0xDE, 0x9B, 0x41, 0x54, 0x2C, 0xB3, 0x8C, 0xD9, 0x3D, 0x2B, 0x7E, 0x80, 0x97, 0x2C, 0xFE, 0x12, 0x28, 0x72, 0xBA, 0x48, 0x6E, 0xA, 0x37, 0xD3, 0xBC, 0x8, 0xC4, 0xE8, 0x55, 0xB7, 0x9B, 0x8, 0x99, 0x2A, 0x5E, 0x4, 0xA2, 0x9E, 0x90, 0x85, 0x49, 0x6E, 0xB8, 0x75, 0xE6, 0x45, 0x20, 0xEA, 0x49, 0x93, 0xDC, 0x9B, 0xA0, 0x3, 0xE3, 0x4C, 0xA1, 0x9B, 0xC4, 0x1B, 0xC5, 0xB2, 0xE0, 0xA1, 0x89, 0x17, 0x81, 0x18, 0x47, 0x5D, 0xB0, 0x26, 0x31, 0x81, 0x34, 0x2F, 0x4, 0xAA, 0x1E, 0x97, 0xFE, 0x60, 0x58, 0x60, 0xE9, 0xB1, 0xE6, 0x10, 0x55, 0x6D, 0xD2, 0x88, 0x40, 0xBD, 0x99, 0x60, 0xAA, 0x16, 0x97, 0xA1, 0xD, 0xEF, 0x5, 0x27, 0xAA, 0xB9, 0xB0, 0x53, 0xD9, 0x82, 0xD3, 0xD4, 0xDA, 0x63, 0x5E, 0xED, 0xAE, 0xC7, 0xE8, 0x8D, 0x50, 0xB, 0x6F, 0x9B, 0x7D, 0xBD, 0xE5, 0x4D, 0x30, 0xAE, 0xB, 0x4E, 0x8C, 0x2B, 0xD7, 0x35, 0xD7, 0x49, 0x8D, 0x77, 0x37, 0x6F, 0x6, 0x51, 0xB1, 0xCC, 0xE, 0x45, 0x1, 0xBD, 0x40, 0xF8, 0x81, 0x92, 0x84, 0x7E, 0x59, 0x9F, 0x21, 0x86, 0x8C, 0x17, 0x4A, 0x12, 0x62, 0x47, 0x76, 0xE4, 0x57, 0xC4, 0x77, 0x63, 0x85, 0x53, 0xC5, 0x4E, 0x97, 0xFD, 0x17, 0xB2, 0x24, 0x5C, 0x13, 0x36, 0xC9, 0x41, 0xF, 0xB2, 0xFC, 0xA1, 0xA4, 0xD1, 0xE7, 0x59, 0xD6, 0x1, 0x74, 0x24, 0x86, 0x7A, 0x4B, 0x4, 0x75, 0x34, 0x7E, 0x7A, 0x43, 0xF4, 0xBE, 0xF3, 0x5D, 0x96, 0x49, 0xCC, 0xAF, 0xE9, 0x83, 0x41, 0x13, 0x64, 0x75, 0xFE, 0x5D, 0x74, 0x78, 0x5D, 0x79, 0x11, 0x88, 0x72, 0xED, 0x37, 0xC3, 0xDC, 0x27, 0x57, 0x38, 0x4D, 0x2C, 0x3B, 0x64, 0xC8, 0x32, 0xEC, 0x85, 0xD2, 0x84, 0xAA, 0x3D, 0x3B, 0x56, 0xD7, 0x1A, 0x3B, 0x74, 0xFA, 0x49, 0xA1, 0x49, 0x8A, 0x5F, 0x76, 0xE6, 0x1D, 0xDB, 0xED, 0x10, 0x1B, 0xD8, 0x1F, 0xE, 0x59, 0xF4, 0x59, 0xDE, 0x6F, 0x5F, 0xC8, 0x7C, 0xE3, 0x93, 0x42, 0x93, 0x1C, 0xAA, 0xEF, 0xF2, 0x16, 0xA8, 0x2E, 0x33, 0x4E, 0x12, 0xCB, 0x2A, 0xEC, 0x52, 0x8C, 0x5, 0x24, 0xCB, 0x7C, 0x63, 0xEE, 0x1B, 0x2B, 0x9C, 0x24, 0x96, 0xEF, 0xD8, 0x2F, 0x7B, 0xE6, 0x88, 0x59, 0x73, 0x4D, 0xA0, 0xC9, 0xB1, 0xEF, 0xE2, 0x9, 0xBD, 0x67, 0xF9, 0x7, 0xA7, 0x89, 0xDD, 0xEE, 0x5F, 0xE5, 0x8D, 0x5, 0x32, 0xED, 0xCD, 0x20, 0x9, 0x72, 0x7D, 0x3B, 0xB1, 0x73, 0x71, 0x62, 0x3F, 0xD8, 0xC9, 0xAA, 0xBF, 0xA4, 0x6D, 0xB2, 0x78, 0x47, 0xB5, 0xA2, 0x90, 0x24, 0x4B, 0xF7, 0x83, 0xDD, 0x84, 0xF0, 0x42, 0x49, 0x42, 0xE2, 0xC4, 0x73, 0xFE, 0x40, 0x2C, 0x4F, 0x2B, 0x9C, 0x24, 0x76, 0x6B, 0xE, 0x17, 0xBC, 0x73, 0x9A, 0x50, 0x92, 0xD0, 0xBD, 0x19, 0xBE, 0xF3, 0x1D, 0xFB, 0xFA, 0xBE, 0x64, 0xC8, 0xD2, 0xF2, 0x2F, 0x89, 0x2E, 0xDB, 0xB1, 0xB, 0x5E, 0xE, 0x6F, 0x6, 0x49, 0xD0, 0x1E, 0x8B, 0xA, 0xEF, 0xF4, 0x26, 0x94, 0x26, 0x74, 0xF9, 0xB9, 0x20, 0x97, 0x13, 0xBD, 0x50, 0x9A, 0xD0, 0xE3, 0x80, 0xF7, 0x98, 0x1E, 0x24, 0xC9, 0x3C, 0x4E, 0x79, 0xB1, 0x63, 0xB8, 0xD2, 0x82, 0x13, 0xC4, 0xC4, 0x63, 0xF7, 0x73, 0xC0, 0x6, 0xC0, 0x19, 0x24, 0xC9, 0xFC, 0xFC, 0xEE, 0x71, 0x19, 0xF, 0x12, 0x64, 0xAE, 0xB2, 0x60, 0xD, 0x97, 0xC9, 0x17, 0xBE, 0x80, 0x58, 0x73, 0x88, 0x53, 0xCB, 0x7A, 0xC7, 0x1E, 0xE8, 0xEE, 0xE0, 0x84, 0xD2, 0x6C, 0x2, 0x6D, 0xBD, 0xCF, 0xDC, 0x9, 0x3B, 0xFA, 0xF1, 0x42, 0x29, 0x42, 0xDA, 0x9D, 0xCE, 0xE0, 0x3B, 0xE5, 0x2B, 0x98, 0x66, 0x54, 0x6E, 0x1B, 0x66, 0x3B, 0xA7, 0x6E, 0xAD, 0x8, 0x5D, 0x28, 0xF0, 0xC9, 0x22, 0x66, 0x5C, 0x23, 0x12, 0xDB, 0x4, 0x24, 0x3D, 0x4C, 0xD5, 0x32, 0xBC, 0xE9, 0x42, 0x9B, 0x18, 0x6F, 0x6, 0x51, 0x51, 0x69, 0x5E, 0xF3, 0xE4, 0x1A, 0x50, 0x7C, 0x31, 0xC8, 0x8A, 0x22, 0xB9, 0x1B, 0x26, 0x81, 0x17, 0x8D, 0xD7, 0x78, 0x2A, 0xB1, 0x67, 0x8D, 0x3F, 0xD5, 0xCC, 0x95, 0xCB, 0x84, 0xE3, 0x46, 0xB3, 0x37, 0x83, 0x1A, 0x3F, 0x1B, 0x2C, 0x8F, 0x9, 0x26, 0x6A, 0x99, 0x21, 0x71, 0xD, 0xDB, 0xA1, 0x9B, 0x10, 0x2F, 0x2, 0x51, 0xCF, 0x5A, 0x96, 0x74, 0xFA, 0x7A, 0x65, 0x36, 0xA9, 0xBF, 0xD9, 0x5, 0x95, 0xFD, 0xC3, 0x23, 0xAA, 0x3B, 0x65, 0x82, 0x96, 0x8F, 0x17, 0x81, 0xA8, 0xF7, 0xB0, 0xF7, 0x3D, 0x62, 0x96, 0x99, 0x31, 0xAA, 0x8, 0xDA, 0xAF, 0x78, 0x94, 0xD8, 0xAF, 0x78, 0xEE, 0x23, 0xB6, 0xE1, 0x3D, 0x4B, 0x3E, 0x36, 0xED, 0x7D, 0xCB, 0x32, 0xCB, 0xCF, 0xEC, 0x89, 0x8C, 0x83, 0x2F, 0x94, 0x24, 0xC4, 0x7F, 0x39, 0xB6, 0xF8, 0x7A, 0xA1, 0x24, 0x21, 0xD, 0xDD, 0xED, 0xFE, 0x82, 0x8, 0x12, 0xC2, 0x1E, 0xE, 0x67, 0xF4, 0xDC, 0xE2, 0xC, 0xD3, 0xFA, 0x4F, 0xD9, 0x72, 0x73, 0xE5, 0x9D, 0xE3, 0xAD, 0xE0, 0x89, 0xCD, 0x33, 0xB4, 0x8E, 0xFD, 0x83, 0x48, 0xAB, 0x6B, 0x52, 0x3E, 0x8E, 0x3F, 0x3F, 0x58, 0xB6, 0xCD, 0x20, 0x41, 0x46, 0x61, 0xBE, 0xBF, 0x1E, 0x21, 0x8, 0xDC, 0xBB, 0x6F, 0x64, 0x84, 0x9E, 0x20, 0xA2, 0x84, 0xD9, 0xED, 0x78, 0x8B, 0x25, 0x67, 0x45, 0x20, 0xC8, 0xE9, 0x21, 0x19, 0x1A, 0x76, 0x2E, 0xD1, 0x1C, 0x5F, 0x8, 0xC4, 0x9C, 0xB6, 0x46, 0xED, 0xC4, 0xFD, 0x81, 0x1F, 0xC5, 0x7B, 0x33, 0x88, 0x75, 0xC3, 0x9A, 0xFB, 0xFE, 0xBB, 0xC, 0xC8, 0x8D, 0x30, 0x55, 0xCB, 0x72, 0x3D, 0x24, 0xD9, 0xC0, 0x38, 0x9A, 0xDE, 0x37, 0x85, 0x98, 0xE2, 0xF9, 0x30, 0x42, 0xD5, 0xE3, 0xB5, 0xF6, 0x4D, 0x21, 0x6A, 0xE, 0xF7, 0xE1, 0x80, 0x99, 0xA9, 0x16, 0x30, 0x2E, 0xA3, 0xF6, 0x2C, 0x8F, 0x5E, 0x60, 0xE7, 0x49, 0xDB, 0xCE, 0x6B, 0x8F, 0x41, 0x28, 0xB2, 0x1B, 0x55, 0x3, 0x6, 0x57, 0xB2, 0x8D, 0x55, 0x1D, 0xF3, 0xE1, 0x71, 0x88, 0x1A, 0x70, 0x67, 0xDA, 0x36, 0xFB, 0xAD, 0x2A, 0x76, 0xAC, 0x29, 0x31, 0x67, 0xB9, 0x9, 0xA5, 0x39, 0xCB, 0x4D, 0x5C, 0x6C, 0xD8, 0x9A, 0x95, 0x48, 0xC3, 0xD6, 0xC4, 0x8D, 0xE, 0x5B, 0xB3, 0xE4, 0xB6, 0x61, 0x6B, 0xC, 0x74, 0x3F, 0x66, 0xA1, 0x68, 0xDE, 0x8F, 0x19, 0x31, 0x9E, 0x65, 0x76, 0xC3, 0x57, 0x70, 0x2F, 0x94, 0x20, 0x74, 0xBB, 0xF1, 0x52, 0x17, 0x89, 0xC0, 0xF7, 0x2C, 0x57, 0x14, 0x5A, 0x73, 0x53, 0xC6, 0x46, 0x9C, 0x33, 0xDF, 0xC, 0xAA, 0xE2, 0xA0, 0x55, 0xE8, 0xC0, 0xD1, 0x8B, 0x40, 0xD4, 0xB3, 0xEE, 0xFB, 0xF7, 0xB, 0x99, 0x40, 0x2C, 0x60, 0x5C, 0x26, 0xE0, 0x5E, 0x18, 0x72, 0x29, 0xFC, 0x23, 0x81, 0xF8, 0x94, 0x78, 0x84, 0x20, 0x50, 0x66, 0x66, 0x57, 0x20, 0xED, 0x72, 0x1, 0x9, 0x32, 0x4A, 0x9B, 0xD2, 0x25, 0xC6, 0xB2, 0xC3, 0x17, 0x3A, 0xD3, 0xFD, 0x20, 0xD1, 0x72, 0xFA, 0x66, 0x8C, 0x3B, 0x1E, 0x1B, 0x6C, 0xB8, 0x99, 0x61, 0xDA, 0x70, 0x73, 0x33, 0x66, 0xC0, 0x6E, 0xD2, 0x9C, 0xB1, 0xB8, 0xC8, 0xFD, 0x3B, 0xE7, 0xD8, 0x50, 0x30, 0x63, 0x4, 0x91, 0xDF, 0xEB, 0x90, 0x61, 0x8B, 0xE6, 0x5, 0x24, 0xC8, 0xE4, 0x35, 0xDB, 0xDF, 0x5A, 0x4C, 0x67, 0x41, 0x9, 0x42, 0xF7, 0xBB, 0xDD, 0xEF, 0xD1, 0x7B, 0x95, 0x66, 0x98, 0x96, 0xCB, 0xF7, 0x7, 0x43, 0xF3, 0xC7, 0x43, 0x24, 0x9, 0x64, 0x25, 0xE2, 0x91, 0xB8, 0x80, 0x66, 0xE1, 0x7B, 0x4A, 0x17, 0x9C, 0x56, 0x7, 0xFD, 0x96, 0xEF, 0xD, 0xF7, 0xD, 0x5C, 0x70, 0xAA, 0x1A, 0x1F, 0x57, 0x91, 0xB8, 0x98, 0x87, 0xA9, 0x5A, 0x43, 0xB2, 0x93, 0xF8, 0xFD, 0x79, 0xB, 0x4E, 0x54, 0xE3, 0x52, 0x72, 0x33, 0x24, 0x1D, 0xEE, 0xDF, 0xB1, 0xA2, 0x10, 0x35, 0xAB, 0xBA, 0xE6, 0xBA, 0xBB, 0xBA, 0x64, 0xB7, 0xC7, 0x97, 0xC0, 0x9F, 0x2C, 0xAA, 0xB2, 0x6A, 0x6C, 0xD2, 0x6, 0xA, 0x79, 0x21, 0x50, 0xF5, 0xEE, 0xDD, 0xEF, 0x17, 0x32, 0x60, 0x2F, 0x20, 0x45, 0xC6, 0x1E, 0xBE, 0x9F, 0x58, 0x4B, 0x9A, 0x50, 0x5A, 0x43, 0xD2, 0x95, 0x3D, 0xA2, 0x7E, 0x9F, 0x1E, 0x24, 0xEB, 0x7C, 0xED, 0x51, 0x7, 0xE3, 0x9, 0x25, 0x2A, 0x9, 0xC3, 0x92, 0x33, 0x7E, 0x27, 0xE2, 0x82, 0x13, 0x33, 0x5C, 0x18, 0x1D, 0x6C, 0xA4, 0x33, 0x4E, 0x54, 0xF3, 0xDE, 0xDB, 0x1, 0x4F, 0xBB, 0x17, 0x81, 0xAA, 0x37, 0x79, 0x32, 0xB5, 0xF8, 0xC4, 0x66, 0x45, 0x21, 0x6A, 0xB6, 0xDE, 0xCF, 0x23, 0xE0, 0xDA, 0xFE, 0x66, 0x10, 0x15, 0xE5, 0x70, 0xDC, 0xA3, 0x57, 0xC1, 0x4C, 0x28, 0xB1, 0x6C, 0x55, 0xE7, 0x7E, 0x7E, 0x10, 0x3, 0xFA, 0x2, 0x12, 0x64, 0xB4, 0x32, 0x21, 0x77, 0x92, 0x5, 0x27, 0x26, 0xCF, 0xE7, 0x46, 0xA8, 0x50, 0x17, 0x2, 0x55, 0xCF, 0x8E, 0xFD, 0x21, 0xAE, 0xE6, 0x61, 0xB2, 0x16, 0x4B, 0x2E, 0x81, 0x94, 0xCE, 0x38, 0x51, 0xAD, 0xCB, 0xF7, 0x7C, 0xC7, 0xDA, 0x23, 0x62, 0xD1, 0x58, 0xE1, 0x4, 0xB1, 0xA1, 0xE6, 0x32, 0xE9, 0x2, 0xD9, 0xB6, 0x10, 0x88, 0x91, 0x1B, 0x74, 0x27, 0xEA, 0x60, 0xBD, 0x7D, 0x31, 0x68, 0x8A, 0x26, 0xE4, 0xCB, 0x69, 0xE8, 0xBE, 0x9C, 0xE6, 0x37, 0x70, 0x2A, 0x78, 0x1, 0x9, 0x32, 0x4C, 0x16, 0x92, 0x1B, 0x6F, 0x7B, 0x56, 0x25, 0x3A, 0x9C, 0xFE, 0xA1, 0x11, 0xA3, 0xE8, 0x2F, 0x45, 0xF, 0x1C, 0xCA, 0x78, 0x11, 0x88, 0x7A, 0xCB, 0x55, 0xA3, 0x7, 0xF4, 0xE, 0x95, 0x15, 0x85, 0x76, 0x81, 0x8A, 0xE1, 0x9A, 0x35, 0xA1, 0xCB, 0x76, 0x5E, 0x4, 0x62, 0x1C, 0xB9, 0x39, 0x9F, 0x4F, 0xC8, 0x58, 0xBA, 0x80, 0x4, 0x99, 0x52, 0x6, 0x2F, 0x63, 0x59, 0x70, 0x62, 0xA4, 0x6A, 0xC1, 0x75, 0x97, 0x38, 0xFC, 0xFA, 0xE2, 0x37, 0x83, 0xAA, 0x78, 0xE5, 0xBA, 0xB, 0x4E, 0x98, 0x56, 0x14, 0xB2, 0x66, 0x9D, 0x18, 0x7C, 0xB2, 0xB9, 0xE0, 0x54, 0x35, 0x29, 0x6C, 0x32, 0xE0, 0xBB, 0x4, 0x2F, 0x2, 0x59, 0xCF, 0xF, 0xA3, 0x17, 0x7C, 0x54, 0x5C, 0x51, 0x88, 0x9A, 0xD, 0xB3, 0xA1, 0x59, 0xC5, 0x82, 0x53, 0xD5, 0xC2, 0x57, 0x4A, 0x9B, 0x4D, 0x57, 0x4A, 0x9B, 0xB6, 0x9, 0x5E, 0xB9, 0xB3, 0xE0, 0x54, 0xB5, 0xF0, 0x85, 0x80, 0xB, 0x4E, 0x54, 0x93, 0xCC, 0xF0, 0x44, 0x6, 0xE4, 0x16, 0x2, 0x55, 0xAF, 0xD0, 0x3C, 0xF1, 0x65, 0xB7, 0xCB, 0xF1, 0xFC, 0xFB, 0x60, 0x51, 0x95, 0xB9, 0x9, 0x2D, 0xA0, 0x16, 0x9C, 0xAA, 0x66, 0x58, 0xA2, 0x43, 0x6A, 0x13, 0x4E, 0x54, 0xD3, 0x9, 0x73, 0x7E, 0x5, 0xD7, 0xE0, 0x27, 0x55, 0x3E, 0x48, 0x64, 0xDD, 0x8B, 0x56, 0x41, 0x67, 0xF9, 0x15, 0x85, 0xAC, 0xA9, 0xFC, 0xE5, 0x5, 0x41, 0xCD, 0x85, 0x42, 0xD6, 0xEC, 0x98, 0x9E, 0x8E, 0x8A, 0x97, 0x81, 0xA1, 0xEF, 0x93, 0x46, 0xD5, 0xBE, 0xA8, 0xA6, 0x8, 0xCD, 0x1B, 0xDE, 0xC, 0xAA, 0xA2, 0xE6, 0xE3, 0x22, 0x23, 0x98, 0x3, 0x2F, 0xA, 0x55, 0xD3, 0xB5, 0xC1, 0x56, 0x39, 0xE3, 0x44, 0x35, 0x7B, 0x9D, 0x5C, 0xC4, 0x3, 0x31, 0x7C, 0x53, 0x36, 0x69, 0x9A, 0x8A, 0x35, 0x86, 0xCB, 0xC4, 0xE0, 0x57, 0x5E, 0xFC, 0x93, 0x49, 0xFD, 0x82, 0xE6, 0x89, 0x55, 0x96, 0xCB, 0xE4, 0x19, 0x68, 0xFB, 0x1F, 0x2C, 0x9A, 0xB2, 0xFD, 0xDA, 0x65, 0x67, 0xCC, 0x20, 0xB4, 0x80, 0x4, 0x99, 0xBC, 0xEE, 0x91, 0xA9, 0xF1, 0x4, 0x11, 0x24, 0xC4, 0xFD, 0xF4, 0xFB, 0xB8, 0xF, 0x88, 0x33, 0xE5, 0x1B, 0x26, 0x48, 0x5D, 0x5B, 0x1E, 0x1A, 0x16, 0x16, 0x9C, 0x98, 0x45, 0x72, 0xC7, 0xD0, 0xDD, 0x7, 0xF, 0xD2, 0x8C, 0xFA, 0x56, 0x9A, 0x8C, 0xEB, 0x32, 0xD4, 0x38, 0x56, 0x14, 0x62, 0xDC, 0x94, 0xDE, 0x1F, 0xF7, 0xE8, 0x19, 0xE0, 0x19, 0xA6, 0x2D, 0x31, 0xAD, 0x66, 0xE1, 0xEB, 0x33, 0x5F, 0x4, 0x62, 0xDC, 0x34, 0x97, 0x56, 0xC8, 0xC4, 0x14, 0xC9, 0x34, 0xDF, 0xD5, 0x4F, 0xF4, 0xC6, 0x42, 0x88, 0x4B, 0x9B, 0x1B, 0xBF, 0x43, 0x4E, 0x1, 0xBF, 0x3B, 0xC2, 0x47, 0x5E, 0xD4, 0x4D, 0xDF, 0x50, 0xEF, 0xE8, 0xE5, 0xC7, 0xD8, 0x57, 0x3E, 0xC9, 0x9B, 0xBF, 0x33, 0x85, 0x34, 0xD1, 0x1C, 0xFB, 0xE0, 0x6E, 0xFB, 0xCA, 0x86, 0x72, 0x51, 0xFF, 0xBA, 0x5C, 0x14, 0xBD, 0x5C, 0xD4, 0xBF, 0x2D, 0x97, 0x57, 0xE4, 0xBE, 0xA2, 0xDF, 0x58, 0x53, 0x37, 0x7E, 0x23, 0xFA, 0xD6, 0xC4, 0x3F, 0x99, 0xD4, 0x2F, 0x94, 0xFE, 0x6E, 0x22, 0x1D, 0xE8, 0x17, 0xDE, 0x14, 0x6A, 0xDB, 0xB, 0xDF, 0x6C, 0x69, 0x37, 0xDD, 0x6C, 0x69, 0xB5, 0x6A, 0x4D, 0xF2, 0xC, 0xF5, 0xC, 0x33, 0x81, 0xA8, 0x37, 0x98, 0x4E, 0x27, 0x5D, 0xA0, 0x83, 0x5E, 0x8, 0x34, 0x3D, 0x97, 0xEF, 0x98, 0xED, 0xB0, 0x2E, 0x7A, 0x42, 0x69, 0x7D, 0xF4, 0xC4, 0xC5, 0xAE, 0xA1, 0x9F, 0x95, 0x48, 0xB7, 0xD0, 0x4F, 0xDC, 0x47, 0x6C, 0xAB, 0x79, 0x96, 0x7C, 0x6C, 0xDA, 0x6A, 0x1E, 0x3, 0xD, 0x27, 0xF4, 0xC2, 0xC1, 0x19, 0x26, 0xA6, 0x58, 0x18, 0x7B, 0x38, 0xA0, 0x36, 0xCA, 0x19, 0xA6, 0x8D, 0x20, 0x4E, 0x98, 0xE7, 0x11, 0xF5, 0x3D, 0x99, 0x50, 0xA2, 0xD2, 0x74, 0xCF, 0xBC, 0xC6, 0x8D, 0x1, 0x6F, 0x6, 0xB1, 0x8E, 0x48, 0x77, 0x3B, 0x21, 0x6F, 0xCB, 0xCF, 0x18, 0x41, 0xC4, 0xF5, 0xEE, 0xFC, 0x83, 0x3A, 0xEB, 0xCC, 0x30, 0x2D, 0x89, 0x1D, 0x2B, 0x4C, 0xE8, 0x82, 0xE1, 0x5, 0xA7, 0x25, 0xAF, 0x63, 0x4D, 0xC3, 0x13, 0xA6, 0x8C, 0xE5, 0x9, 0xCF, 0xD0, 0x3E, 0xEA, 0xF, 0x8D, 0xD6, 0x41, 0x4D, 0x81, 0x8A, 0x25, 0x58, 0x15, 0x51, 0x5F, 0x11, 0x37, 0xE8, 0xCF, 0x61, 0xBE, 0x51, 0xAB, 0xD9, 0x27, 0x6B, 0x83, 0x72, 0x41, 0xCB, 0x95, 0x62, 0x73, 0xAE, 0xE8, 0x22, 0x5C, 0x7E, 0x13, 0x4E, 0x2C, 0x3F, 0x5E, 0x6A, 0x53, 0x71, 0x91, 0xE4, 0xF8, 0x99, 0xFC, 0x35, 0x87, 0xAA, 0xAA, 0xDB, 0x92, 0xFB, 0x49, 0x25, 0xB3, 0x5C, 0x27, 0xB9, 0x45, 0x76, 0x10, 0x20, 0xE2, 0x46, 0x79, 0x27, 0xCB, 0xE4, 0xD6, 0x53, 0xF4, 0x17, 0x26, 0xE5, 0x3, 0xC6, 0x76, 0xE5, 0x90, 0x88, 0x7, 0xFB, 0x52, 0x78, 0xB6, 0xAC, 0x48, 0xC4, 0x7C, 0x29, 0x93, 0x41, 0xE0, 0x46, 0xA8, 0x19, 0xA6, 0x6A, 0x31, 0x99, 0xDC, 0xF1, 0xA1, 0x76, 0xC1, 0xA9, 0x6A, 0x66, 0x18, 0xD9, 0x3B, 0xF4, 0xA2, 0x8B, 0x37, 0x83, 0xAA, 0xE8, 0x4E, 0xBB, 0x3, 0xFA, 0x56, 0xC8, 0xC, 0x13, 0xFB, 0xAC, 0xEA, 0xEE, 0x3A, 0x44, 0xC7, 0x43, 0x4, 0x89, 0x86, 0xEB, 0x31, 0xFA, 0x15, 0x2F, 0x42, 0x8F, 0x55, 0x2E, 0xB4, 0x74, 0xE6, 0x11, 0xD3, 0x3A, 0x87, 0x9A, 0x56, 0xC3, 0xDE, 0x76, 0xF1, 0x8B, 0x9B, 0x42, 0x5F, 0xDF, 0xF8, 0xA4, 0x13, 0x3F, 0xA5, 0x79, 0xC2, 0x85, 0x3F, 0x31, 0x8C, 0x1B, 0xA6, 0x3E, 0x48, 0x54, 0xDD, 0xB2, 0xB4, 0xC9, 0xE3, 0xCE, 0xD0, 0x4B, 0x3A, 0xDF, 0xC, 0xAA, 0x62, 0xC4, 0x69, 0xB5, 0xDB, 0xE6, 0xB4, 0xDA, 0xB9, 0xFB, 0xCF, 0xD7, 0x1E, 0x6B, 0xE8, 0x33, 0x18, 0x97, 0xE9, 0x4F, 0xFA, 0xE7, 0xCC, 0x9B, 0x9F, 0x4B, 0xE5, 0xCE, 0x85, 0xC4, 0xCE, 0xF0, 0xFE, 0x83, 0x45, 0x12, 0x36, 0xA7, 0x1D, 0xE2, 0x3B, 0xB7, 0x80, 0x4, 0x99, 0xBC, 0x33, 0x7, 0xFC, 0x6A, 0x8E, 0x19, 0xA6, 0x4D, 0xB2, 0x66, 0x72, 0x74, 0x36, 0xB8, 0x88, 0x6E, 0x9B, 0xE, 0xF6, 0x65, 0x56, 0xED, 0x91, 0x4D, 0xB6, 0x19, 0x23, 0x89, 0x34, 0x98, 0x1D, 0x68, 0xC6, 0xE2, 0x22, 0x8F, 0xEA, 0x7E, 0x3E, 0x60, 0x55, 0x63, 0x1, 0x9, 0x32, 0x75, 0x7E, 0x60, 0xCD, 0xB1, 0x1A, 0x38, 0x76, 0x28, 0xF1, 0x83, 0x41, 0x13, 0x2C, 0x9A, 0x23, 0x3B, 0x73, 0xBE, 0x43, 0x7C, 0xE9, 0x3E, 0x29, 0x71, 0xC9, 0xE1, 0x97, 0x1D, 0xD9, 0x1D, 0xD6, 0x9A, 0x31, 0x82, 0x8, 0xD7, 0xC9, 0x13, 0xDF, 0x5A, 0x99, 0x61, 0x5A, 0xB3, 0x1C, 0x2E, 0x5A, 0x9D, 0xC4, 0xD7, 0x37, 0xA6, 0xF5, 0x82, 0x9, 0x52, 0x25, 0xD3, 0x5, 0x97, 0xC9, 0xD, 0x5F, 0x91, 0xAE, 0x28, 0xC4, 0xE8, 0x95, 0x99, 0x38, 0xB0, 0xB6, 0x45, 0xCE, 0x51, 0xBC, 0x61, 0x82, 0x94, 0x37, 0x74, 0x9A, 0x46, 0x14, 0x9A, 0x27, 0xEE, 0x8A, 0xE, 0xE3, 0x7F, 0x79, 0xB4, 0x88, 0x3E, 0xB3, 0xC7, 0xEE, 0x70, 0x46, 0x3D, 0x7B, 0x66, 0x98, 0x36, 0x5E, 0x3E, 0x2F, 0x3B, 0xA6, 0xBE, 0x4E, 0xC, 0x5B, 0x50, 0x2E, 0x38, 0x6D, 0x49, 0x39, 0xB2, 0xBB, 0x7, 0xD6, 0x21, 0x4D, 0x28, 0xAD, 0x3F, 0x7A, 0x5E, 0xF4, 0x1E, 0x7D, 0x8D, 0x66, 0x1, 0x63, 0x32, 0x19, 0x1B, 0x72, 0x5, 0x3A, 0x2, 0x66, 0x6C, 0x48, 0x47, 0x8, 0x56, 0x78, 0x5F, 0xB9, 0x69, 0x4E, 0xAC, 0x69, 0xCC, 0x8D, 0xE5, 0x90, 0xE9, 0xD8, 0x83, 0xE9, 0x84, 0x46, 0x85, 0x20, 0x81, 0xD4, 0x44, 0xCD, 0xCE, 0x8F, 0x12, 0x1A, 0x26, 0x1E, 0x69, 0x89, 0xD, 0x10, 0xEF, 0x4F, 0x3E, 0xA0, 0x8A, 0xFC, 0x88, 0x56, 0xDF, 0x81, 0x41, 0xE1, 0x86, 0x94, 0x61, 0x21, 0xDF, 0x5F, 0x1C, 0xA0, 0x43, 0x7E, 0x43, 0xF4, 0x60, 0xDF, 0x13, 0x74, 0xC1, 0x7F, 0xC6, 0x5D, 0xEF, 0x7, 0x96, 0xB9, 0x6A, 0x50, 0x25, 0x68, 0x5D, 0x1E, 0xC1, 0xD4, 0xA3, 0xF1, 0x96, 0x30, 0x72, 0x6B, 0x71, 0x71, 0xB5, 0x33, 0x95, 0x68, 0xC1, 0xD5, 0xF3, 0x44, 0x49, 0x5F, 0x1C, 0x8A, 0x68, 0x3E, 0x12, 0xED, 0xF8, 0x4F, 0x86, 0x68, 0xCE, 0x8C, 0xD4, 0x53, 0x88, 0x92, 0x83, 0xA, 0xB, 0xE, 0x6A, 0xA3, 0x9C, 0xC8, 0x98, 0x66, 0x35, 0x78, 0x72, 0x76, 0x11, 0x9C, 0x29, 0x34, 0x49, 0xB, 0xDC, 0x38, 0x33, 0xFF, 0x1E, 0xB, 0xCC, 0xD, 0x57, 0xB5, 0x90, 0xAC, 0x47, 0x92, 0xC7, 0xD, 0x4F, 0x67, 0x2, 0x25, 0x2E, 0x15, 0xCB, 0x98, 0xE8, 0x99, 0x5, 0x9D, 0x17, 0x27, 0x38, 0xF5, 0x38, 0x49, 0x4C, 0xD4, 0x4A, 0xA, 0x51, 0x32, 0x8B, 0x44, 0x6E, 0x24, 0xA4, 0x33, 0x83, 0x24, 0xA8, 0x80, 0x61, 0x61, 0xFA, 0x39, 0x16, 0x54, 0x58, 0x66, 0xC7, 0x6A, 0x50, 0x22, 0xA5, 0x26, 0x2C, 0x4B, 0x67, 0x2, 0x25, 0x26, 0xE2, 0x39, 0x56, 0xEA, 0xA7, 0x53, 0x35, 0x92, 0x34, 0xF1, 0x74, 0xE9, 0xC2, 0xA0, 0x8, 0xD6, 0xAC, 0xE4, 0xAA, 0x66, 0x3, 0xC3, 0x1A, 0xD3, 0x48, 0x48, 0x67, 0x6, 0x49, 0xD0, 0xB5, 0xAA, 0x52, 0x75, 0xCD, 0x44, 0x81, 0xB4, 0xF6, 0x91, 0x91, 0x2E, 0x14, 0xAA, 0xA4, 0x6F, 0xCA, 0x12, 0xA9, 0x1E, 0x5E, 0x71, 0x66, 0x50, 0x4, 0x1B, 0xD1, 0xE, 0xB6, 0x12, 0x79, 0x92, 0x71, 0x66, 0xAC, 0x1, 0x87, 0x9E, 0x91, 0x95, 0x7E, 0xD0, 0x28, 0xE3, 0xD0, 0xC0, 0x1A, 0xCB, 0x1C, 0x30, 0xD3, 0xF4, 0x40, 0xEA, 0x62, 0x33, 0xCC, 0x31, 0x97, 0xB, 0x36, 0xF6, 0x5D, 0x68, 0x9, 0x8F, 0x84, 0x74, 0x66, 0x50, 0x12, 0x3B, 0xF2, 0x3, 0x4D, 0xCB, 0xCB, 0x91, 0x9B, 0xD6, 0xC8, 0xB6, 0xA, 0xAF, 0x2D, 0x5E, 0x6D, 0x22, 0xD0, 0xE4, 0xCA, 0xB1, 0xF0, 0x58, 0xAB, 0x2C, 0x52, 0x59, 0x46, 0x46, 0xBA, 0x50, 0xA8, 0x92, 0x96, 0x95, 0xE2, 0xE2, 0x70, 0x41, 0xCB, 0xD2, 0x91, 0x40, 0x97, 0xB, 0x76, 0xBC, 0xB3, 0xE2, 0x86, 0x9E, 0x77, 0xA, 0x11, 0x54, 0x23, 0xAB, 0x48, 0x56, 0x32, 0x19, 0xC8, 0x3C, 0xCB, 0xD2, 0x89, 0x42, 0x97, 0x24, 0x45, 0x70, 0x63, 0x4C, 0x5D, 0x5E, 0xE1, 0xD9, 0x37, 0x82, 0x34, 0x9D, 0x5A, 0x58, 0x56, 0xFB, 0x34, 0x63, 0x3, 0xCF, 0xC4, 0x49, 0x17, 0x12, 0x4D, 0x76, 0xAC, 0x5C, 0xAD, 0x18, 0x18, 0xD6, 0x4B, 0xCF, 0xD5, 0x6F, 0xA2, 0xD0, 0x24, 0x25, 0xAB, 0x59, 0xAD, 0xA6, 0xBF, 0xC, 0x9E, 0xF6, 0x89, 0x96, 0xBE, 0x78, 0x54, 0xF1, 0xA0, 0xE4, 0x36, 0x1D, 0xA2, 0xE0, 0x56, 0x69, 0x95, 0xF1, 0x58, 0x45, 0x1A, 0x39, 0x1B, 0x2B, 0x92, 0x54, 0x63, 0x65, 0x9, 0x57, 0xFA, 0x89, 0xB3, 0xA9, 0xDA, 0x9B, 0x51, 0xB3, 0x1E, 0x2, 0xDD, 0x90, 0x67, 0xA4, 0x9E, 0x42, 0x93, 0xB4, 0x2A, 0x32, 0x4D, 0x1D, 0x29, 0xDB, 0xA6, 0xA9, 0x63, 0x88, 0x58, 0xED, 0xB7, 0x6A, 0x63, 0xDD, 0xB7, 0xAA, 0x56, 0x7A, 0xAE, 0x4, 0x3A, 0x20, 0xBB, 0xB0, 0xD2, 0x91, 0x46, 0x96, 0x8E, 0x74, 0xEE, 0x63, 0x6C, 0x37, 0x75, 0xEE, 0x56, 0x8D, 0x1D, 0x40, 0x11, 0x89, 0xEB, 0xC4, 0xD9, 0x12, 0x53, 0xE3, 0x74, 0x6C, 0x59, 0x31, 0xB3, 0xB6, 0x16, 0xD9, 0xC3, 0x1, 0x8B, 0xA6, 0x5, 0x88, 0x7, 0x7F, 0x2A, 0x1E, 0x4A, 0xEB, 0x53, 0x71, 0x7A, 0x32, 0x79, 0x78, 0x62, 0xC1, 0xB7, 0x4C, 0x2B, 0xA6, 0x2A, 0x16, 0x14, 0x9C, 0x6B, 0xE1, 0x26, 0x51, 0xF1, 0x74, 0x91, 0x62, 0xF0, 0x9C, 0x6D, 0x85, 0x20, 0xB, 0xE, 0x2C, 0x95, 0xE7, 0xDF, 0x29, 0x81, 0xF3, 0x46, 0xB9, 0x42, 0x72, 0x60, 0xCF, 0x6F, 0x85, 0xA7, 0x23, 0x21, 0x3A, 0x3F, 0x54, 0xCA, 0x30, 0x2C, 0xC3, 0x54, 0xEA, 0x41, 0x4A, 0x92, 0x94, 0x35, 0xE, 0x9F, 0xE4, 0x8C, 0x28, 0x79, 0x8A, 0x63, 0xE6, 0x11, 0x15, 0xEB, 0x50, 0x7D, 0x94, 0xE8, 0x9D, 0xA9, 0xA9, 0x84, 0x32, 0xE3, 0xBA, 0x6, 0x2B, 0xC0, 0x91, 0x90, 0xCE, 0xC, 0xA2, 0xA0, 0x56, 0x1, 0xEB, 0x81, 0xC7, 0xE9, 0x6, 0x4, 0xE3, 0xA, 0x56, 0x2B, 0x74, 0x3A, 0x32, 0xC2, 0xA9, 0xC7, 0x69, 0x62, 0xA5, 0x8, 0x2E, 0x4D, 0x46, 0xC2, 0x96, 0xA5, 0x89, 0x71, 0x2A, 0x52, 0x1A, 0xBE, 0x2D, 0x6D, 0x28, 0x8E, 0xB1, 0x99, 0x94, 0x58, 0x51, 0xF8, 0xC5, 0x62, 0x49, 0x2A, 0x86, 0x71, 0x86, 0xC4, 0x4, 0x9A, 0x6F, 0x23, 0x9C, 0x7A, 0x9C, 0x24, 0x66, 0x5C, 0xCD, 0x82, 0x19, 0xE7, 0x19, 0x5B, 0x72, 0xCE, 0xFA, 0x94, 0xEA, 0xC8, 0x90, 0xB3, 0xB0, 0x36, 0xD, 0x3B, 0x3D, 0xD3, 0xE1, 0x25, 0xC0, 0x48, 0xD8, 0xB2, 0x0, 0xE8, 0x99, 0x65, 0x15, 0x6B, 0x19, 0xAF, 0x44, 0x8B, 0xA4, 0x7F, 0xA1, 0xA4, 0x9E, 0x43, 0x15, 0xD, 0x4C, 0x61, 0x46, 0x98, 0x3E, 0x7F, 0x79, 0x3A, 0xAB, 0xAC, 0x55, 0x1A, 0x49, 0xF0, 0xD3, 0xA5, 0x33, 0x4E, 0x10, 0xCB, 0x80, 0x2D, 0xAA, 0x21, 0xCD, 0x62, 0xFB, 0x51, 0x43, 0xA6, 0x2E, 0x4C, 0x58, 0x60, 0x93, 0x65, 0xC8, 0x54, 0x3A, 0x41, 0xB1, 0xEE, 0x36, 0x53, 0x37, 0xA6, 0x2D, 0xE0, 0xAB, 0x33, 0x4A, 0x4C, 0x50, 0x5C, 0x42, 0xF3, 0x4E, 0x70, 0xC0, 0xEC, 0x3E, 0x6A, 0xCC, 0x58, 0x5C, 0xC4, 0xE4, 0x82, 0x4B, 0xC8, 0x3A, 0x3D, 0xAA, 0x2C, 0x60, 0x5C, 0xC6, 0x6A, 0x6, 0x5D, 0x85, 0x34, 0x8A, 0x4C, 0x50, 0x4C, 0x2, 0xA, 0x1C, 0x33, 0x6E, 0xF, 0x75, 0xE, 0x99, 0x34, 0xEA, 0x34, 0x8F, 0xDA, 0x33, 0x24, 0x64, 0x32, 0x1E, 0x24, 0xC1, 0x64, 0x2C, 0x2B, 0xE8, 0x32, 0xCC, 0x41, 0x56, 0x29, 0x7A, 0x5, 0xE6, 0x3B, 0x95, 0xAA, 0x50, 0xD9, 0xD8, 0xBF, 0x0, 0x2, 0x2F, 0x28, 0xF2, 0x7D, 0x55, 0x42, 0x69, 0x1E, 0x7F, 0x8D, 0x5, 0x1C, 0x3B, 0xC1, 0xA0, 0x9, 0xCC, 0x33, 0xB6, 0x98, 0xC0, 0x94, 0xB0, 0x2C, 0x64, 0xD1, 0x1C, 0xF1, 0xD, 0x6, 0x4D, 0x55, 0x33, 0x7C, 0x3C, 0x1D, 0x87, 0x17, 0xEA, 0x60, 0xAA, 0x6A, 0xDF, 0xE3, 0x67, 0x1, 0xCB, 0xF9, 0x8B, 0x42, 0xB6, 0x75, 0xAB, 0x9A, 0xF5, 0xE1, 0xF8, 0xF5, 0x5B, 0x62, 0x38, 0x7D, 0xBF, 0x15, 0x70, 0x3F, 0xBB, 0xE0, 0x69, 0x2B, 0x28, 0x9D, 0xAC, 0xAA, 0xD5, 0xD8, 0x29, 0x83, 0x42, 0x13, 0x42, 0x13, 0x80, 0xE2, 0xF2, 0xDF, 0x74, 0x41, 0xFF, 0x46, 0x64, 0xF, 0xEA, 0x98, 0x31, 0x3F, 0x2B, 0x51, 0x33, 0x2D, 0x42, 0xD9, 0xBF, 0xE6, 0x6D, 0x28, 0x3, 0x35, 0x8E, 0xBF, 0xC1, 0xC5, 0xE6, 0xCC, 0xD9, 0xB2, 0xDC, 0x54, 0xB5, 0xB2, 0xDC, 0x1B, 0x68, 0x30, 0x45, 0xCB, 0xD3, 0xC9, 0x80, 0x43, 0x12, 0x7B, 0xF2, 0xD0, 0x4C, 0xD3, 0x13, 0x36, 0xCC, 0x34, 0x55, 0xC3, 0x8C, 0xB0, 0x1C, 0x36, 0x7, 0xCF, 0x20, 0xC9, 0x8, 0xAC, 0xDA, 0x4C, 0xB5, 0x50, 0x47, 0x3B, 0x2, 0x69, 0x1B, 0xEF, 0xA4, 0x55, 0x3B, 0x36, 0xF8, 0xD0, 0x6, 0xCB, 0xC4, 0xD8, 0xB0, 0xC5, 0xA2, 0x24, 0xCB, 0x98, 0x76, 0x41, 0xCD, 0x89, 0xB2, 0x4D, 0xB4, 0x54, 0xA1, 0x89, 0x82, 0x27, 0x6C, 0x98, 0x2A, 0x8C, 0x7C, 0x27, 0x45, 0x24, 0x96, 0x23, 0x65, 0x53, 0x2C, 0xF9, 0x93, 0xF5, 0x61, 0xB3, 0xE6, 0xCC, 0xD9, 0x62, 0x8D, 0x52, 0x32, 0xB8, 0xCE, 0x51, 0x72, 0xCB, 0x2A, 0x47, 0x69, 0x21, 0x82, 0x6A, 0x5A, 0x88, 0x4D, 0x72, 0x35, 0xF6, 0xFA, 0xCA, 0x88, 0xD1, 0x9E, 0x5D, 0x19, 0x99, 0xE3, 0x64, 0x99, 0x87, 0xA4, 0x3C, 0x81, 0xAC, 0xA7, 0xD0, 0x35, 0xAB, 0xD2, 0x8A, 0xBA, 0x62, 0x55, 0x86, 0xB7, 0xC2, 0x6, 0x62, 0x35, 0xE1, 0xD4, 0x48, 0x99, 0x4A, 0x14, 0x2C, 0x98, 0xF7, 0x9E, 0xB1, 0x25, 0xF7, 0x27, 0xC9, 0xD0, 0x46, 0xDD, 0xA2, 0x49, 0xDF, 0xAA, 0x1B, 0x43, 0x8C, 0x43, 0x5F, 0x2C, 0xA6, 0x7E, 0x78, 0xDC, 0x16, 0xD7, 0x36, 0x5C, 0xF5, 0x3C, 0x63, 0xA3, 0xA4, 0x54, 0x25, 0xD3, 0xC2, 0x30, 0x64, 0xE5, 0xF9, 0xA6, 0xA4, 0x23, 0x87, 0x2C, 0x8A, 0x9A, 0xC2, 0x26, 0x98, 0x6A, 0xA, 0x1B, 0xD9, 0xAA, 0x1E, 0xFB, 0x91, 0x40, 0x9A, 0xC7, 0x62, 0xF1, 0xC, 0x8A, 0xA0, 0x5F, 0xAE, 0x8A, 0x22, 0xB0, 0xE3, 0xFF, 0xA2, 0xD0, 0x7, 0x61, 0xE0, 0x6, 0xB3, 0xF1, 0xC7, 0x68, 0x30, 0x5B, 0xE1, 0x6D, 0xC3, 0xD9, 0x8A, 0xDA, 0x30, 0x9C, 0x75, 0x19, 0x38, 0x8A, 0x4D, 0x40, 0x24, 0x38, 0xE4, 0x48, 0x33, 0xC4, 0x1D, 0x69, 0x6, 0xC8, 0xC8, 0x16, 0x73, 0xA2, 0x1F, 0x1C, 0x33, 0xAC, 0x67, 0x81, 0x7D, 0xEC, 0x91, 0x90, 0x2E, 0xC, 0x42, 0xDE, 0xBB, 0x8B, 0x53, 0xD8, 0x34, 0xC5, 0x5D, 0x5C, 0xAA, 0x68, 0x73, 0x14, 0xB7, 0x18, 0x49, 0x71, 0x83, 0xA6, 0x7B, 0x19, 0x49, 0xA9, 0xF6, 0x4C, 0x1F, 0x42, 0x87, 0x27, 0x67, 0x33, 0x67, 0xCB, 0xE4, 0xCC, 0xD5, 0x22, 0x64, 0xE4, 0x70, 0xB5, 0xD8, 0x60, 0xE2, 0x70, 0xB5, 0xAB, 0xC6, 0x1E, 0x30, 0x64, 0x20, 0x5E, 0x38, 0x1B, 0x2C, 0xC4, 0xA0, 0x2D, 0x3D, 0x6E, 0x47, 0x77, 0x3A, 0x52, 0x3B, 0xF4, 0xB6, 0xCA, 0xA1, 0x45, 0xA5, 0xE4, 0x55, 0xE1, 0x33, 0xD9, 0x85, 0x41, 0x9E, 0xCC, 0x3A, 0xE3, 0xAA, 0x31, 0x6F, 0x51, 0xC3, 0xE4, 0x4C, 0x20, 0x9B, 0x26, 0x9D, 0x71, 0xA2, 0x66, 0xA5, 0xA, 0x54, 0x3C, 0xE3, 0x44, 0xFA, 0xA2, 0x50, 0x24, 0x5D, 0x78, 0x2B, 0xD7, 0xB9, 0x74, 0xCB, 0x76, 0xAE, 0x1B, 0x27, 0x5C, 0xA1, 0x55, 0xAD, 0x27, 0x6C, 0x58, 0xD6, 0xBA, 0x67, 0x78, 0x8E, 0xE7, 0x9E, 0x5B, 0xE6, 0x77, 0x4F, 0x96, 0x1, 0x5E, 0x72, 0x4F, 0x96, 0x66, 0x98, 0x9F, 0xDC, 0x6B, 0xFE, 0xFE, 0x64, 0x79, 0x75, 0x53, 0xAD, 0xD2, 0x37, 0xC0, 0x27, 0xF0, 0x5, 0xA6, 0xB7, 0x98, 0xEF, 0xF8, 0x93, 0x81, 0x6B, 0x91, 0x27, 0xA3, 0xAC, 0x42, 0x9E, 0xAC, 0x64, 0x12, 0x8E, 0x40, 0xC9, 0x24, 0xE5, 0xE3, 0xB5, 0xBA, 0x31, 0xC9, 0x61, 0x89, 0x9, 0xC3, 0x55, 0x56, 0xD1, 0x68, 0x83, 0x3D, 0xD5, 0x88, 0x6F, 0xE8, 0xA5, 0x46, 0x7A, 0x1B, 0x5A, 0x1F, 0x4C, 0x4, 0xFA, 0xEA, 0xE0, 0xC9, 0xC0, 0x7D, 0x9D, 0x27, 0xA3, 0x6C, 0xE7, 0x3C, 0x59, 0xC0, 0x6D, 0xE0, 0xC9, 0xE8, 0x1E, 0x3, 0x4F, 0xA6, 0xA0, 0xF7, 0xD9, 0x9F, 0x2C, 0x55, 0xE8, 0xBB, 0xEC, 0xAB, 0x58, 0xDC, 0x94, 0x16, 0xCF, 0xEA, 0x59, 0x71, 0xC8, 0xF5, 0x68, 0x5, 0xC7, 0x1D, 0x90, 0xDE, 0x64, 0x1, 0xFA, 0x31, 0xAD, 0x71, 0x92, 0xDA, 0x4D, 0x1, 0x4E, 0x86, 0xF3, 0xEF, 0xD1, 0xC0, 0x56, 0x21, 0xD9, 0x72, 0xB3, 0x8A, 0x98, 0x33, 0x56, 0x81, 0xDE, 0xAE, 0x93, 0x4, 0xEE, 0xF3, 0xBA, 0x92, 0x80, 0xCE, 0x93, 0xF8, 0x5F, 0x63, 0xD1, 0xD7, 0xAA, 0x87, 0x1B, 0x8F, 0x56, 0x3D, 0xA1, 0xFD, 0x41, 0x66, 0xF3, 0x67, 0xDC, 0x6C, 0xFE, 0xE4, 0xD2, 0x1A, 0x77, 0x1D, 0x3B, 0x7F, 0xAC, 0x89, 0xCC, 0x8C, 0xB4, 0xA6, 0x36, 0x3A, 0xAE, 0x1, 0x7, 0x48, 0xFF, 0x6B, 0x24, 0x60, 0xA9, 0x34, 0x3, 0xF3, 0x60, 0x4, 0x8, 0x79, 0x50, 0x2A, 0xFD, 0xE4, 0xD, 0xCF, 0x31, 0xD, 0xF, 0x12, 0x74, 0x2A, 0x61, 0x55, 0x2B, 0x34, 0x58, 0xA3, 0x67, 0x8C, 0x50, 0x9B, 0xAB, 0xC1, 0xAA, 0x76, 0x40, 0x54, 0x26, 0x8C, 0xA0, 0x22, 0xA0, 0x22, 0x11, 0xD1, 0x32, 0xB8, 0x42, 0x96, 0xF4, 0xE7, 0x35, 0x6E, 0x49, 0x7F, 0x36, 0x65, 0x6, 0x58, 0xD2, 0x9F, 0x4D, 0x99, 0x66, 0xB1, 0xC7, 0xA4, 0x9E, 0xD0, 0xF0, 0x13, 0x5B, 0x39, 0x3C, 0x95, 0x4, 0xE6, 0xFE, 0xFE, 0xD7, 0x58, 0x40, 0xD5, 0xA8, 0x52, 0xE4, 0xAC, 0xC1, 0xD6, 0x2F, 0x6F, 0x6, 0x6D, 0x11, 0x33, 0xF1, 0x87, 0xB0, 0xDC, 0x40, 0xD4, 0xBA, 0x95, 0xAA, 0x3, 0x2B, 0xE2, 0x2D, 0x2D, 0x55, 0x47, 0xA8, 0x85, 0x37, 0xB0, 0xE6, 0xDC, 0x8, 0x75, 0x6, 0x5A, 0x4C, 0x3D, 0xE3, 0x8B, 0xA9, 0xA7, 0x5, 0xBF, 0x68, 0x9, 0x5F, 0x74, 0x5C, 0x8B, 0x1C, 0xD8, 0x7B, 0x5B, 0x80, 0x58, 0x70, 0x6F, 0x89, 0x8, 0xC, 0xFB, 0x23, 0x61, 0xCB, 0xB8, 0xF, 0xEC, 0xBF, 0x3D, 0xC3, 0x27, 0x5D, 0xFE, 0xE7, 0xFF, 0xFC, 0xE7, 0xFF, 0xF2, 0x86, 0xB3, 0x31, 0xEF, 0xFE, 0x73, 0x38, 0xB0, 0xD3, 0xD7, 0x9E, 0xB3, 0xE3, 0xE5, 0x3B, 0x3B, 0x9F, 0xBF, 0x8E, 0x97, 0xAF, 0x9C, 0x5F, 0xE, 0xFB, 0x9F, 0xCB, 0x2F, 0xCF, 0x7F, 0xF3, 0x62, 0xFF, 0x9B, 0x7F, 0xED, 0xBF, 0x8A, 0xE3, 0xF1, 0x3F, 0xFF, 0xF3, 0xBF, 0xFE, 0x5F, 0x0, 0x0, 0x0, 0xFF, 0xFF, 0x35, 0x70, 0x77, 0x53}
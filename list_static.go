@@ -0,0 +1,9209 @@
+// Code generated by publicsuffix/gen.go; DO NOT EDIT
+
+//go:build tinygo || publicsuffix_lite
+// +build tinygo publicsuffix_lite
+
+package publicsuffix
+
+var initialRelease = `22a461ea3f7b5563f6cef218f9ec9cd19c616d33`
+
+var initialDate = ``
+
+var staticRules = []rule{
+	{DottedName: "!city.kawasaki.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!city.kitakyushu.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!city.kobe.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!city.nagoya.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!city.sapporo.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!city.sendai.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!city.yokohama.jp", RuleType: exception, ICANN: true},
+	{DottedName: "!www.ck", RuleType: exception, ICANN: true},
+	{DottedName: "*.bd", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.ck", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.er", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.fk", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.jm", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.kawasaki.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.kh", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.kitakyushu.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.kobe.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.mm", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.nagoya.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.nom.br", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.np", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.pg", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.sapporo.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.sch.uk", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.sendai.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "*.yokohama.jp", RuleType: wildcard, ICANN: true},
+	{DottedName: "0.bg", ICANN: true},
+	{DottedName: "1.bg", ICANN: true},
+	{DottedName: "2.bg", ICANN: true},
+	{DottedName: "2000.hu", ICANN: true},
+	{DottedName: "3.bg", ICANN: true},
+	{DottedName: "4.bg", ICANN: true},
+	{DottedName: "5.bg", ICANN: true},
+	{DottedName: "6.bg", ICANN: true},
+	{DottedName: "7.bg", ICANN: true},
+	{DottedName: "8.bg", ICANN: true},
+	{DottedName: "9.bg", ICANN: true},
+	{DottedName: "9guacu.br", ICANN: true},
+	{DottedName: "a.bg", ICANN: true},
+	{DottedName: "a.se", ICANN: true},
+	{DottedName: "aa.no", ICANN: true},
+	{DottedName: "aaa", ICANN: true},
+	{DottedName: "aaa.pro", ICANN: true},
+	{DottedName: "aarborte.no", ICANN: true},
+	{DottedName: "aarp", ICANN: true},
+	{DottedName: "ab.ca", ICANN: true},
+	{DottedName: "abarth", ICANN: true},
+	{DottedName: "abashiri.hokkaido.jp", ICANN: true},
+	{DottedName: "abb", ICANN: true},
+	{DottedName: "abbott", ICANN: true},
+	{DottedName: "abbvie", ICANN: true},
+	{DottedName: "abc", ICANN: true},
+	{DottedName: "abc.br", ICANN: true},
+	{DottedName: "abeno.osaka.jp", ICANN: true},
+	{DottedName: "abiko.chiba.jp", ICANN: true},
+	{DottedName: "abira.hokkaido.jp", ICANN: true},
+	{DottedName: "able", ICANN: true},
+	{DottedName: "abo.pa", ICANN: true},
+	{DottedName: "abogado", ICANN: true},
+	{DottedName: "abr.it", ICANN: true},
+	{DottedName: "abruzzo.it", ICANN: true},
+	{DottedName: "abu.yamaguchi.jp", ICANN: true},
+	{DottedName: "abudhabi", ICANN: true},
+	{DottedName: "ac", ICANN: true},
+	{DottedName: "ac.ae", ICANN: true},
+	{DottedName: "ac.at", ICANN: true},
+	{DottedName: "ac.be", ICANN: true},
+	{DottedName: "ac.ci", ICANN: true},
+	{DottedName: "ac.cn", ICANN: true},
+	{DottedName: "ac.cr", ICANN: true},
+	{DottedName: "ac.cy", ICANN: true},
+	{DottedName: "ac.fj", ICANN: true},
+	{DottedName: "ac.gn", ICANN: true},
+	{DottedName: "ac.gov.br", ICANN: true},
+	{DottedName: "ac.id", ICANN: true},
+	{DottedName: "ac.il", ICANN: true},
+	{DottedName: "ac.im", ICANN: true},
+	{DottedName: "ac.in", ICANN: true},
+	{DottedName: "ac.ir", ICANN: true},
+	{DottedName: "ac.jp", ICANN: true},
+	{DottedName: "ac.ke", ICANN: true},
+	{DottedName: "ac.kr", ICANN: true},
+	{DottedName: "ac.lk", ICANN: true},
+	{DottedName: "ac.ls", ICANN: true},
+	{DottedName: "ac.ma", ICANN: true},
+	{DottedName: "ac.me", ICANN: true},
+	{DottedName: "ac.mu", ICANN: true},
+	{DottedName: "ac.mw", ICANN: true},
+	{DottedName: "ac.mz", ICANN: true},
+	{DottedName: "ac.ni", ICANN: true},
+	{DottedName: "ac.nz", ICANN: true},
+	{DottedName: "ac.pa", ICANN: true},
+	{DottedName: "ac.pr", ICANN: true},
+	{DottedName: "ac.rs", ICANN: true},
+	{DottedName: "ac.rw", ICANN: true},
+	{DottedName: "ac.se", ICANN: true},
+	{DottedName: "ac.sz", ICANN: true},
+	{DottedName: "ac.th", ICANN: true},
+	{DottedName: "ac.tj", ICANN: true},
+	{DottedName: "ac.tz", ICANN: true},
+	{DottedName: "ac.ug", ICANN: true},
+	{DottedName: "ac.uk", ICANN: true},
+	{DottedName: "ac.vn", ICANN: true},
+	{DottedName: "ac.za", ICANN: true},
+	{DottedName: "ac.zm", ICANN: true},
+	{DottedName: "ac.zw", ICANN: true},
+	{DottedName: "aca.pro", ICANN: true},
+	{DottedName: "academia.bo", ICANN: true},
+	{DottedName: "academy", ICANN: true},
+	{DottedName: "academy.museum", ICANN: true},
+	{DottedName: "accenture", ICANN: true},
+	{DottedName: "accident-investigation.aero", ICANN: true},
+	{DottedName: "accident-prevention.aero", ICANN: true},
+	{DottedName: "accountant", ICANN: true},
+	{DottedName: "accountants", ICANN: true},
+	{DottedName: "acct.pro", ICANN: true},
+	{DottedName: "achi.nagano.jp", ICANN: true},
+	{DottedName: "aco", ICANN: true},
+	{DottedName: "act.au", ICANN: true},
+	{DottedName: "act.edu.au", ICANN: true},
+	{DottedName: "actor", ICANN: true},
+	{DottedName: "ad", ICANN: true},
+	{DottedName: "ad.jp", ICANN: true},
+	{DottedName: "adac", ICANN: true},
+	{DottedName: "adachi.tokyo.jp", ICANN: true},
+	{DottedName: "adm.br", ICANN: true},
+	{DottedName: "ads", ICANN: true},
+	{DottedName: "adult", ICANN: true},
+	{DottedName: "adult.ht", ICANN: true},
+	{DottedName: "adv.br", ICANN: true},
+	{DottedName: "adv.mz", ICANN: true},
+	{DottedName: "ae", ICANN: true},
+	{DottedName: "aeg", ICANN: true},
+	{DottedName: "aejrie.no", ICANN: true},
+	{DottedName: "aero", ICANN: true},
+	{DottedName: "aero.mv", ICANN: true},
+	{DottedName: "aero.tt", ICANN: true},
+	{DottedName: "aerobatic.aero", ICANN: true},
+	{DottedName: "aeroclub.aero", ICANN: true},
+	{DottedName: "aerodrome.aero", ICANN: true},
+	{DottedName: "aeroport.fr", ICANN: true},
+	{DottedName: "aetna", ICANN: true},
+	{DottedName: "af", ICANN: true},
+	{DottedName: "afamilycompany", ICANN: true},
+	{DottedName: "afjord.no", ICANN: true},
+	{DottedName: "afl", ICANN: true},
+	{DottedName: "africa", ICANN: true},
+	{DottedName: "ag", ICANN: true},
+	{DottedName: "ag.it", ICANN: true},
+	{DottedName: "aga.niigata.jp", ICANN: true},
+	{DottedName: "agakhan", ICANN: true},
+	{DottedName: "agano.niigata.jp", ICANN: true},
+	{DottedName: "agdenes.no", ICANN: true},
+	{DottedName: "agematsu.nagano.jp", ICANN: true},
+	{DottedName: "agency", ICANN: true},
+	{DottedName: "agents.aero", ICANN: true},
+	{DottedName: "agr.br", ICANN: true},
+	{DottedName: "agrar.hu", ICANN: true},
+	{DottedName: "agric.za", ICANN: true},
+	{DottedName: "agriculture.museum", ICANN: true},
+	{DottedName: "agrigento.it", ICANN: true},
+	{DottedName: "agro.bo", ICANN: true},
+	{DottedName: "agro.pl", ICANN: true},
+	{DottedName: "aguni.okinawa.jp", ICANN: true},
+	{DottedName: "ah.cn", ICANN: true},
+	{DottedName: "ah.no", ICANN: true},
+	{DottedName: "ai", ICANN: true},
+	{DottedName: "aibetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "aichi.jp", ICANN: true},
+	{DottedName: "aid.pl", ICANN: true},
+	{DottedName: "aig", ICANN: true},
+	{DottedName: "aikawa.kanagawa.jp", ICANN: true},
+	{DottedName: "ainan.ehime.jp", ICANN: true},
+	{DottedName: "aioi.hyogo.jp", ICANN: true},
+	{DottedName: "aip.ee", ICANN: true},
+	{DottedName: "air-surveillance.aero", ICANN: true},
+	{DottedName: "air-traffic-control.aero", ICANN: true},
+	{DottedName: "air.museum", ICANN: true},
+	{DottedName: "airbus", ICANN: true},
+	{DottedName: "aircraft.aero", ICANN: true},
+	{DottedName: "airforce", ICANN: true},
+	{DottedName: "airguard.museum", ICANN: true},
+	{DottedName: "airline.aero", ICANN: true},
+	{DottedName: "airport.aero", ICANN: true},
+	{DottedName: "airtel", ICANN: true},
+	{DottedName: "airtraffic.aero", ICANN: true},
+	{DottedName: "aisai.aichi.jp", ICANN: true},
+	{DottedName: "aisho.shiga.jp", ICANN: true},
+	{DottedName: "aizubange.fukushima.jp", ICANN: true},
+	{DottedName: "aizumi.tokushima.jp", ICANN: true},
+	{DottedName: "aizumisato.fukushima.jp", ICANN: true},
+	{DottedName: "aizuwakamatsu.fukushima.jp", ICANN: true},
+	{DottedName: "aju.br", ICANN: true},
+	{DottedName: "ak.us", ICANN: true},
+	{DottedName: "akabira.hokkaido.jp", ICANN: true},
+	{DottedName: "akagi.shimane.jp", ICANN: true},
+	{DottedName: "akaiwa.okayama.jp", ICANN: true},
+	{DottedName: "akashi.hyogo.jp", ICANN: true},
+	{DottedName: "akdn", ICANN: true},
+	{DottedName: "aki.kochi.jp", ICANN: true},
+	{DottedName: "akiruno.tokyo.jp", ICANN: true},
+	{DottedName: "akishima.tokyo.jp", ICANN: true},
+	{DottedName: "akita.akita.jp", ICANN: true},
+	{DottedName: "akita.jp", ICANN: true},
+	{DottedName: "akkeshi.hokkaido.jp", ICANN: true},
+	{DottedName: "aknoluokta.no", ICANN: true},
+	{DottedName: "ako.hyogo.jp", ICANN: true},
+	{DottedName: "akrehamn.no", ICANN: true},
+	{DottedName: "akune.kagoshima.jp", ICANN: true},
+	{DottedName: "al", ICANN: true},
+	{DottedName: "al.gov.br", ICANN: true},
+	{DottedName: "al.it", ICANN: true},
+	{DottedName: "al.no", ICANN: true},
+	{DottedName: "al.us", ICANN: true},
+	{DottedName: "alabama.museum", ICANN: true},
+	{DottedName: "alaheadju.no", ICANN: true},
+	{DottedName: "aland.fi", ICANN: true},
+	{DottedName: "alaska.museum", ICANN: true},
+	{DottedName: "alessandria.it", ICANN: true},
+	{DottedName: "alesund.no", ICANN: true},
+	{DottedName: "alfaromeo", ICANN: true},
+	{DottedName: "algard.no", ICANN: true},
+	{DottedName: "alibaba", ICANN: true},
+	{DottedName: "alipay", ICANN: true},
+	{DottedName: "allfinanz", ICANN: true},
+	{DottedName: "allstate", ICANN: true},
+	{DottedName: "ally", ICANN: true},
+	{DottedName: "alsace", ICANN: true},
+	{DottedName: "alstahaug.no", ICANN: true},
+	{DottedName: "alstom", ICANN: true},
+	{DottedName: "alt.za", ICANN: true},
+	{DottedName: "alta.no", ICANN: true},
+	{DottedName: "alto-adige.it", ICANN: true},
+	{DottedName: "altoadige.it", ICANN: true},
+	{DottedName: "alvdal.no", ICANN: true},
+	{DottedName: "am", ICANN: true},
+	{DottedName: "am.br", ICANN: true},
+	{DottedName: "am.gov.br", ICANN: true},
+	{DottedName: "ama.aichi.jp", ICANN: true},
+	{DottedName: "ama.shimane.jp", ICANN: true},
+	{DottedName: "amagasaki.hyogo.jp", ICANN: true},
+	{DottedName: "amakusa.kumamoto.jp", ICANN: true},
+	{DottedName: "amami.kagoshima.jp", ICANN: true},
+	{DottedName: "amazon", ICANN: true},
+	{DottedName: "amber.museum", ICANN: true},
+	{DottedName: "ambulance.aero", ICANN: true},
+	{DottedName: "ambulance.museum", ICANN: true},
+	{DottedName: "american.museum", ICANN: true},
+	{DottedName: "americana.museum", ICANN: true},
+	{DottedName: "americanantiques.museum", ICANN: true},
+	{DottedName: "americanart.museum", ICANN: true},
+	{DottedName: "americanexpress", ICANN: true},
+	{DottedName: "americanfamily", ICANN: true},
+	{DottedName: "amex", ICANN: true},
+	{DottedName: "amfam", ICANN: true},
+	{DottedName: "ami.ibaraki.jp", ICANN: true},
+	{DottedName: "amica", ICANN: true},
+	{DottedName: "amli.no", ICANN: true},
+	{DottedName: "amot.no", ICANN: true},
+	{DottedName: "amsterdam", ICANN: true},
+	{DottedName: "amsterdam.museum", ICANN: true},
+	{DottedName: "amusement.aero", ICANN: true},
+	{DottedName: "an.it", ICANN: true},
+	{DottedName: "analytics", ICANN: true},
+	{DottedName: "anamizu.ishikawa.jp", ICANN: true},
+	{DottedName: "anan.nagano.jp", ICANN: true},
+	{DottedName: "anan.tokushima.jp", ICANN: true},
+	{DottedName: "anani.br", ICANN: true},
+	{DottedName: "ancona.it", ICANN: true},
+	{DottedName: "and.museum", ICANN: true},
+	{DottedName: "andasuolo.no", ICANN: true},
+	{DottedName: "andebu.no", ICANN: true},
+	{DottedName: "ando.nara.jp", ICANN: true},
+	{DottedName: "andoy.no", ICANN: true},
+	{DottedName: "andria-barletta-trani.it", ICANN: true},
+	{DottedName: "andria-trani-barletta.it", ICANN: true},
+	{DottedName: "andriabarlettatrani.it", ICANN: true},
+	{DottedName: "andriatranibarletta.it", ICANN: true},
+	{DottedName: "android", ICANN: true},
+	{DottedName: "anjo.aichi.jp", ICANN: true},
+	{DottedName: "ann-arbor.mi.us", ICANN: true},
+	{DottedName: "annaka.gunma.jp", ICANN: true},
+	{DottedName: "annefrank.museum", ICANN: true},
+	{DottedName: "anpachi.gifu.jp", ICANN: true},
+	{DottedName: "anquan", ICANN: true},
+	{DottedName: "anthro.museum", ICANN: true},
+	{DottedName: "anthropology.museum", ICANN: true},
+	{DottedName: "antiques.museum", ICANN: true},
+	{DottedName: "anz", ICANN: true},
+	{DottedName: "ao", ICANN: true},
+	{DottedName: "ao.it", ICANN: true},
+	{DottedName: "aogaki.hyogo.jp", ICANN: true},
+	{DottedName: "aogashima.tokyo.jp", ICANN: true},
+	{DottedName: "aoki.nagano.jp", ICANN: true},
+	{DottedName: "aol", ICANN: true},
+	{DottedName: "aomori.aomori.jp", ICANN: true},
+	{DottedName: "aomori.jp", ICANN: true},
+	{DottedName: "aosta-valley.it", ICANN: true},
+	{DottedName: "aosta.it", ICANN: true},
+	{DottedName: "aostavalley.it", ICANN: true},
+	{DottedName: "aoste.it", ICANN: true},
+	{DottedName: "ap.gov.br", ICANN: true},
+	{DottedName: "ap.gov.pl", ICANN: true},
+	{DottedName: "ap.it", ICANN: true},
+	{DottedName: "aparecida.br", ICANN: true},
+	{DottedName: "apartments", ICANN: true},
+	{DottedName: "app", ICANN: true},
+	{DottedName: "app.br", ICANN: true},
+	{DottedName: "apple", ICANN: true},
+	{DottedName: "aq", ICANN: true},
+	{DottedName: "aq.it", ICANN: true},
+	{DottedName: "aquarelle", ICANN: true},
+	{DottedName: "aquarium.museum", ICANN: true},
+	{DottedName: "aquila.it", ICANN: true},
+	{DottedName: "ar", ICANN: true},
+	{DottedName: "ar.it", ICANN: true},
+	{DottedName: "ar.us", ICANN: true},
+	{DottedName: "arab", ICANN: true},
+	{DottedName: "arai.shizuoka.jp", ICANN: true},
+	{DottedName: "arakawa.saitama.jp", ICANN: true},
+	{DottedName: "arakawa.tokyo.jp", ICANN: true},
+	{DottedName: "aramco", ICANN: true},
+	{DottedName: "arao.kumamoto.jp", ICANN: true},
+	{DottedName: "arboretum.museum", ICANN: true},
+	{DottedName: "archaeological.museum", ICANN: true},
+	{DottedName: "archaeology.museum", ICANN: true},
+	{DottedName: "archi", ICANN: true},
+	{DottedName: "architecture.museum", ICANN: true},
+	{DottedName: "ardal.no", ICANN: true},
+	{DottedName: "aremark.no", ICANN: true},
+	{DottedName: "arendal.no", ICANN: true},
+	{DottedName: "arezzo.it", ICANN: true},
+	{DottedName: "ariake.saga.jp", ICANN: true},
+	{DottedName: "arida.wakayama.jp", ICANN: true},
+	{DottedName: "aridagawa.wakayama.jp", ICANN: true},
+	{DottedName: "arita.saga.jp", ICANN: true},
+	{DottedName: "army", ICANN: true},
+	{DottedName: "arna.no", ICANN: true},
+	{DottedName: "arpa", ICANN: true},
+	{DottedName: "arq.br", ICANN: true},
+	{DottedName: "art", ICANN: true},
+	{DottedName: "art.br", ICANN: true},
+	{DottedName: "art.do", ICANN: true},
+	{DottedName: "art.dz", ICANN: true},
+	{DottedName: "art.ht", ICANN: true},
+	{DottedName: "art.museum", ICANN: true},
+	{DottedName: "art.sn", ICANN: true},
+	{DottedName: "artanddesign.museum", ICANN: true},
+	{DottedName: "artcenter.museum", ICANN: true},
+	{DottedName: "artdeco.museum", ICANN: true},
+	{DottedName: "arte", ICANN: true},
+	{DottedName: "arte.bo", ICANN: true},
+	{DottedName: "arteducation.museum", ICANN: true},
+	{DottedName: "artgallery.museum", ICANN: true},
+	{DottedName: "arts.co", ICANN: true},
+	{DottedName: "arts.museum", ICANN: true},
+	{DottedName: "arts.nf", ICANN: true},
+	{DottedName: "arts.ro", ICANN: true},
+	{DottedName: "arts.ve", ICANN: true},
+	{DottedName: "artsandcrafts.museum", ICANN: true},
+	{DottedName: "as", ICANN: true},
+	{DottedName: "as.us", ICANN: true},
+	{DottedName: "asago.hyogo.jp", ICANN: true},
+	{DottedName: "asahi.chiba.jp", ICANN: true},
+	{DottedName: "asahi.ibaraki.jp", ICANN: true},
+	{DottedName: "asahi.mie.jp", ICANN: true},
+	{DottedName: "asahi.nagano.jp", ICANN: true},
+	{DottedName: "asahi.toyama.jp", ICANN: true},
+	{DottedName: "asahi.yamagata.jp", ICANN: true},
+	{DottedName: "asahikawa.hokkaido.jp", ICANN: true},
+	{DottedName: "asaka.saitama.jp", ICANN: true},
+	{DottedName: "asakawa.fukushima.jp", ICANN: true},
+	{DottedName: "asakuchi.okayama.jp", ICANN: true},
+	{DottedName: "asaminami.hiroshima.jp", ICANN: true},
+	{DottedName: "ascoli-piceno.it", ICANN: true},
+	{DottedName: "ascolipiceno.it", ICANN: true},
+	{DottedName: "asda", ICANN: true},
+	{DottedName: "aseral.no", ICANN: true},
+	{DottedName: "ashibetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "ashikaga.tochigi.jp", ICANN: true},
+	{DottedName: "ashiya.fukuoka.jp", ICANN: true},
+	{DottedName: "ashiya.hyogo.jp", ICANN: true},
+	{DottedName: "ashoro.hokkaido.jp", ICANN: true},
+	{DottedName: "asia", ICANN: true},
+	{DottedName: "asker.no", ICANN: true},
+	{DottedName: "askim.no", ICANN: true},
+	{DottedName: "askoy.no", ICANN: true},
+	{DottedName: "askvoll.no", ICANN: true},
+	{DottedName: "asmatart.museum", ICANN: true},
+	{DottedName: "asn.au", ICANN: true},
+	{DottedName: "asn.lv", ICANN: true},
+	{DottedName: "asnes.no", ICANN: true},
+	{DottedName: "aso.kumamoto.jp", ICANN: true},
+	{DottedName: "ass.km", ICANN: true},
+	{DottedName: "assabu.hokkaido.jp", ICANN: true},
+	{DottedName: "assassination.museum", ICANN: true},
+	{DottedName: "assisi.museum", ICANN: true},
+	{DottedName: "assn.lk", ICANN: true},
+	{DottedName: "asso.bj", ICANN: true},
+	{DottedName: "asso.ci", ICANN: true},
+	{DottedName: "asso.dz", ICANN: true},
+	{DottedName: "asso.fr", ICANN: true},
+	{DottedName: "asso.gp", ICANN: true},
+	{DottedName: "asso.ht", ICANN: true},
+	{DottedName: "asso.km", ICANN: true},
+	{DottedName: "asso.mc", ICANN: true},
+	{DottedName: "asso.nc", ICANN: true},
+	{DottedName: "asso.re", ICANN: true},
+	{DottedName: "associates", ICANN: true},
+	{DottedName: "association.aero", ICANN: true},
+	{DottedName: "association.museum", ICANN: true},
+	{DottedName: "asti.it", ICANN: true},
+	{DottedName: "astronomy.museum", ICANN: true},
+	{DottedName: "asuke.aichi.jp", ICANN: true},
+	{DottedName: "at", ICANN: true},
+	{DottedName: "at.it", ICANN: true},
+	{DottedName: "atami.shizuoka.jp", ICANN: true},
+	{DottedName: "athleta", ICANN: true},
+	{DottedName: "atlanta.museum", ICANN: true},
+	{DottedName: "atm.pl", ICANN: true},
+	{DottedName: "ato.br", ICANN: true},
+	{DottedName: "atsugi.kanagawa.jp", ICANN: true},
+	{DottedName: "atsuma.hokkaido.jp", ICANN: true},
+	{DottedName: "attorney", ICANN: true},
+	{DottedName: "au", ICANN: true},
+	{DottedName: "auction", ICANN: true},
+	{DottedName: "audi", ICANN: true},
+	{DottedName: "audible", ICANN: true},
+	{DottedName: "audio", ICANN: true},
+	{DottedName: "audnedaln.no", ICANN: true},
+	{DottedName: "augustow.pl", ICANN: true},
+	{DottedName: "aukra.no", ICANN: true},
+	{DottedName: "aure.no", ICANN: true},
+	{DottedName: "aurland.no", ICANN: true},
+	{DottedName: "aurskog-holand.no", ICANN: true},
+	{DottedName: "auspost", ICANN: true},
+	{DottedName: "austevoll.no", ICANN: true},
+	{DottedName: "austin.museum", ICANN: true},
+	{DottedName: "australia.museum", ICANN: true},
+	{DottedName: "austrheim.no", ICANN: true},
+	{DottedName: "author", ICANN: true},
+	{DottedName: "author.aero", ICANN: true},
+	{DottedName: "auto", ICANN: true},
+	{DottedName: "auto.pl", ICANN: true},
+	{DottedName: "automotive.museum", ICANN: true},
+	{DottedName: "autos", ICANN: true},
+	{DottedName: "av.it", ICANN: true},
+	{DottedName: "av.tr", ICANN: true},
+	{DottedName: "avellino.it", ICANN: true},
+	{DottedName: "averoy.no", ICANN: true},
+	{DottedName: "avianca", ICANN: true},
+	{DottedName: "aviation.museum", ICANN: true},
+	{DottedName: "avocat.fr", ICANN: true},
+	{DottedName: "avocat.pro", ICANN: true},
+	{DottedName: "avoues.fr", ICANN: true},
+	{DottedName: "aw", ICANN: true},
+	{DottedName: "awaji.hyogo.jp", ICANN: true},
+	{DottedName: "aws", ICANN: true},
+	{DottedName: "ax", ICANN: true},
+	{DottedName: "axa", ICANN: true},
+	{DottedName: "axis.museum", ICANN: true},
+	{DottedName: "aya.miyazaki.jp", ICANN: true},
+	{DottedName: "ayabe.kyoto.jp", ICANN: true},
+	{DottedName: "ayagawa.kagawa.jp", ICANN: true},
+	{DottedName: "ayase.kanagawa.jp", ICANN: true},
+	{DottedName: "az", ICANN: true},
+	{DottedName: "az.us", ICANN: true},
+	{DottedName: "azumino.nagano.jp", ICANN: true},
+	{DottedName: "azure", ICANN: true},
+	{DottedName: "b.bg", ICANN: true},
+	{DottedName: "b.br", ICANN: true},
+	{DottedName: "b.se", ICANN: true},
+	{DottedName: "ba", ICANN: true},
+	{DottedName: "ba.gov.br", ICANN: true},
+	{DottedName: "ba.it", ICANN: true},
+	{DottedName: "babia-gora.pl", ICANN: true},
+	{DottedName: "baby", ICANN: true},
+	{DottedName: "badaddja.no", ICANN: true},
+	{DottedName: "badajoz.museum", ICANN: true},
+	{DottedName: "baghdad.museum", ICANN: true},
+	{DottedName: "bahcavuotna.no", ICANN: true},
+	{DottedName: "bahccavuotna.no", ICANN: true},
+	{DottedName: "bahn.museum", ICANN: true},
+	{DottedName: "baidar.no", ICANN: true},
+	{DottedName: "baidu", ICANN: true},
+	{DottedName: "bajddar.no", ICANN: true},
+	{DottedName: "balat.no", ICANN: true},
+	{DottedName: "bale.museum", ICANN: true},
+	{DottedName: "balestrand.no", ICANN: true},
+	{DottedName: "ballangen.no", ICANN: true},
+	{DottedName: "ballooning.aero", ICANN: true},
+	{DottedName: "balsan-sudtirol.it", ICANN: true},
+	{DottedName: "balsan-suedtirol.it", ICANN: true},
+	{DottedName: "balsan.it", ICANN: true},
+	{DottedName: "balsfjord.no", ICANN: true},
+	{DottedName: "baltimore.museum", ICANN: true},
+	{DottedName: "bamble.no", ICANN: true},
+	{DottedName: "banamex", ICANN: true},
+	{DottedName: "bananarepublic", ICANN: true},
+	{DottedName: "band", ICANN: true},
+	{DottedName: "bandai.fukushima.jp", ICANN: true},
+	{DottedName: "bando.ibaraki.jp", ICANN: true},
+	{DottedName: "bank", ICANN: true},
+	{DottedName: "bar", ICANN: true},
+	{DottedName: "bar.pro", ICANN: true},
+	{DottedName: "barcelona", ICANN: true},
+	{DottedName: "barcelona.museum", ICANN: true},
+	{DottedName: "barclaycard", ICANN: true},
+	{DottedName: "barclays", ICANN: true},
+	{DottedName: "bardu.no", ICANN: true},
+	{DottedName: "barefoot", ICANN: true},
+	{DottedName: "bargains", ICANN: true},
+	{DottedName: "bari.it", ICANN: true},
+	{DottedName: "barletta-trani-andria.it", ICANN: true},
+	{DottedName: "barlettatraniandria.it", ICANN: true},
+	{DottedName: "barreau.bj", ICANN: true},
+	{DottedName: "barueri.br", ICANN: true},
+	{DottedName: "barum.no", ICANN: true},
+	{DottedName: "bas.it", ICANN: true},
+	{DottedName: "baseball", ICANN: true},
+	{DottedName: "baseball.museum", ICANN: true},
+	{DottedName: "basel.museum", ICANN: true},
+	{DottedName: "basilicata.it", ICANN: true},
+	{DottedName: "basketball", ICANN: true},
+	{DottedName: "baths.museum", ICANN: true},
+	{DottedName: "bato.tochigi.jp", ICANN: true},
+	{DottedName: "batsfjord.no", ICANN: true},
+	{DottedName: "bauern.museum", ICANN: true},
+	{DottedName: "bauhaus", ICANN: true},
+	{DottedName: "bayern", ICANN: true},
+	{DottedName: "bb", ICANN: true},
+	{DottedName: "bbc", ICANN: true},
+	{DottedName: "bbs.tr", ICANN: true},
+	{DottedName: "bbt", ICANN: true},
+	{DottedName: "bbva", ICANN: true},
+	{DottedName: "bc.ca", ICANN: true},
+	{DottedName: "bcg", ICANN: true},
+	{DottedName: "bcn", ICANN: true},
+	{DottedName: "bd.se", ICANN: true},
+	{DottedName: "be", ICANN: true},
+	{DottedName: "bearalvahki.no", ICANN: true},
+	{DottedName: "beardu.no", ICANN: true},
+	{DottedName: "beats", ICANN: true},
+	{DottedName: "beauty", ICANN: true},
+	{DottedName: "beauxarts.museum", ICANN: true},
+	{DottedName: "bedzin.pl", ICANN: true},
+	{DottedName: "beeldengeluid.museum", ICANN: true},
+	{DottedName: "beer", ICANN: true},
+	{DottedName: "beiarn.no", ICANN: true},
+	{DottedName: "bel.tr", ICANN: true},
+	{DottedName: "belau.pw", ICANN: true},
+	{DottedName: "belem.br", ICANN: true},
+	{DottedName: "bellevue.museum", ICANN: true},
+	{DottedName: "belluno.it", ICANN: true},
+	{DottedName: "benevento.it", ICANN: true},
+	{DottedName: "bentley", ICANN: true},
+	{DottedName: "beppu.oita.jp", ICANN: true},
+	{DottedName: "berg.no", ICANN: true},
+	{DottedName: "bergamo.it", ICANN: true},
+	{DottedName: "bergbau.museum", ICANN: true},
+	{DottedName: "bergen.no", ICANN: true},
+	{DottedName: "berkeley.museum", ICANN: true},
+	{DottedName: "berlevag.no", ICANN: true},
+	{DottedName: "berlin", ICANN: true},
+	{DottedName: "berlin.museum", ICANN: true},
+	{DottedName: "bern.museum", ICANN: true},
+	{DottedName: "beskidy.pl", ICANN: true},
+	{DottedName: "best", ICANN: true},
+	{DottedName: "bestbuy", ICANN: true},
+	{DottedName: "bet", ICANN: true},
+	{DottedName: "bet.ar", ICANN: true},
+	{DottedName: "bf", ICANN: true},
+	{DottedName: "bg", ICANN: true},
+	{DottedName: "bg.it", ICANN: true},
+	{DottedName: "bh", ICANN: true},
+	{DottedName: "bharti", ICANN: true},
+	{DottedName: "bhz.br", ICANN: true},
+	{DottedName: "bi", ICANN: true},
+	{DottedName: "bi.it", ICANN: true},
+	{DottedName: "bialowieza.pl", ICANN: true},
+	{DottedName: "bialystok.pl", ICANN: true},
+	{DottedName: "bib.br", ICANN: true},
+	{DottedName: "bib.ve", ICANN: true},
+	{DottedName: "bibai.hokkaido.jp", ICANN: true},
+	{DottedName: "bible", ICANN: true},
+	{DottedName: "bible.museum", ICANN: true},
+	{DottedName: "bid", ICANN: true},
+	{DottedName: "biei.hokkaido.jp", ICANN: true},
+	{DottedName: "bielawa.pl", ICANN: true},
+	{DottedName: "biella.it", ICANN: true},
+	{DottedName: "bieszczady.pl", ICANN: true},
+	{DottedName: "bievat.no", ICANN: true},
+	{DottedName: "bifuka.hokkaido.jp", ICANN: true},
+	{DottedName: "bihoro.hokkaido.jp", ICANN: true},
+	{DottedName: "bike", ICANN: true},
+	{DottedName: "bilbao.museum", ICANN: true},
+	{DottedName: "bill.museum", ICANN: true},
+	{DottedName: "bindal.no", ICANN: true},
+	{DottedName: "bing", ICANN: true},
+	{DottedName: "bingo", ICANN: true},
+	{DottedName: "bio", ICANN: true},
+	{DottedName: "bio.br", ICANN: true},
+	{DottedName: "biratori.hokkaido.jp", ICANN: true},
+	{DottedName: "birdart.museum", ICANN: true},
+	{DottedName: "birkenes.no", ICANN: true},
+	{DottedName: "birthplace.museum", ICANN: true},
+	{DottedName: "biz", ICANN: true},
+	{DottedName: "biz.az", ICANN: true},
+	{DottedName: "biz.bb", ICANN: true},
+	{DottedName: "biz.cy", ICANN: true},
+	{DottedName: "biz.et", ICANN: true},
+	{DottedName: "biz.fj", ICANN: true},
+	{DottedName: "biz.id", ICANN: true},
+	{DottedName: "biz.ki", ICANN: true},
+	{DottedName: "biz.ls", ICANN: true},
+	{DottedName: "biz.mv", ICANN: true},
+	{DottedName: "biz.mw", ICANN: true},
+	{DottedName: "biz.my", ICANN: true},
+	{DottedName: "biz.ni", ICANN: true},
+	{DottedName: "biz.nr", ICANN: true},
+	{DottedName: "biz.pk", ICANN: true},
+	{DottedName: "biz.pl", ICANN: true},
+	{DottedName: "biz.pr", ICANN: true},
+	{DottedName: "biz.ss", ICANN: true},
+	{DottedName: "biz.tj", ICANN: true},
+	{DottedName: "biz.tr", ICANN: true},
+	{DottedName: "biz.tt", ICANN: true},
+	{DottedName: "biz.vn", ICANN: true},
+	{DottedName: "biz.zm", ICANN: true},
+	{DottedName: "bizen.okayama.jp", ICANN: true},
+	{DottedName: "bj", ICANN: true},
+	{DottedName: "bj.cn", ICANN: true},
+	{DottedName: "bjarkoy.no", ICANN: true},
+	{DottedName: "bjerkreim.no", ICANN: true},
+	{DottedName: "bjugn.no", ICANN: true},
+	{DottedName: "bl.it", ICANN: true},
+	{DottedName: "black", ICANN: true},
+	{DottedName: "blackfriday", ICANN: true},
+	{DottedName: "blockbuster", ICANN: true},
+	{DottedName: "blog", ICANN: true},
+	{DottedName: "blog.bo", ICANN: true},
+	{DottedName: "blog.br", ICANN: true},
+	{DottedName: "bloomberg", ICANN: true},
+	{DottedName: "blue", ICANN: true},
+	{DottedName: "bm", ICANN: true},
+	{DottedName: "bmd.br", ICANN: true},
+	{DottedName: "bms", ICANN: true},
+	{DottedName: "bmw", ICANN: true},
+	{DottedName: "bn", ICANN: true},
+	{DottedName: "bn.it", ICANN: true},
+	{DottedName: "bnpparibas", ICANN: true},
+	{DottedName: "bo", ICANN: true},
+	{DottedName: "bo.it", ICANN: true},
+	{DottedName: "bo.nordland.no", ICANN: true},
+	{DottedName: "bo.telemark.no", ICANN: true},
+	{DottedName: "boats", ICANN: true},
+	{DottedName: "boavista.br", ICANN: true},
+	{DottedName: "bodo.no", ICANN: true},
+	{DottedName: "boehringer", ICANN: true},
+	{DottedName: "bofa", ICANN: true},
+	{DottedName: "bokn.no", ICANN: true},
+	{DottedName: "boleslawiec.pl", ICANN: true},
+	{DottedName: "bolivia.bo", ICANN: true},
+	{DottedName: "bologna.it", ICANN: true},
+	{DottedName: "bolt.hu", ICANN: true},
+	{DottedName: "bolzano-altoadige.it", ICANN: true},
+	{DottedName: "bolzano.it", ICANN: true},
+	{DottedName: "bom", ICANN: true},
+	{DottedName: "bomlo.no", ICANN: true},
+	{DottedName: "bond", ICANN: true},
+	{DottedName: "bonn.museum", ICANN: true},
+	{DottedName: "boo", ICANN: true},
+	{DottedName: "book", ICANN: true},
+	{DottedName: "booking", ICANN: true},
+	{DottedName: "bosch", ICANN: true},
+	{DottedName: "bostik", ICANN: true},
+	{DottedName: "boston", ICANN: true},
+	{DottedName: "boston.museum", ICANN: true},
+	{DottedName: "bot", ICANN: true},
+	{DottedName: "botanical.museum", ICANN: true},
+	{DottedName: "botanicalgarden.museum", ICANN: true},
+	{DottedName: "botanicgarden.museum", ICANN: true},
+	{DottedName: "botany.museum", ICANN: true},
+	{DottedName: "boutique", ICANN: true},
+	{DottedName: "box", ICANN: true},
+	{DottedName: "bozen-sudtirol.it", ICANN: true},
+	{DottedName: "bozen-suedtirol.it", ICANN: true},
+	{DottedName: "bozen.it", ICANN: true},
+	{DottedName: "br", ICANN: true},
+	{DottedName: "br.it", ICANN: true},
+	{DottedName: "bradesco", ICANN: true},
+	{DottedName: "brand.se", ICANN: true},
+	{DottedName: "brandywinevalley.museum", ICANN: true},
+	{DottedName: "brasil.museum", ICANN: true},
+	{DottedName: "bremanger.no", ICANN: true},
+	{DottedName: "brescia.it", ICANN: true},
+	{DottedName: "bridgestone", ICANN: true},
+	{DottedName: "brindisi.it", ICANN: true},
+	{DottedName: "bristol.museum", ICANN: true},
+	{DottedName: "british.museum", ICANN: true},
+	{DottedName: "britishcolumbia.museum", ICANN: true},
+	{DottedName: "broadcast.museum", ICANN: true},
+	{DottedName: "broadway", ICANN: true},
+	{DottedName: "broker", ICANN: true},
+	{DottedName: "broker.aero", ICANN: true},
+	{DottedName: "bronnoy.no", ICANN: true},
+	{DottedName: "bronnoysund.no", ICANN: true},
+	{DottedName: "brother", ICANN: true},
+	{DottedName: "brumunddal.no", ICANN: true},
+	{DottedName: "brunel.museum", ICANN: true},
+	{DottedName: "brussel.museum", ICANN: true},
+	{DottedName: "brussels", ICANN: true},
+	{DottedName: "brussels.museum", ICANN: true},
+	{DottedName: "bruxelles.museum", ICANN: true},
+	{DottedName: "bryne.no", ICANN: true},
+	{DottedName: "bs", ICANN: true},
+	{DottedName: "bs.it", ICANN: true},
+	{DottedName: "bsb.br", ICANN: true},
+	{DottedName: "bt", ICANN: true},
+	{DottedName: "bt.it", ICANN: true},
+	{DottedName: "bu.no", ICANN: true},
+	{DottedName: "budapest", ICANN: true},
+	{DottedName: "budejju.no", ICANN: true},
+	{DottedName: "bugatti", ICANN: true},
+	{DottedName: "build", ICANN: true},
+	{DottedName: "builders", ICANN: true},
+	{DottedName: "building.museum", ICANN: true},
+	{DottedName: "bulsan-sudtirol.it", ICANN: true},
+	{DottedName: "bulsan-suedtirol.it", ICANN: true},
+	{DottedName: "bulsan.it", ICANN: true},
+	{DottedName: "bungoono.oita.jp", ICANN: true},
+	{DottedName: "bungotakada.oita.jp", ICANN: true},
+	{DottedName: "bunkyo.tokyo.jp", ICANN: true},
+	{DottedName: "burghof.museum", ICANN: true},
+	{DottedName: "bus.museum", ICANN: true},
+	{DottedName: "busan.kr", ICANN: true},
+	{DottedName: "bushey.museum", ICANN: true},
+	{DottedName: "business", ICANN: true},
+	{DottedName: "buy", ICANN: true},
+	{DottedName: "buzen.fukuoka.jp", ICANN: true},
+	{DottedName: "buzz", ICANN: true},
+	{DottedName: "bv", ICANN: true},
+	{DottedName: "bw", ICANN: true},
+	{DottedName: "by", ICANN: true},
+	{DottedName: "bydgoszcz.pl", ICANN: true},
+	{DottedName: "bygland.no", ICANN: true},
+	{DottedName: "bykle.no", ICANN: true},
+	{DottedName: "bytom.pl", ICANN: true},
+	{DottedName: "bz", ICANN: true},
+	{DottedName: "bz.it", ICANN: true},
+	{DottedName: "bzh", ICANN: true},
+	{DottedName: "c.bg", ICANN: true},
+	{DottedName: "c.se", ICANN: true},
+	{DottedName: "ca", ICANN: true},
+	{DottedName: "ca.it", ICANN: true},
+	{DottedName: "ca.na", ICANN: true},
+	{DottedName: "ca.us", ICANN: true},
+	{DottedName: "caa.aero", ICANN: true},
+	{DottedName: "cab", ICANN: true},
+	{DottedName: "cadaques.museum", ICANN: true},
+	{DottedName: "cafe", ICANN: true},
+	{DottedName: "cagliari.it", ICANN: true},
+	{DottedName: "cahcesuolo.no", ICANN: true},
+	{DottedName: "cal", ICANN: true},
+	{DottedName: "cal.it", ICANN: true},
+	{DottedName: "calabria.it", ICANN: true},
+	{DottedName: "california.museum", ICANN: true},
+	{DottedName: "call", ICANN: true},
+	{DottedName: "caltanissetta.it", ICANN: true},
+	{DottedName: "calvinklein", ICANN: true},
+	{DottedName: "cam", ICANN: true},
+	{DottedName: "cam.it", ICANN: true},
+	{DottedName: "cambridge.museum", ICANN: true},
+	{DottedName: "camera", ICANN: true},
+	{DottedName: "camp", ICANN: true},
+	{DottedName: "campania.it", ICANN: true},
+	{DottedName: "campidano-medio.it", ICANN: true},
+	{DottedName: "campidanomedio.it", ICANN: true},
+	{DottedName: "campinagrande.br", ICANN: true},
+	{DottedName: "campinas.br", ICANN: true},
+	{DottedName: "campobasso.it", ICANN: true},
+	{DottedName: "can.museum", ICANN: true},
+	{DottedName: "canada.museum", ICANN: true},
+	{DottedName: "cancerresearch", ICANN: true},
+	{DottedName: "canon", ICANN: true},
+	{DottedName: "capebreton.museum", ICANN: true},
+	{DottedName: "capetown", ICANN: true},
+	{DottedName: "capital", ICANN: true},
+	{DottedName: "capitalone", ICANN: true},
+	{DottedName: "car", ICANN: true},
+	{DottedName: "caravan", ICANN: true},
+	{DottedName: "carbonia-iglesias.it", ICANN: true},
+	{DottedName: "carboniaiglesias.it", ICANN: true},
+	{DottedName: "cards", ICANN: true},
+	{DottedName: "care", ICANN: true},
+	{DottedName: "career", ICANN: true},
+	{DottedName: "careers", ICANN: true},
+	{DottedName: "cargo.aero", ICANN: true},
+	{DottedName: "carrara-massa.it", ICANN: true},
+	{DottedName: "carraramassa.it", ICANN: true},
+	{DottedName: "carrier.museum", ICANN: true},
+	{DottedName: "cars", ICANN: true},
+	{DottedName: "cartoonart.museum", ICANN: true},
+	{DottedName: "casa", ICANN: true},
+	{DottedName: "casadelamoneda.museum", ICANN: true},
+	{DottedName: "case", ICANN: true},
+	{DottedName: "caserta.it", ICANN: true},
+	{DottedName: "cash", ICANN: true},
+	{DottedName: "casino", ICANN: true},
+	{DottedName: "casino.hu", ICANN: true},
+	{DottedName: "castle.museum", ICANN: true},
+	{DottedName: "castres.museum", ICANN: true},
+	{DottedName: "cat", ICANN: true},
+	{DottedName: "catania.it", ICANN: true},
+	{DottedName: "catanzaro.it", ICANN: true},
+	{DottedName: "catering", ICANN: true},
+	{DottedName: "catering.aero", ICANN: true},
+	{DottedName: "catholic", ICANN: true},
+	{DottedName: "catholic.edu.au", ICANN: true},
+	{DottedName: "caxias.br", ICANN: true},
+	{DottedName: "cb.it", ICANN: true},
+	{DottedName: "cba", ICANN: true},
+	{DottedName: "cbn", ICANN: true},
+	{DottedName: "cbre", ICANN: true},
+	{DottedName: "cbs", ICANN: true},
+	{DottedName: "cc", ICANN: true},
+	{DottedName: "cc.ak.us", ICANN: true},
+	{DottedName: "cc.al.us", ICANN: true},
+	{DottedName: "cc.ar.us", ICANN: true},
+	{DottedName: "cc.as.us", ICANN: true},
+	{DottedName: "cc.az.us", ICANN: true},
+	{DottedName: "cc.ca.us", ICANN: true},
+	{DottedName: "cc.co.us", ICANN: true},
+	{DottedName: "cc.ct.us", ICANN: true},
+	{DottedName: "cc.dc.us", ICANN: true},
+	{DottedName: "cc.de.us", ICANN: true},
+	{DottedName: "cc.fl.us", ICANN: true},
+	{DottedName: "cc.ga.us", ICANN: true},
+	{DottedName: "cc.gu.us", ICANN: true},
+	{DottedName: "cc.hi.us", ICANN: true},
+	{DottedName: "cc.ia.us", ICANN: true},
+	{DottedName: "cc.id.us", ICANN: true},
+	{DottedName: "cc.il.us", ICANN: true},
+	{DottedName: "cc.in.us", ICANN: true},
+	{DottedName: "cc.ks.us", ICANN: true},
+	{DottedName: "cc.ky.us", ICANN: true},
+	{DottedName: "cc.la.us", ICANN: true},
+	{DottedName: "cc.ma.us", ICANN: true},
+	{DottedName: "cc.md.us", ICANN: true},
+	{DottedName: "cc.me.us", ICANN: true},
+	{DottedName: "cc.mi.us", ICANN: true},
+	{DottedName: "cc.mn.us", ICANN: true},
+	{DottedName: "cc.mo.us", ICANN: true},
+	{DottedName: "cc.ms.us", ICANN: true},
+	{DottedName: "cc.mt.us", ICANN: true},
+	{DottedName: "cc.na", ICANN: true},
+	{DottedName: "cc.nc.us", ICANN: true},
+	{DottedName: "cc.nd.us", ICANN: true},
+	{DottedName: "cc.ne.us", ICANN: true},
+	{DottedName: "cc.nh.us", ICANN: true},
+	{DottedName: "cc.nj.us", ICANN: true},
+	{DottedName: "cc.nm.us", ICANN: true},
+	{DottedName: "cc.nv.us", ICANN: true},
+	{DottedName: "cc.ny.us", ICANN: true},
+	{DottedName: "cc.oh.us", ICANN: true},
+	{DottedName: "cc.ok.us", ICANN: true},
+	{DottedName: "cc.or.us", ICANN: true},
+	{DottedName: "cc.pa.us", ICANN: true},
+	{DottedName: "cc.pr.us", ICANN: true},
+	{DottedName: "cc.ri.us", ICANN: true},
+	{DottedName: "cc.sc.us", ICANN: true},
+	{DottedName: "cc.sd.us", ICANN: true},
+	{DottedName: "cc.tn.us", ICANN: true},
+	{DottedName: "cc.tx.us", ICANN: true},
+	{DottedName: "cc.ut.us", ICANN: true},
+	{DottedName: "cc.va.us", ICANN: true},
+	{DottedName: "cc.vi.us", ICANN: true},
+	{DottedName: "cc.vt.us", ICANN: true},
+	{DottedName: "cc.wa.us", ICANN: true},
+	{DottedName: "cc.wi.us", ICANN: true},
+	{DottedName: "cc.wv.us", ICANN: true},
+	{DottedName: "cc.wy.us", ICANN: true},
+	{DottedName: "cci.fr", ICANN: true},
+	{DottedName: "cd", ICANN: true},
+	{DottedName: "ce.gov.br", ICANN: true},
+	{DottedName: "ce.it", ICANN: true},
+	{DottedName: "celtic.museum", ICANN: true},
+	{DottedName: "center", ICANN: true},
+	{DottedName: "center.museum", ICANN: true},
+	{DottedName: "ceo", ICANN: true},
+	{DottedName: "cern", ICANN: true},
+	{DottedName: "certification.aero", ICANN: true},
+	{DottedName: "cesena-forli.it", ICANN: true},
+	{DottedName: "cesenaforli.it", ICANN: true},
+	{DottedName: "cf", ICANN: true},
+	{DottedName: "cfa", ICANN: true},
+	{DottedName: "cfd", ICANN: true},
+	{DottedName: "cg", ICANN: true},
+	{DottedName: "ch", ICANN: true},
+	{DottedName: "ch.it", ICANN: true},
+	{DottedName: "chambagri.fr", ICANN: true},
+	{DottedName: "championship.aero", ICANN: true},
+	{DottedName: "chanel", ICANN: true},
+	{DottedName: "channel", ICANN: true},
+	{DottedName: "charity", ICANN: true},
+	{DottedName: "charter.aero", ICANN: true},
+	{DottedName: "chase", ICANN: true},
+	{DottedName: "chat", ICANN: true},
+	{DottedName: "chattanooga.museum", ICANN: true},
+	{DottedName: "cheap", ICANN: true},
+	{DottedName: "cheltenham.museum", ICANN: true},
+	{DottedName: "cherkassy.ua", ICANN: true},
+	{DottedName: "cherkasy.ua", ICANN: true},
+	{DottedName: "chernigov.ua", ICANN: true},
+	{DottedName: "chernihiv.ua", ICANN: true},
+	{DottedName: "chernivtsi.ua", ICANN: true},
+	{DottedName: "chernovtsy.ua", ICANN: true},
+	{DottedName: "chesapeakebay.museum", ICANN: true},
+	{DottedName: "chiba.jp", ICANN: true},
+	{DottedName: "chicago.museum", ICANN: true},
+	{DottedName: "chichibu.saitama.jp", ICANN: true},
+	{DottedName: "chieti.it", ICANN: true},
+	{DottedName: "chigasaki.kanagawa.jp", ICANN: true},
+	{DottedName: "chihayaakasaka.osaka.jp", ICANN: true},
+	{DottedName: "chijiwa.nagasaki.jp", ICANN: true},
+	{DottedName: "chikugo.fukuoka.jp", ICANN: true},
+	{DottedName: "chikuho.fukuoka.jp", ICANN: true},
+	{DottedName: "chikuhoku.nagano.jp", ICANN: true},
+	{DottedName: "chikujo.fukuoka.jp", ICANN: true},
+	{DottedName: "chikuma.nagano.jp", ICANN: true},
+	{DottedName: "chikusei.ibaraki.jp", ICANN: true},
+	{DottedName: "chikushino.fukuoka.jp", ICANN: true},
+	{DottedName: "chikuzen.fukuoka.jp", ICANN: true},
+	{DottedName: "children.museum", ICANN: true},
+	{DottedName: "childrens.museum", ICANN: true},
+	{DottedName: "childrensgarden.museum", ICANN: true},
+	{DottedName: "chino.nagano.jp", ICANN: true},
+	{DottedName: "chintai", ICANN: true},
+	{DottedName: "chippubetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "chiropractic.museum", ICANN: true},
+	{DottedName: "chirurgiens-dentistes.fr", ICANN: true},
+	{DottedName: "chiryu.aichi.jp", ICANN: true},
+	{DottedName: "chita.aichi.jp", ICANN: true},
+	{DottedName: "chitose.hokkaido.jp", ICANN: true},
+	{DottedName: "chiyoda.gunma.jp", ICANN: true},
+	{DottedName: "chiyoda.tokyo.jp", ICANN: true},
+	{DottedName: "chizu.tottori.jp", ICANN: true},
+	{DottedName: "chocolate.museum", ICANN: true},
+	{DottedName: "chofu.tokyo.jp", ICANN: true},
+	{DottedName: "chonan.chiba.jp", ICANN: true},
+	{DottedName: "chosei.chiba.jp", ICANN: true},
+	{DottedName: "choshi.chiba.jp", ICANN: true},
+	{DottedName: "choyo.kumamoto.jp", ICANN: true},
+	{DottedName: "christiansburg.museum", ICANN: true},
+	{DottedName: "christmas", ICANN: true},
+	{DottedName: "chrome", ICANN: true},
+	{DottedName: "chtr.k12.ma.us", ICANN: true},
+	{DottedName: "chungbuk.kr", ICANN: true},
+	{DottedName: "chungnam.kr", ICANN: true},
+	{DottedName: "chuo.chiba.jp", ICANN: true},
+	{DottedName: "chuo.fukuoka.jp", ICANN: true},
+	{DottedName: "chuo.osaka.jp", ICANN: true},
+	{DottedName: "chuo.tokyo.jp", ICANN: true},
+	{DottedName: "chuo.yamanashi.jp", ICANN: true},
+	{DottedName: "church", ICANN: true},
+	{DottedName: "ci", ICANN: true},
+	{DottedName: "ci.it", ICANN: true},
+	{DottedName: "ciencia.bo", ICANN: true},
+	{DottedName: "cieszyn.pl", ICANN: true},
+	{DottedName: "cim.br", ICANN: true},
+	{DottedName: "cincinnati.museum", ICANN: true},
+	{DottedName: "cinema.museum", ICANN: true},
+	{DottedName: "cipriani", ICANN: true},
+	{DottedName: "circle", ICANN: true},
+	{DottedName: "circus.museum", ICANN: true},
+	{DottedName: "cisco", ICANN: true},
+	{DottedName: "citadel", ICANN: true},
+	{DottedName: "citi", ICANN: true},
+	{DottedName: "citic", ICANN: true},
+	{DottedName: "city", ICANN: true},
+	{DottedName: "city.hu", ICANN: true},
+	{DottedName: "cityeats", ICANN: true},
+	{DottedName: "civilaviation.aero", ICANN: true},
+	{DottedName: "civilisation.museum", ICANN: true},
+	{DottedName: "civilization.museum", ICANN: true},
+	{DottedName: "civilwar.museum", ICANN: true},
+	{DottedName: "ck.ua", ICANN: true},
+	{DottedName: "cl", ICANN: true},
+	{DottedName: "cl.it", ICANN: true},
+	{DottedName: "claims", ICANN: true},
+	{DottedName: "cleaning", ICANN: true},
+	{DottedName: "click", ICANN: true},
+	{DottedName: "clinic", ICANN: true},
+	{DottedName: "clinique", ICANN: true},
+	{DottedName: "clinton.museum", ICANN: true},
+	{DottedName: "clock.museum", ICANN: true},
+	{DottedName: "clothing", ICANN: true},
+	{DottedName: "cloud", ICANN: true},
+	{DottedName: "club", ICANN: true},
+	{DottedName: "club.aero", ICANN: true},
+	{DottedName: "club.tw", ICANN: true},
+	{DottedName: "clubmed", ICANN: true},
+	{DottedName: "cm", ICANN: true},
+	{DottedName: "cn", ICANN: true},
+	{DottedName: "cn.it", ICANN: true},
+	{DottedName: "cn.ua", ICANN: true},
+	{DottedName: "cng.br", ICANN: true},
+	{DottedName: "cnt.br", ICANN: true},
+	{DottedName: "co", ICANN: true},
+	{DottedName: "co.ae", ICANN: true},
+	{DottedName: "co.ag", ICANN: true},
+	{DottedName: "co.am", ICANN: true},
+	{DottedName: "co.ao", ICANN: true},
+	{DottedName: "co.at", ICANN: true},
+	{DottedName: "co.bb", ICANN: true},
+	{DottedName: "co.bi", ICANN: true},
+	{DottedName: "co.bw", ICANN: true},
+	{DottedName: "co.ci", ICANN: true},
+	{DottedName: "co.cl", ICANN: true},
+	{DottedName: "co.cm", ICANN: true},
+	{DottedName: "co.cr", ICANN: true},
+	{DottedName: "co.gg", ICANN: true},
+	{DottedName: "co.gl", ICANN: true},
+	{DottedName: "co.gy", ICANN: true},
+	{DottedName: "co.hu", ICANN: true},
+	{DottedName: "co.id", ICANN: true},
+	{DottedName: "co.il", ICANN: true},
+	{DottedName: "co.im", ICANN: true},
+	{DottedName: "co.in", ICANN: true},
+	{DottedName: "co.ir", ICANN: true},
+	{DottedName: "co.it", ICANN: true},
+	{DottedName: "co.je", ICANN: true},
+	{DottedName: "co.jp", ICANN: true},
+	{DottedName: "co.ke", ICANN: true},
+	{DottedName: "co.kr", ICANN: true},
+	{DottedName: "co.lc", ICANN: true},
+	{DottedName: "co.ls", ICANN: true},
+	{DottedName: "co.ma", ICANN: true},
+	{DottedName: "co.me", ICANN: true},
+	{DottedName: "co.mg", ICANN: true},
+	{DottedName: "co.mu", ICANN: true},
+	{DottedName: "co.mw", ICANN: true},
+	{DottedName: "co.mz", ICANN: true},
+	{DottedName: "co.na", ICANN: true},
+	{DottedName: "co.ni", ICANN: true},
+	{DottedName: "co.nz", ICANN: true},
+	{DottedName: "co.om", ICANN: true},
+	{DottedName: "co.pn", ICANN: true},
+	{DottedName: "co.pw", ICANN: true},
+	{DottedName: "co.rs", ICANN: true},
+	{DottedName: "co.rw", ICANN: true},
+	{DottedName: "co.st", ICANN: true},
+	{DottedName: "co.sz", ICANN: true},
+	{DottedName: "co.th", ICANN: true},
+	{DottedName: "co.tj", ICANN: true},
+	{DottedName: "co.tm", ICANN: true},
+	{DottedName: "co.tt", ICANN: true},
+	{DottedName: "co.tz", ICANN: true},
+	{DottedName: "co.ug", ICANN: true},
+	{DottedName: "co.uk", ICANN: true},
+	{DottedName: "co.us", ICANN: true},
+	{DottedName: "co.uz", ICANN: true},
+	{DottedName: "co.ve", ICANN: true},
+	{DottedName: "co.vi", ICANN: true},
+	{DottedName: "co.za", ICANN: true},
+	{DottedName: "co.zm", ICANN: true},
+	{DottedName: "co.zw", ICANN: true},
+	{DottedName: "coach", ICANN: true},
+	{DottedName: "coal.museum", ICANN: true},
+	{DottedName: "coastaldefence.museum", ICANN: true},
+	{DottedName: "codes", ICANN: true},
+	{DottedName: "cody.museum", ICANN: true},
+	{DottedName: "coffee", ICANN: true},
+	{DottedName: "cog.mi.us", ICANN: true},
+	{DottedName: "coldwar.museum", ICANN: true},
+	{DottedName: "collection.museum", ICANN: true},
+	{DottedName: "college", ICANN: true},
+	{DottedName: "cologne", ICANN: true},
+	{DottedName: "colonialwilliamsburg.museum", ICANN: true},
+	{DottedName: "coloradoplateau.museum", ICANN: true},
+	{DottedName: "columbia.museum", ICANN: true},
+	{DottedName: "columbus.museum", ICANN: true},
+	{DottedName: "com", ICANN: true},
+	{DottedName: "com.ac", ICANN: true},
+	{DottedName: "com.af", ICANN: true},
+	{DottedName: "com.ag", ICANN: true},
+	{DottedName: "com.ai", ICANN: true},
+	{DottedName: "com.al", ICANN: true},
+	{DottedName: "com.am", ICANN: true},
+	{DottedName: "com.ar", ICANN: true},
+	{DottedName: "com.au", ICANN: true},
+	{DottedName: "com.aw", ICANN: true},
+	{DottedName: "com.az", ICANN: true},
+	{DottedName: "com.ba", ICANN: true},
+	{DottedName: "com.bb", ICANN: true},
+	{DottedName: "com.bh", ICANN: true},
+	{DottedName: "com.bi", ICANN: true},
+	{DottedName: "com.bm", ICANN: true},
+	{DottedName: "com.bn", ICANN: true},
+	{DottedName: "com.bo", ICANN: true},
+	{DottedName: "com.br", ICANN: true},
+	{DottedName: "com.bs", ICANN: true},
+	{DottedName: "com.bt", ICANN: true},
+	{DottedName: "com.by", ICANN: true},
+	{DottedName: "com.bz", ICANN: true},
+	{DottedName: "com.ci", ICANN: true},
+	{DottedName: "com.cm", ICANN: true},
+	{DottedName: "com.cn", ICANN: true},
+	{DottedName: "com.co", ICANN: true},
+	{DottedName: "com.cu", ICANN: true},
+	{DottedName: "com.cv", ICANN: true},
+	{DottedName: "com.cw", ICANN: true},
+	{DottedName: "com.cy", ICANN: true},
+	{DottedName: "com.dm", ICANN: true},
+	{DottedName: "com.do", ICANN: true},
+	{DottedName: "com.dz", ICANN: true},
+	{DottedName: "com.ec", ICANN: true},
+	{DottedName: "com.ee", ICANN: true},
+	{DottedName: "com.eg", ICANN: true},
+	{DottedName: "com.es", ICANN: true},
+	{DottedName: "com.et", ICANN: true},
+	{DottedName: "com.fj", ICANN: true},
+	{DottedName: "com.fm", ICANN: true},
+	{DottedName: "com.fr", ICANN: true},
+	{DottedName: "com.ge", ICANN: true},
+	{DottedName: "com.gh", ICANN: true},
+	{DottedName: "com.gi", ICANN: true},
+	{DottedName: "com.gl", ICANN: true},
+	{DottedName: "com.gn", ICANN: true},
+	{DottedName: "com.gp", ICANN: true},
+	{DottedName: "com.gr", ICANN: true},
+	{DottedName: "com.gt", ICANN: true},
+	{DottedName: "com.gu", ICANN: true},
+	{DottedName: "com.gy", ICANN: true},
+	{DottedName: "com.hk", ICANN: true},
+	{DottedName: "com.hn", ICANN: true},
+	{DottedName: "com.hr", ICANN: true},
+	{DottedName: "com.ht", ICANN: true},
+	{DottedName: "com.im", ICANN: true},
+	{DottedName: "com.io", ICANN: true},
+	{DottedName: "com.iq", ICANN: true},
+	{DottedName: "com.is", ICANN: true},
+	{DottedName: "com.jo", ICANN: true},
+	{DottedName: "com.kg", ICANN: true},
+	{DottedName: "com.ki", ICANN: true},
+	{DottedName: "com.km", ICANN: true},
+	{DottedName: "com.kp", ICANN: true},
+	{DottedName: "com.kw", ICANN: true},
+	{DottedName: "com.ky", ICANN: true},
+	{DottedName: "com.kz", ICANN: true},
+	{DottedName: "com.la", ICANN: true},
+	{DottedName: "com.lb", ICANN: true},
+	{DottedName: "com.lc", ICANN: true},
+	{DottedName: "com.lk", ICANN: true},
+	{DottedName: "com.lr", ICANN: true},
+	{DottedName: "com.lv", ICANN: true},
+	{DottedName: "com.ly", ICANN: true},
+	{DottedName: "com.mg", ICANN: true},
+	{DottedName: "com.mk", ICANN: true},
+	{DottedName: "com.ml", ICANN: true},
+	{DottedName: "com.mo", ICANN: true},
+	{DottedName: "com.ms", ICANN: true},
+	{DottedName: "com.mt", ICANN: true},
+	{DottedName: "com.mu", ICANN: true},
+	{DottedName: "com.mv", ICANN: true},
+	{DottedName: "com.mw", ICANN: true},
+	{DottedName: "com.mx", ICANN: true},
+	{DottedName: "com.my", ICANN: true},
+	{DottedName: "com.na", ICANN: true},
+	{DottedName: "com.nf", ICANN: true},
+	{DottedName: "com.ng", ICANN: true},
+	{DottedName: "com.ni", ICANN: true},
+	{DottedName: "com.nr", ICANN: true},
+	{DottedName: "com.om", ICANN: true},
+	{DottedName: "com.pa", ICANN: true},
+	{DottedName: "com.pe", ICANN: true},
+	{DottedName: "com.pf", ICANN: true},
+	{DottedName: "com.ph", ICANN: true},
+	{DottedName: "com.pk", ICANN: true},
+	{DottedName: "com.pl", ICANN: true},
+	{DottedName: "com.pr", ICANN: true},
+	{DottedName: "com.ps", ICANN: true},
+	{DottedName: "com.pt", ICANN: true},
+	{DottedName: "com.py", ICANN: true},
+	{DottedName: "com.qa", ICANN: true},
+	{DottedName: "com.re", ICANN: true},
+	{DottedName: "com.ro", ICANN: true},
+	{DottedName: "com.sa", ICANN: true},
+	{DottedName: "com.sb", ICANN: true},
+	{DottedName: "com.sc", ICANN: true},
+	{DottedName: "com.sd", ICANN: true},
+	{DottedName: "com.sg", ICANN: true},
+	{DottedName: "com.sh", ICANN: true},
+	{DottedName: "com.sl", ICANN: true},
+	{DottedName: "com.sn", ICANN: true},
+	{DottedName: "com.so", ICANN: true},
+	{DottedName: "com.ss", ICANN: true},
+	{DottedName: "com.st", ICANN: true},
+	{DottedName: "com.sv", ICANN: true},
+	{DottedName: "com.sy", ICANN: true},
+	{DottedName: "com.tj", ICANN: true},
+	{DottedName: "com.tm", ICANN: true},
+	{DottedName: "com.tn", ICANN: true},
+	{DottedName: "com.to", ICANN: true},
+	{DottedName: "com.tr", ICANN: true},
+	{DottedName: "com.tt", ICANN: true},
+	{DottedName: "com.tw", ICANN: true},
+	{DottedName: "com.ua", ICANN: true},
+	{DottedName: "com.ug", ICANN: true},
+	{DottedName: "com.uy", ICANN: true},
+	{DottedName: "com.uz", ICANN: true},
+	{DottedName: "com.vc", ICANN: true},
+	{DottedName: "com.ve", ICANN: true},
+	{DottedName: "com.vi", ICANN: true},
+	{DottedName: "com.vn", ICANN: true},
+	{DottedName: "com.vu", ICANN: true},
+	{DottedName: "com.ws", ICANN: true},
+	{DottedName: "com.ye", ICANN: true},
+	{DottedName: "com.zm", ICANN: true},
+	{DottedName: "comcast", ICANN: true},
+	{DottedName: "commbank", ICANN: true},
+	{DottedName: "commune.am", ICANN: true},
+	{DottedName: "communication.museum", ICANN: true},
+	{DottedName: "communications.museum", ICANN: true},
+	{DottedName: "community", ICANN: true},
+	{DottedName: "community.museum", ICANN: true},
+	{DottedName: "como.it", ICANN: true},
+	{DottedName: "company", ICANN: true},
+	{DottedName: "compare", ICANN: true},
+	{DottedName: "computer", ICANN: true},
+	{DottedName: "computer.museum", ICANN: true},
+	{DottedName: "computerhistory.museum", ICANN: true},
+	{DottedName: "comsec", ICANN: true},
+	{DottedName: "condos", ICANN: true},
+	{DottedName: "conf.au", ICANN: true},
+	{DottedName: "conf.lv", ICANN: true},
+	{DottedName: "conference.aero", ICANN: true},
+	{DottedName: "construction", ICANN: true},
+	{DottedName: "consulado.st", ICANN: true},
+	{DottedName: "consultant.aero", ICANN: true},
+	{DottedName: "consulting", ICANN: true},
+	{DottedName: "consulting.aero", ICANN: true},
+	{DottedName: "contact", ICANN: true},
+	{DottedName: "contagem.br", ICANN: true},
+	{DottedName: "contemporary.museum", ICANN: true},
+	{DottedName: "contemporaryart.museum", ICANN: true},
+	{DottedName: "contractors", ICANN: true},
+	{DottedName: "control.aero", ICANN: true},
+	{DottedName: "convent.museum", ICANN: true},
+	{DottedName: "cooking", ICANN: true},
+	{DottedName: "cookingchannel", ICANN: true},
+	{DottedName: "cool", ICANN: true},
+	{DottedName: "coop", ICANN: true},
+	{DottedName: "coop.ar", ICANN: true},
+	{DottedName: "coop.br", ICANN: true},
+	{DottedName: "coop.ht", ICANN: true},
+	{DottedName: "coop.km", ICANN: true},
+	{DottedName: "coop.mv", ICANN: true},
+	{DottedName: "coop.mw", ICANN: true},
+	{DottedName: "coop.py", ICANN: true},
+	{DottedName: "coop.rw", ICANN: true},
+	{DottedName: "coop.tt", ICANN: true},
+	{DottedName: "cooperativa.bo", ICANN: true},
+	{DottedName: "copenhagen.museum", ICANN: true},
+	{DottedName: "corporation.museum", ICANN: true},
+	{DottedName: "corsica", ICANN: true},
+	{DottedName: "corvette.museum", ICANN: true},
+	{DottedName: "cosenza.it", ICANN: true},
+	{DottedName: "costume.museum", ICANN: true},
+	{DottedName: "council.aero", ICANN: true},
+	{DottedName: "country", ICANN: true},
+	{DottedName: "countryestate.museum", ICANN: true},
+	{DottedName: "county.museum", ICANN: true},
+	{DottedName: "coupon", ICANN: true},
+	{DottedName: "coupons", ICANN: true},
+	{DottedName: "courses", ICANN: true},
+	{DottedName: "coz.br", ICANN: true},
+	{DottedName: "cpa", ICANN: true},
+	{DottedName: "cpa.pro", ICANN: true},
+	{DottedName: "cq.cn", ICANN: true},
+	{DottedName: "cr", ICANN: true},
+	{DottedName: "cr.it", ICANN: true},
+	{DottedName: "cr.ua", ICANN: true},
+	{DottedName: "crafts.museum", ICANN: true},
+	{DottedName: "cranbrook.museum", ICANN: true},
+	{DottedName: "creation.museum", ICANN: true},
+	{DottedName: "credit", ICANN: true},
+	{DottedName: "creditcard", ICANN: true},
+	{DottedName: "creditunion", ICANN: true},
+	{DottedName: "cremona.it", ICANN: true},
+	{DottedName: "crew.aero", ICANN: true},
+	{DottedName: "cri.br", ICANN: true},
+	{DottedName: "cri.nz", ICANN: true},
+	{DottedName: "cricket", ICANN: true},
+	{DottedName: "crimea.ua", ICANN: true},
+	{DottedName: "crotone.it", ICANN: true},
+	{DottedName: "crown", ICANN: true},
+	{DottedName: "crs", ICANN: true},
+	{DottedName: "cruise", ICANN: true},
+	{DottedName: "cruises", ICANN: true},
+	{DottedName: "cs.it", ICANN: true},
+	{DottedName: "csc", ICANN: true},
+	{DottedName: "ct.it", ICANN: true},
+	{DottedName: "ct.us", ICANN: true},
+	{DottedName: "cu", ICANN: true},
+	{DottedName: "cuiaba.br", ICANN: true},
+	{DottedName: "cuisinella", ICANN: true},
+	{DottedName: "cultural.museum", ICANN: true},
+	{DottedName: "culturalcenter.museum", ICANN: true},
+	{DottedName: "culture.museum", ICANN: true},
+	{DottedName: "cuneo.it", ICANN: true},
+	{DottedName: "curitiba.br", ICANN: true},
+	{DottedName: "cv", ICANN: true},
+	{DottedName: "cv.ua", ICANN: true},
+	{DottedName: "cw", ICANN: true},
+	{DottedName: "cx", ICANN: true},
+	{DottedName: "cy", ICANN: true},
+	{DottedName: "cyber.museum", ICANN: true},
+	{DottedName: "cymru", ICANN: true},
+	{DottedName: "cymru.museum", ICANN: true},
+	{DottedName: "cyou", ICANN: true},
+	{DottedName: "cz", ICANN: true},
+	{DottedName: "cz.it", ICANN: true},
+	{DottedName: "czeladz.pl", ICANN: true},
+	{DottedName: "czest.pl", ICANN: true},
+	{DottedName: "d.bg", ICANN: true},
+	{DottedName: "d.se", ICANN: true},
+	{DottedName: "dabur", ICANN: true},
+	{DottedName: "dad", ICANN: true},
+	{DottedName: "daegu.kr", ICANN: true},
+	{DottedName: "daejeon.kr", ICANN: true},
+	{DottedName: "daigo.ibaraki.jp", ICANN: true},
+	{DottedName: "daisen.akita.jp", ICANN: true},
+	{DottedName: "daito.osaka.jp", ICANN: true},
+	{DottedName: "daiwa.hiroshima.jp", ICANN: true},
+	{DottedName: "dali.museum", ICANN: true},
+	{DottedName: "dallas.museum", ICANN: true},
+	{DottedName: "dance", ICANN: true},
+	{DottedName: "data", ICANN: true},
+	{DottedName: "database.museum", ICANN: true},
+	{DottedName: "date", ICANN: true},
+	{DottedName: "date.fukushima.jp", ICANN: true},
+	{DottedName: "date.hokkaido.jp", ICANN: true},
+	{DottedName: "dating", ICANN: true},
+	{DottedName: "datsun", ICANN: true},
+	{DottedName: "davvenjarga.no", ICANN: true},
+	{DottedName: "davvesiida.no", ICANN: true},
+	{DottedName: "day", ICANN: true},
+	{DottedName: "dazaifu.fukuoka.jp", ICANN: true},
+	{DottedName: "dc.us", ICANN: true},
+	{DottedName: "dclk", ICANN: true},
+	{DottedName: "ddr.museum", ICANN: true},
+	{DottedName: "dds", ICANN: true},
+	{DottedName: "de", ICANN: true},
+	{DottedName: "de.us", ICANN: true},
+	{DottedName: "deal", ICANN: true},
+	{DottedName: "dealer", ICANN: true},
+	{DottedName: "deals", ICANN: true},
+	{DottedName: "deatnu.no", ICANN: true},
+	{DottedName: "decorativearts.museum", ICANN: true},
+	{DottedName: "def.br", ICANN: true},
+	{DottedName: "degree", ICANN: true},
+	{DottedName: "delaware.museum", ICANN: true},
+	{DottedName: "delivery", ICANN: true},
+	{DottedName: "dell", ICANN: true},
+	{DottedName: "dell-ogliastra.it", ICANN: true},
+	{DottedName: "dellogliastra.it", ICANN: true},
+	{DottedName: "delmenhorst.museum", ICANN: true},
+	{DottedName: "deloitte", ICANN: true},
+	{DottedName: "delta", ICANN: true},
+	{DottedName: "democracia.bo", ICANN: true},
+	{DottedName: "democrat", ICANN: true},
+	{DottedName: "denmark.museum", ICANN: true},
+	{DottedName: "dental", ICANN: true},
+	{DottedName: "dentist", ICANN: true},
+	{DottedName: "dep.no", ICANN: true},
+	{DottedName: "deporte.bo", ICANN: true},
+	{DottedName: "depot.museum", ICANN: true},
+	{DottedName: "des.br", ICANN: true},
+	{DottedName: "desa.id", ICANN: true},
+	{DottedName: "desi", ICANN: true},
+	{DottedName: "design", ICANN: true},
+	{DottedName: "design.aero", ICANN: true},
+	{DottedName: "design.museum", ICANN: true},
+	{DottedName: "det.br", ICANN: true},
+	{DottedName: "detroit.museum", ICANN: true},
+	{DottedName: "dev", ICANN: true},
+	{DottedName: "dev.br", ICANN: true},
+	{DottedName: "df.gov.br", ICANN: true},
+	{DottedName: "dgca.aero", ICANN: true},
+	{DottedName: "dhl", ICANN: true},
+	{DottedName: "diamonds", ICANN: true},
+	{DottedName: "dielddanuorri.no", ICANN: true},
+	{DottedName: "diet", ICANN: true},
+	{DottedName: "digital", ICANN: true},
+	{DottedName: "dinosaur.museum", ICANN: true},
+	{DottedName: "direct", ICANN: true},
+	{DottedName: "directory", ICANN: true},
+	{DottedName: "discount", ICANN: true},
+	{DottedName: "discover", ICANN: true},
+	{DottedName: "discovery.museum", ICANN: true},
+	{DottedName: "dish", ICANN: true},
+	{DottedName: "divtasvuodna.no", ICANN: true},
+	{DottedName: "divttasvuotna.no", ICANN: true},
+	{DottedName: "diy", ICANN: true},
+	{DottedName: "dj", ICANN: true},
+	{DottedName: "dk", ICANN: true},
+	{DottedName: "dlugoleka.pl", ICANN: true},
+	{DottedName: "dm", ICANN: true},
+	{DottedName: "dn.ua", ICANN: true},
+	{DottedName: "dnepropetrovsk.ua", ICANN: true},
+	{DottedName: "dni.us", ICANN: true},
+	{DottedName: "dnipropetrovsk.ua", ICANN: true},
+	{DottedName: "dnp", ICANN: true},
+	{DottedName: "do", ICANN: true},
+	{DottedName: "docs", ICANN: true},
+	{DottedName: "doctor", ICANN: true},
+	{DottedName: "dog", ICANN: true},
+	{DottedName: "dolls.museum", ICANN: true},
+	{DottedName: "domains", ICANN: true},
+	{DottedName: "donetsk.ua", ICANN: true},
+	{DottedName: "donna.no", ICANN: true},
+	{DottedName: "donostia.museum", ICANN: true},
+	{DottedName: "doshi.yamanashi.jp", ICANN: true},
+	{DottedName: "dot", ICANN: true},
+	{DottedName: "dovre.no", ICANN: true},
+	{DottedName: "download", ICANN: true},
+	{DottedName: "dp.ua", ICANN: true},
+	{DottedName: "dr.na", ICANN: true},
+	{DottedName: "dr.tr", ICANN: true},
+	{DottedName: "drammen.no", ICANN: true},
+	{DottedName: "drangedal.no", ICANN: true},
+	{DottedName: "drive", ICANN: true},
+	{DottedName: "drobak.no", ICANN: true},
+	{DottedName: "dst.mi.us", ICANN: true},
+	{DottedName: "dtv", ICANN: true},
+	{DottedName: "dubai", ICANN: true},
+	{DottedName: "duck", ICANN: true},
+	{DottedName: "dunlop", ICANN: true},
+	{DottedName: "dupont", ICANN: true},
+	{DottedName: "durban", ICANN: true},
+	{DottedName: "durham.museum", ICANN: true},
+	{DottedName: "dvag", ICANN: true},
+	{DottedName: "dvr", ICANN: true},
+	{DottedName: "dyroy.no", ICANN: true},
+	{DottedName: "dz", ICANN: true},
+	{DottedName: "e.bg", ICANN: true},
+	{DottedName: "e.se", ICANN: true},
+	{DottedName: "e12.ve", ICANN: true},
+	{DottedName: "e164.arpa", ICANN: true},
+	{DottedName: "earth", ICANN: true},
+	{DottedName: "eastafrica.museum", ICANN: true},
+	{DottedName: "eastcoast.museum", ICANN: true},
+	{DottedName: "eat", ICANN: true},
+	{DottedName: "eaton.mi.us", ICANN: true},
+	{DottedName: "ebetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "ebina.kanagawa.jp", ICANN: true},
+	{DottedName: "ebino.miyazaki.jp", ICANN: true},
+	{DottedName: "ebiz.tw", ICANN: true},
+	{DottedName: "ec", ICANN: true},
+	{DottedName: "echizen.fukui.jp", ICANN: true},
+	{DottedName: "ecn.br", ICANN: true},
+	{DottedName: "eco", ICANN: true},
+	{DottedName: "eco.br", ICANN: true},
+	{DottedName: "ecologia.bo", ICANN: true},
+	{DottedName: "economia.bo", ICANN: true},
+	{DottedName: "ed.ao", ICANN: true},
+	{DottedName: "ed.ci", ICANN: true},
+	{DottedName: "ed.cr", ICANN: true},
+	{DottedName: "ed.jp", ICANN: true},
+	{DottedName: "ed.pw", ICANN: true},
+	{DottedName: "edeka", ICANN: true},
+	{DottedName: "edogawa.tokyo.jp", ICANN: true},
+	{DottedName: "edu", ICANN: true},
+	{DottedName: "edu.ac", ICANN: true},
+	{DottedName: "edu.af", ICANN: true},
+	{DottedName: "edu.al", ICANN: true},
+	{DottedName: "edu.ar", ICANN: true},
+	{DottedName: "edu.au", ICANN: true},
+	{DottedName: "edu.az", ICANN: true},
+	{DottedName: "edu.ba", ICANN: true},
+	{DottedName: "edu.bb", ICANN: true},
+	{DottedName: "edu.bh", ICANN: true},
+	{DottedName: "edu.bi", ICANN: true},
+	{DottedName: "edu.bm", ICANN: true},
+	{DottedName: "edu.bn", ICANN: true},
+	{DottedName: "edu.bo", ICANN: true},
+	{DottedName: "edu.br", ICANN: true},
+	{DottedName: "edu.bs", ICANN: true},
+	{DottedName: "edu.bt", ICANN: true},
+	{DottedName: "edu.bz", ICANN: true},
+	{DottedName: "edu.ci", ICANN: true},
+	{DottedName: "edu.cn", ICANN: true},
+	{DottedName: "edu.co", ICANN: true},
+	{DottedName: "edu.cu", ICANN: true},
+	{DottedName: "edu.cv", ICANN: true},
+	{DottedName: "edu.cw", ICANN: true},
+	{DottedName: "edu.dm", ICANN: true},
+	{DottedName: "edu.do", ICANN: true},
+	{DottedName: "edu.dz", ICANN: true},
+	{DottedName: "edu.ec", ICANN: true},
+	{DottedName: "edu.ee", ICANN: true},
+	{DottedName: "edu.eg", ICANN: true},
+	{DottedName: "edu.es", ICANN: true},
+	{DottedName: "edu.et", ICANN: true},
+	{DottedName: "edu.fm", ICANN: true},
+	{DottedName: "edu.gd", ICANN: true},
+	{DottedName: "edu.ge", ICANN: true},
+	{DottedName: "edu.gh", ICANN: true},
+	{DottedName: "edu.gi", ICANN: true},
+	{DottedName: "edu.gl", ICANN: true},
+	{DottedName: "edu.gn", ICANN: true},
+	{DottedName: "edu.gp", ICANN: true},
+	{DottedName: "edu.gr", ICANN: true},
+	{DottedName: "edu.gt", ICANN: true},
+	{DottedName: "edu.gu", ICANN: true},
+	{DottedName: "edu.gy", ICANN: true},
+	{DottedName: "edu.hk", ICANN: true},
+	{DottedName: "edu.hn", ICANN: true},
+	{DottedName: "edu.ht", ICANN: true},
+	{DottedName: "edu.in", ICANN: true},
+	{DottedName: "edu.iq", ICANN: true},
+	{DottedName: "edu.is", ICANN: true},
+	{DottedName: "edu.it", ICANN: true},
+	{DottedName: "edu.jo", ICANN: true},
+	{DottedName: "edu.kg", ICANN: true},
+	{DottedName: "edu.ki", ICANN: true},
+	{DottedName: "edu.km", ICANN: true},
+	{DottedName: "edu.kn", ICANN: true},
+	{DottedName: "edu.kp", ICANN: true},
+	{DottedName: "edu.kw", ICANN: true},
+	{DottedName: "edu.ky", ICANN: true},
+	{DottedName: "edu.kz", ICANN: true},
+	{DottedName: "edu.la", ICANN: true},
+	{DottedName: "edu.lb", ICANN: true},
+	{DottedName: "edu.lc", ICANN: true},
+	{DottedName: "edu.lk", ICANN: true},
+	{DottedName: "edu.lr", ICANN: true},
+	{DottedName: "edu.ls", ICANN: true},
+	{DottedName: "edu.lv", ICANN: true},
+	{DottedName: "edu.ly", ICANN: true},
+	{DottedName: "edu.me", ICANN: true},
+	{DottedName: "edu.mg", ICANN: true},
+	{DottedName: "edu.mk", ICANN: true},
+	{DottedName: "edu.ml", ICANN: true},
+	{DottedName: "edu.mn", ICANN: true},
+	{DottedName: "edu.mo", ICANN: true},
+	{DottedName: "edu.ms", ICANN: true},
+	{DottedName: "edu.mt", ICANN: true},
+	{DottedName: "edu.mv", ICANN: true},
+	{DottedName: "edu.mw", ICANN: true},
+	{DottedName: "edu.mx", ICANN: true},
+	{DottedName: "edu.my", ICANN: true},
+	{DottedName: "edu.mz", ICANN: true},
+	{DottedName: "edu.ng", ICANN: true},
+	{DottedName: "edu.ni", ICANN: true},
+	{DottedName: "edu.nr", ICANN: true},
+	{DottedName: "edu.om", ICANN: true},
+	{DottedName: "edu.pa", ICANN: true},
+	{DottedName: "edu.pe", ICANN: true},
+	{DottedName: "edu.pf", ICANN: true},
+	{DottedName: "edu.ph", ICANN: true},
+	{DottedName: "edu.pk", ICANN: true},
+	{DottedName: "edu.pl", ICANN: true},
+	{DottedName: "edu.pn", ICANN: true},
+	{DottedName: "edu.pr", ICANN: true},
+	{DottedName: "edu.ps", ICANN: true},
+	{DottedName: "edu.pt", ICANN: true},
+	{DottedName: "edu.py", ICANN: true},
+	{DottedName: "edu.qa", ICANN: true},
+	{DottedName: "edu.rs", ICANN: true},
+	{DottedName: "edu.sa", ICANN: true},
+	{DottedName: "edu.sb", ICANN: true},
+	{DottedName: "edu.sc", ICANN: true},
+	{DottedName: "edu.sd", ICANN: true},
+	{DottedName: "edu.sg", ICANN: true},
+	{DottedName: "edu.sl", ICANN: true},
+	{DottedName: "edu.sn", ICANN: true},
+	{DottedName: "edu.so", ICANN: true},
+	{DottedName: "edu.ss", ICANN: true},
+	{DottedName: "edu.st", ICANN: true},
+	{DottedName: "edu.sv", ICANN: true},
+	{DottedName: "edu.sy", ICANN: true},
+	{DottedName: "edu.tj", ICANN: true},
+	{DottedName: "edu.tm", ICANN: true},
+	{DottedName: "edu.to", ICANN: true},
+	{DottedName: "edu.tr", ICANN: true},
+	{DottedName: "edu.tt", ICANN: true},
+	{DottedName: "edu.tw", ICANN: true},
+	{DottedName: "edu.ua", ICANN: true},
+	{DottedName: "edu.uy", ICANN: true},
+	{DottedName: "edu.vc", ICANN: true},
+	{DottedName: "edu.ve", ICANN: true},
+	{DottedName: "edu.vn", ICANN: true},
+	{DottedName: "edu.vu", ICANN: true},
+	{DottedName: "edu.ws", ICANN: true},
+	{DottedName: "edu.ye", ICANN: true},
+	{DottedName: "edu.za", ICANN: true},
+	{DottedName: "edu.zm", ICANN: true},
+	{DottedName: "education", ICANN: true},
+	{DottedName: "education.museum", ICANN: true},
+	{DottedName: "educational.museum", ICANN: true},
+	{DottedName: "educator.aero", ICANN: true},
+	{DottedName: "ee", ICANN: true},
+	{DottedName: "eg", ICANN: true},
+	{DottedName: "egersund.no", ICANN: true},
+	{DottedName: "egyptian.museum", ICANN: true},
+	{DottedName: "ehime.jp", ICANN: true},
+	{DottedName: "eid.no", ICANN: true},
+	{DottedName: "eidfjord.no", ICANN: true},
+	{DottedName: "eidsberg.no", ICANN: true},
+	{DottedName: "eidskog.no", ICANN: true},
+	{DottedName: "eidsvoll.no", ICANN: true},
+	{DottedName: "eigersund.no", ICANN: true},
+	{DottedName: "eiheiji.fukui.jp", ICANN: true},
+	{DottedName: "eisenbahn.museum", ICANN: true},
+	{DottedName: "ekloges.cy", ICANN: true},
+	{DottedName: "elblag.pl", ICANN: true},
+	{DottedName: "elburg.museum", ICANN: true},
+	{DottedName: "elk.pl", ICANN: true},
+	{DottedName: "elvendrell.museum", ICANN: true},
+	{DottedName: "elverum.no", ICANN: true},
+	{DottedName: "email", ICANN: true},
+	{DottedName: "emb.kw", ICANN: true},
+	{DottedName: "embaixada.st", ICANN: true},
+	{DottedName: "embetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "embroidery.museum", ICANN: true},
+	{DottedName: "emerck", ICANN: true},
+	{DottedName: "emergency.aero", ICANN: true},
+	{DottedName: "emilia-romagna.it", ICANN: true},
+	{DottedName: "emiliaromagna.it", ICANN: true},
+	{DottedName: "emp.br", ICANN: true},
+	{DottedName: "empresa.bo", ICANN: true},
+	{DottedName: "emr.it", ICANN: true},
+	{DottedName: "en.it", ICANN: true},
+	{DottedName: "ena.gifu.jp", ICANN: true},
+	{DottedName: "encyclopedic.museum", ICANN: true},
+	{DottedName: "enebakk.no", ICANN: true},
+	{DottedName: "energy", ICANN: true},
+	{DottedName: "enf.br", ICANN: true},
+	{DottedName: "eng.br", ICANN: true},
+	{DottedName: "eng.pro", ICANN: true},
+	{DottedName: "engerdal.no", ICANN: true},
+	{DottedName: "engine.aero", ICANN: true},
+	{DottedName: "engineer", ICANN: true},
+	{DottedName: "engineer.aero", ICANN: true},
+	{DottedName: "engineering", ICANN: true},
+	{DottedName: "england.museum", ICANN: true},
+	{DottedName: "eniwa.hokkaido.jp", ICANN: true},
+	{DottedName: "enna.it", ICANN: true},
+	{DottedName: "ens.tn", ICANN: true},
+	{DottedName: "enterprises", ICANN: true},
+	{DottedName: "entertainment.aero", ICANN: true},
+	{DottedName: "entomology.museum", ICANN: true},
+	{DottedName: "environment.museum", ICANN: true},
+	{DottedName: "environmentalconservation.museum", ICANN: true},
+	{DottedName: "epilepsy.museum", ICANN: true},
+	{DottedName: "epson", ICANN: true},
+	{DottedName: "equipment", ICANN: true},
+	{DottedName: "equipment.aero", ICANN: true},
+	{DottedName: "ericsson", ICANN: true},
+	{DottedName: "erimo.hokkaido.jp", ICANN: true},
+	{DottedName: "erni", ICANN: true},
+	{DottedName: "erotica.hu", ICANN: true},
+	{DottedName: "erotika.hu", ICANN: true},
+	{DottedName: "es", ICANN: true},
+	{DottedName: "es.gov.br", ICANN: true},
+	{DottedName: "es.kr", ICANN: true},
+	{DottedName: "esan.hokkaido.jp", ICANN: true},
+	{DottedName: "esashi.hokkaido.jp", ICANN: true},
+	{DottedName: "esp.br", ICANN: true},
+	{DottedName: "esq", ICANN: true},
+	{DottedName: "essex.museum", ICANN: true},
+	{DottedName: "est.pr", ICANN: true},
+	{DottedName: "estate", ICANN: true},
+	{DottedName: "estate.museum", ICANN: true},
+	{DottedName: "et", ICANN: true},
+	{DottedName: "etajima.hiroshima.jp", ICANN: true},
+	{DottedName: "etc.br", ICANN: true},
+	{DottedName: "ethnology.museum", ICANN: true},
+	{DottedName: "eti.br", ICANN: true},
+	{DottedName: "etisalat", ICANN: true},
+	{DottedName: "etne.no", ICANN: true},
+	{DottedName: "etnedal.no", ICANN: true},
+	{DottedName: "eu", ICANN: true},
+	{DottedName: "eu.int", ICANN: true},
+	{DottedName: "eun.eg", ICANN: true},
+	{DottedName: "eurovision", ICANN: true},
+	{DottedName: "eus", ICANN: true},
+	{DottedName: "evenassi.no", ICANN: true},
+	{DottedName: "evenes.no", ICANN: true},
+	{DottedName: "events", ICANN: true},
+	{DottedName: "evje-og-hornnes.no", ICANN: true},
+	{DottedName: "exchange", ICANN: true},
+	{DottedName: "exchange.aero", ICANN: true},
+	{DottedName: "exeter.museum", ICANN: true},
+	{DottedName: "exhibition.museum", ICANN: true},
+	{DottedName: "expert", ICANN: true},
+	{DottedName: "experts-comptables.fr", ICANN: true},
+	{DottedName: "exposed", ICANN: true},
+	{DottedName: "express", ICANN: true},
+	{DottedName: "express.aero", ICANN: true},
+	{DottedName: "extraspace", ICANN: true},
+	{DottedName: "f.bg", ICANN: true},
+	{DottedName: "f.se", ICANN: true},
+	{DottedName: "fage", ICANN: true},
+	{DottedName: "fail", ICANN: true},
+	{DottedName: "fairwinds", ICANN: true},
+	{DottedName: "faith", ICANN: true},
+	{DottedName: "fam.pk", ICANN: true},
+	{DottedName: "family", ICANN: true},
+	{DottedName: "family.museum", ICANN: true},
+	{DottedName: "fan", ICANN: true},
+	{DottedName: "fans", ICANN: true},
+	{DottedName: "far.br", ICANN: true},
+	{DottedName: "farm", ICANN: true},
+	{DottedName: "farm.museum", ICANN: true},
+	{DottedName: "farmequipment.museum", ICANN: true},
+	{DottedName: "farmers", ICANN: true},
+	{DottedName: "farmers.museum", ICANN: true},
+	{DottedName: "farmstead.museum", ICANN: true},
+	{DottedName: "farsund.no", ICANN: true},
+	{DottedName: "fashion", ICANN: true},
+	{DottedName: "fast", ICANN: true},
+	{DottedName: "fauske.no", ICANN: true},
+	{DottedName: "fc.it", ICANN: true},
+	{DottedName: "fe.it", ICANN: true},
+	{DottedName: "fed.us", ICANN: true},
+	{DottedName: "federation.aero", ICANN: true},
+	{DottedName: "fedex", ICANN: true},
+	{DottedName: "fedje.no", ICANN: true},
+	{DottedName: "feedback", ICANN: true},
+	{DottedName: "feira.br", ICANN: true},
+	{DottedName: "fermo.it", ICANN: true},
+	{DottedName: "ferrara.it", ICANN: true},
+	{DottedName: "ferrari", ICANN: true},
+	{DottedName: "ferrero", ICANN: true},
+	{DottedName: "fet.no", ICANN: true},
+	{DottedName: "fetsund.no", ICANN: true},
+	{DottedName: "fg.it", ICANN: true},
+	{DottedName: "fh.se", ICANN: true},
+	{DottedName: "fhs.no", ICANN: true},
+	{DottedName: "fhsk.se", ICANN: true},
+	{DottedName: "fhv.se", ICANN: true},
+	{DottedName: "fi", ICANN: true},
+	{DottedName: "fi.cr", ICANN: true},
+	{DottedName: "fi.it", ICANN: true},
+	{DottedName: "fiat", ICANN: true},
+	{DottedName: "fidelity", ICANN: true},
+	{DottedName: "fido", ICANN: true},
+	{DottedName: "fie.ee", ICANN: true},
+	{DottedName: "field.museum", ICANN: true},
+	{DottedName: "figueres.museum", ICANN: true},
+	{DottedName: "filatelia.museum", ICANN: true},
+	{DottedName: "film", ICANN: true},
+	{DottedName: "film.hu", ICANN: true},
+	{DottedName: "film.museum", ICANN: true},
+	{DottedName: "fin.ec", ICANN: true},
+	{DottedName: "fin.tn", ICANN: true},
+	{DottedName: "final", ICANN: true},
+	{DottedName: "finance", ICANN: true},
+	{DottedName: "financial", ICANN: true},
+	{DottedName: "fineart.museum", ICANN: true},
+	{DottedName: "finearts.museum", ICANN: true},
+	{DottedName: "finland.museum", ICANN: true},
+	{DottedName: "finnoy.no", ICANN: true},
+	{DottedName: "fire", ICANN: true},
+	{DottedName: "firenze.it", ICANN: true},
+	{DottedName: "firestone", ICANN: true},
+	{DottedName: "firm.co", ICANN: true},
+	{DottedName: "firm.ht", ICANN: true},
+	{DottedName: "firm.in", ICANN: true},
+	{DottedName: "firm.nf", ICANN: true},
+	{DottedName: "firm.ro", ICANN: true},
+	{DottedName: "firm.ve", ICANN: true},
+	{DottedName: "firmdale", ICANN: true},
+	{DottedName: "fish", ICANN: true},
+	{DottedName: "fishing", ICANN: true},
+	{DottedName: "fit", ICANN: true},
+	{DottedName: "fitjar.no", ICANN: true},
+	{DottedName: "fitness", ICANN: true},
+	{DottedName: "fj", ICANN: true},
+	{DottedName: "fj.cn", ICANN: true},
+	{DottedName: "fjaler.no", ICANN: true},
+	{DottedName: "fjell.no", ICANN: true},
+	{DottedName: "fl.us", ICANN: true},
+	{DottedName: "fla.no", ICANN: true},
+	{DottedName: "flakstad.no", ICANN: true},
+	{DottedName: "flanders.museum", ICANN: true},
+	{DottedName: "flatanger.no", ICANN: true},
+	{DottedName: "flekkefjord.no", ICANN: true},
+	{DottedName: "flesberg.no", ICANN: true},
+	{DottedName: "flickr", ICANN: true},
+	{DottedName: "flight.aero", ICANN: true},
+	{DottedName: "flights", ICANN: true},
+	{DottedName: "flir", ICANN: true},
+	{DottedName: "flog.br", ICANN: true},
+	{DottedName: "flora.no", ICANN: true},
+	{DottedName: "florence.it", ICANN: true},
+	{DottedName: "florida.museum", ICANN: true},
+	{DottedName: "floripa.br", ICANN: true},
+	{DottedName: "florist", ICANN: true},
+	{DottedName: "floro.no", ICANN: true},
+	{DottedName: "flowers", ICANN: true},
+	{DottedName: "fly", ICANN: true},
+	{DottedName: "fm", ICANN: true},
+	{DottedName: "fm.br", ICANN: true},
+	{DottedName: "fm.it", ICANN: true},
+	{DottedName: "fm.no", ICANN: true},
+	{DottedName: "fnd.br", ICANN: true},
+	{DottedName: "fo", ICANN: true},
+	{DottedName: "foggia.it", ICANN: true},
+	{DottedName: "folkebibl.no", ICANN: true},
+	{DottedName: "folldal.no", ICANN: true},
+	{DottedName: "foo", ICANN: true},
+	{DottedName: "food", ICANN: true},
+	{DottedName: "foodnetwork", ICANN: true},
+	{DottedName: "football", ICANN: true},
+	{DottedName: "force.museum", ICANN: true},
+	{DottedName: "ford", ICANN: true},
+	{DottedName: "forde.no", ICANN: true},
+	{DottedName: "forex", ICANN: true},
+	{DottedName: "forli-cesena.it", ICANN: true},
+	{DottedName: "forlicesena.it", ICANN: true},
+	{DottedName: "forsale", ICANN: true},
+	{DottedName: "forsand.no", ICANN: true},
+	{DottedName: "fortal.br", ICANN: true},
+	{DottedName: "fortmissoula.museum", ICANN: true},
+	{DottedName: "fortworth.museum", ICANN: true},
+	{DottedName: "forum", ICANN: true},
+	{DottedName: "forum.hu", ICANN: true},
+	{DottedName: "fosnes.no", ICANN: true},
+	{DottedName: "fot.br", ICANN: true},
+	{DottedName: "foundation", ICANN: true},
+	{DottedName: "foundation.museum", ICANN: true},
+	{DottedName: "fox", ICANN: true},
+	{DottedName: "foz.br", ICANN: true},
+	{DottedName: "fr", ICANN: true},
+	{DottedName: "fr.it", ICANN: true},
+	{DottedName: "frana.no", ICANN: true},
+	{DottedName: "francaise.museum", ICANN: true},
+	{DottedName: "frankfurt.museum", ICANN: true},
+	{DottedName: "franziskaner.museum", ICANN: true},
+	{DottedName: "fredrikstad.no", ICANN: true},
+	{DottedName: "free", ICANN: true},
+	{DottedName: "freemasonry.museum", ICANN: true},
+	{DottedName: "frei.no", ICANN: true},
+	{DottedName: "freiburg.museum", ICANN: true},
+	{DottedName: "fresenius", ICANN: true},
+	{DottedName: "fribourg.museum", ICANN: true},
+	{DottedName: "friuli-v-giulia.it", ICANN: true},
+	{DottedName: "friuli-ve-giulia.it", ICANN: true},
+	{DottedName: "friuli-vegiulia.it", ICANN: true},
+	{DottedName: "friuli-venezia-giulia.it", ICANN: true},
+	{DottedName: "friuli-veneziagiulia.it", ICANN: true},
+	{DottedName: "friuli-vgiulia.it", ICANN: true},
+	{DottedName: "friuliv-giulia.it", ICANN: true},
+	{DottedName: "friulive-giulia.it", ICANN: true},
+	{DottedName: "friulivegiulia.it", ICANN: true},
+	{DottedName: "friulivenezia-giulia.it", ICANN: true},
+	{DottedName: "friuliveneziagiulia.it", ICANN: true},
+	{DottedName: "friulivgiulia.it", ICANN: true},
+	{DottedName: "frl", ICANN: true},
+	{DottedName: "frog.museum", ICANN: true},
+	{DottedName: "frogans", ICANN: true},
+	{DottedName: "frogn.no", ICANN: true},
+	{DottedName: "froland.no", ICANN: true},
+	{DottedName: "from.hr", ICANN: true},
+	{DottedName: "frontdoor", ICANN: true},
+	{DottedName: "frontier", ICANN: true},
+	{DottedName: "frosinone.it", ICANN: true},
+	{DottedName: "frosta.no", ICANN: true},
+	{DottedName: "froya.no", ICANN: true},
+	{DottedName: "fst.br", ICANN: true},
+	{DottedName: "ftr", ICANN: true},
+	{DottedName: "fuchu.hiroshima.jp", ICANN: true},
+	{DottedName: "fuchu.tokyo.jp", ICANN: true},
+	{DottedName: "fuchu.toyama.jp", ICANN: true},
+	{DottedName: "fudai.iwate.jp", ICANN: true},
+	{DottedName: "fuefuki.yamanashi.jp", ICANN: true},
+	{DottedName: "fuel.aero", ICANN: true},
+	{DottedName: "fuji.shizuoka.jp", ICANN: true},
+	{DottedName: "fujieda.shizuoka.jp", ICANN: true},
+	{DottedName: "fujiidera.osaka.jp", ICANN: true},
+	{DottedName: "fujikawa.shizuoka.jp", ICANN: true},
+	{DottedName: "fujikawa.yamanashi.jp", ICANN: true},
+	{DottedName: "fujikawaguchiko.yamanashi.jp", ICANN: true},
+	{DottedName: "fujimi.nagano.jp", ICANN: true},
+	{DottedName: "fujimi.saitama.jp", ICANN: true},
+	{DottedName: "fujimino.saitama.jp", ICANN: true},
+	{DottedName: "fujinomiya.shizuoka.jp", ICANN: true},
+	{DottedName: "fujioka.gunma.jp", ICANN: true},
+	{DottedName: "fujisato.akita.jp", ICANN: true},
+	{DottedName: "fujisawa.iwate.jp", ICANN: true},
+	{DottedName: "fujisawa.kanagawa.jp", ICANN: true},
+	{DottedName: "fujishiro.ibaraki.jp", ICANN: true},
+	{DottedName: "fujitsu", ICANN: true},
+	{DottedName: "fujiyoshida.yamanashi.jp", ICANN: true},
+	{DottedName: "fukagawa.hokkaido.jp", ICANN: true},
+	{DottedName: "fukaya.saitama.jp", ICANN: true},
+	{DottedName: "fukuchi.fukuoka.jp", ICANN: true},
+	{DottedName: "fukuchiyama.kyoto.jp", ICANN: true},
+	{DottedName: "fukudomi.saga.jp", ICANN: true},
+	{DottedName: "fukui.fukui.jp", ICANN: true},
+	{DottedName: "fukui.jp", ICANN: true},
+	{DottedName: "fukumitsu.toyama.jp", ICANN: true},
+	{DottedName: "fukuoka.jp", ICANN: true},
+	{DottedName: "fukuroi.shizuoka.jp", ICANN: true},
+	{DottedName: "fukusaki.hyogo.jp", ICANN: true},
+	{DottedName: "fukushima.fukushima.jp", ICANN: true},
+	{DottedName: "fukushima.hokkaido.jp", ICANN: true},
+	{DottedName: "fukushima.jp", ICANN: true},
+	{DottedName: "fukuyama.hiroshima.jp", ICANN: true},
+	{DottedName: "fun", ICANN: true},
+	{DottedName: "funabashi.chiba.jp", ICANN: true},
+	{DottedName: "funagata.yamagata.jp", ICANN: true},
+	{DottedName: "funahashi.toyama.jp", ICANN: true},
+	{DottedName: "fund", ICANN: true},
+	{DottedName: "fundacio.museum", ICANN: true},
+	{DottedName: "fuoisku.no", ICANN: true},
+	{DottedName: "fuossko.no", ICANN: true},
+	{DottedName: "furano.hokkaido.jp", ICANN: true},
+	{DottedName: "furniture", ICANN: true},
+	{DottedName: "furniture.museum", ICANN: true},
+	{DottedName: "furubira.hokkaido.jp", ICANN: true},
+	{DottedName: "furudono.fukushima.jp", ICANN: true},
+	{DottedName: "furukawa.miyagi.jp", ICANN: true},
+	{DottedName: "fusa.no", ICANN: true},
+	{DottedName: "fuso.aichi.jp", ICANN: true},
+	{DottedName: "fussa.tokyo.jp", ICANN: true},
+	{DottedName: "futaba.fukushima.jp", ICANN: true},
+	{DottedName: "futbol", ICANN: true},
+	{DottedName: "futsu.nagasaki.jp", ICANN: true},
+	{DottedName: "futtsu.chiba.jp", ICANN: true},
+	{DottedName: "fvg.it", ICANN: true},
+	{DottedName: "fyi", ICANN: true},
+	{DottedName: "fylkesbibl.no", ICANN: true},
+	{DottedName: "fyresdal.no", ICANN: true},
+	{DottedName: "g.bg", ICANN: true},
+	{DottedName: "g.se", ICANN: true},
+	{DottedName: "g12.br", ICANN: true},
+	{DottedName: "ga", ICANN: true},
+	{DottedName: "ga.us", ICANN: true},
+	{DottedName: "gaivuotna.no", ICANN: true},
+	{DottedName: "gal", ICANN: true},
+	{DottedName: "gallery", ICANN: true},
+	{DottedName: "gallery.museum", ICANN: true},
+	{DottedName: "gallo", ICANN: true},
+	{DottedName: "gallup", ICANN: true},
+	{DottedName: "galsa.no", ICANN: true},
+	{DottedName: "gamagori.aichi.jp", ICANN: true},
+	{DottedName: "game", ICANN: true},
+	{DottedName: "game.tw", ICANN: true},
+	{DottedName: "games", ICANN: true},
+	{DottedName: "games.hu", ICANN: true},
+	{DottedName: "gamo.shiga.jp", ICANN: true},
+	{DottedName: "gamvik.no", ICANN: true},
+	{DottedName: "gangaviika.no", ICANN: true},
+	{DottedName: "gangwon.kr", ICANN: true},
+	{DottedName: "gap", ICANN: true},
+	{DottedName: "garden", ICANN: true},
+	{DottedName: "garden.museum", ICANN: true},
+	{DottedName: "gateway.museum", ICANN: true},
+	{DottedName: "gaular.no", ICANN: true},
+	{DottedName: "gausdal.no", ICANN: true},
+	{DottedName: "gay", ICANN: true},
+	{DottedName: "gb", ICANN: true},
+	{DottedName: "gbiz", ICANN: true},
+	{DottedName: "gc.ca", ICANN: true},
+	{DottedName: "gd", ICANN: true},
+	{DottedName: "gd.cn", ICANN: true},
+	{DottedName: "gdn", ICANN: true},
+	{DottedName: "ge", ICANN: true},
+	{DottedName: "ge.it", ICANN: true},
+	{DottedName: "gea", ICANN: true},
+	{DottedName: "geek.nz", ICANN: true},
+	{DottedName: "geelvinck.museum", ICANN: true},
+	{DottedName: "geisei.kochi.jp", ICANN: true},
+	{DottedName: "gemological.museum", ICANN: true},
+	{DottedName: "gen.in", ICANN: true},
+	{DottedName: "gen.mi.us", ICANN: true},
+	{DottedName: "gen.nz", ICANN: true},
+	{DottedName: "gen.tr", ICANN: true},
+	{DottedName: "genkai.saga.jp", ICANN: true},
+	{DottedName: "genoa.it", ICANN: true},
+	{DottedName: "genova.it", ICANN: true},
+	{DottedName: "gent", ICANN: true},
+	{DottedName: "genting", ICANN: true},
+	{DottedName: "geo.br", ICANN: true},
+	{DottedName: "geology.museum", ICANN: true},
+	{DottedName: "geometre-expert.fr", ICANN: true},
+	{DottedName: "george", ICANN: true},
+	{DottedName: "georgia.museum", ICANN: true},
+	{DottedName: "gf", ICANN: true},
+	{DottedName: "gg", ICANN: true},
+	{DottedName: "ggee", ICANN: true},
+	{DottedName: "ggf.br", ICANN: true},
+	{DottedName: "gh", ICANN: true},
+	{DottedName: "gi", ICANN: true},
+	{DottedName: "giehtavuoatna.no", ICANN: true},
+	{DottedName: "giessen.museum", ICANN: true},
+	{DottedName: "gift", ICANN: true},
+	{DottedName: "gifts", ICANN: true},
+	{DottedName: "gifu.gifu.jp", ICANN: true},
+	{DottedName: "gifu.jp", ICANN: true},
+	{DottedName: "gildeskal.no", ICANN: true},
+	{DottedName: "ginan.gifu.jp", ICANN: true},
+	{DottedName: "ginowan.okinawa.jp", ICANN: true},
+	{DottedName: "ginoza.okinawa.jp", ICANN: true},
+	{DottedName: "giske.no", ICANN: true},
+	{DottedName: "gives", ICANN: true},
+	{DottedName: "giving", ICANN: true},
+	{DottedName: "gjemnes.no", ICANN: true},
+	{DottedName: "gjerdrum.no", ICANN: true},
+	{DottedName: "gjerstad.no", ICANN: true},
+	{DottedName: "gjesdal.no", ICANN: true},
+	{DottedName: "gjovik.no", ICANN: true},
+	{DottedName: "gl", ICANN: true},
+	{DottedName: "glade", ICANN: true},
+	{DottedName: "glas.museum", ICANN: true},
+	{DottedName: "glass", ICANN: true},
+	{DottedName: "glass.museum", ICANN: true},
+	{DottedName: "gle", ICANN: true},
+	{DottedName: "gliding.aero", ICANN: true},
+	{DottedName: "global", ICANN: true},
+	{DottedName: "globo", ICANN: true},
+	{DottedName: "glogow.pl", ICANN: true},
+	{DottedName: "gloppen.no", ICANN: true},
+	{DottedName: "gm", ICANN: true},
+	{DottedName: "gmail", ICANN: true},
+	{DottedName: "gmbh", ICANN: true},
+	{DottedName: "gmina.pl", ICANN: true},
+	{DottedName: "gmo", ICANN: true},
+	{DottedName: "gmx", ICANN: true},
+	{DottedName: "gn", ICANN: true},
+	{DottedName: "gniezno.pl", ICANN: true},
+	{DottedName: "go.ci", ICANN: true},
+	{DottedName: "go.cr", ICANN: true},
+	{DottedName: "go.gov.br", ICANN: true},
+	{DottedName: "go.id", ICANN: true},
+	{DottedName: "go.it", ICANN: true},
+	{DottedName: "go.jp", ICANN: true},
+	{DottedName: "go.ke", ICANN: true},
+	{DottedName: "go.kr", ICANN: true},
+	{DottedName: "go.pw", ICANN: true},
+	{DottedName: "go.th", ICANN: true},
+	{DottedName: "go.tj", ICANN: true},
+	{DottedName: "go.tz", ICANN: true},
+	{DottedName: "go.ug", ICANN: true},
+	{DottedName: "gob.ar", ICANN: true},
+	{DottedName: "gob.bo", ICANN: true},
+	{DottedName: "gob.cl", ICANN: true},
+	{DottedName: "gob.do", ICANN: true},
+	{DottedName: "gob.ec", ICANN: true},
+	{DottedName: "gob.es", ICANN: true},
+	{DottedName: "gob.gt", ICANN: true},
+	{DottedName: "gob.hn", ICANN: true},
+	{DottedName: "gob.mx", ICANN: true},
+	{DottedName: "gob.ni", ICANN: true},
+	{DottedName: "gob.pa", ICANN: true},
+	{DottedName: "gob.pe", ICANN: true},
+	{DottedName: "gob.pk", ICANN: true},
+	{DottedName: "gob.sv", ICANN: true},
+	{DottedName: "gob.ve", ICANN: true},
+	{DottedName: "gobo.wakayama.jp", ICANN: true},
+	{DottedName: "godaddy", ICANN: true},
+	{DottedName: "godo.gifu.jp", ICANN: true},
+	{DottedName: "goiania.br", ICANN: true},
+	{DottedName: "gojome.akita.jp", ICANN: true},
+	{DottedName: "gok.pk", ICANN: true},
+	{DottedName: "gokase.miyazaki.jp", ICANN: true},
+	{DottedName: "gol.no", ICANN: true},
+	{DottedName: "gold", ICANN: true},
+	{DottedName: "goldpoint", ICANN: true},
+	{DottedName: "golf", ICANN: true},
+	{DottedName: "gon.pk", ICANN: true},
+	{DottedName: "gonohe.aomori.jp", ICANN: true},
+	{DottedName: "goo", ICANN: true},
+	{DottedName: "goodyear", ICANN: true},
+	{DottedName: "goog", ICANN: true},
+	{DottedName: "google", ICANN: true},
+	{DottedName: "gop", ICANN: true},
+	{DottedName: "gop.pk", ICANN: true},
+	{DottedName: "gorge.museum", ICANN: true},
+	{DottedName: "gorizia.it", ICANN: true},
+	{DottedName: "gorlice.pl", ICANN: true},
+	{DottedName: "gos.pk", ICANN: true},
+	{DottedName: "gose.nara.jp", ICANN: true},
+	{DottedName: "gosen.niigata.jp", ICANN: true},
+	{DottedName: "goshiki.hyogo.jp", ICANN: true},
+	{DottedName: "got", ICANN: true},
+	{DottedName: "gotemba.shizuoka.jp", ICANN: true},
+	{DottedName: "goto.nagasaki.jp", ICANN: true},
+	{DottedName: "gotsu.shimane.jp", ICANN: true},
+	{DottedName: "gouv.bj", ICANN: true},
+	{DottedName: "gouv.ci", ICANN: true},
+	{DottedName: "gouv.fr", ICANN: true},
+	{DottedName: "gouv.ht", ICANN: true},
+	{DottedName: "gouv.km", ICANN: true},
+	{DottedName: "gouv.ml", ICANN: true},
+	{DottedName: "gouv.sn", ICANN: true},
+	{DottedName: "gov", ICANN: true},
+	{DottedName: "gov.ac", ICANN: true},
+	{DottedName: "gov.ae", ICANN: true},
+	{DottedName: "gov.af", ICANN: true},
+	{DottedName: "gov.al", ICANN: true},
+	{DottedName: "gov.ar", ICANN: true},
+	{DottedName: "gov.as", ICANN: true},
+	{DottedName: "gov.au", ICANN: true},
+	{DottedName: "gov.az", ICANN: true},
+	{DottedName: "gov.ba", ICANN: true},
+	{DottedName: "gov.bb", ICANN: true},
+	{DottedName: "gov.bf", ICANN: true},
+	{DottedName: "gov.bh", ICANN: true},
+	{DottedName: "gov.bm", ICANN: true},
+	{DottedName: "gov.bn", ICANN: true},
+	{DottedName: "gov.br", ICANN: true},
+	{DottedName: "gov.bs", ICANN: true},
+	{DottedName: "gov.bt", ICANN: true},
+	{DottedName: "gov.by", ICANN: true},
+	{DottedName: "gov.bz", ICANN: true},
+	{DottedName: "gov.cd", ICANN: true},
+	{DottedName: "gov.cl", ICANN: true},
+	{DottedName: "gov.cm", ICANN: true},
+	{DottedName: "gov.cn", ICANN: true},
+	{DottedName: "gov.co", ICANN: true},
+	{DottedName: "gov.cu", ICANN: true},
+	{DottedName: "gov.cx", ICANN: true},
+	{DottedName: "gov.cy", ICANN: true},
+	{DottedName: "gov.dm", ICANN: true},
+	{DottedName: "gov.do", ICANN: true},
+	{DottedName: "gov.dz", ICANN: true},
+	{DottedName: "gov.ec", ICANN: true},
+	{DottedName: "gov.ee", ICANN: true},
+	{DottedName: "gov.eg", ICANN: true},
+	{DottedName: "gov.et", ICANN: true},
+	{DottedName: "gov.fj", ICANN: true},
+	{DottedName: "gov.gd", ICANN: true},
+	{DottedName: "gov.ge", ICANN: true},
+	{DottedName: "gov.gh", ICANN: true},
+	{DottedName: "gov.gi", ICANN: true},
+	{DottedName: "gov.gn", ICANN: true},
+	{DottedName: "gov.gr", ICANN: true},
+	{DottedName: "gov.gu", ICANN: true},
+	{DottedName: "gov.gy", ICANN: true},
+	{DottedName: "gov.hk", ICANN: true},
+	{DottedName: "gov.ie", ICANN: true},
+	{DottedName: "gov.il", ICANN: true},
+	{DottedName: "gov.in", ICANN: true},
+	{DottedName: "gov.iq", ICANN: true},
+	{DottedName: "gov.ir", ICANN: true},
+	{DottedName: "gov.is", ICANN: true},
+	{DottedName: "gov.it", ICANN: true},
+	{DottedName: "gov.jo", ICANN: true},
+	{DottedName: "gov.kg", ICANN: true},
+	{DottedName: "gov.ki", ICANN: true},
+	{DottedName: "gov.km", ICANN: true},
+	{DottedName: "gov.kn", ICANN: true},
+	{DottedName: "gov.kp", ICANN: true},
+	{DottedName: "gov.kw", ICANN: true},
+	{DottedName: "gov.ky", ICANN: true},
+	{DottedName: "gov.kz", ICANN: true},
+	{DottedName: "gov.la", ICANN: true},
+	{DottedName: "gov.lb", ICANN: true},
+	{DottedName: "gov.lc", ICANN: true},
+	{DottedName: "gov.lk", ICANN: true},
+	{DottedName: "gov.lr", ICANN: true},
+	{DottedName: "gov.ls", ICANN: true},
+	{DottedName: "gov.lt", ICANN: true},
+	{DottedName: "gov.lv", ICANN: true},
+	{DottedName: "gov.ly", ICANN: true},
+	{DottedName: "gov.ma", ICANN: true},
+	{DottedName: "gov.me", ICANN: true},
+	{DottedName: "gov.mg", ICANN: true},
+	{DottedName: "gov.mk", ICANN: true},
+	{DottedName: "gov.ml", ICANN: true},
+	{DottedName: "gov.mn", ICANN: true},
+	{DottedName: "gov.mo", ICANN: true},
+	{DottedName: "gov.mr", ICANN: true},
+	{DottedName: "gov.ms", ICANN: true},
+	{DottedName: "gov.mu", ICANN: true},
+	{DottedName: "gov.mv", ICANN: true},
+	{DottedName: "gov.mw", ICANN: true},
+	{DottedName: "gov.my", ICANN: true},
+	{DottedName: "gov.mz", ICANN: true},
+	{DottedName: "gov.nc.tr", ICANN: true},
+	{DottedName: "gov.ng", ICANN: true},
+	{DottedName: "gov.nr", ICANN: true},
+	{DottedName: "gov.om", ICANN: true},
+	{DottedName: "gov.ph", ICANN: true},
+	{DottedName: "gov.pk", ICANN: true},
+	{DottedName: "gov.pl", ICANN: true},
+	{DottedName: "gov.pn", ICANN: true},
+	{DottedName: "gov.pr", ICANN: true},
+	{DottedName: "gov.ps", ICANN: true},
+	{DottedName: "gov.pt", ICANN: true},
+	{DottedName: "gov.py", ICANN: true},
+	{DottedName: "gov.qa", ICANN: true},
+	{DottedName: "gov.rs", ICANN: true},
+	{DottedName: "gov.rw", ICANN: true},
+	{DottedName: "gov.sa", ICANN: true},
+	{DottedName: "gov.sb", ICANN: true},
+	{DottedName: "gov.sc", ICANN: true},
+	{DottedName: "gov.sd", ICANN: true},
+	{DottedName: "gov.sg", ICANN: true},
+	{DottedName: "gov.sh", ICANN: true},
+	{DottedName: "gov.sl", ICANN: true},
+	{DottedName: "gov.so", ICANN: true},
+	{DottedName: "gov.ss", ICANN: true},
+	{DottedName: "gov.sx", ICANN: true},
+	{DottedName: "gov.sy", ICANN: true},
+	{DottedName: "gov.tj", ICANN: true},
+	{DottedName: "gov.tl", ICANN: true},
+	{DottedName: "gov.tm", ICANN: true},
+	{DottedName: "gov.tn", ICANN: true},
+	{DottedName: "gov.to", ICANN: true},
+	{DottedName: "gov.tr", ICANN: true},
+	{DottedName: "gov.tt", ICANN: true},
+	{DottedName: "gov.tw", ICANN: true},
+	{DottedName: "gov.ua", ICANN: true},
+	{DottedName: "gov.uk", ICANN: true},
+	{DottedName: "gov.vc", ICANN: true},
+	{DottedName: "gov.ve", ICANN: true},
+	{DottedName: "gov.vn", ICANN: true},
+	{DottedName: "gov.ws", ICANN: true},
+	{DottedName: "gov.ye", ICANN: true},
+	{DottedName: "gov.za", ICANN: true},
+	{DottedName: "gov.zm", ICANN: true},
+	{DottedName: "gov.zw", ICANN: true},
+	{DottedName: "government.aero", ICANN: true},
+	{DottedName: "govt.nz", ICANN: true},
+	{DottedName: "gp", ICANN: true},
+	{DottedName: "gq", ICANN: true},
+	{DottedName: "gr", ICANN: true},
+	{DottedName: "gr.it", ICANN: true},
+	{DottedName: "gr.jp", ICANN: true},
+	{DottedName: "grainger", ICANN: true},
+	{DottedName: "grajewo.pl", ICANN: true},
+	{DottedName: "gran.no", ICANN: true},
+	{DottedName: "grandrapids.museum", ICANN: true},
+	{DottedName: "grane.no", ICANN: true},
+	{DottedName: "granvin.no", ICANN: true},
+	{DottedName: "graphics", ICANN: true},
+	{DottedName: "gratangen.no", ICANN: true},
+	{DottedName: "gratis", ICANN: true},
+	{DottedName: "graz.museum", ICANN: true},
+	{DottedName: "green", ICANN: true},
+	{DottedName: "greta.fr", ICANN: true},
+	{DottedName: "grimstad.no", ICANN: true},
+	{DottedName: "gripe", ICANN: true},
+	{DottedName: "griw.gov.pl", ICANN: true},
+	{DottedName: "grocery", ICANN: true},
+	{DottedName: "grondar.za", ICANN: true},
+	{DottedName: "grong.no", ICANN: true},
+	{DottedName: "grosseto.it", ICANN: true},
+	{DottedName: "groundhandling.aero", ICANN: true},
+	{DottedName: "group", ICANN: true},
+	{DottedName: "group.aero", ICANN: true},
+	{DottedName: "grp.lk", ICANN: true},
+	{DottedName: "gru.br", ICANN: true},
+	{DottedName: "grue.no", ICANN: true},
+	{DottedName: "gs", ICANN: true},
+	{DottedName: "gs.aa.no", ICANN: true},
+	{DottedName: "gs.ah.no", ICANN: true},
+	{DottedName: "gs.bu.no", ICANN: true},
+	{DottedName: "gs.cn", ICANN: true},
+	{DottedName: "gs.fm.no", ICANN: true},
+	{DottedName: "gs.hl.no", ICANN: true},
+	{DottedName: "gs.hm.no", ICANN: true},
+	{DottedName: "gs.jan-mayen.no", ICANN: true},
+	{DottedName: "gs.mr.no", ICANN: true},
+	{DottedName: "gs.nl.no", ICANN: true},
+	{DottedName: "gs.nt.no", ICANN: true},
+	{DottedName: "gs.of.no", ICANN: true},
+	{DottedName: "gs.ol.no", ICANN: true},
+	{DottedName: "gs.oslo.no", ICANN: true},
+	{DottedName: "gs.rl.no", ICANN: true},
+	{DottedName: "gs.sf.no", ICANN: true},
+	{DottedName: "gs.st.no", ICANN: true},
+	{DottedName: "gs.svalbard.no", ICANN: true},
+	{DottedName: "gs.tm.no", ICANN: true},
+	{DottedName: "gs.tr.no", ICANN: true},
+	{DottedName: "gs.va.no", ICANN: true},
+	{DottedName: "gs.vf.no", ICANN: true},
+	{DottedName: "gsm.pl", ICANN: true},
+	{DottedName: "gt", ICANN: true},
+	{DottedName: "gu", ICANN: true},
+	{DottedName: "gu.us", ICANN: true},
+	{DottedName: "guam.gu", ICANN: true},
+	{DottedName: "guardian", ICANN: true},
+	{DottedName: "gub.uy", ICANN: true},
+	{DottedName: "gucci", ICANN: true},
+	{DottedName: "guernsey.museum", ICANN: true},
+	{DottedName: "guge", ICANN: true},
+	{DottedName: "guide", ICANN: true},
+	{DottedName: "guitars", ICANN: true},
+	{DottedName: "gujo.gifu.jp", ICANN: true},
+	{DottedName: "gulen.no", ICANN: true},
+	{DottedName: "gunma.jp", ICANN: true},
+	{DottedName: "guovdageaidnu.no", ICANN: true},
+	{DottedName: "guru", ICANN: true},
+	{DottedName: "gushikami.okinawa.jp", ICANN: true},
+	{DottedName: "gv.ao", ICANN: true},
+	{DottedName: "gv.at", ICANN: true},
+	{DottedName: "gw", ICANN: true},
+	{DottedName: "gwangju.kr", ICANN: true},
+	{DottedName: "gx.cn", ICANN: true},
+	{DottedName: "gy", ICANN: true},
+	{DottedName: "gyeongbuk.kr", ICANN: true},
+	{DottedName: "gyeonggi.kr", ICANN: true},
+	{DottedName: "gyeongnam.kr", ICANN: true},
+	{DottedName: "gyokuto.kumamoto.jp", ICANN: true},
+	{DottedName: "gz.cn", ICANN: true},
+	{DottedName: "h.bg", ICANN: true},
+	{DottedName: "h.se", ICANN: true},
+	{DottedName: "ha.cn", ICANN: true},
+	{DottedName: "ha.no", ICANN: true},
+	{DottedName: "habikino.osaka.jp", ICANN: true},
+	{DottedName: "habmer.no", ICANN: true},
+	{DottedName: "haboro.hokkaido.jp", ICANN: true},
+	{DottedName: "hachijo.tokyo.jp", ICANN: true},
+	{DottedName: "hachinohe.aomori.jp", ICANN: true},
+	{DottedName: "hachioji.tokyo.jp", ICANN: true},
+	{DottedName: "hachirogata.akita.jp", ICANN: true},
+	{DottedName: "hadano.kanagawa.jp", ICANN: true},
+	{DottedName: "hadsel.no", ICANN: true},
+	{DottedName: "haebaru.okinawa.jp", ICANN: true},
+	{DottedName: "haga.tochigi.jp", ICANN: true},
+	{DottedName: "hagebostad.no", ICANN: true},
+	{DottedName: "hagi.yamaguchi.jp", ICANN: true},
+	{DottedName: "haibara.shizuoka.jp", ICANN: true},
+	{DottedName: "hair", ICANN: true},
+	{DottedName: "hakata.fukuoka.jp", ICANN: true},
+	{DottedName: "hakodate.hokkaido.jp", ICANN: true},
+	{DottedName: "hakone.kanagawa.jp", ICANN: true},
+	{DottedName: "hakuba.nagano.jp", ICANN: true},
+	{DottedName: "hakui.ishikawa.jp", ICANN: true},
+	{DottedName: "hakusan.ishikawa.jp", ICANN: true},
+	{DottedName: "halden.no", ICANN: true},
+	{DottedName: "halloffame.museum", ICANN: true},
+	{DottedName: "halsa.no", ICANN: true},
+	{DottedName: "hamada.shimane.jp", ICANN: true},
+	{DottedName: "hamamatsu.shizuoka.jp", ICANN: true},
+	{DottedName: "hamar.no", ICANN: true},
+	{DottedName: "hamaroy.no", ICANN: true},
+	{DottedName: "hamatama.saga.jp", ICANN: true},
+	{DottedName: "hamatonbetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "hamburg", ICANN: true},
+	{DottedName: "hamburg.museum", ICANN: true},
+	{DottedName: "hammarfeasta.no", ICANN: true},
+	{DottedName: "hammerfest.no", ICANN: true},
+	{DottedName: "hamura.tokyo.jp", ICANN: true},
+	{DottedName: "hanamaki.iwate.jp", ICANN: true},
+	{DottedName: "hanamigawa.chiba.jp", ICANN: true},
+	{DottedName: "hanawa.fukushima.jp", ICANN: true},
+	{DottedName: "handa.aichi.jp", ICANN: true},
+	{DottedName: "handson.museum", ICANN: true},
+	{DottedName: "hanggliding.aero", ICANN: true},
+	{DottedName: "hangout", ICANN: true},
+	{DottedName: "hannan.osaka.jp", ICANN: true},
+	{DottedName: "hanno.saitama.jp", ICANN: true},
+	{DottedName: "hanyu.saitama.jp", ICANN: true},
+	{DottedName: "hapmir.no", ICANN: true},
+	{DottedName: "happou.akita.jp", ICANN: true},
+	{DottedName: "hara.nagano.jp", ICANN: true},
+	{DottedName: "haram.no", ICANN: true},
+	{DottedName: "hareid.no", ICANN: true},
+	{DottedName: "harima.hyogo.jp", ICANN: true},
+	{DottedName: "harstad.no", ICANN: true},
+	{DottedName: "harvestcelebration.museum", ICANN: true},
+	{DottedName: "hasama.oita.jp", ICANN: true},
+	{DottedName: "hasami.nagasaki.jp", ICANN: true},
+	{DottedName: "hashikami.aomori.jp", ICANN: true},
+	{DottedName: "hashima.gifu.jp", ICANN: true},
+	{DottedName: "hashimoto.wakayama.jp", ICANN: true},
+	{DottedName: "hasuda.saitama.jp", ICANN: true},
+	{DottedName: "hasvik.no", ICANN: true},
+	{DottedName: "hatogaya.saitama.jp", ICANN: true},
+	{DottedName: "hatoyama.saitama.jp", ICANN: true},
+	{DottedName: "hatsukaichi.hiroshima.jp", ICANN: true},
+	{DottedName: "hattfjelldal.no", ICANN: true},
+	{DottedName: "haugesund.no", ICANN: true},
+	{DottedName: "haus", ICANN: true},
+	{DottedName: "hawaii.museum", ICANN: true},
+	{DottedName: "hayakawa.yamanashi.jp", ICANN: true},
+	{DottedName: "hayashima.okayama.jp", ICANN: true},
+	{DottedName: "hazu.aichi.jp", ICANN: true},
+	{DottedName: "hb.cn", ICANN: true},
+	{DottedName: "hbo", ICANN: true},
+	{DottedName: "hdfc", ICANN: true},
+	{DottedName: "hdfcbank", ICANN: true},
+	{DottedName: "he.cn", ICANN: true},
+	{DottedName: "health", ICANN: true},
+	{DottedName: "health.museum", ICANN: true},
+	{DottedName: "health.nz", ICANN: true},
+	{DottedName: "health.vn", ICANN: true},
+	{DottedName: "healthcare", ICANN: true},
+	{DottedName: "heguri.nara.jp", ICANN: true},
+	{DottedName: "heimatunduhren.museum", ICANN: true},
+	{DottedName: "hekinan.aichi.jp", ICANN: true},
+	{DottedName: "hellas.museum", ICANN: true},
+	{DottedName: "help", ICANN: true},
+	{DottedName: "helsinki", ICANN: true},
+	{DottedName: "helsinki.museum", ICANN: true},
+	{DottedName: "hembygdsforbund.museum", ICANN: true},
+	{DottedName: "hemne.no", ICANN: true},
+	{DottedName: "hemnes.no", ICANN: true},
+	{DottedName: "hemsedal.no", ICANN: true},
+	{DottedName: "herad.no", ICANN: true},
+	{DottedName: "here", ICANN: true},
+	{DottedName: "heritage.museum", ICANN: true},
+	{DottedName: "hermes", ICANN: true},
+	{DottedName: "heroy.more-og-romsdal.no", ICANN: true},
+	{DottedName: "heroy.nordland.no", ICANN: true},
+	{DottedName: "hgtv", ICANN: true},
+	{DottedName: "hi.cn", ICANN: true},
+	{DottedName: "hi.us", ICANN: true},
+	{DottedName: "hichiso.gifu.jp", ICANN: true},
+	{DottedName: "hida.gifu.jp", ICANN: true},
+	{DottedName: "hidaka.hokkaido.jp", ICANN: true},
+	{DottedName: "hidaka.kochi.jp", ICANN: true},
+	{DottedName: "hidaka.saitama.jp", ICANN: true},
+	{DottedName: "hidaka.wakayama.jp", ICANN: true},
+	{DottedName: "higashi.fukuoka.jp", ICANN: true},
+	{DottedName: "higashi.fukushima.jp", ICANN: true},
+	{DottedName: "higashi.okinawa.jp", ICANN: true},
+	{DottedName: "higashiagatsuma.gunma.jp", ICANN: true},
+	{DottedName: "higashichichibu.saitama.jp", ICANN: true},
+	{DottedName: "higashihiroshima.hiroshima.jp", ICANN: true},
+	{DottedName: "higashiizu.shizuoka.jp", ICANN: true},
+	{DottedName: "higashiizumo.shimane.jp", ICANN: true},
+	{DottedName: "higashikagawa.kagawa.jp", ICANN: true},
+	{DottedName: "higashikagura.hokkaido.jp", ICANN: true},
+	{DottedName: "higashikawa.hokkaido.jp", ICANN: true},
+	{DottedName: "higashikurume.tokyo.jp", ICANN: true},
+	{DottedName: "higashimatsushima.miyagi.jp", ICANN: true},
+	{DottedName: "higashimatsuyama.saitama.jp", ICANN: true},
+	{DottedName: "higashimurayama.tokyo.jp", ICANN: true},
+	{DottedName: "higashinaruse.akita.jp", ICANN: true},
+	{DottedName: "higashine.yamagata.jp", ICANN: true},
+	{DottedName: "higashiomi.shiga.jp", ICANN: true},
+	{DottedName: "higashiosaka.osaka.jp", ICANN: true},
+	{DottedName: "higashishirakawa.gifu.jp", ICANN: true},
+	{DottedName: "higashisumiyoshi.osaka.jp", ICANN: true},
+	{DottedName: "higashitsuno.kochi.jp", ICANN: true},
+	{DottedName: "higashiura.aichi.jp", ICANN: true},
+	{DottedName: "higashiyama.kyoto.jp", ICANN: true},
+	{DottedName: "higashiyamato.tokyo.jp", ICANN: true},
+	{DottedName: "higashiyodogawa.osaka.jp", ICANN: true},
+	{DottedName: "higashiyoshino.nara.jp", ICANN: true},
+	{DottedName: "hiji.oita.jp", ICANN: true},
+	{DottedName: "hikari.yamaguchi.jp", ICANN: true},
+	{DottedName: "hikawa.shimane.jp", ICANN: true},
+	{DottedName: "hikimi.shimane.jp", ICANN: true},
+	{DottedName: "hikone.shiga.jp", ICANN: true},
+	{DottedName: "himeji.hyogo.jp", ICANN: true},
+	{DottedName: "himeshima.oita.jp", ICANN: true},
+	{DottedName: "himi.toyama.jp", ICANN: true},
+	{DottedName: "hino.tokyo.jp", ICANN: true},
+	{DottedName: "hino.tottori.jp", ICANN: true},
+	{DottedName: "hinode.tokyo.jp", ICANN: true},
+	{DottedName: "hinohara.tokyo.jp", ICANN: true},
+	{DottedName: "hioki.kagoshima.jp", ICANN: true},
+	{DottedName: "hiphop", ICANN: true},
+	{DottedName: "hirado.nagasaki.jp", ICANN: true},
+	{DottedName: "hiraizumi.iwate.jp", ICANN: true},
+	{DottedName: "hirakata.osaka.jp", ICANN: true},
+	{DottedName: "hiranai.aomori.jp", ICANN: true},
+	{DottedName: "hirara.okinawa.jp", ICANN: true},
+	{DottedName: "hirata.fukushima.jp", ICANN: true},
+	{DottedName: "hiratsuka.kanagawa.jp", ICANN: true},
+	{DottedName: "hiraya.nagano.jp", ICANN: true},
+	{DottedName: "hirogawa.wakayama.jp", ICANN: true},
+	{DottedName: "hirokawa.fukuoka.jp", ICANN: true},
+	{DottedName: "hirono.fukushima.jp", ICANN: true},
+	{DottedName: "hirono.iwate.jp", ICANN: true},
+	{DottedName: "hiroo.hokkaido.jp", ICANN: true},
+	{DottedName: "hirosaki.aomori.jp", ICANN: true},
+	{DottedName: "hiroshima.jp", ICANN: true},
+	{DottedName: "hisamitsu", ICANN: true},
+	{DottedName: "hisayama.fukuoka.jp", ICANN: true},
+	{DottedName: "histoire.museum", ICANN: true},
+	{DottedName: "historical.museum", ICANN: true},
+	{DottedName: "historicalsociety.museum", ICANN: true},
+	{DottedName: "historichouses.museum", ICANN: true},
+	{DottedName: "historisch.museum", ICANN: true},
+	{DottedName: "historisches.museum", ICANN: true},
+	{DottedName: "history.museum", ICANN: true},
+	{DottedName: "historyofscience.museum", ICANN: true},
+	{DottedName: "hita.oita.jp", ICANN: true},
+	{DottedName: "hitachi", ICANN: true},
+	{DottedName: "hitachi.ibaraki.jp", ICANN: true},
+	{DottedName: "hitachinaka.ibaraki.jp", ICANN: true},
+	{DottedName: "hitachiomiya.ibaraki.jp", ICANN: true},
+	{DottedName: "hitachiota.ibaraki.jp", ICANN: true},
+	{DottedName: "hitra.no", ICANN: true},
+	{DottedName: "hiv", ICANN: true},
+	{DottedName: "hizen.saga.jp", ICANN: true},
+	{DottedName: "hjartdal.no", ICANN: true},
+	{DottedName: "hjelmeland.no", ICANN: true},
+	{DottedName: "hk", ICANN: true},
+	{DottedName: "hk.cn", ICANN: true},
+	{DottedName: "hkt", ICANN: true},
+	{DottedName: "hl.cn", ICANN: true},
+	{DottedName: "hl.no", ICANN: true},
+	{DottedName: "hm", ICANN: true},
+	{DottedName: "hm.no", ICANN: true},
+	{DottedName: "hn", ICANN: true},
+	{DottedName: "hn.cn", ICANN: true},
+	{DottedName: "hobol.no", ICANN: true},
+	{DottedName: "hockey", ICANN: true},
+	{DottedName: "hof.no", ICANN: true},
+	{DottedName: "hofu.yamaguchi.jp", ICANN: true},
+	{DottedName: "hokkaido.jp", ICANN: true},
+	{DottedName: "hokksund.no", ICANN: true},
+	{DottedName: "hokuryu.hokkaido.jp", ICANN: true},
+	{DottedName: "hokuto.hokkaido.jp", ICANN: true},
+	{DottedName: "hokuto.yamanashi.jp", ICANN: true},
+	{DottedName: "hol.no", ICANN: true},
+	{DottedName: "holdings", ICANN: true},
+	{DottedName: "hole.no", ICANN: true},
+	{DottedName: "holiday", ICANN: true},
+	{DottedName: "holmestrand.no", ICANN: true},
+	{DottedName: "holtalen.no", ICANN: true},
+	{DottedName: "homebuilt.aero", ICANN: true},
+	{DottedName: "homedepot", ICANN: true},
+	{DottedName: "homegoods", ICANN: true},
+	{DottedName: "homes", ICANN: true},
+	{DottedName: "homesense", ICANN: true},
+	{DottedName: "honai.ehime.jp", ICANN: true},
+	{DottedName: "honbetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "honda", ICANN: true},
+	{DottedName: "honefoss.no", ICANN: true},
+	{DottedName: "hongo.hiroshima.jp", ICANN: true},
+	{DottedName: "honjo.akita.jp", ICANN: true},
+	{DottedName: "honjo.saitama.jp", ICANN: true},
+	{DottedName: "honjyo.akita.jp", ICANN: true},
+	{DottedName: "hornindal.no", ICANN: true},
+	{DottedName: "horokanai.hokkaido.jp", ICANN: true},
+	{DottedName: "horology.museum", ICANN: true},
+	{DottedName: "horonobe.hokkaido.jp", ICANN: true},
+	{DottedName: "horse", ICANN: true},
+	{DottedName: "horten.no", ICANN: true},
+	{DottedName: "hospital", ICANN: true},
+	{DottedName: "host", ICANN: true},
+	{DottedName: "hosting", ICANN: true},
+	{DottedName: "hot", ICANN: true},
+	{DottedName: "hotel.hu", ICANN: true},
+	{DottedName: "hotel.lk", ICANN: true},
+	{DottedName: "hotel.tz", ICANN: true},
+	{DottedName: "hoteles", ICANN: true},
+	{DottedName: "hotels", ICANN: true},
+	{DottedName: "hotmail", ICANN: true},
+	{DottedName: "house", ICANN: true},
+	{DottedName: "house.museum", ICANN: true},
+	{DottedName: "how", ICANN: true},
+	{DottedName: "hoyanger.no", ICANN: true},
+	{DottedName: "hoylandet.no", ICANN: true},
+	{DottedName: "hr", ICANN: true},
+	{DottedName: "hs.kr", ICANN: true},
+	{DottedName: "hsbc", ICANN: true},
+	{DottedName: "ht", ICANN: true},
+	{DottedName: "hu", ICANN: true},
+	{DottedName: "hughes", ICANN: true},
+	{DottedName: "huissier-justice.fr", ICANN: true},
+	{DottedName: "humanities.museum", ICANN: true},
+	{DottedName: "hurdal.no", ICANN: true},
+	{DottedName: "hurum.no", ICANN: true},
+	{DottedName: "hvaler.no", ICANN: true},
+	{DottedName: "hyatt", ICANN: true},
+	{DottedName: "hyllestad.no", ICANN: true},
+	{DottedName: "hyogo.jp", ICANN: true},
+	{DottedName: "hyuga.miyazaki.jp", ICANN: true},
+	{DottedName: "hyundai", ICANN: true},
+	{DottedName: "i.bg", ICANN: true},
+	{DottedName: "i.ng", ICANN: true},
+	{DottedName: "i.ph", ICANN: true},
+	{DottedName: "i.se", ICANN: true},
+	{DottedName: "ia.us", ICANN: true},
+	{DottedName: "ibara.okayama.jp", ICANN: true},
+	{DottedName: "ibaraki.ibaraki.jp", ICANN: true},
+	{DottedName: "ibaraki.jp", ICANN: true},
+	{DottedName: "ibaraki.osaka.jp", ICANN: true},
+	{DottedName: "ibestad.no", ICANN: true},
+	{DottedName: "ibigawa.gifu.jp", ICANN: true},
+	{DottedName: "ibm", ICANN: true},
+	{DottedName: "ic.gov.pl", ICANN: true},
+	{DottedName: "icbc", ICANN: true},
+	{DottedName: "ice", ICANN: true},
+	{DottedName: "ichiba.tokushima.jp", ICANN: true},
+	{DottedName: "ichihara.chiba.jp", ICANN: true},
+	{DottedName: "ichikai.tochigi.jp", ICANN: true},
+	{DottedName: "ichikawa.chiba.jp", ICANN: true},
+	{DottedName: "ichikawa.hyogo.jp", ICANN: true},
+	{DottedName: "ichikawamisato.yamanashi.jp", ICANN: true},
+	{DottedName: "ichinohe.iwate.jp", ICANN: true},
+	{DottedName: "ichinomiya.aichi.jp", ICANN: true},
+	{DottedName: "ichinomiya.chiba.jp", ICANN: true},
+	{DottedName: "ichinoseki.iwate.jp", ICANN: true},
+	{DottedName: "icu", ICANN: true},
+	{DottedName: "id", ICANN: true},
+	{DottedName: "id.au", ICANN: true},
+	{DottedName: "id.ir", ICANN: true},
+	{DottedName: "id.lv", ICANN: true},
+	{DottedName: "id.ly", ICANN: true},
+	{DottedName: "id.us", ICANN: true},
+	{DottedName: "ide.kyoto.jp", ICANN: true},
+	{DottedName: "idf.il", ICANN: true},
+	{DottedName: "idrett.no", ICANN: true},
+	{DottedName: "idv.hk", ICANN: true},
+	{DottedName: "idv.tw", ICANN: true},
+	{DottedName: "ie", ICANN: true},
+	{DottedName: "ieee", ICANN: true},
+	{DottedName: "if.ua", ICANN: true},
+	{DottedName: "ifm", ICANN: true},
+	{DottedName: "iglesias-carbonia.it", ICANN: true},
+	{DottedName: "iglesiascarbonia.it", ICANN: true},
+	{DottedName: "iheya.okinawa.jp", ICANN: true},
+	{DottedName: "iida.nagano.jp", ICANN: true},
+	{DottedName: "iide.yamagata.jp", ICANN: true},
+	{DottedName: "iijima.nagano.jp", ICANN: true},
+	{DottedName: "iitate.fukushima.jp", ICANN: true},
+	{DottedName: "iiyama.nagano.jp", ICANN: true},
+	{DottedName: "iizuka.fukuoka.jp", ICANN: true},
+	{DottedName: "iizuna.nagano.jp", ICANN: true},
+	{DottedName: "ikano", ICANN: true},
+	{DottedName: "ikaruga.nara.jp", ICANN: true},
+	{DottedName: "ikata.ehime.jp", ICANN: true},
+	{DottedName: "ikawa.akita.jp", ICANN: true},
+	{DottedName: "ikeda.fukui.jp", ICANN: true},
+	{DottedName: "ikeda.gifu.jp", ICANN: true},
+	{DottedName: "ikeda.hokkaido.jp", ICANN: true},
+	{DottedName: "ikeda.nagano.jp", ICANN: true},
+	{DottedName: "ikeda.osaka.jp", ICANN: true},
+	{DottedName: "iki.nagasaki.jp", ICANN: true},
+	{DottedName: "ikoma.nara.jp", ICANN: true},
+	{DottedName: "ikusaka.nagano.jp", ICANN: true},
+	{DottedName: "il", ICANN: true},
+	{DottedName: "il.us", ICANN: true},
+	{DottedName: "ilawa.pl", ICANN: true},
+	{DottedName: "illustration.museum", ICANN: true},
+	{DottedName: "im", ICANN: true},
+	{DottedName: "im.it", ICANN: true},
+	{DottedName: "imabari.ehime.jp", ICANN: true},
+	{DottedName: "imageandsound.museum", ICANN: true},
+	{DottedName: "imakane.hokkaido.jp", ICANN: true},
+	{DottedName: "imamat", ICANN: true},
+	{DottedName: "imari.saga.jp", ICANN: true},
+	{DottedName: "imb.br", ICANN: true},
+	{DottedName: "imdb", ICANN: true},
+	{DottedName: "imizu.toyama.jp", ICANN: true},
+	{DottedName: "immo", ICANN: true},
+	{DottedName: "immobilien", ICANN: true},
+	{DottedName: "imperia.it", ICANN: true},
+	{DottedName: "in", ICANN: true},
+	{DottedName: "in-addr.arpa", ICANN: true},
+	{DottedName: "in.na", ICANN: true},
+	{DottedName: "in.ni", ICANN: true},
+	{DottedName: "in.rs", ICANN: true},
+	{DottedName: "in.th", ICANN: true},
+	{DottedName: "in.ua", ICANN: true},
+	{DottedName: "in.us", ICANN: true},
+	{DottedName: "ina.ibaraki.jp", ICANN: true},
+	{DottedName: "ina.nagano.jp", ICANN: true},
+	{DottedName: "ina.saitama.jp", ICANN: true},
+	{DottedName: "inabe.mie.jp", ICANN: true},
+	{DottedName: "inagawa.hyogo.jp", ICANN: true},
+	{DottedName: "inagi.tokyo.jp", ICANN: true},
+	{DottedName: "inami.toyama.jp", ICANN: true},
+	{DottedName: "inami.wakayama.jp", ICANN: true},
+	{DottedName: "inashiki.ibaraki.jp", ICANN: true},
+	{DottedName: "inatsuki.fukuoka.jp", ICANN: true},
+	{DottedName: "inawashiro.fukushima.jp", ICANN: true},
+	{DottedName: "inazawa.aichi.jp", ICANN: true},
+	{DottedName: "inc", ICANN: true},
+	{DottedName: "incheon.kr", ICANN: true},
+	{DottedName: "ind.br", ICANN: true},
+	{DottedName: "ind.gt", ICANN: true},
+	{DottedName: "ind.in", ICANN: true},
+	{DottedName: "ind.kw", ICANN: true},
+	{DottedName: "ind.tn", ICANN: true},
+	{DottedName: "inderoy.no", ICANN: true},
+	{DottedName: "indian.museum", ICANN: true},
+	{DottedName: "indiana.museum", ICANN: true},
+	{DottedName: "indianapolis.museum", ICANN: true},
+	{DottedName: "indianmarket.museum", ICANN: true},
+	{DottedName: "indigena.bo", ICANN: true},
+	{DottedName: "industria.bo", ICANN: true},
+	{DottedName: "industries", ICANN: true},
+	{DottedName: "ine.kyoto.jp", ICANN: true},
+	{DottedName: "inf.br", ICANN: true},
+	{DottedName: "inf.cu", ICANN: true},
+	{DottedName: "inf.mk", ICANN: true},
+	{DottedName: "infiniti", ICANN: true},
+	{DottedName: "info", ICANN: true},
+	{DottedName: "info.au", ICANN: true},
+	{DottedName: "info.az", ICANN: true},
+	{DottedName: "info.bb", ICANN: true},
+	{DottedName: "info.bo", ICANN: true},
+	{DottedName: "info.co", ICANN: true},
+	{DottedName: "info.ec", ICANN: true},
+	{DottedName: "info.et", ICANN: true},
+	{DottedName: "info.fj", ICANN: true},
+	{DottedName: "info.gu", ICANN: true},
+	{DottedName: "info.ht", ICANN: true},
+	{DottedName: "info.hu", ICANN: true},
+	{DottedName: "info.ke", ICANN: true},
+	{DottedName: "info.ki", ICANN: true},
+	{DottedName: "info.la", ICANN: true},
+	{DottedName: "info.ls", ICANN: true},
+	{DottedName: "info.mv", ICANN: true},
+	{DottedName: "info.na", ICANN: true},
+	{DottedName: "info.nf", ICANN: true},
+	{DottedName: "info.ni", ICANN: true},
+	{DottedName: "info.nr", ICANN: true},
+	{DottedName: "info.pk", ICANN: true},
+	{DottedName: "info.pl", ICANN: true},
+	{DottedName: "info.pr", ICANN: true},
+	{DottedName: "info.ro", ICANN: true},
+	{DottedName: "info.sd", ICANN: true},
+	{DottedName: "info.tn", ICANN: true},
+	{DottedName: "info.tr", ICANN: true},
+	{DottedName: "info.tt", ICANN: true},
+	{DottedName: "info.tz", ICANN: true},
+	{DottedName: "info.ve", ICANN: true},
+	{DottedName: "info.vn", ICANN: true},
+	{DottedName: "info.zm", ICANN: true},
+	{DottedName: "ing", ICANN: true},
+	{DottedName: "ing.pa", ICANN: true},
+	{DottedName: "ingatlan.hu", ICANN: true},
+	{DottedName: "ink", ICANN: true},
+	{DottedName: "ino.kochi.jp", ICANN: true},
+	{DottedName: "institute", ICANN: true},
+	{DottedName: "insurance", ICANN: true},
+	{DottedName: "insurance.aero", ICANN: true},
+	{DottedName: "insure", ICANN: true},
+	{DottedName: "int", ICANN: true},
+	{DottedName: "int.ar", ICANN: true},
+	{DottedName: "int.az", ICANN: true},
+	{DottedName: "int.bo", ICANN: true},
+	{DottedName: "int.ci", ICANN: true},
+	{DottedName: "int.co", ICANN: true},
+	{DottedName: "int.cv", ICANN: true},
+	{DottedName: "int.is", ICANN: true},
+	{DottedName: "int.la", ICANN: true},
+	{DottedName: "int.lk", ICANN: true},
+	{DottedName: "int.mv", ICANN: true},
+	{DottedName: "int.mw", ICANN: true},
+	{DottedName: "int.ni", ICANN: true},
+	{DottedName: "int.pt", ICANN: true},
+	{DottedName: "int.tj", ICANN: true},
+	{DottedName: "int.tt", ICANN: true},
+	{DottedName: "int.ve", ICANN: true},
+	{DottedName: "int.vn", ICANN: true},
+	{DottedName: "intelligence.museum", ICANN: true},
+	{DottedName: "interactive.museum", ICANN: true},
+	{DottedName: "international", ICANN: true},
+	{DottedName: "intl.tn", ICANN: true},
+	{DottedName: "intuit", ICANN: true},
+	{DottedName: "inuyama.aichi.jp", ICANN: true},
+	{DottedName: "investments", ICANN: true},
+	{DottedName: "inzai.chiba.jp", ICANN: true},
+	{DottedName: "io", ICANN: true},
+	{DottedName: "ip6.arpa", ICANN: true},
+	{DottedName: "ipiranga", ICANN: true},
+	{DottedName: "iq", ICANN: true},
+	{DottedName: "ir", ICANN: true},
+	{DottedName: "iraq.museum", ICANN: true},
+	{DottedName: "iris.arpa", ICANN: true},
+	{DottedName: "irish", ICANN: true},
+	{DottedName: "iron.museum", ICANN: true},
+	{DottedName: "iruma.saitama.jp", ICANN: true},
+	{DottedName: "is", ICANN: true},
+	{DottedName: "is.gov.pl", ICANN: true},
+	{DottedName: "is.it", ICANN: true},
+	{DottedName: "isa.kagoshima.jp", ICANN: true},
+	{DottedName: "isa.us", ICANN: true},
+	{DottedName: "isahaya.nagasaki.jp", ICANN: true},
+	{DottedName: "ise.mie.jp", ICANN: true},
+	{DottedName: "isehara.kanagawa.jp", ICANN: true},
+	{DottedName: "isen.kagoshima.jp", ICANN: true},
+	{DottedName: "isernia.it", ICANN: true},
+	{DottedName: "isesaki.gunma.jp", ICANN: true},
+	{DottedName: "ishigaki.okinawa.jp", ICANN: true},
+	{DottedName: "ishikari.hokkaido.jp", ICANN: true},
+	{DottedName: "ishikawa.fukushima.jp", ICANN: true},
+	{DottedName: "ishikawa.jp", ICANN: true},
+	{DottedName: "ishikawa.okinawa.jp", ICANN: true},
+	{DottedName: "ishinomaki.miyagi.jp", ICANN: true},
+	{DottedName: "isla.pr", ICANN: true},
+	{DottedName: "isleofman.museum", ICANN: true},
+	{DottedName: "ismaili", ICANN: true},
+	{DottedName: "isshiki.aichi.jp", ICANN: true},
+	{DottedName: "ist", ICANN: true},
+	{DottedName: "istanbul", ICANN: true},
+	{DottedName: "isumi.chiba.jp", ICANN: true},
+	{DottedName: "it", ICANN: true},
+	{DottedName: "it.ao", ICANN: true},
+	{DottedName: "itabashi.tokyo.jp", ICANN: true},
+	{DottedName: "itako.ibaraki.jp", ICANN: true},
+	{DottedName: "itakura.gunma.jp", ICANN: true},
+	{DottedName: "itami.hyogo.jp", ICANN: true},
+	{DottedName: "itano.tokushima.jp", ICANN: true},
+	{DottedName: "itau", ICANN: true},
+	{DottedName: "itayanagi.aomori.jp", ICANN: true},
+	{DottedName: "ito.shizuoka.jp", ICANN: true},
+	{DottedName: "itoigawa.niigata.jp", ICANN: true},
+	{DottedName: "itoman.okinawa.jp", ICANN: true},
+	{DottedName: "its.me", ICANN: true},
+	{DottedName: "itv", ICANN: true},
+	{DottedName: "ivano-frankivsk.ua", ICANN: true},
+	{DottedName: "iveland.no", ICANN: true},
+	{DottedName: "ivgu.no", ICANN: true},
+	{DottedName: "iwade.wakayama.jp", ICANN: true},
+	{DottedName: "iwafune.tochigi.jp", ICANN: true},
+	{DottedName: "iwaizumi.iwate.jp", ICANN: true},
+	{DottedName: "iwaki.fukushima.jp", ICANN: true},
+	{DottedName: "iwakuni.yamaguchi.jp", ICANN: true},
+	{DottedName: "iwakura.aichi.jp", ICANN: true},
+	{DottedName: "iwama.ibaraki.jp", ICANN: true},
+	{DottedName: "iwamizawa.hokkaido.jp", ICANN: true},
+	{DottedName: "iwanai.hokkaido.jp", ICANN: true},
+	{DottedName: "iwanuma.miyagi.jp", ICANN: true},
+	{DottedName: "iwata.shizuoka.jp", ICANN: true},
+	{DottedName: "iwate.iwate.jp", ICANN: true},
+	{DottedName: "iwate.jp", ICANN: true},
+	{DottedName: "iwatsuki.saitama.jp", ICANN: true},
+	{DottedName: "iwi.nz", ICANN: true},
+	{DottedName: "iyo.ehime.jp", ICANN: true},
+	{DottedName: "iz.hr", ICANN: true},
+	{DottedName: "izena.okinawa.jp", ICANN: true},
+	{DottedName: "izu.shizuoka.jp", ICANN: true},
+	{DottedName: "izumi.kagoshima.jp", ICANN: true},
+	{DottedName: "izumi.osaka.jp", ICANN: true},
+	{DottedName: "izumiotsu.osaka.jp", ICANN: true},
+	{DottedName: "izumisano.osaka.jp", ICANN: true},
+	{DottedName: "izumizaki.fukushima.jp", ICANN: true},
+	{DottedName: "izumo.shimane.jp", ICANN: true},
+	{DottedName: "izumozaki.niigata.jp", ICANN: true},
+	{DottedName: "izunokuni.shizuoka.jp", ICANN: true},
+	{DottedName: "j.bg", ICANN: true},
+	{DottedName: "jab.br", ICANN: true},
+	{DottedName: "jaguar", ICANN: true},
+	{DottedName: "jamison.museum", ICANN: true},
+	{DottedName: "jampa.br", ICANN: true},
+	{DottedName: "jan-mayen.no", ICANN: true},
+	{DottedName: "java", ICANN: true},
+	{DottedName: "jaworzno.pl", ICANN: true},
+	{DottedName: "jcb", ICANN: true},
+	{DottedName: "jdf.br", ICANN: true},
+	{DottedName: "je", ICANN: true},
+	{DottedName: "jeep", ICANN: true},
+	{DottedName: "jefferson.museum", ICANN: true},
+	{DottedName: "jeju.kr", ICANN: true},
+	{DottedName: "jelenia-gora.pl", ICANN: true},
+	{DottedName: "jeonbuk.kr", ICANN: true},
+	{DottedName: "jeonnam.kr", ICANN: true},
+	{DottedName: "jerusalem.museum", ICANN: true},
+	{DottedName: "jessheim.no", ICANN: true},
+	{DottedName: "jetzt", ICANN: true},
+	{DottedName: "jevnaker.no", ICANN: true},
+	{DottedName: "jewelry", ICANN: true},
+	{DottedName: "jewelry.museum", ICANN: true},
+	{DottedName: "jewish.museum", ICANN: true},
+	{DottedName: "jewishart.museum", ICANN: true},
+	{DottedName: "jfk.museum", ICANN: true},
+	{DottedName: "jgora.pl", ICANN: true},
+	{DottedName: "jinsekikogen.hiroshima.jp", ICANN: true},
+	{DottedName: "jio", ICANN: true},
+	{DottedName: "jl.cn", ICANN: true},
+	{DottedName: "jll", ICANN: true},
+	{DottedName: "jmp", ICANN: true},
+	{DottedName: "jnj", ICANN: true},
+	{DottedName: "jo", ICANN: true},
+	{DottedName: "joboji.iwate.jp", ICANN: true},
+	{DottedName: "jobs", ICANN: true},
+	{DottedName: "jobs.tt", ICANN: true},
+	{DottedName: "joburg", ICANN: true},
+	{DottedName: "joetsu.niigata.jp", ICANN: true},
+	{DottedName: "jogasz.hu", ICANN: true},
+	{DottedName: "johana.toyama.jp", ICANN: true},
+	{DottedName: "joinville.br", ICANN: true},
+	{DottedName: "jolster.no", ICANN: true},
+	{DottedName: "jondal.no", ICANN: true},
+	{DottedName: "jor.br", ICANN: true},
+	{DottedName: "jorpeland.no", ICANN: true},
+	{DottedName: "joso.ibaraki.jp", ICANN: true},
+	{DottedName: "jot", ICANN: true},
+	{DottedName: "journal.aero", ICANN: true},
+	{DottedName: "journalism.museum", ICANN: true},
+	{DottedName: "journalist.aero", ICANN: true},
+	{DottedName: "joy", ICANN: true},
+	{DottedName: "joyo.kyoto.jp", ICANN: true},
+	{DottedName: "jp", ICANN: true},
+	{DottedName: "jpmorgan", ICANN: true},
+	{DottedName: "jprs", ICANN: true},
+	{DottedName: "js.cn", ICANN: true},
+	{DottedName: "judaica.museum", ICANN: true},
+	{DottedName: "judygarland.museum", ICANN: true},
+	{DottedName: "juedisches.museum", ICANN: true},
+	{DottedName: "juegos", ICANN: true},
+	{DottedName: "juif.museum", ICANN: true},
+	{DottedName: "juniper", ICANN: true},
+	{DottedName: "jur.pro", ICANN: true},
+	{DottedName: "jus.br", ICANN: true},
+	{DottedName: "jx.cn", ICANN: true},
+	{DottedName: "k.bg", ICANN: true},
+	{DottedName: "k.se", ICANN: true},
+	{DottedName: "k12.ak.us", ICANN: true},
+	{DottedName: "k12.al.us", ICANN: true},
+	{DottedName: "k12.ar.us", ICANN: true},
+	{DottedName: "k12.as.us", ICANN: true},
+	{DottedName: "k12.az.us", ICANN: true},
+	{DottedName: "k12.ca.us", ICANN: true},
+	{DottedName: "k12.co.us", ICANN: true},
+	{DottedName: "k12.ct.us", ICANN: true},
+	{DottedName: "k12.dc.us", ICANN: true},
+	{DottedName: "k12.de.us", ICANN: true},
+	{DottedName: "k12.ec", ICANN: true},
+	{DottedName: "k12.fl.us", ICANN: true},
+	{DottedName: "k12.ga.us", ICANN: true},
+	{DottedName: "k12.gu.us", ICANN: true},
+	{DottedName: "k12.ia.us", ICANN: true},
+	{DottedName: "k12.id.us", ICANN: true},
+	{DottedName: "k12.il", ICANN: true},
+	{DottedName: "k12.il.us", ICANN: true},
+	{DottedName: "k12.in.us", ICANN: true},
+	{DottedName: "k12.ks.us", ICANN: true},
+	{DottedName: "k12.ky.us", ICANN: true},
+	{DottedName: "k12.la.us", ICANN: true},
+	{DottedName: "k12.ma.us", ICANN: true},
+	{DottedName: "k12.md.us", ICANN: true},
+	{DottedName: "k12.me.us", ICANN: true},
+	{DottedName: "k12.mi.us", ICANN: true},
+	{DottedName: "k12.mn.us", ICANN: true},
+	{DottedName: "k12.mo.us", ICANN: true},
+	{DottedName: "k12.ms.us", ICANN: true},
+	{DottedName: "k12.mt.us", ICANN: true},
+	{DottedName: "k12.nc.us", ICANN: true},
+	{DottedName: "k12.ne.us", ICANN: true},
+	{DottedName: "k12.nh.us", ICANN: true},
+	{DottedName: "k12.nj.us", ICANN: true},
+	{DottedName: "k12.nm.us", ICANN: true},
+	{DottedName: "k12.nv.us", ICANN: true},
+	{DottedName: "k12.ny.us", ICANN: true},
+	{DottedName: "k12.oh.us", ICANN: true},
+	{DottedName: "k12.ok.us", ICANN: true},
+	{DottedName: "k12.or.us", ICANN: true},
+	{DottedName: "k12.pa.us", ICANN: true},
+	{DottedName: "k12.pr.us", ICANN: true},
+	{DottedName: "k12.sc.us", ICANN: true},
+	{DottedName: "k12.tn.us", ICANN: true},
+	{DottedName: "k12.tr", ICANN: true},
+	{DottedName: "k12.tx.us", ICANN: true},
+	{DottedName: "k12.ut.us", ICANN: true},
+	{DottedName: "k12.va.us", ICANN: true},
+	{DottedName: "k12.vi", ICANN: true},
+	{DottedName: "k12.vi.us", ICANN: true},
+	{DottedName: "k12.vt.us", ICANN: true},
+	{DottedName: "k12.wa.us", ICANN: true},
+	{DottedName: "k12.wi.us", ICANN: true},
+	{DottedName: "k12.wy.us", ICANN: true},
+	{DottedName: "kadena.okinawa.jp", ICANN: true},
+	{DottedName: "kadogawa.miyazaki.jp", ICANN: true},
+	{DottedName: "kadoma.osaka.jp", ICANN: true},
+	{DottedName: "kafjord.no", ICANN: true},
+	{DottedName: "kaga.ishikawa.jp", ICANN: true},
+	{DottedName: "kagami.kochi.jp", ICANN: true},
+	{DottedName: "kagamiishi.fukushima.jp", ICANN: true},
+	{DottedName: "kagamino.okayama.jp", ICANN: true},
+	{DottedName: "kagawa.jp", ICANN: true},
+	{DottedName: "kagoshima.jp", ICANN: true},
+	{DottedName: "kagoshima.kagoshima.jp", ICANN: true},
+	{DottedName: "kaho.fukuoka.jp", ICANN: true},
+	{DottedName: "kahoku.ishikawa.jp", ICANN: true},
+	{DottedName: "kahoku.yamagata.jp", ICANN: true},
+	{DottedName: "kai.yamanashi.jp", ICANN: true},
+	{DottedName: "kainan.tokushima.jp", ICANN: true},
+	{DottedName: "kainan.wakayama.jp", ICANN: true},
+	{DottedName: "kaisei.kanagawa.jp", ICANN: true},
+	{DottedName: "kaita.hiroshima.jp", ICANN: true},
+	{DottedName: "kaizuka.osaka.jp", ICANN: true},
+	{DottedName: "kakamigahara.gifu.jp", ICANN: true},
+	{DottedName: "kakegawa.shizuoka.jp", ICANN: true},
+	{DottedName: "kakinoki.shimane.jp", ICANN: true},
+	{DottedName: "kakogawa.hyogo.jp", ICANN: true},
+	{DottedName: "kakuda.miyagi.jp", ICANN: true},
+	{DottedName: "kalisz.pl", ICANN: true},
+	{DottedName: "kamagaya.chiba.jp", ICANN: true},
+	{DottedName: "kamaishi.iwate.jp", ICANN: true},
+	{DottedName: "kamakura.kanagawa.jp", ICANN: true},
+	{DottedName: "kameoka.kyoto.jp", ICANN: true},
+	{DottedName: "kameyama.mie.jp", ICANN: true},
+	{DottedName: "kami.kochi.jp", ICANN: true},
+	{DottedName: "kami.miyagi.jp", ICANN: true},
+	{DottedName: "kamiamakusa.kumamoto.jp", ICANN: true},
+	{DottedName: "kamifurano.hokkaido.jp", ICANN: true},
+	{DottedName: "kamigori.hyogo.jp", ICANN: true},
+	{DottedName: "kamiichi.toyama.jp", ICANN: true},
+	{DottedName: "kamiizumi.saitama.jp", ICANN: true},
+	{DottedName: "kamijima.ehime.jp", ICANN: true},
+	{DottedName: "kamikawa.hokkaido.jp", ICANN: true},
+	{DottedName: "kamikawa.hyogo.jp", ICANN: true},
+	{DottedName: "kamikawa.saitama.jp", ICANN: true},
+	{DottedName: "kamikitayama.nara.jp", ICANN: true},
+	{DottedName: "kamikoani.akita.jp", ICANN: true},
+	{DottedName: "kamimine.saga.jp", ICANN: true},
+	{DottedName: "kaminokawa.tochigi.jp", ICANN: true},
+	{DottedName: "kaminoyama.yamagata.jp", ICANN: true},
+	{DottedName: "kamioka.akita.jp", ICANN: true},
+	{DottedName: "kamisato.saitama.jp", ICANN: true},
+	{DottedName: "kamishihoro.hokkaido.jp", ICANN: true},
+	{DottedName: "kamisu.ibaraki.jp", ICANN: true},
+	{DottedName: "kamisunagawa.hokkaido.jp", ICANN: true},
+	{DottedName: "kamitonda.wakayama.jp", ICANN: true},
+	{DottedName: "kamitsue.oita.jp", ICANN: true},
+	{DottedName: "kamo.kyoto.jp", ICANN: true},
+	{DottedName: "kamo.niigata.jp", ICANN: true},
+	{DottedName: "kamoenai.hokkaido.jp", ICANN: true},
+	{DottedName: "kamogawa.chiba.jp", ICANN: true},
+	{DottedName: "kanagawa.jp", ICANN: true},
+	{DottedName: "kanan.osaka.jp", ICANN: true},
+	{DottedName: "kanazawa.ishikawa.jp", ICANN: true},
+	{DottedName: "kanegasaki.iwate.jp", ICANN: true},
+	{DottedName: "kaneyama.fukushima.jp", ICANN: true},
+	{DottedName: "kaneyama.yamagata.jp", ICANN: true},
+	{DottedName: "kani.gifu.jp", ICANN: true},
+	{DottedName: "kanie.aichi.jp", ICANN: true},
+	{DottedName: "kanmaki.nara.jp", ICANN: true},
+	{DottedName: "kanna.gunma.jp", ICANN: true},
+	{DottedName: "kannami.shizuoka.jp", ICANN: true},
+	{DottedName: "kanonji.kagawa.jp", ICANN: true},
+	{DottedName: "kanoya.kagoshima.jp", ICANN: true},
+	{DottedName: "kanra.gunma.jp", ICANN: true},
+	{DottedName: "kanuma.tochigi.jp", ICANN: true},
+	{DottedName: "kanzaki.saga.jp", ICANN: true},
+	{DottedName: "karasjohka.no", ICANN: true},
+	{DottedName: "karasjok.no", ICANN: true},
+	{DottedName: "karasuyama.tochigi.jp", ICANN: true},
+	{DottedName: "karate.museum", ICANN: true},
+	{DottedName: "karatsu.saga.jp", ICANN: true},
+	{DottedName: "karikatur.museum", ICANN: true},
+	{DottedName: "kariwa.niigata.jp", ICANN: true},
+	{DottedName: "kariya.aichi.jp", ICANN: true},
+	{DottedName: "karlsoy.no", ICANN: true},
+	{DottedName: "karmoy.no", ICANN: true},
+	{DottedName: "karpacz.pl", ICANN: true},
+	{DottedName: "kartuzy.pl", ICANN: true},
+	{DottedName: "karuizawa.nagano.jp", ICANN: true},
+	{DottedName: "karumai.iwate.jp", ICANN: true},
+	{DottedName: "kasahara.gifu.jp", ICANN: true},
+	{DottedName: "kasai.hyogo.jp", ICANN: true},
+	{DottedName: "kasama.ibaraki.jp", ICANN: true},
+	{DottedName: "kasamatsu.gifu.jp", ICANN: true},
+	{DottedName: "kasaoka.okayama.jp", ICANN: true},
+	{DottedName: "kashiba.nara.jp", ICANN: true},
+	{DottedName: "kashihara.nara.jp", ICANN: true},
+	{DottedName: "kashima.ibaraki.jp", ICANN: true},
+	{DottedName: "kashima.saga.jp", ICANN: true},
+	{DottedName: "kashiwa.chiba.jp", ICANN: true},
+	{DottedName: "kashiwara.osaka.jp", ICANN: true},
+	{DottedName: "kashiwazaki.niigata.jp", ICANN: true},
+	{DottedName: "kasuga.fukuoka.jp", ICANN: true},
+	{DottedName: "kasuga.hyogo.jp", ICANN: true},
+	{DottedName: "kasugai.aichi.jp", ICANN: true},
+	{DottedName: "kasukabe.saitama.jp", ICANN: true},
+	{DottedName: "kasumigaura.ibaraki.jp", ICANN: true},
+	{DottedName: "kasuya.fukuoka.jp", ICANN: true},
+	{DottedName: "kaszuby.pl", ICANN: true},
+	{DottedName: "katagami.akita.jp", ICANN: true},
+	{DottedName: "katano.osaka.jp", ICANN: true},
+	{DottedName: "katashina.gunma.jp", ICANN: true},
+	{DottedName: "katori.chiba.jp", ICANN: true},
+	{DottedName: "katowice.pl", ICANN: true},
+	{DottedName: "katsuragi.nara.jp", ICANN: true},
+	{DottedName: "katsuragi.wakayama.jp", ICANN: true},
+	{DottedName: "katsushika.tokyo.jp", ICANN: true},
+	{DottedName: "katsuura.chiba.jp", ICANN: true},
+	{DottedName: "katsuyama.fukui.jp", ICANN: true},
+	{DottedName: "kaufen", ICANN: true},
+	{DottedName: "kautokeino.no", ICANN: true},
+	{DottedName: "kawaba.gunma.jp", ICANN: true},
+	{DottedName: "kawachinagano.osaka.jp", ICANN: true},
+	{DottedName: "kawagoe.mie.jp", ICANN: true},
+	{DottedName: "kawagoe.saitama.jp", ICANN: true},
+	{DottedName: "kawaguchi.saitama.jp", ICANN: true},
+	{DottedName: "kawahara.tottori.jp", ICANN: true},
+	{DottedName: "kawai.iwate.jp", ICANN: true},
+	{DottedName: "kawai.nara.jp", ICANN: true},
+	{DottedName: "kawajima.saitama.jp", ICANN: true},
+	{DottedName: "kawakami.nagano.jp", ICANN: true},
+	{DottedName: "kawakami.nara.jp", ICANN: true},
+	{DottedName: "kawakita.ishikawa.jp", ICANN: true},
+	{DottedName: "kawamata.fukushima.jp", ICANN: true},
+	{DottedName: "kawaminami.miyazaki.jp", ICANN: true},
+	{DottedName: "kawanabe.kagoshima.jp", ICANN: true},
+	{DottedName: "kawanehon.shizuoka.jp", ICANN: true},
+	{DottedName: "kawanishi.hyogo.jp", ICANN: true},
+	{DottedName: "kawanishi.nara.jp", ICANN: true},
+	{DottedName: "kawanishi.yamagata.jp", ICANN: true},
+	{DottedName: "kawara.fukuoka.jp", ICANN: true},
+	{DottedName: "kawasaki.miyagi.jp", ICANN: true},
+	{DottedName: "kawatana.nagasaki.jp", ICANN: true},
+	{DottedName: "kawaue.gifu.jp", ICANN: true},
+	{DottedName: "kawazu.shizuoka.jp", ICANN: true},
+	{DottedName: "kayabe.hokkaido.jp", ICANN: true},
+	{DottedName: "kazimierz-dolny.pl", ICANN: true},
+	{DottedName: "kazo.saitama.jp", ICANN: true},
+	{DottedName: "kazuno.akita.jp", ICANN: true},
+	{DottedName: "kddi", ICANN: true},
+	{DottedName: "ke", ICANN: true},
+	{DottedName: "keisen.fukuoka.jp", ICANN: true},
+	{DottedName: "kembuchi.hokkaido.jp", ICANN: true},
+	{DottedName: "kep.tr", ICANN: true},
+	{DottedName: "kepno.pl", ICANN: true},
+	{DottedName: "kerryhotels", ICANN: true},
+	{DottedName: "kerrylogistics", ICANN: true},
+	{DottedName: "kerryproperties", ICANN: true},
+	{DottedName: "ketrzyn.pl", ICANN: true},
+	{DottedName: "kfh", ICANN: true},
+	{DottedName: "kg", ICANN: true},
+	{DottedName: "kg.kr", ICANN: true},
+	{DottedName: "kh.ua", ICANN: true},
+	{DottedName: "kharkiv.ua", ICANN: true},
+	{DottedName: "kharkov.ua", ICANN: true},
+	{DottedName: "kherson.ua", ICANN: true},
+	{DottedName: "khmelnitskiy.ua", ICANN: true},
+	{DottedName: "khmelnytskyi.ua", ICANN: true},
+	{DottedName: "ki", ICANN: true},
+	{DottedName: "kia", ICANN: true},
+	{DottedName: "kibichuo.okayama.jp", ICANN: true},
+	{DottedName: "kids", ICANN: true},
+	{DottedName: "kids.museum", ICANN: true},
+	{DottedName: "kids.us", ICANN: true},
+	{DottedName: "kiev.ua", ICANN: true},
+	{DottedName: "kiho.mie.jp", ICANN: true},
+	{DottedName: "kihoku.ehime.jp", ICANN: true},
+	{DottedName: "kijo.miyazaki.jp", ICANN: true},
+	{DottedName: "kikonai.hokkaido.jp", ICANN: true},
+	{DottedName: "kikuchi.kumamoto.jp", ICANN: true},
+	{DottedName: "kikugawa.shizuoka.jp", ICANN: true},
+	{DottedName: "kim", ICANN: true},
+	{DottedName: "kimino.wakayama.jp", ICANN: true},
+	{DottedName: "kimitsu.chiba.jp", ICANN: true},
+	{DottedName: "kimobetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "kin.okinawa.jp", ICANN: true},
+	{DottedName: "kinder", ICANN: true},
+	{DottedName: "kindle", ICANN: true},
+	{DottedName: "kinko.kagoshima.jp", ICANN: true},
+	{DottedName: "kinokawa.wakayama.jp", ICANN: true},
+	{DottedName: "kira.aichi.jp", ICANN: true},
+	{DottedName: "kirkenes.no", ICANN: true},
+	{DottedName: "kirovograd.ua", ICANN: true},
+	{DottedName: "kiryu.gunma.jp", ICANN: true},
+	{DottedName: "kisarazu.chiba.jp", ICANN: true},
+	{DottedName: "kishiwada.osaka.jp", ICANN: true},
+	{DottedName: "kiso.nagano.jp", ICANN: true},
+	{DottedName: "kisofukushima.nagano.jp", ICANN: true},
+	{DottedName: "kisosaki.mie.jp", ICANN: true},
+	{DottedName: "kita.kyoto.jp", ICANN: true},
+	{DottedName: "kita.osaka.jp", ICANN: true},
+	{DottedName: "kita.tokyo.jp", ICANN: true},
+	{DottedName: "kitaaiki.nagano.jp", ICANN: true},
+	{DottedName: "kitaakita.akita.jp", ICANN: true},
+	{DottedName: "kitadaito.okinawa.jp", ICANN: true},
+	{DottedName: "kitagata.gifu.jp", ICANN: true},
+	{DottedName: "kitagata.saga.jp", ICANN: true},
+	{DottedName: "kitagawa.kochi.jp", ICANN: true},
+	{DottedName: "kitagawa.miyazaki.jp", ICANN: true},
+	{DottedName: "kitahata.saga.jp", ICANN: true},
+	{DottedName: "kitahiroshima.hokkaido.jp", ICANN: true},
+	{DottedName: "kitakami.iwate.jp", ICANN: true},
+	{DottedName: "kitakata.fukushima.jp", ICANN: true},
+	{DottedName: "kitakata.miyazaki.jp", ICANN: true},
+	{DottedName: "kitami.hokkaido.jp", ICANN: true},
+	{DottedName: "kitamoto.saitama.jp", ICANN: true},
+	{DottedName: "kitanakagusuku.okinawa.jp", ICANN: true},
+	{DottedName: "kitashiobara.fukushima.jp", ICANN: true},
+	{DottedName: "kitaura.miyazaki.jp", ICANN: true},
+	{DottedName: "kitayama.wakayama.jp", ICANN: true},
+	{DottedName: "kitchen", ICANN: true},
+	{DottedName: "kiwa.mie.jp", ICANN: true},
+	{DottedName: "kiwi", ICANN: true},
+	{DottedName: "kiwi.nz", ICANN: true},
+	{DottedName: "kiyama.saga.jp", ICANN: true},
+	{DottedName: "kiyokawa.kanagawa.jp", ICANN: true},
+	{DottedName: "kiyosato.hokkaido.jp", ICANN: true},
+	{DottedName: "kiyose.tokyo.jp", ICANN: true},
+	{DottedName: "kiyosu.aichi.jp", ICANN: true},
+	{DottedName: "kizu.kyoto.jp", ICANN: true},
+	{DottedName: "klabu.no", ICANN: true},
+	{DottedName: "klepp.no", ICANN: true},
+	{DottedName: "klodzko.pl", ICANN: true},
+	{DottedName: "km", ICANN: true},
+	{DottedName: "km.ua", ICANN: true},
+	{DottedName: "kmpsp.gov.pl", ICANN: true},
+	{DottedName: "kn", ICANN: true},
+	{DottedName: "kobayashi.miyazaki.jp", ICANN: true},
+	{DottedName: "kobierzyce.pl", ICANN: true},
+	{DottedName: "kochi.jp", ICANN: true},
+	{DottedName: "kochi.kochi.jp", ICANN: true},
+	{DottedName: "kodaira.tokyo.jp", ICANN: true},
+	{DottedName: "koebenhavn.museum", ICANN: true},
+	{DottedName: "koeln", ICANN: true},
+	{DottedName: "koeln.museum", ICANN: true},
+	{DottedName: "kofu.yamanashi.jp", ICANN: true},
+	{DottedName: "koga.fukuoka.jp", ICANN: true},
+	{DottedName: "koga.ibaraki.jp", ICANN: true},
+	{DottedName: "koganei.tokyo.jp", ICANN: true},
+	{DottedName: "koge.tottori.jp", ICANN: true},
+	{DottedName: "koka.shiga.jp", ICANN: true},
+	{DottedName: "kokonoe.oita.jp", ICANN: true},
+	{DottedName: "kokubunji.tokyo.jp", ICANN: true},
+	{DottedName: "kolobrzeg.pl", ICANN: true},
+	{DottedName: "komae.tokyo.jp", ICANN: true},
+	{DottedName: "komagane.nagano.jp", ICANN: true},
+	{DottedName: "komaki.aichi.jp", ICANN: true},
+	{DottedName: "komatsu", ICANN: true},
+	{DottedName: "komatsu.ishikawa.jp", ICANN: true},
+	{DottedName: "komatsushima.tokushima.jp", ICANN: true},
+	{DottedName: "komforb.se", ICANN: true},
+	{DottedName: "kommunalforbund.se", ICANN: true},
+	{DottedName: "kommune.no", ICANN: true},
+	{DottedName: "komono.mie.jp", ICANN: true},
+	{DottedName: "komoro.nagano.jp", ICANN: true},
+	{DottedName: "komvux.se", ICANN: true},
+	{DottedName: "konan.aichi.jp", ICANN: true},
+	{DottedName: "konan.shiga.jp", ICANN: true},
+	{DottedName: "kongsberg.no", ICANN: true},
+	{DottedName: "kongsvinger.no", ICANN: true},
+	{DottedName: "konin.pl", ICANN: true},
+	{DottedName: "konskowola.pl", ICANN: true},
+	{DottedName: "konsulat.gov.pl", ICANN: true},
+	{DottedName: "konyvelo.hu", ICANN: true},
+	{DottedName: "koori.fukushima.jp", ICANN: true},
+	{DottedName: "kopervik.no", ICANN: true},
+	{DottedName: "koriyama.fukushima.jp", ICANN: true},
+	{DottedName: "koryo.nara.jp", ICANN: true},
+	{DottedName: "kosai.shizuoka.jp", ICANN: true},
+	{DottedName: "kosaka.akita.jp", ICANN: true},
+	{DottedName: "kosei.shiga.jp", ICANN: true},
+	{DottedName: "kosher", ICANN: true},
+	{DottedName: "koshigaya.saitama.jp", ICANN: true},
+	{DottedName: "koshimizu.hokkaido.jp", ICANN: true},
+	{DottedName: "koshu.yamanashi.jp", ICANN: true},
+	{DottedName: "kosuge.yamanashi.jp", ICANN: true},
+	{DottedName: "kota.aichi.jp", ICANN: true},
+	{DottedName: "koto.shiga.jp", ICANN: true},
+	{DottedName: "koto.tokyo.jp", ICANN: true},
+	{DottedName: "kotohira.kagawa.jp", ICANN: true},
+	{DottedName: "kotoura.tottori.jp", ICANN: true},
+	{DottedName: "kouhoku.saga.jp", ICANN: true},
+	{DottedName: "kounosu.saitama.jp", ICANN: true},
+	{DottedName: "kouyama.kagoshima.jp", ICANN: true},
+	{DottedName: "kouzushima.tokyo.jp", ICANN: true},
+	{DottedName: "koya.wakayama.jp", ICANN: true},
+	{DottedName: "koza.wakayama.jp", ICANN: true},
+	{DottedName: "kozagawa.wakayama.jp", ICANN: true},
+	{DottedName: "kozaki.chiba.jp", ICANN: true},
+	{DottedName: "kp", ICANN: true},
+	{DottedName: "kpmg", ICANN: true},
+	{DottedName: "kpn", ICANN: true},
+	{DottedName: "kppsp.gov.pl", ICANN: true},
+	{DottedName: "kr", ICANN: true},
+	{DottedName: "kr.it", ICANN: true},
+	{DottedName: "kr.ua", ICANN: true},
+	{DottedName: "kraanghke.no", ICANN: true},
+	{DottedName: "kragero.no", ICANN: true},
+	{DottedName: "krd", ICANN: true},
+	{DottedName: "kred", ICANN: true},
+	{DottedName: "kristiansand.no", ICANN: true},
+	{DottedName: "kristiansund.no", ICANN: true},
+	{DottedName: "krodsherad.no", ICANN: true},
+	{DottedName: "krokstadelva.no", ICANN: true},
+	{DottedName: "krym.ua", ICANN: true},
+	{DottedName: "ks.ua", ICANN: true},
+	{DottedName: "ks.us", ICANN: true},
+	{DottedName: "kuchinotsu.nagasaki.jp", ICANN: true},
+	{DottedName: "kudamatsu.yamaguchi.jp", ICANN: true},
+	{DottedName: "kudoyama.wakayama.jp", ICANN: true},
+	{DottedName: "kui.hiroshima.jp", ICANN: true},
+	{DottedName: "kuji.iwate.jp", ICANN: true},
+	{DottedName: "kuju.oita.jp", ICANN: true},
+	{DottedName: "kujukuri.chiba.jp", ICANN: true},
+	{DottedName: "kuki.saitama.jp", ICANN: true},
+	{DottedName: "kumagaya.saitama.jp", ICANN: true},
+	{DottedName: "kumakogen.ehime.jp", ICANN: true},
+	{DottedName: "kumamoto.jp", ICANN: true},
+	{DottedName: "kumamoto.kumamoto.jp", ICANN: true},
+	{DottedName: "kumano.hiroshima.jp", ICANN: true},
+	{DottedName: "kumano.mie.jp", ICANN: true},
+	{DottedName: "kumatori.osaka.jp", ICANN: true},
+	{DottedName: "kumejima.okinawa.jp", ICANN: true},
+	{DottedName: "kumenan.okayama.jp", ICANN: true},
+	{DottedName: "kumiyama.kyoto.jp", ICANN: true},
+	{DottedName: "kunigami.okinawa.jp", ICANN: true},
+	{DottedName: "kunimi.fukushima.jp", ICANN: true},
+	{DottedName: "kunisaki.oita.jp", ICANN: true},
+	{DottedName: "kunitachi.tokyo.jp", ICANN: true},
+	{DottedName: "kunitomi.miyazaki.jp", ICANN: true},
+	{DottedName: "kunneppu.hokkaido.jp", ICANN: true},
+	{DottedName: "kunohe.iwate.jp", ICANN: true},
+	{DottedName: "kunst.museum", ICANN: true},
+	{DottedName: "kunstsammlung.museum", ICANN: true},
+	{DottedName: "kunstunddesign.museum", ICANN: true},
+	{DottedName: "kuokgroup", ICANN: true},
+	{DottedName: "kurashiki.okayama.jp", ICANN: true},
+	{DottedName: "kurate.fukuoka.jp", ICANN: true},
+	{DottedName: "kure.hiroshima.jp", ICANN: true},
+	{DottedName: "kuriyama.hokkaido.jp", ICANN: true},
+	{DottedName: "kurobe.toyama.jp", ICANN: true},
+	{DottedName: "kurogi.fukuoka.jp", ICANN: true},
+	{DottedName: "kuroishi.aomori.jp", ICANN: true},
+	{DottedName: "kuroiso.tochigi.jp", ICANN: true},
+	{DottedName: "kuromatsunai.hokkaido.jp", ICANN: true},
+	{DottedName: "kurotaki.nara.jp", ICANN: true},
+	{DottedName: "kurume.fukuoka.jp", ICANN: true},
+	{DottedName: "kusatsu.gunma.jp", ICANN: true},
+	{DottedName: "kusatsu.shiga.jp", ICANN: true},
+	{DottedName: "kushima.miyazaki.jp", ICANN: true},
+	{DottedName: "kushimoto.wakayama.jp", ICANN: true},
+	{DottedName: "kushiro.hokkaido.jp", ICANN: true},
+	{DottedName: "kusu.oita.jp", ICANN: true},
+	{DottedName: "kutchan.hokkaido.jp", ICANN: true},
+	{DottedName: "kutno.pl", ICANN: true},
+	{DottedName: "kuwana.mie.jp", ICANN: true},
+	{DottedName: "kuzumaki.iwate.jp", ICANN: true},
+	{DottedName: "kv.ua", ICANN: true},
+	{DottedName: "kvafjord.no", ICANN: true},
+	{DottedName: "kvalsund.no", ICANN: true},
+	{DottedName: "kvam.no", ICANN: true},
+	{DottedName: "kvanangen.no", ICANN: true},
+	{DottedName: "kvinesdal.no", ICANN: true},
+	{DottedName: "kvinnherad.no", ICANN: true},
+	{DottedName: "kviteseid.no", ICANN: true},
+	{DottedName: "kvitsoy.no", ICANN: true},
+	{DottedName: "kw", ICANN: true},
+	{DottedName: "kwp.gov.pl", ICANN: true},
+	{DottedName: "kwpsp.gov.pl", ICANN: true},
+	{DottedName: "ky", ICANN: true},
+	{DottedName: "ky.us", ICANN: true},
+	{DottedName: "kyiv.ua", ICANN: true},
+	{DottedName: "kyonan.chiba.jp", ICANN: true},
+	{DottedName: "kyotamba.kyoto.jp", ICANN: true},
+	{DottedName: "kyotanabe.kyoto.jp", ICANN: true},
+	{DottedName: "kyotango.kyoto.jp", ICANN: true},
+	{DottedName: "kyoto", ICANN: true},
+	{DottedName: "kyoto.jp", ICANN: true},
+	{DottedName: "kyowa.akita.jp", ICANN: true},
+	{DottedName: "kyowa.hokkaido.jp", ICANN: true},
+	{DottedName: "kyuragi.saga.jp", ICANN: true},
+	{DottedName: "kz", ICANN: true},
+	{DottedName: "l.bg", ICANN: true},
+	{DottedName: "l.se", ICANN: true},
+	{DottedName: "la", ICANN: true},
+	{DottedName: "la-spezia.it", ICANN: true},
+	{DottedName: "la.us", ICANN: true},
+	{DottedName: "laakesvuemie.no", ICANN: true},
+	{DottedName: "labor.museum", ICANN: true},
+	{DottedName: "labour.museum", ICANN: true},
+	{DottedName: "lacaixa", ICANN: true},
+	{DottedName: "lahppi.no", ICANN: true},
+	{DottedName: "lajolla.museum", ICANN: true},
+	{DottedName: "lakas.hu", ICANN: true},
+	{DottedName: "lamborghini", ICANN: true},
+	{DottedName: "lamer", ICANN: true},
+	{DottedName: "lanbib.se", ICANN: true},
+	{DottedName: "lancashire.museum", ICANN: true},
+	{DottedName: "lancaster", ICANN: true},
+	{DottedName: "lancia", ICANN: true},
+	{DottedName: "land", ICANN: true},
+	{DottedName: "landes.museum", ICANN: true},
+	{DottedName: "landrover", ICANN: true},
+	{DottedName: "langevag.no", ICANN: true},
+	{DottedName: "lans.museum", ICANN: true},
+	{DottedName: "lanxess", ICANN: true},
+	{DottedName: "lapy.pl", ICANN: true},
+	{DottedName: "laquila.it", ICANN: true},
+	{DottedName: "lardal.no", ICANN: true},
+	{DottedName: "larsson.museum", ICANN: true},
+	{DottedName: "larvik.no", ICANN: true},
+	{DottedName: "lasalle", ICANN: true},
+	{DottedName: "laspezia.it", ICANN: true},
+	{DottedName: "lat", ICANN: true},
+	{DottedName: "latina.it", ICANN: true},
+	{DottedName: "latino", ICANN: true},
+	{DottedName: "latrobe", ICANN: true},
+	{DottedName: "lavagis.no", ICANN: true},
+	{DottedName: "lavangen.no", ICANN: true},
+	{DottedName: "law", ICANN: true},
+	{DottedName: "law.pro", ICANN: true},
+	{DottedName: "law.za", ICANN: true},
+	{DottedName: "lawyer", ICANN: true},
+	{DottedName: "laz.it", ICANN: true},
+	{DottedName: "lazio.it", ICANN: true},
+	{DottedName: "lb", ICANN: true},
+	{DottedName: "lc", ICANN: true},
+	{DottedName: "lc.it", ICANN: true},
+	{DottedName: "lds", ICANN: true},
+	{DottedName: "le.it", ICANN: true},
+	{DottedName: "leangaviika.no", ICANN: true},
+	{DottedName: "lease", ICANN: true},
+	{DottedName: "leasing.aero", ICANN: true},
+	{DottedName: "lebesby.no", ICANN: true},
+	{DottedName: "lebork.pl", ICANN: true},
+	{DottedName: "lecce.it", ICANN: true},
+	{DottedName: "lecco.it", ICANN: true},
+	{DottedName: "leclerc", ICANN: true},
+	{DottedName: "lefrak", ICANN: true},
+	{DottedName: "leg.br", ICANN: true},
+	{DottedName: "legal", ICANN: true},
+	{DottedName: "legnica.pl", ICANN: true},
+	{DottedName: "lego", ICANN: true},
+	{DottedName: "leikanger.no", ICANN: true},
+	{DottedName: "leirfjord.no", ICANN: true},
+	{DottedName: "leirvik.no", ICANN: true},
+	{DottedName: "leka.no", ICANN: true},
+	{DottedName: "leksvik.no", ICANN: true},
+	{DottedName: "lel.br", ICANN: true},
+	{DottedName: "lenvik.no", ICANN: true},
+	{DottedName: "lerdal.no", ICANN: true},
+	{DottedName: "lesja.no", ICANN: true},
+	{DottedName: "levanger.no", ICANN: true},
+	{DottedName: "lewismiller.museum", ICANN: true},
+	{DottedName: "lexus", ICANN: true},
+	{DottedName: "lezajsk.pl", ICANN: true},
+	{DottedName: "lg.jp", ICANN: true},
+	{DottedName: "lg.ua", ICANN: true},
+	{DottedName: "lgbt", ICANN: true},
+	{DottedName: "li", ICANN: true},
+	{DottedName: "li.it", ICANN: true},
+	{DottedName: "lib.ak.us", ICANN: true},
+	{DottedName: "lib.al.us", ICANN: true},
+	{DottedName: "lib.ar.us", ICANN: true},
+	{DottedName: "lib.as.us", ICANN: true},
+	{DottedName: "lib.az.us", ICANN: true},
+	{DottedName: "lib.ca.us", ICANN: true},
+	{DottedName: "lib.co.us", ICANN: true},
+	{DottedName: "lib.ct.us", ICANN: true},
+	{DottedName: "lib.dc.us", ICANN: true},
+	{DottedName: "lib.ee", ICANN: true},
+	{DottedName: "lib.fl.us", ICANN: true},
+	{DottedName: "lib.ga.us", ICANN: true},
+	{DottedName: "lib.gu.us", ICANN: true},
+	{DottedName: "lib.hi.us", ICANN: true},
+	{DottedName: "lib.ia.us", ICANN: true},
+	{DottedName: "lib.id.us", ICANN: true},
+	{DottedName: "lib.il.us", ICANN: true},
+	{DottedName: "lib.in.us", ICANN: true},
+	{DottedName: "lib.ks.us", ICANN: true},
+	{DottedName: "lib.ky.us", ICANN: true},
+	{DottedName: "lib.la.us", ICANN: true},
+	{DottedName: "lib.ma.us", ICANN: true},
+	{DottedName: "lib.md.us", ICANN: true},
+	{DottedName: "lib.me.us", ICANN: true},
+	{DottedName: "lib.mi.us", ICANN: true},
+	{DottedName: "lib.mn.us", ICANN: true},
+	{DottedName: "lib.mo.us", ICANN: true},
+	{DottedName: "lib.ms.us", ICANN: true},
+	{DottedName: "lib.mt.us", ICANN: true},
+	{DottedName: "lib.nc.us", ICANN: true},
+	{DottedName: "lib.nd.us", ICANN: true},
+	{DottedName: "lib.ne.us", ICANN: true},
+	{DottedName: "lib.nh.us", ICANN: true},
+	{DottedName: "lib.nj.us", ICANN: true},
+	{DottedName: "lib.nm.us", ICANN: true},
+	{DottedName: "lib.nv.us", ICANN: true},
+	{DottedName: "lib.ny.us", ICANN: true},
+	{DottedName: "lib.oh.us", ICANN: true},
+	{DottedName: "lib.ok.us", ICANN: true},
+	{DottedName: "lib.or.us", ICANN: true},
+	{DottedName: "lib.pa.us", ICANN: true},
+	{DottedName: "lib.pr.us", ICANN: true},
+	{DottedName: "lib.ri.us", ICANN: true},
+	{DottedName: "lib.sc.us", ICANN: true},
+	{DottedName: "lib.sd.us", ICANN: true},
+	{DottedName: "lib.tn.us", ICANN: true},
+	{DottedName: "lib.tx.us", ICANN: true},
+	{DottedName: "lib.ut.us", ICANN: true},
+	{DottedName: "lib.va.us", ICANN: true},
+	{DottedName: "lib.vi.us", ICANN: true},
+	{DottedName: "lib.vt.us", ICANN: true},
+	{DottedName: "lib.wa.us", ICANN: true},
+	{DottedName: "lib.wi.us", ICANN: true},
+	{DottedName: "lib.wy.us", ICANN: true},
+	{DottedName: "lidl", ICANN: true},
+	{DottedName: "lier.no", ICANN: true},
+	{DottedName: "lierne.no", ICANN: true},
+	{DottedName: "life", ICANN: true},
+	{DottedName: "lifeinsurance", ICANN: true},
+	{DottedName: "lifestyle", ICANN: true},
+	{DottedName: "lig.it", ICANN: true},
+	{DottedName: "lighting", ICANN: true},
+	{DottedName: "liguria.it", ICANN: true},
+	{DottedName: "like", ICANN: true},
+	{DottedName: "lillehammer.no", ICANN: true},
+	{DottedName: "lillesand.no", ICANN: true},
+	{DottedName: "lilly", ICANN: true},
+	{DottedName: "limanowa.pl", ICANN: true},
+	{DottedName: "limited", ICANN: true},
+	{DottedName: "limo", ICANN: true},
+	{DottedName: "lincoln", ICANN: true},
+	{DottedName: "lincoln.museum", ICANN: true},
+	{DottedName: "lindas.no", ICANN: true},
+	{DottedName: "linde", ICANN: true},
+	{DottedName: "lindesnes.no", ICANN: true},
+	{DottedName: "link", ICANN: true},
+	{DottedName: "linz.museum", ICANN: true},
+	{DottedName: "lipsy", ICANN: true},
+	{DottedName: "live", ICANN: true},
+	{DottedName: "living", ICANN: true},
+	{DottedName: "living.museum", ICANN: true},
+	{DottedName: "livinghistory.museum", ICANN: true},
+	{DottedName: "livorno.it", ICANN: true},
+	{DottedName: "lixil", ICANN: true},
+	{DottedName: "lk", ICANN: true},
+	{DottedName: "llc", ICANN: true},
+	{DottedName: "llp", ICANN: true},
+	{DottedName: "ln.cn", ICANN: true},
+	{DottedName: "lo.it", ICANN: true},
+	{DottedName: "loabat.no", ICANN: true},
+	{DottedName: "loan", ICANN: true},
+	{DottedName: "loans", ICANN: true},
+	{DottedName: "localhistory.museum", ICANN: true},
+	{DottedName: "locker", ICANN: true},
+	{DottedName: "locus", ICANN: true},
+	{DottedName: "lodi.it", ICANN: true},
+	{DottedName: "lodingen.no", ICANN: true},
+	{DottedName: "loft", ICANN: true},
+	{DottedName: "log.br", ICANN: true},
+	{DottedName: "logistics.aero", ICANN: true},
+	{DottedName: "lol", ICANN: true},
+	{DottedName: "lom.it", ICANN: true},
+	{DottedName: "lom.no", ICANN: true},
+	{DottedName: "lombardia.it", ICANN: true},
+	{DottedName: "lombardy.it", ICANN: true},
+	{DottedName: "lomza.pl", ICANN: true},
+	{DottedName: "london", ICANN: true},
+	{DottedName: "london.museum", ICANN: true},
+	{DottedName: "londrina.br", ICANN: true},
+	{DottedName: "loppa.no", ICANN: true},
+	{DottedName: "lorenskog.no", ICANN: true},
+	{DottedName: "losangeles.museum", ICANN: true},
+	{DottedName: "loten.no", ICANN: true},
+	{DottedName: "lotte", ICANN: true},
+	{DottedName: "lotto", ICANN: true},
+	{DottedName: "louvre.museum", ICANN: true},
+	{DottedName: "love", ICANN: true},
+	{DottedName: "lowicz.pl", ICANN: true},
+	{DottedName: "loyalist.museum", ICANN: true},
+	{DottedName: "lpl", ICANN: true},
+	{DottedName: "lplfinancial", ICANN: true},
+	{DottedName: "lr", ICANN: true},
+	{DottedName: "ls", ICANN: true},
+	{DottedName: "lt", ICANN: true},
+	{DottedName: "lt.it", ICANN: true},
+	{DottedName: "lt.ua", ICANN: true},
+	{DottedName: "ltd", ICANN: true},
+	{DottedName: "ltd.co.im", ICANN: true},
+	{DottedName: "ltd.cy", ICANN: true},
+	{DottedName: "ltd.gi", ICANN: true},
+	{DottedName: "ltd.lk", ICANN: true},
+	{DottedName: "ltd.uk", ICANN: true},
+	{DottedName: "ltda", ICANN: true},
+	{DottedName: "lu", ICANN: true},
+	{DottedName: "lu.it", ICANN: true},
+	{DottedName: "lubin.pl", ICANN: true},
+	{DottedName: "lucania.it", ICANN: true},
+	{DottedName: "lucca.it", ICANN: true},
+	{DottedName: "lucerne.museum", ICANN: true},
+	{DottedName: "lugansk.ua", ICANN: true},
+	{DottedName: "lukow.pl", ICANN: true},
+	{DottedName: "lund.no", ICANN: true},
+	{DottedName: "lundbeck", ICANN: true},
+	{DottedName: "lunner.no", ICANN: true},
+	{DottedName: "luroy.no", ICANN: true},
+	{DottedName: "luster.no", ICANN: true},
+	{DottedName: "lutsk.ua", ICANN: true},
+	{DottedName: "luxe", ICANN: true},
+	{DottedName: "luxembourg.museum", ICANN: true},
+	{DottedName: "luxury", ICANN: true},
+	{DottedName: "luzern.museum", ICANN: true},
+	{DottedName: "lv", ICANN: true},
+	{DottedName: "lv.ua", ICANN: true},
+	{DottedName: "lviv.ua", ICANN: true},
+	{DottedName: "ly", ICANN: true},
+	{DottedName: "lyngdal.no", ICANN: true},
+	{DottedName: "lyngen.no", ICANN: true},
+	{DottedName: "m.bg", ICANN: true},
+	{DottedName: "m.se", ICANN: true},
+	{DottedName: "ma", ICANN: true},
+	{DottedName: "ma.gov.br", ICANN: true},
+	{DottedName: "ma.us", ICANN: true},
+	{DottedName: "macapa.br", ICANN: true},
+	{DottedName: "maceio.br", ICANN: true},
+	{DottedName: "macerata.it", ICANN: true},
+	{DottedName: "machida.tokyo.jp", ICANN: true},
+	{DottedName: "macys", ICANN: true},
+	{DottedName: "mad.museum", ICANN: true},
+	{DottedName: "madrid", ICANN: true},
+	{DottedName: "madrid.museum", ICANN: true},
+	{DottedName: "maebashi.gunma.jp", ICANN: true},
+	{DottedName: "magazine.aero", ICANN: true},
+	{DottedName: "maibara.shiga.jp", ICANN: true},
+	{DottedName: "maif", ICANN: true},
+	{DottedName: "mail.pl", ICANN: true},
+	{DottedName: "maintenance.aero", ICANN: true},
+	{DottedName: "maison", ICANN: true},
+	{DottedName: "maizuru.kyoto.jp", ICANN: true},
+	{DottedName: "makeup", ICANN: true},
+	{DottedName: "makinohara.shizuoka.jp", ICANN: true},
+	{DottedName: "makurazaki.kagoshima.jp", ICANN: true},
+	{DottedName: "malatvuopmi.no", ICANN: true},
+	{DottedName: "malbork.pl", ICANN: true},
+	{DottedName: "mallorca.museum", ICANN: true},
+	{DottedName: "malopolska.pl", ICANN: true},
+	{DottedName: "malselv.no", ICANN: true},
+	{DottedName: "malvik.no", ICANN: true},
+	{DottedName: "mamurogawa.yamagata.jp", ICANN: true},
+	{DottedName: "man", ICANN: true},
+	{DottedName: "management", ICANN: true},
+	{DottedName: "manaus.br", ICANN: true},
+	{DottedName: "manchester.museum", ICANN: true},
+	{DottedName: "mandal.no", ICANN: true},
+	{DottedName: "mango", ICANN: true},
+	{DottedName: "maniwa.okayama.jp", ICANN: true},
+	{DottedName: "manno.kagawa.jp", ICANN: true},
+	{DottedName: "mansion.museum", ICANN: true},
+	{DottedName: "mansions.museum", ICANN: true},
+	{DottedName: "mantova.it", ICANN: true},
+	{DottedName: "manx.museum", ICANN: true},
+	{DottedName: "maori.nz", ICANN: true},
+	{DottedName: "map", ICANN: true},
+	{DottedName: "mar.it", ICANN: true},
+	{DottedName: "marburg.museum", ICANN: true},
+	{DottedName: "marche.it", ICANN: true},
+	{DottedName: "maringa.br", ICANN: true},
+	{DottedName: "maritime.museum", ICANN: true},
+	{DottedName: "maritimo.museum", ICANN: true},
+	{DottedName: "marker.no", ICANN: true},
+	{DottedName: "market", ICANN: true},
+	{DottedName: "marketing", ICANN: true},
+	{DottedName: "markets", ICANN: true},
+	{DottedName: "marnardal.no", ICANN: true},
+	{DottedName: "marriott", ICANN: true},
+	{DottedName: "marshalls", ICANN: true},
+	{DottedName: "marugame.kagawa.jp", ICANN: true},
+	{DottedName: "marumori.miyagi.jp", ICANN: true},
+	{DottedName: "maryland.museum", ICANN: true},
+	{DottedName: "marylhurst.museum", ICANN: true},
+	{DottedName: "masaki.ehime.jp", ICANN: true},
+	{DottedName: "maserati", ICANN: true},
+	{DottedName: "masfjorden.no", ICANN: true},
+	{DottedName: "mashike.hokkaido.jp", ICANN: true},
+	{DottedName: "mashiki.kumamoto.jp", ICANN: true},
+	{DottedName: "mashiko.tochigi.jp", ICANN: true},
+	{DottedName: "masoy.no", ICANN: true},
+	{DottedName: "massa-carrara.it", ICANN: true},
+	{DottedName: "massacarrara.it", ICANN: true},
+	{DottedName: "masuda.shimane.jp", ICANN: true},
+	{DottedName: "mat.br", ICANN: true},
+	{DottedName: "matera.it", ICANN: true},
+	{DottedName: "matsubara.osaka.jp", ICANN: true},
+	{DottedName: "matsubushi.saitama.jp", ICANN: true},
+	{DottedName: "matsuda.kanagawa.jp", ICANN: true},
+	{DottedName: "matsudo.chiba.jp", ICANN: true},
+	{DottedName: "matsue.shimane.jp", ICANN: true},
+	{DottedName: "matsukawa.nagano.jp", ICANN: true},
+	{DottedName: "matsumae.hokkaido.jp", ICANN: true},
+	{DottedName: "matsumoto.kagoshima.jp", ICANN: true},
+	{DottedName: "matsumoto.nagano.jp", ICANN: true},
+	{DottedName: "matsuno.ehime.jp", ICANN: true},
+	{DottedName: "matsusaka.mie.jp", ICANN: true},
+	{DottedName: "matsushige.tokushima.jp", ICANN: true},
+	{DottedName: "matsushima.miyagi.jp", ICANN: true},
+	{DottedName: "matsuura.nagasaki.jp", ICANN: true},
+	{DottedName: "matsuyama.ehime.jp", ICANN: true},
+	{DottedName: "matsuzaki.shizuoka.jp", ICANN: true},
+	{DottedName: "matta-varjjat.no", ICANN: true},
+	{DottedName: "mattel", ICANN: true},
+	{DottedName: "mazowsze.pl", ICANN: true},
+	{DottedName: "mazury.pl", ICANN: true},
+	{DottedName: "mb.ca", ICANN: true},
+	{DottedName: "mb.it", ICANN: true},
+	{DottedName: "mba", ICANN: true},
+	{DottedName: "mc", ICANN: true},
+	{DottedName: "mc.it", ICANN: true},
+	{DottedName: "mckinsey", ICANN: true},
+	{DottedName: "md", ICANN: true},
+	{DottedName: "md.ci", ICANN: true},
+	{DottedName: "md.us", ICANN: true},
+	{DottedName: "me", ICANN: true},
+	{DottedName: "me.it", ICANN: true},
+	{DottedName: "me.ke", ICANN: true},
+	{DottedName: "me.so", ICANN: true},
+	{DottedName: "me.ss", ICANN: true},
+	{DottedName: "me.tz", ICANN: true},
+	{DottedName: "me.uk", ICANN: true},
+	{DottedName: "me.us", ICANN: true},
+	{DottedName: "med", ICANN: true},
+	{DottedName: "med.br", ICANN: true},
+	{DottedName: "med.ec", ICANN: true},
+	{DottedName: "med.ee", ICANN: true},
+	{DottedName: "med.ht", ICANN: true},
+	{DottedName: "med.ly", ICANN: true},
+	{DottedName: "med.om", ICANN: true},
+	{DottedName: "med.pa", ICANN: true},
+	{DottedName: "med.pro", ICANN: true},
+	{DottedName: "med.sa", ICANN: true},
+	{DottedName: "med.sd", ICANN: true},
+	{DottedName: "medecin.fr", ICANN: true},
+	{DottedName: "medecin.km", ICANN: true},
+	{DottedName: "media", ICANN: true},
+	{DottedName: "media.aero", ICANN: true},
+	{DottedName: "media.hu", ICANN: true},
+	{DottedName: "media.museum", ICANN: true},
+	{DottedName: "media.pl", ICANN: true},
+	{DottedName: "medical.museum", ICANN: true},
+	{DottedName: "medicina.bo", ICANN: true},
+	{DottedName: "medio-campidano.it", ICANN: true},
+	{DottedName: "mediocampidano.it", ICANN: true},
+	{DottedName: "medizinhistorisches.museum", ICANN: true},
+	{DottedName: "meeres.museum", ICANN: true},
+	{DottedName: "meet", ICANN: true},
+	{DottedName: "meguro.tokyo.jp", ICANN: true},
+	{DottedName: "meiwa.gunma.jp", ICANN: true},
+	{DottedName: "meiwa.mie.jp", ICANN: true},
+	{DottedName: "meland.no", ICANN: true},
+	{DottedName: "melbourne", ICANN: true},
+	{DottedName: "meldal.no", ICANN: true},
+	{DottedName: "melhus.no", ICANN: true},
+	{DottedName: "meloy.no", ICANN: true},
+	{DottedName: "meme", ICANN: true},
+	{DottedName: "memorial", ICANN: true},
+	{DottedName: "memorial.museum", ICANN: true},
+	{DottedName: "men", ICANN: true},
+	{DottedName: "menu", ICANN: true},
+	{DottedName: "meraker.no", ICANN: true},
+	{DottedName: "merckmsd", ICANN: true},
+	{DottedName: "mesaverde.museum", ICANN: true},
+	{DottedName: "messina.it", ICANN: true},
+	{DottedName: "mg", ICANN: true},
+	{DottedName: "mg.gov.br", ICANN: true},
+	{DottedName: "mh", ICANN: true},
+	{DottedName: "mi.it", ICANN: true},
+	{DottedName: "mi.th", ICANN: true},
+	{DottedName: "mi.us", ICANN: true},
+	{DottedName: "miami", ICANN: true},
+	{DottedName: "miasa.nagano.jp", ICANN: true},
+	{DottedName: "miasta.pl", ICANN: true},
+	{DottedName: "mibu.tochigi.jp", ICANN: true},
+	{DottedName: "michigan.museum", ICANN: true},
+	{DottedName: "microlight.aero", ICANN: true},
+	{DottedName: "microsoft", ICANN: true},
+	{DottedName: "midatlantic.museum", ICANN: true},
+	{DottedName: "midori.chiba.jp", ICANN: true},
+	{DottedName: "midori.gunma.jp", ICANN: true},
+	{DottedName: "midsund.no", ICANN: true},
+	{DottedName: "midtre-gauldal.no", ICANN: true},
+	{DottedName: "mie.jp", ICANN: true},
+	{DottedName: "mielec.pl", ICANN: true},
+	{DottedName: "mielno.pl", ICANN: true},
+	{DottedName: "mifune.kumamoto.jp", ICANN: true},
+	{DottedName: "mihama.aichi.jp", ICANN: true},
+	{DottedName: "mihama.chiba.jp", ICANN: true},
+	{DottedName: "mihama.fukui.jp", ICANN: true},
+	{DottedName: "mihama.mie.jp", ICANN: true},
+	{DottedName: "mihama.wakayama.jp", ICANN: true},
+	{DottedName: "mihara.hiroshima.jp", ICANN: true},
+	{DottedName: "mihara.kochi.jp", ICANN: true},
+	{DottedName: "miharu.fukushima.jp", ICANN: true},
+	{DottedName: "miho.ibaraki.jp", ICANN: true},
+	{DottedName: "mikasa.hokkaido.jp", ICANN: true},
+	{DottedName: "mikawa.yamagata.jp", ICANN: true},
+	{DottedName: "miki.hyogo.jp", ICANN: true},
+	{DottedName: "mil", ICANN: true},
+	{DottedName: "mil.ac", ICANN: true},
+	{DottedName: "mil.ae", ICANN: true},
+	{DottedName: "mil.al", ICANN: true},
+	{DottedName: "mil.ar", ICANN: true},
+	{DottedName: "mil.az", ICANN: true},
+	{DottedName: "mil.ba", ICANN: true},
+	{DottedName: "mil.bo", ICANN: true},
+	{DottedName: "mil.br", ICANN: true},
+	{DottedName: "mil.by", ICANN: true},
+	{DottedName: "mil.cl", ICANN: true},
+	{DottedName: "mil.cn", ICANN: true},
+	{DottedName: "mil.co", ICANN: true},
+	{DottedName: "mil.do", ICANN: true},
+	{DottedName: "mil.ec", ICANN: true},
+	{DottedName: "mil.eg", ICANN: true},
+	{DottedName: "mil.fj", ICANN: true},
+	{DottedName: "mil.ge", ICANN: true},
+	{DottedName: "mil.gh", ICANN: true},
+	{DottedName: "mil.gt", ICANN: true},
+	{DottedName: "mil.hn", ICANN: true},
+	{DottedName: "mil.id", ICANN: true},
+	{DottedName: "mil.in", ICANN: true},
+	{DottedName: "mil.iq", ICANN: true},
+	{DottedName: "mil.jo", ICANN: true},
+	{DottedName: "mil.kg", ICANN: true},
+	{DottedName: "mil.km", ICANN: true},
+	{DottedName: "mil.kr", ICANN: true},
+	{DottedName: "mil.kz", ICANN: true},
+	{DottedName: "mil.lv", ICANN: true},
+	{DottedName: "mil.mg", ICANN: true},
+	{DottedName: "mil.mv", ICANN: true},
+	{DottedName: "mil.my", ICANN: true},
+	{DottedName: "mil.mz", ICANN: true},
+	{DottedName: "mil.ng", ICANN: true},
+	{DottedName: "mil.ni", ICANN: true},
+	{DottedName: "mil.no", ICANN: true},
+	{DottedName: "mil.nz", ICANN: true},
+	{DottedName: "mil.pe", ICANN: true},
+	{DottedName: "mil.ph", ICANN: true},
+	{DottedName: "mil.pl", ICANN: true},
+	{DottedName: "mil.py", ICANN: true},
+	{DottedName: "mil.qa", ICANN: true},
+	{DottedName: "mil.rw", ICANN: true},
+	{DottedName: "mil.sh", ICANN: true},
+	{DottedName: "mil.st", ICANN: true},
+	{DottedName: "mil.sy", ICANN: true},
+	{DottedName: "mil.tj", ICANN: true},
+	{DottedName: "mil.tm", ICANN: true},
+	{DottedName: "mil.to", ICANN: true},
+	{DottedName: "mil.tr", ICANN: true},
+	{DottedName: "mil.tw", ICANN: true},
+	{DottedName: "mil.tz", ICANN: true},
+	{DottedName: "mil.uy", ICANN: true},
+	{DottedName: "mil.vc", ICANN: true},
+	{DottedName: "mil.ve", ICANN: true},
+	{DottedName: "mil.ye", ICANN: true},
+	{DottedName: "mil.za", ICANN: true},
+	{DottedName: "mil.zm", ICANN: true},
+	{DottedName: "mil.zw", ICANN: true},
+	{DottedName: "milan.it", ICANN: true},
+	{DottedName: "milano.it", ICANN: true},
+	{DottedName: "military.museum", ICANN: true},
+	{DottedName: "mill.museum", ICANN: true},
+	{DottedName: "mima.tokushima.jp", ICANN: true},
+	{DottedName: "mimata.miyazaki.jp", ICANN: true},
+	{DottedName: "minakami.gunma.jp", ICANN: true},
+	{DottedName: "minamata.kumamoto.jp", ICANN: true},
+	{DottedName: "minami-alps.yamanashi.jp", ICANN: true},
+	{DottedName: "minami.fukuoka.jp", ICANN: true},
+	{DottedName: "minami.kyoto.jp", ICANN: true},
+	{DottedName: "minami.tokushima.jp", ICANN: true},
+	{DottedName: "minamiaiki.nagano.jp", ICANN: true},
+	{DottedName: "minamiashigara.kanagawa.jp", ICANN: true},
+	{DottedName: "minamiawaji.hyogo.jp", ICANN: true},
+	{DottedName: "minamiboso.chiba.jp", ICANN: true},
+	{DottedName: "minamidaito.okinawa.jp", ICANN: true},
+	{DottedName: "minamiechizen.fukui.jp", ICANN: true},
+	{DottedName: "minamifurano.hokkaido.jp", ICANN: true},
+	{DottedName: "minamiise.mie.jp", ICANN: true},
+	{DottedName: "minamiizu.shizuoka.jp", ICANN: true},
+	{DottedName: "minamimaki.nagano.jp", ICANN: true},
+	{DottedName: "minamiminowa.nagano.jp", ICANN: true},
+	{DottedName: "minamioguni.kumamoto.jp", ICANN: true},
+	{DottedName: "minamisanriku.miyagi.jp", ICANN: true},
+	{DottedName: "minamitane.kagoshima.jp", ICANN: true},
+	{DottedName: "minamiuonuma.niigata.jp", ICANN: true},
+	{DottedName: "minamiyamashiro.kyoto.jp", ICANN: true},
+	{DottedName: "minano.saitama.jp", ICANN: true},
+	{DottedName: "minato.osaka.jp", ICANN: true},
+	{DottedName: "minato.tokyo.jp", ICANN: true},
+	{DottedName: "mincom.tn", ICANN: true},
+	{DottedName: "miners.museum", ICANN: true},
+	{DottedName: "mini", ICANN: true},
+	{DottedName: "mining.museum", ICANN: true},
+	{DottedName: "minnesota.museum", ICANN: true},
+	{DottedName: "mino.gifu.jp", ICANN: true},
+	{DottedName: "minobu.yamanashi.jp", ICANN: true},
+	{DottedName: "minoh.osaka.jp", ICANN: true},
+	{DottedName: "minokamo.gifu.jp", ICANN: true},
+	{DottedName: "minowa.nagano.jp", ICANN: true},
+	{DottedName: "mint", ICANN: true},
+	{DottedName: "misaki.okayama.jp", ICANN: true},
+	{DottedName: "misaki.osaka.jp", ICANN: true},
+	{DottedName: "misasa.tottori.jp", ICANN: true},
+	{DottedName: "misato.akita.jp", ICANN: true},
+	{DottedName: "misato.miyagi.jp", ICANN: true},
+	{DottedName: "misato.saitama.jp", ICANN: true},
+	{DottedName: "misato.shimane.jp", ICANN: true},
+	{DottedName: "misato.wakayama.jp", ICANN: true},
+	{DottedName: "misawa.aomori.jp", ICANN: true},
+	{DottedName: "mishima.fukushima.jp", ICANN: true},
+	{DottedName: "mishima.shizuoka.jp", ICANN: true},
+	{DottedName: "missile.museum", ICANN: true},
+	{DottedName: "missoula.museum", ICANN: true},
+	{DottedName: "misugi.mie.jp", ICANN: true},
+	{DottedName: "mit", ICANN: true},
+	{DottedName: "mitaka.tokyo.jp", ICANN: true},
+	{DottedName: "mitake.gifu.jp", ICANN: true},
+	{DottedName: "mitane.akita.jp", ICANN: true},
+	{DottedName: "mito.ibaraki.jp", ICANN: true},
+	{DottedName: "mitou.yamaguchi.jp", ICANN: true},
+	{DottedName: "mitoyo.kagawa.jp", ICANN: true},
+	{DottedName: "mitsubishi", ICANN: true},
+	{DottedName: "mitsue.nara.jp", ICANN: true},
+	{DottedName: "mitsuke.niigata.jp", ICANN: true},
+	{DottedName: "miura.kanagawa.jp", ICANN: true},
+	{DottedName: "miyada.nagano.jp", ICANN: true},
+	{DottedName: "miyagi.jp", ICANN: true},
+	{DottedName: "miyake.nara.jp", ICANN: true},
+	{DottedName: "miyako.fukuoka.jp", ICANN: true},
+	{DottedName: "miyako.iwate.jp", ICANN: true},
+	{DottedName: "miyakonojo.miyazaki.jp", ICANN: true},
+	{DottedName: "miyama.fukuoka.jp", ICANN: true},
+	{DottedName: "miyama.mie.jp", ICANN: true},
+	{DottedName: "miyashiro.saitama.jp", ICANN: true},
+	{DottedName: "miyawaka.fukuoka.jp", ICANN: true},
+	{DottedName: "miyazaki.jp", ICANN: true},
+	{DottedName: "miyazaki.miyazaki.jp", ICANN: true},
+	{DottedName: "miyazu.kyoto.jp", ICANN: true},
+	{DottedName: "miyoshi.aichi.jp", ICANN: true},
+	{DottedName: "miyoshi.hiroshima.jp", ICANN: true},
+	{DottedName: "miyoshi.saitama.jp", ICANN: true},
+	{DottedName: "miyoshi.tokushima.jp", ICANN: true},
+	{DottedName: "miyota.nagano.jp", ICANN: true},
+	{DottedName: "mizuho.tokyo.jp", ICANN: true},
+	{DottedName: "mizumaki.fukuoka.jp", ICANN: true},
+	{DottedName: "mizunami.gifu.jp", ICANN: true},
+	{DottedName: "mizusawa.iwate.jp", ICANN: true},
+	{DottedName: "mjondalen.no", ICANN: true},
+	{DottedName: "mk", ICANN: true},
+	{DottedName: "mk.ua", ICANN: true},
+	{DottedName: "ml", ICANN: true},
+	{DottedName: "mlb", ICANN: true},
+	{DottedName: "mls", ICANN: true},
+	{DottedName: "mma", ICANN: true},
+	{DottedName: "mn", ICANN: true},
+	{DottedName: "mn.it", ICANN: true},
+	{DottedName: "mn.us", ICANN: true},
+	{DottedName: "mo", ICANN: true},
+	{DottedName: "mo-i-rana.no", ICANN: true},
+	{DottedName: "mo.cn", ICANN: true},
+	{DottedName: "mo.it", ICANN: true},
+	{DottedName: "mo.us", ICANN: true},
+	{DottedName: "moareke.no", ICANN: true},
+	{DottedName: "mobara.chiba.jp", ICANN: true},
+	{DottedName: "mobi", ICANN: true},
+	{DottedName: "mobi.gp", ICANN: true},
+	{DottedName: "mobi.ke", ICANN: true},
+	{DottedName: "mobi.na", ICANN: true},
+	{DottedName: "mobi.ng", ICANN: true},
+	{DottedName: "mobi.tt", ICANN: true},
+	{DottedName: "mobi.tz", ICANN: true},
+	{DottedName: "mobile", ICANN: true},
+	{DottedName: "mochizuki.nagano.jp", ICANN: true},
+	{DottedName: "mod.gi", ICANN: true},
+	{DottedName: "moda", ICANN: true},
+	{DottedName: "modalen.no", ICANN: true},
+	{DottedName: "modelling.aero", ICANN: true},
+	{DottedName: "modena.it", ICANN: true},
+	{DottedName: "modern.museum", ICANN: true},
+	{DottedName: "modum.no", ICANN: true},
+	{DottedName: "moe", ICANN: true},
+	{DottedName: "moi", ICANN: true},
+	{DottedName: "moka.tochigi.jp", ICANN: true},
+	{DottedName: "mol.it", ICANN: true},
+	{DottedName: "molde.no", ICANN: true},
+	{DottedName: "molise.it", ICANN: true},
+	{DottedName: "mom", ICANN: true},
+	{DottedName: "moma.museum", ICANN: true},
+	{DottedName: "mombetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "monash", ICANN: true},
+	{DottedName: "money", ICANN: true},
+	{DottedName: "money.museum", ICANN: true},
+	{DottedName: "monmouth.museum", ICANN: true},
+	{DottedName: "monster", ICANN: true},
+	{DottedName: "monticello.museum", ICANN: true},
+	{DottedName: "montreal.museum", ICANN: true},
+	{DottedName: "monza-brianza.it", ICANN: true},
+	{DottedName: "monza-e-della-brianza.it", ICANN: true},
+	{DottedName: "monza.it", ICANN: true},
+	{DottedName: "monzabrianza.it", ICANN: true},
+	{DottedName: "monzaebrianza.it", ICANN: true},
+	{DottedName: "monzaedellabrianza.it", ICANN: true},
+	{DottedName: "morena.br", ICANN: true},
+	{DottedName: "moriguchi.osaka.jp", ICANN: true},
+	{DottedName: "morimachi.shizuoka.jp", ICANN: true},
+	{DottedName: "morioka.iwate.jp", ICANN: true},
+	{DottedName: "moriya.ibaraki.jp", ICANN: true},
+	{DottedName: "moriyama.shiga.jp", ICANN: true},
+	{DottedName: "moriyoshi.akita.jp", ICANN: true},
+	{DottedName: "mormon", ICANN: true},
+	{DottedName: "morotsuka.miyazaki.jp", ICANN: true},
+	{DottedName: "moroyama.saitama.jp", ICANN: true},
+	{DottedName: "mortgage", ICANN: true},
+	{DottedName: "moscow", ICANN: true},
+	{DottedName: "moscow.museum", ICANN: true},
+	{DottedName: "moseushi.hokkaido.jp", ICANN: true},
+	{DottedName: "mosjoen.no", ICANN: true},
+	{DottedName: "moskenes.no", ICANN: true},
+	{DottedName: "moss.no", ICANN: true},
+	{DottedName: "mosvik.no", ICANN: true},
+	{DottedName: "motegi.tochigi.jp", ICANN: true},
+	{DottedName: "moto", ICANN: true},
+	{DottedName: "motobu.okinawa.jp", ICANN: true},
+	{DottedName: "motorcycle.museum", ICANN: true},
+	{DottedName: "motorcycles", ICANN: true},
+	{DottedName: "motosu.gifu.jp", ICANN: true},
+	{DottedName: "motoyama.kochi.jp", ICANN: true},
+	{DottedName: "mov", ICANN: true},
+	{DottedName: "movie", ICANN: true},
+	{DottedName: "movimiento.bo", ICANN: true},
+	{DottedName: "mp", ICANN: true},
+	{DottedName: "mp.br", ICANN: true},
+	{DottedName: "mq", ICANN: true},
+	{DottedName: "mr", ICANN: true},
+	{DottedName: "mr.no", ICANN: true},
+	{DottedName: "mragowo.pl", ICANN: true},
+	{DottedName: "ms", ICANN: true},
+	{DottedName: "ms.gov.br", ICANN: true},
+	{DottedName: "ms.it", ICANN: true},
+	{DottedName: "ms.kr", ICANN: true},
+	{DottedName: "ms.us", ICANN: true},
+	{DottedName: "msd", ICANN: true},
+	{DottedName: "mt", ICANN: true},
+	{DottedName: "mt.gov.br", ICANN: true},
+	{DottedName: "mt.it", ICANN: true},
+	{DottedName: "mt.us", ICANN: true},
+	{DottedName: "mtn", ICANN: true},
+	{DottedName: "mtr", ICANN: true},
+	{DottedName: "mu", ICANN: true},
+	{DottedName: "muenchen.museum", ICANN: true},
+	{DottedName: "muenster.museum", ICANN: true},
+	{DottedName: "mugi.tokushima.jp", ICANN: true},
+	{DottedName: "muika.niigata.jp", ICANN: true},
+	{DottedName: "mukawa.hokkaido.jp", ICANN: true},
+	{DottedName: "muko.kyoto.jp", ICANN: true},
+	{DottedName: "mulhouse.museum", ICANN: true},
+	{DottedName: "munakata.fukuoka.jp", ICANN: true},
+	{DottedName: "muncie.museum", ICANN: true},
+	{DottedName: "muni.il", ICANN: true},
+	{DottedName: "muosat.no", ICANN: true},
+	{DottedName: "mup.gov.pl", ICANN: true},
+	{DottedName: "murakami.niigata.jp", ICANN: true},
+	{DottedName: "murata.miyagi.jp", ICANN: true},
+	{DottedName: "murayama.yamagata.jp", ICANN: true},
+	{DottedName: "muroran.hokkaido.jp", ICANN: true},
+	{DottedName: "muroto.kochi.jp", ICANN: true},
+	{DottedName: "mus.br", ICANN: true},
+	{DottedName: "mus.mi.us", ICANN: true},
+	{DottedName: "musashimurayama.tokyo.jp", ICANN: true},
+	{DottedName: "musashino.tokyo.jp", ICANN: true},
+	{DottedName: "museet.museum", ICANN: true},
+	{DottedName: "museum", ICANN: true},
+	{DottedName: "museum.mv", ICANN: true},
+	{DottedName: "museum.mw", ICANN: true},
+	{DottedName: "museum.no", ICANN: true},
+	{DottedName: "museum.om", ICANN: true},
+	{DottedName: "museum.tt", ICANN: true},
+	{DottedName: "museumcenter.museum", ICANN: true},
+	{DottedName: "museumvereniging.museum", ICANN: true},
+	{DottedName: "music", ICANN: true},
+	{DottedName: "music.museum", ICANN: true},
+	{DottedName: "musica.ar", ICANN: true},
+	{DottedName: "musica.bo", ICANN: true},
+	{DottedName: "mutsu.aomori.jp", ICANN: true},
+	{DottedName: "mutsuzawa.chiba.jp", ICANN: true},
+	{DottedName: "mutual", ICANN: true},
+	{DottedName: "mutual.ar", ICANN: true},
+	{DottedName: "mv", ICANN: true},
+	{DottedName: "mw", ICANN: true},
+	{DottedName: "mw.gov.pl", ICANN: true},
+	{DottedName: "mx", ICANN: true},
+	{DottedName: "mx.na", ICANN: true},
+	{DottedName: "my", ICANN: true},
+	{DottedName: "my.id", ICANN: true},
+	{DottedName: "mykolaiv.ua", ICANN: true},
+	{DottedName: "myoko.niigata.jp", ICANN: true},
+	{DottedName: "mz", ICANN: true},
+	{DottedName: "n.bg", ICANN: true},
+	{DottedName: "n.se", ICANN: true},
+	{DottedName: "na", ICANN: true},
+	{DottedName: "na.it", ICANN: true},
+	{DottedName: "naamesjevuemie.no", ICANN: true},
+	{DottedName: "nab", ICANN: true},
+	{DottedName: "nabari.mie.jp", ICANN: true},
+	{DottedName: "nachikatsuura.wakayama.jp", ICANN: true},
+	{DottedName: "nagahama.shiga.jp", ICANN: true},
+	{DottedName: "nagai.yamagata.jp", ICANN: true},
+	{DottedName: "nagano.jp", ICANN: true},
+	{DottedName: "nagano.nagano.jp", ICANN: true},
+	{DottedName: "naganohara.gunma.jp", ICANN: true},
+	{DottedName: "nagaoka.niigata.jp", ICANN: true},
+	{DottedName: "nagaokakyo.kyoto.jp", ICANN: true},
+	{DottedName: "nagara.chiba.jp", ICANN: true},
+	{DottedName: "nagareyama.chiba.jp", ICANN: true},
+	{DottedName: "nagasaki.jp", ICANN: true},
+	{DottedName: "nagasaki.nagasaki.jp", ICANN: true},
+	{DottedName: "nagasu.kumamoto.jp", ICANN: true},
+	{DottedName: "nagato.yamaguchi.jp", ICANN: true},
+	{DottedName: "nagatoro.saitama.jp", ICANN: true},
+	{DottedName: "nagawa.nagano.jp", ICANN: true},
+	{DottedName: "nagi.okayama.jp", ICANN: true},
+	{DottedName: "nagiso.nagano.jp", ICANN: true},
+	{DottedName: "nago.okinawa.jp", ICANN: true},
+	{DottedName: "nagoya", ICANN: true},
+	{DottedName: "naha.okinawa.jp", ICANN: true},
+	{DottedName: "nahari.kochi.jp", ICANN: true},
+	{DottedName: "naie.hokkaido.jp", ICANN: true},
+	{DottedName: "naka.hiroshima.jp", ICANN: true},
+	{DottedName: "naka.ibaraki.jp", ICANN: true},
+	{DottedName: "nakadomari.aomori.jp", ICANN: true},
+	{DottedName: "nakagawa.fukuoka.jp", ICANN: true},
+	{DottedName: "nakagawa.hokkaido.jp", ICANN: true},
+	{DottedName: "nakagawa.nagano.jp", ICANN: true},
+	{DottedName: "nakagawa.tokushima.jp", ICANN: true},
+	{DottedName: "nakagusuku.okinawa.jp", ICANN: true},
+	{DottedName: "nakagyo.kyoto.jp", ICANN: true},
+	{DottedName: "nakai.kanagawa.jp", ICANN: true},
+	{DottedName: "nakama.fukuoka.jp", ICANN: true},
+	{DottedName: "nakamichi.yamanashi.jp", ICANN: true},
+	{DottedName: "nakamura.kochi.jp", ICANN: true},
+	{DottedName: "nakaniikawa.toyama.jp", ICANN: true},
+	{DottedName: "nakano.nagano.jp", ICANN: true},
+	{DottedName: "nakano.tokyo.jp", ICANN: true},
+	{DottedName: "nakanojo.gunma.jp", ICANN: true},
+	{DottedName: "nakanoto.ishikawa.jp", ICANN: true},
+	{DottedName: "nakasatsunai.hokkaido.jp", ICANN: true},
+	{DottedName: "nakatane.kagoshima.jp", ICANN: true},
+	{DottedName: "nakatombetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "nakatsugawa.gifu.jp", ICANN: true},
+	{DottedName: "nakayama.yamagata.jp", ICANN: true},
+	{DottedName: "nakijin.okinawa.jp", ICANN: true},
+	{DottedName: "naklo.pl", ICANN: true},
+	{DottedName: "namdalseid.no", ICANN: true},
+	{DottedName: "name", ICANN: true},
+	{DottedName: "name.az", ICANN: true},
+	{DottedName: "name.cy", ICANN: true},
+	{DottedName: "name.eg", ICANN: true},
+	{DottedName: "name.et", ICANN: true},
+	{DottedName: "name.fj", ICANN: true},
+	{DottedName: "name.hr", ICANN: true},
+	{DottedName: "name.jo", ICANN: true},
+	{DottedName: "name.mk", ICANN: true},
+	{DottedName: "name.mv", ICANN: true},
+	{DottedName: "name.my", ICANN: true},
+	{DottedName: "name.na", ICANN: true},
+	{DottedName: "name.ng", ICANN: true},
+	{DottedName: "name.pr", ICANN: true},
+	{DottedName: "name.qa", ICANN: true},
+	{DottedName: "name.tj", ICANN: true},
+	{DottedName: "name.tr", ICANN: true},
+	{DottedName: "name.tt", ICANN: true},
+	{DottedName: "name.vn", ICANN: true},
+	{DottedName: "namegata.ibaraki.jp", ICANN: true},
+	{DottedName: "namegawa.saitama.jp", ICANN: true},
+	{DottedName: "namerikawa.toyama.jp", ICANN: true},
+	{DottedName: "namie.fukushima.jp", ICANN: true},
+	{DottedName: "namikata.ehime.jp", ICANN: true},
+	{DottedName: "namsos.no", ICANN: true},
+	{DottedName: "namsskogan.no", ICANN: true},
+	{DottedName: "nanae.hokkaido.jp", ICANN: true},
+	{DottedName: "nanao.ishikawa.jp", ICANN: true},
+	{DottedName: "nanbu.tottori.jp", ICANN: true},
+	{DottedName: "nanbu.yamanashi.jp", ICANN: true},
+	{DottedName: "nango.fukushima.jp", ICANN: true},
+	{DottedName: "nanjo.okinawa.jp", ICANN: true},
+	{DottedName: "nankoku.kochi.jp", ICANN: true},
+	{DottedName: "nanmoku.gunma.jp", ICANN: true},
+	{DottedName: "nannestad.no", ICANN: true},
+	{DottedName: "nanporo.hokkaido.jp", ICANN: true},
+	{DottedName: "nantan.kyoto.jp", ICANN: true},
+	{DottedName: "nanto.toyama.jp", ICANN: true},
+	{DottedName: "nanyo.yamagata.jp", ICANN: true},
+	{DottedName: "naoshima.kagawa.jp", ICANN: true},
+	{DottedName: "naples.it", ICANN: true},
+	{DottedName: "napoli.it", ICANN: true},
+	{DottedName: "nara.jp", ICANN: true},
+	{DottedName: "nara.nara.jp", ICANN: true},
+	{DottedName: "narashino.chiba.jp", ICANN: true},
+	{DottedName: "narita.chiba.jp", ICANN: true},
+	{DottedName: "naroy.no", ICANN: true},
+	{DottedName: "narusawa.yamanashi.jp", ICANN: true},
+	{DottedName: "naruto.tokushima.jp", ICANN: true},
+	{DottedName: "narviika.no", ICANN: true},
+	{DottedName: "narvik.no", ICANN: true},
+	{DottedName: "nasu.tochigi.jp", ICANN: true},
+	{DottedName: "nasushiobara.tochigi.jp", ICANN: true},
+	{DottedName: "nat.tn", ICANN: true},
+	{DottedName: "natal.br", ICANN: true},
+	{DottedName: "national.museum", ICANN: true},
+	{DottedName: "nationalfirearms.museum", ICANN: true},
+	{DottedName: "nationalheritage.museum", ICANN: true},
+	{DottedName: "nativeamerican.museum", ICANN: true},
+	{DottedName: "natori.miyagi.jp", ICANN: true},
+	{DottedName: "natura", ICANN: true},
+	{DottedName: "natural.bo", ICANN: true},
+	{DottedName: "naturalhistory.museum", ICANN: true},
+	{DottedName: "naturalhistorymuseum.museum", ICANN: true},
+	{DottedName: "naturalsciences.museum", ICANN: true},
+	{DottedName: "naturbruksgymn.se", ICANN: true},
+	{DottedName: "nature.museum", ICANN: true},
+	{DottedName: "naturhistorisches.museum", ICANN: true},
+	{DottedName: "natuurwetenschappen.museum", ICANN: true},
+	{DottedName: "naumburg.museum", ICANN: true},
+	{DottedName: "naustdal.no", ICANN: true},
+	{DottedName: "naval.museum", ICANN: true},
+	{DottedName: "navigation.aero", ICANN: true},
+	{DottedName: "navuotna.no", ICANN: true},
+	{DottedName: "navy", ICANN: true},
+	{DottedName: "nayoro.hokkaido.jp", ICANN: true},
+	{DottedName: "nb.ca", ICANN: true},
+	{DottedName: "nba", ICANN: true},
+	{DottedName: "nc", ICANN: true},
+	{DottedName: "nc.tr", ICANN: true},
+	{DottedName: "nc.us", ICANN: true},
+	{DottedName: "nd.us", ICANN: true},
+	{DottedName: "ne", ICANN: true},
+	{DottedName: "ne.jp", ICANN: true},
+	{DottedName: "ne.ke", ICANN: true},
+	{DottedName: "ne.kr", ICANN: true},
+	{DottedName: "ne.pw", ICANN: true},
+	{DottedName: "ne.tz", ICANN: true},
+	{DottedName: "ne.ug", ICANN: true},
+	{DottedName: "ne.us", ICANN: true},
+	{DottedName: "nebraska.museum", ICANN: true},
+	{DottedName: "nec", ICANN: true},
+	{DottedName: "nedre-eiker.no", ICANN: true},
+	{DottedName: "nemuro.hokkaido.jp", ICANN: true},
+	{DottedName: "nerima.tokyo.jp", ICANN: true},
+	{DottedName: "nes.akershus.no", ICANN: true},
+	{DottedName: "nes.buskerud.no", ICANN: true},
+	{DottedName: "nesna.no", ICANN: true},
+	{DottedName: "nesodden.no", ICANN: true},
+	{DottedName: "nesoddtangen.no", ICANN: true},
+	{DottedName: "nesseby.no", ICANN: true},
+	{DottedName: "nesset.no", ICANN: true},
+	{DottedName: "net", ICANN: true},
+	{DottedName: "net.ac", ICANN: true},
+	{DottedName: "net.ae", ICANN: true},
+	{DottedName: "net.af", ICANN: true},
+	{DottedName: "net.ag", ICANN: true},
+	{DottedName: "net.ai", ICANN: true},
+	{DottedName: "net.al", ICANN: true},
+	{DottedName: "net.am", ICANN: true},
+	{DottedName: "net.ar", ICANN: true},
+	{DottedName: "net.au", ICANN: true},
+	{DottedName: "net.az", ICANN: true},
+	{DottedName: "net.ba", ICANN: true},
+	{DottedName: "net.bb", ICANN: true},
+	{DottedName: "net.bh", ICANN: true},
+	{DottedName: "net.bm", ICANN: true},
+	{DottedName: "net.bn", ICANN: true},
+	{DottedName: "net.bo", ICANN: true},
+	{DottedName: "net.br", ICANN: true},
+	{DottedName: "net.bs", ICANN: true},
+	{DottedName: "net.bt", ICANN: true},
+	{DottedName: "net.bz", ICANN: true},
+	{DottedName: "net.ci", ICANN: true},
+	{DottedName: "net.cm", ICANN: true},
+	{DottedName: "net.cn", ICANN: true},
+	{DottedName: "net.co", ICANN: true},
+	{DottedName: "net.cu", ICANN: true},
+	{DottedName: "net.cw", ICANN: true},
+	{DottedName: "net.cy", ICANN: true},
+	{DottedName: "net.dm", ICANN: true},
+	{DottedName: "net.do", ICANN: true},
+	{DottedName: "net.dz", ICANN: true},
+	{DottedName: "net.ec", ICANN: true},
+	{DottedName: "net.eg", ICANN: true},
+	{DottedName: "net.et", ICANN: true},
+	{DottedName: "net.fj", ICANN: true},
+	{DottedName: "net.fm", ICANN: true},
+	{DottedName: "net.ge", ICANN: true},
+	{DottedName: "net.gg", ICANN: true},
+	{DottedName: "net.gl", ICANN: true},
+	{DottedName: "net.gn", ICANN: true},
+	{DottedName: "net.gp", ICANN: true},
+	{DottedName: "net.gr", ICANN: true},
+	{DottedName: "net.gt", ICANN: true},
+	{DottedName: "net.gu", ICANN: true},
+	{DottedName: "net.gy", ICANN: true},
+	{DottedName: "net.hk", ICANN: true},
+	{DottedName: "net.hn", ICANN: true},
+	{DottedName: "net.ht", ICANN: true},
+	{DottedName: "net.id", ICANN: true},
+	{DottedName: "net.il", ICANN: true},
+	{DottedName: "net.im", ICANN: true},
+	{DottedName: "net.in", ICANN: true},
+	{DottedName: "net.iq", ICANN: true},
+	{DottedName: "net.ir", ICANN: true},
+	{DottedName: "net.is", ICANN: true},
+	{DottedName: "net.je", ICANN: true},
+	{DottedName: "net.jo", ICANN: true},
+	{DottedName: "net.kg", ICANN: true},
+	{DottedName: "net.ki", ICANN: true},
+	{DottedName: "net.kn", ICANN: true},
+	{DottedName: "net.kw", ICANN: true},
+	{DottedName: "net.ky", ICANN: true},
+	{DottedName: "net.kz", ICANN: true},
+	{DottedName: "net.la", ICANN: true},
+	{DottedName: "net.lb", ICANN: true},
+	{DottedName: "net.lc", ICANN: true},
+	{DottedName: "net.lk", ICANN: true},
+	{DottedName: "net.lr", ICANN: true},
+	{DottedName: "net.ls", ICANN: true},
+	{DottedName: "net.lv", ICANN: true},
+	{DottedName: "net.ly", ICANN: true},
+	{DottedName: "net.ma", ICANN: true},
+	{DottedName: "net.me", ICANN: true},
+	{DottedName: "net.mk", ICANN: true},
+	{DottedName: "net.ml", ICANN: true},
+	{DottedName: "net.mo", ICANN: true},
+	{DottedName: "net.ms", ICANN: true},
+	{DottedName: "net.mt", ICANN: true},
+	{DottedName: "net.mu", ICANN: true},
+	{DottedName: "net.mv", ICANN: true},
+	{DottedName: "net.mw", ICANN: true},
+	{DottedName: "net.mx", ICANN: true},
+	{DottedName: "net.my", ICANN: true},
+	{DottedName: "net.mz", ICANN: true},
+	{DottedName: "net.nf", ICANN: true},
+	{DottedName: "net.ng", ICANN: true},
+	{DottedName: "net.ni", ICANN: true},
+	{DottedName: "net.nr", ICANN: true},
+	{DottedName: "net.nz", ICANN: true},
+	{DottedName: "net.om", ICANN: true},
+	{DottedName: "net.pa", ICANN: true},
+	{DottedName: "net.pe", ICANN: true},
+	{DottedName: "net.ph", ICANN: true},
+	{DottedName: "net.pk", ICANN: true},
+	{DottedName: "net.pl", ICANN: true},
+	{DottedName: "net.pn", ICANN: true},
+	{DottedName: "net.pr", ICANN: true},
+	{DottedName: "net.ps", ICANN: true},
+	{DottedName: "net.pt", ICANN: true},
+	{DottedName: "net.py", ICANN: true},
+	{DottedName: "net.qa", ICANN: true},
+	{DottedName: "net.rw", ICANN: true},
+	{DottedName: "net.sa", ICANN: true},
+	{DottedName: "net.sb", ICANN: true},
+	{DottedName: "net.sc", ICANN: true},
+	{DottedName: "net.sd", ICANN: true},
+	{DottedName: "net.sg", ICANN: true},
+	{DottedName: "net.sh", ICANN: true},
+	{DottedName: "net.sl", ICANN: true},
+	{DottedName: "net.so", ICANN: true},
+	{DottedName: "net.ss", ICANN: true},
+	{DottedName: "net.st", ICANN: true},
+	{DottedName: "net.sy", ICANN: true},
+	{DottedName: "net.th", ICANN: true},
+	{DottedName: "net.tj", ICANN: true},
+	{DottedName: "net.tm", ICANN: true},
+	{DottedName: "net.tn", ICANN: true},
+	{DottedName: "net.to", ICANN: true},
+	{DottedName: "net.tr", ICANN: true},
+	{DottedName: "net.tt", ICANN: true},
+	{DottedName: "net.tw", ICANN: true},
+	{DottedName: "net.ua", ICANN: true},
+	{DottedName: "net.uk", ICANN: true},
+	{DottedName: "net.uy", ICANN: true},
+	{DottedName: "net.uz", ICANN: true},
+	{DottedName: "net.vc", ICANN: true},
+	{DottedName: "net.ve", ICANN: true},
+	{DottedName: "net.vi", ICANN: true},
+	{DottedName: "net.vn", ICANN: true},
+	{DottedName: "net.vu", ICANN: true},
+	{DottedName: "net.ws", ICANN: true},
+	{DottedName: "net.ye", ICANN: true},
+	{DottedName: "net.za", ICANN: true},
+	{DottedName: "net.zm", ICANN: true},
+	{DottedName: "netbank", ICANN: true},
+	{DottedName: "netflix", ICANN: true},
+	{DottedName: "network", ICANN: true},
+	{DottedName: "neues.museum", ICANN: true},
+	{DottedName: "neustar", ICANN: true},
+	{DottedName: "new", ICANN: true},
+	{DottedName: "newhampshire.museum", ICANN: true},
+	{DottedName: "newjersey.museum", ICANN: true},
+	{DottedName: "newmexico.museum", ICANN: true},
+	{DottedName: "newport.museum", ICANN: true},
+	{DottedName: "news", ICANN: true},
+	{DottedName: "news.hu", ICANN: true},
+	{DottedName: "newspaper.museum", ICANN: true},
+	{DottedName: "newyork.museum", ICANN: true},
+	{DottedName: "next", ICANN: true},
+	{DottedName: "nextdirect", ICANN: true},
+	{DottedName: "nexus", ICANN: true},
+	{DottedName: "neyagawa.osaka.jp", ICANN: true},
+	{DottedName: "nf", ICANN: true},
+	{DottedName: "nf.ca", ICANN: true},
+	{DottedName: "nfl", ICANN: true},
+	{DottedName: "ng", ICANN: true},
+	{DottedName: "ngo", ICANN: true},
+	{DottedName: "ngo.lk", ICANN: true},
+	{DottedName: "ngo.ph", ICANN: true},
+	{DottedName: "ngo.za", ICANN: true},
+	{DottedName: "nh.us", ICANN: true},
+	{DottedName: "nhk", ICANN: true},
+	{DottedName: "nhs.uk", ICANN: true},
+	{DottedName: "ni", ICANN: true},
+	{DottedName: "nic.in", ICANN: true},
+	{DottedName: "nic.tj", ICANN: true},
+	{DottedName: "nic.za", ICANN: true},
+	{DottedName: "nichinan.miyazaki.jp", ICANN: true},
+	{DottedName: "nichinan.tottori.jp", ICANN: true},
+	{DottedName: "nico", ICANN: true},
+	{DottedName: "niepce.museum", ICANN: true},
+	{DottedName: "nieruchomosci.pl", ICANN: true},
+	{DottedName: "niigata.jp", ICANN: true},
+	{DottedName: "niigata.niigata.jp", ICANN: true},
+	{DottedName: "niihama.ehime.jp", ICANN: true},
+	{DottedName: "niikappu.hokkaido.jp", ICANN: true},
+	{DottedName: "niimi.okayama.jp", ICANN: true},
+	{DottedName: "niiza.saitama.jp", ICANN: true},
+	{DottedName: "nikaho.akita.jp", ICANN: true},
+	{DottedName: "nike", ICANN: true},
+	{DottedName: "niki.hokkaido.jp", ICANN: true},
+	{DottedName: "nikko.tochigi.jp", ICANN: true},
+	{DottedName: "nikolaev.ua", ICANN: true},
+	{DottedName: "nikon", ICANN: true},
+	{DottedName: "ninja", ICANN: true},
+	{DottedName: "ninohe.iwate.jp", ICANN: true},
+	{DottedName: "ninomiya.kanagawa.jp", ICANN: true},
+	{DottedName: "nirasaki.yamanashi.jp", ICANN: true},
+	{DottedName: "nis.za", ICANN: true},
+	{DottedName: "nishi.fukuoka.jp", ICANN: true},
+	{DottedName: "nishi.osaka.jp", ICANN: true},
+	{DottedName: "nishiaizu.fukushima.jp", ICANN: true},
+	{DottedName: "nishiarita.saga.jp", ICANN: true},
+	{DottedName: "nishiawakura.okayama.jp", ICANN: true},
+	{DottedName: "nishiazai.shiga.jp", ICANN: true},
+	{DottedName: "nishigo.fukushima.jp", ICANN: true},
+	{DottedName: "nishihara.kumamoto.jp", ICANN: true},
+	{DottedName: "nishihara.okinawa.jp", ICANN: true},
+	{DottedName: "nishiizu.shizuoka.jp", ICANN: true},
+	{DottedName: "nishikata.tochigi.jp", ICANN: true},
+	{DottedName: "nishikatsura.yamanashi.jp", ICANN: true},
+	{DottedName: "nishikawa.yamagata.jp", ICANN: true},
+	{DottedName: "nishimera.miyazaki.jp", ICANN: true},
+	{DottedName: "nishinomiya.hyogo.jp", ICANN: true},
+	{DottedName: "nishinoomote.kagoshima.jp", ICANN: true},
+	{DottedName: "nishinoshima.shimane.jp", ICANN: true},
+	{DottedName: "nishio.aichi.jp", ICANN: true},
+	{DottedName: "nishiokoppe.hokkaido.jp", ICANN: true},
+	{DottedName: "nishitosa.kochi.jp", ICANN: true},
+	{DottedName: "nishiwaki.hyogo.jp", ICANN: true},
+	{DottedName: "nissan", ICANN: true},
+	{DottedName: "nissay", ICANN: true},
+	{DottedName: "nissedal.no", ICANN: true},
+	{DottedName: "nisshin.aichi.jp", ICANN: true},
+	{DottedName: "niteroi.br", ICANN: true},
+	{DottedName: "nittedal.no", ICANN: true},
+	{DottedName: "niyodogawa.kochi.jp", ICANN: true},
+	{DottedName: "nj.us", ICANN: true},
+	{DottedName: "nl", ICANN: true},
+	{DottedName: "nl.ca", ICANN: true},
+	{DottedName: "nl.no", ICANN: true},
+	{DottedName: "nm.cn", ICANN: true},
+	{DottedName: "nm.us", ICANN: true},
+	{DottedName: "no", ICANN: true},
+	{DottedName: "no.it", ICANN: true},
+	{DottedName: "nobeoka.miyazaki.jp", ICANN: true},
+	{DottedName: "noboribetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "noda.chiba.jp", ICANN: true},
+	{DottedName: "noda.iwate.jp", ICANN: true},
+	{DottedName: "nogata.fukuoka.jp", ICANN: true},
+	{DottedName: "nogi.tochigi.jp", ICANN: true},
+	{DottedName: "noheji.aomori.jp", ICANN: true},
+	{DottedName: "nokia", ICANN: true},
+	{DottedName: "nom.ad", ICANN: true},
+	{DottedName: "nom.ag", ICANN: true},
+	{DottedName: "nom.co", ICANN: true},
+	{DottedName: "nom.es", ICANN: true},
+	{DottedName: "nom.fr", ICANN: true},
+	{DottedName: "nom.km", ICANN: true},
+	{DottedName: "nom.mg", ICANN: true},
+	{DottedName: "nom.nc", ICANN: true},
+	{DottedName: "nom.ni", ICANN: true},
+	{DottedName: "nom.pa", ICANN: true},
+	{DottedName: "nom.pe", ICANN: true},
+	{DottedName: "nom.pl", ICANN: true},
+	{DottedName: "nom.re", ICANN: true},
+	{DottedName: "nom.ro", ICANN: true},
+	{DottedName: "nom.tm", ICANN: true},
+	{DottedName: "nom.ve", ICANN: true},
+	{DottedName: "nom.za", ICANN: true},
+	{DottedName: "nombre.bo", ICANN: true},
+	{DottedName: "nome.cv", ICANN: true},
+	{DottedName: "nome.pt", ICANN: true},
+	{DottedName: "nomi.ishikawa.jp", ICANN: true},
+	{DottedName: "nonoichi.ishikawa.jp", ICANN: true},
+	{DottedName: "nord-aurdal.no", ICANN: true},
+	{DottedName: "nord-fron.no", ICANN: true},
+	{DottedName: "nord-odal.no", ICANN: true},
+	{DottedName: "norddal.no", ICANN: true},
+	{DottedName: "nordkapp.no", ICANN: true},
+	{DottedName: "nordre-land.no", ICANN: true},
+	{DottedName: "nordreisa.no", ICANN: true},
+	{DottedName: "nore-og-uvdal.no", ICANN: true},
+	{DottedName: "norfolk.museum", ICANN: true},
+	{DottedName: "north.museum", ICANN: true},
+	{DottedName: "northwesternmutual", ICANN: true},
+	{DottedName: "norton", ICANN: true},
+	{DottedName: "nose.osaka.jp", ICANN: true},
+	{DottedName: "nosegawa.nara.jp", ICANN: true},
+	{DottedName: "noshiro.akita.jp", ICANN: true},
+	{DottedName: "not.br", ICANN: true},
+	{DottedName: "notaires.fr", ICANN: true},
+	{DottedName: "notaires.km", ICANN: true},
+	{DottedName: "noticias.bo", ICANN: true},
+	{DottedName: "noto.ishikawa.jp", ICANN: true},
+	{DottedName: "notodden.no", ICANN: true},
+	{DottedName: "notogawa.shiga.jp", ICANN: true},
+	{DottedName: "notteroy.no", ICANN: true},
+	{DottedName: "novara.it", ICANN: true},
+	{DottedName: "now", ICANN: true},
+	{DottedName: "nowaruda.pl", ICANN: true},
+	{DottedName: "nowruz", ICANN: true},
+	{DottedName: "nowtv", ICANN: true},
+	{DottedName: "nozawaonsen.nagano.jp", ICANN: true},
+	{DottedName: "nr", ICANN: true},
+	{DottedName: "nra", ICANN: true},
+	{DottedName: "nrw", ICANN: true},
+	{DottedName: "nrw.museum", ICANN: true},
+	{DottedName: "ns.ca", ICANN: true},
+	{DottedName: "nsn.us", ICANN: true},
+	{DottedName: "nsw.au", ICANN: true},
+	{DottedName: "nsw.edu.au", ICANN: true},
+	{DottedName: "nt.au", ICANN: true},
+	{DottedName: "nt.ca", ICANN: true},
+	{DottedName: "nt.edu.au", ICANN: true},
+	{DottedName: "nt.no", ICANN: true},
+	{DottedName: "nt.ro", ICANN: true},
+	{DottedName: "ntr.br", ICANN: true},
+	{DottedName: "ntt", ICANN: true},
+	{DottedName: "nu", ICANN: true},
+	{DottedName: "nu.ca", ICANN: true},
+	{DottedName: "nu.it", ICANN: true},
+	{DottedName: "numata.gunma.jp", ICANN: true},
+	{DottedName: "numata.hokkaido.jp", ICANN: true},
+	{DottedName: "numazu.shizuoka.jp", ICANN: true},
+	{DottedName: "nuoro.it", ICANN: true},
+	{DottedName: "nv.us", ICANN: true},
+	{DottedName: "nx.cn", ICANN: true},
+	{DottedName: "ny.us", ICANN: true},
+	{DottedName: "nyc", ICANN: true},
+	{DottedName: "nyc.museum", ICANN: true},
+	{DottedName: "nyny.museum", ICANN: true},
+	{DottedName: "nysa.pl", ICANN: true},
+	{DottedName: "nyuzen.toyama.jp", ICANN: true},
+	{DottedName: "nz", ICANN: true},
+	{DottedName: "o.bg", ICANN: true},
+	{DottedName: "o.se", ICANN: true},
+	{DottedName: "oamishirasato.chiba.jp", ICANN: true},
+	{DottedName: "oarai.ibaraki.jp", ICANN: true},
+	{DottedName: "obama.fukui.jp", ICANN: true},
+	{DottedName: "obama.nagasaki.jp", ICANN: true},
+	{DottedName: "obanazawa.yamagata.jp", ICANN: true},
+	{DottedName: "obi", ICANN: true},
+	{DottedName: "obihiro.hokkaido.jp", ICANN: true},
+	{DottedName: "obira.hokkaido.jp", ICANN: true},
+	{DottedName: "observer", ICANN: true},
+	{DottedName: "obu.aichi.jp", ICANN: true},
+	{DottedName: "obuse.nagano.jp", ICANN: true},
+	{DottedName: "oceanographic.museum", ICANN: true},
+	{DottedName: "oceanographique.museum", ICANN: true},
+	{DottedName: "ochi.kochi.jp", ICANN: true},
+	{DottedName: "od.ua", ICANN: true},
+	{DottedName: "odate.akita.jp", ICANN: true},
+	{DottedName: "odawara.kanagawa.jp", ICANN: true},
+	{DottedName: "odda.no", ICANN: true},
+	{DottedName: "odesa.ua", ICANN: true},
+	{DottedName: "odessa.ua", ICANN: true},
+	{DottedName: "odo.br", ICANN: true},
+	{DottedName: "oe.yamagata.jp", ICANN: true},
+	{DottedName: "of.by", ICANN: true},
+	{DottedName: "of.no", ICANN: true},
+	{DottedName: "off", ICANN: true},
+	{DottedName: "off.ai", ICANN: true},
+	{DottedName: "office", ICANN: true},
+	{DottedName: "ofunato.iwate.jp", ICANN: true},
+	{DottedName: "og.ao", ICANN: true},
+	{DottedName: "og.it", ICANN: true},
+	{DottedName: "oga.akita.jp", ICANN: true},
+	{DottedName: "ogaki.gifu.jp", ICANN: true},
+	{DottedName: "ogano.saitama.jp", ICANN: true},
+	{DottedName: "ogasawara.tokyo.jp", ICANN: true},
+	{DottedName: "ogata.akita.jp", ICANN: true},
+	{DottedName: "ogawa.ibaraki.jp", ICANN: true},
+	{DottedName: "ogawa.nagano.jp", ICANN: true},
+	{DottedName: "ogawa.saitama.jp", ICANN: true},
+	{DottedName: "ogawara.miyagi.jp", ICANN: true},
+	{DottedName: "ogi.saga.jp", ICANN: true},
+	{DottedName: "ogimi.okinawa.jp", ICANN: true},
+	{DottedName: "ogliastra.it", ICANN: true},
+	{DottedName: "ogori.fukuoka.jp", ICANN: true},
+	{DottedName: "ogose.saitama.jp", ICANN: true},
+	{DottedName: "oguchi.aichi.jp", ICANN: true},
+	{DottedName: "oguni.kumamoto.jp", ICANN: true},
+	{DottedName: "oguni.yamagata.jp", ICANN: true},
+	{DottedName: "oh.us", ICANN: true},
+	{DottedName: "oharu.aichi.jp", ICANN: true},
+	{DottedName: "ohda.shimane.jp", ICANN: true},
+	{DottedName: "ohi.fukui.jp", ICANN: true},
+	{DottedName: "ohira.miyagi.jp", ICANN: true},
+	{DottedName: "ohira.tochigi.jp", ICANN: true},
+	{DottedName: "ohkura.yamagata.jp", ICANN: true},
+	{DottedName: "ohtawara.tochigi.jp", ICANN: true},
+	{DottedName: "oi.kanagawa.jp", ICANN: true},
+	{DottedName: "oirase.aomori.jp", ICANN: true},
+	{DottedName: "oirm.gov.pl", ICANN: true},
+	{DottedName: "oishida.yamagata.jp", ICANN: true},
+	{DottedName: "oiso.kanagawa.jp", ICANN: true},
+	{DottedName: "oita.jp", ICANN: true},
+	{DottedName: "oita.oita.jp", ICANN: true},
+	{DottedName: "oizumi.gunma.jp", ICANN: true},
+	{DottedName: "oji.nara.jp", ICANN: true},
+	{DottedName: "ojiya.niigata.jp", ICANN: true},
+	{DottedName: "ok.us", ICANN: true},
+	{DottedName: "okagaki.fukuoka.jp", ICANN: true},
+	{DottedName: "okawa.fukuoka.jp", ICANN: true},
+	{DottedName: "okawa.kochi.jp", ICANN: true},
+	{DottedName: "okaya.nagano.jp", ICANN: true},
+	{DottedName: "okayama.jp", ICANN: true},
+	{DottedName: "okayama.okayama.jp", ICANN: true},
+	{DottedName: "okazaki.aichi.jp", ICANN: true},
+	{DottedName: "okegawa.saitama.jp", ICANN: true},
+	{DottedName: "oketo.hokkaido.jp", ICANN: true},
+	{DottedName: "oki.fukuoka.jp", ICANN: true},
+	{DottedName: "okinawa", ICANN: true},
+	{DottedName: "okinawa.jp", ICANN: true},
+	{DottedName: "okinawa.okinawa.jp", ICANN: true},
+	{DottedName: "okinoshima.shimane.jp", ICANN: true},
+	{DottedName: "okoppe.hokkaido.jp", ICANN: true},
+	{DottedName: "oksnes.no", ICANN: true},
+	{DottedName: "okuizumo.shimane.jp", ICANN: true},
+	{DottedName: "okuma.fukushima.jp", ICANN: true},
+	{DottedName: "okutama.tokyo.jp", ICANN: true},
+	{DottedName: "ol.no", ICANN: true},
+	{DottedName: "olawa.pl", ICANN: true},
+	{DottedName: "olayan", ICANN: true},
+	{DottedName: "olayangroup", ICANN: true},
+	{DottedName: "olbia-tempio.it", ICANN: true},
+	{DottedName: "olbiatempio.it", ICANN: true},
+	{DottedName: "oldnavy", ICANN: true},
+	{DottedName: "olecko.pl", ICANN: true},
+	{DottedName: "olkusz.pl", ICANN: true},
+	{DottedName: "ollo", ICANN: true},
+	{DottedName: "olsztyn.pl", ICANN: true},
+	{DottedName: "om", ICANN: true},
+	{DottedName: "omachi.nagano.jp", ICANN: true},
+	{DottedName: "omachi.saga.jp", ICANN: true},
+	{DottedName: "omaezaki.shizuoka.jp", ICANN: true},
+	{DottedName: "omaha.museum", ICANN: true},
+	{DottedName: "omasvuotna.no", ICANN: true},
+	{DottedName: "ome.tokyo.jp", ICANN: true},
+	{DottedName: "omega", ICANN: true},
+	{DottedName: "omi.nagano.jp", ICANN: true},
+	{DottedName: "omi.niigata.jp", ICANN: true},
+	{DottedName: "omigawa.chiba.jp", ICANN: true},
+	{DottedName: "omihachiman.shiga.jp", ICANN: true},
+	{DottedName: "omitama.ibaraki.jp", ICANN: true},
+	{DottedName: "omiya.saitama.jp", ICANN: true},
+	{DottedName: "omotego.fukushima.jp", ICANN: true},
+	{DottedName: "omura.nagasaki.jp", ICANN: true},
+	{DottedName: "omuta.fukuoka.jp", ICANN: true},
+	{DottedName: "on.ca", ICANN: true},
+	{DottedName: "onagawa.miyagi.jp", ICANN: true},
+	{DottedName: "one", ICANN: true},
+	{DottedName: "ong", ICANN: true},
+	{DottedName: "ong.br", ICANN: true},
+	{DottedName: "onga.fukuoka.jp", ICANN: true},
+	{DottedName: "onion", ICANN: true},
+	{DottedName: "onjuku.chiba.jp", ICANN: true},
+	{DottedName: "onl", ICANN: true},
+	{DottedName: "online", ICANN: true},
+	{DottedName: "online.museum", ICANN: true},
+	{DottedName: "onna.okinawa.jp", ICANN: true},
+	{DottedName: "ono.fukui.jp", ICANN: true},
+	{DottedName: "ono.fukushima.jp", ICANN: true},
+	{DottedName: "ono.hyogo.jp", ICANN: true},
+	{DottedName: "onojo.fukuoka.jp", ICANN: true},
+	{DottedName: "onomichi.hiroshima.jp", ICANN: true},
+	{DottedName: "ontario.museum", ICANN: true},
+	{DottedName: "ookuwa.nagano.jp", ICANN: true},
+	{DottedName: "ooo", ICANN: true},
+	{DottedName: "ooshika.nagano.jp", ICANN: true},
+	{DottedName: "open", ICANN: true},
+	{DottedName: "openair.museum", ICANN: true},
+	{DottedName: "opoczno.pl", ICANN: true},
+	{DottedName: "opole.pl", ICANN: true},
+	{DottedName: "oppdal.no", ICANN: true},
+	{DottedName: "oppegard.no", ICANN: true},
+	{DottedName: "or.at", ICANN: true},
+	{DottedName: "or.bi", ICANN: true},
+	{DottedName: "or.ci", ICANN: true},
+	{DottedName: "or.cr", ICANN: true},
+	{DottedName: "or.id", ICANN: true},
+	{DottedName: "or.it", ICANN: true},
+	{DottedName: "or.jp", ICANN: true},
+	{DottedName: "or.ke", ICANN: true},
+	{DottedName: "or.kr", ICANN: true},
+	{DottedName: "or.mu", ICANN: true},
+	{DottedName: "or.na", ICANN: true},
+	{DottedName: "or.pw", ICANN: true},
+	{DottedName: "or.th", ICANN: true},
+	{DottedName: "or.tz", ICANN: true},
+	{DottedName: "or.ug", ICANN: true},
+	{DottedName: "or.us", ICANN: true},
+	{DottedName: "ora.gunma.jp", ICANN: true},
+	{DottedName: "oracle", ICANN: true},
+	{DottedName: "orange", ICANN: true},
+	{DottedName: "oregon.museum", ICANN: true},
+	{DottedName: "oregontrail.museum", ICANN: true},
+	{DottedName: "org", ICANN: true},
+	{DottedName: "org.ac", ICANN: true},
+	{DottedName: "org.ae", ICANN: true},
+	{DottedName: "org.af", ICANN: true},
+	{DottedName: "org.ag", ICANN: true},
+	{DottedName: "org.ai", ICANN: true},
+	{DottedName: "org.al", ICANN: true},
+	{DottedName: "org.am", ICANN: true},
+	{DottedName: "org.ar", ICANN: true},
+	{DottedName: "org.au", ICANN: true},
+	{DottedName: "org.az", ICANN: true},
+	{DottedName: "org.ba", ICANN: true},
+	{DottedName: "org.bb", ICANN: true},
+	{DottedName: "org.bh", ICANN: true},
+	{DottedName: "org.bi", ICANN: true},
+	{DottedName: "org.bm", ICANN: true},
+	{DottedName: "org.bn", ICANN: true},
+	{DottedName: "org.bo", ICANN: true},
+	{DottedName: "org.br", ICANN: true},
+	{DottedName: "org.bs", ICANN: true},
+	{DottedName: "org.bt", ICANN: true},
+	{DottedName: "org.bw", ICANN: true},
+	{DottedName: "org.bz", ICANN: true},
+	{DottedName: "org.ci", ICANN: true},
+	{DottedName: "org.cn", ICANN: true},
+	{DottedName: "org.co", ICANN: true},
+	{DottedName: "org.cu", ICANN: true},
+	{DottedName: "org.cv", ICANN: true},
+	{DottedName: "org.cw", ICANN: true},
+	{DottedName: "org.cy", ICANN: true},
+	{DottedName: "org.dm", ICANN: true},
+	{DottedName: "org.do", ICANN: true},
+	{DottedName: "org.dz", ICANN: true},
+	{DottedName: "org.ec", ICANN: true},
+	{DottedName: "org.ee", ICANN: true},
+	{DottedName: "org.eg", ICANN: true},
+	{DottedName: "org.es", ICANN: true},
+	{DottedName: "org.et", ICANN: true},
+	{DottedName: "org.fj", ICANN: true},
+	{DottedName: "org.fm", ICANN: true},
+	{DottedName: "org.ge", ICANN: true},
+	{DottedName: "org.gg", ICANN: true},
+	{DottedName: "org.gh", ICANN: true},
+	{DottedName: "org.gi", ICANN: true},
+	{DottedName: "org.gl", ICANN: true},
+	{DottedName: "org.gn", ICANN: true},
+	{DottedName: "org.gp", ICANN: true},
+	{DottedName: "org.gr", ICANN: true},
+	{DottedName: "org.gt", ICANN: true},
+	{DottedName: "org.gu", ICANN: true},
+	{DottedName: "org.gy", ICANN: true},
+	{DottedName: "org.hk", ICANN: true},
+	{DottedName: "org.hn", ICANN: true},
+	{DottedName: "org.ht", ICANN: true},
+	{DottedName: "org.hu", ICANN: true},
+	{DottedName: "org.il", ICANN: true},
+	{DottedName: "org.im", ICANN: true},
+	{DottedName: "org.in", ICANN: true},
+	{DottedName: "org.iq", ICANN: true},
+	{DottedName: "org.ir", ICANN: true},
+	{DottedName: "org.is", ICANN: true},
+	{DottedName: "org.je", ICANN: true},
+	{DottedName: "org.jo", ICANN: true},
+	{DottedName: "org.kg", ICANN: true},
+	{DottedName: "org.ki", ICANN: true},
+	{DottedName: "org.km", ICANN: true},
+	{DottedName: "org.kn", ICANN: true},
+	{DottedName: "org.kp", ICANN: true},
+	{DottedName: "org.kw", ICANN: true},
+	{DottedName: "org.ky", ICANN: true},
+	{DottedName: "org.kz", ICANN: true},
+	{DottedName: "org.la", ICANN: true},
+	{DottedName: "org.lb", ICANN: true},
+	{DottedName: "org.lc", ICANN: true},
+	{DottedName: "org.lk", ICANN: true},
+	{DottedName: "org.lr", ICANN: true},
+	{DottedName: "org.ls", ICANN: true},
+	{DottedName: "org.lv", ICANN: true},
+	{DottedName: "org.ly", ICANN: true},
+	{DottedName: "org.ma", ICANN: true},
+	{DottedName: "org.me", ICANN: true},
+	{DottedName: "org.mg", ICANN: true},
+	{DottedName: "org.mk", ICANN: true},
+	{DottedName: "org.ml", ICANN: true},
+	{DottedName: "org.mn", ICANN: true},
+	{DottedName: "org.mo", ICANN: true},
+	{DottedName: "org.ms", ICANN: true},
+	{DottedName: "org.mt", ICANN: true},
+	{DottedName: "org.mu", ICANN: true},
+	{DottedName: "org.mv", ICANN: true},
+	{DottedName: "org.mw", ICANN: true},
+	{DottedName: "org.mx", ICANN: true},
+	{DottedName: "org.my", ICANN: true},
+	{DottedName: "org.mz", ICANN: true},
+	{DottedName: "org.na", ICANN: true},
+	{DottedName: "org.ng", ICANN: true},
+	{DottedName: "org.ni", ICANN: true},
+	{DottedName: "org.nr", ICANN: true},
+	{DottedName: "org.nz", ICANN: true},
+	{DottedName: "org.om", ICANN: true},
+	{DottedName: "org.pa", ICANN: true},
+	{DottedName: "org.pe", ICANN: true},
+	{DottedName: "org.pf", ICANN: true},
+	{DottedName: "org.ph", ICANN: true},
+	{DottedName: "org.pk", ICANN: true},
+	{DottedName: "org.pl", ICANN: true},
+	{DottedName: "org.pn", ICANN: true},
+	{DottedName: "org.pr", ICANN: true},
+	{DottedName: "org.ps", ICANN: true},
+	{DottedName: "org.pt", ICANN: true},
+	{DottedName: "org.py", ICANN: true},
+	{DottedName: "org.qa", ICANN: true},
+	{DottedName: "org.ro", ICANN: true},
+	{DottedName: "org.rs", ICANN: true},
+	{DottedName: "org.rw", ICANN: true},
+	{DottedName: "org.sa", ICANN: true},
+	{DottedName: "org.sb", ICANN: true},
+	{DottedName: "org.sc", ICANN: true},
+	{DottedName: "org.sd", ICANN: true},
+	{DottedName: "org.se", ICANN: true},
+	{DottedName: "org.sg", ICANN: true},
+	{DottedName: "org.sh", ICANN: true},
+	{DottedName: "org.sl", ICANN: true},
+	{DottedName: "org.sn", ICANN: true},
+	{DottedName: "org.so", ICANN: true},
+	{DottedName: "org.ss", ICANN: true},
+	{DottedName: "org.st", ICANN: true},
+	{DottedName: "org.sv", ICANN: true},
+	{DottedName: "org.sy", ICANN: true},
+	{DottedName: "org.sz", ICANN: true},
+	{DottedName: "org.tj", ICANN: true},
+	{DottedName: "org.tm", ICANN: true},
+	{DottedName: "org.tn", ICANN: true},
+	{DottedName: "org.to", ICANN: true},
+	{DottedName: "org.tr", ICANN: true},
+	{DottedName: "org.tt", ICANN: true},
+	{DottedName: "org.tw", ICANN: true},
+	{DottedName: "org.ua", ICANN: true},
+	{DottedName: "org.ug", ICANN: true},
+	{DottedName: "org.uk", ICANN: true},
+	{DottedName: "org.uy", ICANN: true},
+	{DottedName: "org.uz", ICANN: true},
+	{DottedName: "org.vc", ICANN: true},
+	{DottedName: "org.ve", ICANN: true},
+	{DottedName: "org.vi", ICANN: true},
+	{DottedName: "org.vn", ICANN: true},
+	{DottedName: "org.vu", ICANN: true},
+	{DottedName: "org.ws", ICANN: true},
+	{DottedName: "org.ye", ICANN: true},
+	{DottedName: "org.za", ICANN: true},
+	{DottedName: "org.zm", ICANN: true},
+	{DottedName: "org.zw", ICANN: true},
+	{DottedName: "organic", ICANN: true},
+	{DottedName: "origins", ICANN: true},
+	{DottedName: "oristano.it", ICANN: true},
+	{DottedName: "orkanger.no", ICANN: true},
+	{DottedName: "orkdal.no", ICANN: true},
+	{DottedName: "orland.no", ICANN: true},
+	{DottedName: "orskog.no", ICANN: true},
+	{DottedName: "orsta.no", ICANN: true},
+	{DottedName: "os.hedmark.no", ICANN: true},
+	{DottedName: "os.hordaland.no", ICANN: true},
+	{DottedName: "osaka", ICANN: true},
+	{DottedName: "osaka.jp", ICANN: true},
+	{DottedName: "osakasayama.osaka.jp", ICANN: true},
+	{DottedName: "osaki.miyagi.jp", ICANN: true},
+	{DottedName: "osakikamijima.hiroshima.jp", ICANN: true},
+	{DottedName: "osasco.br", ICANN: true},
+	{DottedName: "osen.no", ICANN: true},
+	{DottedName: "oseto.nagasaki.jp", ICANN: true},
+	{DottedName: "oshima.tokyo.jp", ICANN: true},
+	{DottedName: "oshima.yamaguchi.jp", ICANN: true},
+	{DottedName: "oshino.yamanashi.jp", ICANN: true},
+	{DottedName: "oshu.iwate.jp", ICANN: true},
+	{DottedName: "oslo.no", ICANN: true},
+	{DottedName: "osoyro.no", ICANN: true},
+	{DottedName: "osteroy.no", ICANN: true},
+	{DottedName: "ostre-toten.no", ICANN: true},
+	{DottedName: "ostroda.pl", ICANN: true},
+	{DottedName: "ostroleka.pl", ICANN: true},
+	{DottedName: "ostrowiec.pl", ICANN: true},
+	{DottedName: "ostrowwlkp.pl", ICANN: true},
+	{DottedName: "ot.it", ICANN: true},
+	{DottedName: "ota.gunma.jp", ICANN: true},
+	{DottedName: "ota.tokyo.jp", ICANN: true},
+	{DottedName: "otago.museum", ICANN: true},
+	{DottedName: "otake.hiroshima.jp", ICANN: true},
+	{DottedName: "otaki.chiba.jp", ICANN: true},
+	{DottedName: "otaki.nagano.jp", ICANN: true},
+	{DottedName: "otaki.saitama.jp", ICANN: true},
+	{DottedName: "otama.fukushima.jp", ICANN: true},
+	{DottedName: "otari.nagano.jp", ICANN: true},
+	{DottedName: "otaru.hokkaido.jp", ICANN: true},
+	{DottedName: "other.nf", ICANN: true},
+	{DottedName: "oto.fukuoka.jp", ICANN: true},
+	{DottedName: "otobe.hokkaido.jp", ICANN: true},
+	{DottedName: "otofuke.hokkaido.jp", ICANN: true},
+	{DottedName: "otoineppu.hokkaido.jp", ICANN: true},
+	{DottedName: "otoyo.kochi.jp", ICANN: true},
+	{DottedName: "otsu.shiga.jp", ICANN: true},
+	{DottedName: "otsuchi.iwate.jp", ICANN: true},
+	{DottedName: "otsuka", ICANN: true},
+	{DottedName: "otsuki.kochi.jp", ICANN: true},
+	{DottedName: "otsuki.yamanashi.jp", ICANN: true},
+	{DottedName: "ott", ICANN: true},
+	{DottedName: "ouchi.saga.jp", ICANN: true},
+	{DottedName: "ouda.nara.jp", ICANN: true},
+	{DottedName: "oum.gov.pl", ICANN: true},
+	{DottedName: "oumu.hokkaido.jp", ICANN: true},
+	{DottedName: "overhalla.no", ICANN: true},
+	{DottedName: "ovh", ICANN: true},
+	{DottedName: "ovre-eiker.no", ICANN: true},
+	{DottedName: "owani.aomori.jp", ICANN: true},
+	{DottedName: "owariasahi.aichi.jp", ICANN: true},
+	{DottedName: "oxford.museum", ICANN: true},
+	{DottedName: "oyabe.toyama.jp", ICANN: true},
+	{DottedName: "oyama.tochigi.jp", ICANN: true},
+	{DottedName: "oyamazaki.kyoto.jp", ICANN: true},
+	{DottedName: "oyer.no", ICANN: true},
+	{DottedName: "oygarden.no", ICANN: true},
+	{DottedName: "oyodo.nara.jp", ICANN: true},
+	{DottedName: "oystre-slidre.no", ICANN: true},
+	{DottedName: "oz.au", ICANN: true},
+	{DottedName: "ozora.hokkaido.jp", ICANN: true},
+	{DottedName: "ozu.ehime.jp", ICANN: true},
+	{DottedName: "ozu.kumamoto.jp", ICANN: true},
+	{DottedName: "p.bg", ICANN: true},
+	{DottedName: "p.se", ICANN: true},
+	{DottedName: "pa", ICANN: true},
+	{DottedName: "pa.gov.br", ICANN: true},
+	{DottedName: "pa.gov.pl", ICANN: true},
+	{DottedName: "pa.it", ICANN: true},
+	{DottedName: "pa.us", ICANN: true},
+	{DottedName: "pacific.museum", ICANN: true},
+	{DottedName: "paderborn.museum", ICANN: true},
+	{DottedName: "padova.it", ICANN: true},
+	{DottedName: "padua.it", ICANN: true},
+	{DottedName: "page", ICANN: true},
+	{DottedName: "palace.museum", ICANN: true},
+	{DottedName: "paleo.museum", ICANN: true},
+	{DottedName: "palermo.it", ICANN: true},
+	{DottedName: "palmas.br", ICANN: true},
+	{DottedName: "palmsprings.museum", ICANN: true},
+	{DottedName: "panama.museum", ICANN: true},
+	{DottedName: "panasonic", ICANN: true},
+	{DottedName: "parachuting.aero", ICANN: true},
+	{DottedName: "paragliding.aero", ICANN: true},
+	{DottedName: "paris", ICANN: true},
+	{DottedName: "paris.museum", ICANN: true},
+	{DottedName: "parliament.cy", ICANN: true},
+	{DottedName: "parliament.nz", ICANN: true},
+	{DottedName: "parma.it", ICANN: true},
+	{DottedName: "paroch.k12.ma.us", ICANN: true},
+	{DottedName: "pars", ICANN: true},
+	{DottedName: "parti.se", ICANN: true},
+	{DottedName: "partners", ICANN: true},
+	{DottedName: "parts", ICANN: true},
+	{DottedName: "party", ICANN: true},
+	{DottedName: "pasadena.museum", ICANN: true},
+	{DottedName: "passagens", ICANN: true},
+	{DottedName: "passenger-association.aero", ICANN: true},
+	{DottedName: "patria.bo", ICANN: true},
+	{DottedName: "pavia.it", ICANN: true},
+	{DottedName: "pay", ICANN: true},
+	{DottedName: "pb.ao", ICANN: true},
+	{DottedName: "pb.gov.br", ICANN: true},
+	{DottedName: "pc.it", ICANN: true},
+	{DottedName: "pc.pl", ICANN: true},
+	{DottedName: "pccw", ICANN: true},
+	{DottedName: "pd.it", ICANN: true},
+	{DottedName: "pe", ICANN: true},
+	{DottedName: "pe.ca", ICANN: true},
+	{DottedName: "pe.gov.br", ICANN: true},
+	{DottedName: "pe.it", ICANN: true},
+	{DottedName: "pe.kr", ICANN: true},
+	{DottedName: "per.la", ICANN: true},
+	{DottedName: "per.nf", ICANN: true},
+	{DottedName: "per.sg", ICANN: true},
+	{DottedName: "perso.ht", ICANN: true},
+	{DottedName: "perso.sn", ICANN: true},
+	{DottedName: "perso.tn", ICANN: true},
+	{DottedName: "perugia.it", ICANN: true},
+	{DottedName: "pesaro-urbino.it", ICANN: true},
+	{DottedName: "pesarourbino.it", ICANN: true},
+	{DottedName: "pescara.it", ICANN: true},
+	{DottedName: "pet", ICANN: true},
+	{DottedName: "pf", ICANN: true},
+	{DottedName: "pfizer", ICANN: true},
+	{DottedName: "pg.it", ICANN: true},
+	{DottedName: "ph", ICANN: true},
+	{DottedName: "pharmacien.fr", ICANN: true},
+	{DottedName: "pharmaciens.km", ICANN: true},
+	{DottedName: "pharmacy", ICANN: true},
+	{DottedName: "pharmacy.museum", ICANN: true},
+	{DottedName: "phd", ICANN: true},
+	{DottedName: "philadelphia.museum", ICANN: true},
+	{DottedName: "philadelphiaarea.museum", ICANN: true},
+	{DottedName: "philately.museum", ICANN: true},
+	{DottedName: "philips", ICANN: true},
+	{DottedName: "phoenix.museum", ICANN: true},
+	{DottedName: "phone", ICANN: true},
+	{DottedName: "photo", ICANN: true},
+	{DottedName: "photography", ICANN: true},
+	{DottedName: "photography.museum", ICANN: true},
+	{DottedName: "photos", ICANN: true},
+	{DottedName: "physio", ICANN: true},
+	{DottedName: "pi.gov.br", ICANN: true},
+	{DottedName: "pi.it", ICANN: true},
+	{DottedName: "piacenza.it", ICANN: true},
+	{DottedName: "pics", ICANN: true},
+	{DottedName: "pictet", ICANN: true},
+	{DottedName: "pictures", ICANN: true},
+	{DottedName: "pid", ICANN: true},
+	{DottedName: "piedmont.it", ICANN: true},
+	{DottedName: "piemonte.it", ICANN: true},
+	{DottedName: "pila.pl", ICANN: true},
+	{DottedName: "pilot.aero", ICANN: true},
+	{DottedName: "pilots.museum", ICANN: true},
+	{DottedName: "pin", ICANN: true},
+	{DottedName: "pinb.gov.pl", ICANN: true},
+	{DottedName: "ping", ICANN: true},
+	{DottedName: "pink", ICANN: true},
+	{DottedName: "pioneer", ICANN: true},
+	{DottedName: "pippu.hokkaido.jp", ICANN: true},
+	{DottedName: "pisa.it", ICANN: true},
+	{DottedName: "pistoia.it", ICANN: true},
+	{DottedName: "pisz.pl", ICANN: true},
+	{DottedName: "pittsburgh.museum", ICANN: true},
+	{DottedName: "piw.gov.pl", ICANN: true},
+	{DottedName: "pizza", ICANN: true},
+	{DottedName: "pk", ICANN: true},
+	{DottedName: "pl", ICANN: true},
+	{DottedName: "pl.ua", ICANN: true},
+	{DottedName: "place", ICANN: true},
+	{DottedName: "planetarium.museum", ICANN: true},
+	{DottedName: "plantation.museum", ICANN: true},
+	{DottedName: "plants.museum", ICANN: true},
+	{DottedName: "play", ICANN: true},
+	{DottedName: "playstation", ICANN: true},
+	{DottedName: "plaza.museum", ICANN: true},
+	{DottedName: "plc.co.im", ICANN: true},
+	{DottedName: "plc.ly", ICANN: true},
+	{DottedName: "plc.uk", ICANN: true},
+	{DottedName: "plo.ps", ICANN: true},
+	{DottedName: "plumbing", ICANN: true},
+	{DottedName: "plurinacional.bo", ICANN: true},
+	{DottedName: "plus", ICANN: true},
+	{DottedName: "pm", ICANN: true},
+	{DottedName: "pmn.it", ICANN: true},
+	{DottedName: "pn", ICANN: true},
+	{DottedName: "pn.it", ICANN: true},
+	{DottedName: "pnc", ICANN: true},
+	{DottedName: "po.gov.pl", ICANN: true},
+	{DottedName: "po.it", ICANN: true},
+	{DottedName: "poa.br", ICANN: true},
+	{DottedName: "podhale.pl", ICANN: true},
+	{DottedName: "podlasie.pl", ICANN: true},
+	{DottedName: "pohl", ICANN: true},
+	{DottedName: "poker", ICANN: true},
+	{DottedName: "pol.dz", ICANN: true},
+	{DottedName: "pol.ht", ICANN: true},
+	{DottedName: "pol.tr", ICANN: true},
+	{DottedName: "police.uk", ICANN: true},
+	{DottedName: "politica.bo", ICANN: true},
+	{DottedName: "politie", ICANN: true},
+	{DottedName: "polkowice.pl", ICANN: true},
+	{DottedName: "poltava.ua", ICANN: true},
+	{DottedName: "pomorskie.pl", ICANN: true},
+	{DottedName: "pomorze.pl", ICANN: true},
+	{DottedName: "ponpes.id", ICANN: true},
+	{DottedName: "pordenone.it", ICANN: true},
+	{DottedName: "porn", ICANN: true},
+	{DottedName: "porsanger.no", ICANN: true},
+	{DottedName: "porsangu.no", ICANN: true},
+	{DottedName: "porsgrunn.no", ICANN: true},
+	{DottedName: "port.fr", ICANN: true},
+	{DottedName: "portal.museum", ICANN: true},
+	{DottedName: "portland.museum", ICANN: true},
+	{DottedName: "portlligat.museum", ICANN: true},
+	{DottedName: "post", ICANN: true},
+	{DottedName: "posts-and-telecommunications.museum", ICANN: true},
+	{DottedName: "potenza.it", ICANN: true},
+	{DottedName: "powiat.pl", ICANN: true},
+	{DottedName: "pp.az", ICANN: true},
+	{DottedName: "pp.se", ICANN: true},
+	{DottedName: "ppg.br", ICANN: true},
+	{DottedName: "pr", ICANN: true},
+	{DottedName: "pr.gov.br", ICANN: true},
+	{DottedName: "pr.it", ICANN: true},
+	{DottedName: "pr.us", ICANN: true},
+	{DottedName: "pramerica", ICANN: true},
+	{DottedName: "prato.it", ICANN: true},
+	{DottedName: "praxi", ICANN: true},
+	{DottedName: "prd.fr", ICANN: true},
+	{DottedName: "prd.km", ICANN: true},
+	{DottedName: "prd.mg", ICANN: true},
+	{DottedName: "preservation.museum", ICANN: true},
+	{DottedName: "presidio.museum", ICANN: true},
+	{DottedName: "press", ICANN: true},
+	{DottedName: "press.aero", ICANN: true},
+	{DottedName: "press.cy", ICANN: true},
+	{DottedName: "press.ma", ICANN: true},
+	{DottedName: "press.museum", ICANN: true},
+	{DottedName: "press.se", ICANN: true},
+	{DottedName: "presse.ci", ICANN: true},
+	{DottedName: "presse.km", ICANN: true},
+	{DottedName: "presse.ml", ICANN: true},
+	{DottedName: "pri.ee", ICANN: true},
+	{DottedName: "prime", ICANN: true},
+	{DottedName: "principe.st", ICANN: true},
+	{DottedName: "priv.hu", ICANN: true},
+	{DottedName: "priv.me", ICANN: true},
+	{DottedName: "priv.no", ICANN: true},
+	{DottedName: "priv.pl", ICANN: true},
+	{DottedName: "pro", ICANN: true},
+	{DottedName: "pro.az", ICANN: true},
+	{DottedName: "pro.br", ICANN: true},
+	{DottedName: "pro.cy", ICANN: true},
+	{DottedName: "pro.ec", ICANN: true},
+	{DottedName: "pro.fj", ICANN: true},
+	{DottedName: "pro.ht", ICANN: true},
+	{DottedName: "pro.mv", ICANN: true},
+	{DottedName: "pro.na", ICANN: true},
+	{DottedName: "pro.om", ICANN: true},
+	{DottedName: "pro.pr", ICANN: true},
+	{DottedName: "pro.tt", ICANN: true},
+	{DottedName: "pro.vn", ICANN: true},
+	{DottedName: "prochowice.pl", ICANN: true},
+	{DottedName: "prod", ICANN: true},
+	{DottedName: "production.aero", ICANN: true},
+	{DottedName: "productions", ICANN: true},
+	{DottedName: "prof", ICANN: true},
+	{DottedName: "prof.pr", ICANN: true},
+	{DottedName: "profesional.bo", ICANN: true},
+	{DottedName: "progressive", ICANN: true},
+	{DottedName: "project.museum", ICANN: true},
+	{DottedName: "promo", ICANN: true},
+	{DottedName: "properties", ICANN: true},
+	{DottedName: "property", ICANN: true},
+	{DottedName: "protection", ICANN: true},
+	{DottedName: "pru", ICANN: true},
+	{DottedName: "prudential", ICANN: true},
+	{DottedName: "pruszkow.pl", ICANN: true},
+	{DottedName: "przeworsk.pl", ICANN: true},
+	{DottedName: "ps", ICANN: true},
+	{DottedName: "psc.br", ICANN: true},
+	{DottedName: "psi.br", ICANN: true},
+	{DottedName: "psp.gov.pl", ICANN: true},
+	{DottedName: "psse.gov.pl", ICANN: true},
+	{DottedName: "pt", ICANN: true},
+	{DottedName: "pt.it", ICANN: true},
+	{DottedName: "pu.it", ICANN: true},
+	{DottedName: "pub", ICANN: true},
+	{DottedName: "pub.sa", ICANN: true},
+	{DottedName: "publ.pt", ICANN: true},
+	{DottedName: "public.museum", ICANN: true},
+	{DottedName: "pubol.museum", ICANN: true},
+	{DottedName: "pueblo.bo", ICANN: true},
+	{DottedName: "pug.it", ICANN: true},
+	{DottedName: "puglia.it", ICANN: true},
+	{DottedName: "pulawy.pl", ICANN: true},
+	{DottedName: "pup.gov.pl", ICANN: true},
+	{DottedName: "pv.it", ICANN: true},
+	{DottedName: "pvh.br", ICANN: true},
+	{DottedName: "pvt.ge", ICANN: true},
+	{DottedName: "pvt.k12.ma.us", ICANN: true},
+	{DottedName: "pw", ICANN: true},
+	{DottedName: "pwc", ICANN: true},
+	{DottedName: "py", ICANN: true},
+	{DottedName: "pz.it", ICANN: true},
+	{DottedName: "q.bg", ICANN: true},
+	{DottedName: "qa", ICANN: true},
+	{DottedName: "qc.ca", ICANN: true},
+	{DottedName: "qh.cn", ICANN: true},
+	{DottedName: "qld.au", ICANN: true},
+	{DottedName: "qld.edu.au", ICANN: true},
+	{DottedName: "qld.gov.au", ICANN: true},
+	{DottedName: "qpon", ICANN: true},
+	{DottedName: "qsl.br", ICANN: true},
+	{DottedName: "quebec", ICANN: true},
+	{DottedName: "quebec.museum", ICANN: true},
+	{DottedName: "quest", ICANN: true},
+	{DottedName: "r.bg", ICANN: true},
+	{DottedName: "r.se", ICANN: true},
+	{DottedName: "ra.it", ICANN: true},
+	{DottedName: "racing", ICANN: true},
+	{DottedName: "rade.no", ICANN: true},
+	{DottedName: "radio", ICANN: true},
+	{DottedName: "radio.br", ICANN: true},
+	{DottedName: "radom.pl", ICANN: true},
+	{DottedName: "radoy.no", ICANN: true},
+	{DottedName: "ragusa.it", ICANN: true},
+	{DottedName: "rahkkeravju.no", ICANN: true},
+	{DottedName: "raholt.no", ICANN: true},
+	{DottedName: "raid", ICANN: true},
+	{DottedName: "railroad.museum", ICANN: true},
+	{DottedName: "railway.museum", ICANN: true},
+	{DottedName: "raisa.no", ICANN: true},
+	{DottedName: "rakkestad.no", ICANN: true},
+	{DottedName: "ralingen.no", ICANN: true},
+	{DottedName: "rana.no", ICANN: true},
+	{DottedName: "randaberg.no", ICANN: true},
+	{DottedName: "rankoshi.hokkaido.jp", ICANN: true},
+	{DottedName: "ranzan.saitama.jp", ICANN: true},
+	{DottedName: "rar.ve", ICANN: true},
+	{DottedName: "rauma.no", ICANN: true},
+	{DottedName: "ravenna.it", ICANN: true},
+	{DottedName: "rawa-maz.pl", ICANN: true},
+	{DottedName: "rc.it", ICANN: true},
+	{DottedName: "re", ICANN: true},
+	{DottedName: "re.it", ICANN: true},
+	{DottedName: "re.kr", ICANN: true},
+	{DottedName: "read", ICANN: true},
+	{DottedName: "realestate", ICANN: true},
+	{DottedName: "realestate.pl", ICANN: true},
+	{DottedName: "realtor", ICANN: true},
+	{DottedName: "realty", ICANN: true},
+	{DottedName: "rebun.hokkaido.jp", ICANN: true},
+	{DottedName: "rec.br", ICANN: true},
+	{DottedName: "rec.co", ICANN: true},
+	{DottedName: "rec.nf", ICANN: true},
+	{DottedName: "rec.ro", ICANN: true},
+	{DottedName: "rec.ve", ICANN: true},
+	{DottedName: "recht.pro", ICANN: true},
+	{DottedName: "recife.br", ICANN: true},
+	{DottedName: "recipes", ICANN: true},
+	{DottedName: "recreation.aero", ICANN: true},
+	{DottedName: "red", ICANN: true},
+	{DottedName: "red.sv", ICANN: true},
+	{DottedName: "redstone", ICANN: true},
+	{DottedName: "redumbrella", ICANN: true},
+	{DottedName: "reggio-calabria.it", ICANN: true},
+	{DottedName: "reggio-emilia.it", ICANN: true},
+	{DottedName: "reggiocalabria.it", ICANN: true},
+	{DottedName: "reggioemilia.it", ICANN: true},
+	{DottedName: "rehab", ICANN: true},
+	{DottedName: "reise", ICANN: true},
+	{DottedName: "reisen", ICANN: true},
+	{DottedName: "reit", ICANN: true},
+	{DottedName: "reklam.hu", ICANN: true},
+	{DottedName: "rel.ht", ICANN: true},
+	{DottedName: "rel.pl", ICANN: true},
+	{DottedName: "reliance", ICANN: true},
+	{DottedName: "ren", ICANN: true},
+	{DottedName: "rendalen.no", ICANN: true},
+	{DottedName: "rennebu.no", ICANN: true},
+	{DottedName: "rennesoy.no", ICANN: true},
+	{DottedName: "rent", ICANN: true},
+	{DottedName: "rentals", ICANN: true},
+	{DottedName: "rep.br", ICANN: true},
+	{DottedName: "rep.kp", ICANN: true},
+	{DottedName: "repair", ICANN: true},
+	{DottedName: "repbody.aero", ICANN: true},
+	{DottedName: "report", ICANN: true},
+	{DottedName: "republican", ICANN: true},
+	{DottedName: "res.aero", ICANN: true},
+	{DottedName: "res.in", ICANN: true},
+	{DottedName: "research.aero", ICANN: true},
+	{DottedName: "research.museum", ICANN: true},
+	{DottedName: "resistance.museum", ICANN: true},
+	{DottedName: "rest", ICANN: true},
+	{DottedName: "restaurant", ICANN: true},
+	{DottedName: "review", ICANN: true},
+	{DottedName: "reviews", ICANN: true},
+	{DottedName: "revista.bo", ICANN: true},
+	{DottedName: "rexroth", ICANN: true},
+	{DottedName: "rg.it", ICANN: true},
+	{DottedName: "ri.it", ICANN: true},
+	{DottedName: "ri.us", ICANN: true},
+	{DottedName: "ribeirao.br", ICANN: true},
+	{DottedName: "rich", ICANN: true},
+	{DottedName: "richardli", ICANN: true},
+	{DottedName: "ricoh", ICANN: true},
+	{DottedName: "rieti.it", ICANN: true},
+	{DottedName: "rifu.miyagi.jp", ICANN: true},
+	{DottedName: "riik.ee", ICANN: true},
+	{DottedName: "rikubetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "rikuzentakata.iwate.jp", ICANN: true},
+	{DottedName: "ril", ICANN: true},
+	{DottedName: "rimini.it", ICANN: true},
+	{DottedName: "rindal.no", ICANN: true},
+	{DottedName: "ringebu.no", ICANN: true},
+	{DottedName: "ringerike.no", ICANN: true},
+	{DottedName: "ringsaker.no", ICANN: true},
+	{DottedName: "rio", ICANN: true},
+	{DottedName: "rio.br", ICANN: true},
+	{DottedName: "riobranco.br", ICANN: true},
+	{DottedName: "riodejaneiro.museum", ICANN: true},
+	{DottedName: "riopreto.br", ICANN: true},
+	{DottedName: "rip", ICANN: true},
+	{DottedName: "rishiri.hokkaido.jp", ICANN: true},
+	{DottedName: "rishirifuji.hokkaido.jp", ICANN: true},
+	{DottedName: "risor.no", ICANN: true},
+	{DottedName: "rissa.no", ICANN: true},
+	{DottedName: "ritto.shiga.jp", ICANN: true},
+	{DottedName: "rivne.ua", ICANN: true},
+	{DottedName: "rj.gov.br", ICANN: true},
+	{DottedName: "rl.no", ICANN: true},
+	{DottedName: "rm.it", ICANN: true},
+	{DottedName: "rn.gov.br", ICANN: true},
+	{DottedName: "rn.it", ICANN: true},
+	{DottedName: "ro", ICANN: true},
+	{DottedName: "ro.gov.br", ICANN: true},
+	{DottedName: "ro.it", ICANN: true},
+	{DottedName: "roan.no", ICANN: true},
+	{DottedName: "rocher", ICANN: true},
+	{DottedName: "rochester.museum", ICANN: true},
+	{DottedName: "rockart.museum", ICANN: true},
+	{DottedName: "rocks", ICANN: true},
+	{DottedName: "rodeo", ICANN: true},
+	{DottedName: "rodoy.no", ICANN: true},
+	{DottedName: "rogers", ICANN: true},
+	{DottedName: "rokunohe.aomori.jp", ICANN: true},
+	{DottedName: "rollag.no", ICANN: true},
+	{DottedName: "roma.it", ICANN: true},
+	{DottedName: "roma.museum", ICANN: true},
+	{DottedName: "rome.it", ICANN: true},
+	{DottedName: "romsa.no", ICANN: true},
+	{DottedName: "romskog.no", ICANN: true},
+	{DottedName: "room", ICANN: true},
+	{DottedName: "roros.no", ICANN: true},
+	{DottedName: "rost.no", ICANN: true},
+	{DottedName: "rotorcraft.aero", ICANN: true},
+	{DottedName: "rovigo.it", ICANN: true},
+	{DottedName: "rovno.ua", ICANN: true},
+	{DottedName: "royken.no", ICANN: true},
+	{DottedName: "royrvik.no", ICANN: true},
+	{DottedName: "rr.gov.br", ICANN: true},
+	{DottedName: "rs", ICANN: true},
+	{DottedName: "rs.gov.br", ICANN: true},
+	{DottedName: "rsvp", ICANN: true},
+	{DottedName: "ru", ICANN: true},
+	{DottedName: "rugby", ICANN: true},
+	{DottedName: "ruhr", ICANN: true},
+	{DottedName: "run", ICANN: true},
+	{DottedName: "ruovat.no", ICANN: true},
+	{DottedName: "russia.museum", ICANN: true},
+	{DottedName: "rv.ua", ICANN: true},
+	{DottedName: "rw", ICANN: true},
+	{DottedName: "rwe", ICANN: true},
+	{DottedName: "rybnik.pl", ICANN: true},
+	{DottedName: "rygge.no", ICANN: true},
+	{DottedName: "ryokami.saitama.jp", ICANN: true},
+	{DottedName: "ryugasaki.ibaraki.jp", ICANN: true},
+	{DottedName: "ryukyu", ICANN: true},
+	{DottedName: "ryuoh.shiga.jp", ICANN: true},
+	{DottedName: "rzeszow.pl", ICANN: true},
+	{DottedName: "rzgw.gov.pl", ICANN: true},
+	{DottedName: "s.bg", ICANN: true},
+	{DottedName: "s.se", ICANN: true},
+	{DottedName: "sa", ICANN: true},
+	{DottedName: "sa.au", ICANN: true},
+	{DottedName: "sa.cr", ICANN: true},
+	{DottedName: "sa.edu.au", ICANN: true},
+	{DottedName: "sa.gov.au", ICANN: true},
+	{DottedName: "sa.gov.pl", ICANN: true},
+	{DottedName: "sa.it", ICANN: true},
+	{DottedName: "saarland", ICANN: true},
+	{DottedName: "sabae.fukui.jp", ICANN: true},
+	{DottedName: "sado.niigata.jp", ICANN: true},
+	{DottedName: "safe", ICANN: true},
+	{DottedName: "safety", ICANN: true},
+	{DottedName: "safety.aero", ICANN: true},
+	{DottedName: "saga.jp", ICANN: true},
+	{DottedName: "saga.saga.jp", ICANN: true},
+	{DottedName: "sagae.yamagata.jp", ICANN: true},
+	{DottedName: "sagamihara.kanagawa.jp", ICANN: true},
+	{DottedName: "saigawa.fukuoka.jp", ICANN: true},
+	{DottedName: "saijo.ehime.jp", ICANN: true},
+	{DottedName: "saikai.nagasaki.jp", ICANN: true},
+	{DottedName: "saiki.oita.jp", ICANN: true},
+	{DottedName: "saintlouis.museum", ICANN: true},
+	{DottedName: "saitama.jp", ICANN: true},
+	{DottedName: "saitama.saitama.jp", ICANN: true},
+	{DottedName: "saito.miyazaki.jp", ICANN: true},
+	{DottedName: "saka.hiroshima.jp", ICANN: true},
+	{DottedName: "sakado.saitama.jp", ICANN: true},
+	{DottedName: "sakae.chiba.jp", ICANN: true},
+	{DottedName: "sakae.nagano.jp", ICANN: true},
+	{DottedName: "sakahogi.gifu.jp", ICANN: true},
+	{DottedName: "sakai.fukui.jp", ICANN: true},
+	{DottedName: "sakai.ibaraki.jp", ICANN: true},
+	{DottedName: "sakai.osaka.jp", ICANN: true},
+	{DottedName: "sakaiminato.tottori.jp", ICANN: true},
+	{DottedName: "sakaki.nagano.jp", ICANN: true},
+	{DottedName: "sakata.yamagata.jp", ICANN: true},
+	{DottedName: "sakawa.kochi.jp", ICANN: true},
+	{DottedName: "sakegawa.yamagata.jp", ICANN: true},
+	{DottedName: "saku.nagano.jp", ICANN: true},
+	{DottedName: "sakuho.nagano.jp", ICANN: true},
+	{DottedName: "sakura", ICANN: true},
+	{DottedName: "sakura.chiba.jp", ICANN: true},
+	{DottedName: "sakura.tochigi.jp", ICANN: true},
+	{DottedName: "sakuragawa.ibaraki.jp", ICANN: true},
+	{DottedName: "sakurai.nara.jp", ICANN: true},
+	{DottedName: "sakyo.kyoto.jp", ICANN: true},
+	{DottedName: "salangen.no", ICANN: true},
+	{DottedName: "salat.no", ICANN: true},
+	{DottedName: "sale", ICANN: true},
+	{DottedName: "salem.museum", ICANN: true},
+	{DottedName: "salerno.it", ICANN: true},
+	{DottedName: "salon", ICANN: true},
+	{DottedName: "saltdal.no", ICANN: true},
+	{DottedName: "salud.bo", ICANN: true},
+	{DottedName: "salvador.br", ICANN: true},
+	{DottedName: "salvadordali.museum", ICANN: true},
+	{DottedName: "salzburg.museum", ICANN: true},
+	{DottedName: "samegawa.fukushima.jp", ICANN: true},
+	{DottedName: "samnanger.no", ICANN: true},
+	{DottedName: "sampa.br", ICANN: true},
+	{DottedName: "samsclub", ICANN: true},
+	{DottedName: "samsung", ICANN: true},
+	{DottedName: "samukawa.kanagawa.jp", ICANN: true},
+	{DottedName: "sanagochi.tokushima.jp", ICANN: true},
+	{DottedName: "sanda.hyogo.jp", ICANN: true},
+	{DottedName: "sande.more-og-romsdal.no", ICANN: true},
+	{DottedName: "sande.vestfold.no", ICANN: true},
+	{DottedName: "sande.xn--mre-og-romsdal-qqb.no", ICANN: true, Text: "sande.møre-og-romsdal.no"},
+	{DottedName: "sandefjord.no", ICANN: true},
+	{DottedName: "sandiego.museum", ICANN: true},
+	{DottedName: "sandnes.no", ICANN: true},
+	{DottedName: "sandnessjoen.no", ICANN: true},
+	{DottedName: "sandoy.no", ICANN: true},
+	{DottedName: "sandvik", ICANN: true},
+	{DottedName: "sandvikcoromant", ICANN: true},
+	{DottedName: "sanfrancisco.museum", ICANN: true},
+	{DottedName: "sango.nara.jp", ICANN: true},
+	{DottedName: "sanjo.niigata.jp", ICANN: true},
+	{DottedName: "sannan.hyogo.jp", ICANN: true},
+	{DottedName: "sannohe.aomori.jp", ICANN: true},
+	{DottedName: "sano.tochigi.jp", ICANN: true},
+	{DottedName: "sanofi", ICANN: true},
+	{DottedName: "sanok.pl", ICANN: true},
+	{DottedName: "santabarbara.museum", ICANN: true},
+	{DottedName: "santacruz.museum", ICANN: true},
+	{DottedName: "santafe.museum", ICANN: true},
+	{DottedName: "santamaria.br", ICANN: true},
+	{DottedName: "santoandre.br", ICANN: true},
+	{DottedName: "sanuki.kagawa.jp", ICANN: true},
+	{DottedName: "saobernardo.br", ICANN: true},
+	{DottedName: "saogonca.br", ICANN: true},
+	{DottedName: "saotome.st", ICANN: true},
+	{DottedName: "sap", ICANN: true},
+	{DottedName: "sar.it", ICANN: true},
+	{DottedName: "sardegna.it", ICANN: true},
+	{DottedName: "sardinia.it", ICANN: true},
+	{DottedName: "sarl", ICANN: true},
+	{DottedName: "saroma.hokkaido.jp", ICANN: true},
+	{DottedName: "sarpsborg.no", ICANN: true},
+	{DottedName: "sarufutsu.hokkaido.jp", ICANN: true},
+	{DottedName: "sas", ICANN: true},
+	{DottedName: "sasaguri.fukuoka.jp", ICANN: true},
+	{DottedName: "sasayama.hyogo.jp", ICANN: true},
+	{DottedName: "sasebo.nagasaki.jp", ICANN: true},
+	{DottedName: "saskatchewan.museum", ICANN: true},
+	{DottedName: "sassari.it", ICANN: true},
+	{DottedName: "satosho.okayama.jp", ICANN: true},
+	{DottedName: "satsumasendai.kagoshima.jp", ICANN: true},
+	{DottedName: "satte.saitama.jp", ICANN: true},
+	{DottedName: "satx.museum", ICANN: true},
+	{DottedName: "sauda.no", ICANN: true},
+	{DottedName: "sauherad.no", ICANN: true},
+	{DottedName: "savannahga.museum", ICANN: true},
+	{DottedName: "save", ICANN: true},
+	{DottedName: "savona.it", ICANN: true},
+	{DottedName: "saxo", ICANN: true},
+	{DottedName: "sayama.osaka.jp", ICANN: true},
+	{DottedName: "sayama.saitama.jp", ICANN: true},
+	{DottedName: "sayo.hyogo.jp", ICANN: true},
+	{DottedName: "sb", ICANN: true},
+	{DottedName: "sb.ua", ICANN: true},
+	{DottedName: "sbi", ICANN: true},
+	{DottedName: "sbs", ICANN: true},
+	{DottedName: "sc", ICANN: true},
+	{DottedName: "sc.cn", ICANN: true},
+	{DottedName: "sc.gov.br", ICANN: true},
+	{DottedName: "sc.ke", ICANN: true},
+	{DottedName: "sc.kr", ICANN: true},
+	{DottedName: "sc.ls", ICANN: true},
+	{DottedName: "sc.tz", ICANN: true},
+	{DottedName: "sc.ug", ICANN: true},
+	{DottedName: "sc.us", ICANN: true},
+	{DottedName: "sca", ICANN: true},
+	{DottedName: "scb", ICANN: true},
+	{DottedName: "sch.ae", ICANN: true},
+	{DottedName: "sch.id", ICANN: true},
+	{DottedName: "sch.ir", ICANN: true},
+	{DottedName: "sch.jo", ICANN: true},
+	{DottedName: "sch.lk", ICANN: true},
+	{DottedName: "sch.ly", ICANN: true},
+	{DottedName: "sch.ng", ICANN: true},
+	{DottedName: "sch.qa", ICANN: true},
+	{DottedName: "sch.sa", ICANN: true},
+	{DottedName: "sch.ss", ICANN: true},
+	{DottedName: "sch.zm", ICANN: true},
+	{DottedName: "schaeffler", ICANN: true},
+	{DottedName: "schlesisches.museum", ICANN: true},
+	{DottedName: "schmidt", ICANN: true},
+	{DottedName: "schoenbrunn.museum", ICANN: true},
+	{DottedName: "schokoladen.museum", ICANN: true},
+	{DottedName: "scholarships", ICANN: true},
+	{DottedName: "school", ICANN: true},
+	{DottedName: "school.museum", ICANN: true},
+	{DottedName: "school.na", ICANN: true},
+	{DottedName: "school.nz", ICANN: true},
+	{DottedName: "school.za", ICANN: true},
+	{DottedName: "schools.nsw.edu.au", ICANN: true},
+	{DottedName: "schule", ICANN: true},
+	{DottedName: "schwarz", ICANN: true},
+	{DottedName: "schweiz.museum", ICANN: true},
+	{DottedName: "sci.eg", ICANN: true},
+	{DottedName: "science", ICANN: true},
+	{DottedName: "science-fiction.museum", ICANN: true},
+	{DottedName: "science.museum", ICANN: true},
+	{DottedName: "scienceandhistory.museum", ICANN: true},
+	{DottedName: "scienceandindustry.museum", ICANN: true},
+	{DottedName: "sciencecenter.museum", ICANN: true},
+	{DottedName: "sciencecenters.museum", ICANN: true},
+	{DottedName: "sciencehistory.museum", ICANN: true},
+	{DottedName: "sciences.museum", ICANN: true},
+	{DottedName: "sciencesnaturelles.museum", ICANN: true},
+	{DottedName: "scientist.aero", ICANN: true},
+	{DottedName: "scjohnson", ICANN: true},
+	{DottedName: "scot", ICANN: true},
+	{DottedName: "scotland.museum", ICANN: true},
+	{DottedName: "sd", ICANN: true},
+	{DottedName: "sd.cn", ICANN: true},
+	{DottedName: "sd.us", ICANN: true},
+	{DottedName: "sdn.gov.pl", ICANN: true},
+	{DottedName: "se", ICANN: true},
+	{DottedName: "se.gov.br", ICANN: true},
+	{DottedName: "seaport.museum", ICANN: true},
+	{DottedName: "search", ICANN: true},
+	{DottedName: "seat", ICANN: true},
+	{DottedName: "sebastopol.ua", ICANN: true},
+	{DottedName: "sec.ps", ICANN: true},
+	{DottedName: "secure", ICANN: true},
+	{DottedName: "security", ICANN: true},
+	{DottedName: "seek", ICANN: true},
+	{DottedName: "seg.br", ICANN: true},
+	{DottedName: "seihi.nagasaki.jp", ICANN: true},
+	{DottedName: "seika.kyoto.jp", ICANN: true},
+	{DottedName: "seiro.niigata.jp", ICANN: true},
+	{DottedName: "seirou.niigata.jp", ICANN: true},
+	{DottedName: "seiyo.ehime.jp", ICANN: true},
+	{DottedName: "sejny.pl", ICANN: true},
+	{DottedName: "seki.gifu.jp", ICANN: true},
+	{DottedName: "sekigahara.gifu.jp", ICANN: true},
+	{DottedName: "sekikawa.niigata.jp", ICANN: true},
+	{DottedName: "sel.no", ICANN: true},
+	{DottedName: "selbu.no", ICANN: true},
+	{DottedName: "select", ICANN: true},
+	{DottedName: "selje.no", ICANN: true},
+	{DottedName: "seljord.no", ICANN: true},
+	{DottedName: "semboku.akita.jp", ICANN: true},
+	{DottedName: "semine.miyagi.jp", ICANN: true},
+	{DottedName: "senasa.ar", ICANN: true},
+	{DottedName: "sener", ICANN: true},
+	{DottedName: "sennan.osaka.jp", ICANN: true},
+	{DottedName: "seoul.kr", ICANN: true},
+	{DottedName: "sera.hiroshima.jp", ICANN: true},
+	{DottedName: "seranishi.hiroshima.jp", ICANN: true},
+	{DottedName: "services", ICANN: true},
+	{DottedName: "services.aero", ICANN: true},
+	{DottedName: "ses", ICANN: true},
+	{DottedName: "setagaya.tokyo.jp", ICANN: true},
+	{DottedName: "seto.aichi.jp", ICANN: true},
+	{DottedName: "setouchi.okayama.jp", ICANN: true},
+	{DottedName: "settlement.museum", ICANN: true},
+	{DottedName: "settlers.museum", ICANN: true},
+	{DottedName: "settsu.osaka.jp", ICANN: true},
+	{DottedName: "sevastopol.ua", ICANN: true},
+	{DottedName: "seven", ICANN: true},
+	{DottedName: "sew", ICANN: true},
+	{DottedName: "sex", ICANN: true},
+	{DottedName: "sex.hu", ICANN: true},
+	{DottedName: "sex.pl", ICANN: true},
+	{DottedName: "sexy", ICANN: true},
+	{DottedName: "sf.no", ICANN: true},
+	{DottedName: "sfr", ICANN: true},
+	{DottedName: "sg", ICANN: true},
+	{DottedName: "sh", ICANN: true},
+	{DottedName: "sh.cn", ICANN: true},
+	{DottedName: "shakotan.hokkaido.jp", ICANN: true},
+	{DottedName: "shangrila", ICANN: true},
+	{DottedName: "shari.hokkaido.jp", ICANN: true},
+	{DottedName: "sharp", ICANN: true},
+	{DottedName: "shaw", ICANN: true},
+	{DottedName: "shell", ICANN: true},
+	{DottedName: "shell.museum", ICANN: true},
+	{DottedName: "sherbrooke.museum", ICANN: true},
+	{DottedName: "shia", ICANN: true},
+	{DottedName: "shibata.miyagi.jp", ICANN: true},
+	{DottedName: "shibata.niigata.jp", ICANN: true},
+	{DottedName: "shibecha.hokkaido.jp", ICANN: true},
+	{DottedName: "shibetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "shibukawa.gunma.jp", ICANN: true},
+	{DottedName: "shibuya.tokyo.jp", ICANN: true},
+	{DottedName: "shichikashuku.miyagi.jp", ICANN: true},
+	{DottedName: "shichinohe.aomori.jp", ICANN: true},
+	{DottedName: "shiga.jp", ICANN: true},
+	{DottedName: "shiiba.miyazaki.jp", ICANN: true},
+	{DottedName: "shijonawate.osaka.jp", ICANN: true},
+	{DottedName: "shika.ishikawa.jp", ICANN: true},
+	{DottedName: "shikabe.hokkaido.jp", ICANN: true},
+	{DottedName: "shikama.miyagi.jp", ICANN: true},
+	{DottedName: "shikaoi.hokkaido.jp", ICANN: true},
+	{DottedName: "shikatsu.aichi.jp", ICANN: true},
+	{DottedName: "shiki.saitama.jp", ICANN: true},
+	{DottedName: "shikokuchuo.ehime.jp", ICANN: true},
+	{DottedName: "shiksha", ICANN: true},
+	{DottedName: "shima.mie.jp", ICANN: true},
+	{DottedName: "shimabara.nagasaki.jp", ICANN: true},
+	{DottedName: "shimada.shizuoka.jp", ICANN: true},
+	{DottedName: "shimamaki.hokkaido.jp", ICANN: true},
+	{DottedName: "shimamoto.osaka.jp", ICANN: true},
+	{DottedName: "shimane.jp", ICANN: true},
+	{DottedName: "shimane.shimane.jp", ICANN: true},
+	{DottedName: "shimizu.hokkaido.jp", ICANN: true},
+	{DottedName: "shimizu.shizuoka.jp", ICANN: true},
+	{DottedName: "shimoda.shizuoka.jp", ICANN: true},
+	{DottedName: "shimodate.ibaraki.jp", ICANN: true},
+	{DottedName: "shimofusa.chiba.jp", ICANN: true},
+	{DottedName: "shimogo.fukushima.jp", ICANN: true},
+	{DottedName: "shimoichi.nara.jp", ICANN: true},
+	{DottedName: "shimoji.okinawa.jp", ICANN: true},
+	{DottedName: "shimokawa.hokkaido.jp", ICANN: true},
+	{DottedName: "shimokitayama.nara.jp", ICANN: true},
+	{DottedName: "shimonita.gunma.jp", ICANN: true},
+	{DottedName: "shimonoseki.yamaguchi.jp", ICANN: true},
+	{DottedName: "shimosuwa.nagano.jp", ICANN: true},
+	{DottedName: "shimotsuke.tochigi.jp", ICANN: true},
+	{DottedName: "shimotsuma.ibaraki.jp", ICANN: true},
+	{DottedName: "shinagawa.tokyo.jp", ICANN: true},
+	{DottedName: "shinanomachi.nagano.jp", ICANN: true},
+	{DottedName: "shingo.aomori.jp", ICANN: true},
+	{DottedName: "shingu.fukuoka.jp", ICANN: true},
+	{DottedName: "shingu.hyogo.jp", ICANN: true},
+	{DottedName: "shingu.wakayama.jp", ICANN: true},
+	{DottedName: "shinichi.hiroshima.jp", ICANN: true},
+	{DottedName: "shinjo.nara.jp", ICANN: true},
+	{DottedName: "shinjo.okayama.jp", ICANN: true},
+	{DottedName: "shinjo.yamagata.jp", ICANN: true},
+	{DottedName: "shinjuku.tokyo.jp", ICANN: true},
+	{DottedName: "shinkamigoto.nagasaki.jp", ICANN: true},
+	{DottedName: "shinonsen.hyogo.jp", ICANN: true},
+	{DottedName: "shinshinotsu.hokkaido.jp", ICANN: true},
+	{DottedName: "shinshiro.aichi.jp", ICANN: true},
+	{DottedName: "shinto.gunma.jp", ICANN: true},
+	{DottedName: "shintoku.hokkaido.jp", ICANN: true},
+	{DottedName: "shintomi.miyazaki.jp", ICANN: true},
+	{DottedName: "shinyoshitomi.fukuoka.jp", ICANN: true},
+	{DottedName: "shiogama.miyagi.jp", ICANN: true},
+	{DottedName: "shiojiri.nagano.jp", ICANN: true},
+	{DottedName: "shioya.tochigi.jp", ICANN: true},
+	{DottedName: "shirahama.wakayama.jp", ICANN: true},
+	{DottedName: "shirakawa.fukushima.jp", ICANN: true},
+	{DottedName: "shirakawa.gifu.jp", ICANN: true},
+	{DottedName: "shirako.chiba.jp", ICANN: true},
+	{DottedName: "shiranuka.hokkaido.jp", ICANN: true},
+	{DottedName: "shiraoi.hokkaido.jp", ICANN: true},
+	{DottedName: "shiraoka.saitama.jp", ICANN: true},
+	{DottedName: "shirataka.yamagata.jp", ICANN: true},
+	{DottedName: "shiriuchi.hokkaido.jp", ICANN: true},
+	{DottedName: "shiroi.chiba.jp", ICANN: true},
+	{DottedName: "shiroishi.miyagi.jp", ICANN: true},
+	{DottedName: "shiroishi.saga.jp", ICANN: true},
+	{DottedName: "shirosato.ibaraki.jp", ICANN: true},
+	{DottedName: "shishikui.tokushima.jp", ICANN: true},
+	{DottedName: "shiso.hyogo.jp", ICANN: true},
+	{DottedName: "shisui.chiba.jp", ICANN: true},
+	{DottedName: "shitara.aichi.jp", ICANN: true},
+	{DottedName: "shiwa.iwate.jp", ICANN: true},
+	{DottedName: "shizukuishi.iwate.jp", ICANN: true},
+	{DottedName: "shizuoka.jp", ICANN: true},
+	{DottedName: "shizuoka.shizuoka.jp", ICANN: true},
+	{DottedName: "shobara.hiroshima.jp", ICANN: true},
+	{DottedName: "shoes", ICANN: true},
+	{DottedName: "shonai.fukuoka.jp", ICANN: true},
+	{DottedName: "shonai.yamagata.jp", ICANN: true},
+	{DottedName: "shoo.okayama.jp", ICANN: true},
+	{DottedName: "shop", ICANN: true},
+	{DottedName: "shop.ht", ICANN: true},
+	{DottedName: "shop.hu", ICANN: true},
+	{DottedName: "shop.pl", ICANN: true},
+	{DottedName: "shopping", ICANN: true},
+	{DottedName: "shouji", ICANN: true},
+	{DottedName: "show", ICANN: true},
+	{DottedName: "show.aero", ICANN: true},
+	{DottedName: "showa.fukushima.jp", ICANN: true},
+	{DottedName: "showa.gunma.jp", ICANN: true},
+	{DottedName: "showa.yamanashi.jp", ICANN: true},
+	{DottedName: "showtime", ICANN: true},
+	{DottedName: "shunan.yamaguchi.jp", ICANN: true},
+	{DottedName: "si", ICANN: true},
+	{DottedName: "si.it", ICANN: true},
+	{DottedName: "sibenik.museum", ICANN: true},
+	{DottedName: "sic.it", ICANN: true},
+	{DottedName: "sicilia.it", ICANN: true},
+	{DottedName: "sicily.it", ICANN: true},
+	{DottedName: "siellak.no", ICANN: true},
+	{DottedName: "siena.it", ICANN: true},
+	{DottedName: "sigdal.no", ICANN: true},
+	{DottedName: "siljan.no", ICANN: true},
+	{DottedName: "silk", ICANN: true},
+	{DottedName: "silk.museum", ICANN: true},
+	{DottedName: "sina", ICANN: true},
+	{DottedName: "singles", ICANN: true},
+	{DottedName: "siracusa.it", ICANN: true},
+	{DottedName: "sirdal.no", ICANN: true},
+	{DottedName: "site", ICANN: true},
+	{DottedName: "sj", ICANN: true},
+	{DottedName: "sjc.br", ICANN: true},
+	{DottedName: "sk", ICANN: true},
+	{DottedName: "sk.ca", ICANN: true},
+	{DottedName: "skanit.no", ICANN: true},
+	{DottedName: "skanland.no", ICANN: true},
+	{DottedName: "skaun.no", ICANN: true},
+	{DottedName: "skedsmo.no", ICANN: true},
+	{DottedName: "skedsmokorset.no", ICANN: true},
+	{DottedName: "ski", ICANN: true},
+	{DottedName: "ski.museum", ICANN: true},
+	{DottedName: "ski.no", ICANN: true},
+	{DottedName: "skien.no", ICANN: true},
+	{DottedName: "skierva.no", ICANN: true},
+	{DottedName: "skin", ICANN: true},
+	{DottedName: "skiptvet.no", ICANN: true},
+	{DottedName: "skjak.no", ICANN: true},
+	{DottedName: "skjervoy.no", ICANN: true},
+	{DottedName: "sklep.pl", ICANN: true},
+	{DottedName: "sko.gov.pl", ICANN: true},
+	{DottedName: "skoczow.pl", ICANN: true},
+	{DottedName: "skodje.no", ICANN: true},
+	{DottedName: "skole.museum", ICANN: true},
+	{DottedName: "sky", ICANN: true},
+	{DottedName: "skydiving.aero", ICANN: true},
+	{DottedName: "skype", ICANN: true},
+	{DottedName: "sl", ICANN: true},
+	{DottedName: "slask.pl", ICANN: true},
+	{DottedName: "slattum.no", ICANN: true},
+	{DottedName: "sld.do", ICANN: true},
+	{DottedName: "sld.pa", ICANN: true},
+	{DottedName: "slg.br", ICANN: true},
+	{DottedName: "sling", ICANN: true},
+	{DottedName: "slupsk.pl", ICANN: true},
+	{DottedName: "slz.br", ICANN: true},
+	{DottedName: "sm", ICANN: true},
+	{DottedName: "sm.ua", ICANN: true},
+	{DottedName: "smart", ICANN: true},
+	{DottedName: "smile", ICANN: true},
+	{DottedName: "smola.no", ICANN: true},
+	{DottedName: "sn", ICANN: true},
+	{DottedName: "sn.cn", ICANN: true},
+	{DottedName: "snaase.no", ICANN: true},
+	{DottedName: "snasa.no", ICANN: true},
+	{DottedName: "sncf", ICANN: true},
+	{DottedName: "snillfjord.no", ICANN: true},
+	{DottedName: "snoasa.no", ICANN: true},
+	{DottedName: "so", ICANN: true},
+	{DottedName: "so.gov.pl", ICANN: true},
+	{DottedName: "so.it", ICANN: true},
+	{DottedName: "sobetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "soc.dz", ICANN: true},
+	{DottedName: "soc.lk", ICANN: true},
+	{DottedName: "soccer", ICANN: true},
+	{DottedName: "social", ICANN: true},
+	{DottedName: "society.museum", ICANN: true},
+	{DottedName: "sodegaura.chiba.jp", ICANN: true},
+	{DottedName: "soeda.fukuoka.jp", ICANN: true},
+	{DottedName: "softbank", ICANN: true},
+	{DottedName: "software", ICANN: true},
+	{DottedName: "software.aero", ICANN: true},
+	{DottedName: "sogndal.no", ICANN: true},
+	{DottedName: "sogne.no", ICANN: true},
+	{DottedName: "sohu", ICANN: true},
+	{DottedName: "soja.okayama.jp", ICANN: true},
+	{DottedName: "soka.saitama.jp", ICANN: true},
+	{DottedName: "sokndal.no", ICANN: true},
+	{DottedName: "sola.no", ICANN: true},
+	{DottedName: "solar", ICANN: true},
+	{DottedName: "sologne.museum", ICANN: true},
+	{DottedName: "solund.no", ICANN: true},
+	{DottedName: "solutions", ICANN: true},
+	{DottedName: "soma.fukushima.jp", ICANN: true},
+	{DottedName: "somna.no", ICANN: true},
+	{DottedName: "sondre-land.no", ICANN: true},
+	{DottedName: "sondrio.it", ICANN: true},
+	{DottedName: "song", ICANN: true},
+	{DottedName: "songdalen.no", ICANN: true},
+	{DottedName: "soni.nara.jp", ICANN: true},
+	{DottedName: "sony", ICANN: true},
+	{DottedName: "soo.kagoshima.jp", ICANN: true},
+	{DottedName: "sor-aurdal.no", ICANN: true},
+	{DottedName: "sor-fron.no", ICANN: true},
+	{DottedName: "sor-odal.no", ICANN: true},
+	{DottedName: "sor-varanger.no", ICANN: true},
+	{DottedName: "sorfold.no", ICANN: true},
+	{DottedName: "sorocaba.br", ICANN: true},
+	{DottedName: "sorreisa.no", ICANN: true},
+	{DottedName: "sortland.no", ICANN: true},
+	{DottedName: "sorum.no", ICANN: true},
+	{DottedName: "sos.pl", ICANN: true},
+	{DottedName: "sosa.chiba.jp", ICANN: true},
+	{DottedName: "sosnowiec.pl", ICANN: true},
+	{DottedName: "soundandvision.museum", ICANN: true},
+	{DottedName: "southcarolina.museum", ICANN: true},
+	{DottedName: "southwest.museum", ICANN: true},
+	{DottedName: "sowa.ibaraki.jp", ICANN: true},
+	{DottedName: "soy", ICANN: true},
+	{DottedName: "sp.gov.br", ICANN: true},
+	{DottedName: "sp.it", ICANN: true},
+	{DottedName: "spa", ICANN: true},
+	{DottedName: "space", ICANN: true},
+	{DottedName: "space.museum", ICANN: true},
+	{DottedName: "spjelkavik.no", ICANN: true},
+	{DottedName: "sport", ICANN: true},
+	{DottedName: "sport.hu", ICANN: true},
+	{DottedName: "spot", ICANN: true},
+	{DottedName: "spy.museum", ICANN: true},
+	{DottedName: "spydeberg.no", ICANN: true},
+	{DottedName: "square.museum", ICANN: true},
+	{DottedName: "sr", ICANN: true},
+	{DottedName: "sr.gov.pl", ICANN: true},
+	{DottedName: "sr.it", ICANN: true},
+	{DottedName: "srl", ICANN: true},
+	{DottedName: "srv.br", ICANN: true},
+	{DottedName: "ss", ICANN: true},
+	{DottedName: "ss.it", ICANN: true},
+	{DottedName: "st", ICANN: true},
+	{DottedName: "st.no", ICANN: true},
+	{DottedName: "stada", ICANN: true},
+	{DottedName: "stadt.museum", ICANN: true},
+	{DottedName: "stalbans.museum", ICANN: true},
+	{DottedName: "stalowa-wola.pl", ICANN: true},
+	{DottedName: "stange.no", ICANN: true},
+	{DottedName: "staples", ICANN: true},
+	{DottedName: "star", ICANN: true},
+	{DottedName: "starachowice.pl", ICANN: true},
+	{DottedName: "stargard.pl", ICANN: true},
+	{DottedName: "starnberg.museum", ICANN: true},
+	{DottedName: "starostwo.gov.pl", ICANN: true},
+	{DottedName: "stat.no", ICANN: true},
+	{DottedName: "state.museum", ICANN: true},
+	{DottedName: "statebank", ICANN: true},
+	{DottedName: "statefarm", ICANN: true},
+	{DottedName: "stateofdelaware.museum", ICANN: true},
+	{DottedName: "stathelle.no", ICANN: true},
+	{DottedName: "station.museum", ICANN: true},
+	{DottedName: "stavanger.no", ICANN: true},
+	{DottedName: "stavern.no", ICANN: true},
+	{DottedName: "stc", ICANN: true},
+	{DottedName: "stcgroup", ICANN: true},
+	{DottedName: "steam.museum", ICANN: true},
+	{DottedName: "steiermark.museum", ICANN: true},
+	{DottedName: "steigen.no", ICANN: true},
+	{DottedName: "steinkjer.no", ICANN: true},
+	{DottedName: "sth.ac.at", ICANN: true},
+	{DottedName: "stjohn.museum", ICANN: true},
+	{DottedName: "stjordal.no", ICANN: true},
+	{DottedName: "stjordalshalsen.no", ICANN: true},
+	{DottedName: "stockholm", ICANN: true},
+	{DottedName: "stockholm.museum", ICANN: true},
+	{DottedName: "stokke.no", ICANN: true},
+	{DottedName: "stor-elvdal.no", ICANN: true},
+	{DottedName: "storage", ICANN: true},
+	{DottedName: "stord.no", ICANN: true},
+	{DottedName: "stordal.no", ICANN: true},
+	{DottedName: "store", ICANN: true},
+	{DottedName: "store.bb", ICANN: true},
+	{DottedName: "store.nf", ICANN: true},
+	{DottedName: "store.ro", ICANN: true},
+	{DottedName: "store.st", ICANN: true},
+	{DottedName: "store.ve", ICANN: true},
+	{DottedName: "storfjord.no", ICANN: true},
+	{DottedName: "stpetersburg.museum", ICANN: true},
+	{DottedName: "strand.no", ICANN: true},
+	{DottedName: "stranda.no", ICANN: true},
+	{DottedName: "stream", ICANN: true},
+	{DottedName: "stryn.no", ICANN: true},
+	{DottedName: "student.aero", ICANN: true},
+	{DottedName: "studio", ICANN: true},
+	{DottedName: "study", ICANN: true},
+	{DottedName: "stuttgart.museum", ICANN: true},
+	{DottedName: "style", ICANN: true},
+	{DottedName: "su", ICANN: true},
+	{DottedName: "sucks", ICANN: true},
+	{DottedName: "sue.fukuoka.jp", ICANN: true},
+	{DottedName: "suedtirol.it", ICANN: true},
+	{DottedName: "suginami.tokyo.jp", ICANN: true},
+	{DottedName: "sugito.saitama.jp", ICANN: true},
+	{DottedName: "suifu.ibaraki.jp", ICANN: true},
+	{DottedName: "suisse.museum", ICANN: true},
+	{DottedName: "suita.osaka.jp", ICANN: true},
+	{DottedName: "sukagawa.fukushima.jp", ICANN: true},
+	{DottedName: "sukumo.kochi.jp", ICANN: true},
+	{DottedName: "sula.no", ICANN: true},
+	{DottedName: "suldal.no", ICANN: true},
+	{DottedName: "suli.hu", ICANN: true},
+	{DottedName: "sumida.tokyo.jp", ICANN: true},
+	{DottedName: "sumita.iwate.jp", ICANN: true},
+	{DottedName: "sumoto.hyogo.jp", ICANN: true},
+	{DottedName: "sumoto.kumamoto.jp", ICANN: true},
+	{DottedName: "sumy.ua", ICANN: true},
+	{DottedName: "sunagawa.hokkaido.jp", ICANN: true},
+	{DottedName: "sund.no", ICANN: true},
+	{DottedName: "sunndal.no", ICANN: true},
+	{DottedName: "supplies", ICANN: true},
+	{DottedName: "supply", ICANN: true},
+	{DottedName: "support", ICANN: true},
+	{DottedName: "surf", ICANN: true},
+	{DottedName: "surgeonshall.museum", ICANN: true},
+	{DottedName: "surgery", ICANN: true},
+	{DottedName: "surnadal.no", ICANN: true},
+	{DottedName: "surrey.museum", ICANN: true},
+	{DottedName: "susaki.kochi.jp", ICANN: true},
+	{DottedName: "susono.shizuoka.jp", ICANN: true},
+	{DottedName: "suwa.nagano.jp", ICANN: true},
+	{DottedName: "suwalki.pl", ICANN: true},
+	{DottedName: "suzaka.nagano.jp", ICANN: true},
+	{DottedName: "suzu.ishikawa.jp", ICANN: true},
+	{DottedName: "suzuka.mie.jp", ICANN: true},
+	{DottedName: "suzuki", ICANN: true},
+	{DottedName: "sv", ICANN: true},
+	{DottedName: "sv.it", ICANN: true},
+	{DottedName: "svalbard.no", ICANN: true},
+	{DottedName: "sveio.no", ICANN: true},
+	{DottedName: "svelvik.no", ICANN: true},
+	{DottedName: "svizzera.museum", ICANN: true},
+	{DottedName: "swatch", ICANN: true},
+	{DottedName: "sweden.museum", ICANN: true},
+	{DottedName: "swidnica.pl", ICANN: true},
+	{DottedName: "swiebodzin.pl", ICANN: true},
+	{DottedName: "swinoujscie.pl", ICANN: true},
+	{DottedName: "swiss", ICANN: true},
+	{DottedName: "sx", ICANN: true},
+	{DottedName: "sx.cn", ICANN: true},
+	{DottedName: "sy", ICANN: true},
+	{DottedName: "sydney", ICANN: true},
+	{DottedName: "sydney.museum", ICANN: true},
+	{DottedName: "sykkylven.no", ICANN: true},
+	{DottedName: "systems", ICANN: true},
+	{DottedName: "sz", ICANN: true},
+	{DottedName: "szczecin.pl", ICANN: true},
+	{DottedName: "szczytno.pl", ICANN: true},
+	{DottedName: "szex.hu", ICANN: true},
+	{DottedName: "szkola.pl", ICANN: true},
+	{DottedName: "t.bg", ICANN: true},
+	{DottedName: "t.se", ICANN: true},
+	{DottedName: "ta.it", ICANN: true},
+	{DottedName: "taa.it", ICANN: true},
+	{DottedName: "tab", ICANN: true},
+	{DottedName: "tabayama.yamanashi.jp", ICANN: true},
+	{DottedName: "tabuse.yamaguchi.jp", ICANN: true},
+	{DottedName: "tachiarai.fukuoka.jp", ICANN: true},
+	{DottedName: "tachikawa.tokyo.jp", ICANN: true},
+	{DottedName: "tadaoka.osaka.jp", ICANN: true},
+	{DottedName: "tado.mie.jp", ICANN: true},
+	{DottedName: "tadotsu.kagawa.jp", ICANN: true},
+	{DottedName: "tagajo.miyagi.jp", ICANN: true},
+	{DottedName: "tagami.niigata.jp", ICANN: true},
+	{DottedName: "tagawa.fukuoka.jp", ICANN: true},
+	{DottedName: "tahara.aichi.jp", ICANN: true},
+	{DottedName: "taiji.wakayama.jp", ICANN: true},
+	{DottedName: "taiki.hokkaido.jp", ICANN: true},
+	{DottedName: "taiki.mie.jp", ICANN: true},
+	{DottedName: "tainai.niigata.jp", ICANN: true},
+	{DottedName: "taipei", ICANN: true},
+	{DottedName: "taira.toyama.jp", ICANN: true},
+	{DottedName: "taishi.hyogo.jp", ICANN: true},
+	{DottedName: "taishi.osaka.jp", ICANN: true},
+	{DottedName: "taishin.fukushima.jp", ICANN: true},
+	{DottedName: "taito.tokyo.jp", ICANN: true},
+	{DottedName: "taiwa.miyagi.jp", ICANN: true},
+	{DottedName: "tajimi.gifu.jp", ICANN: true},
+	{DottedName: "tajiri.osaka.jp", ICANN: true},
+	{DottedName: "taka.hyogo.jp", ICANN: true},
+	{DottedName: "takagi.nagano.jp", ICANN: true},
+	{DottedName: "takahagi.ibaraki.jp", ICANN: true},
+	{DottedName: "takahama.aichi.jp", ICANN: true},
+	{DottedName: "takahama.fukui.jp", ICANN: true},
+	{DottedName: "takaharu.miyazaki.jp", ICANN: true},
+	{DottedName: "takahashi.okayama.jp", ICANN: true},
+	{DottedName: "takahata.yamagata.jp", ICANN: true},
+	{DottedName: "takaishi.osaka.jp", ICANN: true},
+	{DottedName: "takamatsu.kagawa.jp", ICANN: true},
+	{DottedName: "takamori.kumamoto.jp", ICANN: true},
+	{DottedName: "takamori.nagano.jp", ICANN: true},
+	{DottedName: "takanabe.miyazaki.jp", ICANN: true},
+	{DottedName: "takanezawa.tochigi.jp", ICANN: true},
+	{DottedName: "takaoka.toyama.jp", ICANN: true},
+	{DottedName: "takarazuka.hyogo.jp", ICANN: true},
+	{DottedName: "takasago.hyogo.jp", ICANN: true},
+	{DottedName: "takasaki.gunma.jp", ICANN: true},
+	{DottedName: "takashima.shiga.jp", ICANN: true},
+	{DottedName: "takasu.hokkaido.jp", ICANN: true},
+	{DottedName: "takata.fukuoka.jp", ICANN: true},
+	{DottedName: "takatori.nara.jp", ICANN: true},
+	{DottedName: "takatsuki.osaka.jp", ICANN: true},
+	{DottedName: "takatsuki.shiga.jp", ICANN: true},
+	{DottedName: "takayama.gifu.jp", ICANN: true},
+	{DottedName: "takayama.gunma.jp", ICANN: true},
+	{DottedName: "takayama.nagano.jp", ICANN: true},
+	{DottedName: "takazaki.miyazaki.jp", ICANN: true},
+	{DottedName: "takehara.hiroshima.jp", ICANN: true},
+	{DottedName: "taketa.oita.jp", ICANN: true},
+	{DottedName: "taketomi.okinawa.jp", ICANN: true},
+	{DottedName: "taki.mie.jp", ICANN: true},
+	{DottedName: "takikawa.hokkaido.jp", ICANN: true},
+	{DottedName: "takino.hyogo.jp", ICANN: true},
+	{DottedName: "takinoue.hokkaido.jp", ICANN: true},
+	{DottedName: "takko.aomori.jp", ICANN: true},
+	{DottedName: "tako.chiba.jp", ICANN: true},
+	{DottedName: "taku.saga.jp", ICANN: true},
+	{DottedName: "talk", ICANN: true},
+	{DottedName: "tama.tokyo.jp", ICANN: true},
+	{DottedName: "tamakawa.fukushima.jp", ICANN: true},
+	{DottedName: "tamaki.mie.jp", ICANN: true},
+	{DottedName: "tamamura.gunma.jp", ICANN: true},
+	{DottedName: "tamano.okayama.jp", ICANN: true},
+	{DottedName: "tamatsukuri.ibaraki.jp", ICANN: true},
+	{DottedName: "tamayu.shimane.jp", ICANN: true},
+	{DottedName: "tamba.hyogo.jp", ICANN: true},
+	{DottedName: "tana.no", ICANN: true},
+	{DottedName: "tanabe.kyoto.jp", ICANN: true},
+	{DottedName: "tanabe.wakayama.jp", ICANN: true},
+	{DottedName: "tanagura.fukushima.jp", ICANN: true},
+	{DottedName: "tananger.no", ICANN: true},
+	{DottedName: "tank.museum", ICANN: true},
+	{DottedName: "tanohata.iwate.jp", ICANN: true},
+	{DottedName: "taobao", ICANN: true},
+	{DottedName: "tara.saga.jp", ICANN: true},
+	{DottedName: "tarama.okinawa.jp", ICANN: true},
+	{DottedName: "taranto.it", ICANN: true},
+	{DottedName: "target", ICANN: true},
+	{DottedName: "targi.pl", ICANN: true},
+	{DottedName: "tarnobrzeg.pl", ICANN: true},
+	{DottedName: "tarui.gifu.jp", ICANN: true},
+	{DottedName: "tarumizu.kagoshima.jp", ICANN: true},
+	{DottedName: "tas.au", ICANN: true},
+	{DottedName: "tas.edu.au", ICANN: true},
+	{DottedName: "tas.gov.au", ICANN: true},
+	{DottedName: "tatamotors", ICANN: true},
+	{DottedName: "tatar", ICANN: true},
+	{DottedName: "tatebayashi.gunma.jp", ICANN: true},
+	{DottedName: "tateshina.nagano.jp", ICANN: true},
+	{DottedName: "tateyama.chiba.jp", ICANN: true},
+	{DottedName: "tateyama.toyama.jp", ICANN: true},
+	{DottedName: "tatsuno.hyogo.jp", ICANN: true},
+	{DottedName: "tatsuno.nagano.jp", ICANN: true},
+	{DottedName: "tattoo", ICANN: true},
+	{DottedName: "tawaramoto.nara.jp", ICANN: true},
+	{DottedName: "tax", ICANN: true},
+	{DottedName: "taxi", ICANN: true},
+	{DottedName: "taxi.br", ICANN: true},
+	{DottedName: "tc", ICANN: true},
+	{DottedName: "tc.br", ICANN: true},
+	{DottedName: "tci", ICANN: true},
+	{DottedName: "tcm.museum", ICANN: true},
+	{DottedName: "td", ICANN: true},
+	{DottedName: "tdk", ICANN: true},
+	{DottedName: "te.it", ICANN: true},
+	{DottedName: "te.ua", ICANN: true},
+	{DottedName: "team", ICANN: true},
+	{DottedName: "tec.br", ICANN: true},
+	{DottedName: "tec.mi.us", ICANN: true},
+	{DottedName: "tec.ve", ICANN: true},
+	{DottedName: "tech", ICANN: true},
+	{DottedName: "technology", ICANN: true},
+	{DottedName: "technology.museum", ICANN: true},
+	{DottedName: "tecnologia.bo", ICANN: true},
+	{DottedName: "tel", ICANN: true},
+	{DottedName: "tel.tr", ICANN: true},
+	{DottedName: "telekommunikation.museum", ICANN: true},
+	{DottedName: "television.museum", ICANN: true},
+	{DottedName: "temasek", ICANN: true},
+	{DottedName: "tempio-olbia.it", ICANN: true},
+	{DottedName: "tempioolbia.it", ICANN: true},
+	{DottedName: "tendo.yamagata.jp", ICANN: true},
+	{DottedName: "tenei.fukushima.jp", ICANN: true},
+	{DottedName: "tenkawa.nara.jp", ICANN: true},
+	{DottedName: "tennis", ICANN: true},
+	{DottedName: "tenri.nara.jp", ICANN: true},
+	{DottedName: "teo.br", ICANN: true},
+	{DottedName: "teramo.it", ICANN: true},
+	{DottedName: "terni.it", ICANN: true},
+	{DottedName: "ternopil.ua", ICANN: true},
+	{DottedName: "teshikaga.hokkaido.jp", ICANN: true},
+	{DottedName: "test.tj", ICANN: true},
+	{DottedName: "teva", ICANN: true},
+	{DottedName: "texas.museum", ICANN: true},
+	{DottedName: "textile.museum", ICANN: true},
+	{DottedName: "tf", ICANN: true},
+	{DottedName: "tg", ICANN: true},
+	{DottedName: "tgory.pl", ICANN: true},
+	{DottedName: "th", ICANN: true},
+	{DottedName: "thd", ICANN: true},
+	{DottedName: "the.br", ICANN: true},
+	{DottedName: "theater", ICANN: true},
+	{DottedName: "theater.museum", ICANN: true},
+	{DottedName: "theatre", ICANN: true},
+	{DottedName: "tiaa", ICANN: true},
+	{DottedName: "tickets", ICANN: true},
+	{DottedName: "tienda", ICANN: true},
+	{DottedName: "tiffany", ICANN: true},
+	{DottedName: "time.museum", ICANN: true},
+	{DottedName: "time.no", ICANN: true},
+	{DottedName: "timekeeping.museum", ICANN: true},
+	{DottedName: "tingvoll.no", ICANN: true},
+	{DottedName: "tinn.no", ICANN: true},
+	{DottedName: "tips", ICANN: true},
+	{DottedName: "tires", ICANN: true},
+	{DottedName: "tirol", ICANN: true},
+	{DottedName: "tj", ICANN: true},
+	{DottedName: "tj.cn", ICANN: true},
+	{DottedName: "tjeldsund.no", ICANN: true},
+	{DottedName: "tjmaxx", ICANN: true},
+	{DottedName: "tjome.no", ICANN: true},
+	{DottedName: "tjx", ICANN: true},
+	{DottedName: "tk", ICANN: true},
+	{DottedName: "tkmaxx", ICANN: true},
+	{DottedName: "tksat.bo", ICANN: true},
+	{DottedName: "tl", ICANN: true},
+	{DottedName: "tm", ICANN: true},
+	{DottedName: "tm.cy", ICANN: true},
+	{DottedName: "tm.dz", ICANN: true},
+	{DottedName: "tm.fr", ICANN: true},
+	{DottedName: "tm.hu", ICANN: true},
+	{DottedName: "tm.km", ICANN: true},
+	{DottedName: "tm.mc", ICANN: true},
+	{DottedName: "tm.mg", ICANN: true},
+	{DottedName: "tm.no", ICANN: true},
+	{DottedName: "tm.pl", ICANN: true},
+	{DottedName: "tm.ro", ICANN: true},
+	{DottedName: "tm.se", ICANN: true},
+	{DottedName: "tm.za", ICANN: true},
+	{DottedName: "tmall", ICANN: true},
+	{DottedName: "tmp.br", ICANN: true},
+	{DottedName: "tn", ICANN: true},
+	{DottedName: "tn.it", ICANN: true},
+	{DottedName: "tn.us", ICANN: true},
+	{DottedName: "to", ICANN: true},
+	{DottedName: "to.gov.br", ICANN: true},
+	{DottedName: "to.it", ICANN: true},
+	{DottedName: "toba.mie.jp", ICANN: true},
+	{DottedName: "tobe.ehime.jp", ICANN: true},
+	{DottedName: "tobetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "tobishima.aichi.jp", ICANN: true},
+	{DottedName: "tochigi.jp", ICANN: true},
+	{DottedName: "tochigi.tochigi.jp", ICANN: true},
+	{DottedName: "tochio.niigata.jp", ICANN: true},
+	{DottedName: "toda.saitama.jp", ICANN: true},
+	{DottedName: "today", ICANN: true},
+	{DottedName: "toei.aichi.jp", ICANN: true},
+	{DottedName: "toga.toyama.jp", ICANN: true},
+	{DottedName: "togakushi.nagano.jp", ICANN: true},
+	{DottedName: "togane.chiba.jp", ICANN: true},
+	{DottedName: "togitsu.nagasaki.jp", ICANN: true},
+	{DottedName: "togo.aichi.jp", ICANN: true},
+	{DottedName: "togura.nagano.jp", ICANN: true},
+	{DottedName: "tohma.hokkaido.jp", ICANN: true},
+	{DottedName: "tohnosho.chiba.jp", ICANN: true},
+	{DottedName: "toho.fukuoka.jp", ICANN: true},
+	{DottedName: "tokai.aichi.jp", ICANN: true},
+	{DottedName: "tokai.ibaraki.jp", ICANN: true},
+	{DottedName: "tokamachi.niigata.jp", ICANN: true},
+	{DottedName: "tokashiki.okinawa.jp", ICANN: true},
+	{DottedName: "toki.gifu.jp", ICANN: true},
+	{DottedName: "tokigawa.saitama.jp", ICANN: true},
+	{DottedName: "tokke.no", ICANN: true},
+	{DottedName: "tokoname.aichi.jp", ICANN: true},
+	{DottedName: "tokorozawa.saitama.jp", ICANN: true},
+	{DottedName: "tokushima.jp", ICANN: true},
+	{DottedName: "tokushima.tokushima.jp", ICANN: true},
+	{DottedName: "tokuyama.yamaguchi.jp", ICANN: true},
+	{DottedName: "tokyo", ICANN: true},
+	{DottedName: "tokyo.jp", ICANN: true},
+	{DottedName: "tolga.no", ICANN: true},
+	{DottedName: "tomakomai.hokkaido.jp", ICANN: true},
+	{DottedName: "tomari.hokkaido.jp", ICANN: true},
+	{DottedName: "tome.miyagi.jp", ICANN: true},
+	{DottedName: "tomi.nagano.jp", ICANN: true},
+	{DottedName: "tomigusuku.okinawa.jp", ICANN: true},
+	{DottedName: "tomika.gifu.jp", ICANN: true},
+	{DottedName: "tomioka.gunma.jp", ICANN: true},
+	{DottedName: "tomisato.chiba.jp", ICANN: true},
+	{DottedName: "tomiya.miyagi.jp", ICANN: true},
+	{DottedName: "tomobe.ibaraki.jp", ICANN: true},
+	{DottedName: "tonaki.okinawa.jp", ICANN: true},
+	{DottedName: "tonami.toyama.jp", ICANN: true},
+	{DottedName: "tondabayashi.osaka.jp", ICANN: true},
+	{DottedName: "tone.ibaraki.jp", ICANN: true},
+	{DottedName: "tono.iwate.jp", ICANN: true},
+	{DottedName: "tonosho.kagawa.jp", ICANN: true},
+	{DottedName: "tonsberg.no", ICANN: true},
+	{DottedName: "tools", ICANN: true},
+	{DottedName: "toon.ehime.jp", ICANN: true},
+	{DottedName: "top", ICANN: true},
+	{DottedName: "topology.museum", ICANN: true},
+	{DottedName: "torahime.shiga.jp", ICANN: true},
+	{DottedName: "toray", ICANN: true},
+	{DottedName: "toride.ibaraki.jp", ICANN: true},
+	{DottedName: "torino.it", ICANN: true},
+	{DottedName: "torino.museum", ICANN: true},
+	{DottedName: "torsken.no", ICANN: true},
+	{DottedName: "tos.it", ICANN: true},
+	{DottedName: "tosa.kochi.jp", ICANN: true},
+	{DottedName: "tosashimizu.kochi.jp", ICANN: true},
+	{DottedName: "toscana.it", ICANN: true},
+	{DottedName: "toshiba", ICANN: true},
+	{DottedName: "toshima.tokyo.jp", ICANN: true},
+	{DottedName: "tosu.saga.jp", ICANN: true},
+	{DottedName: "total", ICANN: true},
+	{DottedName: "tottori.jp", ICANN: true},
+	{DottedName: "tottori.tottori.jp", ICANN: true},
+	{DottedName: "touch.museum", ICANN: true},
+	{DottedName: "tourism.pl", ICANN: true},
+	{DottedName: "tourism.tn", ICANN: true},
+	{DottedName: "tours", ICANN: true},
+	{DottedName: "towada.aomori.jp", ICANN: true},
+	{DottedName: "town", ICANN: true},
+	{DottedName: "town.museum", ICANN: true},
+	{DottedName: "toya.hokkaido.jp", ICANN: true},
+	{DottedName: "toyako.hokkaido.jp", ICANN: true},
+	{DottedName: "toyama.jp", ICANN: true},
+	{DottedName: "toyama.toyama.jp", ICANN: true},
+	{DottedName: "toyo.kochi.jp", ICANN: true},
+	{DottedName: "toyoake.aichi.jp", ICANN: true},
+	{DottedName: "toyohashi.aichi.jp", ICANN: true},
+	{DottedName: "toyokawa.aichi.jp", ICANN: true},
+	{DottedName: "toyonaka.osaka.jp", ICANN: true},
+	{DottedName: "toyone.aichi.jp", ICANN: true},
+	{DottedName: "toyono.osaka.jp", ICANN: true},
+	{DottedName: "toyooka.hyogo.jp", ICANN: true},
+	{DottedName: "toyosato.shiga.jp", ICANN: true},
+	{DottedName: "toyota", ICANN: true},
+	{DottedName: "toyota.aichi.jp", ICANN: true},
+	{DottedName: "toyota.yamaguchi.jp", ICANN: true},
+	{DottedName: "toyotomi.hokkaido.jp", ICANN: true},
+	{DottedName: "toyotsu.fukuoka.jp", ICANN: true},
+	{DottedName: "toyoura.hokkaido.jp", ICANN: true},
+	{DottedName: "toys", ICANN: true},
+	{DottedName: "tozawa.yamagata.jp", ICANN: true},
+	{DottedName: "tozsde.hu", ICANN: true},
+	{DottedName: "tp.it", ICANN: true},
+	{DottedName: "tr", ICANN: true},
+	{DottedName: "tr.it", ICANN: true},
+	{DottedName: "tr.no", ICANN: true},
+	{DottedName: "tra.kp", ICANN: true},
+	{DottedName: "trade", ICANN: true},
+	{DottedName: "trader.aero", ICANN: true},
+	{DottedName: "trading", ICANN: true},
+	{DottedName: "trading.aero", ICANN: true},
+	{DottedName: "trainer.aero", ICANN: true},
+	{DottedName: "training", ICANN: true},
+	{DottedName: "trana.no", ICANN: true},
+	{DottedName: "tranby.no", ICANN: true},
+	{DottedName: "trani-andria-barletta.it", ICANN: true},
+	{DottedName: "trani-barletta-andria.it", ICANN: true},
+	{DottedName: "traniandriabarletta.it", ICANN: true},
+	{DottedName: "tranibarlettaandria.it", ICANN: true},
+	{DottedName: "tranoy.no", ICANN: true},
+	{DottedName: "transport.museum", ICANN: true},
+	{DottedName: "transporte.bo", ICANN: true},
+	{DottedName: "trapani.it", ICANN: true},
+	{DottedName: "travel", ICANN: true},
+	{DottedName: "travel.pl", ICANN: true},
+	{DottedName: "travel.tt", ICANN: true},
+	{DottedName: "travelchannel", ICANN: true},
+	{DottedName: "travelers", ICANN: true},
+	{DottedName: "travelersinsurance", ICANN: true},
+	{DottedName: "trd.br", ICANN: true},
+	{DottedName: "tree.museum", ICANN: true},
+	{DottedName: "trentin-sud-tirol.it", ICANN: true},
+	{DottedName: "trentin-sudtirol.it", ICANN: true},
+	{DottedName: "trentin-sued-tirol.it", ICANN: true},
+	{DottedName: "trentin-suedtirol.it", ICANN: true},
+	{DottedName: "trentino-a-adige.it", ICANN: true},
+	{DottedName: "trentino-aadige.it", ICANN: true},
+	{DottedName: "trentino-alto-adige.it", ICANN: true},
+	{DottedName: "trentino-altoadige.it", ICANN: true},
+	{DottedName: "trentino-s-tirol.it", ICANN: true},
+	{DottedName: "trentino-stirol.it", ICANN: true},
+	{DottedName: "trentino-sud-tirol.it", ICANN: true},
+	{DottedName: "trentino-sudtirol.it", ICANN: true},
+	{DottedName: "trentino-sued-tirol.it", ICANN: true},
+	{DottedName: "trentino-suedtirol.it", ICANN: true},
+	{DottedName: "trentino.it", ICANN: true},
+	{DottedName: "trentinoa-adige.it", ICANN: true},
+	{DottedName: "trentinoaadige.it", ICANN: true},
+	{DottedName: "trentinoalto-adige.it", ICANN: true},
+	{DottedName: "trentinoaltoadige.it", ICANN: true},
+	{DottedName: "trentinos-tirol.it", ICANN: true},
+	{DottedName: "trentinostirol.it", ICANN: true},
+	{DottedName: "trentinosud-tirol.it", ICANN: true},
+	{DottedName: "trentinosudtirol.it", ICANN: true},
+	{DottedName: "trentinosued-tirol.it", ICANN: true},
+	{DottedName: "trentinosuedtirol.it", ICANN: true},
+	{DottedName: "trentinsud-tirol.it", ICANN: true},
+	{DottedName: "trentinsudtirol.it", ICANN: true},
+	{DottedName: "trentinsued-tirol.it", ICANN: true},
+	{DottedName: "trentinsuedtirol.it", ICANN: true},
+	{DottedName: "trento.it", ICANN: true},
+	{DottedName: "treviso.it", ICANN: true},
+	{DottedName: "trieste.it", ICANN: true},
+	{DottedName: "troandin.no", ICANN: true},
+	{DottedName: "trogstad.no", ICANN: true},
+	{DottedName: "trolley.museum", ICANN: true},
+	{DottedName: "tromsa.no", ICANN: true},
+	{DottedName: "tromso.no", ICANN: true},
+	{DottedName: "trondheim.no", ICANN: true},
+	{DottedName: "trust", ICANN: true},
+	{DottedName: "trust.museum", ICANN: true},
+	{DottedName: "trustee.museum", ICANN: true},
+	{DottedName: "trv", ICANN: true},
+	{DottedName: "trysil.no", ICANN: true},
+	{DottedName: "ts.it", ICANN: true},
+	{DottedName: "tsk.tr", ICANN: true},
+	{DottedName: "tsu.mie.jp", ICANN: true},
+	{DottedName: "tsubame.niigata.jp", ICANN: true},
+	{DottedName: "tsubata.ishikawa.jp", ICANN: true},
+	{DottedName: "tsubetsu.hokkaido.jp", ICANN: true},
+	{DottedName: "tsuchiura.ibaraki.jp", ICANN: true},
+	{DottedName: "tsuga.tochigi.jp", ICANN: true},
+	{DottedName: "tsugaru.aomori.jp", ICANN: true},
+	{DottedName: "tsuiki.fukuoka.jp", ICANN: true},
+	{DottedName: "tsukigata.hokkaido.jp", ICANN: true},
+	{DottedName: "tsukiyono.gunma.jp", ICANN: true},
+	{DottedName: "tsukuba.ibaraki.jp", ICANN: true},
+	{DottedName: "tsukui.kanagawa.jp", ICANN: true},
+	{DottedName: "tsukumi.oita.jp", ICANN: true},
+	{DottedName: "tsumagoi.gunma.jp", ICANN: true},
+	{DottedName: "tsunan.niigata.jp", ICANN: true},
+	{DottedName: "tsuno.kochi.jp", ICANN: true},
+	{DottedName: "tsuno.miyazaki.jp", ICANN: true},
+	{DottedName: "tsuru.yamanashi.jp", ICANN: true},
+	{DottedName: "tsuruga.fukui.jp", ICANN: true},
+	{DottedName: "tsurugashima.saitama.jp", ICANN: true},
+	{DottedName: "tsurugi.ishikawa.jp", ICANN: true},
+	{DottedName: "tsuruoka.yamagata.jp", ICANN: true},
+	{DottedName: "tsuruta.aomori.jp", ICANN: true},
+	{DottedName: "tsushima.aichi.jp", ICANN: true},
+	{DottedName: "tsushima.nagasaki.jp", ICANN: true},
+	{DottedName: "tsuwano.shimane.jp", ICANN: true},
+	{DottedName: "tsuyama.okayama.jp", ICANN: true},
+	{DottedName: "tt", ICANN: true},
+	{DottedName: "tt.im", ICANN: true},
+	{DottedName: "tube", ICANN: true},
+	{DottedName: "tui", ICANN: true},
+	{DottedName: "tunes", ICANN: true},
+	{DottedName: "tur.ar", ICANN: true},
+	{DottedName: "tur.br", ICANN: true},
+	{DottedName: "turek.pl", ICANN: true},
+	{DottedName: "turin.it", ICANN: true},
+	{DottedName: "turystyka.pl", ICANN: true},
+	{DottedName: "tuscany.it", ICANN: true},
+	{DottedName: "tushu", ICANN: true},
+	{DottedName: "tv", ICANN: true},
+	{DottedName: "tv.bb", ICANN: true},
+	{DottedName: "tv.bo", ICANN: true},
+	{DottedName: "tv.br", ICANN: true},
+	{DottedName: "tv.im", ICANN: true},
+	{DottedName: "tv.it", ICANN: true},
+	{DottedName: "tv.na", ICANN: true},
+	{DottedName: "tv.sd", ICANN: true},
+	{DottedName: "tv.tr", ICANN: true},
+	{DottedName: "tv.tz", ICANN: true},
+	{DottedName: "tvedestrand.no", ICANN: true},
+	{DottedName: "tvs", ICANN: true},
+	{DottedName: "tw", ICANN: true},
+	{DottedName: "tw.cn", ICANN: true},
+	{DottedName: "tx.us", ICANN: true},
+	{DottedName: "tychy.pl", ICANN: true},
+	{DottedName: "tydal.no", ICANN: true},
+	{DottedName: "tynset.no", ICANN: true},
+	{DottedName: "tysfjord.no", ICANN: true},
+	{DottedName: "tysnes.no", ICANN: true},
+	{DottedName: "tysvar.no", ICANN: true},
+	{DottedName: "tz", ICANN: true},
+	{DottedName: "u.bg", ICANN: true},
+	{DottedName: "u.se", ICANN: true},
+	{DottedName: "ua", ICANN: true},
+	{DottedName: "ubank", ICANN: true},
+	{DottedName: "ube.yamaguchi.jp", ICANN: true},
+	{DottedName: "ubs", ICANN: true},
+	{DottedName: "uchihara.ibaraki.jp", ICANN: true},
+	{DottedName: "uchiko.ehime.jp", ICANN: true},
+	{DottedName: "uchinada.ishikawa.jp", ICANN: true},
+	{DottedName: "uchinomi.kagawa.jp", ICANN: true},
+	{DottedName: "ud.it", ICANN: true},
+	{DottedName: "uda.nara.jp", ICANN: true},
+	{DottedName: "udi.br", ICANN: true},
+	{DottedName: "udine.it", ICANN: true},
+	{DottedName: "udono.mie.jp", ICANN: true},
+	{DottedName: "ueda.nagano.jp", ICANN: true},
+	{DottedName: "ueno.gunma.jp", ICANN: true},
+	{DottedName: "uenohara.yamanashi.jp", ICANN: true},
+	{DottedName: "ug", ICANN: true},
+	{DottedName: "ug.gov.pl", ICANN: true},
+	{DottedName: "ugim.gov.pl", ICANN: true},
+	{DottedName: "uhren.museum", ICANN: true},
+	{DottedName: "uji.kyoto.jp", ICANN: true},
+	{DottedName: "ujiie.tochigi.jp", ICANN: true},
+	{DottedName: "ujitawara.kyoto.jp", ICANN: true},
+	{DottedName: "uk", ICANN: true},
+	{DottedName: "uki.kumamoto.jp", ICANN: true},
+	{DottedName: "ukiha.fukuoka.jp", ICANN: true},
+	{DottedName: "ullensaker.no", ICANN: true},
+	{DottedName: "ullensvang.no", ICANN: true},
+	{DottedName: "ulm.museum", ICANN: true},
+	{DottedName: "ulsan.kr", ICANN: true},
+	{DottedName: "ulvik.no", ICANN: true},
+	{DottedName: "um.gov.pl", ICANN: true},
+	{DottedName: "umaji.kochi.jp", ICANN: true},
+	{DottedName: "umb.it", ICANN: true},
+	{DottedName: "umbria.it", ICANN: true},
+	{DottedName: "umi.fukuoka.jp", ICANN: true},
+	{DottedName: "umig.gov.pl", ICANN: true},
+	{DottedName: "unazuki.toyama.jp", ICANN: true},
+	{DottedName: "undersea.museum", ICANN: true},
+	{DottedName: "unicom", ICANN: true},
+	{DottedName: "union.aero", ICANN: true},
+	{DottedName: "univ.sn", ICANN: true},
+	{DottedName: "university", ICANN: true},
+	{DottedName: "university.museum", ICANN: true},
+	{DottedName: "unjarga.no", ICANN: true},
+	{DottedName: "unnan.shimane.jp", ICANN: true},
+	{DottedName: "uno", ICANN: true},
+	{DottedName: "unzen.nagasaki.jp", ICANN: true},
+	{DottedName: "uol", ICANN: true},
+	{DottedName: "uonuma.niigata.jp", ICANN: true},
+	{DottedName: "uozu.toyama.jp", ICANN: true},
+	{DottedName: "upow.gov.pl", ICANN: true},
+	{DottedName: "uppo.gov.pl", ICANN: true},
+	{DottedName: "ups", ICANN: true},
+	{DottedName: "urakawa.hokkaido.jp", ICANN: true},
+	{DottedName: "urasoe.okinawa.jp", ICANN: true},
+	{DottedName: "urausu.hokkaido.jp", ICANN: true},
+	{DottedName: "urawa.saitama.jp", ICANN: true},
+	{DottedName: "urayasu.chiba.jp", ICANN: true},
+	{DottedName: "urbino-pesaro.it", ICANN: true},
+	{DottedName: "urbinopesaro.it", ICANN: true},
+	{DottedName: "ureshino.mie.jp", ICANN: true},
+	{DottedName: "uri.arpa", ICANN: true},
+	{DottedName: "urn.arpa", ICANN: true},
+	{DottedName: "uruma.okinawa.jp", ICANN: true},
+	{DottedName: "uryu.hokkaido.jp", ICANN: true},
+	{DottedName: "us", ICANN: true},
+	{DottedName: "us.gov.pl", ICANN: true},
+	{DottedName: "us.na", ICANN: true},
+	{DottedName: "usa.museum", ICANN: true},
+	{DottedName: "usa.oita.jp", ICANN: true},
+	{DottedName: "usantiques.museum", ICANN: true},
+	{DottedName: "usarts.museum", ICANN: true},
+	{DottedName: "uscountryestate.museum", ICANN: true},
+	{DottedName: "usculture.museum", ICANN: true},
+	{DottedName: "usdecorativearts.museum", ICANN: true},
+	{DottedName: "usgarden.museum", ICANN: true},
+	{DottedName: "ushiku.ibaraki.jp", ICANN: true},
+	{DottedName: "ushistory.museum", ICANN: true},
+	{DottedName: "ushuaia.museum", ICANN: true},
+	{DottedName: "uslivinghistory.museum", ICANN: true},
+	{DottedName: "ustka.pl", ICANN: true},
+	{DottedName: "usui.fukuoka.jp", ICANN: true},
+	{DottedName: "usuki.oita.jp", ICANN: true},
+	{DottedName: "ut.us", ICANN: true},
+	{DottedName: "utah.museum", ICANN: true},
+	{DottedName: "utashinai.hokkaido.jp", ICANN: true},
+	{DottedName: "utazas.hu", ICANN: true},
+	{DottedName: "utazu.kagawa.jp", ICANN: true},
+	{DottedName: "uto.kumamoto.jp", ICANN: true},
+	{DottedName: "utsira.no", ICANN: true},
+	{DottedName: "utsunomiya.tochigi.jp", ICANN: true},
+	{DottedName: "uvic.museum", ICANN: true},
+	{DottedName: "uw.gov.pl", ICANN: true},
+	{DottedName: "uwajima.ehime.jp", ICANN: true},
+	{DottedName: "uy", ICANN: true},
+	{DottedName: "uz", ICANN: true},
+	{DottedName: "uz.ua", ICANN: true},
+	{DottedName: "uzhgorod.ua", ICANN: true},
+	{DottedName: "uzs.gov.pl", ICANN: true},
+	{DottedName: "v.bg", ICANN: true},
+	{DottedName: "va", ICANN: true},
+	{DottedName: "va.it", ICANN: true},
+	{DottedName: "va.no", ICANN: true},
+	{DottedName: "va.us", ICANN: true},
+	{DottedName: "vaapste.no", ICANN: true},
+	{DottedName: "vacations", ICANN: true},
+	{DottedName: "vadso.no", ICANN: true},
+	{DottedName: "vaga.no", ICANN: true},
+	{DottedName: "vagan.no", ICANN: true},
+	{DottedName: "vagsoy.no", ICANN: true},
+	{DottedName: "vaksdal.no", ICANN: true},
+	{DottedName: "val-d-aosta.it", ICANN: true},
+	{DottedName: "val-daosta.it", ICANN: true},
+	{DottedName: "vald-aosta.it", ICANN: true},
+	{DottedName: "valdaosta.it", ICANN: true},
+	{DottedName: "valer.hedmark.no", ICANN: true},
+	{DottedName: "valer.ostfold.no", ICANN: true},
+	{DottedName: "valle-aosta.it", ICANN: true},
+	{DottedName: "valle-d-aosta.it", ICANN: true},
+	{DottedName: "valle-daosta.it", ICANN: true},
+	{DottedName: "valle.no", ICANN: true},
+	{DottedName: "valleaosta.it", ICANN: true},
+	{DottedName: "valled-aosta.it", ICANN: true},
+	{DottedName: "valledaosta.it", ICANN: true},
+	{DottedName: "vallee-aoste.it", ICANN: true},
+	{DottedName: "vallee-d-aoste.it", ICANN: true},
+	{DottedName: "valleeaoste.it", ICANN: true},
+	{DottedName: "valleedaoste.it", ICANN: true},
+	{DottedName: "valley.museum", ICANN: true},
+	{DottedName: "vana", ICANN: true},
+	{DottedName: "vang.no", ICANN: true},
+	{DottedName: "vanguard", ICANN: true},
+	{DottedName: "vantaa.museum", ICANN: true},
+	{DottedName: "vanylven.no", ICANN: true},
+	{DottedName: "vao.it", ICANN: true},
+	{DottedName: "vardo.no", ICANN: true},
+	{DottedName: "varese.it", ICANN: true},
+	{DottedName: "varggat.no", ICANN: true},
+	{DottedName: "varoy.no", ICANN: true},
+	{DottedName: "vb.it", ICANN: true},
+	{DottedName: "vc", ICANN: true},
+	{DottedName: "vc.it", ICANN: true},
+	{DottedName: "vda.it", ICANN: true},
+	{DottedName: "ve", ICANN: true},
+	{DottedName: "ve.it", ICANN: true},
+	{DottedName: "vefsn.no", ICANN: true},
+	{DottedName: "vega.no", ICANN: true},
+	{DottedName: "vegarshei.no", ICANN: true},
+	{DottedName: "vegas", ICANN: true},
+	{DottedName: "ven.it", ICANN: true},
+	{DottedName: "veneto.it", ICANN: true},
+	{DottedName: "venezia.it", ICANN: true},
+	{DottedName: "venice.it", ICANN: true},
+	{DottedName: "vennesla.no", ICANN: true},
+	{DottedName: "ventures", ICANN: true},
+	{DottedName: "verbania.it", ICANN: true},
+	{DottedName: "vercelli.it", ICANN: true},
+	{DottedName: "verdal.no", ICANN: true},
+	{DottedName: "verisign", ICANN: true},
+	{DottedName: "verona.it", ICANN: true},
+	{DottedName: "verran.no", ICANN: true},
+	{DottedName: "versailles.museum", ICANN: true},
+	{DottedName: "versicherung", ICANN: true},
+	{DottedName: "vestby.no", ICANN: true},
+	{DottedName: "vestnes.no", ICANN: true},
+	{DottedName: "vestre-slidre.no", ICANN: true},
+	{DottedName: "vestre-toten.no", ICANN: true},
+	{DottedName: "vestvagoy.no", ICANN: true},
+	{DottedName: "vet", ICANN: true},
+	{DottedName: "vet.br", ICANN: true},
+	{DottedName: "veterinaire.fr", ICANN: true},
+	{DottedName: "veterinaire.km", ICANN: true},
+	{DottedName: "vevelstad.no", ICANN: true},
+	{DottedName: "vf.no", ICANN: true},
+	{DottedName: "vg", ICANN: true},
+	{DottedName: "vgs.no", ICANN: true},
+	{DottedName: "vi", ICANN: true},
+	{DottedName: "vi.it", ICANN: true},
+	{DottedName: "vi.us", ICANN: true},
+	{DottedName: "viajes", ICANN: true},
+	{DottedName: "vibo-valentia.it", ICANN: true},
+	{DottedName: "vibovalentia.it", ICANN: true},
+	{DottedName: "vic.au", ICANN: true},
+	{DottedName: "vic.edu.au", ICANN: true},
+	{DottedName: "vic.gov.au", ICANN: true},
+	{DottedName: "vicenza.it", ICANN: true},
+	{DottedName: "video", ICANN: true},
+	{DottedName: "video.hu", ICANN: true},
+	{DottedName: "vig", ICANN: true},
+	{DottedName: "vik.no", ICANN: true},
+	{DottedName: "viking", ICANN: true},
+	{DottedName: "viking.museum", ICANN: true},
+	{DottedName: "vikna.no", ICANN: true},
+	{DottedName: "village.museum", ICANN: true},
+	{DottedName: "villas", ICANN: true},
+	{DottedName: "vin", ICANN: true},
+	{DottedName: "vindafjord.no", ICANN: true},
+	{DottedName: "vinnica.ua", ICANN: true},
+	{DottedName: "vinnytsia.ua", ICANN: true},
+	{DottedName: "vip", ICANN: true},
+	{DottedName: "virgin", ICANN: true},
+	{DottedName: "virginia.museum", ICANN: true},
+	{DottedName: "virtual.museum", ICANN: true},
+	{DottedName: "virtuel.museum", ICANN: true},
+	{DottedName: "visa", ICANN: true},
+	{DottedName: "vision", ICANN: true},
+	{DottedName: "viterbo.it", ICANN: true},
+	{DottedName: "viva", ICANN: true},
+	{DottedName: "vivo", ICANN: true},
+	{DottedName: "vix.br", ICANN: true},
+	{DottedName: "vlaanderen", ICANN: true},
+	{DottedName: "vlaanderen.museum", ICANN: true},
+	{DottedName: "vlog.br", ICANN: true},
+	{DottedName: "vn", ICANN: true},
+	{DottedName: "vn.ua", ICANN: true},
+	{DottedName: "voagat.no", ICANN: true},
+	{DottedName: "vodka", ICANN: true},
+	{DottedName: "volda.no", ICANN: true},
+	{DottedName: "volkenkunde.museum", ICANN: true},
+	{DottedName: "volkswagen", ICANN: true},
+	{DottedName: "volvo", ICANN: true},
+	{DottedName: "volyn.ua", ICANN: true},
+	{DottedName: "voss.no", ICANN: true},
+	{DottedName: "vossevangen.no", ICANN: true},
+	{DottedName: "vote", ICANN: true},
+	{DottedName: "voting", ICANN: true},
+	{DottedName: "voto", ICANN: true},
+	{DottedName: "voyage", ICANN: true},
+	{DottedName: "vr.it", ICANN: true},
+	{DottedName: "vs.it", ICANN: true},
+	{DottedName: "vt.it", ICANN: true},
+	{DottedName: "vt.us", ICANN: true},
+	{DottedName: "vu", ICANN: true},
+	{DottedName: "vuelos", ICANN: true},
+	{DottedName: "vv.it", ICANN: true},
+	{DottedName: "w.bg", ICANN: true},
+	{DottedName: "w.se", ICANN: true},
+	{DottedName: "wa.au", ICANN: true},
+	{DottedName: "wa.edu.au", ICANN: true},
+	{DottedName: "wa.gov.au", ICANN: true},
+	{DottedName: "wa.us", ICANN: true},
+	{DottedName: "wada.nagano.jp", ICANN: true},
+	{DottedName: "wajiki.tokushima.jp", ICANN: true},
+	{DottedName: "wajima.ishikawa.jp", ICANN: true},
+	{DottedName: "wakasa.fukui.jp", ICANN: true},
+	{DottedName: "wakasa.tottori.jp", ICANN: true},
+	{DottedName: "wakayama.jp", ICANN: true},
+	{DottedName: "wakayama.wakayama.jp", ICANN: true},
+	{DottedName: "wake.okayama.jp", ICANN: true},
+	{DottedName: "wakkanai.hokkaido.jp", ICANN: true},
+	{DottedName: "wakuya.miyagi.jp", ICANN: true},
+	{DottedName: "walbrzych.pl", ICANN: true},
+	{DottedName: "wales", ICANN: true},
+	{DottedName: "wales.museum", ICANN: true},
+	{DottedName: "wallonie.museum", ICANN: true},
+	{DottedName: "walmart", ICANN: true},
+	{DottedName: "walter", ICANN: true},
+	{DottedName: "wang", ICANN: true},
+	{DottedName: "wanggou", ICANN: true},
+	{DottedName: "wanouchi.gifu.jp", ICANN: true},
+	{DottedName: "war.museum", ICANN: true},
+	{DottedName: "warabi.saitama.jp", ICANN: true},
+	{DottedName: "warmia.pl", ICANN: true},
+	{DottedName: "warszawa.pl", ICANN: true},
+	{DottedName: "washingtondc.museum", ICANN: true},
+	{DottedName: "washtenaw.mi.us", ICANN: true},
+	{DottedName: "wassamu.hokkaido.jp", ICANN: true},
+	{DottedName: "watarai.mie.jp", ICANN: true},
+	{DottedName: "watari.miyagi.jp", ICANN: true},
+	{DottedName: "watch", ICANN: true},
+	{DottedName: "watch-and-clock.museum", ICANN: true},
+	{DottedName: "watchandclock.museum", ICANN: true},
+	{DottedName: "watches", ICANN: true},
+	{DottedName: "waw.pl", ICANN: true},
+	{DottedName: "wazuka.kyoto.jp", ICANN: true},
+	{DottedName: "weather", ICANN: true},
+	{DottedName: "weatherchannel", ICANN: true},
+	{DottedName: "web.bo", ICANN: true},
+	{DottedName: "web.co", ICANN: true},
+	{DottedName: "web.do", ICANN: true},
+	{DottedName: "web.gu", ICANN: true},
+	{DottedName: "web.id", ICANN: true},
+	{DottedName: "web.lk", ICANN: true},
+	{DottedName: "web.nf", ICANN: true},
+	{DottedName: "web.ni", ICANN: true},
+	{DottedName: "web.pk", ICANN: true},
+	{DottedName: "web.tj", ICANN: true},
+	{DottedName: "web.tr", ICANN: true},
+	{DottedName: "web.ve", ICANN: true},
+	{DottedName: "web.za", ICANN: true},
+	{DottedName: "webcam", ICANN: true},
+	{DottedName: "weber", ICANN: true},
+	{DottedName: "website", ICANN: true},
+	{DottedName: "wedding", ICANN: true},
+	{DottedName: "wegrow.pl", ICANN: true},
+	{DottedName: "weibo", ICANN: true},
+	{DottedName: "weir", ICANN: true},
+	{DottedName: "western.museum", ICANN: true},
+	{DottedName: "westfalen.museum", ICANN: true},
+	{DottedName: "wf", ICANN: true},
+	{DottedName: "whaling.museum", ICANN: true},
+	{DottedName: "whoswho", ICANN: true},
+	{DottedName: "wi.us", ICANN: true},
+	{DottedName: "wielun.pl", ICANN: true},
+	{DottedName: "wien", ICANN: true},
+	{DottedName: "wif.gov.pl", ICANN: true},
+	{DottedName: "wiih.gov.pl", ICANN: true},
+	{DottedName: "wiki", ICANN: true},
+	{DottedName: "wiki.bo", ICANN: true},
+	{DottedName: "wiki.br", ICANN: true},
+	{DottedName: "wildlife.museum", ICANN: true},
+	{DottedName: "williamhill", ICANN: true},
+	{DottedName: "williamsburg.museum", ICANN: true},
+	{DottedName: "win", ICANN: true},
+	{DottedName: "winb.gov.pl", ICANN: true},
+	{DottedName: "windmill.museum", ICANN: true},
+	{DottedName: "windows", ICANN: true},
+	{DottedName: "wine", ICANN: true},
+	{DottedName: "winners", ICANN: true},
+	{DottedName: "wios.gov.pl", ICANN: true},
+	{DottedName: "witd.gov.pl", ICANN: true},
+	{DottedName: "wiw.gov.pl", ICANN: true},
+	{DottedName: "wlocl.pl", ICANN: true},
+	{DottedName: "wloclawek.pl", ICANN: true},
+	{DottedName: "wme", ICANN: true},
+	{DottedName: "wodzislaw.pl", ICANN: true},
+	{DottedName: "wolomin.pl", ICANN: true},
+	{DottedName: "wolterskluwer", ICANN: true},
+	{DottedName: "woodside", ICANN: true},
+	{DottedName: "work", ICANN: true},
+	{DottedName: "workinggroup.aero", ICANN: true},
+	{DottedName: "works", ICANN: true},
+	{DottedName: "works.aero", ICANN: true},
+	{DottedName: "workshop.museum", ICANN: true},
+	{DottedName: "world", ICANN: true},
+	{DottedName: "wow", ICANN: true},
+	{DottedName: "wroclaw.pl", ICANN: true},
+	{DottedName: "ws", ICANN: true},
+	{DottedName: "ws.na", ICANN: true},
+	{DottedName: "wsa.gov.pl", ICANN: true},
+	{DottedName: "wskr.gov.pl", ICANN: true},
+	{DottedName: "wtc", ICANN: true},
+	{DottedName: "wtf", ICANN: true},
+	{DottedName: "wuoz.gov.pl", ICANN: true},
+	{DottedName: "wv.us", ICANN: true},
+	{DottedName: "www.ro", ICANN: true},
+	{DottedName: "wy.us", ICANN: true},
+	{DottedName: "wzmiuw.gov.pl", ICANN: true},
+	{DottedName: "x.bg", ICANN: true},
+	{DottedName: "x.se", ICANN: true},
+	{DottedName: "xbox", ICANN: true},
+	{DottedName: "xerox", ICANN: true},
+	{DottedName: "xfinity", ICANN: true},
+	{DottedName: "xihuan", ICANN: true},
+	{DottedName: "xin", ICANN: true},
+	{DottedName: "xj.cn", ICANN: true},
+	{DottedName: "xn--0trq7p7nn.jp", ICANN: true, Text: "和歌山.jp"},
+	{DottedName: "xn--11b4c3d", ICANN: true, Text: "कॉम"},
+	{DottedName: "xn--12c1fe0br.xn--o3cw4h", ICANN: true, Text: "ศึกษา.ไทย"},
+	{DottedName: "xn--12cfi8ixb8l.xn--o3cw4h", ICANN: true, Text: "องค์กร.ไทย"},
+	{DottedName: "xn--12co0c3b4eva.xn--o3cw4h", ICANN: true, Text: "ธุรกิจ.ไทย"},
+	{DottedName: "xn--1ck2e1b", ICANN: true, Text: "セール"},
+	{DottedName: "xn--1ctwo.jp", ICANN: true, Text: "富山.jp"},
+	{DottedName: "xn--1lqs03n.jp", ICANN: true, Text: "京都.jp"},
+	{DottedName: "xn--1lqs71d.jp", ICANN: true, Text: "東京.jp"},
+	{DottedName: "xn--1qqw23a", ICANN: true, Text: "佛山"},
+	{DottedName: "xn--2m4a15e.jp", ICANN: true, Text: "長野.jp"},
+	{DottedName: "xn--2scrj9c", ICANN: true, Text: "ಭಾರತ"},
+	{DottedName: "xn--30rr7y", ICANN: true, Text: "慈善"},
+	{DottedName: "xn--32vp30h.jp", ICANN: true, Text: "青森.jp"},
+	{DottedName: "xn--3bst00m", ICANN: true, Text: "集团"},
+	{DottedName: "xn--3ds443g", ICANN: true, Text: "在线"},
+	{DottedName: "xn--3e0b707e", ICANN: true, Text: "한국"},
+	{DottedName: "xn--3hcrj9c", ICANN: true, Text: "ଭାରତ"},
+	{DottedName: "xn--3pxu8k", ICANN: true, Text: "点看"},
+	{DottedName: "xn--42c2d9a", ICANN: true, Text: "คอม"},
+	{DottedName: "xn--45br5cyl", ICANN: true, Text: "ভাৰত"},
+	{DottedName: "xn--45brj9c", ICANN: true, Text: "ভারত"},
+	{DottedName: "xn--45q11c", ICANN: true, Text: "八卦"},
+	{DottedName: "xn--4gbrim", ICANN: true, Text: "موقع"},
+	{DottedName: "xn--4it168d.jp", ICANN: true, Text: "福岡.jp"},
+	{DottedName: "xn--4it797k.jp", ICANN: true, Text: "静岡.jp"},
+	{DottedName: "xn--4pvxs.jp", ICANN: true, Text: "栃木.jp"},
+	{DottedName: "xn--54b7fta0cc", ICANN: true, Text: "বাংলা"},
+	{DottedName: "xn--55qw42g", ICANN: true, Text: "公益"},
+	{DottedName: "xn--55qx5d", ICANN: true, Text: "公司"},
+	{DottedName: "xn--55qx5d.cn", ICANN: true, Text: "公司.cn"},
+	{DottedName: "xn--55qx5d.hk", ICANN: true, Text: "公司.hk"},
+	{DottedName: "xn--55qx5d.xn--j6w193g", ICANN: true, Text: "公司.香港"},
+	{DottedName: "xn--5js045d.jp", ICANN: true, Text: "埼玉.jp"},
+	{DottedName: "xn--5rtp49c.jp", ICANN: true, Text: "石川.jp"},
+	{DottedName: "xn--5rtq34k.jp", ICANN: true, Text: "香川.jp"},
+	{DottedName: "xn--5su34j936bgsg", ICANN: true, Text: "香格里拉"},
+	{DottedName: "xn--5tzm5g", ICANN: true, Text: "网站"},
+	{DottedName: "xn--6btw5a.jp", ICANN: true, Text: "宮崎.jp"},
+	{DottedName: "xn--6frz82g", ICANN: true, Text: "移动"},
+	{DottedName: "xn--6orx2r.jp", ICANN: true, Text: "山口.jp"},
+	{DottedName: "xn--6qq986b3xl", ICANN: true, Text: "我爱你"},
+	{DottedName: "xn--7t0a264c.jp", ICANN: true, Text: "群馬.jp"},
+	{DottedName: "xn--80adxhks", ICANN: true, Text: "москва"},
+	{DottedName: "xn--80ao21a", ICANN: true, Text: "қаз"},
+	{DottedName: "xn--80aqecdr1a", ICANN: true, Text: "католик"},
+	{DottedName: "xn--80asehdb", ICANN: true, Text: "онлайн"},
+	{DottedName: "xn--80aswg", ICANN: true, Text: "сайт"},
+	{DottedName: "xn--80au.xn--90a3ac", ICANN: true, Text: "ак.срб"},
+	{DottedName: "xn--8ltr62k.jp", ICANN: true, Text: "長崎.jp"},
+	{DottedName: "xn--8pvr4u.jp", ICANN: true, Text: "熊本.jp"},
+	{DottedName: "xn--8y0a063a", ICANN: true, Text: "联通"},
+	{DottedName: "xn--90a3ac", ICANN: true, Text: "срб"},
+	{DottedName: "xn--90ae", ICANN: true, Text: "бг"},
+	{DottedName: "xn--90ais", ICANN: true, Text: "бел"},
+	{DottedName: "xn--90azh.xn--90a3ac", ICANN: true, Text: "обр.срб"},
+	{DottedName: "xn--9dbhblg6di.museum", ICANN: true, Text: "ירושלים.museum"},
+	{DottedName: "xn--9dbq2a", ICANN: true, Text: "קום"},
+	{DottedName: "xn--9et52u", ICANN: true, Text: "时尚"},
+	{DottedName: "xn--9krt00a", ICANN: true, Text: "微博"},
+	{DottedName: "xn--andy-ira.no", ICANN: true, Text: "andøy.no"},
+	{DottedName: "xn--aroport-bya.ci", ICANN: true, Text: "aéroport.ci"},
+	{DottedName: "xn--asky-ira.no", ICANN: true, Text: "askøy.no"},
+	{DottedName: "xn--aurskog-hland-jnb.no", ICANN: true, Text: "aurskog-høland.no"},
+	{DottedName: "xn--avery-yua.no", ICANN: true, Text: "averøy.no"},
+	{DottedName: "xn--b-5ga.nordland.no", ICANN: true, Text: "bø.nordland.no"},
+	{DottedName: "xn--b-5ga.telemark.no", ICANN: true, Text: "bø.telemark.no"},
+	{DottedName: "xn--b4w605ferd", ICANN: true, Text: "淡马锡"},
+	{DottedName: "xn--balsan-sdtirol-nsb.it", ICANN: true, Text: "balsan-südtirol.it"},
+	{DottedName: "xn--bck1b9a5dre4c", ICANN: true, Text: "ファッション"},
+	{DottedName: "xn--bdddj-mrabd.no", ICANN: true, Text: "bådåddjå.no"},
+	{DottedName: "xn--bearalvhki-y4a.no", ICANN: true, Text: "bearalváhki.no"},
+	{DottedName: "xn--berlevg-jxa.no", ICANN: true, Text: "berlevåg.no"},
+	{DottedName: "xn--bhcavuotna-s4a.no", ICANN: true, Text: "báhcavuotna.no"},
+	{DottedName: "xn--bhccavuotna-k7a.no", ICANN: true, Text: "báhccavuotna.no"},
+	{DottedName: "xn--bidr-5nac.no", ICANN: true, Text: "báidár.no"},
+	{DottedName: "xn--bievt-0qa.no", ICANN: true, Text: "bievát.no"},
+	{DottedName: "xn--bjarky-fya.no", ICANN: true, Text: "bjarkøy.no"},
+	{DottedName: "xn--bjddar-pta.no", ICANN: true, Text: "bájddar.no"},
+	{DottedName: "xn--blt-elab.no", ICANN: true, Text: "bálát.no"},
+	{DottedName: "xn--bmlo-gra.no", ICANN: true, Text: "bømlo.no"},
+	{DottedName: "xn--bod-2na.no", ICANN: true, Text: "bodø.no"},
+	{DottedName: "xn--bozen-sdtirol-2ob.it", ICANN: true, Text: "bozen-südtirol.it"},
+	{DottedName: "xn--brnny-wuac.no", ICANN: true, Text: "brønnøy.no"},
+	{DottedName: "xn--brnnysund-m8ac.no", ICANN: true, Text: "brønnøysund.no"},
+	{DottedName: "xn--brum-voa.no", ICANN: true, Text: "bærum.no"},
+	{DottedName: "xn--btsfjord-9za.no", ICANN: true, Text: "båtsfjord.no"},
+	{DottedName: "xn--bulsan-sdtirol-nsb.it", ICANN: true, Text: "bulsan-südtirol.it"},
+	{DottedName: "xn--c1avg", ICANN: true, Text: "орг"},
+	{DottedName: "xn--c1avg.xn--90a3ac", ICANN: true, Text: "орг.срб"},
+	{DottedName: "xn--c2br7g", ICANN: true, Text: "नेट"},
+	{DottedName: "xn--c3s14m.jp", ICANN: true, Text: "愛媛.jp"},
+	{DottedName: "xn--cck2b3b", ICANN: true, Text: "ストア"},
+	{DottedName: "xn--cckwcxetd", ICANN: true, Text: "アマゾン"},
+	{DottedName: "xn--cesena-forl-mcb.it", ICANN: true, Text: "cesena-forlì.it"},
+	{DottedName: "xn--cesenaforl-i8a.it", ICANN: true, Text: "cesenaforlì.it"},
+	{DottedName: "xn--cg4bki", ICANN: true, Text: "삼성"},
+	{DottedName: "xn--ciqpn.hk", ICANN: true, Text: "个人.hk"},
+	{DottedName: "xn--clchc0ea0b2g2a9gcd", ICANN: true, Text: "சிங்கப்பூர்"},
+	{DottedName: "xn--comunicaes-v6a2o.museum", ICANN: true, Text: "comunicações.museum"},
+	{DottedName: "xn--correios-e-telecomunicaes-ghc29a.museum", ICANN: true, Text: "correios-e-telecomunicações.museum"},
+	{DottedName: "xn--czr694b", ICANN: true, Text: "商标"},
+	{DottedName: "xn--czrs0t", ICANN: true, Text: "商店"},
+	{DottedName: "xn--czru2d", ICANN: true, Text: "商城"},
+	{DottedName: "xn--czrw28b.tw", ICANN: true, Text: "商業.tw"},
+	{DottedName: "xn--d1acj3b", ICANN: true, Text: "дети"},
+	{DottedName: "xn--d1alf", ICANN: true, Text: "мкд"},
+	{DottedName: "xn--d1at.xn--90a3ac", ICANN: true, Text: "од.срб"},
+	{DottedName: "xn--d5qv7z876c.jp", ICANN: true, Text: "鹿児島.jp"},
+	{DottedName: "xn--davvenjrga-y4a.no", ICANN: true, Text: "davvenjárga.no"},
+	{DottedName: "xn--djrs72d6uy.jp", ICANN: true, Text: "北海道.jp"},
+	{DottedName: "xn--djty4k.jp", ICANN: true, Text: "岩手.jp"},
+	{DottedName: "xn--dnna-gra.no", ICANN: true, Text: "dønna.no"},
+	{DottedName: "xn--drbak-wua.no", ICANN: true, Text: "drøbak.no"},
+	{DottedName: "xn--dyry-ira.no", ICANN: true, Text: "dyrøy.no"},
+	{DottedName: "xn--e1a4c", ICANN: true, Text: "ею"},
+	{DottedName: "xn--eckvdtc9d", ICANN: true, Text: "ポイント"},
+	{DottedName: "xn--efvn9s.jp", ICANN: true, Text: "新潟.jp"},
+	{DottedName: "xn--efvy88h", ICANN: true, Text: "新闻"},
+	{DottedName: "xn--ehqz56n.jp", ICANN: true, Text: "三重.jp"},
+	{DottedName: "xn--elqq16h.jp", ICANN: true, Text: "福井.jp"},
+	{DottedName: "xn--eveni-0qa01ga.no", ICANN: true, Text: "evenášši.no"},
+	{DottedName: "xn--f6qx53a.jp", ICANN: true, Text: "兵庫.jp"},
+	{DottedName: "xn--fct429k", ICANN: true, Text: "家電"},
+	{DottedName: "xn--fhbei", ICANN: true, Text: "كوم"},
+	{DottedName: "xn--finny-yua.no", ICANN: true, Text: "finnøy.no"},
+	{DottedName: "xn--fiq228c5hs", ICANN: true, Text: "中文网"},
+	{DottedName: "xn--fiq64b", ICANN: true, Text: "中信"},
+	{DottedName: "xn--fiqs8s", ICANN: true, Text: "中国"},
+	{DottedName: "xn--fiqz9s", ICANN: true, Text: "中國"},
+	{DottedName: "xn--fjord-lra.no", ICANN: true, Text: "åfjord.no"},
+	{DottedName: "xn--fjq720a", ICANN: true, Text: "娱乐"},
+	{DottedName: "xn--fl-zia.no", ICANN: true, Text: "flå.no"},
+	{DottedName: "xn--flor-jra.no", ICANN: true, Text: "florø.no"},
+	{DottedName: "xn--flw351e", ICANN: true, Text: "谷歌"},
+	{DottedName: "xn--forl-cesena-fcb.it", ICANN: true, Text: "forlì-cesena.it"},
+	{DottedName: "xn--forlcesena-c8a.it", ICANN: true, Text: "forlìcesena.it"},
+	{DottedName: "xn--fpcrj9c3d", ICANN: true, Text: "భారత్"},
+	{DottedName: "xn--frde-gra.no", ICANN: true, Text: "førde.no"},
+	{DottedName: "xn--frna-woa.no", ICANN: true, Text: "fræna.no"},
+	{DottedName: "xn--frya-hra.no", ICANN: true, Text: "frøya.no"},
+	{DottedName: "xn--fzc2c9e2c", ICANN: true, Text: "ලංකා"},
+	{DottedName: "xn--fzys8d69uvgm", ICANN: true, Text: "電訊盈科"},
+	{DottedName: "xn--g2xx48c", ICANN: true, Text: "购物"},
+	{DottedName: "xn--gckr3f0f", ICANN: true, Text: "クラウド"},
+	{DottedName: "xn--gecrj9c", ICANN: true, Text: "ભારત"},
+	{DottedName: "xn--ggaviika-8ya47h.no", ICANN: true, Text: "gáŋgaviika.no"},
+	{DottedName: "xn--gildeskl-g0a.no", ICANN: true, Text: "gildeskål.no"},
+	{DottedName: "xn--givuotna-8ya.no", ICANN: true, Text: "gáivuotna.no"},
+	{DottedName: "xn--gjvik-wua.no", ICANN: true, Text: "gjøvik.no"},
+	{DottedName: "xn--gk3at1e", ICANN: true, Text: "通販"},
+	{DottedName: "xn--gls-elac.no", ICANN: true, Text: "gálsá.no"},
+	{DottedName: "xn--gmq050i.hk", ICANN: true, Text: "箇人.hk"},
+	{DottedName: "xn--gmqw5a.hk", ICANN: true, Text: "個人.hk"},
+	{DottedName: "xn--gmqw5a.xn--j6w193g", ICANN: true, Text: "個人.香港"},
+	{DottedName: "xn--h-2fa.no", ICANN: true, Text: "hå.no"},
+	{DottedName: "xn--h1aegh.museum", ICANN: true, Text: "иком.museum"},
+	{DottedName: "xn--h2breg3eve", ICANN: true, Text: "भारतम्"},
+	{DottedName: "xn--h2brj9c", ICANN: true, Text: "भारत"},
+	{DottedName: "xn--h2brj9c8c", ICANN: true, Text: "भारोत"},
+	{DottedName: "xn--h3cuzk1di.xn--o3cw4h", ICANN: true, Text: "รัฐบาล.ไทย"},
+	{DottedName: "xn--hbmer-xqa.no", ICANN: true, Text: "hábmer.no"},
+	{DottedName: "xn--hcesuolo-7ya35b.no", ICANN: true, Text: "čáhcesuolo.no"},
+	{DottedName: "xn--hery-ira.nordland.no", ICANN: true, Text: "herøy.nordland.no"},
+	{DottedName: "xn--hery-ira.xn--mre-og-romsdal-qqb.no", ICANN: true, Text: "herøy.møre-og-romsdal.no"},
+	{DottedName: "xn--hgebostad-g3a.no", ICANN: true, Text: "hægebostad.no"},
+	{DottedName: "xn--hmmrfeasta-s4ac.no", ICANN: true, Text: "hámmárfeasta.no"},
+	{DottedName: "xn--hnefoss-q1a.no", ICANN: true, Text: "hønefoss.no"},
+	{DottedName: "xn--hobl-ira.no", ICANN: true, Text: "hobøl.no"},
+	{DottedName: "xn--holtlen-hxa.no", ICANN: true, Text: "holtålen.no"},
+	{DottedName: "xn--hpmir-xqa.no", ICANN: true, Text: "hápmir.no"},
+	{DottedName: "xn--hxt814e", ICANN: true, Text: "网店"},
+	{DottedName: "xn--hyanger-q1a.no", ICANN: true, Text: "høyanger.no"},
+	{DottedName: "xn--hylandet-54a.no", ICANN: true, Text: "høylandet.no"},
+	{DottedName: "xn--i1b6b1a6a2e", ICANN: true, Text: "संगठन"},
+	{DottedName: "xn--imr513n", ICANN: true, Text: "餐厅"},
+	{DottedName: "xn--indery-fya.no", ICANN: true, Text: "inderøy.no"},
+	{DottedName: "xn--io0a7i", ICANN: true, Text: "网络"},
+	{DottedName: "xn--io0a7i.cn", ICANN: true, Text: "网络.cn"},
+	{DottedName: "xn--io0a7i.hk", ICANN: true, Text: "网络.hk"},
+	{DottedName: "xn--j1aef", ICANN: true, Text: "ком"},
+	{DottedName: "xn--j1amh", ICANN: true, Text: "укр"},
+	{DottedName: "xn--j6w193g", ICANN: true, Text: "香港"},
+	{DottedName: "xn--jlq480n2rg", ICANN: true, Text: "亚马逊"},
+	{DottedName: "xn--jlq61u9w7b", ICANN: true, Text: "诺基亚"},
+	{DottedName: "xn--jlster-bya.no", ICANN: true, Text: "jølster.no"},
+	{DottedName: "xn--jrpeland-54a.no", ICANN: true, Text: "jørpeland.no"},
+	{DottedName: "xn--jvr189m", ICANN: true, Text: "食品"},
+	{DottedName: "xn--k7yn95e.jp", ICANN: true, Text: "高知.jp"},
+	{DottedName: "xn--karmy-yua.no", ICANN: true, Text: "karmøy.no"},
+	{DottedName: "xn--kbrq7o.jp", ICANN: true, Text: "大分.jp"},
+	{DottedName: "xn--kcrx77d1x4a", ICANN: true, Text: "飞利浦"},
+	{DottedName: "xn--kfjord-iua.no", ICANN: true, Text: "kåfjord.no"},
+	{DottedName: "xn--klbu-woa.no", ICANN: true, Text: "klæbu.no"},
+	{DottedName: "xn--klt787d.jp", ICANN: true, Text: "福島.jp"},
+	{DottedName: "xn--kltp7d.jp", ICANN: true, Text: "徳島.jp"},
+	{DottedName: "xn--kltx9a.jp", ICANN: true, Text: "広島.jp"},
+	{DottedName: "xn--klty5x.jp", ICANN: true, Text: "島根.jp"},
+	{DottedName: "xn--koluokta-7ya57h.no", ICANN: true, Text: "ákŋoluokta.no"},
+	{DottedName: "xn--kprw13d", ICANN: true, Text: "台湾"},
+	{DottedName: "xn--kpry57d", ICANN: true, Text: "台灣"},
+	{DottedName: "xn--kput3i", ICANN: true, Text: "手机"},
+	{DottedName: "xn--krager-gya.no", ICANN: true, Text: "kragerø.no"},
+	{DottedName: "xn--kranghke-b0a.no", ICANN: true, Text: "kråanghke.no"},
+	{DottedName: "xn--krdsherad-m8a.no", ICANN: true, Text: "krødsherad.no"},
+	{DottedName: "xn--krehamn-dxa.no", ICANN: true, Text: "åkrehamn.no"},
+	{DottedName: "xn--krjohka-hwab49j.no", ICANN: true, Text: "kárášjohka.no"},
+	{DottedName: "xn--ksnes-uua.no", ICANN: true, Text: "øksnes.no"},
+	{DottedName: "xn--kvfjord-nxa.no", ICANN: true, Text: "kvæfjord.no"},
+	{DottedName: "xn--kvitsy-fya.no", ICANN: true, Text: "kvitsøy.no"},
+	{DottedName: "xn--kvnangen-k0a.no", ICANN: true, Text: "kvænangen.no"},
+	{DottedName: "xn--l-1fa.no", ICANN: true, Text: "ål.no"},
+	{DottedName: "xn--l1acc", ICANN: true, Text: "мон"},
+	{DottedName: "xn--laheadju-7ya.no", ICANN: true, Text: "álaheadju.no"},
+	{DottedName: "xn--langevg-jxa.no", ICANN: true, Text: "langevåg.no"},
+	{DottedName: "xn--lcvr32d.hk", ICANN: true, Text: "敎育.hk"},
+	{DottedName: "xn--ldingen-q1a.no", ICANN: true, Text: "lødingen.no"},
+	{DottedName: "xn--leagaviika-52b.no", ICANN: true, Text: "leaŋgaviika.no"},
+	{DottedName: "xn--lesund-hua.no", ICANN: true, Text: "ålesund.no"},
+	{DottedName: "xn--lgbbat1ad8j", ICANN: true, Text: "الجزائر"},
+	{DottedName: "xn--lgrd-poac.no", ICANN: true, Text: "ålgård.no"},
+	{DottedName: "xn--lhppi-xqa.no", ICANN: true, Text: "láhppi.no"},
+	{DottedName: "xn--linds-pra.no", ICANN: true, Text: "lindås.no"},
+	{DottedName: "xn--lns-qla.museum", ICANN: true, Text: "läns.museum"},
+	{DottedName: "xn--loabt-0qa.no", ICANN: true, Text: "loabát.no"},
+	{DottedName: "xn--lrdal-sra.no", ICANN: true, Text: "lærdal.no"},
+	{DottedName: "xn--lrenskog-54a.no", ICANN: true, Text: "lørenskog.no"},
+	{DottedName: "xn--lt-liac.no", ICANN: true, Text: "áltá.no"},
+	{DottedName: "xn--lten-gra.no", ICANN: true, Text: "løten.no"},
+	{DottedName: "xn--lury-ira.no", ICANN: true, Text: "lurøy.no"},
+	{DottedName: "xn--m3ch0j3a.xn--o3cw4h", ICANN: true, Text: "เน็ต.ไทย"},
+	{DottedName: "xn--mely-ira.no", ICANN: true, Text: "meløy.no"},
+	{DottedName: "xn--merker-kua.no", ICANN: true, Text: "meråker.no"},
+	{DottedName: "xn--mgb2ddes", ICANN: true, Text: "اليمن"},
+	{DottedName: "xn--mgb9awbf", ICANN: true, Text: "عمان"},
+	{DottedName: "xn--mgba3a3ejt", ICANN: true, Text: "ارامكو"},
+	{DottedName: "xn--mgba3a4f16a", ICANN: true, Text: "ایران"},
+	{DottedName: "xn--mgba3a4f16a.ir", ICANN: true, Text: "ایران.ir"},
+	{DottedName: "xn--mgba3a4fra", ICANN: true, Text: "ايران"},
+	{DottedName: "xn--mgba3a4fra.ir", ICANN: true, Text: "ايران.ir"},
+	{DottedName: "xn--mgba7c0bbn0a", ICANN: true, Text: "العليان"},
+	{DottedName: "xn--mgbaakc7dvf", ICANN: true, Text: "اتصالات"},
+	{DottedName: "xn--mgbaam7a8h", ICANN: true, Text: "امارات"},
+	{DottedName: "xn--mgbab2bd", ICANN: true, Text: "بازار"},
+	{DottedName: "xn--mgbah1a3hjkrd", ICANN: true, Text: "موريتانيا"},
+	{DottedName: "xn--mgbai9a5eva00b", ICANN: true, Text: "پاكستان"},
+	{DottedName: "xn--mgbai9azgqp6j", ICANN: true, Text: "پاکستان"},
+	{DottedName: "xn--mgbayh7gpa", ICANN: true, Text: "الاردن"},
+	{DottedName: "xn--mgbbh1a", ICANN: true, Text: "بارت"},
+	{DottedName: "xn--mgbbh1a71e", ICANN: true, Text: "بھارت"},
+	{DottedName: "xn--mgbc0a9azcg", ICANN: true, Text: "المغرب"},
+	{DottedName: "xn--mgbca7dzdo", ICANN: true, Text: "ابوظبي"},
+	{DottedName: "xn--mgbcpq6gpa1a", ICANN: true, Text: "البحرين"},
+	{DottedName: "xn--mgberp4a5d4a87g", ICANN: true, Text: "السعودیة"},
+	{DottedName: "xn--mgberp4a5d4ar", ICANN: true, Text: "السعودية"},
+	{DottedName: "xn--mgbgu82a", ICANN: true, Text: "ڀارت"},
+	{DottedName: "xn--mgbi4ecexp", ICANN: true, Text: "كاثوليك"},
+	{DottedName: "xn--mgbpl2fh", ICANN: true, Text: "سودان"},
+	{DottedName: "xn--mgbqly7c0a67fbc", ICANN: true, Text: "السعودیۃ"},
+	{DottedName: "xn--mgbqly7cvafr", ICANN: true, Text: "السعوديه"},
+	{DottedName: "xn--mgbt3dhd", ICANN: true, Text: "همراه"},
+	{DottedName: "xn--mgbtf8fl", ICANN: true, Text: "سوريا"},
+	{DottedName: "xn--mgbtx2b", ICANN: true, Text: "عراق"},
+	{DottedName: "xn--mgbx4cd0ab", ICANN: true, Text: "مليسيا"},
+	{DottedName: "xn--mix082f", ICANN: true, Text: "澳门"},
+	{DottedName: "xn--mix891f", ICANN: true, Text: "澳門"},
+	{DottedName: "xn--mjndalen-64a.no", ICANN: true, Text: "mjøndalen.no"},
+	{DottedName: "xn--mk0axi.hk", ICANN: true, Text: "组織.hk"},
+	{DottedName: "xn--mk1bu44c", ICANN: true, Text: "닷컴"},
+	{DottedName: "xn--mkru45i.jp", ICANN: true, Text: "千葉.jp"},
+	{DottedName: "xn--mlatvuopmi-s4a.no", ICANN: true, Text: "málatvuopmi.no"},
+	{DottedName: "xn--mli-tla.no", ICANN: true, Text: "åmli.no"},
+	{DottedName: "xn--mlselv-iua.no", ICANN: true, Text: "målselv.no"},
+	{DottedName: "xn--moreke-jua.no", ICANN: true, Text: "moåreke.no"},
+	{DottedName: "xn--mori-qsa.nz", ICANN: true, Text: "māori.nz"},
+	{DottedName: "xn--mosjen-eya.no", ICANN: true, Text: "mosjøen.no"},
+	{DottedName: "xn--mot-tla.no", ICANN: true, Text: "åmot.no"},
+	{DottedName: "xn--msy-ula0h.no", ICANN: true, Text: "måsøy.no"},
+	{DottedName: "xn--mtta-vrjjat-k7af.no", ICANN: true, Text: "mátta-várjjat.no"},
+	{DottedName: "xn--muost-0qa.no", ICANN: true, Text: "muosát.no"},
+	{DottedName: "xn--mxtq1m", ICANN: true, Text: "政府"},
+	{DottedName: "xn--mxtq1m.hk", ICANN: true, Text: "政府.hk"},
+	{DottedName: "xn--mxtq1m.xn--j6w193g", ICANN: true, Text: "政府.香港"},
+	{DottedName: "xn--ngbc5azd", ICANN: true, Text: "شبكة"},
+	{DottedName: "xn--ngbe9e0a", ICANN: true, Text: "بيتك"},
+	{DottedName: "xn--ngbrx", ICANN: true, Text: "عرب"},
+	{DottedName: "xn--nit225k.jp", ICANN: true, Text: "岐阜.jp"},
+	{DottedName: "xn--nmesjevuemie-tcba.no", ICANN: true, Text: "nååmesjevuemie.no"},
+	{DottedName: "xn--nnx388a", ICANN: true, Text: "臺灣"},
+	{DottedName: "xn--node", ICANN: true, Text: "გე"},
+	{DottedName: "xn--nqv7f", ICANN: true, Text: "机构"},
+	{DottedName: "xn--nqv7fs00ema", ICANN: true, Text: "组织机构"},
+	{DottedName: "xn--nry-yla5g.no", ICANN: true, Text: "nærøy.no"},
+	{DottedName: "xn--ntso0iqx3a.jp", ICANN: true, Text: "神奈川.jp"},
+	{DottedName: "xn--ntsq17g.jp", ICANN: true, Text: "奈良.jp"},
+	{DottedName: "xn--nttery-byae.no", ICANN: true, Text: "nøtterøy.no"},
+	{DottedName: "xn--nvuotna-hwa.no", ICANN: true, Text: "návuotna.no"},
+	{DottedName: "xn--nyqy26a", ICANN: true, Text: "健康"},
+	{DottedName: "xn--o1ac.xn--90a3ac", ICANN: true, Text: "пр.срб"},
+	{DottedName: "xn--o1ach.xn--90a3ac", ICANN: true, Text: "упр.срб"},
+	{DottedName: "xn--o3cw4h", ICANN: true, Text: "ไทย"},
+	{DottedName: "xn--o3cyx2a.xn--o3cw4h", ICANN: true, Text: "ทหาร.ไทย"},
+	{DottedName: "xn--od0alg.cn", ICANN: true, Text: "網絡.cn"},
+	{DottedName: "xn--od0alg.hk", ICANN: true, Text: "網絡.hk"},
+	{DottedName: "xn--od0alg.xn--j6w193g", ICANN: true, Text: "網絡.香港"},
+	{DottedName: "xn--od0aq3b.hk", ICANN: true, Text: "网絡.hk"},
+	{DottedName: "xn--ogbpf8fl", ICANN: true, Text: "سورية"},
+	{DottedName: "xn--oppegrd-ixa.no", ICANN: true, Text: "oppegård.no"},
+	{DottedName: "xn--ostery-fya.no", ICANN: true, Text: "osterøy.no"},
+	{DottedName: "xn--osyro-wua.no", ICANN: true, Text: "osøyro.no"},
+	{DottedName: "xn--otu796d", ICANN: true, Text: "招聘"},
+	{DottedName: "xn--p1acf", ICANN: true, Text: "рус"},
+	{DottedName: "xn--p1ai", ICANN: true, Text: "рф"},
+	{DottedName: "xn--pgbs0dh", ICANN: true, Text: "تونس"},
+	{DottedName: "xn--porsgu-sta26f.no", ICANN: true, Text: "porsáŋgu.no"},
+	{DottedName: "xn--pssu33l.jp", ICANN: true, Text: "大阪.jp"},
+	{DottedName: "xn--pssy2u", ICANN: true, Text: "大拿"},
+	{DottedName: "xn--q7ce6a", ICANN: true, Text: "ລາວ"},
+	{DottedName: "xn--q9jyb4c", ICANN: true, Text: "みんな"},
+	{DottedName: "xn--qcka1pmc", ICANN: true, Text: "グーグル"},
+	{DottedName: "xn--qqqt11m.jp", ICANN: true, Text: "佐賀.jp"},
+	{DottedName: "xn--qxa6a", ICANN: true, Text: "ευ"},
+	{DottedName: "xn--qxam", ICANN: true, Text: "ελ"},
+	{DottedName: "xn--rady-ira.no", ICANN: true, Text: "radøy.no"},
+	{DottedName: "xn--rdal-poa.no", ICANN: true, Text: "årdal.no"},
+	{DottedName: "xn--rde-ula.no", ICANN: true, Text: "råde.no"},
+	{DottedName: "xn--rdy-0nab.no", ICANN: true, Text: "rødøy.no"},
+	{DottedName: "xn--rennesy-v1a.no", ICANN: true, Text: "rennesøy.no"},
+	{DottedName: "xn--rhkkervju-01af.no", ICANN: true, Text: "ráhkkerávju.no"},
+	{DottedName: "xn--rholt-mra.no", ICANN: true, Text: "råholt.no"},
+	{DottedName: "xn--rhqv96g", ICANN: true, Text: "世界"},
+	{DottedName: "xn--rht27z.jp", ICANN: true, Text: "山梨.jp"},
+	{DottedName: "xn--rht3d.jp", ICANN: true, Text: "岡山.jp"},
+	{DottedName: "xn--rht61e.jp", ICANN: true, Text: "山形.jp"},
+	{DottedName: "xn--risa-5na.no", ICANN: true, Text: "ráisa.no"},
+	{DottedName: "xn--risr-ira.no", ICANN: true, Text: "risør.no"},
+	{DottedName: "xn--rland-uua.no", ICANN: true, Text: "ørland.no"},
+	{DottedName: "xn--rlingen-mxa.no", ICANN: true, Text: "rælingen.no"},
+	{DottedName: "xn--rmskog-bya.no", ICANN: true, Text: "rømskog.no"},
+	{DottedName: "xn--rny31h.jp", ICANN: true, Text: "秋田.jp"},
+	{DottedName: "xn--rovu88b", ICANN: true, Text: "書籍"},
+	{DottedName: "xn--rros-gra.no", ICANN: true, Text: "røros.no"},
+	{DottedName: "xn--rskog-uua.no", ICANN: true, Text: "ørskog.no"},
+	{DottedName: "xn--rst-0na.no", ICANN: true, Text: "røst.no"},
+	{DottedName: "xn--rsta-fra.no", ICANN: true, Text: "ørsta.no"},
+	{DottedName: "xn--rvc1e0am3e", ICANN: true, Text: "ഭാരതം"},
+	{DottedName: "xn--ryken-vua.no", ICANN: true, Text: "røyken.no"},
+	{DottedName: "xn--ryrvik-bya.no", ICANN: true, Text: "røyrvik.no"},
+	{DottedName: "xn--s-1fa.no", ICANN: true, Text: "ås.no"},
+	{DottedName: "xn--s9brj9c", ICANN: true, Text: "ਭਾਰਤ"},
+	{DottedName: "xn--sandnessjen-ogb.no", ICANN: true, Text: "sandnessjøen.no"},
+	{DottedName: "xn--sandy-yua.no", ICANN: true, Text: "sandøy.no"},
+	{DottedName: "xn--sdtirol-n2a.it", ICANN: true, Text: "südtirol.it"},
+	{DottedName: "xn--seral-lra.no", ICANN: true, Text: "åseral.no"},
+	{DottedName: "xn--ses554g", ICANN: true, Text: "网址"},
+	{DottedName: "xn--sgne-gra.no", ICANN: true, Text: "søgne.no"},
+	{DottedName: "xn--skierv-uta.no", ICANN: true, Text: "skiervá.no"},
+	{DottedName: "xn--skjervy-v1a.no", ICANN: true, Text: "skjervøy.no"},
+	{DottedName: "xn--skjk-soa.no", ICANN: true, Text: "skjåk.no"},
+	{DottedName: "xn--sknit-yqa.no", ICANN: true, Text: "skánit.no"},
+	{DottedName: "xn--sknland-fxa.no", ICANN: true, Text: "skånland.no"},
+	{DottedName: "xn--slat-5na.no", ICANN: true, Text: "sálat.no"},
+	{DottedName: "xn--slt-elab.no", ICANN: true, Text: "sálát.no"},
+	{DottedName: "xn--smla-hra.no", ICANN: true, Text: "smøla.no"},
+	{DottedName: "xn--smna-gra.no", ICANN: true, Text: "sømna.no"},
+	{DottedName: "xn--snase-nra.no", ICANN: true, Text: "snåase.no"},
+	{DottedName: "xn--sndre-land-0cb.no", ICANN: true, Text: "søndre-land.no"},
+	{DottedName: "xn--snes-poa.no", ICANN: true, Text: "åsnes.no"},
+	{DottedName: "xn--snsa-roa.no", ICANN: true, Text: "snåsa.no"},
+	{DottedName: "xn--sr-aurdal-l8a.no", ICANN: true, Text: "sør-aurdal.no"},
+	{DottedName: "xn--sr-fron-q1a.no", ICANN: true, Text: "sør-fron.no"},
+	{DottedName: "xn--sr-odal-q1a.no", ICANN: true, Text: "sør-odal.no"},
+	{DottedName: "xn--sr-varanger-ggb.no", ICANN: true, Text: "sør-varanger.no"},
+	{DottedName: "xn--srfold-bya.no", ICANN: true, Text: "sørfold.no"},
+	{DottedName: "xn--srreisa-q1a.no", ICANN: true, Text: "sørreisa.no"},
+	{DottedName: "xn--srum-gra.no", ICANN: true, Text: "sørum.no"},
+	{DottedName: "xn--stjrdal-s1a.no", ICANN: true, Text: "stjørdal.no"},
+	{DottedName: "xn--stjrdalshalsen-sqb.no", ICANN: true, Text: "stjørdalshalsen.no"},
+	{DottedName: "xn--stre-toten-zcb.no", ICANN: true, Text: "østre-toten.no"},
+	{DottedName: "xn--t60b56a", ICANN: true, Text: "닷넷"},
+	{DottedName: "xn--tckwe", ICANN: true, Text: "コム"},
+	{DottedName: "xn--tiq49xqyj", ICANN: true, Text: "天主教"},
+	{DottedName: "xn--tjme-hra.no", ICANN: true, Text: "tjøme.no"},
+	{DottedName: "xn--tn0ag.hk", ICANN: true, Text: "组织.hk"},
+	{DottedName: "xn--tnsberg-q1a.no", ICANN: true, Text: "tønsberg.no"},
+	{DottedName: "xn--tor131o.jp", ICANN: true, Text: "鳥取.jp"},
+	{DottedName: "xn--trany-yua.no", ICANN: true, Text: "tranøy.no"},
+	{DottedName: "xn--trentin-sd-tirol-rzb.it", ICANN: true, Text: "trentin-süd-tirol.it"},
+	{DottedName: "xn--trentin-sdtirol-7vb.it", ICANN: true, Text: "trentin-südtirol.it"},
+	{DottedName: "xn--trentino-sd-tirol-c3b.it", ICANN: true, Text: "trentino-süd-tirol.it"},
+	{DottedName: "xn--trentino-sdtirol-szb.it", ICANN: true, Text: "trentino-südtirol.it"},
+	{DottedName: "xn--trentinosd-tirol-rzb.it", ICANN: true, Text: "trentinosüd-tirol.it"},
+	{DottedName: "xn--trentinosdtirol-7vb.it", ICANN: true, Text: "trentinosüdtirol.it"},
+	{DottedName: "xn--trentinsd-tirol-6vb.it", ICANN: true, Text: "trentinsüd-tirol.it"},
+	{DottedName: "xn--trentinsdtirol-nsb.it", ICANN: true, Text: "trentinsüdtirol.it"},
+	{DottedName: "xn--trgstad-r1a.no", ICANN: true, Text: "trøgstad.no"},
+	{DottedName: "xn--trna-woa.no", ICANN: true, Text: "træna.no"},
+	{DottedName: "xn--troms-zua.no", ICANN: true, Text: "tromsø.no"},
+	{DottedName: "xn--tysvr-vra.no", ICANN: true, Text: "tysvær.no"},
+	{DottedName: "xn--uc0atv.hk", ICANN: true, Text: "組織.hk"},
+	{DottedName: "xn--uc0atv.tw", ICANN: true, Text: "組織.tw"},
+	{DottedName: "xn--uc0atv.xn--j6w193g", ICANN: true, Text: "組織.香港"},
+	{DottedName: "xn--uc0ay4a.hk", ICANN: true, Text: "組织.hk"},
+	{DottedName: "xn--uist22h.jp", ICANN: true, Text: "茨城.jp"},
+	{DottedName: "xn--uisz3g.jp", ICANN: true, Text: "宮城.jp"},
+	{DottedName: "xn--unjrga-rta.no", ICANN: true, Text: "unjárga.no"},
+	{DottedName: "xn--unup4y", ICANN: true, Text: "游戏"},
+	{DottedName: "xn--uuwu58a.jp", ICANN: true, Text: "沖縄.jp"},
+	{DottedName: "xn--vads-jra.no", ICANN: true, Text: "vadsø.no"},
+	{DottedName: "xn--valle-aoste-ebb.it", ICANN: true, Text: "vallée-aoste.it"},
+	{DottedName: "xn--valle-d-aoste-ehb.it", ICANN: true, Text: "vallée-d-aoste.it"},
+	{DottedName: "xn--valleaoste-e7a.it", ICANN: true, Text: "valléeaoste.it"},
+	{DottedName: "xn--valledaoste-ebb.it", ICANN: true, Text: "valléedaoste.it"},
+	{DottedName: "xn--vard-jra.no", ICANN: true, Text: "vardø.no"},
+	{DottedName: "xn--vegrshei-c0a.no", ICANN: true, Text: "vegårshei.no"},
+	{DottedName: "xn--vermgensberater-ctb", ICANN: true, Text: "vermögensberater"},
+	{DottedName: "xn--vermgensberatung-pwb", ICANN: true, Text: "vermögensberatung"},
+	{DottedName: "xn--vestvgy-ixa6o.no", ICANN: true, Text: "vestvågøy.no"},
+	{DottedName: "xn--vg-yiab.no", ICANN: true, Text: "vågå.no"},
+	{DottedName: "xn--vgan-qoa.no", ICANN: true, Text: "vågan.no"},
+	{DottedName: "xn--vgsy-qoa0j.no", ICANN: true, Text: "vågsøy.no"},
+	{DottedName: "xn--vgu402c.jp", ICANN: true, Text: "愛知.jp"},
+	{DottedName: "xn--vhquv", ICANN: true, Text: "企业"},
+	{DottedName: "xn--vler-qoa.hedmark.no", ICANN: true, Text: "våler.hedmark.no"},
+	{DottedName: "xn--vler-qoa.xn--stfold-9xa.no", ICANN: true, Text: "våler.østfold.no"},
+	{DottedName: "xn--vre-eiker-k8a.no", ICANN: true, Text: "øvre-eiker.no"},
+	{DottedName: "xn--vrggt-xqad.no", ICANN: true, Text: "várggát.no"},
+	{DottedName: "xn--vry-yla5g.no", ICANN: true, Text: "værøy.no"},
+	{DottedName: "xn--vuq861b", ICANN: true, Text: "信息"},
+	{DottedName: "xn--w4r85el8fhu5dnra", ICANN: true, Text: "嘉里大酒店"},
+	{DottedName: "xn--w4rs40l", ICANN: true, Text: "嘉里"},
+	{DottedName: "xn--wcvs22d.hk", ICANN: true, Text: "教育.hk"},
+	{DottedName: "xn--wcvs22d.xn--j6w193g", ICANN: true, Text: "教育.香港"},
+	{DottedName: "xn--wgbh1c", ICANN: true, Text: "مصر"},
+	{DottedName: "xn--wgbl6a", ICANN: true, Text: "قطر"},
+	{DottedName: "xn--xhq521b", ICANN: true, Text: "广东"},
+	{DottedName: "xn--xkc2al3hye2a", ICANN: true, Text: "இலங்கை"},
+	{DottedName: "xn--xkc2dl3a5ee0h", ICANN: true, Text: "இந்தியா"},
+	{DottedName: "xn--y9a3aq", ICANN: true, Text: "հայ"},
+	{DottedName: "xn--yer-zna.no", ICANN: true, Text: "øyer.no"},
+	{DottedName: "xn--yfro4i67o", ICANN: true, Text: "新加坡"},
+	{DottedName: "xn--ygarden-p1a.no", ICANN: true, Text: "øygarden.no"},
+	{DottedName: "xn--ygbi2ammx", ICANN: true, Text: "فلسطين"},
+	{DottedName: "xn--ystre-slidre-ujb.no", ICANN: true, Text: "øystre-slidre.no"},
+	{DottedName: "xn--zbx025d.jp", ICANN: true, Text: "滋賀.jp"},
+	{DottedName: "xn--zf0ao64a.tw", ICANN: true, Text: "網路.tw"},
+	{DottedName: "xn--zf0avx.hk", ICANN: true, Text: "網络.hk"},
+	{DottedName: "xn--zfr164b", ICANN: true, Text: "政务"},
+	{DottedName: "xxx", ICANN: true},
+	{DottedName: "xyz", ICANN: true},
+	{DottedName: "xz.cn", ICANN: true},
+	{DottedName: "y.bg", ICANN: true},
+	{DottedName: "y.se", ICANN: true},
+	{DottedName: "yabu.hyogo.jp", ICANN: true},
+	{DottedName: "yabuki.fukushima.jp", ICANN: true},
+	{DottedName: "yachimata.chiba.jp", ICANN: true},
+	{DottedName: "yachiyo.chiba.jp", ICANN: true},
+	{DottedName: "yachiyo.ibaraki.jp", ICANN: true},
+	{DottedName: "yachts", ICANN: true},
+	{DottedName: "yaese.okinawa.jp", ICANN: true},
+	{DottedName: "yahaba.iwate.jp", ICANN: true},
+	{DottedName: "yahiko.niigata.jp", ICANN: true},
+	{DottedName: "yahoo", ICANN: true},
+	{DottedName: "yaita.tochigi.jp", ICANN: true},
+	{DottedName: "yaizu.shizuoka.jp", ICANN: true},
+	{DottedName: "yakage.okayama.jp", ICANN: true},
+	{DottedName: "yakumo.hokkaido.jp", ICANN: true},
+	{DottedName: "yakumo.shimane.jp", ICANN: true},
+	{DottedName: "yalta.ua", ICANN: true},
+	{DottedName: "yamada.fukuoka.jp", ICANN: true},
+	{DottedName: "yamada.iwate.jp", ICANN: true},
+	{DottedName: "yamada.toyama.jp", ICANN: true},
+	{DottedName: "yamaga.kumamoto.jp", ICANN: true},
+	{DottedName: "yamagata.gifu.jp", ICANN: true},
+	{DottedName: "yamagata.ibaraki.jp", ICANN: true},
+	{DottedName: "yamagata.jp", ICANN: true},
+	{DottedName: "yamagata.nagano.jp", ICANN: true},
+	{DottedName: "yamagata.yamagata.jp", ICANN: true},
+	{DottedName: "yamaguchi.jp", ICANN: true},
+	{DottedName: "yamakita.kanagawa.jp", ICANN: true},
+	{DottedName: "yamamoto.miyagi.jp", ICANN: true},
+	{DottedName: "yamanakako.yamanashi.jp", ICANN: true},
+	{DottedName: "yamanashi.jp", ICANN: true},
+	{DottedName: "yamanashi.yamanashi.jp", ICANN: true},
+	{DottedName: "yamanobe.yamagata.jp", ICANN: true},
+	{DottedName: "yamanouchi.nagano.jp", ICANN: true},
+	{DottedName: "yamashina.kyoto.jp", ICANN: true},
+	{DottedName: "yamato.fukushima.jp", ICANN: true},
+	{DottedName: "yamato.kanagawa.jp", ICANN: true},
+	{DottedName: "yamato.kumamoto.jp", ICANN: true},
+	{DottedName: "yamatokoriyama.nara.jp", ICANN: true},
+	{DottedName: "yamatotakada.nara.jp", ICANN: true},
+	{DottedName: "yamatsuri.fukushima.jp", ICANN: true},
+	{DottedName: "yamaxun", ICANN: true},
+	{DottedName: "yamazoe.nara.jp", ICANN: true},
+	{DottedName: "yame.fukuoka.jp", ICANN: true},
+	{DottedName: "yanagawa.fukuoka.jp", ICANN: true},
+	{DottedName: "yanaizu.fukushima.jp", ICANN: true},
+	{DottedName: "yandex", ICANN: true},
+	{DottedName: "yao.osaka.jp", ICANN: true},
+	{DottedName: "yaotsu.gifu.jp", ICANN: true},
+	{DottedName: "yasaka.nagano.jp", ICANN: true},
+	{DottedName: "yashio.saitama.jp", ICANN: true},
+	{DottedName: "yashiro.hyogo.jp", ICANN: true},
+	{DottedName: "yasu.shiga.jp", ICANN: true},
+	{DottedName: "yasuda.kochi.jp", ICANN: true},
+	{DottedName: "yasugi.shimane.jp", ICANN: true},
+	{DottedName: "yasuoka.nagano.jp", ICANN: true},
+	{DottedName: "yatomi.aichi.jp", ICANN: true},
+	{DottedName: "yatsuka.shimane.jp", ICANN: true},
+	{DottedName: "yatsushiro.kumamoto.jp", ICANN: true},
+	{DottedName: "yawara.ibaraki.jp", ICANN: true},
+	{DottedName: "yawata.kyoto.jp", ICANN: true},
+	{DottedName: "yawatahama.ehime.jp", ICANN: true},
+	{DottedName: "yazu.tottori.jp", ICANN: true},
+	{DottedName: "ye", ICANN: true},
+	{DottedName: "yk.ca", ICANN: true},
+	{DottedName: "yn.cn", ICANN: true},
+	{DottedName: "yodobashi", ICANN: true},
+	{DottedName: "yoga", ICANN: true},
+	{DottedName: "yoichi.hokkaido.jp", ICANN: true},
+	{DottedName: "yoita.niigata.jp", ICANN: true},
+	{DottedName: "yoka.hyogo.jp", ICANN: true},
+	{DottedName: "yokaichiba.chiba.jp", ICANN: true},
+	{DottedName: "yokawa.hyogo.jp", ICANN: true},
+	{DottedName: "yokkaichi.mie.jp", ICANN: true},
+	{DottedName: "yokohama", ICANN: true},
+	{DottedName: "yokoshibahikari.chiba.jp", ICANN: true},
+	{DottedName: "yokosuka.kanagawa.jp", ICANN: true},
+	{DottedName: "yokote.akita.jp", ICANN: true},
+	{DottedName: "yokoze.saitama.jp", ICANN: true},
+	{DottedName: "yomitan.okinawa.jp", ICANN: true},
+	{DottedName: "yonabaru.okinawa.jp", ICANN: true},
+	{DottedName: "yonago.tottori.jp", ICANN: true},
+	{DottedName: "yonaguni.okinawa.jp", ICANN: true},
+	{DottedName: "yonezawa.yamagata.jp", ICANN: true},
+	{DottedName: "yono.saitama.jp", ICANN: true},
+	{DottedName: "yorii.saitama.jp", ICANN: true},
+	{DottedName: "york.museum", ICANN: true},
+	{DottedName: "yorkshire.museum", ICANN: true},
+	{DottedName: "yoro.gifu.jp", ICANN: true},
+	{DottedName: "yosemite.museum", ICANN: true},
+	{DottedName: "yoshida.saitama.jp", ICANN: true},
+	{DottedName: "yoshida.shizuoka.jp", ICANN: true},
+	{DottedName: "yoshikawa.saitama.jp", ICANN: true},
+	{DottedName: "yoshimi.saitama.jp", ICANN: true},
+	{DottedName: "yoshino.nara.jp", ICANN: true},
+	{DottedName: "yoshinogari.saga.jp", ICANN: true},
+	{DottedName: "yoshioka.gunma.jp", ICANN: true},
+	{DottedName: "yotsukaido.chiba.jp", ICANN: true},
+	{DottedName: "you", ICANN: true},
+	{DottedName: "youth.museum", ICANN: true},
+	{DottedName: "youtube", ICANN: true},
+	{DottedName: "yt", ICANN: true},
+	{DottedName: "yuasa.wakayama.jp", ICANN: true},
+	{DottedName: "yufu.oita.jp", ICANN: true},
+	{DottedName: "yugawa.fukushima.jp", ICANN: true},
+	{DottedName: "yugawara.kanagawa.jp", ICANN: true},
+	{DottedName: "yuki.ibaraki.jp", ICANN: true},
+	{DottedName: "yukuhashi.fukuoka.jp", ICANN: true},
+	{DottedName: "yun", ICANN: true},
+	{DottedName: "yura.wakayama.jp", ICANN: true},
+	{DottedName: "yurihonjo.akita.jp", ICANN: true},
+	{DottedName: "yusuhara.kochi.jp", ICANN: true},
+	{DottedName: "yusui.kagoshima.jp", ICANN: true},
+	{DottedName: "yuu.yamaguchi.jp", ICANN: true},
+	{DottedName: "yuza.yamagata.jp", ICANN: true},
+	{DottedName: "yuzawa.niigata.jp", ICANN: true},
+	{DottedName: "z.bg", ICANN: true},
+	{DottedName: "z.se", ICANN: true},
+	{DottedName: "zachpomor.pl", ICANN: true},
+	{DottedName: "zagan.pl", ICANN: true},
+	{DottedName: "zama.kanagawa.jp", ICANN: true},
+	{DottedName: "zamami.okinawa.jp", ICANN: true},
+	{DottedName: "zao.miyagi.jp", ICANN: true},
+	{DottedName: "zaporizhzhe.ua", ICANN: true},
+	{DottedName: "zaporizhzhia.ua", ICANN: true},
+	{DottedName: "zappos", ICANN: true},
+	{DottedName: "zara", ICANN: true},
+	{DottedName: "zarow.pl", ICANN: true},
+	{DottedName: "zentsuji.kagawa.jp", ICANN: true},
+	{DottedName: "zero", ICANN: true},
+	{DottedName: "zgora.pl", ICANN: true},
+	{DottedName: "zgorzelec.pl", ICANN: true},
+	{DottedName: "zhitomir.ua", ICANN: true},
+	{DottedName: "zhytomyr.ua", ICANN: true},
+	{DottedName: "zip", ICANN: true},
+	{DottedName: "zj.cn", ICANN: true},
+	{DottedName: "zlg.br", ICANN: true},
+	{DottedName: "zm", ICANN: true},
+	{DottedName: "zone", ICANN: true},
+	{DottedName: "zoological.museum", ICANN: true},
+	{DottedName: "zoology.museum", ICANN: true},
+	{DottedName: "zp.gov.pl", ICANN: true},
+	{DottedName: "zp.ua", ICANN: true},
+	{DottedName: "zt.ua", ICANN: true},
+	{DottedName: "zuerich", ICANN: true},
+	{DottedName: "zushi.kanagawa.jp", ICANN: true},
+	{DottedName: "zw", ICANN: true},
+	{DottedName: "*.0emm.com", RuleType: wildcard},
+	{DottedName: "*.advisor.ws", RuleType: wildcard},
+	{DottedName: "*.alces.network", RuleType: wildcard},
+	{DottedName: "*.awdev.ca", RuleType: wildcard},
+	{DottedName: "*.azurecontainer.io", RuleType: wildcard},
+	{DottedName: "*.backyards.banzaicloud.io", RuleType: wildcard},
+	{DottedName: "*.banzai.cloud", RuleType: wildcard},
+	{DottedName: "*.bzz.dapps.earth", RuleType: wildcard},
+	{DottedName: "*.cloud.metacentrum.cz", RuleType: wildcard},
+	{DottedName: "*.cloudera.site", RuleType: wildcard},
+	{DottedName: "*.cns.joyent.com", RuleType: wildcard},
+	{DottedName: "*.code.run", RuleType: wildcard},
+	{DottedName: "*.compute-1.amazonaws.com", RuleType: wildcard},
+	{DottedName: "*.compute.amazonaws.com", RuleType: wildcard},
+	{DottedName: "*.compute.amazonaws.com.cn", RuleType: wildcard},
+	{DottedName: "*.compute.estate", RuleType: wildcard},
+	{DottedName: "*.cryptonomic.net", RuleType: wildcard},
+	{DottedName: "*.customer-oci.com", RuleType: wildcard},
+	{DottedName: "*.dapps.earth", RuleType: wildcard},
+	{DottedName: "*.dev.adobeaemcloud.com", RuleType: wildcard},
+	{DottedName: "*.devcdnaccesso.com", RuleType: wildcard},
+	{DottedName: "*.developer.app", RuleType: wildcard},
+	{DottedName: "*.digitaloceanspaces.com", RuleType: wildcard},
+	{DottedName: "*.diher.solutions", RuleType: wildcard},
+	{DottedName: "*.dweb.link", RuleType: wildcard},
+	{DottedName: "*.elb.amazonaws.com", RuleType: wildcard},
+	{DottedName: "*.elb.amazonaws.com.cn", RuleType: wildcard},
+	{DottedName: "*.ex.futurecms.at", RuleType: wildcard},
+	{DottedName: "*.ex.ortsinfo.at", RuleType: wildcard},
+	{DottedName: "*.firenet.ch", RuleType: wildcard},
+	{DottedName: "*.frusky.de", RuleType: wildcard},
+	{DottedName: "*.futurecms.at", RuleType: wildcard},
+	{DottedName: "*.gateway.dev", RuleType: wildcard},
+	{DottedName: "*.hosting.myjino.ru", RuleType: wildcard},
+	{DottedName: "*.hosting.ovh.net", RuleType: wildcard},
+	{DottedName: "*.in.futurecms.at", RuleType: wildcard},
+	{DottedName: "*.kunden.ortsinfo.at", RuleType: wildcard},
+	{DottedName: "*.landing.myjino.ru", RuleType: wildcard},
+	{DottedName: "*.lcl.dev", RuleType: wildcard},
+	{DottedName: "*.lclstage.dev", RuleType: wildcard},
+	{DottedName: "*.linodeobjects.com", RuleType: wildcard},
+	{DottedName: "*.magentosite.cloud", RuleType: wildcard},
+	{DottedName: "*.moonscale.io", RuleType: wildcard},
+	{DottedName: "*.nodebalancer.linode.com", RuleType: wildcard},
+	{DottedName: "*.northflank.app", RuleType: wildcard},
+	{DottedName: "*.oci.customer-oci.com", RuleType: wildcard},
+	{DottedName: "*.ocp.customer-oci.com", RuleType: wildcard},
+	{DottedName: "*.ocs.customer-oci.com", RuleType: wildcard},
+	{DottedName: "*.on-k3s.io", RuleType: wildcard},
+	{DottedName: "*.on-rancher.cloud", RuleType: wildcard},
+	{DottedName: "*.on-rio.io", RuleType: wildcard},
+	{DottedName: "*.otap.co", RuleType: wildcard},
+	{DottedName: "*.owo.codes", RuleType: wildcard},
+	{DottedName: "*.paywhirl.com", RuleType: wildcard},
+	{DottedName: "*.platformsh.site", RuleType: wildcard},
+	{DottedName: "*.quipelements.com", RuleType: wildcard},
+	{DottedName: "*.r.appspot.com", RuleType: wildcard},
+	{DottedName: "*.rss.my.id", RuleType: wildcard},
+	{DottedName: "*.s5y.io", RuleType: wildcard},
+	{DottedName: "*.sensiosite.cloud", RuleType: wildcard},
+	{DottedName: "*.spectrum.myjino.ru", RuleType: wildcard},
+	{DottedName: "*.statics.cloud", RuleType: wildcard},
+	{DottedName: "*.stg.dev", RuleType: wildcard},
+	{DottedName: "*.stgstage.dev", RuleType: wildcard},
+	{DottedName: "*.stolos.io", RuleType: wildcard},
+	{DottedName: "*.svc.firenet.ch", RuleType: wildcard},
+	{DottedName: "*.sys.qcx.io", RuleType: wildcard},
+	{DottedName: "*.telebit.xyz", RuleType: wildcard},
+	{DottedName: "*.transurl.be", RuleType: wildcard},
+	{DottedName: "*.transurl.eu", RuleType: wildcard},
+	{DottedName: "*.transurl.nl", RuleType: wildcard},
+	{DottedName: "*.triton.zone", RuleType: wildcard},
+	{DottedName: "*.tst.site", RuleType: wildcard},
+	{DottedName: "*.uberspace.de", RuleType: wildcard},
+	{DottedName: "*.usercontent.goog", RuleType: wildcard},
+	{DottedName: "*.vps.myjino.ru", RuleType: wildcard},
+	{DottedName: "*.webhare.dev", RuleType: wildcard},
+	{DottedName: "*.webpaas.ovh.net", RuleType: wildcard},
+	{DottedName: "001www.com"},
+	{DottedName: "0e.vc"},
+	{DottedName: "12hp.at"},
+	{DottedName: "12hp.ch"},
+	{DottedName: "12hp.de"},
+	{DottedName: "1337.pictures"},
+	{DottedName: "16-b.it"},
+	{DottedName: "1kapp.com"},
+	{DottedName: "2038.io"},
+	{DottedName: "2ix.at"},
+	{DottedName: "2ix.ch"},
+	{DottedName: "2ix.de"},
+	{DottedName: "32-b.it"},
+	{DottedName: "3utilities.com"},
+	{DottedName: "4lima.at"},
+	{DottedName: "4lima.ch"},
+	{DottedName: "4lima.de"},
+	{DottedName: "4u.com"},
+	{DottedName: "611.to"},
+	{DottedName: "64-b.it"},
+	{DottedName: "a.prod.fastly.net"},
+	{DottedName: "a.run.app"},
+	{DottedName: "a.ssl.fastly.net"},
+	{DottedName: "abkhazia.su"},
+	{DottedName: "ac.leg.br"},
+	{DottedName: "ac.ru"},
+	{DottedName: "accesscam.org"},
+	{DottedName: "adimo.co.uk"},
+	{DottedName: "adobeaemcloud.com"},
+	{DottedName: "adobeaemcloud.net"},
+	{DottedName: "adygeya.ru"},
+	{DottedName: "adygeya.su"},
+	{DottedName: "ae.org"},
+	{DottedName: "affinitylottery.org.uk"},
+	{DottedName: "africa.com"},
+	{DottedName: "aktyubinsk.su"},
+	{DottedName: "al.eu.org"},
+	{DottedName: "al.leg.br"},
+	{DottedName: "alp1.ae.flow.ch"},
+	{DottedName: "alpha-myqnapcloud.com"},
+	{DottedName: "alpha.bounty-full.com"},
+	{DottedName: "altervista.org"},
+	{DottedName: "alwaysdata.net"},
+	{DottedName: "am.leg.br"},
+	{DottedName: "amscompute.com"},
+	{DottedName: "amsw.nl"},
+	{DottedName: "and.mom"},
+	{DottedName: "ap-northeast-1.elasticbeanstalk.com"},
+	{DottedName: "ap-northeast-2.elasticbeanstalk.com"},
+	{DottedName: "ap-northeast-3.elasticbeanstalk.com"},
+	{DottedName: "ap-south-1.elasticbeanstalk.com"},
+	{DottedName: "ap-southeast-1.elasticbeanstalk.com"},
+	{DottedName: "ap-southeast-2.elasticbeanstalk.com"},
+	{DottedName: "ap.leg.br"},
+	{DottedName: "api.stdlib.com"},
+	{DottedName: "apigee.io"},
+	{DottedName: "app.banzaicloud.io"},
+	{DottedName: "app.gp"},
+	{DottedName: "app.lmpm.com"},
+	{DottedName: "app.os.fedoraproject.org"},
+	{DottedName: "app.os.stg.fedoraproject.org"},
+	{DottedName: "app.render.com"},
+	{DottedName: "appchizi.com"},
+	{DottedName: "appengine.flow.ch"},
+	{DottedName: "applinzi.com"},
+	{DottedName: "apps.fbsbx.com"},
+	{DottedName: "apps.lair.io"},
+	{DottedName: "appspacehosted.com"},
+	{DottedName: "appspaceusercontent.com"},
+	{DottedName: "appspot.com"},
+	{DottedName: "appudo.net"},
+	{DottedName: "ar.com"},
+	{DottedName: "arkhangelsk.su"},
+	{DottedName: "armenia.su"},
+	{DottedName: "art.pl"},
+	{DottedName: "arvo.network"},
+	{DottedName: "ashgabad.su"},
+	{DottedName: "asso.eu.org"},
+	{DottedName: "at-band-camp.net"},
+	{DottedName: "at.eu.org"},
+	{DottedName: "at.md"},
+	{DottedName: "at.vg"},
+	{DottedName: "ath.cx"},
+	{DottedName: "atl.jelastic.vps-host.net"},
+	{DottedName: "au.eu.org"},
+	{DottedName: "aus.basketball"},
+	{DottedName: "authgear-staging.com"},
+	{DottedName: "authgearapps.com"},
+	{DottedName: "awsglobalaccelerator.com"},
+	{DottedName: "awsmppl.com"},
+	{DottedName: "azerbaijan.su"},
+	{DottedName: "azimuth.network"},
+	{DottedName: "azure-mobile.net"},
+	{DottedName: "azurestaticapps.net"},
+	{DottedName: "azurewebsites.net"},
+	{DottedName: "b-data.io"},
+	{DottedName: "b.ssl.fastly.net"},
+	{DottedName: "ba.leg.br"},
+	{DottedName: "backplaneapp.io"},
+	{DottedName: "balashov.su"},
+	{DottedName: "balena-devices.com"},
+	{DottedName: "bar0.net"},
+	{DottedName: "bar1.net"},
+	{DottedName: "bar2.net"},
+	{DottedName: "barrel-of-knowledge.info"},
+	{DottedName: "barrell-of-knowledge.info"},
+	{DottedName: "barsy.bg"},
+	{DottedName: "barsy.ca"},
+	{DottedName: "barsy.club"},
+	{DottedName: "barsy.co.uk"},
+	{DottedName: "barsy.de"},
+	{DottedName: "barsy.eu"},
+	{DottedName: "barsy.in"},
+	{DottedName: "barsy.info"},
+	{DottedName: "barsy.io"},
+	{DottedName: "barsy.me"},
+	{DottedName: "barsy.menu"},
+	{DottedName: "barsy.mobi"},
+	{DottedName: "barsy.net"},
+	{DottedName: "barsy.online"},
+	{DottedName: "barsy.org"},
+	{DottedName: "barsy.pro"},
+	{DottedName: "barsy.pub"},
+	{DottedName: "barsy.ro"},
+	{DottedName: "barsy.shop"},
+	{DottedName: "barsy.site"},
+	{DottedName: "barsy.support"},
+	{DottedName: "barsy.uk"},
+	{DottedName: "barsycenter.com"},
+	{DottedName: "barsyonline.co.uk"},
+	{DottedName: "barsyonline.com"},
+	{DottedName: "bashkiria.ru"},
+	{DottedName: "bashkiria.su"},
+	{DottedName: "basicserver.io"},
+	{DottedName: "bc.platform.sh"},
+	{DottedName: "bci.dnstrace.pro"},
+	{DottedName: "be.ax"},
+	{DottedName: "be.eu.org"},
+	{DottedName: "be.gy"},
+	{DottedName: "beep.pl"},
+	{DottedName: "beta.bounty-full.com"},
+	{DottedName: "beta.tailscale.net"},
+	{DottedName: "betainabox.com"},
+	{DottedName: "better-than.tv"},
+	{DottedName: "bg.eu.org"},
+	{DottedName: "bip.sh"},
+	{DottedName: "bir.ru"},
+	{DottedName: "bitbridge.net"},
+	{DottedName: "bitbucket.io"},
+	{DottedName: "biz.at"},
+	{DottedName: "biz.dk"},
+	{DottedName: "biz.gl"},
+	{DottedName: "biz.ua"},
+	{DottedName: "blackbaudcdn.net"},
+	{DottedName: "blog.gt"},
+	{DottedName: "blog.kg"},
+	{DottedName: "blog.vu"},
+	{DottedName: "blogdns.com"},
+	{DottedName: "blogdns.net"},
+	{DottedName: "blogdns.org"},
+	{DottedName: "bloger.id"},
+	{DottedName: "blogsite.org"},
+	{DottedName: "blogsite.xyz"},
+	{DottedName: "blogspot.ae"},
+	{DottedName: "blogspot.al"},
+	{DottedName: "blogspot.am"},
+	{DottedName: "blogspot.ba"},
+	{DottedName: "blogspot.be"},
+	{DottedName: "blogspot.bg"},
+	{DottedName: "blogspot.bj"},
+	{DottedName: "blogspot.ca"},
+	{DottedName: "blogspot.cf"},
+	{DottedName: "blogspot.ch"},
+	{DottedName: "blogspot.cl"},
+	{DottedName: "blogspot.co.at"},
+	{DottedName: "blogspot.co.id"},
+	{DottedName: "blogspot.co.il"},
+	{DottedName: "blogspot.co.ke"},
+	{DottedName: "blogspot.co.nz"},
+	{DottedName: "blogspot.co.uk"},
+	{DottedName: "blogspot.co.za"},
+	{DottedName: "blogspot.com"},
+	{DottedName: "blogspot.com.ar"},
+	{DottedName: "blogspot.com.au"},
+	{DottedName: "blogspot.com.br"},
+	{DottedName: "blogspot.com.by"},
+	{DottedName: "blogspot.com.co"},
+	{DottedName: "blogspot.com.cy"},
+	{DottedName: "blogspot.com.ee"},
+	{DottedName: "blogspot.com.eg"},
+	{DottedName: "blogspot.com.es"},
+	{DottedName: "blogspot.com.mt"},
+	{DottedName: "blogspot.com.ng"},
+	{DottedName: "blogspot.com.tr"},
+	{DottedName: "blogspot.com.uy"},
+	{DottedName: "blogspot.cv"},
+	{DottedName: "blogspot.cz"},
+	{DottedName: "blogspot.de"},
+	{DottedName: "blogspot.dk"},
+	{DottedName: "blogspot.fi"},
+	{DottedName: "blogspot.fr"},
+	{DottedName: "blogspot.gr"},
+	{DottedName: "blogspot.hk"},
+	{DottedName: "blogspot.hr"},
+	{DottedName: "blogspot.hu"},
+	{DottedName: "blogspot.ie"},
+	{DottedName: "blogspot.in"},
+	{DottedName: "blogspot.is"},
+	{DottedName: "blogspot.it"},
+	{DottedName: "blogspot.jp"},
+	{DottedName: "blogspot.kr"},
+	{DottedName: "blogspot.li"},
+	{DottedName: "blogspot.lt"},
+	{DottedName: "blogspot.lu"},
+	{DottedName: "blogspot.md"},
+	{DottedName: "blogspot.mk"},
+	{DottedName: "blogspot.mr"},
+	{DottedName: "blogspot.mx"},
+	{DottedName: "blogspot.my"},
+	{DottedName: "blogspot.nl"},
+	{DottedName: "blogspot.no"},
+	{DottedName: "blogspot.pe"},
+	{DottedName: "blogspot.pt"},
+	{DottedName: "blogspot.qa"},
+	{DottedName: "blogspot.re"},
+	{DottedName: "blogspot.ro"},
+	{DottedName: "blogspot.rs"},
+	{DottedName: "blogspot.ru"},
+	{DottedName: "blogspot.se"},
+	{DottedName: "blogspot.sg"},
+	{DottedName: "blogspot.si"},
+	{DottedName: "blogspot.sk"},
+	{DottedName: "blogspot.sn"},
+	{DottedName: "blogspot.td"},
+	{DottedName: "blogspot.tw"},
+	{DottedName: "blogspot.ug"},
+	{DottedName: "blogspot.vn"},
+	{DottedName: "blogsyte.com"},
+	{DottedName: "bloxcms.com"},
+	{DottedName: "bluebite.io"},
+	{DottedName: "bmoattachments.org"},
+	{DottedName: "bnr.la"},
+	{DottedName: "boldlygoingnowhere.org"},
+	{DottedName: "bookonline.app"},
+	{DottedName: "boomla.net"},
+	{DottedName: "bounceme.net"},
+	{DottedName: "bounty-full.com"},
+	{DottedName: "boutir.com"},
+	{DottedName: "boxfuse.io"},
+	{DottedName: "bplaced.com"},
+	{DottedName: "bplaced.de"},
+	{DottedName: "bplaced.net"},
+	{DottedName: "br.com"},
+	{DottedName: "brasilia.me"},
+	{DottedName: "broke-it.net"},
+	{DottedName: "browsersafetymark.io"},
+	{DottedName: "bryansk.su"},
+	{DottedName: "bss.design"},
+	{DottedName: "builtwithdark.com"},
+	{DottedName: "bukhara.su"},
+	{DottedName: "buyshouses.net"},
+	{DottedName: "byen.site"},
+	{DottedName: "c.cdn77.org"},
+	{DottedName: "c.la"},
+	{DottedName: "c66.me"},
+	{DottedName: "ca-central-1.elasticbeanstalk.com"},
+	{DottedName: "ca.eu.org"},
+	{DottedName: "ca.reclaim.cloud"},
+	{DottedName: "caa.li"},
+	{DottedName: "cable-modem.org"},
+	{DottedName: "cafjs.com"},
+	{DottedName: "camdvr.org"},
+	{DottedName: "caracal.mythic-beasts.com"},
+	{DottedName: "carrd.co"},
+	{DottedName: "casacam.net"},
+	{DottedName: "cat.ax"},
+	{DottedName: "cbg.ru"},
+	{DottedName: "cc.hn"},
+	{DottedName: "cc.ua"},
+	{DottedName: "cd.eu.org"},
+	{DottedName: "cdn-edges.net"},
+	{DottedName: "cdn.prod.atlassian-dev.net"},
+	{DottedName: "cdn77-ssl.net"},
+	{DottedName: "ce.leg.br"},
+	{DottedName: "cechire.com"},
+	{DottedName: "centralus.azurestaticapps.net"},
+	{DottedName: "certmgr.org"},
+	{DottedName: "ch.eu.org"},
+	{DottedName: "ch.tc"},
+	{DottedName: "ch.trendhosting.cloud"},
+	{DottedName: "channelsdvr.net"},
+	{DottedName: "chimkent.su"},
+	{DottedName: "chirurgiens-dentistes-en-france.fr"},
+	{DottedName: "ciscofreak.com"},
+	{DottedName: "cistron.nl"},
+	{DottedName: "clan.rip"},
+	{DottedName: "clerk.app"},
+	{DottedName: "clerkstage.app"},
+	{DottedName: "cleverapps.io"},
+	{DottedName: "clicketcloud.com"},
+	{DottedName: "clickrising.net"},
+	{DottedName: "cloud-fr1.unispace.io"},
+	{DottedName: "cloud.fedoraproject.org"},
+	{DottedName: "cloud.goog"},
+	{DottedName: "cloud.interhostsolutions.be"},
+	{DottedName: "cloud.jelastic.open.tim.it"},
+	{DottedName: "cloud66.ws"},
+	{DottedName: "cloud66.zone"},
+	{DottedName: "cloudaccess.host"},
+	{DottedName: "cloudaccess.net"},
+	{DottedName: "cloudapp.net"},
+	{DottedName: "cloudapps.digital"},
+	{DottedName: "cloudcontrolapp.com"},
+	{DottedName: "cloudcontrolled.com"},
+	{DottedName: "cloudfront.net"},
+	{DottedName: "cloudfunctions.net"},
+	{DottedName: "cloudjiffy.net"},
+	{DottedName: "cloudns.asia"},
+	{DottedName: "cloudns.biz"},
+	{DottedName: "cloudns.cc"},
+	{DottedName: "cloudns.club"},
+	{DottedName: "cloudns.eu"},
+	{DottedName: "cloudns.in"},
+	{DottedName: "cloudns.info"},
+	{DottedName: "cloudns.org"},
+	{DottedName: "cloudns.pro"},
+	{DottedName: "cloudns.pw"},
+	{DottedName: "cloudns.us"},
+	{DottedName: "cloudsite.builders"},
+	{DottedName: "cloudycluster.net"},
+	{DottedName: "cn-north-1.eb.amazonaws.com.cn"},
+	{DottedName: "cn-northwest-1.eb.amazonaws.com.cn"},
+	{DottedName: "cn.com"},
+	{DottedName: "cn.eu.org"},
+	{DottedName: "cn.vu"},
+	{DottedName: "cnpy.gdn"},
+	{DottedName: "co.bn"},
+	{DottedName: "co.business"},
+	{DottedName: "co.ca"},
+	{DottedName: "co.com"},
+	{DottedName: "co.cz"},
+	{DottedName: "co.dk"},
+	{DottedName: "co.education"},
+	{DottedName: "co.events"},
+	{DottedName: "co.financial"},
+	{DottedName: "co.krd"},
+	{DottedName: "co.network"},
+	{DottedName: "co.nl"},
+	{DottedName: "co.no"},
+	{DottedName: "co.pl"},
+	{DottedName: "co.place"},
+	{DottedName: "co.ro"},
+	{DottedName: "co.technology"},
+	{DottedName: "co.ua"},
+	{DottedName: "codespot.com"},
+	{DottedName: "col.ng"},
+	{DottedName: "collegefan.org"},
+	{DottedName: "com.de"},
+	{DottedName: "com.ru"},
+	{DottedName: "com.se"},
+	{DottedName: "community-pro.de"},
+	{DottedName: "community-pro.net"},
+	{DottedName: "conf.se"},
+	{DottedName: "conn.uk"},
+	{DottedName: "copro.uk"},
+	{DottedName: "couchpotatofries.org"},
+	{DottedName: "couk.me"},
+	{DottedName: "crafting.xyz"},
+	{DottedName: "crd.co"},
+	{DottedName: "cs.keliweb.cloud"},
+	{DottedName: "csx.cc"},
+	{DottedName: "cupcake.is"},
+	{DottedName: "curv.dev"},
+	{DottedName: "cust.dev.thingdust.io"},
+	{DottedName: "cust.disrec.thingdust.io"},
+	{DottedName: "cust.prod.thingdust.io"},
+	{DottedName: "cust.retrosnub.co.uk"},
+	{DottedName: "cust.testing.thingdust.io"},
+	{DottedName: "custom.metacentrum.cz"},
+	{DottedName: "customer.mythic-beasts.com"},
+	{DottedName: "customer.speedpartner.de"},
+	{DottedName: "cx.ua"},
+	{DottedName: "cy.eu.org"},
+	{DottedName: "cya.gg"},
+	{DottedName: "cyon.link"},
+	{DottedName: "cyon.site"},
+	{DottedName: "cz.eu.org"},
+	{DottedName: "d.gv.vc"},
+	{DottedName: "daemon.panel.gg"},
+	{DottedName: "dagestan.ru"},
+	{DottedName: "dagestan.su"},
+	{DottedName: "damnserver.com"},
+	{DottedName: "daplie.me"},
+	{DottedName: "dattolocal.com"},
+	{DottedName: "dattolocal.net"},
+	{DottedName: "dattorelay.com"},
+	{DottedName: "dattoweb.com"},
+	{DottedName: "dd-dns.de"},
+	{DottedName: "ddns.me"},
+	{DottedName: "ddns.net"},
+	{DottedName: "ddns5.com"},
+	{DottedName: "ddnsfree.com"},
+	{DottedName: "ddnsgeek.com"},
+	{DottedName: "ddnsking.com"},
+	{DottedName: "ddnslive.com"},
+	{DottedName: "ddnss.de"},
+	{DottedName: "ddnss.org"},
+	{DottedName: "de.com"},
+	{DottedName: "de.cool"},
+	{DottedName: "de.eu.org"},
+	{DottedName: "de.gt"},
+	{DottedName: "de.ls"},
+	{DottedName: "de.md"},
+	{DottedName: "de.trendhosting.cloud"},
+	{DottedName: "debian.net"},
+	{DottedName: "dedyn.io"},
+	{DottedName: "definima.io"},
+	{DottedName: "definima.net"},
+	{DottedName: "demo.datacenter.fi"},
+	{DottedName: "demo.datadetect.com"},
+	{DottedName: "demo.jelastic.com"},
+	{DottedName: "demon.nl"},
+	{DottedName: "deno-staging.dev"},
+	{DottedName: "deno.dev"},
+	{DottedName: "dev-myqnapcloud.com"},
+	{DottedName: "dev.static.land"},
+	{DottedName: "dev.vu"},
+	{DottedName: "development.run"},
+	{DottedName: "devices.resinstaging.io"},
+	{DottedName: "df.leg.br"},
+	{DottedName: "dh.bytemark.co.uk"},
+	{DottedName: "diadem.cloud"},
+	{DottedName: "direct.quickconnect.to"},
+	{DottedName: "discourse.group"},
+	{DottedName: "discourse.team"},
+	{DottedName: "diskstation.eu"},
+	{DottedName: "diskstation.me"},
+	{DottedName: "diskstation.org"},
+	{DottedName: "diskussionsbereich.de"},
+	{DottedName: "ditchyourip.com"},
+	{DottedName: "dk.eu.org"},
+	{DottedName: "dnsalias.com"},
+	{DottedName: "dnsalias.net"},
+	{DottedName: "dnsalias.org"},
+	{DottedName: "dnsdojo.com"},
+	{DottedName: "dnsdojo.net"},
+	{DottedName: "dnsdojo.org"},
+	{DottedName: "dnsfor.me"},
+	{DottedName: "dnshome.de"},
+	{DottedName: "dnsiskinky.com"},
+	{DottedName: "dnsking.ch"},
+	{DottedName: "dnsup.net"},
+	{DottedName: "dnsupdate.info"},
+	{DottedName: "dnsupdater.de"},
+	{DottedName: "does-it.net"},
+	{DottedName: "doesntexist.com"},
+	{DottedName: "doesntexist.org"},
+	{DottedName: "dontexist.com"},
+	{DottedName: "dontexist.net"},
+	{DottedName: "dontexist.org"},
+	{DottedName: "doomdns.com"},
+	{DottedName: "doomdns.org"},
+	{DottedName: "dopaas.com"},
+	{DottedName: "dray-dns.de"},
+	{DottedName: "drayddns.com"},
+	{DottedName: "draydns.de"},
+	{DottedName: "dreamhosters.com"},
+	{DottedName: "drr.ac"},
+	{DottedName: "drud.io"},
+	{DottedName: "drud.us"},
+	{DottedName: "dscloud.biz"},
+	{DottedName: "dscloud.me"},
+	{DottedName: "dscloud.mobi"},
+	{DottedName: "dsmynas.com"},
+	{DottedName: "dsmynas.net"},
+	{DottedName: "dsmynas.org"},
+	{DottedName: "duckdns.org"},
+	{DottedName: "dvrcam.info"},
+	{DottedName: "dvrdns.org"},
+	{DottedName: "dy.fi"},
+	{DottedName: "dyn-berlin.de"},
+	{DottedName: "dyn-ip24.de"},
+	{DottedName: "dyn-o-saur.com"},
+	{DottedName: "dyn-vpn.de"},
+	{DottedName: "dyn.cosidns.de"},
+	{DottedName: "dyn.ddnss.de"},
+	{DottedName: "dyn.home-webserver.de"},
+	{DottedName: "dyn53.io"},
+	{DottedName: "dynalias.com"},
+	{DottedName: "dynalias.net"},
+	{DottedName: "dynalias.org"},
+	{DottedName: "dynamic-dns.info"},
+	{DottedName: "dynamisches-dns.de"},
+	{DottedName: "dynathome.net"},
+	{DottedName: "dyndns-at-home.com"},
+	{DottedName: "dyndns-at-work.com"},
+	{DottedName: "dyndns-blog.com"},
+	{DottedName: "dyndns-free.com"},
+	{DottedName: "dyndns-home.com"},
+	{DottedName: "dyndns-ip.com"},
+	{DottedName: "dyndns-mail.com"},
+	{DottedName: "dyndns-office.com"},
+	{DottedName: "dyndns-pics.com"},
+	{DottedName: "dyndns-remote.com"},
+	{DottedName: "dyndns-server.com"},
+	{DottedName: "dyndns-web.com"},
+	{DottedName: "dyndns-wiki.com"},
+	{DottedName: "dyndns-work.com"},
+	{DottedName: "dyndns.biz"},
+	{DottedName: "dyndns.dappnode.io"},
+	{DottedName: "dyndns.ddnss.de"},
+	{DottedName: "dyndns.info"},
+	{DottedName: "dyndns.org"},
+	{DottedName: "dyndns.tv"},
+	{DottedName: "dyndns.ws"},
+	{DottedName: "dyndns1.de"},
+	{DottedName: "dynns.com"},
+	{DottedName: "dynserv.org"},
+	{DottedName: "dynu.net"},
+	{DottedName: "dynv6.net"},
+	{DottedName: "dynvpn.de"},
+	{DottedName: "e4.cz"},
+	{DottedName: "east-kazakhstan.su"},
+	{DottedName: "eastasia.azurestaticapps.net"},
+	{DottedName: "eastus2.azurestaticapps.net"},
+	{DottedName: "eating-organic.net"},
+	{DottedName: "ecommerce-shop.pl"},
+	{DottedName: "edgeapp.net"},
+	{DottedName: "edgecompute.app"},
+	{DottedName: "edgestack.me"},
+	{DottedName: "editorx.io"},
+	{DottedName: "edu.eu.org"},
+	{DottedName: "edu.krd"},
+	{DottedName: "edu.ru"},
+	{DottedName: "edu.scot"},
+	{DottedName: "edugit.org"},
+	{DottedName: "ee.eu.org"},
+	{DottedName: "eero-stage.online"},
+	{DottedName: "eero.online"},
+	{DottedName: "elasticbeanstalk.com"},
+	{DottedName: "elementor.cloud"},
+	{DottedName: "elementor.cool"},
+	{DottedName: "en-root.fr"},
+	{DottedName: "endofinternet.net"},
+	{DottedName: "endofinternet.org"},
+	{DottedName: "endoftheinternet.org"},
+	{DottedName: "enscaled.sg"},
+	{DottedName: "ent.platform.sh"},
+	{DottedName: "enterprisecloud.nu"},
+	{DottedName: "es-1.axarnet.cloud"},
+	{DottedName: "es.ax"},
+	{DottedName: "es.eu.org"},
+	{DottedName: "es.leg.br"},
+	{DottedName: "est-a-la-maison.com"},
+	{DottedName: "est-a-la-masion.com"},
+	{DottedName: "est-le-patron.com"},
+	{DottedName: "est-mon-blogueur.com"},
+	{DottedName: "eu-1.evennode.com"},
+	{DottedName: "eu-2.evennode.com"},
+	{DottedName: "eu-3.evennode.com"},
+	{DottedName: "eu-4.evennode.com"},
+	{DottedName: "eu-central-1.elasticbeanstalk.com"},
+	{DottedName: "eu-west-1.elasticbeanstalk.com"},
+	{DottedName: "eu-west-2.elasticbeanstalk.com"},
+	{DottedName: "eu-west-3.elasticbeanstalk.com"},
+	{DottedName: "eu.ax"},
+	{DottedName: "eu.com"},
+	{DottedName: "eu.meteorapp.com"},
+	{DottedName: "eu.org"},
+	{DottedName: "eu.platform.sh"},
+	{DottedName: "eu.pythonanywhere.com"},
+	{DottedName: "eurodir.ru"},
+	{DottedName: "exnet.su"},
+	{DottedName: "ezproxy.kuleuven.be"},
+	{DottedName: "familyds.com"},
+	{DottedName: "familyds.net"},
+	{DottedName: "familyds.org"},
+	{DottedName: "fantasyleague.cc"},
+	{DottedName: "fastblog.net"},
+	{DottedName: "fastly-terrarium.com"},
+	{DottedName: "fastlylb.net"},
+	{DottedName: "faststacks.net"},
+	{DottedName: "fastvps-server.com"},
+	{DottedName: "fastvps.host"},
+	{DottedName: "fastvps.site"},
+	{DottedName: "fbx-os.fr"},
+	{DottedName: "fbxos.fr"},
+	{DottedName: "fedorainfracloud.org"},
+	{DottedName: "fedorapeople.org"},
+	{DottedName: "fentiger.mythic-beasts.com"},
+	{DottedName: "feste-ip.net"},
+	{DottedName: "fh-muenster.io"},
+	{DottedName: "fi.cloudplatform.fi"},
+	{DottedName: "fi.eu.org"},
+	{DottedName: "filegear-au.me"},
+	{DottedName: "filegear-de.me"},
+	{DottedName: "filegear-gb.me"},
+	{DottedName: "filegear-ie.me"},
+	{DottedName: "filegear-jp.me"},
+	{DottedName: "filegear-sg.me"},
+	{DottedName: "filegear.me"},
+	{DottedName: "fin.ci"},
+	{DottedName: "firebaseapp.com"},
+	{DottedName: "firewall-gateway.com"},
+	{DottedName: "firewall-gateway.de"},
+	{DottedName: "firewall-gateway.net"},
+	{DottedName: "fireweb.app"},
+	{DottedName: "firm.dk"},
+	{DottedName: "firm.ng"},
+	{DottedName: "flap.id"},
+	{DottedName: "flt.cloud.muni.cz"},
+	{DottedName: "fly.dev"},
+	{DottedName: "flynnhosting.net"},
+	{DottedName: "fnwk.site"},
+	{DottedName: "folionetwork.site"},
+	{DottedName: "for-better.biz"},
+	{DottedName: "for-more.biz"},
+	{DottedName: "for-our.info"},
+	{DottedName: "for-some.biz"},
+	{DottedName: "for-the.biz"},
+	{DottedName: "for.men"},
+	{DottedName: "for.mom"},
+	{DottedName: "for.one"},
+	{DottedName: "for.sale"},
+	{DottedName: "forgeblocks.com"},
+	{DottedName: "forgot.her.name"},
+	{DottedName: "forgot.his.name"},
+	{DottedName: "forte.id"},
+	{DottedName: "forumz.info"},
+	{DottedName: "fr-1.paas.massivegrid.net"},
+	{DottedName: "fr.eu.org"},
+	{DottedName: "fra1-de.cloudjiffy.net"},
+	{DottedName: "framer.app"},
+	{DottedName: "framercanvas.com"},
+	{DottedName: "free.hr"},
+	{DottedName: "freebox-os.com"},
+	{DottedName: "freebox-os.fr"},
+	{DottedName: "freeboxos.com"},
+	{DottedName: "freeboxos.fr"},
+	{DottedName: "freeddns.org"},
+	{DottedName: "freeddns.us"},
+	{DottedName: "freedesktop.org"},
+	{DottedName: "freemyip.com"},
+	{DottedName: "freesite.host"},
+	{DottedName: "freetls.fastly.net"},
+	{DottedName: "from-ak.com"},
+	{DottedName: "from-al.com"},
+	{DottedName: "from-ar.com"},
+	{DottedName: "from-az.net"},
+	{DottedName: "from-ca.com"},
+	{DottedName: "from-co.net"},
+	{DottedName: "from-ct.com"},
+	{DottedName: "from-dc.com"},
+	{DottedName: "from-de.com"},
+	{DottedName: "from-fl.com"},
+	{DottedName: "from-ga.com"},
+	{DottedName: "from-hi.com"},
+	{DottedName: "from-ia.com"},
+	{DottedName: "from-id.com"},
+	{DottedName: "from-il.com"},
+	{DottedName: "from-in.com"},
+	{DottedName: "from-ks.com"},
+	{DottedName: "from-ky.com"},
+	{DottedName: "from-la.net"},
+	{DottedName: "from-ma.com"},
+	{DottedName: "from-md.com"},
+	{DottedName: "from-me.org"},
+	{DottedName: "from-mi.com"},
+	{DottedName: "from-mn.com"},
+	{DottedName: "from-mo.com"},
+	{DottedName: "from-ms.com"},
+	{DottedName: "from-mt.com"},
+	{DottedName: "from-nc.com"},
+	{DottedName: "from-nd.com"},
+	{DottedName: "from-ne.com"},
+	{DottedName: "from-nh.com"},
+	{DottedName: "from-nj.com"},
+	{DottedName: "from-nm.com"},
+	{DottedName: "from-nv.com"},
+	{DottedName: "from-ny.net"},
+	{DottedName: "from-oh.com"},
+	{DottedName: "from-ok.com"},
+	{DottedName: "from-or.com"},
+	{DottedName: "from-pa.com"},
+	{DottedName: "from-pr.com"},
+	{DottedName: "from-ri.com"},
+	{DottedName: "from-sc.com"},
+	{DottedName: "from-sd.com"},
+	{DottedName: "from-tn.com"},
+	{DottedName: "from-tx.com"},
+	{DottedName: "from-ut.com"},
+	{DottedName: "from-va.com"},
+	{DottedName: "from-vt.com"},
+	{DottedName: "from-wa.com"},
+	{DottedName: "from-wi.com"},
+	{DottedName: "from-wv.com"},
+	{DottedName: "from-wy.com"},
+	{DottedName: "from.marketing"},
+	{DottedName: "from.work"},
+	{DottedName: "ftpaccess.cc"},
+	{DottedName: "fuettertdasnetz.de"},
+	{DottedName: "futurehosting.at"},
+	{DottedName: "futuremailing.at"},
+	{DottedName: "g.vbrplsbx.io"},
+	{DottedName: "game-host.org"},
+	{DottedName: "game-server.cc"},
+	{DottedName: "gb.com"},
+	{DottedName: "gb.net"},
+	{DottedName: "gda.pl"},
+	{DottedName: "gdansk.pl"},
+	{DottedName: "gdynia.pl"},
+	{DottedName: "geekgalaxy.com"},
+	{DottedName: "gehirn.ne.jp"},
+	{DottedName: "gen.ng"},
+	{DottedName: "gentapps.com"},
+	{DottedName: "gentlentapis.com"},
+	{DottedName: "georgia.su"},
+	{DottedName: "getmyip.com"},
+	{DottedName: "gets-it.net"},
+	{DottedName: "gg.ax"},
+	{DottedName: "ghost.io"},
+	{DottedName: "giize.com"},
+	{DottedName: "git-pages.rit.edu"},
+	{DottedName: "git-repos.de"},
+	{DottedName: "gitapp.si"},
+	{DottedName: "github.io"},
+	{DottedName: "githubpreview.dev"},
+	{DottedName: "githubusercontent.com"},
+	{DottedName: "gitlab.io"},
+	{DottedName: "gitpage.si"},
+	{DottedName: "gleeze.com"},
+	{DottedName: "glitch.me"},
+	{DottedName: "gliwice.pl"},
+	{DottedName: "global.prod.fastly.net"},
+	{DottedName: "global.ssl.fastly.net"},
+	{DottedName: "glug.org.uk"},
+	{DottedName: "go.dyndns.org"},
+	{DottedName: "go.leg.br"},
+	{DottedName: "goip.de"},
+	{DottedName: "golffan.us"},
+	{DottedName: "googleapis.com"},
+	{DottedName: "googlecode.com"},
+	{DottedName: "gotdns.ch"},
+	{DottedName: "gotdns.com"},
+	{DottedName: "gotdns.org"},
+	{DottedName: "gotpantheon.com"},
+	{DottedName: "goupile.fr"},
+	{DottedName: "gov.ru"},
+	{DottedName: "gov.scot"},
+	{DottedName: "gr.com"},
+	{DottedName: "gr.eu.org"},
+	{DottedName: "graphox.us"},
+	{DottedName: "groks-the.info"},
+	{DottedName: "groks-this.info"},
+	{DottedName: "grozny.ru"},
+	{DottedName: "grozny.su"},
+	{DottedName: "gsj.bz"},
+	{DottedName: "gv.vc"},
+	{DottedName: "gwiddle.co.uk"},
+	{DottedName: "half.host"},
+	{DottedName: "ham-radio-op.net"},
+	{DottedName: "hashbang.sh"},
+	{DottedName: "hasura-app.io"},
+	{DottedName: "hasura.app"},
+	{DottedName: "hb.cldmail.ru"},
+	{DottedName: "health-carereform.com"},
+	{DottedName: "hepforge.org"},
+	{DottedName: "here-for-more.info"},
+	{DottedName: "herokuapp.com"},
+	{DottedName: "herokussl.com"},
+	{DottedName: "hicam.net"},
+	{DottedName: "hidora.com"},
+	{DottedName: "hk.com"},
+	{DottedName: "hk.org"},
+	{DottedName: "hlx.live"},
+	{DottedName: "hlx.page"},
+	{DottedName: "hlx3.page"},
+	{DottedName: "hobby-site.com"},
+	{DottedName: "hobby-site.org"},
+	{DottedName: "home-webserver.de"},
+	{DottedName: "home.dyndns.org"},
+	{DottedName: "homedns.org"},
+	{DottedName: "homeftp.net"},
+	{DottedName: "homeftp.org"},
+	{DottedName: "homeip.net"},
+	{DottedName: "homelink.one"},
+	{DottedName: "homelinux.com"},
+	{DottedName: "homelinux.net"},
+	{DottedName: "homelinux.org"},
+	{DottedName: "homeoffice.gov.uk"},
+	{DottedName: "homesecuritymac.com"},
+	{DottedName: "homesecuritypc.com"},
+	{DottedName: "homesklep.pl"},
+	{DottedName: "homeunix.com"},
+	{DottedName: "homeunix.net"},
+	{DottedName: "homeunix.org"},
+	{DottedName: "hopto.me"},
+	{DottedName: "hopto.org"},
+	{DottedName: "hosp.uk"},
+	{DottedName: "hostedpi.com"},
+	{DottedName: "hosting-cluster.nl"},
+	{DottedName: "hostyhosting.io"},
+	{DottedName: "hotelwithflight.com"},
+	{DottedName: "hr.eu.org"},
+	{DottedName: "hra.health"},
+	{DottedName: "hs.run"},
+	{DottedName: "hs.zone"},
+	{DottedName: "httpbin.org"},
+	{DottedName: "hu.com"},
+	{DottedName: "hu.eu.org"},
+	{DottedName: "hu.net"},
+	{DottedName: "hzc.io"},
+	{DottedName: "i234.me"},
+	{DottedName: "iamallama.com"},
+	{DottedName: "id.forgerock.io"},
+	{DottedName: "id.repl.co"},
+	{DottedName: "idnblogger.com"},
+	{DottedName: "ie.eu.org"},
+	{DottedName: "iki.fi"},
+	{DottedName: "il.eu.org"},
+	{DottedName: "ilovecollege.info"},
+	{DottedName: "impertrix.com"},
+	{DottedName: "impertrixcdn.com"},
+	{DottedName: "in-berlin.de"},
+	{DottedName: "in-brb.de"},
+	{DottedName: "in-butter.de"},
+	{DottedName: "in-dsl.de"},
+	{DottedName: "in-dsl.net"},
+	{DottedName: "in-dsl.org"},
+	{DottedName: "in-the-band.net"},
+	{DottedName: "in-vpn.de"},
+	{DottedName: "in-vpn.net"},
+	{DottedName: "in-vpn.org"},
+	{DottedName: "in.eu.org"},
+	{DottedName: "in.london"},
+	{DottedName: "in.net"},
+	{DottedName: "inc.hk"},
+	{DottedName: "indie.porn"},
+	{DottedName: "indowapblog.com"},
+	{DottedName: "inf.ua"},
+	{DottedName: "info.at"},
+	{DottedName: "info.cx"},
+	{DottedName: "instance.datadetect.com"},
+	{DottedName: "instances.spawn.cc"},
+	{DottedName: "instantcloud.cn"},
+	{DottedName: "int.eu.org"},
+	{DottedName: "int.ru"},
+	{DottedName: "internet-dns.de"},
+	{DottedName: "io.kg"},
+	{DottedName: "iobb.net"},
+	{DottedName: "iopsys.se"},
+	{DottedName: "ip.linodeusercontent.com"},
+	{DottedName: "ipifony.net"},
+	{DottedName: "is-a-anarchist.com"},
+	{DottedName: "is-a-blogger.com"},
+	{DottedName: "is-a-bookkeeper.com"},
+	{DottedName: "is-a-bruinsfan.org"},
+	{DottedName: "is-a-bulls-fan.com"},
+	{DottedName: "is-a-candidate.org"},
+	{DottedName: "is-a-caterer.com"},
+	{DottedName: "is-a-celticsfan.org"},
+	{DottedName: "is-a-chef.com"},
+	{DottedName: "is-a-chef.net"},
+	{DottedName: "is-a-chef.org"},
+	{DottedName: "is-a-conservative.com"},
+	{DottedName: "is-a-cpa.com"},
+	{DottedName: "is-a-cubicle-slave.com"},
+	{DottedName: "is-a-democrat.com"},
+	{DottedName: "is-a-designer.com"},
+	{DottedName: "is-a-doctor.com"},
+	{DottedName: "is-a-financialadvisor.com"},
+	{DottedName: "is-a-geek.com"},
+	{DottedName: "is-a-geek.net"},
+	{DottedName: "is-a-geek.org"},
+	{DottedName: "is-a-green.com"},
+	{DottedName: "is-a-guru.com"},
+	{DottedName: "is-a-hard-worker.com"},
+	{DottedName: "is-a-hunter.com"},
+	{DottedName: "is-a-knight.org"},
+	{DottedName: "is-a-landscaper.com"},
+	{DottedName: "is-a-lawyer.com"},
+	{DottedName: "is-a-liberal.com"},
+	{DottedName: "is-a-libertarian.com"},
+	{DottedName: "is-a-linux-user.org"},
+	{DottedName: "is-a-llama.com"},
+	{DottedName: "is-a-musician.com"},
+	{DottedName: "is-a-nascarfan.com"},
+	{DottedName: "is-a-nurse.com"},
+	{DottedName: "is-a-painter.com"},
+	{DottedName: "is-a-patsfan.org"},
+	{DottedName: "is-a-personaltrainer.com"},
+	{DottedName: "is-a-photographer.com"},
+	{DottedName: "is-a-player.com"},
+	{DottedName: "is-a-republican.com"},
+	{DottedName: "is-a-rockstar.com"},
+	{DottedName: "is-a-socialist.com"},
+	{DottedName: "is-a-soxfan.org"},
+	{DottedName: "is-a-student.com"},
+	{DottedName: "is-a-teacher.com"},
+	{DottedName: "is-a-techie.com"},
+	{DottedName: "is-a-therapist.com"},
+	{DottedName: "is-an-accountant.com"},
+	{DottedName: "is-an-actor.com"},
+	{DottedName: "is-an-actress.com"},
+	{DottedName: "is-an-anarchist.com"},
+	{DottedName: "is-an-artist.com"},
+	{DottedName: "is-an-engineer.com"},
+	{DottedName: "is-an-entertainer.com"},
+	{DottedName: "is-by.us"},
+	{DottedName: "is-certified.com"},
+	{DottedName: "is-found.org"},
+	{DottedName: "is-gone.com"},
+	{DottedName: "is-into-anime.com"},
+	{DottedName: "is-into-cars.com"},
+	{DottedName: "is-into-cartoons.com"},
+	{DottedName: "is-into-games.com"},
+	{DottedName: "is-leet.com"},
+	{DottedName: "is-lost.org"},
+	{DottedName: "is-not-certified.com"},
+	{DottedName: "is-saved.org"},
+	{DottedName: "is-slick.com"},
+	{DottedName: "is-uberleet.com"},
+	{DottedName: "is-very-bad.org"},
+	{DottedName: "is-very-evil.org"},
+	{DottedName: "is-very-good.org"},
+	{DottedName: "is-very-nice.org"},
+	{DottedName: "is-very-sweet.org"},
+	{DottedName: "is-with-theband.com"},
+	{DottedName: "is.eu.org"},
+	{DottedName: "isa-geek.com"},
+	{DottedName: "isa-geek.net"},
+	{DottedName: "isa-geek.org"},
+	{DottedName: "isa-hockeynut.com"},
+	{DottedName: "iserv.dev"},
+	{DottedName: "issmarterthanyou.com"},
+	{DottedName: "isteingeek.de"},
+	{DottedName: "istmein.de"},
+	{DottedName: "it.eu.org"},
+	{DottedName: "it1.eur.aruba.jenv-aruba.cloud"},
+	{DottedName: "it1.jenv-aruba.cloud"},
+	{DottedName: "itcouldbewor.se"},
+	{DottedName: "ivanovo.su"},
+	{DottedName: "j.layershift.co.uk"},
+	{DottedName: "j.scaleforce.com.cy"},
+	{DottedName: "j.scaleforce.net"},
+	{DottedName: "jambyl.su"},
+	{DottedName: "jc.neen.it"},
+	{DottedName: "jcloud-ver-jpc.ik-server.com"},
+	{DottedName: "jcloud.ik-server.com"},
+	{DottedName: "jcloud.kz"},
+	{DottedName: "jdevcloud.com"},
+	{DottedName: "jed.wafaicloud.com"},
+	{DottedName: "jelastic.dogado.eu"},
+	{DottedName: "jelastic.regruhosting.ru"},
+	{DottedName: "jelastic.saveincloud.net"},
+	{DottedName: "jelastic.team"},
+	{DottedName: "jelastic.tsukaeru.net"},
+	{DottedName: "jele.cloud"},
+	{DottedName: "jele.club"},
+	{DottedName: "jele.host"},
+	{DottedName: "jele.io"},
+	{DottedName: "jele.site"},
+	{DottedName: "jls-sto1.elastx.net"},
+	{DottedName: "jls-sto2.elastx.net"},
+	{DottedName: "jls-sto3.elastx.net"},
+	{DottedName: "jotelulu.cloud"},
+	{DottedName: "jozi.biz"},
+	{DottedName: "jp.eu.org"},
+	{DottedName: "jp.kg"},
+	{DottedName: "jp.md"},
+	{DottedName: "jp.net"},
+	{DottedName: "jpn.com"},
+	{DottedName: "js.org"},
+	{DottedName: "js.wpenginepowered.com"},
+	{DottedName: "ju.mp"},
+	{DottedName: "kaas.gg"},
+	{DottedName: "kalmykia.ru"},
+	{DottedName: "kalmykia.su"},
+	{DottedName: "kaluga.su"},
+	{DottedName: "karacol.su"},
+	{DottedName: "karaganda.su"},
+	{DottedName: "karelia.su"},
+	{DottedName: "kasserver.com"},
+	{DottedName: "keliweb.cloud"},
+	{DottedName: "keymachine.de"},
+	{DottedName: "khakassia.su"},
+	{DottedName: "khplay.nl"},
+	{DottedName: "kicks-ass.net"},
+	{DottedName: "kicks-ass.org"},
+	{DottedName: "kilatiron.com"},
+	{DottedName: "kinghost.net"},
+	{DottedName: "knightpoint.systems"},
+	{DottedName: "knowsitall.info"},
+	{DottedName: "knx-server.net"},
+	{DottedName: "kozow.com"},
+	{DottedName: "kr.com"},
+	{DottedName: "kr.eu.org"},
+	{DottedName: "krakow.pl"},
+	{DottedName: "krasnik.pl"},
+	{DottedName: "krasnodar.su"},
+	{DottedName: "krellian.net"},
+	{DottedName: "kuleuven.cloud"},
+	{DottedName: "kurgan.su"},
+	{DottedName: "kustanai.ru"},
+	{DottedName: "kustanai.su"},
+	{DottedName: "l-o-g-i-n.de"},
+	{DottedName: "lab.ms"},
+	{DottedName: "land-4-sale.us"},
+	{DottedName: "lcube-server.de"},
+	{DottedName: "leadpages.co"},
+	{DottedName: "lebtimnetz.de"},
+	{DottedName: "leczna.pl"},
+	{DottedName: "leitungsen.de"},
+	{DottedName: "lelux.site"},
+	{DottedName: "lenug.su"},
+	{DottedName: "lib.de.us"},
+	{DottedName: "likes-pie.com"},
+	{DottedName: "likescandy.com"},
+	{DottedName: "lima-city.at"},
+	{DottedName: "lima-city.ch"},
+	{DottedName: "lima-city.de"},
+	{DottedName: "lima-city.rocks"},
+	{DottedName: "lima.zone"},
+	{DottedName: "linkyard-cloud.ch"},
+	{DottedName: "linkyard.cloud"},
+	{DottedName: "lk3.ru"},
+	{DottedName: "localhost.daplie.me"},
+	{DottedName: "localzone.xyz"},
+	{DottedName: "loginline.app"},
+	{DottedName: "loginline.dev"},
+	{DottedName: "loginline.io"},
+	{DottedName: "loginline.services"},
+	{DottedName: "loginline.site"},
+	{DottedName: "loginto.me"},
+	{DottedName: "logoip.com"},
+	{DottedName: "logoip.de"},
+	{DottedName: "lohmus.me"},
+	{DottedName: "lolipop.io"},
+	{DottedName: "lon-1.paas.massivegrid.net"},
+	{DottedName: "lon-2.paas.massivegrid.net"},
+	{DottedName: "lon.wafaicloud.com"},
+	{DottedName: "london.cloudapps.digital"},
+	{DottedName: "loseyourip.com"},
+	{DottedName: "lpages.co"},
+	{DottedName: "lpusercontent.com"},
+	{DottedName: "lt.eu.org"},
+	{DottedName: "ltd.hk"},
+	{DottedName: "ltd.ng"},
+	{DottedName: "ltd.ua"},
+	{DottedName: "lu.eu.org"},
+	{DottedName: "lubartow.pl"},
+	{DottedName: "lublin.pl"},
+	{DottedName: "lug.org.uk"},
+	{DottedName: "lugs.org.uk"},
+	{DottedName: "lv.eu.org"},
+	{DottedName: "lynx.mythic-beasts.com"},
+	{DottedName: "ma.leg.br"},
+	{DottedName: "magnet.page"},
+	{DottedName: "mangyshlak.su"},
+	{DottedName: "map.fastly.net"},
+	{DottedName: "map.fastlylb.net"},
+	{DottedName: "marine.ru"},
+	{DottedName: "mayfirst.info"},
+	{DottedName: "mayfirst.org"},
+	{DottedName: "mazeplay.com"},
+	{DottedName: "mc.ax"},
+	{DottedName: "mc.eu.org"},
+	{DottedName: "mcdir.me"},
+	{DottedName: "mcdir.ru"},
+	{DottedName: "mcpe.me"},
+	{DottedName: "mcpre.ru"},
+	{DottedName: "me.eu.org"},
+	{DottedName: "me.tc"},
+	{DottedName: "me.vu"},
+	{DottedName: "med.pl"},
+	{DottedName: "mediatech.by"},
+	{DottedName: "mediatech.dev"},
+	{DottedName: "mein-iserv.de"},
+	{DottedName: "mein-vigor.de"},
+	{DottedName: "meinforum.net"},
+	{DottedName: "mel.cloudlets.com.au"},
+	{DottedName: "members.linode.com"},
+	{DottedName: "memset.net"},
+	{DottedName: "merseine.nu"},
+	{DottedName: "meteorapp.com"},
+	{DottedName: "mex.com"},
+	{DottedName: "mg.leg.br"},
+	{DottedName: "mil.ru"},
+	{DottedName: "mine.nu"},
+	{DottedName: "miniserver.com"},
+	{DottedName: "minisite.ms"},
+	{DottedName: "mintere.site"},
+	{DottedName: "mircloud.host"},
+	{DottedName: "mircloud.ru"},
+	{DottedName: "mircloud.us"},
+	{DottedName: "misconfused.org"},
+	{DottedName: "mk.eu.org"},
+	{DottedName: "mlbfan.org"},
+	{DottedName: "mmafan.biz"},
+	{DottedName: "mo-siemens.io"},
+	{DottedName: "mock.pstmn.io"},
+	{DottedName: "moonscale.net"},
+	{DottedName: "mordovia.ru"},
+	{DottedName: "mordovia.su"},
+	{DottedName: "mozilla-iot.org"},
+	{DottedName: "ms.leg.br"},
+	{DottedName: "msk.ru"},
+	{DottedName: "msk.su"},
+	{DottedName: "mt.eu.org"},
+	{DottedName: "mt.leg.br"},
+	{DottedName: "murmansk.su"},
+	{DottedName: "my-firewall.org"},
+	{DottedName: "my-gateway.de"},
+	{DottedName: "my-router.de"},
+	{DottedName: "my-vigor.de"},
+	{DottedName: "my-wan.de"},
+	{DottedName: "my.eu.org"},
+	{DottedName: "myactivedirectory.com"},
+	{DottedName: "myasustor.com"},
+	{DottedName: "mycd.eu"},
+	{DottedName: "mycloud.by"},
+	{DottedName: "mydatto.com"},
+	{DottedName: "mydatto.net"},
+	{DottedName: "myddns.rocks"},
+	{DottedName: "mydissent.net"},
+	{DottedName: "mydobiss.com"},
+	{DottedName: "mydrobo.com"},
+	{DottedName: "myds.me"},
+	{DottedName: "myeffect.net"},
+	{DottedName: "myfast.host"},
+	{DottedName: "myfast.space"},
+	{DottedName: "myfirewall.org"},
+	{DottedName: "myforum.community"},
+	{DottedName: "myfritz.net"},
+	{DottedName: "myftp.biz"},
+	{DottedName: "myftp.org"},
+	{DottedName: "myhome-server.de"},
+	{DottedName: "myiphost.com"},
+	{DottedName: "myjino.ru"},
+	{DottedName: "mymailer.com.tw"},
+	{DottedName: "mymediapc.net"},
+	{DottedName: "mypep.link"},
+	{DottedName: "mypets.ws"},
+	{DottedName: "myphotos.cc"},
+	{DottedName: "mypi.co"},
+	{DottedName: "mypsx.net"},
+	{DottedName: "myqnapcloud.com"},
+	{DottedName: "myravendb.com"},
+	{DottedName: "mysecuritycamera.com"},
+	{DottedName: "mysecuritycamera.net"},
+	{DottedName: "mysecuritycamera.org"},
+	{DottedName: "myshopblocks.com"},
+	{DottedName: "myshopify.com"},
+	{DottedName: "myspreadshop.at"},
+	{DottedName: "myspreadshop.be"},
+	{DottedName: "myspreadshop.ca"},
+	{DottedName: "myspreadshop.ch"},
+	{DottedName: "myspreadshop.co.uk"},
+	{DottedName: "myspreadshop.com"},
+	{DottedName: "myspreadshop.com.au"},
+	{DottedName: "myspreadshop.de"},
+	{DottedName: "myspreadshop.dk"},
+	{DottedName: "myspreadshop.es"},
+	{DottedName: "myspreadshop.fi"},
+	{DottedName: "myspreadshop.fr"},
+	{DottedName: "myspreadshop.ie"},
+	{DottedName: "myspreadshop.it"},
+	{DottedName: "myspreadshop.net"},
+	{DottedName: "myspreadshop.nl"},
+	{DottedName: "myspreadshop.no"},
+	{DottedName: "myspreadshop.pl"},
+	{DottedName: "myspreadshop.se"},
+	{DottedName: "mytis.ru"},
+	{DottedName: "mytuleap.com"},
+	{DottedName: "myvnc.com"},
+	{DottedName: "mywire.org"},
+	{DottedName: "n4t.co"},
+	{DottedName: "na4u.ru"},
+	{DottedName: "nalchik.ru"},
+	{DottedName: "nalchik.su"},
+	{DottedName: "navoi.su"},
+	{DottedName: "neat-url.com"},
+	{DottedName: "neko.am"},
+	{DottedName: "nerdpol.ovh"},
+	{DottedName: "net-freaks.com"},
+	{DottedName: "net.eu.org"},
+	{DottedName: "net.ru"},
+	{DottedName: "netlify.app"},
+	{DottedName: "nflfan.org"},
+	{DottedName: "nfshost.com"},
+	{DottedName: "ng.eu.org"},
+	{DottedName: "ngo.ng"},
+	{DottedName: "ngrok.io"},
+	{DottedName: "nh-serv.co.uk"},
+	{DottedName: "nhlfan.net"},
+	{DottedName: "nid.io"},
+	{DottedName: "njs.jelastic.vps-host.net"},
+	{DottedName: "nl.ci"},
+	{DottedName: "nl.eu.org"},
+	{DottedName: "no-ip.biz"},
+	{DottedName: "no-ip.ca"},
+	{DottedName: "no-ip.co.uk"},
+	{DottedName: "no-ip.info"},
+	{DottedName: "no-ip.net"},
+	{DottedName: "no-ip.org"},
+	{DottedName: "no.com"},
+	{DottedName: "no.eu.org"},
+	{DottedName: "nodum.co"},
+	{DottedName: "nodum.io"},
+	{DottedName: "nog.community"},
+	{DottedName: "noho.st"},
+	{DottedName: "nohost.me"},
+	{DottedName: "noip.me"},
+	{DottedName: "noip.us"},
+	{DottedName: "noop.app"},
+	{DottedName: "nordeste-idc.saveincloud.net"},
+	{DottedName: "north-kazakhstan.su"},
+	{DottedName: "noticeable.news"},
+	{DottedName: "nov.ru"},
+	{DottedName: "nov.su"},
+	{DottedName: "novecore.site"},
+	{DottedName: "now-dns.net"},
+	{DottedName: "now-dns.org"},
+	{DottedName: "now-dns.top"},
+	{DottedName: "now.sh"},
+	{DottedName: "nsupdate.info"},
+	{DottedName: "ntdll.top"},
+	{DottedName: "ny-1.paas.massivegrid.net"},
+	{DottedName: "ny-2.paas.massivegrid.net"},
+	{DottedName: "nyaa.am"},
+	{DottedName: "nyan.to"},
+	{DottedName: "nyc.mn"},
+	{DottedName: "nz.basketball"},
+	{DottedName: "nz.eu.org"},
+	{DottedName: "obninsk.su"},
+	{DottedName: "ocelot.mythic-beasts.com"},
+	{DottedName: "of.fashion"},
+	{DottedName: "of.je"},
+	{DottedName: "of.london"},
+	{DottedName: "office-on-the.net"},
+	{DottedName: "official.academy"},
+	{DottedName: "omg.lol"},
+	{DottedName: "omniwe.site"},
+	{DottedName: "on-aptible.com"},
+	{DottedName: "on-the-web.tv"},
+	{DottedName: "on-web.fr"},
+	{DottedName: "onavstack.net"},
+	{DottedName: "oncilla.mythic-beasts.com"},
+	{DottedName: "ondigitalocean.app"},
+	{DottedName: "onfabrica.com"},
+	{DottedName: "online.th"},
+	{DottedName: "onred.one"},
+	{DottedName: "onrender.com"},
+	{DottedName: "onthewifi.com"},
+	{DottedName: "onza.mythic-beasts.com"},
+	{DottedName: "ooguy.com"},
+	{DottedName: "opencraft.hosting"},
+	{DottedName: "opensocial.site"},
+	{DottedName: "operaunite.com"},
+	{DottedName: "orangecloud.tn"},
+	{DottedName: "org.ru"},
+	{DottedName: "org.yt"},
+	{DottedName: "orsites.com"},
+	{DottedName: "orx.biz"},
+	{DottedName: "outsystemscloud.com"},
+	{DottedName: "own.pm"},
+	{DottedName: "ownip.net"},
+	{DottedName: "ownprovider.com"},
+	{DottedName: "ox.rs"},
+	{DottedName: "oxa.cloud"},
+	{DottedName: "oy.lc"},
+	{DottedName: "oya.to"},
+	{DottedName: "pa.leg.br"},
+	{DottedName: "paas.beebyte.io"},
+	{DottedName: "paas.datacenter.fi"},
+	{DottedName: "paas.hosted-by-previder.com"},
+	{DottedName: "paas.massivegrid.com"},
+	{DottedName: "pagefrontapp.com"},
+	{DottedName: "pages.dev"},
+	{DottedName: "pages.it.hs-heilbronn.de"},
+	{DottedName: "pages.torproject.net"},
+	{DottedName: "pages.wiardweb.com"},
+	{DottedName: "pagespeedmobilizer.com"},
+	{DottedName: "pagexl.com"},
+	{DottedName: "panel.gg"},
+	{DottedName: "pantheonsite.io"},
+	{DottedName: "paris.eu.org"},
+	{DottedName: "pb.leg.br"},
+	{DottedName: "pcloud.host"},
+	{DottedName: "pdns.page"},
+	{DottedName: "pe.leg.br"},
+	{DottedName: "penza.su"},
+	{DottedName: "perspecta.cloud"},
+	{DottedName: "pgafan.net"},
+	{DottedName: "pgfog.com"},
+	{DottedName: "phx.enscaled.us"},
+	{DottedName: "pi.leg.br"},
+	{DottedName: "pimienta.org"},
+	{DottedName: "pixolino.com"},
+	{DottedName: "pl.eu.org"},
+	{DottedName: "platform0.app"},
+	{DottedName: "platter-app.com"},
+	{DottedName: "platter-app.dev"},
+	{DottedName: "platterp.us"},
+	{DottedName: "playstation-cloud.com"},
+	{DottedName: "plesk.page"},
+	{DottedName: "pleskns.com"},
+	{DottedName: "podzone.net"},
+	{DottedName: "podzone.org"},
+	{DottedName: "point2this.com"},
+	{DottedName: "pointto.us"},
+	{DottedName: "poivron.org"},
+	{DottedName: "pokrovsk.su"},
+	{DottedName: "poniatowa.pl"},
+	{DottedName: "pony.club"},
+	{DottedName: "postman-echo.com"},
+	{DottedName: "potager.org"},
+	{DottedName: "poznan.pl"},
+	{DottedName: "pp.ru"},
+	{DottedName: "pp.ua"},
+	{DottedName: "pr.leg.br"},
+	{DottedName: "prequalifyme.today"},
+	{DottedName: "primetel.cloud"},
+	{DottedName: "priv.at"},
+	{DottedName: "privatizehealthinsurance.net"},
+	{DottedName: "protonet.io"},
+	{DottedName: "prvcy.page"},
+	{DottedName: "pstmn.io"},
+	{DottedName: "pt.eu.org"},
+	{DottedName: "publishproxy.com"},
+	{DottedName: "pubtls.org"},
+	{DottedName: "pyatigorsk.ru"},
+	{DottedName: "pymnt.uk"},
+	{DottedName: "pythonanywhere.com"},
+	{DottedName: "q-a.eu.org"},
+	{DottedName: "qa2.com"},
+	{DottedName: "qbuser.com"},
+	{DottedName: "qc.com"},
+	{DottedName: "qcx.io"},
+	{DottedName: "qoto.io"},
+	{DottedName: "qualifioapp.com"},
+	{DottedName: "quicksytes.com"},
+	{DottedName: "r.cdn77.net"},
+	{DottedName: "rackmaze.com"},
+	{DottedName: "rackmaze.net"},
+	{DottedName: "radio.am"},
+	{DottedName: "radio.fm"},
+	{DottedName: "raffleentry.org.uk"},
+	{DottedName: "rag-cloud-ch.hosteur.com"},
+	{DottedName: "rag-cloud.hosteur.com"},
+	{DottedName: "ras.ru"},
+	{DottedName: "ravendb.community"},
+	{DottedName: "ravendb.me"},
+	{DottedName: "ravendb.run"},
+	{DottedName: "ravpage.co.il"},
+	{DottedName: "rdv.to"},
+	{DottedName: "read-books.org"},
+	{DottedName: "readmyblog.org"},
+	{DottedName: "readthedocs.io"},
+	{DottedName: "realm.cz"},
+	{DottedName: "redirectme.net"},
+	{DottedName: "reg.dk"},
+	{DottedName: "remotewd.com"},
+	{DottedName: "repair.men"},
+	{DottedName: "repl.co"},
+	{DottedName: "repl.run"},
+	{DottedName: "reservd.com"},
+	{DottedName: "reservd.dev.thingdust.io"},
+	{DottedName: "reservd.disrec.thingdust.io"},
+	{DottedName: "reservd.testing.thingdust.io"},
+	{DottedName: "reserve-online.com"},
+	{DottedName: "reserve-online.net"},
+	{DottedName: "resindevice.io"},
+	{DottedName: "rhcloud.com"},
+	{DottedName: "ric.jelastic.vps-host.net"},
+	{DottedName: "rj.leg.br"},
+	{DottedName: "rn.leg.br"},
+	{DottedName: "ro.eu.org"},
+	{DottedName: "ro.im"},
+	{DottedName: "ro.leg.br"},
+	{DottedName: "router.management"},
+	{DottedName: "rr.leg.br"},
+	{DottedName: "rs.ba"},
+	{DottedName: "rs.leg.br"},
+	{DottedName: "rsc.cdn77.org"},
+	{DottedName: "ru.com"},
+	{DottedName: "ru.eu.org"},
+	{DottedName: "ru.net"},
+	{DottedName: "run.app"},
+	{DottedName: "ryd.wafaicloud.com"},
+	{DottedName: "s3-ap-northeast-1.amazonaws.com"},
+	{DottedName: "s3-ap-northeast-2.amazonaws.com"},
+	{DottedName: "s3-ap-south-1.amazonaws.com"},
+	{DottedName: "s3-ap-southeast-1.amazonaws.com"},
+	{DottedName: "s3-ap-southeast-2.amazonaws.com"},
+	{DottedName: "s3-ca-central-1.amazonaws.com"},
+	{DottedName: "s3-eu-central-1.amazonaws.com"},
+	{DottedName: "s3-eu-west-1.amazonaws.com"},
+	{DottedName: "s3-eu-west-2.amazonaws.com"},
+	{DottedName: "s3-eu-west-3.amazonaws.com"},
+	{DottedName: "s3-external-1.amazonaws.com"},
+	{DottedName: "s3-fips-us-gov-west-1.amazonaws.com"},
+	{DottedName: "s3-sa-east-1.amazonaws.com"},
+	{DottedName: "s3-us-east-2.amazonaws.com"},
+	{DottedName: "s3-us-gov-west-1.amazonaws.com"},
+	{DottedName: "s3-us-west-1.amazonaws.com"},
+	{DottedName: "s3-us-west-2.amazonaws.com"},
+	{DottedName: "s3-website-ap-northeast-1.amazonaws.com"},
+	{DottedName: "s3-website-ap-southeast-1.amazonaws.com"},
+	{DottedName: "s3-website-ap-southeast-2.amazonaws.com"},
+	{DottedName: "s3-website-eu-west-1.amazonaws.com"},
+	{DottedName: "s3-website-sa-east-1.amazonaws.com"},
+	{DottedName: "s3-website-us-east-1.amazonaws.com"},
+	{DottedName: "s3-website-us-west-1.amazonaws.com"},
+	{DottedName: "s3-website-us-west-2.amazonaws.com"},
+	{DottedName: "s3-website.ap-northeast-2.amazonaws.com"},
+	{DottedName: "s3-website.ap-south-1.amazonaws.com"},
+	{DottedName: "s3-website.ca-central-1.amazonaws.com"},
+	{DottedName: "s3-website.eu-central-1.amazonaws.com"},
+	{DottedName: "s3-website.eu-west-2.amazonaws.com"},
+	{DottedName: "s3-website.eu-west-3.amazonaws.com"},
+	{DottedName: "s3-website.us-east-2.amazonaws.com"},
+	{DottedName: "s3.amazonaws.com"},
+	{DottedName: "s3.ap-northeast-2.amazonaws.com"},
+	{DottedName: "s3.ap-south-1.amazonaws.com"},
+	{DottedName: "s3.ca-central-1.amazonaws.com"},
+	{DottedName: "s3.cn-north-1.amazonaws.com.cn"},
+	{DottedName: "s3.dualstack.ap-northeast-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.ap-northeast-2.amazonaws.com"},
+	{DottedName: "s3.dualstack.ap-south-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.ap-southeast-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.ap-southeast-2.amazonaws.com"},
+	{DottedName: "s3.dualstack.ca-central-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.eu-central-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.eu-west-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.eu-west-2.amazonaws.com"},
+	{DottedName: "s3.dualstack.eu-west-3.amazonaws.com"},
+	{DottedName: "s3.dualstack.sa-east-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.us-east-1.amazonaws.com"},
+	{DottedName: "s3.dualstack.us-east-2.amazonaws.com"},
+	{DottedName: "s3.eu-central-1.amazonaws.com"},
+	{DottedName: "s3.eu-west-2.amazonaws.com"},
+	{DottedName: "s3.eu-west-3.amazonaws.com"},
+	{DottedName: "s3.us-east-2.amazonaws.com"},
+	{DottedName: "sa-east-1.elasticbeanstalk.com"},
+	{DottedName: "sa.com"},
+	{DottedName: "sandcats.io"},
+	{DottedName: "saves-the-whales.com"},
+	{DottedName: "sc.leg.br"},
+	{DottedName: "sch.so"},
+	{DottedName: "schokokeks.net"},
+	{DottedName: "schulserver.de"},
+	{DottedName: "scrapper-site.net"},
+	{DottedName: "scrapping.cc"},
+	{DottedName: "scrysec.com"},
+	{DottedName: "sdscloud.pl"},
+	{DottedName: "se.eu.org"},
+	{DottedName: "se.leg.br"},
+	{DottedName: "se.net"},
+	{DottedName: "secaas.hk"},
+	{DottedName: "securitytactics.com"},
+	{DottedName: "seidat.net"},
+	{DottedName: "sekd1.beebyteapp.io"},
+	{DottedName: "selfip.biz"},
+	{DottedName: "selfip.com"},
+	{DottedName: "selfip.info"},
+	{DottedName: "selfip.net"},
+	{DottedName: "selfip.org"},
+	{DottedName: "sellfy.store"},
+	{DottedName: "sells-for-less.com"},
+	{DottedName: "sells-for-u.com"},
+	{DottedName: "sells-it.net"},
+	{DottedName: "sellsyourhome.org"},
+	{DottedName: "senseering.net"},
+	{DottedName: "servebbs.com"},
+	{DottedName: "servebbs.net"},
+	{DottedName: "servebbs.org"},
+	{DottedName: "servebeer.com"},
+	{DottedName: "serveblog.net"},
+	{DottedName: "servecounterstrike.com"},
+	{DottedName: "serveexchange.com"},
+	{DottedName: "serveftp.com"},
+	{DottedName: "serveftp.net"},
+	{DottedName: "serveftp.org"},
+	{DottedName: "servegame.com"},
+	{DottedName: "servegame.org"},
+	{DottedName: "servehalflife.com"},
+	{DottedName: "servehttp.com"},
+	{DottedName: "servehumour.com"},
+	{DottedName: "serveirc.com"},
+	{DottedName: "serveminecraft.net"},
+	{DottedName: "servemp3.com"},
+	{DottedName: "servep2p.com"},
+	{DottedName: "servepics.com"},
+	{DottedName: "servequake.com"},
+	{DottedName: "servers.run"},
+	{DottedName: "servesarcasm.com"},
+	{DottedName: "service.gov.scot"},
+	{DottedName: "service.gov.uk"},
+	{DottedName: "service.one"},
+	{DottedName: "sg-1.paas.massivegrid.net"},
+	{DottedName: "shacknet.nu"},
+	{DottedName: "shiftcrypto.dev"},
+	{DottedName: "shiftcrypto.io"},
+	{DottedName: "shiftedit.io"},
+	{DottedName: "shop.brendly.rs"},
+	{DottedName: "shop.ro"},
+	{DottedName: "shop.th"},
+	{DottedName: "shoparena.pl"},
+	{DottedName: "shopitsite.com"},
+	{DottedName: "shopware.store"},
+	{DottedName: "shw.io"},
+	{DottedName: "si.eu.org"},
+	{DottedName: "siiites.com"},
+	{DottedName: "simple-url.com"},
+	{DottedName: "sinaapp.com"},
+	{DottedName: "siteleaf.net"},
+	{DottedName: "sites.static.land"},
+	{DottedName: "sk.eu.org"},
+	{DottedName: "skygearapp.com"},
+	{DottedName: "small-web.org"},
+	{DottedName: "smushcdn.com"},
+	{DottedName: "soc.srcf.net"},
+	{DottedName: "sochi.su"},
+	{DottedName: "sopot.pl"},
+	{DottedName: "soundcast.me"},
+	{DottedName: "sp.leg.br"},
+	{DottedName: "space-to-rent.com"},
+	{DottedName: "spacekit.io"},
+	{DottedName: "spb.ru"},
+	{DottedName: "spb.su"},
+	{DottedName: "spdns.de"},
+	{DottedName: "spdns.eu"},
+	{DottedName: "spdns.org"},
+	{DottedName: "sphinx.mythic-beasts.com"},
+	{DottedName: "square7.ch"},
+	{DottedName: "square7.de"},
+	{DottedName: "square7.net"},
+	{DottedName: "srht.site"},
+	{DottedName: "ssl.origin.cdn77-secure.org"},
+	{DottedName: "stackhero-network.com"},
+	{DottedName: "stage.nodeart.io"},
+	{DottedName: "staging.onred.one"},
+	{DottedName: "static-access.net"},
+	{DottedName: "static.land"},
+	{DottedName: "static.observableusercontent.com"},
+	{DottedName: "storage.yandexcloud.net"},
+	{DottedName: "store.dk"},
+	{DottedName: "storebase.store"},
+	{DottedName: "storj.farm"},
+	{DottedName: "stuff-4-sale.org"},
+	{DottedName: "stuff-4-sale.us"},
+	{DottedName: "stufftoread.com"},
+	{DottedName: "su.paba.se"},
+	{DottedName: "supabase.co"},
+	{DottedName: "supabase.in"},
+	{DottedName: "supabase.net"},
+	{DottedName: "svn-repos.de"},
+	{DottedName: "sweetpepper.org"},
+	{DottedName: "swidnik.pl"},
+	{DottedName: "syncloud.it"},
+	{DottedName: "syno-ds.de"},
+	{DottedName: "synology-diskstation.de"},
+	{DottedName: "synology-ds.de"},
+	{DottedName: "synology.me"},
+	{DottedName: "sytes.net"},
+	{DottedName: "t3l3p0rt.net"},
+	{DottedName: "tabitorder.co.il"},
+	{DottedName: "taifun-dns.de"},
+	{DottedName: "tashkent.su"},
+	{DottedName: "tbits.me"},
+	{DottedName: "tcp4.me"},
+	{DottedName: "teaches-yoga.com"},
+	{DottedName: "tele.amune.org"},
+	{DottedName: "telebit.app"},
+	{DottedName: "telebit.io"},
+	{DottedName: "temp-dns.com"},
+	{DottedName: "tempurl.host"},
+	{DottedName: "termez.su"},
+	{DottedName: "test-iserv.de"},
+	{DottedName: "test.ru"},
+	{DottedName: "that.win"},
+	{DottedName: "theworkpc.com"},
+	{DottedName: "thingdustdata.com"},
+	{DottedName: "thruhere.net"},
+	{DottedName: "tickets.io"},
+	{DottedName: "tlon.network"},
+	{DottedName: "tn.oxa.cloud"},
+	{DottedName: "to.gt"},
+	{DottedName: "to.leg.br"},
+	{DottedName: "to.md"},
+	{DottedName: "to.work"},
+	{DottedName: "togliatti.su"},
+	{DottedName: "toolforge.org"},
+	{DottedName: "torproject.net"},
+	{DottedName: "townnews-staging.com"},
+	{DottedName: "tr.eu.org"},
+	{DottedName: "traeumtgerade.de"},
+	{DottedName: "trafficplex.cloud"},
+	{DottedName: "translate.goog"},
+	{DottedName: "troitsk.su"},
+	{DottedName: "try-snowplow.com"},
+	{DottedName: "trycloudflare.com"},
+	{DottedName: "ts.net"},
+	{DottedName: "tselinograd.su"},
+	{DottedName: "tula.su"},
+	{DottedName: "tuleap-partners.com"},
+	{DottedName: "tunk.org"},
+	{DottedName: "tuva.su"},
+	{DottedName: "tuxfamily.org"},
+	{DottedName: "tv.kg"},
+	{DottedName: "twmail.cc"},
+	{DottedName: "twmail.net"},
+	{DottedName: "twmail.org"},
+	{DottedName: "u.channelsdvr.net"},
+	{DottedName: "u2-local.xnbay.com"},
+	{DottedName: "u2.xnbay.com"},
+	{DottedName: "ua.rs"},
+	{DottedName: "uber.space"},
+	{DottedName: "ufcfan.org"},
+	{DottedName: "ui.nabu.casa"},
+	{DottedName: "uk.com"},
+	{DottedName: "uk.eu.org"},
+	{DottedName: "uk.kg"},
+	{DottedName: "uk.net"},
+	{DottedName: "uk.oxa.cloud"},
+	{DottedName: "uk.primetel.cloud"},
+	{DottedName: "uk.reclaim.cloud"},
+	{DottedName: "uk0.bigv.io"},
+	{DottedName: "ukco.me"},
+	{DottedName: "under.one"},
+	{DottedName: "uni5.net"},
+	{DottedName: "unicloud.pl"},
+	{DottedName: "unusualperson.com"},
+	{DottedName: "upaas.kazteleport.kz"},
+	{DottedName: "upli.io"},
+	{DottedName: "url.tw"},
+	{DottedName: "urown.cloud"},
+	{DottedName: "us-1.evennode.com"},
+	{DottedName: "us-2.evennode.com"},
+	{DottedName: "us-3.evennode.com"},
+	{DottedName: "us-4.evennode.com"},
+	{DottedName: "us-east-1.amazonaws.com"},
+	{DottedName: "us-east-1.elasticbeanstalk.com"},
+	{DottedName: "us-east-2.elasticbeanstalk.com"},
+	{DottedName: "us-gov-west-1.elasticbeanstalk.com"},
+	{DottedName: "us-west-1.elasticbeanstalk.com"},
+	{DottedName: "us-west-2.elasticbeanstalk.com"},
+	{DottedName: "us.ax"},
+	{DottedName: "us.com"},
+	{DottedName: "us.eu.org"},
+	{DottedName: "us.kg"},
+	{DottedName: "us.org"},
+	{DottedName: "us.platform.sh"},
+	{DottedName: "us.reclaim.cloud"},
+	{DottedName: "user.aseinet.ne.jp"},
+	{DottedName: "user.party.eus"},
+	{DottedName: "user.srcf.net"},
+	{DottedName: "usercontent.jp"},
+	{DottedName: "users.scale.virtualcloud.com.br"},
+	{DottedName: "usr.cloud.muni.cz"},
+	{DottedName: "utwente.io"},
+	{DottedName: "uwu.ai"},
+	{DottedName: "uy.com"},
+	{DottedName: "v-info.info"},
+	{DottedName: "v.ua"},
+	{DottedName: "vapor.cloud"},
+	{DottedName: "vaporcloud.io"},
+	{DottedName: "vercel.app"},
+	{DottedName: "vercel.dev"},
+	{DottedName: "vip.jelastic.cloud"},
+	{DottedName: "vipsinaapp.com"},
+	{DottedName: "virtual-user.de"},
+	{DottedName: "virtualserver.io"},
+	{DottedName: "virtualuser.de"},
+	{DottedName: "virtueeldomein.nl"},
+	{DottedName: "vladikavkaz.ru"},
+	{DottedName: "vladikavkaz.su"},
+	{DottedName: "vladimir.ru"},
+	{DottedName: "vladimir.su"},
+	{DottedName: "vm.bytemark.co.uk"},
+	{DottedName: "vologda.su"},
+	{DottedName: "voorloper.cloud"},
+	{DottedName: "vp4.me"},
+	{DottedName: "vpndns.net"},
+	{DottedName: "vpnplus.to"},
+	{DottedName: "vps-host.net"},
+	{DottedName: "vps.mcdir.ru"},
+	{DottedName: "vs.mythic-beasts.com"},
+	{DottedName: "vxl.sh"},
+	{DottedName: "wafflecell.com"},
+	{DottedName: "wblog.id"},
+	{DottedName: "wbq.me"},
+	{DottedName: "we.bs"},
+	{DottedName: "we.tc"},
+	{DottedName: "web.app"},
+	{DottedName: "web.in"},
+	{DottedName: "webhop.biz"},
+	{DottedName: "webhop.info"},
+	{DottedName: "webhop.me"},
+	{DottedName: "webhop.net"},
+	{DottedName: "webhop.org"},
+	{DottedName: "webhosting.be"},
+	{DottedName: "webredirect.org"},
+	{DottedName: "website.yandexcloud.net"},
+	{DottedName: "webspace.rocks"},
+	{DottedName: "webthings.io"},
+	{DottedName: "wedeploy.io"},
+	{DottedName: "wedeploy.me"},
+	{DottedName: "wedeploy.sh"},
+	{DottedName: "weeklylottery.org.uk"},
+	{DottedName: "wellbeingzone.co.uk"},
+	{DottedName: "wellbeingzone.eu"},
+	{DottedName: "west1-us.cloudjiffy.net"},
+	{DottedName: "westeurope.azurestaticapps.net"},
+	{DottedName: "westus2.azurestaticapps.net"},
+	{DottedName: "wien.funkfeuer.at"},
+	{DottedName: "with.marketing"},
+	{DottedName: "withgoogle.com"},
+	{DottedName: "withyoutube.com"},
+	{DottedName: "wixsite.com"},
+	{DottedName: "wmcloud.org"},
+	{DottedName: "wmflabs.org"},
+	{DottedName: "wnext.app"},
+	{DottedName: "woltlab-demo.com"},
+	{DottedName: "workers.dev"},
+	{DottedName: "workisboring.com"},
+	{DottedName: "worse-than.tv"},
+	{DottedName: "wpdevcloud.com"},
+	{DottedName: "wpenginepowered.com"},
+	{DottedName: "wphostedmail.com"},
+	{DottedName: "wpmucdn.com"},
+	{DottedName: "wpmudev.host"},
+	{DottedName: "writesthisblog.com"},
+	{DottedName: "wroc.pl"},
+	{DottedName: "x.mythic-beasts.com"},
+	{DottedName: "x443.pw"},
+	{DottedName: "xen.prgmr.com"},
+	{DottedName: "xn--41a.xn--p1acf", Text: "я.рус"},
+	{DottedName: "xn--80aaa0cvac.xn--p1acf", Text: "самара.рус"},
+	{DottedName: "xn--90a1af.xn--p1acf", Text: "спб.рус"},
+	{DottedName: "xn--90amc.xn--p1acf", Text: "биз.рус"},
+	{DottedName: "xn--c1avg.xn--p1acf", Text: "орг.рус"},
+	{DottedName: "xn--gnstigbestellen-zvb.de", Text: "günstigbestellen.de"},
+	{DottedName: "xn--gnstigliefern-wob.de", Text: "günstigliefern.de"},
+	{DottedName: "xn--h1ahn.xn--p1acf", Text: "мир.рус"},
+	{DottedName: "xn--h1aliz.xn--p1acf", Text: "сочи.рус"},
+	{DottedName: "xn--hkkinen-5wa.fi", Text: "häkkinen.fi"},
+	{DottedName: "xn--j1adp.xn--p1acf", Text: "мск.рус"},
+	{DottedName: "xn--j1aef.xn--p1acf", Text: "ком.рус"},
+	{DottedName: "xn--j1ael8b.xn--p1acf", Text: "крым.рус"},
+	{DottedName: "xnbay.com"},
+	{DottedName: "xs4all.space"},
+	{DottedName: "xx.gl"},
+	{DottedName: "xy.ax"},
+	{DottedName: "yali.mythic-beasts.com"},
+	{DottedName: "yandexcloud.net"},
+	{DottedName: "ybo.faith"},
+	{DottedName: "ybo.party"},
+	{DottedName: "ybo.review"},
+	{DottedName: "ybo.science"},
+	{DottedName: "ybo.trade"},
+	{DottedName: "ynh.fr"},
+	{DottedName: "yolasite.com"},
+	{DottedName: "yombo.me"},
+	{DottedName: "za.bz"},
+	{DottedName: "za.com"},
+	{DottedName: "za.net"},
+	{DottedName: "za.org"},
+	{DottedName: "zakopane.pl"},
+	{DottedName: "zapto.org"},
+	{DottedName: "zapto.xyz"},
+}
@@ -0,0 +1,87 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+)
+
+// ListHandler returns an http.Handler distributing l, or the default list if
+// l is nil, to other processes: the snapshot written by Write, to be loaded
+// with Read, or the list in the format of the public_suffix_list.dat file
+// written by WriteDAT if the "format" query parameter is "dat".
+//
+// The ETag of the response identifies the release of the list and the format,
+// so clients can poll it with If-None-Match and only transfer new releases.
+// The release is also set in the X-Publicsuffix-Release header.
+func ListHandler(l *List) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var list = l
+		if list == nil {
+			list = defaultList()
+		}
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		// the list is written from a single load so it matches the ETag
+		var snapshot = &List{}
+		snapshot.rules.Store(list.load())
+
+		var format = r.URL.Query().Get("format")
+		var etag = strconv.Quote(snapshot.Release() + "/" + format)
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("X-Publicsuffix-Release", snapshot.Release())
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		var body bytes.Buffer
+		var err error
+		switch format {
+		case "", "snapshot":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			err = snapshot.Write(&body)
+		case "dat":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			err = snapshot.WriteDAT(&body)
+		default:
+			http.Error(w, "unknown format "+strconv.Quote(format), http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(body.Len()))
+		if r.Method == http.MethodGet {
+			body.WriteTo(w)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_ListHandler(t *testing.T) {
+	var list, err = ParseList(bytes.NewBufferString("jp\nkobe.jp\n"), "handler_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var server = httptest.NewServer(ListHandler(list))
+	defer server.Close()
+
+	var res *http.Response
+	res, err = http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if release := res.Header.Get("X-Publicsuffix-Release"); release != "handler_test" {
+		t.Fatalf("got: %s, want: %s", release, "handler_test")
+	}
+
+	var loaded = NewList()
+	if err := loaded.Read(res.Body); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if suffix, _ := loaded.PublicSuffix("example.kobe.jp"); suffix != "kobe.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "kobe.jp")
+	}
+
+	// the list isn't transferred again until its release changes
+	var req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("If-None-Match", res.Header.Get("ETag"))
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusNotModified {
+		t.Fatalf("got: %d, want: %d", res.StatusCode, http.StatusNotModified)
+	}
+
+	var recorder = httptest.NewRecorder()
+	ListHandler(list).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/?format=dat", nil))
+	if body := recorder.Body.String(); !strings.Contains(body, "\nkobe.jp\n") {
+		t.Fatalf("unexpected list: %q", body)
+	}
+
+	recorder = httptest.NewRecorder()
+	ListHandler(list).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/?format=xml", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusBadRequest)
+	}
+}
@@ -1,3 +1,6 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
 /*
 Copyright 2018 GMO GlobalSign Ltd
 
@@ -18,22 +21,124 @@ package publicsuffix
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
-// ListRetriever is the interface for retrieving release information/content
-type ListRetriever interface {
-	GetLatestReleaseTag() (string, error)
-	GetList(release string) (io.Reader, error)
+// defaultListRetriever is used by Update, the latest release is cached for a
+// minute so frequent updates don't each request it.
+var defaultListRetriever = NewGitHubListRetriever(http.DefaultClient, WithReleaseCacheTTL(time.Minute))
+
+// Update fetches the latest public suffix list from the official github
+// repository and uses it for future lookups.
+//
+//	https://github.com/publicsuffix/list
+//
+// The latest release is cached for a minute, so calling Update frequently
+// doesn't issue a request each time, see WithReleaseCacheTTL. See
+// UpdateWithListRetriever for the supported options.
+func Update(opts ...Option) error {
+	return defaultList().Update(opts...)
+}
+
+// Update fetches the latest public suffix list from the official github
+// repository into l.
+func (l *List) Update(opts ...Option) error {
+	return l.UpdateWithListRetriever(defaultListRetriever, opts...)
 }
 
 // gitHubListRetriever implements the ListRetriever using github
 type gitHubListRetriever struct {
-	client *http.Client
+	client    *http.Client
+	doer      Doer
+	commitURL string
+	listURL   string
+	pollURL   string
+	poll      *pollState
+	limit     *rateLimitState
+	cache     *releaseCache
+	userAgent string
+}
+
+// pollState pairs the validator of the last poll response with the release
+// retrieved after it was seen.
+type pollState struct {
+	mu        sync.Mutex
+	validator string
+	release   string
+	// seen is the time the validator was seen
+	seen time.Time
+}
+
+// rateLimitState records until when the rate limit of the GitHub API is
+// exhausted, so no requests are wasted before it resets.
+type rateLimitState struct {
+	mu    sync.Mutex
+	reset time.Time
+}
+
+// releaseCache holds the latest release until it expires.
+type releaseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	release string
+	// retrieved is the time the release information was requested
+	retrieved time.Time
+	expires   time.Time
+}
+
+// RetrieverOption configures a ListRetriever created by this package.
+type RetrieverOption func(*gitHubListRetriever)
+
+// WithCommitURL sets the URL used to retrieve the latest commit of the list.
+// It must return the same JSON as the GitHub commits API, which allows using a
+// GitHub Enterprise instance or an internal mirror.
+func WithCommitURL(url string) RetrieverOption {
+	return func(gh *gitHubListRetriever) {
+		gh.commitURL = url
+	}
+}
+
+// WithListURL sets the URL used to download a release of the list. url must
+// contain a single %s placeholder which is replaced by the release, for
+// example:
+//
+//	https://mirror.example.com/publicsuffix/list/%s/public_suffix_list.dat
+//
+// Other % characters, such as the escapes of the path, are kept as is.
+func WithListURL(url string) RetrieverOption {
+	return func(gh *gitHubListRetriever) {
+		gh.listURL = url
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request. It
+// defaults to a product token naming this package and its version followed by
+// the URL of the project, e.g.
+// "globalsign-publicsuffix/v1.0.0 (+https://github.com/globalsign/publicsuffix)".
+// GitHub rejects API requests without a User-Agent.
+func WithUserAgent(userAgent string) RetrieverOption {
+	return func(gh *gitHubListRetriever) {
+		gh.userAgent = userAgent
+	}
+}
+
+// WithReleaseCacheTTL caches the latest release returned by
+// GetLatestReleaseTag for ttl, so frequent updates don't each issue a request
+// for the release information. Errors aren't cached.
+func WithReleaseCacheTTL(ttl time.Duration) RetrieverOption {
+	return func(gh *gitHubListRetriever) {
+		gh.cache = &releaseCache{ttl: ttl}
+	}
 }
 
 // releaseInfo decodes the sha field from the commit information
@@ -44,13 +149,81 @@ type releaseInfo struct {
 var (
 	gitCommitURL    = "https://api.github.com/repos/publicsuffix/list/commits?path=public_suffix_list.dat"
 	publicSuffixURL = "https://raw.githubusercontent.com/publicsuffix/list/%s/public_suffix_list.dat"
+
+	// defaultUserAgent is the User-Agent of the retrievers unless configured
+	defaultUserAgent = "globalsign-publicsuffix/" + moduleVersion() + " (+https://" + modulePath + ")"
 )
 
+// modulePath is the path of the module providing this package.
+const modulePath = "github.com/globalsign/publicsuffix"
+
+// moduleVersion returns the version of this module recorded in the build
+// information of the binary, or "devel" if it isn't known.
+func moduleVersion() string {
+	var info, ok = debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+
+	var module = &info.Main
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			module = dep
+		}
+	}
+
+	if module.Path != modulePath || module.Version == "" || module.Version == "(devel)" {
+		return "devel"
+	}
+
+	return module.Version
+}
+
+// WithHeadPolling enables cheap polling for changes: before retrieving the
+// release information, a HEAD request is issued to url and the update is
+// skipped if its ETag or Last-Modified header are unchanged since the current
+// release was retrieved. If url is empty the list URL of the "HEAD" release,
+// the default branch on GitHub, is used.
+func WithHeadPolling(url string) RetrieverOption {
+	return func(gh *gitHubListRetriever) {
+		gh.pollURL = url
+		gh.poll = &pollState{}
+	}
+}
+
+// Doer sends HTTP requests, it is implemented by *http.Client. It allows the
+// retrievers to use instrumented, authenticated or mocked transports.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 // NewGitHubListRetriever creates a new ListRetriever with a custom HTTP client.
-func NewGitHubListRetriever(client *http.Client) ListRetriever {
-	return gitHubListRetriever{
-		client: client,
+//
+// By default the list is retrieved from the official GitHub repository, opts
+// can be used to retrieve it from a mirror instead.
+func NewGitHubListRetriever(client *http.Client, opts ...RetrieverOption) ListRetriever {
+	var gh = gitHubListRetriever{
+		client:    client,
+		commitURL: gitCommitURL,
+		listURL:   publicSuffixURL,
+		limit:     &rateLimitState{},
+		userAgent: defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(&gh)
 	}
+
+	return gh
+}
+
+// NewGitHubListRetrieverWithDoer creates a new ListRetriever sending its
+// requests with doer, see NewGitHubListRetriever.
+func NewGitHubListRetrieverWithDoer(doer Doer, opts ...RetrieverOption) ListRetriever {
+	var gh = NewGitHubListRetriever(nil, opts...).(gitHubListRetriever)
+	gh.doer = doer
+
+	return gh
 }
 
 func (gh gitHubListRetriever) Client() *http.Client {
@@ -62,47 +235,276 @@ func (gh gitHubListRetriever) Client() *http.Client {
 	return client
 }
 
+// get issues a GET request for url. The response is requested gzip compressed
+// to reduce bandwidth and is decompressed transparently, regardless of the
+// configuration of the client's transport.
+func (gh gitHubListRetriever) get(url string) (*http.Response, error) {
+	return gh.do(http.MethodGet, url)
+}
+
+// do issues a request for url, see get.
+func (gh gitHubListRetriever) do(method, url string) (*http.Response, error) {
+	if err := gh.limit.check(url); err != nil {
+		return nil, err
+	}
+
+	var req, err = http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+	if gh.userAgent != "" {
+		req.Header.Set("User-Agent", gh.userAgent)
+	}
+
+	var res *http.Response
+	if gh.doer != nil {
+		res, err = gh.doer.Do(req)
+	} else {
+		res, err = gh.Client().Do(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = gh.limit.update(res, url); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	if res.Header.Get("Content-Encoding") != "gzip" {
+		return res, nil
+	}
+
+	var gzipReader *gzip.Reader
+	gzipReader, err = gzip.NewReader(res.Body)
+	if err != nil {
+		res.Body.Close()
+		return nil, networkError(fmt.Errorf("gzip error: %w", err))
+	}
+
+	res.Body = gzipBody{Reader: gzipReader, body: res.Body}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+
+	return res, nil
+}
+
+// check returns a *RateLimitError if the rate limit is known to be exhausted.
+func (rl *rateLimitState) check(url string) error {
+	if rl == nil {
+		return nil
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if time.Now().Before(rl.reset) {
+		return &RateLimitError{URL: url, Reset: rl.reset}
+	}
+
+	return nil
+}
+
+// update returns a *RateLimitError if res was rejected because the rate limit
+// is exhausted, as reported by the X-RateLimit-Remaining and X-RateLimit-Reset
+// headers, and records when it resets.
+func (rl *rateLimitState) update(res *http.Response, url string) error {
+	if rl == nil {
+		return nil
+	}
+
+	if res.StatusCode != http.StatusForbidden && res.StatusCode != http.StatusTooManyRequests {
+		return nil
+	}
+
+	if res.Header.Get("X-RateLimit-Remaining") != "0" {
+		return nil
+	}
+
+	var err = &RateLimitError{URL: url}
+	if reset, parseErr := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); parseErr == nil {
+		err.Reset = time.Unix(reset, 0)
+
+		rl.mu.Lock()
+		rl.reset = err.Reset
+		rl.mu.Unlock()
+	}
+
+	return err
+}
+
+// newStatusError returns a *StatusError for the unexpected status of res, a
+// response to a method request for url.
+func newStatusError(res *http.Response, method, url string) *StatusError {
+	var err = &StatusError{Method: method, URL: url, StatusCode: res.StatusCode}
+
+	var retryAfter = res.Header.Get("Retry-After")
+	if seconds, parseErr := strconv.Atoi(retryAfter); parseErr == nil && seconds > 0 {
+		err.RetryAfter = time.Duration(seconds) * time.Second
+	} else if date, parseErr := http.ParseTime(retryAfter); parseErr == nil {
+		err.RetryAfter = time.Until(date)
+	}
+
+	if err.RetryAfter < 0 {
+		err.RetryAfter = 0
+	}
+
+	return err
+}
+
+// gzipBody decompresses a response body, closing both on Close.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+// Close closes the gzip reader and the underlying body.
+func (g gzipBody) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
 // GetLatestReleaseTag retrieves the tag for the latest commit on Public Suffix List repo
 func (gh gitHubListRetriever) GetLatestReleaseTag() (string, error) {
-	var res, err = gh.Client().Get(gitCommitURL)
+	if gh.cache == nil {
+		return gh.getLatestReleaseTag()
+	}
+
+	gh.cache.mu.Lock()
+	defer gh.cache.mu.Unlock()
+
+	if gh.cache.release != "" && time.Now().Before(gh.cache.expires) {
+		gh.pollRelease(gh.cache.release, gh.cache.retrieved)
+		return gh.cache.release, nil
+	}
+
+	var retrieved = time.Now()
+	var release, err = gh.getLatestReleaseTag()
 	if err != nil {
-		return "", fmt.Errorf("error while retrieving last release information from github: %s", err.Error())
+		return "", err
+	}
+
+	gh.cache.release = release
+	gh.cache.retrieved = retrieved
+	gh.cache.expires = time.Now().Add(gh.cache.ttl)
+
+	return release, nil
+}
+
+// pollRelease pairs release, whose information was requested at retrieved,
+// with the validator of the last poll response, unless the validator was seen
+// afterwards: the release may then predate it.
+func (gh gitHubListRetriever) pollRelease(release string, retrieved time.Time) {
+	if gh.poll == nil {
+		return
+	}
+
+	gh.poll.mu.Lock()
+	defer gh.poll.mu.Unlock()
+
+	if !retrieved.Before(gh.poll.seen) {
+		gh.poll.release = release
+	}
+}
+
+// getLatestReleaseTag requests the tag for the latest commit, see
+// GetLatestReleaseTag.
+func (gh gitHubListRetriever) getLatestReleaseTag() (string, error) {
+	var retrieved = time.Now()
+	var res, err = gh.get(gh.commitURL)
+	if err != nil {
+		return "", networkError(fmt.Errorf("error while retrieving last release information from github: %w", err))
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("error GET %s: status %d", gitCommitURL, res.StatusCode)
+		return "", newStatusError(res, http.MethodGet, gh.commitURL)
 	}
 
 	var releaseInfo []releaseInfo
 	if err = json.NewDecoder(res.Body).Decode(&releaseInfo); err != nil {
-		return "", fmt.Errorf("error decoding release info: %s", err.Error())
+		return "", dataError(fmt.Errorf("error decoding release info: %w", err))
 	}
 
 	if len(releaseInfo) == 0 || releaseInfo[0].SHA == "" {
-		return "", errors.New("no release info found from github")
+		return "", dataError(errors.New("no release info found from github"))
 	}
 
+	gh.pollRelease(releaseInfo[0].SHA, retrieved)
+
 	return releaseInfo[0].SHA, nil
 }
 
+// Changed issues a HEAD request to the poll URL and reports whether its
+// validator changed since release was retrieved. It always returns true when
+// polling isn't enabled.
+func (gh gitHubListRetriever) Changed(release string) (bool, error) {
+	if gh.poll == nil {
+		return true, nil
+	}
+
+	var url = gh.pollURL
+	if url == "" {
+		url = gh.URL("HEAD")
+	}
+
+	var res, err = gh.do(http.MethodHead, url)
+	if err != nil {
+		return true, networkError(fmt.Errorf("error while polling the PSL: %w", err))
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return true, newStatusError(res, http.MethodHead, url)
+	}
+
+	var validator = res.Header.Get("ETag")
+	if validator == "" {
+		validator = res.Header.Get("Last-Modified")
+	}
+
+	gh.poll.mu.Lock()
+	defer gh.poll.mu.Unlock()
+
+	if validator != "" && validator == gh.poll.validator && release == gh.poll.release {
+		return false, nil
+	}
+
+	// A new release is only paired with this validator once it is retrieved.
+	if validator != gh.poll.validator {
+		gh.poll.validator = validator
+		gh.poll.seen = time.Now()
+	}
+	gh.poll.release = ""
+
+	return true, nil
+}
+
+// URL returns the URL the given release of the list is retrieved from.
+func (gh gitHubListRetriever) URL(release string) string {
+	return strings.Replace(gh.listURL, "%s", release, 1)
+}
+
 // GetList retrieves the given release of the Public Suffix List from the github repository
 func (gh gitHubListRetriever) GetList(release string) (io.Reader, error) {
-	var url = fmt.Sprintf(publicSuffixURL, release)
+	var url = gh.URL(release)
 
-	var res, err = gh.Client().Get(url)
+	var res, err = gh.get(url)
 	if err != nil {
-		return nil, fmt.Errorf("error while retrieving last revision of the PSL(%s): %s", release, err.Error())
+		return nil, networkError(fmt.Errorf("error while retrieving last revision of the PSL(%s): %w", release, err))
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error GET %s: status %d", url, res.StatusCode)
+		return nil, newStatusError(res, http.MethodGet, url)
 	}
 
 	var buf = &bytes.Buffer{}
 	if _, err := io.Copy(buf, res.Body); err != nil {
-		return nil, err
+		return nil, networkError(err)
 	}
 
 	return buf, nil
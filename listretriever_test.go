@@ -0,0 +1,443 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// newMirror starts a server serving a single release of the list in the same
+// way as the GitHub API and raw content server.
+func newMirror(t *testing.T, release, list string) *httptest.Server {
+	var mux = http.NewServeMux()
+	mux.HandleFunc("/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"sha":"` + release + `"}]`))
+	})
+	mux.HandleFunc("/"+release+"/public_suffix_list.dat", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(list))
+	})
+
+	var server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_GitHubListRetriever_Mirror(t *testing.T) {
+	var server = newMirror(t, "mirror_test", "ac\ncom.ac\n")
+
+	var listRetriever = NewGitHubListRetriever(server.Client(),
+		WithCommitURL(server.URL+"/commits"),
+		WithListURL(server.URL+"/%s/public_suffix_list.dat"),
+	)
+
+	var release, err = listRetriever.GetLatestReleaseTag()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if release != "mirror_test" {
+		t.Fatalf("got: %s, want: %s", release, "mirror_test")
+	}
+
+	list, err := listRetriever.GetList(release)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var content, _ = ioutil.ReadAll(list)
+	if string(content) != "ac\ncom.ac\n" {
+		t.Fatalf("got: %q, want: %q", content, "ac\ncom.ac\n")
+	}
+
+	if _, err := listRetriever.GetList("unknown"); err == nil {
+		t.Fatalf("expected an error for an unknown release")
+	}
+}
+
+func Test_GitHubListRetriever_Gzip(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("got Accept-Encoding: %q, want: %q", r.Header.Get("Accept-Encoding"), "gzip")
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		var gzipWriter = gzip.NewWriter(w)
+		gzipWriter.Write([]byte("ac\ncom.ac\n"))
+		gzipWriter.Close()
+	}))
+	defer server.Close()
+
+	var listRetriever = NewGitHubListRetriever(server.Client(), WithListURL(server.URL+"/%s"))
+
+	var list, err = listRetriever.GetList("gzip_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var content, _ = ioutil.ReadAll(list)
+	if string(content) != "ac\ncom.ac\n" {
+		t.Fatalf("got: %q, want: %q", content, "ac\ncom.ac\n")
+	}
+}
+
+func Test_GitHubListRetriever_HeadPolling(t *testing.T) {
+	preserveRules(t)
+
+	var etag = `"1"`
+	var release = "poll_test_1"
+	var commitRequests int
+
+	var mux = http.NewServeMux()
+	mux.HandleFunc("/commits", func(w http.ResponseWriter, r *http.Request) {
+		commitRequests++
+		w.Write([]byte(`[{"sha":"` + release + `"}]`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		if r.Method == http.MethodHead {
+			return
+		}
+		w.Write([]byte("ac\ncom.ac\n"))
+	})
+
+	var server = httptest.NewServer(mux)
+	defer server.Close()
+
+	var listRetriever = NewGitHubListRetriever(server.Client(),
+		WithCommitURL(server.URL+"/commits"),
+		WithListURL(server.URL+"/%s/public_suffix_list.dat"),
+		WithHeadPolling(""),
+	)
+
+	var tests = []struct {
+		name            string
+		etag, release   string
+		expectedCommits int
+	}{
+		{"First update", `"1"`, "poll_test_1", 1},
+		{"Unchanged", `"1"`, "poll_test_1", 1},
+		{"Changed", `"2"`, "poll_test_2", 2},
+		{"Unchanged after change", `"2"`, "poll_test_2", 2},
+	}
+
+	for _, tt := range tests {
+		etag, release = tt.etag, tt.release
+
+		if err := UpdateWithListRetriever(listRetriever); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.name, err.Error())
+		}
+		if commitRequests != tt.expectedCommits {
+			t.Fatalf("%s: got %d commit requests, want: %d", tt.name, commitRequests, tt.expectedCommits)
+		}
+		if Release() != tt.release {
+			t.Fatalf("%s: got release: %s, want: %s", tt.name, Release(), tt.release)
+		}
+	}
+}
+
+func Test_GitHubListRetriever_HeadPollingReleaseCache(t *testing.T) {
+	var listRequests int
+	var mux = http.NewServeMux()
+	mux.HandleFunc("/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"sha":"poll_cache_test"}]`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"1"`)
+		if r.Method == http.MethodHead {
+			return
+		}
+		listRequests++
+		w.Write([]byte("ac\ncom.ac\n"))
+	})
+
+	var server = httptest.NewServer(mux)
+	defer server.Close()
+
+	var listRetriever = NewGitHubListRetriever(server.Client(),
+		WithCommitURL(server.URL+"/commits"),
+		WithListURL(server.URL+"/%s/public_suffix_list.dat"),
+		WithHeadPolling(""),
+		WithReleaseCacheTTL(time.Hour),
+	)
+
+	// the second list gets the release from the cache, which must still be
+	// paired with the validator
+	var first, second = NewList(), NewList()
+	for _, list := range []*List{first, second, first, second} {
+		if err := list.UpdateWithListRetriever(listRetriever); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	if listRequests != 2 {
+		t.Fatalf("got %d list requests, want: %d", listRequests, 2)
+	}
+
+	if changed, err := listRetriever.(ReleasePoller).Changed("poll_cache_test"); err != nil || changed {
+		t.Fatalf("got: %v %v, want: %v %v", changed, err, false, nil)
+	}
+}
+
+func Test_GitHubListRetriever_UserAgent(t *testing.T) {
+	var userAgents = make(chan string, 1)
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents <- r.UserAgent()
+		w.Write([]byte(`[{"sha":"user_agent_test"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	var tests = []struct {
+		name string
+		opts []RetrieverOption
+		want string
+	}{
+		{"Default", nil, defaultUserAgent},
+		{"Custom", []RetrieverOption{WithUserAgent("mirror-sync/1.0")}, "mirror-sync/1.0"},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			var opts = append([]RetrieverOption{WithCommitURL(server.URL)}, tt.opts...)
+			if _, err := NewGitHubListRetriever(server.Client(), opts...).GetLatestReleaseTag(); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got := <-userAgents; got != tt.want {
+				t.Fatalf("got: %s, want: %s", got, tt.want)
+			}
+		})
+	}
+
+	if !strings.HasPrefix(defaultUserAgent, "globalsign-publicsuffix/") || !strings.HasSuffix(defaultUserAgent, " (+https://github.com/globalsign/publicsuffix)") {
+		t.Fatalf("unexpected default User-Agent: %s", defaultUserAgent)
+	}
+}
+
+func Test_GitHubListRetriever_RateLimit(t *testing.T) {
+	var reset = time.Now().Add(time.Hour).Truncate(time.Second)
+
+	var requests int
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	var listRetriever = NewGitHubListRetriever(server.Client(), WithCommitURL(server.URL))
+
+	for i := 0; i < 2; i++ {
+		var _, err = listRetriever.GetLatestReleaseTag()
+
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) {
+			t.Fatalf("got: %v, want: %T", err, rateLimitErr)
+		}
+
+		if !rateLimitErr.Reset.Equal(reset) {
+			t.Fatalf("got: %s, want: %s", rateLimitErr.Reset, reset)
+		}
+
+		if !errors.Is(err, ErrNetwork) {
+			t.Fatalf("got: %v, want: %v", err, ErrNetwork)
+		}
+	}
+
+	// no requests are made until the rate limit resets
+	if requests != 1 {
+		t.Fatalf("got: %d requests, want: %d", requests, 1)
+	}
+}
+
+func Test_GitHubListRetriever_ReleaseCache(t *testing.T) {
+	var requests int
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[{"sha":"cache_test_` + strconv.Itoa(requests) + `"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	var listRetriever = NewGitHubListRetriever(server.Client(), WithCommitURL(server.URL), WithReleaseCacheTTL(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		var release, err = listRetriever.GetLatestReleaseTag()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if release != "cache_test_1" {
+			t.Fatalf("got: %s, want: %s", release, "cache_test_1")
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("got: %d requests, want: %d", requests, 1)
+	}
+
+	// the release is requested again once expired
+	listRetriever.(gitHubListRetriever).cache.expires = time.Now()
+
+	if release, _ := listRetriever.GetLatestReleaseTag(); release != "cache_test_2" {
+		t.Fatalf("got: %s, want: %s", release, "cache_test_2")
+	}
+}
+
+// doerFunc implements Doer with a function.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_GitHubListRetriever_Doer(t *testing.T) {
+	var doer = doerFunc(func(req *http.Request) (*http.Response, error) {
+		var body = "ac\ncom.ac\n"
+		var status = http.StatusOK
+		switch req.URL.Path {
+		case "/commits":
+			body = `[{"sha":"doer_test"}]`
+		case "/missing/public_suffix_list.dat":
+			status = http.StatusNotFound
+		}
+
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+
+	var listRetriever = NewGitHubListRetrieverWithDoer(doer,
+		WithCommitURL("https://mirror.invalid/commits"),
+		WithListURL("https://mirror.invalid/%s/public_suffix_list.dat"),
+	)
+
+	var list = NewList()
+	if err := list.UpdateWithListRetriever(listRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if list.Release() != "doer_test" {
+		t.Fatalf("got: %s, want: %s", list.Release(), "doer_test")
+	}
+
+	var _, err = listRetriever.GetList("missing")
+	if !errors.Is(err, ErrPermanent) {
+		t.Fatalf("got: %v, want: %v", err, ErrPermanent)
+	}
+}
+
+func Test_FetchDoer(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// net/http adds its own User-Agent outside of js/wasm
+		if r.Header.Get("Accept-Encoding") != "" || r.Header.Get("User-Agent") == "fetch_test" {
+			t.Errorf("got: %v, want: no header set by browsers", r.Header)
+		}
+
+		// a browser reports the encoding of the body it decompressed
+		w.Header().Set("Content-Encoding", "gzip")
+		if r.URL.Path == "/commits" {
+			fmt.Fprint(w, `[{"sha":"fetch_test"}]`)
+			return
+		}
+		fmt.Fprint(w, "ac\ncom.ac\n")
+	}))
+	defer server.Close()
+
+	// the transport of the test server doesn't add the headers itself
+	var client = &http.Client{Transport: &http.Transport{DisableCompression: true}}
+	var listRetriever = NewGitHubListRetrieverWithDoer(fetchDoer{client: client},
+		WithCommitURL(server.URL+"/commits"),
+		WithListURL(server.URL+"/%s/public_suffix_list.dat"),
+		WithUserAgent("fetch_test"),
+	)
+
+	var list = NewList()
+	if err := list.UpdateWithListRetriever(listRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := list.Suffixes(); !reflect.DeepEqual(got, []string{"ac", "com.ac"}) {
+		t.Fatalf("got: %v, want: %v", got, []string{"ac", "com.ac"})
+	}
+}
+
+func Test_ErrorCategories(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var unreachable = httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	var tests = []struct {
+		name     string
+		err      error
+		category error
+	}{
+		{
+			"Status error",
+			UpdateWithListRetriever(NewGitHubListRetriever(server.Client(), WithCommitURL(server.URL))),
+			ErrNetwork,
+		},
+		{
+			"Connection error",
+			UpdateWithListRetriever(NewGitHubListRetriever(unreachable.Client(), WithCommitURL(unreachable.URL))),
+			ErrNetwork,
+		},
+		{
+			"Invalid list",
+			UpdateWithListRetriever(mockListRetriever{Release: "errors_test", RawList: bytes.NewBufferString("COM")}),
+			ErrInvalidData,
+		},
+		{
+			"Invalid snapshot",
+			Read(bytes.NewBufferString("not a snapshot")),
+			ErrInvalidData,
+		},
+		{
+			"Snapshot read error",
+			Read(iotest.ErrReader(errors.New("connection reset"))),
+			ErrTemporary,
+		},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.category) {
+				t.Fatalf("got: %v, want: %v", tt.err, tt.category)
+			}
+		})
+	}
+}
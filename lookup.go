@@ -0,0 +1,325 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MatchKind is the kind of rule which determined the public suffix of a
+// domain.
+type MatchKind int
+
+const (
+	// MatchDefault means no rule matched and the implicit "*" rule applied,
+	// the public suffix is the last label of the domain.
+	MatchDefault MatchKind = iota
+	// MatchNormal means a normal rule matched, e.g. "co.uk".
+	MatchNormal
+	// MatchWildcard means a wildcard rule matched, e.g. "*.kobe.jp".
+	MatchWildcard
+	// MatchException means an exception rule matched, e.g. "!city.kobe.jp".
+	MatchException
+)
+
+// String returns "default", "normal", "wildcard" or "exception".
+func (k MatchKind) String() string {
+	switch k {
+	case MatchDefault:
+		return "default"
+	case MatchNormal, MatchWildcard, MatchException:
+		return ruleType(k - MatchNormal).String()
+	default:
+		return fmt.Sprintf("MatchKind(%d)", int(k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler using the name of k.
+func (k MatchKind) MarshalText() ([]byte, error) {
+	if k < MatchDefault || k > MatchException {
+		return nil, fmt.Errorf("publicsuffix: invalid %s", k)
+	}
+
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the names
+// returned by String.
+func (k *MatchKind) UnmarshalText(text []byte) error {
+	for kind := MatchDefault; kind <= MatchException; kind++ {
+		if kind.String() == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+
+	return fmt.Errorf("publicsuffix: unknown match kind %q", text)
+}
+
+// Result is the outcome of Lookup.
+//
+// Its JSON encoding is an object with the fields "public_suffix",
+// "registered_domain", "icann", "kind" and "special_use", the last two holding
+// the names returned by MatchKind.String and SpecialUse.String, for example:
+//
+//	{"public_suffix":"co.uk","registered_domain":"example.co.uk","icann":true,"kind":"normal","special_use":"none"}
+//
+// Fields are never removed or renamed, but new fields may be added.
+type Result struct {
+	// PublicSuffix is the public suffix of the domain, see PublicSuffix.
+	PublicSuffix string `json:"public_suffix"`
+	// RegisteredDomain is the public suffix plus one more label, see
+	// EffectiveTLDPlusOne. It is empty if it can't be derived, for example
+	// because the domain is itself a public suffix.
+	RegisteredDomain string `json:"registered_domain"`
+	// ICANN is true when the public suffix is managed by ICANN.
+	ICANN bool `json:"icann"`
+	// Kind is the kind of rule which matched.
+	Kind MatchKind `json:"kind"`
+	// SpecialUse is set if the domain is a special-use name, such as
+	// "localhost" or a domain under "test", which will never be registrable
+	// publicly even though a suffix is derived for it.
+	SpecialUse SpecialUse `json:"special_use"`
+}
+
+// Lookup returns the public suffix and the registered domain (eTLD+1) of
+// domain, along with how they were determined, from a single search of the
+// list. It is cheaper than calling both PublicSuffix and EffectiveTLDPlusOne.
+//
+// A *DomainError is returned for domains exceeding the lengths allowed by
+// RFC 1035, or the limits set by WithLimits.
+func Lookup(domain string) (Result, error) {
+	return defaultList().Lookup(domain)
+}
+
+// Lookup returns the public suffix and the registered domain of domain using l,
+// see the package level Lookup.
+func (l *List) Lookup(domain string) (Result, error) {
+	return l.lookup(domain, false)
+}
+
+// lookup implements Lookup, ignoring the rules of the private section if icann
+// is set.
+func (l *List) lookup(domain string, icann bool) (Result, error) {
+	if err := checkDomain(domain); err != nil {
+		return Result{}, err
+	}
+
+	var ri = l.load()
+	if ri.tooSmall {
+		return Result{}, ErrListTooSmall
+	}
+
+	if err := ri.limits.check(domain); err != nil {
+		return Result{}, err
+	}
+
+	var m match
+	if icann {
+		m = ri.icannEngine().lookup(domain)
+	} else {
+		m = ri.lookup(domain)
+	}
+	l.canary.Load().check(domain, m.suffix)
+	l.stats.Load().record(m)
+	l.ageWarning.Load().check(ri)
+
+	var result = Result{PublicSuffix: m.suffix, ICANN: m.icann, SpecialUse: SpecialUseOf(domain)}
+	if m.found {
+		result.Kind = MatchNormal + MatchKind(m.kind)
+	}
+
+	result.RegisteredDomain, _ = registeredDomain(domain, result.PublicSuffix)
+
+	return result, nil
+}
+
+// EffectiveTLDPlusOneListed returns the effective top level domain plus one
+// more label of domain like EffectiveTLDPlusOne, and reports whether its
+// public suffix was determined by a rule of the list. It is false when the
+// implicit "*" rule applied, e.g. for the unknown TLD of "www.example.zzz",
+// making the result a guess rather than a registered domain.
+func EffectiveTLDPlusOneListed(domain string) (string, bool, error) {
+	return defaultList().EffectiveTLDPlusOneListed(domain)
+}
+
+// EffectiveTLDPlusOneListed returns the effective top level domain plus one
+// more label using l, see the package level EffectiveTLDPlusOneListed.
+func (l *List) EffectiveTLDPlusOneListed(domain string) (string, bool, error) {
+	var result, err = l.Lookup(domain)
+	if err != nil {
+		return "", false, err
+	}
+
+	var etldPlusOne string
+	etldPlusOne, err = registeredDomain(domain, result.PublicSuffix)
+	if err != nil {
+		return "", false, err
+	}
+
+	return etldPlusOne, result.Kind != MatchDefault, nil
+}
+
+// IsRegistrable reports whether domain is exactly one label below its public
+// suffix, i.e. a registrable domain such as "example.co.uk", but neither
+// "www.example.co.uk" nor "co.uk".
+func IsRegistrable(domain string) bool {
+	return defaultList().IsRegistrable(domain)
+}
+
+// IsRegistrable reports whether domain is a registrable domain of l, see the
+// package level IsRegistrable.
+func (l *List) IsRegistrable(domain string) bool {
+	var result, err = l.Lookup(domain)
+
+	return err == nil && result.RegisteredDomain != "" && result.RegisteredDomain == domain
+}
+
+// IsExactPublicSuffix reports whether domain, as given, is itself a public
+// suffix under the full matching algorithm. Unlike looking for a rule named
+// domain, names covered by wildcard rules such as "anything.bd" are public
+// suffixes, while names excluded by exception rules such as "www.ck" aren't.
+// Like for PublicSuffix, TLDs missing from the list are public suffixes.
+func IsExactPublicSuffix(domain string) bool {
+	return defaultList().IsExactPublicSuffix(domain)
+}
+
+// IsExactPublicSuffix reports whether domain is a public suffix of l, see the
+// package level IsExactPublicSuffix.
+func (l *List) IsExactPublicSuffix(domain string) bool {
+	var result, err = l.Lookup(domain)
+
+	return err == nil && domain != "" && result.PublicSuffix == domain
+}
+
+// RegistrationLevel returns the number of labels of the domains registered
+// directly under suffix, a public suffix of the currently loaded list. It is
+// one more than the labels of suffix, e.g. 3 for "co.uk", unless a wildcard
+// rule applies below suffix, e.g. 3 for "ck" because of the rule "*.ck":
+// registrations then occur under any label added to suffix, or any labels for
+// rules with several wildcards, e.g. 4 for "example" with "*.*.example".
+// Exception rules may allow a few names at a lower level, such as "www.ck".
+//
+// A *DomainError matching ErrNotPublicSuffix is returned if suffix isn't a
+// public suffix, e.g. "example.com" or "". Like for PublicSuffix, TLDs missing
+// from the list are public suffixes.
+func RegistrationLevel(suffix string) (int, error) {
+	return defaultList().RegistrationLevel(suffix)
+}
+
+// RegistrationLevel returns the number of labels of the domains registered
+// directly under suffix using l, see the package level RegistrationLevel.
+func (l *List) RegistrationLevel(suffix string) (int, error) {
+	if err := checkDomain(suffix); err != nil {
+		return 0, err
+	}
+
+	if suffix == "" {
+		return 0, &DomainError{Domain: suffix, Err: ErrNotPublicSuffix}
+	}
+
+	var ri = l.load()
+	if ri.tooSmall {
+		return 0, ErrListTooSmall
+	}
+
+	if err := ri.limits.check(suffix); err != nil {
+		return 0, err
+	}
+
+	// A wildcard rule below suffix makes suffix a public suffix even if no
+	// rule matches it, e.g. "kawasaki.jp" with the rule "*.kawasaki.jp". The
+	// rules with more wildcards, e.g. "*.*.kawasaki.jp", are keyed by the
+	// wildcards after the first one followed by suffix without dots.
+	var labels = strings.Count(suffix, ".") + 1
+	var maxWildcards = (maxDomainLength+1)/2 - labels
+	var keys = []byte(strings.Repeat("*", maxWildcards) + strings.Replace(suffix, ".", "", -1))
+	var level int
+	for wildcards := 1; wildcards <= maxWildcards; wildcards++ {
+		for _, rule := range ri.Map[string(keys[maxWildcards-wildcards+1:])] {
+			if rule.RuleType == wildcard && hasWildcards(rule.DottedName, wildcards, suffix) {
+				level = labels + wildcards + 1
+			}
+		}
+	}
+	if level > 0 {
+		return level, nil
+	}
+
+	if m := ri.lookup(suffix); m.suffix != suffix {
+		return 0, &DomainError{Domain: suffix, Err: ErrNotPublicSuffix}
+	}
+
+	return labels + 1, nil
+}
+
+// hasWildcards reports whether name is suffix below the given number of
+// wildcard labels, e.g. "*.*.kawasaki.jp" for 2 and "kawasaki.jp".
+func hasWildcards(name string, wildcards int, suffix string) bool {
+	if len(name) != 2*wildcards+len(suffix) || !strings.HasSuffix(name, suffix) {
+		return false
+	}
+
+	for i := 0; i < 2*wildcards; i += 2 {
+		if name[i:i+2] != "*." {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SuffixChain returns domain followed by its parent names, down to and
+// including its public suffix, e.g. for "www.example.co.uk":
+//
+//	[www.example.co.uk example.co.uk co.uk]
+//
+// The last name is the public suffix, the registry cut that DNS tooling walks
+// toward but not past, and the one before it, if any, is the registered
+// domain. nil is returned if domain is empty, has an empty label such as
+// "a..b.com", exceeds the lengths allowed by RFC 1035 or can't be looked up.
+func SuffixChain(domain string) []string {
+	return defaultList().SuffixChain(domain)
+}
+
+// SuffixChain returns domain and its parent names down to its public suffix
+// using l, see the package level SuffixChain.
+func (l *List) SuffixChain(domain string) []string {
+	if hasEmptyLabel(domain) {
+		return nil
+	}
+
+	var result, err = l.Lookup(domain)
+	if err != nil || result.PublicSuffix == "" {
+		return nil
+	}
+
+	var chain = []string{domain}
+	for name := domain; name != result.PublicSuffix; {
+		var dot = strings.IndexByte(name, '.')
+		if dot < 0 {
+			// the suffix isn't made of trailing labels of domain
+			return nil
+		}
+
+		name = name[dot+1:]
+		chain = append(chain, name)
+	}
+
+	return chain
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Lookup(t *testing.T) {
+	installRulesTestList(t)
+
+	var tests = []struct {
+		domain string
+		want   Result
+	}{
+		{"www.example.jp", Result{PublicSuffix: "jp", RegisteredDomain: "example.jp", ICANN: true, Kind: MatchNormal}},
+		{"www.city.kobe.jp", Result{PublicSuffix: "kobe.jp", RegisteredDomain: "city.kobe.jp", ICANN: true, Kind: MatchException}},
+		{"a.b.compute.example.jp", Result{PublicSuffix: "b.compute.example.jp", RegisteredDomain: "a.b.compute.example.jp", Kind: MatchWildcard}},
+		{"www.example.invalid", Result{PublicSuffix: "invalid", RegisteredDomain: "example.invalid", Kind: MatchDefault, SpecialUse: SpecialUseInvalid}},
+		{"jp", Result{PublicSuffix: "jp", ICANN: true, Kind: MatchNormal}},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.domain, func(t *testing.T) {
+			var got, err = Lookup(tt.domain)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got != tt.want {
+				t.Fatalf("got: %+v, want: %+v", got, tt.want)
+			}
+
+			// Lookup must agree with the individual functions
+			if suffix, icann := PublicSuffix(tt.domain); suffix != got.PublicSuffix || icann != got.ICANN {
+				t.Fatalf("got: %s %v, want: %s %v", got.PublicSuffix, got.ICANN, suffix, icann)
+			}
+
+			if etldPlusOne, _ := EffectiveTLDPlusOne(tt.domain); etldPlusOne != got.RegisteredDomain {
+				t.Fatalf("got: %s, want: %s", got.RegisteredDomain, etldPlusOne)
+			}
+		})
+	}
+
+	t.Run("Domain too long", func(t *testing.T) {
+		var _, err = Lookup(strings.Repeat("a", 64) + ".jp")
+
+		var domainErr *DomainError
+		if !errors.As(err, &domainErr) || !errors.Is(err, ErrLabelTooLong) {
+			t.Fatalf("got: %v, want: %v", err, ErrLabelTooLong)
+		}
+	})
+}
+
+func Test_EffectiveTLDPlusOneListed(t *testing.T) {
+	installRulesTestList(t)
+
+	var tests = []struct {
+		domain string
+		want   string
+		listed bool
+		err    bool
+	}{
+		{"www.example.jp", "example.jp", true, false},
+		{"www.city.kobe.jp", "city.kobe.jp", true, false},
+		{"a.b.compute.example.jp", "a.b.compute.example.jp", true, false},
+		{"www.example.zzz", "example.zzz", false, false},
+		{"jp", "", false, true},
+	}
+
+	for _, tt := range tests {
+		var got, listed, err = EffectiveTLDPlusOneListed(tt.domain)
+		if (err != nil) != tt.err {
+			t.Fatalf("%s unexpected error: %v", tt.domain, err)
+		}
+
+		if got != tt.want || listed != tt.listed {
+			t.Fatalf("%s got: %s %v, want: %s %v", tt.domain, got, listed, tt.want, tt.listed)
+		}
+	}
+}
+
+func Test_MatchKind(t *testing.T) {
+	var tests = map[MatchKind]string{
+		MatchDefault:   "default",
+		MatchNormal:    "normal",
+		MatchWildcard:  "wildcard",
+		MatchException: "exception",
+	}
+
+	for kind, want := range tests {
+		if got := kind.String(); got != want {
+			t.Fatalf("got: %s, want: %s", got, want)
+		}
+	}
+}
+
+func Test_RegistrationLevel(t *testing.T) {
+	var tests = []struct {
+		suffix string
+		want   int
+	}{
+		{"com", 2},
+		{"co.uk", 3},
+		{"ck", 3},
+		{"foo.ck", 3},
+		{"kawasaki.jp", 4},
+		{"blogspot.com", 3},
+		{"nosuchtld", 2},
+	}
+
+	for _, tt := range tests {
+		var got, err = RegistrationLevel(tt.suffix)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.suffix, err.Error())
+		}
+
+		if got != tt.want {
+			t.Fatalf("%s: got: %d, want: %d", tt.suffix, got, tt.want)
+		}
+	}
+
+	// registrations happen below all the wildcards of patterned rules
+	var list, err = ParseList(strings.NewReader("// ===BEGIN PRIVATE DOMAINS===\n*.*.example\n*.b.example\n// ===END PRIVATE DOMAINS===\n"), "lookup_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got, err := list.RegistrationLevel("example"); err != nil || got != 4 {
+		t.Fatalf("got: %d (%v), want: %d", got, err, 4)
+	}
+	if got, err := list.RegistrationLevel("b.example"); err != nil || got != 4 {
+		t.Fatalf("got: %d (%v), want: %d", got, err, 4)
+	}
+
+	for _, suffix := range []string{"example.com", "www.ck", "a.b.co.uk", ""} {
+		var _, err = RegistrationLevel(suffix)
+
+		var domainErr *DomainError
+		if !errors.As(err, &domainErr) || !errors.Is(err, ErrNotPublicSuffix) {
+			t.Fatalf("%s: got: %v, want: %v", suffix, err, ErrNotPublicSuffix)
+		}
+	}
+}
+
+func Test_IsRegistrable(t *testing.T) {
+	var tests = []struct {
+		domain string
+		want   bool
+	}{
+		{"example.co.uk", true},
+		{"example.com", true},
+		{"foo.bar.ck", true},
+		{"www.ck", true},
+		{"www.example.co.uk", false},
+		{"co.uk", false},
+		{"bar.ck", false},
+		{"com", false},
+		{"", false},
+		{"example.com.", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRegistrable(tt.domain); got != tt.want {
+			t.Errorf("%q: got: %v, want: %v", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func Test_IsExactPublicSuffix(t *testing.T) {
+	var tests = []struct {
+		domain string
+		want   bool
+	}{
+		{"co.uk", true},
+		{"anything.bd", true},
+		{"blogspot.com", true},
+		{"nosuchtld", true},
+		{"bd", true},
+		{"www.ck", false},
+		{"example.co.uk", false},
+		{"www.anything.bd", false},
+		{"", false},
+		{"co.uk.", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsExactPublicSuffix(tt.domain); got != tt.want {
+			t.Errorf("%q: got: %v, want: %v", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func Test_SuffixChain(t *testing.T) {
+	var tests = []struct {
+		domain string
+		want   []string
+	}{
+		{"www.example.co.uk", []string{"www.example.co.uk", "example.co.uk", "co.uk"}},
+		{"example.co.uk", []string{"example.co.uk", "co.uk"}},
+		{"co.uk", []string{"co.uk"}},
+		{"a.b.anything.bd", []string{"a.b.anything.bd", "b.anything.bd", "anything.bd"}},
+		{"www.ck", []string{"www.ck", "ck"}},
+		{"www.example.nosuchtld", []string{"www.example.nosuchtld", "example.nosuchtld", "nosuchtld"}},
+		{"", nil},
+		{"a..b.com", nil},
+		{".b.com", nil},
+		{"www.example.com.", nil},
+	}
+
+	for _, tt := range tests {
+		if got := SuffixChain(tt.domain); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got: %v, want: %v", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func Test_ResultJSON(t *testing.T) {
+	installRulesTestList(t)
+
+	var result, err = Lookup("www.city.kobe.jp")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var encoded []byte
+	encoded, err = json.Marshal(result)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var want = `{"public_suffix":"kobe.jp","registered_domain":"city.kobe.jp","icann":true,"kind":"exception","special_use":"none"}`
+	if string(encoded) != want {
+		t.Fatalf("got: %s, want: %s", encoded, want)
+	}
+
+	var decoded Result
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if decoded != result {
+		t.Fatalf("got: %+v, want: %+v", decoded, result)
+	}
+
+	if err := json.Unmarshal([]byte(`{"kind":"unknown"}`), &decoded); err == nil {
+		t.Fatalf("got: %v, want: an error", err)
+	}
+
+	if _, err := json.Marshal(Result{SpecialUse: SpecialUse(42)}); err == nil {
+		t.Fatalf("got: %v, want: an error", err)
+	}
+}
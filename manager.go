@@ -0,0 +1,467 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultRefreshInterval is the refresh interval of a Manager unless set with
+// WithRefreshInterval.
+const defaultRefreshInterval = 24 * time.Hour
+
+// ErrListStale is returned by Manager.Healthy when the list wasn't refreshed
+// for longer than allowed by WithMaxAge.
+var ErrListStale = errors.New("publicsuffix: list is stale")
+
+// ManagerOption configures a Manager.
+type ManagerOption func(*Manager)
+
+// WithList sets the list managed by a Manager, the default list used by the
+// package level functions if l is nil. A Manager creates its own list by
+// default.
+func WithList(l *List) ManagerOption {
+	return func(m *Manager) {
+		if l == nil {
+			l = defaultList()
+		}
+		m.list = l
+	}
+}
+
+// WithRetrievers sets the chain of retrievers a Manager updates its list
+// with: each update tries them in order until one succeeds. It defaults to
+// the GitHub retriever used by Update.
+func WithRetrievers(listRetrievers ...ListRetriever) ManagerOption {
+	return func(m *Manager) {
+		m.retrievers = listRetrievers
+	}
+}
+
+// WithRefreshInterval sets the interval between the updates of a Manager, a
+// day by default.
+func WithRefreshInterval(interval time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.interval = interval
+	}
+}
+
+// WithCachePath makes a Manager persist its list to the file at path with
+// WriteFile after each update changing the release, and load it from there
+// when started, so that a restart doesn't depend on the retrievers.
+func WithCachePath(path string) ManagerOption {
+	return func(m *Manager) {
+		m.cachePath = path
+	}
+}
+
+// WithMaxAge sets how long the list of a Manager may go without a successful
+// update or load before Healthy reports it stale, three refresh intervals by
+// default.
+func WithMaxAge(maxAge time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.maxAge = maxAge
+	}
+}
+
+// WithListOptions sets the options given to the updates of the list of a
+// Manager and to the loads of its cache file, such as ICANNOnly or MinRules,
+// and to NewList when the Manager creates its list, such as FailClosed.
+func WithListOptions(opts ...Option) ManagerOption {
+	return func(m *Manager) {
+		m.opts = opts
+	}
+}
+
+// ManagerMetrics are the metrics of a Manager, see Manager.Metrics.
+type ManagerMetrics struct {
+	// Updates is the number of successful updates.
+	Updates int64
+	// Failures is the number of failed updates, an update fails when every
+	// retriever failed.
+	Failures int64
+	// LastSuccess is the time of the last successful update or load of the
+	// cache file, zero if none.
+	LastSuccess time.Time
+	// LastFailure is the time of the last failed update, zero if none.
+	LastFailure time.Time
+	// LastError is the error of the last failed update, nil if the last
+	// update succeeded.
+	LastError error
+	// Release is the release of the list.
+	Release string
+	// Rules is the number of rules of the list.
+	Rules int
+}
+
+// Manager keeps a list up to date: it loads it from a cache file, updates it
+// in the background with a chain of retrievers, persists it and reports
+// metrics and its health. It is the usual production setup of this package,
+// created and started by NewManager:
+//
+//	var manager, err = publicsuffix.NewManager(ctx,
+//		publicsuffix.WithList(nil),
+//		publicsuffix.WithCachePath("/var/cache/psl/list.bin"),
+//	)
+type Manager struct {
+	list       *List
+	retrievers []ListRetriever
+	interval   time.Duration
+	maxAge     time.Duration
+	cachePath  string
+	opts       []Option
+	started    time.Time
+
+	// cancel stops the background updates, done is closed once they stopped
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// updating serialises the updates
+	updating sync.Mutex
+
+	// mu protects the fields below
+	mu        sync.Mutex
+	metrics   ManagerMetrics
+	persisted string
+}
+
+// NewManager returns a Manager configured with opts, after loading its cache
+// file if any. It then updates the list right away and every refresh interval
+// in the background, until ctx is done or the Manager is closed.
+//
+// A missing or invalid cache file isn't an error, the list keeps its rules
+// until the first update then. Other errors opening the cache file are
+// returned.
+func NewManager(ctx context.Context, opts ...ManagerOption) (*Manager, error) {
+	var m = &Manager{interval: defaultRefreshInterval, started: time.Now()}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.list == nil {
+		m.list = NewList(m.opts...)
+	}
+	if len(m.retrievers) == 0 {
+		m.retrievers = []ListRetriever{defaultListRetriever}
+	}
+	if m.maxAge == 0 {
+		m.maxAge = 3 * m.interval
+	}
+
+	if err := m.loadCache(); err != nil {
+		return nil, err
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go m.run(ctx)
+
+	return m, nil
+}
+
+// loadCache loads the cache file, if any, in the list.
+func (m *Manager) loadCache() error {
+	if m.cachePath == "" {
+		return nil
+	}
+
+	var err = m.list.ReadFile(m.cachePath, m.opts...)
+	switch {
+	case err == nil:
+		m.mu.Lock()
+		m.metrics.LastSuccess = time.Now()
+		m.persisted = m.list.Release()
+		m.mu.Unlock()
+		return nil
+	case errors.Is(err, os.ErrNotExist), errors.Is(err, ErrInvalidData), errors.Is(err, ErrListTooSmall):
+		return nil
+	default:
+		return err
+	}
+}
+
+// run updates the list every refresh interval until ctx is done.
+func (m *Manager) run(ctx context.Context) {
+	defer close(m.done)
+
+	var ticker = time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.Update()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops the background updates of m, waiting for an update in progress
+// until ctx is done, and writes the list to the cache file if any, so that the
+// next start resumes from the freshest list. The list remains usable.
+//
+// The cache file is written even if ctx is done first, ctx.Err() is returned
+// then unless writing fails.
+func (m *Manager) Close(ctx context.Context) error {
+	m.cancel()
+
+	var err error
+	select {
+	case <-m.done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if m.cachePath == "" {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if writeErr := m.list.WriteFile(m.cachePath); writeErr != nil {
+		return writeErr
+	}
+	m.persisted = m.list.Release()
+
+	return err
+}
+
+// List returns the list managed by m.
+func (m *Manager) List() *List {
+	return m.list
+}
+
+// Update updates the list of m with the first of its retrievers to succeed,
+// and writes it to the cache file if its release changed. It is called every
+// refresh interval, and can be called to refresh the list on demand. An error
+// is returned if every retriever failed or the cache file couldn't be
+// written.
+func (m *Manager) Update() error {
+	return m.update(m.retrievers)
+}
+
+// WebhookHandler returns an http.Handler receiving the events of a GitHub
+// webhook configured with secret on the repository of the public suffix list,
+// see the package level WebhookHandler. The pushes changing the list trigger
+// an Update of m, so that its list options, cache file and metrics apply. The
+// GitHub retrievers of m retrieve the pushed commit rather than the latest
+// release they may have cached.
+func (m *Manager) WebhookHandler(secret string) http.Handler {
+	return newWebhookHandler(secret, func(after string) {
+		var listRetrievers = make([]ListRetriever, len(m.retrievers))
+		for i, listRetriever := range m.retrievers {
+			if _, ok := listRetriever.(gitHubListRetriever); ok {
+				listRetriever = pinnedListRetriever{ListRetriever: listRetriever, release: after}
+			}
+			listRetrievers[i] = listRetriever
+		}
+
+		m.update(listRetrievers)
+	})
+}
+
+// update updates the list of m with the first of listRetrievers to succeed,
+// see Update.
+func (m *Manager) update(listRetrievers []ListRetriever) error {
+	m.updating.Lock()
+	defer m.updating.Unlock()
+
+	var err error
+	for _, listRetriever := range listRetrievers {
+		if err = m.list.UpdateWithListRetriever(listRetriever, m.opts...); err == nil {
+			break
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err != nil {
+		err = fmt.Errorf("publicsuffix: all %d retrievers failed, last error: %w", len(listRetrievers), err)
+		m.metrics.Failures++
+		m.metrics.LastFailure = time.Now()
+		m.metrics.LastError = err
+		return err
+	}
+
+	m.metrics.Updates++
+	m.metrics.LastSuccess = time.Now()
+	m.metrics.LastError = nil
+
+	return m.persist()
+}
+
+// persist writes the list to the cache file unless it already holds its
+// release. It must be called with m.mu held.
+func (m *Manager) persist() error {
+	var release = m.list.Release()
+	if m.cachePath == "" || release == m.persisted {
+		return nil
+	}
+
+	if err := m.list.WriteFile(m.cachePath); err != nil {
+		return err
+	}
+	m.persisted = release
+
+	return nil
+}
+
+// ChangeReport describes the changes an update would make to a list, see
+// Manager.CheckForUpdate.
+type ChangeReport struct {
+	// CurrentRelease is the release of the list.
+	CurrentRelease string
+	// CandidateRelease is the latest release of the list.
+	CandidateRelease string
+	// Retriever is the type of the ListRetriever which retrieved the
+	// candidate.
+	Retriever string
+	// Added and Removed are the rules the update would add and remove,
+	// sorted by name.
+	Added, Removed []Rule
+}
+
+// UpToDate reports whether the list already is at the candidate release.
+func (r ChangeReport) UpToDate() bool {
+	return r.CurrentRelease == r.CandidateRelease
+}
+
+// CheckForUpdate retrieves and parses the latest release of the list like
+// Update, with the first retriever of m to succeed, and reports how it
+// differs from the list without installing it, so that updates can be
+// approved before being applied with Update. It fails like Update if the
+// candidate list is refused, for example by MinRules.
+//
+// The retrievers can't be interrupted, ctx being done makes CheckForUpdate
+// return ctx.Err() without waiting for them.
+func (m *Manager) CheckForUpdate(ctx context.Context) (ChangeReport, error) {
+	type result struct {
+		report ChangeReport
+		err    error
+	}
+
+	var results = make(chan result, 1)
+	go func() {
+		var report, err = m.checkForUpdate(ctx)
+		results <- result{report, err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.report, r.err
+	case <-ctx.Done():
+		return ChangeReport{}, ctx.Err()
+	}
+}
+
+// checkForUpdate implements CheckForUpdate.
+func (m *Manager) checkForUpdate(ctx context.Context) (ChangeReport, error) {
+	var current = m.list.load()
+
+	var err error
+	for _, listRetriever := range m.retrievers {
+		if ctx.Err() != nil {
+			return ChangeReport{}, ctx.Err()
+		}
+
+		// the candidate is parsed and checked exactly like by Update
+		var candidate *rulesInfo
+		if candidate, err = m.list.retrieve(listRetriever, m.opts, true); err != nil {
+			if errors.Is(err, ErrListTooSmall) {
+				return ChangeReport{}, err
+			}
+			continue
+		}
+
+		return ChangeReport{
+			CurrentRelease:   current.Release,
+			CandidateRelease: candidate.Release,
+			Retriever:        fmt.Sprintf("%T", listRetriever),
+			Added:            diffRules(candidate.Map, current.Map),
+			Removed:          diffRules(current.Map, candidate.Map),
+		}, nil
+	}
+
+	return ChangeReport{}, fmt.Errorf("publicsuffix: all %d retrievers failed, last error: %w", len(m.retrievers), err)
+}
+
+// Metrics returns the metrics of m.
+func (m *Manager) Metrics() ManagerMetrics {
+	m.mu.Lock()
+	var metrics = m.metrics
+	m.mu.Unlock()
+
+	var ri = m.list.load()
+	metrics.Release = ri.Release
+	metrics.Rules = ri.size()
+
+	return metrics
+}
+
+// Healthy returns nil if the list of m can be relied on: an error matching
+// ErrListStale if it wasn't updated or loaded from the cache file for longer
+// than allowed by WithMaxAge since m was started, or ErrListTooSmall if it was
+// refused by its MinRules option.
+func (m *Manager) Healthy() error {
+	if m.list.load().tooSmall {
+		return ErrListTooSmall
+	}
+
+	// the list is as fresh as when m was started until updated
+	var metrics = m.Metrics()
+	var fresh = metrics.LastSuccess
+	if fresh.Before(m.started) {
+		fresh = m.started
+	}
+
+	if time.Since(fresh) > m.maxAge {
+		if metrics.LastError != nil {
+			return fmt.Errorf("%w: %s", ErrListStale, metrics.LastError.Error())
+		}
+
+		return ErrListStale
+	}
+
+	return nil
+}
+
+// HealthHandler returns an http.Handler reporting the health of m: status 200
+// if Healthy returns nil, otherwise 503 with the error.
+func (m *Manager) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := m.Healthy(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("ok\n"))
+	})
+}
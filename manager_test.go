@@ -0,0 +1,303 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitFor fails t unless condition becomes true within a few seconds.
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	var deadline = time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func Test_Manager(t *testing.T) {
+	var ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	var cachePath = filepath.Join(t.TempDir(), "list.bin")
+	var failing = mockListRetriever{Err: networkError(errors.New("manager_test"))}
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "manager_test"}
+
+	var manager, err = NewManager(ctx,
+		WithRetrievers(failing, mockRetriever),
+		WithRefreshInterval(time.Hour),
+		WithCachePath(cachePath),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// the first update runs in the background
+	waitFor(t, func() bool { return manager.Metrics().Updates == 1 })
+
+	var metrics = manager.Metrics()
+	if metrics.Release != "manager_test" || metrics.Failures != 0 || metrics.LastSuccess.IsZero() || metrics.Rules == 0 {
+		t.Fatalf("unexpected metrics: %+v", metrics)
+	}
+
+	if suffix, _ := manager.List().PublicSuffix("www.city.kobe.jp"); suffix != "kobe.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "kobe.jp")
+	}
+
+	if err := manager.Healthy(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// a new manager resumes from the cache file even if the retrievers fail
+	var restarted *Manager
+	restarted, err = NewManager(ctx,
+		WithRetrievers(failing),
+		WithCachePath(cachePath),
+		WithMaxAge(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if release := restarted.List().Release(); release != "manager_test" {
+		t.Fatalf("got: %s, want: %s", release, "manager_test")
+	}
+
+	waitFor(t, func() bool { return restarted.Metrics().Failures == 1 })
+	time.Sleep(2 * time.Millisecond)
+
+	if err := restarted.Healthy(); !errors.Is(err, ErrListStale) {
+		t.Fatalf("got: %v, want: %v", err, ErrListStale)
+	}
+
+	var recorder = httptest.NewRecorder()
+	restarted.HealthHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+
+	if err := restarted.Update(); !errors.Is(err, ErrNetwork) {
+		t.Fatalf("got: %v, want: %v", err, ErrNetwork)
+	}
+}
+
+// blockingRetriever blocks GetLatestReleaseTag until release is closed.
+type blockingRetriever struct {
+	mockListRetriever
+	release chan struct{}
+}
+
+func (b blockingRetriever) GetLatestReleaseTag() (string, error) {
+	<-b.release
+	return b.mockListRetriever.GetLatestReleaseTag()
+}
+
+func Test_ManagerClose(t *testing.T) {
+	var cachePath = filepath.Join(t.TempDir(), "list.bin")
+
+	var blocking = blockingRetriever{
+		mockListRetriever: mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "close_test"},
+		release:           make(chan struct{}),
+	}
+
+	var manager, err = NewManager(context.Background(), WithRetrievers(blocking), WithCachePath(cachePath))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// the update in progress outlives the context of Close
+	var ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := manager.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+
+	// the embedded list was written
+	var l = NewList()
+	if err := l.ReadFile(cachePath); err != nil || l.Release() != NewList().Release() {
+		t.Fatalf("got: %s %v, want: %s", l.Release(), err, NewList().Release())
+	}
+
+	close(blocking.release)
+	if err := manager.Close(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := l.ReadFile(cachePath); err != nil || l.Release() != "close_test" {
+		t.Fatalf("got: %s %v, want: %s", l.Release(), err, "close_test")
+	}
+
+	// no more updates
+	if updates := manager.Metrics().Updates; updates != 1 {
+		t.Fatalf("got: %d, want: %d", updates, 1)
+	}
+}
+
+func Test_ManagerCheckForUpdate(t *testing.T) {
+	var l, err = ParseList(bytes.NewBufferString("jp\nkobe.jp\nblogspot.jp\n"), "current")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var blocking = blockingRetriever{
+		mockListRetriever: mockListRetriever{RawList: bytes.NewBufferString("jp\n"), Release: "blocked"},
+		release:           make(chan struct{}),
+	}
+	defer close(blocking.release)
+
+	// the check doesn't wait for the retriever once ctx is done
+	var ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var manager = &Manager{list: l, retrievers: []ListRetriever{blocking}}
+	if _, err := manager.CheckForUpdate(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+
+	var failing = mockListRetriever{Err: networkError(errors.New("check_test"))}
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\nkobe.jp\nexample\n"), Release: "check_test"}
+	manager = &Manager{list: l, retrievers: []ListRetriever{failing, mockRetriever}}
+
+	var report ChangeReport
+	report, err = manager.CheckForUpdate(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if report.CurrentRelease != "current" || report.CandidateRelease != "check_test" || report.UpToDate() {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if len(report.Added) != 1 || report.Added[0].Name != "example" || len(report.Removed) != 1 || report.Removed[0].Name != "blogspot.jp" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	// nothing was installed
+	if release := l.Release(); release != "current" {
+		t.Fatalf("got: %s, want: %s", release, "current")
+	}
+
+	mockRetriever.RawList = bytes.NewBufferString("jp\n")
+	manager = &Manager{list: l, retrievers: []ListRetriever{mockRetriever}, opts: []Option{MinRules(10)}}
+	if _, err := manager.CheckForUpdate(context.Background()); !errors.Is(err, ErrListTooSmall) {
+		t.Fatalf("got: %v, want: %v", err, ErrListTooSmall)
+	}
+
+	// the options of the list apply to the candidate like to an update
+	var warned []Issue
+	l = NewList(ICANNOnly(), CheckRules(func(issue Issue) { warned = append(warned, issue) }))
+	mockRetriever.RawList = bytes.NewBufferString("// ===BEGIN ICANN DOMAINS===\njp\njp\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nblogspot.jp\n// ===END PRIVATE DOMAINS===\n")
+	manager = &Manager{list: l, retrievers: []ListRetriever{mockRetriever}}
+
+	report, err = manager.CheckForUpdate(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, rule := range report.Added {
+		if rule.Name == "blogspot.jp" {
+			t.Fatalf("unexpected report: %+v", report)
+		}
+	}
+
+	if len(warned) != 1 {
+		t.Fatalf("got: %v, want: the duplicate rule", warned)
+	}
+}
+
+func Test_ManagerWebhookHandler(t *testing.T) {
+	var ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	// the latest release can't be retrieved, the pushed commit can
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pushed/public_suffix_list.dat" {
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(rulesTestList))
+	}))
+	defer server.Close()
+
+	var listRetriever = NewGitHubListRetriever(server.Client(),
+		WithCommitURL(server.URL+"/commits"),
+		WithListURL(server.URL+"/%s/public_suffix_list.dat"),
+	)
+
+	var cachePath = filepath.Join(t.TempDir(), "list.bin")
+	var manager, err = NewManager(ctx,
+		WithRetrievers(listRetriever),
+		WithRefreshInterval(time.Hour),
+		WithCachePath(cachePath),
+		WithListOptions(ICANNOnly()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	waitFor(t, func() bool { return manager.Metrics().Failures == 1 })
+
+	const secret = "manager_test"
+	var payload = `{"ref":"refs/heads/main","after":"pushed","repository":{"full_name":"publicsuffix/list","default_branch":"main"},"commits":[{"modified":["public_suffix_list.dat"]}]}`
+	var mac = hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+
+	var request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set("X-GitHub-Event", "push")
+	request.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	var recorder = httptest.NewRecorder()
+	manager.WebhookHandler(secret).ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusAccepted {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusAccepted)
+	}
+
+	waitFor(t, func() bool { return manager.Metrics().Updates == 1 })
+
+	// the update went through the manager, with its list options and cache
+	if release := manager.List().Release(); release != "pushed" {
+		t.Fatalf("got: %s, want: %s", release, "pushed")
+	}
+
+	if suffix, _ := manager.List().PublicSuffix("www.blogspot.jp"); suffix != "jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "jp")
+	}
+
+	var cached = NewList()
+	if err := cached.ReadFile(cachePath); err != nil || cached.Release() != "pushed" {
+		t.Fatalf("got: %v %s, want: the pushed release", err, cached.Release())
+	}
+}
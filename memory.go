@@ -0,0 +1,159 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+var (
+	stringHeaderSize = int64(unsafe.Sizeof(""))
+	ruleSize         = int64(unsafe.Sizeof(rule{}))
+	ruleSliceSize    = int64(unsafe.Sizeof([]rule(nil)))
+	matchSize        = int64(unsafe.Sizeof(match{}))
+)
+
+// memoryUser is implemented by engines able to estimate the memory they
+// retain on top of the rules.
+type memoryUser interface {
+	approxMemoryUsage() int64
+}
+
+// mapEntrySize estimates the memory used by an entry of a map, including the
+// buckets left empty by the load factor (6.5 entries per bucket of 8).
+func mapEntrySize(keySize, valueSize int64) int64 {
+	return (keySize + valueSize + 1) * 8 * 2 / 13
+}
+
+// ApproxMemoryUsage returns an estimate, in bytes, of the memory retained by
+// the currently loaded list: its strings, slices and map overhead, and the
+// data structures of the lookup engine. It is meant to compare the cost of
+// different configurations, such as full and ICANN only lists, rather than for
+// exact accounting.
+func ApproxMemoryUsage() int64 {
+	return defaultList().ApproxMemoryUsage()
+}
+
+// ApproxMemoryUsage returns an estimate of the memory retained by l, see the
+// package level ApproxMemoryUsage.
+func (l *List) ApproxMemoryUsage() int64 {
+	var ri = l.load()
+
+	// the rules of a comment block share its string when parsed, count each
+	// copy once
+	var comments = make(map[uintptr]bool)
+
+	var size = int64(len(ri.Release))
+	for key, rules := range ri.Map {
+		size += mapEntrySize(stringHeaderSize, ruleSliceSize) + int64(len(key))
+		size += int64(cap(rules)) * ruleSize
+		for _, rule := range rules {
+			size += int64(len(rule.DottedName) + len(rule.Text))
+
+			if data := (*reflect.StringHeader)(unsafe.Pointer(&rule.Comment)).Data; rule.Comment != "" && !comments[data] {
+				comments[data] = true
+				size += int64(len(rule.Comment))
+			}
+		}
+	}
+
+	if engine, ok := ri.engine.(memoryUser); ok {
+		size += engine.approxMemoryUsage()
+	}
+
+	return size
+}
+
+// approxMemoryUsage implements memoryUser, the rules are shared with the list.
+func (e mapEngine) approxMemoryUsage() int64 {
+	var size int64
+	for tld := range e.tlds {
+		size += mapEntrySize(stringHeaderSize, matchSize) + int64(len(tld))
+	}
+
+	for name := range e.deeper {
+		size += mapEntrySize(stringHeaderSize, 1) + int64(len(name))
+	}
+
+	for tld := range e.ruled {
+		size += mapEntrySize(stringHeaderSize, 1) + int64(len(tld))
+	}
+
+	size += int64(cap(e.patterns)) * ruleSize
+
+	return size
+}
+
+// compactRules returns a copy of rules using a single allocation for all the
+// rules and another for all the strings, which are deduplicated: the comment
+// of a block of rules is shared by all of them. Only the map is allocated in
+// proportion to the number of rules. See CompactRules.
+//
+// Go arenas would allow freeing the rules explicitly when a list is replaced,
+// but they are experimental and lookups may still use the replaced rules, so
+// the blocks are left to the garbage collector instead.
+func compactRules(rules map[string][]rule) map[string][]rule {
+	var count, size int
+	var interned = make(map[string]int, 2*len(rules))
+
+	var intern = func(s string) {
+		if _, found := interned[s]; !found && s != "" {
+			interned[s] = size
+			size += len(s)
+		}
+	}
+
+	for key, list := range rules {
+		intern(key)
+		for _, r := range list {
+			intern(r.DottedName)
+			intern(r.Text)
+			intern(r.Comment)
+		}
+		count += len(list)
+	}
+
+	var block = make([]byte, size)
+	for s, offset := range interned {
+		copy(block[offset:], s)
+	}
+
+	var strs = string(block)
+	var lookup = func(s string) string {
+		if s == "" {
+			return ""
+		}
+
+		var offset = interned[s]
+		return strs[offset : offset+len(s)]
+	}
+
+	var compacted = make(map[string][]rule, len(rules))
+	var backing = make([]rule, 0, count)
+	for key, list := range rules {
+		var start = len(backing)
+		for _, r := range list {
+			r.DottedName, r.Text, r.Comment = lookup(r.DottedName), lookup(r.Text), lookup(r.Comment)
+			backing = append(backing, r)
+		}
+
+		compacted[lookup(key)] = backing[start:len(backing):len(backing)]
+	}
+
+	return compacted
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_ApproxMemoryUsage(t *testing.T) {
+	var full = NewList().ApproxMemoryUsage()
+	var icannOnly = NewList(ICANNOnly()).ApproxMemoryUsage()
+
+	if icannOnly <= 0 || full <= icannOnly {
+		t.Fatalf("got: full %d, ICANN only %d, want: full > ICANN only > 0", full, icannOnly)
+	}
+
+	// every rule retains at least its name and its entry
+	var nbRules int
+	ICANNRules(func(Rule) bool { nbRules++; return true })
+	PrivateRules(func(Rule) bool { nbRules++; return true })
+
+	if min := int64(nbRules) * ruleSize; full < min {
+		t.Fatalf("got: %d, want at least: %d", full, min)
+	}
+
+	// the comments are counted, once per copy
+	var list = "// ===BEGIN ICANN DOMAINS===\n// " + strings.Repeat("x", 1000) + "\na.example\nb.example\n// ===END ICANN DOMAINS===\n"
+	var parsed, err = ParseList(strings.NewReader(list), "memory_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := parsed.ApproxMemoryUsage(); got < 1000 || got > 2000 {
+		t.Fatalf("got: %d, want: between %d and %d", got, 1000, 2000)
+	}
+}
+
+func Test_CompactRules(t *testing.T) {
+	var plain, compact = NewList(), NewList(CompactRules())
+
+	if !reflect.DeepEqual(compact.load().Map, plain.load().Map) {
+		t.Fatalf("got: different rules, want the same rules")
+	}
+
+	for _, domain := range []string{"www.example.com", "www.city.kobe.jp", "foo.bar.kobe.jp", "www.xn--zf0ao64a.tw", "foo.blogspot.com", "example.zzz"} {
+		var got, gotICANN = compact.PublicSuffix(domain)
+		var want, wantICANN = plain.PublicSuffix(domain)
+		if got != want || gotICANN != wantICANN {
+			t.Fatalf("%q: got: %s %v, want: %s %v", domain, got, gotICANN, want, wantICANN)
+		}
+	}
+
+	// the rules and their strings take a few allocations, unlike the map
+	var rules = plain.load().Map
+	if allocs := testing.AllocsPerRun(1, func() { compactRules(rules) }); allocs > float64(len(rules)/20) {
+		t.Fatalf("got: %v allocations, want: at most %d for %d keys", allocs, len(rules)/20, len(rules))
+	}
+}
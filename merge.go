@@ -0,0 +1,135 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Conflict is a rule left out by MergeLists because it contradicts a rule of
+// a previous input.
+type Conflict struct {
+	// Input is the index of the input of the rule left out, and Line its
+	// line in the input.
+	Input, Line int
+	// Rule is the rule left out.
+	Rule string
+	// Kept is the rule it conflicts with, kept in the merged list.
+	Kept string
+	// KeptInput and KeptLine locate Kept like Input and Line.
+	KeptInput, KeptLine int
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("input %d line %d: rule %q conflicts with %q of input %d line %d", c.Input, c.Line, c.Rule, c.Kept, c.KeptInput, c.KeptLine)
+}
+
+// mergedRule is a rule of an input of MergeLists.
+type mergedRule struct {
+	rule
+	input int
+}
+
+// MergeLists merges inputs, files in the format of the public suffix list such
+// as the list itself and internal overlays, and writes the result to w. The
+// rules of each section are written in the order of the inputs, preceded by
+// their comment and surrounded by the section markers; rules outside of the
+// sections belong to the private section. Rules are written in canonical form
+// and listed once. Like for ParseList, the comments at the top of an input are
+// its header, they are left out.
+//
+// When the same name is listed as an exception and as another kind of rule,
+// or in both sections, the rule of the first input is kept and the other one
+// is reported as a Conflict. An error matching ErrInvalidData is returned if an
+// input isn't valid, nothing is written then.
+func MergeLists(w io.Writer, inputs ...io.Reader) ([]Conflict, error) {
+	var rules []mergedRule
+	for i, input := range inputs {
+		var ri, err = newList(input, "")
+		if err != nil {
+			return nil, fmt.Errorf("input %d: %w", i, err)
+		}
+
+		var start = len(rules)
+		for _, list := range ri.Map {
+			for _, r := range list {
+				rules = append(rules, mergedRule{rule: r, input: i})
+			}
+		}
+
+		var added = rules[start:]
+		sort.Slice(added, func(i, j int) bool {
+			return added[i].Line < added[j].Line
+		})
+	}
+
+	var conflicts []Conflict
+	var kept = map[string]mergedRule{}
+	var merged = rules[:0]
+	for _, r := range rules {
+		var name = strings.TrimPrefix(r.DottedName, "!")
+
+		var previous, found = kept[name]
+		switch {
+		case !found:
+			kept[name] = r
+			merged = append(merged, r)
+		case previous.DottedName != r.DottedName || previous.ICANN != r.ICANN:
+			conflicts = append(conflicts, Conflict{
+				Input: r.input, Line: r.Line, Rule: r.DottedName,
+				Kept: previous.DottedName, KeptInput: previous.input, KeptLine: previous.Line,
+			})
+		}
+	}
+
+	var buffer = bufio.NewWriter(w)
+	writeSection(buffer, "ICANN", merged, true)
+	buffer.WriteString("\n")
+	writeSection(buffer, "PRIVATE", merged, false)
+
+	return conflicts, buffer.Flush()
+}
+
+// writeSection writes the rules of the ICANN section, or of the private one,
+// between the markers of the section named name.
+func writeSection(w *bufio.Writer, name string, rules []mergedRule, icann bool) {
+	fmt.Fprintf(w, "// ===BEGIN %s DOMAINS===\n", name)
+
+	var comment string
+	var input = -1
+	for _, r := range rules {
+		if r.ICANN != icann {
+			continue
+		}
+
+		if r.Comment != comment || r.input != input {
+			comment, input = r.Comment, r.input
+			w.WriteString("\n")
+			if comment != "" {
+				fmt.Fprintf(w, "// %s\n", strings.Replace(comment, "\n", "\n// ", -1))
+			}
+		}
+
+		fmt.Fprintf(w, "%s\n", r.DottedName)
+	}
+
+	fmt.Fprintf(w, "\n// ===END %s DOMAINS===\n", name)
+}
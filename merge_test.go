@@ -0,0 +1,102 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_MergeLists(t *testing.T) {
+	if unsupportedIDN("рф") {
+		t.Skip("internationalised domain names aren't supported")
+	}
+
+	var base = `// ===BEGIN ICANN DOMAINS===
+// jp
+jp
+*.kobe.jp
+!city.kobe.jp
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+// Blogspot
+blogspot.jp
+// ===END PRIVATE DOMAINS===
+`
+	var overlay = `jp
+city.kobe.jp
+
+// Internal
+corp.example
+рф
+// ===BEGIN ICANN DOMAINS===
+blogspot.jp
+// ===END ICANN DOMAINS===
+`
+
+	var merged bytes.Buffer
+	var conflicts, err = MergeLists(&merged, strings.NewReader(base), strings.NewReader(overlay))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var want = `// ===BEGIN ICANN DOMAINS===
+
+// jp
+jp
+*.kobe.jp
+!city.kobe.jp
+
+// ===END ICANN DOMAINS===
+
+// ===BEGIN PRIVATE DOMAINS===
+
+// Blogspot
+blogspot.jp
+
+// Internal
+corp.example
+xn--p1ai
+
+// ===END PRIVATE DOMAINS===
+`
+	if merged.String() != want {
+		t.Fatalf("got: %s, want: %s", merged.String(), want)
+	}
+
+	var wantConflicts = []Conflict{
+		{Input: 1, Line: 1, Rule: "jp", Kept: "jp", KeptInput: 0, KeptLine: 3},
+		{Input: 1, Line: 2, Rule: "city.kobe.jp", Kept: "!city.kobe.jp", KeptInput: 0, KeptLine: 5},
+		{Input: 1, Line: 8, Rule: "blogspot.jp", Kept: "blogspot.jp", KeptInput: 0, KeptLine: 9},
+	}
+	if !reflect.DeepEqual(conflicts, wantConflicts) {
+		t.Fatalf("got: %v, want: %v", conflicts, wantConflicts)
+	}
+
+	// the merged list is a valid list
+	if _, err := ParseList(&merged, "merge_test"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	merged.Reset()
+	if _, err := MergeLists(&merged, strings.NewReader("COM")); !errors.Is(err, ErrInvalidData) || merged.Len() != 0 {
+		t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+	}
+}
@@ -0,0 +1,162 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Headers set by Middleware with the SiteRequestHeaders and
+// SiteResponseHeaders options.
+const (
+	// HeaderRegisteredDomain holds the registered domain of the host of a
+	// request, e.g. "example.co.uk" for "www.example.co.uk".
+	HeaderRegisteredDomain = "X-Registered-Domain"
+	// HeaderPublicSuffix holds the public suffix of the host of a request,
+	// e.g. "co.uk" for "www.example.co.uk".
+	HeaderPublicSuffix = "X-Public-Suffix"
+)
+
+// siteContextKey is the context key of the Result stored by Middleware.
+type siteContextKey struct{}
+
+// MiddlewareOption configures Middleware.
+type MiddlewareOption func(*middlewareOptions)
+
+// middlewareOptions holds the configuration set by MiddlewareOptions.
+type middlewareOptions struct {
+	requestHeaders  bool
+	responseHeaders bool
+}
+
+// SiteRequestHeaders makes Middleware set the HeaderRegisteredDomain and
+// HeaderPublicSuffix headers of the request passed to the next handler, e.g.
+// for access logs or proxied backends. Headers of these names sent by the
+// client are removed.
+func SiteRequestHeaders() MiddlewareOption {
+	return func(o *middlewareOptions) {
+		o.requestHeaders = true
+	}
+}
+
+// SiteResponseHeaders makes Middleware set the HeaderRegisteredDomain and
+// HeaderPublicSuffix headers of the response.
+func SiteResponseHeaders() MiddlewareOption {
+	return func(o *middlewareOptions) {
+		o.responseHeaders = true
+	}
+}
+
+// Middleware returns an http.Handler looking up the host of each request, from
+// its Host header, before passing it to next. The Result of Lookup is stored
+// in the context of the request, to be retrieved with SiteFromContext, so
+// that services rate limit and log per site consistently:
+//
+//	http.ListenAndServe(":8080", publicsuffix.Middleware(mux))
+//
+// The port and the trailing dot of the host are ignored, and the host is
+// normalised with Normalize. The list carried by the context of the request
+// is used, see FromContext. Nothing is stored for IP addresses and hosts which
+// can't be looked up, the request is still passed to next.
+func Middleware(next http.Handler, opts ...MiddlewareOption) http.Handler {
+	var o middlewareOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var site, found = requestSite(r)
+		if found {
+			r = r.WithContext(context.WithValue(r.Context(), siteContextKey{}, site))
+		}
+
+		if o.requestHeaders {
+			// the request of the caller is left unchanged
+			if !found {
+				r = r.WithContext(r.Context())
+			}
+			r.Header = r.Header.Clone()
+			setSiteHeaders(r.Header, site, found)
+		}
+
+		if o.responseHeaders {
+			setSiteHeaders(w.Header(), site, found)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SiteFromContext returns the Result of the lookup of the host of a request
+// stored by Middleware in ctx, and whether one was stored.
+func SiteFromContext(ctx context.Context) (Result, bool) {
+	var site, ok = ctx.Value(siteContextKey{}).(Result)
+
+	return site, ok
+}
+
+// requestSite looks up the host of r, see Middleware.
+func requestSite(r *http.Request) (Result, bool) {
+	var host = r.Host
+	if host == "" && r.URL != nil {
+		host = r.URL.Host
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(host, ".")
+
+	if host == "" || net.ParseIP(strings.Trim(host, "[]")) != nil {
+		return Result{}, false
+	}
+
+	var normalized, err = Normalize(host)
+	if err != nil {
+		return Result{}, false
+	}
+
+	var site Result
+	site, err = FromContext(r.Context()).Lookup(normalized)
+	if err != nil {
+		return Result{}, false
+	}
+
+	return site, true
+}
+
+// setSiteHeaders sets the headers of site in header, or removes them if no
+// site was found.
+func setSiteHeaders(header http.Header, site Result, found bool) {
+	header.Del(HeaderRegisteredDomain)
+	header.Del(HeaderPublicSuffix)
+
+	if !found {
+		return
+	}
+
+	if site.RegisteredDomain != "" {
+		header.Set(HeaderRegisteredDomain, site.RegisteredDomain)
+	}
+	header.Set(HeaderPublicSuffix, site.PublicSuffix)
+}
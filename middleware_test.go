@@ -0,0 +1,82 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_Middleware(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("// ===BEGIN ICANN DOMAINS===\nuk\nco.uk\ncom\n// ===END ICANN DOMAINS===\n"), "middleware_test")
+	if err != nil {
+		t.Fatalf("got: %v, want: no error", err)
+	}
+
+	var site Result
+	var found bool
+	var forwarded string
+	var handler = Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		site, found = SiteFromContext(r.Context())
+		forwarded = r.Header.Get(HeaderRegisteredDomain)
+	}), SiteRequestHeaders(), SiteResponseHeaders())
+
+	var tests = []struct {
+		host   string
+		domain string
+		suffix string
+		found  bool
+	}{
+		{"www.example.co.uk", "example.co.uk", "co.uk", true},
+		{"WWW.Example.COM.:8443", "example.com", "com", true},
+		{"co.uk", "", "co.uk", true},
+		{"127.0.0.1:8080", "", "", false},
+		{"[::1]:8080", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, test := range tests {
+		var r = httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Host = test.host
+		r.Header.Set(HeaderRegisteredDomain, "spoofed.com")
+		r = r.WithContext(NewContext(r.Context(), list))
+
+		var w = httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if found != test.found || site.RegisteredDomain != test.domain || site.PublicSuffix != test.suffix {
+			t.Fatalf("%q: got: %v %+v, want: %v %q %q", test.host, found, site, test.found, test.domain, test.suffix)
+		}
+		if forwarded != test.domain {
+			t.Fatalf("%q: got: %q, want: %q", test.host, forwarded, test.domain)
+		}
+		if got := w.Header().Get(HeaderRegisteredDomain); got != test.domain {
+			t.Fatalf("%q: got: %q, want: %q", test.host, got, test.domain)
+		}
+		if got := w.Header().Get(HeaderPublicSuffix); got != test.suffix {
+			t.Fatalf("%q: got: %q, want: %q", test.host, got, test.suffix)
+		}
+		if got := r.Header.Get(HeaderRegisteredDomain); got != "spoofed.com" {
+			t.Fatalf("%q: got: %q, want: the request of the caller unchanged", test.host, got)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"sync"
+)
+
+// labelCacheSize is the maximum number of labels kept by labelCache.
+const labelCacheSize = 4096
+
+// labelCache memoizes the conversion of labels to ASCII, so pipelines
+// processing the same IDN hostnames repeatedly don't pay the conversion cost
+// every time.
+var labelCache = newASCIICache(labelCacheSize)
+
+// asciiResult is the memoized result of converting a label to ASCII.
+type asciiResult struct {
+	label string
+	err   error
+}
+
+// asciiCache is a bounded cache of label conversions. It keeps two
+// generations of entries: once the current generation is full it replaces
+// the previous one, so recently seen labels are retained without the cost of
+// tracking the exact use order.
+type asciiCache struct {
+	mu       sync.Mutex
+	size     int
+	current  map[string]asciiResult
+	previous map[string]asciiResult
+}
+
+func newASCIICache(size int) *asciiCache {
+	return &asciiCache{
+		size:    size,
+		current: make(map[string]asciiResult),
+	}
+}
+
+// toASCII converts label using the IDNA lookup profile, memoizing the result.
+func (c *asciiCache) toASCII(label string) (string, error) {
+	c.mu.Lock()
+	var result, found = c.current[label]
+	if !found {
+		result, found = c.previous[label]
+		if found {
+			c.add(label, result)
+		}
+	}
+	c.mu.Unlock()
+
+	if found {
+		return result.label, result.err
+	}
+
+	result.label, result.err = lookupToASCII(label)
+
+	c.mu.Lock()
+	c.add(label, result)
+	c.mu.Unlock()
+
+	return result.label, result.err
+}
+
+// add stores result in the current generation, c.mu must be held.
+func (c *asciiCache) add(label string, result asciiResult) {
+	if len(c.current) >= c.size/2 {
+		c.previous = c.current
+		c.current = make(map[string]asciiResult, c.size/2)
+	}
+
+	c.current[label] = result
+}
+
+// Normalize converts domain to the canonical form used by the public suffix
+// list and expected by the lookup functions: labels are lower case and
+// internationalised labels are Punycode encoded. For example "Www.例え.JP"
+// becomes "www.xn--r8jz45g.jp".
+//
+// A *DomainError is returned if domain cannot be converted or exceeds the
+// lengths allowed by RFC 1035.
+//
+// Labels starting with an underscore are rejected unless the AllowUnderscores
+// option is given. Conversions are memoized for recently seen labels.
+func Normalize(domain string, opts ...Option) (string, error) {
+	var o = newOptions(opts)
+	var labels = strings.Split(domain, ".")
+
+	for i, label := range labels {
+		var prefix string
+		if o.allowUnderscores && strings.HasPrefix(label, "_") {
+			prefix, label = "_", label[1:]
+		}
+
+		if label == "" {
+			continue
+		}
+
+		var ascii, err = labelCache.toASCII(label)
+		if err != nil {
+			return "", &DomainError{Domain: domain, Err: err}
+		}
+
+		labels[i] = prefix + ascii
+	}
+
+	var normalized = strings.Join(labels, ".")
+	if err := checkDomain(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// hasEmptyLabel reports whether domain is empty or has an empty label, a
+// trailing dot included.
+func hasEmptyLabel(domain string) bool {
+	return domain == "" || domain[0] == '.' || domain[len(domain)-1] == '.' || strings.Contains(domain, "..")
+}
+
+// Maximum lengths allowed by RFC 1035.
+const (
+	maxDomainLength = 253
+	maxLabelLength  = 63
+)
+
+// checkDomain returns a DomainError if domain, or any of its labels, exceeds
+// the lengths allowed by RFC 1035.
+func checkDomain(domain string) error {
+	var length = len(domain)
+	if length > 0 && domain[length-1] == '.' {
+		length--
+	}
+
+	if length > maxDomainLength {
+		return &DomainError{Domain: domain, Err: ErrDomainTooLong}
+	}
+
+	var labelStart = 0
+	for i := 0; i <= len(domain); i++ {
+		if i < len(domain) && domain[i] != '.' {
+			continue
+		}
+
+		if i-labelStart > maxLabelLength {
+			return &DomainError{Domain: domain, Err: ErrLabelTooLong}
+		}
+
+		labelStart = i + 1
+	}
+
+	return nil
+}
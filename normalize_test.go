@@ -0,0 +1,184 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// unsupportedIDN reports whether s is an internationalised name that the
+// build can't convert, lite builds only handle ASCII.
+func unsupportedIDN(s string) bool {
+	if _, err := toASCII("例え"); err == nil {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Test_Normalize(t *testing.T) {
+	var tests = []struct {
+		domain   string
+		expected string
+		err      bool
+	}{
+		{"example.com", "example.com", false},
+		{"Www.Example.COM", "www.example.com", false},
+		{"www.例え.jp", "www.xn--r8jz45g.jp", false},
+		{"xn--r8jz45g.jp", "xn--r8jz45g.jp", false},
+		{"網路.tw", "xn--zf0ao64a.tw", false},
+		{"example.com.", "example.com.", false},
+		{"b..n", "b..n", false},
+		{"exa mple.com", "", true},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.domain, func(t *testing.T) {
+			if unsupportedIDN(tt.domain) {
+				t.Skip("internationalised domain names aren't supported")
+			}
+
+			var got, err = Normalize(tt.domain)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error: %v, want error: %v", err, tt.err)
+			}
+			if got != tt.expected {
+				t.Fatalf("got: %q, want: %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_Normalize_AllowUnderscores(t *testing.T) {
+	var tests = []struct {
+		domain   string
+		expected string
+		suffix   string
+	}{
+		{"_dmarc.Example.co.uk", "_dmarc.example.co.uk", "co.uk"},
+		{"_sip._tcp.example.com", "_sip._tcp.example.com", "com"},
+		{"_.example.com", "_.example.com", "com"},
+		{"_dmarc.例え.jp", "_dmarc.xn--r8jz45g.jp", "jp"},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.domain, func(t *testing.T) {
+			if unsupportedIDN(tt.domain) {
+				t.Skip("internationalised domain names aren't supported")
+			}
+
+			if _, err := Normalize(tt.domain); err == nil {
+				t.Fatalf("expected an error without AllowUnderscores")
+			}
+
+			var got, err = Normalize(tt.domain, AllowUnderscores())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if got != tt.expected {
+				t.Fatalf("got: %q, want: %q", got, tt.expected)
+			}
+
+			if suffix, _ := PublicSuffix(got); suffix != tt.suffix {
+				t.Fatalf("got suffix: %q, want: %q", suffix, tt.suffix)
+			}
+		})
+	}
+
+	// Underscores are only tolerated at the start of a label.
+	if _, err := Normalize("foo_bar.example.com", AllowUnderscores()); err == nil {
+		t.Fatalf("expected an error for an underscore inside a label")
+	}
+}
+
+func Test_ASCIICache(t *testing.T) {
+	var cache = newASCIICache(4)
+
+	for i := 0; i < 10; i++ {
+		if _, err := cache.toASCII("label" + strconv.Itoa(i)); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if entries := len(cache.current) + len(cache.previous); entries > 4 {
+			t.Fatalf("got %d entries, want at most %d", entries, 4)
+		}
+	}
+
+	// The most recent label must still be cached.
+	if _, found := cache.current["label9"]; !found {
+		t.Fatalf("label9 should be cached")
+	}
+}
+
+func Test_CheckDomain(t *testing.T) {
+	var label63 = strings.Repeat("a", 63)
+	var domain253 = strings.Repeat(label63+".", 3) + strings.Repeat("b", 61)
+
+	var tests = []struct {
+		name     string
+		domain   string
+		expected error
+	}{
+		{"Empty", "", nil},
+		{"Short", "example.co.uk", nil},
+		{"Longest label", label63 + ".com", nil},
+		{"Longest domain", domain253, nil},
+		{"Longest domain with trailing dot", domain253 + ".", nil},
+		{"Label too long", "a" + label63 + ".com", ErrLabelTooLong},
+		{"Last label too long", "example." + label63 + "a", ErrLabelTooLong},
+		{"Domain too long", "a" + domain253, ErrDomainTooLong},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			var err = checkDomain(tt.domain)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("got: %v, want: %v", err, tt.expected)
+			}
+
+			if tt.expected == nil {
+				return
+			}
+
+			var domainErr *DomainError
+			if !errors.As(err, &domainErr) || domainErr.Domain != tt.domain {
+				t.Fatalf("got: %#v, want a *DomainError for the domain", err)
+			}
+
+			if _, err := EffectiveTLDPlusOne(tt.domain); !errors.Is(err, tt.expected) {
+				t.Fatalf("EffectiveTLDPlusOne got: %v, want: %v", err, tt.expected)
+			}
+
+			if suffix, _ := PublicSuffix(tt.domain); suffix != "" {
+				t.Fatalf("PublicSuffix got: %q, want an empty suffix", suffix)
+			}
+		})
+	}
+}
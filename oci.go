@@ -0,0 +1,375 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Annotations and media types of the OCI image specification used by the OCI
+// retriever.
+const (
+	ociVersionAnnotation = "org.opencontainers.image.version"
+	ociTitleAnnotation   = "org.opencontainers.image.title"
+	ociManifestTypes     = "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// ociListRetriever implements ListRetriever using an OCI registry.
+type ociListRetriever struct {
+	doer Doer
+	// base is the URL of the repository, such as https://ghcr.io/v2/org/psl
+	base string
+	// tag is the moving tag of the latest release
+	tag string
+	// scope is requested for the tokens of registries requiring one
+	scope string
+	token *ociToken
+	// versions are the version annotations of the manifests retrieved
+	versions *ociVersions
+}
+
+// ociVersions holds the version annotations of manifests by digest, shared by
+// the copies of a retriever.
+type ociVersions struct {
+	mu       sync.Mutex
+	byDigest map[string]string
+}
+
+// ociToken is the bearer token shared by the copies of a retriever.
+type ociToken struct {
+	mu    sync.Mutex
+	value string
+}
+
+// ociDescriptor is the part of an OCI descriptor used by the retriever.
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// ociManifest is the part of an OCI image manifest used by the retriever.
+type ociManifest struct {
+	Layers      []ociDescriptor   `json:"layers"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// NewOCIListRetriever creates a new ListRetriever pulling the list published
+// as an OCI artifact, for example with oras, from a container registry, with
+// doer. The release is the tag or digest of the artifact, so a list can be
+// pinned like an image.
+//
+// reference is the repository of the artifact, such as ghcr.io/org/psl, and
+// may end with the tag of the latest release, latest by default, or with the
+// digest of an artifact, such as ghcr.io/org/psl@sha256:.... The release
+// reported for it is the digest of its manifest, which identifies the list
+// even once the tag moves. The org.opencontainers.image.version annotation of
+// the manifest is recorded as the Version of the Provenance. The list is the layer titled
+// public_suffix_list.dat, or the only layer of the artifact. The registry is
+// accessed with HTTPS unless reference starts with http://.
+//
+// Anonymous tokens are requested from registries asking for them, doer can
+// add credentials to the requests for private repositories.
+func NewOCIListRetriever(doer Doer, reference string) ListRetriever {
+	var scheme = "https"
+	if strings.HasPrefix(reference, "http://") {
+		scheme = "http"
+	}
+	reference = strings.TrimPrefix(strings.TrimPrefix(reference, "http://"), "https://")
+
+	var host, name = reference, ""
+	if i := strings.Index(reference, "/"); i >= 0 {
+		host, name = reference[:i], reference[i+1:]
+	}
+
+	var tag = "latest"
+	if i := strings.Index(name, "@"); i >= 0 {
+		// a digest identifies the artifact, a tag preceding it is ignored
+		name, tag = name[:i], name[i+1:]
+		if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+			name = name[:i]
+		}
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+
+	// images of Docker Hub are referenced with an alias of their registry
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+
+	return ociListRetriever{
+		doer:     doer,
+		base:     fmt.Sprintf("%s://%s/v2/%s", scheme, host, name),
+		tag:      tag,
+		scope:    fmt.Sprintf("repository:%s:pull", name),
+		token:    &ociToken{},
+		versions: &ociVersions{byDigest: make(map[string]string)},
+	}
+}
+
+// URL returns the URL of the manifest of the given release.
+func (o ociListRetriever) URL(release string) string {
+	return o.base + "/manifests/" + release
+}
+
+// Version returns the version annotation of the manifest of release, if it
+// was retrieved.
+func (o ociListRetriever) Version(release string) string {
+	o.versions.mu.Lock()
+	defer o.versions.mu.Unlock()
+
+	return o.versions.byDigest[release]
+}
+
+// GetLatestReleaseTag retrieves the manifest of the moving tag and returns its
+// digest.
+func (o ociListRetriever) GetLatestReleaseTag() (string, error) {
+	var _, digest, err = o.manifest(o.tag)
+
+	return digest, err
+}
+
+// GetList retrieves the list of the artifact tagged, or with the digest,
+// release.
+func (o ociListRetriever) GetList(release string) (io.Reader, error) {
+	var manifest, _, err = o.manifest(release)
+	if err != nil {
+		return nil, err
+	}
+
+	var layer *ociDescriptor
+	for i := range manifest.Layers {
+		if manifest.Layers[i].Annotations[ociTitleAnnotation] == listFile {
+			layer = &manifest.Layers[i]
+			break
+		}
+	}
+
+	if layer == nil && len(manifest.Layers) == 1 {
+		layer = &manifest.Layers[0]
+	}
+
+	if layer == nil {
+		return nil, dataError(fmt.Errorf("no %s layer in OCI artifact %s", listFile, release))
+	}
+
+	var blobURL = o.base + "/blobs/" + layer.Digest
+
+	var res *http.Response
+	res, err = o.get(blobURL, "")
+	if err != nil {
+		return nil, networkError(fmt.Errorf("error while retrieving the PSL from OCI artifact %s: %w", release, err))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newStatusError(res, http.MethodGet, blobURL)
+	}
+
+	var buf = &bytes.Buffer{}
+	if _, err := io.Copy(buf, res.Body); err != nil {
+		return nil, networkError(err)
+	}
+
+	var sum = sha256.Sum256(buf.Bytes())
+	if want := "sha256:" + hex.EncodeToString(sum[:]); layer.Digest != want {
+		return nil, dataError(fmt.Errorf("digest mismatch of OCI blob %s: got %s", layer.Digest, want))
+	}
+
+	return buf, nil
+}
+
+// manifest retrieves the manifest of reference, a tag or digest, and returns
+// it with its digest. Its version annotation is recorded, see Version.
+func (o ociListRetriever) manifest(reference string) (ociManifest, string, error) {
+	var manifestURL = o.URL(reference)
+
+	var res, err = o.get(manifestURL, ociManifestTypes)
+	if err != nil {
+		return ociManifest{}, "", networkError(fmt.Errorf("error while retrieving OCI manifest %s: %w", reference, err))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return ociManifest{}, "", newStatusError(res, http.MethodGet, manifestURL)
+	}
+
+	var content []byte
+	content, err = io.ReadAll(res.Body)
+	if err != nil {
+		return ociManifest{}, "", networkError(err)
+	}
+
+	var manifest ociManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return ociManifest{}, "", dataError(fmt.Errorf("error decoding OCI manifest: %w", err))
+	}
+
+	var digest = res.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		var sum = sha256.Sum256(content)
+		digest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	if version := manifest.Annotations[ociVersionAnnotation]; version != "" {
+		o.versions.mu.Lock()
+		o.versions.byDigest[digest] = version
+		o.versions.mu.Unlock()
+	}
+
+	return manifest, digest, nil
+}
+
+// get issues a GET request for url, requesting a bearer token and retrying
+// once if the registry asks for one.
+func (o ociListRetriever) get(url, accept string) (*http.Response, error) {
+	var res, err = o.do(url, accept)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	var challenge = res.Header.Get("WWW-Authenticate")
+	res.Body.Close()
+
+	if err := o.authenticate(challenge); err != nil {
+		return nil, err
+	}
+
+	return o.do(url, accept)
+}
+
+// do issues a GET request for url with the current token.
+func (o ociListRetriever) do(url, accept string) (*http.Response, error) {
+	var req, err = http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	req.Header.Set("User-Agent", defaultUserAgent)
+
+	o.token.mu.Lock()
+	var token = o.token.value
+	o.token.mu.Unlock()
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return o.doer.Do(req)
+}
+
+// authenticate requests an anonymous token from the realm of challenge, the
+// WWW-Authenticate header of a response asking for a bearer token.
+func (o ociListRetriever) authenticate(challenge string) error {
+	var params = parseChallenge(challenge)
+	if params == nil || params["realm"] == "" {
+		return errors.New("unsupported registry authentication: " + challenge)
+	}
+
+	var query = url.Values{"scope": {o.scope}}
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if scope := params["scope"]; scope != "" {
+		query.Set("scope", scope)
+	}
+
+	var tokenURL = params["realm"] + "?" + query.Encode()
+
+	// an expired token isn't sent to the realm
+	o.token.mu.Lock()
+	o.token.value = ""
+	o.token.mu.Unlock()
+
+	var res, err = o.do(tokenURL, "application/json")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return newStatusError(res, http.MethodGet, tokenURL)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return dataError(fmt.Errorf("error decoding registry token: %w", err))
+	}
+
+	o.token.mu.Lock()
+	defer o.token.mu.Unlock()
+
+	o.token.value = body.Token
+	if o.token.value == "" {
+		o.token.value = body.AccessToken
+	}
+
+	return nil
+}
+
+// parseChallenge returns the parameters of a Bearer challenge, such as
+//
+//	Bearer realm="https://ghcr.io/token",service="ghcr.io",scope="repository:org/psl:pull"
+//
+// or nil for other schemes.
+func parseChallenge(challenge string) map[string]string {
+	var scheme, rest, _ = strings.Cut(strings.TrimSpace(challenge), " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil
+	}
+
+	var params = make(map[string]string)
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		params[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+
+	return params
+}
@@ -0,0 +1,117 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_OCIListRetriever(t *testing.T) {
+	var list = "jp\nkobe.jp\n"
+	var sum = sha256.Sum256([]byte(list))
+	var digest = "sha256:" + hex.EncodeToString(sum[:])
+
+	var manifest = fmt.Sprintf(`{
+		"schemaVersion": 2,
+		"annotations": {"org.opencontainers.image.version": "2024.01"},
+		"layers": [{"mediaType": "text/plain", "digest": %q, "annotations": {"org.opencontainers.image.title": "public_suffix_list.dat"}}]
+	}`, digest)
+	var manifestSum = sha256.Sum256([]byte(manifest))
+	var manifestDigest = "sha256:" + hex.EncodeToString(manifestSum[:])
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if scope := r.URL.Query().Get("scope"); scope != "repository:org/psl:pull" {
+				t.Errorf("got: %s, want: %s", scope, "repository:org/psl:pull")
+			}
+			w.Write([]byte(`{"token": "anonymous"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test",scope="repository:org/psl:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/org/psl/manifests/stable", "/v2/org/psl/manifests/" + manifestDigest:
+			w.Write([]byte(manifest))
+		case "/v2/org/psl/blobs/" + digest:
+			w.Write([]byte(list))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	var retriever = NewOCIListRetriever(server.Client(), server.URL+"/org/psl:stable")
+
+	var release, err = retriever.GetLatestReleaseTag()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	// the digest is retrievable even once the tag moves, unlike the version
+	if release != manifestDigest {
+		t.Fatalf("got: %s, want: %s", release, manifestDigest)
+	}
+
+	var l = NewList()
+	if err := l.UpdateWithListRetriever(retriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if suffix, _ := l.PublicSuffix("example.kobe.jp"); suffix != "kobe.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "kobe.jp")
+	}
+
+	var provenance = l.Provenance()
+	if provenance.URL != server.URL+"/v2/org/psl/manifests/"+manifestDigest || provenance.Version != "2024.01" {
+		t.Fatalf("got: %s %s, want: %s %s", provenance.URL, provenance.Version, server.URL+"/v2/org/psl/manifests/"+manifestDigest, "2024.01")
+	}
+
+	// artifacts are pinned by digest, with or without a tag
+	for _, reference := range []string{"/org/psl@" + manifestDigest, "/org/psl:stable@" + manifestDigest} {
+		release, err = NewOCIListRetriever(server.Client(), server.URL+reference).GetLatestReleaseTag()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", reference, err.Error())
+		}
+		if release != manifestDigest {
+			t.Fatalf("%s: got: %s, want: %s", reference, release, manifestDigest)
+		}
+	}
+
+	if _, err := retriever.GetList("missing"); !errors.Is(err, ErrPermanent) {
+		t.Fatalf("got: %v, want: %v", err, ErrPermanent)
+	}
+
+	// a blob not matching its digest is rejected
+	list = "jp\n"
+	if _, err := retriever.GetList(manifestDigest); !errors.Is(err, ErrInvalidData) {
+		t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "fmt"
+
+// Option configures the behaviour of the functions accepting it. Options which
+// don't apply to a function are ignored by it.
+type Option func(*options)
+
+// options holds the configuration set by Options.
+type options struct {
+	allowUnderscores bool
+	icannOnly        bool
+	newEngine        func(rulesInfo) engine
+	minRules         int
+	failClosed       bool
+	checkRules       func(Issue)
+	checkOrder       bool
+	compact          bool
+	limits           Limits
+}
+
+// newOptions applies opts to the default configuration.
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// AllowUnderscores permits labels starting with an underscore, such as
+// "_dmarc" or "_tcp", when normalizing domains. These are common in DNS
+// datasets but rejected by strict hostname validation.
+func AllowUnderscores() Option {
+	return func(o *options) {
+		o.allowUnderscores = true
+	}
+}
+
+// ICANNOnly discards the rules of the private section when loading a list,
+// roughly halving its memory footprint. It is meant for applications, such as
+// certificate authorities, which never want private suffixes to apply. Given
+// to NewList, it also applies to the statically compiled list and to every
+// later load.
+func ICANNOnly() Option {
+	return func(o *options) {
+		o.icannOnly = true
+	}
+}
+
+// MinRules refuses to load lists with fewer than n rules, for example a
+// truncated download or an empty file, which would otherwise make every
+// lookup fall back to the implicit "*" rule. Read and the update functions
+// then return an error matching ErrListTooSmall and keep the current list.
+func MinRules(n int) Option {
+	return func(o *options) {
+		o.minRules = n
+	}
+}
+
+// FailClosed makes the lookups of a list return ErrListTooSmall while the
+// loaded list has fewer rules than set by MinRules, or no rule at all, instead
+// of silently falling back to the implicit "*" rule. It is set on a List
+// created by NewList. The lookups which can't fail report no match instead:
+// PublicSuffix returns an empty suffix, HasPublicSuffix, IsRegistrable and
+// IsExactPublicSuffix false, and the cookie jar adapters treat every domain
+// as a public suffix, so that only host cookies are accepted.
+func FailClosed() Option {
+	return func(o *options) {
+		o.failClosed = true
+	}
+}
+
+// CheckOrder makes LintList report the rules out of order within their group
+// of consecutive lines, as IssueOrder.
+func CheckOrder() Option {
+	return func(o *options) {
+		o.checkOrder = true
+	}
+}
+
+// CheckRules makes the parsing of a list, e.g. by ParseList or the update
+// functions, call warn for its duplicate rules and for its normal rules
+// shadowed by a wildcard rule, e.g. "foo.kobe.jp" with "*.kobe.jp", which
+// have no effect on lookups. These are reported as Issues of kind
+// IssueDuplicate and IssueShadowed, in the order of their lines, before the
+// list is used; the list is loaded regardless. A nil warn logs them with the
+// log package.
+//
+// This is meant for lists merged from overlays, where a rule silently
+// shadowed by another file leads to confusing lookup results.
+func CheckRules(warn func(Issue)) Option {
+	if warn == nil {
+		warn = logIssue
+	}
+
+	return func(o *options) {
+		o.checkRules = warn
+	}
+}
+
+// CompactRules stores the rules of the lists loaded by a List in a few large
+// blocks of memory, rather than in an allocation per rule and string. This
+// reduces the work of the garbage collector for the rules of a list, and the
+// memory of a replaced list is released as a whole once no lookup uses it
+// anymore, at the cost of copying the rules when a list is loaded. It is set
+// on a List created by NewList and is meant for memory sensitive services.
+func CompactRules() Option {
+	return func(o *options) {
+		o.compact = true
+	}
+}
+
+// checkSize returns an error if ri has fewer rules than set by MinRules.
+func (o options) checkSize(ri *rulesInfo) error {
+	if n := ri.size(); n < o.minRules {
+		return dataError(fmt.Errorf("%w: %d rules, want at least %d", ErrListTooSmall, n, o.minRules))
+	}
+
+	return nil
+}
+
+// tooSmall reports whether lookups in ri must fail as set by FailClosed.
+func (o options) tooSmall(ri *rulesInfo) bool {
+	var min = o.minRules
+	if min < 1 {
+		min = 1
+	}
+
+	return o.failClosed && ri.size() < min
+}
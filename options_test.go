@@ -0,0 +1,172 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_ICANNOnly(t *testing.T) {
+	installRulesTestList(t)
+
+	var expected = []string{"!city.kobe.jp", "*.kobe.jp", "jp", "kobe.jp"}
+
+	t.Run("Update with the same release", func(t *testing.T) {
+		installRulesTestList(t)
+
+		var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "rules_test"}
+		if err := UpdateWithListRetriever(mockRetriever, ICANNOnly()); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if got := Suffixes(); !reflect.DeepEqual(got, expected) {
+			t.Fatalf("got: %v, want: %v", got, expected)
+		}
+
+		if !load().ICANNOnly {
+			t.Fatalf("the list should be marked as ICANN only")
+		}
+	})
+}
+
+func Test_NewListICANNOnly(t *testing.T) {
+	var list = NewList(ICANNOnly())
+
+	if suffix, _ := list.PublicSuffix("foo.blogspot.com"); suffix != "com" {
+		t.Fatalf("got: %s, want: %s", suffix, "com")
+	}
+
+	// the embedded list used by other lists must be left untouched
+	if suffix, _ := NewList().PublicSuffix("foo.blogspot.com"); suffix != "blogspot.com" {
+		t.Fatalf("got: %s, want: %s", suffix, "blogspot.com")
+	}
+}
+
+func Test_MinRules(t *testing.T) {
+	installRulesTestList(t)
+
+	var emptyList = mockListRetriever{RawList: bytes.NewBufferString(""), Release: "empty"}
+	var err = UpdateWithListRetriever(emptyList, MinRules(2))
+	if !errors.Is(err, ErrListTooSmall) || !errors.Is(err, ErrInvalidData) {
+		t.Fatalf("got: %v, want: %v", err, ErrListTooSmall)
+	}
+
+	if got := Release(); got != "rules_test" {
+		t.Fatalf("got: %s, want: %s", got, "rules_test")
+	}
+}
+
+func Test_FailClosed(t *testing.T) {
+	var list = NewList(FailClosed())
+	if _, err := list.EffectiveTLDPlusOne("www.example.com"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var emptyList = mockListRetriever{RawList: bytes.NewBufferString(""), Release: "empty"}
+	if err := list.UpdateWithListRetriever(emptyList); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if _, err := list.EffectiveTLDPlusOne("www.example.com"); err != ErrListTooSmall {
+		t.Fatalf("got: %v, want: %v", err, ErrListTooSmall)
+	}
+
+	if _, err := list.Lookup("www.example.com"); err != ErrListTooSmall {
+		t.Fatalf("got: %v, want: %v", err, ErrListTooSmall)
+	}
+
+	// the lookups which can't fail report no match
+	if got, icann := list.PublicSuffix("www.example.com"); got != "" || icann {
+		t.Fatalf("got: %q %v, want: %q %v", got, icann, "", false)
+	}
+	if list.HasPublicSuffix("www.example.com") {
+		t.Fatalf("got: true, want: false")
+	}
+	if list.IsRegistrable("example.com") || list.IsExactPublicSuffix("com") {
+		t.Fatalf("got: true, want: false")
+	}
+
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "rules_test"}
+	if err := list.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got, err := list.EffectiveTLDPlusOne("www.example.jp"); err != nil || got != "example.jp" {
+		t.Fatalf("got: %s (%v), want: %s", got, err, "example.jp")
+	}
+}
+
+func Test_CheckRules(t *testing.T) {
+	var list = `// ===BEGIN ICANN DOMAINS===
+jp
+kobe.jp
+*.kobe.jp
+!city.kobe.jp
+foo.kobe.jp
+a.b.kobe.jp
+jp
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+*.compute.example.jp
+eu.compute.example.jp
+kobe.jp
+// ===END PRIVATE DOMAINS===
+`
+
+	var issues []Issue
+	var l, err = ParseList(strings.NewReader(list), "check_test", CheckRules(func(issue Issue) {
+		issues = append(issues, issue)
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var want = []Issue{
+		{Line: 6, Kind: IssueShadowed, Rule: "foo.kobe.jp"},
+		{Line: 8, Kind: IssueDuplicate, Rule: "jp"},
+		{Line: 12, Kind: IssueShadowed, Rule: "eu.compute.example.jp"},
+		{Line: 13, Kind: IssueDuplicate, Rule: "kobe.jp"},
+	}
+	for i := range issues {
+		if issues[i].Message == "" {
+			t.Fatalf("missing message: %+v", issues[i])
+		}
+		issues[i].Message = ""
+	}
+	if !reflect.DeepEqual(issues, want) {
+		t.Fatalf("got: %+v, want: %+v", issues, want)
+	}
+
+	// the list is loaded regardless
+	if suffix, _ := l.PublicSuffix("www.foo.kobe.jp"); suffix != "foo.kobe.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "foo.kobe.jp")
+	}
+
+	issues = nil
+	if _, err := ParseList(strings.NewReader(rulesTestList), "check_test", CheckRules(func(issue Issue) {
+		issues = append(issues, issue)
+	})); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(issues) != 0 {
+		t.Fatalf("got: %v, want: no issue", issues)
+	}
+}
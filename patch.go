@@ -0,0 +1,130 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "time"
+
+// rulesPatch holds the rules changed by UpdateRules, so that engines can
+// patch the index of the previous rules rather than rebuild it, see
+// ShardedRules.
+type rulesPatch struct {
+	// base is the engine of the patched rules
+	base    engine
+	added   []parsedRule
+	removed []parsedRule
+}
+
+// UpdateRules adds the rules of added to the currently loaded list and
+// removes the rules of removed from it, and uses the result, identified by
+// release, for future lookups. This allows applying overlays, or the changes
+// between two releases, without loading a whole list. Only the Name and the
+// Section of the rules are used, plus the Comment of the added rules. An added
+// rule replaces the rule of the same name and section, removing a rule which
+// isn't in the list has no effect.
+//
+// A *DomainError or an error matching ErrInvalidData is returned for an
+// invalid rule, see ValidateRuleLine, and the current list is then kept. The
+// private rules are ignored by lists loaded with ICANNOnly.
+//
+// The lookup index is rebuilt for the whole list, except for lists with
+// ShardedRules which only rebuild the shards of the changed rules.
+func UpdateRules(release string, added, removed []Rule) error {
+	return defaultList().UpdateRules(release, added, removed)
+}
+
+// UpdateRules changes the rules of l, see the package level UpdateRules.
+func (l *List) UpdateRules(release string, added, removed []Rule) error {
+	return l.loaded(l.updateRules(release, added, removed))
+}
+
+// updateRules changes the rules of l, see UpdateRules.
+func (l *List) updateRules(release string, added, removed []Rule) error {
+	var current = l.load()
+	var patch = &rulesPatch{base: current.engine}
+
+	for _, changes := range []struct {
+		rules  []Rule
+		parsed *[]parsedRule
+	}{{added, &patch.added}, {removed, &patch.removed}} {
+		for _, r := range changes.rules {
+			var icann = r.Section == ICANNSection
+			if current.ICANNOnly && !icann {
+				continue
+			}
+
+			var key, parsed, err = parseRule(r.Name, icann)
+			if err != nil {
+				return err
+			}
+			parsed.Comment = r.Comment
+
+			*changes.parsed = append(*changes.parsed, parsedRule{key: key, rule: parsed})
+		}
+	}
+
+	var ri = rulesInfo{Release: release, Map: make(map[string][]rule, len(current.Map)), ICANNOnly: current.ICANNOnly, Header: current.Header}
+	for key, rules := range current.Map {
+		ri.Map[key] = rules
+	}
+	patch.apply(ri.Map, nil)
+
+	if err := l.options(nil).checkSize(&ri); err != nil {
+		return err
+	}
+
+	ri.patch = patch
+	ri.provenance = &Provenance{Source: SourceUpdatedRules, Release: release, Time: time.Now()}
+
+	l.store(ri)
+
+	return nil
+}
+
+// apply removes and adds the changed rules kept by keep, or all of them if
+// keep is nil, to rules. The slices of rules aren't modified, changed keys get
+// new slices, so rules can be a copy of the map of another list.
+func (p *rulesPatch) apply(rules map[string][]rule, keep func(rule) bool) {
+	var remove = func(change parsedRule) {
+		var list = rules[change.key]
+
+		var kept = make([]rule, 0, len(list))
+		for _, r := range list {
+			if r.DottedName != change.rule.DottedName || r.ICANN != change.rule.ICANN {
+				kept = append(kept, r)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(rules, change.key)
+		} else {
+			rules[change.key] = kept
+		}
+	}
+
+	for _, change := range p.removed {
+		if keep == nil || keep(change.rule) {
+			remove(change)
+		}
+	}
+
+	for _, change := range p.added {
+		if keep == nil || keep(change.rule) {
+			remove(change)
+			rules[change.key] = append(rules[change.key][:len(rules[change.key]):len(rules[change.key])], change.rule)
+		}
+	}
+}
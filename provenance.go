@@ -0,0 +1,123 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"time"
+)
+
+// Sources of a list recorded in its Provenance.
+const (
+	// SourceEmbedded is the statically compiled list.
+	SourceEmbedded = "embedded"
+	// SourceSnapshot is a list loaded by Read.
+	SourceSnapshot = "snapshot"
+	// SourceRetriever is a list retrieved by Update or UpdateWithListRetriever.
+	SourceRetriever = "retriever"
+	// SourceParsed is a list created by ParseList.
+	SourceParsed = "parsed"
+	// SourceUpdatedRules is a list changed by UpdateRules.
+	SourceUpdatedRules = "updated"
+)
+
+// Provenance describes where a list came from.
+type Provenance struct {
+	// Source is one of SourceEmbedded, SourceSnapshot, SourceRetriever,
+	// SourceParsed or SourceUpdatedRules.
+	Source string
+	// Retriever is the type of the ListRetriever the list was retrieved with.
+	Retriever string
+	// URL the list was retrieved from. It is only known if the ListRetriever
+	// has a URL(release string) string method.
+	URL string
+	// Release of the list.
+	Release string
+	// Version is the human readable version of the release, such as the
+	// version annotation of an OCI artifact whose release is a digest. It is
+	// only known if the ListRetriever has a Version(release string) string
+	// method.
+	Version string
+	// Time the list was loaded, zero for the embedded list.
+	Time time.Time
+	// SHA256 is the hex encoded SHA-256 of the raw list or snapshot.
+	SHA256 string
+}
+
+// urlRetriever is implemented by ListRetrievers able to report the URL a
+// release is retrieved from.
+type urlRetriever interface {
+	URL(release string) string
+}
+
+// versionRetriever is implemented by ListRetrievers able to report the version
+// of a release.
+type versionRetriever interface {
+	Version(release string) string
+}
+
+// CurrentProvenance returns the provenance of the currently loaded list.
+func CurrentProvenance() Provenance {
+	return defaultList().Provenance()
+}
+
+// Provenance returns the provenance of the rules currently loaded in l.
+func (l *List) Provenance() Provenance {
+	var provenance = l.load().provenance
+	if provenance == nil {
+		return Provenance{}
+	}
+
+	return *provenance
+}
+
+// newRetrieverProvenance returns the provenance of release retrieved by
+// listRetriever, whose content was written to sum.
+func newRetrieverProvenance(listRetriever ListRetriever, release string, sum hash.Hash) *Provenance {
+	var provenance = &Provenance{
+		Source:    SourceRetriever,
+		Retriever: fmt.Sprintf("%T", listRetriever),
+		Release:   release,
+		Time:      time.Now(),
+		SHA256:    hex.EncodeToString(sum.Sum(nil)),
+	}
+
+	if r, ok := listRetriever.(urlRetriever); ok {
+		provenance.URL = r.URL(release)
+	}
+
+	if r, ok := listRetriever.(versionRetriever); ok {
+		provenance.Version = r.Version(release)
+	}
+
+	return provenance
+}
+
+// newSnapshotProvenance returns the provenance of a snapshot of release.
+func newSnapshotProvenance(release string, snapshot []byte) *Provenance {
+	var sum = sha256.Sum256(snapshot)
+
+	return &Provenance{
+		Source:  SourceSnapshot,
+		Release: release,
+		Time:    time.Now(),
+		SHA256:  hex.EncodeToString(sum[:]),
+	}
+}
@@ -0,0 +1,111 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_Provenance(t *testing.T) {
+	var list = NewList()
+
+	var provenance = list.Provenance()
+	if provenance.Source != SourceEmbedded || provenance.Release != initialRelease {
+		t.Fatalf("got: %+v, want the embedded list", provenance)
+	}
+
+	var content = "ac\ncom.ac\n"
+	var server = newMirror(t, "provenance_test", content)
+	var listRetriever = NewGitHubListRetriever(server.Client(),
+		WithCommitURL(server.URL+"/commits"),
+		WithListURL(server.URL+"/%s/public_suffix_list.dat"),
+	)
+
+	if err := list.UpdateWithListRetriever(listRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var sum = sha256.Sum256([]byte(content))
+	provenance = list.Provenance()
+	switch {
+	case provenance.Source != SourceRetriever,
+		provenance.Retriever != "publicsuffix.gitHubListRetriever",
+		provenance.URL != server.URL+"/provenance_test/public_suffix_list.dat",
+		provenance.Release != "provenance_test",
+		provenance.Time.IsZero(),
+		provenance.SHA256 != hex.EncodeToString(sum[:]):
+		t.Fatalf("unexpected retriever provenance: %+v", provenance)
+	}
+
+	var snapshot bytes.Buffer
+	if err := list.Write(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	sum = sha256.Sum256(snapshot.Bytes())
+
+	if err := list.Read(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	provenance = list.Provenance()
+	switch {
+	case provenance.Source != SourceSnapshot,
+		provenance.Release != "provenance_test",
+		provenance.Time.IsZero(),
+		provenance.SHA256 != hex.EncodeToString(sum[:]):
+		t.Fatalf("unexpected snapshot provenance: %+v", provenance)
+	}
+}
+
+func Test_LastLoadError(t *testing.T) {
+	var l = NewList()
+	var release = l.Release()
+
+	var failure = errors.New("load_test")
+	if err := l.UpdateWithListRetriever(mockListRetriever{Err: failure}); !errors.Is(err, failure) {
+		t.Fatalf("got: %v, want: %v", err, failure)
+	}
+
+	if err := l.Read(strings.NewReader("not a snapshot")); !errors.Is(l.LastLoadError(), ErrInvalidData) || err != l.LastLoadError() {
+		t.Fatalf("got: %v, want: %v", l.LastLoadError(), err)
+	}
+
+	// the compiled list keeps serving lookups
+	if got := l.Release(); got != release {
+		t.Fatalf("got: %s, want: %s", got, release)
+	}
+	if provenance := l.Provenance(); provenance.Source != SourceEmbedded {
+		t.Fatalf("got: %s, want: %s", provenance.Source, SourceEmbedded)
+	}
+
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString("jp\nblogspot.jp\n"), Release: "load_test"}
+	if err := l.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := l.LastLoadError(); err != nil {
+		t.Fatalf("got: %v, want: %v", err, nil)
+	}
+}
@@ -17,7 +17,7 @@ limitations under the License.
 // Package publicsuffix provides functions to query the public suffix list found
 // at:
 //
-// 		https://publicsuffix.org/
+//	https://publicsuffix.org/
 //
 // When first initialised, this library uses a statically compiled list which
 // may be out of date - callers should use Update to attempt to fetch a new
@@ -29,32 +29,67 @@ limitations under the License.
 // caller to write the updated internal list to disk at shutdown and resume
 // using it immediately on the next start.
 //
+// Lookups expect domains in the canonical form used by the list: lower case with
+// internationalised labels Punycode encoded. Normalize converts arbitrary input,
+// such as Unicode hostnames, to this form.
+//
+// The package level functions use a default list. Independent lists, for
+// example one per tenant, can be created with NewList and carried in a context
+// using NewContext.
+//
 // All exported functions are concurrency safe and the internal list uses
 // copy-on-write during updates to avoid blocking queries.
+//
+// Building with TinyGo or the publicsuffix_lite build tag leaves out the
+// network, snapshot and cookiejar support, for deployments which only need
+// lookups. Such builds don't link golang.org/x/net/idna either: they only accept
+// ASCII domains and rules, internationalised names must be Punycode encoded.
 package publicsuffix
 
 import (
 	"bufio"
-	"bytes"
-	"compress/zlib"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
-	"net/http"
-	"regexp"
+	"runtime"
 	"strings"
 	"sync"
 	"sync/atomic"
-
-	"golang.org/x/net/idna"
+	"time"
 )
 
 //go:generate go run gen.go
 
 // rulesInfo contains the map of rules and the commit version that generated them
 type rulesInfo struct {
-	Map     map[string][]rule
-	Release string
+	Map       map[string][]rule
+	Release   string
+	ICANNOnly bool    `json:",omitempty"`
+	Header    *Header `json:",omitempty"`
+
+	// provenance records where the rules came from, it isn't serialised
+	provenance *Provenance
+
+	// engine performs the lookups using the rules
+	engine engine
+
+	// icann performs the lookups ignoring the private rules, it is only set
+	// up when first used
+	icann *lazyEngine
+
+	// warm holds the matches of the domains resolved ahead of time
+	warm map[string]match
+
+	// tooSmall is set when lookups must fail, see FailClosed
+	tooSmall bool
+
+	// limits bounds the domains looked up, see WithLimits
+	limits *ruleLimits
+
+	// patch holds the rules changed since the previous rules while the
+	// engine is created, see UpdateRules
+	patch *rulesPatch
 }
 
 // rule contains the data related to a domain from the PSL
@@ -62,6 +97,16 @@ type rule struct {
 	DottedName string
 	RuleType   ruleType
 	ICANN      bool
+	// Text is the rule as written in the list, only set if it differs from
+	// DottedName, i.e. before the conversion of Unicode names to Punycode
+	Text string `json:",omitempty"`
+	// Line is the line number of the rule in the list, 0 if unknown. It isn't
+	// serialised, to keep snapshots small.
+	Line int `json:"-"`
+	// Comment is the comment block preceding the rule in the list, shared by
+	// the rules following it. It isn't serialised, a block would be copied
+	// for each of its rules.
+	Comment string `json:"-"`
 }
 
 type subdomain struct {
@@ -78,6 +123,20 @@ const (
 	exception
 )
 
+// String returns the name of the rule type as used by ExportJSON.
+func (t ruleType) String() string {
+	switch t {
+	case normal:
+		return "normal"
+	case wildcard:
+		return "wildcard"
+	case exception:
+		return "exception"
+	default:
+		return fmt.Sprintf("ruleType(%d)", int(t))
+	}
+}
+
 // icannBegin marks the beginning of ICANN domains in the public suffix list
 // source file.
 const icannBegin = "BEGIN ICANN DOMAINS"
@@ -87,74 +146,226 @@ const icannBegin = "BEGIN ICANN DOMAINS"
 const icannEnd = "END ICANN DOMAINS"
 
 var (
-	// validSuffixRE is used to check that the entries in the public suffix
-	// list are in canonical form (after Punycode encoding). Specifically,
-	// capital letters are not allowed.
-	validSuffixRE = regexp.MustCompile(`^[a-z0-9_\!\*\-\.]+$`)
+	// defaults holds the list used by the package level functions, see
+	// SetDefault
+	defaults = func() *atomic.Pointer[List] {
+		var defaults atomic.Pointer[List]
+		defaults.Store(&List{})
+		return &defaults
+	}()
+
+	// embeddedRules are the rules compiled in list.go, used to initialise
+	// new lists
+	embeddedRules *rulesInfo
+)
 
+// setEmbedded replaces the statically compiled list with ri, see SetEmbedded.
+func setEmbedded(ri rulesInfo) {
+	ri.provenance = &Provenance{Source: SourceEmbedded, Release: ri.Release}
+	embeddedRules = &ri
+
+	embeddedDate = time.Time{}
+	if ri.Header != nil {
+		embeddedDate = ri.Header.Date
+	}
+
+	// A list loaded explicitly is more relevant than a compiled one.
+	if current := defaultList().rules.Load(); current == nil || current.provenance == nil || current.provenance.Source == SourceEmbedded {
+		defaultList().store(ri)
+	}
+}
+
+// List is a public suffix list which can be queried and updated independently
+// of the default list used by the package level functions, for example to
+// use a differently configured list per tenant.
+//
+// The methods of List behave like the package level functions of the same
+// name. They are concurrency safe and updates use copy-on-write to avoid
+// blocking queries.
+type List struct {
 	// rules caches the PSL from the last commit available
 	// handles read/write concurrency
-	rules atomic.Value
+	rules atomic.Pointer[rulesInfo]
+
+	// opts are applied before the options given to each method
+	opts []Option
+
+	// mu serialises the changes of rules
+	mu sync.Mutex
+
+	// warm are the domains resolved ahead of time, see Warm
+	warm []string
+
+	// canary mirrors lookups to a reference implementation, see SetCanary
+	canary atomic.Pointer[canary]
 
-	// subdomainPool pools subdomain arrays to avoid reallocation cost
-	subdomainPool = sync.Pool{
-		New: func() interface{} {
-			// 5 should cover the average domain
-			return make([]subdomain, 5)
-		},
+	// stats counts the outcomes of lookups, see SetStats
+	stats atomic.Pointer[stats]
+
+	// ageWarning warns about lookups served by an old embedded list, see
+	// SetEmbeddedAgeWarning
+	ageWarning atomic.Pointer[ageWarning]
+
+	// loadErr is the outcome of the last load, see LastLoadError
+	loadErr atomic.Pointer[error]
+
+	// history holds the rules of the releases previously loaded, up to
+	// historySize, see SetHistory
+	history     []historyEntry
+	historySize int
+
+	// audit is the audit trail of the changes of rules, see SetAuditLog
+	audit *auditLog
+}
+
+// NewList returns a new List initialised with the statically compiled list.
+//
+// opts are applied to every load of the list, before the options given to the
+// methods loading it. For example a list created with the ICANNOnly option
+// never contains private rules.
+func NewList(opts ...Option) *List {
+	var l = &List{opts: opts}
+	l.store(l.embedded())
+
+	return l
+}
+
+// embedded returns the statically compiled rules configured for l.
+func (l *List) embedded() rulesInfo {
+	var rules = *embeddedRules
+	if l.options(nil).icannOnly {
+		rules = rules.withoutPrivate()
+	}
+
+	return rules
+}
+
+// ParseList parses r, the content of a public_suffix_list.dat file, into a new
+// List identified by release. Unlike Update, nothing is installed: the list
+// can be validated, compared or staged before being used, for example by
+// writing it with Write and loading it with Read.
+//
+// opts are kept by the list like for NewList.
+func ParseList(r io.Reader, release string, opts ...Option) (*List, error) {
+	var sum = sha256.New()
+
+	var rulesInfo, err = newList(io.TeeReader(r, sum), release, opts...)
+	if err != nil {
+		return nil, err
 	}
-)
 
-func init() {
-	if err := Read(bytes.NewReader(listBytes)); err != nil {
-		panic(fmt.Sprintf("error while initialising Public Suffix List from list.go: %s", err.Error()))
+	rulesInfo.provenance = &Provenance{
+		Source:  SourceParsed,
+		Release: release,
+		Time:    time.Now(),
+		SHA256:  hex.EncodeToString(sum.Sum(nil)),
 	}
 
-	// not used after initialisation, set to nil for garbage collector
-	listBytes = nil
+	var l = &List{opts: opts}
+	l.store(*rulesInfo)
+
+	return l, nil
 }
 
-func load() rulesInfo {
-	return rules.Load().(rulesInfo)
+// load returns the rules in use. They must not be modified, a new rulesInfo
+// is stored instead.
+func (l *List) load() *rulesInfo {
+	if ri := l.rules.Load(); ri != nil {
+		return ri
+	}
+
+	// A List which was never loaded successfully, such as the zero List,
+	// serves the statically compiled rules.
+	var ri = l.embedded()
+	l.prepare(&ri)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if current := l.rules.Load(); current != nil {
+		return current
+	}
+
+	ri.warm = ri.resolve(l.warm)
+	l.rules.Store(&ri)
+
+	return &ri
 }
 
-// Write atomically encodes the currently loaded public suffix list as JSON and compresses and
-// writes it to w.
-func Write(w io.Writer) error {
-	// Wrap w in zlib Writer
-	var zlibWriter = zlib.NewWriter(w)
-	defer zlibWriter.Close()
+// options returns the configuration of l with opts applied.
+func (l *List) options(opts []Option) options {
+	return newOptions(append(l.opts[:len(l.opts):len(l.opts)], opts...))
+}
 
-	// Encode directly into the zlib writer, which in turn writes into w.
-	return json.NewEncoder(zlibWriter).Encode(load())
+// store sets up the lookup engine of ri and uses it for future lookups.
+func (l *List) store(ri rulesInfo) {
+	l.prepare(&ri)
+
+	l.mu.Lock()
+
+	ri.warm = ri.resolve(l.warm)
+	var previous = l.rules.Load()
+	if previous != nil && previous.Release != ri.Release {
+		l.retain(previous)
+	}
+	l.rules.Store(&ri)
+
+	var entry, persist = l.record(previous, &ri)
+
+	l.mu.Unlock()
+
+	if persist != nil {
+		persist(entry)
+	}
 }
 
-// Read loads a public suffix list serialised and compressed by Write and uses it for future
-// lookups.
-func Read(r io.Reader) error {
-	var zlibReader, err = zlib.NewReader(r)
-	if err != nil {
-		return fmt.Errorf("zlib error: %s", err.Error())
+// prepare sets up the lookup engines of ri.
+func (l *List) prepare(ri *rulesInfo) {
+	if l.options(nil).compact {
+		ri.Map = compactRules(ri.Map)
 	}
-	defer zlibReader.Close()
 
-	var tempRulesInfo = rulesInfo{}
-	if err := json.NewDecoder(zlibReader).Decode(&tempRulesInfo); err != nil {
-		return fmt.Errorf("json error: %s", err.Error())
+	var newEngine = l.options(nil).newEngine
+	if newEngine == nil {
+		newEngine = newMapEngine
 	}
 
-	rules.Store(tempRulesInfo)
+	ri.engine = newEngine(*ri)
+	ri.icann = &lazyEngine{newEngine: newEngine}
+	// the previous rules must not be retained
+	ri.patch = nil
+	ri.tooSmall = l.options(nil).tooSmall(ri)
+	ri.limits = l.options(nil).limits.forRules(ri)
+}
+
+// loaded records err, the outcome of an attempt to load a list in l, and
+// returns it.
+func (l *List) loaded(err error) error {
+	l.loadErr.Store(&err)
+
+	return err
+}
+
+// LastLoadError returns the error of the last attempt to load a list with Read
+// or an update function, nil if it succeeded or none was made. A failed load
+// never replaces the current list: lookups keep using the previously loaded
+// list, or the statically compiled one, which CurrentProvenance identifies.
+func LastLoadError() error {
+	return defaultList().LastLoadError()
+}
+
+// LastLoadError returns the error of the last attempt to load a list in l, see
+// the package level LastLoadError.
+func (l *List) LastLoadError() error {
+	if err := l.loadErr.Load(); err != nil {
+		return *err
+	}
 
 	return nil
 }
 
-// Update fetches the latest public suffix list from the official github
-// repository and uses it for future lookups.
-//
-// 		https://github.com/publicsuffix/list
-//
-func Update() error {
-	return UpdateWithListRetriever(gitHubListRetriever{http.DefaultClient})
+func load() *rulesInfo {
+	return defaultList().load()
 }
 
 // UpdateWithListRetriever attempts to update the internal public suffix list
@@ -163,66 +374,147 @@ func Update() error {
 // UpdateWithListRetriever is provided to allow callers to provide custom update
 // sources, such as reading from a network store or local cache instead of
 // fetching from the GitHub repository.
-func UpdateWithListRetriever(listRetriever ListRetriever) error {
+//
+// If listRetriever implements ReleasePoller, it is polled first and the update
+// is skipped when it reports no change.
+//
+// The ICANNOnly option discards the rules of the private section.
+//
+// The current list is kept if the update fails at any point, see
+// LastLoadError.
+func UpdateWithListRetriever(listRetriever ListRetriever, opts ...Option) error {
+	return defaultList().UpdateWithListRetriever(listRetriever, opts...)
+}
+
+// UpdateWithListRetriever attempts to update l using listRetriever as a data
+// source.
+func (l *List) UpdateWithListRetriever(listRetriever ListRetriever, opts ...Option) error {
+	return l.loaded(l.updateWithListRetriever(listRetriever, opts))
+}
+
+// updateWithListRetriever updates l using listRetriever, see
+// UpdateWithListRetriever.
+func (l *List) updateWithListRetriever(listRetriever ListRetriever, opts []Option) error {
+	var rulesInfo, err = l.retrieve(listRetriever, opts, false)
+	if err != nil || rulesInfo == nil {
+		return err
+	}
+
+	l.store(*rulesInfo)
+
+	return nil
+}
+
+// retrieve retrieves and parses the latest release of the list with
+// listRetriever, with the options of l and opts, as UpdateWithListRetriever
+// installs it. Unless force is set, nil is returned if l is up to date.
+func (l *List) retrieve(listRetriever ListRetriever, opts []Option, force bool) (*rulesInfo, error) {
+	var o = l.options(opts)
+
+	// A list loaded with different options must be replaced even if the
+	// release didn't change.
+	var current = l.load()
+	var upToDate = func(release string) bool {
+		return !force && current.Release == release && current.ICANNOnly == o.icannOnly
+	}
+
+	if poller, ok := listRetriever.(ReleasePoller); ok && upToDate(current.Release) {
+		if changed, err := poller.Changed(current.Release); err == nil && !changed {
+			return nil, nil
+		}
+	}
+
 	var latestTag, err = listRetriever.GetLatestReleaseTag()
 	if err != nil {
-		return fmt.Errorf("error while retrieving last commit information: %s", err.Error())
+		return nil, fmt.Errorf("error while retrieving last commit information: %w", err)
 	}
 
-	if load().Release == latestTag {
-		return nil
+	if upToDate(latestTag) {
+		return nil, nil
 	}
 
 	var rawList io.Reader
 	rawList, err = listRetriever.GetList(latestTag)
 	if err != nil {
-		return fmt.Errorf("error while retrieving Public Suffix List last release (%s): %s", latestTag, err.Error())
+		return nil, fmt.Errorf("error while retrieving Public Suffix List last release (%s): %w", latestTag, err)
 	}
 
+	var sum = sha256.New()
+
 	var rulesInfo *rulesInfo
-	rulesInfo, err = newList(rawList, latestTag)
+	rulesInfo, err = newList(io.TeeReader(rawList, sum), latestTag, append(l.opts[:len(l.opts):len(l.opts)], opts...)...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rules.Store(*rulesInfo)
+	if err := o.checkSize(rulesInfo); err != nil {
+		return nil, err
+	}
 
-	return nil
+	rulesInfo.provenance = newRetrieverProvenance(listRetriever, latestTag, sum)
+
+	return rulesInfo, nil
 }
 
 // HasPublicSuffix returns true if the TLD of domain is in the public suffix
 // list.
 func HasPublicSuffix(domain string) bool {
-	var _, _, found = searchList(domain)
+	return defaultList().HasPublicSuffix(domain)
+}
+
+// HasPublicSuffix returns true if the TLD of domain is in l.
+func (l *List) HasPublicSuffix(domain string) bool {
+	var ri = l.load()
+	if ri.tooSmall {
+		return false
+	}
+
+	var _, _, found = ri.search(domain)
 
 	return found
 }
 
 // PublicSuffix returns the public suffix of the domain using a copy of the
-// internal public suffix list.
+// internal public suffix list. An empty suffix is returned for domains
+// exceeding the lengths allowed by RFC 1035.
 //
 // The returned bool is true when the public suffix is managed by the Internet
 // Corporation for Assigned Names and Numbers. If false, the public suffix is
 // privately managed. For example, foo.org and foo.co.uk are ICANN domains,
 // foo.dyndns.org and foo.blogspot.co.uk are private domains.
 func PublicSuffix(domain string) (string, bool) {
-	var publicsuffix, icann, _ = searchList(domain)
+	return defaultList().PublicSuffix(domain)
+}
 
-	return publicsuffix, icann
+// PublicSuffix returns the public suffix of the domain using l.
+func (l *List) PublicSuffix(domain string) (string, bool) {
+	return l.PublicSuffixWithBuffer(domain, nil)
 }
 
 // EffectiveTLDPlusOne returns the effective top level domain plus one more
 // label. For example, the eTLD+1 for "foo.bar.golang.org" is "golang.org".
+//
+// A *DomainError is returned for domains exceeding the lengths allowed by
+// RFC 1035, or the limits set by WithLimits.
 func EffectiveTLDPlusOne(domain string) (string, error) {
-	var suffix, _ = PublicSuffix(domain)
+	return defaultList().EffectiveTLDPlusOne(domain)
+}
 
+// EffectiveTLDPlusOne returns the effective top level domain plus one more
+// label using l.
+func (l *List) EffectiveTLDPlusOne(domain string) (string, error) {
+	return l.EffectiveTLDPlusOneWithBuffer(domain, nil)
+}
+
+// registeredDomain returns suffix plus one more label of domain.
+func registeredDomain(domain, suffix string) (string, error) {
 	if len(domain) <= len(suffix) {
-		return "", fmt.Errorf("publicsuffix: cannot derive eTLD+1 for domain %q", domain)
+		return "", noRegisteredDomain(fmt.Errorf("publicsuffix: cannot derive eTLD+1 for domain %q", domain))
 	}
 
 	var i = len(domain) - len(suffix) - 1
 	if domain[i] != '.' {
-		return "", fmt.Errorf("publicsuffix: invalid public suffix %q for domain %q", suffix, domain)
+		return "", noRegisteredDomain(fmt.Errorf("publicsuffix: invalid public suffix %q for domain %q", suffix, domain))
 	}
 
 	return domain[1+strings.LastIndex(domain[:i], "."):], nil
@@ -230,141 +522,300 @@ func EffectiveTLDPlusOne(domain string) (string, error) {
 
 // Release returns the release of the current internal public suffix list.
 func Release() string {
-	return load().Release
+	return defaultList().Release()
+}
+
+// Release returns the release of l.
+func (l *List) Release() string {
+	return l.load().Release
 }
 
-// searchList looks for the given domain in the Public Suffix List and returns
-// the suffix, a flag indicating if it's managed by the Internet Corporation,
-// and a flag indicating if it was found in the list
+// searchList looks for the given domain in the default list, see
+// rulesInfo.search.
 func searchList(domain string) (string, bool, bool) {
-	// If the domain ends on a dot the subdomains can't be obtained - no PSL applicable
-	if strings.LastIndex(domain, ".") == len(domain)-1 {
-		return "", false, false
-	}
+	return load().search(domain)
+}
+
+// search looks for the given domain using the engine of ri and returns the
+// suffix, a flag indicating if it's managed by the Internet Corporation, and a
+// flag indicating if it was found in the list
+func (ri *rulesInfo) search(domain string) (string, bool, bool) {
+	var m = ri.lookup(domain)
+
+	return m.suffix, m.icann, m.found
+}
+
+// parseChunkSize is the number of lines of the list parsed by each task of
+// newList.
+const parseChunkSize = 1024
+
+// rawRule is a line of the list to be parsed.
+type rawRule struct {
+	line    string
+	icann   bool
+	number  int
+	comment string
+}
+
+// parsedRule is a rule parsed from a rawRule, with the key it is stored under.
+type parsedRule struct {
+	key  string
+	rule rule
+}
 
-	var buffer = subdomainPool.Get().([]subdomain)[:0]
-	var subdomains = decomposeDomain(domain, buffer)
-	defer subdomainPool.Put(subdomains)
+// newList reads and parses r to create a new rulesInfo identified by release.
+//
+// The lines are converted to ASCII and validated by a pool of workers, one per
+// CPU, as it dominates the parsing time. The rules are then merged in their
+// order in the list, which matters when several share the same key.
+func newList(r io.Reader, release string, opts ...Option) (*rulesInfo, error) {
+	var o = newOptions(opts)
+	var icann = false
+	var scanner = bufio.NewScanner(r)
+	var rawRules []rawRule
+	var header Header
+	var inHeader = true
 
-	var rulesInfo = load()
+	// comment is the last comment block, shared by the rules following it, and
+	// inComment is set while reading it
+	var comment string
+	var inComment bool
+	var number int
+
+	for scanner.Scan() {
+		var line = strings.TrimSpace(scanner.Text())
+		number++
 
-	// the longest matching rule (the one with the most levels) will be used
-	for _, sub := range subdomains {
-		var rules, found = rulesInfo.Map[sub.name]
-		if !found {
+		if inHeader && strings.HasPrefix(line, "//") && !strings.Contains(line, icannBegin) {
+			header.parseLine(line)
 			continue
 		}
+		inHeader = inHeader && line == ""
 
-		// Look for all the rules matching the concatenated name
-		for _, rule := range rules {
-			switch rule.RuleType {
-			case wildcard:
-				// first check if the rule is contained within the domain without the *.
-				if !strings.HasSuffix(sub.dottedName, rule.DottedName[2:]) {
-					continue
-				}
+		if strings.Contains(line, icannBegin) {
+			icann = true
+			comment, inComment = "", false
+			continue
+		}
 
-				if len(domain) < len(rule.DottedName) {
-					// Handle corner case where the domain doesn't have a left side and a wildcard rule matches,
-					// i.e ".ck" with rule "*.ck" must return .ck as per golang implementation
-					if domain[0] == '.' && strings.Compare(domain, rule.DottedName[1:]) == 0 {
-						return domain, rule.ICANN, true
-					}
+		if strings.Contains(line, icannEnd) {
+			icann = false
+			comment, inComment = "", false
+			continue
+		}
 
-					continue
-				}
+		// markers of the sections don't describe the following rules
+		if strings.Contains(line, "===BEGIN ") || strings.Contains(line, "===END ") {
+			comment, inComment = "", false
+			continue
+		}
 
-				var nbLevels = strings.Count(rule.DottedName, ".") + 1
-				var dot = len(domain) - 1
+		if strings.HasPrefix(line, "//") {
+			var text = strings.TrimSpace(strings.TrimPrefix(line, "//"))
+			if inComment {
+				comment += "\n" + text
+			} else {
+				comment, inComment = text, true
+			}
+			continue
+		}
+		inComment = false
 
-				for i := 0; i < nbLevels && dot != -1; i++ {
-					dot = strings.LastIndex(domain[:dot], ".")
-				}
+		if line == "" || (o.icannOnly && !icann) {
+			continue
+		}
 
-				return domain[dot+1:], rule.ICANN, true
+		rawRules = append(rawRules, rawRule{line: line, icann: icann, number: number, comment: comment})
+	}
 
-			case exception:
-				// first check if the rule is contained within the domain without !
-				if !strings.HasSuffix(sub.dottedName, rule.DottedName[1:]) {
-					continue
-				}
+	var chunks = make([][]parsedRule, (len(rawRules)+parseChunkSize-1)/parseChunkSize)
+	var errs = make([]error, len(chunks))
+	var tasks = make(chan int)
 
-				var dot = strings.Index(rule.DottedName, ".")
+	var workers = runtime.GOMAXPROCS(0)
+	if workers > len(chunks) {
+		workers = len(chunks)
+	}
 
-				return rule.DottedName[dot+1:], rule.ICANN, true
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range tasks {
+				chunks[chunk], errs[chunk] = parseRules(rawRules[chunk*parseChunkSize:], parseChunkSize)
+			}
+		}()
+	}
 
-			default:
-				// first check if the rule is contained within the domain
-				if !strings.HasSuffix(sub.dottedName, rule.DottedName) {
-					continue
-				}
+	for chunk := range chunks {
+		tasks <- chunk
+	}
+	close(tasks)
+	wg.Wait()
 
-				return rule.DottedName, rule.ICANN, true
-			}
+	var tempRulesMap = make(map[string][]rule)
+	for chunk, parsedRules := range chunks {
+		// report the first error of the list, as a sequential parse would
+		if errs[chunk] != nil {
+			return nil, errs[chunk]
+		}
+
+		for _, parsed := range parsedRules {
+			tempRulesMap[parsed.key] = append(tempRulesMap[parsed.key], parsed.rule)
 		}
 	}
 
-	// If no rules match, the prevailing rule is "*".
-	var dot = strings.LastIndex(domain, ".")
+	var tempRulesInfo = rulesInfo{Release: release, Map: tempRulesMap, ICANNOnly: o.icannOnly}
+	if header != (Header{}) {
+		tempRulesInfo.Header = &header
+	}
 
-	return domain[dot+1:], false, false
-}
+	if o.checkRules != nil {
+		checkRules(&tempRulesInfo, o.checkRules)
+	}
 
-// newList reads and parses r to create a new rulesInfo identified by release.
-func newList(r io.Reader, release string) (*rulesInfo, error) {
-	var icann = false
-	var scanner = bufio.NewScanner(r)
-	var tempRulesMap = make(map[string][]rule)
-	var mapKey string
+	return &tempRulesInfo, nil
+}
 
-	for scanner.Scan() {
-		var line = strings.TrimSpace(scanner.Text())
+// parseRules parses up to n rawRules, stopping at the first error.
+func parseRules(rawRules []rawRule, n int) ([]parsedRule, error) {
+	if len(rawRules) > n {
+		rawRules = rawRules[:n]
+	}
 
-		if strings.Contains(line, icannBegin) {
-			icann = true
-			continue
+	var parsedRules = make([]parsedRule, 0, len(rawRules))
+	for _, raw := range rawRules {
+		var key, rule, err = parseRule(raw.line, raw.icann)
+		if err != nil {
+			return nil, err
 		}
+		rule.Line, rule.Comment = raw.number, raw.comment
 
-		if strings.Contains(line, icannEnd) {
-			icann = false
-			continue
-		}
+		parsedRules = append(parsedRules, parsedRule{key: key, rule: rule})
+	}
 
-		if line == "" || strings.HasPrefix(line, "//") {
-			continue
+	return parsedRules, nil
+}
+
+// validSuffix reports whether an entry of the public suffix list is in
+// canonical form (after Punycode encoding), i.e. only made of the characters
+// [a-z0-9_!*-.]. Specifically, capital letters are not allowed.
+func validSuffix(line string) bool {
+	if line == "" {
+		return false
+	}
+
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+		case c == '_', c == '!', c == '*', c == '-', c == '.':
+		default:
+			return false
 		}
+	}
+
+	return true
+}
+
+// parseRule parses a line of the list, returning the rule and the key it is
+// stored under.
+func parseRule(line string, icann bool) (string, rule, error) {
+	var text = line
 
+	// Lines already in ASCII form, such as every line of the lists
+	// pre-processed by cmd/genlist, don't need to go through IDNA.
+	if !validSuffix(line) {
 		var err error
-		line, err = idna.ToASCII(line)
+		line, err = toASCII(line)
 		if err != nil {
-			return nil, fmt.Errorf("error while converting to ASCII %s: %s", line, err.Error())
+			return "", rule{}, dataError(fmt.Errorf("error while converting to ASCII %s: %w", line, err))
 		}
+	}
 
-		if !validSuffixRE.MatchString(line) {
-			return nil, fmt.Errorf("bad publicsuffix.org list data: %q", line)
+	if !validSuffix(line) {
+		return "", rule{}, dataError(fmt.Errorf("bad publicsuffix.org list data: %q", line))
+	}
+
+	// wildcards stand for whole labels, exceptions are only marked at the
+	// start and the implicit "*" rule can't be listed
+	for _, label := range strings.Split(strings.TrimPrefix(line, "!"), ".") {
+		if line == "*" || (label != "*" && strings.ContainsAny(label, "*!")) {
+			return "", rule{}, dataError(fmt.Errorf("bad publicsuffix.org list data: %q", line))
 		}
+	}
 
-		var rule = rule{ICANN: icann, DottedName: line}
-		var concatenatedLine = strings.Replace(line, ".", "", -1)
+	var rule = rule{ICANN: icann, DottedName: line}
+	if text != line {
+		rule.Text = text
+	}
 
-		switch {
-		case strings.HasPrefix(concatenatedLine, "*"):
-			rule.RuleType = wildcard
-			mapKey = concatenatedLine[1:]
-		case strings.HasPrefix(concatenatedLine, "!"):
-			rule.RuleType = exception
-			mapKey = concatenatedLine[1:]
-		default:
-			rule.RuleType = normal
-			mapKey = concatenatedLine
+	switch {
+	case strings.HasPrefix(line, "*"):
+		rule.RuleType = wildcard
+	case strings.HasPrefix(line, "!"):
+		rule.RuleType = exception
+	default:
+		rule.RuleType = normal
+	}
+
+	return rule.key(), rule, nil
+}
+
+// key returns the key r is stored under: its name without dots, nor the
+// leading "*" of wildcard rules or "!" of exception rules.
+func (r rule) key() string {
+	var key = strings.Replace(r.DottedName, ".", "", -1)
+	if r.RuleType != normal {
+		key = key[1:]
+	}
+
+	return key
+}
+
+// patterned reports whether r has wildcards other than a single leftmost "*"
+// label, such as "*.*.example" or "foo.*.example". The key of such rules never
+// matches a domain, they are matched label by label instead.
+func (r rule) patterned() bool {
+	var name = strings.TrimPrefix(r.DottedName, "!")
+	if r.RuleType == wildcard {
+		name = strings.TrimPrefix(name, "*.")
+	}
+
+	return strings.Contains(name, "*")
+}
+
+// size returns the number of rules of ri.
+func (ri *rulesInfo) size() int {
+	var n int
+	for _, rules := range ri.Map {
+		n += len(rules)
+	}
+
+	return n
+}
+
+// withoutPrivate returns a copy of ri without the rules of the private
+// section.
+func (ri rulesInfo) withoutPrivate() rulesInfo {
+	var icannMap = make(map[string][]rule, len(ri.Map))
+	for key, rules := range ri.Map {
+		var icannRules []rule
+		for _, rule := range rules {
+			if rule.ICANN {
+				icannRules = append(icannRules, rule)
+			}
 		}
 
-		tempRulesMap[mapKey] = append(tempRulesMap[mapKey], rule)
+		if len(icannRules) > 0 {
+			icannMap[key] = icannRules
+		}
 	}
 
-	var tempRulesInfo = rulesInfo{Release: release, Map: tempRulesMap}
+	ri.Map = icannMap
+	ri.ICANNOnly = true
 
-	return &tempRulesInfo, nil
+	return ri
 }
 
 // decomposeDomain breaks domain down into a slice of labels.
@@ -1,3 +1,6 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
 /*
 Copyright 2018 GMO GlobalSign Ltd
 
@@ -18,6 +21,8 @@ package publicsuffix
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"reflect"
 	"strconv"
@@ -419,7 +424,10 @@ func Test_Write(t *testing.T) {
 	}
 
 	var expected = "x\x9c\xaaV\xf2M,P\xb2\xaaVJLV\xb2\x8a\xaeVr\xc9/)IM\xf1K\xccMU\xb2\x02\x89\xe9(\x05\x95椆T\x16\xa4*Y\x19\xe8(y:;\xfa\xf9)Y\xa5%\xe6\x14\xa7\xd6\xc6\xea(%\xe7\xe7bӘ\x9c\x9f\xabGHs\xad\x8eRPjNjb1HCyQfIj|Ijq\x89R-\x17 \x00\x00\xff\xff\x9a;/\x86"
-	if strings.Compare(bytes.String(), expected) != 0 {
+	// Newer releases of compress/flate end the stream without an empty stored
+	// block, the content is the same.
+	var expectedFinal = "x\x9c\xaaV\xf2M,P\xb2\xaaVJLV\xb2\x8a\xaeVr\xc9/)IM\xf1K\xccMU\xb2\x02\x89\xe9(\x05\x95椆T\x16\xa4*Y\x19\xe8(y:;\xfa\xf9)Y\xa5%\xe6\x14\xa7\xd6\xc6\xea(%\xe7\xe7bӘ\x9c\x9f\xabGHs\xad\x8eRPjNjb1HCyQfIj|Ijq\x89R-\x17`\x00\x9a;/\x86"
+	if strings.Compare(bytes.String(), expected) != 0 && strings.Compare(bytes.String(), expectedFinal) != 0 {
 		t.Fatalf("got: %#v, want: %#v", bytes.String(), expected)
 	}
 
@@ -496,9 +504,13 @@ org.ac
 
 		var _, err = newList(&input, testRelease)
 
-		if !reflect.DeepEqual(err, expectedErr) {
+		if err == nil || err.Error() != expectedErr.Error() {
 			t.Fatalf("got: %v, want: %v", err, expectedErr)
 		}
+
+		if !errors.Is(err, ErrInvalidData) {
+			t.Fatalf("got: %v, want an ErrInvalidData error", err)
+		}
 	})
 
 	t.Run("Rule type checks", func(t *testing.T) {
@@ -539,6 +551,144 @@ org.ac
 			}
 		}
 	})
+
+	t.Run("Large list", func(t *testing.T) {
+		var expected = load()
+
+		var rulesInfo, err = newList(strings.NewReader(rawList(expected)), testRelease)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		// the embedded rules don't record their position in the list
+		for _, rules := range rulesInfo.Map {
+			for i := range rules {
+				rules[i].Line = 0
+			}
+		}
+
+		if !reflect.DeepEqual(rulesInfo.Map, expected.Map) {
+			t.Fatalf("the parsed rules differ from the loaded ones")
+		}
+	})
+
+	t.Run("Error in a large list", func(t *testing.T) {
+		var input strings.Builder
+		for i := 0; i < 5*parseChunkSize; i++ {
+			switch i {
+			case 3*parseChunkSize + 10:
+				input.WriteString("FIRST\n")
+			case 4*parseChunkSize + 10:
+				input.WriteString("SECOND\n")
+			default:
+				input.WriteString("example" + strconv.Itoa(i) + "\n")
+			}
+		}
+
+		var _, err = newList(strings.NewReader(input.String()), testRelease)
+		if err == nil || err.Error() != `bad publicsuffix.org list data: "FIRST"` {
+			t.Fatalf("got: %v, want: %s", err, `bad publicsuffix.org list data: "FIRST"`)
+		}
+	})
+}
+
+// rawList formats the rules of ri in the format of the public suffix list,
+// preserving the order of the rules sharing a key.
+func rawList(ri *rulesInfo) string {
+	var raw strings.Builder
+	for _, rules := range ri.Map {
+		for _, rule := range rules {
+			if rule.ICANN {
+				raw.WriteString(icannBegin + "\n" + rule.DottedName + "\n" + icannEnd + "\n")
+			} else {
+				raw.WriteString(rule.DottedName + "\n")
+			}
+		}
+	}
+
+	return raw.String()
+}
+
+func Test_ParseList(t *testing.T) {
+	var list, err = ParseList(strings.NewReader(rulesTestList), "parse_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if release := list.Release(); release != "parse_test" {
+		t.Fatalf("got: %s, want: %s", release, "parse_test")
+	}
+
+	if suffix, _ := list.PublicSuffix("foo.blogspot.jp"); suffix != "blogspot.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "blogspot.jp")
+	}
+
+	var sum = sha256.Sum256([]byte(rulesTestList))
+	if provenance := list.Provenance(); provenance.Source != SourceParsed || provenance.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Fatalf("unexpected provenance: %+v", provenance)
+	}
+
+	// the default list is left untouched
+	if release := Release(); release == "parse_test" {
+		t.Fatalf("the parsed list was installed")
+	}
+
+	_, err = ParseList(strings.NewReader("COM"), "parse_test")
+	if !errors.Is(err, ErrInvalidData) {
+		t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+	}
+}
+
+func Test_ValidSuffix(t *testing.T) {
+	var tests = []struct {
+		input    string
+		expected bool
+	}{
+		{"com", true},
+		{"co.uk", true},
+		{"*.kawasaki.jp", true},
+		{"!city.kawasaki.jp", true},
+		{"xn--p1ai", true},
+		{"a_b.example", true},
+		{"", false},
+		{"COM", false},
+		{"exa mple.com", false},
+		{"example/com", false},
+		{"été.fr", false},
+	}
+
+	for _, tt := range tests {
+		if valid := validSuffix(tt.input); valid != tt.expected {
+			t.Fatalf("%q got: %v, want: %v", tt.input, valid, tt.expected)
+		}
+	}
+}
+
+func Test_ParseRuleASCII(t *testing.T) {
+	var tests = []struct {
+		input string
+		name  string
+		text  string
+	}{
+		{"xn--p1ai", "xn--p1ai", ""},
+		{"рф", "xn--p1ai", "рф"},
+		{"*.xn--p1ai", "*.xn--p1ai", ""},
+	}
+
+	for _, tt := range tests {
+		if unsupportedIDN(tt.input) {
+			continue
+		}
+
+		var _, rule, err = parseRule(tt.input, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if rule.DottedName != tt.name || rule.Text != tt.text {
+			t.Fatalf("got: %q %q, want: %q %q", rule.DottedName, rule.Text, tt.name, tt.text)
+		}
+	}
 }
 
 func Test_DecomposeDomain(t *testing.T) {
@@ -552,8 +702,7 @@ func Test_DecomposeDomain(t *testing.T) {
 		{"aaa.xn--p1ai", []subdomain{{"aaaxn--p1ai", "aaa.xn--p1ai"}, {"xn--p1ai", "xn--p1ai"}}},
 	}
 
-	var subdomains = subdomainPool.Get().([]subdomain)[:0]
-	defer subdomainPool.Put(subdomains)
+	var subdomains = make([]subdomain, 0, 5)
 
 	for _, tt := range tests {
 		var tt = tt
@@ -624,6 +773,18 @@ func BenchmarkPublicSuffix4(b *testing.B) { benchmarkPublicSuffix("www.example.t
 func BenchmarkPublicSuffix5(b *testing.B) { benchmarkPublicSuffix("bar.foo.nosuchtld", b) }        // not present in the rules
 func BenchmarkPublicSuffix6(b *testing.B) { benchmarkPublicSuffix("example.sch.uk", b) }           // wildcard rule
 func BenchmarkPublicSuffix7(b *testing.B) { benchmarkPublicSuffix("example.city.kawasaki.jp", b) } // exception rule
+func BenchmarkPublicSuffix8(b *testing.B) { benchmarkPublicSuffix("www.example.com", b) }          // hot TLD
+
+func BenchmarkNewList(b *testing.B) {
+	var raw = rawList(load())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := newList(strings.NewReader(raw), "benchmark"); err != nil {
+			b.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+}
 
 // weppos
 func benchmarkPublicSuffixWeppos(domain string, b *testing.B) {
@@ -0,0 +1,95 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package publicsuffixtest provides helpers for testing code using the
+// publicsuffix package, such as generators of realistic domains for property
+// tests and load generators.
+package publicsuffixtest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+// maxAttempts is the number of domains RandomRegistrableDomain generates
+// before giving up, in case random labels keep matching other rules.
+const maxAttempts = 100
+
+// labelChars are the characters of the labels generated, a hyphen is never
+// the first or last character of a label.
+const labelChars = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+// RandomRegistrableDomain returns a random domain registered directly under
+// suffix according to l, or the default list if l is nil, such as
+// "k3x-9a.co.uk" for "co.uk". Its public suffix is suffix and it is its own
+// eTLD+1. Under a wildcard rule a label is added below suffix, such as
+// "k3x-9a.q0b.ck" for "ck", as registrations occur under any label there,
+// see publicsuffix.RegistrationLevel; random labels matching another rule,
+// such as an exception, are avoided.
+//
+// The labels are drawn from rng, or from the global source of math/rand if
+// nil, so a seeded rng gives reproducible domains. An error matching
+// publicsuffix.ErrNotPublicSuffix is returned if suffix isn't a public suffix.
+func RandomRegistrableDomain(rng *rand.Rand, l *publicsuffix.List, suffix string) (string, error) {
+	var registrationLevel, effectiveTLDPlusOne = publicsuffix.RegistrationLevel, publicsuffix.EffectiveTLDPlusOne
+	if l != nil {
+		registrationLevel, effectiveTLDPlusOne = l.RegistrationLevel, l.EffectiveTLDPlusOne
+	}
+
+	var level, err = registrationLevel(suffix)
+	if err != nil {
+		return "", err
+	}
+
+	var labels = level - strings.Count(suffix, ".") - 1
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		var domain = suffix
+		for i := 0; i < labels; i++ {
+			domain = randomLabel(rng) + "." + domain
+		}
+
+		// a random label may still match a rule, such as an exception
+		if etldPlusOne, err := effectiveTLDPlusOne(domain); err == nil && etldPlusOne == domain {
+			return domain, nil
+		}
+	}
+
+	return "", fmt.Errorf("publicsuffixtest: no registrable domain found under %q", suffix)
+}
+
+// randomLabel returns a label of 3 to 12 characters drawn from rng.
+func randomLabel(rng *rand.Rand) string {
+	var intn = rand.Intn
+	if rng != nil {
+		intn = rng.Intn
+	}
+
+	var label = make([]byte, 3+intn(10))
+	for i := range label {
+		var chars = labelChars
+		if i == 0 || i == len(label)-1 {
+			chars = labelChars[:len(labelChars)-1]
+		}
+
+		label[i] = chars[intn(len(chars))]
+	}
+
+	return string(label)
+}
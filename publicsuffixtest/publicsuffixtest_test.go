@@ -0,0 +1,74 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffixtest
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/globalsign/publicsuffix"
+)
+
+func Test_RandomRegistrableDomain(t *testing.T) {
+	var list, err = publicsuffix.ParseList(bytes.NewBufferString("uk\nco.uk\n*.ck\n!www.ck\njp\n*.kobe.jp\n!city.kobe.jp\n"), "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var rng = rand.New(rand.NewSource(1))
+
+	var tests = []struct {
+		suffix string
+		labels int
+	}{
+		{"co.uk", 3},
+		{"ck", 3},
+		{"kobe.jp", 4},
+		{"nosuchtld", 2},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			var domain, err = RandomRegistrableDomain(rng, list, tt.suffix)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", tt.suffix, err.Error())
+			}
+
+			if !strings.HasSuffix(domain, "."+tt.suffix) || strings.Count(domain, ".")+1 != tt.labels {
+				t.Fatalf("%s: unexpected domain %q", tt.suffix, domain)
+			}
+
+			if etldPlusOne, err := list.EffectiveTLDPlusOne(domain); err != nil || etldPlusOne != domain {
+				t.Fatalf("%s: got: %s %v, want: %s", tt.suffix, etldPlusOne, err, domain)
+			}
+		}
+	}
+
+	// seeded sources give reproducible domains
+	var first, _ = RandomRegistrableDomain(rand.New(rand.NewSource(2)), nil, "co.uk")
+	var second, _ = RandomRegistrableDomain(rand.New(rand.NewSource(2)), nil, "co.uk")
+	if first == "" || first != second {
+		t.Fatalf("got: %s, want: %s", second, first)
+	}
+
+	if _, err := RandomRegistrableDomain(rng, list, "example.co.uk"); !errors.Is(err, publicsuffix.ErrNotPublicSuffix) {
+		t.Fatalf("got: %v, want: %v", err, publicsuffix.ErrNotPublicSuffix)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"sort"
+	"sync"
+)
+
+// registry holds the lists registered by name.
+var registry = struct {
+	sync.RWMutex
+	lists map[string]*List
+}{lists: make(map[string]*List)}
+
+// Register makes l available under name, so components of an application can
+// share differently configured lists without global variables of their own.
+// A list previously registered under name is replaced.
+func Register(name string, l *List) {
+	if l == nil {
+		panic("publicsuffix: Register list is nil")
+	}
+
+	registry.Lock()
+	registry.lists[name] = l
+	registry.Unlock()
+}
+
+// Unregister removes the list registered under name, if any.
+func Unregister(name string) {
+	registry.Lock()
+	delete(registry.lists, name)
+	registry.Unlock()
+}
+
+// Get returns the list registered under name.
+func Get(name string) (*List, bool) {
+	registry.RLock()
+	var l, found = registry.lists[name]
+	registry.RUnlock()
+
+	return l, found
+}
+
+// Registered returns the sorted names of the registered lists.
+func Registered() []string {
+	registry.RLock()
+	var names = make([]string, 0, len(registry.lists))
+	for name := range registry.lists {
+		names = append(names, name)
+	}
+	registry.RUnlock()
+
+	sort.Strings(names)
+
+	return names
+}
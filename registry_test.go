@@ -0,0 +1,51 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_Registry(t *testing.T) {
+	var strict, full = NewList(), NewList()
+
+	Register("strict-icann", strict)
+	Register("full", full)
+	defer Unregister("strict-icann")
+	defer Unregister("full")
+
+	if l, found := Get("strict-icann"); !found || l != strict {
+		t.Fatalf("got: %p %v, want: %p true", l, found, strict)
+	}
+
+	if got := Registered(); !reflect.DeepEqual(got, []string{"full", "strict-icann"}) {
+		t.Fatalf("got: %v, want: %v", got, []string{"full", "strict-icann"})
+	}
+
+	// Registering again replaces the list.
+	var replacement = NewList()
+	Register("full", replacement)
+	if l, _ := Get("full"); l != replacement {
+		t.Fatalf("got: %p, want: %p", l, replacement)
+	}
+
+	Unregister("full")
+	if _, found := Get("full"); found {
+		t.Fatalf("full should have been unregistered")
+	}
+}
@@ -0,0 +1,227 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxRemoteCacheEntries bounds the number of lookups cached by a remote engine.
+const maxRemoteCacheEntries = 10000
+
+// remoteRetryInterval is the time during which a remote engine doesn't query
+// the service again after a failure.
+const remoteRetryInterval = 5 * time.Second
+
+// remoteMatch is the encoding of a match exchanged between LookupHandler and
+// the remote engine.
+type remoteMatch struct {
+	Suffix string   `json:"suffix"`
+	ICANN  bool     `json:"icann"`
+	Found  bool     `json:"found"`
+	Kind   ruleType `json:"kind"`
+}
+
+// LookupHandler returns an http.Handler answering the lookups of the lists
+// configured with RemoteLookups from l, or from the default list if l is nil.
+// The domain is given by the "domain" query parameter, the rules of the
+// private section are ignored if the "icann" parameter is set. Lookups are
+// subject to the checks of Lookup: invalid domains are answered with 400 Bad
+// Request, and a list failing closed, see FailClosed, with 503 Service
+// Unavailable.
+func LookupHandler(l *List) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var list = l
+		if list == nil {
+			list = defaultList()
+		}
+
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		var query = r.URL.Query()
+		var result, err = list.lookup(query.Get("domain"), query.Get("icann") != "")
+		if err != nil {
+			var status = http.StatusBadRequest
+			if errors.Is(err, ErrListTooSmall) {
+				status = http.StatusServiceUnavailable
+			}
+
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		var rm = remoteMatch{Suffix: result.PublicSuffix, ICANN: result.ICANN}
+		if result.Kind != MatchDefault {
+			rm.Found, rm.Kind = true, ruleType(result.Kind-MatchNormal)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(rm)
+	})
+}
+
+// RemoteLookups forwards the lookups of a list to the LookupHandler served at
+// serviceURL with doer, so that a fleet of processes shares a single source of
+// truth. Answers are cached for ttl. The rules loaded in the list are only
+// used, and indexed for lookups, when the service can't be reached or
+// answers with an error, the embedded list then serves as a fallback. After
+// such a failure the service isn't queried for a few seconds, the fallback
+// answers the lookups meanwhile instead of each waiting for the service.
+//
+// Lookups block on the service, doer should be configured with a timeout.
+func RemoteLookups(serviceURL string, doer Doer, ttl time.Duration) Option {
+	return withEngine(func(ri rulesInfo) engine {
+		return &remoteEngine{
+			serviceURL: serviceURL,
+			doer:       doer,
+			ttl:        ttl,
+			icann:      ri.ICANNOnly,
+			rules:      ri,
+			fallback:   &lazyEngine{newEngine: newMapEngine},
+			cache:      make(map[string]remoteEntry),
+		}
+	})
+}
+
+// remoteEntry is a match cached by a remote engine.
+type remoteEntry struct {
+	match   match
+	expires time.Time
+}
+
+// remoteEngine looks up domains with a LookupHandler.
+type remoteEngine struct {
+	serviceURL string
+	doer       Doer
+	ttl        time.Duration
+	// icann is set to ignore the rules of the private section
+	icann bool
+
+	// rules are looked up by the fallback engine when the service fails
+	rules    rulesInfo
+	fallback *lazyEngine
+
+	mu    sync.Mutex
+	cache map[string]remoteEntry
+	// retry is the time before which the service isn't queried, after a
+	// failure
+	retry time.Time
+}
+
+// lookup implements engine.
+func (e *remoteEngine) lookup(domain string) match {
+	// don't bother the service with domains the map engine rejects
+	if strings.LastIndex(domain, ".") == len(domain)-1 || checkDomain(domain) != nil {
+		return match{}
+	}
+
+	var now = time.Now()
+
+	e.mu.Lock()
+	var entry, found = e.cache[domain]
+	var down = now.Before(e.retry)
+	e.mu.Unlock()
+
+	if found && now.Before(entry.expires) {
+		return entry.match
+	}
+
+	if down {
+		return e.fallbackLookup(domain)
+	}
+
+	var m, err = e.request(domain)
+	if err != nil {
+		e.mu.Lock()
+		e.retry = time.Now().Add(remoteRetryInterval)
+		e.mu.Unlock()
+
+		return e.fallbackLookup(domain)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.cache) >= maxRemoteCacheEntries {
+		for key, entry := range e.cache {
+			if !now.Before(entry.expires) {
+				delete(e.cache, key)
+			}
+		}
+
+		if len(e.cache) >= maxRemoteCacheEntries {
+			e.cache = make(map[string]remoteEntry)
+		}
+	}
+
+	e.cache[domain] = remoteEntry{match: m, expires: now.Add(e.ttl)}
+
+	return m
+}
+
+// fallbackLookup looks up domain with the rules of the list, used while the
+// service fails.
+func (e *remoteEngine) fallbackLookup(domain string) match {
+	e.fallback.once.Do(func() {
+		e.fallback.engine = e.fallback.newEngine(e.rules)
+	})
+
+	return e.fallback.engine.lookup(domain)
+}
+
+// request looks up domain with the service.
+func (e *remoteEngine) request(domain string) (match, error) {
+	var query = url.Values{"domain": {domain}}
+	if e.icann {
+		query.Set("icann", "1")
+	}
+
+	var req, err = http.NewRequest(http.MethodGet, e.serviceURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return match{}, err
+	}
+
+	res, err := e.doer.Do(req)
+	if err != nil {
+		return match{}, networkError(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return match{}, newStatusError(res, req.Method, req.URL.String())
+	}
+
+	var rm remoteMatch
+	if err := json.NewDecoder(res.Body).Decode(&rm); err != nil {
+		return match{}, dataError(fmt.Errorf("bad lookup response: %w", err))
+	}
+
+	return match{suffix: rm.Suffix, icann: rm.ICANN, found: rm.Found, kind: rm.Kind}, nil
+}
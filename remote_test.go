@@ -0,0 +1,143 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_RemoteLookups(t *testing.T) {
+	var service, err = ParseList(strings.NewReader(rulesTestList), "remote_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var requests int32
+	var handler = LookupHandler(service)
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	// the embedded list has no rule for "compute.example.jp"
+	var list = NewList(RemoteLookups(server.URL, server.Client(), time.Minute))
+
+	var tests = []struct {
+		domain string
+		suffix string
+		icann  bool
+	}{
+		{"www.city.kobe.jp", "kobe.jp", true},
+		{"foo.bar.compute.example.jp", "bar.compute.example.jp", false},
+		{"foo.bar.compute.example.jp", "bar.compute.example.jp", false},
+	}
+
+	for _, tt := range tests {
+		if suffix, icann := list.PublicSuffix(tt.domain); suffix != tt.suffix || icann != tt.icann {
+			t.Fatalf("%q: got: %s (%t), want: %s (%t)", tt.domain, suffix, icann, tt.suffix, tt.icann)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("got: %d requests, want: %d", got, 2)
+	}
+
+	// ICANN only lookups ignore the private rules of the service
+	if got := list.load().icannEngine().lookup("foo.bar.compute.example.jp").suffix; got != "jp" {
+		t.Fatalf("got: %s, want: %s", got, "jp")
+	}
+
+	// the embedded list is used once the service is gone
+	server.Close()
+	if got, _ := list.PublicSuffix("foo.bar.compute.example.jp"); got != "bar.compute.example.jp" {
+		t.Fatalf("got: %s, want: %s from the cache", got, "bar.compute.example.jp")
+	}
+
+	if got, _ := list.PublicSuffix("www.example.co.uk"); got != "co.uk" {
+		t.Fatalf("got: %s, want: %s", got, "co.uk")
+	}
+}
+
+func Test_RemoteLookupsRetry(t *testing.T) {
+	var requests int32
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var list = NewList(RemoteLookups(server.URL, server.Client(), time.Minute))
+
+	// the service isn't queried again for a while after a failure
+	for _, domain := range []string{"www.example.co.uk", "www.example.com", "www.example.co.uk"} {
+		if got, _ := list.PublicSuffix(domain); got != domain[strings.Index(domain, ".example.")+len(".example."):] {
+			t.Fatalf("%q: got: %s from the fallback", domain, got)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("got: %d requests, want: %d", got, 1)
+	}
+}
+
+func Test_LookupHandler(t *testing.T) {
+	var list = NewList(FailClosed())
+	var handler = LookupHandler(list)
+
+	var tests = []struct {
+		query  string
+		status int
+		body   string
+	}{
+		{"domain=www.example.co.uk", http.StatusOK, `{"suffix":"co.uk","icann":true,"found":true,"kind":0}`},
+		{"domain=www.example.zzz", http.StatusOK, `{"suffix":"zzz","icann":false,"found":false,"kind":0}`},
+		{"domain=" + strings.Repeat("a", 64) + ".com", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		var rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil))
+
+		if rec.Code != tt.status {
+			t.Fatalf("%s: got: %d, want: %d", tt.query, rec.Code, tt.status)
+		}
+		if tt.body != "" && strings.TrimSpace(rec.Body.String()) != tt.body {
+			t.Fatalf("%s: got: %s, want: %s", tt.query, rec.Body.String(), tt.body)
+		}
+	}
+
+	// lists failing closed don't answer
+	var empty = mockListRetriever{RawList: strings.NewReader(""), Release: "empty"}
+	if err := list.UpdateWithListRetriever(empty); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?domain=www.example.com", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("got: %d, want: %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "io"
+
+// ListRetriever is the interface for retrieving release information/content
+type ListRetriever interface {
+	GetLatestReleaseTag() (string, error)
+	GetList(release string) (io.Reader, error)
+}
+
+// ReleasePoller is implemented by ListRetrievers able to cheaply check whether
+// the list may have changed, before the heavier GetLatestReleaseTag and
+// GetList calls are made.
+//
+// Changed reports whether a release other than release, the one currently in
+// use, may be available. It must err on the side of returning true.
+type ReleasePoller interface {
+	Changed(release string) (bool, error)
+}
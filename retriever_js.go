@@ -0,0 +1,37 @@
+//go:build js && wasm && !tinygo && !publicsuffix_lite
+// +build js,wasm,!tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"net/http"
+	"time"
+)
+
+func init() {
+	defaultListRetriever = NewFetchListRetriever(WithReleaseCacheTTL(time.Minute))
+}
+
+// NewFetchListRetriever creates a new ListRetriever for browsers and other
+// js/wasm hosts, retrieving the list from the official GitHub repository, or
+// a mirror set by opts, with the Fetch API. The mirror must allow cross-origin
+// requests. It is used by Update under js/wasm.
+func NewFetchListRetriever(opts ...RetrieverOption) ListRetriever {
+	return NewGitHubListRetrieverWithDoer(fetchDoer{client: http.DefaultClient}, opts...)
+}
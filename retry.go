@@ -0,0 +1,140 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+// maxRetryDelay is the longest delay waited before a retry, longer delays
+// requested by the server return the error instead.
+const maxRetryDelay = time.Minute
+
+// retryListRetriever retries the calls of a ListRetriever failing with a
+// temporary error.
+type retryListRetriever struct {
+	listRetriever ListRetriever
+	attempts      int
+	backoff       time.Duration
+	sleep         func(time.Duration)
+}
+
+// NewRetryListRetriever returns a ListRetriever calling listRetriever up to
+// attempts times when it fails with an error matching ErrTemporary.
+//
+// The delay before a retry doubles after each attempt, starting from backoff,
+// unless the error requests a delay: the Retry-After header of a
+// *StatusError or the reset time of a *RateLimitError. The error is returned
+// without retrying if the requested delay exceeds a minute.
+func NewRetryListRetriever(listRetriever ListRetriever, attempts int, backoff time.Duration) ListRetriever {
+	return retryListRetriever{
+		listRetriever: listRetriever,
+		attempts:      attempts,
+		backoff:       backoff,
+		sleep:         time.Sleep,
+	}
+}
+
+// retry calls fn until it succeeds, fails with an error which isn't
+// temporary, or the attempts are exhausted.
+func (r retryListRetriever) retry(fn func() error) error {
+	var delay = r.backoff
+	for attempt := 1; ; attempt++ {
+		var err = fn()
+		if err == nil || !errors.Is(err, ErrTemporary) || attempt >= r.attempts {
+			return err
+		}
+
+		var wait = delay
+		if requested, ok := retryDelay(err); ok {
+			wait = requested
+		}
+
+		if wait > maxRetryDelay {
+			return err
+		}
+
+		r.sleep(wait)
+		delay *= 2
+	}
+}
+
+// retryDelay returns the delay requested by err, if any.
+func retryDelay(err error) (time.Duration, bool) {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		return statusErr.RetryAfter, true
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) && !rateLimitErr.Reset.IsZero() {
+		return time.Until(rateLimitErr.Reset), true
+	}
+
+	return 0, false
+}
+
+// GetLatestReleaseTag implements ListRetriever.
+func (r retryListRetriever) GetLatestReleaseTag() (string, error) {
+	var release string
+	var err = r.retry(func() (err error) {
+		release, err = r.listRetriever.GetLatestReleaseTag()
+		return err
+	})
+
+	return release, err
+}
+
+// GetList implements ListRetriever.
+func (r retryListRetriever) GetList(release string) (io.Reader, error) {
+	var list io.Reader
+	var err = r.retry(func() (err error) {
+		list, err = r.listRetriever.GetList(release)
+		return err
+	})
+
+	return list, err
+}
+
+// Changed implements ReleasePoller if the wrapped ListRetriever does,
+// otherwise it always returns true.
+func (r retryListRetriever) Changed(release string) (bool, error) {
+	var poller, ok = r.listRetriever.(ReleasePoller)
+	if !ok {
+		return true, nil
+	}
+
+	var changed bool
+	var err = r.retry(func() (err error) {
+		changed, err = poller.Changed(release)
+		return err
+	})
+
+	return changed, err
+}
+
+// URL returns the URL of release reported by the wrapped ListRetriever, if
+// any.
+func (r retryListRetriever) URL(release string) string {
+	if u, ok := r.listRetriever.(urlRetriever); ok {
+		return u.URL(release)
+	}
+
+	return ""
+}
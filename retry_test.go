@@ -0,0 +1,144 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_RetryListRetriever(t *testing.T) {
+	var tests = []struct {
+		name     string
+		failures []func(w http.ResponseWriter)
+		requests int
+		delays   []time.Duration
+		err      error
+	}{
+		{
+			name: "Retry-After seconds",
+			failures: []func(w http.ResponseWriter){func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "2")
+				w.WriteHeader(http.StatusTooManyRequests)
+			}},
+			requests: 2,
+			delays:   []time.Duration{2 * time.Second},
+		},
+		{
+			name: "Secondary rate limit",
+			failures: []func(w http.ResponseWriter){func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusForbidden)
+			}},
+			requests: 2,
+			delays:   []time.Duration{30 * time.Second},
+		},
+		{
+			name: "Backoff",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			},
+			requests: 3,
+			delays:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name: "Delay too long",
+			failures: []func(w http.ResponseWriter){func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}},
+			requests: 1,
+			err:      ErrTemporary,
+		},
+		{
+			name: "Permanent error",
+			failures: []func(w http.ResponseWriter){func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusNotFound)
+			}},
+			requests: 1,
+			err:      ErrPermanent,
+		},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= len(tt.failures) {
+					tt.failures[requests-1](w)
+					return
+				}
+				w.Write([]byte(`[{"sha":"retry_test"}]`))
+			}))
+			defer server.Close()
+
+			var delays []time.Duration
+			var listRetriever = NewRetryListRetriever(NewGitHubListRetriever(server.Client(), WithCommitURL(server.URL)), 3, time.Second).(retryListRetriever)
+			listRetriever.sleep = func(d time.Duration) { delays = append(delays, d) }
+
+			var release, err = listRetriever.GetLatestReleaseTag()
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got: %v, want: %v", err, tt.err)
+				}
+			} else if err != nil || release != "retry_test" {
+				t.Fatalf("got: %s %v, want: %s", release, err, "retry_test")
+			}
+
+			if requests != tt.requests {
+				t.Fatalf("got: %d requests, want: %d", requests, tt.requests)
+			}
+
+			if len(delays) != len(tt.delays) {
+				t.Fatalf("got: %v, want: %v", delays, tt.delays)
+			}
+			for i := range delays {
+				if delays[i] != tt.delays[i] {
+					t.Fatalf("got: %v, want: %v", delays, tt.delays)
+				}
+			}
+		})
+	}
+}
+
+func Test_StatusErrorRetryAfter(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var _, err = NewGitHubListRetriever(server.Client(), WithCommitURL(server.URL)).GetLatestReleaseTag()
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("got: %v, want: %T", err, statusErr)
+	}
+
+	if statusErr.RetryAfter < 59*time.Minute || statusErr.RetryAfter > time.Hour {
+		t.Fatalf("got: %s, want: about %s", statusErr.RetryAfter, time.Hour)
+	}
+}
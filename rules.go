@@ -0,0 +1,304 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RuleKind is the kind of a rule of the public suffix list.
+type RuleKind int
+
+const (
+	// NormalRule matches the name of the rule, e.g. "co.uk".
+	NormalRule RuleKind = RuleKind(normal)
+	// WildcardRule matches any label followed by the rest of the rule, e.g.
+	// "*.kobe.jp".
+	WildcardRule RuleKind = RuleKind(wildcard)
+	// ExceptionRule overrides a wildcard rule, e.g. "!city.kobe.jp".
+	ExceptionRule RuleKind = RuleKind(exception)
+)
+
+// String returns "normal", "wildcard" or "exception".
+func (k RuleKind) String() string {
+	return ruleType(k).String()
+}
+
+// Section is the section of the public suffix list a rule belongs to.
+type Section int
+
+const (
+	// ICANNSection contains the rules of the domains managed by ICANN.
+	ICANNSection Section = iota
+	// PrivateSection contains the rules submitted by private parties.
+	PrivateSection
+)
+
+// String returns "icann" or "private".
+func (s Section) String() string {
+	switch s {
+	case ICANNSection:
+		return "icann"
+	case PrivateSection:
+		return "private"
+	default:
+		return fmt.Sprintf("Section(%d)", int(s))
+	}
+}
+
+// Rule is a rule of the public suffix list.
+type Rule struct {
+	// Name is the rule in canonical (Punycode) form as it appears in the list,
+	// including the leading "*." of wildcard rules and "!" of exception rules.
+	Name    string
+	Kind    RuleKind
+	Section Section
+	// Unicode is the rule as written in the list, e.g. "網路.tw" for the
+	// rule named "xn--zf0ao64a.tw", for display purposes. It is the same as
+	// Name for ASCII rules.
+	Unicode string
+	// Line is the line number of the rule in the list it was parsed from, 0
+	// if unknown, e.g. for the rules of the embedded list or of a snapshot
+	// loaded by Read, which don't record it.
+	Line int
+	// Comment is the comment block preceding the rule in the list, without
+	// the "//" markers, e.g. "uk : https://en.wikipedia.org/wiki/.uk" for the
+	// rule "co.uk". Multiple lines are separated by "\n". Like Line, it is
+	// empty if unknown.
+	Comment string
+	// Owner is the organisation which submitted a rule of the private
+	// section, as named by the first line of its comment, e.g. "Amazon
+	// CloudFront" for "cloudfront.net". It is empty for the rules of the ICANN
+	// section and when the comment is unknown.
+	Owner string
+}
+
+// public converts the internal representation of a rule to a Rule.
+func (r rule) public() Rule {
+	var section = PrivateSection
+	if r.ICANN {
+		section = ICANNSection
+	}
+
+	var text = r.Text
+	if text == "" {
+		text = r.DottedName
+
+		// snapshots of previous releases don't record the text of the rules
+		if strings.Contains(text, "xn--") {
+			if unicode, err := toUnicode(text); err == nil {
+				text = unicode
+			}
+		}
+	}
+
+	var owner string
+	if section == PrivateSection {
+		owner = ownerOf(r.Comment)
+	}
+
+	return Rule{Name: r.DottedName, Kind: RuleKind(r.RuleType), Section: section, Unicode: text, Line: r.Line, Comment: r.Comment, Owner: owner}
+}
+
+// ownerOf returns the organisation named by comment, the comment of a rule of
+// the private section. Its first line has the form "Owner : URL", or only
+// names the owner.
+func ownerOf(comment string) string {
+	var line = comment
+	if newline := strings.IndexByte(line, '\n'); newline != -1 {
+		line = line[:newline]
+	}
+
+	if colon := strings.Index(line, " : "); colon != -1 {
+		line = line[:colon]
+	}
+
+	return strings.TrimSpace(line)
+}
+
+// ValidateRuleLine parses line as a rule of the public suffix list, with the
+// same normalisation and checks as the parser of the list: surrounding spaces
+// are trimmed, Unicode names are converted to Punycode and invalid names are
+// rejected with an error matching ErrInvalidData. This allows tools accepting
+// custom suffixes to validate them identically.
+//
+// A line on its own doesn't belong to a section, the returned rule is in the
+// PrivateSection like any suffix not managed by ICANN. Empty lines and
+// comments, which the parser skips, are rejected.
+func ValidateRuleLine(line string) (Rule, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "//") {
+		return Rule{}, dataError(fmt.Errorf("not a publicsuffix.org list rule: %q", line))
+	}
+
+	var _, rule, err = parseRule(line, false)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return rule.public(), nil
+}
+
+// Filter selects rules, it returns true for the rules to keep.
+type Filter func(Rule) bool
+
+// InSection returns a Filter keeping the rules of section.
+func InSection(section Section) Filter {
+	return func(r Rule) bool {
+		return r.Section == section
+	}
+}
+
+// OfKind returns a Filter keeping the rules of kind.
+func OfKind(kind RuleKind) Filter {
+	return func(r Rule) bool {
+		return r.Kind == kind
+	}
+}
+
+// OwnedBy returns a Filter keeping the rules of the private section whose
+// Owner contains owner, ignoring case. For example the suffixes submitted by
+// Fastly are returned by:
+//
+//	Suffixes(OwnedBy("fastly"))
+func OwnedBy(owner string) Filter {
+	owner = strings.ToLower(owner)
+
+	return func(r Rule) bool {
+		return r.Owner != "" && strings.Contains(strings.ToLower(r.Owner), owner)
+	}
+}
+
+// CommentContains returns a Filter keeping the rules whose Comment contains
+// text, ignoring case, e.g. a contact address or the URL of a registry.
+func CommentContains(text string) Filter {
+	text = strings.ToLower(text)
+
+	return func(r Rule) bool {
+		return r.Comment != "" && strings.Contains(strings.ToLower(r.Comment), text)
+	}
+}
+
+// InTLD returns a Filter keeping the rules under one of tlds, given in
+// canonical (Punycode) form, e.g. "jp" keeps "kobe.jp" and "!city.kobe.jp".
+func InTLD(tlds ...string) Filter {
+	var keep = make(map[string]bool, len(tlds))
+	for _, tld := range tlds {
+		keep[strings.ToLower(strings.Trim(tld, "."))] = true
+	}
+
+	return func(r Rule) bool {
+		return keep[r.Name[strings.LastIndexByte(r.Name, '.')+1:]]
+	}
+}
+
+// AllOf returns a Filter keeping the rules kept by all filters.
+func AllOf(filters ...Filter) Filter {
+	return func(r Rule) bool {
+		return matchAll(r, filters)
+	}
+}
+
+// matchAll reports whether r is kept by all filters.
+func matchAll(r Rule, filters []Filter) bool {
+	for _, filter := range filters {
+		if !filter(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Suffixes returns the names of the rules of the currently loaded list kept by
+// all filters, sorted. For example the wildcard rules of the ICANN section are
+// returned by:
+//
+//	Suffixes(InSection(ICANNSection), OfKind(WildcardRule))
+//
+// Names are returned as they appear in the list, see Rule. The result can be
+// used to build external structures such as bloom filters or database tables.
+func Suffixes(filters ...Filter) []string {
+	return defaultList().Suffixes(filters...)
+}
+
+// Suffixes returns the names of the rules of l kept by all filters, see the
+// package level Suffixes.
+func (l *List) Suffixes(filters ...Filter) []string {
+	var suffixes []string
+	for _, r := range l.load().selectRules(filters) {
+		suffixes = append(suffixes, r.Name)
+	}
+
+	return suffixes
+}
+
+// ICANNRules calls fn for each rule of the ICANN section of the currently
+// loaded list, sorted by name, until fn returns false.
+func ICANNRules(fn func(Rule) bool) {
+	defaultList().ICANNRules(fn)
+}
+
+// ICANNRules calls fn for each rule of the ICANN section of l, see the
+// package level ICANNRules.
+func (l *List) ICANNRules(fn func(Rule) bool) {
+	l.rangeRules(fn, InSection(ICANNSection))
+}
+
+// PrivateRules calls fn for each rule of the private section of the currently
+// loaded list, sorted by name, until fn returns false.
+func PrivateRules(fn func(Rule) bool) {
+	defaultList().PrivateRules(fn)
+}
+
+// PrivateRules calls fn for each rule of the private section of l, see the
+// package level PrivateRules.
+func (l *List) PrivateRules(fn func(Rule) bool) {
+	l.rangeRules(fn, InSection(PrivateSection))
+}
+
+// rangeRules calls fn for each rule of l kept by all filters until fn
+// returns false.
+func (l *List) rangeRules(fn func(Rule) bool, filters ...Filter) {
+	for _, r := range l.load().selectRules(filters) {
+		if !fn(r) {
+			return
+		}
+	}
+}
+
+// selectRules returns the rules of ri kept by all filters, sorted by name.
+func (ri *rulesInfo) selectRules(filters []Filter) []Rule {
+	var selected []Rule
+
+	for _, rules := range ri.Map {
+		for _, rule := range rules {
+			var r = rule.public()
+			if matchAll(r, filters) {
+				selected = append(selected, r)
+			}
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Name < selected[j].Name
+	})
+
+	return selected
+}
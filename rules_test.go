@@ -0,0 +1,283 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// rulesTestList is a small list covering both sections and all rule kinds.
+const rulesTestList = `// ===BEGIN ICANN DOMAINS===
+jp
+kobe.jp
+*.kobe.jp
+!city.kobe.jp
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+blogspot.jp
+*.compute.example.jp
+// ===END PRIVATE DOMAINS===
+`
+
+// preserveRules restores the currently loaded list once t has completed, for
+// tests which install their own list.
+func preserveRules(t *testing.T) {
+	var saved = load()
+	t.Cleanup(func() { defaultList().rules.Store(saved) })
+}
+
+// installRulesTestList installs rulesTestList for the duration of t.
+func installRulesTestList(t *testing.T) {
+	preserveRules(t)
+
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "rules_test"}
+	if err := UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+}
+
+func Test_Suffixes(t *testing.T) {
+	installRulesTestList(t)
+
+	var tests = []struct {
+		name     string
+		filters  []Filter
+		expected []string
+	}{
+		{"All", nil, []string{"!city.kobe.jp", "*.compute.example.jp", "*.kobe.jp", "blogspot.jp", "jp", "kobe.jp"}},
+		{"ICANN", []Filter{InSection(ICANNSection)}, []string{"!city.kobe.jp", "*.kobe.jp", "jp", "kobe.jp"}},
+		{"Private", []Filter{InSection(PrivateSection)}, []string{"*.compute.example.jp", "blogspot.jp"}},
+		{"Wildcard", []Filter{OfKind(WildcardRule)}, []string{"*.compute.example.jp", "*.kobe.jp"}},
+		{"ICANN wildcard", []Filter{InSection(ICANNSection), OfKind(WildcardRule)}, []string{"*.kobe.jp"}},
+		{"None", []Filter{OfKind(ExceptionRule), InSection(PrivateSection)}, nil},
+		{"TLD", []Filter{InTLD("JP.")}, []string{"!city.kobe.jp", "*.compute.example.jp", "*.kobe.jp", "blogspot.jp", "jp", "kobe.jp"}},
+		{"Other TLD", []Filter{InTLD("uk", "com")}, nil},
+		{"All of", []Filter{AllOf(InTLD("jp"), InSection(PrivateSection), OfKind(NormalRule))}, []string{"blogspot.jp"}},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Suffixes(tt.filters...); !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("got: %v, want: %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_SectionRules(t *testing.T) {
+	installRulesTestList(t)
+
+	var collect = func(iterate func(func(Rule) bool)) []Rule {
+		var rules []Rule
+		iterate(func(r Rule) bool {
+			rules = append(rules, r)
+			return true
+		})
+
+		return rules
+	}
+
+	var expectedICANN = []Rule{
+		{Name: "!city.kobe.jp", Kind: ExceptionRule, Section: ICANNSection, Unicode: "!city.kobe.jp", Line: 5},
+		{Name: "*.kobe.jp", Kind: WildcardRule, Section: ICANNSection, Unicode: "*.kobe.jp", Line: 4},
+		{Name: "jp", Kind: NormalRule, Section: ICANNSection, Unicode: "jp", Line: 2},
+		{Name: "kobe.jp", Kind: NormalRule, Section: ICANNSection, Unicode: "kobe.jp", Line: 3},
+	}
+	if got := collect(ICANNRules); !reflect.DeepEqual(got, expectedICANN) {
+		t.Fatalf("got: %v, want: %v", got, expectedICANN)
+	}
+
+	var expectedPrivate = []Rule{
+		{Name: "*.compute.example.jp", Kind: WildcardRule, Section: PrivateSection, Unicode: "*.compute.example.jp", Line: 9},
+		{Name: "blogspot.jp", Kind: NormalRule, Section: PrivateSection, Unicode: "blogspot.jp", Line: 8},
+	}
+	if got := collect(PrivateRules); !reflect.DeepEqual(got, expectedPrivate) {
+		t.Fatalf("got: %v, want: %v", got, expectedPrivate)
+	}
+
+	// Iteration stops when fn returns false.
+	var count int
+	ICANNRules(func(Rule) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Fatalf("got: %d calls, want: %d", count, 2)
+	}
+}
+
+func Test_ValidateRuleLine(t *testing.T) {
+	var tests = []struct {
+		line string
+		want Rule
+		err  string
+	}{
+		{"co.uk", Rule{Name: "co.uk", Kind: NormalRule, Section: PrivateSection, Unicode: "co.uk"}, ""},
+		{"  *.kobe.jp ", Rule{Name: "*.kobe.jp", Kind: WildcardRule, Section: PrivateSection, Unicode: "*.kobe.jp"}, ""},
+		{"!city.kobe.jp", Rule{Name: "!city.kobe.jp", Kind: ExceptionRule, Section: PrivateSection, Unicode: "!city.kobe.jp"}, ""},
+		{"網路.tw", Rule{Name: "xn--zf0ao64a.tw", Kind: NormalRule, Section: PrivateSection, Unicode: "網路.tw"}, ""},
+		{"COM", Rule{}, `bad publicsuffix.org list data: "COM"`},
+		{"", Rule{}, `not a publicsuffix.org list rule: ""`},
+		{"// comment", Rule{}, `not a publicsuffix.org list rule: "// comment"`},
+	}
+
+	for _, tt := range tests {
+		if unsupportedIDN(tt.line) {
+			continue
+		}
+
+		var got, err = ValidateRuleLine(tt.line)
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err || !errors.Is(err, ErrInvalidData) {
+				t.Fatalf("%q: got: %v, want: %s", tt.line, err, tt.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tt.line, err.Error())
+		}
+
+		if got != tt.want {
+			t.Fatalf("%q: got: %+v, want: %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func Test_RuleUnicode(t *testing.T) {
+	if unsupportedIDN("網路.tw") {
+		t.Skip("internationalised domain names aren't supported")
+	}
+
+	// the embedded snapshot doesn't record the text of the rules
+	var got string
+	NewList().ICANNRules(func(r Rule) bool {
+		if r.Name == "xn--zf0ao64a.tw" {
+			got = r.Unicode
+		}
+		return got == ""
+	})
+
+	if got != "網路.tw" {
+		t.Fatalf("got: %s, want: %s", got, "網路.tw")
+	}
+
+	var list, err = ParseList(strings.NewReader("*.網路.tw\n"), "unicode_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = []Rule{{Name: "*.xn--zf0ao64a.tw", Kind: WildcardRule, Section: PrivateSection, Unicode: "*.網路.tw", Line: 1}}
+	var rules []Rule
+	list.PrivateRules(func(r Rule) bool {
+		rules = append(rules, r)
+		return true
+	})
+
+	if !reflect.DeepEqual(rules, expected) {
+		t.Fatalf("got: %v, want: %v", rules, expected)
+	}
+}
+
+func Test_RuleComment(t *testing.T) {
+	var input = `// ===BEGIN ICANN DOMAINS===
+
+// uk : https://en.wikipedia.org/wiki/.uk
+// Submitted by registry
+uk
+co.uk
+
+// ac : https://en.wikipedia.org/wiki/.ac
+ac
+// ===END ICANN DOMAINS===
+`
+
+	var list, err = ParseList(strings.NewReader(input), "comment_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = []Rule{
+		{Name: "ac", Kind: NormalRule, Section: ICANNSection, Unicode: "ac", Line: 9, Comment: "ac : https://en.wikipedia.org/wiki/.ac"},
+		{Name: "co.uk", Kind: NormalRule, Section: ICANNSection, Unicode: "co.uk", Line: 6, Comment: "uk : https://en.wikipedia.org/wiki/.uk\nSubmitted by registry"},
+		{Name: "uk", Kind: NormalRule, Section: ICANNSection, Unicode: "uk", Line: 5, Comment: "uk : https://en.wikipedia.org/wiki/.uk\nSubmitted by registry"},
+	}
+
+	var rules []Rule
+	list.ICANNRules(func(r Rule) bool {
+		rules = append(rules, r)
+		return true
+	})
+
+	if !reflect.DeepEqual(rules, expected) {
+		t.Fatalf("got: %q, want: %q", rules, expected)
+	}
+}
+
+func Test_RuleOwner(t *testing.T) {
+	var input = `// ===BEGIN ICANN DOMAINS===
+// net : https://en.wikipedia.org/wiki/.net
+net
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+// Amazon CloudFront : https://aws.amazon.com/cloudfront/
+// Submitted by Donavan Miller <donavanm@amazon.com>
+cloudfront.net
+
+// Fastly Inc.
+global.ssl.fastly.net
+// ===END PRIVATE DOMAINS===
+`
+
+	var list, err = ParseList(strings.NewReader(input), "owner_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var owners = make(map[string]string)
+	list.rangeRules(func(r Rule) bool {
+		owners[r.Name] = r.Owner
+		return true
+	})
+
+	var expected = map[string]string{"net": "", "cloudfront.net": "Amazon CloudFront", "global.ssl.fastly.net": "Fastly Inc."}
+	if !reflect.DeepEqual(owners, expected) {
+		t.Fatalf("got: %v, want: %v", owners, expected)
+	}
+
+	var tests = []struct {
+		name     string
+		filters  []Filter
+		expected []string
+	}{
+		{"Owner", []Filter{OwnedBy("FASTLY")}, []string{"global.ssl.fastly.net"}},
+		{"Unknown owner", []Filter{OwnedBy("Akamai")}, nil},
+		{"Comment", []Filter{CommentContains("amazon.com")}, []string{"cloudfront.net"}},
+		{"ICANN comment", []Filter{CommentContains("wikipedia")}, []string{"net"}},
+	}
+
+	for _, tt := range tests {
+		if got := list.Suffixes(tt.filters...); !reflect.DeepEqual(got, tt.expected) {
+			t.Fatalf("%s: got: %v, want: %v", tt.name, got, tt.expected)
+		}
+	}
+}
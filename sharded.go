@@ -0,0 +1,167 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "strings"
+
+// ShardedRules partitions the lookup index of the lists loaded by a List into
+// n shards by a hash of the TLD of the rules, each indexed separately. When
+// UpdateRules changes a few rules, only the shards of their TLDs are rebuilt
+// and the other shards are shared with the previous list, rather than
+// rebuilding the whole index. This is meant for lists merged from overlays
+// with far more rules than the public suffix list, which are then updated
+// piecemeal. It is set on a List created by NewList.
+//
+// Lookups are as fast as without shards, a domain being looked up in the
+// shard of its TLD only.
+func ShardedRules(n int) Option {
+	if n < 1 {
+		n = 1
+	}
+
+	return withEngine(func(ri rulesInfo) engine {
+		return newShardedEngine(ri, n)
+	})
+}
+
+// shardedEngine is an engine made of mapEngines, each for the rules of the
+// TLDs of a shard.
+type shardedEngine struct {
+	shards []mapEngine
+	// rules are the rules of each shard
+	rules []map[string][]rule
+}
+
+// newShardedEngine returns a shardedEngine with n shards for the rules of ri.
+// If ri was patched from the rules of a shardedEngine with as many shards,
+// only the shards of the patched rules are rebuilt.
+func newShardedEngine(ri rulesInfo, n int) engine {
+	var e = &shardedEngine{shards: make([]mapEngine, n), rules: make([]map[string][]rule, n)}
+
+	var base *shardedEngine
+	if ri.patch != nil {
+		base, _ = ri.patch.base.(*shardedEngine)
+	}
+
+	if base == nil || len(base.shards) != n {
+		for i := range e.rules {
+			e.rules[i] = make(map[string][]rule)
+		}
+
+		for key, rules := range ri.Map {
+			for _, r := range rules {
+				for _, i := range shardsOf(r.DottedName, n) {
+					e.rules[i][key] = append(e.rules[i][key], r)
+				}
+			}
+		}
+
+		for i := range e.shards {
+			e.shards[i] = newMapEngine(rulesInfo{Map: e.rules[i]}).(mapEngine)
+		}
+
+		return e
+	}
+
+	copy(e.shards, base.shards)
+	copy(e.rules, base.rules)
+
+	var touched = make(map[int]bool)
+	for _, changes := range [][]parsedRule{ri.patch.removed, ri.patch.added} {
+		for _, change := range changes {
+			for _, i := range shardsOf(change.rule.DottedName, n) {
+				touched[i] = true
+			}
+		}
+	}
+
+	for i := range touched {
+		var rules = make(map[string][]rule, len(base.rules[i]))
+		for key, list := range base.rules[i] {
+			rules[key] = list
+		}
+
+		ri.patch.apply(rules, func(r rule) bool {
+			return containsShard(shardsOf(r.DottedName, n), i)
+		})
+
+		e.rules[i] = rules
+		e.shards[i] = newMapEngine(rulesInfo{Map: rules}).(mapEngine)
+	}
+
+	return e
+}
+
+// shardOf returns the shard of name, a domain or the name of a rule, among n:
+// the 32-bit FNV-1a hash of its TLD modulo n.
+func shardOf(name string, n int) int {
+	var tld = name[strings.LastIndexByte(name, '.')+1:]
+
+	var hash uint32 = 2166136261
+	for i := 0; i < len(tld); i++ {
+		hash ^= uint32(tld[i])
+		hash *= 16777619
+	}
+
+	return int(hash % uint32(n))
+}
+
+// shardsOf returns the shards holding the rule named name: the shard of its
+// TLD, or every shard if its TLD is a "*" label.
+func shardsOf(name string, n int) []int {
+	if name == "*" || strings.HasSuffix(name, ".*") {
+		var all = make([]int, n)
+		for i := range all {
+			all[i] = i
+		}
+		return all
+	}
+
+	return []int{shardOf(name, n)}
+}
+
+// containsShard reports whether shards holds shard.
+func containsShard(shards []int, shard int) bool {
+	for _, s := range shards {
+		if s == shard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookup implements engine.
+func (e *shardedEngine) lookup(domain string) match {
+	return e.lookupBuffer(domain, nil)
+}
+
+// lookupBuffer implements bufferedEngine.
+func (e *shardedEngine) lookupBuffer(domain string, buf *LookupBuffer) match {
+	return e.shards[shardOf(domain, len(e.shards))].lookupBuffer(domain, buf)
+}
+
+// approxMemoryUsage implements memoryUser, the rules are shared with the list.
+func (e *shardedEngine) approxMemoryUsage() int64 {
+	var size int64
+	for i, shard := range e.shards {
+		size += shard.approxMemoryUsage()
+		size += int64(len(e.rules[i])) * mapEntrySize(stringHeaderSize, ruleSliceSize)
+	}
+
+	return size
+}
@@ -0,0 +1,143 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_ShardedRules(t *testing.T) {
+	var plain, sharded = NewList(), NewList(ShardedRules(16))
+
+	for _, tc := range publicSuffixTestCases {
+		var got, gotICANN = sharded.PublicSuffix(tc.domain)
+		var want, wantICANN = plain.PublicSuffix(tc.domain)
+		if got != want || gotICANN != wantICANN {
+			t.Fatalf("%q: got: %s %v, want: %s %v", tc.domain, got, gotICANN, want, wantICANN)
+		}
+	}
+
+	// rules with a "*" TLD are in every shard
+	var patterned, err = ParseList(strings.NewReader("example.*\n"), "sharded_test", ShardedRules(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, domain := range []string{"www.example.com", "www.example.jp", "www.example.uk", "www.example.net"} {
+		if got, _ := patterned.PublicSuffix(domain); got != domain[4:] {
+			t.Fatalf("%q: got: %s, want: %s", domain, got, domain[4:])
+		}
+	}
+}
+
+func Test_UpdateRules(t *testing.T) {
+	var l, err = ParseList(strings.NewReader(rulesTestList+"com\n"), "rules_1", ShardedRules(8))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if shardOf("jp", 8) == shardOf("com", 8) {
+		t.Fatalf("jp and com must be in different shards")
+	}
+
+	var before = l.load().engine.(*shardedEngine)
+
+	err = l.UpdateRules("rules_2",
+		[]Rule{{Name: "pages.kobe.jp", Section: PrivateSection, Comment: "Pages"}, {Name: "*.example.jp", Section: ICANNSection}},
+		[]Rule{{Name: "blogspot.jp", Section: PrivateSection}, {Name: "unknown.jp", Section: ICANNSection}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := l.Release(); got != "rules_2" {
+		t.Fatalf("got: %s, want: %s", got, "rules_2")
+	}
+	if got := l.Provenance().Source; got != SourceUpdatedRules {
+		t.Fatalf("got: %s, want: %s", got, SourceUpdatedRules)
+	}
+
+	var want = []string{"!city.kobe.jp", "*.compute.example.jp", "*.example.jp", "*.kobe.jp", "com", "jp", "kobe.jp", "pages.kobe.jp"}
+	if got := l.Suffixes(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+
+	for domain, suffix := range map[string]string{"www.pages.kobe.jp": "pages.kobe.jp", "www.foo.example.jp": "foo.example.jp", "www.blogspot.jp": "jp", "www.example.com": "com"} {
+		if got, _ := l.PublicSuffix(domain); got != suffix {
+			t.Fatalf("%q: got: %s, want: %s", domain, got, suffix)
+		}
+	}
+
+	// only the shard of jp was rebuilt
+	var after = l.load().engine.(*shardedEngine)
+	for i := range after.rules {
+		var shared = reflect.ValueOf(after.rules[i]).Pointer() == reflect.ValueOf(before.rules[i]).Pointer()
+		if shared != (i != shardOf("jp", 8)) {
+			t.Fatalf("shard %d: got shared: %v, want: %v", i, shared, !shared)
+		}
+	}
+
+	// an added rule replaces the rule of the same name and section
+	if err := l.UpdateRules("rules_3", []Rule{{Name: "pages.kobe.jp", Section: PrivateSection, Comment: "Pages, Inc."}}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	var pages []Rule
+	l.PrivateRules(func(r Rule) bool {
+		if r.Name == "pages.kobe.jp" {
+			pages = append(pages, r)
+		}
+		return true
+	})
+	if len(pages) != 1 || pages[0].Comment != "Pages, Inc." {
+		t.Fatalf("got: %+v, want a single rule with the new comment", pages)
+	}
+
+	// invalid rules are refused and the list is kept
+	err = l.UpdateRules("rules_4", []Rule{{Name: "Bad Rule", Section: ICANNSection}}, nil)
+	if !errors.Is(err, ErrInvalidData) && !errors.As(err, new(*DomainError)) {
+		t.Fatalf("got: %v, want an invalid rule error", err)
+	}
+	if got := l.Release(); got != "rules_3" {
+		t.Fatalf("got: %s, want: %s", got, "rules_3")
+	}
+	if l.LastLoadError() == nil {
+		t.Fatalf("got: nil, want the error of the update")
+	}
+}
+
+func Test_UpdateRulesUnsharded(t *testing.T) {
+	var l = NewList()
+	if err := l.UpdateRules("overlay", []Rule{{Name: "internal.example.com", Section: PrivateSection}}, []Rule{{Name: "co.uk", Section: ICANNSection}}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for domain, suffix := range map[string]string{"www.internal.example.com": "internal.example.com", "www.example.co.uk": "uk"} {
+		if got, _ := l.PublicSuffix(domain); got != suffix {
+			t.Fatalf("%q: got: %s, want: %s", domain, got, suffix)
+		}
+	}
+
+	// the embedded rules are unaffected
+	if got, _ := NewList().PublicSuffix("www.example.co.uk"); got != "co.uk" {
+		t.Fatalf("got: %s, want: %s", got, "co.uk")
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// SiteKey returns a key identifying the site of host, its registered domain:
+// the 64-bit FNV-1a hash of the eTLD+1 of host normalised with Normalize, e.g.
+// of "example.co.uk" for "WWW.Example.CO.UK". Distributed caches and rate
+// limiters can partition by site with it, without storing strings.
+//
+// A fully qualified host has the key of the host without its trailing dot. The
+// key of a registered domain in ASCII is stable across releases of this
+// package and platforms, it only changes if a new release of the list moves
+// the registered domain of host. Hosts with non-ASCII labels additionally
+// depend on the IDNA mapping of golang.org/x/net/idna used by Normalize, which
+// may differ between its versions. An error is returned if host can't be
+// normalised, has an empty label or has no registered domain, e.g. because it
+// is itself a public suffix.
+func SiteKey(host string) (uint64, error) {
+	return defaultList().SiteKey(host)
+}
+
+// SiteKey returns a key identifying the site of host in l, see the package
+// level SiteKey.
+func (l *List) SiteKey(host string) (uint64, error) {
+	var normalized, err = Normalize(strings.TrimSuffix(host, "."))
+	if err != nil {
+		return 0, err
+	}
+
+	// the key of "a.com." must not be the one of the "com." eTLD+1 of "b.com."
+	if hasEmptyLabel(normalized) {
+		return 0, noRegisteredDomain(fmt.Errorf("publicsuffix: empty label in host %q", host))
+	}
+
+	var site string
+	site, err = l.EffectiveTLDPlusOne(normalized)
+	if err != nil {
+		return 0, err
+	}
+
+	var hash = fnv.New64a()
+	hash.Write([]byte(site))
+
+	return hash.Sum64(), nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_SiteKey(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("// ===BEGIN ICANN DOMAINS===\nuk\nco.uk\njp\ncom\nnet\n// ===END ICANN DOMAINS===\n"), "sitekey_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// keys are stable, changing them breaks the users partitioning by site
+	var tests = []struct {
+		host     string
+		expected uint64
+	}{
+		{"example.co.uk", 0xf499928389d0529b},
+		{"WWW.Example.CO.UK", 0xf499928389d0529b},
+		{"a.b.example.co.uk", 0xf499928389d0529b},
+		{"www.例え.jp", 0xc4e7b7d05f1a144f},
+		{"xn--r8jz45g.jp", 0xc4e7b7d05f1a144f},
+		{"www.example.co.uk.", 0xf499928389d0529b},
+	}
+
+	for _, tt := range tests {
+		if unsupportedIDN(tt.host) {
+			continue
+		}
+
+		var got, err = list.SiteKey(tt.host)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tt.host, err.Error())
+		}
+		if got != tt.expected {
+			t.Fatalf("%q: got: %#x, want: %#x", tt.host, got, tt.expected)
+		}
+	}
+
+	if a, b := mustSiteKey(t, list, "example.com"), mustSiteKey(t, list, "example.net"); a == b {
+		t.Fatalf("got: %#x, want different keys", a)
+	}
+
+	if a, b := mustSiteKey(t, list, "a.com."), mustSiteKey(t, list, "b.com."); a == b {
+		t.Fatalf("got: %#x, want different keys", a)
+	}
+
+	for _, host := range []string{"co.uk", "", "exa mple.com", ".", "a.com..", "a..b.com", ".a.com"} {
+		if _, err := list.SiteKey(host); err == nil {
+			t.Fatalf("%q: got: nil, want: error", host)
+		}
+	}
+}
+
+func mustSiteKey(t *testing.T, list *List, host string) uint64 {
+	var key, err = list.SiteKey(host)
+	if err != nil {
+		t.Fatalf("%q: unexpected error: %s", host, err.Error())
+	}
+
+	return key
+}
@@ -0,0 +1,435 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	if err := SetEmbedded(listBytes); err != nil {
+		panic(fmt.Sprintf("error while initialising Public Suffix List from list.go: %s", err.Error()))
+	}
+
+	setInitialDate()
+
+	// not used after initialisation, set to nil for garbage collector
+	listBytes = nil
+}
+
+// SetEmbedded replaces the statically compiled list, used by NewList and by
+// the package level functions until the list is updated, with snapshot, a list
+// serialised by Write.
+//
+// It is meant to be called by the init function of packages providing a more
+// recent list, such as github.com/globalsign/publicsuffix/data, and isn't safe
+// to call concurrently with other functions of this package. The build date of
+// the list, see EmbeddedBuildDate, is the date declared by its header.
+func SetEmbedded(snapshot []byte) error {
+	var ri, err = readSnapshot(snapshot, false)
+	if err != nil {
+		return err
+	}
+
+	setEmbedded(ri)
+
+	return nil
+}
+
+// Write atomically encodes the currently loaded public suffix list as JSON and compresses and
+// writes it to w. The legacy format read by every release of this package is
+// written, see WriteVersion.
+func Write(w io.Writer) error {
+	return defaultList().Write(w)
+}
+
+// Write atomically encodes the list as JSON and compresses and writes it to w.
+func (l *List) Write(w io.Writer) error {
+	return l.WriteVersion(w, SnapshotLegacy)
+}
+
+// Versions of the snapshot format written by WriteVersion. Read supports all
+// of them.
+const (
+	// SnapshotLegacy is the zlib compressed JSON written by Write, which
+	// every release of this package reads.
+	SnapshotLegacy = 1
+	// SnapshotSegmented stores the rules of each section in its own segment,
+	// so that reading only the ICANN rules skips the private ones. Releases
+	// of this package which don't support it can't read it, so it must only
+	// be written once every reader is upgraded.
+	SnapshotSegmented = 3
+)
+
+// WriteVersion atomically writes the currently loaded public suffix list to w
+// in the given version of the snapshot format.
+func WriteVersion(w io.Writer, version int) error {
+	return defaultList().WriteVersion(w, version)
+}
+
+// WriteVersion atomically writes the list to w in the given version of the
+// snapshot format, see the package level WriteVersion.
+func (l *List) WriteVersion(w io.Writer, version int) error {
+	return writeSnapshot(w, l.load(), version)
+}
+
+// Read loads a public suffix list serialised and compressed by Write and uses it for future
+// lookups. Snapshots written by previous releases of this package are
+// supported, as well as the uncompressed JSON they encode, as persisted by some
+// older forks. Truncated or inconsistent snapshots are rejected with an error
+// matching ErrInvalidData, the current list is then kept. Errors reading r,
+// which may be a remote file, match ErrNetwork.
+//
+// The ICANNOnly option discards the rules of the private section, they aren't
+// even decoded from snapshots written with SnapshotSegmented.
+func Read(r io.Reader, opts ...Option) error {
+	return defaultList().Read(r, opts...)
+}
+
+// Read loads a public suffix list serialised and compressed by Write into l.
+func (l *List) Read(r io.Reader, opts ...Option) error {
+	return l.loaded(l.read(r, opts))
+}
+
+// read loads the snapshot read from r in l, see Read.
+func (l *List) read(r io.Reader, opts []Option) error {
+	var o = l.options(opts)
+
+	var snapshot, err = ioutil.ReadAll(r)
+	if err != nil {
+		return networkError(err)
+	}
+
+	var tempRulesInfo rulesInfo
+	tempRulesInfo, err = readSnapshot(snapshot, o.icannOnly)
+	if err != nil {
+		return err
+	}
+
+	if err := o.checkSize(&tempRulesInfo); err != nil {
+		return err
+	}
+
+	tempRulesInfo.provenance = newSnapshotProvenance(tempRulesInfo.Release, snapshot)
+	l.store(tempRulesInfo)
+
+	return nil
+}
+
+// Snapshots start with snapshotMagic followed by a single version byte, except
+// the ones of version 1, the zlib compressed JSON written by the first
+// releases of this package without any header. The first byte of a
+// zlib stream never matches snapshotMagic, nor '{' which starts the
+// uncompressed JSON of a list.
+//
+// Since version 3 the header is followed by segments, each made of a section
+// byte, the big-endian uint32 length of its content and the zlib compressed
+// JSON of its rules. The ICANN segment comes first and holds the release and
+// header of the list, the private segment may follow. A reader only wanting
+// the ICANN rules doesn't decompress the private segment.
+const snapshotMagic = "\x89PSL"
+
+// segmentHeaderSize is the size of the section byte and length of a segment.
+const segmentHeaderSize = 5
+
+// writeSnapshot writes ri to w in the given version of the snapshot format.
+func writeSnapshot(w io.Writer, ri *rulesInfo, version int) error {
+	switch version {
+	case SnapshotLegacy:
+		return writeSnapshotV1(w, ri)
+	case SnapshotSegmented:
+	default:
+		return fmt.Errorf("publicsuffix: unsupported snapshot version %d", version)
+	}
+
+	if _, err := w.Write(append([]byte(snapshotMagic), byte(version))); err != nil {
+		return err
+	}
+
+	var icann, private = make(map[string][]rule), make(map[string][]rule)
+	for key, rules := range ri.Map {
+		for _, rule := range rules {
+			if rule.ICANN {
+				icann[key] = append(icann[key], rule)
+			} else {
+				private[key] = append(private[key], rule)
+			}
+		}
+	}
+
+	var head = rulesInfo{Map: icann, Release: ri.Release, ICANNOnly: ri.ICANNOnly, Header: ri.Header}
+	if err := writeSegment(w, ICANNSection, head); err != nil {
+		return err
+	}
+
+	if ri.ICANNOnly {
+		return nil
+	}
+
+	return writeSegment(w, PrivateSection, rulesInfo{Map: private})
+}
+
+// writeSnapshotV1 writes the zlib compressed JSON encoding of ri to w.
+func writeSnapshotV1(w io.Writer, ri *rulesInfo) error {
+	var zlibWriter = zlib.NewWriter(w)
+
+	if err := json.NewEncoder(zlibWriter).Encode(ri); err != nil {
+		zlibWriter.Close()
+		return err
+	}
+
+	return zlibWriter.Close()
+}
+
+// writeSegment writes the segment of section holding the rules of ri to w.
+func writeSegment(w io.Writer, section Section, ri rulesInfo) error {
+	var content bytes.Buffer
+	var zlibWriter = zlib.NewWriter(&content)
+
+	if err := json.NewEncoder(zlibWriter).Encode(ri); err != nil {
+		zlibWriter.Close()
+		return err
+	}
+
+	if err := zlibWriter.Close(); err != nil {
+		return err
+	}
+
+	var header [segmentHeaderSize]byte
+	header[0] = byte(section)
+	binary.BigEndian.PutUint32(header[1:], uint32(content.Len()))
+
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := content.WriteTo(w)
+
+	return err
+}
+
+// readSnapshot decodes a snapshot written by any version of Write. If
+// icannOnly is set the rules of the private section are discarded.
+func readSnapshot(snapshot []byte, icannOnly bool) (rulesInfo, error) {
+	var ri rulesInfo
+	var err error
+
+	switch {
+	case bytes.HasPrefix(bytes.TrimLeft(snapshot, " \t\r\n"), []byte("{")):
+		ri, err = readSnapshotJSON(snapshot)
+	case !bytes.HasPrefix(snapshot, []byte(snapshotMagic)):
+		ri, err = readSnapshotV1(snapshot)
+	default:
+		var payload = snapshot[len(snapshotMagic):]
+		if len(payload) == 0 {
+			return rulesInfo{}, dataError(errors.New("truncated snapshot header"))
+		}
+
+		switch version := payload[0]; version {
+		case 3:
+			return readSnapshotV3(payload[1:], icannOnly)
+		default:
+			return rulesInfo{}, dataError(fmt.Errorf("unsupported snapshot version %d", version))
+		}
+	}
+
+	if err != nil || !icannOnly {
+		return ri, err
+	}
+
+	return ri.withoutPrivate(), nil
+}
+
+// readSnapshotV1 decodes the zlib compressed JSON encoding of rulesInfo.
+func readSnapshotV1(snapshot []byte) (rulesInfo, error) {
+	var ri rulesInfo
+	if err := decodeSegment(snapshot, &ri); err != nil {
+		return rulesInfo{}, err
+	}
+
+	if err := ri.validate(); err != nil {
+		return rulesInfo{}, dataError(fmt.Errorf("corrupt snapshot: %w", err))
+	}
+
+	return ri, nil
+}
+
+// readSnapshotJSON decodes the uncompressed JSON encoding of rulesInfo.
+func readSnapshotJSON(snapshot []byte) (rulesInfo, error) {
+	var ri rulesInfo
+	if err := json.Unmarshal(snapshot, &ri); err != nil {
+		return rulesInfo{}, dataError(fmt.Errorf("json error: %w", err))
+	}
+
+	if err := ri.validate(); err != nil {
+		return rulesInfo{}, dataError(fmt.Errorf("corrupt snapshot: %w", err))
+	}
+
+	return ri, nil
+}
+
+// readSnapshotV3 decodes the segments of a snapshot, skipping the private
+// segment if icannOnly is set.
+func readSnapshotV3(segments []byte, icannOnly bool) (rulesInfo, error) {
+	var ri rulesInfo
+	var icannRead bool
+
+	for len(segments) > 0 {
+		if len(segments) < segmentHeaderSize {
+			return rulesInfo{}, dataError(errors.New("truncated snapshot segment header"))
+		}
+
+		var section = Section(segments[0])
+		var length = binary.BigEndian.Uint32(segments[1:segmentHeaderSize])
+		segments = segments[segmentHeaderSize:]
+
+		if uint64(length) > uint64(len(segments)) {
+			return rulesInfo{}, dataError(fmt.Errorf("truncated %s snapshot segment", section))
+		}
+
+		var segment = segments[:length]
+		segments = segments[length:]
+
+		switch {
+		case section == ICANNSection && !icannRead:
+			if err := decodeSegment(segment, &ri); err != nil {
+				return rulesInfo{}, err
+			}
+
+			// the rules of the ICANN segment must all be ICANN rules
+			var icannOnlyList = ri.ICANNOnly
+			ri.ICANNOnly = true
+			if err := ri.validate(); err != nil {
+				return rulesInfo{}, dataError(fmt.Errorf("corrupt snapshot: %w", err))
+			}
+			ri.ICANNOnly = icannOnlyList || icannOnly
+
+			icannRead = true
+
+		case section == PrivateSection && icannRead:
+			if icannOnly {
+				continue
+			}
+
+			var private rulesInfo
+			if err := decodeSegment(segment, &private); err != nil {
+				return rulesInfo{}, err
+			}
+
+			if err := private.validate(); err != nil {
+				return rulesInfo{}, dataError(fmt.Errorf("corrupt snapshot: %w", err))
+			}
+
+			for key, rules := range private.Map {
+				for _, rule := range rules {
+					if rule.ICANN {
+						return rulesInfo{}, dataError(fmt.Errorf("corrupt snapshot: ICANN rule %q in the private segment", rule.DottedName))
+					}
+				}
+
+				ri.Map[key] = append(ri.Map[key], rules...)
+			}
+
+		default:
+			return rulesInfo{}, dataError(fmt.Errorf("unexpected %s snapshot segment", section))
+		}
+	}
+
+	if !icannRead {
+		return rulesInfo{}, dataError(errors.New("missing icann snapshot segment"))
+	}
+
+	return ri, nil
+}
+
+// decodeSegment decodes the zlib compressed JSON in segment into v.
+func decodeSegment(segment []byte, v interface{}) error {
+	var zlibReader, err = zlib.NewReader(bytes.NewReader(segment))
+	if err != nil {
+		return dataError(fmt.Errorf("zlib error: %w", err))
+	}
+	defer zlibReader.Close()
+
+	if err := json.NewDecoder(zlibReader).Decode(v); err != nil {
+		return dataError(fmt.Errorf("json error: %w", err))
+	}
+
+	// the checksum of the zlib stream is only verified at its end
+	if _, err := io.Copy(io.Discard, zlibReader); err != nil {
+		return dataError(fmt.Errorf("zlib error: %w", err))
+	}
+
+	return nil
+}
+
+// validate checks that the rules decoded from a snapshot are consistent with
+// the ones built by the parser, so that a damaged snapshot is rejected rather
+// than loaded partially.
+func (ri *rulesInfo) validate() error {
+	if ri.Map == nil {
+		return errors.New("missing rules")
+	}
+
+	for key, rules := range ri.Map {
+		if key == "" || len(rules) == 0 {
+			return fmt.Errorf("empty entry %q", key)
+		}
+
+		for _, rule := range rules {
+			var name = rule.DottedName
+			switch rule.RuleType {
+			case normal:
+				if strings.HasPrefix(name, "*") || strings.HasPrefix(name, "!") {
+					return fmt.Errorf("normal rule %q with a wildcard or exclamation mark", name)
+				}
+			case wildcard:
+				if !strings.HasPrefix(name, "*.") {
+					return fmt.Errorf("wildcard rule %q without wildcard", name)
+				}
+				name = name[1:]
+			case exception:
+				if !strings.HasPrefix(name, "!") {
+					return fmt.Errorf("exception rule %q without exclamation mark", name)
+				}
+				name = name[1:]
+			default:
+				return fmt.Errorf("rule %q of unknown kind %d", name, int(rule.RuleType))
+			}
+
+			if got := strings.Replace(name, ".", "", -1); got != key {
+				return fmt.Errorf("rule %q stored under %q", rule.DottedName, key)
+			}
+
+			if ri.ICANNOnly && !rule.ICANN {
+				return fmt.Errorf("private rule %q in an ICANN only list", rule.DottedName)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,430 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_ReadSnapshot(t *testing.T) {
+	var list = NewList()
+
+	t.Run("Legacy", func(t *testing.T) {
+		// snapshots of the first releases are zlib compressed JSON without header
+		var snapshot bytes.Buffer
+		var zlibWriter = zlib.NewWriter(&snapshot)
+		json.NewEncoder(zlibWriter).Encode(map[string]interface{}{
+			"Map":     map[string][]rule{"jp": {{DottedName: "jp", ICANN: true}}},
+			"Release": "legacy",
+		})
+		zlibWriter.Close()
+
+		if err := list.Read(&snapshot); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if release := list.Release(); release != "legacy" {
+			t.Fatalf("got: %s, want: %s", release, "legacy")
+		}
+
+		if suffix, icann := list.PublicSuffix("example.jp"); suffix != "jp" || !icann {
+			t.Fatalf("got: %s %v, want: %s %v", suffix, icann, "jp", true)
+		}
+	})
+
+	t.Run("Uncompressed", func(t *testing.T) {
+		var snapshot = bytes.NewBufferString(`{"Map":{"jp":[{"DottedName":"jp","ICANN":true}]},"Release":"uncompressed"}`)
+
+		if err := list.Read(snapshot); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if release := list.Release(); release != "uncompressed" {
+			t.Fatalf("got: %s, want: %s", release, "uncompressed")
+		}
+
+		if err := list.Read(bytes.NewBufferString(`{"Map":`)); !errors.Is(err, ErrInvalidData) {
+			t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+		}
+	})
+
+	t.Run("Current", func(t *testing.T) {
+		var snapshot bytes.Buffer
+		if err := NewList().Write(&snapshot); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if err := list.Read(&snapshot); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if release := list.Release(); release != initialRelease {
+			t.Fatalf("got: %s, want: %s", release, initialRelease)
+		}
+	})
+
+	t.Run("Unsupported version", func(t *testing.T) {
+		var err = list.Read(bytes.NewBufferString(snapshotMagic + "\xff"))
+
+		if err == nil || err.Error() != "unsupported snapshot version 255" {
+			t.Fatalf("got: %v, want: %s", err, "unsupported snapshot version 255")
+		}
+
+		if !errors.Is(err, ErrInvalidData) {
+			t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+		}
+
+		// no release of this package writes version 2
+		err = list.Read(bytes.NewBufferString(snapshotMagic + "\x02"))
+		if err == nil || err.Error() != "unsupported snapshot version 2" {
+			t.Fatalf("got: %v, want: %s", err, "unsupported snapshot version 2")
+		}
+	})
+}
+
+func Test_ReadCorruptSnapshot(t *testing.T) {
+	var list = NewList()
+
+	var valid bytes.Buffer
+	if err := list.WriteVersion(&valid, SnapshotSegmented); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var legacy = snapshotOf(*list.load())
+
+	var tests = []struct {
+		name     string
+		snapshot []byte
+		err      string
+	}{
+		{"Truncated", valid.Bytes()[:valid.Len()/2], "truncated"},
+		{"No segment", []byte(snapshotMagic + "\x03"), "missing icann snapshot segment"},
+		{"Checksum", legacy[:len(legacy)-1], "zlib error: unexpected EOF"},
+		{"No rules", snapshotOf(rulesInfo{Release: "x"}), "missing rules"},
+		{"Empty key", snapshotOf(rulesInfo{Map: map[string][]rule{"": {{DottedName: ""}}}}), `empty entry ""`},
+		{"Wrong key", snapshotOf(rulesInfo{Map: map[string][]rule{"jp": {{DottedName: "co.jp"}}}}), `rule "co.jp" stored under "jp"`},
+		{"Wildcard", snapshotOf(rulesInfo{Map: map[string][]rule{"jp": {{DottedName: "jp", RuleType: wildcard}}}}), `wildcard rule "jp" without wildcard`},
+		{"Kind", snapshotOf(rulesInfo{Map: map[string][]rule{"jp": {{DottedName: "jp", RuleType: 7}}}}), `rule "jp" of unknown kind 7`},
+		{"ICANN only", snapshotOf(rulesInfo{Map: map[string][]rule{"jp": {{DottedName: "jp"}}}, ICANNOnly: true}), `private rule "jp" in an ICANN only list`},
+	}
+
+	for _, tt := range tests {
+		var tt = tt
+		t.Run(tt.name, func(t *testing.T) {
+			var err = list.Read(bytes.NewReader(tt.snapshot))
+			if err == nil || !strings.Contains(err.Error(), tt.err) || !errors.Is(err, ErrInvalidData) {
+				t.Fatalf("got: %v, want: %s", err, tt.err)
+			}
+
+			// the current list is kept
+			if release := list.Release(); release != initialRelease {
+				t.Fatalf("got: %s, want: %s", release, initialRelease)
+			}
+		})
+	}
+}
+
+// snapshotOf returns the version 1 snapshot of ri, which isn't split in
+// sections.
+func snapshotOf(ri rulesInfo) []byte {
+	var snapshot bytes.Buffer
+
+	var zlibWriter = zlib.NewWriter(&snapshot)
+	json.NewEncoder(zlibWriter).Encode(ri)
+	zlibWriter.Close()
+
+	return snapshot.Bytes()
+}
+
+func Test_ReadSnapshotSections(t *testing.T) {
+	var list, err = ParseList(strings.NewReader(rulesTestList), "sections_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var snapshot bytes.Buffer
+	if err := list.WriteVersion(&snapshot, SnapshotSegmented); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// damage the private segment, which follows the ICANN segment
+	var damaged = append([]byte(nil), snapshot.Bytes()...)
+	var icannLength = binary.BigEndian.Uint32(damaged[len(snapshotMagic)+2:])
+	damaged[len(snapshotMagic)+1+segmentHeaderSize+int(icannLength)+segmentHeaderSize] ^= 0xff
+
+	if err := list.Read(bytes.NewReader(damaged)); !errors.Is(err, ErrInvalidData) {
+		t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+	}
+
+	// the private segment isn't decoded when only the ICANN rules are read
+	if err := list.Read(bytes.NewReader(damaged), ICANNOnly()); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = []string{"!city.kobe.jp", "*.kobe.jp", "jp", "kobe.jp"}
+	if got := list.Suffixes(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, want: %v", got, expected)
+	}
+
+	// both sections are merged otherwise
+	if err := list.Read(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expected = []string{"!city.kobe.jp", "*.compute.example.jp", "*.kobe.jp", "blogspot.jp", "jp", "kobe.jp"}
+	if got := list.Suffixes(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, want: %v", got, expected)
+	}
+}
+
+func Test_SnapshotPatterns(t *testing.T) {
+	var ri, err = newList(bytes.NewBufferString("jp\n*.*.cloud.jp\nedge.*.cdn.jp\n!www.*.cdn.jp\n"), "snapshot_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, version := range []int{SnapshotLegacy, SnapshotSegmented} {
+		var snapshot bytes.Buffer
+		if err := writeSnapshot(&snapshot, ri, version); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var read rulesInfo
+		read, err = readSnapshot(snapshot.Bytes(), false)
+		if err != nil {
+			t.Fatalf("version %d: unexpected error: %s", version, err.Error())
+		}
+
+		var l = &List{}
+		l.store(read)
+
+		if suffix, _ := l.PublicSuffix("www.edge.eu.cdn.jp"); suffix != "edge.eu.cdn.jp" {
+			t.Fatalf("version %d: got: %s, want: %s", version, suffix, "edge.eu.cdn.jp")
+		}
+	}
+}
+
+func FuzzRead(f *testing.F) {
+	var source, err = ParseList(bytes.NewBufferString("// VERSION: fuzz\n\n// ===BEGIN ICANN DOMAINS===\njp\n*.kobe.jp\n!city.kobe.jp\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nblogspot.jp\n"), "fuzz")
+	if err != nil {
+		f.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var snapshot bytes.Buffer
+	if err := source.Write(&snapshot); err != nil {
+		f.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	f.Add(snapshot.Bytes())
+	f.Add([]byte(snapshotMagic + "\x03"))
+	f.Add([]byte(`{"Map":{"jp":[{"DottedName":"jp","ICANN":true}]},"Release":"fuzz"}`))
+
+	f.Fuzz(func(t *testing.T, snapshot []byte) {
+		var l, _ = ParseList(bytes.NewBufferString("com\n"), "before")
+		var before = l.load()
+
+		if err := l.Read(bytes.NewReader(snapshot)); err != nil {
+			if l.load() != before {
+				t.Fatalf("a list was installed despite the error: %s", err.Error())
+			}
+			return
+		}
+
+		var ri = l.load()
+		if err := ri.validate(); err != nil {
+			t.Fatalf("invalid list installed: %s", err.Error())
+		}
+
+		for _, domain := range []string{"example.com", "www.example.kobe.jp", "a.b.c.d", "com", ""} {
+			l.PublicSuffix(domain)
+			l.EffectiveTLDPlusOne(domain)
+		}
+
+		// the list installed can be written and read again
+		var written bytes.Buffer
+		if err := l.Write(&written); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if err := l.Read(&written); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	})
+}
+
+func Test_WriteVersion(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("ac\ncom.ac\n"), "write_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var bytes bytes.Buffer
+	if err := list.WriteVersion(&bytes, SnapshotSegmented); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var header = []byte(snapshotMagic + "\x03")
+	if got := bytes.Next(len(header)); string(got) != string(header) {
+		t.Fatalf("got: %q, want: %q", got, header)
+	}
+
+	// The compressed bytes depend on the zlib implementation of the toolchain,
+	// compare the decompressed content of the segments instead.
+	var expected = []struct {
+		section Section
+		content string
+	}{
+		{ICANNSection, `{"Map":{},"Release":"write_test"}` + "\n"},
+		{PrivateSection, `{"Map":{"ac":[{"DottedName":"ac","RuleType":0,"ICANN":false}],"comac":[{"DottedName":"com.ac","RuleType":0,"ICANN":false}]},"Release":""}` + "\n"},
+	}
+
+	for _, segment := range expected {
+		var header = bytes.Next(segmentHeaderSize)
+		if len(header) != segmentHeaderSize || Section(header[0]) != segment.section {
+			t.Fatalf("got: %q, want: %s segment", header, segment.section)
+		}
+
+		var zlibReader, err = zlib.NewReader(io.LimitReader(&bytes, int64(binary.BigEndian.Uint32(header[1:]))))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var content, _ = ioutil.ReadAll(zlibReader)
+		if strings.Compare(string(content), segment.content) != 0 {
+			t.Fatalf("got: %#v, want: %#v", string(content), segment.content)
+		}
+	}
+
+	if bytes.Len() != 0 {
+		t.Fatalf("got: %d trailing bytes, want: %d", bytes.Len(), 0)
+	}
+
+	if err := list.WriteVersion(&bytes, 2); err == nil {
+		t.Fatalf("got: %v, want: an error", err)
+	}
+}
+
+func Test_SnapshotHeader(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("// VERSION: 2024-06-26_08-54-27_UTC\n// COMMIT: 0b5a2c8e7a1c6c8d6e3e6f2b1c3d4e5f6a7b8c9d\n\njp\n"), "snapshot_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = list.Header()
+
+	var snapshot bytes.Buffer
+	if err := list.Write(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var copied = NewList()
+	if err := copied.Read(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := copied.Header(); got.Version != expected.Version || got.Commit != expected.Commit || !got.Date.Equal(expected.Date) || got.Version == "" {
+		t.Fatalf("got: %+v, want: %+v", got, expected)
+	}
+}
+
+func Test_ReadICANNOnly(t *testing.T) {
+	installRulesTestList(t)
+
+	var expected = []string{"!city.kobe.jp", "*.kobe.jp", "jp", "kobe.jp"}
+
+	var snapshot bytes.Buffer
+	if err := Write(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := Read(&snapshot, ICANNOnly()); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := Suffixes(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, want: %v", got, expected)
+	}
+
+	if suffix, _ := PublicSuffix("foo.blogspot.jp"); suffix != "jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "jp")
+	}
+}
+
+func Test_ReadMinRules(t *testing.T) {
+	installRulesTestList(t)
+
+	var snapshot bytes.Buffer
+	if err := Write(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// the ICANN section of rulesTestList has 4 rules
+	if err := Read(&snapshot, ICANNOnly(), MinRules(5)); !errors.Is(err, ErrListTooSmall) {
+		t.Fatalf("got: %v, want: %v", err, ErrListTooSmall)
+	}
+
+	if got := Release(); got != "rules_test" {
+		t.Fatalf("got: %s, want: %s", got, "rules_test")
+	}
+}
+
+func Test_SetEmbedded(t *testing.T) {
+	preserveRules(t)
+	var saved = embeddedRules
+	t.Cleanup(func() { embeddedRules = saved })
+
+	// the default list is only replaced if it is the embedded one
+	defaultList().store(*embeddedRules)
+
+	var source, err = ParseList(strings.NewReader(rulesTestList), "embedded_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var snapshot bytes.Buffer
+	if err := source.Write(&snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := SetEmbedded([]byte("not a snapshot")); !errors.Is(err, ErrInvalidData) {
+		t.Fatalf("got: %v, want: %v", err, ErrInvalidData)
+	}
+
+	if err := SetEmbedded(snapshot.Bytes()); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if release := NewList().Release(); release != "embedded_test" {
+		t.Fatalf("got: %s, want: %s", release, "embedded_test")
+	}
+
+	if provenance := CurrentProvenance(); provenance.Source != SourceEmbedded || provenance.Release != "embedded_test" {
+		t.Fatalf("unexpected provenance: %+v", provenance)
+	}
+}
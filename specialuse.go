@@ -0,0 +1,155 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SpecialUse classifies the special-use domain names reserved by RFC 6761 and
+// RFC 6762, which will never be publicly registrable domains. The public
+// suffix list doesn't contain them, so they would otherwise be handled like
+// unlisted TLDs.
+type SpecialUse int
+
+const (
+	// NotSpecialUse is a domain which isn't special-use.
+	NotSpecialUse SpecialUse = iota
+	// SpecialUseLocalhost is "localhost" and its subdomains, which refer to
+	// the local host.
+	SpecialUseLocalhost
+	// SpecialUseTest is a domain under "test", reserved for testing.
+	SpecialUseTest
+	// SpecialUseInvalid is a domain under "invalid", guaranteed not to exist.
+	SpecialUseInvalid
+	// SpecialUseExample is a domain under "example", "example.com",
+	// "example.net" or "example.org", reserved for documentation.
+	SpecialUseExample
+	// SpecialUseLocal is a domain under "local", resolved with multicast DNS
+	// on the local link (RFC 6762).
+	SpecialUseLocal
+	// SpecialUseOnion is a domain under "onion", a Tor hidden service which
+	// isn't resolved with DNS (RFC 7686).
+	SpecialUseOnion
+)
+
+// specialUseNames are the special-use names, keyed by name.
+var specialUseNames = map[string]SpecialUse{
+	"localhost":   SpecialUseLocalhost,
+	"test":        SpecialUseTest,
+	"invalid":     SpecialUseInvalid,
+	"example":     SpecialUseExample,
+	"example.com": SpecialUseExample,
+	"example.net": SpecialUseExample,
+	"example.org": SpecialUseExample,
+	"local":       SpecialUseLocal,
+	"onion":       SpecialUseOnion,
+}
+
+// String returns the special-use name of s, such as "localhost", or "none".
+func (s SpecialUse) String() string {
+	switch s {
+	case NotSpecialUse:
+		return "none"
+	case SpecialUseLocalhost:
+		return "localhost"
+	case SpecialUseTest:
+		return "test"
+	case SpecialUseInvalid:
+		return "invalid"
+	case SpecialUseExample:
+		return "example"
+	case SpecialUseLocal:
+		return "local"
+	case SpecialUseOnion:
+		return "onion"
+	default:
+		return fmt.Sprintf("SpecialUse(%d)", int(s))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler using the name of s.
+func (s SpecialUse) MarshalText() ([]byte, error) {
+	if s < NotSpecialUse || s > SpecialUseOnion {
+		return nil, fmt.Errorf("publicsuffix: invalid %s", s)
+	}
+
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the names
+// returned by String.
+func (s *SpecialUse) UnmarshalText(text []byte) error {
+	for special := NotSpecialUse; special <= SpecialUseOnion; special++ {
+		if special.String() == string(text) {
+			*s = special
+			return nil
+		}
+	}
+
+	return fmt.Errorf("publicsuffix: unknown special use %q", text)
+}
+
+// IsDNS reports whether the domains classified as s are resolved with DNS, it
+// is only false for SpecialUseOnion.
+func (s SpecialUse) IsDNS() bool {
+	return s != SpecialUseOnion
+}
+
+// SpecialUseOf returns the classification of domain if it is, or is under, a
+// special-use name. The comparison ignores case and a trailing dot.
+func SpecialUseOf(domain string) SpecialUse {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	// the special-use names have at most two labels
+	var dot = strings.LastIndex(domain, ".")
+	if special, found := specialUseNames[domain[dot+1:]]; found {
+		return special
+	}
+
+	if dot != -1 {
+		var start = strings.LastIndex(domain[:dot], ".")
+		if special, found := specialUseNames[domain[start+1:]]; found {
+			return special
+		}
+	}
+
+	return NotSpecialUse
+}
+
+// onionSuffix returns the public suffix of domain, a domain under "onion".
+// Per RFC 7686 the name of a hidden service is the label before "onion", the
+// suffix is then the last label whatever the rules of the list.
+func onionSuffix(domain string) string {
+	return domain[strings.LastIndex(domain, ".")+1:]
+}
+
+// onionMatch returns m, the match of domain, with the suffix given by
+// onionSuffix if domain is under "onion", so that every lookup agrees on it.
+// Domains ending with a dot keep their match, like for the other TLDs.
+func onionMatch(domain string, m match) match {
+	if len(domain) < len("onion") || !strings.EqualFold(domain[len(domain)-len("onion"):], "onion") {
+		return m
+	}
+
+	if SpecialUseOf(domain) == SpecialUseOnion {
+		m.suffix = onionSuffix(domain)
+	}
+
+	return m
+}
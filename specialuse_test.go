@@ -0,0 +1,121 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_SpecialUseOf(t *testing.T) {
+	var tests = []struct {
+		domain string
+		want   SpecialUse
+	}{
+		{"localhost", SpecialUseLocalhost},
+		{"db.localhost.", SpecialUseLocalhost},
+		{"foo.test", SpecialUseTest},
+		{"foo.INVALID", SpecialUseInvalid},
+		{"example", SpecialUseExample},
+		{"www.example.com", SpecialUseExample},
+		{"example.org", SpecialUseExample},
+		{"printer.local", SpecialUseLocal},
+		{"expyuzz4wqqyqhjn.ONION", SpecialUseOnion},
+		{"example.co.uk", NotSpecialUse},
+		{"localhost.com", NotSpecialUse},
+		{"test.com", NotSpecialUse},
+		{"com", NotSpecialUse},
+		{"", NotSpecialUse},
+	}
+
+	for _, tt := range tests {
+		if got := SpecialUseOf(tt.domain); got != tt.want {
+			t.Errorf("%q: got: %s, want: %s", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func Test_LookupSpecialUse(t *testing.T) {
+	var result, err = Lookup("www.example.test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if result.SpecialUse != SpecialUseTest || result.PublicSuffix != "test" {
+		t.Fatalf("got: %+v, want: %s under %s", result, SpecialUseTest, "test")
+	}
+}
+
+func Test_Onion(t *testing.T) {
+	// the rules of the list don't matter under onion
+	installRulesTestList(t)
+
+	var tests = []struct {
+		domain     string
+		registered string
+	}{
+		{"x.onion", "x.onion"},
+		{"www.x.onion", "x.onion"},
+	}
+
+	for _, tt := range tests {
+		if got, err := EffectiveTLDPlusOne(tt.domain); err != nil || got != tt.registered {
+			t.Fatalf("%q: got: %s (%v), want: %s", tt.domain, got, err, tt.registered)
+		}
+
+		var result, err = Lookup(tt.domain)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if result.PublicSuffix != "onion" || result.RegisteredDomain != tt.registered || result.SpecialUse.IsDNS() {
+			t.Fatalf("%q: got: %+v, want: non-DNS %s under %s", tt.domain, result, tt.registered, "onion")
+		}
+	}
+
+	// the other lookups agree, even with a rule below onion
+	var list, err = ParseList(strings.NewReader("// ===BEGIN PRIVATE DOMAINS===\n*.onion\n// ===END PRIVATE DOMAINS===\n"), "onion_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got, _ := list.PublicSuffix("www.x.onion"); got != "onion" {
+		t.Fatalf("got: %s, want: %s", got, "onion")
+	}
+	if got, _ := list.PublicSuffixWithBuffer("www.x.onion", &LookupBuffer{}); got != "onion" {
+		t.Fatalf("got: %s, want: %s", got, "onion")
+	}
+	if list.IsExactPublicSuffix("x.onion") || !list.IsExactPublicSuffix("onion") {
+		t.Fatalf("got: x.onion public suffix, want: onion")
+	}
+
+	// a trailing dot gives no public suffix, like for the other TLDs
+	for _, domain := range []string{"x.onion.", "x.com."} {
+		if got, icann := list.PublicSuffix(domain); got != "" || icann {
+			t.Fatalf("%q: got: %q %v, want: %q %v", domain, got, icann, "", false)
+		}
+
+		var want = domain[strings.Index(domain, ".")+1:]
+		if got, err := list.EffectiveTLDPlusOne(domain); err != nil || got != want {
+			t.Fatalf("%q: got: %q (%v), want: %q", domain, got, err, want)
+		}
+	}
+
+	if !SpecialUseLocal.IsDNS() || !NotSpecialUse.IsDNS() {
+		t.Fatalf("got: non-DNS, want: DNS")
+	}
+}
@@ -0,0 +1,72 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the normalized registered domain of
+// r, or NULL if it has none.
+func (r Result) Value() (driver.Value, error) {
+	if r.RegisteredDomain == "" {
+		return nil, nil
+	}
+
+	var domain, err = Normalize(r.RegisteredDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+// Scan implements sql.Scanner, setting r to the Lookup of the stored domain
+// using the default list. NULL sets the zero Result.
+func (r *Result) Scan(src interface{}) error {
+	var domain string
+	switch src := src.(type) {
+	case nil:
+		*r = Result{}
+		return nil
+	case string:
+		domain = src
+	case []byte:
+		domain = string(src)
+	default:
+		return fmt.Errorf("publicsuffix: cannot scan %T into Result", src)
+	}
+
+	var normalized, err = Normalize(domain)
+	if err != nil {
+		return err
+	}
+
+	var result Result
+	result, err = Lookup(normalized)
+	if err != nil {
+		return err
+	}
+
+	*r = result
+
+	return nil
+}
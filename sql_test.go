@@ -0,0 +1,79 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Result{}
+	_ sql.Scanner   = &Result{}
+)
+
+func Test_ResultValue(t *testing.T) {
+	installRulesTestList(t)
+
+	var result, err = Lookup("www.Example.JP")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var value driver.Value
+	value, err = result.Value()
+	if err != nil || value != "example.jp" {
+		t.Fatalf("got: %v %v, want: %v", value, err, "example.jp")
+	}
+
+	if value, err := (Result{PublicSuffix: "jp"}).Value(); err != nil || value != nil {
+		t.Fatalf("got: %v %v, want: %v", value, err, nil)
+	}
+}
+
+func Test_ResultScan(t *testing.T) {
+	installRulesTestList(t)
+
+	var tests = []struct {
+		src  interface{}
+		want Result
+	}{
+		{"city.kobe.jp", Result{PublicSuffix: "kobe.jp", RegisteredDomain: "city.kobe.jp", ICANN: true, Kind: MatchException}},
+		{[]byte("Example.JP"), Result{PublicSuffix: "jp", RegisteredDomain: "example.jp", ICANN: true, Kind: MatchNormal}},
+		{nil, Result{}},
+	}
+
+	for _, tt := range tests {
+		var result = Result{PublicSuffix: "stale"}
+		if err := result.Scan(tt.src); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if result != tt.want {
+			t.Fatalf("got: %+v, want: %+v", result, tt.want)
+		}
+	}
+
+	var result Result
+	if err := result.Scan(42); err == nil {
+		t.Fatalf("got: %v, want: an error", err)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build tinygo || publicsuffix_lite
+// +build tinygo publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+// Lite builds, made by TinyGo or with the publicsuffix_lite build tag, leave
+// out the parts of the package depending on net/http, encoding/json and
+// net/http/cookiejar, for firmware and edge deployments which only need
+// lookups: Update and the GitHub retrievers, snapshots (Read, Write,
+// SetEmbedded and the functions built on them), the remote engine, the
+// canary and the cookiejar lists. Lists can still be loaded with
+// UpdateWithListRetriever and ParseList.
+//
+// The list is compiled as a table of rules, list_static.go, rather than as a
+// snapshot, so it doesn't need decoding at initialisation.
+
+func init() {
+	var ri = rulesInfo{Map: make(map[string][]rule), Release: initialRelease}
+	for _, rule := range staticRules {
+		var key = rule.key()
+		ri.Map[key] = append(ri.Map[key], rule)
+	}
+
+	setEmbedded(ri)
+	setInitialDate()
+
+	// not used after initialisation, set to nil for garbage collector
+	staticRules = nil
+}
+
+// canary is never set in lite builds, see SetCanary.
+type canary struct{}
+
+// check is a no-op.
+func (c *canary) check(domain, suffix string) {}
@@ -0,0 +1,107 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import "sync/atomic"
+
+// Stats counts the outcomes of the lookups of a list, see SetStats.
+type Stats struct {
+	// Normal is the number of lookups resolved by a normal rule.
+	Normal uint64
+	// Wildcard is the number of lookups resolved by a wildcard rule.
+	Wildcard uint64
+	// Exception is the number of lookups resolved by an exception rule.
+	Exception uint64
+	// Default is the number of lookups resolved by the implicit "*" rule as no
+	// rule matched.
+	Default uint64
+	// Private is the number of lookups resolved by a rule of the private
+	// section, they are also counted by the kind of the rule.
+	Private uint64
+}
+
+// stats holds the counters of Stats.
+type stats struct {
+	normal, wildcard, exception, implicit, private atomic.Uint64
+}
+
+// SetStats enables or disables counting the outcomes of the lookups of the
+// public suffix of a domain by the package level functions, such as
+// PublicSuffix, EffectiveTLDPlusOne and Lookup. Counting is disabled by
+// default as it adds atomic operations to every lookup. Enabling it resets the
+// counters.
+func SetStats(enabled bool) {
+	defaultList().SetStats(enabled)
+}
+
+// SetStats enables or disables counting the outcomes of the lookups of l, see
+// the package level SetStats.
+func (l *List) SetStats(enabled bool) {
+	if !enabled {
+		l.stats.Store(nil)
+		return
+	}
+
+	l.stats.Store(&stats{})
+}
+
+// CurrentStats returns the outcomes of the lookups by the package level
+// functions since SetStats enabled counting, or zero if it is disabled.
+func CurrentStats() Stats {
+	return defaultList().Stats()
+}
+
+// Stats returns the outcomes of the lookups of l, see CurrentStats.
+func (l *List) Stats() Stats {
+	var s = l.stats.Load()
+	if s == nil {
+		return Stats{}
+	}
+
+	return Stats{
+		Normal:    s.normal.Load(),
+		Wildcard:  s.wildcard.Load(),
+		Exception: s.exception.Load(),
+		Default:   s.implicit.Load(),
+		Private:   s.private.Load(),
+	}
+}
+
+// record counts the outcome of a lookup. It is a no-op on nil stats.
+func (s *stats) record(m match) {
+	if s == nil {
+		return
+	}
+
+	if !m.found {
+		s.implicit.Add(1)
+		return
+	}
+
+	switch m.kind {
+	case wildcard:
+		s.wildcard.Add(1)
+	case exception:
+		s.exception.Add(1)
+	default:
+		s.normal.Add(1)
+	}
+
+	if !m.icann {
+		s.private.Add(1)
+	}
+}
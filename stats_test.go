@@ -0,0 +1,56 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_Stats(t *testing.T) {
+	var list, err = ParseList(strings.NewReader(rulesTestList), "stats_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// disabled by default
+	list.PublicSuffix("example.jp")
+	if got := list.Stats(); got != (Stats{}) {
+		t.Fatalf("got: %+v, want: %+v", got, Stats{})
+	}
+
+	list.SetStats(true)
+
+	for _, domain := range []string{"example.jp", "www.example.jp", "www.city.kobe.jp", "www.blogspot.jp", "a.b.compute.example.jp", "example.com"} {
+		list.PublicSuffix(domain)
+	}
+
+	if _, err := list.Lookup("www.foo.kobe.jp"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var expected = Stats{Normal: 4, Wildcard: 1, Exception: 1, Default: 1, Private: 2}
+	if got := list.Stats(); got != expected {
+		t.Fatalf("got: %+v, want: %+v", got, expected)
+	}
+
+	// enabling again resets the counters
+	list.SetStats(true)
+	if got := list.Stats(); got != (Stats{}) {
+		t.Fatalf("got: %+v, want: %+v", got, Stats{})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxCandidateExamples is the number of hostnames kept as examples of a
+// SuffixCandidate.
+const maxCandidateExamples = 3
+
+// SuffixCandidate is a name suggested as a private suffix by a
+// SuffixAnalyzer.
+type SuffixCandidate struct {
+	// Name is the suggested suffix, a registered domain such as
+	// "platform.example" or a name below one, e.g. "eu.platform.example".
+	Name string
+	// Subdomains is the number of distinct labels observed directly below
+	// Name, e.g. 2 for "alice.platform.example" and "bob.platform.example".
+	Subdomains int
+	// Hosts is the number of hostnames observed below Name, duplicates
+	// included.
+	Hosts int
+	// Examples are the first hostnames observed below Name, at most 3.
+	Examples []string
+}
+
+// suffixCandidate accumulates the observations of a candidate.
+type suffixCandidate struct {
+	labels   map[string]struct{}
+	hosts    int
+	examples []string
+}
+
+// SuffixAnalyzer suggests private suffixes from a corpus of observed
+// hostnames: names under which many unrelated registrants appear, such as
+// the hosting platforms giving a subdomain to each of their users, which
+// should be submitted to the public suffix list or added to a local overlay
+// so that their users are isolated from each other.
+//
+// The heuristic counts the distinct labels observed directly below the
+// registered domains of the hostnames and the names between them: a name
+// with many distinct labels below it is likely to delegate them, while the
+// names of an organisation only have a few well known subdomains such as
+// "www" or "mail". Candidates are suggestions to be reviewed, they aren't
+// reliable on their own.
+//
+// A SuffixAnalyzer isn't safe for concurrent use. Its memory grows with the
+// number of distinct labels observed.
+type SuffixAnalyzer struct {
+	list       *List
+	candidates map[string]*suffixCandidate
+}
+
+// NewSuffixAnalyzer returns a SuffixAnalyzer finding the registered domains
+// of the hostnames with l, or with the default list if l is nil.
+func NewSuffixAnalyzer(l *List) *SuffixAnalyzer {
+	if l == nil {
+		l = defaultList()
+	}
+
+	return &SuffixAnalyzer{list: l, candidates: make(map[string]*suffixCandidate)}
+}
+
+// Add records host, which is normalised with Normalize. An error is returned,
+// and host is ignored, if it can't be normalised or has no registered domain.
+func (a *SuffixAnalyzer) Add(host string) error {
+	var normalized, err = Normalize(strings.TrimSuffix(host, "."))
+	if err != nil {
+		return err
+	}
+
+	var site string
+	site, err = a.list.EffectiveTLDPlusOne(normalized)
+	if err != nil {
+		return err
+	}
+
+	// every name from the registered domain to the parent of host is a
+	// candidate, with the label below it on the way to host
+	for name := normalized; name != site; {
+		var dot = strings.IndexByte(name, '.')
+		var label, parent = name[:dot], name[dot+1:]
+
+		var candidate = a.candidates[parent]
+		if candidate == nil {
+			candidate = &suffixCandidate{labels: make(map[string]struct{})}
+			a.candidates[parent] = candidate
+		}
+
+		candidate.labels[label] = struct{}{}
+		candidate.hosts++
+		if len(candidate.examples) < maxCandidateExamples && !containsString(candidate.examples, normalized) {
+			candidate.examples = append(candidate.examples, normalized)
+		}
+
+		name = parent
+	}
+
+	return nil
+}
+
+// containsString reports whether list holds s.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Suggest returns the names with at least minSubdomains distinct labels
+// observed directly below them, by decreasing number of labels and then by
+// name. A candidate below another one is suggested as well, e.g. both
+// "platform.example" and "eu.platform.example" when the platform delegates
+// names below each of its regions.
+func (a *SuffixAnalyzer) Suggest(minSubdomains int) []SuffixCandidate {
+	var suggested []SuffixCandidate
+	for name, candidate := range a.candidates {
+		if len(candidate.labels) < minSubdomains {
+			continue
+		}
+
+		suggested = append(suggested, SuffixCandidate{
+			Name:       name,
+			Subdomains: len(candidate.labels),
+			Hosts:      candidate.hosts,
+			Examples:   append([]string(nil), candidate.examples...),
+		})
+	}
+
+	sort.Slice(suggested, func(i, j int) bool {
+		if suggested[i].Subdomains != suggested[j].Subdomains {
+			return suggested[i].Subdomains > suggested[j].Subdomains
+		}
+
+		return suggested[i].Name < suggested[j].Name
+	})
+
+	return suggested
+}
+
+// SuggestPrivateSuffixes returns the private suffixes suggested by a
+// SuffixAnalyzer for hosts, the names with at least minSubdomains distinct
+// labels observed directly below them. Hostnames which can't be normalised
+// or have no registered domain are ignored.
+func SuggestPrivateSuffixes(hosts []string, minSubdomains int) []SuffixCandidate {
+	return defaultList().SuggestPrivateSuffixes(hosts, minSubdomains)
+}
+
+// SuggestPrivateSuffixes returns the private suffixes suggested for hosts
+// using l, see the package level SuggestPrivateSuffixes.
+func (l *List) SuggestPrivateSuffixes(hosts []string, minSubdomains int) []SuffixCandidate {
+	var analyzer = NewSuffixAnalyzer(l)
+	for _, host := range hosts {
+		analyzer.Add(host)
+	}
+
+	return analyzer.Suggest(minSubdomains)
+}
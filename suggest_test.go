@@ -0,0 +1,65 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_SuggestPrivateSuffixes(t *testing.T) {
+	var list, err = ParseList(strings.NewReader("// ===BEGIN ICANN DOMAINS===\ncom\nnet\n// ===END ICANN DOMAINS===\n"), "suggest_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var hosts = []string{
+		// an organisation
+		"www.example.com", "mail.example.com", "example.com", "WWW.Example.com.",
+		// a platform with a subdomain per user
+		"alice.pages.net", "www.alice.pages.net", "bob.pages.net", "carol.pages.net", "dave.pages.net",
+		// a platform with a subdomain per user below each region
+		"a.eu.cloud.net", "b.eu.cloud.net", "c.eu.cloud.net", "d.us.cloud.net",
+		// ignored
+		"com", "exa mple.com",
+	}
+
+	var want = []SuffixCandidate{
+		{Name: "pages.net", Subdomains: 4, Hosts: 5, Examples: []string{"alice.pages.net", "www.alice.pages.net", "bob.pages.net"}},
+		{Name: "eu.cloud.net", Subdomains: 3, Hosts: 3, Examples: []string{"a.eu.cloud.net", "b.eu.cloud.net", "c.eu.cloud.net"}},
+	}
+	if got := list.SuggestPrivateSuffixes(hosts, 3); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %+v, want: %+v", got, want)
+	}
+
+	var analyzer = NewSuffixAnalyzer(list)
+	for _, host := range hosts {
+		var err = analyzer.Add(host)
+		if (err != nil) != (host == "com" || host == "exa mple.com") {
+			t.Fatalf("%q: unexpected error: %v", host, err)
+		}
+	}
+
+	var names []string
+	for _, candidate := range analyzer.Suggest(2) {
+		names = append(names, candidate.Name)
+	}
+	if got := strings.Join(names, " "); got != "pages.net eu.cloud.net cloud.net example.com" {
+		t.Fatalf("got: %s, want: %s", got, "pages.net eu.cloud.net cloud.net example.com")
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+// Warm resolves domains ahead of time in the currently loaded list, and again
+// whenever a new list is loaded, before it is used. Their lookups are then
+// answered from a cache, so latency sensitive services don't take slower
+// lookups right after an update.
+//
+// domains replace the ones given to previous calls, nil stops warming. They
+// must be in the form passed to the lookup functions, e.g. normalised.
+func Warm(domains []string) {
+	defaultList().Warm(domains)
+}
+
+// Warm resolves domains ahead of time in l, see the package level Warm.
+func (l *List) Warm(domains []string) {
+	// a List which was never loaded gets its rules first
+	l.load()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.warm = append([]string(nil), domains...)
+
+	var ri = *l.rules.Load()
+	ri.warm = ri.resolve(l.warm)
+	l.rules.Store(&ri)
+}
+
+// resolve looks up domains with the engine of ri, returning the matches keyed
+// by domain.
+func (ri *rulesInfo) resolve(domains []string) map[string]match {
+	if len(domains) == 0 {
+		return nil
+	}
+
+	var matches = make(map[string]match, len(domains))
+	for _, domain := range domains {
+		matches[domain] = onionMatch(domain, ri.engine.lookup(domain))
+	}
+
+	return matches
+}
+
+// lookup looks up domain in the warm matches of ri, and then with its engine.
+// Nothing is found for domains exceeding the limits of ri, see WithLimits.
+func (ri *rulesInfo) lookup(domain string) match {
+	if ri.limits.check(domain) != nil {
+		return match{}
+	}
+
+	if m, found := ri.warm[domain]; found {
+		return m
+	}
+
+	return onionMatch(domain, ri.engine.lookup(domain))
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingEngine counts the lookups reaching the map engine.
+type countingEngine struct {
+	engine
+	lookups *int64
+}
+
+func (e countingEngine) lookup(domain string) match {
+	atomic.AddInt64(e.lookups, 1)
+	return e.engine.lookup(domain)
+}
+
+func Test_Warm(t *testing.T) {
+	var lookups int64
+	var list = NewList(withEngine(func(ri rulesInfo) engine {
+		return countingEngine{engine: newMapEngine(ri), lookups: &lookups}
+	}))
+
+	list.Warm([]string{"www.example.com", "foo.blogspot.jp"})
+	if lookups != 2 {
+		t.Fatalf("got: %d, want: %d", lookups, 2)
+	}
+
+	if suffix, _ := list.PublicSuffix("www.example.com"); suffix != "com" {
+		t.Fatalf("got: %s, want: %s", suffix, "com")
+	}
+	if lookups != 2 {
+		t.Fatalf("warm domain looked up again, got: %d, want: %d", lookups, 2)
+	}
+
+	// the domains are resolved again when a new list is loaded
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "warm_test"}
+	if err := list.UpdateWithListRetriever(mockRetriever); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if lookups != 4 {
+		t.Fatalf("got: %d, want: %d", lookups, 4)
+	}
+
+	if suffix, _ := list.PublicSuffix("foo.blogspot.jp"); suffix != "blogspot.jp" {
+		t.Fatalf("got: %s, want: %s", suffix, "blogspot.jp")
+	}
+	if suffix, _ := list.PublicSuffix("www.example.com"); suffix != "com" {
+		t.Fatalf("got: %s, want: %s", suffix, "com")
+	}
+	if lookups != 4 {
+		t.Fatalf("warm domain looked up again, got: %d, want: %d", lookups, 4)
+	}
+
+	list.Warm(nil)
+	list.PublicSuffix("www.example.com")
+	if lookups != 5 {
+		t.Fatalf("got: %d, want: %d", lookups, 5)
+	}
+}
+
+func Test_WarmZeroList(t *testing.T) {
+	var list List
+	var done = make(chan struct{})
+	go func() {
+		list.Warm([]string{"www.example.com"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Warm of a zero List didn't return")
+	}
+
+	if suffix, _ := list.PublicSuffix("www.example.com"); suffix != "com" {
+		t.Fatalf("got: %s, want: %s", suffix, "com")
+	}
+	if _, found := list.load().warm["www.example.com"]; !found {
+		t.Fatalf("got: not warm, want: warm")
+	}
+}
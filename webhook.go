@@ -0,0 +1,232 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Repository and file of the public suffix list watched by WebhookHandler.
+const (
+	webhookRepository = "publicsuffix/list"
+	webhookFile       = "public_suffix_list.dat"
+)
+
+// maxWebhookPayload bounds the size of the payloads read by WebhookHandler,
+// GitHub caps them at 25 MB.
+const maxWebhookPayload = 25 << 20
+
+// pushEvent is the part of the payload of a GitHub push event used by
+// WebhookHandler.
+type pushEvent struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Repository struct {
+		FullName      string `json:"full_name"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+	Commits []struct {
+		Added    []string `json:"added"`
+		Removed  []string `json:"removed"`
+		Modified []string `json:"modified"`
+	} `json:"commits"`
+}
+
+// changesList reports whether e is a push to the default branch of the
+// repository of the public suffix list changing the list.
+func (e *pushEvent) changesList() bool {
+	if e.Repository.FullName != webhookRepository || e.Ref != "refs/heads/"+e.Repository.DefaultBranch {
+		return false
+	}
+
+	for _, commit := range e.Commits {
+		for _, files := range [][]string{commit.Added, commit.Removed, commit.Modified} {
+			for _, file := range files {
+				if file == webhookFile {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// WebhookHandler returns an http.Handler receiving the events of a GitHub
+// webhook configured with secret on the repository of the public suffix list.
+// It updates l, or the default list if l is nil, with listRetriever and opts
+// when a push to the default branch changes the list, instead of polling for
+// changes. If listRetriever is nil the list is retrieved from GitHub at the
+// pushed commit, so that an update right after a push doesn't get the
+// previous release.
+//
+// Events without a valid X-Hub-Signature-256 header are rejected, as are all
+// events if secret is empty. Updates run in the background once the event is
+// acknowledged, one at a time, the events received meanwhile triggering a
+// single update; their errors are reported by LastLoadError.
+func WebhookHandler(l *List, secret string, listRetriever ListRetriever, opts ...Option) http.Handler {
+	return newWebhookHandler(secret, func(after string) {
+		var list = l
+		if list == nil {
+			list = defaultList()
+		}
+
+		var retriever = listRetriever
+		if retriever == nil {
+			retriever = pinnedListRetriever{ListRetriever: defaultListRetriever, release: after}
+		}
+
+		list.UpdateWithListRetriever(retriever, opts...)
+	})
+}
+
+// newWebhookHandler returns the handler of WebhookHandler, calling update
+// with the commit pushed by the events changing the list.
+func newWebhookHandler(secret string, update func(after string)) http.Handler {
+	var updates = &webhookUpdates{update: update}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		// anyone can sign with an empty secret
+		if secret == "" {
+			http.Error(w, "webhook secret not configured", http.StatusForbidden)
+			return
+		}
+
+		var payload, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayload))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !validSignature(secret, payload, r.Header.Get("X-Hub-Signature-256")) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		// other events, such as the ping sent when the webhook is created,
+		// are acknowledged
+		if r.Header.Get("X-GitHub-Event") != "push" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var event pushEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !event.changesList() {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		go updates.run(event.After)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// webhookUpdates runs the updates triggered by a webhook one at a time.
+type webhookUpdates struct {
+	update func(after string)
+
+	// running is held during an update
+	running sync.Mutex
+
+	mu sync.Mutex
+	// after is the commit of the last event not handled yet
+	after   string
+	pending bool
+}
+
+// run updates to after, unless an update started since then already handled
+// a later event.
+func (u *webhookUpdates) run(after string) {
+	u.mu.Lock()
+	u.after, u.pending = after, true
+	u.mu.Unlock()
+
+	u.running.Lock()
+	defer u.running.Unlock()
+
+	u.mu.Lock()
+	after, pending := u.after, u.pending
+	u.pending = false
+	u.mu.Unlock()
+
+	if pending {
+		u.update(after)
+	}
+}
+
+// pinnedListRetriever retrieves the given release as the latest one.
+type pinnedListRetriever struct {
+	ListRetriever
+	release string
+}
+
+// GetLatestReleaseTag returns the pinned release, or the latest release of
+// the underlying retriever if none is pinned.
+func (p pinnedListRetriever) GetLatestReleaseTag() (string, error) {
+	if p.release == "" {
+		return p.ListRetriever.GetLatestReleaseTag()
+	}
+
+	return p.release, nil
+}
+
+// URL returns the URL of release if the underlying retriever reports it.
+func (p pinnedListRetriever) URL(release string) string {
+	if r, ok := p.ListRetriever.(urlRetriever); ok {
+		return r.URL(release)
+	}
+
+	return ""
+}
+
+// validSignature reports whether signature, the value of the
+// X-Hub-Signature-256 header, is the HMAC of payload with secret.
+func validSignature(secret string, payload []byte, signature string) bool {
+	if secret == "" {
+		return false
+	}
+
+	var sum, err = hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+
+	var mac = hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(sum, mac.Sum(nil))
+}
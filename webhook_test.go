@@ -0,0 +1,163 @@
+//go:build !tinygo && !publicsuffix_lite
+// +build !tinygo,!publicsuffix_lite
+
+/*
+Copyright 2018 GMO GlobalSign Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicsuffix
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_WebhookHandler(t *testing.T) {
+	const secret = "webhook_test"
+	var sign = func(payload string) string {
+		var mac = hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(payload))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	var push = func(repository, ref, file string) string {
+		return `{"ref":"` + ref + `","repository":{"full_name":"` + repository + `","default_branch":"main"},"commits":[{"modified":["` + file + `"]}]}`
+	}
+
+	var l = NewList()
+	var mockRetriever = mockListRetriever{RawList: bytes.NewBufferString(rulesTestList), Release: "webhook_test"}
+	var handler = WebhookHandler(l, secret, mockRetriever)
+
+	var tests = []struct {
+		name      string
+		method    string
+		event     string
+		payload   string
+		signature string
+		status    int
+	}{
+		{"get", http.MethodGet, "push", "", "", http.StatusMethodNotAllowed},
+		{"unsigned", http.MethodPost, "push", push("publicsuffix/list", "refs/heads/main", "public_suffix_list.dat"), "", http.StatusUnauthorized},
+		{"bad signature", http.MethodPost, "push", push("publicsuffix/list", "refs/heads/main", "public_suffix_list.dat"), sign("other"), http.StatusUnauthorized},
+		{"ping", http.MethodPost, "ping", `{"zen":"Keep it logically awesome."}`, sign(`{"zen":"Keep it logically awesome."}`), http.StatusNoContent},
+		{"invalid", http.MethodPost, "push", `{`, sign(`{`), http.StatusBadRequest},
+		{"other repository", http.MethodPost, "push", push("fork/list", "refs/heads/main", "public_suffix_list.dat"), sign(push("fork/list", "refs/heads/main", "public_suffix_list.dat")), http.StatusNoContent},
+		{"other branch", http.MethodPost, "push", push("publicsuffix/list", "refs/heads/dev", "public_suffix_list.dat"), sign(push("publicsuffix/list", "refs/heads/dev", "public_suffix_list.dat")), http.StatusNoContent},
+		{"other file", http.MethodPost, "push", push("publicsuffix/list", "refs/heads/main", "README.md"), sign(push("publicsuffix/list", "refs/heads/main", "README.md")), http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		var request = httptest.NewRequest(tt.method, "/", strings.NewReader(tt.payload))
+		request.Header.Set("X-GitHub-Event", tt.event)
+		request.Header.Set("X-Hub-Signature-256", tt.signature)
+
+		var recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		if recorder.Code != tt.status {
+			t.Fatalf("%s got: %d, want: %d", tt.name, recorder.Code, tt.status)
+		}
+	}
+
+	if release := l.Release(); release == "webhook_test" {
+		t.Fatalf("the list was updated by an ignored event")
+	}
+
+	var payload = push("publicsuffix/list", "refs/heads/main", "public_suffix_list.dat")
+	var request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set("X-GitHub-Event", "push")
+	request.Header.Set("X-Hub-Signature-256", sign(payload))
+
+	var recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusAccepted {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusAccepted)
+	}
+
+	// the update runs in the background
+	var deadline = time.Now().Add(5 * time.Second)
+	for l.Release() != "webhook_test" {
+		if time.Now().After(deadline) {
+			t.Fatalf("the list wasn't updated: %v", l.LastLoadError())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func Test_WebhookHandlerSecret(t *testing.T) {
+	var payload = `{"ref":"refs/heads/main","repository":{"full_name":"publicsuffix/list","default_branch":"main"},"commits":[{"modified":["public_suffix_list.dat"]}]}`
+
+	// the signature of an empty secret can be forged by anyone
+	var mac = hmac.New(sha256.New, nil)
+	mac.Write([]byte(payload))
+
+	var request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	request.Header.Set("X-GitHub-Event", "push")
+	request.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	var recorder = httptest.NewRecorder()
+	WebhookHandler(NewList(), "", nil).ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("got: %d, want: %d", recorder.Code, http.StatusForbidden)
+	}
+}
+
+func Test_WebhookUpdates(t *testing.T) {
+	var started, release = make(chan string), make(chan struct{})
+	var updates = &webhookUpdates{update: func(after string) {
+		started <- after
+		<-release
+	}}
+
+	go updates.run("a")
+	if after := <-started; after != "a" {
+		t.Fatalf("got: %s, want: %s", after, "a")
+	}
+
+	// the events received during an update trigger a single update to the
+	// last pushed commit
+	var done = make(chan struct{})
+	for _, after := range []string{"b", "c"} {
+		go func(after string) {
+			updates.run(after)
+			done <- struct{}{}
+		}(after)
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	release <- struct{}{}
+	if after := <-started; after != "c" {
+		t.Fatalf("got: %s, want: %s", after, "c")
+	}
+	release <- struct{}{}
+	<-done
+	<-done
+
+	var pinned = pinnedListRetriever{ListRetriever: mockListRetriever{Release: "latest"}, release: "c"}
+	if got, _ := pinned.GetLatestReleaseTag(); got != "c" {
+		t.Fatalf("got: %s, want: %s", got, "c")
+	}
+	if got, _ := (pinnedListRetriever{ListRetriever: mockListRetriever{Release: "latest"}}).GetLatestReleaseTag(); got != "latest" {
+		t.Fatalf("got: %s, want: %s", got, "latest")
+	}
+}